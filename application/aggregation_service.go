@@ -2,6 +2,7 @@ package application
 
 import (
 	"sort"
+	"time"
 
 	"github.com/Tattsum/github-analytics/domain"
 )
@@ -19,6 +20,36 @@ type MemberRepoStat struct {
 	ReviewCount   int
 	Additions     int
 	Deletions     int
+	// IssueThroughput はIssueのクローズ数・クローズまでの時間と種類別の内訳です.
+	IssueThroughput domain.IssueThroughput
+	// WorkCategories は作成したPRの作業の種類別の件数です.
+	WorkCategories domain.WorkCategoryCounts
+}
+
+// MemberPullRequest はメンバーが作成したPR 1件分のライフサイクルです.
+// メンバー別・リポジトリ別のサイクルタイム（中央値・90パーセンタイル）と大きさの分布、レビュアー別のレビュー負荷を求める入力として用います.
+// 分位点は合算できないため、集計済みの値ではなくPR単位の行から都度計算します.
+type MemberPullRequest struct {
+	Login     string
+	Lifecycle *domain.PullRequestLifecycle
+}
+
+// MemberIssue はメンバーが作成またはクローズしたIssue 1件分のライフサイクルです.
+// オープン中のIssue数（バックログ）を求める入力として用います.
+// 同じIssueが作成したメンバーとクローズしたメンバーの両方に現れるため、集計はノードIDで重複を除いてから行います.
+type MemberIssue struct {
+	Login     string
+	Lifecycle *domain.IssueLifecycle
+}
+
+// MemberReviewEdge はレビュアー×PR作成者×リポジトリ×日1件分のレビュー件数です.
+// 協業グラフ（ReviewNetwork）を組み立てる入力として用います.
+type MemberReviewEdge struct {
+	Reviewer      string
+	Author        string
+	NameWithOwner string
+	Day           string
+	ReviewCount   int
 }
 
 // SummarizeTeam はメンバー横断スカラー指標を合計し、チーム全体の集計値を返します.
@@ -36,6 +67,8 @@ func SummarizeTeam(members []*MemberStats) *TeamSummary {
 		summary.TotalReviews += member.TotalReviews
 		summary.TotalAdditions += member.TotalAdditions
 		summary.TotalDeletions += member.TotalDeletions
+		summary.IssueThroughput.Add(member.IssueThroughput)
+		summary.WorkCategories.Add(member.WorkCategories)
 	}
 
 	return summary
@@ -65,6 +98,8 @@ func AggregateRepositories(stats []*MemberRepoStat) []*RepositoryStats {
 		repo.TotalReviews += stat.ReviewCount
 		repo.TotalAdditions += stat.Additions
 		repo.TotalDeletions += stat.Deletions
+		repo.IssueThroughput.Add(stat.IssueThroughput)
+		repo.WorkCategories.Add(stat.WorkCategories)
 
 		repo.Contributors = append(repo.Contributors, &RepositoryContributor{
 			Login:       stat.Login,
@@ -118,6 +153,8 @@ func AggregateTeamDaily(rows []*domain.DailyStatistics) []*domain.DailyStatistic
 		day.ReviewCount += row.ReviewCount
 		day.TotalAdditions += row.TotalAdditions
 		day.TotalDeletions += row.TotalDeletions
+		day.IssueThroughput.Add(row.IssueThroughput)
+		day.WorkCategories.Add(row.WorkCategories)
 	}
 
 	days := make([]*domain.DailyStatistics, 0, len(byDay))
@@ -172,6 +209,8 @@ func AggregateRepositoryDaily(stats []*MemberRepoDayStat, metas []*RepoMeta) []*
 		day.ReviewCount += stat.ReviewCount
 		day.TotalAdditions += stat.Additions
 		day.TotalDeletions += stat.Deletions
+		day.IssueThroughput.Add(stat.IssueThroughput)
+		day.WorkCategories.Add(stat.WorkCategories)
 	}
 
 	repos := make([]*RepositoryDailyStats, 0, len(byRepoDay))
@@ -229,6 +268,8 @@ func AggregateRepositoryContributorDaily(
 		day.ReviewCount += stat.ReviewCount
 		day.TotalAdditions += stat.Additions
 		day.TotalDeletions += stat.Deletions
+		day.IssueThroughput.Add(stat.IssueThroughput)
+		day.WorkCategories.Add(stat.WorkCategories)
 	}
 
 	out := make(map[string][]*domain.DailyStatistics, len(byLogin))
@@ -252,3 +293,290 @@ func sortDailyStats(days map[string]*domain.DailyStatistics) []*domain.DailyStat
 
 	return out
 }
+
+// UniqueIssues はメンバーをまたいで重複するIssueをノードIDでまとめ、Issueごとに1件のライフサイクルを返します.
+// 同じIssueの記録が複数ある場合は、クローズを観測している記録を優先します.
+// 戻り値は最初に現れた順です.
+func UniqueIssues(issues []*MemberIssue) []*domain.IssueLifecycle {
+	index := make(map[string]int)
+	out := make([]*domain.IssueLifecycle, 0, len(issues))
+
+	for _, issue := range issues {
+		if issue == nil || issue.Lifecycle == nil {
+			continue
+		}
+
+		i, exists := index[issue.Lifecycle.SourceID]
+		if !exists {
+			index[issue.Lifecycle.SourceID] = len(out)
+			out = append(out, issue.Lifecycle)
+
+			continue
+		}
+
+		if out[i].ClosedAt == nil && issue.Lifecycle.ClosedAt != nil {
+			out[i] = issue.Lifecycle
+		}
+	}
+
+	return out
+}
+
+// CountOpenIssuesByRepository は at の時点でオープンだったIssue数を nameWithOwner ごとに返します（0件のリポジトリは含みません）.
+func CountOpenIssuesByRepository(issues []*domain.IssueLifecycle, at time.Time) map[string]int {
+	out := make(map[string]int)
+
+	for _, issue := range issues {
+		if issue != nil && issue.OpenAt(at) {
+			out[issue.Repository]++
+		}
+	}
+
+	return out
+}
+
+// SetIssueBacklog はリポジトリごとの日別時系列の各バケットに、バケットの終わりの時点でオープンだったIssue数を設定します.
+// バケットの Date はその期間の開始日で、終わりは granularity に応じた次のバケットの開始日（UTC）です.
+func SetIssueBacklog(repos []*RepositoryDailyStats, issues []*domain.IssueLifecycle, granularity Granularity) {
+	byRepo := make(map[string][]*domain.IssueLifecycle)
+	for _, issue := range issues {
+		if issue != nil {
+			byRepo[issue.Repository] = append(byRepo[issue.Repository], issue)
+		}
+	}
+
+	for _, repo := range repos {
+		if repo == nil || len(byRepo[repo.NameWithOwner]) == 0 {
+			continue
+		}
+
+		for _, day := range repo.DailyStats {
+			start, err := time.Parse(time.DateOnly, day.Date)
+			if err != nil {
+				continue
+			}
+
+			day.OpenIssueCount = domain.CountOpenIssues(byRepo[repo.NameWithOwner], bucketEnd(start, granularity))
+		}
+	}
+}
+
+// bucketEnd は start から始まる granularity のバケットの終わり（次のバケットの開始日時）を返します.
+func bucketEnd(start time.Time, granularity Granularity) time.Time {
+	switch granularity {
+	case GranularityWeek:
+		const daysPerWeek = 7
+
+		return start.AddDate(0, 0, daysPerWeek)
+	case GranularityMonth:
+		return start.AddDate(0, 1, 0)
+	case GranularityDay:
+		return start.AddDate(0, 0, 1)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
+
+// AggregateCycleTimeByLogin はPRをログインごとにまとめ、メンバー別のサイクルタイムを返します.
+func AggregateCycleTimeByLogin(prs []*MemberPullRequest) map[string]domain.CycleTimeStats {
+	return aggregateCycleTime(prs, func(pr *MemberPullRequest) string {
+		return pr.Login
+	})
+}
+
+// AggregateCycleTimeByRepository はPRを nameWithOwner ごとにまとめ、リポジトリ別のサイクルタイム（メンバー横断）を返します.
+func AggregateCycleTimeByRepository(prs []*MemberPullRequest) map[string]domain.CycleTimeStats {
+	return aggregateCycleTime(prs, func(pr *MemberPullRequest) string {
+		return pr.Lifecycle.Repository
+	})
+}
+
+// aggregateCycleTime はPRを key でグルーピングし、グループごとのサイクルタイムを計算します.
+func aggregateCycleTime(
+	prs []*MemberPullRequest,
+	key func(*MemberPullRequest) string,
+) map[string]domain.CycleTimeStats {
+	groups := groupPullRequests(prs, key)
+
+	out := make(map[string]domain.CycleTimeStats, len(groups))
+	for k, lifecycles := range groups {
+		out[k] = domain.CalculateCycleTimeStats(lifecycles)
+	}
+
+	return out
+}
+
+// AggregatePRSizeByLogin はPRをログインごとにまとめ、メンバー別の大きさの分布を返します.
+func AggregatePRSizeByLogin(prs []*MemberPullRequest) map[string]domain.PRSizeStats {
+	return aggregatePRSize(prs, func(pr *MemberPullRequest) string {
+		return pr.Login
+	})
+}
+
+// AggregatePRSizeByRepository はPRを nameWithOwner ごとにまとめ、リポジトリ別の大きさの分布（メンバー横断）を返します.
+func AggregatePRSizeByRepository(prs []*MemberPullRequest) map[string]domain.PRSizeStats {
+	return aggregatePRSize(prs, func(pr *MemberPullRequest) string {
+		return pr.Lifecycle.Repository
+	})
+}
+
+// aggregatePRSize はPRを key でグルーピングし、グループごとの大きさの分布を計算します.
+func aggregatePRSize(
+	prs []*MemberPullRequest,
+	key func(*MemberPullRequest) string,
+) map[string]domain.PRSizeStats {
+	groups := groupPullRequests(prs, key)
+
+	out := make(map[string]domain.PRSizeStats, len(groups))
+	for k, lifecycles := range groups {
+		out[k] = domain.CalculatePRSizeStats(lifecycles)
+	}
+
+	return out
+}
+
+// groupPullRequests はPRのライフサイクルを key でグルーピングします.
+func groupPullRequests(
+	prs []*MemberPullRequest,
+	key func(*MemberPullRequest) string,
+) map[string][]*domain.PullRequestLifecycle {
+	groups := make(map[string][]*domain.PullRequestLifecycle)
+
+	for _, pr := range prs {
+		if pr == nil || pr.Lifecycle == nil {
+			continue
+		}
+
+		k := key(pr)
+		groups[k] = append(groups[k], pr.Lifecycle)
+	}
+
+	return groups
+}
+
+// BuildReviewLoad はメンバーが作成したPRのレビュー依頼をレビュアーごとに合算し、レビュアーの昇順で返します.
+// 未完了の依頼は依頼日時の古い順に並べます.
+func BuildReviewLoad(prs []*MemberPullRequest) []*ReviewLoad {
+	loads := make(map[string]*ReviewLoad)
+
+	for _, pr := range prs {
+		if pr == nil || pr.Lifecycle == nil {
+			continue
+		}
+
+		for _, request := range pr.Lifecycle.ReviewRequests {
+			load, exists := loads[request.Reviewer]
+			if !exists {
+				load = &ReviewLoad{Reviewer: request.Reviewer, PendingReviews: make([]*PendingReview, 0)}
+				loads[request.Reviewer] = load
+			}
+
+			load.Requested++
+
+			if request.Completed {
+				load.Completed++
+			}
+
+			if request.Pending {
+				load.PendingReviews = append(load.PendingReviews, &PendingReview{
+					Repository:  pr.Lifecycle.Repository,
+					SourceID:    pr.Lifecycle.SourceID,
+					Author:      pr.Login,
+					RequestedAt: request.RequestedAt,
+				})
+			}
+		}
+	}
+
+	out := make([]*ReviewLoad, 0, len(loads))
+	for _, load := range loads {
+		sort.SliceStable(load.PendingReviews, func(i, j int) bool {
+			return load.PendingReviews[i].RequestedAt.Before(load.PendingReviews[j].RequestedAt)
+		})
+
+		out = append(out, load)
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Reviewer < out[j].Reviewer
+	})
+
+	return out
+}
+
+// BuildReviewNetwork はレビュアー×PR作成者×リポジトリ×日の行を (reviewer, author) の組ごとに合算し、
+// 協業グラフのノードと重み付きエッジを返します.
+// ノードは login の昇順、エッジは reviewer・author の昇順で安定ソートされます.
+// 期間の絞り込みは呼び出し元（SQL）で済ませてある前提です.
+func BuildReviewNetwork(rows []*MemberReviewEdge) *ReviewNetwork {
+	type pair struct{ reviewer, author string }
+
+	edges := make(map[pair]*ReviewNetworkEdge)
+	repos := make(map[pair]map[string]bool)
+	nodes := make(map[string]*ReviewNetworkNode)
+
+	node := func(login string) *ReviewNetworkNode {
+		n, exists := nodes[login]
+		if !exists {
+			n = &ReviewNetworkNode{Login: login}
+			nodes[login] = n
+		}
+
+		return n
+	}
+
+	for _, row := range rows {
+		if row == nil || row.ReviewCount <= 0 {
+			continue
+		}
+
+		key := pair{reviewer: row.Reviewer, author: row.Author}
+
+		edge, exists := edges[key]
+		if !exists {
+			edge = &ReviewNetworkEdge{Reviewer: row.Reviewer, Author: row.Author}
+			edges[key] = edge
+			repos[key] = make(map[string]bool)
+		}
+
+		edge.ReviewCount += row.ReviewCount
+		repos[key][row.NameWithOwner] = true
+
+		node(row.Reviewer).ReviewsGiven += row.ReviewCount
+		node(row.Author).ReviewsReceived += row.ReviewCount
+	}
+
+	network := &ReviewNetwork{
+		Nodes: make([]*ReviewNetworkNode, 0, len(nodes)),
+		Edges: make([]*ReviewNetworkEdge, 0, len(edges)),
+	}
+
+	for _, n := range nodes {
+		network.Nodes = append(network.Nodes, n)
+	}
+
+	sort.Slice(network.Nodes, func(i, j int) bool {
+		return network.Nodes[i].Login < network.Nodes[j].Login
+	})
+
+	for key, edge := range edges {
+		edge.Repositories = make([]string, 0, len(repos[key]))
+		for repo := range repos[key] {
+			edge.Repositories = append(edge.Repositories, repo)
+		}
+
+		sort.Strings(edge.Repositories)
+		network.Edges = append(network.Edges, edge)
+	}
+
+	sort.Slice(network.Edges, func(i, j int) bool {
+		a, b := network.Edges[i], network.Edges[j]
+		if a.Reviewer != b.Reviewer {
+			return a.Reviewer < b.Reviewer
+		}
+
+		return a.Author < b.Author
+	})
+
+	return network
+}
@@ -1,7 +1,9 @@
 package application
 
 import (
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/Tattsum/github-analytics/domain"
 )
@@ -436,3 +438,206 @@ func TestAggregateTeamDaily_Empty(t *testing.T) {
 		t.Errorf("AggregateTeamDaily(nil) = %+v, want empty", got)
 	}
 }
+
+func TestAggregateCycleTime(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC)
+	pr := func(login, repo string, mergeAfterHours int) *MemberPullRequest {
+		merged := created.Add(time.Duration(mergeAfterHours) * time.Hour)
+
+		return &MemberPullRequest{
+			Login: login,
+			Lifecycle: &domain.PullRequestLifecycle{
+				Repository: repo,
+				CreatedAt:  created,
+				MergedAt:   &merged,
+				ClosedAt:   &merged,
+			},
+		}
+	}
+
+	prs := []*MemberPullRequest{
+		pr("alice", "acme/api", 2),
+		pr("alice", "acme/web", 10),
+		pr("bob", "acme/api", 6),
+		nil,
+		{Login: "carol"},
+	}
+
+	byLogin := AggregateCycleTimeByLogin(prs)
+	if len(byLogin) != 2 {
+		t.Fatalf("AggregateCycleTimeByLogin() len = %d, want 2 (nil rows skipped)", len(byLogin))
+	}
+
+	if got := byLogin["alice"]; got.PRCount != 2 || got.TimeToMergeHours.Median != 6 {
+		t.Errorf("alice cycle time = %+v, want 2 PRs with median merge 6h", got)
+	}
+
+	byRepo := AggregateCycleTimeByRepository(prs)
+	if got := byRepo["acme/api"]; got.PRCount != 2 || got.TimeToMergeHours.Median != 4 {
+		t.Errorf("acme/api cycle time = %+v, want 2 PRs with median merge 4h across members", got)
+	}
+
+	if got := byRepo["acme/web"]; got.PRCount != 1 || got.TimeToMergeHours.P90 != 10 {
+		t.Errorf("acme/web cycle time = %+v, want 1 PR with p90 merge 10h", got)
+	}
+}
+
+func TestAggregatePRSize(t *testing.T) {
+	t.Parallel()
+
+	pr := func(login, repo string, lines, files int) *MemberPullRequest {
+		return &MemberPullRequest{
+			Login:     login,
+			Lifecycle: &domain.PullRequestLifecycle{Repository: repo, Additions: lines, ChangedFiles: files},
+		}
+	}
+
+	prs := []*MemberPullRequest{
+		pr("alice", "acme/api", 8, 1),
+		pr("alice", "acme/web", 2000, 50),
+		pr("bob", "acme/api", 120, 4),
+		nil,
+	}
+
+	if got := AggregatePRSizeByLogin(prs)["alice"]; got.PRCount != 2 || got.Histogram.XS != 1 || got.Histogram.XL != 1 {
+		t.Errorf("alice PR size = %+v, want one XS and one XL", got)
+	}
+
+	if got := AggregatePRSizeByRepository(prs)["acme/api"]; got.PRCount != 2 || got.ChangedLines.Median != 64 {
+		t.Errorf("acme/api PR size = %+v, want 2 PRs with median 64 lines across members", got)
+	}
+}
+
+func TestBuildReviewLoad(t *testing.T) {
+	t.Parallel()
+
+	at := func(day int) time.Time { return time.Date(2024, 3, day, 9, 0, 0, 0, time.UTC) }
+	prs := []*MemberPullRequest{
+		{Login: "alice", Lifecycle: &domain.PullRequestLifecycle{
+			Repository: "acme/api", SourceID: "PR_1",
+			ReviewRequests: []domain.ReviewRequest{
+				{Reviewer: "bob", RequestedAt: at(3), Pending: true},
+				{Reviewer: "carol", RequestedAt: at(1), Completed: true},
+			},
+		}},
+		{Login: "carol", Lifecycle: &domain.PullRequestLifecycle{
+			Repository: "acme/web", SourceID: "PR_2",
+			ReviewRequests: []domain.ReviewRequest{
+				{Reviewer: "bob", RequestedAt: at(2), Pending: true},
+			},
+		}},
+		{Login: "dave", Lifecycle: &domain.PullRequestLifecycle{
+			Repository: "acme/web", SourceID: "PR_3",
+			ReviewRequests: []domain.ReviewRequest{{Reviewer: "bob", RequestedAt: at(1), Completed: true}},
+		}},
+		nil,
+	}
+
+	loads := BuildReviewLoad(prs)
+	if len(loads) != 2 || loads[0].Reviewer != "bob" || loads[1].Reviewer != "carol" {
+		t.Fatalf("BuildReviewLoad() = %+v, want bob and carol in order", loads)
+	}
+
+	bob := loads[0]
+	if bob.Requested != 3 || bob.Completed != 1 || len(bob.PendingReviews) != 2 {
+		t.Fatalf("bob = %+v, want 3 requested, 1 completed, 2 pending", bob)
+	}
+
+	// The queue is oldest request first.
+	if first := bob.PendingReviews[0]; first.SourceID != "PR_2" || first.Author != "carol" || first.Repository != "acme/web" {
+		t.Errorf("bob's oldest pending review = %+v, want carol's PR_2", first)
+	}
+
+	if carol := loads[1]; carol.Requested != 1 || carol.Completed != 1 || len(carol.PendingReviews) != 0 {
+		t.Errorf("carol = %+v, want one completed request", carol)
+	}
+}
+
+func TestIssueBacklog(t *testing.T) {
+	t.Parallel()
+
+	at := func(day int) time.Time { return time.Date(2024, 3, day, 9, 0, 0, 0, time.UTC) }
+	closed := at(5)
+	reopenedRecord := &domain.IssueLifecycle{Repository: "acme/api", SourceID: "I_2", CreatedAt: at(2)}
+	closedRecord := &domain.IssueLifecycle{Repository: "acme/api", SourceID: "I_2", CreatedAt: at(2), ClosedAt: &closed}
+
+	issues := UniqueIssues([]*MemberIssue{
+		{Login: "alice", Lifecycle: &domain.IssueLifecycle{Repository: "acme/api", SourceID: "I_1", CreatedAt: at(1)}},
+		{Login: "alice", Lifecycle: reopenedRecord},
+		{Login: "bob", Lifecycle: closedRecord},
+		{Login: "bob", Lifecycle: &domain.IssueLifecycle{Repository: "acme/web", SourceID: "I_3", CreatedAt: at(3)}},
+		nil,
+		{Login: "carol"},
+	})
+
+	if len(issues) != 3 || issues[1] != closedRecord {
+		t.Fatalf("UniqueIssues() = %+v, want 3 issues preferring the record that saw the close", issues)
+	}
+
+	if got := CountOpenIssuesByRepository(issues, at(10)); got["acme/api"] != 1 || got["acme/web"] != 1 {
+		t.Errorf("CountOpenIssuesByRepository() = %v, want 1 open issue per repository", got)
+	}
+
+	repos := []*RepositoryDailyStats{{
+		NameWithOwner: "acme/api",
+		DailyStats:    []*domain.DailyStatistics{{Date: "2024-03-02"}, {Date: "2024-03-04"}, {Date: "2024-03-05"}},
+	}}
+
+	SetIssueBacklog(repos, issues, GranularityDay)
+
+	got := []int{repos[0].DailyStats[0].OpenIssueCount, repos[0].DailyStats[1].OpenIssueCount, repos[0].DailyStats[2].OpenIssueCount}
+	if want := []int{2, 2, 1}; !slices.Equal(got, want) {
+		t.Errorf("daily backlog = %v, want %v (counted at the end of each day)", got, want)
+	}
+
+	SetIssueBacklog(repos, issues, GranularityMonth)
+
+	if got := repos[0].DailyStats[0].OpenIssueCount; got != 1 {
+		t.Errorf("monthly backlog = %d, want 1 at the end of the month", got)
+	}
+}
+
+func TestBuildReviewNetwork(t *testing.T) {
+	t.Parallel()
+
+	rows := []*MemberReviewEdge{
+		{Reviewer: "bob", Author: "alice", NameWithOwner: "acme/web", Day: "2024-01-09", ReviewCount: 1},
+		{Reviewer: "bob", Author: "alice", NameWithOwner: "acme/api", Day: "2024-01-08", ReviewCount: 2},
+		{Reviewer: "alice", Author: "bob", NameWithOwner: "acme/api", Day: "2024-01-08", ReviewCount: 1},
+		{Reviewer: "alice", Author: "carol", NameWithOwner: "acme/api", Day: "2024-01-10", ReviewCount: 0},
+		nil,
+	}
+
+	got := BuildReviewNetwork(rows)
+
+	if len(got.Nodes) != 2 {
+		t.Fatalf("BuildReviewNetwork() nodes = %d, want 2 (zero-count rows skipped)", len(got.Nodes))
+	}
+
+	if n := got.Nodes[0]; n.Login != "alice" || n.ReviewsGiven != 1 || n.ReviewsReceived != 3 {
+		t.Errorf("node[0] = %+v, want alice given 1 / received 3", n)
+	}
+
+	if n := got.Nodes[1]; n.Login != "bob" || n.ReviewsGiven != 3 || n.ReviewsReceived != 1 {
+		t.Errorf("node[1] = %+v, want bob given 3 / received 1", n)
+	}
+
+	if len(got.Edges) != 2 {
+		t.Fatalf("BuildReviewNetwork() edges = %d, want 2", len(got.Edges))
+	}
+
+	if e := got.Edges[0]; e.Reviewer != "alice" || e.Author != "bob" || e.ReviewCount != 1 {
+		t.Errorf("edge[0] = %+v, want alice -> bob weight 1", e)
+	}
+
+	e := got.Edges[1]
+	if e.Reviewer != "bob" || e.Author != "alice" || e.ReviewCount != 3 {
+		t.Errorf("edge[1] = %+v, want bob -> alice weight 3", e)
+	}
+
+	if len(e.Repositories) != 2 || e.Repositories[0] != "acme/api" || e.Repositories[1] != "acme/web" {
+		t.Errorf("edge[1].Repositories = %v, want [acme/api acme/web]", e.Repositories)
+	}
+}
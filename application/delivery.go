@@ -0,0 +1,187 @@
+package application
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Tattsum/github-analytics/domain"
+)
+
+// daysPerWeek は1週の日数です.
+const daysPerWeek = 7
+
+// DeliveryMetrics はリポジトリのデリバリー指標（DORA の4指標）を期間で集計したものです.
+type DeliveryMetrics struct {
+	Repository string
+	// Source はデプロイとして数えた記録の種類です（期間内の最新の週のもの。週が無ければ空）.
+	Source                domain.DeliverySource
+	DeploymentCount       int
+	FailedDeploymentCount int
+	// WeekCount はデプロイ頻度の分母にした週数です.
+	// 期間の指定が無い側は、期間内で最初（最後）にデプロイがあった週までを数えます.
+	WeekCount int
+	// DeploymentsPerWeek はデプロイ頻度（1週あたりのデプロイ数）です.
+	DeploymentsPerWeek float64
+	// LeadTimeHours はマージからデプロイまでの平均時間です（対象のPRが無ければ nil）.
+	LeadTimeHours *float64
+	// ChangeFailureRate はデプロイのうち失敗した、またはロールバックされた割合です（デプロイが無ければ nil）.
+	ChangeFailureRate *float64
+	// TimeToRestoreHours は失敗から次に成功したデプロイまでの平均時間です（復旧した失敗が無ければ nil）.
+	TimeToRestoreHours *float64
+	// Weeks は期間内の週ごとの指標です（週の昇順）.
+	Weeks []*domain.DeliveryWeek
+}
+
+// SummarizeDelivery は repository の週ごとのデリバリー指標のうち、from を含む週から to までに始まる週を集計します.
+// from / to は "2006-01-02" 形式の日付で両端を含みます（空文字なら無制限）.
+func SummarizeDelivery(repository string, weeks []*domain.DeliveryWeek, from, to string) *DeliveryMetrics {
+	fromWeek := from
+	if day, err := time.Parse(time.DateOnly, from); err == nil {
+		fromWeek = domain.DeliveryWeekOf(day)
+	}
+
+	toWeek := to
+	if day, err := time.Parse(time.DateOnly, to); err == nil {
+		toWeek = domain.DeliveryWeekOf(day)
+	}
+
+	metrics := &DeliveryMetrics{Repository: repository, Weeks: make([]*domain.DeliveryWeek, 0)}
+
+	var leadSeconds, leadCount, restoreSeconds, restoreCount int
+
+	for _, w := range weeks {
+		if w.Repository != repository || (fromWeek != "" && w.Week < fromWeek) || (toWeek != "" && w.Week > toWeek) {
+			continue
+		}
+
+		metrics.Weeks = append(metrics.Weeks, w)
+		metrics.DeploymentCount += w.DeploymentCount
+		metrics.FailedDeploymentCount += w.FailedDeploymentCount
+		leadSeconds += w.LeadTimeSeconds
+		leadCount += w.LeadTimeCount
+		restoreSeconds += w.RestoreSeconds
+		restoreCount += w.RestoreCount
+	}
+
+	slices.SortFunc(metrics.Weeks, func(a, b *domain.DeliveryWeek) int { return strings.Compare(a.Week, b.Week) })
+
+	if n := len(metrics.Weeks); n > 0 {
+		metrics.Source = metrics.Weeks[n-1].Source
+
+		if fromWeek == "" {
+			fromWeek = metrics.Weeks[0].Week
+		}
+
+		if toWeek == "" {
+			toWeek = metrics.Weeks[n-1].Week
+		}
+	}
+
+	metrics.WeekCount = weeksBetween(fromWeek, toWeek)
+	if metrics.WeekCount > 0 {
+		metrics.DeploymentsPerWeek = float64(metrics.DeploymentCount) / float64(metrics.WeekCount)
+	}
+
+	metrics.LeadTimeHours = averageHours(leadSeconds, leadCount)
+	metrics.TimeToRestoreHours = averageHours(restoreSeconds, restoreCount)
+
+	if metrics.DeploymentCount > 0 {
+		rate := float64(metrics.FailedDeploymentCount) / float64(metrics.DeploymentCount)
+		metrics.ChangeFailureRate = &rate
+	}
+
+	return metrics
+}
+
+// weeksBetween は週の開始日 from から to までの週数（両端を含む）を返します（どちらかが日付でなければ0）.
+func weeksBetween(from, to string) int {
+	const week = daysPerWeek * 24 * time.Hour
+
+	start, err := time.Parse(time.DateOnly, from)
+	if err != nil {
+		return 0
+	}
+
+	end, err := time.Parse(time.DateOnly, to)
+	if err != nil || end.Before(start) {
+		return 0
+	}
+
+	return int(end.Sub(start)/week) + 1
+}
+
+// averageHours は秒数の合計 total の count 件あたりの平均を時間単位で返します（count が0なら nil）.
+func averageHours(total, count int) *float64 {
+	if count == 0 {
+		return nil
+	}
+
+	hours := (time.Duration(total) * time.Second).Hours() / float64(count)
+
+	return &hours
+}
+
+// DeliveryRepositories はデリバリー指標を取得する対象として、メンバーのPRがマージされたリポジトリを昇順で返します.
+func DeliveryRepositories(members []*domain.UserStatistics) []string {
+	repos := make([]string, 0)
+
+	for _, member := range members {
+		if member == nil {
+			continue
+		}
+
+		for _, pr := range member.PRLifecycles {
+			if pr != nil && pr.MergedAt != nil && !slices.Contains(repos, pr.Repository) {
+				repos = append(repos, pr.Repository)
+			}
+		}
+	}
+
+	slices.Sort(repos)
+
+	return repos
+}
+
+// CalculateDeliveryWeeks は各リポジトリのデプロイ記録と、メンバーが作成したPRのライフサイクルから、リポジトリ×週のデリバリー指標を求めます.
+// リードタイムにはメンバーが作成したPRだけを含めます.
+func CalculateDeliveryWeeks(deliveries []*domain.RepositoryDeliveries, members []*domain.UserStatistics) []*domain.DeliveryWeek {
+	var prs []*domain.PullRequestLifecycle
+
+	for _, member := range members {
+		if member != nil {
+			prs = append(prs, member.PRLifecycles...)
+		}
+	}
+
+	weeks := make([]*domain.DeliveryWeek, 0)
+	for _, d := range deliveries {
+		weeks = append(weeks, domain.CalculateDeliveryWeeks(d, prs)...)
+	}
+
+	return weeks
+}
+
+// DeliveryWeeksInPeriod は期間と重なる週のデリバリー指標を返します（期間の指定が無ければそのまま返します）.
+func DeliveryWeeksInPeriod(weeks []*domain.DeliveryWeek, period domain.CollectionPeriod) []*domain.DeliveryWeek {
+	if period.IsZero() {
+		return weeks
+	}
+
+	out := make([]*domain.DeliveryWeek, 0, len(weeks))
+
+	for _, w := range weeks {
+		start, err := time.Parse(time.DateOnly, w.Week)
+		if err != nil {
+			continue
+		}
+
+		if period.EndsBy(start) || !start.AddDate(0, 0, daysPerWeek).After(period.Since) {
+			continue
+		}
+
+		out = append(out, w)
+	}
+
+	return out
+}
@@ -0,0 +1,103 @@
+package application
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Tattsum/github-analytics/domain"
+)
+
+func TestSummarizeDelivery(t *testing.T) {
+	t.Parallel()
+
+	const hour = int(time.Hour / time.Second)
+
+	weeks := []*domain.DeliveryWeek{
+		{Repository: "acme/api", Week: "2024-03-11", Source: domain.DeliverySourceDeployment,
+			DeploymentCount: 2, FailedDeploymentCount: 1, LeadTimeSeconds: 6 * hour, LeadTimeCount: 2,
+			RestoreSeconds: 2 * hour, RestoreCount: 1},
+		{Repository: "acme/api", Week: "2024-03-04", Source: domain.DeliverySourceRelease,
+			DeploymentCount: 2, LeadTimeSeconds: 2 * hour, LeadTimeCount: 1},
+		{Repository: "acme/api", Week: "2024-02-26", Source: domain.DeliverySourceRelease, DeploymentCount: 5},
+		{Repository: "acme/web", Week: "2024-03-04", Source: domain.DeliverySourceTag, DeploymentCount: 9},
+	}
+
+	// 2024-03-06 is a Wednesday: its whole week is included.
+	got := SummarizeDelivery("acme/api", weeks, "2024-03-06", "2024-03-24")
+
+	require.Len(t, got.Weeks, 2)
+	assert.Equal(t, "2024-03-04", got.Weeks[0].Week)
+	assert.Equal(t, domain.DeliverySourceDeployment, got.Source, "最新の週の種類")
+	assert.Equal(t, 4, got.DeploymentCount)
+	assert.Equal(t, 1, got.FailedDeploymentCount)
+	assert.Equal(t, 3, got.WeekCount, "2024-03-04 から 2024-03-18 の週まで")
+	assert.InDelta(t, 4.0/3, got.DeploymentsPerWeek, 1e-9)
+	require.NotNil(t, got.LeadTimeHours)
+	assert.InDelta(t, 8.0/3, *got.LeadTimeHours, 1e-9)
+	require.NotNil(t, got.ChangeFailureRate)
+	assert.InDelta(t, 0.25, *got.ChangeFailureRate, 1e-9)
+	require.NotNil(t, got.TimeToRestoreHours)
+	assert.InDelta(t, 2.0, *got.TimeToRestoreHours, 1e-9)
+
+	open := SummarizeDelivery("acme/api", weeks, "", "")
+	assert.Equal(t, 9, open.DeploymentCount)
+	assert.Equal(t, 3, open.WeekCount, "指定が無い側は最初と最後のデプロイの週まで")
+
+	none := SummarizeDelivery("acme/other", weeks, "", "")
+	assert.Empty(t, none.Weeks)
+	assert.Zero(t, none.WeekCount)
+	assert.Empty(t, none.Source)
+	assert.Nil(t, none.LeadTimeHours)
+	assert.Nil(t, none.ChangeFailureRate)
+	assert.Nil(t, none.TimeToRestoreHours)
+}
+
+func TestCalculateDeliveryWeeks_UsesMembersPullRequests(t *testing.T) {
+	t.Parallel()
+
+	deployedAt := time.Date(2024, time.March, 5, 12, 0, 0, 0, time.UTC)
+	mergedAt := deployedAt.Add(-3 * time.Hour)
+	openedAt := mergedAt.Add(-time.Hour)
+
+	members := []*domain.UserStatistics{
+		{PRLifecycles: []*domain.PullRequestLifecycle{
+			{Repository: "acme/web", CreatedAt: openedAt},
+			{Repository: "acme/api", CreatedAt: openedAt, MergedAt: &mergedAt},
+		}},
+		nil,
+		{PRLifecycles: []*domain.PullRequestLifecycle{{Repository: "acme/web", CreatedAt: openedAt, MergedAt: &mergedAt}}},
+	}
+
+	assert.Equal(t, []string{"acme/api", "acme/web"}, DeliveryRepositories(members))
+
+	deliveries := []*domain.RepositoryDeliveries{{
+		Repository: "acme/api",
+		Releases:   []*domain.Release{{TagName: "v0", PublishedAt: mergedAt.Add(-time.Hour)}, {TagName: "v1", PublishedAt: deployedAt}},
+	}}
+
+	weeks := CalculateDeliveryWeeks(deliveries, members)
+	require.Len(t, weeks, 1)
+	assert.Equal(t, 2, weeks[0].DeploymentCount)
+	assert.Equal(t, 1, weeks[0].LeadTimeCount)
+	assert.Equal(t, int((3 * time.Hour).Seconds()), weeks[0].LeadTimeSeconds)
+}
+
+func TestDeliveryWeeksInPeriod(t *testing.T) {
+	t.Parallel()
+
+	weeks := []*domain.DeliveryWeek{{Week: "2024-02-26"}, {Week: "2024-03-04"}, {Week: "2024-03-11"}}
+
+	period := domain.CollectionPeriod{
+		Since: time.Date(2024, time.March, 6, 0, 0, 0, 0, time.UTC),
+		Until: time.Date(2024, time.March, 11, 0, 0, 0, 0, time.UTC),
+	}
+
+	got := DeliveryWeeksInPeriod(weeks, period)
+	require.Len(t, got, 1, "期間と重なる週だけ")
+	assert.Equal(t, "2024-03-04", got[0].Week)
+
+	assert.Len(t, DeliveryWeeksInPeriod(weeks, domain.CollectionPeriod{}), 3)
+}
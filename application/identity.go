@@ -0,0 +1,173 @@
+package application
+
+import (
+	"strings"
+
+	"github.com/Tattsum/github-analytics/domain"
+	"github.com/Tattsum/github-analytics/infrastructure"
+)
+
+// BaselinesWithAccounts は baselines のうち、起点のスナップショットでまとめていたアカウントが
+// identities の現在の対応と同じメンバーの起点だけを返します.
+// アカウントを追加・統合したメンバーは、追加したアカウントの起点より前の活動が起点に含まれないため、
+// アカウントを外したメンバーは外したアカウントの活動が起点に残るため、いずれも全期間取得します.
+func BaselinesWithAccounts(baselines map[string]*MemberBaseline, identities *domain.IdentityMap) map[string]*MemberBaseline {
+	matched := make(map[string]*MemberBaseline, len(baselines))
+
+	for login, baseline := range baselines {
+		if baseline != nil && sameAccounts(baseline.Accounts, identities.Accounts(login)) {
+			matched[login] = baseline
+		}
+	}
+
+	return matched
+}
+
+// sameAccounts は2つのアカウント一覧が、大文字小文字と順序を無視して同じかどうかを返します.
+func sameAccounts(a, b []string) bool {
+	set := make(map[string]struct{}, len(a))
+	for _, account := range a {
+		set[strings.ToLower(account)] = struct{}{}
+	}
+
+	other := make(map[string]struct{}, len(b))
+	for _, account := range b {
+		if _, ok := set[strings.ToLower(account)]; !ok {
+			return false
+		}
+
+		other[strings.ToLower(account)] = struct{}{}
+	}
+
+	return len(set) == len(other)
+}
+
+// MergeActivity は1人のメンバー identity の各アカウントの活動データを、代表ログインの1人分の活動データにまとめます.
+// CalculateStatistics の前に適用し、メンバー単位の統計・日別推移・リポジトリ内訳をアカウントをまたいで合算させます.
+// レビュー対象PRの作成者も identities で代表ログインに置き換えるため、まとめたアカウント同士のレビューは自分のPRへのレビューとして扱われます.
+// 名前は identity の表示名、無ければ代表ログインのアカウント（無ければ他のアカウント）の名前を、作成日時は最も古いものを使います.
+// 同じノードIDの活動は1件にまとめます（対応表を導入する前にアカウントごとに保存したイベントと、導入後に代表ログインで保存したイベントが重なる場合など）.
+func MergeActivity(
+	identity *domain.Identity,
+	parts []*infrastructure.UserActivityData,
+	identities *domain.IdentityMap,
+) *infrastructure.UserActivityData {
+	merged := &infrastructure.UserActivityData{
+		User:         domain.NewUser(identity.Login, identity.Name, ""),
+		Commits:      make([]*domain.Activity, 0),
+		PRs:          make([]*domain.Activity, 0),
+		Issues:       make([]*domain.Activity, 0),
+		Reviews:      make([]*domain.Activity, 0),
+		IssueCloses:  make([]*domain.Activity, 0),
+		PRLifecycles: make([]*domain.PullRequestLifecycle, 0),
+	}
+
+	if len(identity.Logins) > 1 {
+		merged.Accounts = identity.Logins
+	}
+
+	var canonicalName, firstName string
+
+	seen := make(map[string]struct{})
+
+	for _, part := range parts {
+		if part == nil {
+			continue
+		}
+
+		if part.User != nil {
+			if strings.EqualFold(part.User.Login, identity.Login) {
+				canonicalName = part.User.Name
+			} else if firstName == "" {
+				firstName = part.User.Name
+			}
+
+			if part.User.CreatedAt != "" && (merged.User.CreatedAt == "" || part.User.CreatedAt < merged.User.CreatedAt) {
+				merged.User.CreatedAt = part.User.CreatedAt
+			}
+		}
+
+		merged.Commits = appendUnseen(merged.Commits, part.Commits, seen)
+		merged.PRs = appendUnseen(merged.PRs, part.PRs, seen)
+		merged.Issues = appendUnseen(merged.Issues, part.Issues, seen)
+		merged.IssueCloses = appendUnseen(merged.IssueCloses, part.IssueCloses, seen)
+		merged.PRLifecycles = append(merged.PRLifecycles, part.PRLifecycles...)
+		merged.IssueLifecycles = appendIssueLifecycles(merged.IssueLifecycles, part.IssueLifecycles)
+		merged.Gaps = append(merged.Gaps, part.Gaps...)
+		merged.Reviews = appendUnseen(merged.Reviews, canonicalReviews(part.Reviews, identities), seen)
+	}
+
+	for _, name := range []string{identity.Name, canonicalName, firstName} {
+		if name != "" {
+			merged.User.Name = name
+
+			break
+		}
+	}
+
+	return merged
+}
+
+// appendUnseen は activities のうち、同じ種類・ノードIDの活動が seen に無いものを dst に追加します.
+// ノードIDを持たない活動（日単位のコミット貢献など）は別の活動と区別できないため、そのまま追加します.
+func appendUnseen(dst, activities []*domain.Activity, seen map[string]struct{}) []*domain.Activity {
+	for _, activity := range activities {
+		if activity != nil && activity.SourceID != "" {
+			key := domain.ActivityNaturalKey("", activity)
+			if _, ok := seen[key]; ok {
+				continue
+			}
+
+			seen[key] = struct{}{}
+		}
+
+		dst = append(dst, activity)
+	}
+
+	return dst
+}
+
+// canonicalReviews はレビュー対象PRの作成者を代表ログインに置き換えたレビューの複製を返します.
+// 元の活動は他のメンバーの取得結果と共有されている場合があるため書き換えません.
+func canonicalReviews(reviews []*domain.Activity, identities *domain.IdentityMap) []*domain.Activity {
+	out := make([]*domain.Activity, 0, len(reviews))
+
+	for _, review := range reviews {
+		if review == nil {
+			continue
+		}
+
+		canonical := *review
+		if canonical.PullRequestAuthor != "" {
+			canonical.PullRequestAuthor = identities.Canonical(canonical.PullRequestAuthor)
+		}
+
+		out = append(out, &canonical)
+	}
+
+	return out
+}
+
+// appendIssueLifecycles は issues のうち、同じノードIDのライフサイクルが dst に無いものを dst に追加します.
+// まとめたアカウント同士が同じIssueを作成・クローズしていても、1件として扱います.
+func appendIssueLifecycles(dst, issues []*domain.IssueLifecycle) []*domain.IssueLifecycle {
+	seen := make(map[string]struct{}, len(dst))
+	for _, issue := range dst {
+		seen[issue.SourceID] = struct{}{}
+	}
+
+	for _, issue := range issues {
+		if issue == nil {
+			continue
+		}
+
+		if _, ok := seen[issue.SourceID]; ok {
+			continue
+		}
+
+		seen[issue.SourceID] = struct{}{}
+		dst = append(dst, issue)
+	}
+
+	return dst
+}
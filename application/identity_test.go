@@ -0,0 +1,159 @@
+package application
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Tattsum/github-analytics/domain"
+	"github.com/Tattsum/github-analytics/infrastructure"
+)
+
+func TestMergeActivity_CombinesAccountsBeforeStatistics(t *testing.T) {
+	t.Parallel()
+
+	identities, err := domain.NewIdentityMap([]domain.Identity{
+		{Login: "alice", Name: "Alice Example", Logins: []string{"alice-work"}},
+	})
+	require.NoError(t, err)
+
+	identity, ok := identities.Lookup("alice")
+	require.True(t, ok)
+
+	day := time.Date(2024, 3, 14, 9, 0, 0, 0, time.UTC)
+
+	personalReview := domain.NewActivity(domain.ActivityTypeReview, "acme/api", day, 0, 0)
+	personalReview.IsReview = true
+	personalReview.PullRequestAuthor = "alice-work"
+
+	workReview := domain.NewActivity(domain.ActivityTypeReview, "acme/api", day, 0, 0)
+	workReview.IsReview = true
+	workReview.PullRequestAuthor = "bob"
+
+	personal := &infrastructure.UserActivityData{
+		User:    domain.NewUser("alice", "alice", "2015-01-01T00:00:00Z"),
+		Commits: []*domain.Activity{domain.NewActivity(domain.ActivityTypeCommit, "alice/dotfiles", day, 0, 0)},
+		Reviews: []*domain.Activity{personalReview},
+	}
+	work := &infrastructure.UserActivityData{
+		User:    domain.NewUser("alice-work", "Alice (Acme)", "2019-06-01T00:00:00Z"),
+		Commits: []*domain.Activity{domain.NewActivity(domain.ActivityTypeCommit, "acme/api", day, 0, 0)},
+		PRs:     []*domain.Activity{domain.NewActivity(domain.ActivityTypePR, "acme/api", day, 10, 2)},
+		Reviews: []*domain.Activity{workReview},
+		Gaps:    []*domain.DataGap{{ActivityType: domain.ActivityTypeIssue, Reason: domain.DataGapTransient}},
+	}
+
+	merged := MergeActivity(identity, []*infrastructure.UserActivityData{personal, work}, identities)
+
+	assert.Equal(t, &domain.User{Login: "alice", Name: "Alice Example", CreatedAt: "2015-01-01T00:00:00Z"}, merged.User)
+	assert.Equal(t, []string{"alice", "alice-work"}, merged.Accounts)
+	assert.Len(t, merged.Gaps, 1)
+	assert.Equal(t, "alice-work", personalReview.PullRequestAuthor, "元の活動は書き換えない")
+
+	stats, err := NewStatisticsService().CalculateStatistics(merged)
+	require.NoError(t, err)
+
+	assert.Equal(t, "alice", stats.User.Login)
+	assert.Equal(t, []string{"alice", "alice-work"}, stats.Logins)
+	assert.Equal(t, 2, stats.TotalCommits)
+	assert.Equal(t, 1, stats.TotalPRCreated)
+	assert.Equal(t, 2, stats.TotalReviews)
+	assert.Len(t, stats.AllRepositories, 2)
+	assert.Equal(t, 2, stats.DailyStats["2024-03-14"].CommitCount)
+
+	// alice-work の PR へのレビューは自分の PR へのレビューになり、レビューエッジは bob へのものだけです.
+	require.Len(t, stats.ReviewEdges, 1)
+	assert.Equal(t, "bob", stats.ReviewEdges[0].Author)
+}
+
+func TestMergeActivity_SingleAccount(t *testing.T) {
+	t.Parallel()
+
+	identities, err := domain.NewIdentityMap([]domain.Identity{
+		{Login: "alice", Logins: []string{"alice-work"}},
+	})
+	require.NoError(t, err)
+
+	review := domain.NewActivity(domain.ActivityTypeReview, "acme/api", time.Date(2024, 3, 14, 0, 0, 0, 0, time.UTC), 0, 0)
+	review.IsReview = true
+	review.PullRequestAuthor = "alice-work"
+
+	bob := &infrastructure.UserActivityData{
+		User:    domain.NewUser("bob", "Bob", ""),
+		Reviews: []*domain.Activity{review},
+	}
+
+	merged := MergeActivity(&domain.Identity{Login: "bob", Logins: []string{"bob"}}, []*infrastructure.UserActivityData{bob}, identities)
+
+	assert.Equal(t, "Bob", merged.User.Name, "表示名が無ければ GitHub の名前を使う")
+	assert.Empty(t, merged.Accounts, "1アカウントのメンバーにはアカウント一覧を付けない")
+	require.Len(t, merged.Reviews, 1)
+	assert.Equal(t, "alice", merged.Reviews[0].PullRequestAuthor, "他のメンバーのアカウントも代表ログインに置き換える")
+}
+
+func TestMergeActivity_DeduplicatesByNodeID(t *testing.T) {
+	t.Parallel()
+
+	identities, err := domain.NewIdentityMap([]domain.Identity{
+		{Login: "alice", Logins: []string{"alice-work"}},
+	})
+	require.NoError(t, err)
+
+	identity, _ := identities.Lookup("alice")
+	day := time.Date(2024, 3, 14, 0, 0, 0, 0, time.UTC)
+
+	pr := domain.NewActivity(domain.ActivityTypePR, "acme/api", day, 10, 2)
+	pr.SourceID = "PR_1"
+	commit := domain.NewActivity(domain.ActivityTypeCommit, "acme/api", day, 0, 0)
+
+	// 代表ログインとアカウントの両方に保存された同じPRは1件、ノードIDの無いコミット貢献はそれぞれ数えます.
+	stored := &infrastructure.UserActivityData{
+		User:    domain.NewUser("alice", "", ""),
+		Commits: []*domain.Activity{commit},
+		PRs:     []*domain.Activity{pr},
+	}
+	legacy := &infrastructure.UserActivityData{
+		User:    domain.NewUser("alice-work", "", ""),
+		Commits: []*domain.Activity{commit},
+		PRs:     []*domain.Activity{pr},
+	}
+
+	merged := MergeActivity(identity, []*infrastructure.UserActivityData{stored, legacy}, identities)
+
+	assert.Len(t, merged.PRs, 1)
+	assert.Len(t, merged.Commits, 2)
+}
+
+func TestBaselinesWithAccounts(t *testing.T) {
+	t.Parallel()
+
+	identities, err := domain.NewIdentityMap([]domain.Identity{
+		{Login: "alice", Logins: []string{"alice-work"}},
+		{Login: "bob", Logins: []string{"bob-work"}},
+	})
+	require.NoError(t, err)
+
+	baselines := map[string]*MemberBaseline{
+		// alice-work was added to alice after the baseline was saved.
+		"alice": {Login: "alice", Accounts: []string{"alice"}},
+		"bob":   {Login: "bob", Accounts: []string{"Bob-Work", "bob"}},
+		"carol": {Login: "carol", Accounts: []string{"carol"}},
+		// dave's second account was split off into its own member.
+		"dave": {Login: "dave", Accounts: []string{"dave", "dave-old"}},
+	}
+
+	got := BaselinesWithAccounts(baselines, identities)
+
+	assert.NotContains(t, got, "alice", "a member with a new account is fetched in full")
+	assert.Contains(t, got, "bob", "the same accounts in another order and case keep the baseline")
+	assert.Contains(t, got, "carol")
+	assert.NotContains(t, got, "dave", "a member that lost an account is fetched in full")
+
+	// Without an identity map every member stands for its own login only.
+	got = BaselinesWithAccounts(baselines, nil)
+	assert.Len(t, got, 2)
+	assert.Contains(t, got, "alice")
+	assert.Contains(t, got, "carol")
+}
@@ -0,0 +1,309 @@
+package application
+
+import (
+	"sort"
+	"time"
+
+	"github.com/Tattsum/github-analytics/domain"
+)
+
+// IncrementalCutoff は差分バッチの取得起点を返します.
+// 前回スナップショット当日の活動は取得後にも増えている可能性があるため、captured_at を UTC の日初めへ切り下げ、
+// その日以降を再取得して永続化済みの日別行を置き換えます（日単位で重複も欠落も起きない境界）.
+func IncrementalCutoff(capturedAt time.Time) time.Time {
+	utc := capturedAt.UTC()
+
+	return time.Date(utc.Year(), utc.Month(), utc.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// BaselinesWithLookback は baselines のうち、遡った年数が lookbackYears と同じスナップショットの起点だけを返します.
+// 遡り方の異なるスナップショットを起点にすると、起点より前の活動の範囲が今回の取得と食い違うため、
+// 除いたメンバーは全期間取得します.
+func BaselinesWithLookback(baselines map[string]*MemberBaseline, lookbackYears int) map[string]*MemberBaseline {
+	matched := make(map[string]*MemberBaseline, len(baselines))
+
+	for login, baseline := range baselines {
+		if baseline != nil && baseline.LookbackYears == lookbackYears {
+			matched[login] = baseline
+		}
+	}
+
+	return matched
+}
+
+// MergeIncremental は永続化済みの起点統計と差分取得した統計をマージし、新しいスナップショット用の統計を返します.
+// cutoff より前の日は baseline の日別行を、cutoff 以降の日は delta の日別行を採用します（レビューエッジも同様）.
+// 合計・年別・リポジトリ内訳・ピーク年・ロール変遷はマージ後の日別行から再計算します.
+// PRライフサイクルも作成日時で同様に振り分け、サイクルタイムを再計算します（1時間ごとの活動件数も時間の始まりで同様です）.
+// Issueのライフサイクルは起点後にクローズされることがあるため、ノードIDごとに差分側を優先してまとめます.
+// baseline が nil の場合は delta をそのまま返します（全期間取得と同じ扱い）.
+func (s *StatisticsService) MergeIncremental(
+	baseline *MemberBaseline,
+	delta *domain.UserStatistics,
+	cutoff time.Time,
+) *domain.UserStatistics {
+	if baseline == nil {
+		return delta
+	}
+
+	cutoffDay := dayKey(cutoff)
+	merged := domain.NewUserStatistics(delta.User)
+
+	for day, stat := range baseline.DailyStats {
+		if stat != nil && day < cutoffDay {
+			merged.DailyStats[day] = stat
+		}
+	}
+
+	for day, stat := range delta.DailyStats {
+		if stat != nil && day >= cutoffDay {
+			merged.DailyStats[day] = stat
+		}
+	}
+
+	for _, stat := range baseline.RepoDailyStats {
+		if stat != nil && stat.Date < cutoffDay {
+			merged.RepoDailyStats = append(merged.RepoDailyStats, stat)
+		}
+	}
+
+	for _, stat := range delta.RepoDailyStats {
+		if stat != nil && stat.Date >= cutoffDay {
+			merged.RepoDailyStats = append(merged.RepoDailyStats, stat)
+		}
+	}
+
+	sort.Slice(merged.RepoDailyStats, func(i, j int) bool {
+		a, b := merged.RepoDailyStats[i], merged.RepoDailyStats[j]
+		if a.Repository != b.Repository {
+			return a.Repository < b.Repository
+		}
+
+		return a.Date < b.Date
+	})
+
+	for _, edge := range baseline.ReviewEdges {
+		if edge != nil && edge.Date < cutoffDay {
+			merged.ReviewEdges = append(merged.ReviewEdges, edge)
+		}
+	}
+
+	for _, edge := range delta.ReviewEdges {
+		if edge != nil && edge.Date >= cutoffDay {
+			merged.ReviewEdges = append(merged.ReviewEdges, edge)
+		}
+	}
+
+	sortReviewEdges(merged.ReviewEdges)
+
+	owners := make(map[string]*RepoMeta, len(baseline.RepoMetas))
+	for _, meta := range baseline.RepoMetas {
+		if meta != nil {
+			owners[meta.NameWithOwner] = meta
+		}
+	}
+
+	// 差分側で観測した所有者情報を優先します（リポジトリの移管等に追従するため）.
+	for _, repo := range delta.AllRepositories {
+		if repo != nil {
+			owners[repo.Repository] = &RepoMeta{
+				NameWithOwner: repo.Repository,
+				Owner:         repo.Owner,
+				OwnerType:     repo.OwnerType,
+			}
+		}
+	}
+
+	s.rebuildFromDaily(merged, owners)
+
+	prs := make([]*domain.PullRequestLifecycle, 0, len(baseline.PRLifecycles)+len(delta.PRLifecycles))
+	for _, pr := range baseline.PRLifecycles {
+		if pr != nil && pr.CreatedAt.Before(cutoff) {
+			prs = append(prs, pr)
+		}
+	}
+
+	for _, pr := range delta.PRLifecycles {
+		if pr != nil && !pr.CreatedAt.Before(cutoff) {
+			prs = append(prs, pr)
+		}
+	}
+
+	merged.SetPRLifecycles(prs)
+	merged.IssueLifecycles = mergeIssueLifecycles(baseline.IssueLifecycles, delta.IssueLifecycles)
+	merged.TimeZone = delta.TimeZone
+	merged.HourlyActivity = mergeHourlyActivity(baseline.HourlyActivity, delta.HourlyActivity, cutoff, s.timeZones.For(delta.User.Login))
+
+	// 起点に欠けがあるメンバーは起点として返されない（全期間取得になる）ため、欠けは差分側のものだけです.
+	merged.DataGaps = delta.DataGaps
+	merged.Logins = delta.Logins
+
+	return merged
+}
+
+// mergeHourlyActivity は cutoff より前に始まる時間は baseline の、cutoff 以降に始まる時間は delta の1時間ごとの件数を採用します.
+// baseline は前回のタイムゾーンで数えている可能性があるため、loc の1時間ごとに数え直します.
+// 現地時刻の時間の境界が UTC の日初めと揃わないタイムゾーンでは、cutoff をまたぐ時間を baseline の件数で数えます.
+func mergeHourlyActivity(baseline, delta []*domain.HourlyActivity, cutoff time.Time, loc *time.Location) []*domain.HourlyActivity {
+	before := make([]*domain.HourlyActivity, 0, len(baseline))
+	for _, hour := range baseline {
+		if hour != nil && hour.Start.Before(cutoff) {
+			before = append(before, hour)
+		}
+	}
+
+	merged := domain.RebucketHourlyActivity(before, loc)
+	for _, hour := range delta {
+		if hour != nil && !hour.Start.Before(cutoff) {
+			merged = append(merged, hour)
+		}
+	}
+
+	return merged
+}
+
+// rebuildFromDaily はマージ済みの日別行・リポジトリ×日別行から、集計値と派生指標を再計算します.
+// 活動単位の明細は永続化していないため、CalculateStatistics と同じ指標を日単位の行から組み立て直します.
+func (s *StatisticsService) rebuildFromDaily(stats *domain.UserStatistics, owners map[string]*RepoMeta) {
+	s.rebuildTotalsFromDaily(stats)
+	s.calculatePeakYear(stats)
+
+	repoMap := s.rebuildRepositoriesFromDaily(stats.RepoDailyStats, owners)
+	stats.AllRepositories = s.sortRepositoriesByCommit(repoMap)
+	stats.TopRepositories = s.selectTopRepositories(stats.AllRepositories)
+	stats.LongTermRepositories = s.findLongTermRepositories(stats.TopRepositories)
+
+	stats.CalculatePRToReviewRatio()
+	s.analyzeContinuityAndCareer(stats)
+}
+
+// rebuildTotalsFromDaily は日別行から合計・年別統計・最初の活動年を再計算します.
+func (s *StatisticsService) rebuildTotalsFromDaily(stats *domain.UserStatistics) {
+	firstYear := 0
+
+	for day, daily := range stats.DailyStats {
+		stats.TotalCommits += daily.CommitCount
+		stats.TotalPRCreated += daily.PRCreated
+		stats.TotalPRMerged += daily.PRMerged
+		stats.TotalIssues += daily.IssueCount
+		stats.TotalReviews += daily.ReviewCount
+		stats.TotalAdditions += daily.TotalAdditions
+		stats.TotalDeletions += daily.TotalDeletions
+		stats.TotalExcludedReviews += daily.ExcludedReviewCount
+		stats.IssueThroughput.Add(daily.IssueThroughput)
+		stats.WorkCategories.Add(daily.WorkCategories)
+
+		// 除外したレビュー・Issueのクローズしか無い日は、年別統計・最初の活動年に数えません.
+		if daily.CommitCount+daily.PRCreated+daily.IssueCount+daily.ReviewCount == 0 {
+			continue
+		}
+
+		date, err := time.Parse(time.DateOnly, day)
+		if err != nil {
+			continue
+		}
+
+		year := date.Year()
+		if firstYear == 0 || year < firstYear {
+			firstYear = year
+		}
+
+		yearly, exists := stats.YearlyStats[year]
+		if !exists {
+			yearly = domain.NewYearlyStatistics(year)
+			stats.YearlyStats[year] = yearly
+		}
+
+		yearly.CommitCount += daily.CommitCount
+		yearly.PRCreated += daily.PRCreated
+		yearly.PRMerged += daily.PRMerged
+		yearly.IssueCount += daily.IssueCount
+		yearly.ReviewCount += daily.ReviewCount
+		yearly.TotalAdditions += daily.TotalAdditions
+		yearly.TotalDeletions += daily.TotalDeletions
+	}
+
+	stats.FirstActivityYear = firstYear
+}
+
+// rebuildRepositoriesFromDaily はリポジトリ×日別行から、リポジトリごとの活動内訳を再計算します.
+// 最初/最後の活動日時は日単位の精度になります.
+func (s *StatisticsService) rebuildRepositoriesFromDaily(
+	repoDays []*domain.RepoDailyStatistics,
+	owners map[string]*RepoMeta,
+) map[string]*domain.RepositoryActivity {
+	repoMap := make(map[string]*domain.RepositoryActivity)
+
+	for _, stat := range repoDays {
+		date, err := time.Parse(time.DateOnly, stat.Date)
+		if err != nil {
+			continue
+		}
+
+		repo, exists := repoMap[stat.Repository]
+		if !exists {
+			repo = domain.NewRepositoryActivity(stat.Repository)
+			if meta := owners[stat.Repository]; meta != nil {
+				repo.Owner = meta.Owner
+				repo.OwnerType = meta.OwnerType
+			}
+
+			repo.FirstActivity = date
+			repo.LastActivity = date
+			repoMap[stat.Repository] = repo
+		}
+
+		repo.CommitCount += stat.CommitCount
+		repo.PRCount += stat.PRCreated
+		repo.IssueCount += stat.IssueCount
+		repo.ReviewCount += stat.ReviewCount
+		repo.TotalAdditions += stat.TotalAdditions
+		repo.TotalDeletions += stat.TotalDeletions
+		repo.IssueThroughput.Add(stat.IssueThroughput)
+		repo.WorkCategories.Add(stat.WorkCategories)
+
+		if date.Before(repo.FirstActivity) {
+			repo.FirstActivity = date
+		}
+
+		if date.After(repo.LastActivity) {
+			repo.LastActivity = date
+		}
+	}
+
+	return repoMap
+}
+
+// mergeIssueLifecycles は起点と差分のIssueライフサイクルを、同じノードIDなら差分側を採用してまとめます.
+// 並び順は起点側の順に、差分で初めて現れたIssueを続けます.
+func mergeIssueLifecycles(baseline, delta []*domain.IssueLifecycle) []*domain.IssueLifecycle {
+	latest := make(map[string]*domain.IssueLifecycle, len(delta))
+	for _, issue := range delta {
+		if issue != nil {
+			latest[issue.SourceID] = issue
+		}
+	}
+
+	merged := make([]*domain.IssueLifecycle, 0, len(baseline)+len(delta))
+
+	for _, issue := range baseline {
+		if issue == nil {
+			continue
+		}
+
+		if newer, ok := latest[issue.SourceID]; ok {
+			issue = newer
+			delete(latest, issue.SourceID)
+		}
+
+		merged = append(merged, issue)
+	}
+
+	for _, issue := range delta {
+		if issue != nil && latest[issue.SourceID] == issue {
+			merged = append(merged, issue)
+		}
+	}
+
+	return merged
+}
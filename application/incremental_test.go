@@ -0,0 +1,235 @@
+package application
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Tattsum/github-analytics/domain"
+	"github.com/Tattsum/github-analytics/infrastructure"
+)
+
+func TestBaselinesWithLookback(t *testing.T) {
+	t.Parallel()
+
+	baselines := map[string]*MemberBaseline{
+		"alice": {Login: "alice"},
+		"bob":   {Login: "bob", LookbackYears: 3},
+		"carol": nil,
+	}
+
+	got := BaselinesWithLookback(baselines, 0)
+	require.Len(t, got, 1)
+	assert.Contains(t, got, "alice", "only the baseline fetched with the default lookback is kept")
+
+	got = BaselinesWithLookback(baselines, 3)
+	require.Len(t, got, 1)
+	assert.Contains(t, got, "bob")
+}
+
+func TestIncrementalCutoff(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		capturedAt time.Time
+		want       time.Time
+	}{
+		{
+			name:       "truncates to the start of the UTC day",
+			capturedAt: time.Date(2024, 3, 10, 15, 4, 5, 0, time.UTC),
+			want:       time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "non-UTC capture time is normalized to its UTC day",
+			capturedAt: time.Date(2024, 3, 11, 2, 0, 0, 0, time.FixedZone("JST", 9*60*60)),
+			want:       time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "midnight stays on the same day",
+			capturedAt: time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC),
+			want:       time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.True(t, tt.want.Equal(IncrementalCutoff(tt.capturedAt)), "cutoff should match")
+		})
+	}
+}
+
+// incrementalActivities は差分マージ検証用の活動一覧（カットオフ前後にまたがる）を作成します.
+func incrementalActivities() *infrastructure.UserActivityData {
+	at := func(month time.Month, day int) time.Time {
+		return time.Date(2024, month, day, 12, 0, 0, 0, time.UTC)
+	}
+
+	commit := func(repo string, date time.Time) *domain.Activity {
+		activity := domain.NewActivity(domain.ActivityTypeCommit, repo, date, 0, 0)
+		activity.RepositoryOwner = "acme"
+		activity.RepositoryOwnerType = "Organization"
+
+		return activity
+	}
+
+	mergedPR := domain.NewActivity(domain.ActivityTypePR, "acme/api", at(time.March, 10), 120, 30)
+	mergedPR.IsMerged = true
+
+	review := domain.NewActivity(domain.ActivityTypeReview, "acme/web", at(time.March, 11), 0, 0)
+	review.IsReview = true
+	review.PullRequestAuthor = "bob"
+
+	earlierReview := domain.NewActivity(domain.ActivityTypeReview, "acme/api", at(time.February, 2), 0, 0)
+	earlierReview.IsReview = true
+	earlierReview.PullRequestAuthor = "carol"
+
+	mergedAt := at(time.March, 12)
+
+	return &infrastructure.UserActivityData{
+		User: domain.NewUser("alice", "Alice", ""),
+		Commits: []*domain.Activity{
+			commit("acme/api", time.Date(2023, time.June, 1, 9, 0, 0, 0, time.UTC)),
+			commit("acme/api", at(time.January, 5)),
+			commit("acme/api", at(time.March, 10)),
+			commit("acme/web", at(time.March, 11)),
+		},
+		PRs: []*domain.Activity{
+			domain.NewActivity(domain.ActivityTypePR, "acme/api", at(time.February, 1), 10, 5),
+			mergedPR,
+		},
+		Issues: []*domain.Activity{
+			domain.NewActivity(domain.ActivityTypeIssue, "acme/web", at(time.March, 12), 0, 0),
+		},
+		Reviews: []*domain.Activity{earlierReview, review},
+		PRLifecycles: []*domain.PullRequestLifecycle{
+			{Repository: "acme/api", CreatedAt: at(time.February, 1)},
+			{Repository: "acme/api", CreatedAt: at(time.March, 10), MergedAt: &mergedAt, ClosedAt: &mergedAt},
+		},
+	}
+}
+
+// splitActivities は活動一覧を cutoff より前とそれ以降に分割します.
+func splitActivities(data *infrastructure.UserActivityData, cutoff time.Time) (*infrastructure.UserActivityData, *infrastructure.UserActivityData) {
+	before := &infrastructure.UserActivityData{User: data.User}
+	after := &infrastructure.UserActivityData{User: data.User}
+
+	split := func(activities []*domain.Activity) ([]*domain.Activity, []*domain.Activity) {
+		var older, newer []*domain.Activity
+
+		for _, activity := range activities {
+			if activity.Date.Before(cutoff) {
+				older = append(older, activity)
+			} else {
+				newer = append(newer, activity)
+			}
+		}
+
+		return older, newer
+	}
+
+	before.Commits, after.Commits = split(data.Commits)
+	before.PRs, after.PRs = split(data.PRs)
+	before.Issues, after.Issues = split(data.Issues)
+	before.Reviews, after.Reviews = split(data.Reviews)
+
+	for _, pr := range data.PRLifecycles {
+		if pr.CreatedAt.Before(cutoff) {
+			before.PRLifecycles = append(before.PRLifecycles, pr)
+		} else {
+			after.PRLifecycles = append(after.PRLifecycles, pr)
+		}
+	}
+
+	return before, after
+}
+
+func TestStatisticsService_MergeIncremental(t *testing.T) {
+	t.Parallel()
+
+	service := NewStatisticsService()
+	cutoff := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+	data := incrementalActivities()
+
+	full, err := service.CalculateStatistics(data)
+	require.NoError(t, err)
+
+	older, newer := splitActivities(data, cutoff)
+
+	previous, err := service.CalculateStatistics(older)
+	require.NoError(t, err)
+
+	// 前回スナップショット当日（カットオフ日）の行は取得途中の値として残っている想定で、差分側に置き換えられること.
+	stale := domain.NewDailyStatistics("2024-03-10")
+	stale.CommitCount = 99
+	previous.DailyStats[stale.Date] = stale
+	previous.RepoDailyStats = append(previous.RepoDailyStats, &domain.RepoDailyStatistics{
+		Repository:  "acme/api",
+		Date:        "2024-03-10",
+		CommitCount: 99,
+	})
+
+	baseline := &MemberBaseline{
+		Login:          "alice",
+		CapturedAt:     time.Date(2024, 3, 10, 8, 0, 0, 0, time.UTC),
+		DailyStats:     previous.DailyStats,
+		RepoDailyStats: previous.RepoDailyStats,
+		RepoMetas: []*RepoMeta{
+			{NameWithOwner: "acme/api", Owner: "acme", OwnerType: "Organization"},
+		},
+		PRLifecycles:   previous.PRLifecycles,
+		ReviewEdges:    previous.ReviewEdges,
+		HourlyActivity: previous.HourlyActivity,
+	}
+
+	delta, err := service.CalculateStatistics(newer)
+	require.NoError(t, err)
+
+	merged := service.MergeIncremental(baseline, delta, cutoff)
+
+	assert.Equal(t, full.TotalCommits, merged.TotalCommits, "TotalCommits should equal a full rebuild")
+	assert.Equal(t, full.TotalPRCreated, merged.TotalPRCreated, "TotalPRCreated should equal a full rebuild")
+	assert.Equal(t, full.TotalPRMerged, merged.TotalPRMerged, "TotalPRMerged should equal a full rebuild")
+	assert.Equal(t, full.TotalIssues, merged.TotalIssues, "TotalIssues should equal a full rebuild")
+	assert.Equal(t, full.TotalReviews, merged.TotalReviews, "TotalReviews should equal a full rebuild")
+	assert.Equal(t, full.TotalAdditions, merged.TotalAdditions, "TotalAdditions should equal a full rebuild")
+	assert.Equal(t, full.TotalDeletions, merged.TotalDeletions, "TotalDeletions should equal a full rebuild")
+	assert.Equal(t, full.FirstActivityYear, merged.FirstActivityYear, "FirstActivityYear should equal a full rebuild")
+	assert.Equal(t, full.PeakActivityYear, merged.PeakActivityYear, "PeakActivityYear should equal a full rebuild")
+	assert.InDelta(t, full.PRToReviewRatio, merged.PRToReviewRatio, 1e-9, "PRToReviewRatio should equal a full rebuild")
+	assert.Equal(t, full.DailyStats, merged.DailyStats, "DailyStats should equal a full rebuild")
+	assert.Equal(t, full.RepoDailyStats, merged.RepoDailyStats, "RepoDailyStats should equal a full rebuild")
+	assert.Equal(t, full.YearlyStats, merged.YearlyStats, "YearlyStats should equal a full rebuild")
+	assert.Equal(t, full.CycleTime, merged.CycleTime, "CycleTime should equal a full rebuild")
+	assert.Equal(t, full.ReviewEdges, merged.ReviewEdges, "ReviewEdges should equal a full rebuild")
+	assert.Equal(t, full.HourlyActivity, merged.HourlyActivity, "HourlyActivity should equal a full rebuild")
+	assert.Len(t, merged.ReviewEdges, 2, "review edges before and after the cutoff should both be kept")
+	assert.Len(t, merged.PRLifecycles, 2, "PR lifecycles before and after the cutoff should both be kept")
+
+	require.Len(t, merged.AllRepositories, 2)
+
+	for _, repo := range merged.AllRepositories {
+		assert.Equal(t, "acme", repo.Owner, "owner should be carried over for %s", repo.Repository)
+		assert.Equal(t, "Organization", repo.OwnerType, "owner type should be carried over for %s", repo.Repository)
+	}
+
+	assert.Equal(t, "acme/api", merged.AllRepositories[0].Repository, "repositories should be sorted by commits")
+	assert.Equal(t, 3, merged.AllRepositories[0].CommitCount)
+	assert.Len(t, merged.RoleTransition, 2, "role transition should be rebuilt per year")
+}
+
+func TestStatisticsService_MergeIncremental_NilBaseline(t *testing.T) {
+	t.Parallel()
+
+	service := NewStatisticsService()
+	delta := domain.NewUserStatistics(domain.NewUser("bob", "Bob", ""))
+	delta.TotalCommits = 3
+
+	merged := service.MergeIncremental(nil, delta, time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC))
+
+	assert.Same(t, delta, merged, "nil baseline should return the delta as-is")
+}
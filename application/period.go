@@ -0,0 +1,75 @@
+package application
+
+import (
+	"github.com/Tattsum/github-analytics/domain"
+	"github.com/Tattsum/github-analytics/infrastructure"
+)
+
+// ActivityInPeriod は data のうち period 内に発生した活動と、period 内に作成されたPRのライフサイクル、
+// period 内に作成またはクローズされたIssueのライフサイクルだけを持つ複製を返します.
+// 保存済みイベントから期間を指定して再集計する場合など、取得済みの活動を後から期間で絞り込むのに使います.
+// period がゼロ値なら data をそのまま返します.
+func ActivityInPeriod(data *infrastructure.UserActivityData, period domain.CollectionPeriod) *infrastructure.UserActivityData {
+	if data == nil || period.IsZero() {
+		return data
+	}
+
+	clipped := *data
+	clipped.Commits = activitiesInPeriod(data.Commits, period)
+	clipped.PRs = activitiesInPeriod(data.PRs, period)
+	clipped.Issues = activitiesInPeriod(data.Issues, period)
+	clipped.Reviews = activitiesInPeriod(data.Reviews, period)
+	clipped.IssueCloses = activitiesInPeriod(data.IssueCloses, period)
+	clipped.PRLifecycles = PRLifecyclesInPeriod(data.PRLifecycles, period)
+	clipped.IssueLifecycles = IssueLifecyclesInPeriod(data.IssueLifecycles, period)
+
+	return &clipped
+}
+
+// PRLifecyclesInPeriod は作成日時が period 内のPRライフサイクルを返します（period がゼロ値ならそのまま返します）.
+func PRLifecyclesInPeriod(lifecycles []*domain.PullRequestLifecycle, period domain.CollectionPeriod) []*domain.PullRequestLifecycle {
+	if period.IsZero() {
+		return lifecycles
+	}
+
+	out := make([]*domain.PullRequestLifecycle, 0, len(lifecycles))
+
+	for _, lifecycle := range lifecycles {
+		if lifecycle != nil && period.Contains(lifecycle.CreatedAt) {
+			out = append(out, lifecycle)
+		}
+	}
+
+	return out
+}
+
+// IssueLifecyclesInPeriod は period 内に作成またはクローズされたIssueのライフサイクルを返します（period がゼロ値ならそのまま返します）.
+func IssueLifecyclesInPeriod(lifecycles []*domain.IssueLifecycle, period domain.CollectionPeriod) []*domain.IssueLifecycle {
+	if period.IsZero() {
+		return lifecycles
+	}
+
+	out := make([]*domain.IssueLifecycle, 0, len(lifecycles))
+
+	for _, lifecycle := range lifecycles {
+		if lifecycle != nil && (period.Contains(lifecycle.CreatedAt) ||
+			(lifecycle.ClosedAt != nil && period.Contains(*lifecycle.ClosedAt))) {
+			out = append(out, lifecycle)
+		}
+	}
+
+	return out
+}
+
+// activitiesInPeriod は period 内に発生した活動を返します.
+func activitiesInPeriod(activities []*domain.Activity, period domain.CollectionPeriod) []*domain.Activity {
+	out := make([]*domain.Activity, 0, len(activities))
+
+	for _, activity := range activities {
+		if activity != nil && period.Contains(activity.Date) {
+			out = append(out, activity)
+		}
+	}
+
+	return out
+}
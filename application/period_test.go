@@ -0,0 +1,45 @@
+package application
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Tattsum/github-analytics/domain"
+	"github.com/Tattsum/github-analytics/infrastructure"
+)
+
+func TestActivityInPeriod(t *testing.T) {
+	t.Parallel()
+
+	since := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	before := domain.NewActivity(domain.ActivityTypeCommit, "acme/api", since.Add(-time.Hour), 0, 0)
+	inside := domain.NewActivity(domain.ActivityTypeCommit, "acme/api", since, 0, 0)
+	atEnd := domain.NewActivity(domain.ActivityTypeCommit, "acme/api", until, 0, 0)
+	pr := domain.NewActivity(domain.ActivityTypePR, "acme/api", until.AddDate(0, -1, 0), 10, 2)
+	lifecycle := domain.NewPullRequestLifecycle("acme/api", "PR_1", "alice", pr.Date, nil, nil, nil)
+	oldLifecycle := domain.NewPullRequestLifecycle("acme/api", "PR_0", "alice", before.Date, nil, nil, nil)
+
+	data := &infrastructure.UserActivityData{
+		User:         domain.NewUser("alice", "Alice", ""),
+		Commits:      []*domain.Activity{before, inside, atEnd},
+		PRs:          []*domain.Activity{pr},
+		PRLifecycles: []*domain.PullRequestLifecycle{lifecycle, oldLifecycle},
+	}
+
+	clipped := ActivityInPeriod(data, domain.CollectionPeriod{Since: since, Until: until})
+	require.NotSame(t, data, clipped)
+
+	assert.Equal(t, []*domain.Activity{inside}, clipped.Commits, "Since を含み Until を含まない")
+	assert.Equal(t, []*domain.Activity{pr}, clipped.PRs)
+	assert.Empty(t, clipped.Issues)
+	assert.Equal(t, []*domain.PullRequestLifecycle{lifecycle}, clipped.PRLifecycles)
+	assert.Equal(t, data.User, clipped.User)
+	assert.Len(t, data.Commits, 3, "元のデータは変更しない")
+
+	assert.Same(t, data, ActivityInPeriod(data, domain.CollectionPeriod{}), "期間の指定が無ければそのまま返す")
+}
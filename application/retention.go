@@ -0,0 +1,142 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// ErrInvalidRetentionPolicy は保持ポリシーの設定値が不正であることを表します.
+var ErrInvalidRetentionPolicy = errors.New("invalid retention policy")
+
+// RetentionPolicy はスナップショットの保持ポリシーです.
+// スナップショットは次のいずれかに当てはまれば保持され、どれにも当てはまらなければ削除対象になります.
+//   - タグ付き（Tag が空でない）
+//   - 新しい順で KeepLast 件以内
+//   - 取得日時が基準時刻から Horizon 以内
+//   - Horizon より古く、KeepOnePer の期間（日 / 週 / 月）ごとに最も新しい
+type RetentionPolicy struct {
+	// KeepLast は常に保持する最新スナップショットの件数です（1 以上。最新は必ず保持されます）.
+	KeepLast int
+	// Horizon はすべてのスナップショットを保持する期間です（0 なら KeepLast 以外は間引きの対象）.
+	Horizon time.Duration
+	// KeepOnePer は Horizon より古いスナップショットを間引く期間の単位です（空文字なら Horizon より古いものは保持しません）.
+	// 週は月曜始まりで、期間ごとに最も新しいスナップショットを残します.
+	KeepOnePer Granularity
+}
+
+// Validate は保持ポリシーの設定値を検証します.
+func (p RetentionPolicy) Validate() error {
+	if p.KeepLast < 1 {
+		return fmt.Errorf("%w: keep-last must be at least 1, got %d", ErrInvalidRetentionPolicy, p.KeepLast)
+	}
+
+	if p.Horizon < 0 {
+		return fmt.Errorf("%w: horizon must not be negative, got %s", ErrInvalidRetentionPolicy, p.Horizon)
+	}
+
+	switch p.KeepOnePer {
+	case "", GranularityDay, GranularityWeek, GranularityMonth:
+		return nil
+	default:
+		return fmt.Errorf("%w: unknown period %q", ErrInvalidRetentionPolicy, p.KeepOnePer)
+	}
+}
+
+// RetentionDecision は保持ポリシーを1スナップショットに適用した結果です.
+type RetentionDecision struct {
+	Snapshot *SnapshotInfo
+	// Keep は保持するかどうかです.
+	Keep bool
+	// Reason は判定の理由です（例: "tagged q1-review"、"latest 10"、"newest of WEEK 2024-03-04"）.
+	Reason string
+}
+
+// RetentionPlan は保持ポリシーを全スナップショットに適用した結果です（取得日時の新しい順）.
+type RetentionPlan struct {
+	Decisions []*RetentionDecision
+}
+
+// PruneIDs は削除対象のスナップショットIDを返します.
+func (p *RetentionPlan) PruneIDs() []int {
+	ids := make([]int, 0, len(p.Decisions))
+	for _, d := range p.Decisions {
+		if !d.Keep {
+			ids = append(ids, d.Snapshot.ID)
+		}
+	}
+
+	return ids
+}
+
+// PlanRetention は保持ポリシーを now 時点で適用し、各スナップショットを保持するか削除するかを判定します.
+// 入力の並び順は問いません. ポリシーが不正な場合はエラーを返します.
+func PlanRetention(snapshots []*SnapshotInfo, policy RetentionPolicy, now time.Time) (*RetentionPlan, error) {
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	ordered := make([]*SnapshotInfo, len(snapshots))
+	copy(ordered, snapshots)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		if !ordered[i].CapturedAt.Equal(ordered[j].CapturedAt) {
+			return ordered[i].CapturedAt.After(ordered[j].CapturedAt)
+		}
+
+		return ordered[i].ID > ordered[j].ID
+	})
+
+	horizonStart := now.Add(-policy.Horizon)
+	seenBuckets := make(map[string]bool)
+	plan := &RetentionPlan{Decisions: make([]*RetentionDecision, 0, len(ordered))}
+
+	for i, snap := range ordered {
+		decision := &RetentionDecision{Snapshot: snap, Keep: true}
+
+		switch {
+		case snap.Tag != "":
+			decision.Reason = "tagged " + snap.Tag
+		case i < policy.KeepLast:
+			decision.Reason = fmt.Sprintf("latest %d", policy.KeepLast)
+		case !snap.CapturedAt.Before(horizonStart):
+			decision.Reason = "within horizon"
+		case policy.KeepOnePer != "" && !seenBuckets[retentionBucket(snap.CapturedAt, policy.KeepOnePer)]:
+			decision.Reason = fmt.Sprintf("newest of %s %s", policy.KeepOnePer, retentionBucket(snap.CapturedAt, policy.KeepOnePer))
+		default:
+			decision.Keep = false
+			decision.Reason = "beyond retention"
+		}
+
+		// 期間ごとの代表は、理由を問わず保持されたスナップショットで既に埋まっているものとして扱います.
+		if decision.Keep && policy.KeepOnePer != "" {
+			seenBuckets[retentionBucket(snap.CapturedAt, policy.KeepOnePer)] = true
+		}
+
+		plan.Decisions = append(plan.Decisions, decision)
+	}
+
+	return plan, nil
+}
+
+// retentionBucket は取得日時（UTC）が属する期間の開始日を "2006-01-02" 形式で返します.
+// 週は月曜始まりで、時系列のバケット（SeriesOptions.Granularity）と同じ区切りです.
+func retentionBucket(t time.Time, per Granularity) string {
+	utc := t.UTC()
+	day := time.Date(utc.Year(), utc.Month(), utc.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch per {
+	case GranularityWeek:
+		const daysPerWeek = 7
+
+		offset := (int(day.Weekday()) + daysPerWeek - 1) % daysPerWeek
+
+		return day.AddDate(0, 0, -offset).Format(time.DateOnly)
+	case GranularityMonth:
+		return time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.UTC).Format(time.DateOnly)
+	case GranularityDay:
+		return day.Format(time.DateOnly)
+	default:
+		return day.Format(time.DateOnly)
+	}
+}
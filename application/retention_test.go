@@ -0,0 +1,129 @@
+package application
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestPlanRetention(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, time.June, 30, 12, 0, 0, 0, time.UTC)
+	day := func(month time.Month, d int) time.Time {
+		return time.Date(2024, month, d, 3, 0, 0, 0, time.UTC)
+	}
+
+	// 新しい順: 1〜2 は直近、3 は期間内、4〜9 は期間外.
+	snapshots := []*SnapshotInfo{
+		{ID: 9, CapturedAt: day(time.June, 29)},
+		{ID: 8, CapturedAt: day(time.June, 22)},
+		{ID: 7, CapturedAt: day(time.June, 15)},
+		{ID: 6, CapturedAt: day(time.May, 30)}, // May の最新
+		{ID: 5, CapturedAt: day(time.May, 2)},
+		{ID: 4, CapturedAt: day(time.April, 20), Tag: "q1-review"},
+		{ID: 3, CapturedAt: day(time.April, 10)}, // April はタグ付きの 4 で代表済み
+		{ID: 2, CapturedAt: day(time.March, 31)},
+		{ID: 1, CapturedAt: day(time.March, 1)},
+	}
+
+	tests := []struct {
+		name      string
+		policy    RetentionPolicy
+		wantPrune []int
+	}{
+		{
+			name:      "keep last only",
+			policy:    RetentionPolicy{KeepLast: 2},
+			wantPrune: []int{7, 6, 5, 3, 2, 1},
+		},
+		{
+			name:      "horizon keeps recent snapshots",
+			policy:    RetentionPolicy{KeepLast: 1, Horizon: 20 * 24 * time.Hour},
+			wantPrune: []int{6, 5, 3, 2, 1},
+		},
+		{
+			name:      "one per month beyond the horizon",
+			policy:    RetentionPolicy{KeepLast: 2, Horizon: 20 * 24 * time.Hour, KeepOnePer: GranularityMonth},
+			wantPrune: []int{5, 3, 1},
+		},
+		{
+			name:      "one per week beyond the horizon",
+			policy:    RetentionPolicy{KeepLast: 1, KeepOnePer: GranularityWeek},
+			wantPrune: []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			plan, err := PlanRetention(snapshots, tt.policy, now)
+			if err != nil {
+				t.Fatalf("PlanRetention() error = %v", err)
+			}
+
+			if got := plan.PruneIDs(); !reflect.DeepEqual(got, tt.wantPrune) {
+				t.Errorf("PruneIDs() = %v, want %v", got, tt.wantPrune)
+			}
+		})
+	}
+}
+
+func TestPlanRetention_OrdersNewestFirstAndExplains(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, time.March, 20, 0, 0, 0, 0, time.UTC)
+	snapshots := []*SnapshotInfo{
+		{ID: 1, CapturedAt: time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC)},  // Monday
+		{ID: 3, CapturedAt: time.Date(2024, time.March, 18, 0, 0, 0, 0, time.UTC)}, // latest
+		{ID: 2, CapturedAt: time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)}, // Sunday of the same week as 1
+	}
+
+	plan, err := PlanRetention(snapshots, RetentionPolicy{KeepLast: 1, KeepOnePer: GranularityWeek}, now)
+	if err != nil {
+		t.Fatalf("PlanRetention() error = %v", err)
+	}
+
+	want := []struct {
+		id     int
+		keep   bool
+		reason string
+	}{
+		{3, true, "latest 1"},
+		{2, true, "newest of WEEK 2024-03-04"},
+		{1, false, "beyond retention"},
+	}
+
+	if len(plan.Decisions) != len(want) {
+		t.Fatalf("got %d decisions, want %d", len(plan.Decisions), len(want))
+	}
+
+	for i, w := range want {
+		got := plan.Decisions[i]
+		if got.Snapshot.ID != w.id || got.Keep != w.keep || got.Reason != w.reason {
+			t.Errorf("decision %d = {%d %v %q}, want {%d %v %q}", i, got.Snapshot.ID, got.Keep, got.Reason, w.id, w.keep, w.reason)
+		}
+	}
+}
+
+func TestRetentionPolicy_Validate(t *testing.T) {
+	t.Parallel()
+
+	invalid := []RetentionPolicy{
+		{KeepLast: 0},
+		{KeepLast: 1, Horizon: -time.Hour},
+		{KeepLast: 1, KeepOnePer: "YEAR"},
+	}
+
+	for _, policy := range invalid {
+		if err := policy.Validate(); !errors.Is(err, ErrInvalidRetentionPolicy) {
+			t.Errorf("Validate(%+v) = %v, want ErrInvalidRetentionPolicy", policy, err)
+		}
+	}
+
+	if err := (RetentionPolicy{KeepLast: 3, Horizon: time.Hour, KeepOnePer: GranularityMonth}).Validate(); err != nil {
+		t.Errorf("Validate() of a valid policy = %v", err)
+	}
+}
@@ -2,9 +2,11 @@ package application
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/Tattsum/github-analytics/domain"
+	"github.com/Tattsum/github-analytics/infrastructure"
 )
 
 // MemberStats はメンバー横断比較・ランキングに用いる、比較可能なスカラー指標の集合です.
@@ -19,8 +21,20 @@ type MemberStats struct {
 	TotalReviews   int
 	TotalAdditions int
 	TotalDeletions int
+	// TotalExcludedReviews は除外対象（ボット・サービスアカウント）が作成したPRへのレビュー数です（TotalReviews には含みません）.
+	TotalExcludedReviews int
+	// IssueThroughput はIssueのクローズ数・クローズまでの時間と、作成・クローズの種類別の内訳です.
+	IssueThroughput domain.IssueThroughput
+	// WorkCategories は作成したPRの作業の種類別の件数です.
+	WorkCategories domain.WorkCategoryCounts
 	// PRToReviewRatio はPR作成数に対するレビュー数の比率です.
 	PRToReviewRatio float64
+	// CycleTime は作成したPRのサイクルタイム（中央値・90パーセンタイル）です.
+	CycleTime domain.CycleTimeStats
+	// PRSize は作成したPRの大きさの分布です.
+	PRSize domain.PRSizeStats
+	// DataGaps は取得に失敗してこの集計に含まれていない範囲です（空なら完全）.
+	DataGaps []*domain.DataGap
 }
 
 // TeamSummary はチーム全体の合計・集計値を表します.
@@ -34,6 +48,12 @@ type TeamSummary struct {
 	TotalReviews    int
 	TotalAdditions  int
 	TotalDeletions  int
+	// IssueThroughput はIssueのクローズ数・クローズまでの時間と、作成・クローズの種類別の内訳の合計です.
+	IssueThroughput domain.IssueThroughput
+	// WorkCategories は作成したPRの作業の種類別の件数の合計です.
+	WorkCategories domain.WorkCategoryCounts
+	// OpenIssueCount は現在オープンのIssue数です（メンバーが作成またはクローズしたIssueのうち、スナップショット時点でオープンのもの）.
+	OpenIssueCount int
 }
 
 // RepositoryContributor はリポジトリ軸でのメンバーごとの貢献内訳です.
@@ -63,6 +83,10 @@ type MemberRepoDayStat struct {
 	ReviewCount   int
 	Additions     int
 	Deletions     int
+	// IssueThroughput はIssueのクローズ数・クローズまでの時間と種類別の内訳です.
+	IssueThroughput domain.IssueThroughput
+	// WorkCategories は作成したPRの作業の種類別の件数です.
+	WorkCategories domain.WorkCategoryCounts
 }
 
 // RepoMeta はリポジトリの所有者メタ情報です（スナップショット内で1リポジトリ1件）.
@@ -81,6 +105,7 @@ type RepositoryDailyStats struct {
 	Owner         string
 	OwnerType     string
 	// DailyStats はこのリポジトリの日別合計の時系列です（日付昇順）.
+	// 各バケットの OpenIssueCount はバケットの終わりの時点でオープンだったIssue数です.
 	DailyStats []*domain.DailyStatistics
 }
 
@@ -96,6 +121,64 @@ type RepositoryStats struct {
 	TotalDeletions   int
 	ContributorCount int
 	Contributors     []*RepositoryContributor
+	// CycleTime はこのリポジトリで作成されたPRのサイクルタイム（メンバー横断）です.
+	CycleTime domain.CycleTimeStats
+	// PRSize はこのリポジトリで作成されたPRの大きさの分布です（メンバー横断）.
+	PRSize domain.PRSizeStats
+	// IssueThroughput はこのリポジトリでのIssueのクローズ数・クローズまでの時間と種類別の内訳です（メンバー横断）.
+	IssueThroughput domain.IssueThroughput
+	// WorkCategories はこのリポジトリで作成したPRの作業の種類別の件数です（メンバー横断）.
+	WorkCategories domain.WorkCategoryCounts
+	// OpenIssueCount はこのリポジトリで現在オープンのIssue数です（メンバーが作成またはクローズしたIssueに限ります）.
+	OpenIssueCount int
+}
+
+// ReviewNetworkNode は協業グラフのノード（レビュアーまたはPR作成者のログイン）です.
+type ReviewNetworkNode struct {
+	Login string
+	// ReviewsGiven は他者のPRに対して行ったレビュー件数です.
+	ReviewsGiven int
+	// ReviewsReceived は自分のPRが受けたレビュー件数です.
+	ReviewsReceived int
+}
+
+// ReviewNetworkEdge はレビュアーからPR作成者への重み付きエッジです（リポジトリ・日を横断して合算済み）.
+type ReviewNetworkEdge struct {
+	Reviewer string
+	Author   string
+	// ReviewCount はエッジの重み（期間内のレビュー件数）です.
+	ReviewCount int
+	// Repositories はレビューが行われたリポジトリの一覧です（昇順）.
+	Repositories []string
+}
+
+// ReviewNetwork は「誰が誰のPRをレビューしているか」を表す協業グラフです.
+// 描画とサイロ（閉じたレビュー関係）の発見はフロントエンドで行います.
+type ReviewNetwork struct {
+	Nodes []*ReviewNetworkNode
+	Edges []*ReviewNetworkEdge
+}
+
+// PendingReview はレビュアーの手元に残っている、未完了のレビュー依頼1件です.
+type PendingReview struct {
+	Repository string
+	// SourceID はPRの GitHub ノードIDです.
+	SourceID string
+	// Author はPRを作成したメンバーのログインです.
+	Author      string
+	RequestedAt time.Time
+}
+
+// ReviewLoad はレビュアー1人のレビュー負荷です.
+// メンバーが作成したPRへのレビュー依頼だけを数えます.
+type ReviewLoad struct {
+	Reviewer string
+	// Requested はレビューを依頼されたPRの数です.
+	Requested int
+	// Completed は依頼の後にレビューを提出したPRの数です.
+	Completed int
+	// PendingReviews はオープン中のPRに残っている依頼です（依頼日時の古い順）.
+	PendingReviews []*PendingReview
 }
 
 // Snapshot はバッチ実行1回分の集計済みスナップショットです.
@@ -104,27 +187,148 @@ type Snapshot struct {
 	CapturedAt time.Time
 	// Members はメンバーごとの集計済み統計です（member-levelスカラー・member×year・member×repositoryを含む）.
 	Members []*domain.UserStatistics
+	// ExcludedMembers は除外ルールに一致してメンバー一覧から外したアカウントです.
+	ExcludedMembers []*domain.ExcludedActor
+	// Period はバッチが活動を取得した期間です（ゼロ値なら期間を指定していない）.
+	Period domain.CollectionPeriod
+	// LookbackYears はバッチが活動を遡って取得した年数です（0 なら既定の遡り方）.
+	LookbackYears int
+	// DeliveryWeeks はリポジトリ×週のデリバリー指標です（取得しなかった場合は空）.
+	DeliveryWeeks []*domain.DeliveryWeek
+}
+
+// ErrSnapshotNotFound は指定IDのスナップショットが存在しないことを表します.
+var ErrSnapshotNotFound = errors.New("snapshot not found")
+
+// Granularity は時系列を集約する粒度です.
+type Granularity string
+
+const (
+	// GranularityDay は日単位（集約なし）です.
+	GranularityDay Granularity = "DAY"
+	// GranularityWeek は週単位です（週は月曜始まり）.
+	GranularityWeek Granularity = "WEEK"
+	// GranularityMonth は月単位です.
+	GranularityMonth Granularity = "MONTH"
+)
+
+// SeriesOptions は時系列を返す読み取りの、対象スナップショット・期間・粒度の指定です.
+// ゼロ値は「最新スナップショット・全期間・日単位」を表します.
+// 期間の絞り込みと粒度への集約は SQL で行い、各バケットの Date はその期間の開始日です.
+type SeriesOptions struct {
+	// SnapshotID は対象スナップショットのIDです（0 なら最新）.
+	SnapshotID int
+	// From は期間の開始日（"2006-01-02" 形式、この日を含む）です（空文字なら無制限）.
+	From string
+	// To は期間の終了日（"2006-01-02" 形式、この日を含む）です（空文字なら無制限）.
+	To string
+	// Granularity は時系列の粒度です（空なら日単位）.
+	Granularity Granularity
+}
+
+// SnapshotInfo は保存済みスナップショット1件の概要です.
+type SnapshotInfo struct {
+	ID         int
+	CapturedAt time.Time
+	// MemberCount はスナップショットに含まれるメンバー数です.
+	MemberCount int
+	// RepositoryCount はスナップショットに含まれるユニークなリポジトリ数です.
+	RepositoryCount int
+	// Tag はスナップショットに付けたタグです（空文字ならタグなし）. タグ付きのスナップショットは保持ポリシーで削除されません.
+	Tag string
+	// ExcludedMembers は除外ルールに一致してメンバー一覧から外したアカウントです.
+	ExcludedMembers []*domain.ExcludedActor
+	// Period はバッチが活動を取得した期間です（ゼロ値なら期間を指定していない）.
+	Period domain.CollectionPeriod
+	// LookbackYears はバッチが活動を遡って取得した年数です（0 なら既定の遡り方）.
+	LookbackYears int
 }
 
-// SnapshotReader は最新スナップショットを読み取るための契約です.
+// MemberHistoryPoint は1スナップショット時点での、あるメンバーのスカラー指標です.
+// スナップショットをまたいだ指標の推移（トレンド）の1点になります.
+type MemberHistoryPoint struct {
+	SnapshotID int
+	CapturedAt time.Time
+	Stats      *MemberStats
+}
+
+// SnapshotReader は保存済みスナップショット（既定では最新）を読み取るための契約です.
+// snapshotID を受け取るメソッドは 0 で最新を、それ以外で指定IDのスナップショットを対象にし、
+// 存在しないIDには ErrSnapshotNotFound を返します.
 // 実装は infrastructure 層（ent/Postgres）が提供します.
 type SnapshotReader interface {
-	// LatestMembers は最新スナップショットのメンバー横断スカラー指標を返します.
-	LatestMembers(ctx context.Context) ([]*MemberStats, error)
+	// Snapshots は保存済みスナップショットの一覧を、取得日時の新しい順で返します.
+	Snapshots(ctx context.Context) ([]*SnapshotInfo, error)
+	// Snapshot は指定IDのスナップショットの概要を返します（存在しない場合は nil）.
+	Snapshot(ctx context.Context, id int) (*SnapshotInfo, error)
+	// MemberHistory は指定ログインのスカラー指標を、そのメンバーを含む各スナップショットについて取得日時の古い順で返します.
+	MemberHistory(ctx context.Context, login string) ([]*MemberHistoryPoint, error)
+	// Members は対象スナップショットのメンバー横断スカラー指標を返します.
+	Members(ctx context.Context, snapshotID int) ([]*MemberStats, error)
 	// Member は指定ログインのドリルダウン統計（年次推移・トップリポジトリ等）を返します.
-	Member(ctx context.Context, login string) (*domain.UserStatistics, error)
-	// TeamSummary はチーム全体の合計・集計値を返します.
-	TeamSummary(ctx context.Context) (*TeamSummary, error)
+	// opts は日別推移（DailyStats）の期間・粒度と対象スナップショットを指定します（合計・年次推移は期間で絞り込みません）.
+	Member(ctx context.Context, login string, opts SeriesOptions) (*domain.UserStatistics, error)
+	// TeamSummary は対象スナップショットのチーム全体の合計・集計値を返します.
+	TeamSummary(ctx context.Context, snapshotID int) (*TeamSummary, error)
 	// TeamDailyStats はチーム全体の日別合計を、日付昇順の時系列で返します.
-	// メンバー横断で同一日の指標を合算したもので、期間絞り込み・推移グラフのデータ源です.
-	TeamDailyStats(ctx context.Context) ([]*domain.DailyStatistics, error)
-	// Repositories はリポジトリ軸の横断集計を返します.
-	Repositories(ctx context.Context) ([]*RepositoryStats, error)
+	// メンバー横断で同一日（粒度が週・月ならその期間）の指標を合算したもので、推移グラフのデータ源です.
+	TeamDailyStats(ctx context.Context, opts SeriesOptions) ([]*domain.DailyStatistics, error)
+	// Repositories は対象スナップショットのリポジトリ軸の横断集計を返します.
+	Repositories(ctx context.Context, snapshotID int) ([]*RepositoryStats, error)
 	// Repository は指定リポジトリの集計を返します（貢献者ごとの日別時系列を含む）.
-	Repository(ctx context.Context, nameWithOwner string) (*RepositoryStats, error)
+	// opts は貢献者ごとの時系列の期間・粒度と対象スナップショットを指定します（合計は期間で絞り込みません）.
+	Repository(ctx context.Context, nameWithOwner string, opts SeriesOptions) (*RepositoryStats, error)
 	// RepositoryDailyStats は各リポジトリの日別合計を、所有者メタ付きで返します.
 	// 複数リポジトリの活動推移を重ね合わせて比較するためのデータ源です.
-	RepositoryDailyStats(ctx context.Context) ([]*RepositoryDailyStats, error)
+	RepositoryDailyStats(ctx context.Context, opts SeriesOptions) ([]*RepositoryDailyStats, error)
+	// ReviewNetwork はレビュアー→PR作成者の協業グラフを返します.
+	// from / to は "2006-01-02" 形式の日付で両端を含みます（空文字なら無制限）.
+	ReviewNetwork(ctx context.Context, from, to string) (*ReviewNetwork, error)
+	// ReviewLoad はレビュアーごとのレビュー依頼数・完了数と未完了の依頼を、レビュアーの昇順で返します.
+	ReviewLoad(ctx context.Context) ([]*ReviewLoad, error)
+	// ActivityHeatmap は指定ログインの活動を、メンバーの現地時刻の曜日×時ごとに数えたヒートマップを返します.
+	// from / to はメンバーの現地時刻の "2006-01-02" 形式の日付で両端を含みます（空文字なら無制限）.
+	// 該当メンバーが存在しない場合は nil を返します.
+	ActivityHeatmap(ctx context.Context, login, from, to string) (*domain.ActivityHeatmap, error)
+	// DeliveryMetrics は指定リポジトリのデリバリー指標（デプロイ頻度・リードタイム・変更失敗率・復旧時間）を返します.
+	// from / to は "2006-01-02" 形式の日付で両端を含み、それぞれを含む週までを集計します（空文字なら無制限）.
+	DeliveryMetrics(ctx context.Context, repository, from, to string) (*DeliveryMetrics, error)
+}
+
+// MemberBaseline は差分バッチの起点となる、メンバーごとの永続化済み統計です.
+// 当該メンバーを含む最新スナップショットから読み出され、差分取得した活動とマージされます.
+type MemberBaseline struct {
+	Login string
+	// CapturedAt は当該メンバーを含む最新スナップショットの captured_at（メンバーごとの差分取得の起点）です.
+	CapturedAt time.Time
+	// LookbackYears はそのスナップショットを取得したときに遡った年数です（0 なら既定の遡り方）.
+	LookbackYears int
+	// Accounts はそのスナップショットで当該メンバーにまとめていた GitHub アカウントです（1アカウントなら Login だけ）.
+	Accounts []string
+	// DailyStats は永続化済みのメンバー×日の統計です（キーは "2006-01-02" 形式の日付）.
+	DailyStats map[string]*domain.DailyStatistics
+	// RepoDailyStats は永続化済みのメンバー×リポジトリ×日の統計です.
+	RepoDailyStats []*domain.RepoDailyStatistics
+	// ReviewEdges は永続化済みの、当該メンバーをレビュアーとするPR作成者×リポジトリ×日のレビュー件数です.
+	ReviewEdges []*domain.ReviewEdge
+	// RepoMetas は当該メンバーが関与したリポジトリの所有者メタです.
+	RepoMetas []*RepoMeta
+	// PRLifecycles は永続化済みの、当該メンバーが作成したPRのライフサイクルです.
+	PRLifecycles []*domain.PullRequestLifecycle
+	// IssueLifecycles は永続化済みの、当該メンバーが作成またはクローズしたIssueのライフサイクルです.
+	IssueLifecycles []*domain.IssueLifecycle
+	// HourlyActivity は永続化済みの、当該メンバーの現地時刻の1時間ごとの活動件数です.
+	HourlyActivity []*domain.HourlyActivity
+}
+
+// BaselineReader は差分バッチがメンバーごとの起点統計を読み取るための契約です.
+// 実装は infrastructure 層（ent/Postgres）が提供します.
+type BaselineReader interface {
+	// Baselines は指定ログインごとに、そのメンバーを含む最新スナップショットの統計を返します.
+	// 収集期間を指定したスナップショットは、期間外の活動を含まないため起点にしません.
+	// どのスナップショットにも存在しないログインは戻り値の map に含まれません（全期間取得の対象）.
+	// 最新スナップショットでの統計に欠け（DataGaps）があるログインも、欠けを埋めるため含まれません.
+	Baselines(ctx context.Context, logins []string) (map[string]*MemberBaseline, error)
 }
 
 // SnapshotWriter はバッチが集計済みスナップショットを永続化するための契約です.
@@ -133,3 +337,28 @@ type SnapshotWriter interface {
 	// Save は1回分の集計済みスナップショットを保存します.
 	Save(ctx context.Context, snapshot *Snapshot) error
 }
+
+// SnapshotMaintainer は保存済みスナップショットのタグ付けと削除（保持ポリシーの適用）のための契約です.
+// 実装は infrastructure 層（ent/Postgres）が提供します.
+type SnapshotMaintainer interface {
+	// TagSnapshot は指定IDのスナップショットにタグを付けます（空文字ならタグを外します）.
+	// 存在しないIDには ErrSnapshotNotFound を返します.
+	TagSnapshot(ctx context.Context, id int, tag string) error
+	// DeleteSnapshots は指定IDのスナップショットを、それに属するすべての集計行とともに1トランザクションで削除し、
+	// 削除したスナップショット数を返します.
+	DeleteSnapshots(ctx context.Context, ids []int) (int, error)
+}
+
+// ActivityEventStore は取得した生の活動（イベント）を追記専用で蓄積し、再集計のために読み出すための契約です.
+// 集計で捨てていた明細を残すことで、新しい指標の追加やスナップショットの再構築を GitHub への再取得なしに行えます.
+// 実装は infrastructure 層（ent/Postgres）が提供します.
+type ActivityEventStore interface {
+	// AppendActivity は1メンバー分の活動をイベントとして追記し、新規に保存した件数を返します.
+	// 保存済みのイベントはナチュラルキー（domain.ActivityNaturalKey）で重複排除され、更新されません.
+	AppendActivity(ctx context.Context, data *infrastructure.UserActivityData) (int, error)
+	// LoadActivity は指定ログインごとに、保存済みのイベントから活動一覧を組み立て直して返します.
+	// イベントが1件もないログインは戻り値に含まれません.
+	LoadActivity(ctx context.Context, logins []string) ([]*infrastructure.UserActivityData, error)
+	// Logins はイベントが保存されている全ログインを昇順で返します.
+	Logins(ctx context.Context) ([]string, error)
+}
@@ -0,0 +1,203 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// MetricDeltas は比較可能なスカラー指標の差分（head − base）です.
+type MetricDeltas struct {
+	Commits   int
+	PRCreated int
+	PRMerged  int
+	Issues    int
+	Reviews   int
+	Additions int
+	Deletions int
+}
+
+// IsZero はすべての指標に変化が無いかを返します.
+func (d MetricDeltas) IsZero() bool {
+	return d == MetricDeltas{}
+}
+
+// MemberDelta は両方のスナップショットに存在するメンバー1人分の指標の差分です.
+type MemberDelta struct {
+	Login string
+	Delta MetricDeltas
+}
+
+// RepositoryDelta は両方のスナップショットに存在するリポジトリ1件分の指標の差分です.
+type RepositoryDelta struct {
+	NameWithOwner string
+	Delta         MetricDeltas
+}
+
+// SnapshotContents は差分計算の入力となる、1スナップショット分の概要とメンバー・リポジトリの集計です.
+type SnapshotContents struct {
+	Info         *SnapshotInfo
+	Members      []*MemberStats
+	Repositories []*RepositoryStats
+}
+
+// SnapshotDiff は2つのスナップショット（base → head）の間で何が変わったかを表します.
+// 追加・削除の一覧はログイン / nameWithOwner の昇順です.
+// 差分（MemberDeltas / RepositoryDeltas）は両方に存在し、いずれかの指標が変化したものだけを昇順で含みます.
+type SnapshotDiff struct {
+	Base                *SnapshotInfo
+	Head                *SnapshotInfo
+	AddedMembers        []string
+	RemovedMembers      []string
+	AddedRepositories   []string
+	RemovedRepositories []string
+	MemberDeltas        []*MemberDelta
+	RepositoryDeltas    []*RepositoryDelta
+}
+
+// LoadSnapshotContents は指定IDのスナップショットの概要・メンバー・リポジトリ集計を読み出します.
+// 存在しないIDには ErrSnapshotNotFound を返します.
+func LoadSnapshotContents(ctx context.Context, reader SnapshotReader, id int) (*SnapshotContents, error) {
+	info, err := reader.Snapshot(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("load snapshot %d: %w", id, err)
+	}
+
+	if info == nil {
+		return nil, fmt.Errorf("snapshot %d: %w", id, ErrSnapshotNotFound)
+	}
+
+	members, err := reader.Members(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("load snapshot %d members: %w", id, err)
+	}
+
+	repos, err := reader.Repositories(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("load snapshot %d repositories: %w", id, err)
+	}
+
+	return &SnapshotContents{Info: info, Members: members, Repositories: repos}, nil
+}
+
+// CompareSnapshots は base と head の2つのスナップショットを読み出し、その差分を返します.
+func CompareSnapshots(ctx context.Context, reader SnapshotReader, baseID, headID int) (*SnapshotDiff, error) {
+	base, err := LoadSnapshotContents(ctx, reader, baseID)
+	if err != nil {
+		return nil, err
+	}
+
+	head, err := LoadSnapshotContents(ctx, reader, headID)
+	if err != nil {
+		return nil, err
+	}
+
+	return DiffSnapshots(base, head), nil
+}
+
+// DiffSnapshots は base から head への、メンバー・リポジトリの追加 / 削除と指標の差分を計算します.
+func DiffSnapshots(base, head *SnapshotContents) *SnapshotDiff {
+	diff := &SnapshotDiff{
+		Base:                base.Info,
+		Head:                head.Info,
+		AddedMembers:        []string{},
+		RemovedMembers:      []string{},
+		AddedRepositories:   []string{},
+		RemovedRepositories: []string{},
+		MemberDeltas:        []*MemberDelta{},
+		RepositoryDeltas:    []*RepositoryDelta{},
+	}
+
+	baseMembers := make(map[string]*MemberStats, len(base.Members))
+	for _, m := range base.Members {
+		baseMembers[m.Login] = m
+	}
+
+	headMembers := make(map[string]bool, len(head.Members))
+	for _, m := range head.Members {
+		headMembers[m.Login] = true
+
+		before, ok := baseMembers[m.Login]
+		if !ok {
+			diff.AddedMembers = append(diff.AddedMembers, m.Login)
+
+			continue
+		}
+
+		if delta := memberMetricDeltas(before, m); !delta.IsZero() {
+			diff.MemberDeltas = append(diff.MemberDeltas, &MemberDelta{Login: m.Login, Delta: delta})
+		}
+	}
+
+	for login := range baseMembers {
+		if !headMembers[login] {
+			diff.RemovedMembers = append(diff.RemovedMembers, login)
+		}
+	}
+
+	baseRepos := make(map[string]*RepositoryStats, len(base.Repositories))
+	for _, r := range base.Repositories {
+		baseRepos[r.NameWithOwner] = r
+	}
+
+	headRepos := make(map[string]bool, len(head.Repositories))
+	for _, r := range head.Repositories {
+		headRepos[r.NameWithOwner] = true
+
+		before, ok := baseRepos[r.NameWithOwner]
+		if !ok {
+			diff.AddedRepositories = append(diff.AddedRepositories, r.NameWithOwner)
+
+			continue
+		}
+
+		if delta := repositoryMetricDeltas(before, r); !delta.IsZero() {
+			diff.RepositoryDeltas = append(diff.RepositoryDeltas, &RepositoryDelta{NameWithOwner: r.NameWithOwner, Delta: delta})
+		}
+	}
+
+	for name := range baseRepos {
+		if !headRepos[name] {
+			diff.RemovedRepositories = append(diff.RemovedRepositories, name)
+		}
+	}
+
+	sort.Strings(diff.AddedMembers)
+	sort.Strings(diff.RemovedMembers)
+	sort.Strings(diff.AddedRepositories)
+	sort.Strings(diff.RemovedRepositories)
+	sort.Slice(diff.MemberDeltas, func(i, j int) bool {
+		return diff.MemberDeltas[i].Login < diff.MemberDeltas[j].Login
+	})
+	sort.Slice(diff.RepositoryDeltas, func(i, j int) bool {
+		return diff.RepositoryDeltas[i].NameWithOwner < diff.RepositoryDeltas[j].NameWithOwner
+	})
+
+	return diff
+}
+
+// memberMetricDeltas はメンバーのスカラー指標の差分（head − base）を返します.
+func memberMetricDeltas(base, head *MemberStats) MetricDeltas {
+	return MetricDeltas{
+		Commits:   head.TotalCommits - base.TotalCommits,
+		PRCreated: head.TotalPRCreated - base.TotalPRCreated,
+		PRMerged:  head.TotalPRMerged - base.TotalPRMerged,
+		Issues:    head.TotalIssues - base.TotalIssues,
+		Reviews:   head.TotalReviews - base.TotalReviews,
+		Additions: head.TotalAdditions - base.TotalAdditions,
+		Deletions: head.TotalDeletions - base.TotalDeletions,
+	}
+}
+
+// repositoryMetricDeltas はリポジトリ合計の差分（head − base）を返します.
+func repositoryMetricDeltas(base, head *RepositoryStats) MetricDeltas {
+	return MetricDeltas{
+		Commits:   head.TotalCommits - base.TotalCommits,
+		PRCreated: head.TotalPRCreated - base.TotalPRCreated,
+		PRMerged:  head.TotalPRMerged - base.TotalPRMerged,
+		Issues:    head.TotalIssues - base.TotalIssues,
+		Reviews:   head.TotalReviews - base.TotalReviews,
+		Additions: head.TotalAdditions - base.TotalAdditions,
+		Deletions: head.TotalDeletions - base.TotalDeletions,
+	}
+}
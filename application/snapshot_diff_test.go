@@ -0,0 +1,129 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestDiffSnapshots(t *testing.T) {
+	t.Parallel()
+
+	base := &SnapshotContents{
+		Info: &SnapshotInfo{ID: 1},
+		Members: []*MemberStats{
+			{Login: "alice", TotalCommits: 10, TotalReviews: 4},
+			{Login: "bob", TotalCommits: 5},
+			{Login: "carol", TotalCommits: 7},
+		},
+		Repositories: []*RepositoryStats{
+			{NameWithOwner: "acme/api", TotalCommits: 12, TotalAdditions: 300},
+			{NameWithOwner: "acme/legacy", TotalCommits: 3},
+			{NameWithOwner: "acme/web", TotalCommits: 7},
+		},
+	}
+	head := &SnapshotContents{
+		Info: &SnapshotInfo{ID: 2},
+		Members: []*MemberStats{
+			{Login: "dave", TotalCommits: 2},
+			{Login: "carol", TotalCommits: 7},
+			{Login: "alice", TotalCommits: 14, TotalReviews: 3, TotalPRCreated: 1},
+		},
+		Repositories: []*RepositoryStats{
+			{NameWithOwner: "acme/web", TotalCommits: 7},
+			{NameWithOwner: "acme/api", TotalCommits: 16, TotalAdditions: 420},
+			{NameWithOwner: "acme/mobile", TotalCommits: 2},
+		},
+	}
+
+	got := DiffSnapshots(base, head)
+
+	want := &SnapshotDiff{
+		Base:                base.Info,
+		Head:                head.Info,
+		AddedMembers:        []string{"dave"},
+		RemovedMembers:      []string{"bob"},
+		AddedRepositories:   []string{"acme/mobile"},
+		RemovedRepositories: []string{"acme/legacy"},
+		MemberDeltas: []*MemberDelta{
+			{Login: "alice", Delta: MetricDeltas{Commits: 4, PRCreated: 1, Reviews: -1}},
+		},
+		RepositoryDeltas: []*RepositoryDelta{
+			{NameWithOwner: "acme/api", Delta: MetricDeltas{Commits: 4, Additions: 120}},
+		},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DiffSnapshots() = %+v, want %+v", got, want)
+	}
+}
+
+func TestDiffSnapshots_IdenticalSnapshotsHaveNoChanges(t *testing.T) {
+	t.Parallel()
+
+	contents := &SnapshotContents{
+		Info:         &SnapshotInfo{ID: 3},
+		Members:      []*MemberStats{{Login: "alice", TotalCommits: 1}},
+		Repositories: []*RepositoryStats{{NameWithOwner: "acme/api", TotalCommits: 1}},
+	}
+
+	got := DiffSnapshots(contents, contents)
+
+	if len(got.AddedMembers)+len(got.RemovedMembers)+len(got.AddedRepositories)+len(got.RemovedRepositories) != 0 {
+		t.Errorf("unexpected membership changes: %+v", got)
+	}
+
+	if got.MemberDeltas == nil || got.RepositoryDeltas == nil || len(got.MemberDeltas)+len(got.RepositoryDeltas) != 0 {
+		t.Errorf("deltas = %+v / %+v, want empty non-nil slices", got.MemberDeltas, got.RepositoryDeltas)
+	}
+}
+
+// diffReader は CompareSnapshots が使うメソッドだけを実装する SnapshotReader のテストダブルです.
+type diffReader struct {
+	SnapshotReader
+
+	snapshots map[int]*SnapshotContents
+}
+
+func (r *diffReader) Snapshot(_ context.Context, id int) (*SnapshotInfo, error) {
+	if c, ok := r.snapshots[id]; ok {
+		return c.Info, nil
+	}
+
+	return nil, nil
+}
+
+func (r *diffReader) Members(_ context.Context, id int) ([]*MemberStats, error) {
+	return r.snapshots[id].Members, nil
+}
+
+func (r *diffReader) Repositories(_ context.Context, id int) ([]*RepositoryStats, error) {
+	return r.snapshots[id].Repositories, nil
+}
+
+func TestCompareSnapshots(t *testing.T) {
+	t.Parallel()
+
+	reader := &diffReader{snapshots: map[int]*SnapshotContents{
+		1: {Info: &SnapshotInfo{ID: 1}, Members: []*MemberStats{{Login: "alice", TotalCommits: 1}}},
+		2: {Info: &SnapshotInfo{ID: 2}, Members: []*MemberStats{{Login: "alice", TotalCommits: 3}}},
+	}}
+
+	got, err := CompareSnapshots(context.Background(), reader, 1, 2)
+	if err != nil {
+		t.Fatalf("CompareSnapshots() error = %v", err)
+	}
+
+	if got.Base.ID != 1 || got.Head.ID != 2 {
+		t.Errorf("compared %d -> %d, want 1 -> 2", got.Base.ID, got.Head.ID)
+	}
+
+	if len(got.MemberDeltas) != 1 || got.MemberDeltas[0].Delta.Commits != 2 {
+		t.Errorf("MemberDeltas = %+v, want alice +2 commits", got.MemberDeltas)
+	}
+
+	if _, err := CompareSnapshots(context.Background(), reader, 1, 9); !errors.Is(err, ErrSnapshotNotFound) {
+		t.Errorf("CompareSnapshots() with unknown head error = %v, want ErrSnapshotNotFound", err)
+	}
+}
@@ -11,17 +11,36 @@ import (
 )
 
 // StatisticsService は統計情報を計算するサービスです.
-type StatisticsService struct{}
+type StatisticsService struct {
+	// exclusion はレビュー数から除くPR作成者（ボット等）です（nil なら除外しない）.
+	exclusion *domain.ActorExclusion
+	// timeZones は活動の時間帯を数えるメンバーごとのタイムゾーンです（nil なら UTC）.
+	timeZones *domain.TimeZones
+}
 
 // NewStatisticsService は新しいStatisticsServiceを作成します.
 func NewStatisticsService() *StatisticsService {
 	return &StatisticsService{}
 }
 
+// NewStatisticsServiceWithExclusion は、exclusion に一致するアカウントが作成したPRへのレビューを
+// レビュー数・レビューエッジから除き、除外数として別に数える StatisticsService を作成します.
+func NewStatisticsServiceWithExclusion(exclusion *domain.ActorExclusion) *StatisticsService {
+	return &StatisticsService{exclusion: exclusion}
+}
+
+// SetTimeZones は活動の時間帯（1時間ごとの件数）を数えるメンバーごとのタイムゾーンを設定します.
+func (s *StatisticsService) SetTimeZones(zones *domain.TimeZones) {
+	s.timeZones = zones
+}
+
 // CalculateStatistics は活動データから統計情報を計算します.
 func (s *StatisticsService) CalculateStatistics(data *infrastructure.UserActivityData) (*domain.UserStatistics, error) {
 	stats := domain.NewUserStatistics(data.User)
 
+	// 除外対象のアカウントのPRへのレビューを分ける
+	data, excludedReviews := s.excludeReviews(data)
+
 	// 全活動を統合
 	allActivities := make([]*domain.Activity, 0)
 	allActivities = append(allActivities, data.Commits...)
@@ -29,6 +48,9 @@ func (s *StatisticsService) CalculateStatistics(data *infrastructure.UserActivit
 	allActivities = append(allActivities, data.Issues...)
 	allActivities = append(allActivities, data.Reviews...)
 
+	// リポジトリ別の内訳にはIssueのクローズも含める（年別統計・最初の活動年には数えない）
+	repoActivities := append(allActivities[:len(allActivities):len(allActivities)], data.IssueCloses...)
+
 	// 基本統計を計算
 	s.calculateBasicStatistics(stats, allActivities, data)
 
@@ -39,17 +61,125 @@ func (s *StatisticsService) CalculateStatistics(data *infrastructure.UserActivit
 	s.calculateDailyStatistics(stats, allActivities, data)
 
 	// リポジトリ統計を計算
-	s.calculateRepositoryStatistics(stats, allActivities)
+	s.calculateRepositoryStatistics(stats, repoActivities)
 
 	// リポジトリ×日別統計を計算（時系列比較の元データ）
-	s.calculateRepoDailyStatistics(stats, allActivities)
+	s.calculateRepoDailyStatistics(stats, repoActivities)
+
+	// レビュアー→PR作成者のレビュー件数を集計（協業グラフの元データ）
+	s.calculateReviewEdges(stats, data.Reviews)
 
 	// 継続性・キャリア変遷を分析
 	s.analyzeContinuityAndCareer(stats)
 
+	// PRのサイクルタイムを集計
+	stats.SetPRLifecycles(data.PRLifecycles)
+
+	// Issueのクローズ数・クローズまでの時間・種類別の内訳を日別に数える
+	s.countIssueThroughput(stats, data)
+
+	// 作成したPRを作業の種類別に日別に数える
+	s.countWorkCategories(stats, data.PRs)
+
+	// 活動をメンバーの現地時刻の1時間ごとに数える（活動の時間帯のヒートマップの元データ）
+	s.countHourlyActivity(stats, repoActivities)
+
+	// 取得できなかった範囲を引き継ぐ（UI で不完全なメンバーを示すため）
+	stats.DataGaps = data.Gaps
+
+	// まとめたアカウントを引き継ぐ（ドリルダウンで示すため）
+	stats.Logins = data.Accounts
+
+	// 除外したレビューを日別に数える（差分取得でも日別行からマージできるように）
+	s.countExcludedReviews(stats, excludedReviews)
+
 	return stats, nil
 }
 
+// excludeReviews は除外ルールに一致するアカウントが作成したPRへのレビューを取り除いた活動データと、取り除いたレビューを返します.
+// 除外ルールが無い場合は data をそのまま返します.
+func (s *StatisticsService) excludeReviews(data *infrastructure.UserActivityData) (*infrastructure.UserActivityData, []*domain.Activity) {
+	if s.exclusion == nil {
+		return data, nil
+	}
+
+	kept := make([]*domain.Activity, 0, len(data.Reviews))
+	excluded := make([]*domain.Activity, 0)
+
+	for _, review := range data.Reviews {
+		if s.exclusion.Excludes(review.PullRequestAuthor, review.PullRequestAuthorType) {
+			excluded = append(excluded, review)
+
+			continue
+		}
+
+		kept = append(kept, review)
+	}
+
+	filtered := *data
+	filtered.Reviews = kept
+
+	return &filtered, excluded
+}
+
+// countExcludedReviews は除外したレビューを日別行と合計に数えます.
+func (s *StatisticsService) countExcludedReviews(stats *domain.UserStatistics, excluded []*domain.Activity) {
+	for _, review := range excluded {
+		day := dayKey(review.Date)
+
+		daily, exists := stats.DailyStats[day]
+		if !exists {
+			daily = domain.NewDailyStatistics(day)
+			stats.DailyStats[day] = daily
+		}
+
+		daily.ExcludedReviewCount++
+		stats.TotalExcludedReviews++
+	}
+}
+
+// countIssueThroughput はIssueの作成・クローズを種類別に、クローズまでの時間とともに日別行と合計に数えます.
+// リポジトリ別・リポジトリ×日別の内訳は各集計で数えます.
+func (s *StatisticsService) countIssueThroughput(stats *domain.UserStatistics, data *infrastructure.UserActivityData) {
+	for _, activities := range [][]*domain.Activity{data.Issues, data.IssueCloses} {
+		for _, activity := range activities {
+			day := dayKey(activity.Date)
+
+			daily, exists := stats.DailyStats[day]
+			if !exists {
+				daily = domain.NewDailyStatistics(day)
+				stats.DailyStats[day] = daily
+			}
+
+			throughput := domain.IssueThroughputOf(activity)
+			daily.IssueThroughput.Add(throughput)
+			stats.IssueThroughput.Add(throughput)
+		}
+	}
+
+	stats.IssueLifecycles = append(stats.IssueLifecycles, data.IssueLifecycles...)
+}
+
+// countHourlyActivity は活動をメンバーのタイムゾーンの1時間ごとに数えます.
+func (s *StatisticsService) countHourlyActivity(stats *domain.UserStatistics, activities []*domain.Activity) {
+	loc := s.timeZones.For(stats.User.Login)
+	stats.TimeZone = loc.String()
+	stats.HourlyActivity = domain.CountHourlyActivity(activities, loc)
+}
+
+// countWorkCategories は作成したPRを作業の種類別に日別行と合計に数えます.
+// PRを作成した日の日別行は calculateDailyStatistics で作成済みです.
+func (s *StatisticsService) countWorkCategories(stats *domain.UserStatistics, prs []*domain.Activity) {
+	for _, pr := range prs {
+		counts := domain.WorkCategoryCountsOf(pr)
+		if daily, exists := stats.DailyStats[dayKey(pr.Date)]; exists {
+			daily.WorkCategories.Add(counts)
+		}
+
+		stats.WorkCategories.Add(counts)
+	}
+}
+
 // calculateBasicStatistics は基本統計を計算します.
 func (s *StatisticsService) calculateBasicStatistics(
 	stats *domain.UserStatistics,
@@ -333,6 +463,8 @@ func (s *StatisticsService) aggregateRepositoryActivities(allActivities []*domai
 
 		repo.TotalAdditions += activity.Additions
 		repo.TotalDeletions += activity.Deletions
+		repo.IssueThroughput.Add(domain.IssueThroughputOf(activity))
+		repo.WorkCategories.Add(domain.WorkCategoryCountsOf(activity))
 
 		if activity.Date.Before(repo.FirstActivity) {
 			repo.FirstActivity = activity.Date
@@ -442,6 +574,8 @@ func (s *StatisticsService) calculateRepoDailyStatistics(
 
 		stat.TotalAdditions += activity.Additions
 		stat.TotalDeletions += activity.Deletions
+		stat.IssueThroughput.Add(domain.IssueThroughputOf(activity))
+		stat.WorkCategories.Add(domain.WorkCategoryCountsOf(activity))
 	}
 
 	repoDays := make([]*domain.RepoDailyStatistics, 0, len(byRepoDay))
@@ -460,6 +594,59 @@ func (s *StatisticsService) calculateRepoDailyStatistics(
 	stats.RepoDailyStats = repoDays
 }
 
+// calculateReviewEdges はレビューをPR作成者×リポジトリ×日別に集計します.
+// PR作成者が不明なレビュー（削除済みアカウント等）と、自分のPRへのレビューは協業を表さないため除外します.
+func (s *StatisticsService) calculateReviewEdges(stats *domain.UserStatistics, reviews []*domain.Activity) {
+	// キーは author + "\x00" + repository + "\x00" + day（calculateRepoDailyStatistics と同じ区切り）.
+	byKey := make(map[string]*domain.ReviewEdge)
+
+	reviewer := ""
+	if stats.User != nil {
+		reviewer = stats.User.Login
+	}
+
+	for _, review := range reviews {
+		if review.PullRequestAuthor == "" || review.PullRequestAuthor == reviewer {
+			continue
+		}
+
+		day := dayKey(review.Date)
+		key := review.PullRequestAuthor + "\x00" + review.Repository + "\x00" + day
+
+		edge, exists := byKey[key]
+		if !exists {
+			edge = domain.NewReviewEdge(review.PullRequestAuthor, review.Repository, day)
+			byKey[key] = edge
+		}
+
+		edge.ReviewCount++
+	}
+
+	edges := make([]*domain.ReviewEdge, 0, len(byKey))
+	for _, edge := range byKey {
+		edges = append(edges, edge)
+	}
+
+	sortReviewEdges(edges)
+	stats.ReviewEdges = edges
+}
+
+// sortReviewEdges はレビューエッジを作成者・リポジトリ・日付の昇順に並べます.
+func sortReviewEdges(edges []*domain.ReviewEdge) {
+	sort.Slice(edges, func(i, j int) bool {
+		a, b := edges[i], edges[j]
+		if a.Author != b.Author {
+			return a.Author < b.Author
+		}
+
+		if a.Repository != b.Repository {
+			return a.Repository < b.Repository
+		}
+
+		return a.Date < b.Date
+	})
+}
+
 // analyzeContinuityAndCareer は継続性・キャリア変遷を分析します.
 func (s *StatisticsService) analyzeContinuityAndCareer(stats *domain.UserStatistics) {
 	// 年ごとのPR作成数とレビュー数の比率を計算
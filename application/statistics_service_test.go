@@ -252,6 +252,224 @@ func TestStatisticsService_CalculateStatistics_DailyStats_UTCBoundary(t *testing
 	assert.Equal(t, 1, stats.DailyStats["2024-01-02"].CommitCount, "morning-JST commit stays on the UTC day")
 }
 
+func TestStatisticsService_CalculateStatistics_HourlyActivity(t *testing.T) {
+	t.Parallel()
+
+	zones, err := domain.NewTimeZones("", []domain.Identity{{Login: "testuser", TimeZone: "Asia/Tokyo"}})
+	require.NoError(t, err)
+
+	service := NewStatisticsService()
+	service.SetTimeZones(zones)
+
+	// ユーザー単位の収集のコミット貢献は日単位のため、時間帯には数えない.
+	contribution := domain.NewActivity(domain.ActivityTypeCommit, "owner/repo", time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC), 0, 0)
+	contribution.DayOnly = true
+
+	data := &infrastructure.UserActivityData{
+		User: domain.NewUser("testuser", "Test User", "2024-01-01T00:00:00Z"),
+		Commits: []*domain.Activity{
+			contribution,
+			// 2024-01-05（金）14:30 UTC == 2024-01-05 23:30 JST.
+			domain.NewActivity(domain.ActivityTypeCommit, "owner/repo", time.Date(2024, 1, 5, 14, 30, 0, 0, time.UTC), 1, 0),
+		},
+		PRs: []*domain.Activity{
+			// 2024-01-05（金）15:10 UTC == 2024-01-06（土）00:10 JST.
+			domain.NewActivity(domain.ActivityTypePR, "owner/repo", time.Date(2024, 1, 5, 15, 10, 0, 0, time.UTC), 1, 0),
+		},
+		Issues:  []*domain.Activity{},
+		Reviews: []*domain.Activity{},
+	}
+
+	stats, err := service.CalculateStatistics(data)
+	require.NoError(t, err, "CalculateStatistics() should not return error")
+
+	assert.Equal(t, "Asia/Tokyo", stats.TimeZone)
+	require.Len(t, stats.HourlyActivity, 2, "the day-only contribution is not counted")
+	assert.Equal(t, "2024-01-05", stats.HourlyActivity[0].Day)
+	assert.Equal(t, 23, stats.HourlyActivity[0].Hour)
+	assert.Equal(t, time.Saturday, stats.HourlyActivity[1].Weekday, "the hour is bucketed in the member's time zone")
+	assert.Equal(t, 2, stats.TotalCommits, "the day-only contribution still counts as a commit")
+
+	utc, err := NewStatisticsService().CalculateStatistics(data)
+	require.NoError(t, err)
+	assert.Equal(t, "UTC", utc.TimeZone, "members default to UTC without time zones")
+	assert.Equal(t, time.Friday, utc.HourlyActivity[1].Weekday)
+}
+
+func TestStatisticsService_CalculateStatistics_ReviewEdges(t *testing.T) {
+	t.Parallel()
+
+	review := func(author, repo string, at time.Time) *domain.Activity {
+		activity := domain.NewActivity(domain.ActivityTypeReview, repo, at, 0, 0)
+		activity.IsReview = true
+		activity.PullRequestAuthor = author
+
+		return activity
+	}
+
+	service := NewStatisticsService()
+	data := &infrastructure.UserActivityData{
+		User:    domain.NewUser("testuser", "Test User", "2024-01-01T00:00:00Z"),
+		Commits: []*domain.Activity{},
+		PRs:     []*domain.Activity{},
+		Issues:  []*domain.Activity{},
+		Reviews: []*domain.Activity{
+			review("bob", "owner/api", time.Date(2024, 1, 8, 9, 0, 0, 0, time.UTC)),
+			review("bob", "owner/api", time.Date(2024, 1, 8, 17, 0, 0, 0, time.UTC)),
+			review("bob", "owner/web", time.Date(2024, 1, 8, 10, 0, 0, 0, time.UTC)),
+			review("alice", "owner/api", time.Date(2024, 1, 9, 10, 0, 0, 0, time.UTC)),
+			review("testuser", "owner/api", time.Date(2024, 1, 9, 11, 0, 0, 0, time.UTC)),
+			review("", "owner/api", time.Date(2024, 1, 9, 12, 0, 0, 0, time.UTC)),
+		},
+	}
+
+	stats, err := service.CalculateStatistics(data)
+	require.NoError(t, err, "CalculateStatistics() should not return error")
+
+	assert.Equal(t, 6, stats.TotalReviews, "self and unknown-author reviews still count as reviews")
+	require.Len(t, stats.ReviewEdges, 3, "self and unknown-author reviews are not edges")
+	assert.Equal(t, &domain.ReviewEdge{Author: "alice", Repository: "owner/api", Date: "2024-01-09", ReviewCount: 1}, stats.ReviewEdges[0])
+	assert.Equal(t, &domain.ReviewEdge{Author: "bob", Repository: "owner/api", Date: "2024-01-08", ReviewCount: 2}, stats.ReviewEdges[1])
+	assert.Equal(t, &domain.ReviewEdge{Author: "bob", Repository: "owner/web", Date: "2024-01-08", ReviewCount: 1}, stats.ReviewEdges[2])
+}
+
+func TestStatisticsService_CalculateStatistics_DataGaps(t *testing.T) {
+	t.Parallel()
+
+	gap := &domain.DataGap{
+		ActivityType: domain.ActivityTypeCommit,
+		Repository:   "owner/big",
+		Reason:       domain.DataGapTransient,
+		Message:      "transient GitHub API error: non-200 OK status code: 502",
+	}
+
+	service := NewStatisticsService()
+
+	complete, err := service.CalculateStatistics(&infrastructure.UserActivityData{User: domain.NewUser("a", "A", "")})
+	require.NoError(t, err)
+	assert.True(t, complete.IsComplete(), "a member without gaps is complete")
+
+	partial, err := service.CalculateStatistics(&infrastructure.UserActivityData{
+		User: domain.NewUser("b", "B", ""),
+		Gaps: []*domain.DataGap{gap},
+	})
+	require.NoError(t, err)
+	assert.False(t, partial.IsComplete(), "a member with gaps is incomplete")
+	assert.Equal(t, []*domain.DataGap{gap}, partial.DataGaps)
+}
+
+func TestStatisticsService_CalculateStatistics_ExcludesReviewsOfExcludedAuthors(t *testing.T) {
+	t.Parallel()
+
+	exclusion, err := domain.NewActorExclusion([]string{"ci-user"}, nil, true)
+	require.NoError(t, err)
+
+	day := time.Date(2024, 3, 15, 10, 0, 0, 0, time.UTC)
+	review := func(author, authorType string, at time.Time) *domain.Activity {
+		activity := domain.NewActivity(domain.ActivityTypeReview, "acme/api", at, 0, 0)
+		activity.IsReview = true
+		activity.PullRequestAuthor = author
+		activity.PullRequestAuthorType = authorType
+
+		return activity
+	}
+
+	data := &infrastructure.UserActivityData{
+		User: domain.NewUser("alice", "Alice", ""),
+		Reviews: []*domain.Activity{
+			review("bob", "User", day),
+			review("dependabot", domain.ActorTypeBot, day),
+			review("ci-user", "User", day.AddDate(0, 0, 1)),
+		},
+	}
+
+	stats, err := NewStatisticsServiceWithExclusion(exclusion).CalculateStatistics(data)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, stats.TotalReviews, "reviews of excluded authors' PRs are not counted")
+	assert.Equal(t, 2, stats.TotalExcludedReviews)
+	assert.Equal(t, 1, stats.DailyStats["2024-03-15"].ExcludedReviewCount)
+	assert.Equal(t, 1, stats.DailyStats["2024-03-16"].ExcludedReviewCount)
+	assert.Equal(t, 0, stats.DailyStats["2024-03-16"].ReviewCount)
+	require.Len(t, stats.ReviewEdges, 1)
+	assert.Equal(t, "bob", stats.ReviewEdges[0].Author)
+	assert.Len(t, data.Reviews, 3, "the input data is left untouched")
+
+	// The excluded counts survive an incremental merge through the daily rows.
+	baseline := &MemberBaseline{
+		Login:      "alice",
+		CapturedAt: day.AddDate(0, 0, 1),
+		DailyStats: stats.DailyStats,
+	}
+	delta, err := NewStatisticsServiceWithExclusion(exclusion).CalculateStatistics(&infrastructure.UserActivityData{
+		User:    domain.NewUser("alice", "Alice", ""),
+		Reviews: []*domain.Activity{review("renovate", domain.ActorTypeBot, day.AddDate(0, 0, 2))},
+	})
+	require.NoError(t, err)
+
+	merged := NewStatisticsService().MergeIncremental(baseline, delta, IncrementalCutoff(baseline.CapturedAt))
+	assert.Equal(t, 1, merged.TotalReviews)
+	assert.Equal(t, 2, merged.TotalExcludedReviews, "the cutoff day is replaced by the delta")
+}
+
+func TestStatisticsService_CalculateStatistics_IssueThroughput(t *testing.T) {
+	t.Parallel()
+
+	day := time.Date(2024, 3, 15, 10, 0, 0, 0, time.UTC)
+	issue := func(activityType domain.ActivityType, kind domain.IssueKind, at time.Time, openedAt time.Time) *domain.Activity {
+		activity := domain.NewActivity(activityType, "acme/api", at, 0, 0)
+		activity.IssueKind = kind
+		activity.IssueOpenedAt = openedAt
+
+		return activity
+	}
+
+	closedAt := day.AddDate(0, 0, 1)
+	data := &infrastructure.UserActivityData{
+		User:   domain.NewUser("alice", "Alice", ""),
+		Issues: []*domain.Activity{issue(domain.ActivityTypeIssue, domain.IssueKindFeature, day, time.Time{})},
+		IssueCloses: []*domain.Activity{
+			issue(domain.ActivityTypeIssueClose, domain.IssueKindBug, closedAt, closedAt.Add(-4*time.Hour)),
+		},
+		IssueLifecycles: []*domain.IssueLifecycle{{Repository: "acme/api", SourceID: "I_1", CreatedAt: day}},
+	}
+
+	stats, err := NewStatisticsService().CalculateStatistics(data)
+	require.NoError(t, err)
+
+	want := domain.IssueThroughput{ClosedCount: 1, CloseSeconds: 4 * 3600, FeatureOpened: 1, BugClosed: 1}
+	assert.Equal(t, want, stats.IssueThroughput)
+	assert.Equal(t, 1, stats.TotalIssues, "closing an issue does not count as filing one")
+	assert.Equal(t, 1, stats.DailyStats["2024-03-16"].IssueThroughput.ClosedCount)
+	assert.Zero(t, stats.DailyStats["2024-03-16"].IssueCount)
+	require.Len(t, stats.AllRepositories, 1)
+	assert.Equal(t, want, stats.AllRepositories[0].IssueThroughput)
+	require.Len(t, stats.RepoDailyStats, 2)
+	assert.Equal(t, 1, stats.RepoDailyStats[1].IssueThroughput.BugClosed)
+	assert.Len(t, stats.IssueLifecycles, 1)
+
+	// The throughput survives an incremental merge, and a later close replaces the stored lifecycle.
+	baseline := &MemberBaseline{
+		Login:           "alice",
+		CapturedAt:      closedAt,
+		DailyStats:      stats.DailyStats,
+		RepoDailyStats:  stats.RepoDailyStats,
+		IssueLifecycles: stats.IssueLifecycles,
+	}
+	delta := domain.NewUserStatistics(data.User)
+	delta.DailyStats["2024-03-16"] = stats.DailyStats["2024-03-16"]
+	delta.IssueLifecycles = []*domain.IssueLifecycle{
+		{Repository: "acme/api", SourceID: "I_1", CreatedAt: day, ClosedAt: &closedAt},
+		{Repository: "acme/api", SourceID: "I_2", CreatedAt: closedAt},
+	}
+
+	merged := NewStatisticsService().MergeIncremental(baseline, delta, IncrementalCutoff(closedAt))
+	assert.Equal(t, domain.IssueThroughput{ClosedCount: 1, CloseSeconds: 4 * 3600, FeatureOpened: 1, BugClosed: 1}, merged.IssueThroughput)
+	require.Len(t, merged.IssueLifecycles, 2)
+	assert.NotNil(t, merged.IssueLifecycles[0].ClosedAt, "the delta's record of the same issue wins")
+	assert.Equal(t, "I_2", merged.IssueLifecycles[1].SourceID)
+}
+
 func TestStatisticsService_CalculateStatistics_TopRepositories(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,144 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Tattsum/github-analytics/domain"
+)
+
+var (
+	// ErrUserNotStarted は、コンテキストの終了によりユーザーの処理を開始しなかったことを表します.
+	ErrUserNotStarted = errors.New("not started")
+	// ErrNoUserStatistics は、処理がエラーも統計も返さなかったことを表します.
+	ErrNoUserStatistics = errors.New("task returned no statistics")
+)
+
+// UserTask は1ユーザー分の活動を取得・集計する処理です.
+// エラーを返さずに統計が nil の場合は、ErrNoUserStatistics による失敗として扱います.
+type UserTask func(ctx context.Context, login string) (*domain.UserStatistics, error)
+
+// UserProgress はユーザー1人の処理が終わるたびに通知される進捗です.
+type UserProgress struct {
+	Login string
+	// Done はこの通知を含めて処理が終わったユーザー数、Total は対象ユーザーの総数です.
+	Done  int
+	Total int
+	// Elapsed はこのユーザーの処理にかかった時間です.
+	Elapsed time.Duration
+	// Err は処理に失敗した場合のエラーです（成功時は nil）.
+	Err error
+}
+
+// UserFailure は処理できなかったユーザーと、その理由です.
+// コンテキストの終了で開始されなかったユーザーの Err は ErrUserNotStarted とコンテキストのエラーを包みます.
+type UserFailure struct {
+	Login string
+	Err   error
+}
+
+// UserPoolResult はワーカープールの実行結果です.
+type UserPoolResult struct {
+	// Stats は成功したユーザーの統計で、入力のユーザー順に並びます.
+	Stats []*domain.UserStatistics
+	// Failures は失敗またはスキップしたユーザーで、入力のユーザー順に並びます.
+	Failures []*UserFailure
+}
+
+// UserPool は複数ユーザーの取得・集計を、同時実行数を制限して並行に行うワーカープールです.
+// GitHub API のレート制限は呼び出し側で共有する GitHubClient のリミッターが担うため、
+// 同時実行数を増やしてもリクエスト頻度の上限は変わらず、応答待ちの重なりだけが増えます.
+type UserPool struct {
+	concurrency int
+	progress    func(UserProgress)
+}
+
+// NewUserPool は新しい UserPool を作成します.
+// concurrency が 1 未満の場合は 1（逐次実行）として扱います. progress は nil でも構いません.
+func NewUserPool(concurrency int, progress func(UserProgress)) *UserPool {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	return &UserPool{concurrency: concurrency, progress: progress}
+}
+
+// Run は logins の各ユーザーについて task を実行し、成功した統計と失敗したユーザーを集めて返します.
+// 1ユーザーの失敗は他のユーザーの処理を止めません. ctx が終了した後は未着手のユーザーを開始せず、
+// 失敗として記録します. progress は1回ずつ直列に呼ばれるため、呼び出し側で排他制御は不要です.
+func (p *UserPool) Run(ctx context.Context, logins []string, task UserTask) *UserPoolResult {
+	stats := make([]*domain.UserStatistics, len(logins))
+	errs := make([]error, len(logins))
+
+	jobs := make(chan int)
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		done int
+	)
+
+	report := func(i int, elapsed time.Duration) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		done++
+
+		if p.progress != nil {
+			p.progress(UserProgress{Login: logins[i], Done: done, Total: len(logins), Elapsed: elapsed, Err: errs[i]})
+		}
+	}
+
+	workers := min(p.concurrency, len(logins))
+	for range workers {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range jobs {
+				if err := ctx.Err(); err != nil {
+					errs[i] = fmt.Errorf("%w: %w", ErrUserNotStarted, err)
+					report(i, 0)
+
+					continue
+				}
+
+				start := time.Now()
+				stats[i], errs[i] = task(ctx, logins[i])
+				if errs[i] == nil && stats[i] == nil {
+					errs[i] = ErrNoUserStatistics
+				}
+
+				report(i, time.Since(start))
+			}
+		}()
+	}
+
+	for i := range logins {
+		jobs <- i
+	}
+
+	close(jobs)
+	wg.Wait()
+
+	result := &UserPoolResult{
+		Stats:    make([]*domain.UserStatistics, 0, len(logins)),
+		Failures: make([]*UserFailure, 0),
+	}
+
+	for i, login := range logins {
+		if errs[i] != nil {
+			result.Failures = append(result.Failures, &UserFailure{Login: login, Err: errs[i]})
+
+			continue
+		}
+
+		result.Stats = append(result.Stats, stats[i])
+	}
+
+	return result
+}
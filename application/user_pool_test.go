@@ -0,0 +1,144 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Tattsum/github-analytics/domain"
+)
+
+func TestUserPool_Run(t *testing.T) {
+	t.Parallel()
+
+	errBoom := errors.New("boom")
+	logins := []string{"alice", "bob", "carol", "dave", "erin"}
+
+	var progress []UserProgress
+
+	pool := NewUserPool(3, func(p UserProgress) { progress = append(progress, p) })
+
+	result := pool.Run(context.Background(), logins, func(_ context.Context, login string) (*domain.UserStatistics, error) {
+		if login == "bob" || login == "dave" {
+			return nil, errBoom
+		}
+
+		return domain.NewUserStatistics(domain.NewUser(login, "", "")), nil
+	})
+
+	got := make([]string, 0, len(result.Stats))
+	for _, s := range result.Stats {
+		got = append(got, s.User.Login)
+	}
+
+	assert.Equal(t, []string{"alice", "carol", "erin"}, got, "successful stats keep the input order")
+
+	require.Len(t, result.Failures, 2)
+	assert.Equal(t, "bob", result.Failures[0].Login)
+	assert.Equal(t, "dave", result.Failures[1].Login)
+	require.ErrorIs(t, result.Failures[0].Err, errBoom)
+
+	require.Len(t, progress, len(logins))
+
+	for i, p := range progress {
+		assert.Equal(t, i+1, p.Done, "progress is reported once per user, serially")
+		assert.Equal(t, len(logins), p.Total)
+	}
+}
+
+func TestUserPool_RunTreatsNilStatisticsAsFailure(t *testing.T) {
+	t.Parallel()
+
+	var progress []UserProgress
+
+	pool := NewUserPool(2, func(p UserProgress) { progress = append(progress, p) })
+
+	result := pool.Run(context.Background(), []string{"alice", "bob"}, func(_ context.Context, login string) (*domain.UserStatistics, error) {
+		if login == "bob" {
+			return nil, nil
+		}
+
+		return domain.NewUserStatistics(domain.NewUser(login, "", "")), nil
+	})
+
+	require.Len(t, result.Stats, 1)
+	assert.Equal(t, "alice", result.Stats[0].User.Login)
+
+	require.Len(t, result.Failures, 1)
+	assert.Equal(t, "bob", result.Failures[0].Login)
+	require.ErrorIs(t, result.Failures[0].Err, ErrNoUserStatistics)
+
+	require.Len(t, progress, 2)
+
+	for _, p := range progress {
+		if p.Login == "bob" {
+			require.ErrorIs(t, p.Err, ErrNoUserStatistics, "the progress reports the failure too")
+		}
+	}
+}
+
+func TestUserPool_RunBoundsConcurrency(t *testing.T) {
+	t.Parallel()
+
+	const limit = 2
+
+	var running, peak atomic.Int32
+
+	logins := []string{"a", "b", "c", "d", "e", "f", "g"}
+
+	result := NewUserPool(limit, nil).Run(context.Background(), logins, func(_ context.Context, login string) (*domain.UserStatistics, error) {
+		n := running.Add(1)
+		defer running.Add(-1)
+
+		for {
+			old := peak.Load()
+			if n <= old || peak.CompareAndSwap(old, n) {
+				break
+			}
+		}
+
+		time.Sleep(5 * time.Millisecond)
+
+		return domain.NewUserStatistics(domain.NewUser(login, "", "")), nil
+	})
+
+	assert.Len(t, result.Stats, len(logins))
+	assert.Empty(t, result.Failures)
+	assert.LessOrEqual(t, peak.Load(), int32(limit))
+}
+
+func TestUserPool_RunSkipsUsersAfterCancel(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var calls atomic.Int32
+
+	result := NewUserPool(1, nil).Run(ctx, []string{"alice", "bob", "carol"}, func(_ context.Context, login string) (*domain.UserStatistics, error) {
+		calls.Add(1)
+		cancel()
+
+		return domain.NewUserStatistics(domain.NewUser(login, "", "")), nil
+	})
+
+	assert.Equal(t, int32(1), calls.Load(), "no user is started after the context ends")
+	require.Len(t, result.Stats, 1)
+	assert.Equal(t, "alice", result.Stats[0].User.Login)
+	require.Len(t, result.Failures, 2)
+	require.ErrorIs(t, result.Failures[0].Err, ErrUserNotStarted)
+	require.ErrorIs(t, result.Failures[0].Err, context.Canceled)
+	assert.Equal(t, "carol", result.Failures[1].Login)
+}
+
+func TestNewUserPool_ClampsConcurrency(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 1, NewUserPool(0, nil).concurrency)
+	assert.Equal(t, 4, NewUserPool(4, nil).concurrency)
+}
@@ -15,17 +15,81 @@ import (
 )
 
 var (
-	// errMissingDatabaseURL is returned when DATABASE_URL is unset in batch mode.
-	errMissingDatabaseURL = errors.New("DATABASE_URL environment variable is not set")
+	// errMissingDatabaseURL is returned when neither -database-url nor
+	// DATABASE_URL is set.
+	errMissingDatabaseURL = errors.New("-database-url or the DATABASE_URL environment variable must be set")
 	// errNoMemberStatistics is returned when no member could be aggregated.
 	errNoMemberStatistics = errors.New("no member statistics were computed; aborting snapshot write")
+	// errIncompleteRun is returned when some users failed and a partial snapshot
+	// was not accepted.
+	errIncompleteRun = errors.New("batch run is incomplete; snapshot not saved")
 )
 
+// batchOptions holds the batch-mode flags.
+type batchOptions struct {
+	users          []string
+	includePrivate bool
+	github         infrastructure.GitHubClientConfig
+	full           bool
+	concurrency    int
+	// stateDir holds one checkpoint directory per run.
+	stateDir string
+	// resumeRunID continues an earlier run; its users, -private and -full are
+	// taken from the run's manifest instead of the flags.
+	resumeRunID string
+	// acceptPartial saves a snapshot even if some users could not be fetched.
+	acceptPartial bool
+	// databaseURL is the -database-url flag; DATABASE_URL is used when empty.
+	databaseURL string
+	// collect is the collection mode; collectRepository walks the
+	// repositories of org instead of fetching each user's contributions.
+	collect string
+	org     string
+	// commitLines fetches per-commit line counts in user collection mode.
+	commitLines bool
+	// delivery fetches the deployments, releases and tags of the members'
+	// repositories and stores their weekly delivery metrics.
+	delivery bool
+	// lookbackYears limits how far back activity is fetched; 0 keeps the
+	// default lookback.
+	lookbackYears int
+	// period limits the fetch to a collection period; it is recorded on the
+	// snapshot.
+	period domain.CollectionPeriod
+	// exclusion are the bot and service-account exclusion rules, and excluded
+	// the accounts they already dropped from users.
+	exclusion exclusionRules
+	excluded  []*domain.ExcludedActor
+	// identities merge several GitHub accounts into one member and set the
+	// members' time zones; users holds the canonical logins.
+	identities identitySettings
+	// issueLabels map issue labels to bugs and features.
+	issueLabels issueLabels
+	// prCategories are the work category rules of pull requests; nil keeps
+	// the defaults.
+	prCategories []domain.WorkCategoryRule
+}
+
+// resolveDatabaseURL returns the -database-url value, falling back to the
+// DATABASE_URL environment variable.
+func resolveDatabaseURL(flagValue string) (string, error) {
+	if flagValue != "" {
+		return flagValue, nil
+	}
+
+	databaseURL := os.Getenv("DATABASE_URL")
+	if databaseURL == "" {
+		return "", errMissingDatabaseURL
+	}
+
+	return databaseURL, nil
+}
+
 // runBatch fetches activity for the given users, aggregates per-member
 // statistics, and writes exactly one snapshot to PostgreSQL. Fatal exit is kept
 // at the top level so deferred cleanup runs before the process terminates.
-func runBatch(users []string, includePrivate bool, token string) {
-	if err := executeBatch(users, includePrivate, token); err != nil {
+func runBatch(opts batchOptions) {
+	if err := executeBatch(opts); err != nil {
 		log.Fatalf("batch: %v", err)
 	}
 }
@@ -33,16 +97,49 @@ func runBatch(users []string, includePrivate bool, token string) {
 // executeBatch performs the batch run and returns an error instead of exiting,
 // so that the deferred context cancel and DB Close always run.
 //
-// DATABASE_URL must point at the target PostgreSQL instance. Migrations are run
+// -database-url or DATABASE_URL must point at the target PostgreSQL instance. Migrations are run
 // before writing so the batch is safe to run against a fresh database.
-func executeBatch(users []string, includePrivate bool, token string) error {
-	const timeoutMinutes = 30
+//
+// Unless full is set, the run is incremental: each member's latest persisted
+// snapshot is used as a baseline, only activity since that snapshot's day is
+// fetched, and the delta is merged with the persisted per-day rows. Snapshots
+// limited to a collection period never serve as baselines, and neither do
+// snapshots fetched with a different -lookback-years. Members without any
+// baseline are fetched in full. A run limited to a collection period
+// (-since / -until) is always fetched in full.
+//
+// Every fetched activity is also appended to the activity event store, so a
+// later reaggregate run can rebuild snapshots without re-fetching.
+//
+// With -delivery-metrics the deployments, releases and tags of the
+// repositories the members merged pull requests into are fetched over the
+// whole lookback window on every run, and their weekly delivery metrics are
+// saved with the snapshot.
+//
+// Up to concurrency users are fetched in parallel, and each user's fetch result
+// is checkpointed under the state directory as soon as it arrives. The snapshot
+// is only saved once every user succeeded, or with acceptPartial; otherwise the
+// run can be continued with -resume, which skips the checkpointed users. The
+// run's state is removed after the snapshot is saved.
+func executeBatch(opts batchOptions) error {
+	const (
+		timeoutMinutes     = 30
+		saveTimeoutMinutes = 5
+	)
 
-	databaseURL := os.Getenv("DATABASE_URL")
-	if databaseURL == "" {
-		return errMissingDatabaseURL
+	databaseURL, err := resolveDatabaseURL(opts.databaseURL)
+	if err != nil {
+		return err
 	}
 
+	run, err := openBatchRun(opts)
+	if err != nil {
+		return err
+	}
+
+	manifest := run.manifest
+	period := manifest.Period()
+
 	ctx, cancel := context.WithTimeout(context.Background(), timeoutMinutes*time.Minute)
 	defer cancel()
 
@@ -61,49 +158,378 @@ func executeBatch(users []string, includePrivate bool, token string) error {
 		return fmt.Errorf("failed to run migrations: %w", err)
 	}
 
-	members := computeMemberStatistics(ctx, users, includePrivate, token)
+	// A limited collection period is always fetched in full: the baselines may
+	// cover a different period. Snapshots fetched with another lookback are not
+	// used as baselines either.
+	baselines := map[string]*application.MemberBaseline{}
+	if !manifest.Full && period.IsZero() {
+		baselines, err = snapshotdb.NewSnapshotReader(client).Baselines(ctx, manifest.Users)
+		if err != nil {
+			return fmt.Errorf("failed to load incremental baselines: %w", err)
+		}
+
+		baselines = application.BaselinesWithLookback(baselines, manifest.LookbackYears)
+	}
+
+	fetcher, github, err := newGitHubFetcher(ctx, opts.github)
+	if err != nil {
+		return err
+	}
+
+	exclusion, err := configureFetcher(fetcher, manifest)
+	if err != nil {
+		return err
+	}
+
+	identities, zones, err := manifestIdentities(manifest)
+	if err != nil {
+		return err
+	}
+
+	// A member whose accounts changed since the baseline is fetched in full, so
+	// that the history of an added account is not cut at the baseline.
+	baselines = application.BaselinesWithAccounts(baselines, identities)
+
+	processor := newBatchUserProcessor(fetcher, run, baselines, snapshotdb.NewEventStore(client), exclusion, identities, zones)
+
+	if manifest.Collect == collectRepository {
+		if err := processor.collectRepositories(ctx, fetcher, manifest, opts.concurrency); err != nil {
+			return err
+		}
+	}
+
+	// All workers share one GitHubClient and so one rate limiter.
+	pool := application.NewUserPool(opts.concurrency, printUserProgress)
+	result := pool.Run(ctx, manifest.Users, processor.process)
+	printSkippedUsers(result.Failures)
+	printIncompleteUsers(result.Stats)
+	printAPIBudgets(github)
+
+	members := result.Stats
 	if len(members) == 0 {
-		return errNoMemberStatistics
+		return fmt.Errorf("%w (resume with -resume %s)", errNoMemberStatistics, manifest.RunID)
+	}
+
+	if len(result.Failures) > 0 && !opts.acceptPartial {
+		return fmt.Errorf("%w: %d of %d users failed; rerun with -resume %s to fetch only those users, "+
+			"or add -accept-partial to save a snapshot without them",
+			errIncompleteRun, len(result.Failures), len(manifest.Users), manifest.RunID)
 	}
 
 	snapshot := &application.Snapshot{
-		CapturedAt: time.Now(),
-		Members:    members,
+		CapturedAt:      time.Now(),
+		Members:         members,
+		ExcludedMembers: manifest.Excluded,
+		Period:          period,
+		LookbackYears:   manifest.LookbackYears,
+		DeliveryWeeks:   collectDeliveryWeeks(ctx, fetcher, manifest.DeliveryMetrics, members),
 	}
 
+	// The fetch may have used up the run timeout, so the write gets its own.
+	saveCtx, saveCancel := context.WithTimeout(context.WithoutCancel(ctx), saveTimeoutMinutes*time.Minute)
+	defer saveCancel()
+
 	writer := snapshotdb.NewSnapshotWriter(client)
-	if err := writer.Save(ctx, snapshot); err != nil {
-		return fmt.Errorf("failed to save snapshot: %w", err)
+	if err := writer.Save(saveCtx, snapshot); err != nil {
+		return fmt.Errorf("failed to save snapshot (checkpoints kept; retry with -resume %s): %w", manifest.RunID, err)
 	}
 
-	fmt.Printf("\n=== バッチ完了 ===\nスナップショットを保存しました（メンバー数: %d, captured_at: %s）\n",
-		len(members), snapshot.CapturedAt.Format(time.RFC3339))
+	if err := run.store.Remove(); err != nil {
+		log.Printf("Failed to remove state of run %s: %v", manifest.RunID, err)
+	}
+
+	fmt.Printf("\n=== バッチ完了 ===\nスナップショットを保存しました（メンバー数: %d, スキップ: %d, captured_at: %s）\n",
+		len(members), len(result.Failures), snapshot.CapturedAt.Format(time.RFC3339))
 
 	return nil
 }
 
-// computeMemberStatistics fetches and aggregates statistics for each user
-// sequentially. Per-user failures are logged and skipped so one unreachable
-// account does not abort the whole snapshot.
-func computeMemberStatistics(ctx context.Context, users []string, includePrivate bool, token string) []*domain.UserStatistics {
-	client := infrastructure.NewGitHubClient(token)
-	repo := infrastructure.NewGitHubRepository(client)
-	fetcher := infrastructure.NewGitHubDataFetcher(repo)
-	statsService := application.NewStatisticsService()
+// batchRun is the checkpoint state of the run being executed.
+type batchRun struct {
+	manifest    *infrastructure.RunManifest
+	store       *infrastructure.CheckpointStore
+	checkpoints map[string]*infrastructure.UserCheckpoint
+}
+
+// openBatchRun starts a new run, or loads the manifest and the completed
+// users' checkpoints of the run being resumed.
+func openBatchRun(opts batchOptions) (*batchRun, error) {
+	if opts.resumeRunID != "" {
+		store, err := infrastructure.NewCheckpointStore(opts.stateDir, opts.resumeRunID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open run state: %w", err)
+		}
 
-	members := make([]*domain.UserStatistics, 0, len(users))
+		manifest, err := store.LoadRun()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load run %s: %w", opts.resumeRunID, err)
+		}
 
-	for _, user := range users {
-		stats, err := processUser(ctx, user, includePrivate, fetcher, statsService)
+		checkpoints, err := store.LoadUsers()
 		if err != nil {
-			log.Printf("Error processing user %s: %v", user, err)
+			return nil, fmt.Errorf("failed to load checkpoints of run %s: %w", opts.resumeRunID, err)
+		}
+
+		fmt.Printf("Resuming run %s: %d of %d users already fetched\n", manifest.RunID, len(checkpoints), len(manifest.Users))
+
+		return &batchRun{manifest: manifest, store: store, checkpoints: checkpoints}, nil
+	}
+
+	startedAt := time.Now()
+	manifest := &infrastructure.RunManifest{
+		RunID:              infrastructure.NewRunID(startedAt),
+		StartedAt:          startedAt,
+		Users:              opts.users,
+		IncludePrivate:     opts.includePrivate,
+		Full:               opts.full,
+		Collect:            opts.collect,
+		Org:                opts.org,
+		CommitLines:        opts.commitLines,
+		DeliveryMetrics:    opts.delivery,
+		LookbackYears:      opts.lookbackYears,
+		PeriodSince:        opts.period.Since,
+		PeriodUntil:        opts.period.Until,
+		ExcludeLogins:      opts.exclusion.logins,
+		ExcludePatterns:    opts.exclusion.patterns,
+		ExcludeBots:        opts.exclusion.bots,
+		Excluded:           opts.excluded,
+		Identities:         opts.identities.identities,
+		TimeZone:           opts.identities.timeZone,
+		IssueBugLabels:     opts.issueLabels.bug,
+		IssueFeatureLabels: opts.issueLabels.feature,
+		PRCategoryRules:    opts.prCategories,
+	}
+
+	store, err := infrastructure.NewCheckpointStore(opts.stateDir, manifest.RunID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open run state: %w", err)
+	}
+
+	if err := store.CreateRun(manifest); err != nil {
+		return nil, fmt.Errorf("failed to create run state: %w", err)
+	}
+
+	fmt.Printf("Run ID: %s (checkpoints: %s)\n", manifest.RunID, store.Dir())
+
+	return &batchRun{manifest: manifest, store: store, checkpoints: map[string]*infrastructure.UserCheckpoint{}}, nil
+}
+
+// manifestExclusion returns the exclusion rules the run was started with.
+func manifestExclusion(manifest *infrastructure.RunManifest) exclusionRules {
+	return exclusionRules{
+		logins:   manifest.ExcludeLogins,
+		patterns: manifest.ExcludePatterns,
+		bots:     manifest.ExcludeBots,
+	}
+}
+
+// configureFetcher applies the fetch settings the run was started with to
+// fetcher, and returns the exclusion rules it filters with.
+func configureFetcher(
+	fetcher *infrastructure.GitHubDataFetcher, manifest *infrastructure.RunManifest,
+) (*domain.ActorExclusion, error) {
+	exclusion, err := manifestExclusion(manifest).build()
+	if err != nil {
+		return nil, err
+	}
+
+	classifier, err := prClassifier(manifest.PRCategoryRules)
+	if err != nil {
+		return nil, err
+	}
+
+	if manifest.CommitLines {
+		fetcher.EnableCommitLineCounts()
+	}
+
+	fetcher.SetActorExclusion(exclusion)
+	fetcher.SetLookbackYears(manifest.LookbackYears)
+	fetcher.SetCollectionPeriod(manifest.Period())
+	fetcher.SetIssueLabelMapping(manifestIssueLabels(manifest).mapping())
+	fetcher.SetPRClassifier(classifier)
+
+	return exclusion, nil
+}
+
+// manifestIssueLabels returns the issue labels the run was started with.
+func manifestIssueLabels(manifest *infrastructure.RunManifest) issueLabels {
+	return issueLabels{bug: manifest.IssueBugLabels, feature: manifest.IssueFeatureLabels}
+}
+
+// manifestIdentities builds the account lookup and the members' time zones of
+// the run from its manifest.
+func manifestIdentities(manifest *infrastructure.RunManifest) (*domain.IdentityMap, *domain.TimeZones, error) {
+	identities, err := buildIdentityMap(manifest.Identities)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	zones, err := buildTimeZones(manifest.TimeZone, manifest.Identities)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return identities, zones, nil
+}
+
+// batchUserProcessor holds what every worker needs to process one member. It
+// is shared by all workers and only read after construction.
+type batchUserProcessor struct {
+	includePrivate bool
+	baselines      map[string]*application.MemberBaseline
+	// source is the per-user fetcher, or the repository-centric collection
+	// once collectRepositories has run, merging the accounts of identities.
+	source       activitySource
+	identities   *domain.IdentityMap
+	statsService *application.StatisticsService
+	events       application.ActivityEventStore
+	store        *infrastructure.CheckpointStore
+	// checkpoints are the users already fetched by the run being resumed.
+	checkpoints map[string]*infrastructure.UserCheckpoint
+}
+
+// newBatchUserProcessor builds the processor shared by the workers of run.
+func newBatchUserProcessor(
+	fetcher *infrastructure.GitHubDataFetcher,
+	run *batchRun,
+	baselines map[string]*application.MemberBaseline,
+	events application.ActivityEventStore,
+	exclusion *domain.ActorExclusion,
+	identities *domain.IdentityMap,
+	zones *domain.TimeZones,
+) *batchUserProcessor {
+	statsService := application.NewStatisticsServiceWithExclusion(exclusion)
+	statsService.SetTimeZones(zones)
+
+	return &batchUserProcessor{
+		includePrivate: run.manifest.IncludePrivate,
+		baselines:      baselines,
+		source:         withIdentities(fetcher, identities),
+		identities:     identities,
+		statsService:   statsService,
+		events:         events,
+		store:          run.store,
+		checkpoints:    run.checkpoints,
+	}
+}
+
+// process fetches one member's activity, checkpoints it, appends the raw
+// events to the event store, and aggregates them. With a baseline only the
+// activity since the baseline snapshot day is fetched and merged with the
+// persisted per-day rows; the baseline day itself is re-fetched and replaced,
+// because it may have been captured while the day was still in progress.
+// Without one the full lookback window is fetched.
+//
+// A checkpoint from the resumed run is used instead of fetching, as long as it
+// was fetched from the same cutoff; if a snapshot was saved in between, the
+// baseline moved and the user is fetched again. Re-appending checkpointed
+// events is harmless because the event store deduplicates them.
+func (p *batchUserProcessor) process(ctx context.Context, user string) (*domain.UserStatistics, error) {
+	baseline := p.baselines[user]
+	cutoff := p.cutoff(user)
+
+	data, err := p.fetch(ctx, user, cutoff)
+	if err != nil {
+		return nil, err
+	}
+
+	inserted, err := p.events.AppendActivity(ctx, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to store activity events: %w", err)
+	}
+
+	fmt.Printf("Stored %d new activity events for user: %s\n", inserted, user)
+
+	stats, err := p.statsService.CalculateStatistics(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate statistics: %w", err)
+	}
+
+	return p.statsService.MergeIncremental(baseline, stats, cutoff), nil
+}
+
+// cutoff returns the incremental cutoff of user: the baseline snapshot day, or
+// zero for a full fetch when the user has no baseline.
+func (p *batchUserProcessor) cutoff(user string) time.Time {
+	if baseline := p.baselines[user]; baseline != nil {
+		return application.IncrementalCutoff(baseline.CapturedAt)
+	}
+
+	return time.Time{}
+}
+
+// collectRepositories walks the repositories of the run's organization once
+// for all users and makes the workers read their activity from the result.
+// The walk starts at the earliest cutoff among the users that still have to
+// be fetched, so each of them can be served from its own cutoff; users with a
+// reusable checkpoint do not count, and if there are none nothing is walked.
+// Every account of a member with several accounts is collected.
+func (p *batchUserProcessor) collectRepositories(
+	ctx context.Context,
+	fetcher *infrastructure.GitHubDataFetcher,
+	manifest *infrastructure.RunManifest,
+	concurrency int,
+) error {
+	var (
+		since   time.Time
+		pending []string
+	)
+
+	for _, user := range manifest.Users {
+		cutoff := p.cutoff(user)
+		if cp, ok := p.checkpoints[user]; ok && cp.Cutoff.Equal(cutoff) {
 			continue
 		}
 
-		members = append(members, stats)
+		if len(pending) == 0 || cutoff.Before(since) {
+			since = cutoff
+		}
+
+		pending = append(pending, user)
+	}
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	accounts := expandAccounts(pending, p.identities)
+
+	collected, err := collectOrganization(ctx, fetcher, manifest.Org, accounts, manifest.IncludePrivate, since, concurrency)
+	if err != nil {
+		return err
+	}
+
+	p.source = withIdentities(collected, p.identities)
+
+	return nil
+}
+
+// fetch returns the user's activity since cutoff, from the resumed run's
+// checkpoint when it is still valid and from GitHub otherwise. A freshly
+// fetched result is checkpointed; a failed checkpoint write only costs a
+// re-fetch on resume, so it is logged rather than failing the user.
+func (p *batchUserProcessor) fetch(ctx context.Context, user string, cutoff time.Time) (*infrastructure.UserActivityData, error) {
+	if cp, ok := p.checkpoints[user]; ok && cp.Cutoff.Equal(cutoff) {
+		fmt.Printf("Processing user: %s (from checkpoint fetched at %s)\n", user, cp.FetchedAt.Format(time.RFC3339))
+
+		return cp.Data, nil
+	}
+
+	if cutoff.IsZero() {
+		fmt.Printf("Processing user: %s\n", user)
+	} else {
+		fmt.Printf("Processing user: %s (incremental since %s)\n", user, cutoff.Format(time.DateOnly))
+	}
+
+	data, err := p.source.FetchUserActivitySince(ctx, user, p.includePrivate, cutoff)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch user activity: %w", err)
+	}
 
-		fmt.Printf("Completed processing user: %s\n", user)
+	cp := &infrastructure.UserCheckpoint{Login: user, Cutoff: cutoff, FetchedAt: time.Now(), Data: data}
+	if err := p.store.SaveUser(cp); err != nil {
+		log.Printf("Failed to checkpoint user %s: %v", user, err)
 	}
 
-	return members
+	return data, nil
 }
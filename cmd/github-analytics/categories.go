@@ -0,0 +1,100 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/Tattsum/github-analytics/domain"
+)
+
+// prCategoryFile is the layout of the -pr-categories file. Each category
+// replaces only the lists it sets; the others keep their defaults, and an
+// empty list turns that kind of match off:
+//
+//	categories:
+//	  bug:
+//	    labels: [bug, incident]
+//	    prefixes: [fix, hotfix]
+//	  docs:
+//	    paths: ["docs/**", "*.md", "*.adoc"]
+//	  chore:
+//	    paths: []
+type prCategoryFile struct {
+	Categories map[string]struct {
+		Labels   *[]string `yaml:"labels"`
+		Prefixes *[]string `yaml:"prefixes"`
+		Paths    *[]string `yaml:"paths"`
+	} `yaml:"categories"`
+}
+
+// loadPRCategories reads the -pr-categories file at path and returns the
+// complete rule set, the defaults with the file's lists applied. An empty path
+// yields nil, which classifies with the default rules.
+func loadPRCategories(path string) ([]domain.WorkCategoryRule, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	raw, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read -pr-categories file: %w", err)
+	}
+
+	dec := yaml.NewDecoder(bytes.NewReader(raw))
+	dec.KnownFields(true)
+
+	var file prCategoryFile
+	if err := dec.Decode(&file); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to parse -pr-categories file %s: %w", path, err)
+	}
+
+	rules := domain.DefaultWorkCategoryRules()
+
+	// Sorted so that the first error is stable.
+	for _, name := range slices.Sorted(maps.Keys(file.Categories)) {
+		category, err := domain.ParseWorkCategory(name)
+		if err != nil {
+			return nil, fmt.Errorf("-pr-categories file %s: %w", path, err)
+		}
+
+		override := file.Categories[name]
+
+		i := slices.IndexFunc(rules, func(rule domain.WorkCategoryRule) bool { return rule.Category == category })
+		if override.Labels != nil {
+			rules[i].Labels = *override.Labels
+		}
+
+		if override.Prefixes != nil {
+			rules[i].TitlePrefixes = *override.Prefixes
+		}
+
+		if override.Paths != nil {
+			rules[i].Paths = *override.Paths
+		}
+	}
+
+	if _, err := domain.NewPRClassifier(rules); err != nil {
+		return nil, fmt.Errorf("-pr-categories file %s: %w", path, err)
+	}
+
+	return rules, nil
+}
+
+// prClassifier builds the classifier of a run from its rules; nil rules use
+// the defaults.
+func prClassifier(rules []domain.WorkCategoryRule) (*domain.PRClassifier, error) {
+	classifier, err := domain.NewPRClassifier(rules)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pull request categories: %w", err)
+	}
+
+	return classifier, nil
+}
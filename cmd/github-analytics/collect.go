@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Tattsum/github-analytics/infrastructure"
+)
+
+// Collection modes selected with -collect.
+const (
+	// collectUser fetches each member's contributions with one set of queries
+	// per member.
+	collectUser = "user"
+	// collectRepository walks every repository of -org once and attributes
+	// its commits, pull requests, reviews and issues to the members.
+	collectRepository = "repository"
+)
+
+var (
+	// errInvalidCollect is returned for an unknown -collect value.
+	errInvalidCollect = errors.New("-collect must be user or repository")
+	// errCollectRequiresOrg is returned when repository collection has no
+	// organization whose repositories it could walk.
+	errCollectRequiresOrg = errors.New("-collect repository requires exactly one -org")
+	// errInvalidLookbackYears is returned for a negative -lookback-years.
+	errInvalidLookbackYears = errors.New("-lookback-years must not be negative")
+)
+
+// activitySource returns one member's activity since a cutoff. The per-user
+// GitHub fetcher and a repository-centric collection both satisfy it, so the
+// rest of the pipeline does not depend on the collection mode.
+type activitySource interface {
+	FetchUserActivitySince(ctx context.Context, login string, includePrivate bool, since time.Time) (*infrastructure.UserActivityData, error)
+}
+
+// collectOrganizationName checks the -collect flag against -org and returns
+// the organization whose repositories repository collection walks, or "" for
+// user collection.
+func collectOrganizationName(collect string, orgs []string) (string, error) {
+	switch collect {
+	case collectUser:
+		return "", nil
+	case collectRepository:
+		if len(orgs) != 1 {
+			return "", errCollectRequiresOrg
+		}
+
+		return orgs[0], nil
+	default:
+		return "", fmt.Errorf("%w, got %q", errInvalidCollect, collect)
+	}
+}
+
+// validateLookbackYears checks the -lookback-years flag value; 0 keeps the
+// default lookback.
+func validateLookbackYears(years int) error {
+	if years < 0 {
+		return fmt.Errorf("%w, got %d", errInvalidLookbackYears, years)
+	}
+
+	return nil
+}
+
+// collectOrganization walks the repositories of org once for all users,
+// covering activity since the given cutoff (zero for the full lookback).
+func collectOrganization(
+	ctx context.Context,
+	fetcher *infrastructure.GitHubDataFetcher,
+	org string,
+	users []string,
+	includePrivate bool,
+	since time.Time,
+	concurrency int,
+) (*infrastructure.OrganizationActivity, error) {
+	if since.IsZero() {
+		fmt.Printf("Collecting activity of %d members from the repositories of %s\n", len(users), org)
+	} else {
+		fmt.Printf("Collecting activity of %d members from the repositories of %s since %s\n",
+			len(users), org, since.Format(time.DateOnly))
+	}
+
+	collected, err := fetcher.CollectOrganizationActivity(ctx, org, users, includePrivate, since, concurrency)
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect organization activity: %w", err)
+	}
+
+	fmt.Printf("Walked %d repositories of %s\n", collected.Repositories, org)
+
+	return collected, nil
+}
@@ -0,0 +1,496 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+
+	"github.com/Tattsum/github-analytics/presentation"
+)
+
+var (
+	// errInvalidConfig is returned for a -config value of the wrong type or
+	// out of range; the message starts with the offending key.
+	errInvalidConfig = errors.New("invalid -config value")
+	// errUnknownConfigKey is returned for a -config key that is not supported.
+	errUnknownConfigKey = errors.New("unknown -config key")
+	// errUnsetConfigVariable is returned when a -config value references an
+	// environment variable that is not set.
+	errUnsetConfigVariable = errors.New("environment variable is not set")
+)
+
+// configFile is the content of the -config file. Every key stands for a flag
+// and only applies when that flag is not given on the command line:
+//
+//	orgs: [acme]                  # -org
+//	teams: [platform, acme/infra] # -team
+//	users: [alice]                # -users
+//	private: true                 # -private
+//	lookback_years: 5             # -lookback-years
+//	since: 2025-04-01             # -since
+//	until: 2026-03-31             # -until
+//	outputs: [json, text]         # -formats
+//	output_dir: reports           # -output
+//	identities: identities.yaml   # -identities
+//	time_zone: Asia/Tokyo         # -time-zone
+//	pr_categories: categories.yaml # -pr-categories
+//	database_url: ${DATABASE_URL} # -database-url
+//	exclusions:
+//	  logins: [renovate]          # -exclude-logins
+//	  patterns: ['^ci-']          # -exclude-pattern
+//	  bots: true                  # -exclude-bots
+//	issue_labels:
+//	  bug: [bug, incident]        # -bug-labels
+//	  feature: [enhancement]      # -feature-labels
+//
+// String values may reference environment variables as $NAME or ${NAME};
+// "$$" stands for a literal "$".
+type configFile struct {
+	Orgs          []string
+	Teams         []string
+	Users         []string
+	Private       *bool
+	LookbackYears *int
+	Since         string
+	Until         string
+	Outputs       []string
+	OutputDir     string
+	Identities    string
+	TimeZone      string
+	PRCategories  string
+	DatabaseURL   string
+	Exclusions    configExclusions
+	IssueLabels   configIssueLabels
+}
+
+// configExclusions is the exclusions table of the -config file.
+type configExclusions struct {
+	Logins   []string
+	Patterns []string
+	Bots     *bool
+}
+
+// configIssueLabels is the issue_labels table of the -config file.
+type configIssueLabels struct {
+	Bug     []string
+	Feature []string
+}
+
+// loadConfigFile reads the -config file at path. Files ending in .toml are
+// read as TOML and everything else as YAML.
+func loadConfigFile(path string) (*configFile, error) {
+	raw, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read -config file: %w", err)
+	}
+
+	doc := map[string]any{}
+	if strings.EqualFold(filepath.Ext(path), ".toml") {
+		_, err = toml.Decode(string(raw), &doc)
+	} else {
+		err = yaml.Unmarshal(raw, &doc)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse -config file %s: %w", path, err)
+	}
+
+	cfg := &configFile{}
+	if err := cfg.bind(doc); err != nil {
+		return nil, fmt.Errorf("-config file %s: %w", path, err)
+	}
+
+	if err := cfg.validate(); err != nil {
+		return nil, fmt.Errorf("-config file %s: %w", path, err)
+	}
+
+	return cfg, nil
+}
+
+// bind copies the decoded document into c, expanding environment variables.
+// Keys are visited in sorted order so that the first error is stable.
+func (c *configFile) bind(doc map[string]any) error {
+	for _, key := range slices.Sorted(maps.Keys(doc)) {
+		value := doc[key]
+
+		var err error
+
+		switch key {
+		case "orgs":
+			c.Orgs, err = configStrings(key, value)
+		case "teams":
+			c.Teams, err = configStrings(key, value)
+		case "users":
+			c.Users, err = configStrings(key, value)
+		case "private":
+			c.Private, err = configBool(key, value)
+		case "lookback_years":
+			c.LookbackYears, err = configInt(key, value)
+		case "since":
+			c.Since, err = configDate(key, value)
+		case "until":
+			c.Until, err = configDate(key, value)
+		case "outputs":
+			c.Outputs, err = configStrings(key, value)
+		case "output_dir":
+			c.OutputDir, err = configString(key, value)
+		case "identities":
+			c.Identities, err = configString(key, value)
+		case "time_zone":
+			c.TimeZone, err = configString(key, value)
+		case "pr_categories":
+			c.PRCategories, err = configString(key, value)
+		case "database_url":
+			c.DatabaseURL, err = configString(key, value)
+		case "exclusions":
+			err = c.Exclusions.bind(key, value)
+		case "issue_labels":
+			err = c.IssueLabels.bind(key, value)
+		default:
+			err = fmt.Errorf("%w: %s", errUnknownConfigKey, key)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// bind copies the exclusions table into e.
+func (e *configExclusions) bind(path string, value any) error {
+	table, ok := value.(map[string]any)
+	if !ok {
+		return fmt.Errorf("%w: %s: want a table with logins, patterns and bots", errInvalidConfig, path)
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(table)) {
+		keyPath := path + "." + key
+
+		var err error
+
+		switch key {
+		case "logins":
+			e.Logins, err = configStrings(keyPath, table[key])
+		case "patterns":
+			e.Patterns, err = configStrings(keyPath, table[key])
+		case "bots":
+			e.Bots, err = configBool(keyPath, table[key])
+		default:
+			err = fmt.Errorf("%w: %s", errUnknownConfigKey, keyPath)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// bind copies the issue_labels table into l.
+func (l *configIssueLabels) bind(path string, value any) error {
+	table, ok := value.(map[string]any)
+	if !ok {
+		return fmt.Errorf("%w: %s: want a table with bug and feature", errInvalidConfig, path)
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(table)) {
+		keyPath := path + "." + key
+
+		var err error
+
+		switch key {
+		case "bug":
+			l.Bug, err = configStrings(keyPath, table[key])
+		case "feature":
+			l.Feature, err = configStrings(keyPath, table[key])
+		default:
+			err = fmt.Errorf("%w: %s", errUnknownConfigKey, keyPath)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validate checks the values that the flags would only reject later, or not
+// at all, so that the error still names the key.
+func (c *configFile) validate() error {
+	if c.LookbackYears != nil && *c.LookbackYears <= 0 {
+		return fmt.Errorf("%w: lookback_years: must be a positive number of years, got %d", errInvalidConfig, *c.LookbackYears)
+	}
+
+	if err := c.validatePeriod(); err != nil {
+		return err
+	}
+
+	if _, err := presentation.ParseOutputFormats(c.Outputs); err != nil {
+		return fmt.Errorf("%w: outputs: %w", errInvalidConfig, err)
+	}
+
+	for i, pattern := range c.Exclusions.Patterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("%w: exclusions.patterns[%d]: %w", errInvalidConfig, i, err)
+		}
+	}
+
+	return nil
+}
+
+// validatePeriod checks the since and until dates.
+func (c *configFile) validatePeriod() error {
+	since, err := parsePeriodDate("since", c.Since)
+	if err != nil {
+		return fmt.Errorf("%w: since: %w", errInvalidConfig, err)
+	}
+
+	until, err := parsePeriodDate("until", c.Until)
+	if err != nil {
+		return fmt.Errorf("%w: until: %w", errInvalidConfig, err)
+	}
+
+	if !since.IsZero() && !until.IsZero() && until.Before(since) {
+		return fmt.Errorf("%w: until: %s is before since %s", errInvalidConfig, c.Until, c.Since)
+	}
+
+	return nil
+}
+
+// configFlag is a flag that a -config key stands for, with the values to set
+// it to. A repeatable flag is set once per value.
+type configFlag struct {
+	key    string
+	name   string
+	values []string
+}
+
+// flags lists the flags the file sets; keys that are absent are left out.
+func (c *configFile) flags() []configFlag {
+	var out []configFlag
+
+	add := func(key, name string, values ...string) {
+		if len(values) > 0 {
+			out = append(out, configFlag{key: key, name: name, values: values})
+		}
+	}
+
+	list := func(values []string) []string {
+		if len(values) == 0 {
+			return nil
+		}
+
+		return []string{strings.Join(values, ",")}
+	}
+
+	str := func(value string) []string {
+		if value == "" {
+			return nil
+		}
+
+		return []string{value}
+	}
+
+	add("orgs", "org", list(c.Orgs)...)
+	add("teams", "team", list(c.Teams)...)
+	add("users", "users", list(c.Users)...)
+	add("outputs", "formats", list(c.Outputs)...)
+	add("since", "since", str(c.Since)...)
+	add("until", "until", str(c.Until)...)
+	add("output_dir", "output", str(c.OutputDir)...)
+	add("identities", "identities", str(c.Identities)...)
+	add("time_zone", "time-zone", str(c.TimeZone)...)
+	add("pr_categories", "pr-categories", str(c.PRCategories)...)
+	add("database_url", "database-url", str(c.DatabaseURL)...)
+	add("exclusions.logins", "exclude-logins", list(c.Exclusions.Logins)...)
+	add("exclusions.patterns", "exclude-pattern", c.Exclusions.Patterns...)
+	add("issue_labels.bug", "bug-labels", list(c.IssueLabels.Bug)...)
+	add("issue_labels.feature", "feature-labels", list(c.IssueLabels.Feature)...)
+
+	if c.Private != nil {
+		add("private", "private", strconv.FormatBool(*c.Private))
+	}
+
+	if c.LookbackYears != nil {
+		add("lookback_years", "lookback-years", strconv.Itoa(*c.LookbackYears))
+	}
+
+	if c.Exclusions.Bots != nil {
+		add("exclusions.bots", "exclude-bots", strconv.FormatBool(*c.Exclusions.Bots))
+	}
+
+	return out
+}
+
+// rosterConfigFlags are the flags that select the members. A resumed batch
+// takes its members from the run's manifest, so the file does not set them
+// when -resume is given.
+var rosterConfigFlags = []string{"org", "team", "users", "identities"}
+
+// applyConfigFile loads the -config file at path, if any, and sets every flag
+// of fs it describes that was not given on the command line. It must run
+// after fs is parsed and before any flag value is read.
+func applyConfigFile(fs *flag.FlagSet, path string) error {
+	if path == "" {
+		return nil
+	}
+
+	cfg, err := loadConfigFile(path)
+	if err != nil {
+		return err
+	}
+
+	explicit := make(map[string]bool)
+	fs.Visit(func(f *flag.Flag) { explicit[f.Name] = true })
+
+	for _, f := range cfg.flags() {
+		if explicit[f.name] || (explicit["resume"] && slices.Contains(rosterConfigFlags, f.name)) {
+			continue
+		}
+
+		for _, value := range f.values {
+			if err := fs.Set(f.name, value); err != nil {
+				return fmt.Errorf("%w: %s: %w", errInvalidConfig, f.key, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// configString returns a string value with environment variables expanded.
+func configString(path string, value any) (string, error) {
+	s, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("%w: %s: want a string, got %v", errInvalidConfig, path, value)
+	}
+
+	return expandConfigEnv(path, s)
+}
+
+// configStrings returns a list of non-empty strings. A single string is
+// accepted as a one-element list.
+func configStrings(path string, value any) ([]string, error) {
+	items, ok := value.([]any)
+	if !ok {
+		items = []any{value}
+	}
+
+	out := make([]string, 0, len(items))
+
+	for i, item := range items {
+		itemPath := fmt.Sprintf("%s[%d]", path, i)
+
+		s, err := configString(itemPath, item)
+		if err != nil {
+			return nil, err
+		}
+
+		if strings.TrimSpace(s) == "" {
+			return nil, fmt.Errorf("%w: %s: must not be empty", errInvalidConfig, itemPath)
+		}
+
+		out = append(out, strings.TrimSpace(s))
+	}
+
+	return out, nil
+}
+
+// configBool returns a boolean value; a string is expanded and parsed.
+func configBool(path string, value any) (*bool, error) {
+	if b, ok := value.(bool); ok {
+		return &b, nil
+	}
+
+	s, err := configString(path, value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: want true or false, got %v", errInvalidConfig, path, value)
+	}
+
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: want true or false, got %q", errInvalidConfig, path, s)
+	}
+
+	return &b, nil
+}
+
+// configDate returns a date value as written by -since and -until. YAML and
+// TOML dates are accepted as well as strings, which are expanded.
+func configDate(path string, value any) (string, error) {
+	if t, ok := value.(time.Time); ok {
+		return t.Format(periodDateLayout), nil
+	}
+
+	s, err := configString(path, value)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s: want a date such as 2025-04-01, got %v", errInvalidConfig, path, value)
+	}
+
+	return s, nil
+}
+
+// configInt returns an integer value; a string is expanded and parsed.
+func configInt(path string, value any) (*int, error) {
+	switch v := value.(type) {
+	case int:
+		return &v, nil
+	case int64:
+		n := int(v)
+
+		return &n, nil
+	}
+
+	s, err := configString(path, value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: want an integer, got %v", errInvalidConfig, path, value)
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: want an integer, got %q", errInvalidConfig, path, s)
+	}
+
+	return &n, nil
+}
+
+// expandConfigEnv expands $NAME and ${NAME} in s. A variable that is not set
+// is an error rather than an empty string, so that a missing secret is not
+// silently turned into an empty value.
+func expandConfigEnv(path, s string) (string, error) {
+	var missing []string
+
+	expanded := os.Expand(s, func(name string) string {
+		if name == "$" {
+			return "$"
+		}
+
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			missing = append(missing, name)
+		}
+
+		return value
+	})
+
+	if len(missing) > 0 {
+		return "", fmt.Errorf("%w: %s: %w: %s", errInvalidConfig, path, errUnsetConfigVariable, strings.Join(missing, ", "))
+	}
+
+	return expanded, nil
+}
@@ -0,0 +1,418 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// writeConfigFile writes content to a -config file named name in a temporary
+// directory and returns its path.
+func writeConfigFile(t *testing.T, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	return path
+}
+
+func TestLoadConfigFile_YAMLAndTOMLParity(t *testing.T) {
+	t.Setenv("GA_CONFIG_TEST_DSN", "postgres://localhost/analytics")
+
+	yamlPath := writeConfigFile(t, "config.yaml", `
+orgs: [acme]
+teams: [platform, acme/infra]
+users: alice
+private: true
+lookback_years: 5
+since: 2025-04-01
+until: "2026-03-31"
+outputs: [json, text]
+output_dir: reports
+identities: identities.yaml
+time_zone: Asia/Tokyo
+pr_categories: categories.yaml
+database_url: ${GA_CONFIG_TEST_DSN}?password=$$ecret
+exclusions:
+  logins: [renovate]
+  patterns: ['^ci-']
+  bots: "true"
+issue_labels:
+  bug: [bug, incident]
+  feature: [enhancement]
+`)
+
+	tomlPath := writeConfigFile(t, "config.toml", `
+orgs = ["acme"]
+teams = ["platform", "acme/infra"]
+users = "alice"
+private = true
+lookback_years = 5
+since = 2025-04-01
+until = "2026-03-31"
+outputs = ["json", "text"]
+output_dir = "reports"
+identities = "identities.yaml"
+time_zone = "Asia/Tokyo"
+pr_categories = "categories.yaml"
+database_url = "${GA_CONFIG_TEST_DSN}?password=$$ecret"
+
+[exclusions]
+logins = ["renovate"]
+patterns = ["^ci-"]
+bots = "true"
+
+[issue_labels]
+bug = ["bug", "incident"]
+feature = ["enhancement"]
+`)
+
+	private, lookbackYears, bots := true, 5, true
+	want := &configFile{
+		Orgs:          []string{"acme"},
+		Teams:         []string{"platform", "acme/infra"},
+		Users:         []string{"alice"},
+		Private:       &private,
+		LookbackYears: &lookbackYears,
+		Since:         "2025-04-01",
+		Until:         "2026-03-31",
+		Outputs:       []string{"json", "text"},
+		OutputDir:     "reports",
+		Identities:    "identities.yaml",
+		TimeZone:      "Asia/Tokyo",
+		PRCategories:  "categories.yaml",
+		DatabaseURL:   "postgres://localhost/analytics?password=$ecret",
+		Exclusions:    configExclusions{Logins: []string{"renovate"}, Patterns: []string{"^ci-"}, Bots: &bots},
+		IssueLabels:   configIssueLabels{Bug: []string{"bug", "incident"}, Feature: []string{"enhancement"}},
+	}
+
+	for _, path := range []string{yamlPath, tomlPath} {
+		got, err := loadConfigFile(path)
+		if err != nil {
+			t.Fatalf("loadConfigFile(%s): %v", filepath.Base(path), err)
+		}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("loadConfigFile(%s) = %+v, want %+v", filepath.Base(path), got, want)
+		}
+	}
+}
+
+func TestLoadConfigFile_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		file    string
+		content string
+		wantErr error
+		// wantKey is the key path the error message must name.
+		wantKey string
+	}{
+		{
+			name:    "unknown key",
+			file:    "config.yaml",
+			content: "colour: red\n",
+			wantErr: errUnknownConfigKey,
+			wantKey: "colour",
+		},
+		{
+			name:    "unknown nested key",
+			file:    "config.yaml",
+			content: "exclusions:\n  robots: true\n",
+			wantErr: errUnknownConfigKey,
+			wantKey: "exclusions.robots",
+		},
+		{
+			name:    "unknown key in TOML",
+			file:    "config.toml",
+			content: "[issue_labels]\nchore = [\"chore\"]\n",
+			wantErr: errUnknownConfigKey,
+			wantKey: "issue_labels.chore",
+		},
+		{
+			name:    "string where a list item is expected",
+			file:    "config.yaml",
+			content: "orgs: [acme, 3]\n",
+			wantErr: errInvalidConfig,
+			wantKey: "orgs[1]",
+		},
+		{
+			name:    "empty list item",
+			file:    "config.yaml",
+			content: "issue_labels:\n  bug: [bug, \" \"]\n",
+			wantErr: errInvalidConfig,
+			wantKey: "issue_labels.bug[1]",
+		},
+		{
+			name:    "list where a boolean is expected",
+			file:    "config.yaml",
+			content: "private: [yes]\n",
+			wantErr: errInvalidConfig,
+			wantKey: "private",
+		},
+		{
+			name:    "string that is not a boolean",
+			file:    "config.toml",
+			content: "[exclusions]\nbots = \"maybe\"\n",
+			wantErr: errInvalidConfig,
+			wantKey: "exclusions.bots",
+		},
+		{
+			name:    "string that is not an integer",
+			file:    "config.yaml",
+			content: "lookback_years: many\n",
+			wantErr: errInvalidConfig,
+			wantKey: "lookback_years",
+		},
+		{
+			name:    "value where a table is expected",
+			file:    "config.yaml",
+			content: "exclusions: true\n",
+			wantErr: errInvalidConfig,
+			wantKey: "exclusions",
+		},
+		{
+			name:    "number where a string is expected",
+			file:    "config.toml",
+			content: "output_dir = 3\n",
+			wantErr: errInvalidConfig,
+			wantKey: "output_dir",
+		},
+		{
+			name:    "unset environment variable",
+			file:    "config.yaml",
+			content: "database_url: ${GA_CONFIG_TEST_UNSET}\n",
+			wantErr: errUnsetConfigVariable,
+			wantKey: "database_url",
+		},
+		{
+			name:    "non-positive lookback",
+			file:    "config.toml",
+			content: "lookback_years = 0\n",
+			wantErr: errInvalidConfig,
+			wantKey: "lookback_years",
+		},
+		{
+			name:    "until before since",
+			file:    "config.yaml",
+			content: "since: 2025-04-01\nuntil: 2025-03-31\n",
+			wantErr: errInvalidConfig,
+			wantKey: "until",
+		},
+		{
+			name:    "malformed date",
+			file:    "config.yaml",
+			content: "since: April\n",
+			wantErr: errInvalidPeriodDate,
+			wantKey: "since",
+		},
+		{
+			name:    "unknown output format",
+			file:    "config.yaml",
+			content: "outputs: [json, pdf]\n",
+			wantErr: errInvalidConfig,
+			wantKey: "outputs",
+		},
+		{
+			name:    "invalid pattern",
+			file:    "config.yaml",
+			content: "exclusions:\n  patterns: ['^ci-', '(']\n",
+			wantErr: errInvalidConfig,
+			wantKey: "exclusions.patterns[1]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := loadConfigFile(writeConfigFile(t, tt.file, tt.content))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+
+			if !strings.Contains(err.Error(), ": "+tt.wantKey) {
+				t.Errorf("err = %q, want it to name %s", err, tt.wantKey)
+			}
+		})
+	}
+}
+
+func TestExpandConfigEnv(t *testing.T) {
+	t.Setenv("GA_CONFIG_TEST_TOKEN", "s3cret")
+
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{name: "braced variable", value: "${GA_CONFIG_TEST_TOKEN}", want: "s3cret"},
+		{name: "bare variable", value: "token=$GA_CONFIG_TEST_TOKEN;", want: "token=s3cret;"},
+		{name: "escaped dollar", value: "$$GA_CONFIG_TEST_TOKEN", want: "$GA_CONFIG_TEST_TOKEN"},
+		{name: "escaped dollar before a variable", value: "$$${GA_CONFIG_TEST_TOKEN}", want: "$s3cret"},
+		{name: "no variables", value: "reports", want: "reports"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := expandConfigEnv("key", tt.value)
+			if err != nil {
+				t.Fatalf("expandConfigEnv(%q): %v", tt.value, err)
+			}
+
+			if got != tt.want {
+				t.Errorf("expandConfigEnv(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+
+	_, err := expandConfigEnv("key", "$GA_CONFIG_TEST_UNSET_A-${GA_CONFIG_TEST_UNSET_B}")
+	if !errors.Is(err, errUnsetConfigVariable) || !strings.Contains(err.Error(), "GA_CONFIG_TEST_UNSET_A, GA_CONFIG_TEST_UNSET_B") {
+		t.Errorf("unset variables: err = %v, want errUnsetConfigVariable naming both", err)
+	}
+}
+
+// configTestFlags is a flag set with the flags the applyConfigFile tests
+// configure, and the values it parsed.
+type configTestFlags struct {
+	set      *flag.FlagSet
+	org      *string
+	users    *string
+	formats  *string
+	private  *bool
+	patterns []string
+}
+
+// newConfigTestFlags defines the flags on a new flag set and parses args.
+func newConfigTestFlags(t *testing.T, args ...string) *configTestFlags {
+	t.Helper()
+
+	fs := flag.NewFlagSet("github-analytics", flag.ContinueOnError)
+	flags := &configTestFlags{
+		set:     fs,
+		org:     fs.String("org", "", ""),
+		users:   fs.String("users", "", ""),
+		formats: fs.String("formats", "", ""),
+		private: fs.Bool("private", false, ""),
+	}
+
+	fs.String("resume", "", "")
+	fs.Func("exclude-pattern", "", func(v string) error {
+		flags.patterns = append(flags.patterns, v)
+
+		return nil
+	})
+
+	if err := fs.Parse(args); err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	return flags
+}
+
+func TestApplyConfigFile(t *testing.T) {
+	t.Parallel()
+
+	const content = `
+orgs: [acme, widgets]
+users: [alice]
+outputs: [json]
+private: true
+exclusions:
+  patterns: ['^ci-', '^svc-']
+`
+
+	tests := []struct {
+		name         string
+		args         []string
+		wantOrg      string
+		wantUsers    string
+		wantFormats  string
+		wantPrivate  bool
+		wantPatterns []string
+	}{
+		{
+			name:         "file fills unset flags",
+			wantOrg:      "acme,widgets",
+			wantUsers:    "alice",
+			wantFormats:  "json",
+			wantPrivate:  true,
+			wantPatterns: []string{"^ci-", "^svc-"},
+		},
+		{
+			name:         "command line beats the file",
+			args:         []string{"-formats", "text", "-private=false", "-exclude-pattern", "^bot-"},
+			wantOrg:      "acme,widgets",
+			wantUsers:    "alice",
+			wantFormats:  "text",
+			wantPrivate:  false,
+			wantPatterns: []string{"^bot-"},
+		},
+		{
+			name:         "resume keeps the roster of the run",
+			args:         []string{"-resume", "run-1"},
+			wantFormats:  "json",
+			wantPrivate:  true,
+			wantPatterns: []string{"^ci-", "^svc-"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			flags := newConfigTestFlags(t, tt.args...)
+
+			if err := applyConfigFile(flags.set, writeConfigFile(t, "config.yaml", content)); err != nil {
+				t.Fatalf("applyConfigFile: %v", err)
+			}
+
+			if *flags.org != tt.wantOrg || *flags.users != tt.wantUsers || *flags.formats != tt.wantFormats {
+				t.Errorf("-org %q -users %q -formats %q, want %q %q %q",
+					*flags.org, *flags.users, *flags.formats, tt.wantOrg, tt.wantUsers, tt.wantFormats)
+			}
+
+			if *flags.private != tt.wantPrivate {
+				t.Errorf("-private = %v, want %v", *flags.private, tt.wantPrivate)
+			}
+
+			if !reflect.DeepEqual(flags.patterns, tt.wantPatterns) {
+				t.Errorf("-exclude-pattern = %q, want %q", flags.patterns, tt.wantPatterns)
+			}
+		})
+	}
+}
+
+func TestApplyConfigFile_NoFile(t *testing.T) {
+	t.Parallel()
+
+	flags := newConfigTestFlags(t)
+
+	if err := applyConfigFile(flags.set, ""); err != nil {
+		t.Fatalf("applyConfigFile: %v", err)
+	}
+
+	if *flags.org != "" || *flags.formats != "" {
+		t.Errorf("-org %q -formats %q, want both unset", *flags.org, *flags.formats)
+	}
+}
+
+func TestApplyConfigFile_FlagRejectsValue(t *testing.T) {
+	t.Parallel()
+
+	flags := newConfigTestFlags(t)
+
+	// A flag that cannot take the value is reported with the key that set it.
+	err := applyConfigFile(flags.set, writeConfigFile(t, "config.yaml", "orgs: [acme]\nidentities: ids.yaml\n"))
+	if !errors.Is(err, errInvalidConfig) || !strings.Contains(err.Error(), "identities:") {
+		t.Errorf("err = %v, want errInvalidConfig naming identities", err)
+	}
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/Tattsum/github-analytics/application"
+	"github.com/Tattsum/github-analytics/domain"
+	"github.com/Tattsum/github-analytics/infrastructure"
+)
+
+// collectDeliveryWeeks fetches the deployments, releases and tags of every
+// repository the members merged pull requests into and computes their weekly
+// delivery metrics; it returns nil unless enabled. A repository that cannot be
+// fetched is logged and left out rather than failing the run.
+func collectDeliveryWeeks(
+	ctx context.Context,
+	fetcher *infrastructure.GitHubDataFetcher,
+	enabled bool,
+	members []*domain.UserStatistics,
+) []*domain.DeliveryWeek {
+	if !enabled {
+		return nil
+	}
+
+	repos := application.DeliveryRepositories(members)
+	fmt.Printf("Fetching deployments, releases and tags of %d repositories\n", len(repos))
+
+	deliveries := make([]*domain.RepositoryDeliveries, 0, len(repos))
+
+	for _, repo := range repos {
+		d, err := fetcher.FetchRepositoryDeliveries(ctx, repo)
+		if err != nil {
+			log.Printf("Skipping delivery metrics of %s: %v", repo, err)
+
+			continue
+		}
+
+		deliveries = append(deliveries, d)
+	}
+
+	return application.CalculateDeliveryWeeks(deliveries, members)
+}
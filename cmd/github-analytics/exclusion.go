@@ -0,0 +1,81 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/Tattsum/github-analytics/domain"
+)
+
+// exclusionFlags holds the flags that leave bots and service accounts out of
+// the roster and of the review counts.
+type exclusionFlags struct {
+	logins   *string
+	patterns []string
+	bots     *bool
+}
+
+// registerExclusionFlags defines the exclusion flags on the default flag set.
+// -exclude-pattern may be repeated, one regular expression per flag, so that
+// patterns can contain commas.
+func registerExclusionFlags() *exclusionFlags {
+	f := &exclusionFlags{
+		logins: flag.String("exclude-logins", "", "集計から除外するアカウント（カンマ区切り、大文字小文字を区別しない。例: renovate,ci-user）"),
+		bots:   flag.Bool("exclude-bots", false, "GitHub の Bot アカウント（dependabot[bot] など）が作成したPRへのレビューを集計から除外する"),
+	}
+
+	flag.Func("exclude-pattern", "ログイン名がこの正規表現に一致するアカウントを集計から除外する（複数指定可。例: -exclude-pattern '^svc-'）", func(v string) error {
+		f.patterns = append(f.patterns, v)
+
+		return nil
+	})
+
+	return f
+}
+
+// exclusionRules is the plain form of the exclusion flags. It is kept in the
+// run manifest so that a resumed batch applies the same rules.
+type exclusionRules struct {
+	logins   []string
+	patterns []string
+	bots     bool
+}
+
+// rules returns the parsed exclusion flags.
+func (f *exclusionFlags) rules() exclusionRules {
+	var logins []string
+	if *f.logins != "" {
+		logins = splitUsers(*f.logins)
+	}
+
+	return exclusionRules{logins: logins, patterns: f.patterns, bots: *f.bots}
+}
+
+// build compiles the rules.
+func (r exclusionRules) build() (*domain.ActorExclusion, error) {
+	exclusion, err := domain.NewActorExclusion(r.logins, r.patterns, r.bots)
+	if err != nil {
+		return nil, fmt.Errorf("invalid -exclude-pattern: %w", err)
+	}
+
+	return exclusion, nil
+}
+
+// excludeFromRoster drops the accounts matching exclusion from users and
+// reports which ones were dropped and why.
+func excludeFromRoster(users []string, exclusion *domain.ActorExclusion) ([]string, []*domain.ExcludedActor) {
+	kept, excluded := exclusion.FilterLogins(users)
+	if len(excluded) == 0 {
+		return kept, excluded
+	}
+
+	described := make([]string, 0, len(excluded))
+	for _, actor := range excluded {
+		described = append(described, fmt.Sprintf("%s (%s)", actor.Login, actor.Rule))
+	}
+
+	fmt.Printf("Excluded %d accounts from the roster: %s\n", len(excluded), strings.Join(described, ", "))
+
+	return kept, excluded
+}
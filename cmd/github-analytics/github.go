@@ -0,0 +1,183 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Tattsum/github-analytics/infrastructure"
+)
+
+var (
+	errMissingGitHubCredentials = errors.New("GITHUB_TOKEN environment variable is not set. Please set your GitHub Personal Access Token, or configure a GitHub App installation")
+	errInvalidGitHubAppID       = errors.New("invalid GitHub App ID")
+)
+
+// githubFlags holds the command-line flags for connecting to GitHub.
+type githubFlags struct {
+	apiURL            *string
+	caBundle          *string
+	appID             *string
+	appInstallationID *string
+	appPrivateKey     *string
+}
+
+// registerGitHubFlags defines the GitHub connection flags on the default flag set.
+func registerGitHubFlags() *githubFlags {
+	return &githubFlags{
+		apiURL:            flag.String("github-url", "", "GitHub Enterprise Server の API URL（例: https://ghes.example.com/api/v3。省略時は環境変数 GITHUB_API_URL、未設定なら github.com）"),
+		caBundle:          flag.String("github-ca-bundle", "", "GitHub Enterprise Server の証明書を検証する CA バンドル（PEM）のパス（省略時は環境変数 GITHUB_CA_BUNDLE）"),
+		appID:             flag.String("github-app-id", "", "GitHub App として認証する場合の App ID（省略時は環境変数 GITHUB_APP_ID）"),
+		appInstallationID: flag.String("github-app-installation-id", "", "GitHub App のインストール ID（省略時は環境変数 GITHUB_APP_INSTALLATION_ID）"),
+		appPrivateKey:     flag.String("github-app-key", "", "GitHub App の秘密鍵（PEM）のパス（省略時は環境変数 GITHUB_APP_PRIVATE_KEY_PATH）"),
+	}
+}
+
+// githubConfigFromEnv builds the GitHub connection settings from GITHUB_TOKEN,
+// GITHUB_API_URL, GITHUB_CA_BUNDLE and the GITHUB_APP_* variables, with the
+// flags taking precedence over the environment. GITHUB_TOKEN may hold several
+// comma-separated tokens, which the client rotates between by remaining rate
+// limit. When an App ID is given the client authenticates as that GitHub App
+// installation and GITHUB_TOKEN is not required.
+func githubConfigFromEnv(flags *githubFlags) (infrastructure.GitHubClientConfig, error) {
+	cfg := infrastructure.GitHubClientConfig{
+		APIURL:          flagOrEnv(*flags.apiURL, "GITHUB_API_URL"),
+		CABundlePath:    flagOrEnv(*flags.caBundle, "GITHUB_CA_BUNDLE"),
+		OnRateLimitWait: logRateLimitWait,
+	}
+
+	if tokens := splitTokens(os.Getenv("GITHUB_TOKEN")); len(tokens) > 0 {
+		cfg.Token, cfg.Tokens = tokens[0], tokens[1:]
+	}
+
+	appID := flagOrEnv(*flags.appID, "GITHUB_APP_ID")
+	if appID == "" {
+		if cfg.Token == "" {
+			return cfg, errMissingGitHubCredentials
+		}
+
+		return cfg, nil
+	}
+
+	app := &infrastructure.GitHubAppConfig{
+		PrivateKeyPath: flagOrEnv(*flags.appPrivateKey, "GITHUB_APP_PRIVATE_KEY_PATH"),
+	}
+
+	var err error
+	if app.AppID, err = parseGitHubAppID("App ID", appID); err != nil {
+		return cfg, err
+	}
+
+	installationID := flagOrEnv(*flags.appInstallationID, "GITHUB_APP_INSTALLATION_ID")
+	if app.InstallationID, err = parseGitHubAppID("installation ID", installationID); err != nil {
+		return cfg, err
+	}
+
+	if err := app.Validate(); err != nil {
+		return cfg, fmt.Errorf("GitHub App authentication: %w", err)
+	}
+
+	cfg.App = app
+
+	return cfg, nil
+}
+
+// splitTokens splits a comma-separated list of tokens, dropping empty entries.
+func splitTokens(s string) []string {
+	var tokens []string
+
+	for token := range strings.SplitSeq(s, ",") {
+		if token = strings.TrimSpace(token); token != "" {
+			tokens = append(tokens, token)
+		}
+	}
+
+	return tokens
+}
+
+// flagOrEnv returns value if it is set, otherwise the environment variable key.
+func flagOrEnv(value, key string) string {
+	if value != "" {
+		return value
+	}
+
+	return os.Getenv(key)
+}
+
+// parseGitHubAppID parses a numeric GitHub App or installation ID.
+func parseGitHubAppID(name, value string) (int64, error) {
+	if value == "" {
+		return 0, fmt.Errorf("%w: %s is required", errInvalidGitHubAppID, name)
+	}
+
+	id, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("%w: %s must be a positive integer, got %q", errInvalidGitHubAppID, name, value)
+	}
+
+	return id, nil
+}
+
+// logRateLimitWait reports that every token has used up its rate limit.
+func logRateLimitWait(until time.Time) {
+	log.Printf("All GitHub tokens have exhausted their rate limit; waiting until %s (%s)",
+		until.Local().Format(time.TimeOnly), time.Until(until).Round(time.Second))
+}
+
+// printAPIBudgets prints the rate limit points each token consumed during the
+// run and what it had left at the last response.
+func printAPIBudgets(client *infrastructure.GitHubClient) {
+	fmt.Println("\n=== GitHub API 使用量 ===")
+
+	for _, b := range client.Budgets() {
+		if !b.Observed {
+			fmt.Printf("%s: %d requests (rate limit not reported)\n", b.Name, b.Requests)
+
+			continue
+		}
+
+		fmt.Printf("%s: %d requests, %d points used, %d remaining (resets at %s)\n",
+			b.Name, b.Requests, b.Cost, b.Remaining, b.ResetAt.Local().Format(time.TimeOnly))
+	}
+}
+
+// newGitHubFetcher connects to GitHub (or GitHub Enterprise Server) and probes
+// which of the fields the fetcher queries the server provides, so that an
+// unreachable endpoint fails once up front instead of once per user. Missing
+// fields are reported; the activities that depend on them are left empty.
+// The client is returned as well so that the caller can report its budgets.
+func newGitHubFetcher(
+	ctx context.Context, cfg infrastructure.GitHubClientConfig,
+) (*infrastructure.GitHubDataFetcher, *infrastructure.GitHubClient, error) {
+	client, err := infrastructure.NewGitHubClientFromConfig(cfg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to configure GitHub client: %w", err)
+	}
+
+	fetcher := infrastructure.NewGitHubDataFetcher(infrastructure.NewGitHubRepository(client))
+
+	if !client.IsEnterprise() {
+		return fetcher, client, nil
+	}
+
+	caps, err := fetcher.Capabilities(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fmt.Printf("GitHub Enterprise Server: %s (%s)\n", client.Endpoint(), caps)
+
+	if len(caps.Missing) > 0 {
+		log.Printf("Warning: %s does not provide %s; commit and/or review contributions depending on them will be empty "+
+			"and recorded as missing data",
+			client.Endpoint(), strings.Join(caps.Missing, ", "))
+	}
+
+	return fetcher, client, nil
+}
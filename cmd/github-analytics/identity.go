@@ -0,0 +1,212 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/Tattsum/github-analytics/application"
+	"github.com/Tattsum/github-analytics/domain"
+	"github.com/Tattsum/github-analytics/infrastructure"
+)
+
+// identityFile is the layout of the -identities file:
+//
+//	identities:
+//	  - login: alice
+//	    name: Alice Example
+//	    logins: [alice-work, alice-oss]
+//	    time_zone: Asia/Tokyo
+type identityFile struct {
+	Identities []struct {
+		Login    string   `yaml:"login"`
+		Name     string   `yaml:"name"`
+		Logins   []string `yaml:"logins"`
+		TimeZone string   `yaml:"time_zone"`
+	} `yaml:"identities"`
+}
+
+// identityFlags holds the flags that describe who the members are: the
+// -identities file and the -time-zone their activity hours are counted in.
+type identityFlags struct {
+	path     *string
+	timeZone *string
+}
+
+// registerIdentityFlags defines -identities and -time-zone on the default flag
+// set.
+func registerIdentityFlags() *identityFlags {
+	return &identityFlags{
+		path:     flag.String("identities", "", "1人が持つ複数の GitHub アカウントを1人のメンバーにまとめる対応表（YAML ファイル。メンバーごとのタイムゾーンも指定できる。docs/usage.md を参照）"),
+		timeZone: flag.String("time-zone", "UTC", "活動の時間帯（曜日×時のヒートマップ）を数える既定のタイムゾーン（IANA の名前、例: Asia/Tokyo。-identities の time_zone が優先）"),
+	}
+}
+
+// given reports whether an -identities file was given.
+func (f *identityFlags) given() bool {
+	return *f.path != ""
+}
+
+// identitySettings are the identities and the default time zone of a run. They
+// are kept in the run manifest so that a resumed batch merges the same
+// accounts and counts activity hours in the same time zones.
+type identitySettings struct {
+	identities []domain.Identity
+	timeZone   string
+}
+
+// read loads the -identities file and validates it together with -time-zone,
+// returning the settings, the account lookup and the members' time zones.
+func (f *identityFlags) read() (identitySettings, *domain.IdentityMap, *domain.TimeZones, error) {
+	identities, m, err := readIdentities(*f.path)
+	if err != nil {
+		return identitySettings{}, nil, nil, err
+	}
+
+	settings := identitySettings{identities: identities, timeZone: *f.timeZone}
+
+	zones, err := buildTimeZones(settings.timeZone, identities)
+	if err != nil {
+		return identitySettings{}, nil, nil, err
+	}
+
+	return settings, m, zones, nil
+}
+
+// buildTimeZones resolves the time zone of every member: the identity's own
+// time_zone, or timeZone for everyone else.
+func buildTimeZones(timeZone string, identities []domain.Identity) (*domain.TimeZones, error) {
+	zones, err := domain.NewTimeZones(timeZone, identities)
+	if err != nil {
+		return nil, fmt.Errorf("invalid -time-zone or identity time_zone: %w", err)
+	}
+
+	return zones, nil
+}
+
+// loadIdentities reads the identity map file at path. An empty path yields no
+// identities, so every account stays its own member.
+func loadIdentities(path string) ([]domain.Identity, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	raw, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read -identities file: %w", err)
+	}
+
+	dec := yaml.NewDecoder(bytes.NewReader(raw))
+	dec.KnownFields(true)
+
+	var file identityFile
+	if err := dec.Decode(&file); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to parse -identities file %s: %w", path, err)
+	}
+
+	identities := make([]domain.Identity, 0, len(file.Identities))
+	for _, identity := range file.Identities {
+		identities = append(identities, domain.Identity{
+			Login:    identity.Login,
+			Name:     identity.Name,
+			Logins:   identity.Logins,
+			TimeZone: identity.TimeZone,
+		})
+	}
+
+	return identities, nil
+}
+
+// readIdentities loads the -identities file and builds its lookup. The plain
+// list is kept in the run manifest so that a resumed batch merges the same
+// accounts.
+func readIdentities(path string) ([]domain.Identity, *domain.IdentityMap, error) {
+	identities, err := loadIdentities(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m, err := buildIdentityMap(identities)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return identities, m, nil
+}
+
+// buildIdentityMap validates identities and builds the login lookup. Without
+// identities it returns nil, which leaves every account its own member.
+func buildIdentityMap(identities []domain.Identity) (*domain.IdentityMap, error) {
+	if len(identities) == 0 {
+		return nil, nil
+	}
+
+	m, err := domain.NewIdentityMap(identities)
+	if err != nil {
+		return nil, fmt.Errorf("invalid -identities file: %w", err)
+	}
+
+	return m, nil
+}
+
+// expandAccounts returns every GitHub account behind the members, for the
+// collection steps that need the raw logins rather than the canonical ones.
+func expandAccounts(members []string, identities *domain.IdentityMap) []string {
+	accounts := make([]string, 0, len(members))
+	for _, member := range members {
+		accounts = append(accounts, identities.Accounts(member)...)
+	}
+
+	return accounts
+}
+
+// identitySource fetches every account of a member from source and merges
+// them into one activity set under the member's canonical login.
+type identitySource struct {
+	source     activitySource
+	identities *domain.IdentityMap
+}
+
+// withIdentities wraps source so that members with several accounts are
+// fetched and merged; without identities source is returned unchanged.
+func withIdentities(source activitySource, identities *domain.IdentityMap) activitySource {
+	if identities == nil {
+		return source
+	}
+
+	return &identitySource{source: source, identities: identities}
+}
+
+// FetchUserActivitySince implements activitySource.
+func (s *identitySource) FetchUserActivitySince(
+	ctx context.Context,
+	login string,
+	includePrivate bool,
+	since time.Time,
+) (*infrastructure.UserActivityData, error) {
+	identity, ok := s.identities.Lookup(login)
+	if !ok {
+		identity = &domain.Identity{Login: login, Logins: []string{login}}
+	}
+
+	parts := make([]*infrastructure.UserActivityData, 0, len(identity.Logins))
+
+	for _, account := range identity.Logins {
+		data, err := s.source.FetchUserActivitySince(ctx, account, includePrivate, since)
+		if err != nil {
+			return nil, fmt.Errorf("account %s: %w", account, err)
+		}
+
+		parts = append(parts, data)
+	}
+
+	return application.MergeActivity(identity, parts, s.identities), nil
+}
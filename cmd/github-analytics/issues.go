@@ -0,0 +1,53 @@
+package main
+
+import (
+	"flag"
+
+	"github.com/Tattsum/github-analytics/domain"
+)
+
+// classificationFlags holds the flags that classify issues and pull requests:
+// the issue labels of bugs and features, and the work category rules of pull
+// requests.
+type classificationFlags struct {
+	bug          *string
+	feature      *string
+	prCategories *string
+}
+
+// registerClassificationFlags defines -bug-labels, -feature-labels and
+// -pr-categories on the default flag set.
+func registerClassificationFlags() *classificationFlags {
+	return &classificationFlags{
+		bug:          flag.String("bug-labels", "", "不具合として数える Issue のラベル（カンマ区切り、大文字小文字を区別しない。既定は bug,defect,regression）"),
+		feature:      flag.String("feature-labels", "", "機能追加として数える Issue のラベル（カンマ区切り、大文字小文字を区別しない。既定は enhancement,feature,feature request）"),
+		prCategories: flag.String("pr-categories", "", "PR を作業の種類（feature・bug・chore・docs・test・infra）に分類する規則を上書きする YAML ファイル（docs/usage.md を参照）"),
+	}
+}
+
+// issueLabels is the plain form of the issue label flags. It is kept in the
+// run manifest so that a resumed batch classifies issues the same way; an
+// empty side keeps the default labels.
+type issueLabels struct {
+	bug     []string
+	feature []string
+}
+
+// labels returns the parsed issue label flags.
+func (f *classificationFlags) labels() issueLabels {
+	var labels issueLabels
+	if *f.bug != "" {
+		labels.bug = splitUsers(*f.bug)
+	}
+
+	if *f.feature != "" {
+		labels.feature = splitUsers(*f.feature)
+	}
+
+	return labels
+}
+
+// mapping returns the label mapping issues are classified with.
+func (l issueLabels) mapping() *domain.IssueLabelMapping {
+	return domain.NewIssueLabelMapping(l.bug, l.feature)
+}
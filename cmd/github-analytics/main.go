@@ -20,6 +20,7 @@ import (
 
 // 実行手順・前提条件:
 // 1. GitHub Personal Access Tokenを環境変数 GITHUB_TOKEN に設定してください
+//    （GitHub App のインストールとして認証する場合は GITHUB_APP_ID などを設定します。docs/usage.md を参照）
 //    - トークンの作成: https://github.com/settings/tokens
 //    - 必要なスコープ: public_repo, repo (privateリポジトリも対象にする場合)
 //    - 組織のメンバーを取得する場合: read:org スコープが必要
@@ -37,55 +38,60 @@ func showHelp() {
 	fmt.Println("  ./github-analytics -org myorg")
 	fmt.Println("  # 組織内の特定チームのメンバーだけを分析")
 	fmt.Println("  ./github-analytics -org myorg -team my-team")
+	fmt.Println("  # 複数の組織のチームを分析（複数の組織を指定した場合、チームは org/team の形式）")
+	fmt.Println("  ./github-analytics -org myorg,otherorg -team myorg/my-team,otherorg/infra")
+	fmt.Println("  # 2025 年度（2025-04-01〜2026-03-31）の活動だけを取得してスナップショットを保存")
+	fmt.Println("  ./github-analytics -mode batch -org myorg -since 2025-04-01 -until 2026-03-31")
+	fmt.Println("  # 設定ファイル（YAML / TOML）の値を使い、一部をフラグで上書き")
+	fmt.Println("  ./github-analytics -mode batch -config analytics.yaml -lookback-years 1")
+	fmt.Println("  # 組織のリポジトリを1度ずつ走査してメンバーの活動を収集")
+	fmt.Println("  ./github-analytics -mode batch -org myorg -collect repository")
+	fmt.Println("  # GitHub Enterprise Server のメンバーを分析（CA バンドルは社内 CA の場合のみ）")
+	fmt.Println("  ./github-analytics -github-url https://ghes.example.com/api/v3 -github-ca-bundle corp-ca.pem -org myorg")
+	fmt.Println("  # Personal Access Token の代わりに GitHub App のインストールとして認証")
+	fmt.Println("  ./github-analytics -github-app-id 12345 -github-app-installation-id 67890 -github-app-key app.pem -org myorg")
+	fmt.Println("  # コミットごとの追加・削除行数も取得（クエリ数が大きく増える）")
+	fmt.Println("  ./github-analytics -users user1 -commit-lines")
+	fmt.Println("  # デプロイ頻度・リードタイム・変更失敗率・復旧時間も集計してスナップショットに保存")
+	fmt.Println("  ./github-analytics -mode batch -org myorg -delivery-metrics")
+	fmt.Println("  # Issue を不具合・機能追加に分類するラベルを指定")
+	fmt.Println("  ./github-analytics -mode batch -org myorg -bug-labels bug,incident -feature-labels enhancement")
+	fmt.Println("  # PR を作業の種類に分類する規則を上書き")
+	fmt.Println("  ./github-analytics -mode batch -org myorg -pr-categories categories.yaml")
+	fmt.Println("  # ボットと CI 用アカウントを除外して組織のメンバーを分析")
+	fmt.Println("  ./github-analytics -org myorg -exclude-bots -exclude-logins renovate -exclude-pattern '^ci-'")
+	fmt.Println("  # 同じ人の個人用・業務用アカウントを1人のメンバーとしてまとめて分析")
+	fmt.Println("  ./github-analytics -org myorg -identities identities.yaml")
+	fmt.Println("  # 活動の時間帯（曜日×時）を日本時間で数えてスナップショットを保存（メンバーごとの指定は -identities の time_zone）")
+	fmt.Println("  ./github-analytics -mode batch -org myorg -time-zone Asia/Tokyo")
 	fmt.Println("  # privateリポジトリも含める")
 	fmt.Println("  ./github-analytics -users user1 -private")
+	fmt.Println("  # バッチを差分取得ではなく全期間取得で実行")
+	fmt.Println("  ./github-analytics -mode batch -users user1 -full")
+	fmt.Println("  # 組織のメンバーを 8 人ずつ並行に取得してバッチを実行")
+	fmt.Println("  ./github-analytics -mode batch -org myorg -concurrency 8")
+	fmt.Println("  # 中断したバッチを再開（取得済みのユーザーはスキップ）")
+	fmt.Println("  ./github-analytics -mode batch -resume 20240315T093000Z")
+	fmt.Println("  # 取得に失敗したユーザーを除いてスナップショットを保存")
+	fmt.Println("  ./github-analytics -mode batch -resume 20240315T093000Z -accept-partial")
+	fmt.Println("  # 保存済みイベントからスナップショットを再構築（GitHub へはアクセスしない）")
+	fmt.Println("  ./github-analytics -mode reaggregate")
+	fmt.Println("  # 直前のスナップショットと最新のスナップショットの差分を表示（-format json で JSON 出力）")
+	fmt.Println("  ./github-analytics snapshot diff")
+	fmt.Println("  # 任意の2つのスナップショットの差分を表示")
+	fmt.Println("  ./github-analytics snapshot diff -base 3 -head 5")
+	fmt.Println("  # 保持ポリシーで削除されるスナップショットを確認（-dry-run を外すと削除）")
+	fmt.Println("  ./github-analytics snapshot prune -keep-last 10 -horizon-days 30 -keep-one-per week -dry-run")
+	fmt.Println("  # スナップショットにタグを付けて削除対象から外す")
+	fmt.Println("  ./github-analytics snapshot tag -id 42 -tag q1-review")
 	os.Exit(0)
 }
 
-// getUsers はユーザーリストを取得します.
-func getUsers(orgName, teamSlug, usersStr, token *string) []string {
-	var users []string
-
-	switch {
-	case *teamSlug != "" && *orgName == "":
-		log.Fatal("-team requires -org to be specified as well.")
-	case *teamSlug != "":
-		var err error
-
-		users, err = fetchTeamMembers(*token, *orgName, *teamSlug)
-		if err != nil {
-			log.Fatalf("Failed to fetch team members: %v", err)
-		}
-
-		if len(users) == 0 {
-			log.Fatalf("No members found in team: %s/%s", *orgName, *teamSlug)
-		}
-
-		fmt.Printf("Found %d members in team: %s/%s\n", len(users), *orgName, *teamSlug)
-	case *orgName != "":
-		var err error
-
-		users, err = fetchOrganizationMembers(*token, *orgName)
-		if err != nil {
-			log.Fatalf("Failed to fetch organization members: %v", err)
-		}
-
-		if len(users) == 0 {
-			log.Fatalf("No members found in organization: %s", *orgName)
-		}
-
-		fmt.Printf("Found %d members in organization: %s\n", len(users), *orgName)
-	case *usersStr != "":
-		users = strings.Split(*usersStr, ",")
-		for i := range users {
-			users[i] = strings.TrimSpace(users[i])
-		}
-	default:
-		log.Fatal("Either -users or -org flag must be specified. Use -help for usage.")
-	}
-
-	if len(users) == 0 {
-		log.Fatal("No users specified for analysis.")
+// splitUsers はカンマ区切りのユーザー名を分割し、前後の空白を取り除きます.
+func splitUsers(usersStr string) []string {
+	users := strings.Split(usersStr, ",")
+	for i := range users {
+		users[i] = strings.TrimSpace(users[i])
 	}
 
 	return users
@@ -96,12 +102,12 @@ func processUser(
 	ctx context.Context,
 	user string,
 	includePrivate bool,
-	fetcher *infrastructure.GitHubDataFetcher,
+	source activitySource,
 	statsService *application.StatisticsService,
 ) (*domain.UserStatistics, error) {
 	fmt.Printf("Processing user: %s\n", user)
 
-	data, err := fetcher.FetchAllUserActivity(ctx, user, includePrivate)
+	data, err := source.FetchUserActivitySince(ctx, user, includePrivate, time.Time{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch user activity: %w", err)
 	}
@@ -114,54 +120,55 @@ func processUser(
 	return stats, nil
 }
 
-// collectResults は結果を収集して出力します.
+// collectResults は成功したユーザーの統計を出力し、ユーザー名をキーとする map にまとめます.
 func collectResults(
-	results chan userResult,
-	users []string,
+	result *application.UserPoolResult,
 	formatter *presentation.OutputFormatter,
 ) map[string]any {
-	allStats := make(map[string]any)
-
-	for range users {
-		result := <-results
-		if result.err != nil {
-			log.Printf("Error processing user %s: %v", result.username, result.err)
-			continue
-		}
-
-		stats, ok := result.stats.(*domain.UserStatistics)
-		if !ok {
-			log.Printf("Error: invalid stats type for user %s", result.username)
-			continue
-		}
+	allStats := make(map[string]any, len(result.Stats))
 
-		allStats[result.username] = stats
+	for _, stats := range result.Stats {
+		username := stats.User.Login
+		allStats[username] = stats
 
 		if err := formatter.FormatAll(stats); err != nil {
-			log.Printf("Error formatting output for user %s: %v", result.username, err)
-			continue
+			log.Printf("Error formatting output for user %s: %v", username, err)
 		}
-
-		fmt.Printf("Completed processing user: %s\n", result.username)
 	}
 
 	return allStats
 }
 
-type userResult struct {
-	username string
-	stats    any
-	err      error
-}
-
 func main() {
+	// snapshot サブコマンドは保存済みスナップショットを扱い、独自のフラグを持ちます.
+	if len(os.Args) > 1 && os.Args[1] == "snapshot" {
+		runSnapshotCommand(os.Args[2:])
+
+		return
+	}
+
 	var (
-		mode           = flag.String("mode", "file", "実行モード: file（output/ へ出力）または batch（Postgresへスナップショット保存）")
-		usersStr       = flag.String("users", "", "分析対象のGitHubユーザー名（カンマ区切り、例: user1,user2）")
-		orgName        = flag.String("org", "", "分析対象のGitHub組織名（指定した場合、組織のメンバーを分析）")
-		teamSlug       = flag.String("team", "", "分析対象のチームslug（-org と併用。組織内の特定チームのメンバーのみを分析）")
+		mode           = flag.String("mode", "file", "実行モード: file（output/ へ出力）、batch（Postgresへスナップショット保存）または reaggregate（保存済みイベントからスナップショットを再構築）")
+		configPath     = flag.String("config", "", "設定ファイル（YAML、または拡張子 .toml の TOML）。コマンドラインで指定したフラグが設定ファイルの値より優先される")
+		roster         = registerRosterFlags()
 		outputDir      = flag.String("output", "output", "出力ディレクトリ")
+		formats        = flag.String("formats", "", "file モードで出力する形式（カンマ区切り: json,csv,text,presentation。既定はすべて）")
 		includePrivate = flag.Bool("private", false, "privateリポジトリも対象にする")
+		lookbackYears  = flag.Int("lookback-years", 0, "活動を遡って取得する年数（既定ではコミット・レビューを 10 年分、PR・Issue を全期間取得する）")
+		periodFlags    = registerPeriodFlags()
+		full           = flag.Bool("full", false, "batch モードで差分取得を行わず、全期間を再取得してスナップショットを作り直す")
+		stateDir       = flag.String("state-dir", "state", "batch モードで取得途中の結果（チェックポイント）を保存するディレクトリ")
+		resume         = flag.String("resume", "", "中断した batch の実行IDを指定して再開する（取得済みのユーザーはスキップ。対象ユーザー・-private・-full・-collect・-commit-lines・-delivery-metrics・-lookback-years・-since・-until・除外ルール・アカウントの対応表・タイムゾーン・Issue のラベル・PR の分類規則は元の実行のものを使う）")
+		acceptPartial  = flag.Bool("accept-partial", false, "batch モードで取得に失敗したユーザーがいても、残りのユーザーだけでスナップショットを保存する")
+		databaseURL    = flag.String("database-url", "", "batch / reaggregate モードで使う PostgreSQL の接続 URL（未指定なら環境変数 DATABASE_URL）")
+		commitLines    = flag.Bool("commit-lines", false, "コミットごとの追加・削除行数を、コミットしたリポジトリのデフォルトブランチの履歴から取得する（-collect user のみ。クエリ数が大きく増える）")
+		delivery       = flag.Bool("delivery-metrics", false, "batch モードで、メンバーのPRがマージされたリポジトリのデプロイメント・リリース・タグを取得し、週ごとのデリバリー指標（デプロイ頻度・リードタイム・変更失敗率・復旧時間）を保存する")
+		collect        = flag.String("collect", collectUser, "活動の収集方法: user（メンバーごとに contributions を取得）または repository（-org のリポジトリを1度ずつ走査してメンバーに帰属させる）")
+		githubFlags    = registerGitHubFlags()
+		exclusionFlags = registerExclusionFlags()
+		classifyFlags  = registerClassificationFlags()
+		identityFlags  = registerIdentityFlags()
+		concurrency    = flag.Int("concurrency", defaultConcurrency, fmt.Sprintf("並行して取得するユーザー数（1〜%d。API のレート制限は全ワーカーで共有）", maxConcurrency))
 		help           = flag.Bool("help", false, "ヘルプを表示")
 	)
 
@@ -171,73 +178,277 @@ func main() {
 		showHelp()
 	}
 
-	token := os.Getenv("GITHUB_TOKEN")
-	if token == "" {
-		log.Fatal("GITHUB_TOKEN environment variable is not set. Please set your GitHub Personal Access Token.")
+	// 設定ファイルの値は、コマンドラインで指定しなかったフラグにだけ反映します.
+	if err := applyConfigFile(flag.CommandLine, *configPath); err != nil {
+		log.Fatal(err)
+	}
+
+	rules := exclusionFlags.rules()
+
+	exclusion, err := rules.build()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	identityConfig, identities, zones, err := identityFlags.read()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	period, err := periodFlags.period()
+	if err != nil {
+		log.Fatal(err)
 	}
 
-	users := getUsers(orgName, teamSlug, usersStr, &token)
+	// reaggregate は保存済みイベントだけを使うため、GitHub トークンを必要としません.
+	if *mode == "reaggregate" {
+		runReaggregate(roster.reaggregateUsers(), exclusion, identities, zones, *databaseURL, period)
+
+		return
+	}
+
+	fetch, err := parseFetchFlags(*concurrency, *lookbackYears, *collect, roster.orgList(), *formats, *classifyFlags.prCategories)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	gh, err := githubConfigFromEnv(githubFlags)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	batch := batchOptions{
+		includePrivate: *includePrivate,
+		github:         gh,
+		full:           *full,
+		concurrency:    *concurrency,
+		stateDir:       *stateDir,
+		resumeRunID:    *resume,
+		acceptPartial:  *acceptPartial,
+		databaseURL:    *databaseURL,
+		collect:        *collect,
+		org:            fetch.org,
+		commitLines:    *commitLines,
+		delivery:       *delivery,
+		lookbackYears:  *lookbackYears,
+		period:         period,
+		exclusion:      rules,
+		identities:     identityConfig,
+		issueLabels:    classifyFlags.labels(),
+		prCategories:   fetch.prCategories,
+	}
+
+	// 再開時は対象ユーザーを元の実行のマニフェストから読み込みます.
+	if *mode == "batch" && *resume != "" {
+		if roster.given() || identityFlags.given() {
+			log.Fatal("-resume takes the users from the resumed run; do not combine it with -users, -org, -team or -identities.")
+		}
+
+		runBatch(batch)
+
+		return
+	}
+
+	users, excluded := selectMembers(roster, gh, exclusion, identities)
 
 	if *mode == "batch" {
-		runBatch(users, *includePrivate, token)
+		batch.users = users
+		batch.excluded = excluded
+		runBatch(batch)
+
 		return
 	}
 
-	setupAndProcessUsers(users, *outputDir, *includePrivate, token)
+	runFile(users, batch.fileOptions(*outputDir, fetch.formats, exclusion, identities, zones, fetch.prClassifier))
+}
+
+// fetchSettings は GitHub から取得するモードのフラグを検証した結果です.
+type fetchSettings struct {
+	// org は -collect repository で走査する組織です.
+	org string
+	// formats は file モードの出力形式です.
+	formats []presentation.OutputFormat
+	// prCategories は -pr-categories を反映したPRの分類規則（未指定なら nil）で、prClassifier はその判定器です.
+	prCategories []domain.WorkCategoryRule
+	prClassifier *domain.PRClassifier
+}
+
+// parseFetchFlags は GitHub から取得するモードのフラグを検証し、取得の設定を返します.
+func parseFetchFlags(
+	concurrency, lookbackYears int,
+	collect string,
+	orgs []string,
+	formats string,
+	prCategoriesPath string,
+) (fetchSettings, error) {
+	var settings fetchSettings
+
+	if err := validateConcurrency(concurrency); err != nil {
+		return settings, err
+	}
+
+	if err := validateLookbackYears(lookbackYears); err != nil {
+		return settings, err
+	}
+
+	var err error
+	if settings.org, err = collectOrganizationName(collect, orgs); err != nil {
+		return settings, err
+	}
+
+	if settings.formats, err = presentation.ParseOutputFormats(commaList(formats)); err != nil {
+		return settings, fmt.Errorf("invalid -formats: %w", err)
+	}
+
+	if settings.prCategories, err = loadPRCategories(prCategoriesPath); err != nil {
+		return settings, err
+	}
+
+	if settings.prClassifier, err = prClassifier(settings.prCategories); err != nil {
+		return settings, err
+	}
+
+	return settings, nil
+}
+
+// fileOptions は file モードの設定です.
+type fileOptions struct {
+	outputDir string
+	// formats は出力する形式です.
+	formats        []presentation.OutputFormat
+	includePrivate bool
+	github         infrastructure.GitHubClientConfig
+	concurrency    int
+	// collectOrg を指定した場合は、その組織のリポジトリを1度ずつ走査して全ユーザーの活動をまとめて収集します.
+	collectOrg string
+	// commitLines の場合はユーザーごとの取得でもコミットの追加・削除行数を取得します.
+	commitLines bool
+	// lookbackYears は活動を遡って取得する年数です（0 なら既定の遡り方）.
+	lookbackYears int
+	// period は活動を取得する期間です（ゼロ値なら既定の遡り方で実行時点まで）.
+	period domain.CollectionPeriod
+	// exclusion に一致するアカウントが作成したPRへのレビューは、レビュー数とは別に数えます.
+	exclusion *domain.ActorExclusion
+	// identities で複数のアカウントを持つメンバーは、各アカウントの活動をまとめて集計します.
+	identities *domain.IdentityMap
+	// issueLabels はIssueを不具合・機能追加に分類するラベルの対応です.
+	issueLabels *domain.IssueLabelMapping
+	// prClassifier はPRを作業の種類に分類します.
+	prClassifier *domain.PRClassifier
+	// timeZones は活動の時間帯を数えるメンバーごとのタイムゾーンです.
+	timeZones *domain.TimeZones
+}
+
+// fileOptions は batch と共通の取得設定に、file モードの出力先・出力形式と構築済みの除外ルール・アカウントの対応表・タイムゾーン・PRの分類器を加えた設定を返します.
+func (o batchOptions) fileOptions(
+	outputDir string,
+	formats []presentation.OutputFormat,
+	exclusion *domain.ActorExclusion,
+	identities *domain.IdentityMap,
+	zones *domain.TimeZones,
+	classifier *domain.PRClassifier,
+) fileOptions {
+	return fileOptions{
+		outputDir:      outputDir,
+		formats:        formats,
+		includePrivate: o.includePrivate,
+		github:         o.github,
+		concurrency:    o.concurrency,
+		collectOrg:     o.org,
+		commitLines:    o.commitLines,
+		lookbackYears:  o.lookbackYears,
+		period:         o.period,
+		exclusion:      exclusion,
+		identities:     identities,
+		issueLabels:    o.issueLabels.mapping(),
+		prClassifier:   classifier,
+		timeZones:      zones,
+	}
+}
+
+// runFile は users の統計を opts.outputDir に出力します.
+func runFile(users []string, opts fileOptions) {
+	if err := setupAndProcessUsers(users, opts); err != nil {
+		log.Fatal(err)
+	}
 
 	fmt.Println("\n=== 処理完了 ===")
-	fmt.Printf("結果は %s/ ディレクトリに出力されました。\n", *outputDir)
+	fmt.Printf("結果は %s/ ディレクトリに出力されました。\n", opts.outputDir)
 }
 
 // setupAndProcessUsers はユーザー処理のセットアップと実行を行います.
-func setupAndProcessUsers(users []string, outputDir string, includePrivate bool, token string) {
+// ユーザーは最大 opts.concurrency 人ずつ並行に取得し、失敗したユーザーは最後にまとめて表示します.
+func setupAndProcessUsers(users []string, opts fileOptions) error {
 	const (
 		dirPerm        = 0o750
 		timeoutMinutes = 30
 	)
 
-	if err := os.MkdirAll(outputDir, dirPerm); err != nil {
-		log.Fatalf("Failed to create output directory: %v", err)
+	if err := os.MkdirAll(opts.outputDir, dirPerm); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), timeoutMinutes*time.Minute)
 	defer cancel()
 
-	client := infrastructure.NewGitHubClient(token)
-	repo := infrastructure.NewGitHubRepository(client)
-	fetcher := infrastructure.NewGitHubDataFetcher(repo)
-	statsService := application.NewStatisticsService()
-
-	results := make(chan userResult, len(users))
+	fetcher, github, err := newGitHubFetcher(ctx, opts.github)
+	if err != nil {
+		return err
+	}
 
-	for _, username := range users {
-		go func(user string) {
-			stats, err := processUser(ctx, user, includePrivate, fetcher, statsService)
-			if err != nil {
-				results <- userResult{username: user, err: err}
-				return
-			}
+	if opts.commitLines {
+		fetcher.EnableCommitLineCounts()
+	}
 
-			results <- userResult{username: user, stats: stats}
-		}(username)
+	fetcher.SetActorExclusion(opts.exclusion)
+	fetcher.SetLookbackYears(opts.lookbackYears)
+	fetcher.SetCollectionPeriod(opts.period)
+	fetcher.SetIssueLabelMapping(opts.issueLabels)
+	fetcher.SetPRClassifier(opts.prClassifier)
+
+	var source activitySource = fetcher
+	if opts.collectOrg != "" {
+		accounts := expandAccounts(users, opts.identities)
+		if source, err = collectOrganization(ctx, fetcher, opts.collectOrg, accounts, opts.includePrivate, time.Time{}, opts.concurrency); err != nil {
+			return err
+		}
 	}
 
-	formatter := presentation.NewOutputFormatter(outputDir)
-	allStats := collectResults(results, users, formatter)
+	source = withIdentities(source, opts.identities)
+
+	statsService := application.NewStatisticsServiceWithExclusion(opts.exclusion)
+	statsService.SetTimeZones(opts.timeZones)
+
+	pool := application.NewUserPool(opts.concurrency, printUserProgress)
+	result := pool.Run(ctx, users, func(ctx context.Context, user string) (*domain.UserStatistics, error) {
+		return processUser(ctx, user, opts.includePrivate, source, statsService)
+	})
 
-	if err := generateCombinedReport(outputDir, allStats); err != nil {
+	formatter := presentation.NewOutputFormatterWithFormats(opts.outputDir, opts.formats)
+	allStats := collectResults(result, formatter)
+
+	printSkippedUsers(result.Failures)
+	printIncompleteUsers(result.Stats)
+	printAPIBudgets(github)
+
+	if err := generateCombinedReport(opts.outputDir, allStats); err != nil {
 		log.Printf("Error generating combined report: %v", err)
 	}
+
+	return nil
 }
 
 // fetchOrganizationMembers は組織のメンバー一覧を取得します.
-func fetchOrganizationMembers(token, orgName string) ([]string, error) {
+func fetchOrganizationMembers(gh infrastructure.GitHubClientConfig, orgName string) ([]string, error) {
 	const orgFetchTimeoutMinutes = 5
 
 	ctx, cancel := context.WithTimeout(context.Background(), orgFetchTimeoutMinutes*time.Minute)
 	defer cancel()
 
-	client := infrastructure.NewGitHubClient(token)
+	client, err := infrastructure.NewGitHubClientFromConfig(gh)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure GitHub client: %w", err)
+	}
 
 	var query struct {
 		Organization struct {
@@ -284,13 +495,16 @@ func fetchOrganizationMembers(token, orgName string) ([]string, error) {
 }
 
 // fetchTeamMembers は組織内の特定チームのメンバー一覧を取得します.
-func fetchTeamMembers(token, orgName, teamSlug string) ([]string, error) {
+func fetchTeamMembers(gh infrastructure.GitHubClientConfig, orgName, teamSlug string) ([]string, error) {
 	const teamFetchTimeoutMinutes = 5
 
 	ctx, cancel := context.WithTimeout(context.Background(), teamFetchTimeoutMinutes*time.Minute)
 	defer cancel()
 
-	client := infrastructure.NewGitHubClient(token)
+	client, err := infrastructure.NewGitHubClientFromConfig(gh)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure GitHub client: %w", err)
+	}
 
 	var query struct {
 		Organization struct {
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/Tattsum/github-analytics/domain"
+)
+
+// periodDateLayout is the layout of the -since and -until dates.
+const periodDateLayout = time.DateOnly
+
+// errInvalidPeriodDate is returned for a -since or -until value that is not a
+// date.
+var errInvalidPeriodDate = errors.New("want a date such as 2025-04-01")
+
+// periodFlags holds the flags that limit the collection period.
+type periodFlags struct {
+	since *string
+	until *string
+}
+
+// registerPeriodFlags defines -since and -until on the default flag set.
+func registerPeriodFlags() *periodFlags {
+	return &periodFlags{
+		since: flag.String("since", "", "この日（UTC、例: 2025-04-01）以降の活動だけを取得する（-lookback-years より優先）"),
+		until: flag.String("until", "", "この日（UTC、例: 2026-03-31）までの活動だけを取得する（この日を含む。既定は実行時点まで）"),
+	}
+}
+
+// period returns the collection period of the flags. -since is the first day
+// and -until the last day of the period, both in UTC.
+func (f *periodFlags) period() (domain.CollectionPeriod, error) {
+	since, err := parsePeriodDate("-since", *f.since)
+	if err != nil {
+		return domain.CollectionPeriod{}, err
+	}
+
+	until, err := parsePeriodDate("-until", *f.until)
+	if err != nil {
+		return domain.CollectionPeriod{}, err
+	}
+
+	if !until.IsZero() {
+		until = until.AddDate(0, 0, 1)
+	}
+
+	period, err := domain.NewCollectionPeriod(since, until)
+	if err != nil {
+		return domain.CollectionPeriod{}, fmt.Errorf("invalid -since / -until: %w", err)
+	}
+
+	return period, nil
+}
+
+// parsePeriodDate parses a -since or -until date; an empty value is the zero
+// time.
+func parsePeriodDate(name, value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(periodDateLayout, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s %q: %w", name, value, errInvalidPeriodDate)
+	}
+
+	return t, nil
+}
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Tattsum/github-analytics/application"
+	"github.com/Tattsum/github-analytics/domain"
+	"github.com/Tattsum/github-analytics/infrastructure"
+	"github.com/Tattsum/github-analytics/infrastructure/snapshotdb"
+)
+
+// errNoStoredEvents is returned when the event store has nothing to re-aggregate.
+var errNoStoredEvents = errors.New("no stored activity events found; run batch mode first")
+
+// runReaggregate rebuilds one snapshot purely from the stored activity events,
+// without any GitHub access. An empty users list re-aggregates every login
+// that has stored events. Logins matching exclusion are left out, and reviews
+// of pull requests they opened are counted separately. The stored activity of
+// every account in identities is merged into its member, and activity hours
+// are counted in each member's time zone from zones. databaseURL is the
+// -database-url flag; DATABASE_URL is used when it is empty. A non-zero period
+// limits the rebuilt snapshot to the stored events inside it.
+func runReaggregate(
+	users []string,
+	exclusion *domain.ActorExclusion,
+	identities *domain.IdentityMap,
+	zones *domain.TimeZones,
+	databaseURL string,
+	period domain.CollectionPeriod,
+) {
+	if err := executeReaggregate(users, exclusion, identities, zones, databaseURL, period); err != nil {
+		log.Fatalf("reaggregate: %v", err)
+	}
+}
+
+// executeReaggregate performs the re-aggregation and returns an error instead
+// of exiting, so that the deferred context cancel and DB Close always run.
+//
+// The rebuilt snapshot covers whatever history the event store holds: events
+// are appended by batch runs, and incremental runs only append their delta, so
+// run one batch with -full first to seed the full lookback window.
+func executeReaggregate(
+	users []string,
+	exclusion *domain.ActorExclusion,
+	identities *domain.IdentityMap,
+	zones *domain.TimeZones,
+	databaseURLFlag string,
+	period domain.CollectionPeriod,
+) error {
+	const timeoutMinutes = 30
+
+	databaseURL, err := resolveDatabaseURL(databaseURLFlag)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeoutMinutes*time.Minute)
+	defer cancel()
+
+	client, err := infrastructure.OpenPostgres(databaseURL)
+	if err != nil {
+		return fmt.Errorf("failed to open PostgreSQL connection: %w", err)
+	}
+
+	defer func() {
+		if cerr := client.Close(); cerr != nil {
+			log.Printf("Failed to close PostgreSQL connection: %v", cerr)
+		}
+	}()
+
+	if err := infrastructure.Migrate(ctx, client); err != nil {
+		return fmt.Errorf("failed to run migrations: %w", err)
+	}
+
+	events := snapshotdb.NewEventStore(client)
+
+	if len(users) == 0 {
+		users, err = events.Logins(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list stored logins: %w", err)
+		}
+	}
+
+	users, excluded := excludeFromRoster(users, exclusion)
+	users = identities.Members(users)
+
+	stored, err := events.LoadActivity(ctx, expandAccounts(users, identities))
+	if err != nil {
+		return fmt.Errorf("failed to load activity events: %w", err)
+	}
+
+	if len(stored) == 0 {
+		return errNoStoredEvents
+	}
+
+	activity := mergeStoredActivity(stored, identities)
+
+	// PR and issue lifecycles (reviews, approval, close) and delivery metrics
+	// are not part of the event store, so they are carried over from the
+	// latest snapshots.
+	reader := snapshotdb.NewSnapshotReader(client)
+
+	baselines, err := reader.Baselines(ctx, users)
+	if err != nil {
+		return fmt.Errorf("failed to load latest member snapshots: %w", err)
+	}
+
+	deliveryWeeks, err := reader.DeliveryWeeks(ctx, "")
+	if err != nil {
+		return fmt.Errorf("failed to load latest delivery metrics: %w", err)
+	}
+
+	statsService := application.NewStatisticsServiceWithExclusion(exclusion)
+	statsService.SetTimeZones(zones)
+	members := make([]*domain.UserStatistics, 0, len(activity))
+
+	for _, data := range activity {
+		stats, err := statsService.CalculateStatistics(application.ActivityInPeriod(data, period))
+		if err != nil {
+			log.Printf("Error re-aggregating user %s: %v", data.User.Login, err)
+			continue
+		}
+
+		if baseline, ok := baselines[data.User.Login]; ok {
+			stats.SetPRLifecycles(application.PRLifecyclesInPeriod(baseline.PRLifecycles, period))
+			stats.IssueLifecycles = application.IssueLifecyclesInPeriod(baseline.IssueLifecycles, period)
+		}
+
+		members = append(members, stats)
+	}
+
+	if len(members) == 0 {
+		return errNoMemberStatistics
+	}
+
+	snapshot := &application.Snapshot{
+		CapturedAt:      time.Now(),
+		Members:         members,
+		ExcludedMembers: excluded,
+		Period:          period,
+		DeliveryWeeks:   application.DeliveryWeeksInPeriod(deliveryWeeks, period),
+	}
+
+	writer := snapshotdb.NewSnapshotWriter(client)
+	if err := writer.Save(ctx, snapshot); err != nil {
+		return fmt.Errorf("failed to save snapshot: %w", err)
+	}
+
+	fmt.Printf("\n=== 再集計完了 ===\n保存済みイベントからスナップショットを再構築しました（メンバー数: %d, captured_at: %s）\n",
+		len(members), snapshot.CapturedAt.Format(time.RFC3339))
+
+	return nil
+}
+
+// mergeStoredActivity groups the stored activity by member and merges the
+// accounts of each member, keeping the order in which members first appear.
+// Without identities the activity is returned unchanged.
+func mergeStoredActivity(
+	stored []*infrastructure.UserActivityData,
+	identities *domain.IdentityMap,
+) []*infrastructure.UserActivityData {
+	if identities == nil {
+		return stored
+	}
+
+	groups := make(map[string][]*infrastructure.UserActivityData)
+	order := make([]string, 0, len(stored))
+
+	for _, data := range stored {
+		member := identities.Canonical(data.User.Login)
+		if _, ok := groups[member]; !ok {
+			order = append(order, member)
+		}
+
+		groups[member] = append(groups[member], data)
+	}
+
+	merged := make([]*infrastructure.UserActivityData, 0, len(order))
+
+	for _, member := range order {
+		identity, ok := identities.Lookup(member)
+		if !ok {
+			identity = &domain.Identity{Login: member, Logins: []string{member}}
+		}
+
+		merged = append(merged, application.MergeActivity(identity, groups[member], identities))
+	}
+
+	return merged
+}
@@ -0,0 +1,214 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Tattsum/github-analytics/domain"
+	"github.com/Tattsum/github-analytics/infrastructure"
+)
+
+var (
+	// errTeamRequiresOrg is returned for a bare team slug without -org.
+	errTeamRequiresOrg = errors.New("-team requires -org to be specified as well")
+	// errAmbiguousTeam is returned for a bare team slug when several
+	// organizations are given.
+	errAmbiguousTeam = errors.New("write the team as org/team when several organizations are given")
+)
+
+// rosterFlags holds the flags that select the members to analyze. -org and
+// -team take comma-separated lists; the explicit -users are added to the
+// members of the organizations or teams.
+type rosterFlags struct {
+	users *string
+	orgs  *string
+	teams *string
+}
+
+// registerRosterFlags defines the roster flags on the default flag set.
+func registerRosterFlags() *rosterFlags {
+	return &rosterFlags{
+		users: flag.String("users", "", "分析対象のGitHubユーザー名（カンマ区切り、例: user1,user2。-org / -team と併用するとそのメンバーに追加）"),
+		orgs:  flag.String("org", "", "分析対象のGitHub組織名（カンマ区切りで複数指定可。指定した場合、組織のメンバーを分析）"),
+		teams: flag.String("team", "", "分析対象のチームslug（カンマ区切りで複数指定可。-org と併用し、組織内の特定チームのメンバーのみを分析。複数の組織を指定した場合は org/team の形式）"),
+	}
+}
+
+// orgList returns the -org organizations.
+func (f *rosterFlags) orgList() []string {
+	return commaList(*f.orgs)
+}
+
+// given reports whether any roster flag is set.
+func (f *rosterFlags) given() bool {
+	return *f.users != "" || *f.orgs != "" || *f.teams != ""
+}
+
+// reaggregateUsers returns the logins given with -users, or nil to
+// re-aggregate every stored login. Fetching a roster from GitHub is not
+// supported, so -org and -team are rejected.
+func (f *rosterFlags) reaggregateUsers() []string {
+	if *f.orgs != "" || *f.teams != "" {
+		log.Fatal("-org and -team are not supported in reaggregate mode; use -users or omit it to re-aggregate all stored logins.")
+	}
+
+	return commaList(*f.users)
+}
+
+// teamRef is one team of an organization.
+type teamRef struct {
+	org  string
+	slug string
+}
+
+// String returns the team as org/slug.
+func (t teamRef) String() string {
+	return t.org + "/" + t.slug
+}
+
+// resolveTeams qualifies each team with its organization. A team is either
+// written as org/slug, or as a bare slug of the only organization in orgs.
+func resolveTeams(orgs, teams []string) ([]teamRef, error) {
+	refs := make([]teamRef, 0, len(teams))
+
+	for _, team := range teams {
+		if org, slug, ok := strings.Cut(team, "/"); ok {
+			refs = append(refs, teamRef{org: org, slug: slug})
+
+			continue
+		}
+
+		switch len(orgs) {
+		case 0:
+			return nil, errTeamRequiresOrg
+		case 1:
+			refs = append(refs, teamRef{org: orgs[0], slug: team})
+		default:
+			return nil, fmt.Errorf("%w: %s", errAmbiguousTeam, team)
+		}
+	}
+
+	return refs, nil
+}
+
+// getUsers returns the members to analyze: the members of the teams if any
+// are given, otherwise of the organizations, plus the explicit -users, with
+// duplicates removed.
+func getUsers(roster *rosterFlags, gh infrastructure.GitHubClientConfig) []string {
+	orgs := roster.orgList()
+
+	teams, err := resolveTeams(orgs, commaList(*roster.teams))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	explicit := commaList(*roster.users)
+
+	var users []string
+
+	switch {
+	case len(teams) > 0:
+		for _, team := range teams {
+			users = append(users, fetchTeamRoster(gh, team)...)
+		}
+	case len(orgs) > 0:
+		for _, org := range orgs {
+			users = append(users, fetchOrganizationRoster(gh, org)...)
+		}
+	case len(explicit) == 0:
+		log.Fatal("Either -users or -org flag must be specified. Use -help for usage.")
+	}
+
+	users = uniqueLogins(append(users, explicit...))
+	if len(users) == 0 {
+		log.Fatal("No users specified for analysis.")
+	}
+
+	return users
+}
+
+// selectMembers returns the canonical logins of the members to analyze: the
+// roster without the excluded accounts, which are returned separately, with
+// the accounts of one person merged into their canonical login.
+func selectMembers(
+	roster *rosterFlags,
+	gh infrastructure.GitHubClientConfig,
+	exclusion *domain.ActorExclusion,
+	identities *domain.IdentityMap,
+) ([]string, []*domain.ExcludedActor) {
+	users, excluded := excludeFromRoster(getUsers(roster, gh), exclusion)
+	if len(users) == 0 {
+		log.Fatal("Every user matched the exclusion rules; nothing to analyze.")
+	}
+
+	return identities.Members(users), excluded
+}
+
+// fetchTeamRoster returns the members of team, exiting if there are none.
+func fetchTeamRoster(gh infrastructure.GitHubClientConfig, team teamRef) []string {
+	users, err := fetchTeamMembers(gh, team.org, team.slug)
+	if err != nil {
+		log.Fatalf("Failed to fetch team members: %v", err)
+	}
+
+	if len(users) == 0 {
+		log.Fatalf("No members found in team: %s", team)
+	}
+
+	fmt.Printf("Found %d members in team: %s\n", len(users), team)
+
+	return users
+}
+
+// fetchOrganizationRoster returns the members of org, exiting if there are
+// none.
+func fetchOrganizationRoster(gh infrastructure.GitHubClientConfig, org string) []string {
+	users, err := fetchOrganizationMembers(gh, org)
+	if err != nil {
+		log.Fatalf("Failed to fetch organization members: %v", err)
+	}
+
+	if len(users) == 0 {
+		log.Fatalf("No members found in organization: %s", org)
+	}
+
+	fmt.Printf("Found %d members in organization: %s\n", len(users), org)
+
+	return users
+}
+
+// uniqueLogins removes case-insensitive duplicates, keeping the first
+// occurrence.
+func uniqueLogins(logins []string) []string {
+	out := make([]string, 0, len(logins))
+	seen := make(map[string]struct{}, len(logins))
+
+	for _, login := range logins {
+		key := strings.ToLower(login)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+
+		seen[key] = struct{}{}
+		out = append(out, login)
+	}
+
+	return out
+}
+
+// commaList splits a comma-separated flag value, dropping blank items. An
+// empty value yields nil.
+func commaList(s string) []string {
+	var out []string
+
+	for _, item := range splitUsers(s) {
+		if item != "" {
+			out = append(out, item)
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,249 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Tattsum/github-analytics/application"
+	"github.com/Tattsum/github-analytics/infrastructure"
+	"github.com/Tattsum/github-analytics/infrastructure/snapshotdb"
+	"github.com/Tattsum/github-analytics/presentation"
+)
+
+var (
+	// errMissingSnapshotSubcommand is returned when "snapshot" is run without a subcommand.
+	errMissingSnapshotSubcommand = errors.New("missing subcommand; usage: github-analytics snapshot diff|prune|tag [flags]")
+	// errNotEnoughSnapshots is returned when a diff has no snapshot to compare against.
+	errNotEnoughSnapshots = errors.New("at least two snapshots are required to diff; pass -base and -head explicitly")
+	// errMissingSnapshotID is returned when "snapshot tag" is run without -id.
+	errMissingSnapshotID = errors.New("-id is required")
+	// errMissingSnapshotDatabase is returned when DATABASE_URL is unset.
+	errMissingSnapshotDatabase = errors.New("DATABASE_URL environment variable is not set")
+)
+
+// runSnapshotCommand handles the "snapshot" subcommands, which inspect the
+// snapshots stored in PostgreSQL without any GitHub access.
+func runSnapshotCommand(args []string) {
+	if err := executeSnapshotCommand(args); err != nil {
+		log.Fatalf("snapshot: %v", err)
+	}
+}
+
+// executeSnapshotCommand dispatches to the requested snapshot subcommand.
+func executeSnapshotCommand(args []string) error {
+	if len(args) == 0 {
+		return errMissingSnapshotSubcommand
+	}
+
+	switch args[0] {
+	case "diff":
+		return executeSnapshotDiff(args[1:])
+	case "prune":
+		return executeSnapshotPrune(args[1:])
+	case "tag":
+		return executeSnapshotTag(args[1:])
+	default:
+		return fmt.Errorf("unknown subcommand %q: %w", args[0], errMissingSnapshotSubcommand)
+	}
+}
+
+// executeSnapshotDiff prints what changed between two snapshots. Without
+// -head the latest snapshot is used, and without -base the snapshot captured
+// just before head.
+func executeSnapshotDiff(args []string) error {
+	fs := flag.NewFlagSet("snapshot diff", flag.ContinueOnError)
+	baseID := fs.Int("base", 0, "比較元のスナップショットID（省略時は -head の直前のスナップショット）")
+	headID := fs.Int("head", 0, "比較先のスナップショットID（省略時は最新）")
+	format := fs.String("format", "table", "出力形式: table または json")
+
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("failed to parse flags: %w", err)
+	}
+
+	if *format != "table" && *format != "json" {
+		return fmt.Errorf("unknown -format %q; use table or json", *format)
+	}
+
+	return withSnapshotDB(func(ctx context.Context, client *infrastructure.EntClient) error {
+		reader := snapshotdb.NewSnapshotReader(client)
+
+		base, head := *baseID, *headID
+		if base == 0 || head == 0 {
+			snapshots, err := reader.Snapshots(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to list snapshots: %w", err)
+			}
+
+			base, head, err = defaultDiffPair(snapshots, base, head)
+			if err != nil {
+				return err
+			}
+		}
+
+		diff, err := application.CompareSnapshots(ctx, reader, base, head)
+		if err != nil {
+			return fmt.Errorf("failed to diff snapshots: %w", err)
+		}
+
+		if *format == "json" {
+			return presentation.WriteSnapshotDiffJSON(os.Stdout, diff)
+		}
+
+		return presentation.WriteSnapshotDiffTable(os.Stdout, diff)
+	})
+}
+
+// executeSnapshotPrune applies the retention policy given by the flags and
+// deletes every snapshot it does not keep, together with its stat rows, in one
+// transaction. With -dry-run it only prints the plan.
+func executeSnapshotPrune(args []string) error {
+	const (
+		defaultKeepLast = 10
+		hoursPerDay     = 24
+	)
+
+	fs := flag.NewFlagSet("snapshot prune", flag.ContinueOnError)
+	keepLast := fs.Int("keep-last", defaultKeepLast, "常に保持する最新スナップショットの件数（1 以上）")
+	horizonDays := fs.Int("horizon-days", 0, "すべてのスナップショットを保持する日数（これより古いものが間引きの対象）")
+	keepOnePer := fs.String("keep-one-per", "month", "horizon より古いスナップショットを間引く単位: day、week、month または none（保持しない）")
+	dryRun := fs.Bool("dry-run", false, "削除せず、保持 / 削除の判定だけを表示する")
+
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("failed to parse flags: %w", err)
+	}
+
+	policy := application.RetentionPolicy{
+		KeepLast: *keepLast,
+		Horizon:  time.Duration(*horizonDays) * hoursPerDay * time.Hour,
+	}
+
+	if *keepOnePer != "none" {
+		policy.KeepOnePer = application.Granularity(strings.ToUpper(*keepOnePer))
+	}
+
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+
+	return withSnapshotDB(func(ctx context.Context, client *infrastructure.EntClient) error {
+		snapshots, err := snapshotdb.NewSnapshotReader(client).Snapshots(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list snapshots: %w", err)
+		}
+
+		plan, err := application.PlanRetention(snapshots, policy, time.Now())
+		if err != nil {
+			return err
+		}
+
+		if err := presentation.WriteRetentionPlan(os.Stdout, plan); err != nil {
+			return err
+		}
+
+		ids := plan.PruneIDs()
+		if *dryRun {
+			fmt.Printf("\n[dry-run] %d 件のスナップショットが削除対象です（削除していません）\n", len(ids))
+
+			return nil
+		}
+
+		deleted, err := snapshotdb.NewSnapshotMaintainer(client).DeleteSnapshots(ctx, ids)
+		if err != nil {
+			return fmt.Errorf("failed to prune snapshots: %w", err)
+		}
+
+		fmt.Printf("\n=== 削除完了 ===\n%d 件のスナップショットを削除しました（保持: %d 件）\n", deleted, len(snapshots)-deleted)
+
+		return nil
+	})
+}
+
+// executeSnapshotTag sets or clears the tag of one snapshot. Tagged snapshots
+// are never deleted by prune.
+func executeSnapshotTag(args []string) error {
+	fs := flag.NewFlagSet("snapshot tag", flag.ContinueOnError)
+	id := fs.Int("id", 0, "タグを付けるスナップショットID")
+	tag := fs.String("tag", "", "付けるタグ（空文字でタグを外す）")
+
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("failed to parse flags: %w", err)
+	}
+
+	if *id <= 0 {
+		return errMissingSnapshotID
+	}
+
+	return withSnapshotDB(func(ctx context.Context, client *infrastructure.EntClient) error {
+		if err := snapshotdb.NewSnapshotMaintainer(client).TagSnapshot(ctx, *id, *tag); err != nil {
+			return fmt.Errorf("failed to tag snapshot: %w", err)
+		}
+
+		if *tag == "" {
+			fmt.Printf("スナップショット %d のタグを外しました\n", *id)
+		} else {
+			fmt.Printf("スナップショット %d にタグ %q を付けました\n", *id, *tag)
+		}
+
+		return nil
+	})
+}
+
+// withSnapshotDB opens the PostgreSQL database named by DATABASE_URL, runs fn
+// with a bounded context, and always closes the connection afterwards. The
+// snapshot subcommands only operate on existing snapshots, so no migration is
+// run here.
+func withSnapshotDB(fn func(ctx context.Context, client *infrastructure.EntClient) error) error {
+	const timeoutMinutes = 5
+
+	databaseURL := os.Getenv("DATABASE_URL")
+	if databaseURL == "" {
+		return errMissingSnapshotDatabase
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeoutMinutes*time.Minute)
+	defer cancel()
+
+	client, err := infrastructure.OpenPostgres(databaseURL)
+	if err != nil {
+		return fmt.Errorf("failed to open PostgreSQL connection: %w", err)
+	}
+
+	defer func() {
+		if cerr := client.Close(); cerr != nil {
+			log.Printf("Failed to close PostgreSQL connection: %v", cerr)
+		}
+	}()
+
+	return fn(ctx, client)
+}
+
+// defaultDiffPair fills in an omitted (zero) head with the latest snapshot and
+// an omitted base with the snapshot listed right after head. snapshots must be
+// ordered newest first, as SnapshotReader.Snapshots returns them.
+func defaultDiffPair(snapshots []*application.SnapshotInfo, base, head int) (int, int, error) {
+	if head == 0 {
+		if len(snapshots) == 0 {
+			return 0, 0, errNotEnoughSnapshots
+		}
+
+		head = snapshots[0].ID
+	}
+
+	if base != 0 {
+		return base, head, nil
+	}
+
+	for i, info := range snapshots {
+		if info.ID == head && i+1 < len(snapshots) {
+			return snapshots[i+1].ID, head, nil
+		}
+	}
+
+	return 0, 0, errNotEnoughSnapshots
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Tattsum/github-analytics/application"
+	"github.com/Tattsum/github-analytics/domain"
+)
+
+const (
+	// defaultConcurrency is the default number of users fetched in parallel.
+	defaultConcurrency = 4
+	// maxConcurrency caps -concurrency. All workers share one GitHubClient and
+	// therefore one rate limiter, so more workers only overlap response
+	// latency; beyond this GitHub's secondary rate limits become the risk.
+	maxConcurrency = 16
+)
+
+// errInvalidConcurrency is returned when -concurrency is out of range.
+var errInvalidConcurrency = fmt.Errorf("-concurrency must be between 1 and %d", maxConcurrency)
+
+// validateConcurrency checks the -concurrency flag value.
+func validateConcurrency(n int) error {
+	if n < 1 || n > maxConcurrency {
+		return fmt.Errorf("%w, got %d", errInvalidConcurrency, n)
+	}
+
+	return nil
+}
+
+// printUserProgress prints one line per finished user. The pool calls it
+// serially, so lines never interleave.
+func printUserProgress(p application.UserProgress) {
+	if p.Err != nil {
+		log.Printf("[%d/%d] Error processing user %s: %v", p.Done, p.Total, p.Login, p.Err)
+
+		return
+	}
+
+	fmt.Printf("[%d/%d] Completed processing user: %s (%s)\n", p.Done, p.Total, p.Login, p.Elapsed.Round(time.Millisecond))
+}
+
+// printSkippedUsers prints the users that were not included in the results,
+// so a partial run is visible at the end of the output rather than only in
+// the scrolled-past per-user log lines.
+func printSkippedUsers(failures []*application.UserFailure) {
+	if len(failures) == 0 {
+		return
+	}
+
+	fmt.Printf("\n=== スキップしたユーザー（%d 人） ===\n", len(failures))
+
+	for _, f := range failures {
+		fmt.Printf("  %s: %v\n", f.Login, f.Err)
+	}
+}
+
+// printIncompleteUsers prints the users whose statistics are missing part of
+// their activity (GitHub kept failing for some repositories even after
+// retries). They are included in the results but flagged as incomplete.
+func printIncompleteUsers(stats []*domain.UserStatistics) {
+	incomplete := make([]*domain.UserStatistics, 0)
+
+	for _, s := range stats {
+		if s != nil && !s.IsComplete() {
+			incomplete = append(incomplete, s)
+		}
+	}
+
+	if len(incomplete) == 0 {
+		return
+	}
+
+	fmt.Printf("\n=== データが欠けているユーザー（%d 人） ===\n", len(incomplete))
+
+	for _, s := range incomplete {
+		for _, gap := range s.DataGaps {
+			fmt.Printf("  %s: %s %s [%s] %s\n", s.User.Login, gap.ActivityType, gap.Repository, gap.Reason, gap.Message)
+		}
+	}
+}
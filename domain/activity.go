@@ -1,7 +1,10 @@
 // Package domain defines core business entities and value objects.
 package domain
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 // ActivityType は活動の種類を表します.
 type ActivityType string
@@ -17,8 +20,14 @@ const (
 	ActivityTypeReview ActivityType = "review"
 	// ActivityTypePRMerge はマージされたPull Request活動を表します.
 	ActivityTypePRMerge ActivityType = "pr_merge"
+	// ActivityTypeIssueClose はIssueをクローズした活動を表します（クローズしたアカウントに帰属します）.
+	ActivityTypeIssueClose ActivityType = "issue_close"
 )
 
+// naturalKeySeparator はナチュラルキーの構成要素の区切り文字です.
+// ログイン名・リポジトリ名・ノードID・RFC3339 日時のいずれにも現れないため安全です.
+const naturalKeySeparator = "|"
+
 // Activity は1つの活動を表す値オブジェクトです.
 type Activity struct {
 	Type       ActivityType
@@ -33,6 +42,37 @@ type Activity struct {
 	Deletions           int
 	IsMerged            bool // PRの場合のみ有効
 	IsReview            bool // Reviewの場合のみ有効
+	// SourceID は GitHub のノードID（PR / Issue / Review）です.
+	// コミット貢献はノードIDを持たないため空文字です.
+	SourceID string
+	// PullRequestAuthor はレビュー対象PRの作成者ログインです（Reviewの場合のみ有効。不明な場合は空文字）.
+	PullRequestAuthor string
+	// PullRequestAuthorType はレビュー対象PRの作成者の種別（"User" / "Bot" など）です（Reviewの場合のみ有効。不明な場合は空文字）.
+	PullRequestAuthorType string
+	// IssueKind はラベルから判定したIssueの種類です（Issueの作成・クローズの場合のみ有効。不明な場合は空文字）.
+	IssueKind IssueKind
+	// IssueOpenedAt はクローズしたIssueの作成日時です（Issueのクローズの場合のみ有効）.
+	IssueOpenedAt time.Time
+	// WorkCategory はラベル・タイトル・変更したファイルから判定したPRの作業の種類です（PRの場合のみ有効。判定していない場合は空文字）.
+	WorkCategory WorkCategory
+	// DayOnly は Date が日単位で時刻を持たないかです（ユーザー単位の収集のコミット貢献. GitHub は日ごとに1件の貢献と数えます）.
+	DayOnly bool
+}
+
+// ActivityNaturalKey はイベントストアでの重複排除に用いる、活動のナチュラルキーを返します.
+// ノードIDを持つ活動は login・種類・ノードIDで、持たないコミット貢献は login・種類・リポジトリ・発生日時で識別します.
+// 同じ期間を再取得しても同じキーになるため、追記専用のストアに重複が生じません.
+func ActivityNaturalKey(login string, activity *Activity) string {
+	if activity.SourceID != "" {
+		return strings.Join([]string{login, string(activity.Type), activity.SourceID}, naturalKeySeparator)
+	}
+
+	return strings.Join([]string{
+		login,
+		string(activity.Type),
+		activity.Repository,
+		activity.Date.UTC().Format(time.RFC3339Nano),
+	}, naturalKeySeparator)
 }
 
 // NewActivity は新しいActivity値オブジェクトを作成します.
@@ -61,6 +101,10 @@ type RepositoryActivity struct {
 	TotalDeletions int
 	FirstActivity  time.Time
 	LastActivity   time.Time
+	// IssueThroughput はこのリポジトリでのIssueのクローズ数・クローズまでの時間と種類別の内訳です.
+	IssueThroughput IssueThroughput
+	// WorkCategories はこのリポジトリで作成したPRの作業の種類別の件数です.
+	WorkCategories WorkCategoryCounts
 }
 
 // NewRepositoryActivity は新しいRepositoryActivity値オブジェクトを作成します.
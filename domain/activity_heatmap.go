@@ -0,0 +1,160 @@
+package domain
+
+import (
+	"sort"
+	"time"
+)
+
+const (
+	// WorkingHoursStart は勤務時間の始まりの時（現地時刻）です.
+	WorkingHoursStart = 9
+	// WorkingHoursEnd は勤務時間の終わりの時（現地時刻、この時を含みません）です.
+	WorkingHoursEnd = 18
+	// daysPerWeek・hoursPerDay はヒートマップの行数・列数です.
+	daysPerWeek = 7
+	hoursPerDay = 24
+)
+
+// HourlyActivity はメンバーの現地時刻の1時間に行った活動の件数です.
+type HourlyActivity struct {
+	// Start は現地時刻の時間の始まりです.
+	Start time.Time
+	// Day は現地時刻の日付（"2006-01-02" 形式）です.
+	Day string
+	// Weekday・Hour は現地時刻の曜日と時（0〜23）です.
+	Weekday time.Weekday
+	Hour    int
+	Count   int
+}
+
+// CountHourlyActivity は活動を現地時刻 loc の1時間ごとに数え、時間の古い順に返します（loc が nil なら UTC）.
+// 時刻を持たない活動（ユーザー単位の収集のコミット貢献）は数えません.
+func CountHourlyActivity(activities []*Activity, loc *time.Location) []*HourlyActivity {
+	buckets := newHourlyBuckets(loc)
+
+	for _, activity := range activities {
+		if activity == nil || activity.DayOnly || activity.Date.IsZero() {
+			continue
+		}
+
+		buckets.add(activity.Date, 1)
+	}
+
+	return buckets.sorted()
+}
+
+// RebucketHourlyActivity は1時間ごとの件数を、現地時刻 loc の1時間ごとに数え直します（loc が nil なら UTC）.
+// メンバーのタイムゾーンを変えた後に、以前のタイムゾーンで数えた件数を引き継ぐために使います.
+func RebucketHourlyActivity(hours []*HourlyActivity, loc *time.Location) []*HourlyActivity {
+	buckets := newHourlyBuckets(loc)
+
+	for _, hour := range hours {
+		if hour != nil {
+			buckets.add(hour.Start, hour.Count)
+		}
+	}
+
+	return buckets.sorted()
+}
+
+// hourlyBuckets は現地時刻の1時間ごとの件数を、時間の始まりの時刻で集めます.
+type hourlyBuckets struct {
+	loc   *time.Location
+	byKey map[int64]*HourlyActivity
+}
+
+// newHourlyBuckets は現地時刻 loc（nil なら UTC）で数える hourlyBuckets を作成します.
+func newHourlyBuckets(loc *time.Location) *hourlyBuckets {
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	return &hourlyBuckets{loc: loc, byKey: make(map[int64]*HourlyActivity)}
+}
+
+// add は at を含む現地時刻の1時間に count を加えます.
+func (b *hourlyBuckets) add(at time.Time, count int) {
+	local := at.In(b.loc)
+	start := time.Date(local.Year(), local.Month(), local.Day(), local.Hour(), 0, 0, 0, b.loc)
+
+	hour, ok := b.byKey[start.Unix()]
+	if !ok {
+		hour = &HourlyActivity{
+			Start:   start,
+			Day:     start.Format(time.DateOnly),
+			Weekday: start.Weekday(),
+			Hour:    start.Hour(),
+		}
+		b.byKey[start.Unix()] = hour
+	}
+
+	hour.Count += count
+}
+
+// sorted は集めた件数を時間の古い順に返します.
+func (b *hourlyBuckets) sorted() []*HourlyActivity {
+	out := make([]*HourlyActivity, 0, len(b.byKey))
+	for _, hour := range b.byKey {
+		out = append(out, hour)
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Start.Before(out[j].Start)
+	})
+
+	return out
+}
+
+// ActivityHeatmap は曜日×時（7×24）ごとの活動件数と、勤務時間外の活動の割合です.
+type ActivityHeatmap struct {
+	Login string
+	// TimeZone は曜日・時を求めたタイムゾーン（IANA の名前）です.
+	TimeZone string
+	// Counts は曜日（月曜始まり）×時（0〜23）ごとの件数です.
+	Counts [daysPerWeek][hoursPerDay]int
+	Total  int
+	// OffHours は平日の勤務時間（WorkingHoursStart〜WorkingHoursEnd）外の件数です.
+	OffHours int
+	// Weekend は土曜・日曜の件数です.
+	Weekend int
+}
+
+// NewActivityHeatmap はメンバー login の1時間ごとの件数を、曜日×時に合算したヒートマップを作成します.
+func NewActivityHeatmap(login, timeZone string, hours []*HourlyActivity) *ActivityHeatmap {
+	heatmap := &ActivityHeatmap{Login: login, TimeZone: timeZone}
+	for _, hour := range hours {
+		if hour != nil {
+			heatmap.Add(hour.Weekday, hour.Hour, hour.Count)
+		}
+	}
+
+	return heatmap
+}
+
+// Add は曜日 weekday・時 hour の件数に count を加えます（範囲外の時は無視します）.
+func (h *ActivityHeatmap) Add(weekday time.Weekday, hour, count int) {
+	if hour < 0 || hour >= hoursPerDay || weekday < time.Sunday || weekday > time.Saturday {
+		return
+	}
+
+	// 月曜を先頭の行にします（日曜は最後の行です）.
+	row := (int(weekday) + daysPerWeek - 1) % daysPerWeek
+	h.Counts[row][hour] += count
+	h.Total += count
+
+	switch {
+	case weekday == time.Saturday || weekday == time.Sunday:
+		h.Weekend += count
+	case hour < WorkingHoursStart || hour >= WorkingHoursEnd:
+		h.OffHours += count
+	}
+}
+
+// OffHoursRatio は全活動に占める勤務時間外（平日の時間外と週末）の活動の割合を返します（活動が無ければ0）.
+func (h *ActivityHeatmap) OffHoursRatio() float64 {
+	if h.Total == 0 {
+		return 0
+	}
+
+	return float64(h.OffHours+h.Weekend) / float64(h.Total)
+}
@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCountHourlyActivity(t *testing.T) {
+	t.Parallel()
+
+	tokyo := time.FixedZone("JST", 9*60*60)
+	// 2024-03-15（金）15:10 UTC は 2024-03-16（土）00:10 JST.
+	late := time.Date(2024, 3, 15, 15, 10, 0, 0, time.UTC)
+
+	hours := CountHourlyActivity([]*Activity{
+		{Type: ActivityTypePR, Date: late},
+		{Type: ActivityTypeReview, Date: late.Add(40 * time.Minute)},
+		{Type: ActivityTypeIssue, Date: late.Add(time.Hour)},
+		// 日単位のコミット貢献は時刻を持たないため数えない.
+		{Type: ActivityTypeCommit, Date: late, DayOnly: true},
+		nil,
+	}, tokyo)
+
+	require.Len(t, hours, 2)
+	assert.Equal(t, "2024-03-16", hours[0].Day)
+	assert.Equal(t, time.Saturday, hours[0].Weekday)
+	assert.Equal(t, 0, hours[0].Hour)
+	assert.Equal(t, 2, hours[0].Count)
+	assert.True(t, hours[0].Start.Equal(time.Date(2024, 3, 15, 15, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 1, hours[1].Hour)
+	assert.Equal(t, 1, hours[1].Count)
+}
+
+func TestRebucketHourlyActivity(t *testing.T) {
+	t.Parallel()
+
+	utcHours := CountHourlyActivity([]*Activity{
+		{Date: time.Date(2024, 3, 15, 15, 10, 0, 0, time.UTC)},
+		{Date: time.Date(2024, 3, 15, 16, 20, 0, 0, time.UTC)},
+	}, nil)
+	require.Len(t, utcHours, 2)
+	assert.Equal(t, time.Friday, utcHours[0].Weekday)
+
+	// 30分ずれたタイムゾーンでは、UTC の2つの時間が現地時刻の同じ時間に入ることがある.
+	india := time.FixedZone("IST", 5*60*60+30*60)
+	hours := RebucketHourlyActivity(utcHours, india)
+
+	require.Len(t, hours, 2)
+	assert.Equal(t, 20, hours[0].Hour)
+	assert.Equal(t, 1, hours[0].Count)
+	assert.Equal(t, 21, hours[1].Hour)
+	assert.Equal(t, 1, hours[1].Count)
+}
+
+func TestNewActivityHeatmap(t *testing.T) {
+	t.Parallel()
+
+	heatmap := NewActivityHeatmap("octocat", "Asia/Tokyo", []*HourlyActivity{
+		{Weekday: time.Monday, Hour: 10, Count: 4},
+		{Weekday: time.Monday, Hour: 23, Count: 2},
+		{Weekday: time.Friday, Hour: 8, Count: 1},
+		{Weekday: time.Sunday, Hour: 14, Count: 3},
+		nil,
+	})
+
+	assert.Equal(t, "octocat", heatmap.Login)
+	assert.Equal(t, "Asia/Tokyo", heatmap.TimeZone)
+	assert.Equal(t, 10, heatmap.Total)
+	assert.Equal(t, 3, heatmap.OffHours)
+	assert.Equal(t, 3, heatmap.Weekend)
+	assert.InDelta(t, 0.6, heatmap.OffHoursRatio(), 1e-9)
+
+	// 月曜が先頭の行、日曜が最後の行.
+	assert.Equal(t, 4, heatmap.Counts[0][10])
+	assert.Equal(t, 1, heatmap.Counts[4][8])
+	assert.Equal(t, 3, heatmap.Counts[6][14])
+
+	assert.Zero(t, NewActivityHeatmap("octocat", "UTC", nil).OffHoursRatio())
+}
@@ -100,3 +100,52 @@ func TestNewRepositoryActivity(t *testing.T) {
 		})
 	}
 }
+
+func TestActivityNaturalKey(t *testing.T) {
+	t.Parallel()
+
+	occurredAt := time.Date(2024, 3, 10, 12, 30, 0, 0, time.UTC)
+
+	withSourceID := NewActivity(ActivityTypePR, "owner/repo", occurredAt, 10, 5)
+	withSourceID.SourceID = "PR_kwDOABC"
+
+	tests := []struct {
+		name     string
+		login    string
+		activity *Activity
+		want     string
+	}{
+		{
+			name:     "ノードIDを持つ活動はノードIDで識別する",
+			login:    "alice",
+			activity: withSourceID,
+			want:     "alice|pull_request|PR_kwDOABC",
+		},
+		{
+			name:     "ノードIDを持たないコミット貢献はリポジトリと発生日時で識別する",
+			login:    "alice",
+			activity: NewActivity(ActivityTypeCommit, "owner/repo", occurredAt, 0, 0),
+			want:     "alice|commit|owner/repo|2024-03-10T12:30:00Z",
+		},
+		{
+			name:  "発生日時はUTCへ正規化する",
+			login: "alice",
+			activity: NewActivity(
+				ActivityTypeCommit,
+				"owner/repo",
+				occurredAt.In(time.FixedZone("JST", 9*60*60)),
+				0,
+				0,
+			),
+			want: "alice|commit|owner/repo|2024-03-10T12:30:00Z",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, ActivityNaturalKey(tt.login, tt.activity))
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ActorTypeBot は GitHub App などのボットアカウントの種別（GraphQL の __typename）です.
+const ActorTypeBot = "Bot"
+
+// ErrInvalidExclusionPattern は除外ルールの正規表現が不正であることを表します.
+var ErrInvalidExclusionPattern = errors.New("invalid exclusion pattern")
+
+// ExcludedActor は集計から除外したアカウントと、一致した除外ルールです.
+type ExcludedActor struct {
+	Login string
+	// Rule は一致した除外ルールです（"login"、"pattern:<正規表現>"、"bot"）.
+	Rule string
+}
+
+// ActorExclusion はボット・サービスアカウントを集計から除外するルールです.
+// ログイン名（大文字小文字を区別しない）・正規表現・GitHub の Bot 種別のいずれかに一致したアカウントを除外します.
+// nil は何も除外しません.
+type ActorExclusion struct {
+	logins   map[string]struct{}
+	patterns []*regexp.Regexp
+	bots     bool
+}
+
+// NewActorExclusion は除外ルールを作成します.
+// patterns はログイン名全体ではなく部分に一致すれば除外します（全体に一致させる場合は ^ と $ を付けます）.
+func NewActorExclusion(logins, patterns []string, excludeBots bool) (*ActorExclusion, error) {
+	e := &ActorExclusion{
+		logins:   make(map[string]struct{}, len(logins)),
+		patterns: make([]*regexp.Regexp, 0, len(patterns)),
+		bots:     excludeBots,
+	}
+
+	for _, login := range logins {
+		if login = strings.TrimSpace(login); login != "" {
+			e.logins[strings.ToLower(login)] = struct{}{}
+		}
+	}
+
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("%w %q: %w", ErrInvalidExclusionPattern, pattern, err)
+		}
+
+		e.patterns = append(e.patterns, re)
+	}
+
+	return e, nil
+}
+
+// Match は login（種別 actorType）が除外対象かどうかと、一致したルールを返します.
+// actorType が不明な場合は空文字を渡します（Bot 種別のルールには一致しません）.
+func (e *ActorExclusion) Match(login, actorType string) (string, bool) {
+	if e == nil || login == "" {
+		return "", false
+	}
+
+	if e.bots && actorType == ActorTypeBot {
+		return "bot", true
+	}
+
+	if _, ok := e.logins[strings.ToLower(login)]; ok {
+		return "login", true
+	}
+
+	for _, re := range e.patterns {
+		if re.MatchString(login) {
+			return "pattern:" + re.String(), true
+		}
+	}
+
+	return "", false
+}
+
+// Excludes は login（種別 actorType）が除外対象かどうかを返します.
+func (e *ActorExclusion) Excludes(login, actorType string) bool {
+	_, ok := e.Match(login, actorType)
+
+	return ok
+}
+
+// FilterLogins は logins から除外対象を取り除いたものと、除外したアカウントを返します.
+// 組織・チームのメンバー一覧はユーザーだけを返すため、種別は問いません.
+func (e *ActorExclusion) FilterLogins(logins []string) ([]string, []*ExcludedActor) {
+	kept := make([]string, 0, len(logins))
+	excluded := make([]*ExcludedActor, 0)
+
+	for _, login := range logins {
+		if rule, ok := e.Match(login, ""); ok {
+			excluded = append(excluded, &ExcludedActor{Login: login, Rule: rule})
+
+			continue
+		}
+
+		kept = append(kept, login)
+	}
+
+	return kept, excluded
+}
@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActorExclusion_Match(t *testing.T) {
+	t.Parallel()
+
+	exclusion, err := NewActorExclusion([]string{"Renovate", " "}, []string{`^ci-`, `-bot$`}, true)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		login     string
+		actorType string
+		wantRule  string
+		wantMatch bool
+	}{
+		{name: "ログイン名は大文字小文字を区別しない", login: "renovate", actorType: "User", wantRule: "login", wantMatch: true},
+		{name: "正規表現に一致", login: "ci-deployer", actorType: "User", wantRule: "pattern:^ci-", wantMatch: true},
+		{name: "2つ目の正規表現に一致", login: "release-bot", actorType: "", wantRule: "pattern:-bot$", wantMatch: true},
+		{name: "Bot 種別", login: "dependabot", actorType: ActorTypeBot, wantRule: "bot", wantMatch: true},
+		{name: "通常のユーザー", login: "alice", actorType: "User", wantMatch: false},
+		{name: "空のログインは対象外", login: "", actorType: ActorTypeBot, wantMatch: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rule, ok := exclusion.Match(tt.login, tt.actorType)
+			assert.Equal(t, tt.wantMatch, ok)
+			assert.Equal(t, tt.wantRule, rule)
+		})
+	}
+}
+
+func TestActorExclusion_Nil(t *testing.T) {
+	t.Parallel()
+
+	var exclusion *ActorExclusion
+
+	assert.False(t, exclusion.Excludes("dependabot", ActorTypeBot), "nil は何も除外しない")
+
+	kept, excluded := exclusion.FilterLogins([]string{"alice", "dependabot"})
+	assert.Equal(t, []string{"alice", "dependabot"}, kept)
+	assert.Empty(t, excluded)
+}
+
+func TestActorExclusion_FilterLogins(t *testing.T) {
+	t.Parallel()
+
+	exclusion, err := NewActorExclusion([]string{"ci-user"}, []string{`^svc-`}, true)
+	require.NoError(t, err)
+
+	kept, excluded := exclusion.FilterLogins([]string{"alice", "ci-user", "svc-deploy", "bob"})
+
+	assert.Equal(t, []string{"alice", "bob"}, kept)
+	assert.Equal(t, []*ExcludedActor{
+		{Login: "ci-user", Rule: "login"},
+		{Login: "svc-deploy", Rule: "pattern:^svc-"},
+	}, excluded)
+}
+
+func TestNewActorExclusion_InvalidPattern(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewActorExclusion(nil, []string{"("}, false)
+	require.ErrorIs(t, err, ErrInvalidExclusionPattern)
+}
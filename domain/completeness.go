@@ -0,0 +1,39 @@
+package domain
+
+// DataGapReason は取得できなかったデータの失敗の分類です.
+type DataGapReason string
+
+const (
+	// DataGapTransient は再試行しても解消しなかった一時的な障害（5xx・タイムアウト等）です.
+	DataGapTransient DataGapReason = "transient"
+	// DataGapRateLimited はレート制限（セカンダリレート制限を含む）が解除されなかったことを表します.
+	DataGapRateLimited DataGapReason = "rate_limited"
+	// DataGapNotFound は対象が存在しない（削除・移管・非公開化された）ことを表します.
+	DataGapNotFound DataGapReason = "not_found"
+	// DataGapPermission はトークンに対象を読む権限が無いことを表します.
+	DataGapPermission DataGapReason = "permission"
+	// DataGapTruncated は検索結果が検索 API の上限を超え、一部しか取得できなかったことを表します.
+	DataGapTruncated DataGapReason = "truncated"
+	// DataGapUnsupported は接続先の GitHub（古い GitHub Enterprise Server など）が取得に必要なフィールドを提供しないことを表します.
+	DataGapUnsupported DataGapReason = "unsupported"
+	// DataGapUnknown は上記のいずれにも分類できない失敗です.
+	DataGapUnknown DataGapReason = "unknown"
+)
+
+// DataGap はメンバーの活動のうち、取得に失敗してスナップショットに含まれていない範囲です.
+// 1件でもあるメンバーの集計値は実際より小さい可能性があります.
+type DataGap struct {
+	// ActivityType は欠けている活動の種類です.
+	ActivityType ActivityType
+	// Repository は欠けているリポジトリです（リポジトリを特定できない場合は空文字）.
+	Repository string
+	// Reason は失敗の分類です.
+	Reason DataGapReason
+	// Message は失敗したときのエラーメッセージです.
+	Message string
+}
+
+// IsComplete はデータの取得に1件も失敗していないかどうかを返します.
+func (us *UserStatistics) IsComplete() bool {
+	return len(us.DataGaps) == 0
+}
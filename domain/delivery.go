@@ -0,0 +1,337 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// DeliverySource は配信（デプロイ）として数えた記録の種類です.
+type DeliverySource string
+
+const (
+	// DeliverySourceDeployment は GitHub Deployment をデプロイとして数えたことを表します.
+	DeliverySourceDeployment DeliverySource = "DEPLOYMENT"
+	// DeliverySourceRelease は Deployment が無いリポジトリで、公開済みのリリースをデプロイとして数えたことを表します.
+	DeliverySourceRelease DeliverySource = "RELEASE"
+	// DeliverySourceTag は Deployment もリリースも無いリポジトリで、タグをデプロイとして数えたことを表します.
+	DeliverySourceTag DeliverySource = "TAG"
+)
+
+// DeploymentState はデプロイメントの最新ステータスの状態（GitHub の DeploymentStatusState）です.
+type DeploymentState string
+
+const (
+	// DeploymentStateSuccess は成功したデプロイメントを表します.
+	DeploymentStateSuccess DeploymentState = "SUCCESS"
+	// DeploymentStateInactive は成功した後、新しいデプロイメントに置き換えられたデプロイメントを表します.
+	DeploymentStateInactive DeploymentState = "INACTIVE"
+	// DeploymentStateFailure は失敗したデプロイメントを表します.
+	DeploymentStateFailure DeploymentState = "FAILURE"
+	// DeploymentStateError はエラーで終わったデプロイメントを表します.
+	DeploymentStateError DeploymentState = "ERROR"
+)
+
+// productionEnvironmentKeyword を名前に含む環境を本番環境とみなします.
+const productionEnvironmentKeyword = "prod"
+
+// Deployment はリポジトリのデプロイメント1件です.
+type Deployment struct {
+	Environment string
+	CommitOID   string
+	CreatedAt   time.Time
+	// State は最新のデプロイメントステータスの状態です（ステータスが無ければ空文字）.
+	State DeploymentState
+	// StatusAt は最新のデプロイメントステータスの作成日時です.
+	StatusAt time.Time
+}
+
+// Release はリポジトリのリリース1件です.
+type Release struct {
+	TagName      string
+	CommitOID    string
+	PublishedAt  time.Time
+	IsDraft      bool
+	IsPrerelease bool
+}
+
+// Tag はリポジトリのタグ1件です.
+type Tag struct {
+	Name      string
+	CommitOID string
+	// At はタグの作成日時（注釈付きタグ）またはタグが指すコミットの日時です.
+	At time.Time
+}
+
+// RepositoryDeliveries はリポジトリ1件のデプロイメント・リリース・タグです.
+type RepositoryDeliveries struct {
+	Repository  string
+	Deployments []*Deployment
+	Releases    []*Release
+	Tags        []*Tag
+}
+
+// DeliveryWeek はリポジトリ×週1件分のデリバリー指標（DORA の4指標の元データ）です.
+// リードタイム・復旧時間は合計と件数で持ち、期間をまたいだ平均を後から求められるようにします.
+type DeliveryWeek struct {
+	Repository string
+	// Week は週の開始日（月曜、"2006-01-02" 形式、UTC）です.
+	Week string
+	// Source はデプロイとして数えた記録の種類です.
+	Source DeliverySource
+	// DeploymentCount はこの週に完了したデプロイ数です（失敗を含む）.
+	DeploymentCount int
+	// FailedDeploymentCount は失敗した、またはロールバックされたデプロイ数です.
+	FailedDeploymentCount int
+	// LeadTimeSeconds は、この週のデプロイで本番に届いたPRの、マージからデプロイまでの秒数の合計です.
+	LeadTimeSeconds int
+	// LeadTimeCount は LeadTimeSeconds に含めたPR数です.
+	LeadTimeCount int
+	// RestoreSeconds は、この週に始まった失敗から次に成功したデプロイまでの秒数の合計です.
+	RestoreSeconds int
+	// RestoreCount は RestoreSeconds に含めた（復旧済みの）失敗の数です.
+	RestoreCount int
+}
+
+// delivery はデプロイとして数える記録1件です.
+type delivery struct {
+	at          time.Time
+	environment string
+	commit      string
+	// statusFailed はステータスが失敗・エラーで終わったことを表します（変更は届いていません）.
+	statusFailed bool
+	// rolledBack は成功した後、以前にデプロイしたコミットへ戻されたことを表します.
+	rolledBack bool
+}
+
+// failed は失敗したデプロイ（変更失敗）かどうかを返します.
+func (d *delivery) failed() bool {
+	return d.statusFailed || d.rolledBack
+}
+
+// CalculateDeliveryWeeks はリポジトリのデプロイ記録と、そのリポジトリのPRライフサイクルから週ごとのデリバリー指標を求めます.
+// デプロイとして数える記録は Deployment、無ければドラフト・プレリリースを除く公開済みリリース、それも無ければタグの順に選びます.
+// Deployment は完了した（成功・置き換え済み・失敗・エラーの）ものだけを数え、名前に "prod" を含む環境があればその環境だけを対象にします.
+// 同じ環境で以前にデプロイしたコミットが再びデプロイされた場合は、直前に成功していたデプロイをロールバックされた失敗として数えます.
+// リードタイムはマージ日時以降で最初に成功したデプロイまでの時間で、最初のデプロイより前にマージされたPRは含めません.
+// 結果は週の昇順で、デプロイも失敗も無い週は含みません.
+func CalculateDeliveryWeeks(deliveries *RepositoryDeliveries, prs []*PullRequestLifecycle) []*DeliveryWeek {
+	if deliveries == nil {
+		return nil
+	}
+
+	source, records := deliveryRecords(deliveries)
+	if len(records) == 0 {
+		return nil
+	}
+
+	weeks := make(map[string]*DeliveryWeek)
+	week := func(t time.Time) *DeliveryWeek {
+		key := DeliveryWeekOf(t)
+		if w, ok := weeks[key]; ok {
+			return w
+		}
+
+		w := &DeliveryWeek{Repository: deliveries.Repository, Week: key, Source: source}
+		weeks[key] = w
+
+		return w
+	}
+
+	if source == DeliverySourceDeployment {
+		markRollbacks(records)
+	}
+
+	for _, r := range records {
+		w := week(r.at)
+		w.DeploymentCount++
+
+		if r.failed() {
+			w.FailedDeploymentCount++
+		}
+	}
+
+	addRestoreTimes(records, week)
+	addLeadTimes(deliveries.Repository, records, prs, week)
+
+	out := make([]*DeliveryWeek, 0, len(weeks))
+	for _, w := range weeks {
+		out = append(out, w)
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].Week < out[j].Week })
+
+	return out
+}
+
+// DeliveryWeekOf は t（UTC）を含む週の開始日（月曜）を "2006-01-02" 形式で返します.
+func DeliveryWeekOf(t time.Time) string {
+	const daysPerWeek = 7
+
+	utc := t.UTC()
+	day := time.Date(utc.Year(), utc.Month(), utc.Day(), 0, 0, 0, 0, time.UTC)
+	offset := (int(day.Weekday()) + daysPerWeek - 1) % daysPerWeek
+
+	return day.AddDate(0, 0, -offset).Format(time.DateOnly)
+}
+
+// deliveryRecords はデプロイとして数える記録を日時の昇順で返します.
+func deliveryRecords(d *RepositoryDeliveries) (DeliverySource, []*delivery) {
+	if records := deploymentRecords(d.Deployments); len(records) > 0 {
+		return DeliverySourceDeployment, records
+	}
+
+	var records []*delivery
+
+	for _, release := range d.Releases {
+		if release.IsDraft || release.IsPrerelease || release.PublishedAt.IsZero() {
+			continue
+		}
+
+		records = append(records, &delivery{at: release.PublishedAt, commit: release.CommitOID})
+	}
+
+	if len(records) > 0 {
+		sortDeliveries(records)
+
+		return DeliverySourceRelease, records
+	}
+
+	for _, tag := range d.Tags {
+		if tag.At.IsZero() {
+			continue
+		}
+
+		records = append(records, &delivery{at: tag.At, commit: tag.CommitOID})
+	}
+
+	sortDeliveries(records)
+
+	return DeliverySourceTag, records
+}
+
+// deploymentRecords は完了したデプロイメントを、本番環境があれば本番環境に絞り込んで日時の昇順で返します.
+// 成功したデプロイメントの日時は成功ステータスの日時、それ以外は作成日時です.
+func deploymentRecords(deployments []*Deployment) []*delivery {
+	var all, production []*delivery
+
+	for _, d := range deployments {
+		record := &delivery{at: d.CreatedAt, environment: d.Environment, commit: d.CommitOID}
+
+		switch d.State {
+		case DeploymentStateSuccess, DeploymentStateInactive:
+			if d.State == DeploymentStateSuccess && !d.StatusAt.IsZero() {
+				record.at = d.StatusAt
+			}
+		case DeploymentStateFailure, DeploymentStateError:
+			record.statusFailed = true
+		default:
+			continue
+		}
+
+		all = append(all, record)
+
+		if strings.Contains(strings.ToLower(d.Environment), productionEnvironmentKeyword) {
+			production = append(production, record)
+		}
+	}
+
+	if len(production) > 0 {
+		all = production
+	}
+
+	sortDeliveries(all)
+
+	return all
+}
+
+// sortDeliveries は記録を日時の昇順に並べます.
+func sortDeliveries(records []*delivery) {
+	sort.SliceStable(records, func(i, j int) bool { return records[i].at.Before(records[j].at) })
+}
+
+// markRollbacks は、環境ごとに以前成功したコミットが再びデプロイされたとき、直前に成功していたデプロイをロールバック済みにします.
+// 直前と同じコミットの再デプロイはロールバックとみなしません.
+func markRollbacks(records []*delivery) {
+	deployed := make(map[string]map[string]bool)
+	last := make(map[string]*delivery)
+
+	for _, r := range records {
+		if r.statusFailed || r.commit == "" {
+			continue
+		}
+
+		commits := deployed[r.environment]
+		if commits == nil {
+			commits = make(map[string]bool)
+			deployed[r.environment] = commits
+		}
+
+		if prev := last[r.environment]; prev != nil && prev.commit != r.commit && commits[r.commit] {
+			prev.rolledBack = true
+		}
+
+		commits[r.commit] = true
+		last[r.environment] = r
+	}
+}
+
+// addRestoreTimes は環境ごとに、連続する失敗の最初から次に成功したデプロイまでの時間を、失敗が始まった週に加算します.
+// ロールバックされたデプロイは、ロールバックしたデプロイで復旧したことになります.
+func addRestoreTimes(records []*delivery, week func(time.Time) *DeliveryWeek) {
+	failedSince := make(map[string]time.Time)
+
+	for _, r := range records {
+		since, failing := failedSince[r.environment]
+
+		if r.failed() {
+			if !failing {
+				failedSince[r.environment] = r.at
+			}
+
+			continue
+		}
+
+		if failing {
+			w := week(since)
+			w.RestoreSeconds += int(r.at.Sub(since).Seconds())
+			w.RestoreCount++
+
+			delete(failedSince, r.environment)
+		}
+	}
+}
+
+// addLeadTimes は repository でマージされたPRごとに、マージ以降で最初に変更を届けたデプロイまでの時間をそのデプロイの週に加算します.
+func addLeadTimes(repository string, records []*delivery, prs []*PullRequestLifecycle, week func(time.Time) *DeliveryWeek) {
+	shipped := make([]*delivery, 0, len(records))
+
+	for _, r := range records {
+		if !r.statusFailed {
+			shipped = append(shipped, r)
+		}
+	}
+
+	if len(shipped) == 0 {
+		return
+	}
+
+	first := records[0].at
+
+	for _, pr := range prs {
+		if pr == nil || pr.MergedAt == nil || !strings.EqualFold(pr.Repository, repository) || pr.MergedAt.Before(first) {
+			continue
+		}
+
+		merged := *pr.MergedAt
+
+		i := sort.Search(len(shipped), func(i int) bool { return !shipped[i].at.Before(merged) })
+		if i == len(shipped) {
+			continue
+		}
+
+		w := week(shipped[i].at)
+		w.LeadTimeSeconds += int(shipped[i].at.Sub(merged).Seconds())
+		w.LeadTimeCount++
+	}
+}
@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalculateDeliveryWeeks_Deployments(t *testing.T) {
+	t.Parallel()
+
+	at := func(day, hour int) time.Time { return time.Date(2024, time.March, day, hour, 0, 0, 0, time.UTC) }
+	merged := func(repo string, day, hour int) *PullRequestLifecycle {
+		m := at(day, hour)
+
+		return &PullRequestLifecycle{Repository: repo, CreatedAt: m.Add(-time.Hour), MergedAt: &m}
+	}
+
+	deliveries := &RepositoryDeliveries{
+		Repository: "acme/api",
+		Deployments: []*Deployment{
+			{Environment: "production", CommitOID: "a", CreatedAt: at(4, 10), State: DeploymentStateInactive},
+			{Environment: "production", CommitOID: "b", CreatedAt: at(5, 11), State: DeploymentStateSuccess, StatusAt: at(5, 12)},
+			// Going back to a is a rollback of b.
+			{Environment: "production", CommitOID: "a", CreatedAt: at(5, 14), State: DeploymentStateSuccess},
+			{Environment: "production", CommitOID: "c", CreatedAt: at(11, 9), State: DeploymentStateFailure},
+			{Environment: "production", CommitOID: "c", CreatedAt: at(11, 11), State: DeploymentStateSuccess},
+			{Environment: "production", CommitOID: "d", CreatedAt: at(12, 9), State: "IN_PROGRESS"},
+			{Environment: "staging", CommitOID: "x", CreatedAt: at(6, 9), State: DeploymentStateSuccess},
+		},
+		Releases: []*Release{{TagName: "v1", PublishedAt: at(5, 9)}},
+	}
+
+	prs := []*PullRequestLifecycle{
+		merged("acme/api", 1, 9), // before the first deployment
+		merged("acme/api", 5, 8),
+		merged("ACME/api", 11, 8),
+		merged("acme/web", 5, 8),
+		{Repository: "acme/api", CreatedAt: at(5, 8)},
+	}
+
+	weeks := CalculateDeliveryWeeks(deliveries, prs)
+
+	const hour = int(time.Hour / time.Second)
+
+	assert.Equal(t, []*DeliveryWeek{
+		{
+			Repository: "acme/api", Week: "2024-03-04", Source: DeliverySourceDeployment,
+			DeploymentCount: 3, FailedDeploymentCount: 1,
+			LeadTimeSeconds: 4 * hour, LeadTimeCount: 1,
+			RestoreSeconds: 2 * hour, RestoreCount: 1,
+		},
+		{
+			Repository: "acme/api", Week: "2024-03-11", Source: DeliverySourceDeployment,
+			DeploymentCount: 2, FailedDeploymentCount: 1,
+			LeadTimeSeconds: 3 * hour, LeadTimeCount: 1,
+			RestoreSeconds: 2 * hour, RestoreCount: 1,
+		},
+	}, weeks)
+}
+
+func TestCalculateDeliveryWeeks_FallsBackToReleasesAndTags(t *testing.T) {
+	t.Parallel()
+
+	day := time.Date(2024, time.March, 6, 0, 0, 0, 0, time.UTC)
+
+	releases := &RepositoryDeliveries{
+		Repository:  "acme/api",
+		Deployments: []*Deployment{{Environment: "production", CreatedAt: day, State: "PENDING"}},
+		Releases: []*Release{
+			{TagName: "v1", PublishedAt: day},
+			{TagName: "v2-rc", PublishedAt: day, IsPrerelease: true},
+			{TagName: "v2", IsDraft: true},
+		},
+		Tags: []*Tag{{Name: "v1", At: day}, {Name: "v0", At: day}},
+	}
+
+	weeks := CalculateDeliveryWeeks(releases, nil)
+	require.Len(t, weeks, 1)
+	assert.Equal(t, DeliverySourceRelease, weeks[0].Source)
+	assert.Equal(t, 1, weeks[0].DeploymentCount)
+
+	tags := &RepositoryDeliveries{Repository: "acme/api", Tags: releases.Tags}
+
+	weeks = CalculateDeliveryWeeks(tags, nil)
+	require.Len(t, weeks, 1)
+	assert.Equal(t, DeliverySourceTag, weeks[0].Source)
+	assert.Equal(t, 2, weeks[0].DeploymentCount)
+
+	assert.Empty(t, CalculateDeliveryWeeks(&RepositoryDeliveries{Repository: "acme/api"}, nil))
+}
+
+func TestDeliveryWeekOf(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "2024-03-04", DeliveryWeekOf(time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, "2024-03-04", DeliveryWeekOf(time.Date(2024, time.March, 10, 23, 59, 0, 0, time.UTC)), "日曜は前の月曜の週")
+	assert.Equal(t, "2024-03-04", DeliveryWeekOf(time.Date(2024, time.March, 11, 8, 0, 0, 0, time.FixedZone("JST", 9*3600))), "UTC で判定する")
+}
@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrInvalidIdentity は代表ログインの無い ID 定義を表します.
+	ErrInvalidIdentity = errors.New("identity must have a login")
+	// ErrDuplicateIdentityLogin は同じログインが複数の ID に割り当てられていることを表します.
+	ErrDuplicateIdentityLogin = errors.New("login belongs to more than one identity")
+)
+
+// Identity は複数の GitHub アカウント（個人用・業務用など）を持つ1人のメンバーです.
+type Identity struct {
+	// Login は集計上のメンバーを表す代表ログインです.
+	Login string
+	// Name は表示名です（空なら GitHub のプロフィールの名前を使います）.
+	Name string
+	// Logins は代表ログインにまとめるアカウントです（代表ログインを含まなくても構いません）.
+	Logins []string
+	// TimeZone はメンバーのタイムゾーン（IANA の名前）です（空なら既定のタイムゾーンを使います）.
+	TimeZone string
+}
+
+// IdentityMap はアカウントから代表ログインへの対応表です.
+// ログインは大文字小文字を区別しません. nil は何もまとめません.
+type IdentityMap struct {
+	byLogin map[string]*Identity
+}
+
+// NewIdentityMap は identities から対応表を作成します.
+// 各 ID の Logins には代表ログイン自身を先頭に加えたうえで重複を除きます.
+func NewIdentityMap(identities []Identity) (*IdentityMap, error) {
+	m := &IdentityMap{byLogin: make(map[string]*Identity)}
+
+	for i := range identities {
+		login := strings.TrimSpace(identities[i].Login)
+		if login == "" {
+			return nil, fmt.Errorf("%w (identity #%d)", ErrInvalidIdentity, i+1)
+		}
+
+		identity := &Identity{
+			Login:    login,
+			Name:     strings.TrimSpace(identities[i].Name),
+			TimeZone: strings.TrimSpace(identities[i].TimeZone),
+		}
+		seen := make(map[string]struct{})
+
+		for _, account := range append([]string{login}, identities[i].Logins...) {
+			account = strings.TrimSpace(account)
+			key := strings.ToLower(account)
+
+			if _, ok := seen[key]; ok || account == "" {
+				continue
+			}
+
+			if other, ok := m.byLogin[key]; ok {
+				return nil, fmt.Errorf("%w: %s (%s and %s)", ErrDuplicateIdentityLogin, account, other.Login, login)
+			}
+
+			seen[key] = struct{}{}
+			identity.Logins = append(identity.Logins, account)
+			m.byLogin[key] = identity
+		}
+	}
+
+	return m, nil
+}
+
+// Lookup は login を含む ID を返します（どの ID にも含まれない場合は nil, false）.
+func (m *IdentityMap) Lookup(login string) (*Identity, bool) {
+	if m == nil {
+		return nil, false
+	}
+
+	identity, ok := m.byLogin[strings.ToLower(login)]
+
+	return identity, ok
+}
+
+// Canonical は login の代表ログインを返します（どの ID にも含まれない場合は login のまま）.
+func (m *IdentityMap) Canonical(login string) string {
+	if identity, ok := m.Lookup(login); ok {
+		return identity.Login
+	}
+
+	return login
+}
+
+// Accounts は代表ログイン login にまとめるアカウントを返します（どの ID にも含まれない場合は login だけ）.
+func (m *IdentityMap) Accounts(login string) []string {
+	if identity, ok := m.Lookup(login); ok {
+		return identity.Logins
+	}
+
+	return []string{login}
+}
+
+// Members はメンバー一覧 logins の各アカウントを代表ログインに置き換え、重複を除いた一覧を返します（初出順）.
+func (m *IdentityMap) Members(logins []string) []string {
+	members := make([]string, 0, len(logins))
+	seen := make(map[string]struct{}, len(logins))
+
+	for _, login := range logins {
+		member := m.Canonical(login)
+		if _, ok := seen[strings.ToLower(member)]; ok {
+			continue
+		}
+
+		seen[strings.ToLower(member)] = struct{}{}
+		members = append(members, member)
+	}
+
+	return members
+}
@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdentityMap(t *testing.T) {
+	t.Parallel()
+
+	identities, err := NewIdentityMap([]Identity{
+		{Login: "alice", Name: "Alice Example", Logins: []string{"alice-work", "Alice", " "}},
+		{Login: "bob", Logins: []string{"bob-corp"}},
+	})
+	require.NoError(t, err)
+
+	identity, ok := identities.Lookup("ALICE-WORK")
+	require.True(t, ok, "ログインは大文字小文字を区別しない")
+	assert.Equal(t, &Identity{Login: "alice", Name: "Alice Example", Logins: []string{"alice", "alice-work"}}, identity)
+
+	assert.Equal(t, "bob", identities.Canonical("bob-corp"))
+	assert.Equal(t, "carol", identities.Canonical("carol"), "対応表に無いログインはそのまま")
+
+	assert.Equal(t, []string{"bob", "bob-corp"}, identities.Accounts("bob"))
+	assert.Equal(t, []string{"carol"}, identities.Accounts("carol"))
+
+	assert.Equal(t, []string{"alice", "carol", "bob"},
+		identities.Members([]string{"alice-work", "carol", "alice", "bob-corp"}))
+}
+
+func TestIdentityMap_Nil(t *testing.T) {
+	t.Parallel()
+
+	var identities *IdentityMap
+
+	assert.Equal(t, "alice-work", identities.Canonical("alice-work"))
+	assert.Equal(t, []string{"alice-work"}, identities.Accounts("alice-work"))
+	assert.Equal(t, []string{"alice", "bob"}, identities.Members([]string{"alice", "bob", "alice"}))
+}
+
+func TestNewIdentityMap_Invalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewIdentityMap([]Identity{{Login: " ", Logins: []string{"alice"}}})
+	require.ErrorIs(t, err, ErrInvalidIdentity)
+
+	_, err = NewIdentityMap([]Identity{
+		{Login: "alice", Logins: []string{"shared"}},
+		{Login: "bob", Logins: []string{"Shared"}},
+	})
+	require.ErrorIs(t, err, ErrDuplicateIdentityLogin)
+}
@@ -0,0 +1,209 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// IssueKind はラベルから判定したIssueの種類です.
+type IssueKind string
+
+const (
+	// IssueKindBug は不具合のIssueを表します.
+	IssueKindBug IssueKind = "BUG"
+	// IssueKindFeature は機能追加・改善のIssueを表します.
+	IssueKindFeature IssueKind = "FEATURE"
+	// IssueKindOther は不具合・機能追加のどちらのラベルも付いていないIssueを表します.
+	IssueKindOther IssueKind = "OTHER"
+)
+
+// IssueStateReason はIssueがクローズされた理由（GitHub の IssueStateReason）です.
+type IssueStateReason string
+
+const (
+	// IssueStateReasonCompleted は完了としてクローズされたIssueを表します.
+	IssueStateReasonCompleted IssueStateReason = "COMPLETED"
+	// IssueStateReasonNotPlanned は対応しないとしてクローズされたIssueを表します.
+	IssueStateReasonNotPlanned IssueStateReason = "NOT_PLANNED"
+)
+
+// IssueLabelMapping はIssueの種類を判定するラベル名の対応です.
+// ラベル名は大文字小文字を区別せずに比較し、"type: bug" や "kind/bug" のように ":" や "/" で区切られたラベルは最後の部分でも比較します.
+type IssueLabelMapping struct {
+	Bug     []string
+	Feature []string
+}
+
+// DefaultIssueLabelMapping は GitHub の既定のラベルに合わせた対応を返します.
+func DefaultIssueLabelMapping() *IssueLabelMapping {
+	return &IssueLabelMapping{
+		Bug:     []string{"bug", "defect", "regression"},
+		Feature: []string{"enhancement", "feature", "feature request"},
+	}
+}
+
+// NewIssueLabelMapping は bug / feature のラベル名から対応を作成します（空の側は既定のラベル名を使います）.
+func NewIssueLabelMapping(bug, feature []string) *IssueLabelMapping {
+	mapping := DefaultIssueLabelMapping()
+
+	if len(bug) > 0 {
+		mapping.Bug = bug
+	}
+
+	if len(feature) > 0 {
+		mapping.Feature = feature
+	}
+
+	return mapping
+}
+
+// Classify はラベルからIssueの種類を判定します（両方に一致する場合は不具合とします）.
+// m が nil の場合は既定の対応で判定します.
+func (m *IssueLabelMapping) Classify(labels []string) IssueKind {
+	if m == nil {
+		m = DefaultIssueLabelMapping()
+	}
+
+	switch {
+	case matchesIssueLabel(labels, m.Bug):
+		return IssueKindBug
+	case matchesIssueLabel(labels, m.Feature):
+		return IssueKindFeature
+	default:
+		return IssueKindOther
+	}
+}
+
+// matchesIssueLabel は labels のいずれかが names のいずれかに一致するかを返します.
+func matchesIssueLabel(labels, names []string) bool {
+	for _, label := range labels {
+		label = strings.TrimSpace(label)
+		last := label[strings.LastIndexAny(label, ":/")+1:]
+
+		for _, name := range names {
+			name = strings.TrimSpace(name)
+			if strings.EqualFold(label, name) || strings.EqualFold(strings.TrimSpace(last), name) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// IssueLifecycle はIssue 1件のライフサイクル（作成→クローズ）を表す値オブジェクトです.
+// オープン中のIssueの ClosedAt は nil です.
+type IssueLifecycle struct {
+	Repository string
+	// SourceID は GitHub のノードIDです.
+	SourceID  string
+	Author    string
+	CreatedAt time.Time
+	ClosedAt  *time.Time
+	// ClosedBy はIssueをクローズしたアカウントのログインです（オープン中・不明な場合は空文字）.
+	ClosedBy string
+	// StateReason はクローズの理由です（オープン中・不明な場合は空文字）.
+	StateReason IssueStateReason
+	// Kind はラベルから判定した種類です.
+	Kind      IssueKind
+	Labels    []string
+	Assignees []string
+}
+
+// TimeToClose は作成からクローズまでの時間を返します（オープン中なら false）.
+func (i *IssueLifecycle) TimeToClose() (time.Duration, bool) {
+	if i.ClosedAt == nil {
+		return 0, false
+	}
+
+	return i.ClosedAt.Sub(i.CreatedAt), true
+}
+
+// OpenAt は at の時点でIssueがオープンだったかどうかを返します.
+func (i *IssueLifecycle) OpenAt(at time.Time) bool {
+	return i.CreatedAt.Before(at) && (i.ClosedAt == nil || !i.ClosedAt.Before(at))
+}
+
+// IssueThroughput はIssueのクローズ数・クローズまでの時間と、作成・クローズの種類別の内訳です.
+// 作成数は IssueCount で数えます. 日別・リポジトリ別の行を足し合わせて任意の範囲の値を求められるよう、
+// クローズまでの時間は秒数の合計で持ちます.
+type IssueThroughput struct {
+	// ClosedCount はクローズしたIssue数です.
+	ClosedCount int
+	// CloseSeconds はクローズしたIssueの、作成からクローズまでの秒数の合計です.
+	CloseSeconds int
+	// BugOpened / FeatureOpened は作成したIssueのうち不具合・機能追加のものの数です.
+	BugOpened     int
+	FeatureOpened int
+	// BugClosed / FeatureClosed はクローズしたIssueのうち不具合・機能追加のものの数です.
+	BugClosed     int
+	FeatureClosed int
+}
+
+// IssueThroughputOf はIssueの作成・クローズの活動1件分の内訳を返します（それ以外の活動ならゼロ値）.
+func IssueThroughputOf(activity *Activity) IssueThroughput {
+	var t IssueThroughput
+
+	switch activity.Type {
+	case ActivityTypeIssue:
+		t.BugOpened, t.FeatureOpened = kindCounts(activity.IssueKind)
+	case ActivityTypeIssueClose:
+		t.ClosedCount = 1
+		t.BugClosed, t.FeatureClosed = kindCounts(activity.IssueKind)
+
+		if !activity.IssueOpenedAt.IsZero() && activity.Date.After(activity.IssueOpenedAt) {
+			t.CloseSeconds = int(activity.Date.Sub(activity.IssueOpenedAt).Seconds())
+		}
+	}
+
+	return t
+}
+
+// kindCounts は種類 kind の不具合・機能追加の件数（0 か 1）を返します.
+func kindCounts(kind IssueKind) (int, int) {
+	switch kind {
+	case IssueKindBug:
+		return 1, 0
+	case IssueKindFeature:
+		return 0, 1
+	}
+
+	return 0, 0
+}
+
+// Add は other を加算します.
+func (t *IssueThroughput) Add(other IssueThroughput) {
+	t.ClosedCount += other.ClosedCount
+	t.CloseSeconds += other.CloseSeconds
+	t.BugOpened += other.BugOpened
+	t.FeatureOpened += other.FeatureOpened
+	t.BugClosed += other.BugClosed
+	t.FeatureClosed += other.FeatureClosed
+}
+
+// IsZero はすべての値が0かどうかを返します.
+func (t IssueThroughput) IsZero() bool {
+	return t == IssueThroughput{}
+}
+
+// TimeToCloseHours は作成からクローズまでの平均時間を返します（クローズしたIssueが無ければ false）.
+func (t IssueThroughput) TimeToCloseHours() (float64, bool) {
+	if t.ClosedCount == 0 {
+		return 0, false
+	}
+
+	return (time.Duration(t.CloseSeconds) * time.Second).Hours() / float64(t.ClosedCount), true
+}
+
+// CountOpenIssues は at の時点でオープンだったIssue数を返します.
+func CountOpenIssues(issues []*IssueLifecycle, at time.Time) int {
+	count := 0
+
+	for _, issue := range issues {
+		if issue != nil && issue.OpenAt(at) {
+			count++
+		}
+	}
+
+	return count
+}
@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssueLabelMapping_Classify(t *testing.T) {
+	t.Parallel()
+
+	defaults := DefaultIssueLabelMapping()
+
+	tests := []struct {
+		name    string
+		mapping *IssueLabelMapping
+		labels  []string
+		want    IssueKind
+	}{
+		{"exact label", defaults, []string{"bug"}, IssueKindBug},
+		{"case-insensitive", defaults, []string{"Enhancement"}, IssueKindFeature},
+		{"prefixed label", defaults, []string{"type: Bug"}, IssueKindBug},
+		{"slash label", defaults, []string{"kind/feature"}, IssueKindFeature},
+		{"bug wins over feature", defaults, []string{"enhancement", "regression"}, IssueKindBug},
+		{"no matching label", defaults, []string{"question"}, IssueKindOther},
+		{"no labels", defaults, nil, IssueKindOther},
+		{"nil mapping uses defaults", nil, []string{"bug"}, IssueKindBug},
+		{"custom bug labels", NewIssueLabelMapping([]string{"incident"}, nil), []string{"bug"}, IssueKindOther},
+		{"custom labels keep default features", NewIssueLabelMapping([]string{"incident"}, nil), []string{"feature"}, IssueKindFeature},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.mapping.Classify(tt.labels))
+		})
+	}
+}
+
+func TestIssueThroughputOf(t *testing.T) {
+	t.Parallel()
+
+	closedAt := time.Date(2024, time.March, 5, 12, 0, 0, 0, time.UTC)
+
+	opened := NewActivity(ActivityTypeIssue, "acme/api", closedAt, 0, 0)
+	opened.IssueKind = IssueKindFeature
+	assert.Equal(t, IssueThroughput{FeatureOpened: 1}, IssueThroughputOf(opened))
+
+	closed := NewActivity(ActivityTypeIssueClose, "acme/api", closedAt, 0, 0)
+	closed.IssueKind = IssueKindBug
+	closed.IssueOpenedAt = closedAt.Add(-3 * time.Hour)
+	assert.Equal(t, IssueThroughput{ClosedCount: 1, CloseSeconds: 3 * 3600, BugClosed: 1}, IssueThroughputOf(closed))
+
+	assert.True(t, IssueThroughputOf(NewActivity(ActivityTypeCommit, "acme/api", closedAt, 1, 1)).IsZero())
+
+	var total IssueThroughput
+	total.Add(IssueThroughputOf(closed))
+	total.Add(IssueThroughput{ClosedCount: 1, CloseSeconds: 3600})
+
+	hours, ok := total.TimeToCloseHours()
+	require.True(t, ok)
+	assert.InDelta(t, 2.0, hours, 1e-9)
+
+	_, ok = IssueThroughput{BugOpened: 1}.TimeToCloseHours()
+	assert.False(t, ok, "クローズが無ければ平均は無い")
+}
+
+func TestCountOpenIssues(t *testing.T) {
+	t.Parallel()
+
+	at := func(day int) time.Time { return time.Date(2024, time.March, day, 0, 0, 0, 0, time.UTC) }
+	closed := at(5)
+
+	issues := []*IssueLifecycle{
+		{CreatedAt: at(1)},
+		{CreatedAt: at(2), ClosedAt: &closed},
+		{CreatedAt: at(6)},
+		nil,
+	}
+
+	assert.Equal(t, 0, CountOpenIssues(issues, at(1)), "作成した瞬間はまだ数えない")
+	assert.Equal(t, 2, CountOpenIssues(issues, at(4)))
+	assert.Equal(t, 2, CountOpenIssues(issues, at(5)), "クローズした瞬間まではオープン")
+	assert.Equal(t, 2, CountOpenIssues(issues, at(7)))
+}
@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrInvalidCollectionPeriod は収集期間の終わりが始まり以前であることを表します.
+var ErrInvalidCollectionPeriod = errors.New("collection period must end after it starts")
+
+// CollectionPeriod は活動を取得・集計する期間です（Since を含み、Until を含みません）.
+// ゼロ値の Since は下限なし（既定の遡り方）、ゼロ値の Until は上限なし（取得時点まで）を表します.
+type CollectionPeriod struct {
+	Since time.Time
+	Until time.Time
+}
+
+// NewCollectionPeriod は [since, until) の収集期間を作成します.
+// どちらもゼロ値でなく、until が since 以前の場合は ErrInvalidCollectionPeriod を返します.
+func NewCollectionPeriod(since, until time.Time) (CollectionPeriod, error) {
+	if !since.IsZero() && !until.IsZero() && !until.After(since) {
+		return CollectionPeriod{}, fmt.Errorf("%w: %s - %s", ErrInvalidCollectionPeriod,
+			since.Format(time.RFC3339), until.Format(time.RFC3339))
+	}
+
+	return CollectionPeriod{Since: since, Until: until}, nil
+}
+
+// IsZero は期間の指定が無いかどうかを返します.
+func (p CollectionPeriod) IsZero() bool {
+	return p.Since.IsZero() && p.Until.IsZero()
+}
+
+// Contains は t が期間内かどうかを返します.
+func (p CollectionPeriod) Contains(t time.Time) bool {
+	return !t.Before(p.Since) && !p.EndsBy(t)
+}
+
+// EndsBy は期間が t までに終わっている（Until が設定され、t が Until 以降である）かどうかを返します.
+// 新しい順にたどる取得で、期間より新しい項目を読み飛ばす判定に使います.
+func (p CollectionPeriod) EndsBy(t time.Time) bool {
+	return !p.Until.IsZero() && !t.Before(p.Until)
+}
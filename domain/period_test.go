@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollectionPeriod_Contains(t *testing.T) {
+	t.Parallel()
+
+	since := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	period, err := NewCollectionPeriod(since, until)
+	require.NoError(t, err)
+
+	assert.False(t, period.IsZero())
+	assert.True(t, period.Contains(since), "Since は期間に含む")
+	assert.True(t, period.Contains(until.Add(-time.Nanosecond)))
+	assert.False(t, period.Contains(until), "Until は期間に含まない")
+	assert.False(t, period.Contains(since.Add(-time.Nanosecond)))
+
+	assert.True(t, period.EndsBy(until))
+	assert.False(t, period.EndsBy(since))
+
+	open := CollectionPeriod{}
+	assert.True(t, open.IsZero())
+	assert.True(t, open.Contains(time.Date(1999, 1, 1, 0, 0, 0, 0, time.UTC)), "ゼロ値は無制限")
+	assert.False(t, open.EndsBy(time.Now()))
+}
+
+func TestNewCollectionPeriod_Invalid(t *testing.T) {
+	t.Parallel()
+
+	day := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err := NewCollectionPeriod(day, day)
+	require.ErrorIs(t, err, ErrInvalidCollectionPeriod)
+
+	_, err = NewCollectionPeriod(time.Time{}, day)
+	require.NoError(t, err, "片方だけの指定は有効")
+}
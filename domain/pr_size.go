@@ -0,0 +1,147 @@
+package domain
+
+// PRSize はPull Requestの大きさの区分です.
+type PRSize string
+
+const (
+	// PRSizeXS はごく小さいPR（10行以下・2ファイル以下）です.
+	PRSizeXS PRSize = "XS"
+	// PRSizeS は小さいPR（50行以下・5ファイル以下）です.
+	PRSizeS PRSize = "S"
+	// PRSizeM は中くらいのPR（250行以下・10ファイル以下）です.
+	PRSizeM PRSize = "M"
+	// PRSizeL は大きいPR（1000行以下・30ファイル以下）です.
+	PRSizeL PRSize = "L"
+	// PRSizeXL はそれより大きいPRです.
+	PRSizeXL PRSize = "XL"
+)
+
+// prSizeLimit は区分ごとの変更行数（追加+削除）と変更ファイル数の上限です.
+type prSizeLimit struct {
+	size  PRSize
+	lines int
+	files int
+}
+
+// prSizeLimits は PRSizeXL を除く区分の上限を小さい順に並べたものです.
+var prSizeLimits = []prSizeLimit{
+	{size: PRSizeXS, lines: 10, files: 2},
+	{size: PRSizeS, lines: 50, files: 5},
+	{size: PRSizeM, lines: 250, files: 10},
+	{size: PRSizeL, lines: 1000, files: 30},
+}
+
+// PRSizes はPRの大きさの区分を小さい順に返します.
+func PRSizes() []PRSize {
+	return []PRSize{PRSizeXS, PRSizeS, PRSizeM, PRSizeL, PRSizeXL}
+}
+
+// ClassifyPRSize は変更行数（追加+削除）と変更ファイル数から、両方が上限に収まる最も小さい区分を返します.
+func ClassifyPRSize(changedLines, changedFiles int) PRSize {
+	for _, limit := range prSizeLimits {
+		if changedLines <= limit.lines && changedFiles <= limit.files {
+			return limit.size
+		}
+	}
+
+	return PRSizeXL
+}
+
+// ChangedLines はPRの変更行数（追加+削除）を返します.
+func (p *PullRequestLifecycle) ChangedLines() int {
+	return p.Additions + p.Deletions
+}
+
+// Size はPRの大きさの区分を返します.
+// 変更行数・変更ファイル数がどちらも0のPR（大きさを記録する前に保存されたPRを含む）は false です.
+func (p *PullRequestLifecycle) Size() (PRSize, bool) {
+	if p.ChangedLines() == 0 && p.ChangedFiles == 0 {
+		return "", false
+	}
+
+	return ClassifyPRSize(p.ChangedLines(), p.ChangedFiles), true
+}
+
+// PRSizeHistogram はPRの大きさの区分ごとの件数です.
+type PRSizeHistogram struct {
+	XS int
+	S  int
+	M  int
+	L  int
+	XL int
+}
+
+// Count は区分 size の件数を返します.
+func (h PRSizeHistogram) Count(size PRSize) int {
+	switch size {
+	case PRSizeXS:
+		return h.XS
+	case PRSizeS:
+		return h.S
+	case PRSizeM:
+		return h.M
+	case PRSizeL:
+		return h.L
+	case PRSizeXL:
+		return h.XL
+	}
+
+	return 0
+}
+
+// add は区分 size の件数に1を加えます.
+func (h *PRSizeHistogram) add(size PRSize) {
+	switch size {
+	case PRSizeXS:
+		h.XS++
+	case PRSizeS:
+		h.S++
+	case PRSizeM:
+		h.M++
+	case PRSizeL:
+		h.L++
+	case PRSizeXL:
+		h.XL++
+	}
+}
+
+// PRSizeStats はPull Requestの大きさの分布です.
+type PRSizeStats struct {
+	// PRCount は大きさが分かるPRの数です.
+	PRCount int
+	// Histogram は区分ごとのPR数です.
+	Histogram PRSizeHistogram
+	// ChangedLines は変更行数（追加+削除）の中央値・90パーセンタイルです.
+	ChangedLines Percentiles
+	// ChangedFiles は変更ファイル数の中央値・90パーセンタイルです.
+	ChangedFiles Percentiles
+}
+
+// CalculatePRSizeStats はPRライフサイクルの一覧から大きさの分布を集計します.
+// 大きさが分からないPRは数えません.
+func CalculatePRSizeStats(prs []*PullRequestLifecycle) PRSizeStats {
+	var lines, files []float64
+
+	stats := PRSizeStats{}
+
+	for _, pr := range prs {
+		if pr == nil {
+			continue
+		}
+
+		size, ok := pr.Size()
+		if !ok {
+			continue
+		}
+
+		stats.PRCount++
+		stats.Histogram.add(size)
+		lines = append(lines, float64(pr.ChangedLines()))
+		files = append(files, float64(pr.ChangedFiles))
+	}
+
+	stats.ChangedLines = NewPercentiles(lines)
+	stats.ChangedFiles = NewPercentiles(files)
+
+	return stats
+}
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyPRSize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		lines int
+		files int
+		want  PRSize
+	}{
+		{name: "上限ちょうどは小さい区分", lines: 10, files: 2, want: PRSizeXS},
+		{name: "行数が上限を超えると次の区分", lines: 11, files: 1, want: PRSizeS},
+		{name: "ファイル数だけが多くても大きい区分", lines: 20, files: 12, want: PRSizeL},
+		{name: "中くらい", lines: 250, files: 10, want: PRSizeM},
+		{name: "どの上限にも収まらなければ XL", lines: 1001, files: 3, want: PRSizeXL},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, ClassifyPRSize(tt.lines, tt.files))
+		})
+	}
+}
+
+func TestCalculatePRSizeStats(t *testing.T) {
+	t.Parallel()
+
+	stats := CalculatePRSizeStats([]*PullRequestLifecycle{
+		{Additions: 5, Deletions: 1, ChangedFiles: 1},
+		{Additions: 40, Deletions: 0, ChangedFiles: 3},
+		{Additions: 900, Deletions: 300, ChangedFiles: 40},
+		// 大きさを記録する前に保存されたPRは数えない.
+		{},
+		nil,
+	})
+
+	assert.Equal(t, 3, stats.PRCount)
+	assert.Equal(t, PRSizeHistogram{XS: 1, S: 1, XL: 1}, stats.Histogram)
+	assert.Equal(t, 1, stats.Histogram.Count(PRSizeXL))
+	assert.InDelta(t, 40, stats.ChangedLines.Median, 0.001)
+	assert.InDelta(t, 3, stats.ChangedFiles.Median, 0.001)
+}
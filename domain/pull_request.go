@@ -0,0 +1,254 @@
+package domain
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// PullRequestReviewState はレビューの状態（GitHub の PullRequestReviewState）です.
+type PullRequestReviewState string
+
+const (
+	// ReviewStateApproved は承認レビューを表します.
+	ReviewStateApproved PullRequestReviewState = "APPROVED"
+	// ReviewStateChangesRequested は変更要求レビューを表します.
+	ReviewStateChangesRequested PullRequestReviewState = "CHANGES_REQUESTED"
+	// ReviewStateCommented はコメントのみのレビューを表します.
+	ReviewStateCommented PullRequestReviewState = "COMMENTED"
+	// ReviewStateDismissed は却下されたレビューを表します.
+	ReviewStateDismissed PullRequestReviewState = "DISMISSED"
+	// ReviewStatePending は未提出のレビューを表します.
+	ReviewStatePending PullRequestReviewState = "PENDING"
+)
+
+const (
+	// medianPercentile は中央値のパーセンタイルです.
+	medianPercentile = 0.5
+	// p90Percentile は90パーセンタイルです.
+	p90Percentile = 0.9
+)
+
+// PullRequestReview はPull Requestに付いたレビュー1件です.
+type PullRequestReview struct {
+	Author      string
+	State       PullRequestReviewState
+	SubmittedAt time.Time
+}
+
+// PullRequestLifecycle はPull Request 1件のライフサイクル（作成→初回レビュー→承認→マージ/クローズ）を表す値オブジェクトです.
+// 未到達の段階の日時は nil です.
+type PullRequestLifecycle struct {
+	Repository string
+	// SourceID は GitHub のノードIDです.
+	SourceID  string
+	CreatedAt time.Time
+	// FirstReviewAt は作成者以外による最初のレビューの提出日時です.
+	FirstReviewAt *time.Time
+	// ApprovedAt は最初の承認レビューの提出日時です.
+	ApprovedAt *time.Time
+	MergedAt   *time.Time
+	// ClosedAt はクローズ日時です（マージされた場合もマージ日時と同時に設定されます）.
+	ClosedAt *time.Time
+	// ReviewRounds はレビューの往復回数です（レビューが無ければ0）.
+	ReviewRounds int
+	// Additions・Deletions・ChangedFiles はPRの追加行数・削除行数・変更ファイル数です（大きさの分布の元データ）.
+	Additions    int
+	Deletions    int
+	ChangedFiles int
+	// ReviewRequests はレビュアーごとのレビュー依頼です（レビュー負荷の元データ）.
+	ReviewRequests []ReviewRequest
+}
+
+// NewPullRequestLifecycle はPull Requestの各日時とレビュー一覧からライフサイクルを組み立てます.
+// 作成者自身のレビュー・未提出（PENDING）のレビューは除外します.
+// レビューラウンドは変更要求（CHANGES_REQUESTED）で1ラウンドが終わるものとして数え、
+// 最後の変更要求より後にレビューがあれば（変更要求が無い場合も含む）最終ラウンドとして1を加えます.
+func NewPullRequestLifecycle(
+	repository, sourceID, author string,
+	createdAt time.Time,
+	mergedAt, closedAt *time.Time,
+	reviews []PullRequestReview,
+) *PullRequestLifecycle {
+	lifecycle := &PullRequestLifecycle{
+		Repository: repository,
+		SourceID:   sourceID,
+		CreatedAt:  createdAt,
+		MergedAt:   mergedAt,
+		ClosedAt:   closedAt,
+	}
+
+	submitted := make([]PullRequestReview, 0, len(reviews))
+	for _, review := range reviews {
+		if review.Author == author || review.State == ReviewStatePending || review.SubmittedAt.IsZero() {
+			continue
+		}
+
+		submitted = append(submitted, review)
+	}
+
+	sort.SliceStable(submitted, func(i, j int) bool {
+		return submitted[i].SubmittedAt.Before(submitted[j].SubmittedAt)
+	})
+
+	openRound := false
+
+	for _, review := range submitted {
+		if lifecycle.FirstReviewAt == nil {
+			at := review.SubmittedAt
+			lifecycle.FirstReviewAt = &at
+		}
+
+		if review.State == ReviewStateApproved && lifecycle.ApprovedAt == nil {
+			at := review.SubmittedAt
+			lifecycle.ApprovedAt = &at
+		}
+
+		if review.State == ReviewStateChangesRequested {
+			lifecycle.ReviewRounds++
+			openRound = false
+
+			continue
+		}
+
+		openRound = true
+	}
+
+	if openRound {
+		lifecycle.ReviewRounds++
+	}
+
+	return lifecycle
+}
+
+// TimeToFirstReview は作成から初回レビューまでの時間を返します（未レビューなら false）.
+func (p *PullRequestLifecycle) TimeToFirstReview() (time.Duration, bool) {
+	return p.since(p.FirstReviewAt)
+}
+
+// TimeToApproval は作成から最初の承認までの時間を返します（未承認なら false）.
+func (p *PullRequestLifecycle) TimeToApproval() (time.Duration, bool) {
+	return p.since(p.ApprovedAt)
+}
+
+// TimeToMerge は作成からマージまでの時間を返します（未マージなら false）.
+func (p *PullRequestLifecycle) TimeToMerge() (time.Duration, bool) {
+	return p.since(p.MergedAt)
+}
+
+// TimeToClose はマージされずにクローズされたPRの、作成からクローズまでの時間を返します.
+// マージ済み・オープン中のPRは false です.
+func (p *PullRequestLifecycle) TimeToClose() (time.Duration, bool) {
+	if p.MergedAt != nil {
+		return 0, false
+	}
+
+	return p.since(p.ClosedAt)
+}
+
+// since は作成日時から at までの時間を返します（at が nil なら false）.
+func (p *PullRequestLifecycle) since(at *time.Time) (time.Duration, bool) {
+	if at == nil {
+		return 0, false
+	}
+
+	return at.Sub(p.CreatedAt), true
+}
+
+// Percentiles は値の分布の要約（件数・中央値・90パーセンタイル）です.
+// 件数が0の場合、中央値・90パーセンタイルは0です.
+type Percentiles struct {
+	Count  int
+	Median float64
+	P90    float64
+}
+
+// NewPercentiles は値の一覧から中央値と90パーセンタイルを計算します.
+// パーセンタイルは昇順に並べた値の間を線形補間して求めます.
+func NewPercentiles(values []float64) Percentiles {
+	if len(values) == 0 {
+		return Percentiles{}
+	}
+
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+
+	return Percentiles{
+		Count:  len(sorted),
+		Median: percentile(sorted, medianPercentile),
+		P90:    percentile(sorted, p90Percentile),
+	}
+}
+
+// percentile は昇順ソート済みの値から p（0〜1）パーセンタイルを線形補間で求めます.
+func percentile(sorted []float64, p float64) float64 {
+	pos := p * float64(len(sorted)-1)
+	lower := int(math.Floor(pos))
+	upper := int(math.Ceil(pos))
+
+	if lower == upper {
+		return sorted[lower]
+	}
+
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(pos-float64(lower))
+}
+
+// CycleTimeStats はPull Requestのサイクルタイム（待ち時間）の集計です.
+// 各時間は時間（hour）単位で、その段階に到達したPRのみを対象に中央値・90パーセンタイルを求めます.
+type CycleTimeStats struct {
+	// PRCount は集計対象のPR数です.
+	PRCount int
+	// TimeToFirstReviewHours は作成から初回レビューまでの時間です.
+	TimeToFirstReviewHours Percentiles
+	// TimeToApprovalHours は作成から最初の承認までの時間です.
+	TimeToApprovalHours Percentiles
+	// TimeToMergeHours は作成からマージまでの時間です.
+	TimeToMergeHours Percentiles
+	// TimeToCloseHours はマージされずにクローズされたPRの、作成からクローズまでの時間です.
+	TimeToCloseHours Percentiles
+	// ReviewRounds はレビューを受けたPRのレビューラウンド数です.
+	ReviewRounds Percentiles
+}
+
+// CalculateCycleTimeStats はPRライフサイクルの一覧からサイクルタイムを集計します.
+func CalculateCycleTimeStats(prs []*PullRequestLifecycle) CycleTimeStats {
+	var firstReview, approval, merge, closeTimes, rounds []float64
+
+	stats := CycleTimeStats{}
+
+	for _, pr := range prs {
+		if pr == nil {
+			continue
+		}
+
+		stats.PRCount++
+
+		if d, ok := pr.TimeToFirstReview(); ok {
+			firstReview = append(firstReview, d.Hours())
+		}
+
+		if d, ok := pr.TimeToApproval(); ok {
+			approval = append(approval, d.Hours())
+		}
+
+		if d, ok := pr.TimeToMerge(); ok {
+			merge = append(merge, d.Hours())
+		}
+
+		if d, ok := pr.TimeToClose(); ok {
+			closeTimes = append(closeTimes, d.Hours())
+		}
+
+		if pr.ReviewRounds > 0 {
+			rounds = append(rounds, float64(pr.ReviewRounds))
+		}
+	}
+
+	stats.TimeToFirstReviewHours = NewPercentiles(firstReview)
+	stats.TimeToApprovalHours = NewPercentiles(approval)
+	stats.TimeToMergeHours = NewPercentiles(merge)
+	stats.TimeToCloseHours = NewPercentiles(closeTimes)
+	stats.ReviewRounds = NewPercentiles(rounds)
+
+	return stats
+}
@@ -0,0 +1,180 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPullRequestLifecycle(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	after := func(hours int) time.Time {
+		return created.Add(time.Duration(hours) * time.Hour)
+	}
+
+	tests := []struct {
+		name            string
+		reviews         []PullRequestReview
+		wantFirstReview *time.Time
+		wantApproved    *time.Time
+		wantRounds      int
+	}{
+		{
+			name:       "レビューが無ければラウンド0",
+			reviews:    nil,
+			wantRounds: 0,
+		},
+		{
+			name: "承認のみは1ラウンド",
+			reviews: []PullRequestReview{
+				{Author: "bob", State: ReviewStateApproved, SubmittedAt: after(5)},
+			},
+			wantFirstReview: ptr(after(5)),
+			wantApproved:    ptr(after(5)),
+			wantRounds:      1,
+		},
+		{
+			name: "変更要求のあと承認されると2ラウンド",
+			reviews: []PullRequestReview{
+				{Author: "bob", State: ReviewStateApproved, SubmittedAt: after(30)},
+				{Author: "carol", State: ReviewStateCommented, SubmittedAt: after(2)},
+				{Author: "bob", State: ReviewStateChangesRequested, SubmittedAt: after(4)},
+			},
+			wantFirstReview: ptr(after(2)),
+			wantApproved:    ptr(after(30)),
+			wantRounds:      2,
+		},
+		{
+			name: "変更要求で終わった場合は追加のラウンドを数えない",
+			reviews: []PullRequestReview{
+				{Author: "bob", State: ReviewStateChangesRequested, SubmittedAt: after(4)},
+			},
+			wantFirstReview: ptr(after(4)),
+			wantRounds:      1,
+		},
+		{
+			name: "作成者自身と未提出のレビューは除外する",
+			reviews: []PullRequestReview{
+				{Author: "alice", State: ReviewStateCommented, SubmittedAt: after(1)},
+				{Author: "bob", State: ReviewStatePending, SubmittedAt: after(2)},
+				{Author: "bob", State: ReviewStateApproved, SubmittedAt: after(8)},
+			},
+			wantFirstReview: ptr(after(8)),
+			wantApproved:    ptr(after(8)),
+			wantRounds:      1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := NewPullRequestLifecycle("acme/api", "PR_1", "alice", created, nil, nil, tt.reviews)
+
+			assert.Equal(t, tt.wantFirstReview, got.FirstReviewAt, "FirstReviewAt should match")
+			assert.Equal(t, tt.wantApproved, got.ApprovedAt, "ApprovedAt should match")
+			assert.Equal(t, tt.wantRounds, got.ReviewRounds, "ReviewRounds should match")
+		})
+	}
+}
+
+func TestPullRequestLifecycle_TimeToClose(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	closed := created.Add(10 * time.Hour)
+
+	merged := &PullRequestLifecycle{CreatedAt: created, MergedAt: &closed, ClosedAt: &closed}
+	_, ok := merged.TimeToClose()
+	assert.False(t, ok, "merged PR should not count as closed without merge")
+
+	d, ok := merged.TimeToMerge()
+	assert.True(t, ok)
+	assert.Equal(t, 10*time.Hour, d)
+
+	abandoned := &PullRequestLifecycle{CreatedAt: created, ClosedAt: &closed}
+	d, ok = abandoned.TimeToClose()
+	assert.True(t, ok)
+	assert.Equal(t, 10*time.Hour, d)
+}
+
+func TestNewPercentiles(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		values []float64
+		want   Percentiles
+	}{
+		{
+			name:   "空なら0",
+			values: nil,
+			want:   Percentiles{},
+		},
+		{
+			name:   "1件ならその値",
+			values: []float64{4},
+			want:   Percentiles{Count: 1, Median: 4, P90: 4},
+		},
+		{
+			name:   "偶数件の中央値は中央2件の平均",
+			values: []float64{10, 1, 4, 2},
+			want:   Percentiles{Count: 4, Median: 3, P90: 8.2},
+		},
+		{
+			name:   "11件の90パーセンタイルはちょうど10番目の値",
+			values: []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+			want:   Percentiles{Count: 11, Median: 5, P90: 9},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := NewPercentiles(tt.values)
+
+			assert.Equal(t, tt.want.Count, got.Count, "Count should match")
+			assert.InDelta(t, tt.want.Median, got.Median, 1e-9, "Median should match")
+			assert.InDelta(t, tt.want.P90, got.P90, 1e-9, "P90 should match")
+		})
+	}
+}
+
+func TestCalculateCycleTimeStats(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	at := func(hours int) *time.Time {
+		return ptr(created.Add(time.Duration(hours) * time.Hour))
+	}
+
+	prs := []*PullRequestLifecycle{
+		{CreatedAt: created, FirstReviewAt: at(2), ApprovedAt: at(4), MergedAt: at(6), ClosedAt: at(6), ReviewRounds: 1},
+		{CreatedAt: created, FirstReviewAt: at(4), ApprovedAt: at(12), MergedAt: at(24), ClosedAt: at(24), ReviewRounds: 3},
+		{CreatedAt: created, ClosedAt: at(48)},
+		{CreatedAt: created},
+		nil,
+	}
+
+	got := CalculateCycleTimeStats(prs)
+
+	assert.Equal(t, 4, got.PRCount)
+	require.Equal(t, 2, got.TimeToFirstReviewHours.Count)
+	assert.InDelta(t, 3, got.TimeToFirstReviewHours.Median, 1e-9)
+	assert.InDelta(t, 8, got.TimeToApprovalHours.Median, 1e-9)
+	assert.InDelta(t, 15, got.TimeToMergeHours.Median, 1e-9)
+	assert.InDelta(t, 22.2, got.TimeToMergeHours.P90, 1e-9)
+	assert.Equal(t, 1, got.TimeToCloseHours.Count)
+	assert.InDelta(t, 48, got.TimeToCloseHours.Median, 1e-9)
+	assert.Equal(t, 2, got.ReviewRounds.Count)
+	assert.InDelta(t, 2, got.ReviewRounds.Median, 1e-9)
+}
+
+func ptr(t time.Time) *time.Time {
+	return &t
+}
@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// ReviewRequest はPull Requestへのレビュー依頼（レビュアー1人分）です.
+// 同じレビュアーへの依頼が繰り返された場合は1件にまとめ、最後の依頼を使います.
+type ReviewRequest struct {
+	Reviewer string
+	// RequestedAt は最後にレビューを依頼された日時です.
+	RequestedAt time.Time
+	// Completed はレビュアーが最後の依頼の後にレビューを提出したかです.
+	Completed bool
+	// Pending はPRがオープン中で、依頼がまだ残っているかです.
+	Pending bool
+}
+
+// RequestedReview はレビュー依頼のイベント1件です.
+type RequestedReview struct {
+	Reviewer    string
+	RequestedAt time.Time
+}
+
+// NewReviewRequests はレビュー依頼のイベント・未完了の依頼・提出済みのレビューから、レビュアーごとの依頼を組み立てます.
+// pending はPRに残っている依頼のレビュアーで、イベントが取得できなかった依頼は作成日時 createdAt の依頼として扱います.
+// open が false（マージ・クローズ済み）のPRに残った依頼は未完了に数えません.
+// 作成者自身への依頼は除外し、結果はレビュアーの昇順です（ログインは大文字小文字を区別しません）.
+func NewReviewRequests(
+	author string,
+	createdAt time.Time,
+	open bool,
+	requested []RequestedReview,
+	pending []string,
+	reviews []PullRequestReview,
+) []ReviewRequest {
+	byReviewer := make(map[string]*ReviewRequest)
+
+	add := func(reviewer string, at time.Time) {
+		if reviewer == "" || strings.EqualFold(reviewer, author) {
+			return
+		}
+
+		key := strings.ToLower(reviewer)
+		if request, ok := byReviewer[key]; ok {
+			if at.After(request.RequestedAt) {
+				request.RequestedAt = at
+			}
+
+			return
+		}
+
+		byReviewer[key] = &ReviewRequest{Reviewer: reviewer, RequestedAt: at}
+	}
+
+	for _, r := range requested {
+		add(r.Reviewer, r.RequestedAt)
+	}
+
+	for _, reviewer := range pending {
+		add(reviewer, createdAt)
+
+		if request, ok := byReviewer[strings.ToLower(reviewer)]; ok {
+			request.Pending = open
+		}
+	}
+
+	for _, review := range reviews {
+		request, ok := byReviewer[strings.ToLower(review.Author)]
+		if !ok || review.State == ReviewStatePending || review.SubmittedAt.Before(request.RequestedAt) {
+			continue
+		}
+
+		request.Completed = true
+	}
+
+	out := make([]ReviewRequest, 0, len(byReviewer))
+	for _, request := range byReviewer {
+		// 依頼が残っているレビュアーは、以前にレビューしていても再依頼を待っています.
+		if request.Pending {
+			request.Completed = false
+		}
+
+		out = append(out, *request)
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Reviewer < out[j].Reviewer
+	})
+
+	return out
+}
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewReviewRequests(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	after := func(hours int) time.Time {
+		return created.Add(time.Duration(hours) * time.Hour)
+	}
+
+	requested := []RequestedReview{
+		{Reviewer: "bob", RequestedAt: after(1)},
+		{Reviewer: "carol", RequestedAt: after(1)},
+		{Reviewer: "Bob", RequestedAt: after(10)},
+		{Reviewer: "alice", RequestedAt: after(1)},
+	}
+	reviews := []PullRequestReview{
+		{Author: "bob", State: ReviewStateApproved, SubmittedAt: after(5)},
+		{Author: "carol", State: ReviewStateCommented, SubmittedAt: after(3)},
+		{Author: "dave", State: ReviewStateApproved, SubmittedAt: after(3)},
+	}
+
+	t.Run("オープン中のPR", func(t *testing.T) {
+		t.Parallel()
+
+		got := NewReviewRequests("alice", created, true, requested, []string{"bob", "erin"}, reviews)
+
+		assert.Equal(t, []ReviewRequest{
+			// 再依頼の後にまだレビューしていない.
+			{Reviewer: "bob", RequestedAt: after(10), Pending: true},
+			{Reviewer: "carol", RequestedAt: after(1), Completed: true},
+			// イベントが取得できなかった依頼は作成日時の依頼とする.
+			{Reviewer: "erin", RequestedAt: created, Pending: true},
+		}, got)
+	})
+
+	t.Run("クローズ済みのPRに残った依頼は未完了に数えない", func(t *testing.T) {
+		t.Parallel()
+
+		got := NewReviewRequests("alice", created, false, nil, []string{"erin"}, nil)
+
+		assert.Equal(t, []ReviewRequest{{Reviewer: "erin", RequestedAt: created}}, got)
+	})
+}
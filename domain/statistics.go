@@ -31,6 +31,15 @@ type DailyStatistics struct {
 	ReviewCount    int
 	TotalAdditions int
 	TotalDeletions int
+	// ExcludedReviewCount は除外ルールに一致したアカウントのPRへのレビュー数です（ReviewCount には含みません）.
+	ExcludedReviewCount int
+	// IssueThroughput はこの日のIssueのクローズ数・クローズまでの時間と種類別の内訳です.
+	IssueThroughput IssueThroughput
+	// WorkCategories はこの日に作成したPRの作業の種類別の件数です.
+	WorkCategories WorkCategoryCounts
+	// OpenIssueCount はこの日（バケット）の終わりにオープンだったIssue数です.
+	// リポジトリの日別合計（RepositoryDailyStats）でのみ設定され、それ以外では0です.
+	OpenIssueCount int
 }
 
 // NewDailyStatistics は新しいDailyStatistics値オブジェクトを作成します.
@@ -54,6 +63,10 @@ type RepoDailyStatistics struct {
 	ReviewCount    int
 	TotalAdditions int
 	TotalDeletions int
+	// IssueThroughput はこのリポジトリ・日のIssueのクローズ数・クローズまでの時間と種類別の内訳です.
+	IssueThroughput IssueThroughput
+	// WorkCategories はこのリポジトリ・日に作成したPRの作業の種類別の件数です.
+	WorkCategories WorkCategoryCounts
 }
 
 // NewRepoDailyStatistics は新しいRepoDailyStatistics値オブジェクトを作成します.
@@ -64,6 +77,25 @@ func NewRepoDailyStatistics(repository, date string) *RepoDailyStatistics {
 	}
 }
 
+// ReviewEdge はレビュアーからPR作成者へのレビュー件数（リポジトリ×日別）を表す値オブジェクトです.
+// レビュアーは当該 UserStatistics のユーザーで、誰が誰のPRをレビューしているかの協業グラフの元データになります.
+// Date は "2006-01-02" 形式（UTC基準で丸めた日）のISO日付文字列です.
+type ReviewEdge struct {
+	Author      string
+	Repository  string
+	Date        string
+	ReviewCount int
+}
+
+// NewReviewEdge は新しいReviewEdge値オブジェクトを作成します.
+func NewReviewEdge(author, repository, date string) *ReviewEdge {
+	return &ReviewEdge{
+		Author:     author,
+		Repository: repository,
+		Date:       date,
+	}
+}
+
 // UserStatistics はユーザーの統計情報を集約するドメインモデルです.
 type UserStatistics struct {
 	User                *User
@@ -81,13 +113,38 @@ type UserStatistics struct {
 	// DailyStats は日別の統計情報です（キーは "2006-01-02" 形式のISO日付文字列）.
 	DailyStats map[string]*DailyStatistics
 	// RepoDailyStats はリポジトリ×日別の統計情報です（時系列比較の元データ）.
-	RepoDailyStats       []*RepoDailyStatistics
+	RepoDailyStats []*RepoDailyStatistics
+	// ReviewEdges はPR作成者×リポジトリ×日別のレビュー件数です（協業グラフの元データ）.
+	ReviewEdges          []*ReviewEdge
 	TopRepositories      []*RepositoryActivity
 	LongTermRepositories []*RepositoryActivity
 	// AllRepositories は関与した全リポジトリの活動内訳です（TopRepositories/LongTermRepositoriesとは別に全件を保持する）.
 	AllRepositories []*RepositoryActivity
 	PRToReviewRatio float64 // PR作成数に対するレビュー数の比率
 	RoleTransition  []RoleTransitionPoint
+	// PRLifecycles は作成したPRごとのライフサイクルです（サイクルタイム集計の元データ）.
+	PRLifecycles []*PullRequestLifecycle
+	// CycleTime は PRLifecycles から求めたサイクルタイムの集計です.
+	CycleTime CycleTimeStats
+	// PRSize は PRLifecycles から求めたPRの大きさの分布です.
+	PRSize PRSizeStats
+	// DataGaps は取得に失敗してこの統計に含まれていない範囲です（空なら完全）.
+	DataGaps []*DataGap
+	// Logins はこのメンバーにまとめた GitHub アカウントです（1アカウントだけのメンバーは空）.
+	Logins []string
+	// TotalExcludedReviews は除外ルールに一致したアカウント（ボット等）のPRへのレビュー数です.
+	// TotalReviews・レビューエッジには含めず、別に報告します.
+	TotalExcludedReviews int
+	// IssueThroughput はIssueのクローズ数・クローズまでの時間と種類別の内訳の合計です.
+	IssueThroughput IssueThroughput
+	// WorkCategories は作成したPRの作業の種類別の件数の合計です.
+	WorkCategories WorkCategoryCounts
+	// IssueLifecycles は作成した、またはクローズしたIssueごとのライフサイクルです（オープン中のIssue数の元データ）.
+	IssueLifecycles []*IssueLifecycle
+	// TimeZone は HourlyActivity を数えたメンバーのタイムゾーン（IANA の名前）です.
+	TimeZone string
+	// HourlyActivity は現地時刻の1時間ごとの活動件数です（時間の古い順. 活動の時間帯のヒートマップの元データ）.
+	HourlyActivity []*HourlyActivity
 }
 
 // RoleTransitionPoint はロール変化のポイントを表します.
@@ -106,13 +163,31 @@ func NewUserStatistics(user *User) *UserStatistics {
 		YearlyStats:          make(map[int]*YearlyStatistics),
 		DailyStats:           make(map[string]*DailyStatistics),
 		RepoDailyStats:       make([]*RepoDailyStatistics, 0),
+		ReviewEdges:          make([]*ReviewEdge, 0),
 		TopRepositories:      make([]*RepositoryActivity, 0),
 		LongTermRepositories: make([]*RepositoryActivity, 0),
 		AllRepositories:      make([]*RepositoryActivity, 0),
 		RoleTransition:       make([]RoleTransitionPoint, 0),
+		PRLifecycles:         make([]*PullRequestLifecycle, 0),
+		IssueLifecycles:      make([]*IssueLifecycle, 0),
+		HourlyActivity:       make([]*HourlyActivity, 0),
 	}
 }
 
+// SetPRLifecycles はPRライフサイクルを設定し、サイクルタイムと大きさの分布を再計算します.
+func (us *UserStatistics) SetPRLifecycles(prs []*PullRequestLifecycle) {
+	us.PRLifecycles = make([]*PullRequestLifecycle, 0, len(prs))
+
+	for _, pr := range prs {
+		if pr != nil {
+			us.PRLifecycles = append(us.PRLifecycles, pr)
+		}
+	}
+
+	us.CycleTime = CalculateCycleTimeStats(us.PRLifecycles)
+	us.PRSize = CalculatePRSizeStats(us.PRLifecycles)
+}
+
 // CalculatePRToReviewRatio はPR作成数に対するレビュー数の比率を計算します.
 func (us *UserStatistics) CalculatePRToReviewRatio() {
 	if us.TotalPRCreated > 0 {
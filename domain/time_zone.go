@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrUnknownTimeZone は存在しないタイムゾーンを指定した場合のエラーです.
+var ErrUnknownTimeZone = errors.New("unknown time zone")
+
+// LoadTimeZone は IANA のタイムゾーン名（"Asia/Tokyo" など）を解釈します（空文字なら UTC）.
+func LoadTimeZone(name string) (*time.Location, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return time.UTC, nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q: %w", ErrUnknownTimeZone, name, err)
+	}
+
+	return loc, nil
+}
+
+// TimeZones はメンバーごとのタイムゾーンです. 活動の時間帯（曜日×時）を数えるのに使います.
+// ログインは大文字小文字を区別しません. nil はすべてのメンバーを UTC とします.
+type TimeZones struct {
+	fallback *time.Location
+	byLogin  map[string]*time.Location
+}
+
+// NewTimeZones は既定のタイムゾーン fallback と、identities の TimeZone からメンバーごとのタイムゾーンを作成します.
+// TimeZone が空の ID は既定のタイムゾーンを使い、まとめたアカウントのログインでも引けます.
+func NewTimeZones(fallback string, identities []Identity) (*TimeZones, error) {
+	loc, err := LoadTimeZone(fallback)
+	if err != nil {
+		return nil, err
+	}
+
+	zones := &TimeZones{fallback: loc, byLogin: make(map[string]*time.Location)}
+
+	for _, identity := range identities {
+		if strings.TrimSpace(identity.TimeZone) == "" {
+			continue
+		}
+
+		loc, err := LoadTimeZone(identity.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("identity %s: %w", identity.Login, err)
+		}
+
+		for _, login := range append([]string{identity.Login}, identity.Logins...) {
+			zones.byLogin[strings.ToLower(strings.TrimSpace(login))] = loc
+		}
+	}
+
+	return zones, nil
+}
+
+// For はメンバー login のタイムゾーンを返します.
+func (z *TimeZones) For(login string) *time.Location {
+	if z == nil {
+		return time.UTC
+	}
+
+	if loc, ok := z.byLogin[strings.ToLower(login)]; ok {
+		return loc
+	}
+
+	return z.fallback
+}
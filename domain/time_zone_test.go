@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeZones(t *testing.T) {
+	t.Parallel()
+
+	zones, err := NewTimeZones("Asia/Tokyo", []Identity{
+		{Login: "alice", Logins: []string{"alice-work"}, TimeZone: "America/New_York"},
+		{Login: "bob", Logins: []string{"bob-work"}},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "America/New_York", zones.For("alice").String())
+	assert.Equal(t, "America/New_York", zones.For("Alice-Work").String())
+	assert.Equal(t, "Asia/Tokyo", zones.For("bob").String())
+	assert.Equal(t, "Asia/Tokyo", zones.For("carol").String())
+
+	var none *TimeZones
+	assert.Equal(t, time.UTC, none.For("alice"))
+
+	_, err = NewTimeZones("", []Identity{{Login: "alice", TimeZone: "Mars/Olympus"}})
+	require.ErrorIs(t, err, ErrUnknownTimeZone)
+
+	_, err = NewTimeZones("Nowhere/City", nil)
+	require.ErrorIs(t, err, ErrUnknownTimeZone)
+}
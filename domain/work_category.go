@@ -0,0 +1,336 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// WorkCategory はPull Requestの作業の種類です.
+type WorkCategory string
+
+const (
+	// WorkCategoryFeature は機能追加・改善のPRを表します.
+	WorkCategoryFeature WorkCategory = "FEATURE"
+	// WorkCategoryBug は不具合修正のPRを表します.
+	WorkCategoryBug WorkCategory = "BUG"
+	// WorkCategoryChore はリファクタリング・依存関係の更新などの保守作業のPRを表します.
+	WorkCategoryChore WorkCategory = "CHORE"
+	// WorkCategoryDocs はドキュメントのPRを表します.
+	WorkCategoryDocs WorkCategory = "DOCS"
+	// WorkCategoryTest はテストのPRを表します.
+	WorkCategoryTest WorkCategory = "TEST"
+	// WorkCategoryInfra は CI・ビルド・デプロイなど基盤のPRを表します.
+	WorkCategoryInfra WorkCategory = "INFRA"
+	// WorkCategoryOther はどの規則にも一致しなかったPRを表します.
+	WorkCategoryOther WorkCategory = "OTHER"
+)
+
+var (
+	// ErrUnknownWorkCategory は存在しない作業の種類を指定した場合のエラーです.
+	ErrUnknownWorkCategory = errors.New("unknown work category")
+	// ErrInvalidPathPattern はファイルパスのパターンが不正な場合のエラーです.
+	ErrInvalidPathPattern = errors.New("invalid path pattern")
+)
+
+// WorkCategories は作業の種類を表示順に返します（WorkCategoryOther は最後です）.
+func WorkCategories() []WorkCategory {
+	return []WorkCategory{
+		WorkCategoryFeature,
+		WorkCategoryBug,
+		WorkCategoryChore,
+		WorkCategoryDocs,
+		WorkCategoryTest,
+		WorkCategoryInfra,
+		WorkCategoryOther,
+	}
+}
+
+// workCategoryPrecedence は規則を試す順序です.
+// ラベル・タイトルが複数の種類に一致する場合は先の種類を優先します（不具合の修正を機能追加より優先します）.
+var workCategoryPrecedence = []WorkCategory{
+	WorkCategoryBug,
+	WorkCategoryFeature,
+	WorkCategoryInfra,
+	WorkCategoryTest,
+	WorkCategoryDocs,
+	WorkCategoryChore,
+}
+
+// ParseWorkCategory は作業の種類の名前（大文字小文字を区別しない）を解釈します.
+// 規則で判定する種類だけを受け付け、WorkCategoryOther は受け付けません.
+func ParseWorkCategory(name string) (WorkCategory, error) {
+	category := WorkCategory(strings.ToUpper(strings.TrimSpace(name)))
+	for _, known := range workCategoryPrecedence {
+		if category == known {
+			return category, nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: %q", ErrUnknownWorkCategory, name)
+}
+
+// WorkCategoryRule は1つの作業の種類を判定する規則です.
+type WorkCategoryRule struct {
+	Category WorkCategory
+	// Labels はこの種類として数えるPRのラベルです（Issue のラベルと同じく、大文字小文字を区別せず、
+	// "type: bug" のように ":" や "/" で区切られたラベルは最後の部分でも比較します）.
+	Labels []string
+	// TitlePrefixes は Conventional Commits 形式のタイトル（"fix(api): ..."）の型です.
+	TitlePrefixes []string
+	// Paths は変更したファイルのパスのパターンです. すべてのファイルがいずれかに一致するPRをこの種類とします.
+	// "/" を含まないパターン（"*.md"）はファイル名に、含むパターン（"docs/**"）はリポジトリのルートからのパスに一致させ、
+	// "**" は0個以上のディレクトリに一致します.
+	Paths []string
+}
+
+// DefaultWorkCategoryRules は既定の規則を判定の順序で返します.
+func DefaultWorkCategoryRules() []WorkCategoryRule {
+	return []WorkCategoryRule{
+		{
+			Category:      WorkCategoryBug,
+			Labels:        []string{"bug", "bugfix", "fix", "regression"},
+			TitlePrefixes: []string{"fix", "hotfix"},
+		},
+		{
+			Category:      WorkCategoryFeature,
+			Labels:        []string{"feature", "enhancement"},
+			TitlePrefixes: []string{"feat", "feature"},
+		},
+		{
+			Category:      WorkCategoryInfra,
+			Labels:        []string{"infra", "infrastructure", "ci"},
+			TitlePrefixes: []string{"ci", "build"},
+			Paths: []string{
+				".github/**", ".circleci/**", "Dockerfile", "*.dockerfile", "docker-compose*.yml", "docker-compose*.yaml",
+				"*.tf", "Makefile", "deploy/**", "k8s/**", "helm/**",
+			},
+		},
+		{
+			Category:      WorkCategoryTest,
+			Labels:        []string{"test", "tests", "testing"},
+			TitlePrefixes: []string{"test", "tests"},
+			Paths:         []string{"*_test.go", "*.test.*", "*.spec.*", "test/**", "tests/**", "**/testdata/**", "**/__tests__/**"},
+		},
+		{
+			Category:      WorkCategoryDocs,
+			Labels:        []string{"documentation", "docs"},
+			TitlePrefixes: []string{"docs", "doc"},
+			Paths:         []string{"docs/**", "*.md", "*.mdx", "*.rst", "LICENSE"},
+		},
+		{
+			Category:      WorkCategoryChore,
+			Labels:        []string{"chore", "dependencies", "maintenance", "refactor"},
+			TitlePrefixes: []string{"chore", "refactor", "style", "perf", "revert", "deps"},
+			Paths:         []string{"go.mod", "go.sum", "package.json", "pnpm-lock.yaml", "package-lock.json", "yarn.lock"},
+		},
+	}
+}
+
+// PRClassifier はラベル・タイトル・変更したファイルのパスからPRの作業の種類を判定します.
+// nil は既定の規則で判定します.
+type PRClassifier struct {
+	rules []WorkCategoryRule
+}
+
+// NewPRClassifier は既定の規則のうち、overrides に含まれる種類の規則を置き換えた判定器を作成します.
+// 判定の順序は既定の規則と同じです.
+func NewPRClassifier(overrides []WorkCategoryRule) (*PRClassifier, error) {
+	rules := DefaultWorkCategoryRules()
+
+	for _, override := range overrides {
+		category, err := ParseWorkCategory(string(override.Category))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, pattern := range override.Paths {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return nil, fmt.Errorf("%w: %s: %q", ErrInvalidPathPattern, strings.ToLower(string(category)), pattern)
+			}
+		}
+
+		override.Category = category
+
+		for i := range rules {
+			if rules[i].Category == category {
+				rules[i] = override
+			}
+		}
+	}
+
+	return &PRClassifier{rules: rules}, nil
+}
+
+// conventionalTitlePattern は Conventional Commits 形式のタイトルの型を取り出します（"feat(api)!: ..." の "feat"）.
+var conventionalTitlePattern = regexp.MustCompile(`^\s*([A-Za-z]+)(?:\([^)]*\))?!?:`)
+
+// Classify はPRの作業の種類を判定します.
+// ラベル、タイトルの型、変更したファイルのパスの順に規則を試し、どれにも一致しなければ WorkCategoryOther を返します.
+func (c *PRClassifier) Classify(labels []string, title string, paths []string) WorkCategory {
+	rules := DefaultWorkCategoryRules()
+	if c != nil {
+		rules = c.rules
+	}
+
+	for _, rule := range rules {
+		if matchesIssueLabel(labels, rule.Labels) {
+			return rule.Category
+		}
+	}
+
+	if match := conventionalTitlePattern.FindStringSubmatch(title); match != nil {
+		for _, rule := range rules {
+			for _, prefix := range rule.TitlePrefixes {
+				if strings.EqualFold(match[1], strings.TrimSpace(prefix)) {
+					return rule.Category
+				}
+			}
+		}
+	}
+
+	if len(paths) > 0 {
+		for _, rule := range rules {
+			if matchesAllPaths(paths, rule.Paths) {
+				return rule.Category
+			}
+		}
+	}
+
+	return WorkCategoryOther
+}
+
+// matchesAllPaths は paths のすべてが patterns のいずれかに一致するかを返します.
+func matchesAllPaths(paths, patterns []string) bool {
+	if len(patterns) == 0 {
+		return false
+	}
+
+	for _, p := range paths {
+		matched := false
+
+		for _, pattern := range patterns {
+			if MatchPathPattern(pattern, p) {
+				matched = true
+
+				break
+			}
+		}
+
+		if !matched {
+			return false
+		}
+	}
+
+	return true
+}
+
+// MatchPathPattern はファイルのパス name がパターンに一致するかを返します（パターンの書式は WorkCategoryRule.Paths を参照）.
+// "/" で終わるパターンはそのディレクトリ以下のすべてのファイルに一致します.
+func MatchPathPattern(pattern, name string) bool {
+	if strings.HasSuffix(pattern, "/") {
+		pattern += "**"
+	}
+
+	if !strings.Contains(pattern, "/") {
+		ok, _ := path.Match(pattern, path.Base(name))
+
+		return ok
+	}
+
+	return matchSegments(strings.Split(strings.TrimPrefix(pattern, "/"), "/"), strings.Split(name, "/"))
+}
+
+// matchSegments はパスの各要素をパターンの各要素に一致させます（"**" は0個以上の要素に一致します）.
+func matchSegments(pattern, name []string) bool {
+	if len(pattern) == 0 {
+		return len(name) == 0
+	}
+
+	if pattern[0] == "**" {
+		for i := 0; i <= len(name); i++ {
+			if matchSegments(pattern[1:], name[i:]) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	if len(name) == 0 {
+		return false
+	}
+
+	ok, _ := path.Match(pattern[0], name[0])
+
+	return ok && matchSegments(pattern[1:], name[1:])
+}
+
+// WorkCategoryCounts は作成したPRの作業の種類別の件数です.
+type WorkCategoryCounts struct {
+	Feature int
+	Bug     int
+	Chore   int
+	Docs    int
+	Test    int
+	Infra   int
+	Other   int
+}
+
+// WorkCategoryCountsOf はPR作成の活動1件分の件数を返します（それ以外の活動と、種類を判定していないPRはゼロ値）.
+func WorkCategoryCountsOf(activity *Activity) WorkCategoryCounts {
+	var c WorkCategoryCounts
+	if activity.Type != ActivityTypePR {
+		return c
+	}
+
+	if field := c.field(activity.WorkCategory); field != nil {
+		*field = 1
+	}
+
+	return c
+}
+
+// field は種類 category の件数を指すポインタを返します（未知の種類なら nil）.
+func (c *WorkCategoryCounts) field(category WorkCategory) *int {
+	switch category {
+	case WorkCategoryFeature:
+		return &c.Feature
+	case WorkCategoryBug:
+		return &c.Bug
+	case WorkCategoryChore:
+		return &c.Chore
+	case WorkCategoryDocs:
+		return &c.Docs
+	case WorkCategoryTest:
+		return &c.Test
+	case WorkCategoryInfra:
+		return &c.Infra
+	case WorkCategoryOther:
+		return &c.Other
+	}
+
+	return nil
+}
+
+// Count は種類 category の件数を返します.
+func (c WorkCategoryCounts) Count(category WorkCategory) int {
+	if field := c.field(category); field != nil {
+		return *field
+	}
+
+	return 0
+}
+
+// Add は other を加算します.
+func (c *WorkCategoryCounts) Add(other WorkCategoryCounts) {
+	c.Feature += other.Feature
+	c.Bug += other.Bug
+	c.Chore += other.Chore
+	c.Docs += other.Docs
+	c.Test += other.Test
+	c.Infra += other.Infra
+	c.Other += other.Other
+}
@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPRClassifier_Classify(t *testing.T) {
+	t.Parallel()
+
+	custom, err := NewPRClassifier([]WorkCategoryRule{
+		{Category: "infra", Labels: []string{"platform"}, Paths: []string{"ops/"}},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		classifier *PRClassifier
+		labels     []string
+		title      string
+		paths      []string
+		want       WorkCategory
+	}{
+		{"label", nil, []string{"enhancement"}, "Add export", nil, WorkCategoryFeature},
+		{"prefixed label", nil, []string{"type: bug"}, "Handle nil user", nil, WorkCategoryBug},
+		{"bug label wins over feature label", nil, []string{"feature", "bug"}, "", nil, WorkCategoryBug},
+		{"label wins over title", nil, []string{"documentation"}, "fix: typo in README", nil, WorkCategoryDocs},
+		{"conventional title", nil, nil, "fix(api): handle nil user", []string{"api/user.go"}, WorkCategoryBug},
+		{"breaking conventional title", nil, nil, "feat!: drop v1 endpoints", nil, WorkCategoryFeature},
+		{"chore title", nil, nil, "refactor: split fetcher", []string{"fetcher.go"}, WorkCategoryChore},
+		{"title without a type", nil, nil, "Update README.md", []string{"README.md", "docs/usage.md"}, WorkCategoryDocs},
+		{"test files only", nil, nil, "More cases", []string{"domain/user_test.go", "internal/testdata/a.json"}, WorkCategoryTest},
+		{"workflow files only", nil, nil, "Bump actions", []string{".github/workflows/ci.yml"}, WorkCategoryInfra},
+		{"mixed files", nil, nil, "Export CSV", []string{"csv.go", "csv_test.go", "docs/usage.md"}, WorkCategoryOther},
+		{"nothing to go on", nil, nil, "", nil, WorkCategoryOther},
+		{"custom labels", custom, []string{"platform"}, "", nil, WorkCategoryInfra},
+		{"custom paths replace the defaults", custom, nil, "", []string{".github/workflows/ci.yml"}, WorkCategoryOther},
+		{"custom directory", custom, nil, "", []string{"ops/terraform/main.tf"}, WorkCategoryInfra},
+		{"other categories keep the defaults", custom, []string{"bug"}, "", nil, WorkCategoryBug},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.classifier.Classify(tt.labels, tt.title, tt.paths))
+		})
+	}
+}
+
+func TestNewPRClassifier_Invalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewPRClassifier([]WorkCategoryRule{{Category: "other"}})
+	require.ErrorIs(t, err, ErrUnknownWorkCategory)
+
+	_, err = NewPRClassifier([]WorkCategoryRule{{Category: "docs", Paths: []string{"docs/["}}})
+	require.ErrorIs(t, err, ErrInvalidPathPattern)
+}
+
+func TestMatchPathPattern(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, MatchPathPattern("*.md", "docs/guide/intro.md"))
+	assert.True(t, MatchPathPattern("docs/**", "docs/guide/intro.md"))
+	assert.True(t, MatchPathPattern("**/testdata/**", "testdata/a.json"))
+	assert.True(t, MatchPathPattern("deploy/", "deploy/prod/values.yaml"))
+	assert.False(t, MatchPathPattern("docs/**", "api/docs/intro.md"))
+	assert.False(t, MatchPathPattern("docs/*.md", "docs/guide/intro.md"))
+}
+
+func TestWorkCategoryCountsOf(t *testing.T) {
+	t.Parallel()
+
+	at := time.Date(2024, time.March, 5, 12, 0, 0, 0, time.UTC)
+
+	pr := NewActivity(ActivityTypePR, "acme/api", at, 10, 2)
+	pr.WorkCategory = WorkCategoryDocs
+	assert.Equal(t, WorkCategoryCounts{Docs: 1}, WorkCategoryCountsOf(pr))
+
+	// PRs stored before they were classified are left out of the breakdown.
+	assert.Equal(t, WorkCategoryCounts{}, WorkCategoryCountsOf(NewActivity(ActivityTypePR, "acme/api", at, 0, 0)))
+	assert.Equal(t, WorkCategoryCounts{}, WorkCategoryCountsOf(NewActivity(ActivityTypeCommit, "acme/api", at, 1, 1)))
+
+	total := WorkCategoryCounts{Bug: 2}
+	total.Add(WorkCategoryCountsOf(pr))
+	assert.Equal(t, 1, total.Count(WorkCategoryDocs))
+	assert.Equal(t, 2, total.Count(WorkCategoryBug))
+	assert.Equal(t, 0, total.Count("UNKNOWN"))
+}
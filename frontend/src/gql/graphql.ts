@@ -16,11 +16,47 @@ export type Scalars = {
   Float: { input: number; output: number; }
 };
 
+export type ActivityHeatmap = {
+  __typename?: 'ActivityHeatmap';
+  login: Scalars['String']['output'];
+  offHours: Scalars['Int']['output'];
+  offHoursRatio: Scalars['Float']['output'];
+  rows: Array<HeatmapRow>;
+  timeZone: Scalars['String']['output'];
+  total: Scalars['Int']['output'];
+  weekend: Scalars['Int']['output'];
+};
+
+export type CategoryCount = {
+  __typename?: 'CategoryCount';
+  category: WorkCategory;
+  count: Scalars['Int']['output'];
+};
+
+export type CollectionPeriod = {
+  __typename?: 'CollectionPeriod';
+  since?: Maybe<Scalars['String']['output']>;
+  until?: Maybe<Scalars['String']['output']>;
+};
+
+export type CycleTimeStats = {
+  __typename?: 'CycleTimeStats';
+  prCount: Scalars['Int']['output'];
+  reviewRounds: Percentiles;
+  timeToApprovalHours: Percentiles;
+  timeToCloseHours: Percentiles;
+  timeToFirstReviewHours: Percentiles;
+  timeToMergeHours: Percentiles;
+};
+
 export type DailyStatistics = {
   __typename?: 'DailyStatistics';
+  categoryBreakdown: Array<CategoryCount>;
   commitCount: Scalars['Int']['output'];
   date: Scalars['String']['output'];
   issueCount: Scalars['Int']['output'];
+  issues: IssueStats;
+  openIssues?: Maybe<Scalars['Int']['output']>;
   prCreated: Scalars['Int']['output'];
   prMerged: Scalars['Int']['output'];
   reviewCount: Scalars['Int']['output'];
@@ -28,10 +64,98 @@ export type DailyStatistics = {
   totalDeletions: Scalars['Int']['output'];
 };
 
+export type DataGap = {
+  __typename?: 'DataGap';
+  activityType: Scalars['String']['output'];
+  message: Scalars['String']['output'];
+  reason: Scalars['String']['output'];
+  repository: Scalars['String']['output'];
+};
+
+export type DeliveryMetrics = {
+  __typename?: 'DeliveryMetrics';
+  changeFailureRate?: Maybe<Scalars['Float']['output']>;
+  deploymentCount: Scalars['Int']['output'];
+  deploymentsPerWeek: Scalars['Float']['output'];
+  failedDeploymentCount: Scalars['Int']['output'];
+  leadTimeHours?: Maybe<Scalars['Float']['output']>;
+  repository: Scalars['String']['output'];
+  source?: Maybe<DeliverySource>;
+  timeToRestoreHours?: Maybe<Scalars['Float']['output']>;
+  weekCount: Scalars['Int']['output'];
+  weeks: Array<DeliveryWeek>;
+};
+
+export enum DeliverySource {
+  Deployment = 'DEPLOYMENT',
+  Release = 'RELEASE',
+  Tag = 'TAG',
+}
+
+export type DeliveryWeek = {
+  __typename?: 'DeliveryWeek';
+  deploymentCount: Scalars['Int']['output'];
+  failedDeploymentCount: Scalars['Int']['output'];
+  leadTimeCount: Scalars['Int']['output'];
+  leadTimeSeconds: Scalars['Int']['output'];
+  restoreCount: Scalars['Int']['output'];
+  restoreSeconds: Scalars['Int']['output'];
+  source: DeliverySource;
+  week: Scalars['String']['output'];
+};
+
+export type ExcludedMember = {
+  __typename?: 'ExcludedMember';
+  login: Scalars['String']['output'];
+  rule: Scalars['String']['output'];
+};
+
+export enum Granularity {
+  Day = 'DAY',
+  Month = 'MONTH',
+  Week = 'WEEK',
+}
+
+export type HeatmapRow = {
+  __typename?: 'HeatmapRow';
+  counts: Array<Scalars['Int']['output']>;
+  weekday: Weekday;
+};
+
+export type IssueStats = {
+  __typename?: 'IssueStats';
+  bugClosed: Scalars['Int']['output'];
+  bugOpened: Scalars['Int']['output'];
+  closed: Scalars['Int']['output'];
+  featureClosed: Scalars['Int']['output'];
+  featureOpened: Scalars['Int']['output'];
+  timeToCloseHours?: Maybe<Scalars['Float']['output']>;
+};
+
+export type MemberDelta = {
+  __typename?: 'MemberDelta';
+  delta: MetricDeltas;
+  login: Scalars['String']['output'];
+};
+
+export type MemberHistoryPoint = {
+  __typename?: 'MemberHistoryPoint';
+  capturedAt: Scalars['String']['output'];
+  snapshotId: Scalars['ID']['output'];
+  stats: MemberStats;
+};
+
 export type MemberStats = {
   __typename?: 'MemberStats';
+  categoryBreakdown: Array<CategoryCount>;
+  complete: Scalars['Boolean']['output'];
+  cycleTime: CycleTimeStats;
+  dataGaps: Array<DataGap>;
+  excludedReviews: Scalars['Int']['output'];
+  issues: IssueStats;
   login: Scalars['String']['output'];
   name: Scalars['String']['output'];
+  prSize: PRSizeStats;
   prToReviewRatio: Scalars['Float']['output'];
   totalAdditions: Scalars['Int']['output'];
   totalCommits: Scalars['Int']['output'];
@@ -42,25 +166,140 @@ export type MemberStats = {
   totalReviews: Scalars['Int']['output'];
 };
 
+export type MetricDeltas = {
+  __typename?: 'MetricDeltas';
+  additions: Scalars['Int']['output'];
+  commits: Scalars['Int']['output'];
+  deletions: Scalars['Int']['output'];
+  issues: Scalars['Int']['output'];
+  prCreated: Scalars['Int']['output'];
+  prMerged: Scalars['Int']['output'];
+  reviews: Scalars['Int']['output'];
+};
+
+export enum PRSize {
+  L = 'L',
+  M = 'M',
+  S = 'S',
+  Xl = 'XL',
+  Xs = 'XS',
+}
+
+export type PRSizeCount = {
+  __typename?: 'PRSizeCount';
+  count: Scalars['Int']['output'];
+  size: PRSize;
+};
+
+export type PRSizeStats = {
+  __typename?: 'PRSizeStats';
+  changedFiles: Percentiles;
+  changedLines: Percentiles;
+  histogram: Array<PRSizeCount>;
+  prCount: Scalars['Int']['output'];
+};
+
+export type PendingReview = {
+  __typename?: 'PendingReview';
+  author: Scalars['String']['output'];
+  repository: Scalars['String']['output'];
+  requestedAt: Scalars['String']['output'];
+};
+
+export type Percentiles = {
+  __typename?: 'Percentiles';
+  count: Scalars['Int']['output'];
+  median: Scalars['Float']['output'];
+  p90: Scalars['Float']['output'];
+};
+
 export type Query = {
   __typename?: 'Query';
+  activityHeatmap?: Maybe<ActivityHeatmap>;
+  deliveryMetrics: DeliveryMetrics;
   member?: Maybe<UserStatistics>;
+  memberHistory: Array<MemberHistoryPoint>;
   members: Array<MemberStats>;
   repositories: Array<RepositoryStats>;
   repository?: Maybe<RepositoryStats>;
   repositoryDailyStats: Array<RepositoryDailyStats>;
+  reviewLoad: Array<ReviewLoad>;
+  reviewNetwork: ReviewNetwork;
+  snapshot?: Maybe<Snapshot>;
+  snapshotDiff: SnapshotDiff;
+  snapshots: Array<SnapshotInfo>;
   teamDailyStats: Array<DailyStatistics>;
   teamSummary: TeamSummary;
 };
 
 
+export type QueryActivityHeatmapArgs = {
+  from?: InputMaybe<Scalars['String']['input']>;
+  login: Scalars['String']['input'];
+  to?: InputMaybe<Scalars['String']['input']>;
+};
+
+
+export type QueryDeliveryMetricsArgs = {
+  from?: InputMaybe<Scalars['String']['input']>;
+  repository: Scalars['String']['input'];
+  to?: InputMaybe<Scalars['String']['input']>;
+};
+
+
 export type QueryMemberArgs = {
+  from?: InputMaybe<Scalars['String']['input']>;
+  granularity?: InputMaybe<Granularity>;
+  login: Scalars['String']['input'];
+  snapshotId?: InputMaybe<Scalars['ID']['input']>;
+  to?: InputMaybe<Scalars['String']['input']>;
+};
+
+
+export type QueryMemberHistoryArgs = {
   login: Scalars['String']['input'];
 };
 
 
 export type QueryRepositoryArgs = {
+  from?: InputMaybe<Scalars['String']['input']>;
+  granularity?: InputMaybe<Granularity>;
   nameWithOwner: Scalars['String']['input'];
+  snapshotId?: InputMaybe<Scalars['ID']['input']>;
+  to?: InputMaybe<Scalars['String']['input']>;
+};
+
+
+export type QueryRepositoryDailyStatsArgs = {
+  from?: InputMaybe<Scalars['String']['input']>;
+  granularity?: InputMaybe<Granularity>;
+  snapshotId?: InputMaybe<Scalars['ID']['input']>;
+  to?: InputMaybe<Scalars['String']['input']>;
+};
+
+
+export type QueryReviewNetworkArgs = {
+  from?: InputMaybe<Scalars['String']['input']>;
+  to?: InputMaybe<Scalars['String']['input']>;
+};
+
+
+export type QuerySnapshotArgs = {
+  id: Scalars['ID']['input'];
+};
+
+
+export type QuerySnapshotDiffArgs = {
+  base: Scalars['ID']['input'];
+  head: Scalars['ID']['input'];
+};
+
+
+export type QueryTeamDailyStatsArgs = {
+  from?: InputMaybe<Scalars['String']['input']>;
+  granularity?: InputMaybe<Granularity>;
+  snapshotId?: InputMaybe<Scalars['ID']['input']>;
+  to?: InputMaybe<Scalars['String']['input']>;
 };
 
 export type RepositoryActivity = {
@@ -95,11 +334,22 @@ export type RepositoryDailyStats = {
   ownerType: Scalars['String']['output'];
 };
 
+export type RepositoryDelta = {
+  __typename?: 'RepositoryDelta';
+  delta: MetricDeltas;
+  nameWithOwner: Scalars['String']['output'];
+};
+
 export type RepositoryStats = {
   __typename?: 'RepositoryStats';
+  categoryBreakdown: Array<CategoryCount>;
   contributorCount: Scalars['Int']['output'];
   contributors: Array<RepositoryContributor>;
+  cycleTime: CycleTimeStats;
+  issues: IssueStats;
   nameWithOwner: Scalars['String']['output'];
+  openIssueCount: Scalars['Int']['output'];
+  prSize: PRSizeStats;
   total: RepositoryTotals;
 };
 
@@ -114,6 +364,36 @@ export type RepositoryTotals = {
   reviews: Scalars['Int']['output'];
 };
 
+export type ReviewLoad = {
+  __typename?: 'ReviewLoad';
+  completed: Scalars['Int']['output'];
+  pending: Scalars['Int']['output'];
+  pendingReviews: Array<PendingReview>;
+  requested: Scalars['Int']['output'];
+  reviewer: Scalars['String']['output'];
+};
+
+export type ReviewNetwork = {
+  __typename?: 'ReviewNetwork';
+  edges: Array<ReviewNetworkEdge>;
+  nodes: Array<ReviewNetworkNode>;
+};
+
+export type ReviewNetworkEdge = {
+  __typename?: 'ReviewNetworkEdge';
+  author: Scalars['String']['output'];
+  repositories: Array<Scalars['String']['output']>;
+  reviewCount: Scalars['Int']['output'];
+  reviewer: Scalars['String']['output'];
+};
+
+export type ReviewNetworkNode = {
+  __typename?: 'ReviewNetworkNode';
+  login: Scalars['String']['output'];
+  reviewsGiven: Scalars['Int']['output'];
+  reviewsReceived: Scalars['Int']['output'];
+};
+
 export type RoleTransitionPoint = {
   __typename?: 'RoleTransitionPoint';
   description: Scalars['String']['output'];
@@ -123,9 +403,49 @@ export type RoleTransitionPoint = {
   year: Scalars['Int']['output'];
 };
 
+export type Snapshot = {
+  __typename?: 'Snapshot';
+  capturedAt: Scalars['String']['output'];
+  excludedMembers: Array<ExcludedMember>;
+  id: Scalars['ID']['output'];
+  memberCount: Scalars['Int']['output'];
+  members: Array<MemberStats>;
+  period: CollectionPeriod;
+  repositories: Array<RepositoryStats>;
+  repositoryCount: Scalars['Int']['output'];
+  tag?: Maybe<Scalars['String']['output']>;
+  teamSummary: TeamSummary;
+};
+
+export type SnapshotDiff = {
+  __typename?: 'SnapshotDiff';
+  addedMembers: Array<Scalars['String']['output']>;
+  addedRepositories: Array<Scalars['String']['output']>;
+  base: SnapshotInfo;
+  head: SnapshotInfo;
+  memberDeltas: Array<MemberDelta>;
+  removedMembers: Array<Scalars['String']['output']>;
+  removedRepositories: Array<Scalars['String']['output']>;
+  repositoryDeltas: Array<RepositoryDelta>;
+};
+
+export type SnapshotInfo = {
+  __typename?: 'SnapshotInfo';
+  capturedAt: Scalars['String']['output'];
+  excludedMembers: Array<ExcludedMember>;
+  id: Scalars['ID']['output'];
+  memberCount: Scalars['Int']['output'];
+  period: CollectionPeriod;
+  repositoryCount: Scalars['Int']['output'];
+  tag?: Maybe<Scalars['String']['output']>;
+};
+
 export type TeamSummary = {
   __typename?: 'TeamSummary';
+  categoryBreakdown: Array<CategoryCount>;
+  issues: IssueStats;
   memberCount: Scalars['Int']['output'];
+  openIssueCount: Scalars['Int']['output'];
   repositoryCount: Scalars['Int']['output'];
   totalAdditions: Scalars['Int']['output'];
   totalCommits: Scalars['Int']['output'];
@@ -138,13 +458,21 @@ export type TeamSummary = {
 
 export type UserStatistics = {
   __typename?: 'UserStatistics';
+  categoryBreakdown: Array<CategoryCount>;
+  complete: Scalars['Boolean']['output'];
+  cycleTime: CycleTimeStats;
   dailyStats: Array<DailyStatistics>;
+  dataGaps: Array<DataGap>;
+  excludedReviews: Scalars['Int']['output'];
   firstActivityYear: Scalars['Int']['output'];
+  issues: IssueStats;
   login: Scalars['String']['output'];
+  logins: Array<Scalars['String']['output']>;
   longTermRepositories: Array<RepositoryActivity>;
   name: Scalars['String']['output'];
   peakActivityCommits: Scalars['Int']['output'];
   peakActivityYear: Scalars['Int']['output'];
+  prSize: PRSizeStats;
   prToReviewRatio: Scalars['Float']['output'];
   roleTransition: Array<RoleTransitionPoint>;
   topRepositories: Array<RepositoryActivity>;
@@ -158,6 +486,26 @@ export type UserStatistics = {
   yearlyStats: Array<YearlyStatistics>;
 };
 
+export enum Weekday {
+  Friday = 'FRIDAY',
+  Monday = 'MONDAY',
+  Saturday = 'SATURDAY',
+  Sunday = 'SUNDAY',
+  Thursday = 'THURSDAY',
+  Tuesday = 'TUESDAY',
+  Wednesday = 'WEDNESDAY',
+}
+
+export enum WorkCategory {
+  Bug = 'BUG',
+  Chore = 'CHORE',
+  Docs = 'DOCS',
+  Feature = 'FEATURE',
+  Infra = 'INFRA',
+  Other = 'OTHER',
+  Test = 'TEST',
+}
+
 export type YearlyStatistics = {
   __typename?: 'YearlyStatistics';
   commitCount: Scalars['Int']['output'];
@@ -36,37 +36,179 @@ type DirectiveRoot struct {
 }
 
 type ComplexityRoot struct {
+	ActivityHeatmap struct {
+		Login         func(childComplexity int) int
+		OffHours      func(childComplexity int) int
+		OffHoursRatio func(childComplexity int) int
+		Rows          func(childComplexity int) int
+		TimeZone      func(childComplexity int) int
+		Total         func(childComplexity int) int
+		Weekend       func(childComplexity int) int
+	}
+
+	CategoryCount struct {
+		Category func(childComplexity int) int
+		Count    func(childComplexity int) int
+	}
+
+	CollectionPeriod struct {
+		Since func(childComplexity int) int
+		Until func(childComplexity int) int
+	}
+
+	CycleTimeStats struct {
+		PrCount                func(childComplexity int) int
+		ReviewRounds           func(childComplexity int) int
+		TimeToApprovalHours    func(childComplexity int) int
+		TimeToCloseHours       func(childComplexity int) int
+		TimeToFirstReviewHours func(childComplexity int) int
+		TimeToMergeHours       func(childComplexity int) int
+	}
+
 	DailyStatistics struct {
-		CommitCount    func(childComplexity int) int
-		Date           func(childComplexity int) int
-		IssueCount     func(childComplexity int) int
-		PrCreated      func(childComplexity int) int
-		PrMerged       func(childComplexity int) int
-		ReviewCount    func(childComplexity int) int
-		TotalAdditions func(childComplexity int) int
-		TotalDeletions func(childComplexity int) int
+		CategoryBreakdown func(childComplexity int) int
+		CommitCount       func(childComplexity int) int
+		Date              func(childComplexity int) int
+		IssueCount        func(childComplexity int) int
+		Issues            func(childComplexity int) int
+		OpenIssues        func(childComplexity int) int
+		PrCreated         func(childComplexity int) int
+		PrMerged          func(childComplexity int) int
+		ReviewCount       func(childComplexity int) int
+		TotalAdditions    func(childComplexity int) int
+		TotalDeletions    func(childComplexity int) int
+	}
+
+	DataGap struct {
+		ActivityType func(childComplexity int) int
+		Message      func(childComplexity int) int
+		Reason       func(childComplexity int) int
+		Repository   func(childComplexity int) int
+	}
+
+	DeliveryMetrics struct {
+		ChangeFailureRate     func(childComplexity int) int
+		DeploymentCount       func(childComplexity int) int
+		DeploymentsPerWeek    func(childComplexity int) int
+		FailedDeploymentCount func(childComplexity int) int
+		LeadTimeHours         func(childComplexity int) int
+		Repository            func(childComplexity int) int
+		Source                func(childComplexity int) int
+		TimeToRestoreHours    func(childComplexity int) int
+		WeekCount             func(childComplexity int) int
+		Weeks                 func(childComplexity int) int
+	}
+
+	DeliveryWeek struct {
+		DeploymentCount       func(childComplexity int) int
+		FailedDeploymentCount func(childComplexity int) int
+		LeadTimeCount         func(childComplexity int) int
+		LeadTimeSeconds       func(childComplexity int) int
+		RestoreCount          func(childComplexity int) int
+		RestoreSeconds        func(childComplexity int) int
+		Source                func(childComplexity int) int
+		Week                  func(childComplexity int) int
+	}
+
+	ExcludedMember struct {
+		Login func(childComplexity int) int
+		Rule  func(childComplexity int) int
+	}
+
+	HeatmapRow struct {
+		Counts  func(childComplexity int) int
+		Weekday func(childComplexity int) int
+	}
+
+	IssueStats struct {
+		BugClosed        func(childComplexity int) int
+		BugOpened        func(childComplexity int) int
+		Closed           func(childComplexity int) int
+		FeatureClosed    func(childComplexity int) int
+		FeatureOpened    func(childComplexity int) int
+		TimeToCloseHours func(childComplexity int) int
+	}
+
+	MemberDelta struct {
+		Delta func(childComplexity int) int
+		Login func(childComplexity int) int
+	}
+
+	MemberHistoryPoint struct {
+		CapturedAt func(childComplexity int) int
+		SnapshotID func(childComplexity int) int
+		Stats      func(childComplexity int) int
 	}
 
 	MemberStats struct {
-		Login           func(childComplexity int) int
-		Name            func(childComplexity int) int
-		PrToReviewRatio func(childComplexity int) int
-		TotalAdditions  func(childComplexity int) int
-		TotalCommits    func(childComplexity int) int
-		TotalDeletions  func(childComplexity int) int
-		TotalIssues     func(childComplexity int) int
-		TotalPRCreated  func(childComplexity int) int
-		TotalPRMerged   func(childComplexity int) int
-		TotalReviews    func(childComplexity int) int
+		CategoryBreakdown func(childComplexity int) int
+		Complete          func(childComplexity int) int
+		CycleTime         func(childComplexity int) int
+		DataGaps          func(childComplexity int) int
+		ExcludedReviews   func(childComplexity int) int
+		Issues            func(childComplexity int) int
+		Login             func(childComplexity int) int
+		Name              func(childComplexity int) int
+		PrSize            func(childComplexity int) int
+		PrToReviewRatio   func(childComplexity int) int
+		TotalAdditions    func(childComplexity int) int
+		TotalCommits      func(childComplexity int) int
+		TotalDeletions    func(childComplexity int) int
+		TotalIssues       func(childComplexity int) int
+		TotalPRCreated    func(childComplexity int) int
+		TotalPRMerged     func(childComplexity int) int
+		TotalReviews      func(childComplexity int) int
+	}
+
+	MetricDeltas struct {
+		Additions func(childComplexity int) int
+		Commits   func(childComplexity int) int
+		Deletions func(childComplexity int) int
+		Issues    func(childComplexity int) int
+		PrCreated func(childComplexity int) int
+		PrMerged  func(childComplexity int) int
+		Reviews   func(childComplexity int) int
+	}
+
+	PRSizeCount struct {
+		Count func(childComplexity int) int
+		Size  func(childComplexity int) int
+	}
+
+	PRSizeStats struct {
+		ChangedFiles func(childComplexity int) int
+		ChangedLines func(childComplexity int) int
+		Histogram    func(childComplexity int) int
+		PrCount      func(childComplexity int) int
+	}
+
+	PendingReview struct {
+		Author      func(childComplexity int) int
+		Repository  func(childComplexity int) int
+		RequestedAt func(childComplexity int) int
+	}
+
+	Percentiles struct {
+		Count  func(childComplexity int) int
+		Median func(childComplexity int) int
+		P90    func(childComplexity int) int
 	}
 
 	Query struct {
-		Member               func(childComplexity int, login string) int
+		ActivityHeatmap      func(childComplexity int, login string, from *string, to *string) int
+		DeliveryMetrics      func(childComplexity int, repository string, from *string, to *string) int
+		Member               func(childComplexity int, login string, from *string, to *string, granularity *model.Granularity, snapshotId *string) int
+		MemberHistory        func(childComplexity int, login string) int
 		Members              func(childComplexity int) int
 		Repositories         func(childComplexity int) int
-		Repository           func(childComplexity int, nameWithOwner string) int
-		RepositoryDailyStats func(childComplexity int) int
-		TeamDailyStats       func(childComplexity int) int
+		Repository           func(childComplexity int, nameWithOwner string, from *string, to *string, granularity *model.Granularity, snapshotId *string) int
+		RepositoryDailyStats func(childComplexity int, from *string, to *string, granularity *model.Granularity, snapshotId *string) int
+		ReviewLoad           func(childComplexity int) int
+		ReviewNetwork        func(childComplexity int, from *string, to *string) int
+		Snapshot             func(childComplexity int, id string) int
+		SnapshotDiff         func(childComplexity int, base string, head string) int
+		Snapshots            func(childComplexity int) int
+		TeamDailyStats       func(childComplexity int, from *string, to *string, granularity *model.Granularity, snapshotId *string) int
 		TeamSummary          func(childComplexity int) int
 	}
 
@@ -99,11 +241,21 @@ type ComplexityRoot struct {
 		OwnerType     func(childComplexity int) int
 	}
 
+	RepositoryDelta struct {
+		Delta         func(childComplexity int) int
+		NameWithOwner func(childComplexity int) int
+	}
+
 	RepositoryStats struct {
-		ContributorCount func(childComplexity int) int
-		Contributors     func(childComplexity int) int
-		NameWithOwner    func(childComplexity int) int
-		Total            func(childComplexity int) int
+		CategoryBreakdown func(childComplexity int) int
+		ContributorCount  func(childComplexity int) int
+		Contributors      func(childComplexity int) int
+		CycleTime         func(childComplexity int) int
+		Issues            func(childComplexity int) int
+		NameWithOwner     func(childComplexity int) int
+		OpenIssueCount    func(childComplexity int) int
+		PrSize            func(childComplexity int) int
+		Total             func(childComplexity int) int
 	}
 
 	RepositoryTotals struct {
@@ -116,6 +268,32 @@ type ComplexityRoot struct {
 		Reviews   func(childComplexity int) int
 	}
 
+	ReviewLoad struct {
+		Completed      func(childComplexity int) int
+		Pending        func(childComplexity int) int
+		PendingReviews func(childComplexity int) int
+		Requested      func(childComplexity int) int
+		Reviewer       func(childComplexity int) int
+	}
+
+	ReviewNetwork struct {
+		Edges func(childComplexity int) int
+		Nodes func(childComplexity int) int
+	}
+
+	ReviewNetworkEdge struct {
+		Author       func(childComplexity int) int
+		Repositories func(childComplexity int) int
+		ReviewCount  func(childComplexity int) int
+		Reviewer     func(childComplexity int) int
+	}
+
+	ReviewNetworkNode struct {
+		Login           func(childComplexity int) int
+		ReviewsGiven    func(childComplexity int) int
+		ReviewsReceived func(childComplexity int) int
+	}
+
 	RoleTransitionPoint struct {
 		Description func(childComplexity int) int
 		PrCreated   func(childComplexity int) int
@@ -124,26 +302,71 @@ type ComplexityRoot struct {
 		Year        func(childComplexity int) int
 	}
 
-	TeamSummary struct {
+	Snapshot struct {
+		CapturedAt      func(childComplexity int) int
+		ExcludedMembers func(childComplexity int) int
+		ID              func(childComplexity int) int
+		MemberCount     func(childComplexity int) int
+		Members         func(childComplexity int) int
+		Period          func(childComplexity int) int
+		Repositories    func(childComplexity int) int
+		RepositoryCount func(childComplexity int) int
+		Tag             func(childComplexity int) int
+		TeamSummary     func(childComplexity int) int
+	}
+
+	SnapshotDiff struct {
+		AddedMembers        func(childComplexity int) int
+		AddedRepositories   func(childComplexity int) int
+		Base                func(childComplexity int) int
+		Head                func(childComplexity int) int
+		MemberDeltas        func(childComplexity int) int
+		RemovedMembers      func(childComplexity int) int
+		RemovedRepositories func(childComplexity int) int
+		RepositoryDeltas    func(childComplexity int) int
+	}
+
+	SnapshotInfo struct {
+		CapturedAt      func(childComplexity int) int
+		ExcludedMembers func(childComplexity int) int
+		ID              func(childComplexity int) int
 		MemberCount     func(childComplexity int) int
+		Period          func(childComplexity int) int
 		RepositoryCount func(childComplexity int) int
-		TotalAdditions  func(childComplexity int) int
-		TotalCommits    func(childComplexity int) int
-		TotalDeletions  func(childComplexity int) int
-		TotalIssues     func(childComplexity int) int
-		TotalPRCreated  func(childComplexity int) int
-		TotalPRMerged   func(childComplexity int) int
-		TotalReviews    func(childComplexity int) int
+		Tag             func(childComplexity int) int
+	}
+
+	TeamSummary struct {
+		CategoryBreakdown func(childComplexity int) int
+		Issues            func(childComplexity int) int
+		MemberCount       func(childComplexity int) int
+		OpenIssueCount    func(childComplexity int) int
+		RepositoryCount   func(childComplexity int) int
+		TotalAdditions    func(childComplexity int) int
+		TotalCommits      func(childComplexity int) int
+		TotalDeletions    func(childComplexity int) int
+		TotalIssues       func(childComplexity int) int
+		TotalPRCreated    func(childComplexity int) int
+		TotalPRMerged     func(childComplexity int) int
+		TotalReviews      func(childComplexity int) int
 	}
 
 	UserStatistics struct {
+		CategoryBreakdown    func(childComplexity int) int
+		Complete             func(childComplexity int) int
+		CycleTime            func(childComplexity int) int
 		DailyStats           func(childComplexity int) int
+		DataGaps             func(childComplexity int) int
+		ExcludedReviews      func(childComplexity int) int
 		FirstActivityYear    func(childComplexity int) int
+		Issues               func(childComplexity int) int
 		Login                func(childComplexity int) int
+		Logins               func(childComplexity int) int
 		LongTermRepositories func(childComplexity int) int
 		Name                 func(childComplexity int) int
 		PeakActivityCommits  func(childComplexity int) int
 		PeakActivityYear     func(childComplexity int) int
+		PrSize               func(childComplexity int) int
 		PrToReviewRatio      func(childComplexity int) int
 		RoleTransition       func(childComplexity int) int
 		TopRepositories      func(childComplexity int) int
@@ -175,12 +398,20 @@ type ComplexityRoot struct {
 
 type QueryResolver interface {
 	Members(ctx context.Context) ([]*model.MemberStats, error)
-	Member(ctx context.Context, login string) (*model.UserStatistics, error)
+	Member(ctx context.Context, login string, from *string, to *string, granularity *model.Granularity, snapshotId *string) (*model.UserStatistics, error)
 	TeamSummary(ctx context.Context) (*model.TeamSummary, error)
-	TeamDailyStats(ctx context.Context) ([]*model.DailyStatistics, error)
+	TeamDailyStats(ctx context.Context, from *string, to *string, granularity *model.Granularity, snapshotId *string) ([]*model.DailyStatistics, error)
 	Repositories(ctx context.Context) ([]*model.RepositoryStats, error)
-	Repository(ctx context.Context, nameWithOwner string) (*model.RepositoryStats, error)
-	RepositoryDailyStats(ctx context.Context) ([]*model.RepositoryDailyStats, error)
+	Repository(ctx context.Context, nameWithOwner string, from *string, to *string, granularity *model.Granularity, snapshotId *string) (*model.RepositoryStats, error)
+	RepositoryDailyStats(ctx context.Context, from *string, to *string, granularity *model.Granularity, snapshotId *string) ([]*model.RepositoryDailyStats, error)
+	ReviewNetwork(ctx context.Context, from *string, to *string) (*model.ReviewNetwork, error)
+	ReviewLoad(ctx context.Context) ([]*model.ReviewLoad, error)
+	ActivityHeatmap(ctx context.Context, login string, from *string, to *string) (*model.ActivityHeatmap, error)
+	DeliveryMetrics(ctx context.Context, repository string, from *string, to *string) (*model.DeliveryMetrics, error)
+	Snapshots(ctx context.Context) ([]*model.SnapshotInfo, error)
+	Snapshot(ctx context.Context, id string) (*model.Snapshot, error)
+	MemberHistory(ctx context.Context, login string) ([]*model.MemberHistoryPoint, error)
+	SnapshotDiff(ctx context.Context, base string, head string) (*model.SnapshotDiff, error)
 }
 
 // endregion ************************** generated!.gotpl **************************
@@ -201,6 +432,118 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 	_ = ec
 	switch typeName + "." + field {
 
+	case "ActivityHeatmap.login":
+		if e.ComplexityRoot.ActivityHeatmap.Login == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ActivityHeatmap.Login(childComplexity), true
+	case "ActivityHeatmap.offHours":
+		if e.ComplexityRoot.ActivityHeatmap.OffHours == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ActivityHeatmap.OffHours(childComplexity), true
+	case "ActivityHeatmap.offHoursRatio":
+		if e.ComplexityRoot.ActivityHeatmap.OffHoursRatio == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ActivityHeatmap.OffHoursRatio(childComplexity), true
+	case "ActivityHeatmap.rows":
+		if e.ComplexityRoot.ActivityHeatmap.Rows == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ActivityHeatmap.Rows(childComplexity), true
+	case "ActivityHeatmap.timeZone":
+		if e.ComplexityRoot.ActivityHeatmap.TimeZone == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ActivityHeatmap.TimeZone(childComplexity), true
+	case "ActivityHeatmap.total":
+		if e.ComplexityRoot.ActivityHeatmap.Total == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ActivityHeatmap.Total(childComplexity), true
+	case "ActivityHeatmap.weekend":
+		if e.ComplexityRoot.ActivityHeatmap.Weekend == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ActivityHeatmap.Weekend(childComplexity), true
+
+	case "CategoryCount.category":
+		if e.ComplexityRoot.CategoryCount.Category == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CategoryCount.Category(childComplexity), true
+	case "CategoryCount.count":
+		if e.ComplexityRoot.CategoryCount.Count == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CategoryCount.Count(childComplexity), true
+
+	case "CollectionPeriod.since":
+		if e.ComplexityRoot.CollectionPeriod.Since == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CollectionPeriod.Since(childComplexity), true
+	case "CollectionPeriod.until":
+		if e.ComplexityRoot.CollectionPeriod.Until == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CollectionPeriod.Until(childComplexity), true
+
+	case "CycleTimeStats.prCount":
+		if e.ComplexityRoot.CycleTimeStats.PrCount == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CycleTimeStats.PrCount(childComplexity), true
+	case "CycleTimeStats.reviewRounds":
+		if e.ComplexityRoot.CycleTimeStats.ReviewRounds == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CycleTimeStats.ReviewRounds(childComplexity), true
+	case "CycleTimeStats.timeToApprovalHours":
+		if e.ComplexityRoot.CycleTimeStats.TimeToApprovalHours == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CycleTimeStats.TimeToApprovalHours(childComplexity), true
+	case "CycleTimeStats.timeToCloseHours":
+		if e.ComplexityRoot.CycleTimeStats.TimeToCloseHours == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CycleTimeStats.TimeToCloseHours(childComplexity), true
+	case "CycleTimeStats.timeToFirstReviewHours":
+		if e.ComplexityRoot.CycleTimeStats.TimeToFirstReviewHours == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CycleTimeStats.TimeToFirstReviewHours(childComplexity), true
+	case "CycleTimeStats.timeToMergeHours":
+		if e.ComplexityRoot.CycleTimeStats.TimeToMergeHours == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CycleTimeStats.TimeToMergeHours(childComplexity), true
+
+	case "DailyStatistics.categoryBreakdown":
+		if e.ComplexityRoot.DailyStatistics.CategoryBreakdown == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DailyStatistics.CategoryBreakdown(childComplexity), true
 	case "DailyStatistics.commitCount":
 		if e.ComplexityRoot.DailyStatistics.CommitCount == nil {
 			break
@@ -219,6 +562,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.ComplexityRoot.DailyStatistics.IssueCount(childComplexity), true
+	case "DailyStatistics.issues":
+		if e.ComplexityRoot.DailyStatistics.Issues == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DailyStatistics.Issues(childComplexity), true
+	case "DailyStatistics.openIssues":
+		if e.ComplexityRoot.DailyStatistics.OpenIssues == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DailyStatistics.OpenIssues(childComplexity), true
 	case "DailyStatistics.prCreated":
 		if e.ComplexityRoot.DailyStatistics.PrCreated == nil {
 			break
@@ -250,4670 +605,12384 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.DailyStatistics.TotalDeletions(childComplexity), true
 
-	case "MemberStats.login":
-		if e.ComplexityRoot.MemberStats.Login == nil {
+	case "DataGap.activityType":
+		if e.ComplexityRoot.DataGap.ActivityType == nil {
 			break
 		}
 
-		return e.ComplexityRoot.MemberStats.Login(childComplexity), true
-	case "MemberStats.name":
-		if e.ComplexityRoot.MemberStats.Name == nil {
+		return e.ComplexityRoot.DataGap.ActivityType(childComplexity), true
+	case "DataGap.message":
+		if e.ComplexityRoot.DataGap.Message == nil {
 			break
 		}
 
-		return e.ComplexityRoot.MemberStats.Name(childComplexity), true
-	case "MemberStats.prToReviewRatio":
-		if e.ComplexityRoot.MemberStats.PrToReviewRatio == nil {
+		return e.ComplexityRoot.DataGap.Message(childComplexity), true
+	case "DataGap.reason":
+		if e.ComplexityRoot.DataGap.Reason == nil {
 			break
 		}
 
-		return e.ComplexityRoot.MemberStats.PrToReviewRatio(childComplexity), true
-	case "MemberStats.totalAdditions":
-		if e.ComplexityRoot.MemberStats.TotalAdditions == nil {
+		return e.ComplexityRoot.DataGap.Reason(childComplexity), true
+	case "DataGap.repository":
+		if e.ComplexityRoot.DataGap.Repository == nil {
 			break
 		}
 
-		return e.ComplexityRoot.MemberStats.TotalAdditions(childComplexity), true
-	case "MemberStats.totalCommits":
-		if e.ComplexityRoot.MemberStats.TotalCommits == nil {
+		return e.ComplexityRoot.DataGap.Repository(childComplexity), true
+
+	case "DeliveryMetrics.changeFailureRate":
+		if e.ComplexityRoot.DeliveryMetrics.ChangeFailureRate == nil {
 			break
 		}
 
-		return e.ComplexityRoot.MemberStats.TotalCommits(childComplexity), true
-	case "MemberStats.totalDeletions":
-		if e.ComplexityRoot.MemberStats.TotalDeletions == nil {
+		return e.ComplexityRoot.DeliveryMetrics.ChangeFailureRate(childComplexity), true
+	case "DeliveryMetrics.deploymentCount":
+		if e.ComplexityRoot.DeliveryMetrics.DeploymentCount == nil {
 			break
 		}
 
-		return e.ComplexityRoot.MemberStats.TotalDeletions(childComplexity), true
-	case "MemberStats.totalIssues":
-		if e.ComplexityRoot.MemberStats.TotalIssues == nil {
+		return e.ComplexityRoot.DeliveryMetrics.DeploymentCount(childComplexity), true
+	case "DeliveryMetrics.deploymentsPerWeek":
+		if e.ComplexityRoot.DeliveryMetrics.DeploymentsPerWeek == nil {
 			break
 		}
 
-		return e.ComplexityRoot.MemberStats.TotalIssues(childComplexity), true
-	case "MemberStats.totalPRCreated":
-		if e.ComplexityRoot.MemberStats.TotalPRCreated == nil {
+		return e.ComplexityRoot.DeliveryMetrics.DeploymentsPerWeek(childComplexity), true
+	case "DeliveryMetrics.failedDeploymentCount":
+		if e.ComplexityRoot.DeliveryMetrics.FailedDeploymentCount == nil {
 			break
 		}
 
-		return e.ComplexityRoot.MemberStats.TotalPRCreated(childComplexity), true
-	case "MemberStats.totalPRMerged":
-		if e.ComplexityRoot.MemberStats.TotalPRMerged == nil {
+		return e.ComplexityRoot.DeliveryMetrics.FailedDeploymentCount(childComplexity), true
+	case "DeliveryMetrics.leadTimeHours":
+		if e.ComplexityRoot.DeliveryMetrics.LeadTimeHours == nil {
 			break
 		}
 
-		return e.ComplexityRoot.MemberStats.TotalPRMerged(childComplexity), true
-	case "MemberStats.totalReviews":
-		if e.ComplexityRoot.MemberStats.TotalReviews == nil {
+		return e.ComplexityRoot.DeliveryMetrics.LeadTimeHours(childComplexity), true
+	case "DeliveryMetrics.repository":
+		if e.ComplexityRoot.DeliveryMetrics.Repository == nil {
 			break
 		}
 
-		return e.ComplexityRoot.MemberStats.TotalReviews(childComplexity), true
-
-	case "Query.member":
-		if e.ComplexityRoot.Query.Member == nil {
+		return e.ComplexityRoot.DeliveryMetrics.Repository(childComplexity), true
+	case "DeliveryMetrics.source":
+		if e.ComplexityRoot.DeliveryMetrics.Source == nil {
 			break
 		}
 
-		args, err := ec.field_Query_member_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.ComplexityRoot.DeliveryMetrics.Source(childComplexity), true
+	case "DeliveryMetrics.timeToRestoreHours":
+		if e.ComplexityRoot.DeliveryMetrics.TimeToRestoreHours == nil {
+			break
 		}
 
-		return e.ComplexityRoot.Query.Member(childComplexity, args["login"].(string)), true
-	case "Query.members":
-		if e.ComplexityRoot.Query.Members == nil {
+		return e.ComplexityRoot.DeliveryMetrics.TimeToRestoreHours(childComplexity), true
+	case "DeliveryMetrics.weekCount":
+		if e.ComplexityRoot.DeliveryMetrics.WeekCount == nil {
 			break
 		}
 
-		return e.ComplexityRoot.Query.Members(childComplexity), true
-	case "Query.repositories":
-		if e.ComplexityRoot.Query.Repositories == nil {
+		return e.ComplexityRoot.DeliveryMetrics.WeekCount(childComplexity), true
+	case "DeliveryMetrics.weeks":
+		if e.ComplexityRoot.DeliveryMetrics.Weeks == nil {
 			break
 		}
 
-		return e.ComplexityRoot.Query.Repositories(childComplexity), true
-	case "Query.repository":
-		if e.ComplexityRoot.Query.Repository == nil {
+		return e.ComplexityRoot.DeliveryMetrics.Weeks(childComplexity), true
+
+	case "DeliveryWeek.deploymentCount":
+		if e.ComplexityRoot.DeliveryWeek.DeploymentCount == nil {
 			break
 		}
 
-		args, err := ec.field_Query_repository_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.ComplexityRoot.DeliveryWeek.DeploymentCount(childComplexity), true
+	case "DeliveryWeek.failedDeploymentCount":
+		if e.ComplexityRoot.DeliveryWeek.FailedDeploymentCount == nil {
+			break
 		}
 
-		return e.ComplexityRoot.Query.Repository(childComplexity, args["nameWithOwner"].(string)), true
-	case "Query.repositoryDailyStats":
-		if e.ComplexityRoot.Query.RepositoryDailyStats == nil {
+		return e.ComplexityRoot.DeliveryWeek.FailedDeploymentCount(childComplexity), true
+	case "DeliveryWeek.leadTimeCount":
+		if e.ComplexityRoot.DeliveryWeek.LeadTimeCount == nil {
 			break
 		}
 
-		return e.ComplexityRoot.Query.RepositoryDailyStats(childComplexity), true
-	case "Query.teamDailyStats":
-		if e.ComplexityRoot.Query.TeamDailyStats == nil {
+		return e.ComplexityRoot.DeliveryWeek.LeadTimeCount(childComplexity), true
+	case "DeliveryWeek.leadTimeSeconds":
+		if e.ComplexityRoot.DeliveryWeek.LeadTimeSeconds == nil {
 			break
 		}
 
-		return e.ComplexityRoot.Query.TeamDailyStats(childComplexity), true
-	case "Query.teamSummary":
-		if e.ComplexityRoot.Query.TeamSummary == nil {
+		return e.ComplexityRoot.DeliveryWeek.LeadTimeSeconds(childComplexity), true
+	case "DeliveryWeek.restoreCount":
+		if e.ComplexityRoot.DeliveryWeek.RestoreCount == nil {
 			break
 		}
 
-		return e.ComplexityRoot.Query.TeamSummary(childComplexity), true
+		return e.ComplexityRoot.DeliveryWeek.RestoreCount(childComplexity), true
+	case "DeliveryWeek.restoreSeconds":
+		if e.ComplexityRoot.DeliveryWeek.RestoreSeconds == nil {
+			break
+		}
 
-	case "RepositoryActivity.commitCount":
-		if e.ComplexityRoot.RepositoryActivity.CommitCount == nil {
+		return e.ComplexityRoot.DeliveryWeek.RestoreSeconds(childComplexity), true
+	case "DeliveryWeek.source":
+		if e.ComplexityRoot.DeliveryWeek.Source == nil {
 			break
 		}
 
-		return e.ComplexityRoot.RepositoryActivity.CommitCount(childComplexity), true
-	case "RepositoryActivity.firstActivity":
-		if e.ComplexityRoot.RepositoryActivity.FirstActivity == nil {
+		return e.ComplexityRoot.DeliveryWeek.Source(childComplexity), true
+	case "DeliveryWeek.week":
+		if e.ComplexityRoot.DeliveryWeek.Week == nil {
 			break
 		}
 
-		return e.ComplexityRoot.RepositoryActivity.FirstActivity(childComplexity), true
-	case "RepositoryActivity.issueCount":
-		if e.ComplexityRoot.RepositoryActivity.IssueCount == nil {
+		return e.ComplexityRoot.DeliveryWeek.Week(childComplexity), true
+
+	case "ExcludedMember.login":
+		if e.ComplexityRoot.ExcludedMember.Login == nil {
 			break
 		}
 
-		return e.ComplexityRoot.RepositoryActivity.IssueCount(childComplexity), true
-	case "RepositoryActivity.lastActivity":
-		if e.ComplexityRoot.RepositoryActivity.LastActivity == nil {
+		return e.ComplexityRoot.ExcludedMember.Login(childComplexity), true
+	case "ExcludedMember.rule":
+		if e.ComplexityRoot.ExcludedMember.Rule == nil {
 			break
 		}
 
-		return e.ComplexityRoot.RepositoryActivity.LastActivity(childComplexity), true
-	case "RepositoryActivity.prCount":
-		if e.ComplexityRoot.RepositoryActivity.PrCount == nil {
+		return e.ComplexityRoot.ExcludedMember.Rule(childComplexity), true
+
+	case "HeatmapRow.counts":
+		if e.ComplexityRoot.HeatmapRow.Counts == nil {
 			break
 		}
 
-		return e.ComplexityRoot.RepositoryActivity.PrCount(childComplexity), true
-	case "RepositoryActivity.repository":
-		if e.ComplexityRoot.RepositoryActivity.Repository == nil {
+		return e.ComplexityRoot.HeatmapRow.Counts(childComplexity), true
+	case "HeatmapRow.weekday":
+		if e.ComplexityRoot.HeatmapRow.Weekday == nil {
 			break
 		}
 
-		return e.ComplexityRoot.RepositoryActivity.Repository(childComplexity), true
-	case "RepositoryActivity.reviewCount":
-		if e.ComplexityRoot.RepositoryActivity.ReviewCount == nil {
+		return e.ComplexityRoot.HeatmapRow.Weekday(childComplexity), true
+
+	case "IssueStats.bugClosed":
+		if e.ComplexityRoot.IssueStats.BugClosed == nil {
 			break
 		}
 
-		return e.ComplexityRoot.RepositoryActivity.ReviewCount(childComplexity), true
-	case "RepositoryActivity.totalAdditions":
-		if e.ComplexityRoot.RepositoryActivity.TotalAdditions == nil {
+		return e.ComplexityRoot.IssueStats.BugClosed(childComplexity), true
+	case "IssueStats.bugOpened":
+		if e.ComplexityRoot.IssueStats.BugOpened == nil {
 			break
 		}
 
-		return e.ComplexityRoot.RepositoryActivity.TotalAdditions(childComplexity), true
-	case "RepositoryActivity.totalDeletions":
-		if e.ComplexityRoot.RepositoryActivity.TotalDeletions == nil {
+		return e.ComplexityRoot.IssueStats.BugOpened(childComplexity), true
+	case "IssueStats.closed":
+		if e.ComplexityRoot.IssueStats.Closed == nil {
 			break
 		}
 
-		return e.ComplexityRoot.RepositoryActivity.TotalDeletions(childComplexity), true
+		return e.ComplexityRoot.IssueStats.Closed(childComplexity), true
+	case "IssueStats.featureClosed":
+		if e.ComplexityRoot.IssueStats.FeatureClosed == nil {
+			break
+		}
 
-	case "RepositoryContributor.additions":
-		if e.ComplexityRoot.RepositoryContributor.Additions == nil {
+		return e.ComplexityRoot.IssueStats.FeatureClosed(childComplexity), true
+	case "IssueStats.featureOpened":
+		if e.ComplexityRoot.IssueStats.FeatureOpened == nil {
 			break
 		}
 
-		return e.ComplexityRoot.RepositoryContributor.Additions(childComplexity), true
-	case "RepositoryContributor.commitCount":
-		if e.ComplexityRoot.RepositoryContributor.CommitCount == nil {
+		return e.ComplexityRoot.IssueStats.FeatureOpened(childComplexity), true
+	case "IssueStats.timeToCloseHours":
+		if e.ComplexityRoot.IssueStats.TimeToCloseHours == nil {
 			break
 		}
 
-		return e.ComplexityRoot.RepositoryContributor.CommitCount(childComplexity), true
-	case "RepositoryContributor.dailyStats":
-		if e.ComplexityRoot.RepositoryContributor.DailyStats == nil {
+		return e.ComplexityRoot.IssueStats.TimeToCloseHours(childComplexity), true
+
+	case "MemberDelta.delta":
+		if e.ComplexityRoot.MemberDelta.Delta == nil {
 			break
 		}
 
-		return e.ComplexityRoot.RepositoryContributor.DailyStats(childComplexity), true
-	case "RepositoryContributor.deletions":
-		if e.ComplexityRoot.RepositoryContributor.Deletions == nil {
+		return e.ComplexityRoot.MemberDelta.Delta(childComplexity), true
+	case "MemberDelta.login":
+		if e.ComplexityRoot.MemberDelta.Login == nil {
 			break
 		}
 
-		return e.ComplexityRoot.RepositoryContributor.Deletions(childComplexity), true
-	case "RepositoryContributor.login":
-		if e.ComplexityRoot.RepositoryContributor.Login == nil {
+		return e.ComplexityRoot.MemberDelta.Login(childComplexity), true
+
+	case "MemberHistoryPoint.capturedAt":
+		if e.ComplexityRoot.MemberHistoryPoint.CapturedAt == nil {
 			break
 		}
 
-		return e.ComplexityRoot.RepositoryContributor.Login(childComplexity), true
-	case "RepositoryContributor.prCreated":
-		if e.ComplexityRoot.RepositoryContributor.PrCreated == nil {
+		return e.ComplexityRoot.MemberHistoryPoint.CapturedAt(childComplexity), true
+	case "MemberHistoryPoint.snapshotId":
+		if e.ComplexityRoot.MemberHistoryPoint.SnapshotID == nil {
 			break
 		}
 
-		return e.ComplexityRoot.RepositoryContributor.PrCreated(childComplexity), true
-	case "RepositoryContributor.reviewCount":
-		if e.ComplexityRoot.RepositoryContributor.ReviewCount == nil {
+		return e.ComplexityRoot.MemberHistoryPoint.SnapshotID(childComplexity), true
+	case "MemberHistoryPoint.stats":
+		if e.ComplexityRoot.MemberHistoryPoint.Stats == nil {
 			break
 		}
 
-		return e.ComplexityRoot.RepositoryContributor.ReviewCount(childComplexity), true
+		return e.ComplexityRoot.MemberHistoryPoint.Stats(childComplexity), true
 
-	case "RepositoryDailyStats.dailyStats":
-		if e.ComplexityRoot.RepositoryDailyStats.DailyStats == nil {
+	case "MemberStats.categoryBreakdown":
+		if e.ComplexityRoot.MemberStats.CategoryBreakdown == nil {
 			break
 		}
 
-		return e.ComplexityRoot.RepositoryDailyStats.DailyStats(childComplexity), true
-	case "RepositoryDailyStats.nameWithOwner":
-		if e.ComplexityRoot.RepositoryDailyStats.NameWithOwner == nil {
+		return e.ComplexityRoot.MemberStats.CategoryBreakdown(childComplexity), true
+	case "MemberStats.complete":
+		if e.ComplexityRoot.MemberStats.Complete == nil {
 			break
 		}
 
-		return e.ComplexityRoot.RepositoryDailyStats.NameWithOwner(childComplexity), true
-	case "RepositoryDailyStats.owner":
-		if e.ComplexityRoot.RepositoryDailyStats.Owner == nil {
+		return e.ComplexityRoot.MemberStats.Complete(childComplexity), true
+	case "MemberStats.cycleTime":
+		if e.ComplexityRoot.MemberStats.CycleTime == nil {
 			break
 		}
 
-		return e.ComplexityRoot.RepositoryDailyStats.Owner(childComplexity), true
-	case "RepositoryDailyStats.ownerType":
-		if e.ComplexityRoot.RepositoryDailyStats.OwnerType == nil {
+		return e.ComplexityRoot.MemberStats.CycleTime(childComplexity), true
+	case "MemberStats.dataGaps":
+		if e.ComplexityRoot.MemberStats.DataGaps == nil {
 			break
 		}
 
-		return e.ComplexityRoot.RepositoryDailyStats.OwnerType(childComplexity), true
-
-	case "RepositoryStats.contributorCount":
-		if e.ComplexityRoot.RepositoryStats.ContributorCount == nil {
+		return e.ComplexityRoot.MemberStats.DataGaps(childComplexity), true
+	case "MemberStats.excludedReviews":
+		if e.ComplexityRoot.MemberStats.ExcludedReviews == nil {
 			break
 		}
 
-		return e.ComplexityRoot.RepositoryStats.ContributorCount(childComplexity), true
-	case "RepositoryStats.contributors":
-		if e.ComplexityRoot.RepositoryStats.Contributors == nil {
+		return e.ComplexityRoot.MemberStats.ExcludedReviews(childComplexity), true
+	case "MemberStats.issues":
+		if e.ComplexityRoot.MemberStats.Issues == nil {
 			break
 		}
 
-		return e.ComplexityRoot.RepositoryStats.Contributors(childComplexity), true
-	case "RepositoryStats.nameWithOwner":
-		if e.ComplexityRoot.RepositoryStats.NameWithOwner == nil {
+		return e.ComplexityRoot.MemberStats.Issues(childComplexity), true
+	case "MemberStats.login":
+		if e.ComplexityRoot.MemberStats.Login == nil {
 			break
 		}
 
-		return e.ComplexityRoot.RepositoryStats.NameWithOwner(childComplexity), true
-	case "RepositoryStats.total":
-		if e.ComplexityRoot.RepositoryStats.Total == nil {
+		return e.ComplexityRoot.MemberStats.Login(childComplexity), true
+	case "MemberStats.name":
+		if e.ComplexityRoot.MemberStats.Name == nil {
 			break
 		}
 
-		return e.ComplexityRoot.RepositoryStats.Total(childComplexity), true
-
-	case "RepositoryTotals.additions":
-		if e.ComplexityRoot.RepositoryTotals.Additions == nil {
+		return e.ComplexityRoot.MemberStats.Name(childComplexity), true
+	case "MemberStats.prSize":
+		if e.ComplexityRoot.MemberStats.PrSize == nil {
 			break
 		}
 
-		return e.ComplexityRoot.RepositoryTotals.Additions(childComplexity), true
-	case "RepositoryTotals.commits":
-		if e.ComplexityRoot.RepositoryTotals.Commits == nil {
+		return e.ComplexityRoot.MemberStats.PrSize(childComplexity), true
+	case "MemberStats.prToReviewRatio":
+		if e.ComplexityRoot.MemberStats.PrToReviewRatio == nil {
 			break
 		}
 
-		return e.ComplexityRoot.RepositoryTotals.Commits(childComplexity), true
-	case "RepositoryTotals.deletions":
-		if e.ComplexityRoot.RepositoryTotals.Deletions == nil {
+		return e.ComplexityRoot.MemberStats.PrToReviewRatio(childComplexity), true
+	case "MemberStats.totalAdditions":
+		if e.ComplexityRoot.MemberStats.TotalAdditions == nil {
 			break
 		}
 
-		return e.ComplexityRoot.RepositoryTotals.Deletions(childComplexity), true
-	case "RepositoryTotals.issues":
-		if e.ComplexityRoot.RepositoryTotals.Issues == nil {
+		return e.ComplexityRoot.MemberStats.TotalAdditions(childComplexity), true
+	case "MemberStats.totalCommits":
+		if e.ComplexityRoot.MemberStats.TotalCommits == nil {
 			break
 		}
 
-		return e.ComplexityRoot.RepositoryTotals.Issues(childComplexity), true
-	case "RepositoryTotals.prCreated":
-		if e.ComplexityRoot.RepositoryTotals.PrCreated == nil {
+		return e.ComplexityRoot.MemberStats.TotalCommits(childComplexity), true
+	case "MemberStats.totalDeletions":
+		if e.ComplexityRoot.MemberStats.TotalDeletions == nil {
 			break
 		}
 
-		return e.ComplexityRoot.RepositoryTotals.PrCreated(childComplexity), true
-	case "RepositoryTotals.prMerged":
-		if e.ComplexityRoot.RepositoryTotals.PrMerged == nil {
+		return e.ComplexityRoot.MemberStats.TotalDeletions(childComplexity), true
+	case "MemberStats.totalIssues":
+		if e.ComplexityRoot.MemberStats.TotalIssues == nil {
 			break
 		}
 
-		return e.ComplexityRoot.RepositoryTotals.PrMerged(childComplexity), true
-	case "RepositoryTotals.reviews":
-		if e.ComplexityRoot.RepositoryTotals.Reviews == nil {
+		return e.ComplexityRoot.MemberStats.TotalIssues(childComplexity), true
+	case "MemberStats.totalPRCreated":
+		if e.ComplexityRoot.MemberStats.TotalPRCreated == nil {
 			break
 		}
 
-		return e.ComplexityRoot.RepositoryTotals.Reviews(childComplexity), true
-
-	case "RoleTransitionPoint.description":
-		if e.ComplexityRoot.RoleTransitionPoint.Description == nil {
+		return e.ComplexityRoot.MemberStats.TotalPRCreated(childComplexity), true
+	case "MemberStats.totalPRMerged":
+		if e.ComplexityRoot.MemberStats.TotalPRMerged == nil {
 			break
 		}
 
-		return e.ComplexityRoot.RoleTransitionPoint.Description(childComplexity), true
-	case "RoleTransitionPoint.prCreated":
-		if e.ComplexityRoot.RoleTransitionPoint.PrCreated == nil {
+		return e.ComplexityRoot.MemberStats.TotalPRMerged(childComplexity), true
+	case "MemberStats.totalReviews":
+		if e.ComplexityRoot.MemberStats.TotalReviews == nil {
 			break
 		}
 
-		return e.ComplexityRoot.RoleTransitionPoint.PrCreated(childComplexity), true
-	case "RoleTransitionPoint.ratio":
-		if e.ComplexityRoot.RoleTransitionPoint.Ratio == nil {
+		return e.ComplexityRoot.MemberStats.TotalReviews(childComplexity), true
+
+	case "MetricDeltas.additions":
+		if e.ComplexityRoot.MetricDeltas.Additions == nil {
 			break
 		}
 
-		return e.ComplexityRoot.RoleTransitionPoint.Ratio(childComplexity), true
-	case "RoleTransitionPoint.reviewCount":
-		if e.ComplexityRoot.RoleTransitionPoint.ReviewCount == nil {
+		return e.ComplexityRoot.MetricDeltas.Additions(childComplexity), true
+	case "MetricDeltas.commits":
+		if e.ComplexityRoot.MetricDeltas.Commits == nil {
 			break
 		}
 
-		return e.ComplexityRoot.RoleTransitionPoint.ReviewCount(childComplexity), true
-	case "RoleTransitionPoint.year":
-		if e.ComplexityRoot.RoleTransitionPoint.Year == nil {
+		return e.ComplexityRoot.MetricDeltas.Commits(childComplexity), true
+	case "MetricDeltas.deletions":
+		if e.ComplexityRoot.MetricDeltas.Deletions == nil {
 			break
 		}
 
-		return e.ComplexityRoot.RoleTransitionPoint.Year(childComplexity), true
+		return e.ComplexityRoot.MetricDeltas.Deletions(childComplexity), true
+	case "MetricDeltas.issues":
+		if e.ComplexityRoot.MetricDeltas.Issues == nil {
+			break
+		}
 
-	case "TeamSummary.memberCount":
-		if e.ComplexityRoot.TeamSummary.MemberCount == nil {
+		return e.ComplexityRoot.MetricDeltas.Issues(childComplexity), true
+	case "MetricDeltas.prCreated":
+		if e.ComplexityRoot.MetricDeltas.PrCreated == nil {
 			break
 		}
 
-		return e.ComplexityRoot.TeamSummary.MemberCount(childComplexity), true
-	case "TeamSummary.repositoryCount":
-		if e.ComplexityRoot.TeamSummary.RepositoryCount == nil {
+		return e.ComplexityRoot.MetricDeltas.PrCreated(childComplexity), true
+	case "MetricDeltas.prMerged":
+		if e.ComplexityRoot.MetricDeltas.PrMerged == nil {
 			break
 		}
 
-		return e.ComplexityRoot.TeamSummary.RepositoryCount(childComplexity), true
-	case "TeamSummary.totalAdditions":
-		if e.ComplexityRoot.TeamSummary.TotalAdditions == nil {
+		return e.ComplexityRoot.MetricDeltas.PrMerged(childComplexity), true
+	case "MetricDeltas.reviews":
+		if e.ComplexityRoot.MetricDeltas.Reviews == nil {
 			break
 		}
 
-		return e.ComplexityRoot.TeamSummary.TotalAdditions(childComplexity), true
-	case "TeamSummary.totalCommits":
-		if e.ComplexityRoot.TeamSummary.TotalCommits == nil {
+		return e.ComplexityRoot.MetricDeltas.Reviews(childComplexity), true
+
+	case "PRSizeCount.count":
+		if e.ComplexityRoot.PRSizeCount.Count == nil {
 			break
 		}
 
-		return e.ComplexityRoot.TeamSummary.TotalCommits(childComplexity), true
-	case "TeamSummary.totalDeletions":
-		if e.ComplexityRoot.TeamSummary.TotalDeletions == nil {
+		return e.ComplexityRoot.PRSizeCount.Count(childComplexity), true
+	case "PRSizeCount.size":
+		if e.ComplexityRoot.PRSizeCount.Size == nil {
 			break
 		}
 
-		return e.ComplexityRoot.TeamSummary.TotalDeletions(childComplexity), true
-	case "TeamSummary.totalIssues":
-		if e.ComplexityRoot.TeamSummary.TotalIssues == nil {
+		return e.ComplexityRoot.PRSizeCount.Size(childComplexity), true
+
+	case "PRSizeStats.changedFiles":
+		if e.ComplexityRoot.PRSizeStats.ChangedFiles == nil {
 			break
 		}
 
-		return e.ComplexityRoot.TeamSummary.TotalIssues(childComplexity), true
-	case "TeamSummary.totalPRCreated":
-		if e.ComplexityRoot.TeamSummary.TotalPRCreated == nil {
+		return e.ComplexityRoot.PRSizeStats.ChangedFiles(childComplexity), true
+	case "PRSizeStats.changedLines":
+		if e.ComplexityRoot.PRSizeStats.ChangedLines == nil {
 			break
 		}
 
-		return e.ComplexityRoot.TeamSummary.TotalPRCreated(childComplexity), true
-	case "TeamSummary.totalPRMerged":
-		if e.ComplexityRoot.TeamSummary.TotalPRMerged == nil {
+		return e.ComplexityRoot.PRSizeStats.ChangedLines(childComplexity), true
+	case "PRSizeStats.histogram":
+		if e.ComplexityRoot.PRSizeStats.Histogram == nil {
 			break
 		}
 
-		return e.ComplexityRoot.TeamSummary.TotalPRMerged(childComplexity), true
-	case "TeamSummary.totalReviews":
-		if e.ComplexityRoot.TeamSummary.TotalReviews == nil {
+		return e.ComplexityRoot.PRSizeStats.Histogram(childComplexity), true
+	case "PRSizeStats.prCount":
+		if e.ComplexityRoot.PRSizeStats.PrCount == nil {
 			break
 		}
 
-		return e.ComplexityRoot.TeamSummary.TotalReviews(childComplexity), true
+		return e.ComplexityRoot.PRSizeStats.PrCount(childComplexity), true
 
-	case "UserStatistics.dailyStats":
-		if e.ComplexityRoot.UserStatistics.DailyStats == nil {
+	case "PendingReview.author":
+		if e.ComplexityRoot.PendingReview.Author == nil {
 			break
 		}
 
-		return e.ComplexityRoot.UserStatistics.DailyStats(childComplexity), true
-	case "UserStatistics.firstActivityYear":
-		if e.ComplexityRoot.UserStatistics.FirstActivityYear == nil {
+		return e.ComplexityRoot.PendingReview.Author(childComplexity), true
+	case "PendingReview.repository":
+		if e.ComplexityRoot.PendingReview.Repository == nil {
 			break
 		}
 
-		return e.ComplexityRoot.UserStatistics.FirstActivityYear(childComplexity), true
-	case "UserStatistics.login":
-		if e.ComplexityRoot.UserStatistics.Login == nil {
+		return e.ComplexityRoot.PendingReview.Repository(childComplexity), true
+	case "PendingReview.requestedAt":
+		if e.ComplexityRoot.PendingReview.RequestedAt == nil {
 			break
 		}
 
-		return e.ComplexityRoot.UserStatistics.Login(childComplexity), true
-	case "UserStatistics.longTermRepositories":
-		if e.ComplexityRoot.UserStatistics.LongTermRepositories == nil {
+		return e.ComplexityRoot.PendingReview.RequestedAt(childComplexity), true
+
+	case "Percentiles.count":
+		if e.ComplexityRoot.Percentiles.Count == nil {
 			break
 		}
 
-		return e.ComplexityRoot.UserStatistics.LongTermRepositories(childComplexity), true
-	case "UserStatistics.name":
-		if e.ComplexityRoot.UserStatistics.Name == nil {
+		return e.ComplexityRoot.Percentiles.Count(childComplexity), true
+	case "Percentiles.median":
+		if e.ComplexityRoot.Percentiles.Median == nil {
 			break
 		}
 
-		return e.ComplexityRoot.UserStatistics.Name(childComplexity), true
-	case "UserStatistics.peakActivityCommits":
-		if e.ComplexityRoot.UserStatistics.PeakActivityCommits == nil {
+		return e.ComplexityRoot.Percentiles.Median(childComplexity), true
+	case "Percentiles.p90":
+		if e.ComplexityRoot.Percentiles.P90 == nil {
 			break
 		}
 
-		return e.ComplexityRoot.UserStatistics.PeakActivityCommits(childComplexity), true
-	case "UserStatistics.peakActivityYear":
-		if e.ComplexityRoot.UserStatistics.PeakActivityYear == nil {
+		return e.ComplexityRoot.Percentiles.P90(childComplexity), true
+
+	case "Query.activityHeatmap":
+		if e.ComplexityRoot.Query.ActivityHeatmap == nil {
 			break
 		}
 
-		return e.ComplexityRoot.UserStatistics.PeakActivityYear(childComplexity), true
-	case "UserStatistics.prToReviewRatio":
-		if e.ComplexityRoot.UserStatistics.PrToReviewRatio == nil {
-			break
+		args, err := ec.field_Query_activityHeatmap_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.ComplexityRoot.UserStatistics.PrToReviewRatio(childComplexity), true
-	case "UserStatistics.roleTransition":
-		if e.ComplexityRoot.UserStatistics.RoleTransition == nil {
+		return e.ComplexityRoot.Query.ActivityHeatmap(childComplexity, args["login"].(string), args["from"].(*string), args["to"].(*string)), true
+	case "Query.deliveryMetrics":
+		if e.ComplexityRoot.Query.DeliveryMetrics == nil {
 			break
 		}
 
-		return e.ComplexityRoot.UserStatistics.RoleTransition(childComplexity), true
-	case "UserStatistics.topRepositories":
-		if e.ComplexityRoot.UserStatistics.TopRepositories == nil {
-			break
+		args, err := ec.field_Query_deliveryMetrics_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.ComplexityRoot.UserStatistics.TopRepositories(childComplexity), true
-	case "UserStatistics.totalAdditions":
-		if e.ComplexityRoot.UserStatistics.TotalAdditions == nil {
+		return e.ComplexityRoot.Query.DeliveryMetrics(childComplexity, args["repository"].(string), args["from"].(*string), args["to"].(*string)), true
+	case "Query.member":
+		if e.ComplexityRoot.Query.Member == nil {
 			break
 		}
 
-		return e.ComplexityRoot.UserStatistics.TotalAdditions(childComplexity), true
-	case "UserStatistics.totalCommits":
-		if e.ComplexityRoot.UserStatistics.TotalCommits == nil {
-			break
+		args, err := ec.field_Query_member_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.ComplexityRoot.UserStatistics.TotalCommits(childComplexity), true
-	case "UserStatistics.totalDeletions":
-		if e.ComplexityRoot.UserStatistics.TotalDeletions == nil {
+		return e.ComplexityRoot.Query.Member(childComplexity, args["login"].(string), args["from"].(*string), args["to"].(*string), args["granularity"].(*model.Granularity), args["snapshotId"].(*string)), true
+	case "Query.memberHistory":
+		if e.ComplexityRoot.Query.MemberHistory == nil {
 			break
 		}
 
-		return e.ComplexityRoot.UserStatistics.TotalDeletions(childComplexity), true
-	case "UserStatistics.totalIssues":
-		if e.ComplexityRoot.UserStatistics.TotalIssues == nil {
-			break
+		args, err := ec.field_Query_memberHistory_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.ComplexityRoot.UserStatistics.TotalIssues(childComplexity), true
-	case "UserStatistics.totalPRCreated":
-		if e.ComplexityRoot.UserStatistics.TotalPRCreated == nil {
+		return e.ComplexityRoot.Query.MemberHistory(childComplexity, args["login"].(string)), true
+	case "Query.members":
+		if e.ComplexityRoot.Query.Members == nil {
 			break
 		}
 
-		return e.ComplexityRoot.UserStatistics.TotalPRCreated(childComplexity), true
-	case "UserStatistics.totalPRMerged":
-		if e.ComplexityRoot.UserStatistics.TotalPRMerged == nil {
+		return e.ComplexityRoot.Query.Members(childComplexity), true
+	case "Query.repositories":
+		if e.ComplexityRoot.Query.Repositories == nil {
 			break
 		}
 
-		return e.ComplexityRoot.UserStatistics.TotalPRMerged(childComplexity), true
-	case "UserStatistics.totalReviews":
-		if e.ComplexityRoot.UserStatistics.TotalReviews == nil {
+		return e.ComplexityRoot.Query.Repositories(childComplexity), true
+	case "Query.repository":
+		if e.ComplexityRoot.Query.Repository == nil {
 			break
 		}
 
-		return e.ComplexityRoot.UserStatistics.TotalReviews(childComplexity), true
-	case "UserStatistics.yearlyStats":
-		if e.ComplexityRoot.UserStatistics.YearlyStats == nil {
+		args, err := ec.field_Query_repository_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Query.Repository(childComplexity, args["nameWithOwner"].(string), args["from"].(*string), args["to"].(*string), args["granularity"].(*model.Granularity), args["snapshotId"].(*string)), true
+	case "Query.repositoryDailyStats":
+		if e.ComplexityRoot.Query.RepositoryDailyStats == nil {
 			break
 		}
 
-		return e.ComplexityRoot.UserStatistics.YearlyStats(childComplexity), true
+		args, err := ec.field_Query_repositoryDailyStats_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "YearlyStatistics.commitCount":
-		if e.ComplexityRoot.YearlyStatistics.CommitCount == nil {
+		return e.ComplexityRoot.Query.RepositoryDailyStats(childComplexity, args["from"].(*string), args["to"].(*string), args["granularity"].(*model.Granularity), args["snapshotId"].(*string)), true
+	case "Query.reviewLoad":
+		if e.ComplexityRoot.Query.ReviewLoad == nil {
 			break
 		}
 
-		return e.ComplexityRoot.YearlyStatistics.CommitCount(childComplexity), true
-	case "YearlyStatistics.issueCount":
-		if e.ComplexityRoot.YearlyStatistics.IssueCount == nil {
+		return e.ComplexityRoot.Query.ReviewLoad(childComplexity), true
+	case "Query.reviewNetwork":
+		if e.ComplexityRoot.Query.ReviewNetwork == nil {
 			break
 		}
 
-		return e.ComplexityRoot.YearlyStatistics.IssueCount(childComplexity), true
-	case "YearlyStatistics.prCreated":
-		if e.ComplexityRoot.YearlyStatistics.PrCreated == nil {
-			break
+		args, err := ec.field_Query_reviewNetwork_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.ComplexityRoot.YearlyStatistics.PrCreated(childComplexity), true
-	case "YearlyStatistics.prMerged":
-		if e.ComplexityRoot.YearlyStatistics.PrMerged == nil {
+		return e.ComplexityRoot.Query.ReviewNetwork(childComplexity, args["from"].(*string), args["to"].(*string)), true
+	case "Query.snapshot":
+		if e.ComplexityRoot.Query.Snapshot == nil {
 			break
 		}
 
-		return e.ComplexityRoot.YearlyStatistics.PrMerged(childComplexity), true
-	case "YearlyStatistics.reviewCount":
-		if e.ComplexityRoot.YearlyStatistics.ReviewCount == nil {
-			break
+		args, err := ec.field_Query_snapshot_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.ComplexityRoot.YearlyStatistics.ReviewCount(childComplexity), true
-	case "YearlyStatistics.totalAdditions":
-		if e.ComplexityRoot.YearlyStatistics.TotalAdditions == nil {
+		return e.ComplexityRoot.Query.Snapshot(childComplexity, args["id"].(string)), true
+	case "Query.snapshotDiff":
+		if e.ComplexityRoot.Query.SnapshotDiff == nil {
 			break
 		}
 
-		return e.ComplexityRoot.YearlyStatistics.TotalAdditions(childComplexity), true
-	case "YearlyStatistics.totalDeletions":
-		if e.ComplexityRoot.YearlyStatistics.TotalDeletions == nil {
+		args, err := ec.field_Query_snapshotDiff_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Query.SnapshotDiff(childComplexity, args["base"].(string), args["head"].(string)), true
+	case "Query.snapshots":
+		if e.ComplexityRoot.Query.Snapshots == nil {
 			break
 		}
 
-		return e.ComplexityRoot.YearlyStatistics.TotalDeletions(childComplexity), true
-	case "YearlyStatistics.year":
-		if e.ComplexityRoot.YearlyStatistics.Year == nil {
+		return e.ComplexityRoot.Query.Snapshots(childComplexity), true
+	case "Query.teamDailyStats":
+		if e.ComplexityRoot.Query.TeamDailyStats == nil {
 			break
 		}
 
-		return e.ComplexityRoot.YearlyStatistics.Year(childComplexity), true
+		args, err := ec.field_Query_teamDailyStats_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	}
-	return 0, false
-}
+		return e.ComplexityRoot.Query.TeamDailyStats(childComplexity, args["from"].(*string), args["to"].(*string), args["granularity"].(*model.Granularity), args["snapshotId"].(*string)), true
+	case "Query.teamSummary":
+		if e.ComplexityRoot.Query.TeamSummary == nil {
+			break
+		}
 
-func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
-	opCtx := graphql.GetOperationContext(ctx)
-	ec := newExecutionContext(opCtx, e, make(chan graphql.DeferredResult))
-	inputUnmarshalMap := graphql.BuildUnmarshalerMap()
-	first := true
+		return e.ComplexityRoot.Query.TeamSummary(childComplexity), true
 
-	switch opCtx.Operation.Operation {
-	case ast.Query:
-		return func(ctx context.Context) *graphql.Response {
-			var response graphql.Response
-			var data graphql.Marshaler
-			if first {
-				first = false
-				ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
-				data = ec._Query(ctx, opCtx.Operation.SelectionSet)
-			} else {
-				if atomic.LoadInt32(&ec.PendingDeferred) > 0 {
-					result := <-ec.DeferredResults
-					atomic.AddInt32(&ec.PendingDeferred, -1)
-					data = result.Result
-					response.Path = result.Path
-					response.Label = result.Label
-					response.Errors = result.Errors
-				} else {
-					return nil
-				}
-			}
-			var buf bytes.Buffer
-			data.MarshalGQL(&buf)
-			response.Data = buf.Bytes()
-			if atomic.LoadInt32(&ec.Deferred) > 0 {
-				hasNext := atomic.LoadInt32(&ec.PendingDeferred) > 0
-				response.HasNext = &hasNext
-			}
+	case "RepositoryActivity.commitCount":
+		if e.ComplexityRoot.RepositoryActivity.CommitCount == nil {
+			break
+		}
 
-			return &response
+		return e.ComplexityRoot.RepositoryActivity.CommitCount(childComplexity), true
+	case "RepositoryActivity.firstActivity":
+		if e.ComplexityRoot.RepositoryActivity.FirstActivity == nil {
+			break
 		}
 
-	default:
-		return graphql.OneShot(graphql.ErrorResponse(ctx, "unsupported GraphQL operation"))
-	}
-}
+		return e.ComplexityRoot.RepositoryActivity.FirstActivity(childComplexity), true
+	case "RepositoryActivity.issueCount":
+		if e.ComplexityRoot.RepositoryActivity.IssueCount == nil {
+			break
+		}
 
-type executionContext struct {
-	*graphql.ExecutionContextState[ResolverRoot, DirectiveRoot, ComplexityRoot]
-}
+		return e.ComplexityRoot.RepositoryActivity.IssueCount(childComplexity), true
+	case "RepositoryActivity.lastActivity":
+		if e.ComplexityRoot.RepositoryActivity.LastActivity == nil {
+			break
+		}
 
-func newExecutionContext(
-	opCtx *graphql.OperationContext,
-	execSchema *executableSchema,
-	deferredResults chan graphql.DeferredResult,
-) *executionContext {
-	return &executionContext{
-		ExecutionContextState: graphql.NewExecutionContextState[ResolverRoot, DirectiveRoot, ComplexityRoot](
-			opCtx,
-			(*graphql.ExecutableSchemaState[ResolverRoot, DirectiveRoot, ComplexityRoot])(execSchema),
-			parsedSchema,
-			deferredResults,
-		),
-	}
-}
+		return e.ComplexityRoot.RepositoryActivity.LastActivity(childComplexity), true
+	case "RepositoryActivity.prCount":
+		if e.ComplexityRoot.RepositoryActivity.PrCount == nil {
+			break
+		}
 
-//go:embed "schema.graphqls"
-var sourcesFS embed.FS
+		return e.ComplexityRoot.RepositoryActivity.PrCount(childComplexity), true
+	case "RepositoryActivity.repository":
+		if e.ComplexityRoot.RepositoryActivity.Repository == nil {
+			break
+		}
 
-func sourceData(filename string) string {
-	data, err := sourcesFS.ReadFile(filename)
-	if err != nil {
-		panic(fmt.Sprintf("codegen problem: %s not available", filename))
-	}
-	return string(data)
-}
+		return e.ComplexityRoot.RepositoryActivity.Repository(childComplexity), true
+	case "RepositoryActivity.reviewCount":
+		if e.ComplexityRoot.RepositoryActivity.ReviewCount == nil {
+			break
+		}
 
-var sources = []*ast.Source{
-	{Name: "schema.graphqls", Input: sourceData("schema.graphqls"), BuiltIn: false},
-}
-var parsedSchema = gqlparser.MustLoadSchema(sources...)
+		return e.ComplexityRoot.RepositoryActivity.ReviewCount(childComplexity), true
+	case "RepositoryActivity.totalAdditions":
+		if e.ComplexityRoot.RepositoryActivity.TotalAdditions == nil {
+			break
+		}
 
-// childFields_* functions provide shared child field context lookups.
-// Each function is generated once per unique object type, deduplicating the
-// switch statements that were previously inlined in every fieldContext_* function.
+		return e.ComplexityRoot.RepositoryActivity.TotalAdditions(childComplexity), true
+	case "RepositoryActivity.totalDeletions":
+		if e.ComplexityRoot.RepositoryActivity.TotalDeletions == nil {
+			break
+		}
 
-func (ec *executionContext) childFields_DailyStatistics(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-	switch field.Name {
-	case "date":
-		return ec.fieldContext_DailyStatistics_date(ctx, field)
-	case "commitCount":
-		return ec.fieldContext_DailyStatistics_commitCount(ctx, field)
-	case "prCreated":
-		return ec.fieldContext_DailyStatistics_prCreated(ctx, field)
-	case "prMerged":
-		return ec.fieldContext_DailyStatistics_prMerged(ctx, field)
-	case "issueCount":
-		return ec.fieldContext_DailyStatistics_issueCount(ctx, field)
-	case "reviewCount":
-		return ec.fieldContext_DailyStatistics_reviewCount(ctx, field)
-	case "totalAdditions":
-		return ec.fieldContext_DailyStatistics_totalAdditions(ctx, field)
-	case "totalDeletions":
-		return ec.fieldContext_DailyStatistics_totalDeletions(ctx, field)
-	}
-	return nil, fmt.Errorf("no field named %q was found under type DailyStatistics", field.Name)
-}
+		return e.ComplexityRoot.RepositoryActivity.TotalDeletions(childComplexity), true
 
-func (ec *executionContext) childFields_MemberStats(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-	switch field.Name {
-	case "login":
-		return ec.fieldContext_MemberStats_login(ctx, field)
-	case "name":
-		return ec.fieldContext_MemberStats_name(ctx, field)
-	case "totalCommits":
-		return ec.fieldContext_MemberStats_totalCommits(ctx, field)
-	case "totalPRCreated":
-		return ec.fieldContext_MemberStats_totalPRCreated(ctx, field)
-	case "totalPRMerged":
-		return ec.fieldContext_MemberStats_totalPRMerged(ctx, field)
-	case "totalIssues":
-		return ec.fieldContext_MemberStats_totalIssues(ctx, field)
-	case "totalReviews":
-		return ec.fieldContext_MemberStats_totalReviews(ctx, field)
-	case "totalAdditions":
-		return ec.fieldContext_MemberStats_totalAdditions(ctx, field)
-	case "totalDeletions":
-		return ec.fieldContext_MemberStats_totalDeletions(ctx, field)
-	case "prToReviewRatio":
-		return ec.fieldContext_MemberStats_prToReviewRatio(ctx, field)
-	}
-	return nil, fmt.Errorf("no field named %q was found under type MemberStats", field.Name)
-}
+	case "RepositoryContributor.additions":
+		if e.ComplexityRoot.RepositoryContributor.Additions == nil {
+			break
+		}
 
-func (ec *executionContext) childFields_RepositoryActivity(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-	switch field.Name {
-	case "repository":
-		return ec.fieldContext_RepositoryActivity_repository(ctx, field)
-	case "commitCount":
-		return ec.fieldContext_RepositoryActivity_commitCount(ctx, field)
-	case "prCount":
-		return ec.fieldContext_RepositoryActivity_prCount(ctx, field)
-	case "issueCount":
-		return ec.fieldContext_RepositoryActivity_issueCount(ctx, field)
-	case "reviewCount":
-		return ec.fieldContext_RepositoryActivity_reviewCount(ctx, field)
-	case "totalAdditions":
-		return ec.fieldContext_RepositoryActivity_totalAdditions(ctx, field)
-	case "totalDeletions":
-		return ec.fieldContext_RepositoryActivity_totalDeletions(ctx, field)
-	case "firstActivity":
-		return ec.fieldContext_RepositoryActivity_firstActivity(ctx, field)
-	case "lastActivity":
-		return ec.fieldContext_RepositoryActivity_lastActivity(ctx, field)
-	}
-	return nil, fmt.Errorf("no field named %q was found under type RepositoryActivity", field.Name)
-}
+		return e.ComplexityRoot.RepositoryContributor.Additions(childComplexity), true
+	case "RepositoryContributor.commitCount":
+		if e.ComplexityRoot.RepositoryContributor.CommitCount == nil {
+			break
+		}
 
-func (ec *executionContext) childFields_RepositoryContributor(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-	switch field.Name {
-	case "login":
-		return ec.fieldContext_RepositoryContributor_login(ctx, field)
-	case "commitCount":
-		return ec.fieldContext_RepositoryContributor_commitCount(ctx, field)
-	case "prCreated":
-		return ec.fieldContext_RepositoryContributor_prCreated(ctx, field)
-	case "reviewCount":
-		return ec.fieldContext_RepositoryContributor_reviewCount(ctx, field)
-	case "additions":
-		return ec.fieldContext_RepositoryContributor_additions(ctx, field)
-	case "deletions":
-		return ec.fieldContext_RepositoryContributor_deletions(ctx, field)
-	case "dailyStats":
-		return ec.fieldContext_RepositoryContributor_dailyStats(ctx, field)
-	}
-	return nil, fmt.Errorf("no field named %q was found under type RepositoryContributor", field.Name)
-}
+		return e.ComplexityRoot.RepositoryContributor.CommitCount(childComplexity), true
+	case "RepositoryContributor.dailyStats":
+		if e.ComplexityRoot.RepositoryContributor.DailyStats == nil {
+			break
+		}
 
-func (ec *executionContext) childFields_RepositoryDailyStats(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-	switch field.Name {
-	case "nameWithOwner":
-		return ec.fieldContext_RepositoryDailyStats_nameWithOwner(ctx, field)
-	case "owner":
-		return ec.fieldContext_RepositoryDailyStats_owner(ctx, field)
-	case "ownerType":
-		return ec.fieldContext_RepositoryDailyStats_ownerType(ctx, field)
-	case "dailyStats":
-		return ec.fieldContext_RepositoryDailyStats_dailyStats(ctx, field)
-	}
-	return nil, fmt.Errorf("no field named %q was found under type RepositoryDailyStats", field.Name)
-}
+		return e.ComplexityRoot.RepositoryContributor.DailyStats(childComplexity), true
+	case "RepositoryContributor.deletions":
+		if e.ComplexityRoot.RepositoryContributor.Deletions == nil {
+			break
+		}
 
-func (ec *executionContext) childFields_RepositoryStats(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-	switch field.Name {
-	case "nameWithOwner":
-		return ec.fieldContext_RepositoryStats_nameWithOwner(ctx, field)
-	case "total":
-		return ec.fieldContext_RepositoryStats_total(ctx, field)
-	case "contributorCount":
-		return ec.fieldContext_RepositoryStats_contributorCount(ctx, field)
-	case "contributors":
-		return ec.fieldContext_RepositoryStats_contributors(ctx, field)
-	}
-	return nil, fmt.Errorf("no field named %q was found under type RepositoryStats", field.Name)
-}
+		return e.ComplexityRoot.RepositoryContributor.Deletions(childComplexity), true
+	case "RepositoryContributor.login":
+		if e.ComplexityRoot.RepositoryContributor.Login == nil {
+			break
+		}
 
-func (ec *executionContext) childFields_RepositoryTotals(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-	switch field.Name {
-	case "commits":
-		return ec.fieldContext_RepositoryTotals_commits(ctx, field)
-	case "prCreated":
-		return ec.fieldContext_RepositoryTotals_prCreated(ctx, field)
-	case "prMerged":
-		return ec.fieldContext_RepositoryTotals_prMerged(ctx, field)
-	case "issues":
-		return ec.fieldContext_RepositoryTotals_issues(ctx, field)
-	case "reviews":
-		return ec.fieldContext_RepositoryTotals_reviews(ctx, field)
-	case "additions":
-		return ec.fieldContext_RepositoryTotals_additions(ctx, field)
-	case "deletions":
-		return ec.fieldContext_RepositoryTotals_deletions(ctx, field)
-	}
-	return nil, fmt.Errorf("no field named %q was found under type RepositoryTotals", field.Name)
-}
-
-func (ec *executionContext) childFields_RoleTransitionPoint(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-	switch field.Name {
-	case "year":
-		return ec.fieldContext_RoleTransitionPoint_year(ctx, field)
-	case "prCreated":
-		return ec.fieldContext_RoleTransitionPoint_prCreated(ctx, field)
-	case "reviewCount":
-		return ec.fieldContext_RoleTransitionPoint_reviewCount(ctx, field)
-	case "ratio":
-		return ec.fieldContext_RoleTransitionPoint_ratio(ctx, field)
-	case "description":
-		return ec.fieldContext_RoleTransitionPoint_description(ctx, field)
-	}
-	return nil, fmt.Errorf("no field named %q was found under type RoleTransitionPoint", field.Name)
-}
+		return e.ComplexityRoot.RepositoryContributor.Login(childComplexity), true
+	case "RepositoryContributor.prCreated":
+		if e.ComplexityRoot.RepositoryContributor.PrCreated == nil {
+			break
+		}
 
-func (ec *executionContext) childFields_TeamSummary(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-	switch field.Name {
-	case "memberCount":
-		return ec.fieldContext_TeamSummary_memberCount(ctx, field)
-	case "repositoryCount":
-		return ec.fieldContext_TeamSummary_repositoryCount(ctx, field)
-	case "totalCommits":
-		return ec.fieldContext_TeamSummary_totalCommits(ctx, field)
-	case "totalPRCreated":
-		return ec.fieldContext_TeamSummary_totalPRCreated(ctx, field)
-	case "totalPRMerged":
-		return ec.fieldContext_TeamSummary_totalPRMerged(ctx, field)
-	case "totalIssues":
-		return ec.fieldContext_TeamSummary_totalIssues(ctx, field)
-	case "totalReviews":
-		return ec.fieldContext_TeamSummary_totalReviews(ctx, field)
-	case "totalAdditions":
-		return ec.fieldContext_TeamSummary_totalAdditions(ctx, field)
-	case "totalDeletions":
-		return ec.fieldContext_TeamSummary_totalDeletions(ctx, field)
-	}
-	return nil, fmt.Errorf("no field named %q was found under type TeamSummary", field.Name)
-}
+		return e.ComplexityRoot.RepositoryContributor.PrCreated(childComplexity), true
+	case "RepositoryContributor.reviewCount":
+		if e.ComplexityRoot.RepositoryContributor.ReviewCount == nil {
+			break
+		}
 
-func (ec *executionContext) childFields_UserStatistics(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-	switch field.Name {
-	case "login":
-		return ec.fieldContext_UserStatistics_login(ctx, field)
-	case "name":
-		return ec.fieldContext_UserStatistics_name(ctx, field)
-	case "totalCommits":
-		return ec.fieldContext_UserStatistics_totalCommits(ctx, field)
-	case "totalPRCreated":
-		return ec.fieldContext_UserStatistics_totalPRCreated(ctx, field)
-	case "totalPRMerged":
-		return ec.fieldContext_UserStatistics_totalPRMerged(ctx, field)
-	case "totalIssues":
-		return ec.fieldContext_UserStatistics_totalIssues(ctx, field)
-	case "totalReviews":
-		return ec.fieldContext_UserStatistics_totalReviews(ctx, field)
-	case "totalAdditions":
-		return ec.fieldContext_UserStatistics_totalAdditions(ctx, field)
-	case "totalDeletions":
-		return ec.fieldContext_UserStatistics_totalDeletions(ctx, field)
-	case "prToReviewRatio":
-		return ec.fieldContext_UserStatistics_prToReviewRatio(ctx, field)
-	case "firstActivityYear":
-		return ec.fieldContext_UserStatistics_firstActivityYear(ctx, field)
-	case "peakActivityYear":
-		return ec.fieldContext_UserStatistics_peakActivityYear(ctx, field)
-	case "peakActivityCommits":
-		return ec.fieldContext_UserStatistics_peakActivityCommits(ctx, field)
-	case "yearlyStats":
-		return ec.fieldContext_UserStatistics_yearlyStats(ctx, field)
-	case "dailyStats":
-		return ec.fieldContext_UserStatistics_dailyStats(ctx, field)
-	case "topRepositories":
-		return ec.fieldContext_UserStatistics_topRepositories(ctx, field)
-	case "longTermRepositories":
-		return ec.fieldContext_UserStatistics_longTermRepositories(ctx, field)
-	case "roleTransition":
-		return ec.fieldContext_UserStatistics_roleTransition(ctx, field)
-	}
-	return nil, fmt.Errorf("no field named %q was found under type UserStatistics", field.Name)
-}
+		return e.ComplexityRoot.RepositoryContributor.ReviewCount(childComplexity), true
 
-func (ec *executionContext) childFields_YearlyStatistics(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-	switch field.Name {
-	case "year":
-		return ec.fieldContext_YearlyStatistics_year(ctx, field)
-	case "commitCount":
-		return ec.fieldContext_YearlyStatistics_commitCount(ctx, field)
-	case "prCreated":
-		return ec.fieldContext_YearlyStatistics_prCreated(ctx, field)
-	case "prMerged":
-		return ec.fieldContext_YearlyStatistics_prMerged(ctx, field)
-	case "issueCount":
-		return ec.fieldContext_YearlyStatistics_issueCount(ctx, field)
-	case "reviewCount":
-		return ec.fieldContext_YearlyStatistics_reviewCount(ctx, field)
-	case "totalAdditions":
-		return ec.fieldContext_YearlyStatistics_totalAdditions(ctx, field)
-	case "totalDeletions":
-		return ec.fieldContext_YearlyStatistics_totalDeletions(ctx, field)
-	}
-	return nil, fmt.Errorf("no field named %q was found under type YearlyStatistics", field.Name)
-}
+	case "RepositoryDailyStats.dailyStats":
+		if e.ComplexityRoot.RepositoryDailyStats.DailyStats == nil {
+			break
+		}
 
-func (ec *executionContext) childFields___Directive(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-	switch field.Name {
-	case "name":
-		return ec.fieldContext___Directive_name(ctx, field)
-	case "description":
-		return ec.fieldContext___Directive_description(ctx, field)
-	case "isRepeatable":
-		return ec.fieldContext___Directive_isRepeatable(ctx, field)
-	case "locations":
-		return ec.fieldContext___Directive_locations(ctx, field)
-	case "args":
-		return ec.fieldContext___Directive_args(ctx, field)
-	}
-	return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
-}
+		return e.ComplexityRoot.RepositoryDailyStats.DailyStats(childComplexity), true
+	case "RepositoryDailyStats.nameWithOwner":
+		if e.ComplexityRoot.RepositoryDailyStats.NameWithOwner == nil {
+			break
+		}
 
-func (ec *executionContext) childFields___EnumValue(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-	switch field.Name {
-	case "name":
-		return ec.fieldContext___EnumValue_name(ctx, field)
-	case "description":
-		return ec.fieldContext___EnumValue_description(ctx, field)
-	case "isDeprecated":
-		return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
-	case "deprecationReason":
-		return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
-	}
-	return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
-}
+		return e.ComplexityRoot.RepositoryDailyStats.NameWithOwner(childComplexity), true
+	case "RepositoryDailyStats.owner":
+		if e.ComplexityRoot.RepositoryDailyStats.Owner == nil {
+			break
+		}
 
-func (ec *executionContext) childFields___Field(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-	switch field.Name {
-	case "name":
-		return ec.fieldContext___Field_name(ctx, field)
-	case "description":
-		return ec.fieldContext___Field_description(ctx, field)
-	case "args":
-		return ec.fieldContext___Field_args(ctx, field)
-	case "type":
-		return ec.fieldContext___Field_type(ctx, field)
-	case "isDeprecated":
-		return ec.fieldContext___Field_isDeprecated(ctx, field)
-	case "deprecationReason":
-		return ec.fieldContext___Field_deprecationReason(ctx, field)
-	}
-	return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
-}
+		return e.ComplexityRoot.RepositoryDailyStats.Owner(childComplexity), true
+	case "RepositoryDailyStats.ownerType":
+		if e.ComplexityRoot.RepositoryDailyStats.OwnerType == nil {
+			break
+		}
 
-func (ec *executionContext) childFields___InputValue(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-	switch field.Name {
-	case "name":
-		return ec.fieldContext___InputValue_name(ctx, field)
-	case "description":
-		return ec.fieldContext___InputValue_description(ctx, field)
-	case "type":
-		return ec.fieldContext___InputValue_type(ctx, field)
-	case "defaultValue":
-		return ec.fieldContext___InputValue_defaultValue(ctx, field)
-	case "isDeprecated":
-		return ec.fieldContext___InputValue_isDeprecated(ctx, field)
-	case "deprecationReason":
-		return ec.fieldContext___InputValue_deprecationReason(ctx, field)
-	}
-	return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
-}
+		return e.ComplexityRoot.RepositoryDailyStats.OwnerType(childComplexity), true
 
-func (ec *executionContext) childFields___Schema(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-	switch field.Name {
-	case "description":
-		return ec.fieldContext___Schema_description(ctx, field)
-	case "types":
-		return ec.fieldContext___Schema_types(ctx, field)
-	case "queryType":
-		return ec.fieldContext___Schema_queryType(ctx, field)
-	case "mutationType":
-		return ec.fieldContext___Schema_mutationType(ctx, field)
-	case "subscriptionType":
-		return ec.fieldContext___Schema_subscriptionType(ctx, field)
-	case "directives":
-		return ec.fieldContext___Schema_directives(ctx, field)
-	}
-	return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
-}
+	case "RepositoryDelta.delta":
+		if e.ComplexityRoot.RepositoryDelta.Delta == nil {
+			break
+		}
 
-func (ec *executionContext) childFields___Type(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-	switch field.Name {
-	case "kind":
-		return ec.fieldContext___Type_kind(ctx, field)
-	case "name":
-		return ec.fieldContext___Type_name(ctx, field)
-	case "description":
-		return ec.fieldContext___Type_description(ctx, field)
-	case "specifiedByURL":
-		return ec.fieldContext___Type_specifiedByURL(ctx, field)
-	case "fields":
-		return ec.fieldContext___Type_fields(ctx, field)
-	case "interfaces":
-		return ec.fieldContext___Type_interfaces(ctx, field)
-	case "possibleTypes":
-		return ec.fieldContext___Type_possibleTypes(ctx, field)
-	case "enumValues":
-		return ec.fieldContext___Type_enumValues(ctx, field)
-	case "inputFields":
-		return ec.fieldContext___Type_inputFields(ctx, field)
-	case "ofType":
-		return ec.fieldContext___Type_ofType(ctx, field)
-	case "isOneOf":
-		return ec.fieldContext___Type_isOneOf(ctx, field)
-	}
-	return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-}
+		return e.ComplexityRoot.RepositoryDelta.Delta(childComplexity), true
+	case "RepositoryDelta.nameWithOwner":
+		if e.ComplexityRoot.RepositoryDelta.NameWithOwner == nil {
+			break
+		}
 
-// endregion ************************** internal!.gotpl ***************************
+		return e.ComplexityRoot.RepositoryDelta.NameWithOwner(childComplexity), true
 
-// region    ***************************** args.gotpl *****************************
-
-func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "name",
-		func(ctx context.Context, v any) (string, error) {
-			return ec.unmarshalNString2string(ctx, v)
-		})
-	if err != nil {
-		return nil, err
-	}
-	args["name"] = arg0
-	return args, nil
-}
+	case "RepositoryStats.categoryBreakdown":
+		if e.ComplexityRoot.RepositoryStats.CategoryBreakdown == nil {
+			break
+		}
 
-func (ec *executionContext) field_Query_member_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "login",
-		func(ctx context.Context, v any) (string, error) {
-			return ec.unmarshalNString2string(ctx, v)
-		})
-	if err != nil {
-		return nil, err
-	}
-	args["login"] = arg0
-	return args, nil
-}
+		return e.ComplexityRoot.RepositoryStats.CategoryBreakdown(childComplexity), true
+	case "RepositoryStats.contributorCount":
+		if e.ComplexityRoot.RepositoryStats.ContributorCount == nil {
+			break
+		}
 
-func (ec *executionContext) field_Query_repository_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "nameWithOwner",
-		func(ctx context.Context, v any) (string, error) {
-			return ec.unmarshalNString2string(ctx, v)
-		})
-	if err != nil {
-		return nil, err
-	}
-	args["nameWithOwner"] = arg0
-	return args, nil
-}
+		return e.ComplexityRoot.RepositoryStats.ContributorCount(childComplexity), true
+	case "RepositoryStats.contributors":
+		if e.ComplexityRoot.RepositoryStats.Contributors == nil {
+			break
+		}
 
-func (ec *executionContext) field___Directive_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated",
-		func(ctx context.Context, v any) (*bool, error) {
-			return ec.unmarshalOBoolean2ᚖbool(ctx, v)
-		})
-	if err != nil {
-		return nil, err
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
+		return e.ComplexityRoot.RepositoryStats.Contributors(childComplexity), true
+	case "RepositoryStats.cycleTime":
+		if e.ComplexityRoot.RepositoryStats.CycleTime == nil {
+			break
+		}
 
-func (ec *executionContext) field___Field_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated",
-		func(ctx context.Context, v any) (*bool, error) {
-			return ec.unmarshalOBoolean2ᚖbool(ctx, v)
-		})
-	if err != nil {
-		return nil, err
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
+		return e.ComplexityRoot.RepositoryStats.CycleTime(childComplexity), true
+	case "RepositoryStats.issues":
+		if e.ComplexityRoot.RepositoryStats.Issues == nil {
+			break
+		}
 
-func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated",
-		func(ctx context.Context, v any) (bool, error) {
-			return ec.unmarshalOBoolean2bool(ctx, v)
-		})
-	if err != nil {
-		return nil, err
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
+		return e.ComplexityRoot.RepositoryStats.Issues(childComplexity), true
+	case "RepositoryStats.nameWithOwner":
+		if e.ComplexityRoot.RepositoryStats.NameWithOwner == nil {
+			break
+		}
 
-func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated",
-		func(ctx context.Context, v any) (bool, error) {
-			return ec.unmarshalOBoolean2bool(ctx, v)
-		})
-	if err != nil {
-		return nil, err
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
+		return e.ComplexityRoot.RepositoryStats.NameWithOwner(childComplexity), true
+	case "RepositoryStats.openIssueCount":
+		if e.ComplexityRoot.RepositoryStats.OpenIssueCount == nil {
+			break
+		}
 
-// endregion ***************************** args.gotpl *****************************
+		return e.ComplexityRoot.RepositoryStats.OpenIssueCount(childComplexity), true
+	case "RepositoryStats.prSize":
+		if e.ComplexityRoot.RepositoryStats.PrSize == nil {
+			break
+		}
 
-// region    **************************** field.gotpl *****************************
+		return e.ComplexityRoot.RepositoryStats.PrSize(childComplexity), true
+	case "RepositoryStats.total":
+		if e.ComplexityRoot.RepositoryStats.Total == nil {
+			break
+		}
 
-func (ec *executionContext) _DailyStatistics_date(ctx context.Context, field graphql.CollectedField, obj *model.DailyStatistics) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_DailyStatistics_date(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.Date, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
-			return ec.marshalNString2string(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_DailyStatistics_date(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("DailyStatistics", field, false, false, errors.New("field of type String does not have child fields"))
-}
+		return e.ComplexityRoot.RepositoryStats.Total(childComplexity), true
 
-func (ec *executionContext) _DailyStatistics_commitCount(ctx context.Context, field graphql.CollectedField, obj *model.DailyStatistics) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_DailyStatistics_commitCount(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.CommitCount, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_DailyStatistics_commitCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("DailyStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+	case "RepositoryTotals.additions":
+		if e.ComplexityRoot.RepositoryTotals.Additions == nil {
+			break
+		}
 
-func (ec *executionContext) _DailyStatistics_prCreated(ctx context.Context, field graphql.CollectedField, obj *model.DailyStatistics) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_DailyStatistics_prCreated(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.PrCreated, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_DailyStatistics_prCreated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("DailyStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.RepositoryTotals.Additions(childComplexity), true
+	case "RepositoryTotals.commits":
+		if e.ComplexityRoot.RepositoryTotals.Commits == nil {
+			break
+		}
 
-func (ec *executionContext) _DailyStatistics_prMerged(ctx context.Context, field graphql.CollectedField, obj *model.DailyStatistics) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_DailyStatistics_prMerged(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.PrMerged, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_DailyStatistics_prMerged(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("DailyStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.RepositoryTotals.Commits(childComplexity), true
+	case "RepositoryTotals.deletions":
+		if e.ComplexityRoot.RepositoryTotals.Deletions == nil {
+			break
+		}
 
-func (ec *executionContext) _DailyStatistics_issueCount(ctx context.Context, field graphql.CollectedField, obj *model.DailyStatistics) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_DailyStatistics_issueCount(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.IssueCount, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_DailyStatistics_issueCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("DailyStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.RepositoryTotals.Deletions(childComplexity), true
+	case "RepositoryTotals.issues":
+		if e.ComplexityRoot.RepositoryTotals.Issues == nil {
+			break
+		}
 
-func (ec *executionContext) _DailyStatistics_reviewCount(ctx context.Context, field graphql.CollectedField, obj *model.DailyStatistics) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_DailyStatistics_reviewCount(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.ReviewCount, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_DailyStatistics_reviewCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("DailyStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.RepositoryTotals.Issues(childComplexity), true
+	case "RepositoryTotals.prCreated":
+		if e.ComplexityRoot.RepositoryTotals.PrCreated == nil {
+			break
+		}
 
-func (ec *executionContext) _DailyStatistics_totalAdditions(ctx context.Context, field graphql.CollectedField, obj *model.DailyStatistics) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_DailyStatistics_totalAdditions(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.TotalAdditions, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_DailyStatistics_totalAdditions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("DailyStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.RepositoryTotals.PrCreated(childComplexity), true
+	case "RepositoryTotals.prMerged":
+		if e.ComplexityRoot.RepositoryTotals.PrMerged == nil {
+			break
+		}
 
-func (ec *executionContext) _DailyStatistics_totalDeletions(ctx context.Context, field graphql.CollectedField, obj *model.DailyStatistics) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_DailyStatistics_totalDeletions(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.TotalDeletions, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_DailyStatistics_totalDeletions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("DailyStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.RepositoryTotals.PrMerged(childComplexity), true
+	case "RepositoryTotals.reviews":
+		if e.ComplexityRoot.RepositoryTotals.Reviews == nil {
+			break
+		}
 
-func (ec *executionContext) _MemberStats_login(ctx context.Context, field graphql.CollectedField, obj *model.MemberStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_MemberStats_login(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.Login, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
-			return ec.marshalNString2string(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_MemberStats_login(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("MemberStats", field, false, false, errors.New("field of type String does not have child fields"))
-}
+		return e.ComplexityRoot.RepositoryTotals.Reviews(childComplexity), true
 
-func (ec *executionContext) _MemberStats_name(ctx context.Context, field graphql.CollectedField, obj *model.MemberStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_MemberStats_name(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
-			return ec.marshalNString2string(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_MemberStats_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("MemberStats", field, false, false, errors.New("field of type String does not have child fields"))
-}
+	case "ReviewLoad.completed":
+		if e.ComplexityRoot.ReviewLoad.Completed == nil {
+			break
+		}
 
-func (ec *executionContext) _MemberStats_totalCommits(ctx context.Context, field graphql.CollectedField, obj *model.MemberStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_MemberStats_totalCommits(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.TotalCommits, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_MemberStats_totalCommits(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("MemberStats", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.ReviewLoad.Completed(childComplexity), true
+	case "ReviewLoad.pending":
+		if e.ComplexityRoot.ReviewLoad.Pending == nil {
+			break
+		}
 
-func (ec *executionContext) _MemberStats_totalPRCreated(ctx context.Context, field graphql.CollectedField, obj *model.MemberStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_MemberStats_totalPRCreated(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.TotalPRCreated, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_MemberStats_totalPRCreated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("MemberStats", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.ReviewLoad.Pending(childComplexity), true
+	case "ReviewLoad.pendingReviews":
+		if e.ComplexityRoot.ReviewLoad.PendingReviews == nil {
+			break
+		}
 
-func (ec *executionContext) _MemberStats_totalPRMerged(ctx context.Context, field graphql.CollectedField, obj *model.MemberStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_MemberStats_totalPRMerged(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.TotalPRMerged, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_MemberStats_totalPRMerged(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("MemberStats", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.ReviewLoad.PendingReviews(childComplexity), true
+	case "ReviewLoad.requested":
+		if e.ComplexityRoot.ReviewLoad.Requested == nil {
+			break
+		}
 
-func (ec *executionContext) _MemberStats_totalIssues(ctx context.Context, field graphql.CollectedField, obj *model.MemberStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_MemberStats_totalIssues(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.TotalIssues, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_MemberStats_totalIssues(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("MemberStats", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.ReviewLoad.Requested(childComplexity), true
+	case "ReviewLoad.reviewer":
+		if e.ComplexityRoot.ReviewLoad.Reviewer == nil {
+			break
+		}
 
-func (ec *executionContext) _MemberStats_totalReviews(ctx context.Context, field graphql.CollectedField, obj *model.MemberStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_MemberStats_totalReviews(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.TotalReviews, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_MemberStats_totalReviews(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("MemberStats", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.ReviewLoad.Reviewer(childComplexity), true
 
-func (ec *executionContext) _MemberStats_totalAdditions(ctx context.Context, field graphql.CollectedField, obj *model.MemberStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_MemberStats_totalAdditions(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.TotalAdditions, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_MemberStats_totalAdditions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("MemberStats", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+	case "ReviewNetwork.edges":
+		if e.ComplexityRoot.ReviewNetwork.Edges == nil {
+			break
+		}
 
-func (ec *executionContext) _MemberStats_totalDeletions(ctx context.Context, field graphql.CollectedField, obj *model.MemberStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_MemberStats_totalDeletions(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.TotalDeletions, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_MemberStats_totalDeletions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("MemberStats", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.ReviewNetwork.Edges(childComplexity), true
+	case "ReviewNetwork.nodes":
+		if e.ComplexityRoot.ReviewNetwork.Nodes == nil {
+			break
+		}
 
-func (ec *executionContext) _MemberStats_prToReviewRatio(ctx context.Context, field graphql.CollectedField, obj *model.MemberStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_MemberStats_prToReviewRatio(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.PrToReviewRatio, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v float64) graphql.Marshaler {
-			return ec.marshalNFloat2float64(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_MemberStats_prToReviewRatio(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("MemberStats", field, false, false, errors.New("field of type Float does not have child fields"))
-}
+		return e.ComplexityRoot.ReviewNetwork.Nodes(childComplexity), true
 
-func (ec *executionContext) _Query_members(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_Query_members(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return ec.Resolvers.Query().Members(ctx)
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v []*model.MemberStats) graphql.Marshaler {
-			return ec.marshalNMemberStats2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐMemberStatsᚄ(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_Query_members(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.childFields_MemberStats(ctx, field)
-		},
-	}
-	return fc, nil
-}
+	case "ReviewNetworkEdge.author":
+		if e.ComplexityRoot.ReviewNetworkEdge.Author == nil {
+			break
+		}
 
-func (ec *executionContext) _Query_member(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_Query_member(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Query().Member(ctx, fc.Args["login"].(string))
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v *model.UserStatistics) graphql.Marshaler {
-			return ec.marshalOUserStatistics2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐUserStatistics(ctx, selections, v)
-		},
-		true,
-		false,
-	)
-}
-func (ec *executionContext) fieldContext_Query_member(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.childFields_UserStatistics(ctx, field)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		return e.ComplexityRoot.ReviewNetworkEdge.Author(childComplexity), true
+	case "ReviewNetworkEdge.repositories":
+		if e.ComplexityRoot.ReviewNetworkEdge.Repositories == nil {
+			break
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_member_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Query_teamSummary(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_Query_teamSummary(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return ec.Resolvers.Query().TeamSummary(ctx)
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v *model.TeamSummary) graphql.Marshaler {
-			return ec.marshalNTeamSummary2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐTeamSummary(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_Query_teamSummary(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.childFields_TeamSummary(ctx, field)
-		},
-	}
-	return fc, nil
-}
+		return e.ComplexityRoot.ReviewNetworkEdge.Repositories(childComplexity), true
+	case "ReviewNetworkEdge.reviewCount":
+		if e.ComplexityRoot.ReviewNetworkEdge.ReviewCount == nil {
+			break
+		}
 
-func (ec *executionContext) _Query_teamDailyStats(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_Query_teamDailyStats(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return ec.Resolvers.Query().TeamDailyStats(ctx)
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v []*model.DailyStatistics) graphql.Marshaler {
-			return ec.marshalNDailyStatistics2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐDailyStatisticsᚄ(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_Query_teamDailyStats(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.childFields_DailyStatistics(ctx, field)
-		},
-	}
-	return fc, nil
-}
+		return e.ComplexityRoot.ReviewNetworkEdge.ReviewCount(childComplexity), true
+	case "ReviewNetworkEdge.reviewer":
+		if e.ComplexityRoot.ReviewNetworkEdge.Reviewer == nil {
+			break
+		}
 
-func (ec *executionContext) _Query_repositories(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_Query_repositories(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return ec.Resolvers.Query().Repositories(ctx)
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v []*model.RepositoryStats) graphql.Marshaler {
-			return ec.marshalNRepositoryStats2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryStatsᚄ(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_Query_repositories(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.childFields_RepositoryStats(ctx, field)
-		},
-	}
-	return fc, nil
-}
+		return e.ComplexityRoot.ReviewNetworkEdge.Reviewer(childComplexity), true
 
-func (ec *executionContext) _Query_repository(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_Query_repository(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Query().Repository(ctx, fc.Args["nameWithOwner"].(string))
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v *model.RepositoryStats) graphql.Marshaler {
-			return ec.marshalORepositoryStats2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryStats(ctx, selections, v)
-		},
-		true,
-		false,
-	)
-}
-func (ec *executionContext) fieldContext_Query_repository(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.childFields_RepositoryStats(ctx, field)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+	case "ReviewNetworkNode.login":
+		if e.ComplexityRoot.ReviewNetworkNode.Login == nil {
+			break
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_repository_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Query_repositoryDailyStats(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_Query_repositoryDailyStats(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return ec.Resolvers.Query().RepositoryDailyStats(ctx)
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v []*model.RepositoryDailyStats) graphql.Marshaler {
-			return ec.marshalNRepositoryDailyStats2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryDailyStatsᚄ(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_Query_repositoryDailyStats(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.childFields_RepositoryDailyStats(ctx, field)
-		},
-	}
-	return fc, nil
-}
+		return e.ComplexityRoot.ReviewNetworkNode.Login(childComplexity), true
+	case "ReviewNetworkNode.reviewsGiven":
+		if e.ComplexityRoot.ReviewNetworkNode.ReviewsGiven == nil {
+			break
+		}
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_Query___type(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.IntrospectType(fc.Args["name"].(string))
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v *introspection.Type) graphql.Marshaler {
-			return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, selections, v)
-		},
-		true,
-		false,
-	)
-}
-func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.childFields___Type(ctx, field)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		return e.ComplexityRoot.ReviewNetworkNode.ReviewsGiven(childComplexity), true
+	case "ReviewNetworkNode.reviewsReceived":
+		if e.ComplexityRoot.ReviewNetworkNode.ReviewsReceived == nil {
+			break
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_Query___schema(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return ec.IntrospectSchema()
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v *introspection.Schema) graphql.Marshaler {
-			return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, selections, v)
-		},
-		true,
-		false,
-	)
-}
-func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.childFields___Schema(ctx, field)
-		},
-	}
-	return fc, nil
-}
+		return e.ComplexityRoot.ReviewNetworkNode.ReviewsReceived(childComplexity), true
 
-func (ec *executionContext) _RepositoryActivity_repository(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryActivity) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_RepositoryActivity_repository(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.Repository, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
-			return ec.marshalNString2string(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_RepositoryActivity_repository(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("RepositoryActivity", field, false, false, errors.New("field of type String does not have child fields"))
-}
+	case "RoleTransitionPoint.description":
+		if e.ComplexityRoot.RoleTransitionPoint.Description == nil {
+			break
+		}
 
-func (ec *executionContext) _RepositoryActivity_commitCount(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryActivity) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_RepositoryActivity_commitCount(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.CommitCount, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_RepositoryActivity_commitCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("RepositoryActivity", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.RoleTransitionPoint.Description(childComplexity), true
+	case "RoleTransitionPoint.prCreated":
+		if e.ComplexityRoot.RoleTransitionPoint.PrCreated == nil {
+			break
+		}
 
-func (ec *executionContext) _RepositoryActivity_prCount(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryActivity) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_RepositoryActivity_prCount(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.PrCount, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_RepositoryActivity_prCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("RepositoryActivity", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.RoleTransitionPoint.PrCreated(childComplexity), true
+	case "RoleTransitionPoint.ratio":
+		if e.ComplexityRoot.RoleTransitionPoint.Ratio == nil {
+			break
+		}
 
-func (ec *executionContext) _RepositoryActivity_issueCount(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryActivity) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_RepositoryActivity_issueCount(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.IssueCount, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_RepositoryActivity_issueCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("RepositoryActivity", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.RoleTransitionPoint.Ratio(childComplexity), true
+	case "RoleTransitionPoint.reviewCount":
+		if e.ComplexityRoot.RoleTransitionPoint.ReviewCount == nil {
+			break
+		}
 
-func (ec *executionContext) _RepositoryActivity_reviewCount(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryActivity) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_RepositoryActivity_reviewCount(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.ReviewCount, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_RepositoryActivity_reviewCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("RepositoryActivity", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.RoleTransitionPoint.ReviewCount(childComplexity), true
+	case "RoleTransitionPoint.year":
+		if e.ComplexityRoot.RoleTransitionPoint.Year == nil {
+			break
+		}
 
-func (ec *executionContext) _RepositoryActivity_totalAdditions(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryActivity) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_RepositoryActivity_totalAdditions(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.TotalAdditions, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_RepositoryActivity_totalAdditions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("RepositoryActivity", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.RoleTransitionPoint.Year(childComplexity), true
 
-func (ec *executionContext) _RepositoryActivity_totalDeletions(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryActivity) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_RepositoryActivity_totalDeletions(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.TotalDeletions, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_RepositoryActivity_totalDeletions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("RepositoryActivity", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+	case "Snapshot.capturedAt":
+		if e.ComplexityRoot.Snapshot.CapturedAt == nil {
+			break
+		}
 
-func (ec *executionContext) _RepositoryActivity_firstActivity(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryActivity) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_RepositoryActivity_firstActivity(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.FirstActivity, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
-			return ec.marshalNString2string(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_RepositoryActivity_firstActivity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("RepositoryActivity", field, false, false, errors.New("field of type String does not have child fields"))
-}
+		return e.ComplexityRoot.Snapshot.CapturedAt(childComplexity), true
+	case "Snapshot.excludedMembers":
+		if e.ComplexityRoot.Snapshot.ExcludedMembers == nil {
+			break
+		}
 
-func (ec *executionContext) _RepositoryActivity_lastActivity(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryActivity) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_RepositoryActivity_lastActivity(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.LastActivity, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
-			return ec.marshalNString2string(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_RepositoryActivity_lastActivity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("RepositoryActivity", field, false, false, errors.New("field of type String does not have child fields"))
-}
+		return e.ComplexityRoot.Snapshot.ExcludedMembers(childComplexity), true
+	case "Snapshot.id":
+		if e.ComplexityRoot.Snapshot.ID == nil {
+			break
+		}
 
-func (ec *executionContext) _RepositoryContributor_login(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryContributor) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_RepositoryContributor_login(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.Login, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
-			return ec.marshalNString2string(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_RepositoryContributor_login(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("RepositoryContributor", field, false, false, errors.New("field of type String does not have child fields"))
-}
+		return e.ComplexityRoot.Snapshot.ID(childComplexity), true
+	case "Snapshot.memberCount":
+		if e.ComplexityRoot.Snapshot.MemberCount == nil {
+			break
+		}
 
-func (ec *executionContext) _RepositoryContributor_commitCount(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryContributor) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_RepositoryContributor_commitCount(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.CommitCount, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_RepositoryContributor_commitCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("RepositoryContributor", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.Snapshot.MemberCount(childComplexity), true
+	case "Snapshot.members":
+		if e.ComplexityRoot.Snapshot.Members == nil {
+			break
+		}
 
-func (ec *executionContext) _RepositoryContributor_prCreated(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryContributor) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_RepositoryContributor_prCreated(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.PrCreated, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_RepositoryContributor_prCreated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("RepositoryContributor", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.Snapshot.Members(childComplexity), true
+	case "Snapshot.period":
+		if e.ComplexityRoot.Snapshot.Period == nil {
+			break
+		}
 
-func (ec *executionContext) _RepositoryContributor_reviewCount(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryContributor) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_RepositoryContributor_reviewCount(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.ReviewCount, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_RepositoryContributor_reviewCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("RepositoryContributor", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.Snapshot.Period(childComplexity), true
+	case "Snapshot.repositories":
+		if e.ComplexityRoot.Snapshot.Repositories == nil {
+			break
+		}
 
-func (ec *executionContext) _RepositoryContributor_additions(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryContributor) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_RepositoryContributor_additions(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.Additions, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_RepositoryContributor_additions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("RepositoryContributor", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.Snapshot.Repositories(childComplexity), true
+	case "Snapshot.repositoryCount":
+		if e.ComplexityRoot.Snapshot.RepositoryCount == nil {
+			break
+		}
 
-func (ec *executionContext) _RepositoryContributor_deletions(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryContributor) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_RepositoryContributor_deletions(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.Deletions, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_RepositoryContributor_deletions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("RepositoryContributor", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.Snapshot.RepositoryCount(childComplexity), true
+	case "Snapshot.tag":
+		if e.ComplexityRoot.Snapshot.Tag == nil {
+			break
+		}
 
-func (ec *executionContext) _RepositoryContributor_dailyStats(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryContributor) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_RepositoryContributor_dailyStats(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.DailyStats, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v []*model.DailyStatistics) graphql.Marshaler {
-			return ec.marshalNDailyStatistics2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐDailyStatisticsᚄ(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_RepositoryContributor_dailyStats(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RepositoryContributor",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.childFields_DailyStatistics(ctx, field)
-		},
-	}
-	return fc, nil
-}
+		return e.ComplexityRoot.Snapshot.Tag(childComplexity), true
+	case "Snapshot.teamSummary":
+		if e.ComplexityRoot.Snapshot.TeamSummary == nil {
+			break
+		}
 
-func (ec *executionContext) _RepositoryDailyStats_nameWithOwner(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryDailyStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_RepositoryDailyStats_nameWithOwner(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.NameWithOwner, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
-			return ec.marshalNString2string(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_RepositoryDailyStats_nameWithOwner(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("RepositoryDailyStats", field, false, false, errors.New("field of type String does not have child fields"))
-}
+		return e.ComplexityRoot.Snapshot.TeamSummary(childComplexity), true
 
-func (ec *executionContext) _RepositoryDailyStats_owner(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryDailyStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_RepositoryDailyStats_owner(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.Owner, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
-			return ec.marshalNString2string(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_RepositoryDailyStats_owner(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("RepositoryDailyStats", field, false, false, errors.New("field of type String does not have child fields"))
-}
+	case "SnapshotDiff.addedMembers":
+		if e.ComplexityRoot.SnapshotDiff.AddedMembers == nil {
+			break
+		}
 
-func (ec *executionContext) _RepositoryDailyStats_ownerType(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryDailyStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_RepositoryDailyStats_ownerType(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.OwnerType, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
-			return ec.marshalNString2string(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_RepositoryDailyStats_ownerType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("RepositoryDailyStats", field, false, false, errors.New("field of type String does not have child fields"))
-}
+		return e.ComplexityRoot.SnapshotDiff.AddedMembers(childComplexity), true
+	case "SnapshotDiff.addedRepositories":
+		if e.ComplexityRoot.SnapshotDiff.AddedRepositories == nil {
+			break
+		}
 
-func (ec *executionContext) _RepositoryDailyStats_dailyStats(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryDailyStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_RepositoryDailyStats_dailyStats(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.DailyStats, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v []*model.DailyStatistics) graphql.Marshaler {
-			return ec.marshalNDailyStatistics2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐDailyStatisticsᚄ(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_RepositoryDailyStats_dailyStats(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RepositoryDailyStats",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.childFields_DailyStatistics(ctx, field)
-		},
-	}
-	return fc, nil
-}
+		return e.ComplexityRoot.SnapshotDiff.AddedRepositories(childComplexity), true
+	case "SnapshotDiff.base":
+		if e.ComplexityRoot.SnapshotDiff.Base == nil {
+			break
+		}
 
-func (ec *executionContext) _RepositoryStats_nameWithOwner(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_RepositoryStats_nameWithOwner(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.NameWithOwner, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
-			return ec.marshalNString2string(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_RepositoryStats_nameWithOwner(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("RepositoryStats", field, false, false, errors.New("field of type String does not have child fields"))
-}
+		return e.ComplexityRoot.SnapshotDiff.Base(childComplexity), true
+	case "SnapshotDiff.head":
+		if e.ComplexityRoot.SnapshotDiff.Head == nil {
+			break
+		}
 
-func (ec *executionContext) _RepositoryStats_total(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_RepositoryStats_total(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.Total, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v *model.RepositoryTotals) graphql.Marshaler {
-			return ec.marshalNRepositoryTotals2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryTotals(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_RepositoryStats_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RepositoryStats",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.childFields_RepositoryTotals(ctx, field)
-		},
-	}
-	return fc, nil
-}
+		return e.ComplexityRoot.SnapshotDiff.Head(childComplexity), true
+	case "SnapshotDiff.memberDeltas":
+		if e.ComplexityRoot.SnapshotDiff.MemberDeltas == nil {
+			break
+		}
 
-func (ec *executionContext) _RepositoryStats_contributorCount(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_RepositoryStats_contributorCount(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.ContributorCount, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_RepositoryStats_contributorCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("RepositoryStats", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.SnapshotDiff.MemberDeltas(childComplexity), true
+	case "SnapshotDiff.removedMembers":
+		if e.ComplexityRoot.SnapshotDiff.RemovedMembers == nil {
+			break
+		}
 
-func (ec *executionContext) _RepositoryStats_contributors(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_RepositoryStats_contributors(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.Contributors, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v []*model.RepositoryContributor) graphql.Marshaler {
-			return ec.marshalNRepositoryContributor2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryContributorᚄ(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_RepositoryStats_contributors(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RepositoryStats",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.childFields_RepositoryContributor(ctx, field)
-		},
-	}
-	return fc, nil
-}
+		return e.ComplexityRoot.SnapshotDiff.RemovedMembers(childComplexity), true
+	case "SnapshotDiff.removedRepositories":
+		if e.ComplexityRoot.SnapshotDiff.RemovedRepositories == nil {
+			break
+		}
 
-func (ec *executionContext) _RepositoryTotals_commits(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryTotals) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_RepositoryTotals_commits(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.Commits, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_RepositoryTotals_commits(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("RepositoryTotals", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.SnapshotDiff.RemovedRepositories(childComplexity), true
+	case "SnapshotDiff.repositoryDeltas":
+		if e.ComplexityRoot.SnapshotDiff.RepositoryDeltas == nil {
+			break
+		}
 
-func (ec *executionContext) _RepositoryTotals_prCreated(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryTotals) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_RepositoryTotals_prCreated(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.PrCreated, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_RepositoryTotals_prCreated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("RepositoryTotals", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.SnapshotDiff.RepositoryDeltas(childComplexity), true
 
-func (ec *executionContext) _RepositoryTotals_prMerged(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryTotals) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_RepositoryTotals_prMerged(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.PrMerged, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_RepositoryTotals_prMerged(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("RepositoryTotals", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+	case "SnapshotInfo.capturedAt":
+		if e.ComplexityRoot.SnapshotInfo.CapturedAt == nil {
+			break
+		}
 
-func (ec *executionContext) _RepositoryTotals_issues(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryTotals) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_RepositoryTotals_issues(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.Issues, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_RepositoryTotals_issues(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("RepositoryTotals", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.SnapshotInfo.CapturedAt(childComplexity), true
+	case "SnapshotInfo.excludedMembers":
+		if e.ComplexityRoot.SnapshotInfo.ExcludedMembers == nil {
+			break
+		}
 
-func (ec *executionContext) _RepositoryTotals_reviews(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryTotals) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_RepositoryTotals_reviews(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.Reviews, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_RepositoryTotals_reviews(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("RepositoryTotals", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.SnapshotInfo.ExcludedMembers(childComplexity), true
+	case "SnapshotInfo.id":
+		if e.ComplexityRoot.SnapshotInfo.ID == nil {
+			break
+		}
 
-func (ec *executionContext) _RepositoryTotals_additions(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryTotals) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_RepositoryTotals_additions(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.Additions, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_RepositoryTotals_additions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("RepositoryTotals", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.SnapshotInfo.ID(childComplexity), true
+	case "SnapshotInfo.memberCount":
+		if e.ComplexityRoot.SnapshotInfo.MemberCount == nil {
+			break
+		}
 
-func (ec *executionContext) _RepositoryTotals_deletions(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryTotals) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_RepositoryTotals_deletions(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.Deletions, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_RepositoryTotals_deletions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("RepositoryTotals", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.SnapshotInfo.MemberCount(childComplexity), true
+	case "SnapshotInfo.period":
+		if e.ComplexityRoot.SnapshotInfo.Period == nil {
+			break
+		}
 
-func (ec *executionContext) _RoleTransitionPoint_year(ctx context.Context, field graphql.CollectedField, obj *model.RoleTransitionPoint) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_RoleTransitionPoint_year(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.Year, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_RoleTransitionPoint_year(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("RoleTransitionPoint", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.SnapshotInfo.Period(childComplexity), true
+	case "SnapshotInfo.repositoryCount":
+		if e.ComplexityRoot.SnapshotInfo.RepositoryCount == nil {
+			break
+		}
 
-func (ec *executionContext) _RoleTransitionPoint_prCreated(ctx context.Context, field graphql.CollectedField, obj *model.RoleTransitionPoint) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_RoleTransitionPoint_prCreated(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.PrCreated, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_RoleTransitionPoint_prCreated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("RoleTransitionPoint", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.SnapshotInfo.RepositoryCount(childComplexity), true
+	case "SnapshotInfo.tag":
+		if e.ComplexityRoot.SnapshotInfo.Tag == nil {
+			break
+		}
 
-func (ec *executionContext) _RoleTransitionPoint_reviewCount(ctx context.Context, field graphql.CollectedField, obj *model.RoleTransitionPoint) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_RoleTransitionPoint_reviewCount(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.ReviewCount, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_RoleTransitionPoint_reviewCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("RoleTransitionPoint", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.SnapshotInfo.Tag(childComplexity), true
 
-func (ec *executionContext) _RoleTransitionPoint_ratio(ctx context.Context, field graphql.CollectedField, obj *model.RoleTransitionPoint) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_RoleTransitionPoint_ratio(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.Ratio, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v float64) graphql.Marshaler {
-			return ec.marshalNFloat2float64(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_RoleTransitionPoint_ratio(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("RoleTransitionPoint", field, false, false, errors.New("field of type Float does not have child fields"))
-}
+	case "TeamSummary.categoryBreakdown":
+		if e.ComplexityRoot.TeamSummary.CategoryBreakdown == nil {
+			break
+		}
 
-func (ec *executionContext) _RoleTransitionPoint_description(ctx context.Context, field graphql.CollectedField, obj *model.RoleTransitionPoint) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_RoleTransitionPoint_description(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.Description, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
-			return ec.marshalNString2string(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_RoleTransitionPoint_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("RoleTransitionPoint", field, false, false, errors.New("field of type String does not have child fields"))
-}
+		return e.ComplexityRoot.TeamSummary.CategoryBreakdown(childComplexity), true
+	case "TeamSummary.issues":
+		if e.ComplexityRoot.TeamSummary.Issues == nil {
+			break
+		}
 
-func (ec *executionContext) _TeamSummary_memberCount(ctx context.Context, field graphql.CollectedField, obj *model.TeamSummary) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_TeamSummary_memberCount(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.MemberCount, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_TeamSummary_memberCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("TeamSummary", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.TeamSummary.Issues(childComplexity), true
+	case "TeamSummary.memberCount":
+		if e.ComplexityRoot.TeamSummary.MemberCount == nil {
+			break
+		}
 
-func (ec *executionContext) _TeamSummary_repositoryCount(ctx context.Context, field graphql.CollectedField, obj *model.TeamSummary) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_TeamSummary_repositoryCount(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.RepositoryCount, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_TeamSummary_repositoryCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("TeamSummary", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.TeamSummary.MemberCount(childComplexity), true
+	case "TeamSummary.openIssueCount":
+		if e.ComplexityRoot.TeamSummary.OpenIssueCount == nil {
+			break
+		}
 
-func (ec *executionContext) _TeamSummary_totalCommits(ctx context.Context, field graphql.CollectedField, obj *model.TeamSummary) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_TeamSummary_totalCommits(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.TotalCommits, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_TeamSummary_totalCommits(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("TeamSummary", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.TeamSummary.OpenIssueCount(childComplexity), true
+	case "TeamSummary.repositoryCount":
+		if e.ComplexityRoot.TeamSummary.RepositoryCount == nil {
+			break
+		}
 
-func (ec *executionContext) _TeamSummary_totalPRCreated(ctx context.Context, field graphql.CollectedField, obj *model.TeamSummary) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_TeamSummary_totalPRCreated(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.TotalPRCreated, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_TeamSummary_totalPRCreated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("TeamSummary", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.TeamSummary.RepositoryCount(childComplexity), true
+	case "TeamSummary.totalAdditions":
+		if e.ComplexityRoot.TeamSummary.TotalAdditions == nil {
+			break
+		}
 
-func (ec *executionContext) _TeamSummary_totalPRMerged(ctx context.Context, field graphql.CollectedField, obj *model.TeamSummary) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_TeamSummary_totalPRMerged(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.TotalPRMerged, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_TeamSummary_totalPRMerged(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("TeamSummary", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.TeamSummary.TotalAdditions(childComplexity), true
+	case "TeamSummary.totalCommits":
+		if e.ComplexityRoot.TeamSummary.TotalCommits == nil {
+			break
+		}
 
-func (ec *executionContext) _TeamSummary_totalIssues(ctx context.Context, field graphql.CollectedField, obj *model.TeamSummary) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_TeamSummary_totalIssues(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.TotalIssues, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_TeamSummary_totalIssues(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("TeamSummary", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.TeamSummary.TotalCommits(childComplexity), true
+	case "TeamSummary.totalDeletions":
+		if e.ComplexityRoot.TeamSummary.TotalDeletions == nil {
+			break
+		}
 
-func (ec *executionContext) _TeamSummary_totalReviews(ctx context.Context, field graphql.CollectedField, obj *model.TeamSummary) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_TeamSummary_totalReviews(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.TotalReviews, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_TeamSummary_totalReviews(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("TeamSummary", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.TeamSummary.TotalDeletions(childComplexity), true
+	case "TeamSummary.totalIssues":
+		if e.ComplexityRoot.TeamSummary.TotalIssues == nil {
+			break
+		}
 
-func (ec *executionContext) _TeamSummary_totalAdditions(ctx context.Context, field graphql.CollectedField, obj *model.TeamSummary) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_TeamSummary_totalAdditions(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.TotalAdditions, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_TeamSummary_totalAdditions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("TeamSummary", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.TeamSummary.TotalIssues(childComplexity), true
+	case "TeamSummary.totalPRCreated":
+		if e.ComplexityRoot.TeamSummary.TotalPRCreated == nil {
+			break
+		}
 
-func (ec *executionContext) _TeamSummary_totalDeletions(ctx context.Context, field graphql.CollectedField, obj *model.TeamSummary) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_TeamSummary_totalDeletions(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.TotalDeletions, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_TeamSummary_totalDeletions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("TeamSummary", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.TeamSummary.TotalPRCreated(childComplexity), true
+	case "TeamSummary.totalPRMerged":
+		if e.ComplexityRoot.TeamSummary.TotalPRMerged == nil {
+			break
+		}
 
-func (ec *executionContext) _UserStatistics_login(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_UserStatistics_login(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.Login, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
-			return ec.marshalNString2string(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_UserStatistics_login(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("UserStatistics", field, false, false, errors.New("field of type String does not have child fields"))
-}
-
-func (ec *executionContext) _UserStatistics_name(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_UserStatistics_name(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
-			return ec.marshalNString2string(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_UserStatistics_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("UserStatistics", field, false, false, errors.New("field of type String does not have child fields"))
-}
+		return e.ComplexityRoot.TeamSummary.TotalPRMerged(childComplexity), true
+	case "TeamSummary.totalReviews":
+		if e.ComplexityRoot.TeamSummary.TotalReviews == nil {
+			break
+		}
 
-func (ec *executionContext) _UserStatistics_totalCommits(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_UserStatistics_totalCommits(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.TotalCommits, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_UserStatistics_totalCommits(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("UserStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.TeamSummary.TotalReviews(childComplexity), true
 
-func (ec *executionContext) _UserStatistics_totalPRCreated(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_UserStatistics_totalPRCreated(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.TotalPRCreated, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_UserStatistics_totalPRCreated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("UserStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+	case "UserStatistics.categoryBreakdown":
+		if e.ComplexityRoot.UserStatistics.CategoryBreakdown == nil {
+			break
+		}
 
-func (ec *executionContext) _UserStatistics_totalPRMerged(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_UserStatistics_totalPRMerged(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.TotalPRMerged, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_UserStatistics_totalPRMerged(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("UserStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.UserStatistics.CategoryBreakdown(childComplexity), true
+	case "UserStatistics.complete":
+		if e.ComplexityRoot.UserStatistics.Complete == nil {
+			break
+		}
 
-func (ec *executionContext) _UserStatistics_totalIssues(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_UserStatistics_totalIssues(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.TotalIssues, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_UserStatistics_totalIssues(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("UserStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.UserStatistics.Complete(childComplexity), true
+	case "UserStatistics.cycleTime":
+		if e.ComplexityRoot.UserStatistics.CycleTime == nil {
+			break
+		}
 
-func (ec *executionContext) _UserStatistics_totalReviews(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_UserStatistics_totalReviews(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.TotalReviews, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_UserStatistics_totalReviews(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("UserStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.UserStatistics.CycleTime(childComplexity), true
+	case "UserStatistics.dailyStats":
+		if e.ComplexityRoot.UserStatistics.DailyStats == nil {
+			break
+		}
 
-func (ec *executionContext) _UserStatistics_totalAdditions(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_UserStatistics_totalAdditions(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.TotalAdditions, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_UserStatistics_totalAdditions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("UserStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.UserStatistics.DailyStats(childComplexity), true
+	case "UserStatistics.dataGaps":
+		if e.ComplexityRoot.UserStatistics.DataGaps == nil {
+			break
+		}
 
-func (ec *executionContext) _UserStatistics_totalDeletions(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_UserStatistics_totalDeletions(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.TotalDeletions, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_UserStatistics_totalDeletions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("UserStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.UserStatistics.DataGaps(childComplexity), true
+	case "UserStatistics.excludedReviews":
+		if e.ComplexityRoot.UserStatistics.ExcludedReviews == nil {
+			break
+		}
 
-func (ec *executionContext) _UserStatistics_prToReviewRatio(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_UserStatistics_prToReviewRatio(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.PrToReviewRatio, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v float64) graphql.Marshaler {
-			return ec.marshalNFloat2float64(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_UserStatistics_prToReviewRatio(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("UserStatistics", field, false, false, errors.New("field of type Float does not have child fields"))
-}
+		return e.ComplexityRoot.UserStatistics.ExcludedReviews(childComplexity), true
+	case "UserStatistics.firstActivityYear":
+		if e.ComplexityRoot.UserStatistics.FirstActivityYear == nil {
+			break
+		}
 
-func (ec *executionContext) _UserStatistics_firstActivityYear(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_UserStatistics_firstActivityYear(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.FirstActivityYear, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_UserStatistics_firstActivityYear(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("UserStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.UserStatistics.FirstActivityYear(childComplexity), true
+	case "UserStatistics.issues":
+		if e.ComplexityRoot.UserStatistics.Issues == nil {
+			break
+		}
 
-func (ec *executionContext) _UserStatistics_peakActivityYear(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_UserStatistics_peakActivityYear(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.PeakActivityYear, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_UserStatistics_peakActivityYear(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("UserStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.UserStatistics.Issues(childComplexity), true
+	case "UserStatistics.login":
+		if e.ComplexityRoot.UserStatistics.Login == nil {
+			break
+		}
 
-func (ec *executionContext) _UserStatistics_peakActivityCommits(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_UserStatistics_peakActivityCommits(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.PeakActivityCommits, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_UserStatistics_peakActivityCommits(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("UserStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+		return e.ComplexityRoot.UserStatistics.Login(childComplexity), true
+	case "UserStatistics.logins":
+		if e.ComplexityRoot.UserStatistics.Logins == nil {
+			break
+		}
 
-func (ec *executionContext) _UserStatistics_yearlyStats(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_UserStatistics_yearlyStats(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.YearlyStats, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v []*model.YearlyStatistics) graphql.Marshaler {
-			return ec.marshalNYearlyStatistics2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐYearlyStatisticsᚄ(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_UserStatistics_yearlyStats(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "UserStatistics",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.childFields_YearlyStatistics(ctx, field)
-		},
-	}
-	return fc, nil
-}
+		return e.ComplexityRoot.UserStatistics.Logins(childComplexity), true
+	case "UserStatistics.longTermRepositories":
+		if e.ComplexityRoot.UserStatistics.LongTermRepositories == nil {
+			break
+		}
+
+		return e.ComplexityRoot.UserStatistics.LongTermRepositories(childComplexity), true
+	case "UserStatistics.name":
+		if e.ComplexityRoot.UserStatistics.Name == nil {
+			break
+		}
+
+		return e.ComplexityRoot.UserStatistics.Name(childComplexity), true
+	case "UserStatistics.peakActivityCommits":
+		if e.ComplexityRoot.UserStatistics.PeakActivityCommits == nil {
+			break
+		}
+
+		return e.ComplexityRoot.UserStatistics.PeakActivityCommits(childComplexity), true
+	case "UserStatistics.peakActivityYear":
+		if e.ComplexityRoot.UserStatistics.PeakActivityYear == nil {
+			break
+		}
+
+		return e.ComplexityRoot.UserStatistics.PeakActivityYear(childComplexity), true
+	case "UserStatistics.prSize":
+		if e.ComplexityRoot.UserStatistics.PrSize == nil {
+			break
+		}
+
+		return e.ComplexityRoot.UserStatistics.PrSize(childComplexity), true
+	case "UserStatistics.prToReviewRatio":
+		if e.ComplexityRoot.UserStatistics.PrToReviewRatio == nil {
+			break
+		}
+
+		return e.ComplexityRoot.UserStatistics.PrToReviewRatio(childComplexity), true
+	case "UserStatistics.roleTransition":
+		if e.ComplexityRoot.UserStatistics.RoleTransition == nil {
+			break
+		}
+
+		return e.ComplexityRoot.UserStatistics.RoleTransition(childComplexity), true
+	case "UserStatistics.topRepositories":
+		if e.ComplexityRoot.UserStatistics.TopRepositories == nil {
+			break
+		}
+
+		return e.ComplexityRoot.UserStatistics.TopRepositories(childComplexity), true
+	case "UserStatistics.totalAdditions":
+		if e.ComplexityRoot.UserStatistics.TotalAdditions == nil {
+			break
+		}
+
+		return e.ComplexityRoot.UserStatistics.TotalAdditions(childComplexity), true
+	case "UserStatistics.totalCommits":
+		if e.ComplexityRoot.UserStatistics.TotalCommits == nil {
+			break
+		}
+
+		return e.ComplexityRoot.UserStatistics.TotalCommits(childComplexity), true
+	case "UserStatistics.totalDeletions":
+		if e.ComplexityRoot.UserStatistics.TotalDeletions == nil {
+			break
+		}
+
+		return e.ComplexityRoot.UserStatistics.TotalDeletions(childComplexity), true
+	case "UserStatistics.totalIssues":
+		if e.ComplexityRoot.UserStatistics.TotalIssues == nil {
+			break
+		}
+
+		return e.ComplexityRoot.UserStatistics.TotalIssues(childComplexity), true
+	case "UserStatistics.totalPRCreated":
+		if e.ComplexityRoot.UserStatistics.TotalPRCreated == nil {
+			break
+		}
+
+		return e.ComplexityRoot.UserStatistics.TotalPRCreated(childComplexity), true
+	case "UserStatistics.totalPRMerged":
+		if e.ComplexityRoot.UserStatistics.TotalPRMerged == nil {
+			break
+		}
+
+		return e.ComplexityRoot.UserStatistics.TotalPRMerged(childComplexity), true
+	case "UserStatistics.totalReviews":
+		if e.ComplexityRoot.UserStatistics.TotalReviews == nil {
+			break
+		}
+
+		return e.ComplexityRoot.UserStatistics.TotalReviews(childComplexity), true
+	case "UserStatistics.yearlyStats":
+		if e.ComplexityRoot.UserStatistics.YearlyStats == nil {
+			break
+		}
+
+		return e.ComplexityRoot.UserStatistics.YearlyStats(childComplexity), true
+
+	case "YearlyStatistics.commitCount":
+		if e.ComplexityRoot.YearlyStatistics.CommitCount == nil {
+			break
+		}
+
+		return e.ComplexityRoot.YearlyStatistics.CommitCount(childComplexity), true
+	case "YearlyStatistics.issueCount":
+		if e.ComplexityRoot.YearlyStatistics.IssueCount == nil {
+			break
+		}
+
+		return e.ComplexityRoot.YearlyStatistics.IssueCount(childComplexity), true
+	case "YearlyStatistics.prCreated":
+		if e.ComplexityRoot.YearlyStatistics.PrCreated == nil {
+			break
+		}
+
+		return e.ComplexityRoot.YearlyStatistics.PrCreated(childComplexity), true
+	case "YearlyStatistics.prMerged":
+		if e.ComplexityRoot.YearlyStatistics.PrMerged == nil {
+			break
+		}
+
+		return e.ComplexityRoot.YearlyStatistics.PrMerged(childComplexity), true
+	case "YearlyStatistics.reviewCount":
+		if e.ComplexityRoot.YearlyStatistics.ReviewCount == nil {
+			break
+		}
+
+		return e.ComplexityRoot.YearlyStatistics.ReviewCount(childComplexity), true
+	case "YearlyStatistics.totalAdditions":
+		if e.ComplexityRoot.YearlyStatistics.TotalAdditions == nil {
+			break
+		}
+
+		return e.ComplexityRoot.YearlyStatistics.TotalAdditions(childComplexity), true
+	case "YearlyStatistics.totalDeletions":
+		if e.ComplexityRoot.YearlyStatistics.TotalDeletions == nil {
+			break
+		}
+
+		return e.ComplexityRoot.YearlyStatistics.TotalDeletions(childComplexity), true
+	case "YearlyStatistics.year":
+		if e.ComplexityRoot.YearlyStatistics.Year == nil {
+			break
+		}
+
+		return e.ComplexityRoot.YearlyStatistics.Year(childComplexity), true
 
-func (ec *executionContext) _UserStatistics_dailyStats(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_UserStatistics_dailyStats(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.DailyStats, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v []*model.DailyStatistics) graphql.Marshaler {
-			return ec.marshalNDailyStatistics2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐDailyStatisticsᚄ(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_UserStatistics_dailyStats(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "UserStatistics",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.childFields_DailyStatistics(ctx, field)
-		},
 	}
-	return fc, nil
+	return 0, false
 }
 
-func (ec *executionContext) _UserStatistics_topRepositories(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_UserStatistics_topRepositories(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.TopRepositories, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v []*model.RepositoryActivity) graphql.Marshaler {
-			return ec.marshalNRepositoryActivity2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryActivityᚄ(ctx, selections, v)
-		},
-		true,
-		true,
-	)
+func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
+	opCtx := graphql.GetOperationContext(ctx)
+	ec := newExecutionContext(opCtx, e, make(chan graphql.DeferredResult))
+	inputUnmarshalMap := graphql.BuildUnmarshalerMap()
+	first := true
+
+	switch opCtx.Operation.Operation {
+	case ast.Query:
+		return func(ctx context.Context) *graphql.Response {
+			var response graphql.Response
+			var data graphql.Marshaler
+			if first {
+				first = false
+				ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
+				data = ec._Query(ctx, opCtx.Operation.SelectionSet)
+			} else {
+				if atomic.LoadInt32(&ec.PendingDeferred) > 0 {
+					result := <-ec.DeferredResults
+					atomic.AddInt32(&ec.PendingDeferred, -1)
+					data = result.Result
+					response.Path = result.Path
+					response.Label = result.Label
+					response.Errors = result.Errors
+				} else {
+					return nil
+				}
+			}
+			var buf bytes.Buffer
+			data.MarshalGQL(&buf)
+			response.Data = buf.Bytes()
+			if atomic.LoadInt32(&ec.Deferred) > 0 {
+				hasNext := atomic.LoadInt32(&ec.PendingDeferred) > 0
+				response.HasNext = &hasNext
+			}
+
+			return &response
+		}
+
+	default:
+		return graphql.OneShot(graphql.ErrorResponse(ctx, "unsupported GraphQL operation"))
+	}
 }
-func (ec *executionContext) fieldContext_UserStatistics_topRepositories(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "UserStatistics",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.childFields_RepositoryActivity(ctx, field)
-		},
+
+type executionContext struct {
+	*graphql.ExecutionContextState[ResolverRoot, DirectiveRoot, ComplexityRoot]
+}
+
+func newExecutionContext(
+	opCtx *graphql.OperationContext,
+	execSchema *executableSchema,
+	deferredResults chan graphql.DeferredResult,
+) *executionContext {
+	return &executionContext{
+		ExecutionContextState: graphql.NewExecutionContextState[ResolverRoot, DirectiveRoot, ComplexityRoot](
+			opCtx,
+			(*graphql.ExecutableSchemaState[ResolverRoot, DirectiveRoot, ComplexityRoot])(execSchema),
+			parsedSchema,
+			deferredResults,
+		),
 	}
-	return fc, nil
 }
 
-func (ec *executionContext) _UserStatistics_longTermRepositories(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_UserStatistics_longTermRepositories(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.LongTermRepositories, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v []*model.RepositoryActivity) graphql.Marshaler {
-			return ec.marshalNRepositoryActivity2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryActivityᚄ(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_UserStatistics_longTermRepositories(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "UserStatistics",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.childFields_RepositoryActivity(ctx, field)
-		},
-	}
-	return fc, nil
-}
+//go:embed "schema.graphqls"
+var sourcesFS embed.FS
+
+func sourceData(filename string) string {
+	data, err := sourcesFS.ReadFile(filename)
+	if err != nil {
+		panic(fmt.Sprintf("codegen problem: %s not available", filename))
+	}
+	return string(data)
+}
+
+var sources = []*ast.Source{
+	{Name: "schema.graphqls", Input: sourceData("schema.graphqls"), BuiltIn: false},
+}
+var parsedSchema = gqlparser.MustLoadSchema(sources...)
+
+// childFields_* functions provide shared child field context lookups.
+// Each function is generated once per unique object type, deduplicating the
+// switch statements that were previously inlined in every fieldContext_* function.
+
+func (ec *executionContext) childFields_ActivityHeatmap(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "login":
+		return ec.fieldContext_ActivityHeatmap_login(ctx, field)
+	case "timeZone":
+		return ec.fieldContext_ActivityHeatmap_timeZone(ctx, field)
+	case "total":
+		return ec.fieldContext_ActivityHeatmap_total(ctx, field)
+	case "offHours":
+		return ec.fieldContext_ActivityHeatmap_offHours(ctx, field)
+	case "weekend":
+		return ec.fieldContext_ActivityHeatmap_weekend(ctx, field)
+	case "offHoursRatio":
+		return ec.fieldContext_ActivityHeatmap_offHoursRatio(ctx, field)
+	case "rows":
+		return ec.fieldContext_ActivityHeatmap_rows(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type ActivityHeatmap", field.Name)
+}
+
+func (ec *executionContext) childFields_CategoryCount(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "category":
+		return ec.fieldContext_CategoryCount_category(ctx, field)
+	case "count":
+		return ec.fieldContext_CategoryCount_count(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type CategoryCount", field.Name)
+}
+
+func (ec *executionContext) childFields_CollectionPeriod(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "since":
+		return ec.fieldContext_CollectionPeriod_since(ctx, field)
+	case "until":
+		return ec.fieldContext_CollectionPeriod_until(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type CollectionPeriod", field.Name)
+}
+
+func (ec *executionContext) childFields_CycleTimeStats(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "prCount":
+		return ec.fieldContext_CycleTimeStats_prCount(ctx, field)
+	case "timeToFirstReviewHours":
+		return ec.fieldContext_CycleTimeStats_timeToFirstReviewHours(ctx, field)
+	case "timeToApprovalHours":
+		return ec.fieldContext_CycleTimeStats_timeToApprovalHours(ctx, field)
+	case "timeToMergeHours":
+		return ec.fieldContext_CycleTimeStats_timeToMergeHours(ctx, field)
+	case "timeToCloseHours":
+		return ec.fieldContext_CycleTimeStats_timeToCloseHours(ctx, field)
+	case "reviewRounds":
+		return ec.fieldContext_CycleTimeStats_reviewRounds(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type CycleTimeStats", field.Name)
+}
+
+func (ec *executionContext) childFields_DailyStatistics(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "date":
+		return ec.fieldContext_DailyStatistics_date(ctx, field)
+	case "commitCount":
+		return ec.fieldContext_DailyStatistics_commitCount(ctx, field)
+	case "prCreated":
+		return ec.fieldContext_DailyStatistics_prCreated(ctx, field)
+	case "prMerged":
+		return ec.fieldContext_DailyStatistics_prMerged(ctx, field)
+	case "issueCount":
+		return ec.fieldContext_DailyStatistics_issueCount(ctx, field)
+	case "reviewCount":
+		return ec.fieldContext_DailyStatistics_reviewCount(ctx, field)
+	case "totalAdditions":
+		return ec.fieldContext_DailyStatistics_totalAdditions(ctx, field)
+	case "totalDeletions":
+		return ec.fieldContext_DailyStatistics_totalDeletions(ctx, field)
+	case "issues":
+		return ec.fieldContext_DailyStatistics_issues(ctx, field)
+	case "categoryBreakdown":
+		return ec.fieldContext_DailyStatistics_categoryBreakdown(ctx, field)
+	case "openIssues":
+		return ec.fieldContext_DailyStatistics_openIssues(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type DailyStatistics", field.Name)
+}
+
+func (ec *executionContext) childFields_DataGap(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "activityType":
+		return ec.fieldContext_DataGap_activityType(ctx, field)
+	case "repository":
+		return ec.fieldContext_DataGap_repository(ctx, field)
+	case "reason":
+		return ec.fieldContext_DataGap_reason(ctx, field)
+	case "message":
+		return ec.fieldContext_DataGap_message(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type DataGap", field.Name)
+}
+
+func (ec *executionContext) childFields_DeliveryMetrics(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "repository":
+		return ec.fieldContext_DeliveryMetrics_repository(ctx, field)
+	case "source":
+		return ec.fieldContext_DeliveryMetrics_source(ctx, field)
+	case "deploymentCount":
+		return ec.fieldContext_DeliveryMetrics_deploymentCount(ctx, field)
+	case "failedDeploymentCount":
+		return ec.fieldContext_DeliveryMetrics_failedDeploymentCount(ctx, field)
+	case "weekCount":
+		return ec.fieldContext_DeliveryMetrics_weekCount(ctx, field)
+	case "deploymentsPerWeek":
+		return ec.fieldContext_DeliveryMetrics_deploymentsPerWeek(ctx, field)
+	case "leadTimeHours":
+		return ec.fieldContext_DeliveryMetrics_leadTimeHours(ctx, field)
+	case "changeFailureRate":
+		return ec.fieldContext_DeliveryMetrics_changeFailureRate(ctx, field)
+	case "timeToRestoreHours":
+		return ec.fieldContext_DeliveryMetrics_timeToRestoreHours(ctx, field)
+	case "weeks":
+		return ec.fieldContext_DeliveryMetrics_weeks(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type DeliveryMetrics", field.Name)
+}
+
+func (ec *executionContext) childFields_DeliveryWeek(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "week":
+		return ec.fieldContext_DeliveryWeek_week(ctx, field)
+	case "source":
+		return ec.fieldContext_DeliveryWeek_source(ctx, field)
+	case "deploymentCount":
+		return ec.fieldContext_DeliveryWeek_deploymentCount(ctx, field)
+	case "failedDeploymentCount":
+		return ec.fieldContext_DeliveryWeek_failedDeploymentCount(ctx, field)
+	case "leadTimeSeconds":
+		return ec.fieldContext_DeliveryWeek_leadTimeSeconds(ctx, field)
+	case "leadTimeCount":
+		return ec.fieldContext_DeliveryWeek_leadTimeCount(ctx, field)
+	case "restoreSeconds":
+		return ec.fieldContext_DeliveryWeek_restoreSeconds(ctx, field)
+	case "restoreCount":
+		return ec.fieldContext_DeliveryWeek_restoreCount(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type DeliveryWeek", field.Name)
+}
+
+func (ec *executionContext) childFields_ExcludedMember(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "login":
+		return ec.fieldContext_ExcludedMember_login(ctx, field)
+	case "rule":
+		return ec.fieldContext_ExcludedMember_rule(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type ExcludedMember", field.Name)
+}
+
+func (ec *executionContext) childFields_HeatmapRow(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "weekday":
+		return ec.fieldContext_HeatmapRow_weekday(ctx, field)
+	case "counts":
+		return ec.fieldContext_HeatmapRow_counts(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type HeatmapRow", field.Name)
+}
+
+func (ec *executionContext) childFields_IssueStats(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "closed":
+		return ec.fieldContext_IssueStats_closed(ctx, field)
+	case "timeToCloseHours":
+		return ec.fieldContext_IssueStats_timeToCloseHours(ctx, field)
+	case "bugOpened":
+		return ec.fieldContext_IssueStats_bugOpened(ctx, field)
+	case "featureOpened":
+		return ec.fieldContext_IssueStats_featureOpened(ctx, field)
+	case "bugClosed":
+		return ec.fieldContext_IssueStats_bugClosed(ctx, field)
+	case "featureClosed":
+		return ec.fieldContext_IssueStats_featureClosed(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type IssueStats", field.Name)
+}
+
+func (ec *executionContext) childFields_MemberDelta(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "login":
+		return ec.fieldContext_MemberDelta_login(ctx, field)
+	case "delta":
+		return ec.fieldContext_MemberDelta_delta(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type MemberDelta", field.Name)
+}
+
+func (ec *executionContext) childFields_MemberHistoryPoint(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "snapshotId":
+		return ec.fieldContext_MemberHistoryPoint_snapshotId(ctx, field)
+	case "capturedAt":
+		return ec.fieldContext_MemberHistoryPoint_capturedAt(ctx, field)
+	case "stats":
+		return ec.fieldContext_MemberHistoryPoint_stats(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type MemberHistoryPoint", field.Name)
+}
+
+func (ec *executionContext) childFields_MemberStats(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "login":
+		return ec.fieldContext_MemberStats_login(ctx, field)
+	case "name":
+		return ec.fieldContext_MemberStats_name(ctx, field)
+	case "totalCommits":
+		return ec.fieldContext_MemberStats_totalCommits(ctx, field)
+	case "totalPRCreated":
+		return ec.fieldContext_MemberStats_totalPRCreated(ctx, field)
+	case "totalPRMerged":
+		return ec.fieldContext_MemberStats_totalPRMerged(ctx, field)
+	case "totalIssues":
+		return ec.fieldContext_MemberStats_totalIssues(ctx, field)
+	case "totalReviews":
+		return ec.fieldContext_MemberStats_totalReviews(ctx, field)
+	case "totalAdditions":
+		return ec.fieldContext_MemberStats_totalAdditions(ctx, field)
+	case "totalDeletions":
+		return ec.fieldContext_MemberStats_totalDeletions(ctx, field)
+	case "excludedReviews":
+		return ec.fieldContext_MemberStats_excludedReviews(ctx, field)
+	case "prToReviewRatio":
+		return ec.fieldContext_MemberStats_prToReviewRatio(ctx, field)
+	case "cycleTime":
+		return ec.fieldContext_MemberStats_cycleTime(ctx, field)
+	case "prSize":
+		return ec.fieldContext_MemberStats_prSize(ctx, field)
+	case "issues":
+		return ec.fieldContext_MemberStats_issues(ctx, field)
+	case "categoryBreakdown":
+		return ec.fieldContext_MemberStats_categoryBreakdown(ctx, field)
+	case "complete":
+		return ec.fieldContext_MemberStats_complete(ctx, field)
+	case "dataGaps":
+		return ec.fieldContext_MemberStats_dataGaps(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type MemberStats", field.Name)
+}
+
+func (ec *executionContext) childFields_MetricDeltas(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "commits":
+		return ec.fieldContext_MetricDeltas_commits(ctx, field)
+	case "prCreated":
+		return ec.fieldContext_MetricDeltas_prCreated(ctx, field)
+	case "prMerged":
+		return ec.fieldContext_MetricDeltas_prMerged(ctx, field)
+	case "issues":
+		return ec.fieldContext_MetricDeltas_issues(ctx, field)
+	case "reviews":
+		return ec.fieldContext_MetricDeltas_reviews(ctx, field)
+	case "additions":
+		return ec.fieldContext_MetricDeltas_additions(ctx, field)
+	case "deletions":
+		return ec.fieldContext_MetricDeltas_deletions(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type MetricDeltas", field.Name)
+}
+
+func (ec *executionContext) childFields_PRSizeCount(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "size":
+		return ec.fieldContext_PRSizeCount_size(ctx, field)
+	case "count":
+		return ec.fieldContext_PRSizeCount_count(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type PRSizeCount", field.Name)
+}
+
+func (ec *executionContext) childFields_PRSizeStats(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "prCount":
+		return ec.fieldContext_PRSizeStats_prCount(ctx, field)
+	case "histogram":
+		return ec.fieldContext_PRSizeStats_histogram(ctx, field)
+	case "changedLines":
+		return ec.fieldContext_PRSizeStats_changedLines(ctx, field)
+	case "changedFiles":
+		return ec.fieldContext_PRSizeStats_changedFiles(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type PRSizeStats", field.Name)
+}
+
+func (ec *executionContext) childFields_PendingReview(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "repository":
+		return ec.fieldContext_PendingReview_repository(ctx, field)
+	case "author":
+		return ec.fieldContext_PendingReview_author(ctx, field)
+	case "requestedAt":
+		return ec.fieldContext_PendingReview_requestedAt(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type PendingReview", field.Name)
+}
+
+func (ec *executionContext) childFields_Percentiles(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "count":
+		return ec.fieldContext_Percentiles_count(ctx, field)
+	case "median":
+		return ec.fieldContext_Percentiles_median(ctx, field)
+	case "p90":
+		return ec.fieldContext_Percentiles_p90(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type Percentiles", field.Name)
+}
+
+func (ec *executionContext) childFields_RepositoryActivity(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "repository":
+		return ec.fieldContext_RepositoryActivity_repository(ctx, field)
+	case "commitCount":
+		return ec.fieldContext_RepositoryActivity_commitCount(ctx, field)
+	case "prCount":
+		return ec.fieldContext_RepositoryActivity_prCount(ctx, field)
+	case "issueCount":
+		return ec.fieldContext_RepositoryActivity_issueCount(ctx, field)
+	case "reviewCount":
+		return ec.fieldContext_RepositoryActivity_reviewCount(ctx, field)
+	case "totalAdditions":
+		return ec.fieldContext_RepositoryActivity_totalAdditions(ctx, field)
+	case "totalDeletions":
+		return ec.fieldContext_RepositoryActivity_totalDeletions(ctx, field)
+	case "firstActivity":
+		return ec.fieldContext_RepositoryActivity_firstActivity(ctx, field)
+	case "lastActivity":
+		return ec.fieldContext_RepositoryActivity_lastActivity(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type RepositoryActivity", field.Name)
+}
+
+func (ec *executionContext) childFields_RepositoryContributor(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "login":
+		return ec.fieldContext_RepositoryContributor_login(ctx, field)
+	case "commitCount":
+		return ec.fieldContext_RepositoryContributor_commitCount(ctx, field)
+	case "prCreated":
+		return ec.fieldContext_RepositoryContributor_prCreated(ctx, field)
+	case "reviewCount":
+		return ec.fieldContext_RepositoryContributor_reviewCount(ctx, field)
+	case "additions":
+		return ec.fieldContext_RepositoryContributor_additions(ctx, field)
+	case "deletions":
+		return ec.fieldContext_RepositoryContributor_deletions(ctx, field)
+	case "dailyStats":
+		return ec.fieldContext_RepositoryContributor_dailyStats(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type RepositoryContributor", field.Name)
+}
+
+func (ec *executionContext) childFields_RepositoryDailyStats(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "nameWithOwner":
+		return ec.fieldContext_RepositoryDailyStats_nameWithOwner(ctx, field)
+	case "owner":
+		return ec.fieldContext_RepositoryDailyStats_owner(ctx, field)
+	case "ownerType":
+		return ec.fieldContext_RepositoryDailyStats_ownerType(ctx, field)
+	case "dailyStats":
+		return ec.fieldContext_RepositoryDailyStats_dailyStats(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type RepositoryDailyStats", field.Name)
+}
+
+func (ec *executionContext) childFields_RepositoryDelta(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "nameWithOwner":
+		return ec.fieldContext_RepositoryDelta_nameWithOwner(ctx, field)
+	case "delta":
+		return ec.fieldContext_RepositoryDelta_delta(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type RepositoryDelta", field.Name)
+}
+
+func (ec *executionContext) childFields_RepositoryStats(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "nameWithOwner":
+		return ec.fieldContext_RepositoryStats_nameWithOwner(ctx, field)
+	case "total":
+		return ec.fieldContext_RepositoryStats_total(ctx, field)
+	case "contributorCount":
+		return ec.fieldContext_RepositoryStats_contributorCount(ctx, field)
+	case "contributors":
+		return ec.fieldContext_RepositoryStats_contributors(ctx, field)
+	case "cycleTime":
+		return ec.fieldContext_RepositoryStats_cycleTime(ctx, field)
+	case "prSize":
+		return ec.fieldContext_RepositoryStats_prSize(ctx, field)
+	case "issues":
+		return ec.fieldContext_RepositoryStats_issues(ctx, field)
+	case "categoryBreakdown":
+		return ec.fieldContext_RepositoryStats_categoryBreakdown(ctx, field)
+	case "openIssueCount":
+		return ec.fieldContext_RepositoryStats_openIssueCount(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type RepositoryStats", field.Name)
+}
+
+func (ec *executionContext) childFields_RepositoryTotals(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "commits":
+		return ec.fieldContext_RepositoryTotals_commits(ctx, field)
+	case "prCreated":
+		return ec.fieldContext_RepositoryTotals_prCreated(ctx, field)
+	case "prMerged":
+		return ec.fieldContext_RepositoryTotals_prMerged(ctx, field)
+	case "issues":
+		return ec.fieldContext_RepositoryTotals_issues(ctx, field)
+	case "reviews":
+		return ec.fieldContext_RepositoryTotals_reviews(ctx, field)
+	case "additions":
+		return ec.fieldContext_RepositoryTotals_additions(ctx, field)
+	case "deletions":
+		return ec.fieldContext_RepositoryTotals_deletions(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type RepositoryTotals", field.Name)
+}
+
+func (ec *executionContext) childFields_ReviewLoad(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "reviewer":
+		return ec.fieldContext_ReviewLoad_reviewer(ctx, field)
+	case "requested":
+		return ec.fieldContext_ReviewLoad_requested(ctx, field)
+	case "completed":
+		return ec.fieldContext_ReviewLoad_completed(ctx, field)
+	case "pending":
+		return ec.fieldContext_ReviewLoad_pending(ctx, field)
+	case "pendingReviews":
+		return ec.fieldContext_ReviewLoad_pendingReviews(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type ReviewLoad", field.Name)
+}
+
+func (ec *executionContext) childFields_ReviewNetwork(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "nodes":
+		return ec.fieldContext_ReviewNetwork_nodes(ctx, field)
+	case "edges":
+		return ec.fieldContext_ReviewNetwork_edges(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type ReviewNetwork", field.Name)
+}
+
+func (ec *executionContext) childFields_ReviewNetworkEdge(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "reviewer":
+		return ec.fieldContext_ReviewNetworkEdge_reviewer(ctx, field)
+	case "author":
+		return ec.fieldContext_ReviewNetworkEdge_author(ctx, field)
+	case "reviewCount":
+		return ec.fieldContext_ReviewNetworkEdge_reviewCount(ctx, field)
+	case "repositories":
+		return ec.fieldContext_ReviewNetworkEdge_repositories(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type ReviewNetworkEdge", field.Name)
+}
+
+func (ec *executionContext) childFields_ReviewNetworkNode(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "login":
+		return ec.fieldContext_ReviewNetworkNode_login(ctx, field)
+	case "reviewsGiven":
+		return ec.fieldContext_ReviewNetworkNode_reviewsGiven(ctx, field)
+	case "reviewsReceived":
+		return ec.fieldContext_ReviewNetworkNode_reviewsReceived(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type ReviewNetworkNode", field.Name)
+}
+
+func (ec *executionContext) childFields_RoleTransitionPoint(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "year":
+		return ec.fieldContext_RoleTransitionPoint_year(ctx, field)
+	case "prCreated":
+		return ec.fieldContext_RoleTransitionPoint_prCreated(ctx, field)
+	case "reviewCount":
+		return ec.fieldContext_RoleTransitionPoint_reviewCount(ctx, field)
+	case "ratio":
+		return ec.fieldContext_RoleTransitionPoint_ratio(ctx, field)
+	case "description":
+		return ec.fieldContext_RoleTransitionPoint_description(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type RoleTransitionPoint", field.Name)
+}
+
+func (ec *executionContext) childFields_Snapshot(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "id":
+		return ec.fieldContext_Snapshot_id(ctx, field)
+	case "capturedAt":
+		return ec.fieldContext_Snapshot_capturedAt(ctx, field)
+	case "tag":
+		return ec.fieldContext_Snapshot_tag(ctx, field)
+	case "memberCount":
+		return ec.fieldContext_Snapshot_memberCount(ctx, field)
+	case "repositoryCount":
+		return ec.fieldContext_Snapshot_repositoryCount(ctx, field)
+	case "excludedMembers":
+		return ec.fieldContext_Snapshot_excludedMembers(ctx, field)
+	case "period":
+		return ec.fieldContext_Snapshot_period(ctx, field)
+	case "members":
+		return ec.fieldContext_Snapshot_members(ctx, field)
+	case "teamSummary":
+		return ec.fieldContext_Snapshot_teamSummary(ctx, field)
+	case "repositories":
+		return ec.fieldContext_Snapshot_repositories(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type Snapshot", field.Name)
+}
+
+func (ec *executionContext) childFields_SnapshotDiff(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "base":
+		return ec.fieldContext_SnapshotDiff_base(ctx, field)
+	case "head":
+		return ec.fieldContext_SnapshotDiff_head(ctx, field)
+	case "addedMembers":
+		return ec.fieldContext_SnapshotDiff_addedMembers(ctx, field)
+	case "removedMembers":
+		return ec.fieldContext_SnapshotDiff_removedMembers(ctx, field)
+	case "addedRepositories":
+		return ec.fieldContext_SnapshotDiff_addedRepositories(ctx, field)
+	case "removedRepositories":
+		return ec.fieldContext_SnapshotDiff_removedRepositories(ctx, field)
+	case "memberDeltas":
+		return ec.fieldContext_SnapshotDiff_memberDeltas(ctx, field)
+	case "repositoryDeltas":
+		return ec.fieldContext_SnapshotDiff_repositoryDeltas(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type SnapshotDiff", field.Name)
+}
+
+func (ec *executionContext) childFields_SnapshotInfo(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "id":
+		return ec.fieldContext_SnapshotInfo_id(ctx, field)
+	case "capturedAt":
+		return ec.fieldContext_SnapshotInfo_capturedAt(ctx, field)
+	case "tag":
+		return ec.fieldContext_SnapshotInfo_tag(ctx, field)
+	case "memberCount":
+		return ec.fieldContext_SnapshotInfo_memberCount(ctx, field)
+	case "repositoryCount":
+		return ec.fieldContext_SnapshotInfo_repositoryCount(ctx, field)
+	case "excludedMembers":
+		return ec.fieldContext_SnapshotInfo_excludedMembers(ctx, field)
+	case "period":
+		return ec.fieldContext_SnapshotInfo_period(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type SnapshotInfo", field.Name)
+}
+
+func (ec *executionContext) childFields_TeamSummary(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "memberCount":
+		return ec.fieldContext_TeamSummary_memberCount(ctx, field)
+	case "repositoryCount":
+		return ec.fieldContext_TeamSummary_repositoryCount(ctx, field)
+	case "totalCommits":
+		return ec.fieldContext_TeamSummary_totalCommits(ctx, field)
+	case "totalPRCreated":
+		return ec.fieldContext_TeamSummary_totalPRCreated(ctx, field)
+	case "totalPRMerged":
+		return ec.fieldContext_TeamSummary_totalPRMerged(ctx, field)
+	case "totalIssues":
+		return ec.fieldContext_TeamSummary_totalIssues(ctx, field)
+	case "totalReviews":
+		return ec.fieldContext_TeamSummary_totalReviews(ctx, field)
+	case "totalAdditions":
+		return ec.fieldContext_TeamSummary_totalAdditions(ctx, field)
+	case "totalDeletions":
+		return ec.fieldContext_TeamSummary_totalDeletions(ctx, field)
+	case "issues":
+		return ec.fieldContext_TeamSummary_issues(ctx, field)
+	case "categoryBreakdown":
+		return ec.fieldContext_TeamSummary_categoryBreakdown(ctx, field)
+	case "openIssueCount":
+		return ec.fieldContext_TeamSummary_openIssueCount(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type TeamSummary", field.Name)
+}
+
+func (ec *executionContext) childFields_UserStatistics(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "login":
+		return ec.fieldContext_UserStatistics_login(ctx, field)
+	case "name":
+		return ec.fieldContext_UserStatistics_name(ctx, field)
+	case "logins":
+		return ec.fieldContext_UserStatistics_logins(ctx, field)
+	case "totalCommits":
+		return ec.fieldContext_UserStatistics_totalCommits(ctx, field)
+	case "totalPRCreated":
+		return ec.fieldContext_UserStatistics_totalPRCreated(ctx, field)
+	case "totalPRMerged":
+		return ec.fieldContext_UserStatistics_totalPRMerged(ctx, field)
+	case "totalIssues":
+		return ec.fieldContext_UserStatistics_totalIssues(ctx, field)
+	case "totalReviews":
+		return ec.fieldContext_UserStatistics_totalReviews(ctx, field)
+	case "totalAdditions":
+		return ec.fieldContext_UserStatistics_totalAdditions(ctx, field)
+	case "totalDeletions":
+		return ec.fieldContext_UserStatistics_totalDeletions(ctx, field)
+	case "excludedReviews":
+		return ec.fieldContext_UserStatistics_excludedReviews(ctx, field)
+	case "prToReviewRatio":
+		return ec.fieldContext_UserStatistics_prToReviewRatio(ctx, field)
+	case "firstActivityYear":
+		return ec.fieldContext_UserStatistics_firstActivityYear(ctx, field)
+	case "peakActivityYear":
+		return ec.fieldContext_UserStatistics_peakActivityYear(ctx, field)
+	case "peakActivityCommits":
+		return ec.fieldContext_UserStatistics_peakActivityCommits(ctx, field)
+	case "yearlyStats":
+		return ec.fieldContext_UserStatistics_yearlyStats(ctx, field)
+	case "dailyStats":
+		return ec.fieldContext_UserStatistics_dailyStats(ctx, field)
+	case "topRepositories":
+		return ec.fieldContext_UserStatistics_topRepositories(ctx, field)
+	case "longTermRepositories":
+		return ec.fieldContext_UserStatistics_longTermRepositories(ctx, field)
+	case "roleTransition":
+		return ec.fieldContext_UserStatistics_roleTransition(ctx, field)
+	case "cycleTime":
+		return ec.fieldContext_UserStatistics_cycleTime(ctx, field)
+	case "prSize":
+		return ec.fieldContext_UserStatistics_prSize(ctx, field)
+	case "issues":
+		return ec.fieldContext_UserStatistics_issues(ctx, field)
+	case "categoryBreakdown":
+		return ec.fieldContext_UserStatistics_categoryBreakdown(ctx, field)
+	case "complete":
+		return ec.fieldContext_UserStatistics_complete(ctx, field)
+	case "dataGaps":
+		return ec.fieldContext_UserStatistics_dataGaps(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type UserStatistics", field.Name)
+}
+
+func (ec *executionContext) childFields_YearlyStatistics(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "year":
+		return ec.fieldContext_YearlyStatistics_year(ctx, field)
+	case "commitCount":
+		return ec.fieldContext_YearlyStatistics_commitCount(ctx, field)
+	case "prCreated":
+		return ec.fieldContext_YearlyStatistics_prCreated(ctx, field)
+	case "prMerged":
+		return ec.fieldContext_YearlyStatistics_prMerged(ctx, field)
+	case "issueCount":
+		return ec.fieldContext_YearlyStatistics_issueCount(ctx, field)
+	case "reviewCount":
+		return ec.fieldContext_YearlyStatistics_reviewCount(ctx, field)
+	case "totalAdditions":
+		return ec.fieldContext_YearlyStatistics_totalAdditions(ctx, field)
+	case "totalDeletions":
+		return ec.fieldContext_YearlyStatistics_totalDeletions(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type YearlyStatistics", field.Name)
+}
+
+func (ec *executionContext) childFields___Directive(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "name":
+		return ec.fieldContext___Directive_name(ctx, field)
+	case "description":
+		return ec.fieldContext___Directive_description(ctx, field)
+	case "isRepeatable":
+		return ec.fieldContext___Directive_isRepeatable(ctx, field)
+	case "locations":
+		return ec.fieldContext___Directive_locations(ctx, field)
+	case "args":
+		return ec.fieldContext___Directive_args(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
+}
+
+func (ec *executionContext) childFields___EnumValue(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "name":
+		return ec.fieldContext___EnumValue_name(ctx, field)
+	case "description":
+		return ec.fieldContext___EnumValue_description(ctx, field)
+	case "isDeprecated":
+		return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+	case "deprecationReason":
+		return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
+}
+
+func (ec *executionContext) childFields___Field(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "name":
+		return ec.fieldContext___Field_name(ctx, field)
+	case "description":
+		return ec.fieldContext___Field_description(ctx, field)
+	case "args":
+		return ec.fieldContext___Field_args(ctx, field)
+	case "type":
+		return ec.fieldContext___Field_type(ctx, field)
+	case "isDeprecated":
+		return ec.fieldContext___Field_isDeprecated(ctx, field)
+	case "deprecationReason":
+		return ec.fieldContext___Field_deprecationReason(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
+}
+
+func (ec *executionContext) childFields___InputValue(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "name":
+		return ec.fieldContext___InputValue_name(ctx, field)
+	case "description":
+		return ec.fieldContext___InputValue_description(ctx, field)
+	case "type":
+		return ec.fieldContext___InputValue_type(ctx, field)
+	case "defaultValue":
+		return ec.fieldContext___InputValue_defaultValue(ctx, field)
+	case "isDeprecated":
+		return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+	case "deprecationReason":
+		return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+}
+
+func (ec *executionContext) childFields___Schema(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "description":
+		return ec.fieldContext___Schema_description(ctx, field)
+	case "types":
+		return ec.fieldContext___Schema_types(ctx, field)
+	case "queryType":
+		return ec.fieldContext___Schema_queryType(ctx, field)
+	case "mutationType":
+		return ec.fieldContext___Schema_mutationType(ctx, field)
+	case "subscriptionType":
+		return ec.fieldContext___Schema_subscriptionType(ctx, field)
+	case "directives":
+		return ec.fieldContext___Schema_directives(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+}
+
+func (ec *executionContext) childFields___Type(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "kind":
+		return ec.fieldContext___Type_kind(ctx, field)
+	case "name":
+		return ec.fieldContext___Type_name(ctx, field)
+	case "description":
+		return ec.fieldContext___Type_description(ctx, field)
+	case "specifiedByURL":
+		return ec.fieldContext___Type_specifiedByURL(ctx, field)
+	case "fields":
+		return ec.fieldContext___Type_fields(ctx, field)
+	case "interfaces":
+		return ec.fieldContext___Type_interfaces(ctx, field)
+	case "possibleTypes":
+		return ec.fieldContext___Type_possibleTypes(ctx, field)
+	case "enumValues":
+		return ec.fieldContext___Type_enumValues(ctx, field)
+	case "inputFields":
+		return ec.fieldContext___Type_inputFields(ctx, field)
+	case "ofType":
+		return ec.fieldContext___Type_ofType(ctx, field)
+	case "isOneOf":
+		return ec.fieldContext___Type_isOneOf(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+}
+
+// endregion ************************** internal!.gotpl ***************************
+
+// region    ***************************** args.gotpl *****************************
+
+func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "name",
+		func(ctx context.Context, v any) (string, error) {
+			return ec.unmarshalNString2string(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["name"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_activityHeatmap_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "login",
+		func(ctx context.Context, v any) (string, error) {
+			return ec.unmarshalNString2string(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["login"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "from",
+		func(ctx context.Context, v any) (*string, error) {
+			return ec.unmarshalOString2ᚖstring(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["from"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "to",
+		func(ctx context.Context, v any) (*string, error) {
+			return ec.unmarshalOString2ᚖstring(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["to"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_deliveryMetrics_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "repository",
+		func(ctx context.Context, v any) (string, error) {
+			return ec.unmarshalNString2string(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["repository"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "from",
+		func(ctx context.Context, v any) (*string, error) {
+			return ec.unmarshalOString2ᚖstring(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["from"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "to",
+		func(ctx context.Context, v any) (*string, error) {
+			return ec.unmarshalOString2ᚖstring(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["to"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_memberHistory_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "login",
+		func(ctx context.Context, v any) (string, error) {
+			return ec.unmarshalNString2string(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["login"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_member_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "login",
+		func(ctx context.Context, v any) (string, error) {
+			return ec.unmarshalNString2string(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["login"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "from",
+		func(ctx context.Context, v any) (*string, error) {
+			return ec.unmarshalOString2ᚖstring(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["from"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "to",
+		func(ctx context.Context, v any) (*string, error) {
+			return ec.unmarshalOString2ᚖstring(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["to"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "granularity",
+		func(ctx context.Context, v any) (*model.Granularity, error) {
+			return ec.unmarshalOGranularity2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐGranularity(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["granularity"] = arg3
+	arg4, err := graphql.ProcessArgField(ctx, rawArgs, "snapshotId",
+		func(ctx context.Context, v any) (*string, error) {
+			return ec.unmarshalOID2ᚖstring(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["snapshotId"] = arg4
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_repositoryDailyStats_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "from",
+		func(ctx context.Context, v any) (*string, error) {
+			return ec.unmarshalOString2ᚖstring(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["from"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "to",
+		func(ctx context.Context, v any) (*string, error) {
+			return ec.unmarshalOString2ᚖstring(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["to"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "granularity",
+		func(ctx context.Context, v any) (*model.Granularity, error) {
+			return ec.unmarshalOGranularity2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐGranularity(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["granularity"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "snapshotId",
+		func(ctx context.Context, v any) (*string, error) {
+			return ec.unmarshalOID2ᚖstring(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["snapshotId"] = arg3
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_repository_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "nameWithOwner",
+		func(ctx context.Context, v any) (string, error) {
+			return ec.unmarshalNString2string(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["nameWithOwner"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "from",
+		func(ctx context.Context, v any) (*string, error) {
+			return ec.unmarshalOString2ᚖstring(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["from"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "to",
+		func(ctx context.Context, v any) (*string, error) {
+			return ec.unmarshalOString2ᚖstring(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["to"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "granularity",
+		func(ctx context.Context, v any) (*model.Granularity, error) {
+			return ec.unmarshalOGranularity2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐGranularity(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["granularity"] = arg3
+	arg4, err := graphql.ProcessArgField(ctx, rawArgs, "snapshotId",
+		func(ctx context.Context, v any) (*string, error) {
+			return ec.unmarshalOID2ᚖstring(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["snapshotId"] = arg4
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_reviewNetwork_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "from",
+		func(ctx context.Context, v any) (*string, error) {
+			return ec.unmarshalOString2ᚖstring(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["from"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "to",
+		func(ctx context.Context, v any) (*string, error) {
+			return ec.unmarshalOString2ᚖstring(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["to"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_snapshotDiff_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "base",
+		func(ctx context.Context, v any) (string, error) {
+			return ec.unmarshalNID2string(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["base"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "head",
+		func(ctx context.Context, v any) (string, error) {
+			return ec.unmarshalNID2string(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["head"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_snapshot_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id",
+		func(ctx context.Context, v any) (string, error) {
+			return ec.unmarshalNID2string(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_teamDailyStats_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "from",
+		func(ctx context.Context, v any) (*string, error) {
+			return ec.unmarshalOString2ᚖstring(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["from"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "to",
+		func(ctx context.Context, v any) (*string, error) {
+			return ec.unmarshalOString2ᚖstring(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["to"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "granularity",
+		func(ctx context.Context, v any) (*model.Granularity, error) {
+			return ec.unmarshalOGranularity2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐGranularity(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["granularity"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "snapshotId",
+		func(ctx context.Context, v any) (*string, error) {
+			return ec.unmarshalOID2ᚖstring(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["snapshotId"] = arg3
+	return args, nil
+}
+
+func (ec *executionContext) field___Directive_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated",
+		func(ctx context.Context, v any) (*bool, error) {
+			return ec.unmarshalOBoolean2ᚖbool(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Field_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated",
+		func(ctx context.Context, v any) (*bool, error) {
+			return ec.unmarshalOBoolean2ᚖbool(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated",
+		func(ctx context.Context, v any) (bool, error) {
+			return ec.unmarshalOBoolean2bool(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated",
+		func(ctx context.Context, v any) (bool, error) {
+			return ec.unmarshalOBoolean2bool(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+// endregion ***************************** args.gotpl *****************************
+
+// region    **************************** field.gotpl *****************************
+
+func (ec *executionContext) _ActivityHeatmap_login(ctx context.Context, field graphql.CollectedField, obj *model.ActivityHeatmap) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_ActivityHeatmap_login(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Login, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_ActivityHeatmap_login(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("ActivityHeatmap", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _ActivityHeatmap_timeZone(ctx context.Context, field graphql.CollectedField, obj *model.ActivityHeatmap) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_ActivityHeatmap_timeZone(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.TimeZone, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_ActivityHeatmap_timeZone(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("ActivityHeatmap", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _ActivityHeatmap_total(ctx context.Context, field graphql.CollectedField, obj *model.ActivityHeatmap) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_ActivityHeatmap_total(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Total, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_ActivityHeatmap_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("ActivityHeatmap", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _ActivityHeatmap_offHours(ctx context.Context, field graphql.CollectedField, obj *model.ActivityHeatmap) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_ActivityHeatmap_offHours(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.OffHours, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_ActivityHeatmap_offHours(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("ActivityHeatmap", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _ActivityHeatmap_weekend(ctx context.Context, field graphql.CollectedField, obj *model.ActivityHeatmap) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_ActivityHeatmap_weekend(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Weekend, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_ActivityHeatmap_weekend(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("ActivityHeatmap", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _ActivityHeatmap_offHoursRatio(ctx context.Context, field graphql.CollectedField, obj *model.ActivityHeatmap) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_ActivityHeatmap_offHoursRatio(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.OffHoursRatio, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v float64) graphql.Marshaler {
+			return ec.marshalNFloat2float64(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_ActivityHeatmap_offHoursRatio(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("ActivityHeatmap", field, false, false, errors.New("field of type Float does not have child fields"))
+}
+
+func (ec *executionContext) _ActivityHeatmap_rows(ctx context.Context, field graphql.CollectedField, obj *model.ActivityHeatmap) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_ActivityHeatmap_rows(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Rows, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*model.HeatmapRow) graphql.Marshaler {
+			return ec.marshalNHeatmapRow2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐHeatmapRowᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_ActivityHeatmap_rows(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ActivityHeatmap",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_HeatmapRow(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CategoryCount_category(ctx context.Context, field graphql.CollectedField, obj *model.CategoryCount) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_CategoryCount_category(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Category, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v model.WorkCategory) graphql.Marshaler {
+			return ec.marshalNWorkCategory2githubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐWorkCategory(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_CategoryCount_category(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("CategoryCount", field, false, false, errors.New("field of type WorkCategory does not have child fields"))
+}
+
+func (ec *executionContext) _CategoryCount_count(ctx context.Context, field graphql.CollectedField, obj *model.CategoryCount) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_CategoryCount_count(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Count, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_CategoryCount_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("CategoryCount", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _CollectionPeriod_since(ctx context.Context, field graphql.CollectedField, obj *model.CollectionPeriod) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_CollectionPeriod_since(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Since, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *string) graphql.Marshaler {
+			return ec.marshalOString2ᚖstring(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_CollectionPeriod_since(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("CollectionPeriod", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _CollectionPeriod_until(ctx context.Context, field graphql.CollectedField, obj *model.CollectionPeriod) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_CollectionPeriod_until(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Until, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *string) graphql.Marshaler {
+			return ec.marshalOString2ᚖstring(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_CollectionPeriod_until(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("CollectionPeriod", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _CycleTimeStats_prCount(ctx context.Context, field graphql.CollectedField, obj *model.CycleTimeStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_CycleTimeStats_prCount(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.PrCount, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_CycleTimeStats_prCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("CycleTimeStats", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _CycleTimeStats_timeToFirstReviewHours(ctx context.Context, field graphql.CollectedField, obj *model.CycleTimeStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_CycleTimeStats_timeToFirstReviewHours(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.TimeToFirstReviewHours, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *model.Percentiles) graphql.Marshaler {
+			return ec.marshalNPercentiles2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐPercentiles(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_CycleTimeStats_timeToFirstReviewHours(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CycleTimeStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_Percentiles(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CycleTimeStats_timeToApprovalHours(ctx context.Context, field graphql.CollectedField, obj *model.CycleTimeStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_CycleTimeStats_timeToApprovalHours(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.TimeToApprovalHours, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *model.Percentiles) graphql.Marshaler {
+			return ec.marshalNPercentiles2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐPercentiles(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_CycleTimeStats_timeToApprovalHours(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CycleTimeStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_Percentiles(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CycleTimeStats_timeToMergeHours(ctx context.Context, field graphql.CollectedField, obj *model.CycleTimeStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_CycleTimeStats_timeToMergeHours(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.TimeToMergeHours, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *model.Percentiles) graphql.Marshaler {
+			return ec.marshalNPercentiles2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐPercentiles(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_CycleTimeStats_timeToMergeHours(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CycleTimeStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_Percentiles(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CycleTimeStats_timeToCloseHours(ctx context.Context, field graphql.CollectedField, obj *model.CycleTimeStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_CycleTimeStats_timeToCloseHours(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.TimeToCloseHours, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *model.Percentiles) graphql.Marshaler {
+			return ec.marshalNPercentiles2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐPercentiles(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_CycleTimeStats_timeToCloseHours(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CycleTimeStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_Percentiles(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CycleTimeStats_reviewRounds(ctx context.Context, field graphql.CollectedField, obj *model.CycleTimeStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_CycleTimeStats_reviewRounds(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.ReviewRounds, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *model.Percentiles) graphql.Marshaler {
+			return ec.marshalNPercentiles2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐPercentiles(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_CycleTimeStats_reviewRounds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CycleTimeStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_Percentiles(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DailyStatistics_date(ctx context.Context, field graphql.CollectedField, obj *model.DailyStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_DailyStatistics_date(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Date, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_DailyStatistics_date(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("DailyStatistics", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _DailyStatistics_commitCount(ctx context.Context, field graphql.CollectedField, obj *model.DailyStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_DailyStatistics_commitCount(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.CommitCount, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_DailyStatistics_commitCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("DailyStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _DailyStatistics_prCreated(ctx context.Context, field graphql.CollectedField, obj *model.DailyStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_DailyStatistics_prCreated(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.PrCreated, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_DailyStatistics_prCreated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("DailyStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _DailyStatistics_prMerged(ctx context.Context, field graphql.CollectedField, obj *model.DailyStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_DailyStatistics_prMerged(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.PrMerged, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_DailyStatistics_prMerged(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("DailyStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _DailyStatistics_issueCount(ctx context.Context, field graphql.CollectedField, obj *model.DailyStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_DailyStatistics_issueCount(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.IssueCount, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_DailyStatistics_issueCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("DailyStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _DailyStatistics_reviewCount(ctx context.Context, field graphql.CollectedField, obj *model.DailyStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_DailyStatistics_reviewCount(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.ReviewCount, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_DailyStatistics_reviewCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("DailyStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _DailyStatistics_totalAdditions(ctx context.Context, field graphql.CollectedField, obj *model.DailyStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_DailyStatistics_totalAdditions(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.TotalAdditions, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_DailyStatistics_totalAdditions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("DailyStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _DailyStatistics_totalDeletions(ctx context.Context, field graphql.CollectedField, obj *model.DailyStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_DailyStatistics_totalDeletions(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.TotalDeletions, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_DailyStatistics_totalDeletions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("DailyStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _DailyStatistics_issues(ctx context.Context, field graphql.CollectedField, obj *model.DailyStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_DailyStatistics_issues(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Issues, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *model.IssueStats) graphql.Marshaler {
+			return ec.marshalNIssueStats2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐIssueStats(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_DailyStatistics_issues(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DailyStatistics",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_IssueStats(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DailyStatistics_categoryBreakdown(ctx context.Context, field graphql.CollectedField, obj *model.DailyStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_DailyStatistics_categoryBreakdown(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.CategoryBreakdown, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*model.CategoryCount) graphql.Marshaler {
+			return ec.marshalNCategoryCount2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐCategoryCountᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_DailyStatistics_categoryBreakdown(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DailyStatistics",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_CategoryCount(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DailyStatistics_openIssues(ctx context.Context, field graphql.CollectedField, obj *model.DailyStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_DailyStatistics_openIssues(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.OpenIssues, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *int) graphql.Marshaler {
+			return ec.marshalOInt2ᚖint(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_DailyStatistics_openIssues(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("DailyStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _DataGap_activityType(ctx context.Context, field graphql.CollectedField, obj *model.DataGap) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_DataGap_activityType(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.ActivityType, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_DataGap_activityType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("DataGap", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _DataGap_repository(ctx context.Context, field graphql.CollectedField, obj *model.DataGap) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_DataGap_repository(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Repository, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_DataGap_repository(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("DataGap", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _DataGap_reason(ctx context.Context, field graphql.CollectedField, obj *model.DataGap) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_DataGap_reason(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Reason, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_DataGap_reason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("DataGap", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _DataGap_message(ctx context.Context, field graphql.CollectedField, obj *model.DataGap) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_DataGap_message(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Message, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_DataGap_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("DataGap", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _DeliveryMetrics_repository(ctx context.Context, field graphql.CollectedField, obj *model.DeliveryMetrics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_DeliveryMetrics_repository(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Repository, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_DeliveryMetrics_repository(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("DeliveryMetrics", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _DeliveryMetrics_source(ctx context.Context, field graphql.CollectedField, obj *model.DeliveryMetrics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_DeliveryMetrics_source(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Source, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *model.DeliverySource) graphql.Marshaler {
+			return ec.marshalODeliverySource2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐDeliverySource(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_DeliveryMetrics_source(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("DeliveryMetrics", field, false, false, errors.New("field of type DeliverySource does not have child fields"))
+}
+
+func (ec *executionContext) _DeliveryMetrics_deploymentCount(ctx context.Context, field graphql.CollectedField, obj *model.DeliveryMetrics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_DeliveryMetrics_deploymentCount(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.DeploymentCount, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_DeliveryMetrics_deploymentCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("DeliveryMetrics", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _DeliveryMetrics_failedDeploymentCount(ctx context.Context, field graphql.CollectedField, obj *model.DeliveryMetrics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_DeliveryMetrics_failedDeploymentCount(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.FailedDeploymentCount, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_DeliveryMetrics_failedDeploymentCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("DeliveryMetrics", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _DeliveryMetrics_weekCount(ctx context.Context, field graphql.CollectedField, obj *model.DeliveryMetrics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_DeliveryMetrics_weekCount(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.WeekCount, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_DeliveryMetrics_weekCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("DeliveryMetrics", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _DeliveryMetrics_deploymentsPerWeek(ctx context.Context, field graphql.CollectedField, obj *model.DeliveryMetrics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_DeliveryMetrics_deploymentsPerWeek(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.DeploymentsPerWeek, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v float64) graphql.Marshaler {
+			return ec.marshalNFloat2float64(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_DeliveryMetrics_deploymentsPerWeek(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("DeliveryMetrics", field, false, false, errors.New("field of type Float does not have child fields"))
+}
+
+func (ec *executionContext) _DeliveryMetrics_leadTimeHours(ctx context.Context, field graphql.CollectedField, obj *model.DeliveryMetrics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_DeliveryMetrics_leadTimeHours(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.LeadTimeHours, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *float64) graphql.Marshaler {
+			return ec.marshalOFloat2ᚖfloat64(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_DeliveryMetrics_leadTimeHours(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("DeliveryMetrics", field, false, false, errors.New("field of type Float does not have child fields"))
+}
+
+func (ec *executionContext) _DeliveryMetrics_changeFailureRate(ctx context.Context, field graphql.CollectedField, obj *model.DeliveryMetrics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_DeliveryMetrics_changeFailureRate(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.ChangeFailureRate, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *float64) graphql.Marshaler {
+			return ec.marshalOFloat2ᚖfloat64(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_DeliveryMetrics_changeFailureRate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("DeliveryMetrics", field, false, false, errors.New("field of type Float does not have child fields"))
+}
+
+func (ec *executionContext) _DeliveryMetrics_timeToRestoreHours(ctx context.Context, field graphql.CollectedField, obj *model.DeliveryMetrics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_DeliveryMetrics_timeToRestoreHours(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.TimeToRestoreHours, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *float64) graphql.Marshaler {
+			return ec.marshalOFloat2ᚖfloat64(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_DeliveryMetrics_timeToRestoreHours(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("DeliveryMetrics", field, false, false, errors.New("field of type Float does not have child fields"))
+}
+
+func (ec *executionContext) _DeliveryMetrics_weeks(ctx context.Context, field graphql.CollectedField, obj *model.DeliveryMetrics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_DeliveryMetrics_weeks(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Weeks, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*model.DeliveryWeek) graphql.Marshaler {
+			return ec.marshalNDeliveryWeek2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐDeliveryWeekᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_DeliveryMetrics_weeks(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DeliveryMetrics",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_DeliveryWeek(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DeliveryWeek_week(ctx context.Context, field graphql.CollectedField, obj *model.DeliveryWeek) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_DeliveryWeek_week(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Week, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_DeliveryWeek_week(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("DeliveryWeek", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _DeliveryWeek_source(ctx context.Context, field graphql.CollectedField, obj *model.DeliveryWeek) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_DeliveryWeek_source(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Source, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v model.DeliverySource) graphql.Marshaler {
+			return ec.marshalNDeliverySource2githubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐDeliverySource(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_DeliveryWeek_source(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("DeliveryWeek", field, false, false, errors.New("field of type DeliverySource does not have child fields"))
+}
+
+func (ec *executionContext) _DeliveryWeek_deploymentCount(ctx context.Context, field graphql.CollectedField, obj *model.DeliveryWeek) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_DeliveryWeek_deploymentCount(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.DeploymentCount, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_DeliveryWeek_deploymentCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("DeliveryWeek", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _DeliveryWeek_failedDeploymentCount(ctx context.Context, field graphql.CollectedField, obj *model.DeliveryWeek) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_DeliveryWeek_failedDeploymentCount(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.FailedDeploymentCount, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_DeliveryWeek_failedDeploymentCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("DeliveryWeek", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _DeliveryWeek_leadTimeSeconds(ctx context.Context, field graphql.CollectedField, obj *model.DeliveryWeek) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_DeliveryWeek_leadTimeSeconds(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.LeadTimeSeconds, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_DeliveryWeek_leadTimeSeconds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("DeliveryWeek", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _DeliveryWeek_leadTimeCount(ctx context.Context, field graphql.CollectedField, obj *model.DeliveryWeek) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_DeliveryWeek_leadTimeCount(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.LeadTimeCount, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_DeliveryWeek_leadTimeCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("DeliveryWeek", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _DeliveryWeek_restoreSeconds(ctx context.Context, field graphql.CollectedField, obj *model.DeliveryWeek) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_DeliveryWeek_restoreSeconds(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.RestoreSeconds, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_DeliveryWeek_restoreSeconds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("DeliveryWeek", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _DeliveryWeek_restoreCount(ctx context.Context, field graphql.CollectedField, obj *model.DeliveryWeek) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_DeliveryWeek_restoreCount(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.RestoreCount, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_DeliveryWeek_restoreCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("DeliveryWeek", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _ExcludedMember_login(ctx context.Context, field graphql.CollectedField, obj *model.ExcludedMember) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_ExcludedMember_login(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Login, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_ExcludedMember_login(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("ExcludedMember", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _ExcludedMember_rule(ctx context.Context, field graphql.CollectedField, obj *model.ExcludedMember) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_ExcludedMember_rule(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Rule, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_ExcludedMember_rule(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("ExcludedMember", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _HeatmapRow_weekday(ctx context.Context, field graphql.CollectedField, obj *model.HeatmapRow) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_HeatmapRow_weekday(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Weekday, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v model.Weekday) graphql.Marshaler {
+			return ec.marshalNWeekday2githubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐWeekday(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_HeatmapRow_weekday(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("HeatmapRow", field, false, false, errors.New("field of type Weekday does not have child fields"))
+}
+
+func (ec *executionContext) _HeatmapRow_counts(ctx context.Context, field graphql.CollectedField, obj *model.HeatmapRow) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_HeatmapRow_counts(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Counts, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []int) graphql.Marshaler {
+			return ec.marshalNInt2ᚕintᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_HeatmapRow_counts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("HeatmapRow", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _IssueStats_closed(ctx context.Context, field graphql.CollectedField, obj *model.IssueStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_IssueStats_closed(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Closed, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_IssueStats_closed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("IssueStats", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _IssueStats_timeToCloseHours(ctx context.Context, field graphql.CollectedField, obj *model.IssueStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_IssueStats_timeToCloseHours(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.TimeToCloseHours, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *float64) graphql.Marshaler {
+			return ec.marshalOFloat2ᚖfloat64(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_IssueStats_timeToCloseHours(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("IssueStats", field, false, false, errors.New("field of type Float does not have child fields"))
+}
+
+func (ec *executionContext) _IssueStats_bugOpened(ctx context.Context, field graphql.CollectedField, obj *model.IssueStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_IssueStats_bugOpened(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.BugOpened, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_IssueStats_bugOpened(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("IssueStats", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _IssueStats_featureOpened(ctx context.Context, field graphql.CollectedField, obj *model.IssueStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_IssueStats_featureOpened(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.FeatureOpened, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_IssueStats_featureOpened(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("IssueStats", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _IssueStats_bugClosed(ctx context.Context, field graphql.CollectedField, obj *model.IssueStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_IssueStats_bugClosed(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.BugClosed, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_IssueStats_bugClosed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("IssueStats", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _IssueStats_featureClosed(ctx context.Context, field graphql.CollectedField, obj *model.IssueStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_IssueStats_featureClosed(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.FeatureClosed, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_IssueStats_featureClosed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("IssueStats", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _MemberDelta_login(ctx context.Context, field graphql.CollectedField, obj *model.MemberDelta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_MemberDelta_login(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Login, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_MemberDelta_login(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("MemberDelta", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _MemberDelta_delta(ctx context.Context, field graphql.CollectedField, obj *model.MemberDelta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_MemberDelta_delta(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Delta, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *model.MetricDeltas) graphql.Marshaler {
+			return ec.marshalNMetricDeltas2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐMetricDeltas(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_MemberDelta_delta(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MemberDelta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_MetricDeltas(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MemberHistoryPoint_snapshotId(ctx context.Context, field graphql.CollectedField, obj *model.MemberHistoryPoint) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_MemberHistoryPoint_snapshotId(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.SnapshotID, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNID2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_MemberHistoryPoint_snapshotId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("MemberHistoryPoint", field, false, false, errors.New("field of type ID does not have child fields"))
+}
+
+func (ec *executionContext) _MemberHistoryPoint_capturedAt(ctx context.Context, field graphql.CollectedField, obj *model.MemberHistoryPoint) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_MemberHistoryPoint_capturedAt(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.CapturedAt, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_MemberHistoryPoint_capturedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("MemberHistoryPoint", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _MemberHistoryPoint_stats(ctx context.Context, field graphql.CollectedField, obj *model.MemberHistoryPoint) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_MemberHistoryPoint_stats(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Stats, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *model.MemberStats) graphql.Marshaler {
+			return ec.marshalNMemberStats2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐMemberStats(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_MemberHistoryPoint_stats(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MemberHistoryPoint",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_MemberStats(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MemberStats_login(ctx context.Context, field graphql.CollectedField, obj *model.MemberStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_MemberStats_login(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Login, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_MemberStats_login(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("MemberStats", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _MemberStats_name(ctx context.Context, field graphql.CollectedField, obj *model.MemberStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_MemberStats_name(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_MemberStats_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("MemberStats", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _MemberStats_totalCommits(ctx context.Context, field graphql.CollectedField, obj *model.MemberStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_MemberStats_totalCommits(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.TotalCommits, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_MemberStats_totalCommits(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("MemberStats", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _MemberStats_totalPRCreated(ctx context.Context, field graphql.CollectedField, obj *model.MemberStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_MemberStats_totalPRCreated(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.TotalPRCreated, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_MemberStats_totalPRCreated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("MemberStats", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _MemberStats_totalPRMerged(ctx context.Context, field graphql.CollectedField, obj *model.MemberStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_MemberStats_totalPRMerged(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.TotalPRMerged, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_MemberStats_totalPRMerged(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("MemberStats", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _MemberStats_totalIssues(ctx context.Context, field graphql.CollectedField, obj *model.MemberStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_MemberStats_totalIssues(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.TotalIssues, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_MemberStats_totalIssues(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("MemberStats", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _MemberStats_totalReviews(ctx context.Context, field graphql.CollectedField, obj *model.MemberStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_MemberStats_totalReviews(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.TotalReviews, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_MemberStats_totalReviews(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("MemberStats", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _MemberStats_totalAdditions(ctx context.Context, field graphql.CollectedField, obj *model.MemberStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_MemberStats_totalAdditions(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.TotalAdditions, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_MemberStats_totalAdditions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("MemberStats", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _MemberStats_totalDeletions(ctx context.Context, field graphql.CollectedField, obj *model.MemberStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_MemberStats_totalDeletions(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.TotalDeletions, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_MemberStats_totalDeletions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("MemberStats", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _MemberStats_excludedReviews(ctx context.Context, field graphql.CollectedField, obj *model.MemberStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_MemberStats_excludedReviews(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.ExcludedReviews, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_MemberStats_excludedReviews(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("MemberStats", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _MemberStats_prToReviewRatio(ctx context.Context, field graphql.CollectedField, obj *model.MemberStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_MemberStats_prToReviewRatio(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.PrToReviewRatio, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v float64) graphql.Marshaler {
+			return ec.marshalNFloat2float64(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_MemberStats_prToReviewRatio(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("MemberStats", field, false, false, errors.New("field of type Float does not have child fields"))
+}
+
+func (ec *executionContext) _MemberStats_cycleTime(ctx context.Context, field graphql.CollectedField, obj *model.MemberStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_MemberStats_cycleTime(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.CycleTime, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *model.CycleTimeStats) graphql.Marshaler {
+			return ec.marshalNCycleTimeStats2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐCycleTimeStats(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_MemberStats_cycleTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MemberStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_CycleTimeStats(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MemberStats_prSize(ctx context.Context, field graphql.CollectedField, obj *model.MemberStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_MemberStats_prSize(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.PrSize, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *model.PRSizeStats) graphql.Marshaler {
+			return ec.marshalNPRSizeStats2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐPRSizeStats(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_MemberStats_prSize(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MemberStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_PRSizeStats(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MemberStats_issues(ctx context.Context, field graphql.CollectedField, obj *model.MemberStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_MemberStats_issues(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Issues, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *model.IssueStats) graphql.Marshaler {
+			return ec.marshalNIssueStats2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐIssueStats(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_MemberStats_issues(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MemberStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_IssueStats(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MemberStats_categoryBreakdown(ctx context.Context, field graphql.CollectedField, obj *model.MemberStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_MemberStats_categoryBreakdown(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.CategoryBreakdown, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*model.CategoryCount) graphql.Marshaler {
+			return ec.marshalNCategoryCount2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐCategoryCountᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_MemberStats_categoryBreakdown(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MemberStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_CategoryCount(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MemberStats_complete(ctx context.Context, field graphql.CollectedField, obj *model.MemberStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_MemberStats_complete(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Complete, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v bool) graphql.Marshaler {
+			return ec.marshalNBoolean2bool(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_MemberStats_complete(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("MemberStats", field, false, false, errors.New("field of type Boolean does not have child fields"))
+}
+
+func (ec *executionContext) _MemberStats_dataGaps(ctx context.Context, field graphql.CollectedField, obj *model.MemberStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_MemberStats_dataGaps(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.DataGaps, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*model.DataGap) graphql.Marshaler {
+			return ec.marshalNDataGap2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐDataGapᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_MemberStats_dataGaps(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MemberStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_DataGap(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MetricDeltas_commits(ctx context.Context, field graphql.CollectedField, obj *model.MetricDeltas) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_MetricDeltas_commits(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Commits, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_MetricDeltas_commits(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("MetricDeltas", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _MetricDeltas_prCreated(ctx context.Context, field graphql.CollectedField, obj *model.MetricDeltas) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_MetricDeltas_prCreated(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.PrCreated, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_MetricDeltas_prCreated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("MetricDeltas", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _MetricDeltas_prMerged(ctx context.Context, field graphql.CollectedField, obj *model.MetricDeltas) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_MetricDeltas_prMerged(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.PrMerged, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_MetricDeltas_prMerged(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("MetricDeltas", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _MetricDeltas_issues(ctx context.Context, field graphql.CollectedField, obj *model.MetricDeltas) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_MetricDeltas_issues(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Issues, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_MetricDeltas_issues(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("MetricDeltas", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _MetricDeltas_reviews(ctx context.Context, field graphql.CollectedField, obj *model.MetricDeltas) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_MetricDeltas_reviews(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Reviews, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_MetricDeltas_reviews(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("MetricDeltas", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _MetricDeltas_additions(ctx context.Context, field graphql.CollectedField, obj *model.MetricDeltas) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_MetricDeltas_additions(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Additions, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_MetricDeltas_additions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("MetricDeltas", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _MetricDeltas_deletions(ctx context.Context, field graphql.CollectedField, obj *model.MetricDeltas) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_MetricDeltas_deletions(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Deletions, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_MetricDeltas_deletions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("MetricDeltas", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _PRSizeCount_size(ctx context.Context, field graphql.CollectedField, obj *model.PRSizeCount) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_PRSizeCount_size(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Size, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v model.PRSize) graphql.Marshaler {
+			return ec.marshalNPRSize2githubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐPRSize(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_PRSizeCount_size(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("PRSizeCount", field, false, false, errors.New("field of type PRSize does not have child fields"))
+}
+
+func (ec *executionContext) _PRSizeCount_count(ctx context.Context, field graphql.CollectedField, obj *model.PRSizeCount) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_PRSizeCount_count(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Count, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_PRSizeCount_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("PRSizeCount", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _PRSizeStats_prCount(ctx context.Context, field graphql.CollectedField, obj *model.PRSizeStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_PRSizeStats_prCount(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.PrCount, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_PRSizeStats_prCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("PRSizeStats", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _PRSizeStats_histogram(ctx context.Context, field graphql.CollectedField, obj *model.PRSizeStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_PRSizeStats_histogram(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Histogram, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*model.PRSizeCount) graphql.Marshaler {
+			return ec.marshalNPRSizeCount2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐPRSizeCountᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_PRSizeStats_histogram(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PRSizeStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_PRSizeCount(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PRSizeStats_changedLines(ctx context.Context, field graphql.CollectedField, obj *model.PRSizeStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_PRSizeStats_changedLines(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.ChangedLines, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *model.Percentiles) graphql.Marshaler {
+			return ec.marshalNPercentiles2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐPercentiles(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_PRSizeStats_changedLines(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PRSizeStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_Percentiles(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PRSizeStats_changedFiles(ctx context.Context, field graphql.CollectedField, obj *model.PRSizeStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_PRSizeStats_changedFiles(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.ChangedFiles, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *model.Percentiles) graphql.Marshaler {
+			return ec.marshalNPercentiles2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐPercentiles(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_PRSizeStats_changedFiles(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PRSizeStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_Percentiles(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PendingReview_repository(ctx context.Context, field graphql.CollectedField, obj *model.PendingReview) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_PendingReview_repository(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Repository, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_PendingReview_repository(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("PendingReview", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _PendingReview_author(ctx context.Context, field graphql.CollectedField, obj *model.PendingReview) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_PendingReview_author(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Author, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_PendingReview_author(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("PendingReview", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _PendingReview_requestedAt(ctx context.Context, field graphql.CollectedField, obj *model.PendingReview) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_PendingReview_requestedAt(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.RequestedAt, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_PendingReview_requestedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("PendingReview", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _Percentiles_count(ctx context.Context, field graphql.CollectedField, obj *model.Percentiles) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Percentiles_count(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Count, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Percentiles_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("Percentiles", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _Percentiles_median(ctx context.Context, field graphql.CollectedField, obj *model.Percentiles) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Percentiles_median(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Median, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v float64) graphql.Marshaler {
+			return ec.marshalNFloat2float64(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Percentiles_median(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("Percentiles", field, false, false, errors.New("field of type Float does not have child fields"))
+}
+
+func (ec *executionContext) _Percentiles_p90(ctx context.Context, field graphql.CollectedField, obj *model.Percentiles) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Percentiles_p90(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.P90, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v float64) graphql.Marshaler {
+			return ec.marshalNFloat2float64(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Percentiles_p90(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("Percentiles", field, false, false, errors.New("field of type Float does not have child fields"))
+}
+
+func (ec *executionContext) _Query_members(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Query_members(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return ec.Resolvers.Query().Members(ctx)
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*model.MemberStats) graphql.Marshaler {
+			return ec.marshalNMemberStats2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐMemberStatsᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Query_members(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_MemberStats(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_member(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Query_member(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Query().Member(ctx, fc.Args["login"].(string), fc.Args["from"].(*string), fc.Args["to"].(*string), fc.Args["granularity"].(*model.Granularity), fc.Args["snapshotId"].(*string))
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *model.UserStatistics) graphql.Marshaler {
+			return ec.marshalOUserStatistics2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐUserStatistics(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_Query_member(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_UserStatistics(ctx, field)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_member_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_teamSummary(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Query_teamSummary(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return ec.Resolvers.Query().TeamSummary(ctx)
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *model.TeamSummary) graphql.Marshaler {
+			return ec.marshalNTeamSummary2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐTeamSummary(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Query_teamSummary(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_TeamSummary(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_teamDailyStats(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Query_teamDailyStats(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Query().TeamDailyStats(ctx, fc.Args["from"].(*string), fc.Args["to"].(*string), fc.Args["granularity"].(*model.Granularity), fc.Args["snapshotId"].(*string))
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*model.DailyStatistics) graphql.Marshaler {
+			return ec.marshalNDailyStatistics2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐDailyStatisticsᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Query_teamDailyStats(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_DailyStatistics(ctx, field)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_teamDailyStats_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_repositories(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Query_repositories(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return ec.Resolvers.Query().Repositories(ctx)
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*model.RepositoryStats) graphql.Marshaler {
+			return ec.marshalNRepositoryStats2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryStatsᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Query_repositories(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_RepositoryStats(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_repository(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Query_repository(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Query().Repository(ctx, fc.Args["nameWithOwner"].(string), fc.Args["from"].(*string), fc.Args["to"].(*string), fc.Args["granularity"].(*model.Granularity), fc.Args["snapshotId"].(*string))
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *model.RepositoryStats) graphql.Marshaler {
+			return ec.marshalORepositoryStats2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryStats(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_Query_repository(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_RepositoryStats(ctx, field)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_repository_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_repositoryDailyStats(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Query_repositoryDailyStats(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Query().RepositoryDailyStats(ctx, fc.Args["from"].(*string), fc.Args["to"].(*string), fc.Args["granularity"].(*model.Granularity), fc.Args["snapshotId"].(*string))
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*model.RepositoryDailyStats) graphql.Marshaler {
+			return ec.marshalNRepositoryDailyStats2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryDailyStatsᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Query_repositoryDailyStats(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_RepositoryDailyStats(ctx, field)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_repositoryDailyStats_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_reviewNetwork(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Query_reviewNetwork(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Query().ReviewNetwork(ctx, fc.Args["from"].(*string), fc.Args["to"].(*string))
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *model.ReviewNetwork) graphql.Marshaler {
+			return ec.marshalNReviewNetwork2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐReviewNetwork(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Query_reviewNetwork(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_ReviewNetwork(ctx, field)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_reviewNetwork_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_reviewLoad(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Query_reviewLoad(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return ec.Resolvers.Query().ReviewLoad(ctx)
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*model.ReviewLoad) graphql.Marshaler {
+			return ec.marshalNReviewLoad2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐReviewLoadᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Query_reviewLoad(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_ReviewLoad(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_activityHeatmap(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Query_activityHeatmap(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Query().ActivityHeatmap(ctx, fc.Args["login"].(string), fc.Args["from"].(*string), fc.Args["to"].(*string))
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *model.ActivityHeatmap) graphql.Marshaler {
+			return ec.marshalOActivityHeatmap2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐActivityHeatmap(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_Query_activityHeatmap(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_ActivityHeatmap(ctx, field)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_activityHeatmap_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_deliveryMetrics(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Query_deliveryMetrics(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Query().DeliveryMetrics(ctx, fc.Args["repository"].(string), fc.Args["from"].(*string), fc.Args["to"].(*string))
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *model.DeliveryMetrics) graphql.Marshaler {
+			return ec.marshalNDeliveryMetrics2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐDeliveryMetrics(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Query_deliveryMetrics(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_DeliveryMetrics(ctx, field)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_deliveryMetrics_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_snapshots(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Query_snapshots(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return ec.Resolvers.Query().Snapshots(ctx)
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*model.SnapshotInfo) graphql.Marshaler {
+			return ec.marshalNSnapshotInfo2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐSnapshotInfoᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Query_snapshots(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_SnapshotInfo(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_snapshot(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Query_snapshot(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Query().Snapshot(ctx, fc.Args["id"].(string))
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *model.Snapshot) graphql.Marshaler {
+			return ec.marshalOSnapshot2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐSnapshot(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_Query_snapshot(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_Snapshot(ctx, field)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_snapshot_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_memberHistory(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Query_memberHistory(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Query().MemberHistory(ctx, fc.Args["login"].(string))
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*model.MemberHistoryPoint) graphql.Marshaler {
+			return ec.marshalNMemberHistoryPoint2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐMemberHistoryPointᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Query_memberHistory(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_MemberHistoryPoint(ctx, field)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_memberHistory_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_snapshotDiff(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Query_snapshotDiff(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Query().SnapshotDiff(ctx, fc.Args["base"].(string), fc.Args["head"].(string))
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *model.SnapshotDiff) graphql.Marshaler {
+			return ec.marshalNSnapshotDiff2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐSnapshotDiff(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Query_snapshotDiff(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_SnapshotDiff(ctx, field)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_snapshotDiff_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Query___type(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.IntrospectType(fc.Args["name"].(string))
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *introspection.Type) graphql.Marshaler {
+			return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields___Type(ctx, field)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Query___schema(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return ec.IntrospectSchema()
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *introspection.Schema) graphql.Marshaler {
+			return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields___Schema(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RepositoryActivity_repository(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryActivity) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RepositoryActivity_repository(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Repository, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RepositoryActivity_repository(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("RepositoryActivity", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _RepositoryActivity_commitCount(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryActivity) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RepositoryActivity_commitCount(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.CommitCount, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RepositoryActivity_commitCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("RepositoryActivity", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _RepositoryActivity_prCount(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryActivity) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RepositoryActivity_prCount(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.PrCount, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RepositoryActivity_prCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("RepositoryActivity", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _RepositoryActivity_issueCount(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryActivity) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RepositoryActivity_issueCount(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.IssueCount, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RepositoryActivity_issueCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("RepositoryActivity", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _RepositoryActivity_reviewCount(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryActivity) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RepositoryActivity_reviewCount(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.ReviewCount, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RepositoryActivity_reviewCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("RepositoryActivity", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _RepositoryActivity_totalAdditions(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryActivity) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RepositoryActivity_totalAdditions(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.TotalAdditions, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RepositoryActivity_totalAdditions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("RepositoryActivity", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _RepositoryActivity_totalDeletions(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryActivity) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RepositoryActivity_totalDeletions(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.TotalDeletions, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RepositoryActivity_totalDeletions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("RepositoryActivity", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _RepositoryActivity_firstActivity(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryActivity) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RepositoryActivity_firstActivity(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.FirstActivity, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RepositoryActivity_firstActivity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("RepositoryActivity", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _RepositoryActivity_lastActivity(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryActivity) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RepositoryActivity_lastActivity(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.LastActivity, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RepositoryActivity_lastActivity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("RepositoryActivity", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _RepositoryContributor_login(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryContributor) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RepositoryContributor_login(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Login, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RepositoryContributor_login(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("RepositoryContributor", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _RepositoryContributor_commitCount(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryContributor) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RepositoryContributor_commitCount(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.CommitCount, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RepositoryContributor_commitCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("RepositoryContributor", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _RepositoryContributor_prCreated(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryContributor) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RepositoryContributor_prCreated(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.PrCreated, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RepositoryContributor_prCreated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("RepositoryContributor", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _RepositoryContributor_reviewCount(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryContributor) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RepositoryContributor_reviewCount(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.ReviewCount, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RepositoryContributor_reviewCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("RepositoryContributor", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _RepositoryContributor_additions(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryContributor) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RepositoryContributor_additions(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Additions, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RepositoryContributor_additions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("RepositoryContributor", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _RepositoryContributor_deletions(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryContributor) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RepositoryContributor_deletions(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Deletions, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RepositoryContributor_deletions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("RepositoryContributor", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _RepositoryContributor_dailyStats(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryContributor) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RepositoryContributor_dailyStats(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.DailyStats, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*model.DailyStatistics) graphql.Marshaler {
+			return ec.marshalNDailyStatistics2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐDailyStatisticsᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RepositoryContributor_dailyStats(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RepositoryContributor",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_DailyStatistics(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RepositoryDailyStats_nameWithOwner(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryDailyStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RepositoryDailyStats_nameWithOwner(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.NameWithOwner, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RepositoryDailyStats_nameWithOwner(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("RepositoryDailyStats", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _RepositoryDailyStats_owner(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryDailyStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RepositoryDailyStats_owner(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Owner, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RepositoryDailyStats_owner(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("RepositoryDailyStats", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _RepositoryDailyStats_ownerType(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryDailyStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RepositoryDailyStats_ownerType(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.OwnerType, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RepositoryDailyStats_ownerType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("RepositoryDailyStats", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _RepositoryDailyStats_dailyStats(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryDailyStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RepositoryDailyStats_dailyStats(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.DailyStats, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*model.DailyStatistics) graphql.Marshaler {
+			return ec.marshalNDailyStatistics2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐDailyStatisticsᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RepositoryDailyStats_dailyStats(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RepositoryDailyStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_DailyStatistics(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RepositoryDelta_nameWithOwner(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryDelta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RepositoryDelta_nameWithOwner(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.NameWithOwner, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RepositoryDelta_nameWithOwner(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("RepositoryDelta", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _RepositoryDelta_delta(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryDelta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RepositoryDelta_delta(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Delta, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *model.MetricDeltas) graphql.Marshaler {
+			return ec.marshalNMetricDeltas2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐMetricDeltas(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RepositoryDelta_delta(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RepositoryDelta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_MetricDeltas(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RepositoryStats_nameWithOwner(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RepositoryStats_nameWithOwner(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.NameWithOwner, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RepositoryStats_nameWithOwner(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("RepositoryStats", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _RepositoryStats_total(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RepositoryStats_total(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Total, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *model.RepositoryTotals) graphql.Marshaler {
+			return ec.marshalNRepositoryTotals2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryTotals(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RepositoryStats_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RepositoryStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_RepositoryTotals(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RepositoryStats_contributorCount(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RepositoryStats_contributorCount(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.ContributorCount, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RepositoryStats_contributorCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("RepositoryStats", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _RepositoryStats_contributors(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RepositoryStats_contributors(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Contributors, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*model.RepositoryContributor) graphql.Marshaler {
+			return ec.marshalNRepositoryContributor2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryContributorᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RepositoryStats_contributors(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RepositoryStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_RepositoryContributor(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RepositoryStats_cycleTime(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RepositoryStats_cycleTime(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.CycleTime, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *model.CycleTimeStats) graphql.Marshaler {
+			return ec.marshalNCycleTimeStats2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐCycleTimeStats(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RepositoryStats_cycleTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RepositoryStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_CycleTimeStats(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RepositoryStats_prSize(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RepositoryStats_prSize(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.PrSize, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *model.PRSizeStats) graphql.Marshaler {
+			return ec.marshalNPRSizeStats2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐPRSizeStats(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RepositoryStats_prSize(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RepositoryStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_PRSizeStats(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RepositoryStats_issues(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RepositoryStats_issues(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Issues, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *model.IssueStats) graphql.Marshaler {
+			return ec.marshalNIssueStats2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐIssueStats(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RepositoryStats_issues(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RepositoryStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_IssueStats(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RepositoryStats_categoryBreakdown(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RepositoryStats_categoryBreakdown(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.CategoryBreakdown, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*model.CategoryCount) graphql.Marshaler {
+			return ec.marshalNCategoryCount2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐCategoryCountᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RepositoryStats_categoryBreakdown(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RepositoryStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_CategoryCount(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RepositoryStats_openIssueCount(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RepositoryStats_openIssueCount(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.OpenIssueCount, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RepositoryStats_openIssueCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("RepositoryStats", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _RepositoryTotals_commits(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryTotals) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RepositoryTotals_commits(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Commits, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RepositoryTotals_commits(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("RepositoryTotals", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _RepositoryTotals_prCreated(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryTotals) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RepositoryTotals_prCreated(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.PrCreated, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RepositoryTotals_prCreated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("RepositoryTotals", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _RepositoryTotals_prMerged(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryTotals) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RepositoryTotals_prMerged(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.PrMerged, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RepositoryTotals_prMerged(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("RepositoryTotals", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _RepositoryTotals_issues(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryTotals) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RepositoryTotals_issues(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Issues, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RepositoryTotals_issues(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("RepositoryTotals", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _RepositoryTotals_reviews(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryTotals) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RepositoryTotals_reviews(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Reviews, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RepositoryTotals_reviews(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("RepositoryTotals", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _RepositoryTotals_additions(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryTotals) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RepositoryTotals_additions(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Additions, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RepositoryTotals_additions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("RepositoryTotals", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _RepositoryTotals_deletions(ctx context.Context, field graphql.CollectedField, obj *model.RepositoryTotals) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RepositoryTotals_deletions(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Deletions, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RepositoryTotals_deletions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("RepositoryTotals", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _ReviewLoad_reviewer(ctx context.Context, field graphql.CollectedField, obj *model.ReviewLoad) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_ReviewLoad_reviewer(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Reviewer, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_ReviewLoad_reviewer(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("ReviewLoad", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _ReviewLoad_requested(ctx context.Context, field graphql.CollectedField, obj *model.ReviewLoad) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_ReviewLoad_requested(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Requested, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_ReviewLoad_requested(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("ReviewLoad", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _ReviewLoad_completed(ctx context.Context, field graphql.CollectedField, obj *model.ReviewLoad) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_ReviewLoad_completed(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Completed, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_ReviewLoad_completed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("ReviewLoad", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _ReviewLoad_pending(ctx context.Context, field graphql.CollectedField, obj *model.ReviewLoad) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_ReviewLoad_pending(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Pending, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_ReviewLoad_pending(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("ReviewLoad", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _ReviewLoad_pendingReviews(ctx context.Context, field graphql.CollectedField, obj *model.ReviewLoad) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_ReviewLoad_pendingReviews(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.PendingReviews, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*model.PendingReview) graphql.Marshaler {
+			return ec.marshalNPendingReview2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐPendingReviewᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_ReviewLoad_pendingReviews(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReviewLoad",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_PendingReview(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReviewNetwork_nodes(ctx context.Context, field graphql.CollectedField, obj *model.ReviewNetwork) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_ReviewNetwork_nodes(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Nodes, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*model.ReviewNetworkNode) graphql.Marshaler {
+			return ec.marshalNReviewNetworkNode2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐReviewNetworkNodeᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_ReviewNetwork_nodes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReviewNetwork",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_ReviewNetworkNode(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReviewNetwork_edges(ctx context.Context, field graphql.CollectedField, obj *model.ReviewNetwork) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_ReviewNetwork_edges(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Edges, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*model.ReviewNetworkEdge) graphql.Marshaler {
+			return ec.marshalNReviewNetworkEdge2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐReviewNetworkEdgeᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_ReviewNetwork_edges(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReviewNetwork",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_ReviewNetworkEdge(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReviewNetworkEdge_reviewer(ctx context.Context, field graphql.CollectedField, obj *model.ReviewNetworkEdge) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_ReviewNetworkEdge_reviewer(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Reviewer, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_ReviewNetworkEdge_reviewer(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("ReviewNetworkEdge", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _ReviewNetworkEdge_author(ctx context.Context, field graphql.CollectedField, obj *model.ReviewNetworkEdge) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_ReviewNetworkEdge_author(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Author, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_ReviewNetworkEdge_author(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("ReviewNetworkEdge", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _ReviewNetworkEdge_reviewCount(ctx context.Context, field graphql.CollectedField, obj *model.ReviewNetworkEdge) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_ReviewNetworkEdge_reviewCount(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.ReviewCount, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_ReviewNetworkEdge_reviewCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("ReviewNetworkEdge", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _ReviewNetworkEdge_repositories(ctx context.Context, field graphql.CollectedField, obj *model.ReviewNetworkEdge) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_ReviewNetworkEdge_repositories(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Repositories, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []string) graphql.Marshaler {
+			return ec.marshalNString2ᚕstringᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_ReviewNetworkEdge_repositories(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("ReviewNetworkEdge", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _ReviewNetworkNode_login(ctx context.Context, field graphql.CollectedField, obj *model.ReviewNetworkNode) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_ReviewNetworkNode_login(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Login, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_ReviewNetworkNode_login(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("ReviewNetworkNode", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _ReviewNetworkNode_reviewsGiven(ctx context.Context, field graphql.CollectedField, obj *model.ReviewNetworkNode) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_ReviewNetworkNode_reviewsGiven(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.ReviewsGiven, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_ReviewNetworkNode_reviewsGiven(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("ReviewNetworkNode", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _ReviewNetworkNode_reviewsReceived(ctx context.Context, field graphql.CollectedField, obj *model.ReviewNetworkNode) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_ReviewNetworkNode_reviewsReceived(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.ReviewsReceived, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_ReviewNetworkNode_reviewsReceived(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("ReviewNetworkNode", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _RoleTransitionPoint_year(ctx context.Context, field graphql.CollectedField, obj *model.RoleTransitionPoint) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RoleTransitionPoint_year(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Year, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RoleTransitionPoint_year(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("RoleTransitionPoint", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _RoleTransitionPoint_prCreated(ctx context.Context, field graphql.CollectedField, obj *model.RoleTransitionPoint) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RoleTransitionPoint_prCreated(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.PrCreated, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RoleTransitionPoint_prCreated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("RoleTransitionPoint", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _RoleTransitionPoint_reviewCount(ctx context.Context, field graphql.CollectedField, obj *model.RoleTransitionPoint) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RoleTransitionPoint_reviewCount(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.ReviewCount, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RoleTransitionPoint_reviewCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("RoleTransitionPoint", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _RoleTransitionPoint_ratio(ctx context.Context, field graphql.CollectedField, obj *model.RoleTransitionPoint) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RoleTransitionPoint_ratio(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Ratio, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v float64) graphql.Marshaler {
+			return ec.marshalNFloat2float64(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RoleTransitionPoint_ratio(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("RoleTransitionPoint", field, false, false, errors.New("field of type Float does not have child fields"))
+}
+
+func (ec *executionContext) _RoleTransitionPoint_description(ctx context.Context, field graphql.CollectedField, obj *model.RoleTransitionPoint) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_RoleTransitionPoint_description(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Description, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_RoleTransitionPoint_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("RoleTransitionPoint", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _Snapshot_id(ctx context.Context, field graphql.CollectedField, obj *model.Snapshot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Snapshot_id(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNID2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Snapshot_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("Snapshot", field, false, false, errors.New("field of type ID does not have child fields"))
+}
+
+func (ec *executionContext) _Snapshot_capturedAt(ctx context.Context, field graphql.CollectedField, obj *model.Snapshot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Snapshot_capturedAt(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.CapturedAt, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Snapshot_capturedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("Snapshot", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _Snapshot_tag(ctx context.Context, field graphql.CollectedField, obj *model.Snapshot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Snapshot_tag(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Tag, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *string) graphql.Marshaler {
+			return ec.marshalOString2ᚖstring(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_Snapshot_tag(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("Snapshot", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _Snapshot_memberCount(ctx context.Context, field graphql.CollectedField, obj *model.Snapshot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Snapshot_memberCount(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.MemberCount, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Snapshot_memberCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("Snapshot", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _Snapshot_repositoryCount(ctx context.Context, field graphql.CollectedField, obj *model.Snapshot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Snapshot_repositoryCount(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.RepositoryCount, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Snapshot_repositoryCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("Snapshot", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _Snapshot_excludedMembers(ctx context.Context, field graphql.CollectedField, obj *model.Snapshot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Snapshot_excludedMembers(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.ExcludedMembers, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*model.ExcludedMember) graphql.Marshaler {
+			return ec.marshalNExcludedMember2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐExcludedMemberᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Snapshot_excludedMembers(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Snapshot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_ExcludedMember(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Snapshot_period(ctx context.Context, field graphql.CollectedField, obj *model.Snapshot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Snapshot_period(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Period, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *model.CollectionPeriod) graphql.Marshaler {
+			return ec.marshalNCollectionPeriod2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐCollectionPeriod(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Snapshot_period(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Snapshot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_CollectionPeriod(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Snapshot_members(ctx context.Context, field graphql.CollectedField, obj *model.Snapshot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Snapshot_members(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Members, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*model.MemberStats) graphql.Marshaler {
+			return ec.marshalNMemberStats2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐMemberStatsᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Snapshot_members(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Snapshot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_MemberStats(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Snapshot_teamSummary(ctx context.Context, field graphql.CollectedField, obj *model.Snapshot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Snapshot_teamSummary(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.TeamSummary, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *model.TeamSummary) graphql.Marshaler {
+			return ec.marshalNTeamSummary2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐTeamSummary(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Snapshot_teamSummary(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Snapshot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_TeamSummary(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Snapshot_repositories(ctx context.Context, field graphql.CollectedField, obj *model.Snapshot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Snapshot_repositories(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Repositories, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*model.RepositoryStats) graphql.Marshaler {
+			return ec.marshalNRepositoryStats2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryStatsᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Snapshot_repositories(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Snapshot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_RepositoryStats(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SnapshotDiff_base(ctx context.Context, field graphql.CollectedField, obj *model.SnapshotDiff) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_SnapshotDiff_base(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Base, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *model.SnapshotInfo) graphql.Marshaler {
+			return ec.marshalNSnapshotInfo2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐSnapshotInfo(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_SnapshotDiff_base(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SnapshotDiff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_SnapshotInfo(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SnapshotDiff_head(ctx context.Context, field graphql.CollectedField, obj *model.SnapshotDiff) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_SnapshotDiff_head(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Head, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *model.SnapshotInfo) graphql.Marshaler {
+			return ec.marshalNSnapshotInfo2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐSnapshotInfo(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_SnapshotDiff_head(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SnapshotDiff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_SnapshotInfo(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SnapshotDiff_addedMembers(ctx context.Context, field graphql.CollectedField, obj *model.SnapshotDiff) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_SnapshotDiff_addedMembers(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.AddedMembers, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []string) graphql.Marshaler {
+			return ec.marshalNString2ᚕstringᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_SnapshotDiff_addedMembers(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("SnapshotDiff", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _SnapshotDiff_removedMembers(ctx context.Context, field graphql.CollectedField, obj *model.SnapshotDiff) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_SnapshotDiff_removedMembers(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.RemovedMembers, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []string) graphql.Marshaler {
+			return ec.marshalNString2ᚕstringᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_SnapshotDiff_removedMembers(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("SnapshotDiff", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _SnapshotDiff_addedRepositories(ctx context.Context, field graphql.CollectedField, obj *model.SnapshotDiff) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_SnapshotDiff_addedRepositories(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.AddedRepositories, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []string) graphql.Marshaler {
+			return ec.marshalNString2ᚕstringᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_SnapshotDiff_addedRepositories(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("SnapshotDiff", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _SnapshotDiff_removedRepositories(ctx context.Context, field graphql.CollectedField, obj *model.SnapshotDiff) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_SnapshotDiff_removedRepositories(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.RemovedRepositories, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []string) graphql.Marshaler {
+			return ec.marshalNString2ᚕstringᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_SnapshotDiff_removedRepositories(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("SnapshotDiff", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _SnapshotDiff_memberDeltas(ctx context.Context, field graphql.CollectedField, obj *model.SnapshotDiff) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_SnapshotDiff_memberDeltas(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.MemberDeltas, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*model.MemberDelta) graphql.Marshaler {
+			return ec.marshalNMemberDelta2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐMemberDeltaᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_SnapshotDiff_memberDeltas(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SnapshotDiff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_MemberDelta(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SnapshotDiff_repositoryDeltas(ctx context.Context, field graphql.CollectedField, obj *model.SnapshotDiff) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_SnapshotDiff_repositoryDeltas(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.RepositoryDeltas, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*model.RepositoryDelta) graphql.Marshaler {
+			return ec.marshalNRepositoryDelta2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryDeltaᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_SnapshotDiff_repositoryDeltas(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SnapshotDiff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_RepositoryDelta(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SnapshotInfo_id(ctx context.Context, field graphql.CollectedField, obj *model.SnapshotInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_SnapshotInfo_id(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNID2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_SnapshotInfo_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("SnapshotInfo", field, false, false, errors.New("field of type ID does not have child fields"))
+}
+
+func (ec *executionContext) _SnapshotInfo_capturedAt(ctx context.Context, field graphql.CollectedField, obj *model.SnapshotInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_SnapshotInfo_capturedAt(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.CapturedAt, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_SnapshotInfo_capturedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("SnapshotInfo", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _SnapshotInfo_tag(ctx context.Context, field graphql.CollectedField, obj *model.SnapshotInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_SnapshotInfo_tag(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Tag, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *string) graphql.Marshaler {
+			return ec.marshalOString2ᚖstring(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_SnapshotInfo_tag(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("SnapshotInfo", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _SnapshotInfo_memberCount(ctx context.Context, field graphql.CollectedField, obj *model.SnapshotInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_SnapshotInfo_memberCount(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.MemberCount, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_SnapshotInfo_memberCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("SnapshotInfo", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _SnapshotInfo_repositoryCount(ctx context.Context, field graphql.CollectedField, obj *model.SnapshotInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_SnapshotInfo_repositoryCount(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.RepositoryCount, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_SnapshotInfo_repositoryCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("SnapshotInfo", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _SnapshotInfo_excludedMembers(ctx context.Context, field graphql.CollectedField, obj *model.SnapshotInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_SnapshotInfo_excludedMembers(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.ExcludedMembers, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*model.ExcludedMember) graphql.Marshaler {
+			return ec.marshalNExcludedMember2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐExcludedMemberᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_SnapshotInfo_excludedMembers(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SnapshotInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_ExcludedMember(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SnapshotInfo_period(ctx context.Context, field graphql.CollectedField, obj *model.SnapshotInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_SnapshotInfo_period(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Period, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *model.CollectionPeriod) graphql.Marshaler {
+			return ec.marshalNCollectionPeriod2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐCollectionPeriod(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_SnapshotInfo_period(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SnapshotInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_CollectionPeriod(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TeamSummary_memberCount(ctx context.Context, field graphql.CollectedField, obj *model.TeamSummary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_TeamSummary_memberCount(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.MemberCount, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_TeamSummary_memberCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("TeamSummary", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _TeamSummary_repositoryCount(ctx context.Context, field graphql.CollectedField, obj *model.TeamSummary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_TeamSummary_repositoryCount(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.RepositoryCount, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_TeamSummary_repositoryCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("TeamSummary", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _TeamSummary_totalCommits(ctx context.Context, field graphql.CollectedField, obj *model.TeamSummary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_TeamSummary_totalCommits(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.TotalCommits, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_TeamSummary_totalCommits(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("TeamSummary", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _TeamSummary_totalPRCreated(ctx context.Context, field graphql.CollectedField, obj *model.TeamSummary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_TeamSummary_totalPRCreated(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.TotalPRCreated, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_TeamSummary_totalPRCreated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("TeamSummary", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _TeamSummary_totalPRMerged(ctx context.Context, field graphql.CollectedField, obj *model.TeamSummary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_TeamSummary_totalPRMerged(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.TotalPRMerged, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_TeamSummary_totalPRMerged(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("TeamSummary", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _TeamSummary_totalIssues(ctx context.Context, field graphql.CollectedField, obj *model.TeamSummary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_TeamSummary_totalIssues(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.TotalIssues, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_TeamSummary_totalIssues(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("TeamSummary", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _TeamSummary_totalReviews(ctx context.Context, field graphql.CollectedField, obj *model.TeamSummary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_TeamSummary_totalReviews(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.TotalReviews, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_TeamSummary_totalReviews(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("TeamSummary", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _TeamSummary_totalAdditions(ctx context.Context, field graphql.CollectedField, obj *model.TeamSummary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_TeamSummary_totalAdditions(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.TotalAdditions, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_TeamSummary_totalAdditions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("TeamSummary", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _TeamSummary_totalDeletions(ctx context.Context, field graphql.CollectedField, obj *model.TeamSummary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_TeamSummary_totalDeletions(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.TotalDeletions, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_TeamSummary_totalDeletions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("TeamSummary", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _TeamSummary_issues(ctx context.Context, field graphql.CollectedField, obj *model.TeamSummary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_TeamSummary_issues(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Issues, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *model.IssueStats) graphql.Marshaler {
+			return ec.marshalNIssueStats2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐIssueStats(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_TeamSummary_issues(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TeamSummary",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_IssueStats(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TeamSummary_categoryBreakdown(ctx context.Context, field graphql.CollectedField, obj *model.TeamSummary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_TeamSummary_categoryBreakdown(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.CategoryBreakdown, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*model.CategoryCount) graphql.Marshaler {
+			return ec.marshalNCategoryCount2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐCategoryCountᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_TeamSummary_categoryBreakdown(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TeamSummary",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_CategoryCount(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TeamSummary_openIssueCount(ctx context.Context, field graphql.CollectedField, obj *model.TeamSummary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_TeamSummary_openIssueCount(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.OpenIssueCount, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_TeamSummary_openIssueCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("TeamSummary", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _UserStatistics_login(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_UserStatistics_login(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Login, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_UserStatistics_login(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("UserStatistics", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _UserStatistics_name(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_UserStatistics_name(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_UserStatistics_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("UserStatistics", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _UserStatistics_logins(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_UserStatistics_logins(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Logins, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []string) graphql.Marshaler {
+			return ec.marshalNString2ᚕstringᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_UserStatistics_logins(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("UserStatistics", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _UserStatistics_totalCommits(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_UserStatistics_totalCommits(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.TotalCommits, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_UserStatistics_totalCommits(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("UserStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _UserStatistics_totalPRCreated(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_UserStatistics_totalPRCreated(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.TotalPRCreated, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_UserStatistics_totalPRCreated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("UserStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _UserStatistics_totalPRMerged(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_UserStatistics_totalPRMerged(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.TotalPRMerged, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_UserStatistics_totalPRMerged(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("UserStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _UserStatistics_totalIssues(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_UserStatistics_totalIssues(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.TotalIssues, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_UserStatistics_totalIssues(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("UserStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _UserStatistics_totalReviews(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_UserStatistics_totalReviews(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.TotalReviews, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_UserStatistics_totalReviews(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("UserStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _UserStatistics_totalAdditions(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_UserStatistics_totalAdditions(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.TotalAdditions, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_UserStatistics_totalAdditions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("UserStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _UserStatistics_totalDeletions(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_UserStatistics_totalDeletions(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.TotalDeletions, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_UserStatistics_totalDeletions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("UserStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _UserStatistics_excludedReviews(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_UserStatistics_excludedReviews(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.ExcludedReviews, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_UserStatistics_excludedReviews(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("UserStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _UserStatistics_prToReviewRatio(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_UserStatistics_prToReviewRatio(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.PrToReviewRatio, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v float64) graphql.Marshaler {
+			return ec.marshalNFloat2float64(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_UserStatistics_prToReviewRatio(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("UserStatistics", field, false, false, errors.New("field of type Float does not have child fields"))
+}
+
+func (ec *executionContext) _UserStatistics_firstActivityYear(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_UserStatistics_firstActivityYear(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.FirstActivityYear, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_UserStatistics_firstActivityYear(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("UserStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _UserStatistics_peakActivityYear(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_UserStatistics_peakActivityYear(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.PeakActivityYear, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_UserStatistics_peakActivityYear(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("UserStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _UserStatistics_peakActivityCommits(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_UserStatistics_peakActivityCommits(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.PeakActivityCommits, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_UserStatistics_peakActivityCommits(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("UserStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _UserStatistics_yearlyStats(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_UserStatistics_yearlyStats(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.YearlyStats, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*model.YearlyStatistics) graphql.Marshaler {
+			return ec.marshalNYearlyStatistics2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐYearlyStatisticsᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_UserStatistics_yearlyStats(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserStatistics",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_YearlyStatistics(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserStatistics_dailyStats(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_UserStatistics_dailyStats(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.DailyStats, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*model.DailyStatistics) graphql.Marshaler {
+			return ec.marshalNDailyStatistics2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐDailyStatisticsᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_UserStatistics_dailyStats(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserStatistics",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_DailyStatistics(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserStatistics_topRepositories(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_UserStatistics_topRepositories(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.TopRepositories, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*model.RepositoryActivity) graphql.Marshaler {
+			return ec.marshalNRepositoryActivity2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryActivityᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_UserStatistics_topRepositories(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserStatistics",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_RepositoryActivity(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserStatistics_longTermRepositories(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_UserStatistics_longTermRepositories(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.LongTermRepositories, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*model.RepositoryActivity) graphql.Marshaler {
+			return ec.marshalNRepositoryActivity2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryActivityᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_UserStatistics_longTermRepositories(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserStatistics",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_RepositoryActivity(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserStatistics_roleTransition(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_UserStatistics_roleTransition(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.RoleTransition, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*model.RoleTransitionPoint) graphql.Marshaler {
+			return ec.marshalNRoleTransitionPoint2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRoleTransitionPointᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_UserStatistics_roleTransition(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserStatistics",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_RoleTransitionPoint(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserStatistics_cycleTime(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_UserStatistics_cycleTime(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.CycleTime, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *model.CycleTimeStats) graphql.Marshaler {
+			return ec.marshalNCycleTimeStats2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐCycleTimeStats(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_UserStatistics_cycleTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserStatistics",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_CycleTimeStats(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserStatistics_prSize(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_UserStatistics_prSize(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.PrSize, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *model.PRSizeStats) graphql.Marshaler {
+			return ec.marshalNPRSizeStats2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐPRSizeStats(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_UserStatistics_prSize(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserStatistics",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_PRSizeStats(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserStatistics_issues(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_UserStatistics_issues(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Issues, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *model.IssueStats) graphql.Marshaler {
+			return ec.marshalNIssueStats2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐIssueStats(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_UserStatistics_issues(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserStatistics",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_IssueStats(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserStatistics_categoryBreakdown(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_UserStatistics_categoryBreakdown(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.CategoryBreakdown, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*model.CategoryCount) graphql.Marshaler {
+			return ec.marshalNCategoryCount2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐCategoryCountᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_UserStatistics_categoryBreakdown(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserStatistics",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_CategoryCount(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserStatistics_complete(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_UserStatistics_complete(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Complete, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v bool) graphql.Marshaler {
+			return ec.marshalNBoolean2bool(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_UserStatistics_complete(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("UserStatistics", field, false, false, errors.New("field of type Boolean does not have child fields"))
+}
+
+func (ec *executionContext) _UserStatistics_dataGaps(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_UserStatistics_dataGaps(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.DataGaps, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*model.DataGap) graphql.Marshaler {
+			return ec.marshalNDataGap2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐDataGapᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_UserStatistics_dataGaps(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserStatistics",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_DataGap(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _YearlyStatistics_year(ctx context.Context, field graphql.CollectedField, obj *model.YearlyStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_YearlyStatistics_year(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Year, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_YearlyStatistics_year(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("YearlyStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _YearlyStatistics_commitCount(ctx context.Context, field graphql.CollectedField, obj *model.YearlyStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_YearlyStatistics_commitCount(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.CommitCount, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_YearlyStatistics_commitCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("YearlyStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _YearlyStatistics_prCreated(ctx context.Context, field graphql.CollectedField, obj *model.YearlyStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_YearlyStatistics_prCreated(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.PrCreated, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_YearlyStatistics_prCreated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("YearlyStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _YearlyStatistics_prMerged(ctx context.Context, field graphql.CollectedField, obj *model.YearlyStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_YearlyStatistics_prMerged(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.PrMerged, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_YearlyStatistics_prMerged(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("YearlyStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _YearlyStatistics_issueCount(ctx context.Context, field graphql.CollectedField, obj *model.YearlyStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_YearlyStatistics_issueCount(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.IssueCount, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_YearlyStatistics_issueCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("YearlyStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _YearlyStatistics_reviewCount(ctx context.Context, field graphql.CollectedField, obj *model.YearlyStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_YearlyStatistics_reviewCount(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.ReviewCount, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_YearlyStatistics_reviewCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("YearlyStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _YearlyStatistics_totalAdditions(ctx context.Context, field graphql.CollectedField, obj *model.YearlyStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_YearlyStatistics_totalAdditions(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.TotalAdditions, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_YearlyStatistics_totalAdditions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("YearlyStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _YearlyStatistics_totalDeletions(ctx context.Context, field graphql.CollectedField, obj *model.YearlyStatistics) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_YearlyStatistics_totalDeletions(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.TotalDeletions, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_YearlyStatistics_totalDeletions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("YearlyStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext___Directive_name(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext___Directive_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("__Directive", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext___Directive_description(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *string) graphql.Marshaler {
+			return ec.marshalOString2ᚖstring(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext___Directive_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("__Directive", field, true, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext___Directive_isRepeatable(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.IsRepeatable, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v bool) graphql.Marshaler {
+			return ec.marshalNBoolean2bool(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext___Directive_isRepeatable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("__Directive", field, false, false, errors.New("field of type Boolean does not have child fields"))
+}
+
+func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext___Directive_locations(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Locations, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []string) graphql.Marshaler {
+			return ec.marshalN__DirectiveLocation2ᚕstringᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext___Directive_locations(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("__Directive", field, false, false, errors.New("field of type __DirectiveLocation does not have child fields"))
+}
+
+func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext___Directive_args(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Args, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []introspection.InputValue) graphql.Marshaler {
+			return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields___InputValue(ctx, field)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Directive_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext___EnumValue_name(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext___EnumValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("__EnumValue", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext___EnumValue_description(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *string) graphql.Marshaler {
+			return ec.marshalOString2ᚖstring(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext___EnumValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("__EnumValue", field, true, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.IsDeprecated(), nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v bool) graphql.Marshaler {
+			return ec.marshalNBoolean2bool(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext___EnumValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("__EnumValue", field, true, false, errors.New("field of type Boolean does not have child fields"))
+}
+
+func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.DeprecationReason(), nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *string) graphql.Marshaler {
+			return ec.marshalOString2ᚖstring(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext___EnumValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("__EnumValue", field, true, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext___Field_name(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext___Field_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("__Field", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext___Field_description(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *string) graphql.Marshaler {
+			return ec.marshalOString2ᚖstring(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext___Field_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("__Field", field, true, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext___Field_args(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Args, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []introspection.InputValue) graphql.Marshaler {
+			return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext___Field_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields___InputValue(ctx, field)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Field_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext___Field_type(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Type, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *introspection.Type) graphql.Marshaler {
+			return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext___Field_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields___Type(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext___Field_isDeprecated(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.IsDeprecated(), nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v bool) graphql.Marshaler {
+			return ec.marshalNBoolean2bool(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext___Field_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("__Field", field, true, false, errors.New("field of type Boolean does not have child fields"))
+}
+
+func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext___Field_deprecationReason(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.DeprecationReason(), nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *string) graphql.Marshaler {
+			return ec.marshalOString2ᚖstring(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext___Field_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("__Field", field, true, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext___InputValue_name(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext___InputValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("__InputValue", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext___InputValue_description(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *string) graphql.Marshaler {
+			return ec.marshalOString2ᚖstring(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext___InputValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("__InputValue", field, true, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext___InputValue_type(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Type, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *introspection.Type) graphql.Marshaler {
+			return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext___InputValue_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields___Type(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext___InputValue_defaultValue(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.DefaultValue, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *string) graphql.Marshaler {
+			return ec.marshalOString2ᚖstring(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext___InputValue_defaultValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("__InputValue", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) ___InputValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.IsDeprecated(), nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v bool) graphql.Marshaler {
+			return ec.marshalNBoolean2bool(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext___InputValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("__InputValue", field, true, false, errors.New("field of type Boolean does not have child fields"))
+}
+
+func (ec *executionContext) ___InputValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.DeprecationReason(), nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *string) graphql.Marshaler {
+			return ec.marshalOString2ᚖstring(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext___InputValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("__InputValue", field, true, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext___Schema_description(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *string) graphql.Marshaler {
+			return ec.marshalOString2ᚖstring(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext___Schema_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("__Schema", field, true, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext___Schema_types(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Types(), nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []introspection.Type) graphql.Marshaler {
+			return ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext___Schema_types(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields___Type(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext___Schema_queryType(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.QueryType(), nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *introspection.Type) graphql.Marshaler {
+			return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext___Schema_queryType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields___Type(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext___Schema_mutationType(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.MutationType(), nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *introspection.Type) graphql.Marshaler {
+			return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext___Schema_mutationType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields___Type(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext___Schema_subscriptionType(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.SubscriptionType(), nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *introspection.Type) graphql.Marshaler {
+			return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext___Schema_subscriptionType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields___Type(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext___Schema_directives(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Directives(), nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []introspection.Directive) graphql.Marshaler {
+			return ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext___Schema_directives(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields___Directive(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext___Type_kind(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Kind(), nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalN__TypeKind2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext___Type_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("__Type", field, true, false, errors.New("field of type __TypeKind does not have child fields"))
+}
+
+func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext___Type_name(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Name(), nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *string) graphql.Marshaler {
+			return ec.marshalOString2ᚖstring(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext___Type_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("__Type", field, true, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext___Type_description(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *string) graphql.Marshaler {
+			return ec.marshalOString2ᚖstring(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext___Type_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("__Type", field, true, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext___Type_specifiedByURL(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.SpecifiedByURL(), nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *string) graphql.Marshaler {
+			return ec.marshalOString2ᚖstring(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext___Type_specifiedByURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("__Type", field, true, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext___Type_fields(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []introspection.Field) graphql.Marshaler {
+			return ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields___Field(ctx, field)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext___Type_interfaces(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Interfaces(), nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []introspection.Type) graphql.Marshaler {
+			return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext___Type_interfaces(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields___Type(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext___Type_possibleTypes(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.PossibleTypes(), nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []introspection.Type) graphql.Marshaler {
+			return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext___Type_possibleTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields___Type(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext___Type_enumValues(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []introspection.EnumValue) graphql.Marshaler {
+			return ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields___EnumValue(ctx, field)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext___Type_inputFields(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.InputFields(), nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []introspection.InputValue) graphql.Marshaler {
+			return ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext___Type_inputFields(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields___InputValue(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext___Type_ofType(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.OfType(), nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *introspection.Type) graphql.Marshaler {
+			return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext___Type_ofType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields___Type(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_isOneOf(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext___Type_isOneOf(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.IsOneOf(), nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v bool) graphql.Marshaler {
+			return ec.marshalOBoolean2bool(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext___Type_isOneOf(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("__Type", field, true, false, errors.New("field of type Boolean does not have child fields"))
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+var activityHeatmapImplementors = []string{"ActivityHeatmap"}
+
+func (ec *executionContext) _ActivityHeatmap(ctx context.Context, sel ast.SelectionSet, obj *model.ActivityHeatmap) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, activityHeatmapImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ActivityHeatmap")
+		case "login":
+			out.Values[i] = ec._ActivityHeatmap_login(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "timeZone":
+			out.Values[i] = ec._ActivityHeatmap_timeZone(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "total":
+			out.Values[i] = ec._ActivityHeatmap_total(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "offHours":
+			out.Values[i] = ec._ActivityHeatmap_offHours(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "weekend":
+			out.Values[i] = ec._ActivityHeatmap_weekend(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "offHoursRatio":
+			out.Values[i] = ec._ActivityHeatmap_offHoursRatio(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "rows":
+			out.Values[i] = ec._ActivityHeatmap_rows(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferred), math.MaxInt32)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var categoryCountImplementors = []string{"CategoryCount"}
+
+func (ec *executionContext) _CategoryCount(ctx context.Context, sel ast.SelectionSet, obj *model.CategoryCount) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, categoryCountImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CategoryCount")
+		case "category":
+			out.Values[i] = ec._CategoryCount_category(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "count":
+			out.Values[i] = ec._CategoryCount_count(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferred), math.MaxInt32)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var collectionPeriodImplementors = []string{"CollectionPeriod"}
+
+func (ec *executionContext) _CollectionPeriod(ctx context.Context, sel ast.SelectionSet, obj *model.CollectionPeriod) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, collectionPeriodImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CollectionPeriod")
+		case "since":
+			out.Values[i] = ec._CollectionPeriod_since(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
+				out.Invalids++
+			}
+		case "until":
+			out.Values[i] = ec._CollectionPeriod_until(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferred), math.MaxInt32)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var cycleTimeStatsImplementors = []string{"CycleTimeStats"}
+
+func (ec *executionContext) _CycleTimeStats(ctx context.Context, sel ast.SelectionSet, obj *model.CycleTimeStats) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, cycleTimeStatsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CycleTimeStats")
+		case "prCount":
+			out.Values[i] = ec._CycleTimeStats_prCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "timeToFirstReviewHours":
+			out.Values[i] = ec._CycleTimeStats_timeToFirstReviewHours(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "timeToApprovalHours":
+			out.Values[i] = ec._CycleTimeStats_timeToApprovalHours(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "timeToMergeHours":
+			out.Values[i] = ec._CycleTimeStats_timeToMergeHours(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "timeToCloseHours":
+			out.Values[i] = ec._CycleTimeStats_timeToCloseHours(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "reviewRounds":
+			out.Values[i] = ec._CycleTimeStats_reviewRounds(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferred), math.MaxInt32)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var dailyStatisticsImplementors = []string{"DailyStatistics"}
+
+func (ec *executionContext) _DailyStatistics(ctx context.Context, sel ast.SelectionSet, obj *model.DailyStatistics) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, dailyStatisticsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DailyStatistics")
+		case "date":
+			out.Values[i] = ec._DailyStatistics_date(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "commitCount":
+			out.Values[i] = ec._DailyStatistics_commitCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "prCreated":
+			out.Values[i] = ec._DailyStatistics_prCreated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "prMerged":
+			out.Values[i] = ec._DailyStatistics_prMerged(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "issueCount":
+			out.Values[i] = ec._DailyStatistics_issueCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "reviewCount":
+			out.Values[i] = ec._DailyStatistics_reviewCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "totalAdditions":
+			out.Values[i] = ec._DailyStatistics_totalAdditions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "totalDeletions":
+			out.Values[i] = ec._DailyStatistics_totalDeletions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "issues":
+			out.Values[i] = ec._DailyStatistics_issues(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "categoryBreakdown":
+			out.Values[i] = ec._DailyStatistics_categoryBreakdown(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "openIssues":
+			out.Values[i] = ec._DailyStatistics_openIssues(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferred), math.MaxInt32)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var dataGapImplementors = []string{"DataGap"}
+
+func (ec *executionContext) _DataGap(ctx context.Context, sel ast.SelectionSet, obj *model.DataGap) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, dataGapImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DataGap")
+		case "activityType":
+			out.Values[i] = ec._DataGap_activityType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "repository":
+			out.Values[i] = ec._DataGap_repository(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "reason":
+			out.Values[i] = ec._DataGap_reason(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "message":
+			out.Values[i] = ec._DataGap_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferred), math.MaxInt32)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var deliveryMetricsImplementors = []string{"DeliveryMetrics"}
+
+func (ec *executionContext) _DeliveryMetrics(ctx context.Context, sel ast.SelectionSet, obj *model.DeliveryMetrics) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, deliveryMetricsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DeliveryMetrics")
+		case "repository":
+			out.Values[i] = ec._DeliveryMetrics_repository(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "source":
+			out.Values[i] = ec._DeliveryMetrics_source(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
+				out.Invalids++
+			}
+		case "deploymentCount":
+			out.Values[i] = ec._DeliveryMetrics_deploymentCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "failedDeploymentCount":
+			out.Values[i] = ec._DeliveryMetrics_failedDeploymentCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "weekCount":
+			out.Values[i] = ec._DeliveryMetrics_weekCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deploymentsPerWeek":
+			out.Values[i] = ec._DeliveryMetrics_deploymentsPerWeek(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "leadTimeHours":
+			out.Values[i] = ec._DeliveryMetrics_leadTimeHours(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
+				out.Invalids++
+			}
+		case "changeFailureRate":
+			out.Values[i] = ec._DeliveryMetrics_changeFailureRate(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
+				out.Invalids++
+			}
+		case "timeToRestoreHours":
+			out.Values[i] = ec._DeliveryMetrics_timeToRestoreHours(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
+				out.Invalids++
+			}
+		case "weeks":
+			out.Values[i] = ec._DeliveryMetrics_weeks(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferred), math.MaxInt32)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var deliveryWeekImplementors = []string{"DeliveryWeek"}
+
+func (ec *executionContext) _DeliveryWeek(ctx context.Context, sel ast.SelectionSet, obj *model.DeliveryWeek) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, deliveryWeekImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DeliveryWeek")
+		case "week":
+			out.Values[i] = ec._DeliveryWeek_week(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "source":
+			out.Values[i] = ec._DeliveryWeek_source(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deploymentCount":
+			out.Values[i] = ec._DeliveryWeek_deploymentCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "failedDeploymentCount":
+			out.Values[i] = ec._DeliveryWeek_failedDeploymentCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "leadTimeSeconds":
+			out.Values[i] = ec._DeliveryWeek_leadTimeSeconds(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "leadTimeCount":
+			out.Values[i] = ec._DeliveryWeek_leadTimeCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "restoreSeconds":
+			out.Values[i] = ec._DeliveryWeek_restoreSeconds(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "restoreCount":
+			out.Values[i] = ec._DeliveryWeek_restoreCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferred), math.MaxInt32)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var excludedMemberImplementors = []string{"ExcludedMember"}
+
+func (ec *executionContext) _ExcludedMember(ctx context.Context, sel ast.SelectionSet, obj *model.ExcludedMember) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, excludedMemberImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ExcludedMember")
+		case "login":
+			out.Values[i] = ec._ExcludedMember_login(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "rule":
+			out.Values[i] = ec._ExcludedMember_rule(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferred), math.MaxInt32)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var heatmapRowImplementors = []string{"HeatmapRow"}
+
+func (ec *executionContext) _HeatmapRow(ctx context.Context, sel ast.SelectionSet, obj *model.HeatmapRow) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, heatmapRowImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("HeatmapRow")
+		case "weekday":
+			out.Values[i] = ec._HeatmapRow_weekday(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "counts":
+			out.Values[i] = ec._HeatmapRow_counts(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferred), math.MaxInt32)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var issueStatsImplementors = []string{"IssueStats"}
+
+func (ec *executionContext) _IssueStats(ctx context.Context, sel ast.SelectionSet, obj *model.IssueStats) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, issueStatsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("IssueStats")
+		case "closed":
+			out.Values[i] = ec._IssueStats_closed(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "timeToCloseHours":
+			out.Values[i] = ec._IssueStats_timeToCloseHours(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
+				out.Invalids++
+			}
+		case "bugOpened":
+			out.Values[i] = ec._IssueStats_bugOpened(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "featureOpened":
+			out.Values[i] = ec._IssueStats_featureOpened(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "bugClosed":
+			out.Values[i] = ec._IssueStats_bugClosed(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "featureClosed":
+			out.Values[i] = ec._IssueStats_featureClosed(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferred), math.MaxInt32)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var memberDeltaImplementors = []string{"MemberDelta"}
+
+func (ec *executionContext) _MemberDelta(ctx context.Context, sel ast.SelectionSet, obj *model.MemberDelta) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, memberDeltaImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MemberDelta")
+		case "login":
+			out.Values[i] = ec._MemberDelta_login(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "delta":
+			out.Values[i] = ec._MemberDelta_delta(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferred), math.MaxInt32)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var memberHistoryPointImplementors = []string{"MemberHistoryPoint"}
+
+func (ec *executionContext) _MemberHistoryPoint(ctx context.Context, sel ast.SelectionSet, obj *model.MemberHistoryPoint) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, memberHistoryPointImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MemberHistoryPoint")
+		case "snapshotId":
+			out.Values[i] = ec._MemberHistoryPoint_snapshotId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "capturedAt":
+			out.Values[i] = ec._MemberHistoryPoint_capturedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "stats":
+			out.Values[i] = ec._MemberHistoryPoint_stats(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferred), math.MaxInt32)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var memberStatsImplementors = []string{"MemberStats"}
+
+func (ec *executionContext) _MemberStats(ctx context.Context, sel ast.SelectionSet, obj *model.MemberStats) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, memberStatsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MemberStats")
+		case "login":
+			out.Values[i] = ec._MemberStats_login(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "name":
+			out.Values[i] = ec._MemberStats_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "totalCommits":
+			out.Values[i] = ec._MemberStats_totalCommits(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "totalPRCreated":
+			out.Values[i] = ec._MemberStats_totalPRCreated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "totalPRMerged":
+			out.Values[i] = ec._MemberStats_totalPRMerged(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "totalIssues":
+			out.Values[i] = ec._MemberStats_totalIssues(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "totalReviews":
+			out.Values[i] = ec._MemberStats_totalReviews(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "totalAdditions":
+			out.Values[i] = ec._MemberStats_totalAdditions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "totalDeletions":
+			out.Values[i] = ec._MemberStats_totalDeletions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "excludedReviews":
+			out.Values[i] = ec._MemberStats_excludedReviews(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "prToReviewRatio":
+			out.Values[i] = ec._MemberStats_prToReviewRatio(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "cycleTime":
+			out.Values[i] = ec._MemberStats_cycleTime(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "prSize":
+			out.Values[i] = ec._MemberStats_prSize(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "issues":
+			out.Values[i] = ec._MemberStats_issues(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "categoryBreakdown":
+			out.Values[i] = ec._MemberStats_categoryBreakdown(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "complete":
+			out.Values[i] = ec._MemberStats_complete(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "dataGaps":
+			out.Values[i] = ec._MemberStats_dataGaps(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferred), math.MaxInt32)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var metricDeltasImplementors = []string{"MetricDeltas"}
+
+func (ec *executionContext) _MetricDeltas(ctx context.Context, sel ast.SelectionSet, obj *model.MetricDeltas) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, metricDeltasImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MetricDeltas")
+		case "commits":
+			out.Values[i] = ec._MetricDeltas_commits(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "prCreated":
+			out.Values[i] = ec._MetricDeltas_prCreated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "prMerged":
+			out.Values[i] = ec._MetricDeltas_prMerged(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "issues":
+			out.Values[i] = ec._MetricDeltas_issues(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "reviews":
+			out.Values[i] = ec._MetricDeltas_reviews(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "additions":
+			out.Values[i] = ec._MetricDeltas_additions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deletions":
+			out.Values[i] = ec._MetricDeltas_deletions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferred), math.MaxInt32)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var pRSizeCountImplementors = []string{"PRSizeCount"}
+
+func (ec *executionContext) _PRSizeCount(ctx context.Context, sel ast.SelectionSet, obj *model.PRSizeCount) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, pRSizeCountImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PRSizeCount")
+		case "size":
+			out.Values[i] = ec._PRSizeCount_size(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "count":
+			out.Values[i] = ec._PRSizeCount_count(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferred), math.MaxInt32)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var pRSizeStatsImplementors = []string{"PRSizeStats"}
+
+func (ec *executionContext) _PRSizeStats(ctx context.Context, sel ast.SelectionSet, obj *model.PRSizeStats) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, pRSizeStatsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PRSizeStats")
+		case "prCount":
+			out.Values[i] = ec._PRSizeStats_prCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "histogram":
+			out.Values[i] = ec._PRSizeStats_histogram(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "changedLines":
+			out.Values[i] = ec._PRSizeStats_changedLines(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "changedFiles":
+			out.Values[i] = ec._PRSizeStats_changedFiles(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferred), math.MaxInt32)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var pendingReviewImplementors = []string{"PendingReview"}
+
+func (ec *executionContext) _PendingReview(ctx context.Context, sel ast.SelectionSet, obj *model.PendingReview) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, pendingReviewImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PendingReview")
+		case "repository":
+			out.Values[i] = ec._PendingReview_repository(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "author":
+			out.Values[i] = ec._PendingReview_author(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "requestedAt":
+			out.Values[i] = ec._PendingReview_requestedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferred), math.MaxInt32)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var percentilesImplementors = []string{"Percentiles"}
+
+func (ec *executionContext) _Percentiles(ctx context.Context, sel ast.SelectionSet, obj *model.Percentiles) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, percentilesImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Percentiles")
+		case "count":
+			out.Values[i] = ec._Percentiles_count(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "median":
+			out.Values[i] = ec._Percentiles_median(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "p90":
+			out.Values[i] = ec._Percentiles_p90(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferred), math.MaxInt32)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var queryImplementors = []string{"Query"}
+
+func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Query",
+	})
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+			Object: field.Name,
+			Field:  field,
+		})
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Query")
+		case "members":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_members(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "member":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_member(ctx, field)
+				if res == graphql.RequiredNull {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "teamSummary":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_teamSummary(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "teamDailyStats":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_teamDailyStats(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "repositories":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_repositories(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "repository":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_repository(ctx, field)
+				if res == graphql.RequiredNull {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "repositoryDailyStats":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_repositoryDailyStats(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "reviewNetwork":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_reviewNetwork(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "reviewLoad":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_reviewLoad(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "activityHeatmap":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_activityHeatmap(ctx, field)
+				if res == graphql.RequiredNull {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "deliveryMetrics":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_deliveryMetrics(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "snapshots":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_snapshots(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "snapshot":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_snapshot(ctx, field)
+				if res == graphql.RequiredNull {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "memberHistory":
+			field := field
 
-func (ec *executionContext) _UserStatistics_roleTransition(ctx context.Context, field graphql.CollectedField, obj *model.UserStatistics) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_UserStatistics_roleTransition(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.RoleTransition, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v []*model.RoleTransitionPoint) graphql.Marshaler {
-			return ec.marshalNRoleTransitionPoint2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRoleTransitionPointᚄ(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_UserStatistics_roleTransition(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "UserStatistics",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.childFields_RoleTransitionPoint(ctx, field)
-		},
-	}
-	return fc, nil
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_memberHistory(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) _YearlyStatistics_year(ctx context.Context, field graphql.CollectedField, obj *model.YearlyStatistics) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_YearlyStatistics_year(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.Year, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_YearlyStatistics_year(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("YearlyStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) _YearlyStatistics_commitCount(ctx context.Context, field graphql.CollectedField, obj *model.YearlyStatistics) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_YearlyStatistics_commitCount(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.CommitCount, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_YearlyStatistics_commitCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("YearlyStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "snapshotDiff":
+			field := field
 
-func (ec *executionContext) _YearlyStatistics_prCreated(ctx context.Context, field graphql.CollectedField, obj *model.YearlyStatistics) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_YearlyStatistics_prCreated(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.PrCreated, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_YearlyStatistics_prCreated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("YearlyStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_snapshotDiff(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) _YearlyStatistics_prMerged(ctx context.Context, field graphql.CollectedField, obj *model.YearlyStatistics) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_YearlyStatistics_prMerged(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.PrMerged, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_YearlyStatistics_prMerged(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("YearlyStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) _YearlyStatistics_issueCount(ctx context.Context, field graphql.CollectedField, obj *model.YearlyStatistics) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_YearlyStatistics_issueCount(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.IssueCount, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_YearlyStatistics_issueCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("YearlyStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "__type":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Query___type(ctx, field)
+			})
+			if out.Values[i] == graphql.RequiredNull {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "__schema":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Query___schema(ctx, field)
+			})
+			if out.Values[i] == graphql.RequiredNull {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-func (ec *executionContext) _YearlyStatistics_reviewCount(ctx context.Context, field graphql.CollectedField, obj *model.YearlyStatistics) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_YearlyStatistics_reviewCount(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.ReviewCount, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_YearlyStatistics_reviewCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("YearlyStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferred), math.MaxInt32)))
 
-func (ec *executionContext) _YearlyStatistics_totalAdditions(ctx context.Context, field graphql.CollectedField, obj *model.YearlyStatistics) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_YearlyStatistics_totalAdditions(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.TotalAdditions, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_YearlyStatistics_totalAdditions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("YearlyStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
-}
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
 
-func (ec *executionContext) _YearlyStatistics_totalDeletions(ctx context.Context, field graphql.CollectedField, obj *model.YearlyStatistics) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_YearlyStatistics_totalDeletions(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.TotalDeletions, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
-			return ec.marshalNInt2int(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext_YearlyStatistics_totalDeletions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("YearlyStatistics", field, false, false, errors.New("field of type Int does not have child fields"))
+	return out
 }
 
-func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext___Directive_name(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
-			return ec.marshalNString2string(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext___Directive_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("__Directive", field, false, false, errors.New("field of type String does not have child fields"))
-}
+var repositoryActivityImplementors = []string{"RepositoryActivity"}
 
-func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext___Directive_description(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v *string) graphql.Marshaler {
-			return ec.marshalOString2ᚖstring(ctx, selections, v)
-		},
-		true,
-		false,
-	)
-}
-func (ec *executionContext) fieldContext___Directive_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("__Directive", field, true, false, errors.New("field of type String does not have child fields"))
-}
+func (ec *executionContext) _RepositoryActivity(ctx context.Context, sel ast.SelectionSet, obj *model.RepositoryActivity) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, repositoryActivityImplementors)
 
-func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext___Directive_isRepeatable(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.IsRepeatable, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v bool) graphql.Marshaler {
-			return ec.marshalNBoolean2bool(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext___Directive_isRepeatable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("__Directive", field, false, false, errors.New("field of type Boolean does not have child fields"))
-}
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RepositoryActivity")
+		case "repository":
+			out.Values[i] = ec._RepositoryActivity_repository(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "commitCount":
+			out.Values[i] = ec._RepositoryActivity_commitCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "prCount":
+			out.Values[i] = ec._RepositoryActivity_prCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "issueCount":
+			out.Values[i] = ec._RepositoryActivity_issueCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "reviewCount":
+			out.Values[i] = ec._RepositoryActivity_reviewCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "totalAdditions":
+			out.Values[i] = ec._RepositoryActivity_totalAdditions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "totalDeletions":
+			out.Values[i] = ec._RepositoryActivity_totalDeletions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "firstActivity":
+			out.Values[i] = ec._RepositoryActivity_firstActivity(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "lastActivity":
+			out.Values[i] = ec._RepositoryActivity_lastActivity(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext___Directive_locations(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.Locations, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v []string) graphql.Marshaler {
-			return ec.marshalN__DirectiveLocation2ᚕstringᚄ(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext___Directive_locations(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("__Directive", field, false, false, errors.New("field of type __DirectiveLocation does not have child fields"))
-}
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferred), math.MaxInt32)))
 
-func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext___Directive_args(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.Args, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v []introspection.InputValue) graphql.Marshaler {
-			return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.childFields___InputValue(ctx, field)
-		},
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+
+	return out
+}
+
+var repositoryContributorImplementors = []string{"RepositoryContributor"}
+
+func (ec *executionContext) _RepositoryContributor(ctx context.Context, sel ast.SelectionSet, obj *model.RepositoryContributor) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, repositoryContributorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RepositoryContributor")
+		case "login":
+			out.Values[i] = ec._RepositoryContributor_login(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "commitCount":
+			out.Values[i] = ec._RepositoryContributor_commitCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "prCreated":
+			out.Values[i] = ec._RepositoryContributor_prCreated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "reviewCount":
+			out.Values[i] = ec._RepositoryContributor_reviewCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "additions":
+			out.Values[i] = ec._RepositoryContributor_additions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deletions":
+			out.Values[i] = ec._RepositoryContributor_deletions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "dailyStats":
+			out.Values[i] = ec._RepositoryContributor_dailyStats(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Directive_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
 	}
-	return fc, nil
-}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext___EnumValue_name(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
-			return ec.marshalNString2string(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext___EnumValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("__EnumValue", field, false, false, errors.New("field of type String does not have child fields"))
-}
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferred), math.MaxInt32)))
 
-func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext___EnumValue_description(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v *string) graphql.Marshaler {
-			return ec.marshalOString2ᚖstring(ctx, selections, v)
-		},
-		true,
-		false,
-	)
-}
-func (ec *executionContext) fieldContext___EnumValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("__EnumValue", field, true, false, errors.New("field of type String does not have child fields"))
-}
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
 
-func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.IsDeprecated(), nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v bool) graphql.Marshaler {
-			return ec.marshalNBoolean2bool(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext___EnumValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("__EnumValue", field, true, false, errors.New("field of type Boolean does not have child fields"))
+	return out
 }
 
-func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.DeprecationReason(), nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v *string) graphql.Marshaler {
-			return ec.marshalOString2ᚖstring(ctx, selections, v)
-		},
-		true,
-		false,
-	)
-}
-func (ec *executionContext) fieldContext___EnumValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("__EnumValue", field, true, false, errors.New("field of type String does not have child fields"))
-}
+var repositoryDailyStatsImplementors = []string{"RepositoryDailyStats"}
 
-func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext___Field_name(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
-			return ec.marshalNString2string(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext___Field_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("__Field", field, false, false, errors.New("field of type String does not have child fields"))
-}
+func (ec *executionContext) _RepositoryDailyStats(ctx context.Context, sel ast.SelectionSet, obj *model.RepositoryDailyStats) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, repositoryDailyStatsImplementors)
 
-func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext___Field_description(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v *string) graphql.Marshaler {
-			return ec.marshalOString2ᚖstring(ctx, selections, v)
-		},
-		true,
-		false,
-	)
-}
-func (ec *executionContext) fieldContext___Field_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("__Field", field, true, false, errors.New("field of type String does not have child fields"))
-}
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RepositoryDailyStats")
+		case "nameWithOwner":
+			out.Values[i] = ec._RepositoryDailyStats_nameWithOwner(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "owner":
+			out.Values[i] = ec._RepositoryDailyStats_owner(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "ownerType":
+			out.Values[i] = ec._RepositoryDailyStats_ownerType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "dailyStats":
+			out.Values[i] = ec._RepositoryDailyStats_dailyStats(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext___Field_args(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.Args, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v []introspection.InputValue) graphql.Marshaler {
-			return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext___Field_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.childFields___InputValue(ctx, field)
-		},
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferred), math.MaxInt32)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+
+	return out
+}
+
+var repositoryDeltaImplementors = []string{"RepositoryDelta"}
+
+func (ec *executionContext) _RepositoryDelta(ctx context.Context, sel ast.SelectionSet, obj *model.RepositoryDelta) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, repositoryDeltaImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RepositoryDelta")
+		case "nameWithOwner":
+			out.Values[i] = ec._RepositoryDelta_nameWithOwner(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "delta":
+			out.Values[i] = ec._RepositoryDelta_delta(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Field_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
 	}
-	return fc, nil
-}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext___Field_type(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.Type, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v *introspection.Type) graphql.Marshaler {
-			return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext___Field_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.childFields___Type(ctx, field)
-		},
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferred), math.MaxInt32)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext___Field_isDeprecated(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.IsDeprecated(), nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v bool) graphql.Marshaler {
-			return ec.marshalNBoolean2bool(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext___Field_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("__Field", field, true, false, errors.New("field of type Boolean does not have child fields"))
+	return out
 }
 
-func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext___Field_deprecationReason(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.DeprecationReason(), nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v *string) graphql.Marshaler {
-			return ec.marshalOString2ᚖstring(ctx, selections, v)
-		},
-		true,
-		false,
-	)
-}
-func (ec *executionContext) fieldContext___Field_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("__Field", field, true, false, errors.New("field of type String does not have child fields"))
-}
+var repositoryStatsImplementors = []string{"RepositoryStats"}
 
-func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext___InputValue_name(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
-			return ec.marshalNString2string(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext___InputValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("__InputValue", field, false, false, errors.New("field of type String does not have child fields"))
-}
+func (ec *executionContext) _RepositoryStats(ctx context.Context, sel ast.SelectionSet, obj *model.RepositoryStats) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, repositoryStatsImplementors)
 
-func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext___InputValue_description(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v *string) graphql.Marshaler {
-			return ec.marshalOString2ᚖstring(ctx, selections, v)
-		},
-		true,
-		false,
-	)
-}
-func (ec *executionContext) fieldContext___InputValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("__InputValue", field, true, false, errors.New("field of type String does not have child fields"))
-}
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RepositoryStats")
+		case "nameWithOwner":
+			out.Values[i] = ec._RepositoryStats_nameWithOwner(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "total":
+			out.Values[i] = ec._RepositoryStats_total(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "contributorCount":
+			out.Values[i] = ec._RepositoryStats_contributorCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "contributors":
+			out.Values[i] = ec._RepositoryStats_contributors(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "cycleTime":
+			out.Values[i] = ec._RepositoryStats_cycleTime(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "prSize":
+			out.Values[i] = ec._RepositoryStats_prSize(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "issues":
+			out.Values[i] = ec._RepositoryStats_issues(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "categoryBreakdown":
+			out.Values[i] = ec._RepositoryStats_categoryBreakdown(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "openIssueCount":
+			out.Values[i] = ec._RepositoryStats_openIssueCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext___InputValue_type(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.Type, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v *introspection.Type) graphql.Marshaler {
-			return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext___InputValue_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.childFields___Type(ctx, field)
-		},
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferred), math.MaxInt32)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
-
-func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext___InputValue_defaultValue(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.DefaultValue, nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v *string) graphql.Marshaler {
-			return ec.marshalOString2ᚖstring(ctx, selections, v)
-		},
-		true,
-		false,
-	)
-}
-func (ec *executionContext) fieldContext___InputValue_defaultValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("__InputValue", field, false, false, errors.New("field of type String does not have child fields"))
-}
 
-func (ec *executionContext) ___InputValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext___InputValue_isDeprecated(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.IsDeprecated(), nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v bool) graphql.Marshaler {
-			return ec.marshalNBoolean2bool(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext___InputValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("__InputValue", field, true, false, errors.New("field of type Boolean does not have child fields"))
+	return out
 }
 
-func (ec *executionContext) ___InputValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext___InputValue_deprecationReason(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.DeprecationReason(), nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v *string) graphql.Marshaler {
-			return ec.marshalOString2ᚖstring(ctx, selections, v)
-		},
-		true,
-		false,
-	)
-}
-func (ec *executionContext) fieldContext___InputValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("__InputValue", field, true, false, errors.New("field of type String does not have child fields"))
-}
+var repositoryTotalsImplementors = []string{"RepositoryTotals"}
 
-func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext___Schema_description(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v *string) graphql.Marshaler {
-			return ec.marshalOString2ᚖstring(ctx, selections, v)
-		},
-		true,
-		false,
-	)
-}
-func (ec *executionContext) fieldContext___Schema_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("__Schema", field, true, false, errors.New("field of type String does not have child fields"))
-}
+func (ec *executionContext) _RepositoryTotals(ctx context.Context, sel ast.SelectionSet, obj *model.RepositoryTotals) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, repositoryTotalsImplementors)
 
-func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext___Schema_types(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.Types(), nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v []introspection.Type) graphql.Marshaler {
-			return ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext___Schema_types(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.childFields___Type(ctx, field)
-		},
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RepositoryTotals")
+		case "commits":
+			out.Values[i] = ec._RepositoryTotals_commits(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "prCreated":
+			out.Values[i] = ec._RepositoryTotals_prCreated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "prMerged":
+			out.Values[i] = ec._RepositoryTotals_prMerged(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "issues":
+			out.Values[i] = ec._RepositoryTotals_issues(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "reviews":
+			out.Values[i] = ec._RepositoryTotals_reviews(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "additions":
+			out.Values[i] = ec._RepositoryTotals_additions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deletions":
+			out.Values[i] = ec._RepositoryTotals_deletions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext___Schema_queryType(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.QueryType(), nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v *introspection.Type) graphql.Marshaler {
-			return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext___Schema_queryType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.childFields___Type(ctx, field)
-		},
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferred), math.MaxInt32)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext___Schema_mutationType(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.MutationType(), nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v *introspection.Type) graphql.Marshaler {
-			return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, selections, v)
-		},
-		true,
-		false,
-	)
+	return out
 }
-func (ec *executionContext) fieldContext___Schema_mutationType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.childFields___Type(ctx, field)
-		},
+
+var reviewLoadImplementors = []string{"ReviewLoad"}
+
+func (ec *executionContext) _ReviewLoad(ctx context.Context, sel ast.SelectionSet, obj *model.ReviewLoad) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, reviewLoadImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ReviewLoad")
+		case "reviewer":
+			out.Values[i] = ec._ReviewLoad_reviewer(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "requested":
+			out.Values[i] = ec._ReviewLoad_requested(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "completed":
+			out.Values[i] = ec._ReviewLoad_completed(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "pending":
+			out.Values[i] = ec._ReviewLoad_pending(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "pendingReviews":
+			out.Values[i] = ec._ReviewLoad_pendingReviews(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext___Schema_subscriptionType(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.SubscriptionType(), nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v *introspection.Type) graphql.Marshaler {
-			return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, selections, v)
-		},
-		true,
-		false,
-	)
-}
-func (ec *executionContext) fieldContext___Schema_subscriptionType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.childFields___Type(ctx, field)
-		},
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferred), math.MaxInt32)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext___Schema_directives(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.Directives(), nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v []introspection.Directive) graphql.Marshaler {
-			return ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx, selections, v)
-		},
-		true,
-		true,
-	)
+	return out
 }
-func (ec *executionContext) fieldContext___Schema_directives(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.childFields___Directive(ctx, field)
-		},
+
+var reviewNetworkImplementors = []string{"ReviewNetwork"}
+
+func (ec *executionContext) _ReviewNetwork(ctx context.Context, sel ast.SelectionSet, obj *model.ReviewNetwork) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, reviewNetworkImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ReviewNetwork")
+		case "nodes":
+			out.Values[i] = ec._ReviewNetwork_nodes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "edges":
+			out.Values[i] = ec._ReviewNetwork_edges(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext___Type_kind(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.Kind(), nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
-			return ec.marshalN__TypeKind2string(ctx, selections, v)
-		},
-		true,
-		true,
-	)
-}
-func (ec *executionContext) fieldContext___Type_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("__Type", field, true, false, errors.New("field of type __TypeKind does not have child fields"))
-}
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferred), math.MaxInt32)))
 
-func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext___Type_name(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.Name(), nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v *string) graphql.Marshaler {
-			return ec.marshalOString2ᚖstring(ctx, selections, v)
-		},
-		true,
-		false,
-	)
-}
-func (ec *executionContext) fieldContext___Type_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("__Type", field, true, false, errors.New("field of type String does not have child fields"))
-}
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
 
-func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext___Type_description(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v *string) graphql.Marshaler {
-			return ec.marshalOString2ᚖstring(ctx, selections, v)
-		},
-		true,
-		false,
-	)
-}
-func (ec *executionContext) fieldContext___Type_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("__Type", field, true, false, errors.New("field of type String does not have child fields"))
+	return out
 }
 
-func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext___Type_specifiedByURL(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.SpecifiedByURL(), nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v *string) graphql.Marshaler {
-			return ec.marshalOString2ᚖstring(ctx, selections, v)
-		},
-		true,
-		false,
-	)
-}
-func (ec *executionContext) fieldContext___Type_specifiedByURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("__Type", field, true, false, errors.New("field of type String does not have child fields"))
-}
+var reviewNetworkEdgeImplementors = []string{"ReviewNetworkEdge"}
 
-func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext___Type_fields(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v []introspection.Field) graphql.Marshaler {
-			return ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ(ctx, selections, v)
-		},
-		true,
-		false,
-	)
-}
-func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.childFields___Field(ctx, field)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+func (ec *executionContext) _ReviewNetworkEdge(ctx context.Context, sel ast.SelectionSet, obj *model.ReviewNetworkEdge) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, reviewNetworkEdgeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ReviewNetworkEdge")
+		case "reviewer":
+			out.Values[i] = ec._ReviewNetworkEdge_reviewer(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "author":
+			out.Values[i] = ec._ReviewNetworkEdge_author(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "reviewCount":
+			out.Values[i] = ec._ReviewNetworkEdge_reviewCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "repositories":
+			out.Values[i] = ec._ReviewNetworkEdge_repositories(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
 	}
-	return fc, nil
-}
-
-func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext___Type_interfaces(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.Interfaces(), nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v []introspection.Type) graphql.Marshaler {
-			return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, selections, v)
-		},
-		true,
-		false,
-	)
-}
-func (ec *executionContext) fieldContext___Type_interfaces(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.childFields___Type(ctx, field)
-		},
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext___Type_possibleTypes(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.PossibleTypes(), nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v []introspection.Type) graphql.Marshaler {
-			return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, selections, v)
-		},
-		true,
-		false,
-	)
-}
-func (ec *executionContext) fieldContext___Type_possibleTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.childFields___Type(ctx, field)
-		},
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferred), math.MaxInt32)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext___Type_enumValues(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v []introspection.EnumValue) graphql.Marshaler {
-			return ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx, selections, v)
-		},
-		true,
-		false,
-	)
+	return out
 }
-func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.childFields___EnumValue(ctx, field)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+
+var reviewNetworkNodeImplementors = []string{"ReviewNetworkNode"}
+
+func (ec *executionContext) _ReviewNetworkNode(ctx context.Context, sel ast.SelectionSet, obj *model.ReviewNetworkNode) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, reviewNetworkNodeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ReviewNetworkNode")
+		case "login":
+			out.Values[i] = ec._ReviewNetworkNode_login(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "reviewsGiven":
+			out.Values[i] = ec._ReviewNetworkNode_reviewsGiven(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "reviewsReceived":
+			out.Values[i] = ec._ReviewNetworkNode_reviewsReceived(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
 	}
-	return fc, nil
-}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext___Type_inputFields(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.InputFields(), nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v []introspection.InputValue) graphql.Marshaler {
-			return ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, selections, v)
-		},
-		true,
-		false,
-	)
-}
-func (ec *executionContext) fieldContext___Type_inputFields(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.childFields___InputValue(ctx, field)
-		},
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferred), math.MaxInt32)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext___Type_ofType(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.OfType(), nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v *introspection.Type) graphql.Marshaler {
-			return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, selections, v)
-		},
-		true,
-		false,
-	)
+	return out
 }
-func (ec *executionContext) fieldContext___Type_ofType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.childFields___Type(ctx, field)
-		},
+
+var roleTransitionPointImplementors = []string{"RoleTransitionPoint"}
+
+func (ec *executionContext) _RoleTransitionPoint(ctx context.Context, sel ast.SelectionSet, obj *model.RoleTransitionPoint) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, roleTransitionPointImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RoleTransitionPoint")
+		case "year":
+			out.Values[i] = ec._RoleTransitionPoint_year(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "prCreated":
+			out.Values[i] = ec._RoleTransitionPoint_prCreated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "reviewCount":
+			out.Values[i] = ec._RoleTransitionPoint_reviewCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "ratio":
+			out.Values[i] = ec._RoleTransitionPoint_ratio(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "description":
+			out.Values[i] = ec._RoleTransitionPoint_description(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
-
-func (ec *executionContext) ___Type_isOneOf(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext___Type_isOneOf(ctx, field)
-		},
-		func(ctx context.Context) (any, error) {
-			return obj.IsOneOf(), nil
-		},
-		nil,
-		func(ctx context.Context, selections ast.SelectionSet, v bool) graphql.Marshaler {
-			return ec.marshalOBoolean2bool(ctx, selections, v)
-		},
-		true,
-		false,
-	)
-}
-func (ec *executionContext) fieldContext___Type_isOneOf(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	return graphql.NewScalarFieldContext("__Type", field, true, false, errors.New("field of type Boolean does not have child fields"))
-}
-
-// endregion **************************** field.gotpl *****************************
-
-// region    **************************** input.gotpl *****************************
-
-// endregion **************************** input.gotpl *****************************
 
-// region    ************************** interface.gotpl ***************************
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferred), math.MaxInt32)))
 
-// endregion ************************** interface.gotpl ***************************
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
 
-// region    **************************** object.gotpl ****************************
+	return out
+}
 
-var dailyStatisticsImplementors = []string{"DailyStatistics"}
+var snapshotImplementors = []string{"Snapshot"}
 
-func (ec *executionContext) _DailyStatistics(ctx context.Context, sel ast.SelectionSet, obj *model.DailyStatistics) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, dailyStatisticsImplementors)
+func (ec *executionContext) _Snapshot(ctx context.Context, sel ast.SelectionSet, obj *model.Snapshot) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, snapshotImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("DailyStatistics")
-		case "date":
-			out.Values[i] = ec._DailyStatistics_date(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("Snapshot")
+		case "id":
+			out.Values[i] = ec._Snapshot_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "commitCount":
-			out.Values[i] = ec._DailyStatistics_commitCount(ctx, field, obj)
+		case "capturedAt":
+			out.Values[i] = ec._Snapshot_capturedAt(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "prCreated":
-			out.Values[i] = ec._DailyStatistics_prCreated(ctx, field, obj)
+		case "tag":
+			out.Values[i] = ec._Snapshot_tag(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
+				out.Invalids++
+			}
+		case "memberCount":
+			out.Values[i] = ec._Snapshot_memberCount(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "prMerged":
-			out.Values[i] = ec._DailyStatistics_prMerged(ctx, field, obj)
+		case "repositoryCount":
+			out.Values[i] = ec._Snapshot_repositoryCount(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "issueCount":
-			out.Values[i] = ec._DailyStatistics_issueCount(ctx, field, obj)
+		case "excludedMembers":
+			out.Values[i] = ec._Snapshot_excludedMembers(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "reviewCount":
-			out.Values[i] = ec._DailyStatistics_reviewCount(ctx, field, obj)
+		case "period":
+			out.Values[i] = ec._Snapshot_period(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "totalAdditions":
-			out.Values[i] = ec._DailyStatistics_totalAdditions(ctx, field, obj)
+		case "members":
+			out.Values[i] = ec._Snapshot_members(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "totalDeletions":
-			out.Values[i] = ec._DailyStatistics_totalDeletions(ctx, field, obj)
+		case "teamSummary":
+			out.Values[i] = ec._Snapshot_teamSummary(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "repositories":
+			out.Values[i] = ec._Snapshot_repositories(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -4940,64 +13009,54 @@ func (ec *executionContext) _DailyStatistics(ctx context.Context, sel ast.Select
 	return out
 }
 
-var memberStatsImplementors = []string{"MemberStats"}
+var snapshotDiffImplementors = []string{"SnapshotDiff"}
 
-func (ec *executionContext) _MemberStats(ctx context.Context, sel ast.SelectionSet, obj *model.MemberStats) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, memberStatsImplementors)
+func (ec *executionContext) _SnapshotDiff(ctx context.Context, sel ast.SelectionSet, obj *model.SnapshotDiff) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, snapshotDiffImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("MemberStats")
-		case "login":
-			out.Values[i] = ec._MemberStats_login(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "name":
-			out.Values[i] = ec._MemberStats_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "totalCommits":
-			out.Values[i] = ec._MemberStats_totalCommits(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("SnapshotDiff")
+		case "base":
+			out.Values[i] = ec._SnapshotDiff_base(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "totalPRCreated":
-			out.Values[i] = ec._MemberStats_totalPRCreated(ctx, field, obj)
+		case "head":
+			out.Values[i] = ec._SnapshotDiff_head(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "totalPRMerged":
-			out.Values[i] = ec._MemberStats_totalPRMerged(ctx, field, obj)
+		case "addedMembers":
+			out.Values[i] = ec._SnapshotDiff_addedMembers(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "totalIssues":
-			out.Values[i] = ec._MemberStats_totalIssues(ctx, field, obj)
+		case "removedMembers":
+			out.Values[i] = ec._SnapshotDiff_removedMembers(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "totalReviews":
-			out.Values[i] = ec._MemberStats_totalReviews(ctx, field, obj)
+		case "addedRepositories":
+			out.Values[i] = ec._SnapshotDiff_addedRepositories(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "totalAdditions":
-			out.Values[i] = ec._MemberStats_totalAdditions(ctx, field, obj)
+		case "removedRepositories":
+			out.Values[i] = ec._SnapshotDiff_removedRepositories(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "totalDeletions":
-			out.Values[i] = ec._MemberStats_totalDeletions(ctx, field, obj)
+		case "memberDeltas":
+			out.Values[i] = ec._SnapshotDiff_memberDeltas(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "prToReviewRatio":
-			out.Values[i] = ec._MemberStats_prToReviewRatio(ctx, field, obj)
+		case "repositoryDeltas":
+			out.Values[i] = ec._SnapshotDiff_repositoryDeltas(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -5024,192 +13083,145 @@ func (ec *executionContext) _MemberStats(ctx context.Context, sel ast.SelectionS
 	return out
 }
 
-var queryImplementors = []string{"Query"}
+var snapshotInfoImplementors = []string{"SnapshotInfo"}
 
-func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Query",
-	})
+func (ec *executionContext) _SnapshotInfo(ctx context.Context, sel ast.SelectionSet, obj *model.SnapshotInfo) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, snapshotInfoImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
-		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
-			Object: field.Name,
-			Field:  field,
-		})
-
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Query")
-		case "members":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_members(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
+			out.Values[i] = graphql.MarshalString("SnapshotInfo")
+		case "id":
+			out.Values[i] = ec._SnapshotInfo_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "capturedAt":
+			out.Values[i] = ec._SnapshotInfo_capturedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "member":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_member(ctx, field)
-				if res == graphql.RequiredNull {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
+		case "tag":
+			out.Values[i] = ec._SnapshotInfo_tag(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
+				out.Invalids++
 			}
-
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "memberCount":
+			out.Values[i] = ec._SnapshotInfo_memberCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "repositoryCount":
+			out.Values[i] = ec._SnapshotInfo_repositoryCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "excludedMembers":
+			out.Values[i] = ec._SnapshotInfo_excludedMembers(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "period":
+			out.Values[i] = ec._SnapshotInfo_period(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "teamSummary":
-			field := field
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferred), math.MaxInt32)))
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_teamSummary(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
+	return out
+}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "teamDailyStats":
-			field := field
+var teamSummaryImplementors = []string{"TeamSummary"}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_teamDailyStats(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
+func (ec *executionContext) _TeamSummary(ctx context.Context, sel ast.SelectionSet, obj *model.TeamSummary) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, teamSummaryImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TeamSummary")
+		case "memberCount":
+			out.Values[i] = ec._TeamSummary_memberCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "repositoryCount":
+			out.Values[i] = ec._TeamSummary_repositoryCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "totalCommits":
+			out.Values[i] = ec._TeamSummary_totalCommits(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "totalPRCreated":
+			out.Values[i] = ec._TeamSummary_totalPRCreated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "repositories":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_repositories(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
+		case "totalPRMerged":
+			out.Values[i] = ec._TeamSummary_totalPRMerged(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "totalIssues":
+			out.Values[i] = ec._TeamSummary_totalIssues(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "repository":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_repository(ctx, field)
-				if res == graphql.RequiredNull {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
+		case "totalReviews":
+			out.Values[i] = ec._TeamSummary_totalReviews(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "totalAdditions":
+			out.Values[i] = ec._TeamSummary_totalAdditions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "repositoryDailyStats":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_repositoryDailyStats(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
+		case "totalDeletions":
+			out.Values[i] = ec._TeamSummary_totalDeletions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "issues":
+			out.Values[i] = ec._TeamSummary_issues(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "__type":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Query___type(ctx, field)
-			})
-			if out.Values[i] == graphql.RequiredNull {
-				atomic.AddUint32(&out.Invalids, 1)
+		case "categoryBreakdown":
+			out.Values[i] = ec._TeamSummary_categoryBreakdown(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-		case "__schema":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Query___schema(ctx, field)
-			})
-			if out.Values[i] == graphql.RequiredNull {
-				atomic.AddUint32(&out.Invalids, 1)
+		case "openIssueCount":
+			out.Values[i] = ec._TeamSummary_openIssueCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
@@ -5234,59 +13246,144 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 	return out
 }
 
-var repositoryActivityImplementors = []string{"RepositoryActivity"}
+var userStatisticsImplementors = []string{"UserStatistics"}
 
-func (ec *executionContext) _RepositoryActivity(ctx context.Context, sel ast.SelectionSet, obj *model.RepositoryActivity) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, repositoryActivityImplementors)
+func (ec *executionContext) _UserStatistics(ctx context.Context, sel ast.SelectionSet, obj *model.UserStatistics) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, userStatisticsImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("RepositoryActivity")
-		case "repository":
-			out.Values[i] = ec._RepositoryActivity_repository(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("UserStatistics")
+		case "login":
+			out.Values[i] = ec._UserStatistics_login(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "commitCount":
-			out.Values[i] = ec._RepositoryActivity_commitCount(ctx, field, obj)
+		case "name":
+			out.Values[i] = ec._UserStatistics_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "prCount":
-			out.Values[i] = ec._RepositoryActivity_prCount(ctx, field, obj)
+		case "logins":
+			out.Values[i] = ec._UserStatistics_logins(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "issueCount":
-			out.Values[i] = ec._RepositoryActivity_issueCount(ctx, field, obj)
+		case "totalCommits":
+			out.Values[i] = ec._UserStatistics_totalCommits(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "reviewCount":
-			out.Values[i] = ec._RepositoryActivity_reviewCount(ctx, field, obj)
+		case "totalPRCreated":
+			out.Values[i] = ec._UserStatistics_totalPRCreated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "totalPRMerged":
+			out.Values[i] = ec._UserStatistics_totalPRMerged(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "totalIssues":
+			out.Values[i] = ec._UserStatistics_totalIssues(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "totalReviews":
+			out.Values[i] = ec._UserStatistics_totalReviews(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
 		case "totalAdditions":
-			out.Values[i] = ec._RepositoryActivity_totalAdditions(ctx, field, obj)
+			out.Values[i] = ec._UserStatistics_totalAdditions(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
 		case "totalDeletions":
-			out.Values[i] = ec._RepositoryActivity_totalDeletions(ctx, field, obj)
+			out.Values[i] = ec._UserStatistics_totalDeletions(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "firstActivity":
-			out.Values[i] = ec._RepositoryActivity_firstActivity(ctx, field, obj)
+		case "excludedReviews":
+			out.Values[i] = ec._UserStatistics_excludedReviews(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "lastActivity":
-			out.Values[i] = ec._RepositoryActivity_lastActivity(ctx, field, obj)
+		case "prToReviewRatio":
+			out.Values[i] = ec._UserStatistics_prToReviewRatio(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "firstActivityYear":
+			out.Values[i] = ec._UserStatistics_firstActivityYear(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "peakActivityYear":
+			out.Values[i] = ec._UserStatistics_peakActivityYear(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "peakActivityCommits":
+			out.Values[i] = ec._UserStatistics_peakActivityCommits(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "yearlyStats":
+			out.Values[i] = ec._UserStatistics_yearlyStats(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "dailyStats":
+			out.Values[i] = ec._UserStatistics_dailyStats(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "topRepositories":
+			out.Values[i] = ec._UserStatistics_topRepositories(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "longTermRepositories":
+			out.Values[i] = ec._UserStatistics_longTermRepositories(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "roleTransition":
+			out.Values[i] = ec._UserStatistics_roleTransition(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "cycleTime":
+			out.Values[i] = ec._UserStatistics_cycleTime(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "prSize":
+			out.Values[i] = ec._UserStatistics_prSize(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "issues":
+			out.Values[i] = ec._UserStatistics_issues(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "categoryBreakdown":
+			out.Values[i] = ec._UserStatistics_categoryBreakdown(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "complete":
+			out.Values[i] = ec._UserStatistics_complete(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "dataGaps":
+			out.Values[i] = ec._UserStatistics_dataGaps(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -5313,49 +13410,54 @@ func (ec *executionContext) _RepositoryActivity(ctx context.Context, sel ast.Sel
 	return out
 }
 
-var repositoryContributorImplementors = []string{"RepositoryContributor"}
+var yearlyStatisticsImplementors = []string{"YearlyStatistics"}
 
-func (ec *executionContext) _RepositoryContributor(ctx context.Context, sel ast.SelectionSet, obj *model.RepositoryContributor) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, repositoryContributorImplementors)
+func (ec *executionContext) _YearlyStatistics(ctx context.Context, sel ast.SelectionSet, obj *model.YearlyStatistics) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, yearlyStatisticsImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("RepositoryContributor")
-		case "login":
-			out.Values[i] = ec._RepositoryContributor_login(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("YearlyStatistics")
+		case "year":
+			out.Values[i] = ec._YearlyStatistics_year(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
 		case "commitCount":
-			out.Values[i] = ec._RepositoryContributor_commitCount(ctx, field, obj)
+			out.Values[i] = ec._YearlyStatistics_commitCount(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
 		case "prCreated":
-			out.Values[i] = ec._RepositoryContributor_prCreated(ctx, field, obj)
+			out.Values[i] = ec._YearlyStatistics_prCreated(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "reviewCount":
-			out.Values[i] = ec._RepositoryContributor_reviewCount(ctx, field, obj)
+		case "prMerged":
+			out.Values[i] = ec._YearlyStatistics_prMerged(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "additions":
-			out.Values[i] = ec._RepositoryContributor_additions(ctx, field, obj)
+		case "issueCount":
+			out.Values[i] = ec._YearlyStatistics_issueCount(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "deletions":
-			out.Values[i] = ec._RepositoryContributor_deletions(ctx, field, obj)
+		case "reviewCount":
+			out.Values[i] = ec._YearlyStatistics_reviewCount(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "dailyStats":
-			out.Values[i] = ec._RepositoryContributor_dailyStats(ctx, field, obj)
+		case "totalAdditions":
+			out.Values[i] = ec._YearlyStatistics_totalAdditions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "totalDeletions":
+			out.Values[i] = ec._YearlyStatistics_totalDeletions(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -5382,34 +13484,39 @@ func (ec *executionContext) _RepositoryContributor(ctx context.Context, sel ast.
 	return out
 }
 
-var repositoryDailyStatsImplementors = []string{"RepositoryDailyStats"}
+var __DirectiveImplementors = []string{"__Directive"}
 
-func (ec *executionContext) _RepositoryDailyStats(ctx context.Context, sel ast.SelectionSet, obj *model.RepositoryDailyStats) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, repositoryDailyStatsImplementors)
+func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __DirectiveImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("RepositoryDailyStats")
-		case "nameWithOwner":
-			out.Values[i] = ec._RepositoryDailyStats_nameWithOwner(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("__Directive")
+		case "name":
+			out.Values[i] = ec.___Directive_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "owner":
-			out.Values[i] = ec._RepositoryDailyStats_owner(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___Directive_description(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
+				out.Invalids++
+			}
+		case "isRepeatable":
+			out.Values[i] = ec.___Directive_isRepeatable(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "ownerType":
-			out.Values[i] = ec._RepositoryDailyStats_ownerType(ctx, field, obj)
+		case "locations":
+			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "dailyStats":
-			out.Values[i] = ec._RepositoryDailyStats_dailyStats(ctx, field, obj)
+		case "args":
+			out.Values[i] = ec.___Directive_args(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -5436,35 +13543,35 @@ func (ec *executionContext) _RepositoryDailyStats(ctx context.Context, sel ast.S
 	return out
 }
 
-var repositoryStatsImplementors = []string{"RepositoryStats"}
+var __EnumValueImplementors = []string{"__EnumValue"}
 
-func (ec *executionContext) _RepositoryStats(ctx context.Context, sel ast.SelectionSet, obj *model.RepositoryStats) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, repositoryStatsImplementors)
+func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __EnumValueImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("RepositoryStats")
-		case "nameWithOwner":
-			out.Values[i] = ec._RepositoryStats_nameWithOwner(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("__EnumValue")
+		case "name":
+			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "total":
-			out.Values[i] = ec._RepositoryStats_total(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+		case "description":
+			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
 				out.Invalids++
 			}
-		case "contributorCount":
-			out.Values[i] = ec._RepositoryStats_contributorCount(ctx, field, obj)
+		case "isDeprecated":
+			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "contributors":
-			out.Values[i] = ec._RepositoryStats_contributors(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+		case "deprecationReason":
+			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
 				out.Invalids++
 			}
 		default:
@@ -5490,50 +13597,45 @@ func (ec *executionContext) _RepositoryStats(ctx context.Context, sel ast.Select
 	return out
 }
 
-var repositoryTotalsImplementors = []string{"RepositoryTotals"}
+var __FieldImplementors = []string{"__Field"}
 
-func (ec *executionContext) _RepositoryTotals(ctx context.Context, sel ast.SelectionSet, obj *model.RepositoryTotals) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, repositoryTotalsImplementors)
+func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __FieldImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("RepositoryTotals")
-		case "commits":
-			out.Values[i] = ec._RepositoryTotals_commits(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "prCreated":
-			out.Values[i] = ec._RepositoryTotals_prCreated(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("__Field")
+		case "name":
+			out.Values[i] = ec.___Field_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "prMerged":
-			out.Values[i] = ec._RepositoryTotals_prMerged(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+		case "description":
+			out.Values[i] = ec.___Field_description(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
 				out.Invalids++
 			}
-		case "issues":
-			out.Values[i] = ec._RepositoryTotals_issues(ctx, field, obj)
+		case "args":
+			out.Values[i] = ec.___Field_args(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "reviews":
-			out.Values[i] = ec._RepositoryTotals_reviews(ctx, field, obj)
+		case "type":
+			out.Values[i] = ec.___Field_type(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "additions":
-			out.Values[i] = ec._RepositoryTotals_additions(ctx, field, obj)
+		case "isDeprecated":
+			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "deletions":
-			out.Values[i] = ec._RepositoryTotals_deletions(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+		case "deprecationReason":
+			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
 				out.Invalids++
 			}
 		default:
@@ -5559,42 +13661,47 @@ func (ec *executionContext) _RepositoryTotals(ctx context.Context, sel ast.Selec
 	return out
 }
 
-var roleTransitionPointImplementors = []string{"RoleTransitionPoint"}
+var __InputValueImplementors = []string{"__InputValue"}
 
-func (ec *executionContext) _RoleTransitionPoint(ctx context.Context, sel ast.SelectionSet, obj *model.RoleTransitionPoint) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, roleTransitionPointImplementors)
+func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __InputValueImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("RoleTransitionPoint")
-		case "year":
-			out.Values[i] = ec._RoleTransitionPoint_year(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("__InputValue")
+		case "name":
+			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "prCreated":
-			out.Values[i] = ec._RoleTransitionPoint_prCreated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+		case "description":
+			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
 				out.Invalids++
 			}
-		case "reviewCount":
-			out.Values[i] = ec._RoleTransitionPoint_reviewCount(ctx, field, obj)
+		case "type":
+			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "ratio":
-			out.Values[i] = ec._RoleTransitionPoint_ratio(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+		case "defaultValue":
+			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
 				out.Invalids++
 			}
-		case "description":
-			out.Values[i] = ec._RoleTransitionPoint_description(ctx, field, obj)
+		case "isDeprecated":
+			out.Values[i] = ec.___InputValue_isDeprecated(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "deprecationReason":
+			out.Values[i] = ec.___InputValue_deprecationReason(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
+				out.Invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -5618,59 +13725,44 @@ func (ec *executionContext) _RoleTransitionPoint(ctx context.Context, sel ast.Se
 	return out
 }
 
-var teamSummaryImplementors = []string{"TeamSummary"}
+var __SchemaImplementors = []string{"__Schema"}
 
-func (ec *executionContext) _TeamSummary(ctx context.Context, sel ast.SelectionSet, obj *model.TeamSummary) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, teamSummaryImplementors)
+func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __SchemaImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("TeamSummary")
-		case "memberCount":
-			out.Values[i] = ec._TeamSummary_memberCount(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "repositoryCount":
-			out.Values[i] = ec._TeamSummary_repositoryCount(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "totalCommits":
-			out.Values[i] = ec._TeamSummary_totalCommits(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "totalPRCreated":
-			out.Values[i] = ec._TeamSummary_totalPRCreated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			out.Values[i] = graphql.MarshalString("__Schema")
+		case "description":
+			out.Values[i] = ec.___Schema_description(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
 				out.Invalids++
 			}
-		case "totalPRMerged":
-			out.Values[i] = ec._TeamSummary_totalPRMerged(ctx, field, obj)
+		case "types":
+			out.Values[i] = ec.___Schema_types(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "totalIssues":
-			out.Values[i] = ec._TeamSummary_totalIssues(ctx, field, obj)
+		case "queryType":
+			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "totalReviews":
-			out.Values[i] = ec._TeamSummary_totalReviews(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+		case "mutationType":
+			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
 				out.Invalids++
 			}
-		case "totalAdditions":
-			out.Values[i] = ec._TeamSummary_totalAdditions(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+		case "subscriptionType":
+			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
 				out.Invalids++
 			}
-		case "totalDeletions":
-			out.Values[i] = ec._TeamSummary_totalDeletions(ctx, field, obj)
+		case "directives":
+			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -5697,105 +13789,70 @@ func (ec *executionContext) _TeamSummary(ctx context.Context, sel ast.SelectionS
 	return out
 }
 
-var userStatisticsImplementors = []string{"UserStatistics"}
+var __TypeImplementors = []string{"__Type"}
 
-func (ec *executionContext) _UserStatistics(ctx context.Context, sel ast.SelectionSet, obj *model.UserStatistics) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, userStatisticsImplementors)
+func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __TypeImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("UserStatistics")
-		case "login":
-			out.Values[i] = ec._UserStatistics_login(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("__Type")
+		case "kind":
+			out.Values[i] = ec.___Type_kind(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
 		case "name":
-			out.Values[i] = ec._UserStatistics_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "totalCommits":
-			out.Values[i] = ec._UserStatistics_totalCommits(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "totalPRCreated":
-			out.Values[i] = ec._UserStatistics_totalPRCreated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "totalPRMerged":
-			out.Values[i] = ec._UserStatistics_totalPRMerged(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "totalIssues":
-			out.Values[i] = ec._UserStatistics_totalIssues(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "totalReviews":
-			out.Values[i] = ec._UserStatistics_totalReviews(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "totalAdditions":
-			out.Values[i] = ec._UserStatistics_totalAdditions(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "totalDeletions":
-			out.Values[i] = ec._UserStatistics_totalDeletions(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+			out.Values[i] = ec.___Type_name(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
 				out.Invalids++
 			}
-		case "prToReviewRatio":
-			out.Values[i] = ec._UserStatistics_prToReviewRatio(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+		case "description":
+			out.Values[i] = ec.___Type_description(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
 				out.Invalids++
 			}
-		case "firstActivityYear":
-			out.Values[i] = ec._UserStatistics_firstActivityYear(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+		case "specifiedByURL":
+			out.Values[i] = ec.___Type_specifiedByURL(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
 				out.Invalids++
 			}
-		case "peakActivityYear":
-			out.Values[i] = ec._UserStatistics_peakActivityYear(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+		case "fields":
+			out.Values[i] = ec.___Type_fields(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
 				out.Invalids++
 			}
-		case "peakActivityCommits":
-			out.Values[i] = ec._UserStatistics_peakActivityCommits(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+		case "interfaces":
+			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
 				out.Invalids++
 			}
-		case "yearlyStats":
-			out.Values[i] = ec._UserStatistics_yearlyStats(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+		case "possibleTypes":
+			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
 				out.Invalids++
 			}
-		case "dailyStats":
-			out.Values[i] = ec._UserStatistics_dailyStats(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+		case "enumValues":
+			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
 				out.Invalids++
 			}
-		case "topRepositories":
-			out.Values[i] = ec._UserStatistics_topRepositories(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+		case "inputFields":
+			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
 				out.Invalids++
 			}
-		case "longTermRepositories":
-			out.Values[i] = ec._UserStatistics_longTermRepositories(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+		case "ofType":
+			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
 				out.Invalids++
 			}
-		case "roleTransition":
-			out.Values[i] = ec._UserStatistics_roleTransition(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+		case "isOneOf":
+			out.Values[i] = ec.___Type_isOneOf(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
 				out.Invalids++
 			}
 		default:
@@ -5821,499 +13878,516 @@ func (ec *executionContext) _UserStatistics(ctx context.Context, sel ast.Selecti
 	return out
 }
 
-var yearlyStatisticsImplementors = []string{"YearlyStatistics"}
+// endregion **************************** object.gotpl ****************************
 
-func (ec *executionContext) _YearlyStatistics(ctx context.Context, sel ast.SelectionSet, obj *model.YearlyStatistics) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, yearlyStatisticsImplementors)
+// region    ***************************** type.gotpl *****************************
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("YearlyStatistics")
-		case "year":
-			out.Values[i] = ec._YearlyStatistics_year(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "commitCount":
-			out.Values[i] = ec._YearlyStatistics_commitCount(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "prCreated":
-			out.Values[i] = ec._YearlyStatistics_prCreated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "prMerged":
-			out.Values[i] = ec._YearlyStatistics_prMerged(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "issueCount":
-			out.Values[i] = ec._YearlyStatistics_issueCount(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "reviewCount":
-			out.Values[i] = ec._YearlyStatistics_reviewCount(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "totalAdditions":
-			out.Values[i] = ec._YearlyStatistics_totalAdditions(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "totalDeletions":
-			out.Values[i] = ec._YearlyStatistics_totalDeletions(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v any) (bool, error) {
+	res, err := graphql.UnmarshalBoolean(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalBoolean(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) marshalNCategoryCount2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐCategoryCountᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.CategoryCount) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNCategoryCount2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐCategoryCount(ctx, sel, v[i])
+	})
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNCategoryCount2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐCategoryCount(ctx context.Context, sel ast.SelectionSet, v *model.CategoryCount) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._CategoryCount(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNCollectionPeriod2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐCollectionPeriod(ctx context.Context, sel ast.SelectionSet, v *model.CollectionPeriod) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._CollectionPeriod(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNCycleTimeStats2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐCycleTimeStats(ctx context.Context, sel ast.SelectionSet, v *model.CycleTimeStats) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._CycleTimeStats(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNDailyStatistics2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐDailyStatisticsᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.DailyStatistics) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNDailyStatistics2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐDailyStatistics(ctx, sel, v[i])
+	})
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNDailyStatistics2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐDailyStatistics(ctx context.Context, sel ast.SelectionSet, v *model.DailyStatistics) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._DailyStatistics(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNDataGap2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐDataGapᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.DataGap) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNDataGap2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐDataGap(ctx, sel, v[i])
+	})
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNDataGap2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐDataGap(ctx context.Context, sel ast.SelectionSet, v *model.DataGap) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._DataGap(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNDeliveryMetrics2githubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐDeliveryMetrics(ctx context.Context, sel ast.SelectionSet, v model.DeliveryMetrics) graphql.Marshaler {
+	return ec._DeliveryMetrics(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNDeliveryMetrics2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐDeliveryMetrics(ctx context.Context, sel ast.SelectionSet, v *model.DeliveryMetrics) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._DeliveryMetrics(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNDeliverySource2githubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐDeliverySource(ctx context.Context, v any) (model.DeliverySource, error) {
+	var res model.DeliverySource
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNDeliverySource2githubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐDeliverySource(ctx context.Context, sel ast.SelectionSet, v model.DeliverySource) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNDeliveryWeek2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐDeliveryWeekᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.DeliveryWeek) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNDeliveryWeek2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐDeliveryWeek(ctx, sel, v[i])
+	})
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNDeliveryWeek2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐDeliveryWeek(ctx context.Context, sel ast.SelectionSet, v *model.DeliveryWeek) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._DeliveryWeek(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNExcludedMember2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐExcludedMemberᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ExcludedMember) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNExcludedMember2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐExcludedMember(ctx, sel, v[i])
+	})
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNExcludedMember2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐExcludedMember(ctx context.Context, sel ast.SelectionSet, v *model.ExcludedMember) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ExcludedMember(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v any) (float64, error) {
+	res, err := graphql.UnmarshalFloatContext(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalFloatContext(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
+	return graphql.WrapContextMarshaler(ctx, res)
+}
+
+func (ec *executionContext) marshalNHeatmapRow2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐHeatmapRowᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.HeatmapRow) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNHeatmapRow2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐHeatmapRow(ctx, sel, v[i])
+	})
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	atomic.AddInt32(&ec.Deferred, int32(min(len(deferred), math.MaxInt32)))
+	return ret
+}
 
-	for label, dfs := range deferred {
-		ec.ProcessDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNHeatmapRow2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐHeatmapRow(ctx context.Context, sel ast.SelectionSet, v *model.HeatmapRow) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
+	return ec._HeatmapRow(ctx, sel, v)
+}
 
-	return out
+func (ec *executionContext) unmarshalNID2string(ctx context.Context, v any) (string, error) {
+	res, err := graphql.UnmarshalID(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-var __DirectiveImplementors = []string{"__Directive"}
+func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalID(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
 
-func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __DirectiveImplementors)
+func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v any) (int, error) {
+	res, err := graphql.UnmarshalInt(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Directive")
-		case "name":
-			out.Values[i] = ec.___Directive_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___Directive_description(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
-				out.Invalids++
-			}
-		case "isRepeatable":
-			out.Values[i] = ec.___Directive_isRepeatable(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "locations":
-			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "args":
-			out.Values[i] = ec.___Directive_args(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalInt(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
+	return res
+}
+
+func (ec *executionContext) unmarshalNInt2ᚕintᚄ(ctx context.Context, v any) ([]int, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]int, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNInt2int(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
 	}
+	return res, nil
+}
 
-	atomic.AddInt32(&ec.Deferred, int32(min(len(deferred), math.MaxInt32)))
+func (ec *executionContext) marshalNInt2ᚕintᚄ(ctx context.Context, sel ast.SelectionSet, v []int) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNInt2int(ctx, sel, v[i])
+	})
 
-	for label, dfs := range deferred {
-		ec.ProcessDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	return out
+	return ret
 }
 
-var __EnumValueImplementors = []string{"__EnumValue"}
+func (ec *executionContext) marshalNIssueStats2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐIssueStats(ctx context.Context, sel ast.SelectionSet, v *model.IssueStats) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._IssueStats(ctx, sel, v)
+}
 
-func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __EnumValueImplementors)
+func (ec *executionContext) marshalNMemberDelta2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐMemberDeltaᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.MemberDelta) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNMemberDelta2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐMemberDelta(ctx, sel, v[i])
+	})
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__EnumValue")
-		case "name":
-			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
-				out.Invalids++
-			}
-		case "isDeprecated":
-			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "deprecationReason":
-			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+
+	return ret
+}
+
+func (ec *executionContext) marshalNMemberDelta2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐMemberDelta(ctx context.Context, sel ast.SelectionSet, v *model.MemberDelta) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
 		return graphql.Null
 	}
+	return ec._MemberDelta(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.Deferred, int32(min(len(deferred), math.MaxInt32)))
+func (ec *executionContext) marshalNMemberHistoryPoint2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐMemberHistoryPointᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.MemberHistoryPoint) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNMemberHistoryPoint2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐMemberHistoryPoint(ctx, sel, v[i])
+	})
 
-	for label, dfs := range deferred {
-		ec.ProcessDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	return out
+	return ret
 }
 
-var __FieldImplementors = []string{"__Field"}
+func (ec *executionContext) marshalNMemberHistoryPoint2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐMemberHistoryPoint(ctx context.Context, sel ast.SelectionSet, v *model.MemberHistoryPoint) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._MemberHistoryPoint(ctx, sel, v)
+}
 
-func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __FieldImplementors)
+func (ec *executionContext) marshalNMemberStats2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐMemberStatsᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.MemberStats) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNMemberStats2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐMemberStats(ctx, sel, v[i])
+	})
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Field")
-		case "name":
-			out.Values[i] = ec.___Field_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___Field_description(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
-				out.Invalids++
-			}
-		case "args":
-			out.Values[i] = ec.___Field_args(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "type":
-			out.Values[i] = ec.___Field_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "isDeprecated":
-			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "deprecationReason":
-			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+
+	return ret
+}
+
+func (ec *executionContext) marshalNMemberStats2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐMemberStats(ctx context.Context, sel ast.SelectionSet, v *model.MemberStats) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
 		return graphql.Null
 	}
+	return ec._MemberStats(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.Deferred, int32(min(len(deferred), math.MaxInt32)))
-
-	for label, dfs := range deferred {
-		ec.ProcessDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNMetricDeltas2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐMetricDeltas(ctx context.Context, sel ast.SelectionSet, v *model.MetricDeltas) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
+	return ec._MetricDeltas(ctx, sel, v)
+}
 
-	return out
+func (ec *executionContext) unmarshalNPRSize2githubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐPRSize(ctx context.Context, v any) (model.PRSize, error) {
+	var res model.PRSize
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-var __InputValueImplementors = []string{"__InputValue"}
+func (ec *executionContext) marshalNPRSize2githubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐPRSize(ctx context.Context, sel ast.SelectionSet, v model.PRSize) graphql.Marshaler {
+	return v
+}
 
-func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __InputValueImplementors)
+func (ec *executionContext) marshalNPRSizeCount2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐPRSizeCountᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.PRSizeCount) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNPRSizeCount2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐPRSizeCount(ctx, sel, v[i])
+	})
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__InputValue")
-		case "name":
-			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
-				out.Invalids++
-			}
-		case "type":
-			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "defaultValue":
-			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
-				out.Invalids++
-			}
-		case "isDeprecated":
-			out.Values[i] = ec.___InputValue_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "deprecationReason":
-			out.Values[i] = ec.___InputValue_deprecationReason(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
 
-	atomic.AddInt32(&ec.Deferred, int32(min(len(deferred), math.MaxInt32)))
+	return ret
+}
 
-	for label, dfs := range deferred {
-		ec.ProcessDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNPRSizeCount2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐPRSizeCount(ctx context.Context, sel ast.SelectionSet, v *model.PRSizeCount) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
-
-	return out
+	return ec._PRSizeCount(ctx, sel, v)
 }
 
-var __SchemaImplementors = []string{"__Schema"}
-
-func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __SchemaImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Schema")
-		case "description":
-			out.Values[i] = ec.___Schema_description(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
-				out.Invalids++
-			}
-		case "types":
-			out.Values[i] = ec.___Schema_types(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "queryType":
-			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "mutationType":
-			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
-				out.Invalids++
-			}
-		case "subscriptionType":
-			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
-				out.Invalids++
-			}
-		case "directives":
-			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNPRSizeStats2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐPRSizeStats(ctx context.Context, sel ast.SelectionSet, v *model.PRSizeStats) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
 		return graphql.Null
 	}
+	return ec._PRSizeStats(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.Deferred, int32(min(len(deferred), math.MaxInt32)))
+func (ec *executionContext) marshalNPendingReview2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐPendingReviewᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.PendingReview) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNPendingReview2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐPendingReview(ctx, sel, v[i])
+	})
 
-	for label, dfs := range deferred {
-		ec.ProcessDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	return out
+	return ret
 }
 
-var __TypeImplementors = []string{"__Type"}
-
-func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __TypeImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Type")
-		case "kind":
-			out.Values[i] = ec.___Type_kind(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "name":
-			out.Values[i] = ec.___Type_name(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___Type_description(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
-				out.Invalids++
-			}
-		case "specifiedByURL":
-			out.Values[i] = ec.___Type_specifiedByURL(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
-				out.Invalids++
-			}
-		case "fields":
-			out.Values[i] = ec.___Type_fields(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
-				out.Invalids++
-			}
-		case "interfaces":
-			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
-				out.Invalids++
-			}
-		case "possibleTypes":
-			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
-				out.Invalids++
-			}
-		case "enumValues":
-			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
-				out.Invalids++
-			}
-		case "inputFields":
-			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
-				out.Invalids++
-			}
-		case "ofType":
-			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
-				out.Invalids++
-			}
-		case "isOneOf":
-			out.Values[i] = ec.___Type_isOneOf(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNPendingReview2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐPendingReview(ctx context.Context, sel ast.SelectionSet, v *model.PendingReview) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
 		return graphql.Null
 	}
+	return ec._PendingReview(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.Deferred, int32(min(len(deferred), math.MaxInt32)))
-
-	for label, dfs := range deferred {
-		ec.ProcessDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNPercentiles2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐPercentiles(ctx context.Context, sel ast.SelectionSet, v *model.Percentiles) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
-
-	return out
+	return ec._Percentiles(ctx, sel, v)
 }
 
-// endregion **************************** object.gotpl ****************************
+func (ec *executionContext) marshalNRepositoryActivity2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryActivityᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.RepositoryActivity) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNRepositoryActivity2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryActivity(ctx, sel, v[i])
+	})
 
-// region    ***************************** type.gotpl *****************************
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v any) (bool, error) {
-	res, err := graphql.UnmarshalBoolean(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	return ret
 }
 
-func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalBoolean(v)
-	if res == graphql.Null {
+func (ec *executionContext) marshalNRepositoryActivity2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryActivity(ctx context.Context, sel ast.SelectionSet, v *model.RepositoryActivity) graphql.Marshaler {
+	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
+		return graphql.Null
 	}
-	return res
+	return ec._RepositoryActivity(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNDailyStatistics2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐDailyStatisticsᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.DailyStatistics) graphql.Marshaler {
+func (ec *executionContext) marshalNRepositoryContributor2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryContributorᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.RepositoryContributor) graphql.Marshaler {
 	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
 		fc := graphql.GetFieldContext(ctx)
 		fc.Result = &v[i]
-		return ec.marshalNDailyStatistics2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐDailyStatistics(ctx, sel, v[i])
+		return ec.marshalNRepositoryContributor2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryContributor(ctx, sel, v[i])
 	})
 
 	for _, e := range ret {
@@ -6325,53 +14399,73 @@ func (ec *executionContext) marshalNDailyStatistics2ᚕᚖgithubᚗcomᚋTattsum
 	return ret
 }
 
-func (ec *executionContext) marshalNDailyStatistics2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐDailyStatistics(ctx context.Context, sel ast.SelectionSet, v *model.DailyStatistics) graphql.Marshaler {
+func (ec *executionContext) marshalNRepositoryContributor2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryContributor(ctx context.Context, sel ast.SelectionSet, v *model.RepositoryContributor) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._DailyStatistics(ctx, sel, v)
+	return ec._RepositoryContributor(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v any) (float64, error) {
-	res, err := graphql.UnmarshalFloatContext(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalNRepositoryDailyStats2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryDailyStatsᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.RepositoryDailyStats) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNRepositoryDailyStats2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryDailyStats(ctx, sel, v[i])
+	})
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
 }
 
-func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalFloatContext(v)
-	if res == graphql.Null {
+func (ec *executionContext) marshalNRepositoryDailyStats2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryDailyStats(ctx context.Context, sel ast.SelectionSet, v *model.RepositoryDailyStats) graphql.Marshaler {
+	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
+		return graphql.Null
 	}
-	return graphql.WrapContextMarshaler(ctx, res)
+	return ec._RepositoryDailyStats(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v any) (int, error) {
-	res, err := graphql.UnmarshalInt(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalNRepositoryDelta2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryDeltaᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.RepositoryDelta) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNRepositoryDelta2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryDelta(ctx, sel, v[i])
+	})
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
 }
 
-func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalInt(v)
-	if res == graphql.Null {
+func (ec *executionContext) marshalNRepositoryDelta2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryDelta(ctx context.Context, sel ast.SelectionSet, v *model.RepositoryDelta) graphql.Marshaler {
+	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
+		return graphql.Null
 	}
-	return res
+	return ec._RepositoryDelta(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNMemberStats2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐMemberStatsᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.MemberStats) graphql.Marshaler {
+func (ec *executionContext) marshalNRepositoryStats2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryStatsᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.RepositoryStats) graphql.Marshaler {
 	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
 		fc := graphql.GetFieldContext(ctx)
 		fc.Result = &v[i]
-		return ec.marshalNMemberStats2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐMemberStats(ctx, sel, v[i])
+		return ec.marshalNRepositoryStats2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryStats(ctx, sel, v[i])
 	})
 
 	for _, e := range ret {
@@ -6383,21 +14477,31 @@ func (ec *executionContext) marshalNMemberStats2ᚕᚖgithubᚗcomᚋTattsumᚋg
 	return ret
 }
 
-func (ec *executionContext) marshalNMemberStats2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐMemberStats(ctx context.Context, sel ast.SelectionSet, v *model.MemberStats) graphql.Marshaler {
+func (ec *executionContext) marshalNRepositoryStats2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryStats(ctx context.Context, sel ast.SelectionSet, v *model.RepositoryStats) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._MemberStats(ctx, sel, v)
+	return ec._RepositoryStats(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNRepositoryActivity2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryActivityᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.RepositoryActivity) graphql.Marshaler {
+func (ec *executionContext) marshalNRepositoryTotals2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryTotals(ctx context.Context, sel ast.SelectionSet, v *model.RepositoryTotals) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._RepositoryTotals(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNReviewLoad2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐReviewLoadᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ReviewLoad) graphql.Marshaler {
 	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
 		fc := graphql.GetFieldContext(ctx)
 		fc.Result = &v[i]
-		return ec.marshalNRepositoryActivity2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryActivity(ctx, sel, v[i])
+		return ec.marshalNReviewLoad2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐReviewLoad(ctx, sel, v[i])
 	})
 
 	for _, e := range ret {
@@ -6409,21 +14513,35 @@ func (ec *executionContext) marshalNRepositoryActivity2ᚕᚖgithubᚗcomᚋTatt
 	return ret
 }
 
-func (ec *executionContext) marshalNRepositoryActivity2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryActivity(ctx context.Context, sel ast.SelectionSet, v *model.RepositoryActivity) graphql.Marshaler {
+func (ec *executionContext) marshalNReviewLoad2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐReviewLoad(ctx context.Context, sel ast.SelectionSet, v *model.ReviewLoad) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._RepositoryActivity(ctx, sel, v)
+	return ec._ReviewLoad(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNRepositoryContributor2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryContributorᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.RepositoryContributor) graphql.Marshaler {
+func (ec *executionContext) marshalNReviewNetwork2githubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐReviewNetwork(ctx context.Context, sel ast.SelectionSet, v model.ReviewNetwork) graphql.Marshaler {
+	return ec._ReviewNetwork(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNReviewNetwork2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐReviewNetwork(ctx context.Context, sel ast.SelectionSet, v *model.ReviewNetwork) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ReviewNetwork(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNReviewNetworkEdge2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐReviewNetworkEdgeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ReviewNetworkEdge) graphql.Marshaler {
 	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
 		fc := graphql.GetFieldContext(ctx)
 		fc.Result = &v[i]
-		return ec.marshalNRepositoryContributor2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryContributor(ctx, sel, v[i])
+		return ec.marshalNReviewNetworkEdge2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐReviewNetworkEdge(ctx, sel, v[i])
 	})
 
 	for _, e := range ret {
@@ -6435,21 +14553,21 @@ func (ec *executionContext) marshalNRepositoryContributor2ᚕᚖgithubᚗcomᚋT
 	return ret
 }
 
-func (ec *executionContext) marshalNRepositoryContributor2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryContributor(ctx context.Context, sel ast.SelectionSet, v *model.RepositoryContributor) graphql.Marshaler {
+func (ec *executionContext) marshalNReviewNetworkEdge2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐReviewNetworkEdge(ctx context.Context, sel ast.SelectionSet, v *model.ReviewNetworkEdge) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._RepositoryContributor(ctx, sel, v)
+	return ec._ReviewNetworkEdge(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNRepositoryDailyStats2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryDailyStatsᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.RepositoryDailyStats) graphql.Marshaler {
+func (ec *executionContext) marshalNReviewNetworkNode2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐReviewNetworkNodeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ReviewNetworkNode) graphql.Marshaler {
 	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
 		fc := graphql.GetFieldContext(ctx)
 		fc.Result = &v[i]
-		return ec.marshalNRepositoryDailyStats2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryDailyStats(ctx, sel, v[i])
+		return ec.marshalNReviewNetworkNode2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐReviewNetworkNode(ctx, sel, v[i])
 	})
 
 	for _, e := range ret {
@@ -6461,21 +14579,21 @@ func (ec *executionContext) marshalNRepositoryDailyStats2ᚕᚖgithubᚗcomᚋTa
 	return ret
 }
 
-func (ec *executionContext) marshalNRepositoryDailyStats2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryDailyStats(ctx context.Context, sel ast.SelectionSet, v *model.RepositoryDailyStats) graphql.Marshaler {
+func (ec *executionContext) marshalNReviewNetworkNode2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐReviewNetworkNode(ctx context.Context, sel ast.SelectionSet, v *model.ReviewNetworkNode) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._RepositoryDailyStats(ctx, sel, v)
+	return ec._ReviewNetworkNode(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNRepositoryStats2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryStatsᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.RepositoryStats) graphql.Marshaler {
+func (ec *executionContext) marshalNRoleTransitionPoint2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRoleTransitionPointᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.RoleTransitionPoint) graphql.Marshaler {
 	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
 		fc := graphql.GetFieldContext(ctx)
 		fc.Result = &v[i]
-		return ec.marshalNRepositoryStats2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryStats(ctx, sel, v[i])
+		return ec.marshalNRoleTransitionPoint2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRoleTransitionPoint(ctx, sel, v[i])
 	})
 
 	for _, e := range ret {
@@ -6487,31 +14605,35 @@ func (ec *executionContext) marshalNRepositoryStats2ᚕᚖgithubᚗcomᚋTattsum
 	return ret
 }
 
-func (ec *executionContext) marshalNRepositoryStats2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryStats(ctx context.Context, sel ast.SelectionSet, v *model.RepositoryStats) graphql.Marshaler {
+func (ec *executionContext) marshalNRoleTransitionPoint2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRoleTransitionPoint(ctx context.Context, sel ast.SelectionSet, v *model.RoleTransitionPoint) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._RepositoryStats(ctx, sel, v)
+	return ec._RoleTransitionPoint(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNRepositoryTotals2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryTotals(ctx context.Context, sel ast.SelectionSet, v *model.RepositoryTotals) graphql.Marshaler {
+func (ec *executionContext) marshalNSnapshotDiff2githubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐSnapshotDiff(ctx context.Context, sel ast.SelectionSet, v model.SnapshotDiff) graphql.Marshaler {
+	return ec._SnapshotDiff(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNSnapshotDiff2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐSnapshotDiff(ctx context.Context, sel ast.SelectionSet, v *model.SnapshotDiff) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._RepositoryTotals(ctx, sel, v)
+	return ec._SnapshotDiff(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNRoleTransitionPoint2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRoleTransitionPointᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.RoleTransitionPoint) graphql.Marshaler {
+func (ec *executionContext) marshalNSnapshotInfo2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐSnapshotInfoᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.SnapshotInfo) graphql.Marshaler {
 	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
 		fc := graphql.GetFieldContext(ctx)
 		fc.Result = &v[i]
-		return ec.marshalNRoleTransitionPoint2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRoleTransitionPoint(ctx, sel, v[i])
+		return ec.marshalNSnapshotInfo2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐSnapshotInfo(ctx, sel, v[i])
 	})
 
 	for _, e := range ret {
@@ -6523,14 +14645,14 @@ func (ec *executionContext) marshalNRoleTransitionPoint2ᚕᚖgithubᚗcomᚋTat
 	return ret
 }
 
-func (ec *executionContext) marshalNRoleTransitionPoint2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRoleTransitionPoint(ctx context.Context, sel ast.SelectionSet, v *model.RoleTransitionPoint) graphql.Marshaler {
+func (ec *executionContext) marshalNSnapshotInfo2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐSnapshotInfo(ctx context.Context, sel ast.SelectionSet, v *model.SnapshotInfo) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._RoleTransitionPoint(ctx, sel, v)
+	return ec._SnapshotInfo(ctx, sel, v)
 }
 
 func (ec *executionContext) unmarshalNString2string(ctx context.Context, v any) (string, error) {
@@ -6549,6 +14671,37 @@ func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.S
 	return res
 }
 
+func (ec *executionContext) unmarshalNString2ᚕstringᚄ(ctx context.Context, v any) ([]string, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalNString2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNString2string(ctx, sel, v[i])
+	})
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) marshalNTeamSummary2githubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐTeamSummary(ctx context.Context, sel ast.SelectionSet, v model.TeamSummary) graphql.Marshaler {
 	return ec._TeamSummary(ctx, sel, &v)
 }
@@ -6563,6 +14716,26 @@ func (ec *executionContext) marshalNTeamSummary2ᚖgithubᚗcomᚋTattsumᚋgith
 	return ec._TeamSummary(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNWeekday2githubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐWeekday(ctx context.Context, v any) (model.Weekday, error) {
+	var res model.Weekday
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNWeekday2githubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐWeekday(ctx context.Context, sel ast.SelectionSet, v model.Weekday) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNWorkCategory2githubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐWorkCategory(ctx context.Context, v any) (model.WorkCategory, error) {
+	var res model.WorkCategory
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNWorkCategory2githubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐWorkCategory(ctx context.Context, sel ast.SelectionSet, v model.WorkCategory) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) marshalNYearlyStatistics2ᚕᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐYearlyStatisticsᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.YearlyStatistics) graphql.Marshaler {
 	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
 		fc := graphql.GetFieldContext(ctx)
@@ -6730,6 +14903,13 @@ func (ec *executionContext) marshalN__TypeKind2string(ctx context.Context, sel a
 	return res
 }
 
+func (ec *executionContext) marshalOActivityHeatmap2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐActivityHeatmap(ctx context.Context, sel ast.SelectionSet, v *model.ActivityHeatmap) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._ActivityHeatmap(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalOBoolean2bool(ctx context.Context, v any) (bool, error) {
 	res, err := graphql.UnmarshalBoolean(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -6760,6 +14940,91 @@ func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast
 	return res
 }
 
+func (ec *executionContext) unmarshalODeliverySource2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐDeliverySource(ctx context.Context, v any) (*model.DeliverySource, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.DeliverySource)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalODeliverySource2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐDeliverySource(ctx context.Context, sel ast.SelectionSet, v *model.DeliverySource) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalOFloat2ᚖfloat64(ctx context.Context, v any) (*float64, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalFloatContext(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOFloat2ᚖfloat64(ctx context.Context, sel ast.SelectionSet, v *float64) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	_ = sel
+	res := graphql.MarshalFloatContext(*v)
+	return graphql.WrapContextMarshaler(ctx, res)
+}
+
+func (ec *executionContext) unmarshalOGranularity2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐGranularity(ctx context.Context, v any) (*model.Granularity, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.Granularity)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOGranularity2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐGranularity(ctx context.Context, sel ast.SelectionSet, v *model.Granularity) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalOID2ᚖstring(ctx context.Context, v any) (*string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalID(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOID2ᚖstring(ctx context.Context, sel ast.SelectionSet, v *string) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	_ = sel
+	_ = ctx
+	res := graphql.MarshalID(*v)
+	return res
+}
+
+func (ec *executionContext) unmarshalOInt2ᚖint(ctx context.Context, v any) (*int, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalInt(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOInt2ᚖint(ctx context.Context, sel ast.SelectionSet, v *int) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	_ = sel
+	_ = ctx
+	res := graphql.MarshalInt(*v)
+	return res
+}
+
 func (ec *executionContext) marshalORepositoryStats2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐRepositoryStats(ctx context.Context, sel ast.SelectionSet, v *model.RepositoryStats) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -6767,6 +15032,13 @@ func (ec *executionContext) marshalORepositoryStats2ᚖgithubᚗcomᚋTattsumᚋ
 	return ec._RepositoryStats(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOSnapshot2ᚖgithubᚗcomᚋTattsumᚋgithubᚑanalyticsᚋgraphᚋmodelᚐSnapshot(ctx context.Context, sel ast.SelectionSet, v *model.Snapshot) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._Snapshot(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalOString2ᚖstring(ctx context.Context, v any) (*string, error) {
 	if v == nil {
 		return nil, nil
@@ -2,28 +2,169 @@
 
 package model
 
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+type ActivityHeatmap struct {
+	Login         string        `json:"login"`
+	TimeZone      string        `json:"timeZone"`
+	Total         int           `json:"total"`
+	OffHours      int           `json:"offHours"`
+	Weekend       int           `json:"weekend"`
+	OffHoursRatio float64       `json:"offHoursRatio"`
+	Rows          []*HeatmapRow `json:"rows"`
+}
+
+type CategoryCount struct {
+	Category WorkCategory `json:"category"`
+	Count    int          `json:"count"`
+}
+
+type CollectionPeriod struct {
+	Since *string `json:"since,omitempty"`
+	Until *string `json:"until,omitempty"`
+}
+
+type CycleTimeStats struct {
+	PrCount                int          `json:"prCount"`
+	TimeToFirstReviewHours *Percentiles `json:"timeToFirstReviewHours"`
+	TimeToApprovalHours    *Percentiles `json:"timeToApprovalHours"`
+	TimeToMergeHours       *Percentiles `json:"timeToMergeHours"`
+	TimeToCloseHours       *Percentiles `json:"timeToCloseHours"`
+	ReviewRounds           *Percentiles `json:"reviewRounds"`
+}
+
 type DailyStatistics struct {
-	Date           string `json:"date"`
-	CommitCount    int    `json:"commitCount"`
-	PrCreated      int    `json:"prCreated"`
-	PrMerged       int    `json:"prMerged"`
-	IssueCount     int    `json:"issueCount"`
-	ReviewCount    int    `json:"reviewCount"`
-	TotalAdditions int    `json:"totalAdditions"`
-	TotalDeletions int    `json:"totalDeletions"`
+	Date              string           `json:"date"`
+	CommitCount       int              `json:"commitCount"`
+	PrCreated         int              `json:"prCreated"`
+	PrMerged          int              `json:"prMerged"`
+	IssueCount        int              `json:"issueCount"`
+	ReviewCount       int              `json:"reviewCount"`
+	TotalAdditions    int              `json:"totalAdditions"`
+	TotalDeletions    int              `json:"totalDeletions"`
+	Issues            *IssueStats      `json:"issues"`
+	CategoryBreakdown []*CategoryCount `json:"categoryBreakdown"`
+	OpenIssues        *int             `json:"openIssues,omitempty"`
+}
+
+type DataGap struct {
+	ActivityType string `json:"activityType"`
+	Repository   string `json:"repository"`
+	Reason       string `json:"reason"`
+	Message      string `json:"message"`
+}
+
+type DeliveryMetrics struct {
+	Repository            string          `json:"repository"`
+	Source                *DeliverySource `json:"source,omitempty"`
+	DeploymentCount       int             `json:"deploymentCount"`
+	FailedDeploymentCount int             `json:"failedDeploymentCount"`
+	WeekCount             int             `json:"weekCount"`
+	DeploymentsPerWeek    float64         `json:"deploymentsPerWeek"`
+	LeadTimeHours         *float64        `json:"leadTimeHours,omitempty"`
+	ChangeFailureRate     *float64        `json:"changeFailureRate,omitempty"`
+	TimeToRestoreHours    *float64        `json:"timeToRestoreHours,omitempty"`
+	Weeks                 []*DeliveryWeek `json:"weeks"`
+}
+
+type DeliveryWeek struct {
+	Week                  string         `json:"week"`
+	Source                DeliverySource `json:"source"`
+	DeploymentCount       int            `json:"deploymentCount"`
+	FailedDeploymentCount int            `json:"failedDeploymentCount"`
+	LeadTimeSeconds       int            `json:"leadTimeSeconds"`
+	LeadTimeCount         int            `json:"leadTimeCount"`
+	RestoreSeconds        int            `json:"restoreSeconds"`
+	RestoreCount          int            `json:"restoreCount"`
+}
+
+type ExcludedMember struct {
+	Login string `json:"login"`
+	Rule  string `json:"rule"`
+}
+
+type HeatmapRow struct {
+	Weekday Weekday `json:"weekday"`
+	Counts  []int   `json:"counts"`
+}
+
+type IssueStats struct {
+	Closed           int      `json:"closed"`
+	TimeToCloseHours *float64 `json:"timeToCloseHours,omitempty"`
+	BugOpened        int      `json:"bugOpened"`
+	FeatureOpened    int      `json:"featureOpened"`
+	BugClosed        int      `json:"bugClosed"`
+	FeatureClosed    int      `json:"featureClosed"`
+}
+
+type MemberDelta struct {
+	Login string        `json:"login"`
+	Delta *MetricDeltas `json:"delta"`
+}
+
+type MemberHistoryPoint struct {
+	SnapshotID string       `json:"snapshotId"`
+	CapturedAt string       `json:"capturedAt"`
+	Stats      *MemberStats `json:"stats"`
 }
 
 type MemberStats struct {
-	Login           string  `json:"login"`
-	Name            string  `json:"name"`
-	TotalCommits    int     `json:"totalCommits"`
-	TotalPRCreated  int     `json:"totalPRCreated"`
-	TotalPRMerged   int     `json:"totalPRMerged"`
-	TotalIssues     int     `json:"totalIssues"`
-	TotalReviews    int     `json:"totalReviews"`
-	TotalAdditions  int     `json:"totalAdditions"`
-	TotalDeletions  int     `json:"totalDeletions"`
-	PrToReviewRatio float64 `json:"prToReviewRatio"`
+	Login             string           `json:"login"`
+	Name              string           `json:"name"`
+	TotalCommits      int              `json:"totalCommits"`
+	TotalPRCreated    int              `json:"totalPRCreated"`
+	TotalPRMerged     int              `json:"totalPRMerged"`
+	TotalIssues       int              `json:"totalIssues"`
+	TotalReviews      int              `json:"totalReviews"`
+	TotalAdditions    int              `json:"totalAdditions"`
+	TotalDeletions    int              `json:"totalDeletions"`
+	ExcludedReviews   int              `json:"excludedReviews"`
+	PrToReviewRatio   float64          `json:"prToReviewRatio"`
+	CycleTime         *CycleTimeStats  `json:"cycleTime"`
+	PrSize            *PRSizeStats     `json:"prSize"`
+	Issues            *IssueStats      `json:"issues"`
+	CategoryBreakdown []*CategoryCount `json:"categoryBreakdown"`
+	Complete          bool             `json:"complete"`
+	DataGaps          []*DataGap       `json:"dataGaps"`
+}
+
+type MetricDeltas struct {
+	Commits   int `json:"commits"`
+	PrCreated int `json:"prCreated"`
+	PrMerged  int `json:"prMerged"`
+	Issues    int `json:"issues"`
+	Reviews   int `json:"reviews"`
+	Additions int `json:"additions"`
+	Deletions int `json:"deletions"`
+}
+
+type PRSizeCount struct {
+	Size  PRSize `json:"size"`
+	Count int    `json:"count"`
+}
+
+type PRSizeStats struct {
+	PrCount      int            `json:"prCount"`
+	Histogram    []*PRSizeCount `json:"histogram"`
+	ChangedLines *Percentiles   `json:"changedLines"`
+	ChangedFiles *Percentiles   `json:"changedFiles"`
+}
+
+type PendingReview struct {
+	Repository  string `json:"repository"`
+	Author      string `json:"author"`
+	RequestedAt string `json:"requestedAt"`
+}
+
+type Percentiles struct {
+	Count  int     `json:"count"`
+	Median float64 `json:"median"`
+	P90    float64 `json:"p90"`
 }
 
 type Query struct {
@@ -58,11 +199,21 @@ type RepositoryDailyStats struct {
 	DailyStats    []*DailyStatistics `json:"dailyStats"`
 }
 
+type RepositoryDelta struct {
+	NameWithOwner string        `json:"nameWithOwner"`
+	Delta         *MetricDeltas `json:"delta"`
+}
+
 type RepositoryStats struct {
-	NameWithOwner    string                   `json:"nameWithOwner"`
-	Total            *RepositoryTotals        `json:"total"`
-	ContributorCount int                      `json:"contributorCount"`
-	Contributors     []*RepositoryContributor `json:"contributors"`
+	NameWithOwner     string                   `json:"nameWithOwner"`
+	Total             *RepositoryTotals        `json:"total"`
+	ContributorCount  int                      `json:"contributorCount"`
+	Contributors      []*RepositoryContributor `json:"contributors"`
+	CycleTime         *CycleTimeStats          `json:"cycleTime"`
+	PrSize            *PRSizeStats             `json:"prSize"`
+	Issues            *IssueStats              `json:"issues"`
+	CategoryBreakdown []*CategoryCount         `json:"categoryBreakdown"`
+	OpenIssueCount    int                      `json:"openIssueCount"`
 }
 
 type RepositoryTotals struct {
@@ -75,6 +226,32 @@ type RepositoryTotals struct {
 	Deletions int `json:"deletions"`
 }
 
+type ReviewLoad struct {
+	Reviewer       string           `json:"reviewer"`
+	Requested      int              `json:"requested"`
+	Completed      int              `json:"completed"`
+	Pending        int              `json:"pending"`
+	PendingReviews []*PendingReview `json:"pendingReviews"`
+}
+
+type ReviewNetwork struct {
+	Nodes []*ReviewNetworkNode `json:"nodes"`
+	Edges []*ReviewNetworkEdge `json:"edges"`
+}
+
+type ReviewNetworkEdge struct {
+	Reviewer     string   `json:"reviewer"`
+	Author       string   `json:"author"`
+	ReviewCount  int      `json:"reviewCount"`
+	Repositories []string `json:"repositories"`
+}
+
+type ReviewNetworkNode struct {
+	Login           string `json:"login"`
+	ReviewsGiven    int    `json:"reviewsGiven"`
+	ReviewsReceived int    `json:"reviewsReceived"`
+}
+
 type RoleTransitionPoint struct {
 	Year        int     `json:"year"`
 	PrCreated   int     `json:"prCreated"`
@@ -83,21 +260,59 @@ type RoleTransitionPoint struct {
 	Description string  `json:"description"`
 }
 
+type Snapshot struct {
+	ID              string             `json:"id"`
+	CapturedAt      string             `json:"capturedAt"`
+	Tag             *string            `json:"tag,omitempty"`
+	MemberCount     int                `json:"memberCount"`
+	RepositoryCount int                `json:"repositoryCount"`
+	ExcludedMembers []*ExcludedMember  `json:"excludedMembers"`
+	Period          *CollectionPeriod  `json:"period"`
+	Members         []*MemberStats     `json:"members"`
+	TeamSummary     *TeamSummary       `json:"teamSummary"`
+	Repositories    []*RepositoryStats `json:"repositories"`
+}
+
+type SnapshotDiff struct {
+	Base                *SnapshotInfo      `json:"base"`
+	Head                *SnapshotInfo      `json:"head"`
+	AddedMembers        []string           `json:"addedMembers"`
+	RemovedMembers      []string           `json:"removedMembers"`
+	AddedRepositories   []string           `json:"addedRepositories"`
+	RemovedRepositories []string           `json:"removedRepositories"`
+	MemberDeltas        []*MemberDelta     `json:"memberDeltas"`
+	RepositoryDeltas    []*RepositoryDelta `json:"repositoryDeltas"`
+}
+
+type SnapshotInfo struct {
+	ID              string            `json:"id"`
+	CapturedAt      string            `json:"capturedAt"`
+	Tag             *string           `json:"tag,omitempty"`
+	MemberCount     int               `json:"memberCount"`
+	RepositoryCount int               `json:"repositoryCount"`
+	ExcludedMembers []*ExcludedMember `json:"excludedMembers"`
+	Period          *CollectionPeriod `json:"period"`
+}
+
 type TeamSummary struct {
-	MemberCount     int `json:"memberCount"`
-	RepositoryCount int `json:"repositoryCount"`
-	TotalCommits    int `json:"totalCommits"`
-	TotalPRCreated  int `json:"totalPRCreated"`
-	TotalPRMerged   int `json:"totalPRMerged"`
-	TotalIssues     int `json:"totalIssues"`
-	TotalReviews    int `json:"totalReviews"`
-	TotalAdditions  int `json:"totalAdditions"`
-	TotalDeletions  int `json:"totalDeletions"`
+	MemberCount       int              `json:"memberCount"`
+	RepositoryCount   int              `json:"repositoryCount"`
+	TotalCommits      int              `json:"totalCommits"`
+	TotalPRCreated    int              `json:"totalPRCreated"`
+	TotalPRMerged     int              `json:"totalPRMerged"`
+	TotalIssues       int              `json:"totalIssues"`
+	TotalReviews      int              `json:"totalReviews"`
+	TotalAdditions    int              `json:"totalAdditions"`
+	TotalDeletions    int              `json:"totalDeletions"`
+	Issues            *IssueStats      `json:"issues"`
+	CategoryBreakdown []*CategoryCount `json:"categoryBreakdown"`
+	OpenIssueCount    int              `json:"openIssueCount"`
 }
 
 type UserStatistics struct {
 	Login                string                 `json:"login"`
 	Name                 string                 `json:"name"`
+	Logins               []string               `json:"logins"`
 	TotalCommits         int                    `json:"totalCommits"`
 	TotalPRCreated       int                    `json:"totalPRCreated"`
 	TotalPRMerged        int                    `json:"totalPRMerged"`
@@ -105,6 +320,7 @@ type UserStatistics struct {
 	TotalReviews         int                    `json:"totalReviews"`
 	TotalAdditions       int                    `json:"totalAdditions"`
 	TotalDeletions       int                    `json:"totalDeletions"`
+	ExcludedReviews      int                    `json:"excludedReviews"`
 	PrToReviewRatio      float64                `json:"prToReviewRatio"`
 	FirstActivityYear    int                    `json:"firstActivityYear"`
 	PeakActivityYear     int                    `json:"peakActivityYear"`
@@ -114,6 +330,12 @@ type UserStatistics struct {
 	TopRepositories      []*RepositoryActivity  `json:"topRepositories"`
 	LongTermRepositories []*RepositoryActivity  `json:"longTermRepositories"`
 	RoleTransition       []*RoleTransitionPoint `json:"roleTransition"`
+	CycleTime            *CycleTimeStats        `json:"cycleTime"`
+	PrSize               *PRSizeStats           `json:"prSize"`
+	Issues               *IssueStats            `json:"issues"`
+	CategoryBreakdown    []*CategoryCount       `json:"categoryBreakdown"`
+	Complete             bool                   `json:"complete"`
+	DataGaps             []*DataGap             `json:"dataGaps"`
 }
 
 type YearlyStatistics struct {
@@ -126,3 +348,308 @@ type YearlyStatistics struct {
 	TotalAdditions int `json:"totalAdditions"`
 	TotalDeletions int `json:"totalDeletions"`
 }
+
+type DeliverySource string
+
+const (
+	DeliverySourceDeployment DeliverySource = "DEPLOYMENT"
+	DeliverySourceRelease    DeliverySource = "RELEASE"
+	DeliverySourceTag        DeliverySource = "TAG"
+)
+
+var AllDeliverySource = []DeliverySource{
+	DeliverySourceDeployment,
+	DeliverySourceRelease,
+	DeliverySourceTag,
+}
+
+func (e DeliverySource) IsValid() bool {
+	switch e {
+	case DeliverySourceDeployment, DeliverySourceRelease, DeliverySourceTag:
+		return true
+	}
+	return false
+}
+
+func (e DeliverySource) String() string {
+	return string(e)
+}
+
+func (e *DeliverySource) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DeliverySource(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DeliverySource", str)
+	}
+	return nil
+}
+
+func (e DeliverySource) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *DeliverySource) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e DeliverySource) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type Granularity string
+
+const (
+	GranularityDay   Granularity = "DAY"
+	GranularityWeek  Granularity = "WEEK"
+	GranularityMonth Granularity = "MONTH"
+)
+
+var AllGranularity = []Granularity{
+	GranularityDay,
+	GranularityWeek,
+	GranularityMonth,
+}
+
+func (e Granularity) IsValid() bool {
+	switch e {
+	case GranularityDay, GranularityWeek, GranularityMonth:
+		return true
+	}
+	return false
+}
+
+func (e Granularity) String() string {
+	return string(e)
+}
+
+func (e *Granularity) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Granularity(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Granularity", str)
+	}
+	return nil
+}
+
+func (e Granularity) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *Granularity) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e Granularity) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type PRSize string
+
+const (
+	PRSizeXs PRSize = "XS"
+	PRSizeS  PRSize = "S"
+	PRSizeM  PRSize = "M"
+	PRSizeL  PRSize = "L"
+	PRSizeXl PRSize = "XL"
+)
+
+var AllPRSize = []PRSize{
+	PRSizeXs,
+	PRSizeS,
+	PRSizeM,
+	PRSizeL,
+	PRSizeXl,
+}
+
+func (e PRSize) IsValid() bool {
+	switch e {
+	case PRSizeXs, PRSizeS, PRSizeM, PRSizeL, PRSizeXl:
+		return true
+	}
+	return false
+}
+
+func (e PRSize) String() string {
+	return string(e)
+}
+
+func (e *PRSize) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PRSize(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PRSize", str)
+	}
+	return nil
+}
+
+func (e PRSize) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *PRSize) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e PRSize) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type Weekday string
+
+const (
+	WeekdayMonday    Weekday = "MONDAY"
+	WeekdayTuesday   Weekday = "TUESDAY"
+	WeekdayWednesday Weekday = "WEDNESDAY"
+	WeekdayThursday  Weekday = "THURSDAY"
+	WeekdayFriday    Weekday = "FRIDAY"
+	WeekdaySaturday  Weekday = "SATURDAY"
+	WeekdaySunday    Weekday = "SUNDAY"
+)
+
+var AllWeekday = []Weekday{
+	WeekdayMonday,
+	WeekdayTuesday,
+	WeekdayWednesday,
+	WeekdayThursday,
+	WeekdayFriday,
+	WeekdaySaturday,
+	WeekdaySunday,
+}
+
+func (e Weekday) IsValid() bool {
+	switch e {
+	case WeekdayMonday, WeekdayTuesday, WeekdayWednesday, WeekdayThursday, WeekdayFriday, WeekdaySaturday, WeekdaySunday:
+		return true
+	}
+	return false
+}
+
+func (e Weekday) String() string {
+	return string(e)
+}
+
+func (e *Weekday) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Weekday(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Weekday", str)
+	}
+	return nil
+}
+
+func (e Weekday) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *Weekday) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e Weekday) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type WorkCategory string
+
+const (
+	WorkCategoryFeature WorkCategory = "FEATURE"
+	WorkCategoryBug     WorkCategory = "BUG"
+	WorkCategoryChore   WorkCategory = "CHORE"
+	WorkCategoryDocs    WorkCategory = "DOCS"
+	WorkCategoryTest    WorkCategory = "TEST"
+	WorkCategoryInfra   WorkCategory = "INFRA"
+	WorkCategoryOther   WorkCategory = "OTHER"
+)
+
+var AllWorkCategory = []WorkCategory{
+	WorkCategoryFeature,
+	WorkCategoryBug,
+	WorkCategoryChore,
+	WorkCategoryDocs,
+	WorkCategoryTest,
+	WorkCategoryInfra,
+	WorkCategoryOther,
+}
+
+func (e WorkCategory) IsValid() bool {
+	switch e {
+	case WorkCategoryFeature, WorkCategoryBug, WorkCategoryChore, WorkCategoryDocs, WorkCategoryTest, WorkCategoryInfra, WorkCategoryOther:
+		return true
+	}
+	return false
+}
+
+func (e WorkCategory) String() string {
+	return string(e)
+}
+
+func (e *WorkCategory) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WorkCategory(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WorkCategory", str)
+	}
+	return nil
+}
+
+func (e WorkCategory) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *WorkCategory) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e WorkCategory) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
@@ -3,7 +3,9 @@
 package graph
 
 import (
+	"fmt"
 	"sort"
+	"strconv"
 	"time"
 
 	"github.com/Tattsum/github-analytics/application"
@@ -32,31 +34,59 @@ func NewResolver(reader application.SnapshotReader) *Resolver {
 // toMemberStats maps an application.MemberStats to its GraphQL model.
 func toMemberStats(m *application.MemberStats) *model.MemberStats {
 	return &model.MemberStats{
-		Login:           m.Login,
-		Name:            m.Name,
-		TotalCommits:    m.TotalCommits,
-		TotalPRCreated:  m.TotalPRCreated,
-		TotalPRMerged:   m.TotalPRMerged,
-		TotalIssues:     m.TotalIssues,
-		TotalReviews:    m.TotalReviews,
-		TotalAdditions:  m.TotalAdditions,
-		TotalDeletions:  m.TotalDeletions,
-		PrToReviewRatio: m.PRToReviewRatio,
+		Login:             m.Login,
+		Name:              m.Name,
+		TotalCommits:      m.TotalCommits,
+		TotalPRCreated:    m.TotalPRCreated,
+		TotalPRMerged:     m.TotalPRMerged,
+		TotalIssues:       m.TotalIssues,
+		TotalReviews:      m.TotalReviews,
+		TotalAdditions:    m.TotalAdditions,
+		TotalDeletions:    m.TotalDeletions,
+		ExcludedReviews:   m.TotalExcludedReviews,
+		PrToReviewRatio:   m.PRToReviewRatio,
+		CycleTime:         toCycleTimeStats(m.CycleTime),
+		PrSize:            toPRSizeStats(m.PRSize),
+		Issues:            toIssueStats(m.IssueThroughput),
+		CategoryBreakdown: toCategoryBreakdown(m.WorkCategories),
+		Complete:          len(m.DataGaps) == 0,
+		DataGaps:          toDataGaps(m.DataGaps),
 	}
 }
 
+// toDataGaps maps the parts of a member's activity that could not be fetched
+// to their GraphQL model.
+func toDataGaps(gaps []*domain.DataGap) []*model.DataGap {
+	out := make([]*model.DataGap, 0, len(gaps))
+	for _, g := range gaps {
+		if g == nil {
+			continue
+		}
+		out = append(out, &model.DataGap{
+			ActivityType: string(g.ActivityType),
+			Repository:   g.Repository,
+			Reason:       string(g.Reason),
+			Message:      g.Message,
+		})
+	}
+	return out
+}
+
 // toTeamSummary maps an application.TeamSummary to its GraphQL model.
 func toTeamSummary(s *application.TeamSummary) *model.TeamSummary {
 	return &model.TeamSummary{
-		MemberCount:     s.MemberCount,
-		RepositoryCount: s.RepositoryCount,
-		TotalCommits:    s.TotalCommits,
-		TotalPRCreated:  s.TotalPRCreated,
-		TotalPRMerged:   s.TotalPRMerged,
-		TotalIssues:     s.TotalIssues,
-		TotalReviews:    s.TotalReviews,
-		TotalAdditions:  s.TotalAdditions,
-		TotalDeletions:  s.TotalDeletions,
+		MemberCount:       s.MemberCount,
+		RepositoryCount:   s.RepositoryCount,
+		TotalCommits:      s.TotalCommits,
+		TotalPRCreated:    s.TotalPRCreated,
+		TotalPRMerged:     s.TotalPRMerged,
+		TotalIssues:       s.TotalIssues,
+		TotalReviews:      s.TotalReviews,
+		TotalAdditions:    s.TotalAdditions,
+		TotalDeletions:    s.TotalDeletions,
+		Issues:            toIssueStats(s.IssueThroughput),
+		CategoryBreakdown: toCategoryBreakdown(s.WorkCategories),
+		OpenIssueCount:    s.OpenIssueCount,
 	}
 }
 
@@ -85,8 +115,13 @@ func toRepositoryStats(r *application.RepositoryStats) *model.RepositoryStats {
 			Additions: r.TotalAdditions,
 			Deletions: r.TotalDeletions,
 		},
-		ContributorCount: r.ContributorCount,
-		Contributors:     contributors,
+		ContributorCount:  r.ContributorCount,
+		Contributors:      contributors,
+		CycleTime:         toCycleTimeStats(r.CycleTime),
+		PrSize:            toPRSizeStats(r.PRSize),
+		Issues:            toIssueStats(r.IssueThroughput),
+		CategoryBreakdown: toCategoryBreakdown(r.WorkCategories),
+		OpenIssueCount:    r.OpenIssueCount,
 	}
 }
 
@@ -95,6 +130,7 @@ func toRepositoryStats(r *application.RepositoryStats) *model.RepositoryStats {
 // the frontend receives a stable, chronological trend.
 func toUserStatistics(s *domain.UserStatistics) *model.UserStatistics {
 	out := &model.UserStatistics{
+		Logins:               append([]string{}, s.Logins...),
 		TotalCommits:         s.TotalCommits,
 		TotalPRCreated:       s.TotalPRCreated,
 		TotalPRMerged:        s.TotalPRMerged,
@@ -102,6 +138,7 @@ func toUserStatistics(s *domain.UserStatistics) *model.UserStatistics {
 		TotalReviews:         s.TotalReviews,
 		TotalAdditions:       s.TotalAdditions,
 		TotalDeletions:       s.TotalDeletions,
+		ExcludedReviews:      s.TotalExcludedReviews,
 		PrToReviewRatio:      s.PRToReviewRatio,
 		FirstActivityYear:    s.FirstActivityYear,
 		PeakActivityYear:     s.PeakActivityYear,
@@ -111,6 +148,12 @@ func toUserStatistics(s *domain.UserStatistics) *model.UserStatistics {
 		TopRepositories:      toRepositoryActivities(s.TopRepositories),
 		LongTermRepositories: toRepositoryActivities(s.LongTermRepositories),
 		RoleTransition:       toRoleTransitions(s.RoleTransition),
+		CycleTime:            toCycleTimeStats(s.CycleTime),
+		PrSize:               toPRSizeStats(s.PRSize),
+		Issues:               toIssueStats(s.IssueThroughput),
+		CategoryBreakdown:    toCategoryBreakdown(s.WorkCategories),
+		Complete:             s.IsComplete(),
+		DataGaps:             toDataGaps(s.DataGaps),
 	}
 	if s.User != nil {
 		out.Login = s.User.Login
@@ -119,6 +162,76 @@ func toUserStatistics(s *domain.UserStatistics) *model.UserStatistics {
 	return out
 }
 
+// toCycleTimeStats maps a domain.CycleTimeStats to its GraphQL model.
+func toCycleTimeStats(c domain.CycleTimeStats) *model.CycleTimeStats {
+	return &model.CycleTimeStats{
+		PrCount:                c.PRCount,
+		TimeToFirstReviewHours: toPercentiles(c.TimeToFirstReviewHours),
+		TimeToApprovalHours:    toPercentiles(c.TimeToApprovalHours),
+		TimeToMergeHours:       toPercentiles(c.TimeToMergeHours),
+		TimeToCloseHours:       toPercentiles(c.TimeToCloseHours),
+		ReviewRounds:           toPercentiles(c.ReviewRounds),
+	}
+}
+
+// toPRSizeStats maps a domain.PRSizeStats to its GraphQL model, listing every
+// size from smallest to largest so that histograms line up.
+func toPRSizeStats(p domain.PRSizeStats) *model.PRSizeStats {
+	sizes := domain.PRSizes()
+	histogram := make([]*model.PRSizeCount, 0, len(sizes))
+	for _, size := range sizes {
+		histogram = append(histogram, &model.PRSizeCount{
+			Size:  model.PRSize(size),
+			Count: p.Histogram.Count(size),
+		})
+	}
+	return &model.PRSizeStats{
+		PrCount:      p.PRCount,
+		Histogram:    histogram,
+		ChangedLines: toPercentiles(p.ChangedLines),
+		ChangedFiles: toPercentiles(p.ChangedFiles),
+	}
+}
+
+// toIssueStats maps a domain.IssueThroughput to its GraphQL model; the mean
+// time to close is null when no issue was closed.
+func toIssueStats(t domain.IssueThroughput) *model.IssueStats {
+	out := &model.IssueStats{
+		Closed:        t.ClosedCount,
+		BugOpened:     t.BugOpened,
+		FeatureOpened: t.FeatureOpened,
+		BugClosed:     t.BugClosed,
+		FeatureClosed: t.FeatureClosed,
+	}
+	if hours, ok := t.TimeToCloseHours(); ok {
+		out.TimeToCloseHours = &hours
+	}
+	return out
+}
+
+// toCategoryBreakdown maps per-category pull request counts to their GraphQL
+// model, listing every category in display order so that series line up.
+func toCategoryBreakdown(c domain.WorkCategoryCounts) []*model.CategoryCount {
+	categories := domain.WorkCategories()
+	out := make([]*model.CategoryCount, 0, len(categories))
+	for _, category := range categories {
+		out = append(out, &model.CategoryCount{
+			Category: model.WorkCategory(category),
+			Count:    c.Count(category),
+		})
+	}
+	return out
+}
+
+// toPercentiles maps a domain.Percentiles to its GraphQL model.
+func toPercentiles(p domain.Percentiles) *model.Percentiles {
+	return &model.Percentiles{
+		Count:  p.Count,
+		Median: p.Median,
+		P90:    p.P90,
+	}
+}
+
 // toYearlyStatistics flattens a year-keyed map into a slice sorted by year ascending.
 func toYearlyStatistics(stats map[int]*domain.YearlyStatistics) []*model.YearlyStatistics {
 	years := make([]int, 0, len(stats))
@@ -163,14 +276,16 @@ func toDailyStatistics(stats map[string]*domain.DailyStatistics) []*model.DailyS
 // toDailyStatistic maps a single domain.DailyStatistics to its GraphQL model.
 func toDailyStatistic(d *domain.DailyStatistics) *model.DailyStatistics {
 	return &model.DailyStatistics{
-		Date:           d.Date,
-		CommitCount:    d.CommitCount,
-		PrCreated:      d.PRCreated,
-		PrMerged:       d.PRMerged,
-		IssueCount:     d.IssueCount,
-		ReviewCount:    d.ReviewCount,
-		TotalAdditions: d.TotalAdditions,
-		TotalDeletions: d.TotalDeletions,
+		Date:              d.Date,
+		CommitCount:       d.CommitCount,
+		PrCreated:         d.PRCreated,
+		PrMerged:          d.PRMerged,
+		IssueCount:        d.IssueCount,
+		ReviewCount:       d.ReviewCount,
+		TotalAdditions:    d.TotalAdditions,
+		TotalDeletions:    d.TotalDeletions,
+		Issues:            toIssueStats(d.IssueThroughput),
+		CategoryBreakdown: toCategoryBreakdown(d.WorkCategories),
 	}
 }
 
@@ -189,12 +304,18 @@ func toDailyStatisticsSlice(stats []*domain.DailyStatistics) []*model.DailyStati
 }
 
 // toRepositoryDailyStats maps an application.RepositoryDailyStats to its GraphQL model.
+// Only this series carries the open issue backlog of each day or bucket.
 func toRepositoryDailyStats(r *application.RepositoryDailyStats) *model.RepositoryDailyStats {
+	daily := toDailyStatisticsSlice(r.DailyStats)
+	for i, d := range r.DailyStats {
+		open := d.OpenIssueCount
+		daily[i].OpenIssues = &open
+	}
 	return &model.RepositoryDailyStats{
 		NameWithOwner: r.NameWithOwner,
 		Owner:         r.Owner,
 		OwnerType:     r.OwnerType,
-		DailyStats:    toDailyStatisticsSlice(r.DailyStats),
+		DailyStats:    daily,
 	}
 }
 
@@ -231,3 +352,265 @@ func toRoleTransitions(points []domain.RoleTransitionPoint) []*model.RoleTransit
 	}
 	return out
 }
+
+// toReviewNetwork maps an application.ReviewNetwork to its GraphQL model.
+func toReviewNetwork(n *application.ReviewNetwork) *model.ReviewNetwork {
+	nodes := make([]*model.ReviewNetworkNode, 0, len(n.Nodes))
+	for _, node := range n.Nodes {
+		nodes = append(nodes, &model.ReviewNetworkNode{
+			Login:           node.Login,
+			ReviewsGiven:    node.ReviewsGiven,
+			ReviewsReceived: node.ReviewsReceived,
+		})
+	}
+	edges := make([]*model.ReviewNetworkEdge, 0, len(n.Edges))
+	for _, edge := range n.Edges {
+		edges = append(edges, &model.ReviewNetworkEdge{
+			Reviewer:     edge.Reviewer,
+			Author:       edge.Author,
+			ReviewCount:  edge.ReviewCount,
+			Repositories: edge.Repositories,
+		})
+	}
+	return &model.ReviewNetwork{Nodes: nodes, Edges: edges}
+}
+
+// toReviewLoads maps the per-reviewer review load to its GraphQL model.
+func toReviewLoads(loads []*application.ReviewLoad) []*model.ReviewLoad {
+	out := make([]*model.ReviewLoad, 0, len(loads))
+	for _, load := range loads {
+		pending := make([]*model.PendingReview, 0, len(load.PendingReviews))
+		for _, p := range load.PendingReviews {
+			pending = append(pending, &model.PendingReview{
+				Repository:  p.Repository,
+				Author:      p.Author,
+				RequestedAt: p.RequestedAt.UTC().Format(time.RFC3339),
+			})
+		}
+		out = append(out, &model.ReviewLoad{
+			Reviewer:       load.Reviewer,
+			Requested:      load.Requested,
+			Completed:      load.Completed,
+			Pending:        len(pending),
+			PendingReviews: pending,
+		})
+	}
+	return out
+}
+
+// toActivityHeatmap maps a member's hour-of-week heatmap; its rows follow the
+// Weekday enum order (Monday first) like the domain counts.
+func toActivityHeatmap(h *domain.ActivityHeatmap) *model.ActivityHeatmap {
+	rows := make([]*model.HeatmapRow, 0, len(h.Counts))
+	for i, counts := range h.Counts {
+		rows = append(rows, &model.HeatmapRow{
+			Weekday: model.AllWeekday[i],
+			Counts:  append([]int(nil), counts[:]...),
+		})
+	}
+	return &model.ActivityHeatmap{
+		Login:         h.Login,
+		TimeZone:      h.TimeZone,
+		Total:         h.Total,
+		OffHours:      h.OffHours,
+		Weekend:       h.Weekend,
+		OffHoursRatio: h.OffHoursRatio(),
+		Rows:          rows,
+	}
+}
+
+// toDeliveryMetrics maps a repository's delivery metrics; source is null when
+// no week falls in the requested range.
+func toDeliveryMetrics(m *application.DeliveryMetrics) *model.DeliveryMetrics {
+	out := &model.DeliveryMetrics{
+		Repository:            m.Repository,
+		DeploymentCount:       m.DeploymentCount,
+		FailedDeploymentCount: m.FailedDeploymentCount,
+		WeekCount:             m.WeekCount,
+		DeploymentsPerWeek:    m.DeploymentsPerWeek,
+		LeadTimeHours:         m.LeadTimeHours,
+		ChangeFailureRate:     m.ChangeFailureRate,
+		TimeToRestoreHours:    m.TimeToRestoreHours,
+		Weeks:                 make([]*model.DeliveryWeek, 0, len(m.Weeks)),
+	}
+	if m.Source != "" {
+		source := model.DeliverySource(m.Source)
+		out.Source = &source
+	}
+	for _, w := range m.Weeks {
+		out.Weeks = append(out.Weeks, &model.DeliveryWeek{
+			Week:                  w.Week,
+			Source:                model.DeliverySource(w.Source),
+			DeploymentCount:       w.DeploymentCount,
+			FailedDeploymentCount: w.FailedDeploymentCount,
+			LeadTimeSeconds:       w.LeadTimeSeconds,
+			LeadTimeCount:         w.LeadTimeCount,
+			RestoreSeconds:        w.RestoreSeconds,
+			RestoreCount:          w.RestoreCount,
+		})
+	}
+	return out
+}
+
+// dateArg validates an optional "YYYY-MM-DD" query argument and returns it as
+// a plain string, with "" standing for an omitted (open-ended) bound.
+func dateArg(name string, v *string) (string, error) {
+	if v == nil || *v == "" {
+		return "", nil
+	}
+	if _, err := time.Parse(time.DateOnly, *v); err != nil {
+		return "", fmt.Errorf("%s must be a YYYY-MM-DD date: %w", name, err)
+	}
+	return *v, nil
+}
+
+// seriesOptions validates the optional time-series arguments shared by the
+// member, teamDailyStats, repository and repositoryDailyStats queries.
+func seriesOptions(from, to *string, granularity *model.Granularity, snapshotID *string) (application.SeriesOptions, error) {
+	var opts application.SeriesOptions
+	var err error
+	if opts.From, err = dateArg("from", from); err != nil {
+		return opts, err
+	}
+	if opts.To, err = dateArg("to", to); err != nil {
+		return opts, err
+	}
+	if opts.From != "" && opts.To != "" && opts.From > opts.To {
+		return opts, fmt.Errorf("from %s is after to %s", opts.From, opts.To)
+	}
+	if granularity != nil {
+		switch *granularity {
+		case model.GranularityDay:
+			opts.Granularity = application.GranularityDay
+		case model.GranularityWeek:
+			opts.Granularity = application.GranularityWeek
+		case model.GranularityMonth:
+			opts.Granularity = application.GranularityMonth
+		default:
+			return opts, fmt.Errorf("unknown granularity %q", *granularity)
+		}
+	}
+	if snapshotID != nil && *snapshotID != "" {
+		if opts.SnapshotID, err = snapshotIDArg("snapshotId", *snapshotID); err != nil {
+			return opts, err
+		}
+	}
+	return opts, nil
+}
+
+// snapshotIDArg parses a snapshot ID argument. Snapshot IDs are positive
+// integers; 0 is reserved by the reader for "latest".
+func snapshotIDArg(name, v string) (int, error) {
+	id, err := strconv.Atoi(v)
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("%s must be a positive integer, got %q", name, v)
+	}
+	return id, nil
+}
+
+// toMemberStatsList maps application member stats to their GraphQL models.
+func toMemberStatsList(members []*application.MemberStats) []*model.MemberStats {
+	out := make([]*model.MemberStats, 0, len(members))
+	for _, m := range members {
+		out = append(out, toMemberStats(m))
+	}
+	return out
+}
+
+// toRepositoryStatsList maps application repository stats to their GraphQL models.
+func toRepositoryStatsList(repos []*application.RepositoryStats) []*model.RepositoryStats {
+	out := make([]*model.RepositoryStats, 0, len(repos))
+	for _, repo := range repos {
+		out = append(out, toRepositoryStats(repo))
+	}
+	return out
+}
+
+// toSnapshotInfo maps an application.SnapshotInfo to its GraphQL model.
+func toSnapshotInfo(info *application.SnapshotInfo) *model.SnapshotInfo {
+	out := &model.SnapshotInfo{
+		ID:              strconv.Itoa(info.ID),
+		CapturedAt:      info.CapturedAt.UTC().Format(time.RFC3339),
+		MemberCount:     info.MemberCount,
+		RepositoryCount: info.RepositoryCount,
+		ExcludedMembers: toExcludedMembers(info.ExcludedMembers),
+		Period:          toCollectionPeriod(info.Period),
+	}
+	if info.Tag != "" {
+		tag := info.Tag
+		out.Tag = &tag
+	}
+	return out
+}
+
+// toCollectionPeriod maps a snapshot's collection period to its GraphQL
+// model; an unlimited side of the period is null.
+func toCollectionPeriod(period domain.CollectionPeriod) *model.CollectionPeriod {
+	out := &model.CollectionPeriod{}
+	if !period.Since.IsZero() {
+		since := period.Since.UTC().Format(time.RFC3339)
+		out.Since = &since
+	}
+	if !period.Until.IsZero() {
+		until := period.Until.UTC().Format(time.RFC3339)
+		out.Until = &until
+	}
+	return out
+}
+
+// toExcludedMembers maps the accounts left out of a snapshot's roster to their
+// GraphQL model.
+func toExcludedMembers(actors []*domain.ExcludedActor) []*model.ExcludedMember {
+	out := make([]*model.ExcludedMember, 0, len(actors))
+	for _, a := range actors {
+		if a == nil {
+			continue
+		}
+		out = append(out, &model.ExcludedMember{Login: a.Login, Rule: a.Rule})
+	}
+	return out
+}
+
+// toMemberHistoryPoint maps an application.MemberHistoryPoint to its GraphQL model.
+func toMemberHistoryPoint(p *application.MemberHistoryPoint) *model.MemberHistoryPoint {
+	return &model.MemberHistoryPoint{
+		SnapshotID: strconv.Itoa(p.SnapshotID),
+		CapturedAt: p.CapturedAt.UTC().Format(time.RFC3339),
+		Stats:      toMemberStats(p.Stats),
+	}
+}
+
+// toSnapshotDiff maps an application.SnapshotDiff to its GraphQL model.
+func toSnapshotDiff(d *application.SnapshotDiff) *model.SnapshotDiff {
+	members := make([]*model.MemberDelta, 0, len(d.MemberDeltas))
+	for _, m := range d.MemberDeltas {
+		members = append(members, &model.MemberDelta{Login: m.Login, Delta: toMetricDeltas(m.Delta)})
+	}
+	repos := make([]*model.RepositoryDelta, 0, len(d.RepositoryDeltas))
+	for _, r := range d.RepositoryDeltas {
+		repos = append(repos, &model.RepositoryDelta{NameWithOwner: r.NameWithOwner, Delta: toMetricDeltas(r.Delta)})
+	}
+	return &model.SnapshotDiff{
+		Base:                toSnapshotInfo(d.Base),
+		Head:                toSnapshotInfo(d.Head),
+		AddedMembers:        d.AddedMembers,
+		RemovedMembers:      d.RemovedMembers,
+		AddedRepositories:   d.AddedRepositories,
+		RemovedRepositories: d.RemovedRepositories,
+		MemberDeltas:        members,
+		RepositoryDeltas:    repos,
+	}
+}
+
+// toMetricDeltas maps application.MetricDeltas to its GraphQL model.
+func toMetricDeltas(d application.MetricDeltas) *model.MetricDeltas {
+	return &model.MetricDeltas{
+		Commits:   d.Commits,
+		PrCreated: d.PRCreated,
+		PrMerged:  d.PRMerged,
+		Issues:    d.Issues,
+		Reviews:   d.Reviews,
+		Additions: d.Additions,
+		Deletions: d.Deletions,
+	}
+}
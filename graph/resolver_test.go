@@ -23,46 +23,141 @@ type fakeSnapshotReader struct {
 	repos       []*application.RepositoryStats
 	repo        *application.RepositoryStats
 	repoDaily   []*application.RepositoryDailyStats
+	network     *application.ReviewNetwork
+	reviewLoad  []*application.ReviewLoad
+	heatmap     *domain.ActivityHeatmap
+	delivery    *application.DeliveryMetrics
+	snapshots   []*application.SnapshotInfo
+	snapshot    *application.SnapshotInfo
+	history     []*application.MemberHistoryPoint
 	err         error
+
+	// gotFrom / gotTo record the date range passed to ReviewNetwork, ActivityHeatmap and DeliveryMetrics.
+	gotFrom, gotTo string
+	// gotLogin records the login passed to ActivityHeatmap.
+	gotLogin string
+	// gotRepository records the repository passed to DeliveryMetrics.
+	gotRepository string
+	// gotOpts records the series options passed to the time-series methods.
+	gotOpts application.SeriesOptions
+	// gotSnapshotIDs records the snapshot IDs passed to Members, TeamSummary and Repositories.
+	gotSnapshotIDs []int
+}
+
+func (f *fakeSnapshotReader) Snapshots(_ context.Context) ([]*application.SnapshotInfo, error) {
+	return f.snapshots, f.err
+}
+
+func (f *fakeSnapshotReader) Snapshot(_ context.Context, _ int) (*application.SnapshotInfo, error) {
+	return f.snapshot, f.err
+}
+
+func (f *fakeSnapshotReader) MemberHistory(_ context.Context, _ string) ([]*application.MemberHistoryPoint, error) {
+	return f.history, f.err
 }
 
-func (f *fakeSnapshotReader) LatestMembers(_ context.Context) ([]*application.MemberStats, error) {
+func (f *fakeSnapshotReader) Members(_ context.Context, snapshotID int) ([]*application.MemberStats, error) {
+	f.gotSnapshotIDs = append(f.gotSnapshotIDs, snapshotID)
 	return f.members, f.err
 }
 
-func (f *fakeSnapshotReader) Member(_ context.Context, _ string) (*domain.UserStatistics, error) {
+func (f *fakeSnapshotReader) Member(_ context.Context, _ string, opts application.SeriesOptions) (*domain.UserStatistics, error) {
+	f.gotOpts = opts
 	return f.member, f.err
 }
 
-func (f *fakeSnapshotReader) TeamSummary(_ context.Context) (*application.TeamSummary, error) {
+func (f *fakeSnapshotReader) TeamSummary(_ context.Context, snapshotID int) (*application.TeamSummary, error) {
+	f.gotSnapshotIDs = append(f.gotSnapshotIDs, snapshotID)
 	return f.teamSummary, f.err
 }
 
-func (f *fakeSnapshotReader) TeamDailyStats(_ context.Context) ([]*domain.DailyStatistics, error) {
+func (f *fakeSnapshotReader) TeamDailyStats(_ context.Context, opts application.SeriesOptions) ([]*domain.DailyStatistics, error) {
+	f.gotOpts = opts
 	return f.teamDaily, f.err
 }
 
-func (f *fakeSnapshotReader) Repositories(_ context.Context) ([]*application.RepositoryStats, error) {
+func (f *fakeSnapshotReader) Repositories(_ context.Context, snapshotID int) ([]*application.RepositoryStats, error) {
+	f.gotSnapshotIDs = append(f.gotSnapshotIDs, snapshotID)
 	return f.repos, f.err
 }
 
-func (f *fakeSnapshotReader) Repository(_ context.Context, _ string) (*application.RepositoryStats, error) {
+func (f *fakeSnapshotReader) Repository(_ context.Context, _ string, opts application.SeriesOptions) (*application.RepositoryStats, error) {
+	f.gotOpts = opts
 	return f.repo, f.err
 }
 
-func (f *fakeSnapshotReader) RepositoryDailyStats(_ context.Context) ([]*application.RepositoryDailyStats, error) {
+func (f *fakeSnapshotReader) RepositoryDailyStats(_ context.Context, opts application.SeriesOptions) ([]*application.RepositoryDailyStats, error) {
+	f.gotOpts = opts
 	return f.repoDaily, f.err
 }
 
+func (f *fakeSnapshotReader) ReviewNetwork(_ context.Context, from, to string) (*application.ReviewNetwork, error) {
+	f.gotFrom, f.gotTo = from, to
+	return f.network, f.err
+}
+
+func (f *fakeSnapshotReader) ReviewLoad(_ context.Context) ([]*application.ReviewLoad, error) {
+	return f.reviewLoad, f.err
+}
+
+func (f *fakeSnapshotReader) ActivityHeatmap(_ context.Context, login, from, to string) (*domain.ActivityHeatmap, error) {
+	f.gotLogin, f.gotFrom, f.gotTo = login, from, to
+	return f.heatmap, f.err
+}
+
+func (f *fakeSnapshotReader) DeliveryMetrics(_ context.Context, repository, from, to string) (*application.DeliveryMetrics, error) {
+	f.gotRepository, f.gotFrom, f.gotTo = repository, from, to
+	return f.delivery, f.err
+}
+
 func newTestQueryResolver(t *testing.T, reader application.SnapshotReader) QueryResolver {
 	t.Helper()
 	return NewResolver(reader).Query()
 }
 
+// emptyCycleTime is the GraphQL cycle time of a member or repository without pull requests.
+func emptyCycleTime() *model.CycleTimeStats {
+	return &model.CycleTimeStats{
+		TimeToFirstReviewHours: &model.Percentiles{},
+		TimeToApprovalHours:    &model.Percentiles{},
+		TimeToMergeHours:       &model.Percentiles{},
+		TimeToCloseHours:       &model.Percentiles{},
+		ReviewRounds:           &model.Percentiles{},
+	}
+}
+
+// emptyPRSize is the GraphQL size distribution of a member or repository without sized pull requests.
+func emptyPRSize() *model.PRSizeStats {
+	return &model.PRSizeStats{
+		Histogram: []*model.PRSizeCount{
+			{Size: model.PRSizeXs},
+			{Size: model.PRSizeS},
+			{Size: model.PRSizeM},
+			{Size: model.PRSizeL},
+			{Size: model.PRSizeXl},
+		},
+		ChangedLines: &model.Percentiles{},
+		ChangedFiles: &model.Percentiles{},
+	}
+}
+
+func emptyCategoryBreakdown() []*model.CategoryCount {
+	return []*model.CategoryCount{
+		{Category: model.WorkCategoryFeature},
+		{Category: model.WorkCategoryBug},
+		{Category: model.WorkCategoryChore},
+		{Category: model.WorkCategoryDocs},
+		{Category: model.WorkCategoryTest},
+		{Category: model.WorkCategoryInfra},
+		{Category: model.WorkCategoryOther},
+	}
+}
+
 func TestQueryResolver_Members(t *testing.T) {
 	t.Parallel()
 
 	sentinel := errors.New("boom")
+	closeHours := 1.5
 
 	tests := []struct {
 		name    string
@@ -75,16 +170,30 @@ func TestQueryResolver_Members(t *testing.T) {
 			reader: &fakeSnapshotReader{
 				members: []*application.MemberStats{
 					{
-						Login:           "octocat",
-						Name:            "The Octocat",
-						TotalCommits:    42,
-						TotalPRCreated:  7,
-						TotalPRMerged:   5,
-						TotalIssues:     3,
-						TotalReviews:    11,
-						TotalAdditions:  120,
-						TotalDeletions:  30,
-						PRToReviewRatio: 1.57,
+						Login:                "octocat",
+						Name:                 "The Octocat",
+						TotalCommits:         42,
+						TotalPRCreated:       7,
+						TotalPRMerged:        5,
+						TotalIssues:          3,
+						TotalReviews:         11,
+						TotalAdditions:       120,
+						TotalDeletions:       30,
+						PRToReviewRatio:      1.57,
+						TotalExcludedReviews: 4,
+						IssueThroughput:      domain.IssueThroughput{ClosedCount: 2, CloseSeconds: 3 * 3600, BugClosed: 1},
+						CycleTime: domain.CycleTimeStats{
+							PRCount:                7,
+							TimeToFirstReviewHours: domain.Percentiles{Count: 6, Median: 3.5, P90: 20},
+							TimeToMergeHours:       domain.Percentiles{Count: 5, Median: 26, P90: 70},
+							ReviewRounds:           domain.Percentiles{Count: 6, Median: 1, P90: 2.5},
+						},
+						PRSize: domain.PRSizeStats{
+							PRCount:      6,
+							Histogram:    domain.PRSizeHistogram{XS: 1, S: 3, M: 1, XL: 1},
+							ChangedLines: domain.Percentiles{Count: 6, Median: 40, P90: 900},
+							ChangedFiles: domain.Percentiles{Count: 6, Median: 4, P90: 35},
+						},
 					},
 				},
 			},
@@ -99,7 +208,64 @@ func TestQueryResolver_Members(t *testing.T) {
 					TotalReviews:    11,
 					TotalAdditions:  120,
 					TotalDeletions:  30,
+					ExcludedReviews: 4,
 					PrToReviewRatio: 1.57,
+					CycleTime: &model.CycleTimeStats{
+						PrCount:                7,
+						TimeToFirstReviewHours: &model.Percentiles{Count: 6, Median: 3.5, P90: 20},
+						TimeToApprovalHours:    &model.Percentiles{},
+						TimeToMergeHours:       &model.Percentiles{Count: 5, Median: 26, P90: 70},
+						TimeToCloseHours:       &model.Percentiles{},
+						ReviewRounds:           &model.Percentiles{Count: 6, Median: 1, P90: 2.5},
+					},
+					PrSize: &model.PRSizeStats{
+						PrCount: 6,
+						Histogram: []*model.PRSizeCount{
+							{Size: model.PRSizeXs, Count: 1},
+							{Size: model.PRSizeS, Count: 3},
+							{Size: model.PRSizeM, Count: 1},
+							{Size: model.PRSizeL},
+							{Size: model.PRSizeXl, Count: 1},
+						},
+						ChangedLines: &model.Percentiles{Count: 6, Median: 40, P90: 900},
+						ChangedFiles: &model.Percentiles{Count: 6, Median: 4, P90: 35},
+					},
+					Issues:            &model.IssueStats{Closed: 2, TimeToCloseHours: &closeHours, BugClosed: 1},
+					CategoryBreakdown: emptyCategoryBreakdown(),
+					Complete:          true,
+					DataGaps:          []*model.DataGap{},
+				},
+			},
+		},
+		{
+			name: "members with data gaps are flagged incomplete",
+			reader: &fakeSnapshotReader{
+				members: []*application.MemberStats{{
+					Login: "octocat",
+					Name:  "octocat",
+					DataGaps: []*domain.DataGap{{
+						ActivityType: domain.ActivityTypeReview,
+						Repository:   "acme/api",
+						Reason:       domain.DataGapPermission,
+						Message:      "insufficient permission for GitHub resource: non-200 OK status code: 403",
+					}},
+				}},
+			},
+			want: []*model.MemberStats{
+				{
+					Login:             "octocat",
+					Name:              "octocat",
+					CycleTime:         toCycleTimeStats(domain.CycleTimeStats{}),
+					PrSize:            emptyPRSize(),
+					Issues:            &model.IssueStats{},
+					CategoryBreakdown: emptyCategoryBreakdown(),
+					Complete:          false,
+					DataGaps: []*model.DataGap{{
+						ActivityType: "review",
+						Repository:   "acme/api",
+						Reason:       "permission",
+						Message:      "insufficient permission for GitHub resource: non-200 OK status code: 403",
+					}},
 				},
 			},
 		},
@@ -150,6 +316,7 @@ func TestQueryResolver_Member(t *testing.T) {
 			reader: &fakeSnapshotReader{
 				member: &domain.UserStatistics{
 					User:                &domain.User{Login: "octocat", Name: "The Octocat"},
+					Logins:              []string{"octocat", "octocat-work"},
 					TotalCommits:        42,
 					TotalReviews:        11,
 					TotalPRCreated:      7,
@@ -182,12 +349,18 @@ func TestQueryResolver_Member(t *testing.T) {
 					RoleTransition: []domain.RoleTransitionPoint{
 						{Year: 2022, PRCreated: 2, ReviewCount: 9, Ratio: 4.5, Description: "shift to reviewer"},
 					},
+					CycleTime: domain.CycleTimeStats{
+						PRCount:             7,
+						TimeToApprovalHours: domain.Percentiles{Count: 4, Median: 12, P90: 40},
+					},
+					PRSize: domain.PRSizeStats{PRCount: 2, Histogram: domain.PRSizeHistogram{S: 1, L: 1}},
 				},
 			},
 			assert: func(t *testing.T, got *model.UserStatistics) {
 				t.Helper()
 				assert.Equal(t, "octocat", got.Login)
 				assert.Equal(t, "The Octocat", got.Name)
+				assert.Equal(t, []string{"octocat", "octocat-work"}, got.Logins)
 				assert.Equal(t, 42, got.TotalCommits)
 				assert.InEpsilon(t, 1.57, got.PrToReviewRatio, 1e-9)
 
@@ -210,6 +383,15 @@ func TestQueryResolver_Member(t *testing.T) {
 				require.Len(t, got.RoleTransition, 1)
 				assert.Equal(t, "shift to reviewer", got.RoleTransition[0].Description)
 				assert.InEpsilon(t, 4.5, got.RoleTransition[0].Ratio, 1e-9)
+
+				require.NotNil(t, got.CycleTime)
+				assert.Equal(t, 7, got.CycleTime.PrCount)
+				assert.Equal(t, &model.Percentiles{Count: 4, Median: 12, P90: 40}, got.CycleTime.TimeToApprovalHours)
+
+				require.NotNil(t, got.PrSize)
+				assert.Equal(t, 2, got.PrSize.PrCount)
+				require.Len(t, got.PrSize.Histogram, 5)
+				assert.Equal(t, &model.PRSizeCount{Size: model.PRSizeL, Count: 1}, got.PrSize.Histogram[3])
 			},
 		},
 		{
@@ -229,7 +411,7 @@ func TestQueryResolver_Member(t *testing.T) {
 			t.Parallel()
 			r := newTestQueryResolver(t, tt.reader)
 
-			got, err := r.Member(context.Background(), "octocat")
+			got, err := r.Member(context.Background(), "octocat", nil, nil, nil, nil)
 			if tt.wantErr {
 				require.Error(t, err)
 				return
@@ -267,6 +449,9 @@ func TestQueryResolver_TeamSummary(t *testing.T) {
 					TotalReviews:    60,
 					TotalAdditions:  9000,
 					TotalDeletions:  3000,
+					IssueThroughput: domain.IssueThroughput{BugOpened: 4, FeatureOpened: 2},
+					WorkCategories:  domain.WorkCategoryCounts{Feature: 20, Bug: 12, Docs: 3, Other: 10},
+					OpenIssueCount:  5,
 				},
 			},
 			want: &model.TeamSummary{
@@ -279,6 +464,17 @@ func TestQueryResolver_TeamSummary(t *testing.T) {
 				TotalReviews:    60,
 				TotalAdditions:  9000,
 				TotalDeletions:  3000,
+				Issues:          &model.IssueStats{BugOpened: 4, FeatureOpened: 2},
+				CategoryBreakdown: []*model.CategoryCount{
+					{Category: model.WorkCategoryFeature, Count: 20},
+					{Category: model.WorkCategoryBug, Count: 12},
+					{Category: model.WorkCategoryChore},
+					{Category: model.WorkCategoryDocs, Count: 3},
+					{Category: model.WorkCategoryTest},
+					{Category: model.WorkCategoryInfra},
+					{Category: model.WorkCategoryOther, Count: 10},
+				},
+				OpenIssueCount: 5,
 			},
 		},
 		{
@@ -322,8 +518,8 @@ func TestQueryResolver_TeamDailyStats(t *testing.T) {
 				},
 			},
 			want: []*model.DailyStatistics{
-				{Date: "2024-01-08", CommitCount: 8, PrCreated: 3, PrMerged: 2, IssueCount: 1, ReviewCount: 5, TotalAdditions: 80, TotalDeletions: 20},
-				{Date: "2024-01-09", CommitCount: 4, PrCreated: 1, PrMerged: 1, IssueCount: 0, ReviewCount: 2, TotalAdditions: 40, TotalDeletions: 10},
+				{Date: "2024-01-08", CommitCount: 8, PrCreated: 3, PrMerged: 2, IssueCount: 1, ReviewCount: 5, TotalAdditions: 80, TotalDeletions: 20, Issues: &model.IssueStats{}, CategoryBreakdown: emptyCategoryBreakdown()},
+				{Date: "2024-01-09", CommitCount: 4, PrCreated: 1, PrMerged: 1, IssueCount: 0, ReviewCount: 2, TotalAdditions: 40, TotalDeletions: 10, Issues: &model.IssueStats{}, CategoryBreakdown: emptyCategoryBreakdown()},
 			},
 		},
 		{
@@ -343,7 +539,7 @@ func TestQueryResolver_TeamDailyStats(t *testing.T) {
 			t.Parallel()
 			r := newTestQueryResolver(t, tt.reader)
 
-			got, err := r.TeamDailyStats(context.Background())
+			got, err := r.TeamDailyStats(context.Background(), nil, nil, nil, nil)
 			if tt.wantErr {
 				require.Error(t, err)
 				return
@@ -401,6 +597,10 @@ func TestQueryResolver_Repositories(t *testing.T) {
 						{Login: "octocat", CommitCount: 30, PrCreated: 12, ReviewCount: 20, Additions: 2500, Deletions: 900},
 						{Login: "hubot", CommitCount: 20, PrCreated: 8, ReviewCount: 13, Additions: 1500, Deletions: 600},
 					},
+					CycleTime:         emptyCycleTime(),
+					PrSize:            emptyPRSize(),
+					Issues:            &model.IssueStats{},
+					CategoryBreakdown: emptyCategoryBreakdown(),
 				},
 			},
 		},
@@ -463,6 +663,10 @@ func TestQueryResolver_Repository(t *testing.T) {
 				Contributors: []*model.RepositoryContributor{
 					{Login: "octocat", CommitCount: 12},
 				},
+				CycleTime:         emptyCycleTime(),
+				PrSize:            emptyPRSize(),
+				Issues:            &model.IssueStats{},
+				CategoryBreakdown: emptyCategoryBreakdown(),
 			},
 		},
 		{
@@ -482,7 +686,7 @@ func TestQueryResolver_Repository(t *testing.T) {
 			t.Parallel()
 			r := newTestQueryResolver(t, tt.reader)
 
-			got, err := r.Repository(context.Background(), "Tattsum/dotfiles")
+			got, err := r.Repository(context.Background(), "Tattsum/dotfiles", nil, nil, nil, nil)
 			if tt.wantErr {
 				require.Error(t, err)
 				return
@@ -496,3 +700,688 @@ func TestQueryResolver_Repository(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryResolver_RepositoryDailyStats(t *testing.T) {
+	t.Parallel()
+
+	reader := &fakeSnapshotReader{
+		repoDaily: []*application.RepositoryDailyStats{{
+			NameWithOwner: "acme/api",
+			Owner:         "acme",
+			OwnerType:     "Organization",
+			DailyStats: []*domain.DailyStatistics{
+				{Date: "2024-01-08", IssueCount: 2, IssueThroughput: domain.IssueThroughput{BugOpened: 1}, OpenIssueCount: 2},
+				{Date: "2024-01-15", IssueThroughput: domain.IssueThroughput{ClosedCount: 1, CloseSeconds: 7200, BugClosed: 1}, OpenIssueCount: 1},
+			},
+		}},
+	}
+	r := newTestQueryResolver(t, reader)
+
+	got, err := r.RepositoryDailyStats(context.Background(), nil, nil, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Len(t, got[0].DailyStats, 2)
+
+	// Only the repository series carries the open issue backlog.
+	first, second := got[0].DailyStats[0], got[0].DailyStats[1]
+	require.NotNil(t, first.OpenIssues)
+	require.NotNil(t, second.OpenIssues)
+	assert.Equal(t, 2, *first.OpenIssues)
+	assert.Equal(t, 1, *second.OpenIssues)
+	assert.Nil(t, first.Issues.TimeToCloseHours)
+	require.NotNil(t, second.Issues.TimeToCloseHours)
+	assert.InDelta(t, 2.0, *second.Issues.TimeToCloseHours, 1e-9)
+	assert.Equal(t, 1, second.Issues.BugClosed)
+}
+
+func TestQueryResolver_ReviewNetwork(t *testing.T) {
+	t.Parallel()
+
+	from, to, bad := "2024-01-01", "2024-01-31", "2024/01/01"
+
+	tests := []struct {
+		name     string
+		reader   *fakeSnapshotReader
+		from, to *string
+		want     *model.ReviewNetwork
+		wantFrom string
+		wantTo   string
+		wantErr  bool
+	}{
+		{
+			name: "maps nodes and weighted edges and passes the range through",
+			reader: &fakeSnapshotReader{
+				network: &application.ReviewNetwork{
+					Nodes: []*application.ReviewNetworkNode{
+						{Login: "Tattsum", ReviewsGiven: 3, ReviewsReceived: 1},
+						{Login: "hubot", ReviewsGiven: 1, ReviewsReceived: 3},
+					},
+					Edges: []*application.ReviewNetworkEdge{
+						{Reviewer: "Tattsum", Author: "hubot", ReviewCount: 3, Repositories: []string{"acme/api", "acme/web"}},
+						{Reviewer: "hubot", Author: "Tattsum", ReviewCount: 1, Repositories: []string{"acme/api"}},
+					},
+				},
+			},
+			from: &from,
+			to:   &to,
+			want: &model.ReviewNetwork{
+				Nodes: []*model.ReviewNetworkNode{
+					{Login: "Tattsum", ReviewsGiven: 3, ReviewsReceived: 1},
+					{Login: "hubot", ReviewsGiven: 1, ReviewsReceived: 3},
+				},
+				Edges: []*model.ReviewNetworkEdge{
+					{Reviewer: "Tattsum", Author: "hubot", ReviewCount: 3, Repositories: []string{"acme/api", "acme/web"}},
+					{Reviewer: "hubot", Author: "Tattsum", ReviewCount: 1, Repositories: []string{"acme/api"}},
+				},
+			},
+			wantFrom: from,
+			wantTo:   to,
+		},
+		{
+			name:   "omitted range is open-ended and empty graph maps to empty lists",
+			reader: &fakeSnapshotReader{network: &application.ReviewNetwork{}},
+			want: &model.ReviewNetwork{
+				Nodes: []*model.ReviewNetworkNode{},
+				Edges: []*model.ReviewNetworkEdge{},
+			},
+		},
+		{
+			name:    "malformed date is rejected",
+			reader:  &fakeSnapshotReader{network: &application.ReviewNetwork{}},
+			from:    &bad,
+			wantErr: true,
+		},
+		{
+			name:    "reader error is wrapped",
+			reader:  &fakeSnapshotReader{err: errors.New("boom")},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := newTestQueryResolver(t, tt.reader)
+
+			got, err := r.ReviewNetwork(context.Background(), tt.from, tt.to)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantFrom, tt.reader.gotFrom)
+			assert.Equal(t, tt.wantTo, tt.reader.gotTo)
+		})
+	}
+}
+
+func TestQueryResolver_ReviewLoad(t *testing.T) {
+	t.Parallel()
+
+	requested := time.Date(2024, 3, 14, 10, 0, 0, 0, time.FixedZone("JST", 9*60*60))
+
+	tests := []struct {
+		name    string
+		reader  *fakeSnapshotReader
+		want    []*model.ReviewLoad
+		wantErr bool
+	}{
+		{
+			name: "maps counts and pending reviews with RFC 3339 timestamps",
+			reader: &fakeSnapshotReader{
+				reviewLoad: []*application.ReviewLoad{
+					{
+						Reviewer:  "alice",
+						Requested: 3,
+						Completed: 1,
+						PendingReviews: []*application.PendingReview{
+							{Repository: "acme/api", SourceID: "PR_1", Author: "Tattsum", RequestedAt: requested},
+						},
+					},
+					{Reviewer: "bob", Requested: 1, Completed: 1},
+				},
+			},
+			want: []*model.ReviewLoad{
+				{
+					Reviewer:  "alice",
+					Requested: 3,
+					Completed: 1,
+					Pending:   1,
+					PendingReviews: []*model.PendingReview{
+						{Repository: "acme/api", Author: "Tattsum", RequestedAt: "2024-03-14T01:00:00Z"},
+					},
+				},
+				{Reviewer: "bob", Requested: 1, Completed: 1, PendingReviews: []*model.PendingReview{}},
+			},
+		},
+		{
+			name:   "no review requests yields empty slice",
+			reader: &fakeSnapshotReader{},
+			want:   []*model.ReviewLoad{},
+		},
+		{
+			name:    "reader error is wrapped",
+			reader:  &fakeSnapshotReader{err: errors.New("boom")},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := newTestQueryResolver(t, tt.reader)
+
+			got, err := r.ReviewLoad(context.Background())
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestQueryResolver_DeliveryMetrics(t *testing.T) {
+	t.Parallel()
+
+	from, to, bad := "2024-03-01", "2024-03-31", "2024/03/01"
+	lead, rate := 4.0, 0.25
+
+	t.Run("maps the summary and weeks and passes the arguments through", func(t *testing.T) {
+		t.Parallel()
+		reader := &fakeSnapshotReader{delivery: &application.DeliveryMetrics{
+			Repository:            "acme/api",
+			Source:                domain.DeliverySourceDeployment,
+			DeploymentCount:       4,
+			FailedDeploymentCount: 1,
+			WeekCount:             5,
+			DeploymentsPerWeek:    0.8,
+			LeadTimeHours:         &lead,
+			ChangeFailureRate:     &rate,
+			Weeks: []*domain.DeliveryWeek{{
+				Repository: "acme/api", Week: "2024-03-04", Source: domain.DeliverySourceDeployment,
+				DeploymentCount: 4, FailedDeploymentCount: 1, LeadTimeSeconds: 14400, LeadTimeCount: 1,
+			}},
+		}}
+
+		got, err := newTestQueryResolver(t, reader).DeliveryMetrics(context.Background(), "acme/api", &from, &to)
+		require.NoError(t, err)
+
+		source := model.DeliverySourceDeployment
+		assert.Equal(t, &model.DeliveryMetrics{
+			Repository:            "acme/api",
+			Source:                &source,
+			DeploymentCount:       4,
+			FailedDeploymentCount: 1,
+			WeekCount:             5,
+			DeploymentsPerWeek:    0.8,
+			LeadTimeHours:         &lead,
+			ChangeFailureRate:     &rate,
+			Weeks: []*model.DeliveryWeek{{
+				Week: "2024-03-04", Source: model.DeliverySourceDeployment,
+				DeploymentCount: 4, FailedDeploymentCount: 1, LeadTimeSeconds: 14400, LeadTimeCount: 1,
+			}},
+		}, got)
+		assert.Equal(t, "acme/api", reader.gotRepository)
+		assert.Equal(t, from, reader.gotFrom)
+		assert.Equal(t, to, reader.gotTo)
+	})
+
+	t.Run("no deployments has no source and empty weeks", func(t *testing.T) {
+		t.Parallel()
+		reader := &fakeSnapshotReader{delivery: &application.DeliveryMetrics{Repository: "acme/api"}}
+
+		got, err := newTestQueryResolver(t, reader).DeliveryMetrics(context.Background(), "acme/api", nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, &model.DeliveryMetrics{Repository: "acme/api", Weeks: []*model.DeliveryWeek{}}, got)
+	})
+
+	t.Run("malformed date is rejected", func(t *testing.T) {
+		t.Parallel()
+		_, err := newTestQueryResolver(t, &fakeSnapshotReader{}).DeliveryMetrics(context.Background(), "acme/api", nil, &bad)
+		require.Error(t, err)
+	})
+
+	t.Run("reader error is wrapped", func(t *testing.T) {
+		t.Parallel()
+		_, err := newTestQueryResolver(t, &fakeSnapshotReader{err: errors.New("boom")}).DeliveryMetrics(context.Background(), "acme/api", nil, nil)
+		require.Error(t, err)
+	})
+}
+
+func TestQueryResolver_ActivityHeatmap(t *testing.T) {
+	t.Parallel()
+
+	from, to, bad := "2024-03-01", "2024-03-31", "2024/03/01"
+
+	t.Run("maps the rows Monday first and passes the arguments through", func(t *testing.T) {
+		t.Parallel()
+		heatmap := domain.NewActivityHeatmap("Tattsum", "Asia/Tokyo", []*domain.HourlyActivity{
+			{Weekday: time.Monday, Hour: 10, Count: 2},
+			{Weekday: time.Monday, Hour: 23, Count: 1},
+			{Weekday: time.Sunday, Hour: 14, Count: 1},
+		})
+		reader := &fakeSnapshotReader{heatmap: heatmap}
+
+		got, err := newTestQueryResolver(t, reader).ActivityHeatmap(context.Background(), "Tattsum", &from, &to)
+		require.NoError(t, err)
+
+		assert.Equal(t, "Tattsum", got.Login)
+		assert.Equal(t, "Asia/Tokyo", got.TimeZone)
+		assert.Equal(t, 4, got.Total)
+		assert.Equal(t, 1, got.OffHours)
+		assert.Equal(t, 1, got.Weekend)
+		assert.InDelta(t, 0.5, got.OffHoursRatio, 1e-9)
+		require.Len(t, got.Rows, 7)
+		assert.Equal(t, model.WeekdayMonday, got.Rows[0].Weekday)
+		assert.Equal(t, model.WeekdaySunday, got.Rows[6].Weekday)
+		require.Len(t, got.Rows[0].Counts, 24)
+		assert.Equal(t, 2, got.Rows[0].Counts[10])
+		assert.Equal(t, 1, got.Rows[0].Counts[23])
+		assert.Equal(t, 1, got.Rows[6].Counts[14])
+		assert.Equal(t, "Tattsum", reader.gotLogin)
+		assert.Equal(t, from, reader.gotFrom)
+		assert.Equal(t, to, reader.gotTo)
+	})
+
+	t.Run("unknown member yields null", func(t *testing.T) {
+		t.Parallel()
+		got, err := newTestQueryResolver(t, &fakeSnapshotReader{}).ActivityHeatmap(context.Background(), "ghost", nil, nil)
+		require.NoError(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("malformed date is rejected", func(t *testing.T) {
+		t.Parallel()
+		_, err := newTestQueryResolver(t, &fakeSnapshotReader{}).ActivityHeatmap(context.Background(), "Tattsum", &bad, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("reader error is wrapped", func(t *testing.T) {
+		t.Parallel()
+		_, err := newTestQueryResolver(t, &fakeSnapshotReader{err: errors.New("boom")}).ActivityHeatmap(context.Background(), "Tattsum", nil, nil)
+		require.Error(t, err)
+	})
+}
+
+func TestQueryResolver_SeriesArguments(t *testing.T) {
+	t.Parallel()
+
+	str := func(v string) *string { return &v }
+	week := model.GranularityWeek
+	month := model.GranularityMonth
+
+	tests := []struct {
+		name        string
+		from, to    *string
+		granularity *model.Granularity
+		snapshotID  *string
+		want        application.SeriesOptions
+		wantErr     bool
+	}{
+		{
+			name: "omitted arguments select the latest daily series",
+			want: application.SeriesOptions{},
+		},
+		{
+			name:        "range, granularity and snapshot are passed through",
+			from:        str("2024-01-01"),
+			to:          str("2024-03-31"),
+			granularity: &week,
+			snapshotID:  str("7"),
+			want: application.SeriesOptions{
+				SnapshotID:  7,
+				From:        "2024-01-01",
+				To:          "2024-03-31",
+				Granularity: application.GranularityWeek,
+			},
+		},
+		{
+			name:        "month granularity only",
+			granularity: &month,
+			want:        application.SeriesOptions{Granularity: application.GranularityMonth},
+		},
+		{
+			name:    "malformed from is rejected",
+			from:    str("2024/01/01"),
+			wantErr: true,
+		},
+		{
+			name:    "inverted range is rejected",
+			from:    str("2024-02-01"),
+			to:      str("2024-01-01"),
+			wantErr: true,
+		},
+		{
+			name:       "non-numeric snapshot id is rejected",
+			snapshotID: str("latest"),
+			wantErr:    true,
+		},
+		{
+			name:       "non-positive snapshot id is rejected",
+			snapshotID: str("0"),
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			calls := map[string]func(r QueryResolver) error{
+				"member": func(r QueryResolver) error {
+					_, err := r.Member(context.Background(), "octocat", tt.from, tt.to, tt.granularity, tt.snapshotID)
+					return err
+				},
+				"teamDailyStats": func(r QueryResolver) error {
+					_, err := r.TeamDailyStats(context.Background(), tt.from, tt.to, tt.granularity, tt.snapshotID)
+					return err
+				},
+				"repository": func(r QueryResolver) error {
+					_, err := r.Repository(context.Background(), "Tattsum/dotfiles", tt.from, tt.to, tt.granularity, tt.snapshotID)
+					return err
+				},
+				"repositoryDailyStats": func(r QueryResolver) error {
+					_, err := r.RepositoryDailyStats(context.Background(), tt.from, tt.to, tt.granularity, tt.snapshotID)
+					return err
+				},
+			}
+			for field, call := range calls {
+				reader := &fakeSnapshotReader{}
+				err := call(newTestQueryResolver(t, reader))
+				if tt.wantErr {
+					require.Error(t, err, field)
+					continue
+				}
+				require.NoError(t, err, field)
+				assert.Equal(t, tt.want, reader.gotOpts, field)
+			}
+		})
+	}
+}
+
+func TestQueryResolver_Snapshots(t *testing.T) {
+	t.Parallel()
+
+	capturedAt := time.Date(2024, time.March, 15, 9, 30, 0, 0, time.FixedZone("JST", 9*60*60))
+	tag := "q1-review"
+	periodSince, periodUntil := "2025-03-31T15:00:00Z", "2026-04-01T00:00:00Z"
+
+	tests := []struct {
+		name    string
+		reader  *fakeSnapshotReader
+		want    []*model.SnapshotInfo
+		wantErr bool
+	}{
+		{
+			name: "maps snapshot headers with UTC timestamps",
+			reader: &fakeSnapshotReader{
+				snapshots: []*application.SnapshotInfo{
+					{
+						ID: 12, CapturedAt: capturedAt, MemberCount: 8, RepositoryCount: 31,
+						ExcludedMembers: []*domain.ExcludedActor{{Login: "renovate", Rule: "bot"}},
+						Period: domain.CollectionPeriod{
+							Since: time.Date(2025, time.April, 1, 0, 0, 0, 0, time.FixedZone("JST", 9*60*60)),
+							Until: time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC),
+						},
+					},
+				},
+			},
+			want: []*model.SnapshotInfo{
+				{
+					ID: "12", CapturedAt: "2024-03-15T00:30:00Z", MemberCount: 8, RepositoryCount: 31,
+					ExcludedMembers: []*model.ExcludedMember{{Login: "renovate", Rule: "bot"}},
+					Period:          &model.CollectionPeriod{Since: &periodSince, Until: &periodUntil},
+				},
+			},
+		},
+		{
+			name: "tag is exposed only when set",
+			reader: &fakeSnapshotReader{
+				snapshots: []*application.SnapshotInfo{
+					{ID: 13, CapturedAt: capturedAt, Tag: "q1-review"},
+					{ID: 12, CapturedAt: capturedAt},
+				},
+			},
+			want: []*model.SnapshotInfo{
+				{ID: "13", CapturedAt: "2024-03-15T00:30:00Z", Tag: &tag, ExcludedMembers: []*model.ExcludedMember{}, Period: &model.CollectionPeriod{}},
+				{ID: "12", CapturedAt: "2024-03-15T00:30:00Z", ExcludedMembers: []*model.ExcludedMember{}, Period: &model.CollectionPeriod{}},
+			},
+		},
+		{
+			name:   "no snapshots yields empty slice",
+			reader: &fakeSnapshotReader{},
+			want:   []*model.SnapshotInfo{},
+		},
+		{
+			name:    "reader error is wrapped",
+			reader:  &fakeSnapshotReader{err: errors.New("boom")},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := newTestQueryResolver(t, tt.reader)
+
+			got, err := r.Snapshots(context.Background())
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestQueryResolver_Snapshot(t *testing.T) {
+	t.Parallel()
+
+	capturedAt := time.Date(2024, time.March, 15, 0, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		id      string
+		reader  *fakeSnapshotReader
+		want    *model.Snapshot
+		wantIDs []int
+		wantErr bool
+	}{
+		{
+			name: "exposes members, summary and repositories of the requested snapshot",
+			id:   "7",
+			reader: &fakeSnapshotReader{
+				snapshot:    &application.SnapshotInfo{ID: 7, CapturedAt: capturedAt, MemberCount: 1, RepositoryCount: 1},
+				members:     []*application.MemberStats{{Login: "octocat", Name: "octocat", TotalCommits: 3}},
+				teamSummary: &application.TeamSummary{MemberCount: 1, RepositoryCount: 1, TotalCommits: 3},
+				repos:       []*application.RepositoryStats{{NameWithOwner: "acme/api", TotalCommits: 3}},
+			},
+			want: &model.Snapshot{
+				ID:              "7",
+				CapturedAt:      "2024-03-15T00:30:00Z",
+				MemberCount:     1,
+				RepositoryCount: 1,
+				ExcludedMembers: []*model.ExcludedMember{},
+				Period:          &model.CollectionPeriod{},
+				Members: []*model.MemberStats{
+					{
+						Login: "octocat", Name: "octocat", TotalCommits: 3, CycleTime: toCycleTimeStats(domain.CycleTimeStats{}),
+						PrSize: emptyPRSize(), Issues: &model.IssueStats{}, CategoryBreakdown: emptyCategoryBreakdown(), Complete: true, DataGaps: []*model.DataGap{},
+					},
+				},
+				TeamSummary: &model.TeamSummary{MemberCount: 1, RepositoryCount: 1, TotalCommits: 3, Issues: &model.IssueStats{}, CategoryBreakdown: emptyCategoryBreakdown()},
+				Repositories: []*model.RepositoryStats{
+					toRepositoryStats(&application.RepositoryStats{NameWithOwner: "acme/api", TotalCommits: 3}),
+				},
+			},
+			wantIDs: []int{7, 7, 7},
+		},
+		{
+			name:   "unknown snapshot resolves to null",
+			id:     "99",
+			reader: &fakeSnapshotReader{},
+			want:   nil,
+		},
+		{
+			name:    "non-numeric id is rejected",
+			id:      "latest",
+			reader:  &fakeSnapshotReader{},
+			wantErr: true,
+		},
+		{
+			name:    "reader error is wrapped",
+			id:      "7",
+			reader:  &fakeSnapshotReader{err: errors.New("boom")},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := newTestQueryResolver(t, tt.reader)
+
+			got, err := r.Snapshot(context.Background(), tt.id)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantIDs, tt.reader.gotSnapshotIDs)
+		})
+	}
+}
+
+func TestQueryResolver_MemberHistory(t *testing.T) {
+	t.Parallel()
+
+	first := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	second := time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		reader  *fakeSnapshotReader
+		want    []*model.MemberHistoryPoint
+		wantErr bool
+	}{
+		{
+			name: "maps each snapshot point in order",
+			reader: &fakeSnapshotReader{
+				history: []*application.MemberHistoryPoint{
+					{SnapshotID: 1, CapturedAt: first, Stats: &application.MemberStats{Login: "octocat", TotalCommits: 10}},
+					{SnapshotID: 2, CapturedAt: second, Stats: &application.MemberStats{Login: "octocat", TotalCommits: 14}},
+				},
+			},
+			want: []*model.MemberHistoryPoint{
+				{
+					SnapshotID: "1",
+					CapturedAt: "2024-01-01T00:00:00Z",
+					Stats:      toMemberStats(&application.MemberStats{Login: "octocat", TotalCommits: 10}),
+				},
+				{
+					SnapshotID: "2",
+					CapturedAt: "2024-02-01T00:00:00Z",
+					Stats:      toMemberStats(&application.MemberStats{Login: "octocat", TotalCommits: 14}),
+				},
+			},
+		},
+		{
+			name:   "unknown member yields empty slice",
+			reader: &fakeSnapshotReader{},
+			want:   []*model.MemberHistoryPoint{},
+		},
+		{
+			name:    "reader error is wrapped",
+			reader:  &fakeSnapshotReader{err: errors.New("boom")},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := newTestQueryResolver(t, tt.reader)
+
+			got, err := r.MemberHistory(context.Background(), "octocat")
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestQueryResolver_SnapshotDiff(t *testing.T) {
+	t.Parallel()
+
+	capturedAt := time.Date(2024, time.March, 15, 0, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		base, head string
+		reader     *fakeSnapshotReader
+		want       *model.SnapshotDiff
+		wantErrIs  error
+		wantErr    bool
+	}{
+		{
+			name: "identical snapshots report no changes",
+			base: "1",
+			head: "1",
+			reader: &fakeSnapshotReader{
+				snapshot: &application.SnapshotInfo{ID: 1, CapturedAt: capturedAt, MemberCount: 1},
+				members:  []*application.MemberStats{{Login: "octocat", TotalCommits: 3}},
+			},
+			want: &model.SnapshotDiff{
+				Base:                &model.SnapshotInfo{ID: "1", CapturedAt: "2024-03-15T00:30:00Z", MemberCount: 1, ExcludedMembers: []*model.ExcludedMember{}, Period: &model.CollectionPeriod{}},
+				Head:                &model.SnapshotInfo{ID: "1", CapturedAt: "2024-03-15T00:30:00Z", MemberCount: 1, ExcludedMembers: []*model.ExcludedMember{}, Period: &model.CollectionPeriod{}},
+				AddedMembers:        []string{},
+				RemovedMembers:      []string{},
+				AddedRepositories:   []string{},
+				RemovedRepositories: []string{},
+				MemberDeltas:        []*model.MemberDelta{},
+				RepositoryDeltas:    []*model.RepositoryDelta{},
+			},
+		},
+		{
+			name:      "unknown snapshot is an error",
+			base:      "1",
+			head:      "2",
+			reader:    &fakeSnapshotReader{},
+			wantErr:   true,
+			wantErrIs: application.ErrSnapshotNotFound,
+		},
+		{
+			name:    "non-numeric head is rejected",
+			base:    "1",
+			head:    "HEAD",
+			reader:  &fakeSnapshotReader{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := newTestQueryResolver(t, tt.reader)
+
+			got, err := r.SnapshotDiff(context.Background(), tt.base, tt.head)
+			if tt.wantErr {
+				require.Error(t, err)
+				if tt.wantErrIs != nil {
+					assert.ErrorIs(t, err, tt.wantErrIs)
+				}
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
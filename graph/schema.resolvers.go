@@ -9,25 +9,26 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/Tattsum/github-analytics/application"
 	"github.com/Tattsum/github-analytics/graph/model"
 )
 
 // Members is the resolver for the members field.
 func (r *queryResolver) Members(ctx context.Context) ([]*model.MemberStats, error) {
-	members, err := r.reader.LatestMembers(ctx)
+	members, err := r.reader.Members(ctx, 0)
 	if err != nil {
 		return nil, fmt.Errorf("resolve members: %w", err)
 	}
-	out := make([]*model.MemberStats, 0, len(members))
-	for _, m := range members {
-		out = append(out, toMemberStats(m))
-	}
-	return out, nil
+	return toMemberStatsList(members), nil
 }
 
 // Member is the resolver for the member field.
-func (r *queryResolver) Member(ctx context.Context, login string) (*model.UserStatistics, error) {
-	stats, err := r.reader.Member(ctx, login)
+func (r *queryResolver) Member(ctx context.Context, login string, from *string, to *string, granularity *model.Granularity, snapshotID *string) (*model.UserStatistics, error) {
+	opts, err := seriesOptions(from, to, granularity, snapshotID)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := r.reader.Member(ctx, login, opts)
 	if err != nil {
 		return nil, fmt.Errorf("resolve member %q: %w", login, err)
 	}
@@ -39,7 +40,7 @@ func (r *queryResolver) Member(ctx context.Context, login string) (*model.UserSt
 
 // TeamSummary is the resolver for the teamSummary field.
 func (r *queryResolver) TeamSummary(ctx context.Context) (*model.TeamSummary, error) {
-	summary, err := r.reader.TeamSummary(ctx)
+	summary, err := r.reader.TeamSummary(ctx, 0)
 	if err != nil {
 		return nil, fmt.Errorf("resolve teamSummary: %w", err)
 	}
@@ -47,8 +48,12 @@ func (r *queryResolver) TeamSummary(ctx context.Context) (*model.TeamSummary, er
 }
 
 // TeamDailyStats is the resolver for the teamDailyStats field.
-func (r *queryResolver) TeamDailyStats(ctx context.Context) ([]*model.DailyStatistics, error) {
-	daily, err := r.reader.TeamDailyStats(ctx)
+func (r *queryResolver) TeamDailyStats(ctx context.Context, from *string, to *string, granularity *model.Granularity, snapshotID *string) ([]*model.DailyStatistics, error) {
+	opts, err := seriesOptions(from, to, granularity, snapshotID)
+	if err != nil {
+		return nil, err
+	}
+	daily, err := r.reader.TeamDailyStats(ctx, opts)
 	if err != nil {
 		return nil, fmt.Errorf("resolve teamDailyStats: %w", err)
 	}
@@ -61,20 +66,20 @@ func (r *queryResolver) TeamDailyStats(ctx context.Context) ([]*model.DailyStati
 
 // Repositories is the resolver for the repositories field.
 func (r *queryResolver) Repositories(ctx context.Context) ([]*model.RepositoryStats, error) {
-	repos, err := r.reader.Repositories(ctx)
+	repos, err := r.reader.Repositories(ctx, 0)
 	if err != nil {
 		return nil, fmt.Errorf("resolve repositories: %w", err)
 	}
-	out := make([]*model.RepositoryStats, 0, len(repos))
-	for _, repo := range repos {
-		out = append(out, toRepositoryStats(repo))
-	}
-	return out, nil
+	return toRepositoryStatsList(repos), nil
 }
 
 // Repository is the resolver for the repository field.
-func (r *queryResolver) Repository(ctx context.Context, nameWithOwner string) (*model.RepositoryStats, error) {
-	repo, err := r.reader.Repository(ctx, nameWithOwner)
+func (r *queryResolver) Repository(ctx context.Context, nameWithOwner string, from *string, to *string, granularity *model.Granularity, snapshotID *string) (*model.RepositoryStats, error) {
+	opts, err := seriesOptions(from, to, granularity, snapshotID)
+	if err != nil {
+		return nil, err
+	}
+	repo, err := r.reader.Repository(ctx, nameWithOwner, opts)
 	if err != nil {
 		return nil, fmt.Errorf("resolve repository %q: %w", nameWithOwner, err)
 	}
@@ -85,8 +90,12 @@ func (r *queryResolver) Repository(ctx context.Context, nameWithOwner string) (*
 }
 
 // RepositoryDailyStats is the resolver for the repositoryDailyStats field.
-func (r *queryResolver) RepositoryDailyStats(ctx context.Context) ([]*model.RepositoryDailyStats, error) {
-	repos, err := r.reader.RepositoryDailyStats(ctx)
+func (r *queryResolver) RepositoryDailyStats(ctx context.Context, from *string, to *string, granularity *model.Granularity, snapshotID *string) ([]*model.RepositoryDailyStats, error) {
+	opts, err := seriesOptions(from, to, granularity, snapshotID)
+	if err != nil {
+		return nil, err
+	}
+	repos, err := r.reader.RepositoryDailyStats(ctx, opts)
 	if err != nil {
 		return nil, fmt.Errorf("resolve repositoryDailyStats: %w", err)
 	}
@@ -97,7 +106,153 @@ func (r *queryResolver) RepositoryDailyStats(ctx context.Context) ([]*model.Repo
 	return out, nil
 }
 
+// ReviewNetwork is the resolver for the reviewNetwork field.
+func (r *queryResolver) ReviewNetwork(ctx context.Context, from *string, to *string) (*model.ReviewNetwork, error) {
+	fromDay, err := dateArg("from", from)
+	if err != nil {
+		return nil, fmt.Errorf("resolve reviewNetwork: %w", err)
+	}
+	toDay, err := dateArg("to", to)
+	if err != nil {
+		return nil, fmt.Errorf("resolve reviewNetwork: %w", err)
+	}
+	network, err := r.reader.ReviewNetwork(ctx, fromDay, toDay)
+	if err != nil {
+		return nil, fmt.Errorf("resolve reviewNetwork: %w", err)
+	}
+	return toReviewNetwork(network), nil
+}
+
+// ReviewLoad is the resolver for the reviewLoad field.
+func (r *queryResolver) ReviewLoad(ctx context.Context) ([]*model.ReviewLoad, error) {
+	loads, err := r.reader.ReviewLoad(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("resolve reviewLoad: %w", err)
+	}
+	return toReviewLoads(loads), nil
+}
+
+// ActivityHeatmap is the resolver for the activityHeatmap field.
+func (r *queryResolver) ActivityHeatmap(ctx context.Context, login string, from *string, to *string) (*model.ActivityHeatmap, error) {
+	fromDay, err := dateArg("from", from)
+	if err != nil {
+		return nil, fmt.Errorf("resolve activityHeatmap: %w", err)
+	}
+	toDay, err := dateArg("to", to)
+	if err != nil {
+		return nil, fmt.Errorf("resolve activityHeatmap: %w", err)
+	}
+	heatmap, err := r.reader.ActivityHeatmap(ctx, login, fromDay, toDay)
+	if err != nil {
+		return nil, fmt.Errorf("resolve activityHeatmap: %w", err)
+	}
+	if heatmap == nil {
+		return nil, nil
+	}
+	return toActivityHeatmap(heatmap), nil
+}
+
+// DeliveryMetrics is the resolver for the deliveryMetrics field.
+func (r *queryResolver) DeliveryMetrics(ctx context.Context, repository string, from *string, to *string) (*model.DeliveryMetrics, error) {
+	fromDay, err := dateArg("from", from)
+	if err != nil {
+		return nil, fmt.Errorf("resolve deliveryMetrics: %w", err)
+	}
+	toDay, err := dateArg("to", to)
+	if err != nil {
+		return nil, fmt.Errorf("resolve deliveryMetrics: %w", err)
+	}
+	metrics, err := r.reader.DeliveryMetrics(ctx, repository, fromDay, toDay)
+	if err != nil {
+		return nil, fmt.Errorf("resolve deliveryMetrics: %w", err)
+	}
+	return toDeliveryMetrics(metrics), nil
+}
+
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
 type queryResolver struct{ *Resolver }
+
+// Snapshots is the resolver for the snapshots field.
+func (r *queryResolver) Snapshots(ctx context.Context) ([]*model.SnapshotInfo, error) {
+	snapshots, err := r.reader.Snapshots(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("resolve snapshots: %w", err)
+	}
+	out := make([]*model.SnapshotInfo, 0, len(snapshots))
+	for _, info := range snapshots {
+		out = append(out, toSnapshotInfo(info))
+	}
+	return out, nil
+}
+
+// Snapshot is the resolver for the snapshot field.
+func (r *queryResolver) Snapshot(ctx context.Context, id string) (*model.Snapshot, error) {
+	snapshotID, err := snapshotIDArg("id", id)
+	if err != nil {
+		return nil, err
+	}
+	info, err := r.reader.Snapshot(ctx, snapshotID)
+	if err != nil {
+		return nil, fmt.Errorf("resolve snapshot %s: %w", id, err)
+	}
+	if info == nil {
+		return nil, nil
+	}
+	members, err := r.reader.Members(ctx, snapshotID)
+	if err != nil {
+		return nil, fmt.Errorf("resolve snapshot %s members: %w", id, err)
+	}
+	summary, err := r.reader.TeamSummary(ctx, snapshotID)
+	if err != nil {
+		return nil, fmt.Errorf("resolve snapshot %s teamSummary: %w", id, err)
+	}
+	repos, err := r.reader.Repositories(ctx, snapshotID)
+	if err != nil {
+		return nil, fmt.Errorf("resolve snapshot %s repositories: %w", id, err)
+	}
+	header := toSnapshotInfo(info)
+	return &model.Snapshot{
+		ID:              header.ID,
+		CapturedAt:      header.CapturedAt,
+		Tag:             header.Tag,
+		MemberCount:     header.MemberCount,
+		RepositoryCount: header.RepositoryCount,
+		ExcludedMembers: header.ExcludedMembers,
+		Period:          header.Period,
+		Members:         toMemberStatsList(members),
+		TeamSummary:     toTeamSummary(summary),
+		Repositories:    toRepositoryStatsList(repos),
+	}, nil
+}
+
+// MemberHistory is the resolver for the memberHistory field.
+func (r *queryResolver) MemberHistory(ctx context.Context, login string) ([]*model.MemberHistoryPoint, error) {
+	history, err := r.reader.MemberHistory(ctx, login)
+	if err != nil {
+		return nil, fmt.Errorf("resolve memberHistory %q: %w", login, err)
+	}
+	out := make([]*model.MemberHistoryPoint, 0, len(history))
+	for _, p := range history {
+		out = append(out, toMemberHistoryPoint(p))
+	}
+	return out, nil
+}
+
+// SnapshotDiff is the resolver for the snapshotDiff field.
+func (r *queryResolver) SnapshotDiff(ctx context.Context, base string, head string) (*model.SnapshotDiff, error) {
+	baseID, err := snapshotIDArg("base", base)
+	if err != nil {
+		return nil, err
+	}
+	headID, err := snapshotIDArg("head", head)
+	if err != nil {
+		return nil, err
+	}
+	diff, err := application.CompareSnapshots(ctx, r.reader, baseID, headID)
+	if err != nil {
+		return nil, fmt.Errorf("resolve snapshotDiff %s..%s: %w", base, head, err)
+	}
+	return toSnapshotDiff(diff), nil
+}
@@ -0,0 +1,252 @@
+package infrastructure
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Tattsum/github-analytics/domain"
+)
+
+const (
+	// checkpointDirPerm and checkpointFilePerm keep fetched activity, which may
+	// include private repositories, readable by the owner only.
+	checkpointDirPerm  = 0o700
+	checkpointFilePerm = 0o600
+
+	runManifestFile = "run.json"
+	usersDir        = "users"
+)
+
+var (
+	// ErrInvalidRunID is returned for run IDs that are not a single safe path
+	// component.
+	ErrInvalidRunID = errors.New("invalid run id")
+	// ErrRunNotFound is returned when resuming a run that has no state on disk.
+	ErrRunNotFound = errors.New("run not found")
+	// ErrRunExists is returned when creating a run whose state already exists.
+	ErrRunExists = errors.New("run already exists")
+
+	runIDPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+)
+
+// RunManifest records what a batch run was asked to do, so that a resumed run
+// fetches exactly the same users with the same options.
+type RunManifest struct {
+	RunID          string    `json:"run_id"`
+	StartedAt      time.Time `json:"started_at"`
+	Users          []string  `json:"users"`
+	IncludePrivate bool      `json:"include_private"`
+	Full           bool      `json:"full"`
+	// Collect is the collection mode ("user" or "repository"); empty in
+	// manifests written before repository-centric collection existed.
+	Collect string `json:"collect,omitempty"`
+	// Org is the organization whose repositories are walked in repository
+	// collection mode.
+	Org string `json:"org,omitempty"`
+	// CommitLines fetches per-commit additions and deletions in user
+	// collection mode.
+	CommitLines bool `json:"commit_lines,omitempty"`
+	// DeliveryMetrics fetches the deployments, releases and tags of the
+	// members' repositories for the weekly delivery metrics.
+	DeliveryMetrics bool `json:"delivery_metrics,omitempty"`
+	// LookbackYears limits how far back activity is fetched; 0 keeps the
+	// default lookback.
+	LookbackYears int `json:"lookback_years,omitempty"`
+	// PeriodSince and PeriodUntil are the collection period of the run; zero
+	// when that side is not limited.
+	PeriodSince time.Time `json:"period_since,omitzero"`
+	PeriodUntil time.Time `json:"period_until,omitzero"`
+	// ExcludeLogins, ExcludePatterns and ExcludeBots are the bot and
+	// service-account exclusion rules of the run.
+	ExcludeLogins   []string `json:"exclude_logins,omitempty"`
+	ExcludePatterns []string `json:"exclude_patterns,omitempty"`
+	ExcludeBots     bool     `json:"exclude_bots,omitempty"`
+	// Excluded are the accounts the rules left out of Users.
+	Excluded []*domain.ExcludedActor `json:"excluded,omitempty"`
+	// Identities merge several GitHub accounts into one member; Users holds
+	// their canonical logins.
+	Identities []domain.Identity `json:"identities,omitempty"`
+	// TimeZone is the default time zone activity hours are counted in, for
+	// members whose identity has none; empty means UTC.
+	TimeZone string `json:"time_zone,omitempty"`
+	// IssueBugLabels and IssueFeatureLabels map issue labels to bugs and
+	// features; empty keeps the default labels.
+	IssueBugLabels     []string `json:"issue_bug_labels,omitempty"`
+	IssueFeatureLabels []string `json:"issue_feature_labels,omitempty"`
+	// PRCategoryRules are the rules pull requests are classified into work
+	// categories with; empty keeps the default rules.
+	PRCategoryRules []domain.WorkCategoryRule `json:"pr_category_rules,omitempty"`
+}
+
+// Period returns the collection period of the run.
+func (m *RunManifest) Period() domain.CollectionPeriod {
+	return domain.CollectionPeriod{Since: m.PeriodSince, Until: m.PeriodUntil}
+}
+
+// UserCheckpoint is one user's fetch result. Cutoff is the incremental cutoff
+// the data was fetched from (zero for a full fetch); a checkpoint is only
+// reusable while the user's baseline still yields the same cutoff.
+type UserCheckpoint struct {
+	Login     string            `json:"login"`
+	Cutoff    time.Time         `json:"cutoff"`
+	FetchedAt time.Time         `json:"fetched_at"`
+	Data      *UserActivityData `json:"data"`
+}
+
+// CheckpointStore persists the progress of one batch run under
+// <stateDir>/<runID>: the run manifest and one JSON file per completed user.
+// Every file is written to a temporary name and renamed into place, so an
+// interrupted write never leaves a truncated checkpoint behind.
+type CheckpointStore struct {
+	dir string
+}
+
+// NewCheckpointStore returns the store for runID under stateDir. It does not
+// touch the filesystem; use CreateRun or LoadRun.
+func NewCheckpointStore(stateDir, runID string) (*CheckpointStore, error) {
+	if !runIDPattern.MatchString(runID) {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidRunID, runID)
+	}
+
+	return &CheckpointStore{dir: filepath.Join(stateDir, runID)}, nil
+}
+
+// NewRunID returns a sortable, human-readable run ID for a run started at t.
+func NewRunID(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+// Dir returns the directory holding the run's state.
+func (s *CheckpointStore) Dir() string {
+	return s.dir
+}
+
+// CreateRun initializes the state directory of a new run and writes its
+// manifest. It fails with ErrRunExists if the run already has state.
+func (s *CheckpointStore) CreateRun(manifest *RunManifest) error {
+	if _, err := os.Stat(s.dir); err == nil {
+		return fmt.Errorf("%w: %s", ErrRunExists, s.dir)
+	}
+
+	if err := os.MkdirAll(filepath.Join(s.dir, usersDir), checkpointDirPerm); err != nil {
+		return fmt.Errorf("create run state directory: %w", err)
+	}
+
+	return writeJSONFile(filepath.Join(s.dir, runManifestFile), manifest)
+}
+
+// LoadRun reads the manifest of an existing run.
+func (s *CheckpointStore) LoadRun() (*RunManifest, error) {
+	var manifest RunManifest
+	if err := readJSONFile(filepath.Join(s.dir, runManifestFile), &manifest); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %s", ErrRunNotFound, s.dir)
+		}
+
+		return nil, err
+	}
+
+	return &manifest, nil
+}
+
+// SaveUser checkpoints one user's fetch result. It is safe to call from
+// concurrent workers, since each user has its own file.
+func (s *CheckpointStore) SaveUser(cp *UserCheckpoint) error {
+	return writeJSONFile(s.userPath(cp.Login), cp)
+}
+
+// LoadUsers returns the checkpoints saved so far, keyed by login. Unreadable
+// files are reported as an error rather than silently re-fetched.
+func (s *CheckpointStore) LoadUsers() (map[string]*UserCheckpoint, error) {
+	entries, err := os.ReadDir(filepath.Join(s.dir, usersDir))
+	if err != nil {
+		return nil, fmt.Errorf("list user checkpoints: %w", err)
+	}
+
+	checkpoints := make(map[string]*UserCheckpoint, len(entries))
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+
+		var cp UserCheckpoint
+		if err := readJSONFile(filepath.Join(s.dir, usersDir, entry.Name()), &cp); err != nil {
+			return nil, err
+		}
+
+		checkpoints[cp.Login] = &cp
+	}
+
+	return checkpoints, nil
+}
+
+// Remove deletes the run's state once its snapshot has been committed.
+func (s *CheckpointStore) Remove() error {
+	if err := os.RemoveAll(s.dir); err != nil {
+		return fmt.Errorf("remove run state: %w", err)
+	}
+
+	return nil
+}
+
+// userPath returns the checkpoint file of login. GitHub logins are
+// alphanumeric with hyphens, so they are already safe file names.
+func (s *CheckpointStore) userPath(login string) string {
+	return filepath.Join(s.dir, usersDir, login+".json")
+}
+
+// writeJSONFile atomically replaces path with the JSON encoding of v.
+func writeJSONFile(path string, v any) error {
+	name := filepath.Base(path)
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("encode %s: %w", name, err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), name+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("create temp file for %s: %w", name, err)
+	}
+
+	if err := writeAndClose(tmp, data); err != nil {
+		return errors.Join(fmt.Errorf("write %s: %w", name, err), os.Remove(tmp.Name()))
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return errors.Join(fmt.Errorf("rename %s into place: %w", name, err), os.Remove(tmp.Name()))
+	}
+
+	return nil
+}
+
+// writeAndClose writes data to f with owner-only permissions and closes it.
+func writeAndClose(f *os.File, data []byte) error {
+	_, werr := f.Write(data)
+	if werr == nil {
+		werr = f.Chmod(checkpointFilePerm)
+	}
+
+	return errors.Join(werr, f.Close())
+}
+
+// readJSONFile decodes the JSON file at path into v.
+func readJSONFile(path string, v any) error {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return fmt.Errorf("read %s: %w", filepath.Base(path), err)
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("decode %s: %w", filepath.Base(path), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package infrastructure
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Tattsum/github-analytics/domain"
+)
+
+func TestCheckpointStore_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	stateDir := t.TempDir()
+	startedAt := time.Date(2024, time.March, 15, 9, 30, 0, 0, time.UTC)
+	runID := NewRunID(startedAt)
+
+	if runID != "20240315T093000Z" {
+		t.Fatalf("NewRunID = %q", runID)
+	}
+
+	store, err := NewCheckpointStore(stateDir, runID)
+	if err != nil {
+		t.Fatalf("NewCheckpointStore: %v", err)
+	}
+
+	manifest := &RunManifest{RunID: runID, StartedAt: startedAt, Users: []string{"alice", "bob"}, Full: true}
+	if err := store.CreateRun(manifest); err != nil {
+		t.Fatalf("CreateRun: %v", err)
+	}
+
+	if err := store.CreateRun(manifest); !errors.Is(err, ErrRunExists) {
+		t.Fatalf("second CreateRun error = %v, want ErrRunExists", err)
+	}
+
+	mergedAt := startedAt.Add(-time.Hour)
+	cp := &UserCheckpoint{
+		Login:     "alice",
+		Cutoff:    time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC),
+		FetchedAt: startedAt,
+		Data: &UserActivityData{
+			User: domain.NewUser("alice", "Alice", "2020-01-01T00:00:00Z"),
+			PRs: []*domain.Activity{
+				{Type: domain.ActivityTypePR, Repository: "acme/api", Date: startedAt, IsMerged: true, SourceID: "PR_1"},
+			},
+			PRLifecycles: []*domain.PullRequestLifecycle{
+				{Repository: "acme/api", SourceID: "PR_1", CreatedAt: startedAt.Add(-2 * time.Hour), MergedAt: &mergedAt},
+			},
+		},
+	}
+
+	if err := store.SaveUser(cp); err != nil {
+		t.Fatalf("SaveUser: %v", err)
+	}
+
+	info, err := os.Stat(filepath.Join(store.Dir(), "users", "alice.json"))
+	if err != nil {
+		t.Fatalf("stat checkpoint: %v", err)
+	}
+
+	if perm := info.Mode().Perm(); perm != checkpointFilePerm {
+		t.Errorf("checkpoint permissions = %o, want %o", perm, checkpointFilePerm)
+	}
+
+	resumed, err := NewCheckpointStore(stateDir, runID)
+	if err != nil {
+		t.Fatalf("NewCheckpointStore: %v", err)
+	}
+
+	gotManifest, err := resumed.LoadRun()
+	if err != nil {
+		t.Fatalf("LoadRun: %v", err)
+	}
+
+	if !reflect.DeepEqual(gotManifest, manifest) {
+		t.Errorf("LoadRun = %+v, want %+v", gotManifest, manifest)
+	}
+
+	got, err := resumed.LoadUsers()
+	if err != nil {
+		t.Fatalf("LoadUsers: %v", err)
+	}
+
+	if len(got) != 1 || !reflect.DeepEqual(got["alice"], cp) {
+		t.Errorf("LoadUsers = %+v, want only alice's checkpoint", got)
+	}
+
+	if err := resumed.Remove(); err != nil {
+		t.Fatalf("Remove: %v", err)
+	}
+
+	if _, err := resumed.LoadRun(); !errors.Is(err, ErrRunNotFound) {
+		t.Errorf("LoadRun after Remove error = %v, want ErrRunNotFound", err)
+	}
+}
+
+func TestNewCheckpointStore_RejectsUnsafeRunIDs(t *testing.T) {
+	t.Parallel()
+
+	for _, runID := range []string{"", "..", "../other", "a/b", ".hidden"} {
+		if _, err := NewCheckpointStore(t.TempDir(), runID); !errors.Is(err, ErrInvalidRunID) {
+			t.Errorf("NewCheckpointStore(%q) error = %v, want ErrInvalidRunID", runID, err)
+		}
+	}
+}
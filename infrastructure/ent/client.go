@@ -15,12 +15,22 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/activityevent"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/excludedmember"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberaccount"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberdatagap"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/memberdaystat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberhourstat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberissue"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberpullrequest"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/memberrepodaystat"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/memberrepostat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberreviewrequest"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/memberstat"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/memberyearstat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/repodeliveryweek"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/repometa"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/reviewedge"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/snapshot"
 )
 
@@ -29,18 +39,38 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// ActivityEvent is the client for interacting with the ActivityEvent builders.
+	ActivityEvent *ActivityEventClient
+	// ExcludedMember is the client for interacting with the ExcludedMember builders.
+	ExcludedMember *ExcludedMemberClient
+	// MemberAccount is the client for interacting with the MemberAccount builders.
+	MemberAccount *MemberAccountClient
+	// MemberDataGap is the client for interacting with the MemberDataGap builders.
+	MemberDataGap *MemberDataGapClient
 	// MemberDayStat is the client for interacting with the MemberDayStat builders.
 	MemberDayStat *MemberDayStatClient
+	// MemberHourStat is the client for interacting with the MemberHourStat builders.
+	MemberHourStat *MemberHourStatClient
+	// MemberIssue is the client for interacting with the MemberIssue builders.
+	MemberIssue *MemberIssueClient
+	// MemberPullRequest is the client for interacting with the MemberPullRequest builders.
+	MemberPullRequest *MemberPullRequestClient
 	// MemberRepoDayStat is the client for interacting with the MemberRepoDayStat builders.
 	MemberRepoDayStat *MemberRepoDayStatClient
 	// MemberRepoStat is the client for interacting with the MemberRepoStat builders.
 	MemberRepoStat *MemberRepoStatClient
+	// MemberReviewRequest is the client for interacting with the MemberReviewRequest builders.
+	MemberReviewRequest *MemberReviewRequestClient
 	// MemberStat is the client for interacting with the MemberStat builders.
 	MemberStat *MemberStatClient
 	// MemberYearStat is the client for interacting with the MemberYearStat builders.
 	MemberYearStat *MemberYearStatClient
+	// RepoDeliveryWeek is the client for interacting with the RepoDeliveryWeek builders.
+	RepoDeliveryWeek *RepoDeliveryWeekClient
 	// RepoMeta is the client for interacting with the RepoMeta builders.
 	RepoMeta *RepoMetaClient
+	// ReviewEdge is the client for interacting with the ReviewEdge builders.
+	ReviewEdge *ReviewEdgeClient
 	// Snapshot is the client for interacting with the Snapshot builders.
 	Snapshot *SnapshotClient
 }
@@ -54,12 +84,22 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.ActivityEvent = NewActivityEventClient(c.config)
+	c.ExcludedMember = NewExcludedMemberClient(c.config)
+	c.MemberAccount = NewMemberAccountClient(c.config)
+	c.MemberDataGap = NewMemberDataGapClient(c.config)
 	c.MemberDayStat = NewMemberDayStatClient(c.config)
+	c.MemberHourStat = NewMemberHourStatClient(c.config)
+	c.MemberIssue = NewMemberIssueClient(c.config)
+	c.MemberPullRequest = NewMemberPullRequestClient(c.config)
 	c.MemberRepoDayStat = NewMemberRepoDayStatClient(c.config)
 	c.MemberRepoStat = NewMemberRepoStatClient(c.config)
+	c.MemberReviewRequest = NewMemberReviewRequestClient(c.config)
 	c.MemberStat = NewMemberStatClient(c.config)
 	c.MemberYearStat = NewMemberYearStatClient(c.config)
+	c.RepoDeliveryWeek = NewRepoDeliveryWeekClient(c.config)
 	c.RepoMeta = NewRepoMetaClient(c.config)
+	c.ReviewEdge = NewReviewEdgeClient(c.config)
 	c.Snapshot = NewSnapshotClient(c.config)
 }
 
@@ -151,15 +191,25 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:               ctx,
-		config:            cfg,
-		MemberDayStat:     NewMemberDayStatClient(cfg),
-		MemberRepoDayStat: NewMemberRepoDayStatClient(cfg),
-		MemberRepoStat:    NewMemberRepoStatClient(cfg),
-		MemberStat:        NewMemberStatClient(cfg),
-		MemberYearStat:    NewMemberYearStatClient(cfg),
-		RepoMeta:          NewRepoMetaClient(cfg),
-		Snapshot:          NewSnapshotClient(cfg),
+		ctx:                 ctx,
+		config:              cfg,
+		ActivityEvent:       NewActivityEventClient(cfg),
+		ExcludedMember:      NewExcludedMemberClient(cfg),
+		MemberAccount:       NewMemberAccountClient(cfg),
+		MemberDataGap:       NewMemberDataGapClient(cfg),
+		MemberDayStat:       NewMemberDayStatClient(cfg),
+		MemberHourStat:      NewMemberHourStatClient(cfg),
+		MemberIssue:         NewMemberIssueClient(cfg),
+		MemberPullRequest:   NewMemberPullRequestClient(cfg),
+		MemberRepoDayStat:   NewMemberRepoDayStatClient(cfg),
+		MemberRepoStat:      NewMemberRepoStatClient(cfg),
+		MemberReviewRequest: NewMemberReviewRequestClient(cfg),
+		MemberStat:          NewMemberStatClient(cfg),
+		MemberYearStat:      NewMemberYearStatClient(cfg),
+		RepoDeliveryWeek:    NewRepoDeliveryWeekClient(cfg),
+		RepoMeta:            NewRepoMetaClient(cfg),
+		ReviewEdge:          NewReviewEdgeClient(cfg),
+		Snapshot:            NewSnapshotClient(cfg),
 	}, nil
 }
 
@@ -177,22 +227,32 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:               ctx,
-		config:            cfg,
-		MemberDayStat:     NewMemberDayStatClient(cfg),
-		MemberRepoDayStat: NewMemberRepoDayStatClient(cfg),
-		MemberRepoStat:    NewMemberRepoStatClient(cfg),
-		MemberStat:        NewMemberStatClient(cfg),
-		MemberYearStat:    NewMemberYearStatClient(cfg),
-		RepoMeta:          NewRepoMetaClient(cfg),
-		Snapshot:          NewSnapshotClient(cfg),
+		ctx:                 ctx,
+		config:              cfg,
+		ActivityEvent:       NewActivityEventClient(cfg),
+		ExcludedMember:      NewExcludedMemberClient(cfg),
+		MemberAccount:       NewMemberAccountClient(cfg),
+		MemberDataGap:       NewMemberDataGapClient(cfg),
+		MemberDayStat:       NewMemberDayStatClient(cfg),
+		MemberHourStat:      NewMemberHourStatClient(cfg),
+		MemberIssue:         NewMemberIssueClient(cfg),
+		MemberPullRequest:   NewMemberPullRequestClient(cfg),
+		MemberRepoDayStat:   NewMemberRepoDayStatClient(cfg),
+		MemberRepoStat:      NewMemberRepoStatClient(cfg),
+		MemberReviewRequest: NewMemberReviewRequestClient(cfg),
+		MemberStat:          NewMemberStatClient(cfg),
+		MemberYearStat:      NewMemberYearStatClient(cfg),
+		RepoDeliveryWeek:    NewRepoDeliveryWeekClient(cfg),
+		RepoMeta:            NewRepoMetaClient(cfg),
+		ReviewEdge:          NewReviewEdgeClient(cfg),
+		Snapshot:            NewSnapshotClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		MemberDayStat.
+//		ActivityEvent.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -215,8 +275,10 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.MemberDayStat, c.MemberRepoDayStat, c.MemberRepoStat, c.MemberStat,
-		c.MemberYearStat, c.RepoMeta, c.Snapshot,
+		c.ActivityEvent, c.ExcludedMember, c.MemberAccount, c.MemberDataGap,
+		c.MemberDayStat, c.MemberHourStat, c.MemberIssue, c.MemberPullRequest,
+		c.MemberRepoDayStat, c.MemberRepoStat, c.MemberReviewRequest, c.MemberStat,
+		c.MemberYearStat, c.RepoDeliveryWeek, c.RepoMeta, c.ReviewEdge, c.Snapshot,
 	} {
 		n.Use(hooks...)
 	}
@@ -226,8 +288,10 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.MemberDayStat, c.MemberRepoDayStat, c.MemberRepoStat, c.MemberStat,
-		c.MemberYearStat, c.RepoMeta, c.Snapshot,
+		c.ActivityEvent, c.ExcludedMember, c.MemberAccount, c.MemberDataGap,
+		c.MemberDayStat, c.MemberHourStat, c.MemberIssue, c.MemberPullRequest,
+		c.MemberRepoDayStat, c.MemberRepoStat, c.MemberReviewRequest, c.MemberStat,
+		c.MemberYearStat, c.RepoDeliveryWeek, c.RepoMeta, c.ReviewEdge, c.Snapshot,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -236,18 +300,38 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *ActivityEventMutation:
+		return c.ActivityEvent.mutate(ctx, m)
+	case *ExcludedMemberMutation:
+		return c.ExcludedMember.mutate(ctx, m)
+	case *MemberAccountMutation:
+		return c.MemberAccount.mutate(ctx, m)
+	case *MemberDataGapMutation:
+		return c.MemberDataGap.mutate(ctx, m)
 	case *MemberDayStatMutation:
 		return c.MemberDayStat.mutate(ctx, m)
+	case *MemberHourStatMutation:
+		return c.MemberHourStat.mutate(ctx, m)
+	case *MemberIssueMutation:
+		return c.MemberIssue.mutate(ctx, m)
+	case *MemberPullRequestMutation:
+		return c.MemberPullRequest.mutate(ctx, m)
 	case *MemberRepoDayStatMutation:
 		return c.MemberRepoDayStat.mutate(ctx, m)
 	case *MemberRepoStatMutation:
 		return c.MemberRepoStat.mutate(ctx, m)
+	case *MemberReviewRequestMutation:
+		return c.MemberReviewRequest.mutate(ctx, m)
 	case *MemberStatMutation:
 		return c.MemberStat.mutate(ctx, m)
 	case *MemberYearStatMutation:
 		return c.MemberYearStat.mutate(ctx, m)
+	case *RepoDeliveryWeekMutation:
+		return c.RepoDeliveryWeek.mutate(ctx, m)
 	case *RepoMetaMutation:
 		return c.RepoMeta.mutate(ctx, m)
+	case *ReviewEdgeMutation:
+		return c.ReviewEdge.mutate(ctx, m)
 	case *SnapshotMutation:
 		return c.Snapshot.mutate(ctx, m)
 	default:
@@ -255,107 +339,1134 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// ActivityEventClient is a client for the ActivityEvent schema.
+type ActivityEventClient struct {
+	config
+}
+
+// NewActivityEventClient returns a client for the ActivityEvent from the given config.
+func NewActivityEventClient(c config) *ActivityEventClient {
+	return &ActivityEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `activityevent.Hooks(f(g(h())))`.
+func (c *ActivityEventClient) Use(hooks ...Hook) {
+	c.hooks.ActivityEvent = append(c.hooks.ActivityEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `activityevent.Intercept(f(g(h())))`.
+func (c *ActivityEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ActivityEvent = append(c.inters.ActivityEvent, interceptors...)
+}
+
+// Create returns a builder for creating a ActivityEvent entity.
+func (c *ActivityEventClient) Create() *ActivityEventCreate {
+	mutation := newActivityEventMutation(c.config, OpCreate)
+	return &ActivityEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ActivityEvent entities.
+func (c *ActivityEventClient) CreateBulk(builders ...*ActivityEventCreate) *ActivityEventCreateBulk {
+	return &ActivityEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ActivityEventClient) MapCreateBulk(slice any, setFunc func(*ActivityEventCreate, int)) *ActivityEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ActivityEventCreateBulk{err: fmt.Errorf("calling to ActivityEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ActivityEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ActivityEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ActivityEvent.
+func (c *ActivityEventClient) Update() *ActivityEventUpdate {
+	mutation := newActivityEventMutation(c.config, OpUpdate)
+	return &ActivityEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ActivityEventClient) UpdateOne(_m *ActivityEvent) *ActivityEventUpdateOne {
+	mutation := newActivityEventMutation(c.config, OpUpdateOne, withActivityEvent(_m))
+	return &ActivityEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ActivityEventClient) UpdateOneID(id int) *ActivityEventUpdateOne {
+	mutation := newActivityEventMutation(c.config, OpUpdateOne, withActivityEventID(id))
+	return &ActivityEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ActivityEvent.
+func (c *ActivityEventClient) Delete() *ActivityEventDelete {
+	mutation := newActivityEventMutation(c.config, OpDelete)
+	return &ActivityEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ActivityEventClient) DeleteOne(_m *ActivityEvent) *ActivityEventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ActivityEventClient) DeleteOneID(id int) *ActivityEventDeleteOne {
+	builder := c.Delete().Where(activityevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ActivityEventDeleteOne{builder}
+}
+
+// Query returns a query builder for ActivityEvent.
+func (c *ActivityEventClient) Query() *ActivityEventQuery {
+	return &ActivityEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeActivityEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ActivityEvent entity by its id.
+func (c *ActivityEventClient) Get(ctx context.Context, id int) (*ActivityEvent, error) {
+	return c.Query().Where(activityevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ActivityEventClient) GetX(ctx context.Context, id int) *ActivityEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ActivityEventClient) Hooks() []Hook {
+	return c.hooks.ActivityEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *ActivityEventClient) Interceptors() []Interceptor {
+	return c.inters.ActivityEvent
+}
+
+func (c *ActivityEventClient) mutate(ctx context.Context, m *ActivityEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ActivityEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ActivityEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ActivityEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ActivityEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ActivityEvent mutation op: %q", m.Op())
+	}
+}
+
+// ExcludedMemberClient is a client for the ExcludedMember schema.
+type ExcludedMemberClient struct {
+	config
+}
+
+// NewExcludedMemberClient returns a client for the ExcludedMember from the given config.
+func NewExcludedMemberClient(c config) *ExcludedMemberClient {
+	return &ExcludedMemberClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `excludedmember.Hooks(f(g(h())))`.
+func (c *ExcludedMemberClient) Use(hooks ...Hook) {
+	c.hooks.ExcludedMember = append(c.hooks.ExcludedMember, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `excludedmember.Intercept(f(g(h())))`.
+func (c *ExcludedMemberClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ExcludedMember = append(c.inters.ExcludedMember, interceptors...)
+}
+
+// Create returns a builder for creating a ExcludedMember entity.
+func (c *ExcludedMemberClient) Create() *ExcludedMemberCreate {
+	mutation := newExcludedMemberMutation(c.config, OpCreate)
+	return &ExcludedMemberCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ExcludedMember entities.
+func (c *ExcludedMemberClient) CreateBulk(builders ...*ExcludedMemberCreate) *ExcludedMemberCreateBulk {
+	return &ExcludedMemberCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ExcludedMemberClient) MapCreateBulk(slice any, setFunc func(*ExcludedMemberCreate, int)) *ExcludedMemberCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ExcludedMemberCreateBulk{err: fmt.Errorf("calling to ExcludedMemberClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ExcludedMemberCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ExcludedMemberCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ExcludedMember.
+func (c *ExcludedMemberClient) Update() *ExcludedMemberUpdate {
+	mutation := newExcludedMemberMutation(c.config, OpUpdate)
+	return &ExcludedMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ExcludedMemberClient) UpdateOne(_m *ExcludedMember) *ExcludedMemberUpdateOne {
+	mutation := newExcludedMemberMutation(c.config, OpUpdateOne, withExcludedMember(_m))
+	return &ExcludedMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ExcludedMemberClient) UpdateOneID(id int) *ExcludedMemberUpdateOne {
+	mutation := newExcludedMemberMutation(c.config, OpUpdateOne, withExcludedMemberID(id))
+	return &ExcludedMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ExcludedMember.
+func (c *ExcludedMemberClient) Delete() *ExcludedMemberDelete {
+	mutation := newExcludedMemberMutation(c.config, OpDelete)
+	return &ExcludedMemberDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ExcludedMemberClient) DeleteOne(_m *ExcludedMember) *ExcludedMemberDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ExcludedMemberClient) DeleteOneID(id int) *ExcludedMemberDeleteOne {
+	builder := c.Delete().Where(excludedmember.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ExcludedMemberDeleteOne{builder}
+}
+
+// Query returns a query builder for ExcludedMember.
+func (c *ExcludedMemberClient) Query() *ExcludedMemberQuery {
+	return &ExcludedMemberQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeExcludedMember},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ExcludedMember entity by its id.
+func (c *ExcludedMemberClient) Get(ctx context.Context, id int) (*ExcludedMember, error) {
+	return c.Query().Where(excludedmember.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ExcludedMemberClient) GetX(ctx context.Context, id int) *ExcludedMember {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySnapshot queries the snapshot edge of a ExcludedMember.
+func (c *ExcludedMemberClient) QuerySnapshot(_m *ExcludedMember) *SnapshotQuery {
+	query := (&SnapshotClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(excludedmember.Table, excludedmember.FieldID, id),
+			sqlgraph.To(snapshot.Table, snapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, excludedmember.SnapshotTable, excludedmember.SnapshotColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ExcludedMemberClient) Hooks() []Hook {
+	return c.hooks.ExcludedMember
+}
+
+// Interceptors returns the client interceptors.
+func (c *ExcludedMemberClient) Interceptors() []Interceptor {
+	return c.inters.ExcludedMember
+}
+
+func (c *ExcludedMemberClient) mutate(ctx context.Context, m *ExcludedMemberMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ExcludedMemberCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ExcludedMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ExcludedMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ExcludedMemberDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ExcludedMember mutation op: %q", m.Op())
+	}
+}
+
+// MemberAccountClient is a client for the MemberAccount schema.
+type MemberAccountClient struct {
+	config
+}
+
+// NewMemberAccountClient returns a client for the MemberAccount from the given config.
+func NewMemberAccountClient(c config) *MemberAccountClient {
+	return &MemberAccountClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `memberaccount.Hooks(f(g(h())))`.
+func (c *MemberAccountClient) Use(hooks ...Hook) {
+	c.hooks.MemberAccount = append(c.hooks.MemberAccount, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `memberaccount.Intercept(f(g(h())))`.
+func (c *MemberAccountClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MemberAccount = append(c.inters.MemberAccount, interceptors...)
+}
+
+// Create returns a builder for creating a MemberAccount entity.
+func (c *MemberAccountClient) Create() *MemberAccountCreate {
+	mutation := newMemberAccountMutation(c.config, OpCreate)
+	return &MemberAccountCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MemberAccount entities.
+func (c *MemberAccountClient) CreateBulk(builders ...*MemberAccountCreate) *MemberAccountCreateBulk {
+	return &MemberAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MemberAccountClient) MapCreateBulk(slice any, setFunc func(*MemberAccountCreate, int)) *MemberAccountCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MemberAccountCreateBulk{err: fmt.Errorf("calling to MemberAccountClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MemberAccountCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MemberAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MemberAccount.
+func (c *MemberAccountClient) Update() *MemberAccountUpdate {
+	mutation := newMemberAccountMutation(c.config, OpUpdate)
+	return &MemberAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MemberAccountClient) UpdateOne(_m *MemberAccount) *MemberAccountUpdateOne {
+	mutation := newMemberAccountMutation(c.config, OpUpdateOne, withMemberAccount(_m))
+	return &MemberAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MemberAccountClient) UpdateOneID(id int) *MemberAccountUpdateOne {
+	mutation := newMemberAccountMutation(c.config, OpUpdateOne, withMemberAccountID(id))
+	return &MemberAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MemberAccount.
+func (c *MemberAccountClient) Delete() *MemberAccountDelete {
+	mutation := newMemberAccountMutation(c.config, OpDelete)
+	return &MemberAccountDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MemberAccountClient) DeleteOne(_m *MemberAccount) *MemberAccountDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MemberAccountClient) DeleteOneID(id int) *MemberAccountDeleteOne {
+	builder := c.Delete().Where(memberaccount.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MemberAccountDeleteOne{builder}
+}
+
+// Query returns a query builder for MemberAccount.
+func (c *MemberAccountClient) Query() *MemberAccountQuery {
+	return &MemberAccountQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMemberAccount},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MemberAccount entity by its id.
+func (c *MemberAccountClient) Get(ctx context.Context, id int) (*MemberAccount, error) {
+	return c.Query().Where(memberaccount.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MemberAccountClient) GetX(ctx context.Context, id int) *MemberAccount {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySnapshot queries the snapshot edge of a MemberAccount.
+func (c *MemberAccountClient) QuerySnapshot(_m *MemberAccount) *SnapshotQuery {
+	query := (&SnapshotClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(memberaccount.Table, memberaccount.FieldID, id),
+			sqlgraph.To(snapshot.Table, snapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, memberaccount.SnapshotTable, memberaccount.SnapshotColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MemberAccountClient) Hooks() []Hook {
+	return c.hooks.MemberAccount
+}
+
+// Interceptors returns the client interceptors.
+func (c *MemberAccountClient) Interceptors() []Interceptor {
+	return c.inters.MemberAccount
+}
+
+func (c *MemberAccountClient) mutate(ctx context.Context, m *MemberAccountMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MemberAccountCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MemberAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MemberAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MemberAccountDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MemberAccount mutation op: %q", m.Op())
+	}
+}
+
+// MemberDataGapClient is a client for the MemberDataGap schema.
+type MemberDataGapClient struct {
+	config
+}
+
+// NewMemberDataGapClient returns a client for the MemberDataGap from the given config.
+func NewMemberDataGapClient(c config) *MemberDataGapClient {
+	return &MemberDataGapClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `memberdatagap.Hooks(f(g(h())))`.
+func (c *MemberDataGapClient) Use(hooks ...Hook) {
+	c.hooks.MemberDataGap = append(c.hooks.MemberDataGap, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `memberdatagap.Intercept(f(g(h())))`.
+func (c *MemberDataGapClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MemberDataGap = append(c.inters.MemberDataGap, interceptors...)
+}
+
+// Create returns a builder for creating a MemberDataGap entity.
+func (c *MemberDataGapClient) Create() *MemberDataGapCreate {
+	mutation := newMemberDataGapMutation(c.config, OpCreate)
+	return &MemberDataGapCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MemberDataGap entities.
+func (c *MemberDataGapClient) CreateBulk(builders ...*MemberDataGapCreate) *MemberDataGapCreateBulk {
+	return &MemberDataGapCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MemberDataGapClient) MapCreateBulk(slice any, setFunc func(*MemberDataGapCreate, int)) *MemberDataGapCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MemberDataGapCreateBulk{err: fmt.Errorf("calling to MemberDataGapClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MemberDataGapCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MemberDataGapCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MemberDataGap.
+func (c *MemberDataGapClient) Update() *MemberDataGapUpdate {
+	mutation := newMemberDataGapMutation(c.config, OpUpdate)
+	return &MemberDataGapUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MemberDataGapClient) UpdateOne(_m *MemberDataGap) *MemberDataGapUpdateOne {
+	mutation := newMemberDataGapMutation(c.config, OpUpdateOne, withMemberDataGap(_m))
+	return &MemberDataGapUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MemberDataGapClient) UpdateOneID(id int) *MemberDataGapUpdateOne {
+	mutation := newMemberDataGapMutation(c.config, OpUpdateOne, withMemberDataGapID(id))
+	return &MemberDataGapUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MemberDataGap.
+func (c *MemberDataGapClient) Delete() *MemberDataGapDelete {
+	mutation := newMemberDataGapMutation(c.config, OpDelete)
+	return &MemberDataGapDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MemberDataGapClient) DeleteOne(_m *MemberDataGap) *MemberDataGapDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MemberDataGapClient) DeleteOneID(id int) *MemberDataGapDeleteOne {
+	builder := c.Delete().Where(memberdatagap.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MemberDataGapDeleteOne{builder}
+}
+
+// Query returns a query builder for MemberDataGap.
+func (c *MemberDataGapClient) Query() *MemberDataGapQuery {
+	return &MemberDataGapQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMemberDataGap},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MemberDataGap entity by its id.
+func (c *MemberDataGapClient) Get(ctx context.Context, id int) (*MemberDataGap, error) {
+	return c.Query().Where(memberdatagap.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MemberDataGapClient) GetX(ctx context.Context, id int) *MemberDataGap {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySnapshot queries the snapshot edge of a MemberDataGap.
+func (c *MemberDataGapClient) QuerySnapshot(_m *MemberDataGap) *SnapshotQuery {
+	query := (&SnapshotClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(memberdatagap.Table, memberdatagap.FieldID, id),
+			sqlgraph.To(snapshot.Table, snapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, memberdatagap.SnapshotTable, memberdatagap.SnapshotColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MemberDataGapClient) Hooks() []Hook {
+	return c.hooks.MemberDataGap
+}
+
+// Interceptors returns the client interceptors.
+func (c *MemberDataGapClient) Interceptors() []Interceptor {
+	return c.inters.MemberDataGap
+}
+
+func (c *MemberDataGapClient) mutate(ctx context.Context, m *MemberDataGapMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MemberDataGapCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MemberDataGapUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MemberDataGapUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MemberDataGapDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MemberDataGap mutation op: %q", m.Op())
+	}
+}
+
 // MemberDayStatClient is a client for the MemberDayStat schema.
 type MemberDayStatClient struct {
 	config
 }
 
-// NewMemberDayStatClient returns a client for the MemberDayStat from the given config.
-func NewMemberDayStatClient(c config) *MemberDayStatClient {
-	return &MemberDayStatClient{config: c}
+// NewMemberDayStatClient returns a client for the MemberDayStat from the given config.
+func NewMemberDayStatClient(c config) *MemberDayStatClient {
+	return &MemberDayStatClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `memberdaystat.Hooks(f(g(h())))`.
+func (c *MemberDayStatClient) Use(hooks ...Hook) {
+	c.hooks.MemberDayStat = append(c.hooks.MemberDayStat, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `memberdaystat.Intercept(f(g(h())))`.
+func (c *MemberDayStatClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MemberDayStat = append(c.inters.MemberDayStat, interceptors...)
+}
+
+// Create returns a builder for creating a MemberDayStat entity.
+func (c *MemberDayStatClient) Create() *MemberDayStatCreate {
+	mutation := newMemberDayStatMutation(c.config, OpCreate)
+	return &MemberDayStatCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MemberDayStat entities.
+func (c *MemberDayStatClient) CreateBulk(builders ...*MemberDayStatCreate) *MemberDayStatCreateBulk {
+	return &MemberDayStatCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MemberDayStatClient) MapCreateBulk(slice any, setFunc func(*MemberDayStatCreate, int)) *MemberDayStatCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MemberDayStatCreateBulk{err: fmt.Errorf("calling to MemberDayStatClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MemberDayStatCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MemberDayStatCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MemberDayStat.
+func (c *MemberDayStatClient) Update() *MemberDayStatUpdate {
+	mutation := newMemberDayStatMutation(c.config, OpUpdate)
+	return &MemberDayStatUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MemberDayStatClient) UpdateOne(_m *MemberDayStat) *MemberDayStatUpdateOne {
+	mutation := newMemberDayStatMutation(c.config, OpUpdateOne, withMemberDayStat(_m))
+	return &MemberDayStatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MemberDayStatClient) UpdateOneID(id int) *MemberDayStatUpdateOne {
+	mutation := newMemberDayStatMutation(c.config, OpUpdateOne, withMemberDayStatID(id))
+	return &MemberDayStatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MemberDayStat.
+func (c *MemberDayStatClient) Delete() *MemberDayStatDelete {
+	mutation := newMemberDayStatMutation(c.config, OpDelete)
+	return &MemberDayStatDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MemberDayStatClient) DeleteOne(_m *MemberDayStat) *MemberDayStatDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MemberDayStatClient) DeleteOneID(id int) *MemberDayStatDeleteOne {
+	builder := c.Delete().Where(memberdaystat.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MemberDayStatDeleteOne{builder}
+}
+
+// Query returns a query builder for MemberDayStat.
+func (c *MemberDayStatClient) Query() *MemberDayStatQuery {
+	return &MemberDayStatQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMemberDayStat},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MemberDayStat entity by its id.
+func (c *MemberDayStatClient) Get(ctx context.Context, id int) (*MemberDayStat, error) {
+	return c.Query().Where(memberdaystat.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MemberDayStatClient) GetX(ctx context.Context, id int) *MemberDayStat {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySnapshot queries the snapshot edge of a MemberDayStat.
+func (c *MemberDayStatClient) QuerySnapshot(_m *MemberDayStat) *SnapshotQuery {
+	query := (&SnapshotClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(memberdaystat.Table, memberdaystat.FieldID, id),
+			sqlgraph.To(snapshot.Table, snapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, memberdaystat.SnapshotTable, memberdaystat.SnapshotColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MemberDayStatClient) Hooks() []Hook {
+	return c.hooks.MemberDayStat
+}
+
+// Interceptors returns the client interceptors.
+func (c *MemberDayStatClient) Interceptors() []Interceptor {
+	return c.inters.MemberDayStat
+}
+
+func (c *MemberDayStatClient) mutate(ctx context.Context, m *MemberDayStatMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MemberDayStatCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MemberDayStatUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MemberDayStatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MemberDayStatDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MemberDayStat mutation op: %q", m.Op())
+	}
+}
+
+// MemberHourStatClient is a client for the MemberHourStat schema.
+type MemberHourStatClient struct {
+	config
+}
+
+// NewMemberHourStatClient returns a client for the MemberHourStat from the given config.
+func NewMemberHourStatClient(c config) *MemberHourStatClient {
+	return &MemberHourStatClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `memberdaystat.Hooks(f(g(h())))`.
-func (c *MemberDayStatClient) Use(hooks ...Hook) {
-	c.hooks.MemberDayStat = append(c.hooks.MemberDayStat, hooks...)
+// A call to `Use(f, g, h)` equals to `memberhourstat.Hooks(f(g(h())))`.
+func (c *MemberHourStatClient) Use(hooks ...Hook) {
+	c.hooks.MemberHourStat = append(c.hooks.MemberHourStat, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `memberdaystat.Intercept(f(g(h())))`.
-func (c *MemberDayStatClient) Intercept(interceptors ...Interceptor) {
-	c.inters.MemberDayStat = append(c.inters.MemberDayStat, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `memberhourstat.Intercept(f(g(h())))`.
+func (c *MemberHourStatClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MemberHourStat = append(c.inters.MemberHourStat, interceptors...)
 }
 
-// Create returns a builder for creating a MemberDayStat entity.
-func (c *MemberDayStatClient) Create() *MemberDayStatCreate {
-	mutation := newMemberDayStatMutation(c.config, OpCreate)
-	return &MemberDayStatCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a MemberHourStat entity.
+func (c *MemberHourStatClient) Create() *MemberHourStatCreate {
+	mutation := newMemberHourStatMutation(c.config, OpCreate)
+	return &MemberHourStatCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of MemberDayStat entities.
-func (c *MemberDayStatClient) CreateBulk(builders ...*MemberDayStatCreate) *MemberDayStatCreateBulk {
-	return &MemberDayStatCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of MemberHourStat entities.
+func (c *MemberHourStatClient) CreateBulk(builders ...*MemberHourStatCreate) *MemberHourStatCreateBulk {
+	return &MemberHourStatCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *MemberDayStatClient) MapCreateBulk(slice any, setFunc func(*MemberDayStatCreate, int)) *MemberDayStatCreateBulk {
+func (c *MemberHourStatClient) MapCreateBulk(slice any, setFunc func(*MemberHourStatCreate, int)) *MemberHourStatCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &MemberDayStatCreateBulk{err: fmt.Errorf("calling to MemberDayStatClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &MemberHourStatCreateBulk{err: fmt.Errorf("calling to MemberHourStatClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*MemberDayStatCreate, rv.Len())
+	builders := make([]*MemberHourStatCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &MemberDayStatCreateBulk{config: c.config, builders: builders}
+	return &MemberHourStatCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for MemberDayStat.
-func (c *MemberDayStatClient) Update() *MemberDayStatUpdate {
-	mutation := newMemberDayStatMutation(c.config, OpUpdate)
-	return &MemberDayStatUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for MemberHourStat.
+func (c *MemberHourStatClient) Update() *MemberHourStatUpdate {
+	mutation := newMemberHourStatMutation(c.config, OpUpdate)
+	return &MemberHourStatUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MemberDayStatClient) UpdateOne(_m *MemberDayStat) *MemberDayStatUpdateOne {
-	mutation := newMemberDayStatMutation(c.config, OpUpdateOne, withMemberDayStat(_m))
-	return &MemberDayStatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *MemberHourStatClient) UpdateOne(_m *MemberHourStat) *MemberHourStatUpdateOne {
+	mutation := newMemberHourStatMutation(c.config, OpUpdateOne, withMemberHourStat(_m))
+	return &MemberHourStatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *MemberDayStatClient) UpdateOneID(id int) *MemberDayStatUpdateOne {
-	mutation := newMemberDayStatMutation(c.config, OpUpdateOne, withMemberDayStatID(id))
-	return &MemberDayStatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *MemberHourStatClient) UpdateOneID(id int) *MemberHourStatUpdateOne {
+	mutation := newMemberHourStatMutation(c.config, OpUpdateOne, withMemberHourStatID(id))
+	return &MemberHourStatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for MemberDayStat.
-func (c *MemberDayStatClient) Delete() *MemberDayStatDelete {
-	mutation := newMemberDayStatMutation(c.config, OpDelete)
-	return &MemberDayStatDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for MemberHourStat.
+func (c *MemberHourStatClient) Delete() *MemberHourStatDelete {
+	mutation := newMemberHourStatMutation(c.config, OpDelete)
+	return &MemberHourStatDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *MemberDayStatClient) DeleteOne(_m *MemberDayStat) *MemberDayStatDeleteOne {
+func (c *MemberHourStatClient) DeleteOne(_m *MemberHourStat) *MemberHourStatDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *MemberDayStatClient) DeleteOneID(id int) *MemberDayStatDeleteOne {
-	builder := c.Delete().Where(memberdaystat.ID(id))
+func (c *MemberHourStatClient) DeleteOneID(id int) *MemberHourStatDeleteOne {
+	builder := c.Delete().Where(memberhourstat.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &MemberDayStatDeleteOne{builder}
+	return &MemberHourStatDeleteOne{builder}
 }
 
-// Query returns a query builder for MemberDayStat.
-func (c *MemberDayStatClient) Query() *MemberDayStatQuery {
-	return &MemberDayStatQuery{
+// Query returns a query builder for MemberHourStat.
+func (c *MemberHourStatClient) Query() *MemberHourStatQuery {
+	return &MemberHourStatQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMemberHourStat},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MemberHourStat entity by its id.
+func (c *MemberHourStatClient) Get(ctx context.Context, id int) (*MemberHourStat, error) {
+	return c.Query().Where(memberhourstat.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MemberHourStatClient) GetX(ctx context.Context, id int) *MemberHourStat {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySnapshot queries the snapshot edge of a MemberHourStat.
+func (c *MemberHourStatClient) QuerySnapshot(_m *MemberHourStat) *SnapshotQuery {
+	query := (&SnapshotClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(memberhourstat.Table, memberhourstat.FieldID, id),
+			sqlgraph.To(snapshot.Table, snapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, memberhourstat.SnapshotTable, memberhourstat.SnapshotColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MemberHourStatClient) Hooks() []Hook {
+	return c.hooks.MemberHourStat
+}
+
+// Interceptors returns the client interceptors.
+func (c *MemberHourStatClient) Interceptors() []Interceptor {
+	return c.inters.MemberHourStat
+}
+
+func (c *MemberHourStatClient) mutate(ctx context.Context, m *MemberHourStatMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MemberHourStatCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MemberHourStatUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MemberHourStatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MemberHourStatDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MemberHourStat mutation op: %q", m.Op())
+	}
+}
+
+// MemberIssueClient is a client for the MemberIssue schema.
+type MemberIssueClient struct {
+	config
+}
+
+// NewMemberIssueClient returns a client for the MemberIssue from the given config.
+func NewMemberIssueClient(c config) *MemberIssueClient {
+	return &MemberIssueClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `memberissue.Hooks(f(g(h())))`.
+func (c *MemberIssueClient) Use(hooks ...Hook) {
+	c.hooks.MemberIssue = append(c.hooks.MemberIssue, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `memberissue.Intercept(f(g(h())))`.
+func (c *MemberIssueClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MemberIssue = append(c.inters.MemberIssue, interceptors...)
+}
+
+// Create returns a builder for creating a MemberIssue entity.
+func (c *MemberIssueClient) Create() *MemberIssueCreate {
+	mutation := newMemberIssueMutation(c.config, OpCreate)
+	return &MemberIssueCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MemberIssue entities.
+func (c *MemberIssueClient) CreateBulk(builders ...*MemberIssueCreate) *MemberIssueCreateBulk {
+	return &MemberIssueCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MemberIssueClient) MapCreateBulk(slice any, setFunc func(*MemberIssueCreate, int)) *MemberIssueCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MemberIssueCreateBulk{err: fmt.Errorf("calling to MemberIssueClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MemberIssueCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MemberIssueCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MemberIssue.
+func (c *MemberIssueClient) Update() *MemberIssueUpdate {
+	mutation := newMemberIssueMutation(c.config, OpUpdate)
+	return &MemberIssueUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MemberIssueClient) UpdateOne(_m *MemberIssue) *MemberIssueUpdateOne {
+	mutation := newMemberIssueMutation(c.config, OpUpdateOne, withMemberIssue(_m))
+	return &MemberIssueUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MemberIssueClient) UpdateOneID(id int) *MemberIssueUpdateOne {
+	mutation := newMemberIssueMutation(c.config, OpUpdateOne, withMemberIssueID(id))
+	return &MemberIssueUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MemberIssue.
+func (c *MemberIssueClient) Delete() *MemberIssueDelete {
+	mutation := newMemberIssueMutation(c.config, OpDelete)
+	return &MemberIssueDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MemberIssueClient) DeleteOne(_m *MemberIssue) *MemberIssueDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MemberIssueClient) DeleteOneID(id int) *MemberIssueDeleteOne {
+	builder := c.Delete().Where(memberissue.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MemberIssueDeleteOne{builder}
+}
+
+// Query returns a query builder for MemberIssue.
+func (c *MemberIssueClient) Query() *MemberIssueQuery {
+	return &MemberIssueQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMemberIssue},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MemberIssue entity by its id.
+func (c *MemberIssueClient) Get(ctx context.Context, id int) (*MemberIssue, error) {
+	return c.Query().Where(memberissue.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MemberIssueClient) GetX(ctx context.Context, id int) *MemberIssue {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySnapshot queries the snapshot edge of a MemberIssue.
+func (c *MemberIssueClient) QuerySnapshot(_m *MemberIssue) *SnapshotQuery {
+	query := (&SnapshotClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(memberissue.Table, memberissue.FieldID, id),
+			sqlgraph.To(snapshot.Table, snapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, memberissue.SnapshotTable, memberissue.SnapshotColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MemberIssueClient) Hooks() []Hook {
+	return c.hooks.MemberIssue
+}
+
+// Interceptors returns the client interceptors.
+func (c *MemberIssueClient) Interceptors() []Interceptor {
+	return c.inters.MemberIssue
+}
+
+func (c *MemberIssueClient) mutate(ctx context.Context, m *MemberIssueMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MemberIssueCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MemberIssueUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MemberIssueUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MemberIssueDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MemberIssue mutation op: %q", m.Op())
+	}
+}
+
+// MemberPullRequestClient is a client for the MemberPullRequest schema.
+type MemberPullRequestClient struct {
+	config
+}
+
+// NewMemberPullRequestClient returns a client for the MemberPullRequest from the given config.
+func NewMemberPullRequestClient(c config) *MemberPullRequestClient {
+	return &MemberPullRequestClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `memberpullrequest.Hooks(f(g(h())))`.
+func (c *MemberPullRequestClient) Use(hooks ...Hook) {
+	c.hooks.MemberPullRequest = append(c.hooks.MemberPullRequest, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `memberpullrequest.Intercept(f(g(h())))`.
+func (c *MemberPullRequestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MemberPullRequest = append(c.inters.MemberPullRequest, interceptors...)
+}
+
+// Create returns a builder for creating a MemberPullRequest entity.
+func (c *MemberPullRequestClient) Create() *MemberPullRequestCreate {
+	mutation := newMemberPullRequestMutation(c.config, OpCreate)
+	return &MemberPullRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MemberPullRequest entities.
+func (c *MemberPullRequestClient) CreateBulk(builders ...*MemberPullRequestCreate) *MemberPullRequestCreateBulk {
+	return &MemberPullRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MemberPullRequestClient) MapCreateBulk(slice any, setFunc func(*MemberPullRequestCreate, int)) *MemberPullRequestCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MemberPullRequestCreateBulk{err: fmt.Errorf("calling to MemberPullRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MemberPullRequestCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MemberPullRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MemberPullRequest.
+func (c *MemberPullRequestClient) Update() *MemberPullRequestUpdate {
+	mutation := newMemberPullRequestMutation(c.config, OpUpdate)
+	return &MemberPullRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MemberPullRequestClient) UpdateOne(_m *MemberPullRequest) *MemberPullRequestUpdateOne {
+	mutation := newMemberPullRequestMutation(c.config, OpUpdateOne, withMemberPullRequest(_m))
+	return &MemberPullRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MemberPullRequestClient) UpdateOneID(id int) *MemberPullRequestUpdateOne {
+	mutation := newMemberPullRequestMutation(c.config, OpUpdateOne, withMemberPullRequestID(id))
+	return &MemberPullRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MemberPullRequest.
+func (c *MemberPullRequestClient) Delete() *MemberPullRequestDelete {
+	mutation := newMemberPullRequestMutation(c.config, OpDelete)
+	return &MemberPullRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MemberPullRequestClient) DeleteOne(_m *MemberPullRequest) *MemberPullRequestDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MemberPullRequestClient) DeleteOneID(id int) *MemberPullRequestDeleteOne {
+	builder := c.Delete().Where(memberpullrequest.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MemberPullRequestDeleteOne{builder}
+}
+
+// Query returns a query builder for MemberPullRequest.
+func (c *MemberPullRequestClient) Query() *MemberPullRequestQuery {
+	return &MemberPullRequestQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeMemberDayStat},
+		ctx:    &QueryContext{Type: TypeMemberPullRequest},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a MemberDayStat entity by its id.
-func (c *MemberDayStatClient) Get(ctx context.Context, id int) (*MemberDayStat, error) {
-	return c.Query().Where(memberdaystat.ID(id)).Only(ctx)
+// Get returns a MemberPullRequest entity by its id.
+func (c *MemberPullRequestClient) Get(ctx context.Context, id int) (*MemberPullRequest, error) {
+	return c.Query().Where(memberpullrequest.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *MemberDayStatClient) GetX(ctx context.Context, id int) *MemberDayStat {
+func (c *MemberPullRequestClient) GetX(ctx context.Context, id int) *MemberPullRequest {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -363,15 +1474,15 @@ func (c *MemberDayStatClient) GetX(ctx context.Context, id int) *MemberDayStat {
 	return obj
 }
 
-// QuerySnapshot queries the snapshot edge of a MemberDayStat.
-func (c *MemberDayStatClient) QuerySnapshot(_m *MemberDayStat) *SnapshotQuery {
+// QuerySnapshot queries the snapshot edge of a MemberPullRequest.
+func (c *MemberPullRequestClient) QuerySnapshot(_m *MemberPullRequest) *SnapshotQuery {
 	query := (&SnapshotClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(memberdaystat.Table, memberdaystat.FieldID, id),
+			sqlgraph.From(memberpullrequest.Table, memberpullrequest.FieldID, id),
 			sqlgraph.To(snapshot.Table, snapshot.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, memberdaystat.SnapshotTable, memberdaystat.SnapshotColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, memberpullrequest.SnapshotTable, memberpullrequest.SnapshotColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -380,27 +1491,27 @@ func (c *MemberDayStatClient) QuerySnapshot(_m *MemberDayStat) *SnapshotQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *MemberDayStatClient) Hooks() []Hook {
-	return c.hooks.MemberDayStat
+func (c *MemberPullRequestClient) Hooks() []Hook {
+	return c.hooks.MemberPullRequest
 }
 
 // Interceptors returns the client interceptors.
-func (c *MemberDayStatClient) Interceptors() []Interceptor {
-	return c.inters.MemberDayStat
+func (c *MemberPullRequestClient) Interceptors() []Interceptor {
+	return c.inters.MemberPullRequest
 }
 
-func (c *MemberDayStatClient) mutate(ctx context.Context, m *MemberDayStatMutation) (Value, error) {
+func (c *MemberPullRequestClient) mutate(ctx context.Context, m *MemberPullRequestMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&MemberDayStatCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MemberPullRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&MemberDayStatUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MemberPullRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&MemberDayStatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MemberPullRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&MemberDayStatDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&MemberPullRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown MemberDayStat mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown MemberPullRequest mutation op: %q", m.Op())
 	}
 }
 
@@ -702,6 +1813,155 @@ func (c *MemberRepoStatClient) mutate(ctx context.Context, m *MemberRepoStatMuta
 	}
 }
 
+// MemberReviewRequestClient is a client for the MemberReviewRequest schema.
+type MemberReviewRequestClient struct {
+	config
+}
+
+// NewMemberReviewRequestClient returns a client for the MemberReviewRequest from the given config.
+func NewMemberReviewRequestClient(c config) *MemberReviewRequestClient {
+	return &MemberReviewRequestClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `memberreviewrequest.Hooks(f(g(h())))`.
+func (c *MemberReviewRequestClient) Use(hooks ...Hook) {
+	c.hooks.MemberReviewRequest = append(c.hooks.MemberReviewRequest, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `memberreviewrequest.Intercept(f(g(h())))`.
+func (c *MemberReviewRequestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MemberReviewRequest = append(c.inters.MemberReviewRequest, interceptors...)
+}
+
+// Create returns a builder for creating a MemberReviewRequest entity.
+func (c *MemberReviewRequestClient) Create() *MemberReviewRequestCreate {
+	mutation := newMemberReviewRequestMutation(c.config, OpCreate)
+	return &MemberReviewRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MemberReviewRequest entities.
+func (c *MemberReviewRequestClient) CreateBulk(builders ...*MemberReviewRequestCreate) *MemberReviewRequestCreateBulk {
+	return &MemberReviewRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MemberReviewRequestClient) MapCreateBulk(slice any, setFunc func(*MemberReviewRequestCreate, int)) *MemberReviewRequestCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MemberReviewRequestCreateBulk{err: fmt.Errorf("calling to MemberReviewRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MemberReviewRequestCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MemberReviewRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MemberReviewRequest.
+func (c *MemberReviewRequestClient) Update() *MemberReviewRequestUpdate {
+	mutation := newMemberReviewRequestMutation(c.config, OpUpdate)
+	return &MemberReviewRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MemberReviewRequestClient) UpdateOne(_m *MemberReviewRequest) *MemberReviewRequestUpdateOne {
+	mutation := newMemberReviewRequestMutation(c.config, OpUpdateOne, withMemberReviewRequest(_m))
+	return &MemberReviewRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MemberReviewRequestClient) UpdateOneID(id int) *MemberReviewRequestUpdateOne {
+	mutation := newMemberReviewRequestMutation(c.config, OpUpdateOne, withMemberReviewRequestID(id))
+	return &MemberReviewRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MemberReviewRequest.
+func (c *MemberReviewRequestClient) Delete() *MemberReviewRequestDelete {
+	mutation := newMemberReviewRequestMutation(c.config, OpDelete)
+	return &MemberReviewRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MemberReviewRequestClient) DeleteOne(_m *MemberReviewRequest) *MemberReviewRequestDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MemberReviewRequestClient) DeleteOneID(id int) *MemberReviewRequestDeleteOne {
+	builder := c.Delete().Where(memberreviewrequest.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MemberReviewRequestDeleteOne{builder}
+}
+
+// Query returns a query builder for MemberReviewRequest.
+func (c *MemberReviewRequestClient) Query() *MemberReviewRequestQuery {
+	return &MemberReviewRequestQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMemberReviewRequest},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MemberReviewRequest entity by its id.
+func (c *MemberReviewRequestClient) Get(ctx context.Context, id int) (*MemberReviewRequest, error) {
+	return c.Query().Where(memberreviewrequest.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MemberReviewRequestClient) GetX(ctx context.Context, id int) *MemberReviewRequest {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySnapshot queries the snapshot edge of a MemberReviewRequest.
+func (c *MemberReviewRequestClient) QuerySnapshot(_m *MemberReviewRequest) *SnapshotQuery {
+	query := (&SnapshotClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(memberreviewrequest.Table, memberreviewrequest.FieldID, id),
+			sqlgraph.To(snapshot.Table, snapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, memberreviewrequest.SnapshotTable, memberreviewrequest.SnapshotColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MemberReviewRequestClient) Hooks() []Hook {
+	return c.hooks.MemberReviewRequest
+}
+
+// Interceptors returns the client interceptors.
+func (c *MemberReviewRequestClient) Interceptors() []Interceptor {
+	return c.inters.MemberReviewRequest
+}
+
+func (c *MemberReviewRequestClient) mutate(ctx context.Context, m *MemberReviewRequestMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MemberReviewRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MemberReviewRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MemberReviewRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MemberReviewRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MemberReviewRequest mutation op: %q", m.Op())
+	}
+}
+
 // MemberStatClient is a client for the MemberStat schema.
 type MemberStatClient struct {
 	config
@@ -841,117 +2101,266 @@ func (c *MemberStatClient) mutate(ctx context.Context, m *MemberStatMutation) (V
 	case OpCreate:
 		return (&MemberStatCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&MemberStatUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MemberStatUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MemberStatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MemberStatDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MemberStat mutation op: %q", m.Op())
+	}
+}
+
+// MemberYearStatClient is a client for the MemberYearStat schema.
+type MemberYearStatClient struct {
+	config
+}
+
+// NewMemberYearStatClient returns a client for the MemberYearStat from the given config.
+func NewMemberYearStatClient(c config) *MemberYearStatClient {
+	return &MemberYearStatClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `memberyearstat.Hooks(f(g(h())))`.
+func (c *MemberYearStatClient) Use(hooks ...Hook) {
+	c.hooks.MemberYearStat = append(c.hooks.MemberYearStat, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `memberyearstat.Intercept(f(g(h())))`.
+func (c *MemberYearStatClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MemberYearStat = append(c.inters.MemberYearStat, interceptors...)
+}
+
+// Create returns a builder for creating a MemberYearStat entity.
+func (c *MemberYearStatClient) Create() *MemberYearStatCreate {
+	mutation := newMemberYearStatMutation(c.config, OpCreate)
+	return &MemberYearStatCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MemberYearStat entities.
+func (c *MemberYearStatClient) CreateBulk(builders ...*MemberYearStatCreate) *MemberYearStatCreateBulk {
+	return &MemberYearStatCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MemberYearStatClient) MapCreateBulk(slice any, setFunc func(*MemberYearStatCreate, int)) *MemberYearStatCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MemberYearStatCreateBulk{err: fmt.Errorf("calling to MemberYearStatClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MemberYearStatCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MemberYearStatCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MemberYearStat.
+func (c *MemberYearStatClient) Update() *MemberYearStatUpdate {
+	mutation := newMemberYearStatMutation(c.config, OpUpdate)
+	return &MemberYearStatUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MemberYearStatClient) UpdateOne(_m *MemberYearStat) *MemberYearStatUpdateOne {
+	mutation := newMemberYearStatMutation(c.config, OpUpdateOne, withMemberYearStat(_m))
+	return &MemberYearStatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MemberYearStatClient) UpdateOneID(id int) *MemberYearStatUpdateOne {
+	mutation := newMemberYearStatMutation(c.config, OpUpdateOne, withMemberYearStatID(id))
+	return &MemberYearStatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MemberYearStat.
+func (c *MemberYearStatClient) Delete() *MemberYearStatDelete {
+	mutation := newMemberYearStatMutation(c.config, OpDelete)
+	return &MemberYearStatDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MemberYearStatClient) DeleteOne(_m *MemberYearStat) *MemberYearStatDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MemberYearStatClient) DeleteOneID(id int) *MemberYearStatDeleteOne {
+	builder := c.Delete().Where(memberyearstat.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MemberYearStatDeleteOne{builder}
+}
+
+// Query returns a query builder for MemberYearStat.
+func (c *MemberYearStatClient) Query() *MemberYearStatQuery {
+	return &MemberYearStatQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMemberYearStat},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MemberYearStat entity by its id.
+func (c *MemberYearStatClient) Get(ctx context.Context, id int) (*MemberYearStat, error) {
+	return c.Query().Where(memberyearstat.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MemberYearStatClient) GetX(ctx context.Context, id int) *MemberYearStat {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySnapshot queries the snapshot edge of a MemberYearStat.
+func (c *MemberYearStatClient) QuerySnapshot(_m *MemberYearStat) *SnapshotQuery {
+	query := (&SnapshotClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(memberyearstat.Table, memberyearstat.FieldID, id),
+			sqlgraph.To(snapshot.Table, snapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, memberyearstat.SnapshotTable, memberyearstat.SnapshotColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MemberYearStatClient) Hooks() []Hook {
+	return c.hooks.MemberYearStat
+}
+
+// Interceptors returns the client interceptors.
+func (c *MemberYearStatClient) Interceptors() []Interceptor {
+	return c.inters.MemberYearStat
+}
+
+func (c *MemberYearStatClient) mutate(ctx context.Context, m *MemberYearStatMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MemberYearStatCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MemberYearStatUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&MemberStatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MemberYearStatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&MemberStatDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&MemberYearStatDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown MemberStat mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown MemberYearStat mutation op: %q", m.Op())
 	}
 }
 
-// MemberYearStatClient is a client for the MemberYearStat schema.
-type MemberYearStatClient struct {
+// RepoDeliveryWeekClient is a client for the RepoDeliveryWeek schema.
+type RepoDeliveryWeekClient struct {
 	config
 }
 
-// NewMemberYearStatClient returns a client for the MemberYearStat from the given config.
-func NewMemberYearStatClient(c config) *MemberYearStatClient {
-	return &MemberYearStatClient{config: c}
+// NewRepoDeliveryWeekClient returns a client for the RepoDeliveryWeek from the given config.
+func NewRepoDeliveryWeekClient(c config) *RepoDeliveryWeekClient {
+	return &RepoDeliveryWeekClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `memberyearstat.Hooks(f(g(h())))`.
-func (c *MemberYearStatClient) Use(hooks ...Hook) {
-	c.hooks.MemberYearStat = append(c.hooks.MemberYearStat, hooks...)
+// A call to `Use(f, g, h)` equals to `repodeliveryweek.Hooks(f(g(h())))`.
+func (c *RepoDeliveryWeekClient) Use(hooks ...Hook) {
+	c.hooks.RepoDeliveryWeek = append(c.hooks.RepoDeliveryWeek, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `memberyearstat.Intercept(f(g(h())))`.
-func (c *MemberYearStatClient) Intercept(interceptors ...Interceptor) {
-	c.inters.MemberYearStat = append(c.inters.MemberYearStat, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `repodeliveryweek.Intercept(f(g(h())))`.
+func (c *RepoDeliveryWeekClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RepoDeliveryWeek = append(c.inters.RepoDeliveryWeek, interceptors...)
 }
 
-// Create returns a builder for creating a MemberYearStat entity.
-func (c *MemberYearStatClient) Create() *MemberYearStatCreate {
-	mutation := newMemberYearStatMutation(c.config, OpCreate)
-	return &MemberYearStatCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a RepoDeliveryWeek entity.
+func (c *RepoDeliveryWeekClient) Create() *RepoDeliveryWeekCreate {
+	mutation := newRepoDeliveryWeekMutation(c.config, OpCreate)
+	return &RepoDeliveryWeekCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of MemberYearStat entities.
-func (c *MemberYearStatClient) CreateBulk(builders ...*MemberYearStatCreate) *MemberYearStatCreateBulk {
-	return &MemberYearStatCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of RepoDeliveryWeek entities.
+func (c *RepoDeliveryWeekClient) CreateBulk(builders ...*RepoDeliveryWeekCreate) *RepoDeliveryWeekCreateBulk {
+	return &RepoDeliveryWeekCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *MemberYearStatClient) MapCreateBulk(slice any, setFunc func(*MemberYearStatCreate, int)) *MemberYearStatCreateBulk {
+func (c *RepoDeliveryWeekClient) MapCreateBulk(slice any, setFunc func(*RepoDeliveryWeekCreate, int)) *RepoDeliveryWeekCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &MemberYearStatCreateBulk{err: fmt.Errorf("calling to MemberYearStatClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &RepoDeliveryWeekCreateBulk{err: fmt.Errorf("calling to RepoDeliveryWeekClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*MemberYearStatCreate, rv.Len())
+	builders := make([]*RepoDeliveryWeekCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &MemberYearStatCreateBulk{config: c.config, builders: builders}
+	return &RepoDeliveryWeekCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for MemberYearStat.
-func (c *MemberYearStatClient) Update() *MemberYearStatUpdate {
-	mutation := newMemberYearStatMutation(c.config, OpUpdate)
-	return &MemberYearStatUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for RepoDeliveryWeek.
+func (c *RepoDeliveryWeekClient) Update() *RepoDeliveryWeekUpdate {
+	mutation := newRepoDeliveryWeekMutation(c.config, OpUpdate)
+	return &RepoDeliveryWeekUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MemberYearStatClient) UpdateOne(_m *MemberYearStat) *MemberYearStatUpdateOne {
-	mutation := newMemberYearStatMutation(c.config, OpUpdateOne, withMemberYearStat(_m))
-	return &MemberYearStatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *RepoDeliveryWeekClient) UpdateOne(_m *RepoDeliveryWeek) *RepoDeliveryWeekUpdateOne {
+	mutation := newRepoDeliveryWeekMutation(c.config, OpUpdateOne, withRepoDeliveryWeek(_m))
+	return &RepoDeliveryWeekUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *MemberYearStatClient) UpdateOneID(id int) *MemberYearStatUpdateOne {
-	mutation := newMemberYearStatMutation(c.config, OpUpdateOne, withMemberYearStatID(id))
-	return &MemberYearStatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *RepoDeliveryWeekClient) UpdateOneID(id int) *RepoDeliveryWeekUpdateOne {
+	mutation := newRepoDeliveryWeekMutation(c.config, OpUpdateOne, withRepoDeliveryWeekID(id))
+	return &RepoDeliveryWeekUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for MemberYearStat.
-func (c *MemberYearStatClient) Delete() *MemberYearStatDelete {
-	mutation := newMemberYearStatMutation(c.config, OpDelete)
-	return &MemberYearStatDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for RepoDeliveryWeek.
+func (c *RepoDeliveryWeekClient) Delete() *RepoDeliveryWeekDelete {
+	mutation := newRepoDeliveryWeekMutation(c.config, OpDelete)
+	return &RepoDeliveryWeekDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *MemberYearStatClient) DeleteOne(_m *MemberYearStat) *MemberYearStatDeleteOne {
+func (c *RepoDeliveryWeekClient) DeleteOne(_m *RepoDeliveryWeek) *RepoDeliveryWeekDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *MemberYearStatClient) DeleteOneID(id int) *MemberYearStatDeleteOne {
-	builder := c.Delete().Where(memberyearstat.ID(id))
+func (c *RepoDeliveryWeekClient) DeleteOneID(id int) *RepoDeliveryWeekDeleteOne {
+	builder := c.Delete().Where(repodeliveryweek.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &MemberYearStatDeleteOne{builder}
+	return &RepoDeliveryWeekDeleteOne{builder}
 }
 
-// Query returns a query builder for MemberYearStat.
-func (c *MemberYearStatClient) Query() *MemberYearStatQuery {
-	return &MemberYearStatQuery{
+// Query returns a query builder for RepoDeliveryWeek.
+func (c *RepoDeliveryWeekClient) Query() *RepoDeliveryWeekQuery {
+	return &RepoDeliveryWeekQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeMemberYearStat},
+		ctx:    &QueryContext{Type: TypeRepoDeliveryWeek},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a MemberYearStat entity by its id.
-func (c *MemberYearStatClient) Get(ctx context.Context, id int) (*MemberYearStat, error) {
-	return c.Query().Where(memberyearstat.ID(id)).Only(ctx)
+// Get returns a RepoDeliveryWeek entity by its id.
+func (c *RepoDeliveryWeekClient) Get(ctx context.Context, id int) (*RepoDeliveryWeek, error) {
+	return c.Query().Where(repodeliveryweek.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *MemberYearStatClient) GetX(ctx context.Context, id int) *MemberYearStat {
+func (c *RepoDeliveryWeekClient) GetX(ctx context.Context, id int) *RepoDeliveryWeek {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -959,15 +2368,15 @@ func (c *MemberYearStatClient) GetX(ctx context.Context, id int) *MemberYearStat
 	return obj
 }
 
-// QuerySnapshot queries the snapshot edge of a MemberYearStat.
-func (c *MemberYearStatClient) QuerySnapshot(_m *MemberYearStat) *SnapshotQuery {
+// QuerySnapshot queries the snapshot edge of a RepoDeliveryWeek.
+func (c *RepoDeliveryWeekClient) QuerySnapshot(_m *RepoDeliveryWeek) *SnapshotQuery {
 	query := (&SnapshotClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(memberyearstat.Table, memberyearstat.FieldID, id),
+			sqlgraph.From(repodeliveryweek.Table, repodeliveryweek.FieldID, id),
 			sqlgraph.To(snapshot.Table, snapshot.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, memberyearstat.SnapshotTable, memberyearstat.SnapshotColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, repodeliveryweek.SnapshotTable, repodeliveryweek.SnapshotColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -976,27 +2385,27 @@ func (c *MemberYearStatClient) QuerySnapshot(_m *MemberYearStat) *SnapshotQuery
 }
 
 // Hooks returns the client hooks.
-func (c *MemberYearStatClient) Hooks() []Hook {
-	return c.hooks.MemberYearStat
+func (c *RepoDeliveryWeekClient) Hooks() []Hook {
+	return c.hooks.RepoDeliveryWeek
 }
 
 // Interceptors returns the client interceptors.
-func (c *MemberYearStatClient) Interceptors() []Interceptor {
-	return c.inters.MemberYearStat
+func (c *RepoDeliveryWeekClient) Interceptors() []Interceptor {
+	return c.inters.RepoDeliveryWeek
 }
 
-func (c *MemberYearStatClient) mutate(ctx context.Context, m *MemberYearStatMutation) (Value, error) {
+func (c *RepoDeliveryWeekClient) mutate(ctx context.Context, m *RepoDeliveryWeekMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&MemberYearStatCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RepoDeliveryWeekCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&MemberYearStatUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RepoDeliveryWeekUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&MemberYearStatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RepoDeliveryWeekUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&MemberYearStatDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&RepoDeliveryWeekDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown MemberYearStat mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown RepoDeliveryWeek mutation op: %q", m.Op())
 	}
 }
 
@@ -1149,6 +2558,155 @@ func (c *RepoMetaClient) mutate(ctx context.Context, m *RepoMetaMutation) (Value
 	}
 }
 
+// ReviewEdgeClient is a client for the ReviewEdge schema.
+type ReviewEdgeClient struct {
+	config
+}
+
+// NewReviewEdgeClient returns a client for the ReviewEdge from the given config.
+func NewReviewEdgeClient(c config) *ReviewEdgeClient {
+	return &ReviewEdgeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `reviewedge.Hooks(f(g(h())))`.
+func (c *ReviewEdgeClient) Use(hooks ...Hook) {
+	c.hooks.ReviewEdge = append(c.hooks.ReviewEdge, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `reviewedge.Intercept(f(g(h())))`.
+func (c *ReviewEdgeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ReviewEdge = append(c.inters.ReviewEdge, interceptors...)
+}
+
+// Create returns a builder for creating a ReviewEdge entity.
+func (c *ReviewEdgeClient) Create() *ReviewEdgeCreate {
+	mutation := newReviewEdgeMutation(c.config, OpCreate)
+	return &ReviewEdgeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ReviewEdge entities.
+func (c *ReviewEdgeClient) CreateBulk(builders ...*ReviewEdgeCreate) *ReviewEdgeCreateBulk {
+	return &ReviewEdgeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReviewEdgeClient) MapCreateBulk(slice any, setFunc func(*ReviewEdgeCreate, int)) *ReviewEdgeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReviewEdgeCreateBulk{err: fmt.Errorf("calling to ReviewEdgeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReviewEdgeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReviewEdgeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ReviewEdge.
+func (c *ReviewEdgeClient) Update() *ReviewEdgeUpdate {
+	mutation := newReviewEdgeMutation(c.config, OpUpdate)
+	return &ReviewEdgeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReviewEdgeClient) UpdateOne(_m *ReviewEdge) *ReviewEdgeUpdateOne {
+	mutation := newReviewEdgeMutation(c.config, OpUpdateOne, withReviewEdge(_m))
+	return &ReviewEdgeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReviewEdgeClient) UpdateOneID(id int) *ReviewEdgeUpdateOne {
+	mutation := newReviewEdgeMutation(c.config, OpUpdateOne, withReviewEdgeID(id))
+	return &ReviewEdgeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ReviewEdge.
+func (c *ReviewEdgeClient) Delete() *ReviewEdgeDelete {
+	mutation := newReviewEdgeMutation(c.config, OpDelete)
+	return &ReviewEdgeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReviewEdgeClient) DeleteOne(_m *ReviewEdge) *ReviewEdgeDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReviewEdgeClient) DeleteOneID(id int) *ReviewEdgeDeleteOne {
+	builder := c.Delete().Where(reviewedge.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReviewEdgeDeleteOne{builder}
+}
+
+// Query returns a query builder for ReviewEdge.
+func (c *ReviewEdgeClient) Query() *ReviewEdgeQuery {
+	return &ReviewEdgeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReviewEdge},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ReviewEdge entity by its id.
+func (c *ReviewEdgeClient) Get(ctx context.Context, id int) (*ReviewEdge, error) {
+	return c.Query().Where(reviewedge.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReviewEdgeClient) GetX(ctx context.Context, id int) *ReviewEdge {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySnapshot queries the snapshot edge of a ReviewEdge.
+func (c *ReviewEdgeClient) QuerySnapshot(_m *ReviewEdge) *SnapshotQuery {
+	query := (&SnapshotClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reviewedge.Table, reviewedge.FieldID, id),
+			sqlgraph.To(snapshot.Table, snapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, reviewedge.SnapshotTable, reviewedge.SnapshotColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ReviewEdgeClient) Hooks() []Hook {
+	return c.hooks.ReviewEdge
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReviewEdgeClient) Interceptors() []Interceptor {
+	return c.inters.ReviewEdge
+}
+
+func (c *ReviewEdgeClient) mutate(ctx context.Context, m *ReviewEdgeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReviewEdgeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReviewEdgeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReviewEdgeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReviewEdgeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ReviewEdge mutation op: %q", m.Op())
+	}
+}
+
 // SnapshotClient is a client for the Snapshot schema.
 type SnapshotClient struct {
 	config
@@ -1353,6 +2911,150 @@ func (c *SnapshotClient) QueryRepoMetas(_m *Snapshot) *RepoMetaQuery {
 	return query
 }
 
+// QueryMemberPullRequests queries the member_pull_requests edge of a Snapshot.
+func (c *SnapshotClient) QueryMemberPullRequests(_m *Snapshot) *MemberPullRequestQuery {
+	query := (&MemberPullRequestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(snapshot.Table, snapshot.FieldID, id),
+			sqlgraph.To(memberpullrequest.Table, memberpullrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, snapshot.MemberPullRequestsTable, snapshot.MemberPullRequestsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReviewEdges queries the review_edges edge of a Snapshot.
+func (c *SnapshotClient) QueryReviewEdges(_m *Snapshot) *ReviewEdgeQuery {
+	query := (&ReviewEdgeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(snapshot.Table, snapshot.FieldID, id),
+			sqlgraph.To(reviewedge.Table, reviewedge.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, snapshot.ReviewEdgesTable, snapshot.ReviewEdgesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMemberDataGaps queries the member_data_gaps edge of a Snapshot.
+func (c *SnapshotClient) QueryMemberDataGaps(_m *Snapshot) *MemberDataGapQuery {
+	query := (&MemberDataGapClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(snapshot.Table, snapshot.FieldID, id),
+			sqlgraph.To(memberdatagap.Table, memberdatagap.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, snapshot.MemberDataGapsTable, snapshot.MemberDataGapsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryExcludedMembers queries the excluded_members edge of a Snapshot.
+func (c *SnapshotClient) QueryExcludedMembers(_m *Snapshot) *ExcludedMemberQuery {
+	query := (&ExcludedMemberClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(snapshot.Table, snapshot.FieldID, id),
+			sqlgraph.To(excludedmember.Table, excludedmember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, snapshot.ExcludedMembersTable, snapshot.ExcludedMembersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMemberAccounts queries the member_accounts edge of a Snapshot.
+func (c *SnapshotClient) QueryMemberAccounts(_m *Snapshot) *MemberAccountQuery {
+	query := (&MemberAccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(snapshot.Table, snapshot.FieldID, id),
+			sqlgraph.To(memberaccount.Table, memberaccount.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, snapshot.MemberAccountsTable, snapshot.MemberAccountsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRepoDeliveryWeeks queries the repo_delivery_weeks edge of a Snapshot.
+func (c *SnapshotClient) QueryRepoDeliveryWeeks(_m *Snapshot) *RepoDeliveryWeekQuery {
+	query := (&RepoDeliveryWeekClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(snapshot.Table, snapshot.FieldID, id),
+			sqlgraph.To(repodeliveryweek.Table, repodeliveryweek.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, snapshot.RepoDeliveryWeeksTable, snapshot.RepoDeliveryWeeksColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMemberIssues queries the member_issues edge of a Snapshot.
+func (c *SnapshotClient) QueryMemberIssues(_m *Snapshot) *MemberIssueQuery {
+	query := (&MemberIssueClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(snapshot.Table, snapshot.FieldID, id),
+			sqlgraph.To(memberissue.Table, memberissue.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, snapshot.MemberIssuesTable, snapshot.MemberIssuesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMemberReviewRequests queries the member_review_requests edge of a Snapshot.
+func (c *SnapshotClient) QueryMemberReviewRequests(_m *Snapshot) *MemberReviewRequestQuery {
+	query := (&MemberReviewRequestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(snapshot.Table, snapshot.FieldID, id),
+			sqlgraph.To(memberreviewrequest.Table, memberreviewrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, snapshot.MemberReviewRequestsTable, snapshot.MemberReviewRequestsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMemberHourStats queries the member_hour_stats edge of a Snapshot.
+func (c *SnapshotClient) QueryMemberHourStats(_m *Snapshot) *MemberHourStatQuery {
+	query := (&MemberHourStatClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(snapshot.Table, snapshot.FieldID, id),
+			sqlgraph.To(memberhourstat.Table, memberhourstat.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, snapshot.MemberHourStatsTable, snapshot.MemberHourStatsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *SnapshotClient) Hooks() []Hook {
 	return c.hooks.Snapshot
@@ -1381,11 +3083,15 @@ func (c *SnapshotClient) mutate(ctx context.Context, m *SnapshotMutation) (Value
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		MemberDayStat, MemberRepoDayStat, MemberRepoStat, MemberStat, MemberYearStat,
-		RepoMeta, Snapshot []ent.Hook
+		ActivityEvent, ExcludedMember, MemberAccount, MemberDataGap, MemberDayStat,
+		MemberHourStat, MemberIssue, MemberPullRequest, MemberRepoDayStat,
+		MemberRepoStat, MemberReviewRequest, MemberStat, MemberYearStat,
+		RepoDeliveryWeek, RepoMeta, ReviewEdge, Snapshot []ent.Hook
 	}
 	inters struct {
-		MemberDayStat, MemberRepoDayStat, MemberRepoStat, MemberStat, MemberYearStat,
-		RepoMeta, Snapshot []ent.Interceptor
+		ActivityEvent, ExcludedMember, MemberAccount, MemberDataGap, MemberDayStat,
+		MemberHourStat, MemberIssue, MemberPullRequest, MemberRepoDayStat,
+		MemberRepoStat, MemberReviewRequest, MemberStat, MemberYearStat,
+		RepoDeliveryWeek, RepoMeta, ReviewEdge, Snapshot []ent.Interceptor
 	}
 )
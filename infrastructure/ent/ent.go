@@ -12,12 +12,22 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/activityevent"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/excludedmember"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberaccount"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberdatagap"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/memberdaystat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberhourstat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberissue"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberpullrequest"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/memberrepodaystat"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/memberrepostat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberreviewrequest"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/memberstat"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/memberyearstat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/repodeliveryweek"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/repometa"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/reviewedge"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/snapshot"
 )
 
@@ -79,13 +89,23 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			memberdaystat.Table:     memberdaystat.ValidColumn,
-			memberrepodaystat.Table: memberrepodaystat.ValidColumn,
-			memberrepostat.Table:    memberrepostat.ValidColumn,
-			memberstat.Table:        memberstat.ValidColumn,
-			memberyearstat.Table:    memberyearstat.ValidColumn,
-			repometa.Table:          repometa.ValidColumn,
-			snapshot.Table:          snapshot.ValidColumn,
+			activityevent.Table:       activityevent.ValidColumn,
+			excludedmember.Table:      excludedmember.ValidColumn,
+			memberaccount.Table:       memberaccount.ValidColumn,
+			memberdatagap.Table:       memberdatagap.ValidColumn,
+			memberdaystat.Table:       memberdaystat.ValidColumn,
+			memberhourstat.Table:      memberhourstat.ValidColumn,
+			memberissue.Table:         memberissue.ValidColumn,
+			memberpullrequest.Table:   memberpullrequest.ValidColumn,
+			memberrepodaystat.Table:   memberrepodaystat.ValidColumn,
+			memberrepostat.Table:      memberrepostat.ValidColumn,
+			memberreviewrequest.Table: memberreviewrequest.ValidColumn,
+			memberstat.Table:          memberstat.ValidColumn,
+			memberyearstat.Table:      memberyearstat.ValidColumn,
+			repodeliveryweek.Table:    repodeliveryweek.ValidColumn,
+			repometa.Table:            repometa.ValidColumn,
+			reviewedge.Table:          reviewedge.ValidColumn,
+			snapshot.Table:            snapshot.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -4,4 +4,4 @@
 // interfaces and never import this package.
 package ent
 
-//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate ./schema
+//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate --feature sql/modifier ./schema
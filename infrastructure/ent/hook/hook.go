@@ -9,6 +9,54 @@ import (
 	"github.com/Tattsum/github-analytics/infrastructure/ent"
 )
 
+// The ActivityEventFunc type is an adapter to allow the use of ordinary
+// function as ActivityEvent mutator.
+type ActivityEventFunc func(context.Context, *ent.ActivityEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ActivityEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ActivityEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ActivityEventMutation", m)
+}
+
+// The ExcludedMemberFunc type is an adapter to allow the use of ordinary
+// function as ExcludedMember mutator.
+type ExcludedMemberFunc func(context.Context, *ent.ExcludedMemberMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ExcludedMemberFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ExcludedMemberMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ExcludedMemberMutation", m)
+}
+
+// The MemberAccountFunc type is an adapter to allow the use of ordinary
+// function as MemberAccount mutator.
+type MemberAccountFunc func(context.Context, *ent.MemberAccountMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MemberAccountFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MemberAccountMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MemberAccountMutation", m)
+}
+
+// The MemberDataGapFunc type is an adapter to allow the use of ordinary
+// function as MemberDataGap mutator.
+type MemberDataGapFunc func(context.Context, *ent.MemberDataGapMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MemberDataGapFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MemberDataGapMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MemberDataGapMutation", m)
+}
+
 // The MemberDayStatFunc type is an adapter to allow the use of ordinary
 // function as MemberDayStat mutator.
 type MemberDayStatFunc func(context.Context, *ent.MemberDayStatMutation) (ent.Value, error)
@@ -21,6 +69,42 @@ func (f MemberDayStatFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Valu
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MemberDayStatMutation", m)
 }
 
+// The MemberHourStatFunc type is an adapter to allow the use of ordinary
+// function as MemberHourStat mutator.
+type MemberHourStatFunc func(context.Context, *ent.MemberHourStatMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MemberHourStatFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MemberHourStatMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MemberHourStatMutation", m)
+}
+
+// The MemberIssueFunc type is an adapter to allow the use of ordinary
+// function as MemberIssue mutator.
+type MemberIssueFunc func(context.Context, *ent.MemberIssueMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MemberIssueFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MemberIssueMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MemberIssueMutation", m)
+}
+
+// The MemberPullRequestFunc type is an adapter to allow the use of ordinary
+// function as MemberPullRequest mutator.
+type MemberPullRequestFunc func(context.Context, *ent.MemberPullRequestMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MemberPullRequestFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MemberPullRequestMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MemberPullRequestMutation", m)
+}
+
 // The MemberRepoDayStatFunc type is an adapter to allow the use of ordinary
 // function as MemberRepoDayStat mutator.
 type MemberRepoDayStatFunc func(context.Context, *ent.MemberRepoDayStatMutation) (ent.Value, error)
@@ -45,6 +129,18 @@ func (f MemberRepoStatFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MemberRepoStatMutation", m)
 }
 
+// The MemberReviewRequestFunc type is an adapter to allow the use of ordinary
+// function as MemberReviewRequest mutator.
+type MemberReviewRequestFunc func(context.Context, *ent.MemberReviewRequestMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MemberReviewRequestFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MemberReviewRequestMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MemberReviewRequestMutation", m)
+}
+
 // The MemberStatFunc type is an adapter to allow the use of ordinary
 // function as MemberStat mutator.
 type MemberStatFunc func(context.Context, *ent.MemberStatMutation) (ent.Value, error)
@@ -69,6 +165,18 @@ func (f MemberYearStatFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MemberYearStatMutation", m)
 }
 
+// The RepoDeliveryWeekFunc type is an adapter to allow the use of ordinary
+// function as RepoDeliveryWeek mutator.
+type RepoDeliveryWeekFunc func(context.Context, *ent.RepoDeliveryWeekMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RepoDeliveryWeekFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RepoDeliveryWeekMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RepoDeliveryWeekMutation", m)
+}
+
 // The RepoMetaFunc type is an adapter to allow the use of ordinary
 // function as RepoMeta mutator.
 type RepoMetaFunc func(context.Context, *ent.RepoMetaMutation) (ent.Value, error)
@@ -81,6 +189,18 @@ func (f RepoMetaFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RepoMetaMutation", m)
 }
 
+// The ReviewEdgeFunc type is an adapter to allow the use of ordinary
+// function as ReviewEdge mutator.
+type ReviewEdgeFunc func(context.Context, *ent.ReviewEdgeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReviewEdgeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ReviewEdgeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReviewEdgeMutation", m)
+}
+
 // The SnapshotFunc type is an adapter to allow the use of ordinary
 // function as Snapshot mutator.
 type SnapshotFunc func(context.Context, *ent.SnapshotMutation) (ent.Value, error)
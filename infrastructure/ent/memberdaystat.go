@@ -35,6 +35,34 @@ type MemberDayStat struct {
 	Additions int `json:"additions,omitempty"`
 	// Deletions holds the value of the "deletions" field.
 	Deletions int `json:"deletions,omitempty"`
+	// ExcludedReviewCount holds the value of the "excluded_review_count" field.
+	ExcludedReviewCount int `json:"excluded_review_count,omitempty"`
+	// IssuesClosed holds the value of the "issues_closed" field.
+	IssuesClosed int `json:"issues_closed,omitempty"`
+	// IssueCloseSeconds holds the value of the "issue_close_seconds" field.
+	IssueCloseSeconds int `json:"issue_close_seconds,omitempty"`
+	// BugIssuesOpened holds the value of the "bug_issues_opened" field.
+	BugIssuesOpened int `json:"bug_issues_opened,omitempty"`
+	// FeatureIssuesOpened holds the value of the "feature_issues_opened" field.
+	FeatureIssuesOpened int `json:"feature_issues_opened,omitempty"`
+	// BugIssuesClosed holds the value of the "bug_issues_closed" field.
+	BugIssuesClosed int `json:"bug_issues_closed,omitempty"`
+	// FeatureIssuesClosed holds the value of the "feature_issues_closed" field.
+	FeatureIssuesClosed int `json:"feature_issues_closed,omitempty"`
+	// FeaturePrs holds the value of the "feature_prs" field.
+	FeaturePrs int `json:"feature_prs,omitempty"`
+	// BugPrs holds the value of the "bug_prs" field.
+	BugPrs int `json:"bug_prs,omitempty"`
+	// ChorePrs holds the value of the "chore_prs" field.
+	ChorePrs int `json:"chore_prs,omitempty"`
+	// DocsPrs holds the value of the "docs_prs" field.
+	DocsPrs int `json:"docs_prs,omitempty"`
+	// TestPrs holds the value of the "test_prs" field.
+	TestPrs int `json:"test_prs,omitempty"`
+	// InfraPrs holds the value of the "infra_prs" field.
+	InfraPrs int `json:"infra_prs,omitempty"`
+	// OtherPrs holds the value of the "other_prs" field.
+	OtherPrs int `json:"other_prs,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the MemberDayStatQuery when eager-loading is set.
 	Edges                     MemberDayStatEdges `json:"edges"`
@@ -67,7 +95,7 @@ func (*MemberDayStat) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case memberdaystat.FieldID, memberdaystat.FieldCommitCount, memberdaystat.FieldPrCreated, memberdaystat.FieldPrMerged, memberdaystat.FieldIssueCount, memberdaystat.FieldReviewCount, memberdaystat.FieldAdditions, memberdaystat.FieldDeletions:
+		case memberdaystat.FieldID, memberdaystat.FieldCommitCount, memberdaystat.FieldPrCreated, memberdaystat.FieldPrMerged, memberdaystat.FieldIssueCount, memberdaystat.FieldReviewCount, memberdaystat.FieldAdditions, memberdaystat.FieldDeletions, memberdaystat.FieldExcludedReviewCount, memberdaystat.FieldIssuesClosed, memberdaystat.FieldIssueCloseSeconds, memberdaystat.FieldBugIssuesOpened, memberdaystat.FieldFeatureIssuesOpened, memberdaystat.FieldBugIssuesClosed, memberdaystat.FieldFeatureIssuesClosed, memberdaystat.FieldFeaturePrs, memberdaystat.FieldBugPrs, memberdaystat.FieldChorePrs, memberdaystat.FieldDocsPrs, memberdaystat.FieldTestPrs, memberdaystat.FieldInfraPrs, memberdaystat.FieldOtherPrs:
 			values[i] = new(sql.NullInt64)
 		case memberdaystat.FieldLogin, memberdaystat.FieldDay:
 			values[i] = new(sql.NullString)
@@ -148,6 +176,90 @@ func (_m *MemberDayStat) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Deletions = int(value.Int64)
 			}
+		case memberdaystat.FieldExcludedReviewCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field excluded_review_count", values[i])
+			} else if value.Valid {
+				_m.ExcludedReviewCount = int(value.Int64)
+			}
+		case memberdaystat.FieldIssuesClosed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field issues_closed", values[i])
+			} else if value.Valid {
+				_m.IssuesClosed = int(value.Int64)
+			}
+		case memberdaystat.FieldIssueCloseSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field issue_close_seconds", values[i])
+			} else if value.Valid {
+				_m.IssueCloseSeconds = int(value.Int64)
+			}
+		case memberdaystat.FieldBugIssuesOpened:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field bug_issues_opened", values[i])
+			} else if value.Valid {
+				_m.BugIssuesOpened = int(value.Int64)
+			}
+		case memberdaystat.FieldFeatureIssuesOpened:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field feature_issues_opened", values[i])
+			} else if value.Valid {
+				_m.FeatureIssuesOpened = int(value.Int64)
+			}
+		case memberdaystat.FieldBugIssuesClosed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field bug_issues_closed", values[i])
+			} else if value.Valid {
+				_m.BugIssuesClosed = int(value.Int64)
+			}
+		case memberdaystat.FieldFeatureIssuesClosed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field feature_issues_closed", values[i])
+			} else if value.Valid {
+				_m.FeatureIssuesClosed = int(value.Int64)
+			}
+		case memberdaystat.FieldFeaturePrs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field feature_prs", values[i])
+			} else if value.Valid {
+				_m.FeaturePrs = int(value.Int64)
+			}
+		case memberdaystat.FieldBugPrs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field bug_prs", values[i])
+			} else if value.Valid {
+				_m.BugPrs = int(value.Int64)
+			}
+		case memberdaystat.FieldChorePrs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field chore_prs", values[i])
+			} else if value.Valid {
+				_m.ChorePrs = int(value.Int64)
+			}
+		case memberdaystat.FieldDocsPrs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field docs_prs", values[i])
+			} else if value.Valid {
+				_m.DocsPrs = int(value.Int64)
+			}
+		case memberdaystat.FieldTestPrs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field test_prs", values[i])
+			} else if value.Valid {
+				_m.TestPrs = int(value.Int64)
+			}
+		case memberdaystat.FieldInfraPrs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field infra_prs", values[i])
+			} else if value.Valid {
+				_m.InfraPrs = int(value.Int64)
+			}
+		case memberdaystat.FieldOtherPrs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field other_prs", values[i])
+			} else if value.Valid {
+				_m.OtherPrs = int(value.Int64)
+			}
 		case memberdaystat.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field snapshot_member_day_stats", value)
@@ -222,6 +334,48 @@ func (_m *MemberDayStat) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("deletions=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Deletions))
+	builder.WriteString(", ")
+	builder.WriteString("excluded_review_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExcludedReviewCount))
+	builder.WriteString(", ")
+	builder.WriteString("issues_closed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IssuesClosed))
+	builder.WriteString(", ")
+	builder.WriteString("issue_close_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IssueCloseSeconds))
+	builder.WriteString(", ")
+	builder.WriteString("bug_issues_opened=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BugIssuesOpened))
+	builder.WriteString(", ")
+	builder.WriteString("feature_issues_opened=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FeatureIssuesOpened))
+	builder.WriteString(", ")
+	builder.WriteString("bug_issues_closed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BugIssuesClosed))
+	builder.WriteString(", ")
+	builder.WriteString("feature_issues_closed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FeatureIssuesClosed))
+	builder.WriteString(", ")
+	builder.WriteString("feature_prs=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FeaturePrs))
+	builder.WriteString(", ")
+	builder.WriteString("bug_prs=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BugPrs))
+	builder.WriteString(", ")
+	builder.WriteString("chore_prs=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChorePrs))
+	builder.WriteString(", ")
+	builder.WriteString("docs_prs=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DocsPrs))
+	builder.WriteString(", ")
+	builder.WriteString("test_prs=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TestPrs))
+	builder.WriteString(", ")
+	builder.WriteString("infra_prs=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InfraPrs))
+	builder.WriteString(", ")
+	builder.WriteString("other_prs=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OtherPrs))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -130,6 +130,202 @@ func (_c *MemberDayStatCreate) SetNillableDeletions(v *int) *MemberDayStatCreate
 	return _c
 }
 
+// SetExcludedReviewCount sets the "excluded_review_count" field.
+func (_c *MemberDayStatCreate) SetExcludedReviewCount(v int) *MemberDayStatCreate {
+	_c.mutation.SetExcludedReviewCount(v)
+	return _c
+}
+
+// SetNillableExcludedReviewCount sets the "excluded_review_count" field if the given value is not nil.
+func (_c *MemberDayStatCreate) SetNillableExcludedReviewCount(v *int) *MemberDayStatCreate {
+	if v != nil {
+		_c.SetExcludedReviewCount(*v)
+	}
+	return _c
+}
+
+// SetIssuesClosed sets the "issues_closed" field.
+func (_c *MemberDayStatCreate) SetIssuesClosed(v int) *MemberDayStatCreate {
+	_c.mutation.SetIssuesClosed(v)
+	return _c
+}
+
+// SetNillableIssuesClosed sets the "issues_closed" field if the given value is not nil.
+func (_c *MemberDayStatCreate) SetNillableIssuesClosed(v *int) *MemberDayStatCreate {
+	if v != nil {
+		_c.SetIssuesClosed(*v)
+	}
+	return _c
+}
+
+// SetIssueCloseSeconds sets the "issue_close_seconds" field.
+func (_c *MemberDayStatCreate) SetIssueCloseSeconds(v int) *MemberDayStatCreate {
+	_c.mutation.SetIssueCloseSeconds(v)
+	return _c
+}
+
+// SetNillableIssueCloseSeconds sets the "issue_close_seconds" field if the given value is not nil.
+func (_c *MemberDayStatCreate) SetNillableIssueCloseSeconds(v *int) *MemberDayStatCreate {
+	if v != nil {
+		_c.SetIssueCloseSeconds(*v)
+	}
+	return _c
+}
+
+// SetBugIssuesOpened sets the "bug_issues_opened" field.
+func (_c *MemberDayStatCreate) SetBugIssuesOpened(v int) *MemberDayStatCreate {
+	_c.mutation.SetBugIssuesOpened(v)
+	return _c
+}
+
+// SetNillableBugIssuesOpened sets the "bug_issues_opened" field if the given value is not nil.
+func (_c *MemberDayStatCreate) SetNillableBugIssuesOpened(v *int) *MemberDayStatCreate {
+	if v != nil {
+		_c.SetBugIssuesOpened(*v)
+	}
+	return _c
+}
+
+// SetFeatureIssuesOpened sets the "feature_issues_opened" field.
+func (_c *MemberDayStatCreate) SetFeatureIssuesOpened(v int) *MemberDayStatCreate {
+	_c.mutation.SetFeatureIssuesOpened(v)
+	return _c
+}
+
+// SetNillableFeatureIssuesOpened sets the "feature_issues_opened" field if the given value is not nil.
+func (_c *MemberDayStatCreate) SetNillableFeatureIssuesOpened(v *int) *MemberDayStatCreate {
+	if v != nil {
+		_c.SetFeatureIssuesOpened(*v)
+	}
+	return _c
+}
+
+// SetBugIssuesClosed sets the "bug_issues_closed" field.
+func (_c *MemberDayStatCreate) SetBugIssuesClosed(v int) *MemberDayStatCreate {
+	_c.mutation.SetBugIssuesClosed(v)
+	return _c
+}
+
+// SetNillableBugIssuesClosed sets the "bug_issues_closed" field if the given value is not nil.
+func (_c *MemberDayStatCreate) SetNillableBugIssuesClosed(v *int) *MemberDayStatCreate {
+	if v != nil {
+		_c.SetBugIssuesClosed(*v)
+	}
+	return _c
+}
+
+// SetFeatureIssuesClosed sets the "feature_issues_closed" field.
+func (_c *MemberDayStatCreate) SetFeatureIssuesClosed(v int) *MemberDayStatCreate {
+	_c.mutation.SetFeatureIssuesClosed(v)
+	return _c
+}
+
+// SetNillableFeatureIssuesClosed sets the "feature_issues_closed" field if the given value is not nil.
+func (_c *MemberDayStatCreate) SetNillableFeatureIssuesClosed(v *int) *MemberDayStatCreate {
+	if v != nil {
+		_c.SetFeatureIssuesClosed(*v)
+	}
+	return _c
+}
+
+// SetFeaturePrs sets the "feature_prs" field.
+func (_c *MemberDayStatCreate) SetFeaturePrs(v int) *MemberDayStatCreate {
+	_c.mutation.SetFeaturePrs(v)
+	return _c
+}
+
+// SetNillableFeaturePrs sets the "feature_prs" field if the given value is not nil.
+func (_c *MemberDayStatCreate) SetNillableFeaturePrs(v *int) *MemberDayStatCreate {
+	if v != nil {
+		_c.SetFeaturePrs(*v)
+	}
+	return _c
+}
+
+// SetBugPrs sets the "bug_prs" field.
+func (_c *MemberDayStatCreate) SetBugPrs(v int) *MemberDayStatCreate {
+	_c.mutation.SetBugPrs(v)
+	return _c
+}
+
+// SetNillableBugPrs sets the "bug_prs" field if the given value is not nil.
+func (_c *MemberDayStatCreate) SetNillableBugPrs(v *int) *MemberDayStatCreate {
+	if v != nil {
+		_c.SetBugPrs(*v)
+	}
+	return _c
+}
+
+// SetChorePrs sets the "chore_prs" field.
+func (_c *MemberDayStatCreate) SetChorePrs(v int) *MemberDayStatCreate {
+	_c.mutation.SetChorePrs(v)
+	return _c
+}
+
+// SetNillableChorePrs sets the "chore_prs" field if the given value is not nil.
+func (_c *MemberDayStatCreate) SetNillableChorePrs(v *int) *MemberDayStatCreate {
+	if v != nil {
+		_c.SetChorePrs(*v)
+	}
+	return _c
+}
+
+// SetDocsPrs sets the "docs_prs" field.
+func (_c *MemberDayStatCreate) SetDocsPrs(v int) *MemberDayStatCreate {
+	_c.mutation.SetDocsPrs(v)
+	return _c
+}
+
+// SetNillableDocsPrs sets the "docs_prs" field if the given value is not nil.
+func (_c *MemberDayStatCreate) SetNillableDocsPrs(v *int) *MemberDayStatCreate {
+	if v != nil {
+		_c.SetDocsPrs(*v)
+	}
+	return _c
+}
+
+// SetTestPrs sets the "test_prs" field.
+func (_c *MemberDayStatCreate) SetTestPrs(v int) *MemberDayStatCreate {
+	_c.mutation.SetTestPrs(v)
+	return _c
+}
+
+// SetNillableTestPrs sets the "test_prs" field if the given value is not nil.
+func (_c *MemberDayStatCreate) SetNillableTestPrs(v *int) *MemberDayStatCreate {
+	if v != nil {
+		_c.SetTestPrs(*v)
+	}
+	return _c
+}
+
+// SetInfraPrs sets the "infra_prs" field.
+func (_c *MemberDayStatCreate) SetInfraPrs(v int) *MemberDayStatCreate {
+	_c.mutation.SetInfraPrs(v)
+	return _c
+}
+
+// SetNillableInfraPrs sets the "infra_prs" field if the given value is not nil.
+func (_c *MemberDayStatCreate) SetNillableInfraPrs(v *int) *MemberDayStatCreate {
+	if v != nil {
+		_c.SetInfraPrs(*v)
+	}
+	return _c
+}
+
+// SetOtherPrs sets the "other_prs" field.
+func (_c *MemberDayStatCreate) SetOtherPrs(v int) *MemberDayStatCreate {
+	_c.mutation.SetOtherPrs(v)
+	return _c
+}
+
+// SetNillableOtherPrs sets the "other_prs" field if the given value is not nil.
+func (_c *MemberDayStatCreate) SetNillableOtherPrs(v *int) *MemberDayStatCreate {
+	if v != nil {
+		_c.SetOtherPrs(*v)
+	}
+	return _c
+}
+
 // SetSnapshotID sets the "snapshot" edge to the Snapshot entity by ID.
 func (_c *MemberDayStatCreate) SetSnapshotID(id int) *MemberDayStatCreate {
 	_c.mutation.SetSnapshotID(id)
@@ -204,6 +400,62 @@ func (_c *MemberDayStatCreate) defaults() {
 		v := memberdaystat.DefaultDeletions
 		_c.mutation.SetDeletions(v)
 	}
+	if _, ok := _c.mutation.ExcludedReviewCount(); !ok {
+		v := memberdaystat.DefaultExcludedReviewCount
+		_c.mutation.SetExcludedReviewCount(v)
+	}
+	if _, ok := _c.mutation.IssuesClosed(); !ok {
+		v := memberdaystat.DefaultIssuesClosed
+		_c.mutation.SetIssuesClosed(v)
+	}
+	if _, ok := _c.mutation.IssueCloseSeconds(); !ok {
+		v := memberdaystat.DefaultIssueCloseSeconds
+		_c.mutation.SetIssueCloseSeconds(v)
+	}
+	if _, ok := _c.mutation.BugIssuesOpened(); !ok {
+		v := memberdaystat.DefaultBugIssuesOpened
+		_c.mutation.SetBugIssuesOpened(v)
+	}
+	if _, ok := _c.mutation.FeatureIssuesOpened(); !ok {
+		v := memberdaystat.DefaultFeatureIssuesOpened
+		_c.mutation.SetFeatureIssuesOpened(v)
+	}
+	if _, ok := _c.mutation.BugIssuesClosed(); !ok {
+		v := memberdaystat.DefaultBugIssuesClosed
+		_c.mutation.SetBugIssuesClosed(v)
+	}
+	if _, ok := _c.mutation.FeatureIssuesClosed(); !ok {
+		v := memberdaystat.DefaultFeatureIssuesClosed
+		_c.mutation.SetFeatureIssuesClosed(v)
+	}
+	if _, ok := _c.mutation.FeaturePrs(); !ok {
+		v := memberdaystat.DefaultFeaturePrs
+		_c.mutation.SetFeaturePrs(v)
+	}
+	if _, ok := _c.mutation.BugPrs(); !ok {
+		v := memberdaystat.DefaultBugPrs
+		_c.mutation.SetBugPrs(v)
+	}
+	if _, ok := _c.mutation.ChorePrs(); !ok {
+		v := memberdaystat.DefaultChorePrs
+		_c.mutation.SetChorePrs(v)
+	}
+	if _, ok := _c.mutation.DocsPrs(); !ok {
+		v := memberdaystat.DefaultDocsPrs
+		_c.mutation.SetDocsPrs(v)
+	}
+	if _, ok := _c.mutation.TestPrs(); !ok {
+		v := memberdaystat.DefaultTestPrs
+		_c.mutation.SetTestPrs(v)
+	}
+	if _, ok := _c.mutation.InfraPrs(); !ok {
+		v := memberdaystat.DefaultInfraPrs
+		_c.mutation.SetInfraPrs(v)
+	}
+	if _, ok := _c.mutation.OtherPrs(); !ok {
+		v := memberdaystat.DefaultOtherPrs
+		_c.mutation.SetOtherPrs(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -245,6 +497,48 @@ func (_c *MemberDayStatCreate) check() error {
 	if _, ok := _c.mutation.Deletions(); !ok {
 		return &ValidationError{Name: "deletions", err: errors.New(`ent: missing required field "MemberDayStat.deletions"`)}
 	}
+	if _, ok := _c.mutation.ExcludedReviewCount(); !ok {
+		return &ValidationError{Name: "excluded_review_count", err: errors.New(`ent: missing required field "MemberDayStat.excluded_review_count"`)}
+	}
+	if _, ok := _c.mutation.IssuesClosed(); !ok {
+		return &ValidationError{Name: "issues_closed", err: errors.New(`ent: missing required field "MemberDayStat.issues_closed"`)}
+	}
+	if _, ok := _c.mutation.IssueCloseSeconds(); !ok {
+		return &ValidationError{Name: "issue_close_seconds", err: errors.New(`ent: missing required field "MemberDayStat.issue_close_seconds"`)}
+	}
+	if _, ok := _c.mutation.BugIssuesOpened(); !ok {
+		return &ValidationError{Name: "bug_issues_opened", err: errors.New(`ent: missing required field "MemberDayStat.bug_issues_opened"`)}
+	}
+	if _, ok := _c.mutation.FeatureIssuesOpened(); !ok {
+		return &ValidationError{Name: "feature_issues_opened", err: errors.New(`ent: missing required field "MemberDayStat.feature_issues_opened"`)}
+	}
+	if _, ok := _c.mutation.BugIssuesClosed(); !ok {
+		return &ValidationError{Name: "bug_issues_closed", err: errors.New(`ent: missing required field "MemberDayStat.bug_issues_closed"`)}
+	}
+	if _, ok := _c.mutation.FeatureIssuesClosed(); !ok {
+		return &ValidationError{Name: "feature_issues_closed", err: errors.New(`ent: missing required field "MemberDayStat.feature_issues_closed"`)}
+	}
+	if _, ok := _c.mutation.FeaturePrs(); !ok {
+		return &ValidationError{Name: "feature_prs", err: errors.New(`ent: missing required field "MemberDayStat.feature_prs"`)}
+	}
+	if _, ok := _c.mutation.BugPrs(); !ok {
+		return &ValidationError{Name: "bug_prs", err: errors.New(`ent: missing required field "MemberDayStat.bug_prs"`)}
+	}
+	if _, ok := _c.mutation.ChorePrs(); !ok {
+		return &ValidationError{Name: "chore_prs", err: errors.New(`ent: missing required field "MemberDayStat.chore_prs"`)}
+	}
+	if _, ok := _c.mutation.DocsPrs(); !ok {
+		return &ValidationError{Name: "docs_prs", err: errors.New(`ent: missing required field "MemberDayStat.docs_prs"`)}
+	}
+	if _, ok := _c.mutation.TestPrs(); !ok {
+		return &ValidationError{Name: "test_prs", err: errors.New(`ent: missing required field "MemberDayStat.test_prs"`)}
+	}
+	if _, ok := _c.mutation.InfraPrs(); !ok {
+		return &ValidationError{Name: "infra_prs", err: errors.New(`ent: missing required field "MemberDayStat.infra_prs"`)}
+	}
+	if _, ok := _c.mutation.OtherPrs(); !ok {
+		return &ValidationError{Name: "other_prs", err: errors.New(`ent: missing required field "MemberDayStat.other_prs"`)}
+	}
 	if len(_c.mutation.SnapshotIDs()) == 0 {
 		return &ValidationError{Name: "snapshot", err: errors.New(`ent: missing required edge "MemberDayStat.snapshot"`)}
 	}
@@ -310,6 +604,62 @@ func (_c *MemberDayStatCreate) createSpec() (*MemberDayStat, *sqlgraph.CreateSpe
 		_spec.SetField(memberdaystat.FieldDeletions, field.TypeInt, value)
 		_node.Deletions = value
 	}
+	if value, ok := _c.mutation.ExcludedReviewCount(); ok {
+		_spec.SetField(memberdaystat.FieldExcludedReviewCount, field.TypeInt, value)
+		_node.ExcludedReviewCount = value
+	}
+	if value, ok := _c.mutation.IssuesClosed(); ok {
+		_spec.SetField(memberdaystat.FieldIssuesClosed, field.TypeInt, value)
+		_node.IssuesClosed = value
+	}
+	if value, ok := _c.mutation.IssueCloseSeconds(); ok {
+		_spec.SetField(memberdaystat.FieldIssueCloseSeconds, field.TypeInt, value)
+		_node.IssueCloseSeconds = value
+	}
+	if value, ok := _c.mutation.BugIssuesOpened(); ok {
+		_spec.SetField(memberdaystat.FieldBugIssuesOpened, field.TypeInt, value)
+		_node.BugIssuesOpened = value
+	}
+	if value, ok := _c.mutation.FeatureIssuesOpened(); ok {
+		_spec.SetField(memberdaystat.FieldFeatureIssuesOpened, field.TypeInt, value)
+		_node.FeatureIssuesOpened = value
+	}
+	if value, ok := _c.mutation.BugIssuesClosed(); ok {
+		_spec.SetField(memberdaystat.FieldBugIssuesClosed, field.TypeInt, value)
+		_node.BugIssuesClosed = value
+	}
+	if value, ok := _c.mutation.FeatureIssuesClosed(); ok {
+		_spec.SetField(memberdaystat.FieldFeatureIssuesClosed, field.TypeInt, value)
+		_node.FeatureIssuesClosed = value
+	}
+	if value, ok := _c.mutation.FeaturePrs(); ok {
+		_spec.SetField(memberdaystat.FieldFeaturePrs, field.TypeInt, value)
+		_node.FeaturePrs = value
+	}
+	if value, ok := _c.mutation.BugPrs(); ok {
+		_spec.SetField(memberdaystat.FieldBugPrs, field.TypeInt, value)
+		_node.BugPrs = value
+	}
+	if value, ok := _c.mutation.ChorePrs(); ok {
+		_spec.SetField(memberdaystat.FieldChorePrs, field.TypeInt, value)
+		_node.ChorePrs = value
+	}
+	if value, ok := _c.mutation.DocsPrs(); ok {
+		_spec.SetField(memberdaystat.FieldDocsPrs, field.TypeInt, value)
+		_node.DocsPrs = value
+	}
+	if value, ok := _c.mutation.TestPrs(); ok {
+		_spec.SetField(memberdaystat.FieldTestPrs, field.TypeInt, value)
+		_node.TestPrs = value
+	}
+	if value, ok := _c.mutation.InfraPrs(); ok {
+		_spec.SetField(memberdaystat.FieldInfraPrs, field.TypeInt, value)
+		_node.InfraPrs = value
+	}
+	if value, ok := _c.mutation.OtherPrs(); ok {
+		_spec.SetField(memberdaystat.FieldOtherPrs, field.TypeInt, value)
+		_node.OtherPrs = value
+	}
 	if nodes := _c.mutation.SnapshotIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
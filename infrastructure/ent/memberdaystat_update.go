@@ -203,6 +203,300 @@ func (_u *MemberDayStatUpdate) AddDeletions(v int) *MemberDayStatUpdate {
 	return _u
 }
 
+// SetExcludedReviewCount sets the "excluded_review_count" field.
+func (_u *MemberDayStatUpdate) SetExcludedReviewCount(v int) *MemberDayStatUpdate {
+	_u.mutation.ResetExcludedReviewCount()
+	_u.mutation.SetExcludedReviewCount(v)
+	return _u
+}
+
+// SetNillableExcludedReviewCount sets the "excluded_review_count" field if the given value is not nil.
+func (_u *MemberDayStatUpdate) SetNillableExcludedReviewCount(v *int) *MemberDayStatUpdate {
+	if v != nil {
+		_u.SetExcludedReviewCount(*v)
+	}
+	return _u
+}
+
+// AddExcludedReviewCount adds value to the "excluded_review_count" field.
+func (_u *MemberDayStatUpdate) AddExcludedReviewCount(v int) *MemberDayStatUpdate {
+	_u.mutation.AddExcludedReviewCount(v)
+	return _u
+}
+
+// SetIssuesClosed sets the "issues_closed" field.
+func (_u *MemberDayStatUpdate) SetIssuesClosed(v int) *MemberDayStatUpdate {
+	_u.mutation.ResetIssuesClosed()
+	_u.mutation.SetIssuesClosed(v)
+	return _u
+}
+
+// SetNillableIssuesClosed sets the "issues_closed" field if the given value is not nil.
+func (_u *MemberDayStatUpdate) SetNillableIssuesClosed(v *int) *MemberDayStatUpdate {
+	if v != nil {
+		_u.SetIssuesClosed(*v)
+	}
+	return _u
+}
+
+// AddIssuesClosed adds value to the "issues_closed" field.
+func (_u *MemberDayStatUpdate) AddIssuesClosed(v int) *MemberDayStatUpdate {
+	_u.mutation.AddIssuesClosed(v)
+	return _u
+}
+
+// SetIssueCloseSeconds sets the "issue_close_seconds" field.
+func (_u *MemberDayStatUpdate) SetIssueCloseSeconds(v int) *MemberDayStatUpdate {
+	_u.mutation.ResetIssueCloseSeconds()
+	_u.mutation.SetIssueCloseSeconds(v)
+	return _u
+}
+
+// SetNillableIssueCloseSeconds sets the "issue_close_seconds" field if the given value is not nil.
+func (_u *MemberDayStatUpdate) SetNillableIssueCloseSeconds(v *int) *MemberDayStatUpdate {
+	if v != nil {
+		_u.SetIssueCloseSeconds(*v)
+	}
+	return _u
+}
+
+// AddIssueCloseSeconds adds value to the "issue_close_seconds" field.
+func (_u *MemberDayStatUpdate) AddIssueCloseSeconds(v int) *MemberDayStatUpdate {
+	_u.mutation.AddIssueCloseSeconds(v)
+	return _u
+}
+
+// SetBugIssuesOpened sets the "bug_issues_opened" field.
+func (_u *MemberDayStatUpdate) SetBugIssuesOpened(v int) *MemberDayStatUpdate {
+	_u.mutation.ResetBugIssuesOpened()
+	_u.mutation.SetBugIssuesOpened(v)
+	return _u
+}
+
+// SetNillableBugIssuesOpened sets the "bug_issues_opened" field if the given value is not nil.
+func (_u *MemberDayStatUpdate) SetNillableBugIssuesOpened(v *int) *MemberDayStatUpdate {
+	if v != nil {
+		_u.SetBugIssuesOpened(*v)
+	}
+	return _u
+}
+
+// AddBugIssuesOpened adds value to the "bug_issues_opened" field.
+func (_u *MemberDayStatUpdate) AddBugIssuesOpened(v int) *MemberDayStatUpdate {
+	_u.mutation.AddBugIssuesOpened(v)
+	return _u
+}
+
+// SetFeatureIssuesOpened sets the "feature_issues_opened" field.
+func (_u *MemberDayStatUpdate) SetFeatureIssuesOpened(v int) *MemberDayStatUpdate {
+	_u.mutation.ResetFeatureIssuesOpened()
+	_u.mutation.SetFeatureIssuesOpened(v)
+	return _u
+}
+
+// SetNillableFeatureIssuesOpened sets the "feature_issues_opened" field if the given value is not nil.
+func (_u *MemberDayStatUpdate) SetNillableFeatureIssuesOpened(v *int) *MemberDayStatUpdate {
+	if v != nil {
+		_u.SetFeatureIssuesOpened(*v)
+	}
+	return _u
+}
+
+// AddFeatureIssuesOpened adds value to the "feature_issues_opened" field.
+func (_u *MemberDayStatUpdate) AddFeatureIssuesOpened(v int) *MemberDayStatUpdate {
+	_u.mutation.AddFeatureIssuesOpened(v)
+	return _u
+}
+
+// SetBugIssuesClosed sets the "bug_issues_closed" field.
+func (_u *MemberDayStatUpdate) SetBugIssuesClosed(v int) *MemberDayStatUpdate {
+	_u.mutation.ResetBugIssuesClosed()
+	_u.mutation.SetBugIssuesClosed(v)
+	return _u
+}
+
+// SetNillableBugIssuesClosed sets the "bug_issues_closed" field if the given value is not nil.
+func (_u *MemberDayStatUpdate) SetNillableBugIssuesClosed(v *int) *MemberDayStatUpdate {
+	if v != nil {
+		_u.SetBugIssuesClosed(*v)
+	}
+	return _u
+}
+
+// AddBugIssuesClosed adds value to the "bug_issues_closed" field.
+func (_u *MemberDayStatUpdate) AddBugIssuesClosed(v int) *MemberDayStatUpdate {
+	_u.mutation.AddBugIssuesClosed(v)
+	return _u
+}
+
+// SetFeatureIssuesClosed sets the "feature_issues_closed" field.
+func (_u *MemberDayStatUpdate) SetFeatureIssuesClosed(v int) *MemberDayStatUpdate {
+	_u.mutation.ResetFeatureIssuesClosed()
+	_u.mutation.SetFeatureIssuesClosed(v)
+	return _u
+}
+
+// SetNillableFeatureIssuesClosed sets the "feature_issues_closed" field if the given value is not nil.
+func (_u *MemberDayStatUpdate) SetNillableFeatureIssuesClosed(v *int) *MemberDayStatUpdate {
+	if v != nil {
+		_u.SetFeatureIssuesClosed(*v)
+	}
+	return _u
+}
+
+// AddFeatureIssuesClosed adds value to the "feature_issues_closed" field.
+func (_u *MemberDayStatUpdate) AddFeatureIssuesClosed(v int) *MemberDayStatUpdate {
+	_u.mutation.AddFeatureIssuesClosed(v)
+	return _u
+}
+
+// SetFeaturePrs sets the "feature_prs" field.
+func (_u *MemberDayStatUpdate) SetFeaturePrs(v int) *MemberDayStatUpdate {
+	_u.mutation.ResetFeaturePrs()
+	_u.mutation.SetFeaturePrs(v)
+	return _u
+}
+
+// SetNillableFeaturePrs sets the "feature_prs" field if the given value is not nil.
+func (_u *MemberDayStatUpdate) SetNillableFeaturePrs(v *int) *MemberDayStatUpdate {
+	if v != nil {
+		_u.SetFeaturePrs(*v)
+	}
+	return _u
+}
+
+// AddFeaturePrs adds value to the "feature_prs" field.
+func (_u *MemberDayStatUpdate) AddFeaturePrs(v int) *MemberDayStatUpdate {
+	_u.mutation.AddFeaturePrs(v)
+	return _u
+}
+
+// SetBugPrs sets the "bug_prs" field.
+func (_u *MemberDayStatUpdate) SetBugPrs(v int) *MemberDayStatUpdate {
+	_u.mutation.ResetBugPrs()
+	_u.mutation.SetBugPrs(v)
+	return _u
+}
+
+// SetNillableBugPrs sets the "bug_prs" field if the given value is not nil.
+func (_u *MemberDayStatUpdate) SetNillableBugPrs(v *int) *MemberDayStatUpdate {
+	if v != nil {
+		_u.SetBugPrs(*v)
+	}
+	return _u
+}
+
+// AddBugPrs adds value to the "bug_prs" field.
+func (_u *MemberDayStatUpdate) AddBugPrs(v int) *MemberDayStatUpdate {
+	_u.mutation.AddBugPrs(v)
+	return _u
+}
+
+// SetChorePrs sets the "chore_prs" field.
+func (_u *MemberDayStatUpdate) SetChorePrs(v int) *MemberDayStatUpdate {
+	_u.mutation.ResetChorePrs()
+	_u.mutation.SetChorePrs(v)
+	return _u
+}
+
+// SetNillableChorePrs sets the "chore_prs" field if the given value is not nil.
+func (_u *MemberDayStatUpdate) SetNillableChorePrs(v *int) *MemberDayStatUpdate {
+	if v != nil {
+		_u.SetChorePrs(*v)
+	}
+	return _u
+}
+
+// AddChorePrs adds value to the "chore_prs" field.
+func (_u *MemberDayStatUpdate) AddChorePrs(v int) *MemberDayStatUpdate {
+	_u.mutation.AddChorePrs(v)
+	return _u
+}
+
+// SetDocsPrs sets the "docs_prs" field.
+func (_u *MemberDayStatUpdate) SetDocsPrs(v int) *MemberDayStatUpdate {
+	_u.mutation.ResetDocsPrs()
+	_u.mutation.SetDocsPrs(v)
+	return _u
+}
+
+// SetNillableDocsPrs sets the "docs_prs" field if the given value is not nil.
+func (_u *MemberDayStatUpdate) SetNillableDocsPrs(v *int) *MemberDayStatUpdate {
+	if v != nil {
+		_u.SetDocsPrs(*v)
+	}
+	return _u
+}
+
+// AddDocsPrs adds value to the "docs_prs" field.
+func (_u *MemberDayStatUpdate) AddDocsPrs(v int) *MemberDayStatUpdate {
+	_u.mutation.AddDocsPrs(v)
+	return _u
+}
+
+// SetTestPrs sets the "test_prs" field.
+func (_u *MemberDayStatUpdate) SetTestPrs(v int) *MemberDayStatUpdate {
+	_u.mutation.ResetTestPrs()
+	_u.mutation.SetTestPrs(v)
+	return _u
+}
+
+// SetNillableTestPrs sets the "test_prs" field if the given value is not nil.
+func (_u *MemberDayStatUpdate) SetNillableTestPrs(v *int) *MemberDayStatUpdate {
+	if v != nil {
+		_u.SetTestPrs(*v)
+	}
+	return _u
+}
+
+// AddTestPrs adds value to the "test_prs" field.
+func (_u *MemberDayStatUpdate) AddTestPrs(v int) *MemberDayStatUpdate {
+	_u.mutation.AddTestPrs(v)
+	return _u
+}
+
+// SetInfraPrs sets the "infra_prs" field.
+func (_u *MemberDayStatUpdate) SetInfraPrs(v int) *MemberDayStatUpdate {
+	_u.mutation.ResetInfraPrs()
+	_u.mutation.SetInfraPrs(v)
+	return _u
+}
+
+// SetNillableInfraPrs sets the "infra_prs" field if the given value is not nil.
+func (_u *MemberDayStatUpdate) SetNillableInfraPrs(v *int) *MemberDayStatUpdate {
+	if v != nil {
+		_u.SetInfraPrs(*v)
+	}
+	return _u
+}
+
+// AddInfraPrs adds value to the "infra_prs" field.
+func (_u *MemberDayStatUpdate) AddInfraPrs(v int) *MemberDayStatUpdate {
+	_u.mutation.AddInfraPrs(v)
+	return _u
+}
+
+// SetOtherPrs sets the "other_prs" field.
+func (_u *MemberDayStatUpdate) SetOtherPrs(v int) *MemberDayStatUpdate {
+	_u.mutation.ResetOtherPrs()
+	_u.mutation.SetOtherPrs(v)
+	return _u
+}
+
+// SetNillableOtherPrs sets the "other_prs" field if the given value is not nil.
+func (_u *MemberDayStatUpdate) SetNillableOtherPrs(v *int) *MemberDayStatUpdate {
+	if v != nil {
+		_u.SetOtherPrs(*v)
+	}
+	return _u
+}
+
+// AddOtherPrs adds value to the "other_prs" field.
+func (_u *MemberDayStatUpdate) AddOtherPrs(v int) *MemberDayStatUpdate {
+	_u.mutation.AddOtherPrs(v)
+	return _u
+}
+
 // SetSnapshotID sets the "snapshot" edge to the Snapshot entity by ID.
 func (_u *MemberDayStatUpdate) SetSnapshotID(id int) *MemberDayStatUpdate {
 	_u.mutation.SetSnapshotID(id)
@@ -330,6 +624,90 @@ func (_u *MemberDayStatUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if value, ok := _u.mutation.AddedDeletions(); ok {
 		_spec.AddField(memberdaystat.FieldDeletions, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.ExcludedReviewCount(); ok {
+		_spec.SetField(memberdaystat.FieldExcludedReviewCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedExcludedReviewCount(); ok {
+		_spec.AddField(memberdaystat.FieldExcludedReviewCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IssuesClosed(); ok {
+		_spec.SetField(memberdaystat.FieldIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedIssuesClosed(); ok {
+		_spec.AddField(memberdaystat.FieldIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IssueCloseSeconds(); ok {
+		_spec.SetField(memberdaystat.FieldIssueCloseSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedIssueCloseSeconds(); ok {
+		_spec.AddField(memberdaystat.FieldIssueCloseSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BugIssuesOpened(); ok {
+		_spec.SetField(memberdaystat.FieldBugIssuesOpened, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBugIssuesOpened(); ok {
+		_spec.AddField(memberdaystat.FieldBugIssuesOpened, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FeatureIssuesOpened(); ok {
+		_spec.SetField(memberdaystat.FieldFeatureIssuesOpened, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFeatureIssuesOpened(); ok {
+		_spec.AddField(memberdaystat.FieldFeatureIssuesOpened, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BugIssuesClosed(); ok {
+		_spec.SetField(memberdaystat.FieldBugIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBugIssuesClosed(); ok {
+		_spec.AddField(memberdaystat.FieldBugIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FeatureIssuesClosed(); ok {
+		_spec.SetField(memberdaystat.FieldFeatureIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFeatureIssuesClosed(); ok {
+		_spec.AddField(memberdaystat.FieldFeatureIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FeaturePrs(); ok {
+		_spec.SetField(memberdaystat.FieldFeaturePrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFeaturePrs(); ok {
+		_spec.AddField(memberdaystat.FieldFeaturePrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BugPrs(); ok {
+		_spec.SetField(memberdaystat.FieldBugPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBugPrs(); ok {
+		_spec.AddField(memberdaystat.FieldBugPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ChorePrs(); ok {
+		_spec.SetField(memberdaystat.FieldChorePrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChorePrs(); ok {
+		_spec.AddField(memberdaystat.FieldChorePrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DocsPrs(); ok {
+		_spec.SetField(memberdaystat.FieldDocsPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDocsPrs(); ok {
+		_spec.AddField(memberdaystat.FieldDocsPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TestPrs(); ok {
+		_spec.SetField(memberdaystat.FieldTestPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTestPrs(); ok {
+		_spec.AddField(memberdaystat.FieldTestPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.InfraPrs(); ok {
+		_spec.SetField(memberdaystat.FieldInfraPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInfraPrs(); ok {
+		_spec.AddField(memberdaystat.FieldInfraPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OtherPrs(); ok {
+		_spec.SetField(memberdaystat.FieldOtherPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOtherPrs(); ok {
+		_spec.AddField(memberdaystat.FieldOtherPrs, field.TypeInt, value)
+	}
 	if _u.mutation.SnapshotCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -554,6 +932,300 @@ func (_u *MemberDayStatUpdateOne) AddDeletions(v int) *MemberDayStatUpdateOne {
 	return _u
 }
 
+// SetExcludedReviewCount sets the "excluded_review_count" field.
+func (_u *MemberDayStatUpdateOne) SetExcludedReviewCount(v int) *MemberDayStatUpdateOne {
+	_u.mutation.ResetExcludedReviewCount()
+	_u.mutation.SetExcludedReviewCount(v)
+	return _u
+}
+
+// SetNillableExcludedReviewCount sets the "excluded_review_count" field if the given value is not nil.
+func (_u *MemberDayStatUpdateOne) SetNillableExcludedReviewCount(v *int) *MemberDayStatUpdateOne {
+	if v != nil {
+		_u.SetExcludedReviewCount(*v)
+	}
+	return _u
+}
+
+// AddExcludedReviewCount adds value to the "excluded_review_count" field.
+func (_u *MemberDayStatUpdateOne) AddExcludedReviewCount(v int) *MemberDayStatUpdateOne {
+	_u.mutation.AddExcludedReviewCount(v)
+	return _u
+}
+
+// SetIssuesClosed sets the "issues_closed" field.
+func (_u *MemberDayStatUpdateOne) SetIssuesClosed(v int) *MemberDayStatUpdateOne {
+	_u.mutation.ResetIssuesClosed()
+	_u.mutation.SetIssuesClosed(v)
+	return _u
+}
+
+// SetNillableIssuesClosed sets the "issues_closed" field if the given value is not nil.
+func (_u *MemberDayStatUpdateOne) SetNillableIssuesClosed(v *int) *MemberDayStatUpdateOne {
+	if v != nil {
+		_u.SetIssuesClosed(*v)
+	}
+	return _u
+}
+
+// AddIssuesClosed adds value to the "issues_closed" field.
+func (_u *MemberDayStatUpdateOne) AddIssuesClosed(v int) *MemberDayStatUpdateOne {
+	_u.mutation.AddIssuesClosed(v)
+	return _u
+}
+
+// SetIssueCloseSeconds sets the "issue_close_seconds" field.
+func (_u *MemberDayStatUpdateOne) SetIssueCloseSeconds(v int) *MemberDayStatUpdateOne {
+	_u.mutation.ResetIssueCloseSeconds()
+	_u.mutation.SetIssueCloseSeconds(v)
+	return _u
+}
+
+// SetNillableIssueCloseSeconds sets the "issue_close_seconds" field if the given value is not nil.
+func (_u *MemberDayStatUpdateOne) SetNillableIssueCloseSeconds(v *int) *MemberDayStatUpdateOne {
+	if v != nil {
+		_u.SetIssueCloseSeconds(*v)
+	}
+	return _u
+}
+
+// AddIssueCloseSeconds adds value to the "issue_close_seconds" field.
+func (_u *MemberDayStatUpdateOne) AddIssueCloseSeconds(v int) *MemberDayStatUpdateOne {
+	_u.mutation.AddIssueCloseSeconds(v)
+	return _u
+}
+
+// SetBugIssuesOpened sets the "bug_issues_opened" field.
+func (_u *MemberDayStatUpdateOne) SetBugIssuesOpened(v int) *MemberDayStatUpdateOne {
+	_u.mutation.ResetBugIssuesOpened()
+	_u.mutation.SetBugIssuesOpened(v)
+	return _u
+}
+
+// SetNillableBugIssuesOpened sets the "bug_issues_opened" field if the given value is not nil.
+func (_u *MemberDayStatUpdateOne) SetNillableBugIssuesOpened(v *int) *MemberDayStatUpdateOne {
+	if v != nil {
+		_u.SetBugIssuesOpened(*v)
+	}
+	return _u
+}
+
+// AddBugIssuesOpened adds value to the "bug_issues_opened" field.
+func (_u *MemberDayStatUpdateOne) AddBugIssuesOpened(v int) *MemberDayStatUpdateOne {
+	_u.mutation.AddBugIssuesOpened(v)
+	return _u
+}
+
+// SetFeatureIssuesOpened sets the "feature_issues_opened" field.
+func (_u *MemberDayStatUpdateOne) SetFeatureIssuesOpened(v int) *MemberDayStatUpdateOne {
+	_u.mutation.ResetFeatureIssuesOpened()
+	_u.mutation.SetFeatureIssuesOpened(v)
+	return _u
+}
+
+// SetNillableFeatureIssuesOpened sets the "feature_issues_opened" field if the given value is not nil.
+func (_u *MemberDayStatUpdateOne) SetNillableFeatureIssuesOpened(v *int) *MemberDayStatUpdateOne {
+	if v != nil {
+		_u.SetFeatureIssuesOpened(*v)
+	}
+	return _u
+}
+
+// AddFeatureIssuesOpened adds value to the "feature_issues_opened" field.
+func (_u *MemberDayStatUpdateOne) AddFeatureIssuesOpened(v int) *MemberDayStatUpdateOne {
+	_u.mutation.AddFeatureIssuesOpened(v)
+	return _u
+}
+
+// SetBugIssuesClosed sets the "bug_issues_closed" field.
+func (_u *MemberDayStatUpdateOne) SetBugIssuesClosed(v int) *MemberDayStatUpdateOne {
+	_u.mutation.ResetBugIssuesClosed()
+	_u.mutation.SetBugIssuesClosed(v)
+	return _u
+}
+
+// SetNillableBugIssuesClosed sets the "bug_issues_closed" field if the given value is not nil.
+func (_u *MemberDayStatUpdateOne) SetNillableBugIssuesClosed(v *int) *MemberDayStatUpdateOne {
+	if v != nil {
+		_u.SetBugIssuesClosed(*v)
+	}
+	return _u
+}
+
+// AddBugIssuesClosed adds value to the "bug_issues_closed" field.
+func (_u *MemberDayStatUpdateOne) AddBugIssuesClosed(v int) *MemberDayStatUpdateOne {
+	_u.mutation.AddBugIssuesClosed(v)
+	return _u
+}
+
+// SetFeatureIssuesClosed sets the "feature_issues_closed" field.
+func (_u *MemberDayStatUpdateOne) SetFeatureIssuesClosed(v int) *MemberDayStatUpdateOne {
+	_u.mutation.ResetFeatureIssuesClosed()
+	_u.mutation.SetFeatureIssuesClosed(v)
+	return _u
+}
+
+// SetNillableFeatureIssuesClosed sets the "feature_issues_closed" field if the given value is not nil.
+func (_u *MemberDayStatUpdateOne) SetNillableFeatureIssuesClosed(v *int) *MemberDayStatUpdateOne {
+	if v != nil {
+		_u.SetFeatureIssuesClosed(*v)
+	}
+	return _u
+}
+
+// AddFeatureIssuesClosed adds value to the "feature_issues_closed" field.
+func (_u *MemberDayStatUpdateOne) AddFeatureIssuesClosed(v int) *MemberDayStatUpdateOne {
+	_u.mutation.AddFeatureIssuesClosed(v)
+	return _u
+}
+
+// SetFeaturePrs sets the "feature_prs" field.
+func (_u *MemberDayStatUpdateOne) SetFeaturePrs(v int) *MemberDayStatUpdateOne {
+	_u.mutation.ResetFeaturePrs()
+	_u.mutation.SetFeaturePrs(v)
+	return _u
+}
+
+// SetNillableFeaturePrs sets the "feature_prs" field if the given value is not nil.
+func (_u *MemberDayStatUpdateOne) SetNillableFeaturePrs(v *int) *MemberDayStatUpdateOne {
+	if v != nil {
+		_u.SetFeaturePrs(*v)
+	}
+	return _u
+}
+
+// AddFeaturePrs adds value to the "feature_prs" field.
+func (_u *MemberDayStatUpdateOne) AddFeaturePrs(v int) *MemberDayStatUpdateOne {
+	_u.mutation.AddFeaturePrs(v)
+	return _u
+}
+
+// SetBugPrs sets the "bug_prs" field.
+func (_u *MemberDayStatUpdateOne) SetBugPrs(v int) *MemberDayStatUpdateOne {
+	_u.mutation.ResetBugPrs()
+	_u.mutation.SetBugPrs(v)
+	return _u
+}
+
+// SetNillableBugPrs sets the "bug_prs" field if the given value is not nil.
+func (_u *MemberDayStatUpdateOne) SetNillableBugPrs(v *int) *MemberDayStatUpdateOne {
+	if v != nil {
+		_u.SetBugPrs(*v)
+	}
+	return _u
+}
+
+// AddBugPrs adds value to the "bug_prs" field.
+func (_u *MemberDayStatUpdateOne) AddBugPrs(v int) *MemberDayStatUpdateOne {
+	_u.mutation.AddBugPrs(v)
+	return _u
+}
+
+// SetChorePrs sets the "chore_prs" field.
+func (_u *MemberDayStatUpdateOne) SetChorePrs(v int) *MemberDayStatUpdateOne {
+	_u.mutation.ResetChorePrs()
+	_u.mutation.SetChorePrs(v)
+	return _u
+}
+
+// SetNillableChorePrs sets the "chore_prs" field if the given value is not nil.
+func (_u *MemberDayStatUpdateOne) SetNillableChorePrs(v *int) *MemberDayStatUpdateOne {
+	if v != nil {
+		_u.SetChorePrs(*v)
+	}
+	return _u
+}
+
+// AddChorePrs adds value to the "chore_prs" field.
+func (_u *MemberDayStatUpdateOne) AddChorePrs(v int) *MemberDayStatUpdateOne {
+	_u.mutation.AddChorePrs(v)
+	return _u
+}
+
+// SetDocsPrs sets the "docs_prs" field.
+func (_u *MemberDayStatUpdateOne) SetDocsPrs(v int) *MemberDayStatUpdateOne {
+	_u.mutation.ResetDocsPrs()
+	_u.mutation.SetDocsPrs(v)
+	return _u
+}
+
+// SetNillableDocsPrs sets the "docs_prs" field if the given value is not nil.
+func (_u *MemberDayStatUpdateOne) SetNillableDocsPrs(v *int) *MemberDayStatUpdateOne {
+	if v != nil {
+		_u.SetDocsPrs(*v)
+	}
+	return _u
+}
+
+// AddDocsPrs adds value to the "docs_prs" field.
+func (_u *MemberDayStatUpdateOne) AddDocsPrs(v int) *MemberDayStatUpdateOne {
+	_u.mutation.AddDocsPrs(v)
+	return _u
+}
+
+// SetTestPrs sets the "test_prs" field.
+func (_u *MemberDayStatUpdateOne) SetTestPrs(v int) *MemberDayStatUpdateOne {
+	_u.mutation.ResetTestPrs()
+	_u.mutation.SetTestPrs(v)
+	return _u
+}
+
+// SetNillableTestPrs sets the "test_prs" field if the given value is not nil.
+func (_u *MemberDayStatUpdateOne) SetNillableTestPrs(v *int) *MemberDayStatUpdateOne {
+	if v != nil {
+		_u.SetTestPrs(*v)
+	}
+	return _u
+}
+
+// AddTestPrs adds value to the "test_prs" field.
+func (_u *MemberDayStatUpdateOne) AddTestPrs(v int) *MemberDayStatUpdateOne {
+	_u.mutation.AddTestPrs(v)
+	return _u
+}
+
+// SetInfraPrs sets the "infra_prs" field.
+func (_u *MemberDayStatUpdateOne) SetInfraPrs(v int) *MemberDayStatUpdateOne {
+	_u.mutation.ResetInfraPrs()
+	_u.mutation.SetInfraPrs(v)
+	return _u
+}
+
+// SetNillableInfraPrs sets the "infra_prs" field if the given value is not nil.
+func (_u *MemberDayStatUpdateOne) SetNillableInfraPrs(v *int) *MemberDayStatUpdateOne {
+	if v != nil {
+		_u.SetInfraPrs(*v)
+	}
+	return _u
+}
+
+// AddInfraPrs adds value to the "infra_prs" field.
+func (_u *MemberDayStatUpdateOne) AddInfraPrs(v int) *MemberDayStatUpdateOne {
+	_u.mutation.AddInfraPrs(v)
+	return _u
+}
+
+// SetOtherPrs sets the "other_prs" field.
+func (_u *MemberDayStatUpdateOne) SetOtherPrs(v int) *MemberDayStatUpdateOne {
+	_u.mutation.ResetOtherPrs()
+	_u.mutation.SetOtherPrs(v)
+	return _u
+}
+
+// SetNillableOtherPrs sets the "other_prs" field if the given value is not nil.
+func (_u *MemberDayStatUpdateOne) SetNillableOtherPrs(v *int) *MemberDayStatUpdateOne {
+	if v != nil {
+		_u.SetOtherPrs(*v)
+	}
+	return _u
+}
+
+// AddOtherPrs adds value to the "other_prs" field.
+func (_u *MemberDayStatUpdateOne) AddOtherPrs(v int) *MemberDayStatUpdateOne {
+	_u.mutation.AddOtherPrs(v)
+	return _u
+}
+
 // SetSnapshotID sets the "snapshot" edge to the Snapshot entity by ID.
 func (_u *MemberDayStatUpdateOne) SetSnapshotID(id int) *MemberDayStatUpdateOne {
 	_u.mutation.SetSnapshotID(id)
@@ -711,6 +1383,90 @@ func (_u *MemberDayStatUpdateOne) sqlSave(ctx context.Context) (_node *MemberDay
 	if value, ok := _u.mutation.AddedDeletions(); ok {
 		_spec.AddField(memberdaystat.FieldDeletions, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.ExcludedReviewCount(); ok {
+		_spec.SetField(memberdaystat.FieldExcludedReviewCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedExcludedReviewCount(); ok {
+		_spec.AddField(memberdaystat.FieldExcludedReviewCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IssuesClosed(); ok {
+		_spec.SetField(memberdaystat.FieldIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedIssuesClosed(); ok {
+		_spec.AddField(memberdaystat.FieldIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IssueCloseSeconds(); ok {
+		_spec.SetField(memberdaystat.FieldIssueCloseSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedIssueCloseSeconds(); ok {
+		_spec.AddField(memberdaystat.FieldIssueCloseSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BugIssuesOpened(); ok {
+		_spec.SetField(memberdaystat.FieldBugIssuesOpened, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBugIssuesOpened(); ok {
+		_spec.AddField(memberdaystat.FieldBugIssuesOpened, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FeatureIssuesOpened(); ok {
+		_spec.SetField(memberdaystat.FieldFeatureIssuesOpened, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFeatureIssuesOpened(); ok {
+		_spec.AddField(memberdaystat.FieldFeatureIssuesOpened, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BugIssuesClosed(); ok {
+		_spec.SetField(memberdaystat.FieldBugIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBugIssuesClosed(); ok {
+		_spec.AddField(memberdaystat.FieldBugIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FeatureIssuesClosed(); ok {
+		_spec.SetField(memberdaystat.FieldFeatureIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFeatureIssuesClosed(); ok {
+		_spec.AddField(memberdaystat.FieldFeatureIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FeaturePrs(); ok {
+		_spec.SetField(memberdaystat.FieldFeaturePrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFeaturePrs(); ok {
+		_spec.AddField(memberdaystat.FieldFeaturePrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BugPrs(); ok {
+		_spec.SetField(memberdaystat.FieldBugPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBugPrs(); ok {
+		_spec.AddField(memberdaystat.FieldBugPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ChorePrs(); ok {
+		_spec.SetField(memberdaystat.FieldChorePrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChorePrs(); ok {
+		_spec.AddField(memberdaystat.FieldChorePrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DocsPrs(); ok {
+		_spec.SetField(memberdaystat.FieldDocsPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDocsPrs(); ok {
+		_spec.AddField(memberdaystat.FieldDocsPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TestPrs(); ok {
+		_spec.SetField(memberdaystat.FieldTestPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTestPrs(); ok {
+		_spec.AddField(memberdaystat.FieldTestPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.InfraPrs(); ok {
+		_spec.SetField(memberdaystat.FieldInfraPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInfraPrs(); ok {
+		_spec.AddField(memberdaystat.FieldInfraPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OtherPrs(); ok {
+		_spec.SetField(memberdaystat.FieldOtherPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOtherPrs(); ok {
+		_spec.AddField(memberdaystat.FieldOtherPrs, field.TypeInt, value)
+	}
 	if _u.mutation.SnapshotCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
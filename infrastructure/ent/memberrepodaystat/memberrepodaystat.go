@@ -32,6 +32,32 @@ const (
 	FieldAdditions = "additions"
 	// FieldDeletions holds the string denoting the deletions field in the database.
 	FieldDeletions = "deletions"
+	// FieldIssuesClosed holds the string denoting the issues_closed field in the database.
+	FieldIssuesClosed = "issues_closed"
+	// FieldIssueCloseSeconds holds the string denoting the issue_close_seconds field in the database.
+	FieldIssueCloseSeconds = "issue_close_seconds"
+	// FieldBugIssuesOpened holds the string denoting the bug_issues_opened field in the database.
+	FieldBugIssuesOpened = "bug_issues_opened"
+	// FieldFeatureIssuesOpened holds the string denoting the feature_issues_opened field in the database.
+	FieldFeatureIssuesOpened = "feature_issues_opened"
+	// FieldBugIssuesClosed holds the string denoting the bug_issues_closed field in the database.
+	FieldBugIssuesClosed = "bug_issues_closed"
+	// FieldFeatureIssuesClosed holds the string denoting the feature_issues_closed field in the database.
+	FieldFeatureIssuesClosed = "feature_issues_closed"
+	// FieldFeaturePrs holds the string denoting the feature_prs field in the database.
+	FieldFeaturePrs = "feature_prs"
+	// FieldBugPrs holds the string denoting the bug_prs field in the database.
+	FieldBugPrs = "bug_prs"
+	// FieldChorePrs holds the string denoting the chore_prs field in the database.
+	FieldChorePrs = "chore_prs"
+	// FieldDocsPrs holds the string denoting the docs_prs field in the database.
+	FieldDocsPrs = "docs_prs"
+	// FieldTestPrs holds the string denoting the test_prs field in the database.
+	FieldTestPrs = "test_prs"
+	// FieldInfraPrs holds the string denoting the infra_prs field in the database.
+	FieldInfraPrs = "infra_prs"
+	// FieldOtherPrs holds the string denoting the other_prs field in the database.
+	FieldOtherPrs = "other_prs"
 	// EdgeSnapshot holds the string denoting the snapshot edge name in mutations.
 	EdgeSnapshot = "snapshot"
 	// Table holds the table name of the memberrepodaystat in the database.
@@ -58,6 +84,19 @@ var Columns = []string{
 	FieldReviewCount,
 	FieldAdditions,
 	FieldDeletions,
+	FieldIssuesClosed,
+	FieldIssueCloseSeconds,
+	FieldBugIssuesOpened,
+	FieldFeatureIssuesOpened,
+	FieldBugIssuesClosed,
+	FieldFeatureIssuesClosed,
+	FieldFeaturePrs,
+	FieldBugPrs,
+	FieldChorePrs,
+	FieldDocsPrs,
+	FieldTestPrs,
+	FieldInfraPrs,
+	FieldOtherPrs,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "member_repo_day_stats"
@@ -102,6 +141,32 @@ var (
 	DefaultAdditions int
 	// DefaultDeletions holds the default value on creation for the "deletions" field.
 	DefaultDeletions int
+	// DefaultIssuesClosed holds the default value on creation for the "issues_closed" field.
+	DefaultIssuesClosed int
+	// DefaultIssueCloseSeconds holds the default value on creation for the "issue_close_seconds" field.
+	DefaultIssueCloseSeconds int
+	// DefaultBugIssuesOpened holds the default value on creation for the "bug_issues_opened" field.
+	DefaultBugIssuesOpened int
+	// DefaultFeatureIssuesOpened holds the default value on creation for the "feature_issues_opened" field.
+	DefaultFeatureIssuesOpened int
+	// DefaultBugIssuesClosed holds the default value on creation for the "bug_issues_closed" field.
+	DefaultBugIssuesClosed int
+	// DefaultFeatureIssuesClosed holds the default value on creation for the "feature_issues_closed" field.
+	DefaultFeatureIssuesClosed int
+	// DefaultFeaturePrs holds the default value on creation for the "feature_prs" field.
+	DefaultFeaturePrs int
+	// DefaultBugPrs holds the default value on creation for the "bug_prs" field.
+	DefaultBugPrs int
+	// DefaultChorePrs holds the default value on creation for the "chore_prs" field.
+	DefaultChorePrs int
+	// DefaultDocsPrs holds the default value on creation for the "docs_prs" field.
+	DefaultDocsPrs int
+	// DefaultTestPrs holds the default value on creation for the "test_prs" field.
+	DefaultTestPrs int
+	// DefaultInfraPrs holds the default value on creation for the "infra_prs" field.
+	DefaultInfraPrs int
+	// DefaultOtherPrs holds the default value on creation for the "other_prs" field.
+	DefaultOtherPrs int
 )
 
 // OrderOption defines the ordering options for the MemberRepoDayStat queries.
@@ -162,6 +227,71 @@ func ByDeletions(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDeletions, opts...).ToFunc()
 }
 
+// ByIssuesClosed orders the results by the issues_closed field.
+func ByIssuesClosed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIssuesClosed, opts...).ToFunc()
+}
+
+// ByIssueCloseSeconds orders the results by the issue_close_seconds field.
+func ByIssueCloseSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIssueCloseSeconds, opts...).ToFunc()
+}
+
+// ByBugIssuesOpened orders the results by the bug_issues_opened field.
+func ByBugIssuesOpened(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBugIssuesOpened, opts...).ToFunc()
+}
+
+// ByFeatureIssuesOpened orders the results by the feature_issues_opened field.
+func ByFeatureIssuesOpened(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFeatureIssuesOpened, opts...).ToFunc()
+}
+
+// ByBugIssuesClosed orders the results by the bug_issues_closed field.
+func ByBugIssuesClosed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBugIssuesClosed, opts...).ToFunc()
+}
+
+// ByFeatureIssuesClosed orders the results by the feature_issues_closed field.
+func ByFeatureIssuesClosed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFeatureIssuesClosed, opts...).ToFunc()
+}
+
+// ByFeaturePrs orders the results by the feature_prs field.
+func ByFeaturePrs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFeaturePrs, opts...).ToFunc()
+}
+
+// ByBugPrs orders the results by the bug_prs field.
+func ByBugPrs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBugPrs, opts...).ToFunc()
+}
+
+// ByChorePrs orders the results by the chore_prs field.
+func ByChorePrs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChorePrs, opts...).ToFunc()
+}
+
+// ByDocsPrs orders the results by the docs_prs field.
+func ByDocsPrs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDocsPrs, opts...).ToFunc()
+}
+
+// ByTestPrs orders the results by the test_prs field.
+func ByTestPrs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTestPrs, opts...).ToFunc()
+}
+
+// ByInfraPrs orders the results by the infra_prs field.
+func ByInfraPrs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInfraPrs, opts...).ToFunc()
+}
+
+// ByOtherPrs orders the results by the other_prs field.
+func ByOtherPrs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOtherPrs, opts...).ToFunc()
+}
+
 // BySnapshotField orders the results by snapshot field.
 func BySnapshotField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
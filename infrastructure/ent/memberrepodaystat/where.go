@@ -103,6 +103,71 @@ func Deletions(v int) predicate.MemberRepoDayStat {
 	return predicate.MemberRepoDayStat(sql.FieldEQ(FieldDeletions, v))
 }
 
+// IssuesClosed applies equality check predicate on the "issues_closed" field. It's identical to IssuesClosedEQ.
+func IssuesClosed(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldEQ(FieldIssuesClosed, v))
+}
+
+// IssueCloseSeconds applies equality check predicate on the "issue_close_seconds" field. It's identical to IssueCloseSecondsEQ.
+func IssueCloseSeconds(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldEQ(FieldIssueCloseSeconds, v))
+}
+
+// BugIssuesOpened applies equality check predicate on the "bug_issues_opened" field. It's identical to BugIssuesOpenedEQ.
+func BugIssuesOpened(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldEQ(FieldBugIssuesOpened, v))
+}
+
+// FeatureIssuesOpened applies equality check predicate on the "feature_issues_opened" field. It's identical to FeatureIssuesOpenedEQ.
+func FeatureIssuesOpened(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldEQ(FieldFeatureIssuesOpened, v))
+}
+
+// BugIssuesClosed applies equality check predicate on the "bug_issues_closed" field. It's identical to BugIssuesClosedEQ.
+func BugIssuesClosed(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldEQ(FieldBugIssuesClosed, v))
+}
+
+// FeatureIssuesClosed applies equality check predicate on the "feature_issues_closed" field. It's identical to FeatureIssuesClosedEQ.
+func FeatureIssuesClosed(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldEQ(FieldFeatureIssuesClosed, v))
+}
+
+// FeaturePrs applies equality check predicate on the "feature_prs" field. It's identical to FeaturePrsEQ.
+func FeaturePrs(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldEQ(FieldFeaturePrs, v))
+}
+
+// BugPrs applies equality check predicate on the "bug_prs" field. It's identical to BugPrsEQ.
+func BugPrs(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldEQ(FieldBugPrs, v))
+}
+
+// ChorePrs applies equality check predicate on the "chore_prs" field. It's identical to ChorePrsEQ.
+func ChorePrs(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldEQ(FieldChorePrs, v))
+}
+
+// DocsPrs applies equality check predicate on the "docs_prs" field. It's identical to DocsPrsEQ.
+func DocsPrs(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldEQ(FieldDocsPrs, v))
+}
+
+// TestPrs applies equality check predicate on the "test_prs" field. It's identical to TestPrsEQ.
+func TestPrs(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldEQ(FieldTestPrs, v))
+}
+
+// InfraPrs applies equality check predicate on the "infra_prs" field. It's identical to InfraPrsEQ.
+func InfraPrs(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldEQ(FieldInfraPrs, v))
+}
+
+// OtherPrs applies equality check predicate on the "other_prs" field. It's identical to OtherPrsEQ.
+func OtherPrs(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldEQ(FieldOtherPrs, v))
+}
+
 // LoginEQ applies the EQ predicate on the "login" field.
 func LoginEQ(v string) predicate.MemberRepoDayStat {
 	return predicate.MemberRepoDayStat(sql.FieldEQ(FieldLogin, v))
@@ -578,6 +643,526 @@ func DeletionsLTE(v int) predicate.MemberRepoDayStat {
 	return predicate.MemberRepoDayStat(sql.FieldLTE(FieldDeletions, v))
 }
 
+// IssuesClosedEQ applies the EQ predicate on the "issues_closed" field.
+func IssuesClosedEQ(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldEQ(FieldIssuesClosed, v))
+}
+
+// IssuesClosedNEQ applies the NEQ predicate on the "issues_closed" field.
+func IssuesClosedNEQ(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldNEQ(FieldIssuesClosed, v))
+}
+
+// IssuesClosedIn applies the In predicate on the "issues_closed" field.
+func IssuesClosedIn(vs ...int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldIn(FieldIssuesClosed, vs...))
+}
+
+// IssuesClosedNotIn applies the NotIn predicate on the "issues_closed" field.
+func IssuesClosedNotIn(vs ...int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldNotIn(FieldIssuesClosed, vs...))
+}
+
+// IssuesClosedGT applies the GT predicate on the "issues_closed" field.
+func IssuesClosedGT(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldGT(FieldIssuesClosed, v))
+}
+
+// IssuesClosedGTE applies the GTE predicate on the "issues_closed" field.
+func IssuesClosedGTE(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldGTE(FieldIssuesClosed, v))
+}
+
+// IssuesClosedLT applies the LT predicate on the "issues_closed" field.
+func IssuesClosedLT(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldLT(FieldIssuesClosed, v))
+}
+
+// IssuesClosedLTE applies the LTE predicate on the "issues_closed" field.
+func IssuesClosedLTE(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldLTE(FieldIssuesClosed, v))
+}
+
+// IssueCloseSecondsEQ applies the EQ predicate on the "issue_close_seconds" field.
+func IssueCloseSecondsEQ(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldEQ(FieldIssueCloseSeconds, v))
+}
+
+// IssueCloseSecondsNEQ applies the NEQ predicate on the "issue_close_seconds" field.
+func IssueCloseSecondsNEQ(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldNEQ(FieldIssueCloseSeconds, v))
+}
+
+// IssueCloseSecondsIn applies the In predicate on the "issue_close_seconds" field.
+func IssueCloseSecondsIn(vs ...int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldIn(FieldIssueCloseSeconds, vs...))
+}
+
+// IssueCloseSecondsNotIn applies the NotIn predicate on the "issue_close_seconds" field.
+func IssueCloseSecondsNotIn(vs ...int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldNotIn(FieldIssueCloseSeconds, vs...))
+}
+
+// IssueCloseSecondsGT applies the GT predicate on the "issue_close_seconds" field.
+func IssueCloseSecondsGT(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldGT(FieldIssueCloseSeconds, v))
+}
+
+// IssueCloseSecondsGTE applies the GTE predicate on the "issue_close_seconds" field.
+func IssueCloseSecondsGTE(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldGTE(FieldIssueCloseSeconds, v))
+}
+
+// IssueCloseSecondsLT applies the LT predicate on the "issue_close_seconds" field.
+func IssueCloseSecondsLT(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldLT(FieldIssueCloseSeconds, v))
+}
+
+// IssueCloseSecondsLTE applies the LTE predicate on the "issue_close_seconds" field.
+func IssueCloseSecondsLTE(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldLTE(FieldIssueCloseSeconds, v))
+}
+
+// BugIssuesOpenedEQ applies the EQ predicate on the "bug_issues_opened" field.
+func BugIssuesOpenedEQ(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldEQ(FieldBugIssuesOpened, v))
+}
+
+// BugIssuesOpenedNEQ applies the NEQ predicate on the "bug_issues_opened" field.
+func BugIssuesOpenedNEQ(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldNEQ(FieldBugIssuesOpened, v))
+}
+
+// BugIssuesOpenedIn applies the In predicate on the "bug_issues_opened" field.
+func BugIssuesOpenedIn(vs ...int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldIn(FieldBugIssuesOpened, vs...))
+}
+
+// BugIssuesOpenedNotIn applies the NotIn predicate on the "bug_issues_opened" field.
+func BugIssuesOpenedNotIn(vs ...int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldNotIn(FieldBugIssuesOpened, vs...))
+}
+
+// BugIssuesOpenedGT applies the GT predicate on the "bug_issues_opened" field.
+func BugIssuesOpenedGT(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldGT(FieldBugIssuesOpened, v))
+}
+
+// BugIssuesOpenedGTE applies the GTE predicate on the "bug_issues_opened" field.
+func BugIssuesOpenedGTE(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldGTE(FieldBugIssuesOpened, v))
+}
+
+// BugIssuesOpenedLT applies the LT predicate on the "bug_issues_opened" field.
+func BugIssuesOpenedLT(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldLT(FieldBugIssuesOpened, v))
+}
+
+// BugIssuesOpenedLTE applies the LTE predicate on the "bug_issues_opened" field.
+func BugIssuesOpenedLTE(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldLTE(FieldBugIssuesOpened, v))
+}
+
+// FeatureIssuesOpenedEQ applies the EQ predicate on the "feature_issues_opened" field.
+func FeatureIssuesOpenedEQ(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldEQ(FieldFeatureIssuesOpened, v))
+}
+
+// FeatureIssuesOpenedNEQ applies the NEQ predicate on the "feature_issues_opened" field.
+func FeatureIssuesOpenedNEQ(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldNEQ(FieldFeatureIssuesOpened, v))
+}
+
+// FeatureIssuesOpenedIn applies the In predicate on the "feature_issues_opened" field.
+func FeatureIssuesOpenedIn(vs ...int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldIn(FieldFeatureIssuesOpened, vs...))
+}
+
+// FeatureIssuesOpenedNotIn applies the NotIn predicate on the "feature_issues_opened" field.
+func FeatureIssuesOpenedNotIn(vs ...int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldNotIn(FieldFeatureIssuesOpened, vs...))
+}
+
+// FeatureIssuesOpenedGT applies the GT predicate on the "feature_issues_opened" field.
+func FeatureIssuesOpenedGT(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldGT(FieldFeatureIssuesOpened, v))
+}
+
+// FeatureIssuesOpenedGTE applies the GTE predicate on the "feature_issues_opened" field.
+func FeatureIssuesOpenedGTE(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldGTE(FieldFeatureIssuesOpened, v))
+}
+
+// FeatureIssuesOpenedLT applies the LT predicate on the "feature_issues_opened" field.
+func FeatureIssuesOpenedLT(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldLT(FieldFeatureIssuesOpened, v))
+}
+
+// FeatureIssuesOpenedLTE applies the LTE predicate on the "feature_issues_opened" field.
+func FeatureIssuesOpenedLTE(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldLTE(FieldFeatureIssuesOpened, v))
+}
+
+// BugIssuesClosedEQ applies the EQ predicate on the "bug_issues_closed" field.
+func BugIssuesClosedEQ(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldEQ(FieldBugIssuesClosed, v))
+}
+
+// BugIssuesClosedNEQ applies the NEQ predicate on the "bug_issues_closed" field.
+func BugIssuesClosedNEQ(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldNEQ(FieldBugIssuesClosed, v))
+}
+
+// BugIssuesClosedIn applies the In predicate on the "bug_issues_closed" field.
+func BugIssuesClosedIn(vs ...int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldIn(FieldBugIssuesClosed, vs...))
+}
+
+// BugIssuesClosedNotIn applies the NotIn predicate on the "bug_issues_closed" field.
+func BugIssuesClosedNotIn(vs ...int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldNotIn(FieldBugIssuesClosed, vs...))
+}
+
+// BugIssuesClosedGT applies the GT predicate on the "bug_issues_closed" field.
+func BugIssuesClosedGT(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldGT(FieldBugIssuesClosed, v))
+}
+
+// BugIssuesClosedGTE applies the GTE predicate on the "bug_issues_closed" field.
+func BugIssuesClosedGTE(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldGTE(FieldBugIssuesClosed, v))
+}
+
+// BugIssuesClosedLT applies the LT predicate on the "bug_issues_closed" field.
+func BugIssuesClosedLT(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldLT(FieldBugIssuesClosed, v))
+}
+
+// BugIssuesClosedLTE applies the LTE predicate on the "bug_issues_closed" field.
+func BugIssuesClosedLTE(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldLTE(FieldBugIssuesClosed, v))
+}
+
+// FeatureIssuesClosedEQ applies the EQ predicate on the "feature_issues_closed" field.
+func FeatureIssuesClosedEQ(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldEQ(FieldFeatureIssuesClosed, v))
+}
+
+// FeatureIssuesClosedNEQ applies the NEQ predicate on the "feature_issues_closed" field.
+func FeatureIssuesClosedNEQ(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldNEQ(FieldFeatureIssuesClosed, v))
+}
+
+// FeatureIssuesClosedIn applies the In predicate on the "feature_issues_closed" field.
+func FeatureIssuesClosedIn(vs ...int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldIn(FieldFeatureIssuesClosed, vs...))
+}
+
+// FeatureIssuesClosedNotIn applies the NotIn predicate on the "feature_issues_closed" field.
+func FeatureIssuesClosedNotIn(vs ...int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldNotIn(FieldFeatureIssuesClosed, vs...))
+}
+
+// FeatureIssuesClosedGT applies the GT predicate on the "feature_issues_closed" field.
+func FeatureIssuesClosedGT(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldGT(FieldFeatureIssuesClosed, v))
+}
+
+// FeatureIssuesClosedGTE applies the GTE predicate on the "feature_issues_closed" field.
+func FeatureIssuesClosedGTE(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldGTE(FieldFeatureIssuesClosed, v))
+}
+
+// FeatureIssuesClosedLT applies the LT predicate on the "feature_issues_closed" field.
+func FeatureIssuesClosedLT(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldLT(FieldFeatureIssuesClosed, v))
+}
+
+// FeatureIssuesClosedLTE applies the LTE predicate on the "feature_issues_closed" field.
+func FeatureIssuesClosedLTE(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldLTE(FieldFeatureIssuesClosed, v))
+}
+
+// FeaturePrsEQ applies the EQ predicate on the "feature_prs" field.
+func FeaturePrsEQ(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldEQ(FieldFeaturePrs, v))
+}
+
+// FeaturePrsNEQ applies the NEQ predicate on the "feature_prs" field.
+func FeaturePrsNEQ(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldNEQ(FieldFeaturePrs, v))
+}
+
+// FeaturePrsIn applies the In predicate on the "feature_prs" field.
+func FeaturePrsIn(vs ...int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldIn(FieldFeaturePrs, vs...))
+}
+
+// FeaturePrsNotIn applies the NotIn predicate on the "feature_prs" field.
+func FeaturePrsNotIn(vs ...int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldNotIn(FieldFeaturePrs, vs...))
+}
+
+// FeaturePrsGT applies the GT predicate on the "feature_prs" field.
+func FeaturePrsGT(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldGT(FieldFeaturePrs, v))
+}
+
+// FeaturePrsGTE applies the GTE predicate on the "feature_prs" field.
+func FeaturePrsGTE(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldGTE(FieldFeaturePrs, v))
+}
+
+// FeaturePrsLT applies the LT predicate on the "feature_prs" field.
+func FeaturePrsLT(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldLT(FieldFeaturePrs, v))
+}
+
+// FeaturePrsLTE applies the LTE predicate on the "feature_prs" field.
+func FeaturePrsLTE(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldLTE(FieldFeaturePrs, v))
+}
+
+// BugPrsEQ applies the EQ predicate on the "bug_prs" field.
+func BugPrsEQ(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldEQ(FieldBugPrs, v))
+}
+
+// BugPrsNEQ applies the NEQ predicate on the "bug_prs" field.
+func BugPrsNEQ(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldNEQ(FieldBugPrs, v))
+}
+
+// BugPrsIn applies the In predicate on the "bug_prs" field.
+func BugPrsIn(vs ...int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldIn(FieldBugPrs, vs...))
+}
+
+// BugPrsNotIn applies the NotIn predicate on the "bug_prs" field.
+func BugPrsNotIn(vs ...int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldNotIn(FieldBugPrs, vs...))
+}
+
+// BugPrsGT applies the GT predicate on the "bug_prs" field.
+func BugPrsGT(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldGT(FieldBugPrs, v))
+}
+
+// BugPrsGTE applies the GTE predicate on the "bug_prs" field.
+func BugPrsGTE(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldGTE(FieldBugPrs, v))
+}
+
+// BugPrsLT applies the LT predicate on the "bug_prs" field.
+func BugPrsLT(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldLT(FieldBugPrs, v))
+}
+
+// BugPrsLTE applies the LTE predicate on the "bug_prs" field.
+func BugPrsLTE(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldLTE(FieldBugPrs, v))
+}
+
+// ChorePrsEQ applies the EQ predicate on the "chore_prs" field.
+func ChorePrsEQ(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldEQ(FieldChorePrs, v))
+}
+
+// ChorePrsNEQ applies the NEQ predicate on the "chore_prs" field.
+func ChorePrsNEQ(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldNEQ(FieldChorePrs, v))
+}
+
+// ChorePrsIn applies the In predicate on the "chore_prs" field.
+func ChorePrsIn(vs ...int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldIn(FieldChorePrs, vs...))
+}
+
+// ChorePrsNotIn applies the NotIn predicate on the "chore_prs" field.
+func ChorePrsNotIn(vs ...int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldNotIn(FieldChorePrs, vs...))
+}
+
+// ChorePrsGT applies the GT predicate on the "chore_prs" field.
+func ChorePrsGT(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldGT(FieldChorePrs, v))
+}
+
+// ChorePrsGTE applies the GTE predicate on the "chore_prs" field.
+func ChorePrsGTE(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldGTE(FieldChorePrs, v))
+}
+
+// ChorePrsLT applies the LT predicate on the "chore_prs" field.
+func ChorePrsLT(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldLT(FieldChorePrs, v))
+}
+
+// ChorePrsLTE applies the LTE predicate on the "chore_prs" field.
+func ChorePrsLTE(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldLTE(FieldChorePrs, v))
+}
+
+// DocsPrsEQ applies the EQ predicate on the "docs_prs" field.
+func DocsPrsEQ(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldEQ(FieldDocsPrs, v))
+}
+
+// DocsPrsNEQ applies the NEQ predicate on the "docs_prs" field.
+func DocsPrsNEQ(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldNEQ(FieldDocsPrs, v))
+}
+
+// DocsPrsIn applies the In predicate on the "docs_prs" field.
+func DocsPrsIn(vs ...int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldIn(FieldDocsPrs, vs...))
+}
+
+// DocsPrsNotIn applies the NotIn predicate on the "docs_prs" field.
+func DocsPrsNotIn(vs ...int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldNotIn(FieldDocsPrs, vs...))
+}
+
+// DocsPrsGT applies the GT predicate on the "docs_prs" field.
+func DocsPrsGT(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldGT(FieldDocsPrs, v))
+}
+
+// DocsPrsGTE applies the GTE predicate on the "docs_prs" field.
+func DocsPrsGTE(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldGTE(FieldDocsPrs, v))
+}
+
+// DocsPrsLT applies the LT predicate on the "docs_prs" field.
+func DocsPrsLT(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldLT(FieldDocsPrs, v))
+}
+
+// DocsPrsLTE applies the LTE predicate on the "docs_prs" field.
+func DocsPrsLTE(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldLTE(FieldDocsPrs, v))
+}
+
+// TestPrsEQ applies the EQ predicate on the "test_prs" field.
+func TestPrsEQ(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldEQ(FieldTestPrs, v))
+}
+
+// TestPrsNEQ applies the NEQ predicate on the "test_prs" field.
+func TestPrsNEQ(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldNEQ(FieldTestPrs, v))
+}
+
+// TestPrsIn applies the In predicate on the "test_prs" field.
+func TestPrsIn(vs ...int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldIn(FieldTestPrs, vs...))
+}
+
+// TestPrsNotIn applies the NotIn predicate on the "test_prs" field.
+func TestPrsNotIn(vs ...int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldNotIn(FieldTestPrs, vs...))
+}
+
+// TestPrsGT applies the GT predicate on the "test_prs" field.
+func TestPrsGT(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldGT(FieldTestPrs, v))
+}
+
+// TestPrsGTE applies the GTE predicate on the "test_prs" field.
+func TestPrsGTE(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldGTE(FieldTestPrs, v))
+}
+
+// TestPrsLT applies the LT predicate on the "test_prs" field.
+func TestPrsLT(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldLT(FieldTestPrs, v))
+}
+
+// TestPrsLTE applies the LTE predicate on the "test_prs" field.
+func TestPrsLTE(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldLTE(FieldTestPrs, v))
+}
+
+// InfraPrsEQ applies the EQ predicate on the "infra_prs" field.
+func InfraPrsEQ(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldEQ(FieldInfraPrs, v))
+}
+
+// InfraPrsNEQ applies the NEQ predicate on the "infra_prs" field.
+func InfraPrsNEQ(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldNEQ(FieldInfraPrs, v))
+}
+
+// InfraPrsIn applies the In predicate on the "infra_prs" field.
+func InfraPrsIn(vs ...int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldIn(FieldInfraPrs, vs...))
+}
+
+// InfraPrsNotIn applies the NotIn predicate on the "infra_prs" field.
+func InfraPrsNotIn(vs ...int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldNotIn(FieldInfraPrs, vs...))
+}
+
+// InfraPrsGT applies the GT predicate on the "infra_prs" field.
+func InfraPrsGT(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldGT(FieldInfraPrs, v))
+}
+
+// InfraPrsGTE applies the GTE predicate on the "infra_prs" field.
+func InfraPrsGTE(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldGTE(FieldInfraPrs, v))
+}
+
+// InfraPrsLT applies the LT predicate on the "infra_prs" field.
+func InfraPrsLT(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldLT(FieldInfraPrs, v))
+}
+
+// InfraPrsLTE applies the LTE predicate on the "infra_prs" field.
+func InfraPrsLTE(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldLTE(FieldInfraPrs, v))
+}
+
+// OtherPrsEQ applies the EQ predicate on the "other_prs" field.
+func OtherPrsEQ(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldEQ(FieldOtherPrs, v))
+}
+
+// OtherPrsNEQ applies the NEQ predicate on the "other_prs" field.
+func OtherPrsNEQ(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldNEQ(FieldOtherPrs, v))
+}
+
+// OtherPrsIn applies the In predicate on the "other_prs" field.
+func OtherPrsIn(vs ...int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldIn(FieldOtherPrs, vs...))
+}
+
+// OtherPrsNotIn applies the NotIn predicate on the "other_prs" field.
+func OtherPrsNotIn(vs ...int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldNotIn(FieldOtherPrs, vs...))
+}
+
+// OtherPrsGT applies the GT predicate on the "other_prs" field.
+func OtherPrsGT(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldGT(FieldOtherPrs, v))
+}
+
+// OtherPrsGTE applies the GTE predicate on the "other_prs" field.
+func OtherPrsGTE(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldGTE(FieldOtherPrs, v))
+}
+
+// OtherPrsLT applies the LT predicate on the "other_prs" field.
+func OtherPrsLT(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldLT(FieldOtherPrs, v))
+}
+
+// OtherPrsLTE applies the LTE predicate on the "other_prs" field.
+func OtherPrsLTE(v int) predicate.MemberRepoDayStat {
+	return predicate.MemberRepoDayStat(sql.FieldLTE(FieldOtherPrs, v))
+}
+
 // HasSnapshot applies the HasEdge predicate on the "snapshot" edge.
 func HasSnapshot() predicate.MemberRepoDayStat {
 	return predicate.MemberRepoDayStat(func(s *sql.Selector) {
@@ -136,6 +136,188 @@ func (_c *MemberRepoDayStatCreate) SetNillableDeletions(v *int) *MemberRepoDaySt
 	return _c
 }
 
+// SetIssuesClosed sets the "issues_closed" field.
+func (_c *MemberRepoDayStatCreate) SetIssuesClosed(v int) *MemberRepoDayStatCreate {
+	_c.mutation.SetIssuesClosed(v)
+	return _c
+}
+
+// SetNillableIssuesClosed sets the "issues_closed" field if the given value is not nil.
+func (_c *MemberRepoDayStatCreate) SetNillableIssuesClosed(v *int) *MemberRepoDayStatCreate {
+	if v != nil {
+		_c.SetIssuesClosed(*v)
+	}
+	return _c
+}
+
+// SetIssueCloseSeconds sets the "issue_close_seconds" field.
+func (_c *MemberRepoDayStatCreate) SetIssueCloseSeconds(v int) *MemberRepoDayStatCreate {
+	_c.mutation.SetIssueCloseSeconds(v)
+	return _c
+}
+
+// SetNillableIssueCloseSeconds sets the "issue_close_seconds" field if the given value is not nil.
+func (_c *MemberRepoDayStatCreate) SetNillableIssueCloseSeconds(v *int) *MemberRepoDayStatCreate {
+	if v != nil {
+		_c.SetIssueCloseSeconds(*v)
+	}
+	return _c
+}
+
+// SetBugIssuesOpened sets the "bug_issues_opened" field.
+func (_c *MemberRepoDayStatCreate) SetBugIssuesOpened(v int) *MemberRepoDayStatCreate {
+	_c.mutation.SetBugIssuesOpened(v)
+	return _c
+}
+
+// SetNillableBugIssuesOpened sets the "bug_issues_opened" field if the given value is not nil.
+func (_c *MemberRepoDayStatCreate) SetNillableBugIssuesOpened(v *int) *MemberRepoDayStatCreate {
+	if v != nil {
+		_c.SetBugIssuesOpened(*v)
+	}
+	return _c
+}
+
+// SetFeatureIssuesOpened sets the "feature_issues_opened" field.
+func (_c *MemberRepoDayStatCreate) SetFeatureIssuesOpened(v int) *MemberRepoDayStatCreate {
+	_c.mutation.SetFeatureIssuesOpened(v)
+	return _c
+}
+
+// SetNillableFeatureIssuesOpened sets the "feature_issues_opened" field if the given value is not nil.
+func (_c *MemberRepoDayStatCreate) SetNillableFeatureIssuesOpened(v *int) *MemberRepoDayStatCreate {
+	if v != nil {
+		_c.SetFeatureIssuesOpened(*v)
+	}
+	return _c
+}
+
+// SetBugIssuesClosed sets the "bug_issues_closed" field.
+func (_c *MemberRepoDayStatCreate) SetBugIssuesClosed(v int) *MemberRepoDayStatCreate {
+	_c.mutation.SetBugIssuesClosed(v)
+	return _c
+}
+
+// SetNillableBugIssuesClosed sets the "bug_issues_closed" field if the given value is not nil.
+func (_c *MemberRepoDayStatCreate) SetNillableBugIssuesClosed(v *int) *MemberRepoDayStatCreate {
+	if v != nil {
+		_c.SetBugIssuesClosed(*v)
+	}
+	return _c
+}
+
+// SetFeatureIssuesClosed sets the "feature_issues_closed" field.
+func (_c *MemberRepoDayStatCreate) SetFeatureIssuesClosed(v int) *MemberRepoDayStatCreate {
+	_c.mutation.SetFeatureIssuesClosed(v)
+	return _c
+}
+
+// SetNillableFeatureIssuesClosed sets the "feature_issues_closed" field if the given value is not nil.
+func (_c *MemberRepoDayStatCreate) SetNillableFeatureIssuesClosed(v *int) *MemberRepoDayStatCreate {
+	if v != nil {
+		_c.SetFeatureIssuesClosed(*v)
+	}
+	return _c
+}
+
+// SetFeaturePrs sets the "feature_prs" field.
+func (_c *MemberRepoDayStatCreate) SetFeaturePrs(v int) *MemberRepoDayStatCreate {
+	_c.mutation.SetFeaturePrs(v)
+	return _c
+}
+
+// SetNillableFeaturePrs sets the "feature_prs" field if the given value is not nil.
+func (_c *MemberRepoDayStatCreate) SetNillableFeaturePrs(v *int) *MemberRepoDayStatCreate {
+	if v != nil {
+		_c.SetFeaturePrs(*v)
+	}
+	return _c
+}
+
+// SetBugPrs sets the "bug_prs" field.
+func (_c *MemberRepoDayStatCreate) SetBugPrs(v int) *MemberRepoDayStatCreate {
+	_c.mutation.SetBugPrs(v)
+	return _c
+}
+
+// SetNillableBugPrs sets the "bug_prs" field if the given value is not nil.
+func (_c *MemberRepoDayStatCreate) SetNillableBugPrs(v *int) *MemberRepoDayStatCreate {
+	if v != nil {
+		_c.SetBugPrs(*v)
+	}
+	return _c
+}
+
+// SetChorePrs sets the "chore_prs" field.
+func (_c *MemberRepoDayStatCreate) SetChorePrs(v int) *MemberRepoDayStatCreate {
+	_c.mutation.SetChorePrs(v)
+	return _c
+}
+
+// SetNillableChorePrs sets the "chore_prs" field if the given value is not nil.
+func (_c *MemberRepoDayStatCreate) SetNillableChorePrs(v *int) *MemberRepoDayStatCreate {
+	if v != nil {
+		_c.SetChorePrs(*v)
+	}
+	return _c
+}
+
+// SetDocsPrs sets the "docs_prs" field.
+func (_c *MemberRepoDayStatCreate) SetDocsPrs(v int) *MemberRepoDayStatCreate {
+	_c.mutation.SetDocsPrs(v)
+	return _c
+}
+
+// SetNillableDocsPrs sets the "docs_prs" field if the given value is not nil.
+func (_c *MemberRepoDayStatCreate) SetNillableDocsPrs(v *int) *MemberRepoDayStatCreate {
+	if v != nil {
+		_c.SetDocsPrs(*v)
+	}
+	return _c
+}
+
+// SetTestPrs sets the "test_prs" field.
+func (_c *MemberRepoDayStatCreate) SetTestPrs(v int) *MemberRepoDayStatCreate {
+	_c.mutation.SetTestPrs(v)
+	return _c
+}
+
+// SetNillableTestPrs sets the "test_prs" field if the given value is not nil.
+func (_c *MemberRepoDayStatCreate) SetNillableTestPrs(v *int) *MemberRepoDayStatCreate {
+	if v != nil {
+		_c.SetTestPrs(*v)
+	}
+	return _c
+}
+
+// SetInfraPrs sets the "infra_prs" field.
+func (_c *MemberRepoDayStatCreate) SetInfraPrs(v int) *MemberRepoDayStatCreate {
+	_c.mutation.SetInfraPrs(v)
+	return _c
+}
+
+// SetNillableInfraPrs sets the "infra_prs" field if the given value is not nil.
+func (_c *MemberRepoDayStatCreate) SetNillableInfraPrs(v *int) *MemberRepoDayStatCreate {
+	if v != nil {
+		_c.SetInfraPrs(*v)
+	}
+	return _c
+}
+
+// SetOtherPrs sets the "other_prs" field.
+func (_c *MemberRepoDayStatCreate) SetOtherPrs(v int) *MemberRepoDayStatCreate {
+	_c.mutation.SetOtherPrs(v)
+	return _c
+}
+
+// SetNillableOtherPrs sets the "other_prs" field if the given value is not nil.
+func (_c *MemberRepoDayStatCreate) SetNillableOtherPrs(v *int) *MemberRepoDayStatCreate {
+	if v != nil {
+		_c.SetOtherPrs(*v)
+	}
+	return _c
+}
+
 // SetSnapshotID sets the "snapshot" edge to the Snapshot entity by ID.
 func (_c *MemberRepoDayStatCreate) SetSnapshotID(id int) *MemberRepoDayStatCreate {
 	_c.mutation.SetSnapshotID(id)
@@ -210,6 +392,58 @@ func (_c *MemberRepoDayStatCreate) defaults() {
 		v := memberrepodaystat.DefaultDeletions
 		_c.mutation.SetDeletions(v)
 	}
+	if _, ok := _c.mutation.IssuesClosed(); !ok {
+		v := memberrepodaystat.DefaultIssuesClosed
+		_c.mutation.SetIssuesClosed(v)
+	}
+	if _, ok := _c.mutation.IssueCloseSeconds(); !ok {
+		v := memberrepodaystat.DefaultIssueCloseSeconds
+		_c.mutation.SetIssueCloseSeconds(v)
+	}
+	if _, ok := _c.mutation.BugIssuesOpened(); !ok {
+		v := memberrepodaystat.DefaultBugIssuesOpened
+		_c.mutation.SetBugIssuesOpened(v)
+	}
+	if _, ok := _c.mutation.FeatureIssuesOpened(); !ok {
+		v := memberrepodaystat.DefaultFeatureIssuesOpened
+		_c.mutation.SetFeatureIssuesOpened(v)
+	}
+	if _, ok := _c.mutation.BugIssuesClosed(); !ok {
+		v := memberrepodaystat.DefaultBugIssuesClosed
+		_c.mutation.SetBugIssuesClosed(v)
+	}
+	if _, ok := _c.mutation.FeatureIssuesClosed(); !ok {
+		v := memberrepodaystat.DefaultFeatureIssuesClosed
+		_c.mutation.SetFeatureIssuesClosed(v)
+	}
+	if _, ok := _c.mutation.FeaturePrs(); !ok {
+		v := memberrepodaystat.DefaultFeaturePrs
+		_c.mutation.SetFeaturePrs(v)
+	}
+	if _, ok := _c.mutation.BugPrs(); !ok {
+		v := memberrepodaystat.DefaultBugPrs
+		_c.mutation.SetBugPrs(v)
+	}
+	if _, ok := _c.mutation.ChorePrs(); !ok {
+		v := memberrepodaystat.DefaultChorePrs
+		_c.mutation.SetChorePrs(v)
+	}
+	if _, ok := _c.mutation.DocsPrs(); !ok {
+		v := memberrepodaystat.DefaultDocsPrs
+		_c.mutation.SetDocsPrs(v)
+	}
+	if _, ok := _c.mutation.TestPrs(); !ok {
+		v := memberrepodaystat.DefaultTestPrs
+		_c.mutation.SetTestPrs(v)
+	}
+	if _, ok := _c.mutation.InfraPrs(); !ok {
+		v := memberrepodaystat.DefaultInfraPrs
+		_c.mutation.SetInfraPrs(v)
+	}
+	if _, ok := _c.mutation.OtherPrs(); !ok {
+		v := memberrepodaystat.DefaultOtherPrs
+		_c.mutation.SetOtherPrs(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -259,6 +493,45 @@ func (_c *MemberRepoDayStatCreate) check() error {
 	if _, ok := _c.mutation.Deletions(); !ok {
 		return &ValidationError{Name: "deletions", err: errors.New(`ent: missing required field "MemberRepoDayStat.deletions"`)}
 	}
+	if _, ok := _c.mutation.IssuesClosed(); !ok {
+		return &ValidationError{Name: "issues_closed", err: errors.New(`ent: missing required field "MemberRepoDayStat.issues_closed"`)}
+	}
+	if _, ok := _c.mutation.IssueCloseSeconds(); !ok {
+		return &ValidationError{Name: "issue_close_seconds", err: errors.New(`ent: missing required field "MemberRepoDayStat.issue_close_seconds"`)}
+	}
+	if _, ok := _c.mutation.BugIssuesOpened(); !ok {
+		return &ValidationError{Name: "bug_issues_opened", err: errors.New(`ent: missing required field "MemberRepoDayStat.bug_issues_opened"`)}
+	}
+	if _, ok := _c.mutation.FeatureIssuesOpened(); !ok {
+		return &ValidationError{Name: "feature_issues_opened", err: errors.New(`ent: missing required field "MemberRepoDayStat.feature_issues_opened"`)}
+	}
+	if _, ok := _c.mutation.BugIssuesClosed(); !ok {
+		return &ValidationError{Name: "bug_issues_closed", err: errors.New(`ent: missing required field "MemberRepoDayStat.bug_issues_closed"`)}
+	}
+	if _, ok := _c.mutation.FeatureIssuesClosed(); !ok {
+		return &ValidationError{Name: "feature_issues_closed", err: errors.New(`ent: missing required field "MemberRepoDayStat.feature_issues_closed"`)}
+	}
+	if _, ok := _c.mutation.FeaturePrs(); !ok {
+		return &ValidationError{Name: "feature_prs", err: errors.New(`ent: missing required field "MemberRepoDayStat.feature_prs"`)}
+	}
+	if _, ok := _c.mutation.BugPrs(); !ok {
+		return &ValidationError{Name: "bug_prs", err: errors.New(`ent: missing required field "MemberRepoDayStat.bug_prs"`)}
+	}
+	if _, ok := _c.mutation.ChorePrs(); !ok {
+		return &ValidationError{Name: "chore_prs", err: errors.New(`ent: missing required field "MemberRepoDayStat.chore_prs"`)}
+	}
+	if _, ok := _c.mutation.DocsPrs(); !ok {
+		return &ValidationError{Name: "docs_prs", err: errors.New(`ent: missing required field "MemberRepoDayStat.docs_prs"`)}
+	}
+	if _, ok := _c.mutation.TestPrs(); !ok {
+		return &ValidationError{Name: "test_prs", err: errors.New(`ent: missing required field "MemberRepoDayStat.test_prs"`)}
+	}
+	if _, ok := _c.mutation.InfraPrs(); !ok {
+		return &ValidationError{Name: "infra_prs", err: errors.New(`ent: missing required field "MemberRepoDayStat.infra_prs"`)}
+	}
+	if _, ok := _c.mutation.OtherPrs(); !ok {
+		return &ValidationError{Name: "other_prs", err: errors.New(`ent: missing required field "MemberRepoDayStat.other_prs"`)}
+	}
 	if len(_c.mutation.SnapshotIDs()) == 0 {
 		return &ValidationError{Name: "snapshot", err: errors.New(`ent: missing required edge "MemberRepoDayStat.snapshot"`)}
 	}
@@ -328,6 +601,58 @@ func (_c *MemberRepoDayStatCreate) createSpec() (*MemberRepoDayStat, *sqlgraph.C
 		_spec.SetField(memberrepodaystat.FieldDeletions, field.TypeInt, value)
 		_node.Deletions = value
 	}
+	if value, ok := _c.mutation.IssuesClosed(); ok {
+		_spec.SetField(memberrepodaystat.FieldIssuesClosed, field.TypeInt, value)
+		_node.IssuesClosed = value
+	}
+	if value, ok := _c.mutation.IssueCloseSeconds(); ok {
+		_spec.SetField(memberrepodaystat.FieldIssueCloseSeconds, field.TypeInt, value)
+		_node.IssueCloseSeconds = value
+	}
+	if value, ok := _c.mutation.BugIssuesOpened(); ok {
+		_spec.SetField(memberrepodaystat.FieldBugIssuesOpened, field.TypeInt, value)
+		_node.BugIssuesOpened = value
+	}
+	if value, ok := _c.mutation.FeatureIssuesOpened(); ok {
+		_spec.SetField(memberrepodaystat.FieldFeatureIssuesOpened, field.TypeInt, value)
+		_node.FeatureIssuesOpened = value
+	}
+	if value, ok := _c.mutation.BugIssuesClosed(); ok {
+		_spec.SetField(memberrepodaystat.FieldBugIssuesClosed, field.TypeInt, value)
+		_node.BugIssuesClosed = value
+	}
+	if value, ok := _c.mutation.FeatureIssuesClosed(); ok {
+		_spec.SetField(memberrepodaystat.FieldFeatureIssuesClosed, field.TypeInt, value)
+		_node.FeatureIssuesClosed = value
+	}
+	if value, ok := _c.mutation.FeaturePrs(); ok {
+		_spec.SetField(memberrepodaystat.FieldFeaturePrs, field.TypeInt, value)
+		_node.FeaturePrs = value
+	}
+	if value, ok := _c.mutation.BugPrs(); ok {
+		_spec.SetField(memberrepodaystat.FieldBugPrs, field.TypeInt, value)
+		_node.BugPrs = value
+	}
+	if value, ok := _c.mutation.ChorePrs(); ok {
+		_spec.SetField(memberrepodaystat.FieldChorePrs, field.TypeInt, value)
+		_node.ChorePrs = value
+	}
+	if value, ok := _c.mutation.DocsPrs(); ok {
+		_spec.SetField(memberrepodaystat.FieldDocsPrs, field.TypeInt, value)
+		_node.DocsPrs = value
+	}
+	if value, ok := _c.mutation.TestPrs(); ok {
+		_spec.SetField(memberrepodaystat.FieldTestPrs, field.TypeInt, value)
+		_node.TestPrs = value
+	}
+	if value, ok := _c.mutation.InfraPrs(); ok {
+		_spec.SetField(memberrepodaystat.FieldInfraPrs, field.TypeInt, value)
+		_node.InfraPrs = value
+	}
+	if value, ok := _c.mutation.OtherPrs(); ok {
+		_spec.SetField(memberrepodaystat.FieldOtherPrs, field.TypeInt, value)
+		_node.OtherPrs = value
+	}
 	if nodes := _c.mutation.SnapshotIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
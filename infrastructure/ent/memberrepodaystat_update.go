@@ -217,6 +217,279 @@ func (_u *MemberRepoDayStatUpdate) AddDeletions(v int) *MemberRepoDayStatUpdate
 	return _u
 }
 
+// SetIssuesClosed sets the "issues_closed" field.
+func (_u *MemberRepoDayStatUpdate) SetIssuesClosed(v int) *MemberRepoDayStatUpdate {
+	_u.mutation.ResetIssuesClosed()
+	_u.mutation.SetIssuesClosed(v)
+	return _u
+}
+
+// SetNillableIssuesClosed sets the "issues_closed" field if the given value is not nil.
+func (_u *MemberRepoDayStatUpdate) SetNillableIssuesClosed(v *int) *MemberRepoDayStatUpdate {
+	if v != nil {
+		_u.SetIssuesClosed(*v)
+	}
+	return _u
+}
+
+// AddIssuesClosed adds value to the "issues_closed" field.
+func (_u *MemberRepoDayStatUpdate) AddIssuesClosed(v int) *MemberRepoDayStatUpdate {
+	_u.mutation.AddIssuesClosed(v)
+	return _u
+}
+
+// SetIssueCloseSeconds sets the "issue_close_seconds" field.
+func (_u *MemberRepoDayStatUpdate) SetIssueCloseSeconds(v int) *MemberRepoDayStatUpdate {
+	_u.mutation.ResetIssueCloseSeconds()
+	_u.mutation.SetIssueCloseSeconds(v)
+	return _u
+}
+
+// SetNillableIssueCloseSeconds sets the "issue_close_seconds" field if the given value is not nil.
+func (_u *MemberRepoDayStatUpdate) SetNillableIssueCloseSeconds(v *int) *MemberRepoDayStatUpdate {
+	if v != nil {
+		_u.SetIssueCloseSeconds(*v)
+	}
+	return _u
+}
+
+// AddIssueCloseSeconds adds value to the "issue_close_seconds" field.
+func (_u *MemberRepoDayStatUpdate) AddIssueCloseSeconds(v int) *MemberRepoDayStatUpdate {
+	_u.mutation.AddIssueCloseSeconds(v)
+	return _u
+}
+
+// SetBugIssuesOpened sets the "bug_issues_opened" field.
+func (_u *MemberRepoDayStatUpdate) SetBugIssuesOpened(v int) *MemberRepoDayStatUpdate {
+	_u.mutation.ResetBugIssuesOpened()
+	_u.mutation.SetBugIssuesOpened(v)
+	return _u
+}
+
+// SetNillableBugIssuesOpened sets the "bug_issues_opened" field if the given value is not nil.
+func (_u *MemberRepoDayStatUpdate) SetNillableBugIssuesOpened(v *int) *MemberRepoDayStatUpdate {
+	if v != nil {
+		_u.SetBugIssuesOpened(*v)
+	}
+	return _u
+}
+
+// AddBugIssuesOpened adds value to the "bug_issues_opened" field.
+func (_u *MemberRepoDayStatUpdate) AddBugIssuesOpened(v int) *MemberRepoDayStatUpdate {
+	_u.mutation.AddBugIssuesOpened(v)
+	return _u
+}
+
+// SetFeatureIssuesOpened sets the "feature_issues_opened" field.
+func (_u *MemberRepoDayStatUpdate) SetFeatureIssuesOpened(v int) *MemberRepoDayStatUpdate {
+	_u.mutation.ResetFeatureIssuesOpened()
+	_u.mutation.SetFeatureIssuesOpened(v)
+	return _u
+}
+
+// SetNillableFeatureIssuesOpened sets the "feature_issues_opened" field if the given value is not nil.
+func (_u *MemberRepoDayStatUpdate) SetNillableFeatureIssuesOpened(v *int) *MemberRepoDayStatUpdate {
+	if v != nil {
+		_u.SetFeatureIssuesOpened(*v)
+	}
+	return _u
+}
+
+// AddFeatureIssuesOpened adds value to the "feature_issues_opened" field.
+func (_u *MemberRepoDayStatUpdate) AddFeatureIssuesOpened(v int) *MemberRepoDayStatUpdate {
+	_u.mutation.AddFeatureIssuesOpened(v)
+	return _u
+}
+
+// SetBugIssuesClosed sets the "bug_issues_closed" field.
+func (_u *MemberRepoDayStatUpdate) SetBugIssuesClosed(v int) *MemberRepoDayStatUpdate {
+	_u.mutation.ResetBugIssuesClosed()
+	_u.mutation.SetBugIssuesClosed(v)
+	return _u
+}
+
+// SetNillableBugIssuesClosed sets the "bug_issues_closed" field if the given value is not nil.
+func (_u *MemberRepoDayStatUpdate) SetNillableBugIssuesClosed(v *int) *MemberRepoDayStatUpdate {
+	if v != nil {
+		_u.SetBugIssuesClosed(*v)
+	}
+	return _u
+}
+
+// AddBugIssuesClosed adds value to the "bug_issues_closed" field.
+func (_u *MemberRepoDayStatUpdate) AddBugIssuesClosed(v int) *MemberRepoDayStatUpdate {
+	_u.mutation.AddBugIssuesClosed(v)
+	return _u
+}
+
+// SetFeatureIssuesClosed sets the "feature_issues_closed" field.
+func (_u *MemberRepoDayStatUpdate) SetFeatureIssuesClosed(v int) *MemberRepoDayStatUpdate {
+	_u.mutation.ResetFeatureIssuesClosed()
+	_u.mutation.SetFeatureIssuesClosed(v)
+	return _u
+}
+
+// SetNillableFeatureIssuesClosed sets the "feature_issues_closed" field if the given value is not nil.
+func (_u *MemberRepoDayStatUpdate) SetNillableFeatureIssuesClosed(v *int) *MemberRepoDayStatUpdate {
+	if v != nil {
+		_u.SetFeatureIssuesClosed(*v)
+	}
+	return _u
+}
+
+// AddFeatureIssuesClosed adds value to the "feature_issues_closed" field.
+func (_u *MemberRepoDayStatUpdate) AddFeatureIssuesClosed(v int) *MemberRepoDayStatUpdate {
+	_u.mutation.AddFeatureIssuesClosed(v)
+	return _u
+}
+
+// SetFeaturePrs sets the "feature_prs" field.
+func (_u *MemberRepoDayStatUpdate) SetFeaturePrs(v int) *MemberRepoDayStatUpdate {
+	_u.mutation.ResetFeaturePrs()
+	_u.mutation.SetFeaturePrs(v)
+	return _u
+}
+
+// SetNillableFeaturePrs sets the "feature_prs" field if the given value is not nil.
+func (_u *MemberRepoDayStatUpdate) SetNillableFeaturePrs(v *int) *MemberRepoDayStatUpdate {
+	if v != nil {
+		_u.SetFeaturePrs(*v)
+	}
+	return _u
+}
+
+// AddFeaturePrs adds value to the "feature_prs" field.
+func (_u *MemberRepoDayStatUpdate) AddFeaturePrs(v int) *MemberRepoDayStatUpdate {
+	_u.mutation.AddFeaturePrs(v)
+	return _u
+}
+
+// SetBugPrs sets the "bug_prs" field.
+func (_u *MemberRepoDayStatUpdate) SetBugPrs(v int) *MemberRepoDayStatUpdate {
+	_u.mutation.ResetBugPrs()
+	_u.mutation.SetBugPrs(v)
+	return _u
+}
+
+// SetNillableBugPrs sets the "bug_prs" field if the given value is not nil.
+func (_u *MemberRepoDayStatUpdate) SetNillableBugPrs(v *int) *MemberRepoDayStatUpdate {
+	if v != nil {
+		_u.SetBugPrs(*v)
+	}
+	return _u
+}
+
+// AddBugPrs adds value to the "bug_prs" field.
+func (_u *MemberRepoDayStatUpdate) AddBugPrs(v int) *MemberRepoDayStatUpdate {
+	_u.mutation.AddBugPrs(v)
+	return _u
+}
+
+// SetChorePrs sets the "chore_prs" field.
+func (_u *MemberRepoDayStatUpdate) SetChorePrs(v int) *MemberRepoDayStatUpdate {
+	_u.mutation.ResetChorePrs()
+	_u.mutation.SetChorePrs(v)
+	return _u
+}
+
+// SetNillableChorePrs sets the "chore_prs" field if the given value is not nil.
+func (_u *MemberRepoDayStatUpdate) SetNillableChorePrs(v *int) *MemberRepoDayStatUpdate {
+	if v != nil {
+		_u.SetChorePrs(*v)
+	}
+	return _u
+}
+
+// AddChorePrs adds value to the "chore_prs" field.
+func (_u *MemberRepoDayStatUpdate) AddChorePrs(v int) *MemberRepoDayStatUpdate {
+	_u.mutation.AddChorePrs(v)
+	return _u
+}
+
+// SetDocsPrs sets the "docs_prs" field.
+func (_u *MemberRepoDayStatUpdate) SetDocsPrs(v int) *MemberRepoDayStatUpdate {
+	_u.mutation.ResetDocsPrs()
+	_u.mutation.SetDocsPrs(v)
+	return _u
+}
+
+// SetNillableDocsPrs sets the "docs_prs" field if the given value is not nil.
+func (_u *MemberRepoDayStatUpdate) SetNillableDocsPrs(v *int) *MemberRepoDayStatUpdate {
+	if v != nil {
+		_u.SetDocsPrs(*v)
+	}
+	return _u
+}
+
+// AddDocsPrs adds value to the "docs_prs" field.
+func (_u *MemberRepoDayStatUpdate) AddDocsPrs(v int) *MemberRepoDayStatUpdate {
+	_u.mutation.AddDocsPrs(v)
+	return _u
+}
+
+// SetTestPrs sets the "test_prs" field.
+func (_u *MemberRepoDayStatUpdate) SetTestPrs(v int) *MemberRepoDayStatUpdate {
+	_u.mutation.ResetTestPrs()
+	_u.mutation.SetTestPrs(v)
+	return _u
+}
+
+// SetNillableTestPrs sets the "test_prs" field if the given value is not nil.
+func (_u *MemberRepoDayStatUpdate) SetNillableTestPrs(v *int) *MemberRepoDayStatUpdate {
+	if v != nil {
+		_u.SetTestPrs(*v)
+	}
+	return _u
+}
+
+// AddTestPrs adds value to the "test_prs" field.
+func (_u *MemberRepoDayStatUpdate) AddTestPrs(v int) *MemberRepoDayStatUpdate {
+	_u.mutation.AddTestPrs(v)
+	return _u
+}
+
+// SetInfraPrs sets the "infra_prs" field.
+func (_u *MemberRepoDayStatUpdate) SetInfraPrs(v int) *MemberRepoDayStatUpdate {
+	_u.mutation.ResetInfraPrs()
+	_u.mutation.SetInfraPrs(v)
+	return _u
+}
+
+// SetNillableInfraPrs sets the "infra_prs" field if the given value is not nil.
+func (_u *MemberRepoDayStatUpdate) SetNillableInfraPrs(v *int) *MemberRepoDayStatUpdate {
+	if v != nil {
+		_u.SetInfraPrs(*v)
+	}
+	return _u
+}
+
+// AddInfraPrs adds value to the "infra_prs" field.
+func (_u *MemberRepoDayStatUpdate) AddInfraPrs(v int) *MemberRepoDayStatUpdate {
+	_u.mutation.AddInfraPrs(v)
+	return _u
+}
+
+// SetOtherPrs sets the "other_prs" field.
+func (_u *MemberRepoDayStatUpdate) SetOtherPrs(v int) *MemberRepoDayStatUpdate {
+	_u.mutation.ResetOtherPrs()
+	_u.mutation.SetOtherPrs(v)
+	return _u
+}
+
+// SetNillableOtherPrs sets the "other_prs" field if the given value is not nil.
+func (_u *MemberRepoDayStatUpdate) SetNillableOtherPrs(v *int) *MemberRepoDayStatUpdate {
+	if v != nil {
+		_u.SetOtherPrs(*v)
+	}
+	return _u
+}
+
+// AddOtherPrs adds value to the "other_prs" field.
+func (_u *MemberRepoDayStatUpdate) AddOtherPrs(v int) *MemberRepoDayStatUpdate {
+	_u.mutation.AddOtherPrs(v)
+	return _u
+}
+
 // SetSnapshotID sets the "snapshot" edge to the Snapshot entity by ID.
 func (_u *MemberRepoDayStatUpdate) SetSnapshotID(id int) *MemberRepoDayStatUpdate {
 	_u.mutation.SetSnapshotID(id)
@@ -352,6 +625,84 @@ func (_u *MemberRepoDayStatUpdate) sqlSave(ctx context.Context) (_node int, err
 	if value, ok := _u.mutation.AddedDeletions(); ok {
 		_spec.AddField(memberrepodaystat.FieldDeletions, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.IssuesClosed(); ok {
+		_spec.SetField(memberrepodaystat.FieldIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedIssuesClosed(); ok {
+		_spec.AddField(memberrepodaystat.FieldIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IssueCloseSeconds(); ok {
+		_spec.SetField(memberrepodaystat.FieldIssueCloseSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedIssueCloseSeconds(); ok {
+		_spec.AddField(memberrepodaystat.FieldIssueCloseSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BugIssuesOpened(); ok {
+		_spec.SetField(memberrepodaystat.FieldBugIssuesOpened, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBugIssuesOpened(); ok {
+		_spec.AddField(memberrepodaystat.FieldBugIssuesOpened, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FeatureIssuesOpened(); ok {
+		_spec.SetField(memberrepodaystat.FieldFeatureIssuesOpened, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFeatureIssuesOpened(); ok {
+		_spec.AddField(memberrepodaystat.FieldFeatureIssuesOpened, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BugIssuesClosed(); ok {
+		_spec.SetField(memberrepodaystat.FieldBugIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBugIssuesClosed(); ok {
+		_spec.AddField(memberrepodaystat.FieldBugIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FeatureIssuesClosed(); ok {
+		_spec.SetField(memberrepodaystat.FieldFeatureIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFeatureIssuesClosed(); ok {
+		_spec.AddField(memberrepodaystat.FieldFeatureIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FeaturePrs(); ok {
+		_spec.SetField(memberrepodaystat.FieldFeaturePrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFeaturePrs(); ok {
+		_spec.AddField(memberrepodaystat.FieldFeaturePrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BugPrs(); ok {
+		_spec.SetField(memberrepodaystat.FieldBugPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBugPrs(); ok {
+		_spec.AddField(memberrepodaystat.FieldBugPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ChorePrs(); ok {
+		_spec.SetField(memberrepodaystat.FieldChorePrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChorePrs(); ok {
+		_spec.AddField(memberrepodaystat.FieldChorePrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DocsPrs(); ok {
+		_spec.SetField(memberrepodaystat.FieldDocsPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDocsPrs(); ok {
+		_spec.AddField(memberrepodaystat.FieldDocsPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TestPrs(); ok {
+		_spec.SetField(memberrepodaystat.FieldTestPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTestPrs(); ok {
+		_spec.AddField(memberrepodaystat.FieldTestPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.InfraPrs(); ok {
+		_spec.SetField(memberrepodaystat.FieldInfraPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInfraPrs(); ok {
+		_spec.AddField(memberrepodaystat.FieldInfraPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OtherPrs(); ok {
+		_spec.SetField(memberrepodaystat.FieldOtherPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOtherPrs(); ok {
+		_spec.AddField(memberrepodaystat.FieldOtherPrs, field.TypeInt, value)
+	}
 	if _u.mutation.SnapshotCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -590,6 +941,279 @@ func (_u *MemberRepoDayStatUpdateOne) AddDeletions(v int) *MemberRepoDayStatUpda
 	return _u
 }
 
+// SetIssuesClosed sets the "issues_closed" field.
+func (_u *MemberRepoDayStatUpdateOne) SetIssuesClosed(v int) *MemberRepoDayStatUpdateOne {
+	_u.mutation.ResetIssuesClosed()
+	_u.mutation.SetIssuesClosed(v)
+	return _u
+}
+
+// SetNillableIssuesClosed sets the "issues_closed" field if the given value is not nil.
+func (_u *MemberRepoDayStatUpdateOne) SetNillableIssuesClosed(v *int) *MemberRepoDayStatUpdateOne {
+	if v != nil {
+		_u.SetIssuesClosed(*v)
+	}
+	return _u
+}
+
+// AddIssuesClosed adds value to the "issues_closed" field.
+func (_u *MemberRepoDayStatUpdateOne) AddIssuesClosed(v int) *MemberRepoDayStatUpdateOne {
+	_u.mutation.AddIssuesClosed(v)
+	return _u
+}
+
+// SetIssueCloseSeconds sets the "issue_close_seconds" field.
+func (_u *MemberRepoDayStatUpdateOne) SetIssueCloseSeconds(v int) *MemberRepoDayStatUpdateOne {
+	_u.mutation.ResetIssueCloseSeconds()
+	_u.mutation.SetIssueCloseSeconds(v)
+	return _u
+}
+
+// SetNillableIssueCloseSeconds sets the "issue_close_seconds" field if the given value is not nil.
+func (_u *MemberRepoDayStatUpdateOne) SetNillableIssueCloseSeconds(v *int) *MemberRepoDayStatUpdateOne {
+	if v != nil {
+		_u.SetIssueCloseSeconds(*v)
+	}
+	return _u
+}
+
+// AddIssueCloseSeconds adds value to the "issue_close_seconds" field.
+func (_u *MemberRepoDayStatUpdateOne) AddIssueCloseSeconds(v int) *MemberRepoDayStatUpdateOne {
+	_u.mutation.AddIssueCloseSeconds(v)
+	return _u
+}
+
+// SetBugIssuesOpened sets the "bug_issues_opened" field.
+func (_u *MemberRepoDayStatUpdateOne) SetBugIssuesOpened(v int) *MemberRepoDayStatUpdateOne {
+	_u.mutation.ResetBugIssuesOpened()
+	_u.mutation.SetBugIssuesOpened(v)
+	return _u
+}
+
+// SetNillableBugIssuesOpened sets the "bug_issues_opened" field if the given value is not nil.
+func (_u *MemberRepoDayStatUpdateOne) SetNillableBugIssuesOpened(v *int) *MemberRepoDayStatUpdateOne {
+	if v != nil {
+		_u.SetBugIssuesOpened(*v)
+	}
+	return _u
+}
+
+// AddBugIssuesOpened adds value to the "bug_issues_opened" field.
+func (_u *MemberRepoDayStatUpdateOne) AddBugIssuesOpened(v int) *MemberRepoDayStatUpdateOne {
+	_u.mutation.AddBugIssuesOpened(v)
+	return _u
+}
+
+// SetFeatureIssuesOpened sets the "feature_issues_opened" field.
+func (_u *MemberRepoDayStatUpdateOne) SetFeatureIssuesOpened(v int) *MemberRepoDayStatUpdateOne {
+	_u.mutation.ResetFeatureIssuesOpened()
+	_u.mutation.SetFeatureIssuesOpened(v)
+	return _u
+}
+
+// SetNillableFeatureIssuesOpened sets the "feature_issues_opened" field if the given value is not nil.
+func (_u *MemberRepoDayStatUpdateOne) SetNillableFeatureIssuesOpened(v *int) *MemberRepoDayStatUpdateOne {
+	if v != nil {
+		_u.SetFeatureIssuesOpened(*v)
+	}
+	return _u
+}
+
+// AddFeatureIssuesOpened adds value to the "feature_issues_opened" field.
+func (_u *MemberRepoDayStatUpdateOne) AddFeatureIssuesOpened(v int) *MemberRepoDayStatUpdateOne {
+	_u.mutation.AddFeatureIssuesOpened(v)
+	return _u
+}
+
+// SetBugIssuesClosed sets the "bug_issues_closed" field.
+func (_u *MemberRepoDayStatUpdateOne) SetBugIssuesClosed(v int) *MemberRepoDayStatUpdateOne {
+	_u.mutation.ResetBugIssuesClosed()
+	_u.mutation.SetBugIssuesClosed(v)
+	return _u
+}
+
+// SetNillableBugIssuesClosed sets the "bug_issues_closed" field if the given value is not nil.
+func (_u *MemberRepoDayStatUpdateOne) SetNillableBugIssuesClosed(v *int) *MemberRepoDayStatUpdateOne {
+	if v != nil {
+		_u.SetBugIssuesClosed(*v)
+	}
+	return _u
+}
+
+// AddBugIssuesClosed adds value to the "bug_issues_closed" field.
+func (_u *MemberRepoDayStatUpdateOne) AddBugIssuesClosed(v int) *MemberRepoDayStatUpdateOne {
+	_u.mutation.AddBugIssuesClosed(v)
+	return _u
+}
+
+// SetFeatureIssuesClosed sets the "feature_issues_closed" field.
+func (_u *MemberRepoDayStatUpdateOne) SetFeatureIssuesClosed(v int) *MemberRepoDayStatUpdateOne {
+	_u.mutation.ResetFeatureIssuesClosed()
+	_u.mutation.SetFeatureIssuesClosed(v)
+	return _u
+}
+
+// SetNillableFeatureIssuesClosed sets the "feature_issues_closed" field if the given value is not nil.
+func (_u *MemberRepoDayStatUpdateOne) SetNillableFeatureIssuesClosed(v *int) *MemberRepoDayStatUpdateOne {
+	if v != nil {
+		_u.SetFeatureIssuesClosed(*v)
+	}
+	return _u
+}
+
+// AddFeatureIssuesClosed adds value to the "feature_issues_closed" field.
+func (_u *MemberRepoDayStatUpdateOne) AddFeatureIssuesClosed(v int) *MemberRepoDayStatUpdateOne {
+	_u.mutation.AddFeatureIssuesClosed(v)
+	return _u
+}
+
+// SetFeaturePrs sets the "feature_prs" field.
+func (_u *MemberRepoDayStatUpdateOne) SetFeaturePrs(v int) *MemberRepoDayStatUpdateOne {
+	_u.mutation.ResetFeaturePrs()
+	_u.mutation.SetFeaturePrs(v)
+	return _u
+}
+
+// SetNillableFeaturePrs sets the "feature_prs" field if the given value is not nil.
+func (_u *MemberRepoDayStatUpdateOne) SetNillableFeaturePrs(v *int) *MemberRepoDayStatUpdateOne {
+	if v != nil {
+		_u.SetFeaturePrs(*v)
+	}
+	return _u
+}
+
+// AddFeaturePrs adds value to the "feature_prs" field.
+func (_u *MemberRepoDayStatUpdateOne) AddFeaturePrs(v int) *MemberRepoDayStatUpdateOne {
+	_u.mutation.AddFeaturePrs(v)
+	return _u
+}
+
+// SetBugPrs sets the "bug_prs" field.
+func (_u *MemberRepoDayStatUpdateOne) SetBugPrs(v int) *MemberRepoDayStatUpdateOne {
+	_u.mutation.ResetBugPrs()
+	_u.mutation.SetBugPrs(v)
+	return _u
+}
+
+// SetNillableBugPrs sets the "bug_prs" field if the given value is not nil.
+func (_u *MemberRepoDayStatUpdateOne) SetNillableBugPrs(v *int) *MemberRepoDayStatUpdateOne {
+	if v != nil {
+		_u.SetBugPrs(*v)
+	}
+	return _u
+}
+
+// AddBugPrs adds value to the "bug_prs" field.
+func (_u *MemberRepoDayStatUpdateOne) AddBugPrs(v int) *MemberRepoDayStatUpdateOne {
+	_u.mutation.AddBugPrs(v)
+	return _u
+}
+
+// SetChorePrs sets the "chore_prs" field.
+func (_u *MemberRepoDayStatUpdateOne) SetChorePrs(v int) *MemberRepoDayStatUpdateOne {
+	_u.mutation.ResetChorePrs()
+	_u.mutation.SetChorePrs(v)
+	return _u
+}
+
+// SetNillableChorePrs sets the "chore_prs" field if the given value is not nil.
+func (_u *MemberRepoDayStatUpdateOne) SetNillableChorePrs(v *int) *MemberRepoDayStatUpdateOne {
+	if v != nil {
+		_u.SetChorePrs(*v)
+	}
+	return _u
+}
+
+// AddChorePrs adds value to the "chore_prs" field.
+func (_u *MemberRepoDayStatUpdateOne) AddChorePrs(v int) *MemberRepoDayStatUpdateOne {
+	_u.mutation.AddChorePrs(v)
+	return _u
+}
+
+// SetDocsPrs sets the "docs_prs" field.
+func (_u *MemberRepoDayStatUpdateOne) SetDocsPrs(v int) *MemberRepoDayStatUpdateOne {
+	_u.mutation.ResetDocsPrs()
+	_u.mutation.SetDocsPrs(v)
+	return _u
+}
+
+// SetNillableDocsPrs sets the "docs_prs" field if the given value is not nil.
+func (_u *MemberRepoDayStatUpdateOne) SetNillableDocsPrs(v *int) *MemberRepoDayStatUpdateOne {
+	if v != nil {
+		_u.SetDocsPrs(*v)
+	}
+	return _u
+}
+
+// AddDocsPrs adds value to the "docs_prs" field.
+func (_u *MemberRepoDayStatUpdateOne) AddDocsPrs(v int) *MemberRepoDayStatUpdateOne {
+	_u.mutation.AddDocsPrs(v)
+	return _u
+}
+
+// SetTestPrs sets the "test_prs" field.
+func (_u *MemberRepoDayStatUpdateOne) SetTestPrs(v int) *MemberRepoDayStatUpdateOne {
+	_u.mutation.ResetTestPrs()
+	_u.mutation.SetTestPrs(v)
+	return _u
+}
+
+// SetNillableTestPrs sets the "test_prs" field if the given value is not nil.
+func (_u *MemberRepoDayStatUpdateOne) SetNillableTestPrs(v *int) *MemberRepoDayStatUpdateOne {
+	if v != nil {
+		_u.SetTestPrs(*v)
+	}
+	return _u
+}
+
+// AddTestPrs adds value to the "test_prs" field.
+func (_u *MemberRepoDayStatUpdateOne) AddTestPrs(v int) *MemberRepoDayStatUpdateOne {
+	_u.mutation.AddTestPrs(v)
+	return _u
+}
+
+// SetInfraPrs sets the "infra_prs" field.
+func (_u *MemberRepoDayStatUpdateOne) SetInfraPrs(v int) *MemberRepoDayStatUpdateOne {
+	_u.mutation.ResetInfraPrs()
+	_u.mutation.SetInfraPrs(v)
+	return _u
+}
+
+// SetNillableInfraPrs sets the "infra_prs" field if the given value is not nil.
+func (_u *MemberRepoDayStatUpdateOne) SetNillableInfraPrs(v *int) *MemberRepoDayStatUpdateOne {
+	if v != nil {
+		_u.SetInfraPrs(*v)
+	}
+	return _u
+}
+
+// AddInfraPrs adds value to the "infra_prs" field.
+func (_u *MemberRepoDayStatUpdateOne) AddInfraPrs(v int) *MemberRepoDayStatUpdateOne {
+	_u.mutation.AddInfraPrs(v)
+	return _u
+}
+
+// SetOtherPrs sets the "other_prs" field.
+func (_u *MemberRepoDayStatUpdateOne) SetOtherPrs(v int) *MemberRepoDayStatUpdateOne {
+	_u.mutation.ResetOtherPrs()
+	_u.mutation.SetOtherPrs(v)
+	return _u
+}
+
+// SetNillableOtherPrs sets the "other_prs" field if the given value is not nil.
+func (_u *MemberRepoDayStatUpdateOne) SetNillableOtherPrs(v *int) *MemberRepoDayStatUpdateOne {
+	if v != nil {
+		_u.SetOtherPrs(*v)
+	}
+	return _u
+}
+
+// AddOtherPrs adds value to the "other_prs" field.
+func (_u *MemberRepoDayStatUpdateOne) AddOtherPrs(v int) *MemberRepoDayStatUpdateOne {
+	_u.mutation.AddOtherPrs(v)
+	return _u
+}
+
 // SetSnapshotID sets the "snapshot" edge to the Snapshot entity by ID.
 func (_u *MemberRepoDayStatUpdateOne) SetSnapshotID(id int) *MemberRepoDayStatUpdateOne {
 	_u.mutation.SetSnapshotID(id)
@@ -755,6 +1379,84 @@ func (_u *MemberRepoDayStatUpdateOne) sqlSave(ctx context.Context) (_node *Membe
 	if value, ok := _u.mutation.AddedDeletions(); ok {
 		_spec.AddField(memberrepodaystat.FieldDeletions, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.IssuesClosed(); ok {
+		_spec.SetField(memberrepodaystat.FieldIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedIssuesClosed(); ok {
+		_spec.AddField(memberrepodaystat.FieldIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IssueCloseSeconds(); ok {
+		_spec.SetField(memberrepodaystat.FieldIssueCloseSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedIssueCloseSeconds(); ok {
+		_spec.AddField(memberrepodaystat.FieldIssueCloseSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BugIssuesOpened(); ok {
+		_spec.SetField(memberrepodaystat.FieldBugIssuesOpened, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBugIssuesOpened(); ok {
+		_spec.AddField(memberrepodaystat.FieldBugIssuesOpened, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FeatureIssuesOpened(); ok {
+		_spec.SetField(memberrepodaystat.FieldFeatureIssuesOpened, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFeatureIssuesOpened(); ok {
+		_spec.AddField(memberrepodaystat.FieldFeatureIssuesOpened, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BugIssuesClosed(); ok {
+		_spec.SetField(memberrepodaystat.FieldBugIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBugIssuesClosed(); ok {
+		_spec.AddField(memberrepodaystat.FieldBugIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FeatureIssuesClosed(); ok {
+		_spec.SetField(memberrepodaystat.FieldFeatureIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFeatureIssuesClosed(); ok {
+		_spec.AddField(memberrepodaystat.FieldFeatureIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FeaturePrs(); ok {
+		_spec.SetField(memberrepodaystat.FieldFeaturePrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFeaturePrs(); ok {
+		_spec.AddField(memberrepodaystat.FieldFeaturePrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BugPrs(); ok {
+		_spec.SetField(memberrepodaystat.FieldBugPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBugPrs(); ok {
+		_spec.AddField(memberrepodaystat.FieldBugPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ChorePrs(); ok {
+		_spec.SetField(memberrepodaystat.FieldChorePrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChorePrs(); ok {
+		_spec.AddField(memberrepodaystat.FieldChorePrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DocsPrs(); ok {
+		_spec.SetField(memberrepodaystat.FieldDocsPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDocsPrs(); ok {
+		_spec.AddField(memberrepodaystat.FieldDocsPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TestPrs(); ok {
+		_spec.SetField(memberrepodaystat.FieldTestPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTestPrs(); ok {
+		_spec.AddField(memberrepodaystat.FieldTestPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.InfraPrs(); ok {
+		_spec.SetField(memberrepodaystat.FieldInfraPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInfraPrs(); ok {
+		_spec.AddField(memberrepodaystat.FieldInfraPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OtherPrs(); ok {
+		_spec.SetField(memberrepodaystat.FieldOtherPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOtherPrs(); ok {
+		_spec.AddField(memberrepodaystat.FieldOtherPrs, field.TypeInt, value)
+	}
 	if _u.mutation.SnapshotCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
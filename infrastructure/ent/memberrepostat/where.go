@@ -98,6 +98,71 @@ func Deletions(v int) predicate.MemberRepoStat {
 	return predicate.MemberRepoStat(sql.FieldEQ(FieldDeletions, v))
 }
 
+// IssuesClosed applies equality check predicate on the "issues_closed" field. It's identical to IssuesClosedEQ.
+func IssuesClosed(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldEQ(FieldIssuesClosed, v))
+}
+
+// IssueCloseSeconds applies equality check predicate on the "issue_close_seconds" field. It's identical to IssueCloseSecondsEQ.
+func IssueCloseSeconds(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldEQ(FieldIssueCloseSeconds, v))
+}
+
+// BugIssuesOpened applies equality check predicate on the "bug_issues_opened" field. It's identical to BugIssuesOpenedEQ.
+func BugIssuesOpened(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldEQ(FieldBugIssuesOpened, v))
+}
+
+// FeatureIssuesOpened applies equality check predicate on the "feature_issues_opened" field. It's identical to FeatureIssuesOpenedEQ.
+func FeatureIssuesOpened(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldEQ(FieldFeatureIssuesOpened, v))
+}
+
+// BugIssuesClosed applies equality check predicate on the "bug_issues_closed" field. It's identical to BugIssuesClosedEQ.
+func BugIssuesClosed(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldEQ(FieldBugIssuesClosed, v))
+}
+
+// FeatureIssuesClosed applies equality check predicate on the "feature_issues_closed" field. It's identical to FeatureIssuesClosedEQ.
+func FeatureIssuesClosed(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldEQ(FieldFeatureIssuesClosed, v))
+}
+
+// FeaturePrs applies equality check predicate on the "feature_prs" field. It's identical to FeaturePrsEQ.
+func FeaturePrs(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldEQ(FieldFeaturePrs, v))
+}
+
+// BugPrs applies equality check predicate on the "bug_prs" field. It's identical to BugPrsEQ.
+func BugPrs(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldEQ(FieldBugPrs, v))
+}
+
+// ChorePrs applies equality check predicate on the "chore_prs" field. It's identical to ChorePrsEQ.
+func ChorePrs(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldEQ(FieldChorePrs, v))
+}
+
+// DocsPrs applies equality check predicate on the "docs_prs" field. It's identical to DocsPrsEQ.
+func DocsPrs(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldEQ(FieldDocsPrs, v))
+}
+
+// TestPrs applies equality check predicate on the "test_prs" field. It's identical to TestPrsEQ.
+func TestPrs(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldEQ(FieldTestPrs, v))
+}
+
+// InfraPrs applies equality check predicate on the "infra_prs" field. It's identical to InfraPrsEQ.
+func InfraPrs(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldEQ(FieldInfraPrs, v))
+}
+
+// OtherPrs applies equality check predicate on the "other_prs" field. It's identical to OtherPrsEQ.
+func OtherPrs(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldEQ(FieldOtherPrs, v))
+}
+
 // LoginEQ applies the EQ predicate on the "login" field.
 func LoginEQ(v string) predicate.MemberRepoStat {
 	return predicate.MemberRepoStat(sql.FieldEQ(FieldLogin, v))
@@ -508,6 +573,526 @@ func DeletionsLTE(v int) predicate.MemberRepoStat {
 	return predicate.MemberRepoStat(sql.FieldLTE(FieldDeletions, v))
 }
 
+// IssuesClosedEQ applies the EQ predicate on the "issues_closed" field.
+func IssuesClosedEQ(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldEQ(FieldIssuesClosed, v))
+}
+
+// IssuesClosedNEQ applies the NEQ predicate on the "issues_closed" field.
+func IssuesClosedNEQ(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldNEQ(FieldIssuesClosed, v))
+}
+
+// IssuesClosedIn applies the In predicate on the "issues_closed" field.
+func IssuesClosedIn(vs ...int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldIn(FieldIssuesClosed, vs...))
+}
+
+// IssuesClosedNotIn applies the NotIn predicate on the "issues_closed" field.
+func IssuesClosedNotIn(vs ...int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldNotIn(FieldIssuesClosed, vs...))
+}
+
+// IssuesClosedGT applies the GT predicate on the "issues_closed" field.
+func IssuesClosedGT(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldGT(FieldIssuesClosed, v))
+}
+
+// IssuesClosedGTE applies the GTE predicate on the "issues_closed" field.
+func IssuesClosedGTE(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldGTE(FieldIssuesClosed, v))
+}
+
+// IssuesClosedLT applies the LT predicate on the "issues_closed" field.
+func IssuesClosedLT(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldLT(FieldIssuesClosed, v))
+}
+
+// IssuesClosedLTE applies the LTE predicate on the "issues_closed" field.
+func IssuesClosedLTE(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldLTE(FieldIssuesClosed, v))
+}
+
+// IssueCloseSecondsEQ applies the EQ predicate on the "issue_close_seconds" field.
+func IssueCloseSecondsEQ(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldEQ(FieldIssueCloseSeconds, v))
+}
+
+// IssueCloseSecondsNEQ applies the NEQ predicate on the "issue_close_seconds" field.
+func IssueCloseSecondsNEQ(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldNEQ(FieldIssueCloseSeconds, v))
+}
+
+// IssueCloseSecondsIn applies the In predicate on the "issue_close_seconds" field.
+func IssueCloseSecondsIn(vs ...int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldIn(FieldIssueCloseSeconds, vs...))
+}
+
+// IssueCloseSecondsNotIn applies the NotIn predicate on the "issue_close_seconds" field.
+func IssueCloseSecondsNotIn(vs ...int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldNotIn(FieldIssueCloseSeconds, vs...))
+}
+
+// IssueCloseSecondsGT applies the GT predicate on the "issue_close_seconds" field.
+func IssueCloseSecondsGT(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldGT(FieldIssueCloseSeconds, v))
+}
+
+// IssueCloseSecondsGTE applies the GTE predicate on the "issue_close_seconds" field.
+func IssueCloseSecondsGTE(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldGTE(FieldIssueCloseSeconds, v))
+}
+
+// IssueCloseSecondsLT applies the LT predicate on the "issue_close_seconds" field.
+func IssueCloseSecondsLT(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldLT(FieldIssueCloseSeconds, v))
+}
+
+// IssueCloseSecondsLTE applies the LTE predicate on the "issue_close_seconds" field.
+func IssueCloseSecondsLTE(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldLTE(FieldIssueCloseSeconds, v))
+}
+
+// BugIssuesOpenedEQ applies the EQ predicate on the "bug_issues_opened" field.
+func BugIssuesOpenedEQ(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldEQ(FieldBugIssuesOpened, v))
+}
+
+// BugIssuesOpenedNEQ applies the NEQ predicate on the "bug_issues_opened" field.
+func BugIssuesOpenedNEQ(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldNEQ(FieldBugIssuesOpened, v))
+}
+
+// BugIssuesOpenedIn applies the In predicate on the "bug_issues_opened" field.
+func BugIssuesOpenedIn(vs ...int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldIn(FieldBugIssuesOpened, vs...))
+}
+
+// BugIssuesOpenedNotIn applies the NotIn predicate on the "bug_issues_opened" field.
+func BugIssuesOpenedNotIn(vs ...int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldNotIn(FieldBugIssuesOpened, vs...))
+}
+
+// BugIssuesOpenedGT applies the GT predicate on the "bug_issues_opened" field.
+func BugIssuesOpenedGT(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldGT(FieldBugIssuesOpened, v))
+}
+
+// BugIssuesOpenedGTE applies the GTE predicate on the "bug_issues_opened" field.
+func BugIssuesOpenedGTE(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldGTE(FieldBugIssuesOpened, v))
+}
+
+// BugIssuesOpenedLT applies the LT predicate on the "bug_issues_opened" field.
+func BugIssuesOpenedLT(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldLT(FieldBugIssuesOpened, v))
+}
+
+// BugIssuesOpenedLTE applies the LTE predicate on the "bug_issues_opened" field.
+func BugIssuesOpenedLTE(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldLTE(FieldBugIssuesOpened, v))
+}
+
+// FeatureIssuesOpenedEQ applies the EQ predicate on the "feature_issues_opened" field.
+func FeatureIssuesOpenedEQ(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldEQ(FieldFeatureIssuesOpened, v))
+}
+
+// FeatureIssuesOpenedNEQ applies the NEQ predicate on the "feature_issues_opened" field.
+func FeatureIssuesOpenedNEQ(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldNEQ(FieldFeatureIssuesOpened, v))
+}
+
+// FeatureIssuesOpenedIn applies the In predicate on the "feature_issues_opened" field.
+func FeatureIssuesOpenedIn(vs ...int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldIn(FieldFeatureIssuesOpened, vs...))
+}
+
+// FeatureIssuesOpenedNotIn applies the NotIn predicate on the "feature_issues_opened" field.
+func FeatureIssuesOpenedNotIn(vs ...int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldNotIn(FieldFeatureIssuesOpened, vs...))
+}
+
+// FeatureIssuesOpenedGT applies the GT predicate on the "feature_issues_opened" field.
+func FeatureIssuesOpenedGT(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldGT(FieldFeatureIssuesOpened, v))
+}
+
+// FeatureIssuesOpenedGTE applies the GTE predicate on the "feature_issues_opened" field.
+func FeatureIssuesOpenedGTE(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldGTE(FieldFeatureIssuesOpened, v))
+}
+
+// FeatureIssuesOpenedLT applies the LT predicate on the "feature_issues_opened" field.
+func FeatureIssuesOpenedLT(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldLT(FieldFeatureIssuesOpened, v))
+}
+
+// FeatureIssuesOpenedLTE applies the LTE predicate on the "feature_issues_opened" field.
+func FeatureIssuesOpenedLTE(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldLTE(FieldFeatureIssuesOpened, v))
+}
+
+// BugIssuesClosedEQ applies the EQ predicate on the "bug_issues_closed" field.
+func BugIssuesClosedEQ(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldEQ(FieldBugIssuesClosed, v))
+}
+
+// BugIssuesClosedNEQ applies the NEQ predicate on the "bug_issues_closed" field.
+func BugIssuesClosedNEQ(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldNEQ(FieldBugIssuesClosed, v))
+}
+
+// BugIssuesClosedIn applies the In predicate on the "bug_issues_closed" field.
+func BugIssuesClosedIn(vs ...int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldIn(FieldBugIssuesClosed, vs...))
+}
+
+// BugIssuesClosedNotIn applies the NotIn predicate on the "bug_issues_closed" field.
+func BugIssuesClosedNotIn(vs ...int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldNotIn(FieldBugIssuesClosed, vs...))
+}
+
+// BugIssuesClosedGT applies the GT predicate on the "bug_issues_closed" field.
+func BugIssuesClosedGT(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldGT(FieldBugIssuesClosed, v))
+}
+
+// BugIssuesClosedGTE applies the GTE predicate on the "bug_issues_closed" field.
+func BugIssuesClosedGTE(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldGTE(FieldBugIssuesClosed, v))
+}
+
+// BugIssuesClosedLT applies the LT predicate on the "bug_issues_closed" field.
+func BugIssuesClosedLT(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldLT(FieldBugIssuesClosed, v))
+}
+
+// BugIssuesClosedLTE applies the LTE predicate on the "bug_issues_closed" field.
+func BugIssuesClosedLTE(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldLTE(FieldBugIssuesClosed, v))
+}
+
+// FeatureIssuesClosedEQ applies the EQ predicate on the "feature_issues_closed" field.
+func FeatureIssuesClosedEQ(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldEQ(FieldFeatureIssuesClosed, v))
+}
+
+// FeatureIssuesClosedNEQ applies the NEQ predicate on the "feature_issues_closed" field.
+func FeatureIssuesClosedNEQ(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldNEQ(FieldFeatureIssuesClosed, v))
+}
+
+// FeatureIssuesClosedIn applies the In predicate on the "feature_issues_closed" field.
+func FeatureIssuesClosedIn(vs ...int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldIn(FieldFeatureIssuesClosed, vs...))
+}
+
+// FeatureIssuesClosedNotIn applies the NotIn predicate on the "feature_issues_closed" field.
+func FeatureIssuesClosedNotIn(vs ...int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldNotIn(FieldFeatureIssuesClosed, vs...))
+}
+
+// FeatureIssuesClosedGT applies the GT predicate on the "feature_issues_closed" field.
+func FeatureIssuesClosedGT(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldGT(FieldFeatureIssuesClosed, v))
+}
+
+// FeatureIssuesClosedGTE applies the GTE predicate on the "feature_issues_closed" field.
+func FeatureIssuesClosedGTE(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldGTE(FieldFeatureIssuesClosed, v))
+}
+
+// FeatureIssuesClosedLT applies the LT predicate on the "feature_issues_closed" field.
+func FeatureIssuesClosedLT(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldLT(FieldFeatureIssuesClosed, v))
+}
+
+// FeatureIssuesClosedLTE applies the LTE predicate on the "feature_issues_closed" field.
+func FeatureIssuesClosedLTE(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldLTE(FieldFeatureIssuesClosed, v))
+}
+
+// FeaturePrsEQ applies the EQ predicate on the "feature_prs" field.
+func FeaturePrsEQ(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldEQ(FieldFeaturePrs, v))
+}
+
+// FeaturePrsNEQ applies the NEQ predicate on the "feature_prs" field.
+func FeaturePrsNEQ(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldNEQ(FieldFeaturePrs, v))
+}
+
+// FeaturePrsIn applies the In predicate on the "feature_prs" field.
+func FeaturePrsIn(vs ...int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldIn(FieldFeaturePrs, vs...))
+}
+
+// FeaturePrsNotIn applies the NotIn predicate on the "feature_prs" field.
+func FeaturePrsNotIn(vs ...int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldNotIn(FieldFeaturePrs, vs...))
+}
+
+// FeaturePrsGT applies the GT predicate on the "feature_prs" field.
+func FeaturePrsGT(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldGT(FieldFeaturePrs, v))
+}
+
+// FeaturePrsGTE applies the GTE predicate on the "feature_prs" field.
+func FeaturePrsGTE(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldGTE(FieldFeaturePrs, v))
+}
+
+// FeaturePrsLT applies the LT predicate on the "feature_prs" field.
+func FeaturePrsLT(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldLT(FieldFeaturePrs, v))
+}
+
+// FeaturePrsLTE applies the LTE predicate on the "feature_prs" field.
+func FeaturePrsLTE(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldLTE(FieldFeaturePrs, v))
+}
+
+// BugPrsEQ applies the EQ predicate on the "bug_prs" field.
+func BugPrsEQ(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldEQ(FieldBugPrs, v))
+}
+
+// BugPrsNEQ applies the NEQ predicate on the "bug_prs" field.
+func BugPrsNEQ(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldNEQ(FieldBugPrs, v))
+}
+
+// BugPrsIn applies the In predicate on the "bug_prs" field.
+func BugPrsIn(vs ...int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldIn(FieldBugPrs, vs...))
+}
+
+// BugPrsNotIn applies the NotIn predicate on the "bug_prs" field.
+func BugPrsNotIn(vs ...int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldNotIn(FieldBugPrs, vs...))
+}
+
+// BugPrsGT applies the GT predicate on the "bug_prs" field.
+func BugPrsGT(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldGT(FieldBugPrs, v))
+}
+
+// BugPrsGTE applies the GTE predicate on the "bug_prs" field.
+func BugPrsGTE(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldGTE(FieldBugPrs, v))
+}
+
+// BugPrsLT applies the LT predicate on the "bug_prs" field.
+func BugPrsLT(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldLT(FieldBugPrs, v))
+}
+
+// BugPrsLTE applies the LTE predicate on the "bug_prs" field.
+func BugPrsLTE(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldLTE(FieldBugPrs, v))
+}
+
+// ChorePrsEQ applies the EQ predicate on the "chore_prs" field.
+func ChorePrsEQ(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldEQ(FieldChorePrs, v))
+}
+
+// ChorePrsNEQ applies the NEQ predicate on the "chore_prs" field.
+func ChorePrsNEQ(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldNEQ(FieldChorePrs, v))
+}
+
+// ChorePrsIn applies the In predicate on the "chore_prs" field.
+func ChorePrsIn(vs ...int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldIn(FieldChorePrs, vs...))
+}
+
+// ChorePrsNotIn applies the NotIn predicate on the "chore_prs" field.
+func ChorePrsNotIn(vs ...int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldNotIn(FieldChorePrs, vs...))
+}
+
+// ChorePrsGT applies the GT predicate on the "chore_prs" field.
+func ChorePrsGT(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldGT(FieldChorePrs, v))
+}
+
+// ChorePrsGTE applies the GTE predicate on the "chore_prs" field.
+func ChorePrsGTE(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldGTE(FieldChorePrs, v))
+}
+
+// ChorePrsLT applies the LT predicate on the "chore_prs" field.
+func ChorePrsLT(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldLT(FieldChorePrs, v))
+}
+
+// ChorePrsLTE applies the LTE predicate on the "chore_prs" field.
+func ChorePrsLTE(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldLTE(FieldChorePrs, v))
+}
+
+// DocsPrsEQ applies the EQ predicate on the "docs_prs" field.
+func DocsPrsEQ(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldEQ(FieldDocsPrs, v))
+}
+
+// DocsPrsNEQ applies the NEQ predicate on the "docs_prs" field.
+func DocsPrsNEQ(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldNEQ(FieldDocsPrs, v))
+}
+
+// DocsPrsIn applies the In predicate on the "docs_prs" field.
+func DocsPrsIn(vs ...int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldIn(FieldDocsPrs, vs...))
+}
+
+// DocsPrsNotIn applies the NotIn predicate on the "docs_prs" field.
+func DocsPrsNotIn(vs ...int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldNotIn(FieldDocsPrs, vs...))
+}
+
+// DocsPrsGT applies the GT predicate on the "docs_prs" field.
+func DocsPrsGT(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldGT(FieldDocsPrs, v))
+}
+
+// DocsPrsGTE applies the GTE predicate on the "docs_prs" field.
+func DocsPrsGTE(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldGTE(FieldDocsPrs, v))
+}
+
+// DocsPrsLT applies the LT predicate on the "docs_prs" field.
+func DocsPrsLT(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldLT(FieldDocsPrs, v))
+}
+
+// DocsPrsLTE applies the LTE predicate on the "docs_prs" field.
+func DocsPrsLTE(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldLTE(FieldDocsPrs, v))
+}
+
+// TestPrsEQ applies the EQ predicate on the "test_prs" field.
+func TestPrsEQ(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldEQ(FieldTestPrs, v))
+}
+
+// TestPrsNEQ applies the NEQ predicate on the "test_prs" field.
+func TestPrsNEQ(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldNEQ(FieldTestPrs, v))
+}
+
+// TestPrsIn applies the In predicate on the "test_prs" field.
+func TestPrsIn(vs ...int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldIn(FieldTestPrs, vs...))
+}
+
+// TestPrsNotIn applies the NotIn predicate on the "test_prs" field.
+func TestPrsNotIn(vs ...int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldNotIn(FieldTestPrs, vs...))
+}
+
+// TestPrsGT applies the GT predicate on the "test_prs" field.
+func TestPrsGT(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldGT(FieldTestPrs, v))
+}
+
+// TestPrsGTE applies the GTE predicate on the "test_prs" field.
+func TestPrsGTE(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldGTE(FieldTestPrs, v))
+}
+
+// TestPrsLT applies the LT predicate on the "test_prs" field.
+func TestPrsLT(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldLT(FieldTestPrs, v))
+}
+
+// TestPrsLTE applies the LTE predicate on the "test_prs" field.
+func TestPrsLTE(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldLTE(FieldTestPrs, v))
+}
+
+// InfraPrsEQ applies the EQ predicate on the "infra_prs" field.
+func InfraPrsEQ(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldEQ(FieldInfraPrs, v))
+}
+
+// InfraPrsNEQ applies the NEQ predicate on the "infra_prs" field.
+func InfraPrsNEQ(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldNEQ(FieldInfraPrs, v))
+}
+
+// InfraPrsIn applies the In predicate on the "infra_prs" field.
+func InfraPrsIn(vs ...int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldIn(FieldInfraPrs, vs...))
+}
+
+// InfraPrsNotIn applies the NotIn predicate on the "infra_prs" field.
+func InfraPrsNotIn(vs ...int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldNotIn(FieldInfraPrs, vs...))
+}
+
+// InfraPrsGT applies the GT predicate on the "infra_prs" field.
+func InfraPrsGT(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldGT(FieldInfraPrs, v))
+}
+
+// InfraPrsGTE applies the GTE predicate on the "infra_prs" field.
+func InfraPrsGTE(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldGTE(FieldInfraPrs, v))
+}
+
+// InfraPrsLT applies the LT predicate on the "infra_prs" field.
+func InfraPrsLT(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldLT(FieldInfraPrs, v))
+}
+
+// InfraPrsLTE applies the LTE predicate on the "infra_prs" field.
+func InfraPrsLTE(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldLTE(FieldInfraPrs, v))
+}
+
+// OtherPrsEQ applies the EQ predicate on the "other_prs" field.
+func OtherPrsEQ(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldEQ(FieldOtherPrs, v))
+}
+
+// OtherPrsNEQ applies the NEQ predicate on the "other_prs" field.
+func OtherPrsNEQ(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldNEQ(FieldOtherPrs, v))
+}
+
+// OtherPrsIn applies the In predicate on the "other_prs" field.
+func OtherPrsIn(vs ...int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldIn(FieldOtherPrs, vs...))
+}
+
+// OtherPrsNotIn applies the NotIn predicate on the "other_prs" field.
+func OtherPrsNotIn(vs ...int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldNotIn(FieldOtherPrs, vs...))
+}
+
+// OtherPrsGT applies the GT predicate on the "other_prs" field.
+func OtherPrsGT(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldGT(FieldOtherPrs, v))
+}
+
+// OtherPrsGTE applies the GTE predicate on the "other_prs" field.
+func OtherPrsGTE(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldGTE(FieldOtherPrs, v))
+}
+
+// OtherPrsLT applies the LT predicate on the "other_prs" field.
+func OtherPrsLT(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldLT(FieldOtherPrs, v))
+}
+
+// OtherPrsLTE applies the LTE predicate on the "other_prs" field.
+func OtherPrsLTE(v int) predicate.MemberRepoStat {
+	return predicate.MemberRepoStat(sql.FieldLTE(FieldOtherPrs, v))
+}
+
 // HasSnapshot applies the HasEdge predicate on the "snapshot" edge.
 func HasSnapshot() predicate.MemberRepoStat {
 	return predicate.MemberRepoStat(func(s *sql.Selector) {
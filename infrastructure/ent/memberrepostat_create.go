@@ -130,6 +130,188 @@ func (_c *MemberRepoStatCreate) SetNillableDeletions(v *int) *MemberRepoStatCrea
 	return _c
 }
 
+// SetIssuesClosed sets the "issues_closed" field.
+func (_c *MemberRepoStatCreate) SetIssuesClosed(v int) *MemberRepoStatCreate {
+	_c.mutation.SetIssuesClosed(v)
+	return _c
+}
+
+// SetNillableIssuesClosed sets the "issues_closed" field if the given value is not nil.
+func (_c *MemberRepoStatCreate) SetNillableIssuesClosed(v *int) *MemberRepoStatCreate {
+	if v != nil {
+		_c.SetIssuesClosed(*v)
+	}
+	return _c
+}
+
+// SetIssueCloseSeconds sets the "issue_close_seconds" field.
+func (_c *MemberRepoStatCreate) SetIssueCloseSeconds(v int) *MemberRepoStatCreate {
+	_c.mutation.SetIssueCloseSeconds(v)
+	return _c
+}
+
+// SetNillableIssueCloseSeconds sets the "issue_close_seconds" field if the given value is not nil.
+func (_c *MemberRepoStatCreate) SetNillableIssueCloseSeconds(v *int) *MemberRepoStatCreate {
+	if v != nil {
+		_c.SetIssueCloseSeconds(*v)
+	}
+	return _c
+}
+
+// SetBugIssuesOpened sets the "bug_issues_opened" field.
+func (_c *MemberRepoStatCreate) SetBugIssuesOpened(v int) *MemberRepoStatCreate {
+	_c.mutation.SetBugIssuesOpened(v)
+	return _c
+}
+
+// SetNillableBugIssuesOpened sets the "bug_issues_opened" field if the given value is not nil.
+func (_c *MemberRepoStatCreate) SetNillableBugIssuesOpened(v *int) *MemberRepoStatCreate {
+	if v != nil {
+		_c.SetBugIssuesOpened(*v)
+	}
+	return _c
+}
+
+// SetFeatureIssuesOpened sets the "feature_issues_opened" field.
+func (_c *MemberRepoStatCreate) SetFeatureIssuesOpened(v int) *MemberRepoStatCreate {
+	_c.mutation.SetFeatureIssuesOpened(v)
+	return _c
+}
+
+// SetNillableFeatureIssuesOpened sets the "feature_issues_opened" field if the given value is not nil.
+func (_c *MemberRepoStatCreate) SetNillableFeatureIssuesOpened(v *int) *MemberRepoStatCreate {
+	if v != nil {
+		_c.SetFeatureIssuesOpened(*v)
+	}
+	return _c
+}
+
+// SetBugIssuesClosed sets the "bug_issues_closed" field.
+func (_c *MemberRepoStatCreate) SetBugIssuesClosed(v int) *MemberRepoStatCreate {
+	_c.mutation.SetBugIssuesClosed(v)
+	return _c
+}
+
+// SetNillableBugIssuesClosed sets the "bug_issues_closed" field if the given value is not nil.
+func (_c *MemberRepoStatCreate) SetNillableBugIssuesClosed(v *int) *MemberRepoStatCreate {
+	if v != nil {
+		_c.SetBugIssuesClosed(*v)
+	}
+	return _c
+}
+
+// SetFeatureIssuesClosed sets the "feature_issues_closed" field.
+func (_c *MemberRepoStatCreate) SetFeatureIssuesClosed(v int) *MemberRepoStatCreate {
+	_c.mutation.SetFeatureIssuesClosed(v)
+	return _c
+}
+
+// SetNillableFeatureIssuesClosed sets the "feature_issues_closed" field if the given value is not nil.
+func (_c *MemberRepoStatCreate) SetNillableFeatureIssuesClosed(v *int) *MemberRepoStatCreate {
+	if v != nil {
+		_c.SetFeatureIssuesClosed(*v)
+	}
+	return _c
+}
+
+// SetFeaturePrs sets the "feature_prs" field.
+func (_c *MemberRepoStatCreate) SetFeaturePrs(v int) *MemberRepoStatCreate {
+	_c.mutation.SetFeaturePrs(v)
+	return _c
+}
+
+// SetNillableFeaturePrs sets the "feature_prs" field if the given value is not nil.
+func (_c *MemberRepoStatCreate) SetNillableFeaturePrs(v *int) *MemberRepoStatCreate {
+	if v != nil {
+		_c.SetFeaturePrs(*v)
+	}
+	return _c
+}
+
+// SetBugPrs sets the "bug_prs" field.
+func (_c *MemberRepoStatCreate) SetBugPrs(v int) *MemberRepoStatCreate {
+	_c.mutation.SetBugPrs(v)
+	return _c
+}
+
+// SetNillableBugPrs sets the "bug_prs" field if the given value is not nil.
+func (_c *MemberRepoStatCreate) SetNillableBugPrs(v *int) *MemberRepoStatCreate {
+	if v != nil {
+		_c.SetBugPrs(*v)
+	}
+	return _c
+}
+
+// SetChorePrs sets the "chore_prs" field.
+func (_c *MemberRepoStatCreate) SetChorePrs(v int) *MemberRepoStatCreate {
+	_c.mutation.SetChorePrs(v)
+	return _c
+}
+
+// SetNillableChorePrs sets the "chore_prs" field if the given value is not nil.
+func (_c *MemberRepoStatCreate) SetNillableChorePrs(v *int) *MemberRepoStatCreate {
+	if v != nil {
+		_c.SetChorePrs(*v)
+	}
+	return _c
+}
+
+// SetDocsPrs sets the "docs_prs" field.
+func (_c *MemberRepoStatCreate) SetDocsPrs(v int) *MemberRepoStatCreate {
+	_c.mutation.SetDocsPrs(v)
+	return _c
+}
+
+// SetNillableDocsPrs sets the "docs_prs" field if the given value is not nil.
+func (_c *MemberRepoStatCreate) SetNillableDocsPrs(v *int) *MemberRepoStatCreate {
+	if v != nil {
+		_c.SetDocsPrs(*v)
+	}
+	return _c
+}
+
+// SetTestPrs sets the "test_prs" field.
+func (_c *MemberRepoStatCreate) SetTestPrs(v int) *MemberRepoStatCreate {
+	_c.mutation.SetTestPrs(v)
+	return _c
+}
+
+// SetNillableTestPrs sets the "test_prs" field if the given value is not nil.
+func (_c *MemberRepoStatCreate) SetNillableTestPrs(v *int) *MemberRepoStatCreate {
+	if v != nil {
+		_c.SetTestPrs(*v)
+	}
+	return _c
+}
+
+// SetInfraPrs sets the "infra_prs" field.
+func (_c *MemberRepoStatCreate) SetInfraPrs(v int) *MemberRepoStatCreate {
+	_c.mutation.SetInfraPrs(v)
+	return _c
+}
+
+// SetNillableInfraPrs sets the "infra_prs" field if the given value is not nil.
+func (_c *MemberRepoStatCreate) SetNillableInfraPrs(v *int) *MemberRepoStatCreate {
+	if v != nil {
+		_c.SetInfraPrs(*v)
+	}
+	return _c
+}
+
+// SetOtherPrs sets the "other_prs" field.
+func (_c *MemberRepoStatCreate) SetOtherPrs(v int) *MemberRepoStatCreate {
+	_c.mutation.SetOtherPrs(v)
+	return _c
+}
+
+// SetNillableOtherPrs sets the "other_prs" field if the given value is not nil.
+func (_c *MemberRepoStatCreate) SetNillableOtherPrs(v *int) *MemberRepoStatCreate {
+	if v != nil {
+		_c.SetOtherPrs(*v)
+	}
+	return _c
+}
+
 // SetSnapshotID sets the "snapshot" edge to the Snapshot entity by ID.
 func (_c *MemberRepoStatCreate) SetSnapshotID(id int) *MemberRepoStatCreate {
 	_c.mutation.SetSnapshotID(id)
@@ -204,6 +386,58 @@ func (_c *MemberRepoStatCreate) defaults() {
 		v := memberrepostat.DefaultDeletions
 		_c.mutation.SetDeletions(v)
 	}
+	if _, ok := _c.mutation.IssuesClosed(); !ok {
+		v := memberrepostat.DefaultIssuesClosed
+		_c.mutation.SetIssuesClosed(v)
+	}
+	if _, ok := _c.mutation.IssueCloseSeconds(); !ok {
+		v := memberrepostat.DefaultIssueCloseSeconds
+		_c.mutation.SetIssueCloseSeconds(v)
+	}
+	if _, ok := _c.mutation.BugIssuesOpened(); !ok {
+		v := memberrepostat.DefaultBugIssuesOpened
+		_c.mutation.SetBugIssuesOpened(v)
+	}
+	if _, ok := _c.mutation.FeatureIssuesOpened(); !ok {
+		v := memberrepostat.DefaultFeatureIssuesOpened
+		_c.mutation.SetFeatureIssuesOpened(v)
+	}
+	if _, ok := _c.mutation.BugIssuesClosed(); !ok {
+		v := memberrepostat.DefaultBugIssuesClosed
+		_c.mutation.SetBugIssuesClosed(v)
+	}
+	if _, ok := _c.mutation.FeatureIssuesClosed(); !ok {
+		v := memberrepostat.DefaultFeatureIssuesClosed
+		_c.mutation.SetFeatureIssuesClosed(v)
+	}
+	if _, ok := _c.mutation.FeaturePrs(); !ok {
+		v := memberrepostat.DefaultFeaturePrs
+		_c.mutation.SetFeaturePrs(v)
+	}
+	if _, ok := _c.mutation.BugPrs(); !ok {
+		v := memberrepostat.DefaultBugPrs
+		_c.mutation.SetBugPrs(v)
+	}
+	if _, ok := _c.mutation.ChorePrs(); !ok {
+		v := memberrepostat.DefaultChorePrs
+		_c.mutation.SetChorePrs(v)
+	}
+	if _, ok := _c.mutation.DocsPrs(); !ok {
+		v := memberrepostat.DefaultDocsPrs
+		_c.mutation.SetDocsPrs(v)
+	}
+	if _, ok := _c.mutation.TestPrs(); !ok {
+		v := memberrepostat.DefaultTestPrs
+		_c.mutation.SetTestPrs(v)
+	}
+	if _, ok := _c.mutation.InfraPrs(); !ok {
+		v := memberrepostat.DefaultInfraPrs
+		_c.mutation.SetInfraPrs(v)
+	}
+	if _, ok := _c.mutation.OtherPrs(); !ok {
+		v := memberrepostat.DefaultOtherPrs
+		_c.mutation.SetOtherPrs(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -245,6 +479,45 @@ func (_c *MemberRepoStatCreate) check() error {
 	if _, ok := _c.mutation.Deletions(); !ok {
 		return &ValidationError{Name: "deletions", err: errors.New(`ent: missing required field "MemberRepoStat.deletions"`)}
 	}
+	if _, ok := _c.mutation.IssuesClosed(); !ok {
+		return &ValidationError{Name: "issues_closed", err: errors.New(`ent: missing required field "MemberRepoStat.issues_closed"`)}
+	}
+	if _, ok := _c.mutation.IssueCloseSeconds(); !ok {
+		return &ValidationError{Name: "issue_close_seconds", err: errors.New(`ent: missing required field "MemberRepoStat.issue_close_seconds"`)}
+	}
+	if _, ok := _c.mutation.BugIssuesOpened(); !ok {
+		return &ValidationError{Name: "bug_issues_opened", err: errors.New(`ent: missing required field "MemberRepoStat.bug_issues_opened"`)}
+	}
+	if _, ok := _c.mutation.FeatureIssuesOpened(); !ok {
+		return &ValidationError{Name: "feature_issues_opened", err: errors.New(`ent: missing required field "MemberRepoStat.feature_issues_opened"`)}
+	}
+	if _, ok := _c.mutation.BugIssuesClosed(); !ok {
+		return &ValidationError{Name: "bug_issues_closed", err: errors.New(`ent: missing required field "MemberRepoStat.bug_issues_closed"`)}
+	}
+	if _, ok := _c.mutation.FeatureIssuesClosed(); !ok {
+		return &ValidationError{Name: "feature_issues_closed", err: errors.New(`ent: missing required field "MemberRepoStat.feature_issues_closed"`)}
+	}
+	if _, ok := _c.mutation.FeaturePrs(); !ok {
+		return &ValidationError{Name: "feature_prs", err: errors.New(`ent: missing required field "MemberRepoStat.feature_prs"`)}
+	}
+	if _, ok := _c.mutation.BugPrs(); !ok {
+		return &ValidationError{Name: "bug_prs", err: errors.New(`ent: missing required field "MemberRepoStat.bug_prs"`)}
+	}
+	if _, ok := _c.mutation.ChorePrs(); !ok {
+		return &ValidationError{Name: "chore_prs", err: errors.New(`ent: missing required field "MemberRepoStat.chore_prs"`)}
+	}
+	if _, ok := _c.mutation.DocsPrs(); !ok {
+		return &ValidationError{Name: "docs_prs", err: errors.New(`ent: missing required field "MemberRepoStat.docs_prs"`)}
+	}
+	if _, ok := _c.mutation.TestPrs(); !ok {
+		return &ValidationError{Name: "test_prs", err: errors.New(`ent: missing required field "MemberRepoStat.test_prs"`)}
+	}
+	if _, ok := _c.mutation.InfraPrs(); !ok {
+		return &ValidationError{Name: "infra_prs", err: errors.New(`ent: missing required field "MemberRepoStat.infra_prs"`)}
+	}
+	if _, ok := _c.mutation.OtherPrs(); !ok {
+		return &ValidationError{Name: "other_prs", err: errors.New(`ent: missing required field "MemberRepoStat.other_prs"`)}
+	}
 	if len(_c.mutation.SnapshotIDs()) == 0 {
 		return &ValidationError{Name: "snapshot", err: errors.New(`ent: missing required edge "MemberRepoStat.snapshot"`)}
 	}
@@ -310,6 +583,58 @@ func (_c *MemberRepoStatCreate) createSpec() (*MemberRepoStat, *sqlgraph.CreateS
 		_spec.SetField(memberrepostat.FieldDeletions, field.TypeInt, value)
 		_node.Deletions = value
 	}
+	if value, ok := _c.mutation.IssuesClosed(); ok {
+		_spec.SetField(memberrepostat.FieldIssuesClosed, field.TypeInt, value)
+		_node.IssuesClosed = value
+	}
+	if value, ok := _c.mutation.IssueCloseSeconds(); ok {
+		_spec.SetField(memberrepostat.FieldIssueCloseSeconds, field.TypeInt, value)
+		_node.IssueCloseSeconds = value
+	}
+	if value, ok := _c.mutation.BugIssuesOpened(); ok {
+		_spec.SetField(memberrepostat.FieldBugIssuesOpened, field.TypeInt, value)
+		_node.BugIssuesOpened = value
+	}
+	if value, ok := _c.mutation.FeatureIssuesOpened(); ok {
+		_spec.SetField(memberrepostat.FieldFeatureIssuesOpened, field.TypeInt, value)
+		_node.FeatureIssuesOpened = value
+	}
+	if value, ok := _c.mutation.BugIssuesClosed(); ok {
+		_spec.SetField(memberrepostat.FieldBugIssuesClosed, field.TypeInt, value)
+		_node.BugIssuesClosed = value
+	}
+	if value, ok := _c.mutation.FeatureIssuesClosed(); ok {
+		_spec.SetField(memberrepostat.FieldFeatureIssuesClosed, field.TypeInt, value)
+		_node.FeatureIssuesClosed = value
+	}
+	if value, ok := _c.mutation.FeaturePrs(); ok {
+		_spec.SetField(memberrepostat.FieldFeaturePrs, field.TypeInt, value)
+		_node.FeaturePrs = value
+	}
+	if value, ok := _c.mutation.BugPrs(); ok {
+		_spec.SetField(memberrepostat.FieldBugPrs, field.TypeInt, value)
+		_node.BugPrs = value
+	}
+	if value, ok := _c.mutation.ChorePrs(); ok {
+		_spec.SetField(memberrepostat.FieldChorePrs, field.TypeInt, value)
+		_node.ChorePrs = value
+	}
+	if value, ok := _c.mutation.DocsPrs(); ok {
+		_spec.SetField(memberrepostat.FieldDocsPrs, field.TypeInt, value)
+		_node.DocsPrs = value
+	}
+	if value, ok := _c.mutation.TestPrs(); ok {
+		_spec.SetField(memberrepostat.FieldTestPrs, field.TypeInt, value)
+		_node.TestPrs = value
+	}
+	if value, ok := _c.mutation.InfraPrs(); ok {
+		_spec.SetField(memberrepostat.FieldInfraPrs, field.TypeInt, value)
+		_node.InfraPrs = value
+	}
+	if value, ok := _c.mutation.OtherPrs(); ok {
+		_spec.SetField(memberrepostat.FieldOtherPrs, field.TypeInt, value)
+		_node.OtherPrs = value
+	}
 	if nodes := _c.mutation.SnapshotIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
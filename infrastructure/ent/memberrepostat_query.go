@@ -25,6 +25,7 @@ type MemberRepoStatQuery struct {
 	predicates   []predicate.MemberRepoStat
 	withSnapshot *SnapshotQuery
 	withFKs      bool
+	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -276,6 +277,7 @@ func (_q *MemberRepoStatQuery) Clone() *MemberRepoStatQuery {
 		inters:       append([]Interceptor{}, _q.inters...),
 		predicates:   append([]predicate.MemberRepoStat{}, _q.predicates...),
 		withSnapshot: _q.withSnapshot.Clone(),
+		modifiers:    append([]func(*sql.Selector){}, _q.modifiers...),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -391,6 +393,9 @@ func (_q *MemberRepoStatQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -444,6 +449,9 @@ func (_q *MemberRepoStatQuery) loadSnapshot(ctx context.Context, query *Snapshot
 
 func (_q *MemberRepoStatQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -506,6 +514,9 @@ func (_q *MemberRepoStatQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -523,6 +534,12 @@ func (_q *MemberRepoStatQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *MemberRepoStatQuery) Modify(modifiers ...func(s *sql.Selector)) *MemberRepoStatSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // MemberRepoStatGroupBy is the group-by builder for MemberRepoStat entities.
 type MemberRepoStatGroupBy struct {
 	selector
@@ -612,3 +629,9 @@ func (_s *MemberRepoStatSelect) sqlScan(ctx context.Context, root *MemberRepoSta
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *MemberRepoStatSelect) Modify(modifiers ...func(s *sql.Selector)) *MemberRepoStatSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
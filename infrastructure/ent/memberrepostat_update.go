@@ -203,6 +203,279 @@ func (_u *MemberRepoStatUpdate) AddDeletions(v int) *MemberRepoStatUpdate {
 	return _u
 }
 
+// SetIssuesClosed sets the "issues_closed" field.
+func (_u *MemberRepoStatUpdate) SetIssuesClosed(v int) *MemberRepoStatUpdate {
+	_u.mutation.ResetIssuesClosed()
+	_u.mutation.SetIssuesClosed(v)
+	return _u
+}
+
+// SetNillableIssuesClosed sets the "issues_closed" field if the given value is not nil.
+func (_u *MemberRepoStatUpdate) SetNillableIssuesClosed(v *int) *MemberRepoStatUpdate {
+	if v != nil {
+		_u.SetIssuesClosed(*v)
+	}
+	return _u
+}
+
+// AddIssuesClosed adds value to the "issues_closed" field.
+func (_u *MemberRepoStatUpdate) AddIssuesClosed(v int) *MemberRepoStatUpdate {
+	_u.mutation.AddIssuesClosed(v)
+	return _u
+}
+
+// SetIssueCloseSeconds sets the "issue_close_seconds" field.
+func (_u *MemberRepoStatUpdate) SetIssueCloseSeconds(v int) *MemberRepoStatUpdate {
+	_u.mutation.ResetIssueCloseSeconds()
+	_u.mutation.SetIssueCloseSeconds(v)
+	return _u
+}
+
+// SetNillableIssueCloseSeconds sets the "issue_close_seconds" field if the given value is not nil.
+func (_u *MemberRepoStatUpdate) SetNillableIssueCloseSeconds(v *int) *MemberRepoStatUpdate {
+	if v != nil {
+		_u.SetIssueCloseSeconds(*v)
+	}
+	return _u
+}
+
+// AddIssueCloseSeconds adds value to the "issue_close_seconds" field.
+func (_u *MemberRepoStatUpdate) AddIssueCloseSeconds(v int) *MemberRepoStatUpdate {
+	_u.mutation.AddIssueCloseSeconds(v)
+	return _u
+}
+
+// SetBugIssuesOpened sets the "bug_issues_opened" field.
+func (_u *MemberRepoStatUpdate) SetBugIssuesOpened(v int) *MemberRepoStatUpdate {
+	_u.mutation.ResetBugIssuesOpened()
+	_u.mutation.SetBugIssuesOpened(v)
+	return _u
+}
+
+// SetNillableBugIssuesOpened sets the "bug_issues_opened" field if the given value is not nil.
+func (_u *MemberRepoStatUpdate) SetNillableBugIssuesOpened(v *int) *MemberRepoStatUpdate {
+	if v != nil {
+		_u.SetBugIssuesOpened(*v)
+	}
+	return _u
+}
+
+// AddBugIssuesOpened adds value to the "bug_issues_opened" field.
+func (_u *MemberRepoStatUpdate) AddBugIssuesOpened(v int) *MemberRepoStatUpdate {
+	_u.mutation.AddBugIssuesOpened(v)
+	return _u
+}
+
+// SetFeatureIssuesOpened sets the "feature_issues_opened" field.
+func (_u *MemberRepoStatUpdate) SetFeatureIssuesOpened(v int) *MemberRepoStatUpdate {
+	_u.mutation.ResetFeatureIssuesOpened()
+	_u.mutation.SetFeatureIssuesOpened(v)
+	return _u
+}
+
+// SetNillableFeatureIssuesOpened sets the "feature_issues_opened" field if the given value is not nil.
+func (_u *MemberRepoStatUpdate) SetNillableFeatureIssuesOpened(v *int) *MemberRepoStatUpdate {
+	if v != nil {
+		_u.SetFeatureIssuesOpened(*v)
+	}
+	return _u
+}
+
+// AddFeatureIssuesOpened adds value to the "feature_issues_opened" field.
+func (_u *MemberRepoStatUpdate) AddFeatureIssuesOpened(v int) *MemberRepoStatUpdate {
+	_u.mutation.AddFeatureIssuesOpened(v)
+	return _u
+}
+
+// SetBugIssuesClosed sets the "bug_issues_closed" field.
+func (_u *MemberRepoStatUpdate) SetBugIssuesClosed(v int) *MemberRepoStatUpdate {
+	_u.mutation.ResetBugIssuesClosed()
+	_u.mutation.SetBugIssuesClosed(v)
+	return _u
+}
+
+// SetNillableBugIssuesClosed sets the "bug_issues_closed" field if the given value is not nil.
+func (_u *MemberRepoStatUpdate) SetNillableBugIssuesClosed(v *int) *MemberRepoStatUpdate {
+	if v != nil {
+		_u.SetBugIssuesClosed(*v)
+	}
+	return _u
+}
+
+// AddBugIssuesClosed adds value to the "bug_issues_closed" field.
+func (_u *MemberRepoStatUpdate) AddBugIssuesClosed(v int) *MemberRepoStatUpdate {
+	_u.mutation.AddBugIssuesClosed(v)
+	return _u
+}
+
+// SetFeatureIssuesClosed sets the "feature_issues_closed" field.
+func (_u *MemberRepoStatUpdate) SetFeatureIssuesClosed(v int) *MemberRepoStatUpdate {
+	_u.mutation.ResetFeatureIssuesClosed()
+	_u.mutation.SetFeatureIssuesClosed(v)
+	return _u
+}
+
+// SetNillableFeatureIssuesClosed sets the "feature_issues_closed" field if the given value is not nil.
+func (_u *MemberRepoStatUpdate) SetNillableFeatureIssuesClosed(v *int) *MemberRepoStatUpdate {
+	if v != nil {
+		_u.SetFeatureIssuesClosed(*v)
+	}
+	return _u
+}
+
+// AddFeatureIssuesClosed adds value to the "feature_issues_closed" field.
+func (_u *MemberRepoStatUpdate) AddFeatureIssuesClosed(v int) *MemberRepoStatUpdate {
+	_u.mutation.AddFeatureIssuesClosed(v)
+	return _u
+}
+
+// SetFeaturePrs sets the "feature_prs" field.
+func (_u *MemberRepoStatUpdate) SetFeaturePrs(v int) *MemberRepoStatUpdate {
+	_u.mutation.ResetFeaturePrs()
+	_u.mutation.SetFeaturePrs(v)
+	return _u
+}
+
+// SetNillableFeaturePrs sets the "feature_prs" field if the given value is not nil.
+func (_u *MemberRepoStatUpdate) SetNillableFeaturePrs(v *int) *MemberRepoStatUpdate {
+	if v != nil {
+		_u.SetFeaturePrs(*v)
+	}
+	return _u
+}
+
+// AddFeaturePrs adds value to the "feature_prs" field.
+func (_u *MemberRepoStatUpdate) AddFeaturePrs(v int) *MemberRepoStatUpdate {
+	_u.mutation.AddFeaturePrs(v)
+	return _u
+}
+
+// SetBugPrs sets the "bug_prs" field.
+func (_u *MemberRepoStatUpdate) SetBugPrs(v int) *MemberRepoStatUpdate {
+	_u.mutation.ResetBugPrs()
+	_u.mutation.SetBugPrs(v)
+	return _u
+}
+
+// SetNillableBugPrs sets the "bug_prs" field if the given value is not nil.
+func (_u *MemberRepoStatUpdate) SetNillableBugPrs(v *int) *MemberRepoStatUpdate {
+	if v != nil {
+		_u.SetBugPrs(*v)
+	}
+	return _u
+}
+
+// AddBugPrs adds value to the "bug_prs" field.
+func (_u *MemberRepoStatUpdate) AddBugPrs(v int) *MemberRepoStatUpdate {
+	_u.mutation.AddBugPrs(v)
+	return _u
+}
+
+// SetChorePrs sets the "chore_prs" field.
+func (_u *MemberRepoStatUpdate) SetChorePrs(v int) *MemberRepoStatUpdate {
+	_u.mutation.ResetChorePrs()
+	_u.mutation.SetChorePrs(v)
+	return _u
+}
+
+// SetNillableChorePrs sets the "chore_prs" field if the given value is not nil.
+func (_u *MemberRepoStatUpdate) SetNillableChorePrs(v *int) *MemberRepoStatUpdate {
+	if v != nil {
+		_u.SetChorePrs(*v)
+	}
+	return _u
+}
+
+// AddChorePrs adds value to the "chore_prs" field.
+func (_u *MemberRepoStatUpdate) AddChorePrs(v int) *MemberRepoStatUpdate {
+	_u.mutation.AddChorePrs(v)
+	return _u
+}
+
+// SetDocsPrs sets the "docs_prs" field.
+func (_u *MemberRepoStatUpdate) SetDocsPrs(v int) *MemberRepoStatUpdate {
+	_u.mutation.ResetDocsPrs()
+	_u.mutation.SetDocsPrs(v)
+	return _u
+}
+
+// SetNillableDocsPrs sets the "docs_prs" field if the given value is not nil.
+func (_u *MemberRepoStatUpdate) SetNillableDocsPrs(v *int) *MemberRepoStatUpdate {
+	if v != nil {
+		_u.SetDocsPrs(*v)
+	}
+	return _u
+}
+
+// AddDocsPrs adds value to the "docs_prs" field.
+func (_u *MemberRepoStatUpdate) AddDocsPrs(v int) *MemberRepoStatUpdate {
+	_u.mutation.AddDocsPrs(v)
+	return _u
+}
+
+// SetTestPrs sets the "test_prs" field.
+func (_u *MemberRepoStatUpdate) SetTestPrs(v int) *MemberRepoStatUpdate {
+	_u.mutation.ResetTestPrs()
+	_u.mutation.SetTestPrs(v)
+	return _u
+}
+
+// SetNillableTestPrs sets the "test_prs" field if the given value is not nil.
+func (_u *MemberRepoStatUpdate) SetNillableTestPrs(v *int) *MemberRepoStatUpdate {
+	if v != nil {
+		_u.SetTestPrs(*v)
+	}
+	return _u
+}
+
+// AddTestPrs adds value to the "test_prs" field.
+func (_u *MemberRepoStatUpdate) AddTestPrs(v int) *MemberRepoStatUpdate {
+	_u.mutation.AddTestPrs(v)
+	return _u
+}
+
+// SetInfraPrs sets the "infra_prs" field.
+func (_u *MemberRepoStatUpdate) SetInfraPrs(v int) *MemberRepoStatUpdate {
+	_u.mutation.ResetInfraPrs()
+	_u.mutation.SetInfraPrs(v)
+	return _u
+}
+
+// SetNillableInfraPrs sets the "infra_prs" field if the given value is not nil.
+func (_u *MemberRepoStatUpdate) SetNillableInfraPrs(v *int) *MemberRepoStatUpdate {
+	if v != nil {
+		_u.SetInfraPrs(*v)
+	}
+	return _u
+}
+
+// AddInfraPrs adds value to the "infra_prs" field.
+func (_u *MemberRepoStatUpdate) AddInfraPrs(v int) *MemberRepoStatUpdate {
+	_u.mutation.AddInfraPrs(v)
+	return _u
+}
+
+// SetOtherPrs sets the "other_prs" field.
+func (_u *MemberRepoStatUpdate) SetOtherPrs(v int) *MemberRepoStatUpdate {
+	_u.mutation.ResetOtherPrs()
+	_u.mutation.SetOtherPrs(v)
+	return _u
+}
+
+// SetNillableOtherPrs sets the "other_prs" field if the given value is not nil.
+func (_u *MemberRepoStatUpdate) SetNillableOtherPrs(v *int) *MemberRepoStatUpdate {
+	if v != nil {
+		_u.SetOtherPrs(*v)
+	}
+	return _u
+}
+
+// AddOtherPrs adds value to the "other_prs" field.
+func (_u *MemberRepoStatUpdate) AddOtherPrs(v int) *MemberRepoStatUpdate {
+	_u.mutation.AddOtherPrs(v)
+	return _u
+}
+
 // SetSnapshotID sets the "snapshot" edge to the Snapshot entity by ID.
 func (_u *MemberRepoStatUpdate) SetSnapshotID(id int) *MemberRepoStatUpdate {
 	_u.mutation.SetSnapshotID(id)
@@ -330,6 +603,84 @@ func (_u *MemberRepoStatUpdate) sqlSave(ctx context.Context) (_node int, err err
 	if value, ok := _u.mutation.AddedDeletions(); ok {
 		_spec.AddField(memberrepostat.FieldDeletions, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.IssuesClosed(); ok {
+		_spec.SetField(memberrepostat.FieldIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedIssuesClosed(); ok {
+		_spec.AddField(memberrepostat.FieldIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IssueCloseSeconds(); ok {
+		_spec.SetField(memberrepostat.FieldIssueCloseSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedIssueCloseSeconds(); ok {
+		_spec.AddField(memberrepostat.FieldIssueCloseSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BugIssuesOpened(); ok {
+		_spec.SetField(memberrepostat.FieldBugIssuesOpened, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBugIssuesOpened(); ok {
+		_spec.AddField(memberrepostat.FieldBugIssuesOpened, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FeatureIssuesOpened(); ok {
+		_spec.SetField(memberrepostat.FieldFeatureIssuesOpened, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFeatureIssuesOpened(); ok {
+		_spec.AddField(memberrepostat.FieldFeatureIssuesOpened, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BugIssuesClosed(); ok {
+		_spec.SetField(memberrepostat.FieldBugIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBugIssuesClosed(); ok {
+		_spec.AddField(memberrepostat.FieldBugIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FeatureIssuesClosed(); ok {
+		_spec.SetField(memberrepostat.FieldFeatureIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFeatureIssuesClosed(); ok {
+		_spec.AddField(memberrepostat.FieldFeatureIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FeaturePrs(); ok {
+		_spec.SetField(memberrepostat.FieldFeaturePrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFeaturePrs(); ok {
+		_spec.AddField(memberrepostat.FieldFeaturePrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BugPrs(); ok {
+		_spec.SetField(memberrepostat.FieldBugPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBugPrs(); ok {
+		_spec.AddField(memberrepostat.FieldBugPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ChorePrs(); ok {
+		_spec.SetField(memberrepostat.FieldChorePrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChorePrs(); ok {
+		_spec.AddField(memberrepostat.FieldChorePrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DocsPrs(); ok {
+		_spec.SetField(memberrepostat.FieldDocsPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDocsPrs(); ok {
+		_spec.AddField(memberrepostat.FieldDocsPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TestPrs(); ok {
+		_spec.SetField(memberrepostat.FieldTestPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTestPrs(); ok {
+		_spec.AddField(memberrepostat.FieldTestPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.InfraPrs(); ok {
+		_spec.SetField(memberrepostat.FieldInfraPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInfraPrs(); ok {
+		_spec.AddField(memberrepostat.FieldInfraPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OtherPrs(); ok {
+		_spec.SetField(memberrepostat.FieldOtherPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOtherPrs(); ok {
+		_spec.AddField(memberrepostat.FieldOtherPrs, field.TypeInt, value)
+	}
 	if _u.mutation.SnapshotCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -554,6 +905,279 @@ func (_u *MemberRepoStatUpdateOne) AddDeletions(v int) *MemberRepoStatUpdateOne
 	return _u
 }
 
+// SetIssuesClosed sets the "issues_closed" field.
+func (_u *MemberRepoStatUpdateOne) SetIssuesClosed(v int) *MemberRepoStatUpdateOne {
+	_u.mutation.ResetIssuesClosed()
+	_u.mutation.SetIssuesClosed(v)
+	return _u
+}
+
+// SetNillableIssuesClosed sets the "issues_closed" field if the given value is not nil.
+func (_u *MemberRepoStatUpdateOne) SetNillableIssuesClosed(v *int) *MemberRepoStatUpdateOne {
+	if v != nil {
+		_u.SetIssuesClosed(*v)
+	}
+	return _u
+}
+
+// AddIssuesClosed adds value to the "issues_closed" field.
+func (_u *MemberRepoStatUpdateOne) AddIssuesClosed(v int) *MemberRepoStatUpdateOne {
+	_u.mutation.AddIssuesClosed(v)
+	return _u
+}
+
+// SetIssueCloseSeconds sets the "issue_close_seconds" field.
+func (_u *MemberRepoStatUpdateOne) SetIssueCloseSeconds(v int) *MemberRepoStatUpdateOne {
+	_u.mutation.ResetIssueCloseSeconds()
+	_u.mutation.SetIssueCloseSeconds(v)
+	return _u
+}
+
+// SetNillableIssueCloseSeconds sets the "issue_close_seconds" field if the given value is not nil.
+func (_u *MemberRepoStatUpdateOne) SetNillableIssueCloseSeconds(v *int) *MemberRepoStatUpdateOne {
+	if v != nil {
+		_u.SetIssueCloseSeconds(*v)
+	}
+	return _u
+}
+
+// AddIssueCloseSeconds adds value to the "issue_close_seconds" field.
+func (_u *MemberRepoStatUpdateOne) AddIssueCloseSeconds(v int) *MemberRepoStatUpdateOne {
+	_u.mutation.AddIssueCloseSeconds(v)
+	return _u
+}
+
+// SetBugIssuesOpened sets the "bug_issues_opened" field.
+func (_u *MemberRepoStatUpdateOne) SetBugIssuesOpened(v int) *MemberRepoStatUpdateOne {
+	_u.mutation.ResetBugIssuesOpened()
+	_u.mutation.SetBugIssuesOpened(v)
+	return _u
+}
+
+// SetNillableBugIssuesOpened sets the "bug_issues_opened" field if the given value is not nil.
+func (_u *MemberRepoStatUpdateOne) SetNillableBugIssuesOpened(v *int) *MemberRepoStatUpdateOne {
+	if v != nil {
+		_u.SetBugIssuesOpened(*v)
+	}
+	return _u
+}
+
+// AddBugIssuesOpened adds value to the "bug_issues_opened" field.
+func (_u *MemberRepoStatUpdateOne) AddBugIssuesOpened(v int) *MemberRepoStatUpdateOne {
+	_u.mutation.AddBugIssuesOpened(v)
+	return _u
+}
+
+// SetFeatureIssuesOpened sets the "feature_issues_opened" field.
+func (_u *MemberRepoStatUpdateOne) SetFeatureIssuesOpened(v int) *MemberRepoStatUpdateOne {
+	_u.mutation.ResetFeatureIssuesOpened()
+	_u.mutation.SetFeatureIssuesOpened(v)
+	return _u
+}
+
+// SetNillableFeatureIssuesOpened sets the "feature_issues_opened" field if the given value is not nil.
+func (_u *MemberRepoStatUpdateOne) SetNillableFeatureIssuesOpened(v *int) *MemberRepoStatUpdateOne {
+	if v != nil {
+		_u.SetFeatureIssuesOpened(*v)
+	}
+	return _u
+}
+
+// AddFeatureIssuesOpened adds value to the "feature_issues_opened" field.
+func (_u *MemberRepoStatUpdateOne) AddFeatureIssuesOpened(v int) *MemberRepoStatUpdateOne {
+	_u.mutation.AddFeatureIssuesOpened(v)
+	return _u
+}
+
+// SetBugIssuesClosed sets the "bug_issues_closed" field.
+func (_u *MemberRepoStatUpdateOne) SetBugIssuesClosed(v int) *MemberRepoStatUpdateOne {
+	_u.mutation.ResetBugIssuesClosed()
+	_u.mutation.SetBugIssuesClosed(v)
+	return _u
+}
+
+// SetNillableBugIssuesClosed sets the "bug_issues_closed" field if the given value is not nil.
+func (_u *MemberRepoStatUpdateOne) SetNillableBugIssuesClosed(v *int) *MemberRepoStatUpdateOne {
+	if v != nil {
+		_u.SetBugIssuesClosed(*v)
+	}
+	return _u
+}
+
+// AddBugIssuesClosed adds value to the "bug_issues_closed" field.
+func (_u *MemberRepoStatUpdateOne) AddBugIssuesClosed(v int) *MemberRepoStatUpdateOne {
+	_u.mutation.AddBugIssuesClosed(v)
+	return _u
+}
+
+// SetFeatureIssuesClosed sets the "feature_issues_closed" field.
+func (_u *MemberRepoStatUpdateOne) SetFeatureIssuesClosed(v int) *MemberRepoStatUpdateOne {
+	_u.mutation.ResetFeatureIssuesClosed()
+	_u.mutation.SetFeatureIssuesClosed(v)
+	return _u
+}
+
+// SetNillableFeatureIssuesClosed sets the "feature_issues_closed" field if the given value is not nil.
+func (_u *MemberRepoStatUpdateOne) SetNillableFeatureIssuesClosed(v *int) *MemberRepoStatUpdateOne {
+	if v != nil {
+		_u.SetFeatureIssuesClosed(*v)
+	}
+	return _u
+}
+
+// AddFeatureIssuesClosed adds value to the "feature_issues_closed" field.
+func (_u *MemberRepoStatUpdateOne) AddFeatureIssuesClosed(v int) *MemberRepoStatUpdateOne {
+	_u.mutation.AddFeatureIssuesClosed(v)
+	return _u
+}
+
+// SetFeaturePrs sets the "feature_prs" field.
+func (_u *MemberRepoStatUpdateOne) SetFeaturePrs(v int) *MemberRepoStatUpdateOne {
+	_u.mutation.ResetFeaturePrs()
+	_u.mutation.SetFeaturePrs(v)
+	return _u
+}
+
+// SetNillableFeaturePrs sets the "feature_prs" field if the given value is not nil.
+func (_u *MemberRepoStatUpdateOne) SetNillableFeaturePrs(v *int) *MemberRepoStatUpdateOne {
+	if v != nil {
+		_u.SetFeaturePrs(*v)
+	}
+	return _u
+}
+
+// AddFeaturePrs adds value to the "feature_prs" field.
+func (_u *MemberRepoStatUpdateOne) AddFeaturePrs(v int) *MemberRepoStatUpdateOne {
+	_u.mutation.AddFeaturePrs(v)
+	return _u
+}
+
+// SetBugPrs sets the "bug_prs" field.
+func (_u *MemberRepoStatUpdateOne) SetBugPrs(v int) *MemberRepoStatUpdateOne {
+	_u.mutation.ResetBugPrs()
+	_u.mutation.SetBugPrs(v)
+	return _u
+}
+
+// SetNillableBugPrs sets the "bug_prs" field if the given value is not nil.
+func (_u *MemberRepoStatUpdateOne) SetNillableBugPrs(v *int) *MemberRepoStatUpdateOne {
+	if v != nil {
+		_u.SetBugPrs(*v)
+	}
+	return _u
+}
+
+// AddBugPrs adds value to the "bug_prs" field.
+func (_u *MemberRepoStatUpdateOne) AddBugPrs(v int) *MemberRepoStatUpdateOne {
+	_u.mutation.AddBugPrs(v)
+	return _u
+}
+
+// SetChorePrs sets the "chore_prs" field.
+func (_u *MemberRepoStatUpdateOne) SetChorePrs(v int) *MemberRepoStatUpdateOne {
+	_u.mutation.ResetChorePrs()
+	_u.mutation.SetChorePrs(v)
+	return _u
+}
+
+// SetNillableChorePrs sets the "chore_prs" field if the given value is not nil.
+func (_u *MemberRepoStatUpdateOne) SetNillableChorePrs(v *int) *MemberRepoStatUpdateOne {
+	if v != nil {
+		_u.SetChorePrs(*v)
+	}
+	return _u
+}
+
+// AddChorePrs adds value to the "chore_prs" field.
+func (_u *MemberRepoStatUpdateOne) AddChorePrs(v int) *MemberRepoStatUpdateOne {
+	_u.mutation.AddChorePrs(v)
+	return _u
+}
+
+// SetDocsPrs sets the "docs_prs" field.
+func (_u *MemberRepoStatUpdateOne) SetDocsPrs(v int) *MemberRepoStatUpdateOne {
+	_u.mutation.ResetDocsPrs()
+	_u.mutation.SetDocsPrs(v)
+	return _u
+}
+
+// SetNillableDocsPrs sets the "docs_prs" field if the given value is not nil.
+func (_u *MemberRepoStatUpdateOne) SetNillableDocsPrs(v *int) *MemberRepoStatUpdateOne {
+	if v != nil {
+		_u.SetDocsPrs(*v)
+	}
+	return _u
+}
+
+// AddDocsPrs adds value to the "docs_prs" field.
+func (_u *MemberRepoStatUpdateOne) AddDocsPrs(v int) *MemberRepoStatUpdateOne {
+	_u.mutation.AddDocsPrs(v)
+	return _u
+}
+
+// SetTestPrs sets the "test_prs" field.
+func (_u *MemberRepoStatUpdateOne) SetTestPrs(v int) *MemberRepoStatUpdateOne {
+	_u.mutation.ResetTestPrs()
+	_u.mutation.SetTestPrs(v)
+	return _u
+}
+
+// SetNillableTestPrs sets the "test_prs" field if the given value is not nil.
+func (_u *MemberRepoStatUpdateOne) SetNillableTestPrs(v *int) *MemberRepoStatUpdateOne {
+	if v != nil {
+		_u.SetTestPrs(*v)
+	}
+	return _u
+}
+
+// AddTestPrs adds value to the "test_prs" field.
+func (_u *MemberRepoStatUpdateOne) AddTestPrs(v int) *MemberRepoStatUpdateOne {
+	_u.mutation.AddTestPrs(v)
+	return _u
+}
+
+// SetInfraPrs sets the "infra_prs" field.
+func (_u *MemberRepoStatUpdateOne) SetInfraPrs(v int) *MemberRepoStatUpdateOne {
+	_u.mutation.ResetInfraPrs()
+	_u.mutation.SetInfraPrs(v)
+	return _u
+}
+
+// SetNillableInfraPrs sets the "infra_prs" field if the given value is not nil.
+func (_u *MemberRepoStatUpdateOne) SetNillableInfraPrs(v *int) *MemberRepoStatUpdateOne {
+	if v != nil {
+		_u.SetInfraPrs(*v)
+	}
+	return _u
+}
+
+// AddInfraPrs adds value to the "infra_prs" field.
+func (_u *MemberRepoStatUpdateOne) AddInfraPrs(v int) *MemberRepoStatUpdateOne {
+	_u.mutation.AddInfraPrs(v)
+	return _u
+}
+
+// SetOtherPrs sets the "other_prs" field.
+func (_u *MemberRepoStatUpdateOne) SetOtherPrs(v int) *MemberRepoStatUpdateOne {
+	_u.mutation.ResetOtherPrs()
+	_u.mutation.SetOtherPrs(v)
+	return _u
+}
+
+// SetNillableOtherPrs sets the "other_prs" field if the given value is not nil.
+func (_u *MemberRepoStatUpdateOne) SetNillableOtherPrs(v *int) *MemberRepoStatUpdateOne {
+	if v != nil {
+		_u.SetOtherPrs(*v)
+	}
+	return _u
+}
+
+// AddOtherPrs adds value to the "other_prs" field.
+func (_u *MemberRepoStatUpdateOne) AddOtherPrs(v int) *MemberRepoStatUpdateOne {
+	_u.mutation.AddOtherPrs(v)
+	return _u
+}
+
 // SetSnapshotID sets the "snapshot" edge to the Snapshot entity by ID.
 func (_u *MemberRepoStatUpdateOne) SetSnapshotID(id int) *MemberRepoStatUpdateOne {
 	_u.mutation.SetSnapshotID(id)
@@ -711,6 +1335,84 @@ func (_u *MemberRepoStatUpdateOne) sqlSave(ctx context.Context) (_node *MemberRe
 	if value, ok := _u.mutation.AddedDeletions(); ok {
 		_spec.AddField(memberrepostat.FieldDeletions, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.IssuesClosed(); ok {
+		_spec.SetField(memberrepostat.FieldIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedIssuesClosed(); ok {
+		_spec.AddField(memberrepostat.FieldIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IssueCloseSeconds(); ok {
+		_spec.SetField(memberrepostat.FieldIssueCloseSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedIssueCloseSeconds(); ok {
+		_spec.AddField(memberrepostat.FieldIssueCloseSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BugIssuesOpened(); ok {
+		_spec.SetField(memberrepostat.FieldBugIssuesOpened, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBugIssuesOpened(); ok {
+		_spec.AddField(memberrepostat.FieldBugIssuesOpened, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FeatureIssuesOpened(); ok {
+		_spec.SetField(memberrepostat.FieldFeatureIssuesOpened, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFeatureIssuesOpened(); ok {
+		_spec.AddField(memberrepostat.FieldFeatureIssuesOpened, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BugIssuesClosed(); ok {
+		_spec.SetField(memberrepostat.FieldBugIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBugIssuesClosed(); ok {
+		_spec.AddField(memberrepostat.FieldBugIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FeatureIssuesClosed(); ok {
+		_spec.SetField(memberrepostat.FieldFeatureIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFeatureIssuesClosed(); ok {
+		_spec.AddField(memberrepostat.FieldFeatureIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FeaturePrs(); ok {
+		_spec.SetField(memberrepostat.FieldFeaturePrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFeaturePrs(); ok {
+		_spec.AddField(memberrepostat.FieldFeaturePrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BugPrs(); ok {
+		_spec.SetField(memberrepostat.FieldBugPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBugPrs(); ok {
+		_spec.AddField(memberrepostat.FieldBugPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ChorePrs(); ok {
+		_spec.SetField(memberrepostat.FieldChorePrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChorePrs(); ok {
+		_spec.AddField(memberrepostat.FieldChorePrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DocsPrs(); ok {
+		_spec.SetField(memberrepostat.FieldDocsPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDocsPrs(); ok {
+		_spec.AddField(memberrepostat.FieldDocsPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TestPrs(); ok {
+		_spec.SetField(memberrepostat.FieldTestPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTestPrs(); ok {
+		_spec.AddField(memberrepostat.FieldTestPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.InfraPrs(); ok {
+		_spec.SetField(memberrepostat.FieldInfraPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInfraPrs(); ok {
+		_spec.AddField(memberrepostat.FieldInfraPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OtherPrs(); ok {
+		_spec.SetField(memberrepostat.FieldOtherPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOtherPrs(); ok {
+		_spec.AddField(memberrepostat.FieldOtherPrs, field.TypeInt, value)
+	}
 	if _u.mutation.SnapshotCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
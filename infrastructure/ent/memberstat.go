@@ -19,6 +19,8 @@ type MemberStat struct {
 	ID int `json:"id,omitempty"`
 	// Login holds the value of the "login" field.
 	Login string `json:"login,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
 	// TotalCommits holds the value of the "total_commits" field.
 	TotalCommits int `json:"total_commits,omitempty"`
 	// TotalPrCreated holds the value of the "total_pr_created" field.
@@ -39,8 +41,38 @@ type MemberStat struct {
 	PeakActivityYear int `json:"peak_activity_year,omitempty"`
 	// PeakActivityCommits holds the value of the "peak_activity_commits" field.
 	PeakActivityCommits int `json:"peak_activity_commits,omitempty"`
+	// TotalExcludedReviews holds the value of the "total_excluded_reviews" field.
+	TotalExcludedReviews int `json:"total_excluded_reviews,omitempty"`
+	// TotalIssuesClosed holds the value of the "total_issues_closed" field.
+	TotalIssuesClosed int `json:"total_issues_closed,omitempty"`
+	// TotalIssueCloseSeconds holds the value of the "total_issue_close_seconds" field.
+	TotalIssueCloseSeconds int `json:"total_issue_close_seconds,omitempty"`
+	// TotalBugIssuesOpened holds the value of the "total_bug_issues_opened" field.
+	TotalBugIssuesOpened int `json:"total_bug_issues_opened,omitempty"`
+	// TotalFeatureIssuesOpened holds the value of the "total_feature_issues_opened" field.
+	TotalFeatureIssuesOpened int `json:"total_feature_issues_opened,omitempty"`
+	// TotalBugIssuesClosed holds the value of the "total_bug_issues_closed" field.
+	TotalBugIssuesClosed int `json:"total_bug_issues_closed,omitempty"`
+	// TotalFeatureIssuesClosed holds the value of the "total_feature_issues_closed" field.
+	TotalFeatureIssuesClosed int `json:"total_feature_issues_closed,omitempty"`
+	// TotalFeaturePrs holds the value of the "total_feature_prs" field.
+	TotalFeaturePrs int `json:"total_feature_prs,omitempty"`
+	// TotalBugPrs holds the value of the "total_bug_prs" field.
+	TotalBugPrs int `json:"total_bug_prs,omitempty"`
+	// TotalChorePrs holds the value of the "total_chore_prs" field.
+	TotalChorePrs int `json:"total_chore_prs,omitempty"`
+	// TotalDocsPrs holds the value of the "total_docs_prs" field.
+	TotalDocsPrs int `json:"total_docs_prs,omitempty"`
+	// TotalTestPrs holds the value of the "total_test_prs" field.
+	TotalTestPrs int `json:"total_test_prs,omitempty"`
+	// TotalInfraPrs holds the value of the "total_infra_prs" field.
+	TotalInfraPrs int `json:"total_infra_prs,omitempty"`
+	// TotalOtherPrs holds the value of the "total_other_prs" field.
+	TotalOtherPrs int `json:"total_other_prs,omitempty"`
 	// PrToReviewRatio holds the value of the "pr_to_review_ratio" field.
 	PrToReviewRatio float64 `json:"pr_to_review_ratio,omitempty"`
+	// TimeZone holds the value of the "time_zone" field.
+	TimeZone string `json:"time_zone,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the MemberStatQuery when eager-loading is set.
 	Edges                 MemberStatEdges `json:"edges"`
@@ -75,9 +107,9 @@ func (*MemberStat) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case memberstat.FieldPrToReviewRatio:
 			values[i] = new(sql.NullFloat64)
-		case memberstat.FieldID, memberstat.FieldTotalCommits, memberstat.FieldTotalPrCreated, memberstat.FieldTotalPrMerged, memberstat.FieldTotalIssues, memberstat.FieldTotalReviews, memberstat.FieldTotalAdditions, memberstat.FieldTotalDeletions, memberstat.FieldFirstActivityYear, memberstat.FieldPeakActivityYear, memberstat.FieldPeakActivityCommits:
+		case memberstat.FieldID, memberstat.FieldTotalCommits, memberstat.FieldTotalPrCreated, memberstat.FieldTotalPrMerged, memberstat.FieldTotalIssues, memberstat.FieldTotalReviews, memberstat.FieldTotalAdditions, memberstat.FieldTotalDeletions, memberstat.FieldFirstActivityYear, memberstat.FieldPeakActivityYear, memberstat.FieldPeakActivityCommits, memberstat.FieldTotalExcludedReviews, memberstat.FieldTotalIssuesClosed, memberstat.FieldTotalIssueCloseSeconds, memberstat.FieldTotalBugIssuesOpened, memberstat.FieldTotalFeatureIssuesOpened, memberstat.FieldTotalBugIssuesClosed, memberstat.FieldTotalFeatureIssuesClosed, memberstat.FieldTotalFeaturePrs, memberstat.FieldTotalBugPrs, memberstat.FieldTotalChorePrs, memberstat.FieldTotalDocsPrs, memberstat.FieldTotalTestPrs, memberstat.FieldTotalInfraPrs, memberstat.FieldTotalOtherPrs:
 			values[i] = new(sql.NullInt64)
-		case memberstat.FieldLogin:
+		case memberstat.FieldLogin, memberstat.FieldName, memberstat.FieldTimeZone:
 			values[i] = new(sql.NullString)
 		case memberstat.ForeignKeys[0]: // snapshot_member_stats
 			values[i] = new(sql.NullInt64)
@@ -108,6 +140,12 @@ func (_m *MemberStat) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Login = value.String
 			}
+		case memberstat.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
 		case memberstat.FieldTotalCommits:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field total_commits", values[i])
@@ -168,12 +206,102 @@ func (_m *MemberStat) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.PeakActivityCommits = int(value.Int64)
 			}
+		case memberstat.FieldTotalExcludedReviews:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_excluded_reviews", values[i])
+			} else if value.Valid {
+				_m.TotalExcludedReviews = int(value.Int64)
+			}
+		case memberstat.FieldTotalIssuesClosed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_issues_closed", values[i])
+			} else if value.Valid {
+				_m.TotalIssuesClosed = int(value.Int64)
+			}
+		case memberstat.FieldTotalIssueCloseSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_issue_close_seconds", values[i])
+			} else if value.Valid {
+				_m.TotalIssueCloseSeconds = int(value.Int64)
+			}
+		case memberstat.FieldTotalBugIssuesOpened:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_bug_issues_opened", values[i])
+			} else if value.Valid {
+				_m.TotalBugIssuesOpened = int(value.Int64)
+			}
+		case memberstat.FieldTotalFeatureIssuesOpened:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_feature_issues_opened", values[i])
+			} else if value.Valid {
+				_m.TotalFeatureIssuesOpened = int(value.Int64)
+			}
+		case memberstat.FieldTotalBugIssuesClosed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_bug_issues_closed", values[i])
+			} else if value.Valid {
+				_m.TotalBugIssuesClosed = int(value.Int64)
+			}
+		case memberstat.FieldTotalFeatureIssuesClosed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_feature_issues_closed", values[i])
+			} else if value.Valid {
+				_m.TotalFeatureIssuesClosed = int(value.Int64)
+			}
+		case memberstat.FieldTotalFeaturePrs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_feature_prs", values[i])
+			} else if value.Valid {
+				_m.TotalFeaturePrs = int(value.Int64)
+			}
+		case memberstat.FieldTotalBugPrs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_bug_prs", values[i])
+			} else if value.Valid {
+				_m.TotalBugPrs = int(value.Int64)
+			}
+		case memberstat.FieldTotalChorePrs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_chore_prs", values[i])
+			} else if value.Valid {
+				_m.TotalChorePrs = int(value.Int64)
+			}
+		case memberstat.FieldTotalDocsPrs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_docs_prs", values[i])
+			} else if value.Valid {
+				_m.TotalDocsPrs = int(value.Int64)
+			}
+		case memberstat.FieldTotalTestPrs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_test_prs", values[i])
+			} else if value.Valid {
+				_m.TotalTestPrs = int(value.Int64)
+			}
+		case memberstat.FieldTotalInfraPrs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_infra_prs", values[i])
+			} else if value.Valid {
+				_m.TotalInfraPrs = int(value.Int64)
+			}
+		case memberstat.FieldTotalOtherPrs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_other_prs", values[i])
+			} else if value.Valid {
+				_m.TotalOtherPrs = int(value.Int64)
+			}
 		case memberstat.FieldPrToReviewRatio:
 			if value, ok := values[i].(*sql.NullFloat64); !ok {
 				return fmt.Errorf("unexpected type %T for field pr_to_review_ratio", values[i])
 			} else if value.Valid {
 				_m.PrToReviewRatio = value.Float64
 			}
+		case memberstat.FieldTimeZone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field time_zone", values[i])
+			} else if value.Valid {
+				_m.TimeZone = value.String
+			}
 		case memberstat.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field snapshot_member_stats", value)
@@ -225,6 +353,9 @@ func (_m *MemberStat) String() string {
 	builder.WriteString("login=")
 	builder.WriteString(_m.Login)
 	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
 	builder.WriteString("total_commits=")
 	builder.WriteString(fmt.Sprintf("%v", _m.TotalCommits))
 	builder.WriteString(", ")
@@ -255,8 +386,53 @@ func (_m *MemberStat) String() string {
 	builder.WriteString("peak_activity_commits=")
 	builder.WriteString(fmt.Sprintf("%v", _m.PeakActivityCommits))
 	builder.WriteString(", ")
+	builder.WriteString("total_excluded_reviews=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalExcludedReviews))
+	builder.WriteString(", ")
+	builder.WriteString("total_issues_closed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalIssuesClosed))
+	builder.WriteString(", ")
+	builder.WriteString("total_issue_close_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalIssueCloseSeconds))
+	builder.WriteString(", ")
+	builder.WriteString("total_bug_issues_opened=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalBugIssuesOpened))
+	builder.WriteString(", ")
+	builder.WriteString("total_feature_issues_opened=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalFeatureIssuesOpened))
+	builder.WriteString(", ")
+	builder.WriteString("total_bug_issues_closed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalBugIssuesClosed))
+	builder.WriteString(", ")
+	builder.WriteString("total_feature_issues_closed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalFeatureIssuesClosed))
+	builder.WriteString(", ")
+	builder.WriteString("total_feature_prs=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalFeaturePrs))
+	builder.WriteString(", ")
+	builder.WriteString("total_bug_prs=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalBugPrs))
+	builder.WriteString(", ")
+	builder.WriteString("total_chore_prs=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalChorePrs))
+	builder.WriteString(", ")
+	builder.WriteString("total_docs_prs=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalDocsPrs))
+	builder.WriteString(", ")
+	builder.WriteString("total_test_prs=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalTestPrs))
+	builder.WriteString(", ")
+	builder.WriteString("total_infra_prs=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalInfraPrs))
+	builder.WriteString(", ")
+	builder.WriteString("total_other_prs=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalOtherPrs))
+	builder.WriteString(", ")
 	builder.WriteString("pr_to_review_ratio=")
 	builder.WriteString(fmt.Sprintf("%v", _m.PrToReviewRatio))
+	builder.WriteString(", ")
+	builder.WriteString("time_zone=")
+	builder.WriteString(_m.TimeZone)
 	builder.WriteByte(')')
 	return builder.String()
 }
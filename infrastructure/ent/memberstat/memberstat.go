@@ -14,6 +14,8 @@ const (
 	FieldID = "id"
 	// FieldLogin holds the string denoting the login field in the database.
 	FieldLogin = "login"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
 	// FieldTotalCommits holds the string denoting the total_commits field in the database.
 	FieldTotalCommits = "total_commits"
 	// FieldTotalPrCreated holds the string denoting the total_pr_created field in the database.
@@ -34,8 +36,38 @@ const (
 	FieldPeakActivityYear = "peak_activity_year"
 	// FieldPeakActivityCommits holds the string denoting the peak_activity_commits field in the database.
 	FieldPeakActivityCommits = "peak_activity_commits"
+	// FieldTotalExcludedReviews holds the string denoting the total_excluded_reviews field in the database.
+	FieldTotalExcludedReviews = "total_excluded_reviews"
+	// FieldTotalIssuesClosed holds the string denoting the total_issues_closed field in the database.
+	FieldTotalIssuesClosed = "total_issues_closed"
+	// FieldTotalIssueCloseSeconds holds the string denoting the total_issue_close_seconds field in the database.
+	FieldTotalIssueCloseSeconds = "total_issue_close_seconds"
+	// FieldTotalBugIssuesOpened holds the string denoting the total_bug_issues_opened field in the database.
+	FieldTotalBugIssuesOpened = "total_bug_issues_opened"
+	// FieldTotalFeatureIssuesOpened holds the string denoting the total_feature_issues_opened field in the database.
+	FieldTotalFeatureIssuesOpened = "total_feature_issues_opened"
+	// FieldTotalBugIssuesClosed holds the string denoting the total_bug_issues_closed field in the database.
+	FieldTotalBugIssuesClosed = "total_bug_issues_closed"
+	// FieldTotalFeatureIssuesClosed holds the string denoting the total_feature_issues_closed field in the database.
+	FieldTotalFeatureIssuesClosed = "total_feature_issues_closed"
+	// FieldTotalFeaturePrs holds the string denoting the total_feature_prs field in the database.
+	FieldTotalFeaturePrs = "total_feature_prs"
+	// FieldTotalBugPrs holds the string denoting the total_bug_prs field in the database.
+	FieldTotalBugPrs = "total_bug_prs"
+	// FieldTotalChorePrs holds the string denoting the total_chore_prs field in the database.
+	FieldTotalChorePrs = "total_chore_prs"
+	// FieldTotalDocsPrs holds the string denoting the total_docs_prs field in the database.
+	FieldTotalDocsPrs = "total_docs_prs"
+	// FieldTotalTestPrs holds the string denoting the total_test_prs field in the database.
+	FieldTotalTestPrs = "total_test_prs"
+	// FieldTotalInfraPrs holds the string denoting the total_infra_prs field in the database.
+	FieldTotalInfraPrs = "total_infra_prs"
+	// FieldTotalOtherPrs holds the string denoting the total_other_prs field in the database.
+	FieldTotalOtherPrs = "total_other_prs"
 	// FieldPrToReviewRatio holds the string denoting the pr_to_review_ratio field in the database.
 	FieldPrToReviewRatio = "pr_to_review_ratio"
+	// FieldTimeZone holds the string denoting the time_zone field in the database.
+	FieldTimeZone = "time_zone"
 	// EdgeSnapshot holds the string denoting the snapshot edge name in mutations.
 	EdgeSnapshot = "snapshot"
 	// Table holds the table name of the memberstat in the database.
@@ -53,6 +85,7 @@ const (
 var Columns = []string{
 	FieldID,
 	FieldLogin,
+	FieldName,
 	FieldTotalCommits,
 	FieldTotalPrCreated,
 	FieldTotalPrMerged,
@@ -63,7 +96,22 @@ var Columns = []string{
 	FieldFirstActivityYear,
 	FieldPeakActivityYear,
 	FieldPeakActivityCommits,
+	FieldTotalExcludedReviews,
+	FieldTotalIssuesClosed,
+	FieldTotalIssueCloseSeconds,
+	FieldTotalBugIssuesOpened,
+	FieldTotalFeatureIssuesOpened,
+	FieldTotalBugIssuesClosed,
+	FieldTotalFeatureIssuesClosed,
+	FieldTotalFeaturePrs,
+	FieldTotalBugPrs,
+	FieldTotalChorePrs,
+	FieldTotalDocsPrs,
+	FieldTotalTestPrs,
+	FieldTotalInfraPrs,
+	FieldTotalOtherPrs,
 	FieldPrToReviewRatio,
+	FieldTimeZone,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "member_stats"
@@ -90,6 +138,8 @@ func ValidColumn(column string) bool {
 var (
 	// LoginValidator is a validator for the "login" field. It is called by the builders before save.
 	LoginValidator func(string) error
+	// DefaultName holds the default value on creation for the "name" field.
+	DefaultName string
 	// DefaultTotalCommits holds the default value on creation for the "total_commits" field.
 	DefaultTotalCommits int
 	// DefaultTotalPrCreated holds the default value on creation for the "total_pr_created" field.
@@ -110,8 +160,38 @@ var (
 	DefaultPeakActivityYear int
 	// DefaultPeakActivityCommits holds the default value on creation for the "peak_activity_commits" field.
 	DefaultPeakActivityCommits int
+	// DefaultTotalExcludedReviews holds the default value on creation for the "total_excluded_reviews" field.
+	DefaultTotalExcludedReviews int
+	// DefaultTotalIssuesClosed holds the default value on creation for the "total_issues_closed" field.
+	DefaultTotalIssuesClosed int
+	// DefaultTotalIssueCloseSeconds holds the default value on creation for the "total_issue_close_seconds" field.
+	DefaultTotalIssueCloseSeconds int
+	// DefaultTotalBugIssuesOpened holds the default value on creation for the "total_bug_issues_opened" field.
+	DefaultTotalBugIssuesOpened int
+	// DefaultTotalFeatureIssuesOpened holds the default value on creation for the "total_feature_issues_opened" field.
+	DefaultTotalFeatureIssuesOpened int
+	// DefaultTotalBugIssuesClosed holds the default value on creation for the "total_bug_issues_closed" field.
+	DefaultTotalBugIssuesClosed int
+	// DefaultTotalFeatureIssuesClosed holds the default value on creation for the "total_feature_issues_closed" field.
+	DefaultTotalFeatureIssuesClosed int
+	// DefaultTotalFeaturePrs holds the default value on creation for the "total_feature_prs" field.
+	DefaultTotalFeaturePrs int
+	// DefaultTotalBugPrs holds the default value on creation for the "total_bug_prs" field.
+	DefaultTotalBugPrs int
+	// DefaultTotalChorePrs holds the default value on creation for the "total_chore_prs" field.
+	DefaultTotalChorePrs int
+	// DefaultTotalDocsPrs holds the default value on creation for the "total_docs_prs" field.
+	DefaultTotalDocsPrs int
+	// DefaultTotalTestPrs holds the default value on creation for the "total_test_prs" field.
+	DefaultTotalTestPrs int
+	// DefaultTotalInfraPrs holds the default value on creation for the "total_infra_prs" field.
+	DefaultTotalInfraPrs int
+	// DefaultTotalOtherPrs holds the default value on creation for the "total_other_prs" field.
+	DefaultTotalOtherPrs int
 	// DefaultPrToReviewRatio holds the default value on creation for the "pr_to_review_ratio" field.
 	DefaultPrToReviewRatio float64
+	// DefaultTimeZone holds the default value on creation for the "time_zone" field.
+	DefaultTimeZone string
 )
 
 // OrderOption defines the ordering options for the MemberStat queries.
@@ -127,6 +207,11 @@ func ByLogin(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLogin, opts...).ToFunc()
 }
 
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
 // ByTotalCommits orders the results by the total_commits field.
 func ByTotalCommits(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTotalCommits, opts...).ToFunc()
@@ -177,11 +262,86 @@ func ByPeakActivityCommits(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPeakActivityCommits, opts...).ToFunc()
 }
 
+// ByTotalExcludedReviews orders the results by the total_excluded_reviews field.
+func ByTotalExcludedReviews(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalExcludedReviews, opts...).ToFunc()
+}
+
+// ByTotalIssuesClosed orders the results by the total_issues_closed field.
+func ByTotalIssuesClosed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalIssuesClosed, opts...).ToFunc()
+}
+
+// ByTotalIssueCloseSeconds orders the results by the total_issue_close_seconds field.
+func ByTotalIssueCloseSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalIssueCloseSeconds, opts...).ToFunc()
+}
+
+// ByTotalBugIssuesOpened orders the results by the total_bug_issues_opened field.
+func ByTotalBugIssuesOpened(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalBugIssuesOpened, opts...).ToFunc()
+}
+
+// ByTotalFeatureIssuesOpened orders the results by the total_feature_issues_opened field.
+func ByTotalFeatureIssuesOpened(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalFeatureIssuesOpened, opts...).ToFunc()
+}
+
+// ByTotalBugIssuesClosed orders the results by the total_bug_issues_closed field.
+func ByTotalBugIssuesClosed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalBugIssuesClosed, opts...).ToFunc()
+}
+
+// ByTotalFeatureIssuesClosed orders the results by the total_feature_issues_closed field.
+func ByTotalFeatureIssuesClosed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalFeatureIssuesClosed, opts...).ToFunc()
+}
+
+// ByTotalFeaturePrs orders the results by the total_feature_prs field.
+func ByTotalFeaturePrs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalFeaturePrs, opts...).ToFunc()
+}
+
+// ByTotalBugPrs orders the results by the total_bug_prs field.
+func ByTotalBugPrs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalBugPrs, opts...).ToFunc()
+}
+
+// ByTotalChorePrs orders the results by the total_chore_prs field.
+func ByTotalChorePrs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalChorePrs, opts...).ToFunc()
+}
+
+// ByTotalDocsPrs orders the results by the total_docs_prs field.
+func ByTotalDocsPrs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalDocsPrs, opts...).ToFunc()
+}
+
+// ByTotalTestPrs orders the results by the total_test_prs field.
+func ByTotalTestPrs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalTestPrs, opts...).ToFunc()
+}
+
+// ByTotalInfraPrs orders the results by the total_infra_prs field.
+func ByTotalInfraPrs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalInfraPrs, opts...).ToFunc()
+}
+
+// ByTotalOtherPrs orders the results by the total_other_prs field.
+func ByTotalOtherPrs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalOtherPrs, opts...).ToFunc()
+}
+
 // ByPrToReviewRatio orders the results by the pr_to_review_ratio field.
 func ByPrToReviewRatio(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPrToReviewRatio, opts...).ToFunc()
 }
 
+// ByTimeZone orders the results by the time_zone field.
+func ByTimeZone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimeZone, opts...).ToFunc()
+}
+
 // BySnapshotField orders the results by snapshot field.
 func BySnapshotField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -58,6 +58,11 @@ func Login(v string) predicate.MemberStat {
 	return predicate.MemberStat(sql.FieldEQ(FieldLogin, v))
 }
 
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldEQ(FieldName, v))
+}
+
 // TotalCommits applies equality check predicate on the "total_commits" field. It's identical to TotalCommitsEQ.
 func TotalCommits(v int) predicate.MemberStat {
 	return predicate.MemberStat(sql.FieldEQ(FieldTotalCommits, v))
@@ -108,11 +113,86 @@ func PeakActivityCommits(v int) predicate.MemberStat {
 	return predicate.MemberStat(sql.FieldEQ(FieldPeakActivityCommits, v))
 }
 
+// TotalExcludedReviews applies equality check predicate on the "total_excluded_reviews" field. It's identical to TotalExcludedReviewsEQ.
+func TotalExcludedReviews(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldEQ(FieldTotalExcludedReviews, v))
+}
+
+// TotalIssuesClosed applies equality check predicate on the "total_issues_closed" field. It's identical to TotalIssuesClosedEQ.
+func TotalIssuesClosed(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldEQ(FieldTotalIssuesClosed, v))
+}
+
+// TotalIssueCloseSeconds applies equality check predicate on the "total_issue_close_seconds" field. It's identical to TotalIssueCloseSecondsEQ.
+func TotalIssueCloseSeconds(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldEQ(FieldTotalIssueCloseSeconds, v))
+}
+
+// TotalBugIssuesOpened applies equality check predicate on the "total_bug_issues_opened" field. It's identical to TotalBugIssuesOpenedEQ.
+func TotalBugIssuesOpened(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldEQ(FieldTotalBugIssuesOpened, v))
+}
+
+// TotalFeatureIssuesOpened applies equality check predicate on the "total_feature_issues_opened" field. It's identical to TotalFeatureIssuesOpenedEQ.
+func TotalFeatureIssuesOpened(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldEQ(FieldTotalFeatureIssuesOpened, v))
+}
+
+// TotalBugIssuesClosed applies equality check predicate on the "total_bug_issues_closed" field. It's identical to TotalBugIssuesClosedEQ.
+func TotalBugIssuesClosed(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldEQ(FieldTotalBugIssuesClosed, v))
+}
+
+// TotalFeatureIssuesClosed applies equality check predicate on the "total_feature_issues_closed" field. It's identical to TotalFeatureIssuesClosedEQ.
+func TotalFeatureIssuesClosed(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldEQ(FieldTotalFeatureIssuesClosed, v))
+}
+
+// TotalFeaturePrs applies equality check predicate on the "total_feature_prs" field. It's identical to TotalFeaturePrsEQ.
+func TotalFeaturePrs(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldEQ(FieldTotalFeaturePrs, v))
+}
+
+// TotalBugPrs applies equality check predicate on the "total_bug_prs" field. It's identical to TotalBugPrsEQ.
+func TotalBugPrs(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldEQ(FieldTotalBugPrs, v))
+}
+
+// TotalChorePrs applies equality check predicate on the "total_chore_prs" field. It's identical to TotalChorePrsEQ.
+func TotalChorePrs(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldEQ(FieldTotalChorePrs, v))
+}
+
+// TotalDocsPrs applies equality check predicate on the "total_docs_prs" field. It's identical to TotalDocsPrsEQ.
+func TotalDocsPrs(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldEQ(FieldTotalDocsPrs, v))
+}
+
+// TotalTestPrs applies equality check predicate on the "total_test_prs" field. It's identical to TotalTestPrsEQ.
+func TotalTestPrs(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldEQ(FieldTotalTestPrs, v))
+}
+
+// TotalInfraPrs applies equality check predicate on the "total_infra_prs" field. It's identical to TotalInfraPrsEQ.
+func TotalInfraPrs(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldEQ(FieldTotalInfraPrs, v))
+}
+
+// TotalOtherPrs applies equality check predicate on the "total_other_prs" field. It's identical to TotalOtherPrsEQ.
+func TotalOtherPrs(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldEQ(FieldTotalOtherPrs, v))
+}
+
 // PrToReviewRatio applies equality check predicate on the "pr_to_review_ratio" field. It's identical to PrToReviewRatioEQ.
 func PrToReviewRatio(v float64) predicate.MemberStat {
 	return predicate.MemberStat(sql.FieldEQ(FieldPrToReviewRatio, v))
 }
 
+// TimeZone applies equality check predicate on the "time_zone" field. It's identical to TimeZoneEQ.
+func TimeZone(v string) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldEQ(FieldTimeZone, v))
+}
+
 // LoginEQ applies the EQ predicate on the "login" field.
 func LoginEQ(v string) predicate.MemberStat {
 	return predicate.MemberStat(sql.FieldEQ(FieldLogin, v))
@@ -178,6 +258,71 @@ func LoginContainsFold(v string) predicate.MemberStat {
 	return predicate.MemberStat(sql.FieldContainsFold(FieldLogin, v))
 }
 
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldContainsFold(FieldName, v))
+}
+
 // TotalCommitsEQ applies the EQ predicate on the "total_commits" field.
 func TotalCommitsEQ(v int) predicate.MemberStat {
 	return predicate.MemberStat(sql.FieldEQ(FieldTotalCommits, v))
@@ -578,6 +723,566 @@ func PeakActivityCommitsLTE(v int) predicate.MemberStat {
 	return predicate.MemberStat(sql.FieldLTE(FieldPeakActivityCommits, v))
 }
 
+// TotalExcludedReviewsEQ applies the EQ predicate on the "total_excluded_reviews" field.
+func TotalExcludedReviewsEQ(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldEQ(FieldTotalExcludedReviews, v))
+}
+
+// TotalExcludedReviewsNEQ applies the NEQ predicate on the "total_excluded_reviews" field.
+func TotalExcludedReviewsNEQ(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldNEQ(FieldTotalExcludedReviews, v))
+}
+
+// TotalExcludedReviewsIn applies the In predicate on the "total_excluded_reviews" field.
+func TotalExcludedReviewsIn(vs ...int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldIn(FieldTotalExcludedReviews, vs...))
+}
+
+// TotalExcludedReviewsNotIn applies the NotIn predicate on the "total_excluded_reviews" field.
+func TotalExcludedReviewsNotIn(vs ...int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldNotIn(FieldTotalExcludedReviews, vs...))
+}
+
+// TotalExcludedReviewsGT applies the GT predicate on the "total_excluded_reviews" field.
+func TotalExcludedReviewsGT(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldGT(FieldTotalExcludedReviews, v))
+}
+
+// TotalExcludedReviewsGTE applies the GTE predicate on the "total_excluded_reviews" field.
+func TotalExcludedReviewsGTE(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldGTE(FieldTotalExcludedReviews, v))
+}
+
+// TotalExcludedReviewsLT applies the LT predicate on the "total_excluded_reviews" field.
+func TotalExcludedReviewsLT(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldLT(FieldTotalExcludedReviews, v))
+}
+
+// TotalExcludedReviewsLTE applies the LTE predicate on the "total_excluded_reviews" field.
+func TotalExcludedReviewsLTE(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldLTE(FieldTotalExcludedReviews, v))
+}
+
+// TotalIssuesClosedEQ applies the EQ predicate on the "total_issues_closed" field.
+func TotalIssuesClosedEQ(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldEQ(FieldTotalIssuesClosed, v))
+}
+
+// TotalIssuesClosedNEQ applies the NEQ predicate on the "total_issues_closed" field.
+func TotalIssuesClosedNEQ(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldNEQ(FieldTotalIssuesClosed, v))
+}
+
+// TotalIssuesClosedIn applies the In predicate on the "total_issues_closed" field.
+func TotalIssuesClosedIn(vs ...int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldIn(FieldTotalIssuesClosed, vs...))
+}
+
+// TotalIssuesClosedNotIn applies the NotIn predicate on the "total_issues_closed" field.
+func TotalIssuesClosedNotIn(vs ...int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldNotIn(FieldTotalIssuesClosed, vs...))
+}
+
+// TotalIssuesClosedGT applies the GT predicate on the "total_issues_closed" field.
+func TotalIssuesClosedGT(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldGT(FieldTotalIssuesClosed, v))
+}
+
+// TotalIssuesClosedGTE applies the GTE predicate on the "total_issues_closed" field.
+func TotalIssuesClosedGTE(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldGTE(FieldTotalIssuesClosed, v))
+}
+
+// TotalIssuesClosedLT applies the LT predicate on the "total_issues_closed" field.
+func TotalIssuesClosedLT(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldLT(FieldTotalIssuesClosed, v))
+}
+
+// TotalIssuesClosedLTE applies the LTE predicate on the "total_issues_closed" field.
+func TotalIssuesClosedLTE(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldLTE(FieldTotalIssuesClosed, v))
+}
+
+// TotalIssueCloseSecondsEQ applies the EQ predicate on the "total_issue_close_seconds" field.
+func TotalIssueCloseSecondsEQ(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldEQ(FieldTotalIssueCloseSeconds, v))
+}
+
+// TotalIssueCloseSecondsNEQ applies the NEQ predicate on the "total_issue_close_seconds" field.
+func TotalIssueCloseSecondsNEQ(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldNEQ(FieldTotalIssueCloseSeconds, v))
+}
+
+// TotalIssueCloseSecondsIn applies the In predicate on the "total_issue_close_seconds" field.
+func TotalIssueCloseSecondsIn(vs ...int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldIn(FieldTotalIssueCloseSeconds, vs...))
+}
+
+// TotalIssueCloseSecondsNotIn applies the NotIn predicate on the "total_issue_close_seconds" field.
+func TotalIssueCloseSecondsNotIn(vs ...int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldNotIn(FieldTotalIssueCloseSeconds, vs...))
+}
+
+// TotalIssueCloseSecondsGT applies the GT predicate on the "total_issue_close_seconds" field.
+func TotalIssueCloseSecondsGT(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldGT(FieldTotalIssueCloseSeconds, v))
+}
+
+// TotalIssueCloseSecondsGTE applies the GTE predicate on the "total_issue_close_seconds" field.
+func TotalIssueCloseSecondsGTE(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldGTE(FieldTotalIssueCloseSeconds, v))
+}
+
+// TotalIssueCloseSecondsLT applies the LT predicate on the "total_issue_close_seconds" field.
+func TotalIssueCloseSecondsLT(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldLT(FieldTotalIssueCloseSeconds, v))
+}
+
+// TotalIssueCloseSecondsLTE applies the LTE predicate on the "total_issue_close_seconds" field.
+func TotalIssueCloseSecondsLTE(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldLTE(FieldTotalIssueCloseSeconds, v))
+}
+
+// TotalBugIssuesOpenedEQ applies the EQ predicate on the "total_bug_issues_opened" field.
+func TotalBugIssuesOpenedEQ(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldEQ(FieldTotalBugIssuesOpened, v))
+}
+
+// TotalBugIssuesOpenedNEQ applies the NEQ predicate on the "total_bug_issues_opened" field.
+func TotalBugIssuesOpenedNEQ(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldNEQ(FieldTotalBugIssuesOpened, v))
+}
+
+// TotalBugIssuesOpenedIn applies the In predicate on the "total_bug_issues_opened" field.
+func TotalBugIssuesOpenedIn(vs ...int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldIn(FieldTotalBugIssuesOpened, vs...))
+}
+
+// TotalBugIssuesOpenedNotIn applies the NotIn predicate on the "total_bug_issues_opened" field.
+func TotalBugIssuesOpenedNotIn(vs ...int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldNotIn(FieldTotalBugIssuesOpened, vs...))
+}
+
+// TotalBugIssuesOpenedGT applies the GT predicate on the "total_bug_issues_opened" field.
+func TotalBugIssuesOpenedGT(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldGT(FieldTotalBugIssuesOpened, v))
+}
+
+// TotalBugIssuesOpenedGTE applies the GTE predicate on the "total_bug_issues_opened" field.
+func TotalBugIssuesOpenedGTE(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldGTE(FieldTotalBugIssuesOpened, v))
+}
+
+// TotalBugIssuesOpenedLT applies the LT predicate on the "total_bug_issues_opened" field.
+func TotalBugIssuesOpenedLT(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldLT(FieldTotalBugIssuesOpened, v))
+}
+
+// TotalBugIssuesOpenedLTE applies the LTE predicate on the "total_bug_issues_opened" field.
+func TotalBugIssuesOpenedLTE(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldLTE(FieldTotalBugIssuesOpened, v))
+}
+
+// TotalFeatureIssuesOpenedEQ applies the EQ predicate on the "total_feature_issues_opened" field.
+func TotalFeatureIssuesOpenedEQ(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldEQ(FieldTotalFeatureIssuesOpened, v))
+}
+
+// TotalFeatureIssuesOpenedNEQ applies the NEQ predicate on the "total_feature_issues_opened" field.
+func TotalFeatureIssuesOpenedNEQ(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldNEQ(FieldTotalFeatureIssuesOpened, v))
+}
+
+// TotalFeatureIssuesOpenedIn applies the In predicate on the "total_feature_issues_opened" field.
+func TotalFeatureIssuesOpenedIn(vs ...int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldIn(FieldTotalFeatureIssuesOpened, vs...))
+}
+
+// TotalFeatureIssuesOpenedNotIn applies the NotIn predicate on the "total_feature_issues_opened" field.
+func TotalFeatureIssuesOpenedNotIn(vs ...int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldNotIn(FieldTotalFeatureIssuesOpened, vs...))
+}
+
+// TotalFeatureIssuesOpenedGT applies the GT predicate on the "total_feature_issues_opened" field.
+func TotalFeatureIssuesOpenedGT(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldGT(FieldTotalFeatureIssuesOpened, v))
+}
+
+// TotalFeatureIssuesOpenedGTE applies the GTE predicate on the "total_feature_issues_opened" field.
+func TotalFeatureIssuesOpenedGTE(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldGTE(FieldTotalFeatureIssuesOpened, v))
+}
+
+// TotalFeatureIssuesOpenedLT applies the LT predicate on the "total_feature_issues_opened" field.
+func TotalFeatureIssuesOpenedLT(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldLT(FieldTotalFeatureIssuesOpened, v))
+}
+
+// TotalFeatureIssuesOpenedLTE applies the LTE predicate on the "total_feature_issues_opened" field.
+func TotalFeatureIssuesOpenedLTE(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldLTE(FieldTotalFeatureIssuesOpened, v))
+}
+
+// TotalBugIssuesClosedEQ applies the EQ predicate on the "total_bug_issues_closed" field.
+func TotalBugIssuesClosedEQ(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldEQ(FieldTotalBugIssuesClosed, v))
+}
+
+// TotalBugIssuesClosedNEQ applies the NEQ predicate on the "total_bug_issues_closed" field.
+func TotalBugIssuesClosedNEQ(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldNEQ(FieldTotalBugIssuesClosed, v))
+}
+
+// TotalBugIssuesClosedIn applies the In predicate on the "total_bug_issues_closed" field.
+func TotalBugIssuesClosedIn(vs ...int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldIn(FieldTotalBugIssuesClosed, vs...))
+}
+
+// TotalBugIssuesClosedNotIn applies the NotIn predicate on the "total_bug_issues_closed" field.
+func TotalBugIssuesClosedNotIn(vs ...int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldNotIn(FieldTotalBugIssuesClosed, vs...))
+}
+
+// TotalBugIssuesClosedGT applies the GT predicate on the "total_bug_issues_closed" field.
+func TotalBugIssuesClosedGT(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldGT(FieldTotalBugIssuesClosed, v))
+}
+
+// TotalBugIssuesClosedGTE applies the GTE predicate on the "total_bug_issues_closed" field.
+func TotalBugIssuesClosedGTE(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldGTE(FieldTotalBugIssuesClosed, v))
+}
+
+// TotalBugIssuesClosedLT applies the LT predicate on the "total_bug_issues_closed" field.
+func TotalBugIssuesClosedLT(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldLT(FieldTotalBugIssuesClosed, v))
+}
+
+// TotalBugIssuesClosedLTE applies the LTE predicate on the "total_bug_issues_closed" field.
+func TotalBugIssuesClosedLTE(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldLTE(FieldTotalBugIssuesClosed, v))
+}
+
+// TotalFeatureIssuesClosedEQ applies the EQ predicate on the "total_feature_issues_closed" field.
+func TotalFeatureIssuesClosedEQ(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldEQ(FieldTotalFeatureIssuesClosed, v))
+}
+
+// TotalFeatureIssuesClosedNEQ applies the NEQ predicate on the "total_feature_issues_closed" field.
+func TotalFeatureIssuesClosedNEQ(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldNEQ(FieldTotalFeatureIssuesClosed, v))
+}
+
+// TotalFeatureIssuesClosedIn applies the In predicate on the "total_feature_issues_closed" field.
+func TotalFeatureIssuesClosedIn(vs ...int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldIn(FieldTotalFeatureIssuesClosed, vs...))
+}
+
+// TotalFeatureIssuesClosedNotIn applies the NotIn predicate on the "total_feature_issues_closed" field.
+func TotalFeatureIssuesClosedNotIn(vs ...int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldNotIn(FieldTotalFeatureIssuesClosed, vs...))
+}
+
+// TotalFeatureIssuesClosedGT applies the GT predicate on the "total_feature_issues_closed" field.
+func TotalFeatureIssuesClosedGT(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldGT(FieldTotalFeatureIssuesClosed, v))
+}
+
+// TotalFeatureIssuesClosedGTE applies the GTE predicate on the "total_feature_issues_closed" field.
+func TotalFeatureIssuesClosedGTE(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldGTE(FieldTotalFeatureIssuesClosed, v))
+}
+
+// TotalFeatureIssuesClosedLT applies the LT predicate on the "total_feature_issues_closed" field.
+func TotalFeatureIssuesClosedLT(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldLT(FieldTotalFeatureIssuesClosed, v))
+}
+
+// TotalFeatureIssuesClosedLTE applies the LTE predicate on the "total_feature_issues_closed" field.
+func TotalFeatureIssuesClosedLTE(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldLTE(FieldTotalFeatureIssuesClosed, v))
+}
+
+// TotalFeaturePrsEQ applies the EQ predicate on the "total_feature_prs" field.
+func TotalFeaturePrsEQ(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldEQ(FieldTotalFeaturePrs, v))
+}
+
+// TotalFeaturePrsNEQ applies the NEQ predicate on the "total_feature_prs" field.
+func TotalFeaturePrsNEQ(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldNEQ(FieldTotalFeaturePrs, v))
+}
+
+// TotalFeaturePrsIn applies the In predicate on the "total_feature_prs" field.
+func TotalFeaturePrsIn(vs ...int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldIn(FieldTotalFeaturePrs, vs...))
+}
+
+// TotalFeaturePrsNotIn applies the NotIn predicate on the "total_feature_prs" field.
+func TotalFeaturePrsNotIn(vs ...int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldNotIn(FieldTotalFeaturePrs, vs...))
+}
+
+// TotalFeaturePrsGT applies the GT predicate on the "total_feature_prs" field.
+func TotalFeaturePrsGT(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldGT(FieldTotalFeaturePrs, v))
+}
+
+// TotalFeaturePrsGTE applies the GTE predicate on the "total_feature_prs" field.
+func TotalFeaturePrsGTE(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldGTE(FieldTotalFeaturePrs, v))
+}
+
+// TotalFeaturePrsLT applies the LT predicate on the "total_feature_prs" field.
+func TotalFeaturePrsLT(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldLT(FieldTotalFeaturePrs, v))
+}
+
+// TotalFeaturePrsLTE applies the LTE predicate on the "total_feature_prs" field.
+func TotalFeaturePrsLTE(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldLTE(FieldTotalFeaturePrs, v))
+}
+
+// TotalBugPrsEQ applies the EQ predicate on the "total_bug_prs" field.
+func TotalBugPrsEQ(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldEQ(FieldTotalBugPrs, v))
+}
+
+// TotalBugPrsNEQ applies the NEQ predicate on the "total_bug_prs" field.
+func TotalBugPrsNEQ(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldNEQ(FieldTotalBugPrs, v))
+}
+
+// TotalBugPrsIn applies the In predicate on the "total_bug_prs" field.
+func TotalBugPrsIn(vs ...int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldIn(FieldTotalBugPrs, vs...))
+}
+
+// TotalBugPrsNotIn applies the NotIn predicate on the "total_bug_prs" field.
+func TotalBugPrsNotIn(vs ...int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldNotIn(FieldTotalBugPrs, vs...))
+}
+
+// TotalBugPrsGT applies the GT predicate on the "total_bug_prs" field.
+func TotalBugPrsGT(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldGT(FieldTotalBugPrs, v))
+}
+
+// TotalBugPrsGTE applies the GTE predicate on the "total_bug_prs" field.
+func TotalBugPrsGTE(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldGTE(FieldTotalBugPrs, v))
+}
+
+// TotalBugPrsLT applies the LT predicate on the "total_bug_prs" field.
+func TotalBugPrsLT(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldLT(FieldTotalBugPrs, v))
+}
+
+// TotalBugPrsLTE applies the LTE predicate on the "total_bug_prs" field.
+func TotalBugPrsLTE(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldLTE(FieldTotalBugPrs, v))
+}
+
+// TotalChorePrsEQ applies the EQ predicate on the "total_chore_prs" field.
+func TotalChorePrsEQ(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldEQ(FieldTotalChorePrs, v))
+}
+
+// TotalChorePrsNEQ applies the NEQ predicate on the "total_chore_prs" field.
+func TotalChorePrsNEQ(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldNEQ(FieldTotalChorePrs, v))
+}
+
+// TotalChorePrsIn applies the In predicate on the "total_chore_prs" field.
+func TotalChorePrsIn(vs ...int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldIn(FieldTotalChorePrs, vs...))
+}
+
+// TotalChorePrsNotIn applies the NotIn predicate on the "total_chore_prs" field.
+func TotalChorePrsNotIn(vs ...int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldNotIn(FieldTotalChorePrs, vs...))
+}
+
+// TotalChorePrsGT applies the GT predicate on the "total_chore_prs" field.
+func TotalChorePrsGT(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldGT(FieldTotalChorePrs, v))
+}
+
+// TotalChorePrsGTE applies the GTE predicate on the "total_chore_prs" field.
+func TotalChorePrsGTE(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldGTE(FieldTotalChorePrs, v))
+}
+
+// TotalChorePrsLT applies the LT predicate on the "total_chore_prs" field.
+func TotalChorePrsLT(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldLT(FieldTotalChorePrs, v))
+}
+
+// TotalChorePrsLTE applies the LTE predicate on the "total_chore_prs" field.
+func TotalChorePrsLTE(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldLTE(FieldTotalChorePrs, v))
+}
+
+// TotalDocsPrsEQ applies the EQ predicate on the "total_docs_prs" field.
+func TotalDocsPrsEQ(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldEQ(FieldTotalDocsPrs, v))
+}
+
+// TotalDocsPrsNEQ applies the NEQ predicate on the "total_docs_prs" field.
+func TotalDocsPrsNEQ(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldNEQ(FieldTotalDocsPrs, v))
+}
+
+// TotalDocsPrsIn applies the In predicate on the "total_docs_prs" field.
+func TotalDocsPrsIn(vs ...int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldIn(FieldTotalDocsPrs, vs...))
+}
+
+// TotalDocsPrsNotIn applies the NotIn predicate on the "total_docs_prs" field.
+func TotalDocsPrsNotIn(vs ...int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldNotIn(FieldTotalDocsPrs, vs...))
+}
+
+// TotalDocsPrsGT applies the GT predicate on the "total_docs_prs" field.
+func TotalDocsPrsGT(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldGT(FieldTotalDocsPrs, v))
+}
+
+// TotalDocsPrsGTE applies the GTE predicate on the "total_docs_prs" field.
+func TotalDocsPrsGTE(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldGTE(FieldTotalDocsPrs, v))
+}
+
+// TotalDocsPrsLT applies the LT predicate on the "total_docs_prs" field.
+func TotalDocsPrsLT(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldLT(FieldTotalDocsPrs, v))
+}
+
+// TotalDocsPrsLTE applies the LTE predicate on the "total_docs_prs" field.
+func TotalDocsPrsLTE(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldLTE(FieldTotalDocsPrs, v))
+}
+
+// TotalTestPrsEQ applies the EQ predicate on the "total_test_prs" field.
+func TotalTestPrsEQ(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldEQ(FieldTotalTestPrs, v))
+}
+
+// TotalTestPrsNEQ applies the NEQ predicate on the "total_test_prs" field.
+func TotalTestPrsNEQ(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldNEQ(FieldTotalTestPrs, v))
+}
+
+// TotalTestPrsIn applies the In predicate on the "total_test_prs" field.
+func TotalTestPrsIn(vs ...int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldIn(FieldTotalTestPrs, vs...))
+}
+
+// TotalTestPrsNotIn applies the NotIn predicate on the "total_test_prs" field.
+func TotalTestPrsNotIn(vs ...int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldNotIn(FieldTotalTestPrs, vs...))
+}
+
+// TotalTestPrsGT applies the GT predicate on the "total_test_prs" field.
+func TotalTestPrsGT(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldGT(FieldTotalTestPrs, v))
+}
+
+// TotalTestPrsGTE applies the GTE predicate on the "total_test_prs" field.
+func TotalTestPrsGTE(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldGTE(FieldTotalTestPrs, v))
+}
+
+// TotalTestPrsLT applies the LT predicate on the "total_test_prs" field.
+func TotalTestPrsLT(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldLT(FieldTotalTestPrs, v))
+}
+
+// TotalTestPrsLTE applies the LTE predicate on the "total_test_prs" field.
+func TotalTestPrsLTE(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldLTE(FieldTotalTestPrs, v))
+}
+
+// TotalInfraPrsEQ applies the EQ predicate on the "total_infra_prs" field.
+func TotalInfraPrsEQ(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldEQ(FieldTotalInfraPrs, v))
+}
+
+// TotalInfraPrsNEQ applies the NEQ predicate on the "total_infra_prs" field.
+func TotalInfraPrsNEQ(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldNEQ(FieldTotalInfraPrs, v))
+}
+
+// TotalInfraPrsIn applies the In predicate on the "total_infra_prs" field.
+func TotalInfraPrsIn(vs ...int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldIn(FieldTotalInfraPrs, vs...))
+}
+
+// TotalInfraPrsNotIn applies the NotIn predicate on the "total_infra_prs" field.
+func TotalInfraPrsNotIn(vs ...int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldNotIn(FieldTotalInfraPrs, vs...))
+}
+
+// TotalInfraPrsGT applies the GT predicate on the "total_infra_prs" field.
+func TotalInfraPrsGT(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldGT(FieldTotalInfraPrs, v))
+}
+
+// TotalInfraPrsGTE applies the GTE predicate on the "total_infra_prs" field.
+func TotalInfraPrsGTE(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldGTE(FieldTotalInfraPrs, v))
+}
+
+// TotalInfraPrsLT applies the LT predicate on the "total_infra_prs" field.
+func TotalInfraPrsLT(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldLT(FieldTotalInfraPrs, v))
+}
+
+// TotalInfraPrsLTE applies the LTE predicate on the "total_infra_prs" field.
+func TotalInfraPrsLTE(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldLTE(FieldTotalInfraPrs, v))
+}
+
+// TotalOtherPrsEQ applies the EQ predicate on the "total_other_prs" field.
+func TotalOtherPrsEQ(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldEQ(FieldTotalOtherPrs, v))
+}
+
+// TotalOtherPrsNEQ applies the NEQ predicate on the "total_other_prs" field.
+func TotalOtherPrsNEQ(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldNEQ(FieldTotalOtherPrs, v))
+}
+
+// TotalOtherPrsIn applies the In predicate on the "total_other_prs" field.
+func TotalOtherPrsIn(vs ...int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldIn(FieldTotalOtherPrs, vs...))
+}
+
+// TotalOtherPrsNotIn applies the NotIn predicate on the "total_other_prs" field.
+func TotalOtherPrsNotIn(vs ...int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldNotIn(FieldTotalOtherPrs, vs...))
+}
+
+// TotalOtherPrsGT applies the GT predicate on the "total_other_prs" field.
+func TotalOtherPrsGT(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldGT(FieldTotalOtherPrs, v))
+}
+
+// TotalOtherPrsGTE applies the GTE predicate on the "total_other_prs" field.
+func TotalOtherPrsGTE(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldGTE(FieldTotalOtherPrs, v))
+}
+
+// TotalOtherPrsLT applies the LT predicate on the "total_other_prs" field.
+func TotalOtherPrsLT(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldLT(FieldTotalOtherPrs, v))
+}
+
+// TotalOtherPrsLTE applies the LTE predicate on the "total_other_prs" field.
+func TotalOtherPrsLTE(v int) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldLTE(FieldTotalOtherPrs, v))
+}
+
 // PrToReviewRatioEQ applies the EQ predicate on the "pr_to_review_ratio" field.
 func PrToReviewRatioEQ(v float64) predicate.MemberStat {
 	return predicate.MemberStat(sql.FieldEQ(FieldPrToReviewRatio, v))
@@ -618,6 +1323,71 @@ func PrToReviewRatioLTE(v float64) predicate.MemberStat {
 	return predicate.MemberStat(sql.FieldLTE(FieldPrToReviewRatio, v))
 }
 
+// TimeZoneEQ applies the EQ predicate on the "time_zone" field.
+func TimeZoneEQ(v string) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldEQ(FieldTimeZone, v))
+}
+
+// TimeZoneNEQ applies the NEQ predicate on the "time_zone" field.
+func TimeZoneNEQ(v string) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldNEQ(FieldTimeZone, v))
+}
+
+// TimeZoneIn applies the In predicate on the "time_zone" field.
+func TimeZoneIn(vs ...string) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldIn(FieldTimeZone, vs...))
+}
+
+// TimeZoneNotIn applies the NotIn predicate on the "time_zone" field.
+func TimeZoneNotIn(vs ...string) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldNotIn(FieldTimeZone, vs...))
+}
+
+// TimeZoneGT applies the GT predicate on the "time_zone" field.
+func TimeZoneGT(v string) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldGT(FieldTimeZone, v))
+}
+
+// TimeZoneGTE applies the GTE predicate on the "time_zone" field.
+func TimeZoneGTE(v string) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldGTE(FieldTimeZone, v))
+}
+
+// TimeZoneLT applies the LT predicate on the "time_zone" field.
+func TimeZoneLT(v string) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldLT(FieldTimeZone, v))
+}
+
+// TimeZoneLTE applies the LTE predicate on the "time_zone" field.
+func TimeZoneLTE(v string) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldLTE(FieldTimeZone, v))
+}
+
+// TimeZoneContains applies the Contains predicate on the "time_zone" field.
+func TimeZoneContains(v string) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldContains(FieldTimeZone, v))
+}
+
+// TimeZoneHasPrefix applies the HasPrefix predicate on the "time_zone" field.
+func TimeZoneHasPrefix(v string) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldHasPrefix(FieldTimeZone, v))
+}
+
+// TimeZoneHasSuffix applies the HasSuffix predicate on the "time_zone" field.
+func TimeZoneHasSuffix(v string) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldHasSuffix(FieldTimeZone, v))
+}
+
+// TimeZoneEqualFold applies the EqualFold predicate on the "time_zone" field.
+func TimeZoneEqualFold(v string) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldEqualFold(FieldTimeZone, v))
+}
+
+// TimeZoneContainsFold applies the ContainsFold predicate on the "time_zone" field.
+func TimeZoneContainsFold(v string) predicate.MemberStat {
+	return predicate.MemberStat(sql.FieldContainsFold(FieldTimeZone, v))
+}
+
 // HasSnapshot applies the HasEdge predicate on the "snapshot" edge.
 func HasSnapshot() predicate.MemberStat {
 	return predicate.MemberStat(func(s *sql.Selector) {
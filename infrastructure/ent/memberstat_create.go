@@ -26,6 +26,20 @@ func (_c *MemberStatCreate) SetLogin(v string) *MemberStatCreate {
 	return _c
 }
 
+// SetName sets the "name" field.
+func (_c *MemberStatCreate) SetName(v string) *MemberStatCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *MemberStatCreate) SetNillableName(v *string) *MemberStatCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
 // SetTotalCommits sets the "total_commits" field.
 func (_c *MemberStatCreate) SetTotalCommits(v int) *MemberStatCreate {
 	_c.mutation.SetTotalCommits(v)
@@ -166,6 +180,202 @@ func (_c *MemberStatCreate) SetNillablePeakActivityCommits(v *int) *MemberStatCr
 	return _c
 }
 
+// SetTotalExcludedReviews sets the "total_excluded_reviews" field.
+func (_c *MemberStatCreate) SetTotalExcludedReviews(v int) *MemberStatCreate {
+	_c.mutation.SetTotalExcludedReviews(v)
+	return _c
+}
+
+// SetNillableTotalExcludedReviews sets the "total_excluded_reviews" field if the given value is not nil.
+func (_c *MemberStatCreate) SetNillableTotalExcludedReviews(v *int) *MemberStatCreate {
+	if v != nil {
+		_c.SetTotalExcludedReviews(*v)
+	}
+	return _c
+}
+
+// SetTotalIssuesClosed sets the "total_issues_closed" field.
+func (_c *MemberStatCreate) SetTotalIssuesClosed(v int) *MemberStatCreate {
+	_c.mutation.SetTotalIssuesClosed(v)
+	return _c
+}
+
+// SetNillableTotalIssuesClosed sets the "total_issues_closed" field if the given value is not nil.
+func (_c *MemberStatCreate) SetNillableTotalIssuesClosed(v *int) *MemberStatCreate {
+	if v != nil {
+		_c.SetTotalIssuesClosed(*v)
+	}
+	return _c
+}
+
+// SetTotalIssueCloseSeconds sets the "total_issue_close_seconds" field.
+func (_c *MemberStatCreate) SetTotalIssueCloseSeconds(v int) *MemberStatCreate {
+	_c.mutation.SetTotalIssueCloseSeconds(v)
+	return _c
+}
+
+// SetNillableTotalIssueCloseSeconds sets the "total_issue_close_seconds" field if the given value is not nil.
+func (_c *MemberStatCreate) SetNillableTotalIssueCloseSeconds(v *int) *MemberStatCreate {
+	if v != nil {
+		_c.SetTotalIssueCloseSeconds(*v)
+	}
+	return _c
+}
+
+// SetTotalBugIssuesOpened sets the "total_bug_issues_opened" field.
+func (_c *MemberStatCreate) SetTotalBugIssuesOpened(v int) *MemberStatCreate {
+	_c.mutation.SetTotalBugIssuesOpened(v)
+	return _c
+}
+
+// SetNillableTotalBugIssuesOpened sets the "total_bug_issues_opened" field if the given value is not nil.
+func (_c *MemberStatCreate) SetNillableTotalBugIssuesOpened(v *int) *MemberStatCreate {
+	if v != nil {
+		_c.SetTotalBugIssuesOpened(*v)
+	}
+	return _c
+}
+
+// SetTotalFeatureIssuesOpened sets the "total_feature_issues_opened" field.
+func (_c *MemberStatCreate) SetTotalFeatureIssuesOpened(v int) *MemberStatCreate {
+	_c.mutation.SetTotalFeatureIssuesOpened(v)
+	return _c
+}
+
+// SetNillableTotalFeatureIssuesOpened sets the "total_feature_issues_opened" field if the given value is not nil.
+func (_c *MemberStatCreate) SetNillableTotalFeatureIssuesOpened(v *int) *MemberStatCreate {
+	if v != nil {
+		_c.SetTotalFeatureIssuesOpened(*v)
+	}
+	return _c
+}
+
+// SetTotalBugIssuesClosed sets the "total_bug_issues_closed" field.
+func (_c *MemberStatCreate) SetTotalBugIssuesClosed(v int) *MemberStatCreate {
+	_c.mutation.SetTotalBugIssuesClosed(v)
+	return _c
+}
+
+// SetNillableTotalBugIssuesClosed sets the "total_bug_issues_closed" field if the given value is not nil.
+func (_c *MemberStatCreate) SetNillableTotalBugIssuesClosed(v *int) *MemberStatCreate {
+	if v != nil {
+		_c.SetTotalBugIssuesClosed(*v)
+	}
+	return _c
+}
+
+// SetTotalFeatureIssuesClosed sets the "total_feature_issues_closed" field.
+func (_c *MemberStatCreate) SetTotalFeatureIssuesClosed(v int) *MemberStatCreate {
+	_c.mutation.SetTotalFeatureIssuesClosed(v)
+	return _c
+}
+
+// SetNillableTotalFeatureIssuesClosed sets the "total_feature_issues_closed" field if the given value is not nil.
+func (_c *MemberStatCreate) SetNillableTotalFeatureIssuesClosed(v *int) *MemberStatCreate {
+	if v != nil {
+		_c.SetTotalFeatureIssuesClosed(*v)
+	}
+	return _c
+}
+
+// SetTotalFeaturePrs sets the "total_feature_prs" field.
+func (_c *MemberStatCreate) SetTotalFeaturePrs(v int) *MemberStatCreate {
+	_c.mutation.SetTotalFeaturePrs(v)
+	return _c
+}
+
+// SetNillableTotalFeaturePrs sets the "total_feature_prs" field if the given value is not nil.
+func (_c *MemberStatCreate) SetNillableTotalFeaturePrs(v *int) *MemberStatCreate {
+	if v != nil {
+		_c.SetTotalFeaturePrs(*v)
+	}
+	return _c
+}
+
+// SetTotalBugPrs sets the "total_bug_prs" field.
+func (_c *MemberStatCreate) SetTotalBugPrs(v int) *MemberStatCreate {
+	_c.mutation.SetTotalBugPrs(v)
+	return _c
+}
+
+// SetNillableTotalBugPrs sets the "total_bug_prs" field if the given value is not nil.
+func (_c *MemberStatCreate) SetNillableTotalBugPrs(v *int) *MemberStatCreate {
+	if v != nil {
+		_c.SetTotalBugPrs(*v)
+	}
+	return _c
+}
+
+// SetTotalChorePrs sets the "total_chore_prs" field.
+func (_c *MemberStatCreate) SetTotalChorePrs(v int) *MemberStatCreate {
+	_c.mutation.SetTotalChorePrs(v)
+	return _c
+}
+
+// SetNillableTotalChorePrs sets the "total_chore_prs" field if the given value is not nil.
+func (_c *MemberStatCreate) SetNillableTotalChorePrs(v *int) *MemberStatCreate {
+	if v != nil {
+		_c.SetTotalChorePrs(*v)
+	}
+	return _c
+}
+
+// SetTotalDocsPrs sets the "total_docs_prs" field.
+func (_c *MemberStatCreate) SetTotalDocsPrs(v int) *MemberStatCreate {
+	_c.mutation.SetTotalDocsPrs(v)
+	return _c
+}
+
+// SetNillableTotalDocsPrs sets the "total_docs_prs" field if the given value is not nil.
+func (_c *MemberStatCreate) SetNillableTotalDocsPrs(v *int) *MemberStatCreate {
+	if v != nil {
+		_c.SetTotalDocsPrs(*v)
+	}
+	return _c
+}
+
+// SetTotalTestPrs sets the "total_test_prs" field.
+func (_c *MemberStatCreate) SetTotalTestPrs(v int) *MemberStatCreate {
+	_c.mutation.SetTotalTestPrs(v)
+	return _c
+}
+
+// SetNillableTotalTestPrs sets the "total_test_prs" field if the given value is not nil.
+func (_c *MemberStatCreate) SetNillableTotalTestPrs(v *int) *MemberStatCreate {
+	if v != nil {
+		_c.SetTotalTestPrs(*v)
+	}
+	return _c
+}
+
+// SetTotalInfraPrs sets the "total_infra_prs" field.
+func (_c *MemberStatCreate) SetTotalInfraPrs(v int) *MemberStatCreate {
+	_c.mutation.SetTotalInfraPrs(v)
+	return _c
+}
+
+// SetNillableTotalInfraPrs sets the "total_infra_prs" field if the given value is not nil.
+func (_c *MemberStatCreate) SetNillableTotalInfraPrs(v *int) *MemberStatCreate {
+	if v != nil {
+		_c.SetTotalInfraPrs(*v)
+	}
+	return _c
+}
+
+// SetTotalOtherPrs sets the "total_other_prs" field.
+func (_c *MemberStatCreate) SetTotalOtherPrs(v int) *MemberStatCreate {
+	_c.mutation.SetTotalOtherPrs(v)
+	return _c
+}
+
+// SetNillableTotalOtherPrs sets the "total_other_prs" field if the given value is not nil.
+func (_c *MemberStatCreate) SetNillableTotalOtherPrs(v *int) *MemberStatCreate {
+	if v != nil {
+		_c.SetTotalOtherPrs(*v)
+	}
+	return _c
+}
+
 // SetPrToReviewRatio sets the "pr_to_review_ratio" field.
 func (_c *MemberStatCreate) SetPrToReviewRatio(v float64) *MemberStatCreate {
 	_c.mutation.SetPrToReviewRatio(v)
@@ -180,6 +390,20 @@ func (_c *MemberStatCreate) SetNillablePrToReviewRatio(v *float64) *MemberStatCr
 	return _c
 }
 
+// SetTimeZone sets the "time_zone" field.
+func (_c *MemberStatCreate) SetTimeZone(v string) *MemberStatCreate {
+	_c.mutation.SetTimeZone(v)
+	return _c
+}
+
+// SetNillableTimeZone sets the "time_zone" field if the given value is not nil.
+func (_c *MemberStatCreate) SetNillableTimeZone(v *string) *MemberStatCreate {
+	if v != nil {
+		_c.SetTimeZone(*v)
+	}
+	return _c
+}
+
 // SetSnapshotID sets the "snapshot" edge to the Snapshot entity by ID.
 func (_c *MemberStatCreate) SetSnapshotID(id int) *MemberStatCreate {
 	_c.mutation.SetSnapshotID(id)
@@ -226,6 +450,10 @@ func (_c *MemberStatCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *MemberStatCreate) defaults() {
+	if _, ok := _c.mutation.Name(); !ok {
+		v := memberstat.DefaultName
+		_c.mutation.SetName(v)
+	}
 	if _, ok := _c.mutation.TotalCommits(); !ok {
 		v := memberstat.DefaultTotalCommits
 		_c.mutation.SetTotalCommits(v)
@@ -266,10 +494,70 @@ func (_c *MemberStatCreate) defaults() {
 		v := memberstat.DefaultPeakActivityCommits
 		_c.mutation.SetPeakActivityCommits(v)
 	}
+	if _, ok := _c.mutation.TotalExcludedReviews(); !ok {
+		v := memberstat.DefaultTotalExcludedReviews
+		_c.mutation.SetTotalExcludedReviews(v)
+	}
+	if _, ok := _c.mutation.TotalIssuesClosed(); !ok {
+		v := memberstat.DefaultTotalIssuesClosed
+		_c.mutation.SetTotalIssuesClosed(v)
+	}
+	if _, ok := _c.mutation.TotalIssueCloseSeconds(); !ok {
+		v := memberstat.DefaultTotalIssueCloseSeconds
+		_c.mutation.SetTotalIssueCloseSeconds(v)
+	}
+	if _, ok := _c.mutation.TotalBugIssuesOpened(); !ok {
+		v := memberstat.DefaultTotalBugIssuesOpened
+		_c.mutation.SetTotalBugIssuesOpened(v)
+	}
+	if _, ok := _c.mutation.TotalFeatureIssuesOpened(); !ok {
+		v := memberstat.DefaultTotalFeatureIssuesOpened
+		_c.mutation.SetTotalFeatureIssuesOpened(v)
+	}
+	if _, ok := _c.mutation.TotalBugIssuesClosed(); !ok {
+		v := memberstat.DefaultTotalBugIssuesClosed
+		_c.mutation.SetTotalBugIssuesClosed(v)
+	}
+	if _, ok := _c.mutation.TotalFeatureIssuesClosed(); !ok {
+		v := memberstat.DefaultTotalFeatureIssuesClosed
+		_c.mutation.SetTotalFeatureIssuesClosed(v)
+	}
+	if _, ok := _c.mutation.TotalFeaturePrs(); !ok {
+		v := memberstat.DefaultTotalFeaturePrs
+		_c.mutation.SetTotalFeaturePrs(v)
+	}
+	if _, ok := _c.mutation.TotalBugPrs(); !ok {
+		v := memberstat.DefaultTotalBugPrs
+		_c.mutation.SetTotalBugPrs(v)
+	}
+	if _, ok := _c.mutation.TotalChorePrs(); !ok {
+		v := memberstat.DefaultTotalChorePrs
+		_c.mutation.SetTotalChorePrs(v)
+	}
+	if _, ok := _c.mutation.TotalDocsPrs(); !ok {
+		v := memberstat.DefaultTotalDocsPrs
+		_c.mutation.SetTotalDocsPrs(v)
+	}
+	if _, ok := _c.mutation.TotalTestPrs(); !ok {
+		v := memberstat.DefaultTotalTestPrs
+		_c.mutation.SetTotalTestPrs(v)
+	}
+	if _, ok := _c.mutation.TotalInfraPrs(); !ok {
+		v := memberstat.DefaultTotalInfraPrs
+		_c.mutation.SetTotalInfraPrs(v)
+	}
+	if _, ok := _c.mutation.TotalOtherPrs(); !ok {
+		v := memberstat.DefaultTotalOtherPrs
+		_c.mutation.SetTotalOtherPrs(v)
+	}
 	if _, ok := _c.mutation.PrToReviewRatio(); !ok {
 		v := memberstat.DefaultPrToReviewRatio
 		_c.mutation.SetPrToReviewRatio(v)
 	}
+	if _, ok := _c.mutation.TimeZone(); !ok {
+		v := memberstat.DefaultTimeZone
+		_c.mutation.SetTimeZone(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -282,6 +570,9 @@ func (_c *MemberStatCreate) check() error {
 			return &ValidationError{Name: "login", err: fmt.Errorf(`ent: validator failed for field "MemberStat.login": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "MemberStat.name"`)}
+	}
 	if _, ok := _c.mutation.TotalCommits(); !ok {
 		return &ValidationError{Name: "total_commits", err: errors.New(`ent: missing required field "MemberStat.total_commits"`)}
 	}
@@ -312,9 +603,54 @@ func (_c *MemberStatCreate) check() error {
 	if _, ok := _c.mutation.PeakActivityCommits(); !ok {
 		return &ValidationError{Name: "peak_activity_commits", err: errors.New(`ent: missing required field "MemberStat.peak_activity_commits"`)}
 	}
+	if _, ok := _c.mutation.TotalExcludedReviews(); !ok {
+		return &ValidationError{Name: "total_excluded_reviews", err: errors.New(`ent: missing required field "MemberStat.total_excluded_reviews"`)}
+	}
+	if _, ok := _c.mutation.TotalIssuesClosed(); !ok {
+		return &ValidationError{Name: "total_issues_closed", err: errors.New(`ent: missing required field "MemberStat.total_issues_closed"`)}
+	}
+	if _, ok := _c.mutation.TotalIssueCloseSeconds(); !ok {
+		return &ValidationError{Name: "total_issue_close_seconds", err: errors.New(`ent: missing required field "MemberStat.total_issue_close_seconds"`)}
+	}
+	if _, ok := _c.mutation.TotalBugIssuesOpened(); !ok {
+		return &ValidationError{Name: "total_bug_issues_opened", err: errors.New(`ent: missing required field "MemberStat.total_bug_issues_opened"`)}
+	}
+	if _, ok := _c.mutation.TotalFeatureIssuesOpened(); !ok {
+		return &ValidationError{Name: "total_feature_issues_opened", err: errors.New(`ent: missing required field "MemberStat.total_feature_issues_opened"`)}
+	}
+	if _, ok := _c.mutation.TotalBugIssuesClosed(); !ok {
+		return &ValidationError{Name: "total_bug_issues_closed", err: errors.New(`ent: missing required field "MemberStat.total_bug_issues_closed"`)}
+	}
+	if _, ok := _c.mutation.TotalFeatureIssuesClosed(); !ok {
+		return &ValidationError{Name: "total_feature_issues_closed", err: errors.New(`ent: missing required field "MemberStat.total_feature_issues_closed"`)}
+	}
+	if _, ok := _c.mutation.TotalFeaturePrs(); !ok {
+		return &ValidationError{Name: "total_feature_prs", err: errors.New(`ent: missing required field "MemberStat.total_feature_prs"`)}
+	}
+	if _, ok := _c.mutation.TotalBugPrs(); !ok {
+		return &ValidationError{Name: "total_bug_prs", err: errors.New(`ent: missing required field "MemberStat.total_bug_prs"`)}
+	}
+	if _, ok := _c.mutation.TotalChorePrs(); !ok {
+		return &ValidationError{Name: "total_chore_prs", err: errors.New(`ent: missing required field "MemberStat.total_chore_prs"`)}
+	}
+	if _, ok := _c.mutation.TotalDocsPrs(); !ok {
+		return &ValidationError{Name: "total_docs_prs", err: errors.New(`ent: missing required field "MemberStat.total_docs_prs"`)}
+	}
+	if _, ok := _c.mutation.TotalTestPrs(); !ok {
+		return &ValidationError{Name: "total_test_prs", err: errors.New(`ent: missing required field "MemberStat.total_test_prs"`)}
+	}
+	if _, ok := _c.mutation.TotalInfraPrs(); !ok {
+		return &ValidationError{Name: "total_infra_prs", err: errors.New(`ent: missing required field "MemberStat.total_infra_prs"`)}
+	}
+	if _, ok := _c.mutation.TotalOtherPrs(); !ok {
+		return &ValidationError{Name: "total_other_prs", err: errors.New(`ent: missing required field "MemberStat.total_other_prs"`)}
+	}
 	if _, ok := _c.mutation.PrToReviewRatio(); !ok {
 		return &ValidationError{Name: "pr_to_review_ratio", err: errors.New(`ent: missing required field "MemberStat.pr_to_review_ratio"`)}
 	}
+	if _, ok := _c.mutation.TimeZone(); !ok {
+		return &ValidationError{Name: "time_zone", err: errors.New(`ent: missing required field "MemberStat.time_zone"`)}
+	}
 	if len(_c.mutation.SnapshotIDs()) == 0 {
 		return &ValidationError{Name: "snapshot", err: errors.New(`ent: missing required edge "MemberStat.snapshot"`)}
 	}
@@ -348,6 +684,10 @@ func (_c *MemberStatCreate) createSpec() (*MemberStat, *sqlgraph.CreateSpec) {
 		_spec.SetField(memberstat.FieldLogin, field.TypeString, value)
 		_node.Login = value
 	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(memberstat.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
 	if value, ok := _c.mutation.TotalCommits(); ok {
 		_spec.SetField(memberstat.FieldTotalCommits, field.TypeInt, value)
 		_node.TotalCommits = value
@@ -388,10 +728,70 @@ func (_c *MemberStatCreate) createSpec() (*MemberStat, *sqlgraph.CreateSpec) {
 		_spec.SetField(memberstat.FieldPeakActivityCommits, field.TypeInt, value)
 		_node.PeakActivityCommits = value
 	}
+	if value, ok := _c.mutation.TotalExcludedReviews(); ok {
+		_spec.SetField(memberstat.FieldTotalExcludedReviews, field.TypeInt, value)
+		_node.TotalExcludedReviews = value
+	}
+	if value, ok := _c.mutation.TotalIssuesClosed(); ok {
+		_spec.SetField(memberstat.FieldTotalIssuesClosed, field.TypeInt, value)
+		_node.TotalIssuesClosed = value
+	}
+	if value, ok := _c.mutation.TotalIssueCloseSeconds(); ok {
+		_spec.SetField(memberstat.FieldTotalIssueCloseSeconds, field.TypeInt, value)
+		_node.TotalIssueCloseSeconds = value
+	}
+	if value, ok := _c.mutation.TotalBugIssuesOpened(); ok {
+		_spec.SetField(memberstat.FieldTotalBugIssuesOpened, field.TypeInt, value)
+		_node.TotalBugIssuesOpened = value
+	}
+	if value, ok := _c.mutation.TotalFeatureIssuesOpened(); ok {
+		_spec.SetField(memberstat.FieldTotalFeatureIssuesOpened, field.TypeInt, value)
+		_node.TotalFeatureIssuesOpened = value
+	}
+	if value, ok := _c.mutation.TotalBugIssuesClosed(); ok {
+		_spec.SetField(memberstat.FieldTotalBugIssuesClosed, field.TypeInt, value)
+		_node.TotalBugIssuesClosed = value
+	}
+	if value, ok := _c.mutation.TotalFeatureIssuesClosed(); ok {
+		_spec.SetField(memberstat.FieldTotalFeatureIssuesClosed, field.TypeInt, value)
+		_node.TotalFeatureIssuesClosed = value
+	}
+	if value, ok := _c.mutation.TotalFeaturePrs(); ok {
+		_spec.SetField(memberstat.FieldTotalFeaturePrs, field.TypeInt, value)
+		_node.TotalFeaturePrs = value
+	}
+	if value, ok := _c.mutation.TotalBugPrs(); ok {
+		_spec.SetField(memberstat.FieldTotalBugPrs, field.TypeInt, value)
+		_node.TotalBugPrs = value
+	}
+	if value, ok := _c.mutation.TotalChorePrs(); ok {
+		_spec.SetField(memberstat.FieldTotalChorePrs, field.TypeInt, value)
+		_node.TotalChorePrs = value
+	}
+	if value, ok := _c.mutation.TotalDocsPrs(); ok {
+		_spec.SetField(memberstat.FieldTotalDocsPrs, field.TypeInt, value)
+		_node.TotalDocsPrs = value
+	}
+	if value, ok := _c.mutation.TotalTestPrs(); ok {
+		_spec.SetField(memberstat.FieldTotalTestPrs, field.TypeInt, value)
+		_node.TotalTestPrs = value
+	}
+	if value, ok := _c.mutation.TotalInfraPrs(); ok {
+		_spec.SetField(memberstat.FieldTotalInfraPrs, field.TypeInt, value)
+		_node.TotalInfraPrs = value
+	}
+	if value, ok := _c.mutation.TotalOtherPrs(); ok {
+		_spec.SetField(memberstat.FieldTotalOtherPrs, field.TypeInt, value)
+		_node.TotalOtherPrs = value
+	}
 	if value, ok := _c.mutation.PrToReviewRatio(); ok {
 		_spec.SetField(memberstat.FieldPrToReviewRatio, field.TypeFloat64, value)
 		_node.PrToReviewRatio = value
 	}
+	if value, ok := _c.mutation.TimeZone(); ok {
+		_spec.SetField(memberstat.FieldTimeZone, field.TypeString, value)
+		_node.TimeZone = value
+	}
 	if nodes := _c.mutation.SnapshotIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
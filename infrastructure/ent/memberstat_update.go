@@ -42,6 +42,20 @@ func (_u *MemberStatUpdate) SetNillableLogin(v *string) *MemberStatUpdate {
 	return _u
 }
 
+// SetName sets the "name" field.
+func (_u *MemberStatUpdate) SetName(v string) *MemberStatUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *MemberStatUpdate) SetNillableName(v *string) *MemberStatUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
 // SetTotalCommits sets the "total_commits" field.
 func (_u *MemberStatUpdate) SetTotalCommits(v int) *MemberStatUpdate {
 	_u.mutation.ResetTotalCommits()
@@ -252,6 +266,300 @@ func (_u *MemberStatUpdate) AddPeakActivityCommits(v int) *MemberStatUpdate {
 	return _u
 }
 
+// SetTotalExcludedReviews sets the "total_excluded_reviews" field.
+func (_u *MemberStatUpdate) SetTotalExcludedReviews(v int) *MemberStatUpdate {
+	_u.mutation.ResetTotalExcludedReviews()
+	_u.mutation.SetTotalExcludedReviews(v)
+	return _u
+}
+
+// SetNillableTotalExcludedReviews sets the "total_excluded_reviews" field if the given value is not nil.
+func (_u *MemberStatUpdate) SetNillableTotalExcludedReviews(v *int) *MemberStatUpdate {
+	if v != nil {
+		_u.SetTotalExcludedReviews(*v)
+	}
+	return _u
+}
+
+// AddTotalExcludedReviews adds value to the "total_excluded_reviews" field.
+func (_u *MemberStatUpdate) AddTotalExcludedReviews(v int) *MemberStatUpdate {
+	_u.mutation.AddTotalExcludedReviews(v)
+	return _u
+}
+
+// SetTotalIssuesClosed sets the "total_issues_closed" field.
+func (_u *MemberStatUpdate) SetTotalIssuesClosed(v int) *MemberStatUpdate {
+	_u.mutation.ResetTotalIssuesClosed()
+	_u.mutation.SetTotalIssuesClosed(v)
+	return _u
+}
+
+// SetNillableTotalIssuesClosed sets the "total_issues_closed" field if the given value is not nil.
+func (_u *MemberStatUpdate) SetNillableTotalIssuesClosed(v *int) *MemberStatUpdate {
+	if v != nil {
+		_u.SetTotalIssuesClosed(*v)
+	}
+	return _u
+}
+
+// AddTotalIssuesClosed adds value to the "total_issues_closed" field.
+func (_u *MemberStatUpdate) AddTotalIssuesClosed(v int) *MemberStatUpdate {
+	_u.mutation.AddTotalIssuesClosed(v)
+	return _u
+}
+
+// SetTotalIssueCloseSeconds sets the "total_issue_close_seconds" field.
+func (_u *MemberStatUpdate) SetTotalIssueCloseSeconds(v int) *MemberStatUpdate {
+	_u.mutation.ResetTotalIssueCloseSeconds()
+	_u.mutation.SetTotalIssueCloseSeconds(v)
+	return _u
+}
+
+// SetNillableTotalIssueCloseSeconds sets the "total_issue_close_seconds" field if the given value is not nil.
+func (_u *MemberStatUpdate) SetNillableTotalIssueCloseSeconds(v *int) *MemberStatUpdate {
+	if v != nil {
+		_u.SetTotalIssueCloseSeconds(*v)
+	}
+	return _u
+}
+
+// AddTotalIssueCloseSeconds adds value to the "total_issue_close_seconds" field.
+func (_u *MemberStatUpdate) AddTotalIssueCloseSeconds(v int) *MemberStatUpdate {
+	_u.mutation.AddTotalIssueCloseSeconds(v)
+	return _u
+}
+
+// SetTotalBugIssuesOpened sets the "total_bug_issues_opened" field.
+func (_u *MemberStatUpdate) SetTotalBugIssuesOpened(v int) *MemberStatUpdate {
+	_u.mutation.ResetTotalBugIssuesOpened()
+	_u.mutation.SetTotalBugIssuesOpened(v)
+	return _u
+}
+
+// SetNillableTotalBugIssuesOpened sets the "total_bug_issues_opened" field if the given value is not nil.
+func (_u *MemberStatUpdate) SetNillableTotalBugIssuesOpened(v *int) *MemberStatUpdate {
+	if v != nil {
+		_u.SetTotalBugIssuesOpened(*v)
+	}
+	return _u
+}
+
+// AddTotalBugIssuesOpened adds value to the "total_bug_issues_opened" field.
+func (_u *MemberStatUpdate) AddTotalBugIssuesOpened(v int) *MemberStatUpdate {
+	_u.mutation.AddTotalBugIssuesOpened(v)
+	return _u
+}
+
+// SetTotalFeatureIssuesOpened sets the "total_feature_issues_opened" field.
+func (_u *MemberStatUpdate) SetTotalFeatureIssuesOpened(v int) *MemberStatUpdate {
+	_u.mutation.ResetTotalFeatureIssuesOpened()
+	_u.mutation.SetTotalFeatureIssuesOpened(v)
+	return _u
+}
+
+// SetNillableTotalFeatureIssuesOpened sets the "total_feature_issues_opened" field if the given value is not nil.
+func (_u *MemberStatUpdate) SetNillableTotalFeatureIssuesOpened(v *int) *MemberStatUpdate {
+	if v != nil {
+		_u.SetTotalFeatureIssuesOpened(*v)
+	}
+	return _u
+}
+
+// AddTotalFeatureIssuesOpened adds value to the "total_feature_issues_opened" field.
+func (_u *MemberStatUpdate) AddTotalFeatureIssuesOpened(v int) *MemberStatUpdate {
+	_u.mutation.AddTotalFeatureIssuesOpened(v)
+	return _u
+}
+
+// SetTotalBugIssuesClosed sets the "total_bug_issues_closed" field.
+func (_u *MemberStatUpdate) SetTotalBugIssuesClosed(v int) *MemberStatUpdate {
+	_u.mutation.ResetTotalBugIssuesClosed()
+	_u.mutation.SetTotalBugIssuesClosed(v)
+	return _u
+}
+
+// SetNillableTotalBugIssuesClosed sets the "total_bug_issues_closed" field if the given value is not nil.
+func (_u *MemberStatUpdate) SetNillableTotalBugIssuesClosed(v *int) *MemberStatUpdate {
+	if v != nil {
+		_u.SetTotalBugIssuesClosed(*v)
+	}
+	return _u
+}
+
+// AddTotalBugIssuesClosed adds value to the "total_bug_issues_closed" field.
+func (_u *MemberStatUpdate) AddTotalBugIssuesClosed(v int) *MemberStatUpdate {
+	_u.mutation.AddTotalBugIssuesClosed(v)
+	return _u
+}
+
+// SetTotalFeatureIssuesClosed sets the "total_feature_issues_closed" field.
+func (_u *MemberStatUpdate) SetTotalFeatureIssuesClosed(v int) *MemberStatUpdate {
+	_u.mutation.ResetTotalFeatureIssuesClosed()
+	_u.mutation.SetTotalFeatureIssuesClosed(v)
+	return _u
+}
+
+// SetNillableTotalFeatureIssuesClosed sets the "total_feature_issues_closed" field if the given value is not nil.
+func (_u *MemberStatUpdate) SetNillableTotalFeatureIssuesClosed(v *int) *MemberStatUpdate {
+	if v != nil {
+		_u.SetTotalFeatureIssuesClosed(*v)
+	}
+	return _u
+}
+
+// AddTotalFeatureIssuesClosed adds value to the "total_feature_issues_closed" field.
+func (_u *MemberStatUpdate) AddTotalFeatureIssuesClosed(v int) *MemberStatUpdate {
+	_u.mutation.AddTotalFeatureIssuesClosed(v)
+	return _u
+}
+
+// SetTotalFeaturePrs sets the "total_feature_prs" field.
+func (_u *MemberStatUpdate) SetTotalFeaturePrs(v int) *MemberStatUpdate {
+	_u.mutation.ResetTotalFeaturePrs()
+	_u.mutation.SetTotalFeaturePrs(v)
+	return _u
+}
+
+// SetNillableTotalFeaturePrs sets the "total_feature_prs" field if the given value is not nil.
+func (_u *MemberStatUpdate) SetNillableTotalFeaturePrs(v *int) *MemberStatUpdate {
+	if v != nil {
+		_u.SetTotalFeaturePrs(*v)
+	}
+	return _u
+}
+
+// AddTotalFeaturePrs adds value to the "total_feature_prs" field.
+func (_u *MemberStatUpdate) AddTotalFeaturePrs(v int) *MemberStatUpdate {
+	_u.mutation.AddTotalFeaturePrs(v)
+	return _u
+}
+
+// SetTotalBugPrs sets the "total_bug_prs" field.
+func (_u *MemberStatUpdate) SetTotalBugPrs(v int) *MemberStatUpdate {
+	_u.mutation.ResetTotalBugPrs()
+	_u.mutation.SetTotalBugPrs(v)
+	return _u
+}
+
+// SetNillableTotalBugPrs sets the "total_bug_prs" field if the given value is not nil.
+func (_u *MemberStatUpdate) SetNillableTotalBugPrs(v *int) *MemberStatUpdate {
+	if v != nil {
+		_u.SetTotalBugPrs(*v)
+	}
+	return _u
+}
+
+// AddTotalBugPrs adds value to the "total_bug_prs" field.
+func (_u *MemberStatUpdate) AddTotalBugPrs(v int) *MemberStatUpdate {
+	_u.mutation.AddTotalBugPrs(v)
+	return _u
+}
+
+// SetTotalChorePrs sets the "total_chore_prs" field.
+func (_u *MemberStatUpdate) SetTotalChorePrs(v int) *MemberStatUpdate {
+	_u.mutation.ResetTotalChorePrs()
+	_u.mutation.SetTotalChorePrs(v)
+	return _u
+}
+
+// SetNillableTotalChorePrs sets the "total_chore_prs" field if the given value is not nil.
+func (_u *MemberStatUpdate) SetNillableTotalChorePrs(v *int) *MemberStatUpdate {
+	if v != nil {
+		_u.SetTotalChorePrs(*v)
+	}
+	return _u
+}
+
+// AddTotalChorePrs adds value to the "total_chore_prs" field.
+func (_u *MemberStatUpdate) AddTotalChorePrs(v int) *MemberStatUpdate {
+	_u.mutation.AddTotalChorePrs(v)
+	return _u
+}
+
+// SetTotalDocsPrs sets the "total_docs_prs" field.
+func (_u *MemberStatUpdate) SetTotalDocsPrs(v int) *MemberStatUpdate {
+	_u.mutation.ResetTotalDocsPrs()
+	_u.mutation.SetTotalDocsPrs(v)
+	return _u
+}
+
+// SetNillableTotalDocsPrs sets the "total_docs_prs" field if the given value is not nil.
+func (_u *MemberStatUpdate) SetNillableTotalDocsPrs(v *int) *MemberStatUpdate {
+	if v != nil {
+		_u.SetTotalDocsPrs(*v)
+	}
+	return _u
+}
+
+// AddTotalDocsPrs adds value to the "total_docs_prs" field.
+func (_u *MemberStatUpdate) AddTotalDocsPrs(v int) *MemberStatUpdate {
+	_u.mutation.AddTotalDocsPrs(v)
+	return _u
+}
+
+// SetTotalTestPrs sets the "total_test_prs" field.
+func (_u *MemberStatUpdate) SetTotalTestPrs(v int) *MemberStatUpdate {
+	_u.mutation.ResetTotalTestPrs()
+	_u.mutation.SetTotalTestPrs(v)
+	return _u
+}
+
+// SetNillableTotalTestPrs sets the "total_test_prs" field if the given value is not nil.
+func (_u *MemberStatUpdate) SetNillableTotalTestPrs(v *int) *MemberStatUpdate {
+	if v != nil {
+		_u.SetTotalTestPrs(*v)
+	}
+	return _u
+}
+
+// AddTotalTestPrs adds value to the "total_test_prs" field.
+func (_u *MemberStatUpdate) AddTotalTestPrs(v int) *MemberStatUpdate {
+	_u.mutation.AddTotalTestPrs(v)
+	return _u
+}
+
+// SetTotalInfraPrs sets the "total_infra_prs" field.
+func (_u *MemberStatUpdate) SetTotalInfraPrs(v int) *MemberStatUpdate {
+	_u.mutation.ResetTotalInfraPrs()
+	_u.mutation.SetTotalInfraPrs(v)
+	return _u
+}
+
+// SetNillableTotalInfraPrs sets the "total_infra_prs" field if the given value is not nil.
+func (_u *MemberStatUpdate) SetNillableTotalInfraPrs(v *int) *MemberStatUpdate {
+	if v != nil {
+		_u.SetTotalInfraPrs(*v)
+	}
+	return _u
+}
+
+// AddTotalInfraPrs adds value to the "total_infra_prs" field.
+func (_u *MemberStatUpdate) AddTotalInfraPrs(v int) *MemberStatUpdate {
+	_u.mutation.AddTotalInfraPrs(v)
+	return _u
+}
+
+// SetTotalOtherPrs sets the "total_other_prs" field.
+func (_u *MemberStatUpdate) SetTotalOtherPrs(v int) *MemberStatUpdate {
+	_u.mutation.ResetTotalOtherPrs()
+	_u.mutation.SetTotalOtherPrs(v)
+	return _u
+}
+
+// SetNillableTotalOtherPrs sets the "total_other_prs" field if the given value is not nil.
+func (_u *MemberStatUpdate) SetNillableTotalOtherPrs(v *int) *MemberStatUpdate {
+	if v != nil {
+		_u.SetTotalOtherPrs(*v)
+	}
+	return _u
+}
+
+// AddTotalOtherPrs adds value to the "total_other_prs" field.
+func (_u *MemberStatUpdate) AddTotalOtherPrs(v int) *MemberStatUpdate {
+	_u.mutation.AddTotalOtherPrs(v)
+	return _u
+}
+
 // SetPrToReviewRatio sets the "pr_to_review_ratio" field.
 func (_u *MemberStatUpdate) SetPrToReviewRatio(v float64) *MemberStatUpdate {
 	_u.mutation.ResetPrToReviewRatio()
@@ -273,6 +581,20 @@ func (_u *MemberStatUpdate) AddPrToReviewRatio(v float64) *MemberStatUpdate {
 	return _u
 }
 
+// SetTimeZone sets the "time_zone" field.
+func (_u *MemberStatUpdate) SetTimeZone(v string) *MemberStatUpdate {
+	_u.mutation.SetTimeZone(v)
+	return _u
+}
+
+// SetNillableTimeZone sets the "time_zone" field if the given value is not nil.
+func (_u *MemberStatUpdate) SetNillableTimeZone(v *string) *MemberStatUpdate {
+	if v != nil {
+		_u.SetTimeZone(*v)
+	}
+	return _u
+}
+
 // SetSnapshotID sets the "snapshot" edge to the Snapshot entity by ID.
 func (_u *MemberStatUpdate) SetSnapshotID(id int) *MemberStatUpdate {
 	_u.mutation.SetSnapshotID(id)
@@ -350,6 +672,9 @@ func (_u *MemberStatUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.Login(); ok {
 		_spec.SetField(memberstat.FieldLogin, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(memberstat.FieldName, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.TotalCommits(); ok {
 		_spec.SetField(memberstat.FieldTotalCommits, field.TypeInt, value)
 	}
@@ -410,12 +735,99 @@ func (_u *MemberStatUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.AddedPeakActivityCommits(); ok {
 		_spec.AddField(memberstat.FieldPeakActivityCommits, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.TotalExcludedReviews(); ok {
+		_spec.SetField(memberstat.FieldTotalExcludedReviews, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalExcludedReviews(); ok {
+		_spec.AddField(memberstat.FieldTotalExcludedReviews, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalIssuesClosed(); ok {
+		_spec.SetField(memberstat.FieldTotalIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalIssuesClosed(); ok {
+		_spec.AddField(memberstat.FieldTotalIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalIssueCloseSeconds(); ok {
+		_spec.SetField(memberstat.FieldTotalIssueCloseSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalIssueCloseSeconds(); ok {
+		_spec.AddField(memberstat.FieldTotalIssueCloseSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalBugIssuesOpened(); ok {
+		_spec.SetField(memberstat.FieldTotalBugIssuesOpened, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalBugIssuesOpened(); ok {
+		_spec.AddField(memberstat.FieldTotalBugIssuesOpened, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalFeatureIssuesOpened(); ok {
+		_spec.SetField(memberstat.FieldTotalFeatureIssuesOpened, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalFeatureIssuesOpened(); ok {
+		_spec.AddField(memberstat.FieldTotalFeatureIssuesOpened, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalBugIssuesClosed(); ok {
+		_spec.SetField(memberstat.FieldTotalBugIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalBugIssuesClosed(); ok {
+		_spec.AddField(memberstat.FieldTotalBugIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalFeatureIssuesClosed(); ok {
+		_spec.SetField(memberstat.FieldTotalFeatureIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalFeatureIssuesClosed(); ok {
+		_spec.AddField(memberstat.FieldTotalFeatureIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalFeaturePrs(); ok {
+		_spec.SetField(memberstat.FieldTotalFeaturePrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalFeaturePrs(); ok {
+		_spec.AddField(memberstat.FieldTotalFeaturePrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalBugPrs(); ok {
+		_spec.SetField(memberstat.FieldTotalBugPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalBugPrs(); ok {
+		_spec.AddField(memberstat.FieldTotalBugPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalChorePrs(); ok {
+		_spec.SetField(memberstat.FieldTotalChorePrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalChorePrs(); ok {
+		_spec.AddField(memberstat.FieldTotalChorePrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalDocsPrs(); ok {
+		_spec.SetField(memberstat.FieldTotalDocsPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalDocsPrs(); ok {
+		_spec.AddField(memberstat.FieldTotalDocsPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalTestPrs(); ok {
+		_spec.SetField(memberstat.FieldTotalTestPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalTestPrs(); ok {
+		_spec.AddField(memberstat.FieldTotalTestPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalInfraPrs(); ok {
+		_spec.SetField(memberstat.FieldTotalInfraPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalInfraPrs(); ok {
+		_spec.AddField(memberstat.FieldTotalInfraPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalOtherPrs(); ok {
+		_spec.SetField(memberstat.FieldTotalOtherPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalOtherPrs(); ok {
+		_spec.AddField(memberstat.FieldTotalOtherPrs, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.PrToReviewRatio(); ok {
 		_spec.SetField(memberstat.FieldPrToReviewRatio, field.TypeFloat64, value)
 	}
 	if value, ok := _u.mutation.AddedPrToReviewRatio(); ok {
 		_spec.AddField(memberstat.FieldPrToReviewRatio, field.TypeFloat64, value)
 	}
+	if value, ok := _u.mutation.TimeZone(); ok {
+		_spec.SetField(memberstat.FieldTimeZone, field.TypeString, value)
+	}
 	if _u.mutation.SnapshotCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -479,6 +891,20 @@ func (_u *MemberStatUpdateOne) SetNillableLogin(v *string) *MemberStatUpdateOne
 	return _u
 }
 
+// SetName sets the "name" field.
+func (_u *MemberStatUpdateOne) SetName(v string) *MemberStatUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *MemberStatUpdateOne) SetNillableName(v *string) *MemberStatUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
 // SetTotalCommits sets the "total_commits" field.
 func (_u *MemberStatUpdateOne) SetTotalCommits(v int) *MemberStatUpdateOne {
 	_u.mutation.ResetTotalCommits()
@@ -689,6 +1115,300 @@ func (_u *MemberStatUpdateOne) AddPeakActivityCommits(v int) *MemberStatUpdateOn
 	return _u
 }
 
+// SetTotalExcludedReviews sets the "total_excluded_reviews" field.
+func (_u *MemberStatUpdateOne) SetTotalExcludedReviews(v int) *MemberStatUpdateOne {
+	_u.mutation.ResetTotalExcludedReviews()
+	_u.mutation.SetTotalExcludedReviews(v)
+	return _u
+}
+
+// SetNillableTotalExcludedReviews sets the "total_excluded_reviews" field if the given value is not nil.
+func (_u *MemberStatUpdateOne) SetNillableTotalExcludedReviews(v *int) *MemberStatUpdateOne {
+	if v != nil {
+		_u.SetTotalExcludedReviews(*v)
+	}
+	return _u
+}
+
+// AddTotalExcludedReviews adds value to the "total_excluded_reviews" field.
+func (_u *MemberStatUpdateOne) AddTotalExcludedReviews(v int) *MemberStatUpdateOne {
+	_u.mutation.AddTotalExcludedReviews(v)
+	return _u
+}
+
+// SetTotalIssuesClosed sets the "total_issues_closed" field.
+func (_u *MemberStatUpdateOne) SetTotalIssuesClosed(v int) *MemberStatUpdateOne {
+	_u.mutation.ResetTotalIssuesClosed()
+	_u.mutation.SetTotalIssuesClosed(v)
+	return _u
+}
+
+// SetNillableTotalIssuesClosed sets the "total_issues_closed" field if the given value is not nil.
+func (_u *MemberStatUpdateOne) SetNillableTotalIssuesClosed(v *int) *MemberStatUpdateOne {
+	if v != nil {
+		_u.SetTotalIssuesClosed(*v)
+	}
+	return _u
+}
+
+// AddTotalIssuesClosed adds value to the "total_issues_closed" field.
+func (_u *MemberStatUpdateOne) AddTotalIssuesClosed(v int) *MemberStatUpdateOne {
+	_u.mutation.AddTotalIssuesClosed(v)
+	return _u
+}
+
+// SetTotalIssueCloseSeconds sets the "total_issue_close_seconds" field.
+func (_u *MemberStatUpdateOne) SetTotalIssueCloseSeconds(v int) *MemberStatUpdateOne {
+	_u.mutation.ResetTotalIssueCloseSeconds()
+	_u.mutation.SetTotalIssueCloseSeconds(v)
+	return _u
+}
+
+// SetNillableTotalIssueCloseSeconds sets the "total_issue_close_seconds" field if the given value is not nil.
+func (_u *MemberStatUpdateOne) SetNillableTotalIssueCloseSeconds(v *int) *MemberStatUpdateOne {
+	if v != nil {
+		_u.SetTotalIssueCloseSeconds(*v)
+	}
+	return _u
+}
+
+// AddTotalIssueCloseSeconds adds value to the "total_issue_close_seconds" field.
+func (_u *MemberStatUpdateOne) AddTotalIssueCloseSeconds(v int) *MemberStatUpdateOne {
+	_u.mutation.AddTotalIssueCloseSeconds(v)
+	return _u
+}
+
+// SetTotalBugIssuesOpened sets the "total_bug_issues_opened" field.
+func (_u *MemberStatUpdateOne) SetTotalBugIssuesOpened(v int) *MemberStatUpdateOne {
+	_u.mutation.ResetTotalBugIssuesOpened()
+	_u.mutation.SetTotalBugIssuesOpened(v)
+	return _u
+}
+
+// SetNillableTotalBugIssuesOpened sets the "total_bug_issues_opened" field if the given value is not nil.
+func (_u *MemberStatUpdateOne) SetNillableTotalBugIssuesOpened(v *int) *MemberStatUpdateOne {
+	if v != nil {
+		_u.SetTotalBugIssuesOpened(*v)
+	}
+	return _u
+}
+
+// AddTotalBugIssuesOpened adds value to the "total_bug_issues_opened" field.
+func (_u *MemberStatUpdateOne) AddTotalBugIssuesOpened(v int) *MemberStatUpdateOne {
+	_u.mutation.AddTotalBugIssuesOpened(v)
+	return _u
+}
+
+// SetTotalFeatureIssuesOpened sets the "total_feature_issues_opened" field.
+func (_u *MemberStatUpdateOne) SetTotalFeatureIssuesOpened(v int) *MemberStatUpdateOne {
+	_u.mutation.ResetTotalFeatureIssuesOpened()
+	_u.mutation.SetTotalFeatureIssuesOpened(v)
+	return _u
+}
+
+// SetNillableTotalFeatureIssuesOpened sets the "total_feature_issues_opened" field if the given value is not nil.
+func (_u *MemberStatUpdateOne) SetNillableTotalFeatureIssuesOpened(v *int) *MemberStatUpdateOne {
+	if v != nil {
+		_u.SetTotalFeatureIssuesOpened(*v)
+	}
+	return _u
+}
+
+// AddTotalFeatureIssuesOpened adds value to the "total_feature_issues_opened" field.
+func (_u *MemberStatUpdateOne) AddTotalFeatureIssuesOpened(v int) *MemberStatUpdateOne {
+	_u.mutation.AddTotalFeatureIssuesOpened(v)
+	return _u
+}
+
+// SetTotalBugIssuesClosed sets the "total_bug_issues_closed" field.
+func (_u *MemberStatUpdateOne) SetTotalBugIssuesClosed(v int) *MemberStatUpdateOne {
+	_u.mutation.ResetTotalBugIssuesClosed()
+	_u.mutation.SetTotalBugIssuesClosed(v)
+	return _u
+}
+
+// SetNillableTotalBugIssuesClosed sets the "total_bug_issues_closed" field if the given value is not nil.
+func (_u *MemberStatUpdateOne) SetNillableTotalBugIssuesClosed(v *int) *MemberStatUpdateOne {
+	if v != nil {
+		_u.SetTotalBugIssuesClosed(*v)
+	}
+	return _u
+}
+
+// AddTotalBugIssuesClosed adds value to the "total_bug_issues_closed" field.
+func (_u *MemberStatUpdateOne) AddTotalBugIssuesClosed(v int) *MemberStatUpdateOne {
+	_u.mutation.AddTotalBugIssuesClosed(v)
+	return _u
+}
+
+// SetTotalFeatureIssuesClosed sets the "total_feature_issues_closed" field.
+func (_u *MemberStatUpdateOne) SetTotalFeatureIssuesClosed(v int) *MemberStatUpdateOne {
+	_u.mutation.ResetTotalFeatureIssuesClosed()
+	_u.mutation.SetTotalFeatureIssuesClosed(v)
+	return _u
+}
+
+// SetNillableTotalFeatureIssuesClosed sets the "total_feature_issues_closed" field if the given value is not nil.
+func (_u *MemberStatUpdateOne) SetNillableTotalFeatureIssuesClosed(v *int) *MemberStatUpdateOne {
+	if v != nil {
+		_u.SetTotalFeatureIssuesClosed(*v)
+	}
+	return _u
+}
+
+// AddTotalFeatureIssuesClosed adds value to the "total_feature_issues_closed" field.
+func (_u *MemberStatUpdateOne) AddTotalFeatureIssuesClosed(v int) *MemberStatUpdateOne {
+	_u.mutation.AddTotalFeatureIssuesClosed(v)
+	return _u
+}
+
+// SetTotalFeaturePrs sets the "total_feature_prs" field.
+func (_u *MemberStatUpdateOne) SetTotalFeaturePrs(v int) *MemberStatUpdateOne {
+	_u.mutation.ResetTotalFeaturePrs()
+	_u.mutation.SetTotalFeaturePrs(v)
+	return _u
+}
+
+// SetNillableTotalFeaturePrs sets the "total_feature_prs" field if the given value is not nil.
+func (_u *MemberStatUpdateOne) SetNillableTotalFeaturePrs(v *int) *MemberStatUpdateOne {
+	if v != nil {
+		_u.SetTotalFeaturePrs(*v)
+	}
+	return _u
+}
+
+// AddTotalFeaturePrs adds value to the "total_feature_prs" field.
+func (_u *MemberStatUpdateOne) AddTotalFeaturePrs(v int) *MemberStatUpdateOne {
+	_u.mutation.AddTotalFeaturePrs(v)
+	return _u
+}
+
+// SetTotalBugPrs sets the "total_bug_prs" field.
+func (_u *MemberStatUpdateOne) SetTotalBugPrs(v int) *MemberStatUpdateOne {
+	_u.mutation.ResetTotalBugPrs()
+	_u.mutation.SetTotalBugPrs(v)
+	return _u
+}
+
+// SetNillableTotalBugPrs sets the "total_bug_prs" field if the given value is not nil.
+func (_u *MemberStatUpdateOne) SetNillableTotalBugPrs(v *int) *MemberStatUpdateOne {
+	if v != nil {
+		_u.SetTotalBugPrs(*v)
+	}
+	return _u
+}
+
+// AddTotalBugPrs adds value to the "total_bug_prs" field.
+func (_u *MemberStatUpdateOne) AddTotalBugPrs(v int) *MemberStatUpdateOne {
+	_u.mutation.AddTotalBugPrs(v)
+	return _u
+}
+
+// SetTotalChorePrs sets the "total_chore_prs" field.
+func (_u *MemberStatUpdateOne) SetTotalChorePrs(v int) *MemberStatUpdateOne {
+	_u.mutation.ResetTotalChorePrs()
+	_u.mutation.SetTotalChorePrs(v)
+	return _u
+}
+
+// SetNillableTotalChorePrs sets the "total_chore_prs" field if the given value is not nil.
+func (_u *MemberStatUpdateOne) SetNillableTotalChorePrs(v *int) *MemberStatUpdateOne {
+	if v != nil {
+		_u.SetTotalChorePrs(*v)
+	}
+	return _u
+}
+
+// AddTotalChorePrs adds value to the "total_chore_prs" field.
+func (_u *MemberStatUpdateOne) AddTotalChorePrs(v int) *MemberStatUpdateOne {
+	_u.mutation.AddTotalChorePrs(v)
+	return _u
+}
+
+// SetTotalDocsPrs sets the "total_docs_prs" field.
+func (_u *MemberStatUpdateOne) SetTotalDocsPrs(v int) *MemberStatUpdateOne {
+	_u.mutation.ResetTotalDocsPrs()
+	_u.mutation.SetTotalDocsPrs(v)
+	return _u
+}
+
+// SetNillableTotalDocsPrs sets the "total_docs_prs" field if the given value is not nil.
+func (_u *MemberStatUpdateOne) SetNillableTotalDocsPrs(v *int) *MemberStatUpdateOne {
+	if v != nil {
+		_u.SetTotalDocsPrs(*v)
+	}
+	return _u
+}
+
+// AddTotalDocsPrs adds value to the "total_docs_prs" field.
+func (_u *MemberStatUpdateOne) AddTotalDocsPrs(v int) *MemberStatUpdateOne {
+	_u.mutation.AddTotalDocsPrs(v)
+	return _u
+}
+
+// SetTotalTestPrs sets the "total_test_prs" field.
+func (_u *MemberStatUpdateOne) SetTotalTestPrs(v int) *MemberStatUpdateOne {
+	_u.mutation.ResetTotalTestPrs()
+	_u.mutation.SetTotalTestPrs(v)
+	return _u
+}
+
+// SetNillableTotalTestPrs sets the "total_test_prs" field if the given value is not nil.
+func (_u *MemberStatUpdateOne) SetNillableTotalTestPrs(v *int) *MemberStatUpdateOne {
+	if v != nil {
+		_u.SetTotalTestPrs(*v)
+	}
+	return _u
+}
+
+// AddTotalTestPrs adds value to the "total_test_prs" field.
+func (_u *MemberStatUpdateOne) AddTotalTestPrs(v int) *MemberStatUpdateOne {
+	_u.mutation.AddTotalTestPrs(v)
+	return _u
+}
+
+// SetTotalInfraPrs sets the "total_infra_prs" field.
+func (_u *MemberStatUpdateOne) SetTotalInfraPrs(v int) *MemberStatUpdateOne {
+	_u.mutation.ResetTotalInfraPrs()
+	_u.mutation.SetTotalInfraPrs(v)
+	return _u
+}
+
+// SetNillableTotalInfraPrs sets the "total_infra_prs" field if the given value is not nil.
+func (_u *MemberStatUpdateOne) SetNillableTotalInfraPrs(v *int) *MemberStatUpdateOne {
+	if v != nil {
+		_u.SetTotalInfraPrs(*v)
+	}
+	return _u
+}
+
+// AddTotalInfraPrs adds value to the "total_infra_prs" field.
+func (_u *MemberStatUpdateOne) AddTotalInfraPrs(v int) *MemberStatUpdateOne {
+	_u.mutation.AddTotalInfraPrs(v)
+	return _u
+}
+
+// SetTotalOtherPrs sets the "total_other_prs" field.
+func (_u *MemberStatUpdateOne) SetTotalOtherPrs(v int) *MemberStatUpdateOne {
+	_u.mutation.ResetTotalOtherPrs()
+	_u.mutation.SetTotalOtherPrs(v)
+	return _u
+}
+
+// SetNillableTotalOtherPrs sets the "total_other_prs" field if the given value is not nil.
+func (_u *MemberStatUpdateOne) SetNillableTotalOtherPrs(v *int) *MemberStatUpdateOne {
+	if v != nil {
+		_u.SetTotalOtherPrs(*v)
+	}
+	return _u
+}
+
+// AddTotalOtherPrs adds value to the "total_other_prs" field.
+func (_u *MemberStatUpdateOne) AddTotalOtherPrs(v int) *MemberStatUpdateOne {
+	_u.mutation.AddTotalOtherPrs(v)
+	return _u
+}
+
 // SetPrToReviewRatio sets the "pr_to_review_ratio" field.
 func (_u *MemberStatUpdateOne) SetPrToReviewRatio(v float64) *MemberStatUpdateOne {
 	_u.mutation.ResetPrToReviewRatio()
@@ -710,6 +1430,20 @@ func (_u *MemberStatUpdateOne) AddPrToReviewRatio(v float64) *MemberStatUpdateOn
 	return _u
 }
 
+// SetTimeZone sets the "time_zone" field.
+func (_u *MemberStatUpdateOne) SetTimeZone(v string) *MemberStatUpdateOne {
+	_u.mutation.SetTimeZone(v)
+	return _u
+}
+
+// SetNillableTimeZone sets the "time_zone" field if the given value is not nil.
+func (_u *MemberStatUpdateOne) SetNillableTimeZone(v *string) *MemberStatUpdateOne {
+	if v != nil {
+		_u.SetTimeZone(*v)
+	}
+	return _u
+}
+
 // SetSnapshotID sets the "snapshot" edge to the Snapshot entity by ID.
 func (_u *MemberStatUpdateOne) SetSnapshotID(id int) *MemberStatUpdateOne {
 	_u.mutation.SetSnapshotID(id)
@@ -817,6 +1551,9 @@ func (_u *MemberStatUpdateOne) sqlSave(ctx context.Context) (_node *MemberStat,
 	if value, ok := _u.mutation.Login(); ok {
 		_spec.SetField(memberstat.FieldLogin, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(memberstat.FieldName, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.TotalCommits(); ok {
 		_spec.SetField(memberstat.FieldTotalCommits, field.TypeInt, value)
 	}
@@ -877,12 +1614,99 @@ func (_u *MemberStatUpdateOne) sqlSave(ctx context.Context) (_node *MemberStat,
 	if value, ok := _u.mutation.AddedPeakActivityCommits(); ok {
 		_spec.AddField(memberstat.FieldPeakActivityCommits, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.TotalExcludedReviews(); ok {
+		_spec.SetField(memberstat.FieldTotalExcludedReviews, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalExcludedReviews(); ok {
+		_spec.AddField(memberstat.FieldTotalExcludedReviews, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalIssuesClosed(); ok {
+		_spec.SetField(memberstat.FieldTotalIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalIssuesClosed(); ok {
+		_spec.AddField(memberstat.FieldTotalIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalIssueCloseSeconds(); ok {
+		_spec.SetField(memberstat.FieldTotalIssueCloseSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalIssueCloseSeconds(); ok {
+		_spec.AddField(memberstat.FieldTotalIssueCloseSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalBugIssuesOpened(); ok {
+		_spec.SetField(memberstat.FieldTotalBugIssuesOpened, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalBugIssuesOpened(); ok {
+		_spec.AddField(memberstat.FieldTotalBugIssuesOpened, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalFeatureIssuesOpened(); ok {
+		_spec.SetField(memberstat.FieldTotalFeatureIssuesOpened, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalFeatureIssuesOpened(); ok {
+		_spec.AddField(memberstat.FieldTotalFeatureIssuesOpened, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalBugIssuesClosed(); ok {
+		_spec.SetField(memberstat.FieldTotalBugIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalBugIssuesClosed(); ok {
+		_spec.AddField(memberstat.FieldTotalBugIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalFeatureIssuesClosed(); ok {
+		_spec.SetField(memberstat.FieldTotalFeatureIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalFeatureIssuesClosed(); ok {
+		_spec.AddField(memberstat.FieldTotalFeatureIssuesClosed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalFeaturePrs(); ok {
+		_spec.SetField(memberstat.FieldTotalFeaturePrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalFeaturePrs(); ok {
+		_spec.AddField(memberstat.FieldTotalFeaturePrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalBugPrs(); ok {
+		_spec.SetField(memberstat.FieldTotalBugPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalBugPrs(); ok {
+		_spec.AddField(memberstat.FieldTotalBugPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalChorePrs(); ok {
+		_spec.SetField(memberstat.FieldTotalChorePrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalChorePrs(); ok {
+		_spec.AddField(memberstat.FieldTotalChorePrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalDocsPrs(); ok {
+		_spec.SetField(memberstat.FieldTotalDocsPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalDocsPrs(); ok {
+		_spec.AddField(memberstat.FieldTotalDocsPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalTestPrs(); ok {
+		_spec.SetField(memberstat.FieldTotalTestPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalTestPrs(); ok {
+		_spec.AddField(memberstat.FieldTotalTestPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalInfraPrs(); ok {
+		_spec.SetField(memberstat.FieldTotalInfraPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalInfraPrs(); ok {
+		_spec.AddField(memberstat.FieldTotalInfraPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalOtherPrs(); ok {
+		_spec.SetField(memberstat.FieldTotalOtherPrs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalOtherPrs(); ok {
+		_spec.AddField(memberstat.FieldTotalOtherPrs, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.PrToReviewRatio(); ok {
 		_spec.SetField(memberstat.FieldPrToReviewRatio, field.TypeFloat64, value)
 	}
 	if value, ok := _u.mutation.AddedPrToReviewRatio(); ok {
 		_spec.AddField(memberstat.FieldPrToReviewRatio, field.TypeFloat64, value)
 	}
+	if value, ok := _u.mutation.TimeZone(); ok {
+		_spec.SetField(memberstat.FieldTimeZone, field.TypeString, value)
+	}
 	if _u.mutation.SnapshotCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -8,6 +8,133 @@ import (
 )
 
 var (
+	// ActivityEventsColumns holds the columns for the "activity_events" table.
+	ActivityEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "natural_key", Type: field.TypeString},
+		{Name: "login", Type: field.TypeString},
+		{Name: "activity_type", Type: field.TypeString},
+		{Name: "source_id", Type: field.TypeString, Default: ""},
+		{Name: "name_with_owner", Type: field.TypeString},
+		{Name: "owner", Type: field.TypeString, Default: ""},
+		{Name: "owner_type", Type: field.TypeString, Default: ""},
+		{Name: "occurred_at", Type: field.TypeTime},
+		{Name: "additions", Type: field.TypeInt, Default: 0},
+		{Name: "deletions", Type: field.TypeInt, Default: 0},
+		{Name: "is_merged", Type: field.TypeBool, Default: false},
+		{Name: "pull_request_author", Type: field.TypeString, Default: ""},
+		{Name: "pull_request_author_type", Type: field.TypeString, Default: ""},
+		{Name: "issue_kind", Type: field.TypeString, Default: ""},
+		{Name: "issue_opened_at", Type: field.TypeTime, Nullable: true},
+		{Name: "work_category", Type: field.TypeString, Default: ""},
+		{Name: "day_only", Type: field.TypeBool, Default: false},
+		{Name: "recorded_at", Type: field.TypeTime},
+	}
+	// ActivityEventsTable holds the schema information for the "activity_events" table.
+	ActivityEventsTable = &schema.Table{
+		Name:       "activity_events",
+		Columns:    ActivityEventsColumns,
+		PrimaryKey: []*schema.Column{ActivityEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "activityevent_natural_key",
+				Unique:  true,
+				Columns: []*schema.Column{ActivityEventsColumns[1]},
+			},
+			{
+				Name:    "activityevent_login_occurred_at",
+				Unique:  false,
+				Columns: []*schema.Column{ActivityEventsColumns[2], ActivityEventsColumns[8]},
+			},
+		},
+	}
+	// ExcludedMembersColumns holds the columns for the "excluded_members" table.
+	ExcludedMembersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "login", Type: field.TypeString},
+		{Name: "rule", Type: field.TypeString, Default: ""},
+		{Name: "snapshot_excluded_members", Type: field.TypeInt},
+	}
+	// ExcludedMembersTable holds the schema information for the "excluded_members" table.
+	ExcludedMembersTable = &schema.Table{
+		Name:       "excluded_members",
+		Columns:    ExcludedMembersColumns,
+		PrimaryKey: []*schema.Column{ExcludedMembersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "excluded_members_snapshots_excluded_members",
+				Columns:    []*schema.Column{ExcludedMembersColumns[3]},
+				RefColumns: []*schema.Column{SnapshotsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "excludedmember_login_snapshot_excluded_members",
+				Unique:  false,
+				Columns: []*schema.Column{ExcludedMembersColumns[1], ExcludedMembersColumns[3]},
+			},
+		},
+	}
+	// MemberAccountsColumns holds the columns for the "member_accounts" table.
+	MemberAccountsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "login", Type: field.TypeString},
+		{Name: "account", Type: field.TypeString},
+		{Name: "snapshot_member_accounts", Type: field.TypeInt},
+	}
+	// MemberAccountsTable holds the schema information for the "member_accounts" table.
+	MemberAccountsTable = &schema.Table{
+		Name:       "member_accounts",
+		Columns:    MemberAccountsColumns,
+		PrimaryKey: []*schema.Column{MemberAccountsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "member_accounts_snapshots_member_accounts",
+				Columns:    []*schema.Column{MemberAccountsColumns[3]},
+				RefColumns: []*schema.Column{SnapshotsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "memberaccount_login_account_snapshot_member_accounts",
+				Unique:  true,
+				Columns: []*schema.Column{MemberAccountsColumns[1], MemberAccountsColumns[2], MemberAccountsColumns[3]},
+			},
+		},
+	}
+	// MemberDataGapsColumns holds the columns for the "member_data_gaps" table.
+	MemberDataGapsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "login", Type: field.TypeString},
+		{Name: "activity_type", Type: field.TypeString},
+		{Name: "name_with_owner", Type: field.TypeString, Default: ""},
+		{Name: "reason", Type: field.TypeString},
+		{Name: "message", Type: field.TypeString, Default: ""},
+		{Name: "snapshot_member_data_gaps", Type: field.TypeInt},
+	}
+	// MemberDataGapsTable holds the schema information for the "member_data_gaps" table.
+	MemberDataGapsTable = &schema.Table{
+		Name:       "member_data_gaps",
+		Columns:    MemberDataGapsColumns,
+		PrimaryKey: []*schema.Column{MemberDataGapsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "member_data_gaps_snapshots_member_data_gaps",
+				Columns:    []*schema.Column{MemberDataGapsColumns[6]},
+				RefColumns: []*schema.Column{SnapshotsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "memberdatagap_login_snapshot_member_data_gaps",
+				Unique:  false,
+				Columns: []*schema.Column{MemberDataGapsColumns[1], MemberDataGapsColumns[6]},
+			},
+		},
+	}
 	// MemberDayStatsColumns holds the columns for the "member_day_stats" table.
 	MemberDayStatsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -20,6 +147,20 @@ var (
 		{Name: "review_count", Type: field.TypeInt, Default: 0},
 		{Name: "additions", Type: field.TypeInt, Default: 0},
 		{Name: "deletions", Type: field.TypeInt, Default: 0},
+		{Name: "excluded_review_count", Type: field.TypeInt, Default: 0},
+		{Name: "issues_closed", Type: field.TypeInt, Default: 0},
+		{Name: "issue_close_seconds", Type: field.TypeInt, Default: 0},
+		{Name: "bug_issues_opened", Type: field.TypeInt, Default: 0},
+		{Name: "feature_issues_opened", Type: field.TypeInt, Default: 0},
+		{Name: "bug_issues_closed", Type: field.TypeInt, Default: 0},
+		{Name: "feature_issues_closed", Type: field.TypeInt, Default: 0},
+		{Name: "feature_prs", Type: field.TypeInt, Default: 0},
+		{Name: "bug_prs", Type: field.TypeInt, Default: 0},
+		{Name: "chore_prs", Type: field.TypeInt, Default: 0},
+		{Name: "docs_prs", Type: field.TypeInt, Default: 0},
+		{Name: "test_prs", Type: field.TypeInt, Default: 0},
+		{Name: "infra_prs", Type: field.TypeInt, Default: 0},
+		{Name: "other_prs", Type: field.TypeInt, Default: 0},
 		{Name: "snapshot_member_day_stats", Type: field.TypeInt},
 	}
 	// MemberDayStatsTable holds the schema information for the "member_day_stats" table.
@@ -30,7 +171,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "member_day_stats_snapshots_member_day_stats",
-				Columns:    []*schema.Column{MemberDayStatsColumns[10]},
+				Columns:    []*schema.Column{MemberDayStatsColumns[24]},
 				RefColumns: []*schema.Column{SnapshotsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -39,7 +180,127 @@ var (
 			{
 				Name:    "memberdaystat_login_day_snapshot_member_day_stats",
 				Unique:  true,
-				Columns: []*schema.Column{MemberDayStatsColumns[1], MemberDayStatsColumns[2], MemberDayStatsColumns[10]},
+				Columns: []*schema.Column{MemberDayStatsColumns[1], MemberDayStatsColumns[2], MemberDayStatsColumns[24]},
+			},
+		},
+	}
+	// MemberHourStatsColumns holds the columns for the "member_hour_stats" table.
+	MemberHourStatsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "login", Type: field.TypeString},
+		{Name: "hour_start", Type: field.TypeTime},
+		{Name: "day", Type: field.TypeString},
+		{Name: "weekday", Type: field.TypeInt, Default: 0},
+		{Name: "hour", Type: field.TypeInt, Default: 0},
+		{Name: "activity_count", Type: field.TypeInt, Default: 0},
+		{Name: "snapshot_member_hour_stats", Type: field.TypeInt},
+	}
+	// MemberHourStatsTable holds the schema information for the "member_hour_stats" table.
+	MemberHourStatsTable = &schema.Table{
+		Name:       "member_hour_stats",
+		Columns:    MemberHourStatsColumns,
+		PrimaryKey: []*schema.Column{MemberHourStatsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "member_hour_stats_snapshots_member_hour_stats",
+				Columns:    []*schema.Column{MemberHourStatsColumns[7]},
+				RefColumns: []*schema.Column{SnapshotsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "memberhourstat_login_hour_start_snapshot_member_hour_stats",
+				Unique:  true,
+				Columns: []*schema.Column{MemberHourStatsColumns[1], MemberHourStatsColumns[2], MemberHourStatsColumns[7]},
+			},
+			{
+				Name:    "memberhourstat_login_day_snapshot_member_hour_stats",
+				Unique:  false,
+				Columns: []*schema.Column{MemberHourStatsColumns[1], MemberHourStatsColumns[3], MemberHourStatsColumns[7]},
+			},
+		},
+	}
+	// MemberIssuesColumns holds the columns for the "member_issues" table.
+	MemberIssuesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "login", Type: field.TypeString},
+		{Name: "name_with_owner", Type: field.TypeString},
+		{Name: "source_id", Type: field.TypeString},
+		{Name: "author", Type: field.TypeString, Default: ""},
+		{Name: "opened_at", Type: field.TypeTime},
+		{Name: "closed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "closed_by", Type: field.TypeString, Default: ""},
+		{Name: "state_reason", Type: field.TypeString, Default: ""},
+		{Name: "kind", Type: field.TypeString, Default: ""},
+		{Name: "snapshot_member_issues", Type: field.TypeInt},
+	}
+	// MemberIssuesTable holds the schema information for the "member_issues" table.
+	MemberIssuesTable = &schema.Table{
+		Name:       "member_issues",
+		Columns:    MemberIssuesColumns,
+		PrimaryKey: []*schema.Column{MemberIssuesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "member_issues_snapshots_member_issues",
+				Columns:    []*schema.Column{MemberIssuesColumns[10]},
+				RefColumns: []*schema.Column{SnapshotsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "memberissue_login_snapshot_member_issues",
+				Unique:  false,
+				Columns: []*schema.Column{MemberIssuesColumns[1], MemberIssuesColumns[10]},
+			},
+			{
+				Name:    "memberissue_name_with_owner_snapshot_member_issues",
+				Unique:  false,
+				Columns: []*schema.Column{MemberIssuesColumns[2], MemberIssuesColumns[10]},
+			},
+		},
+	}
+	// MemberPullRequestsColumns holds the columns for the "member_pull_requests" table.
+	MemberPullRequestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "login", Type: field.TypeString},
+		{Name: "name_with_owner", Type: field.TypeString},
+		{Name: "source_id", Type: field.TypeString, Default: ""},
+		{Name: "opened_at", Type: field.TypeTime},
+		{Name: "first_review_at", Type: field.TypeTime, Nullable: true},
+		{Name: "approved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "merged_at", Type: field.TypeTime, Nullable: true},
+		{Name: "closed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "review_rounds", Type: field.TypeInt, Default: 0},
+		{Name: "additions", Type: field.TypeInt, Default: 0},
+		{Name: "deletions", Type: field.TypeInt, Default: 0},
+		{Name: "changed_files", Type: field.TypeInt, Default: 0},
+		{Name: "snapshot_member_pull_requests", Type: field.TypeInt},
+	}
+	// MemberPullRequestsTable holds the schema information for the "member_pull_requests" table.
+	MemberPullRequestsTable = &schema.Table{
+		Name:       "member_pull_requests",
+		Columns:    MemberPullRequestsColumns,
+		PrimaryKey: []*schema.Column{MemberPullRequestsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "member_pull_requests_snapshots_member_pull_requests",
+				Columns:    []*schema.Column{MemberPullRequestsColumns[13]},
+				RefColumns: []*schema.Column{SnapshotsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "memberpullrequest_login_snapshot_member_pull_requests",
+				Unique:  false,
+				Columns: []*schema.Column{MemberPullRequestsColumns[1], MemberPullRequestsColumns[13]},
+			},
+			{
+				Name:    "memberpullrequest_name_with_owner_snapshot_member_pull_requests",
+				Unique:  false,
+				Columns: []*schema.Column{MemberPullRequestsColumns[2], MemberPullRequestsColumns[13]},
 			},
 		},
 	}
@@ -56,6 +317,19 @@ var (
 		{Name: "review_count", Type: field.TypeInt, Default: 0},
 		{Name: "additions", Type: field.TypeInt, Default: 0},
 		{Name: "deletions", Type: field.TypeInt, Default: 0},
+		{Name: "issues_closed", Type: field.TypeInt, Default: 0},
+		{Name: "issue_close_seconds", Type: field.TypeInt, Default: 0},
+		{Name: "bug_issues_opened", Type: field.TypeInt, Default: 0},
+		{Name: "feature_issues_opened", Type: field.TypeInt, Default: 0},
+		{Name: "bug_issues_closed", Type: field.TypeInt, Default: 0},
+		{Name: "feature_issues_closed", Type: field.TypeInt, Default: 0},
+		{Name: "feature_prs", Type: field.TypeInt, Default: 0},
+		{Name: "bug_prs", Type: field.TypeInt, Default: 0},
+		{Name: "chore_prs", Type: field.TypeInt, Default: 0},
+		{Name: "docs_prs", Type: field.TypeInt, Default: 0},
+		{Name: "test_prs", Type: field.TypeInt, Default: 0},
+		{Name: "infra_prs", Type: field.TypeInt, Default: 0},
+		{Name: "other_prs", Type: field.TypeInt, Default: 0},
 		{Name: "snapshot_member_repo_day_stats", Type: field.TypeInt},
 	}
 	// MemberRepoDayStatsTable holds the schema information for the "member_repo_day_stats" table.
@@ -66,7 +340,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "member_repo_day_stats_snapshots_member_repo_day_stats",
-				Columns:    []*schema.Column{MemberRepoDayStatsColumns[11]},
+				Columns:    []*schema.Column{MemberRepoDayStatsColumns[24]},
 				RefColumns: []*schema.Column{SnapshotsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -75,12 +349,12 @@ var (
 			{
 				Name:    "memberrepodaystat_login_name_with_owner_day_snapshot_member_repo_day_stats",
 				Unique:  true,
-				Columns: []*schema.Column{MemberRepoDayStatsColumns[1], MemberRepoDayStatsColumns[2], MemberRepoDayStatsColumns[3], MemberRepoDayStatsColumns[11]},
+				Columns: []*schema.Column{MemberRepoDayStatsColumns[1], MemberRepoDayStatsColumns[2], MemberRepoDayStatsColumns[3], MemberRepoDayStatsColumns[24]},
 			},
 			{
 				Name:    "memberrepodaystat_name_with_owner_snapshot_member_repo_day_stats",
 				Unique:  false,
-				Columns: []*schema.Column{MemberRepoDayStatsColumns[2], MemberRepoDayStatsColumns[11]},
+				Columns: []*schema.Column{MemberRepoDayStatsColumns[2], MemberRepoDayStatsColumns[24]},
 			},
 		},
 	}
@@ -96,6 +370,19 @@ var (
 		{Name: "review_count", Type: field.TypeInt, Default: 0},
 		{Name: "additions", Type: field.TypeInt, Default: 0},
 		{Name: "deletions", Type: field.TypeInt, Default: 0},
+		{Name: "issues_closed", Type: field.TypeInt, Default: 0},
+		{Name: "issue_close_seconds", Type: field.TypeInt, Default: 0},
+		{Name: "bug_issues_opened", Type: field.TypeInt, Default: 0},
+		{Name: "feature_issues_opened", Type: field.TypeInt, Default: 0},
+		{Name: "bug_issues_closed", Type: field.TypeInt, Default: 0},
+		{Name: "feature_issues_closed", Type: field.TypeInt, Default: 0},
+		{Name: "feature_prs", Type: field.TypeInt, Default: 0},
+		{Name: "bug_prs", Type: field.TypeInt, Default: 0},
+		{Name: "chore_prs", Type: field.TypeInt, Default: 0},
+		{Name: "docs_prs", Type: field.TypeInt, Default: 0},
+		{Name: "test_prs", Type: field.TypeInt, Default: 0},
+		{Name: "infra_prs", Type: field.TypeInt, Default: 0},
+		{Name: "other_prs", Type: field.TypeInt, Default: 0},
 		{Name: "snapshot_member_repo_stats", Type: field.TypeInt},
 	}
 	// MemberRepoStatsTable holds the schema information for the "member_repo_stats" table.
@@ -106,7 +393,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "member_repo_stats_snapshots_member_repo_stats",
-				Columns:    []*schema.Column{MemberRepoStatsColumns[10]},
+				Columns:    []*schema.Column{MemberRepoStatsColumns[23]},
 				RefColumns: []*schema.Column{SnapshotsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -115,12 +402,50 @@ var (
 			{
 				Name:    "memberrepostat_login_name_with_owner_snapshot_member_repo_stats",
 				Unique:  true,
-				Columns: []*schema.Column{MemberRepoStatsColumns[1], MemberRepoStatsColumns[2], MemberRepoStatsColumns[10]},
+				Columns: []*schema.Column{MemberRepoStatsColumns[1], MemberRepoStatsColumns[2], MemberRepoStatsColumns[23]},
 			},
 			{
 				Name:    "memberrepostat_name_with_owner_snapshot_member_repo_stats",
 				Unique:  false,
-				Columns: []*schema.Column{MemberRepoStatsColumns[2], MemberRepoStatsColumns[10]},
+				Columns: []*schema.Column{MemberRepoStatsColumns[2], MemberRepoStatsColumns[23]},
+			},
+		},
+	}
+	// MemberReviewRequestsColumns holds the columns for the "member_review_requests" table.
+	MemberReviewRequestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "login", Type: field.TypeString},
+		{Name: "name_with_owner", Type: field.TypeString},
+		{Name: "source_id", Type: field.TypeString, Default: ""},
+		{Name: "reviewer", Type: field.TypeString},
+		{Name: "requested_at", Type: field.TypeTime},
+		{Name: "completed", Type: field.TypeBool, Default: false},
+		{Name: "pending", Type: field.TypeBool, Default: false},
+		{Name: "snapshot_member_review_requests", Type: field.TypeInt},
+	}
+	// MemberReviewRequestsTable holds the schema information for the "member_review_requests" table.
+	MemberReviewRequestsTable = &schema.Table{
+		Name:       "member_review_requests",
+		Columns:    MemberReviewRequestsColumns,
+		PrimaryKey: []*schema.Column{MemberReviewRequestsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "member_review_requests_snapshots_member_review_requests",
+				Columns:    []*schema.Column{MemberReviewRequestsColumns[8]},
+				RefColumns: []*schema.Column{SnapshotsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "memberreviewrequest_login_snapshot_member_review_requests",
+				Unique:  false,
+				Columns: []*schema.Column{MemberReviewRequestsColumns[1], MemberReviewRequestsColumns[8]},
+			},
+			{
+				Name:    "memberreviewrequest_reviewer_snapshot_member_review_requests",
+				Unique:  false,
+				Columns: []*schema.Column{MemberReviewRequestsColumns[4], MemberReviewRequestsColumns[8]},
 			},
 		},
 	}
@@ -128,6 +453,7 @@ var (
 	MemberStatsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "login", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString, Default: ""},
 		{Name: "total_commits", Type: field.TypeInt, Default: 0},
 		{Name: "total_pr_created", Type: field.TypeInt, Default: 0},
 		{Name: "total_pr_merged", Type: field.TypeInt, Default: 0},
@@ -138,7 +464,22 @@ var (
 		{Name: "first_activity_year", Type: field.TypeInt, Default: 0},
 		{Name: "peak_activity_year", Type: field.TypeInt, Default: 0},
 		{Name: "peak_activity_commits", Type: field.TypeInt, Default: 0},
+		{Name: "total_excluded_reviews", Type: field.TypeInt, Default: 0},
+		{Name: "total_issues_closed", Type: field.TypeInt, Default: 0},
+		{Name: "total_issue_close_seconds", Type: field.TypeInt, Default: 0},
+		{Name: "total_bug_issues_opened", Type: field.TypeInt, Default: 0},
+		{Name: "total_feature_issues_opened", Type: field.TypeInt, Default: 0},
+		{Name: "total_bug_issues_closed", Type: field.TypeInt, Default: 0},
+		{Name: "total_feature_issues_closed", Type: field.TypeInt, Default: 0},
+		{Name: "total_feature_prs", Type: field.TypeInt, Default: 0},
+		{Name: "total_bug_prs", Type: field.TypeInt, Default: 0},
+		{Name: "total_chore_prs", Type: field.TypeInt, Default: 0},
+		{Name: "total_docs_prs", Type: field.TypeInt, Default: 0},
+		{Name: "total_test_prs", Type: field.TypeInt, Default: 0},
+		{Name: "total_infra_prs", Type: field.TypeInt, Default: 0},
+		{Name: "total_other_prs", Type: field.TypeInt, Default: 0},
 		{Name: "pr_to_review_ratio", Type: field.TypeFloat64, Default: 0},
+		{Name: "time_zone", Type: field.TypeString, Default: ""},
 		{Name: "snapshot_member_stats", Type: field.TypeInt},
 	}
 	// MemberStatsTable holds the schema information for the "member_stats" table.
@@ -149,7 +490,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "member_stats_snapshots_member_stats",
-				Columns:    []*schema.Column{MemberStatsColumns[13]},
+				Columns:    []*schema.Column{MemberStatsColumns[29]},
 				RefColumns: []*schema.Column{SnapshotsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -158,7 +499,7 @@ var (
 			{
 				Name:    "memberstat_login_snapshot_member_stats",
 				Unique:  true,
-				Columns: []*schema.Column{MemberStatsColumns[1], MemberStatsColumns[13]},
+				Columns: []*schema.Column{MemberStatsColumns[1], MemberStatsColumns[29]},
 			},
 		},
 	}
@@ -197,6 +538,41 @@ var (
 			},
 		},
 	}
+	// RepoDeliveryWeeksColumns holds the columns for the "repo_delivery_weeks" table.
+	RepoDeliveryWeeksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name_with_owner", Type: field.TypeString},
+		{Name: "week", Type: field.TypeString},
+		{Name: "source", Type: field.TypeString},
+		{Name: "deployment_count", Type: field.TypeInt, Default: 0},
+		{Name: "failed_deployment_count", Type: field.TypeInt, Default: 0},
+		{Name: "lead_time_seconds", Type: field.TypeInt, Default: 0},
+		{Name: "lead_time_count", Type: field.TypeInt, Default: 0},
+		{Name: "restore_seconds", Type: field.TypeInt, Default: 0},
+		{Name: "restore_count", Type: field.TypeInt, Default: 0},
+		{Name: "snapshot_repo_delivery_weeks", Type: field.TypeInt},
+	}
+	// RepoDeliveryWeeksTable holds the schema information for the "repo_delivery_weeks" table.
+	RepoDeliveryWeeksTable = &schema.Table{
+		Name:       "repo_delivery_weeks",
+		Columns:    RepoDeliveryWeeksColumns,
+		PrimaryKey: []*schema.Column{RepoDeliveryWeeksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "repo_delivery_weeks_snapshots_repo_delivery_weeks",
+				Columns:    []*schema.Column{RepoDeliveryWeeksColumns[10]},
+				RefColumns: []*schema.Column{SnapshotsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "repodeliveryweek_name_with_owner_week_snapshot_repo_delivery_weeks",
+				Unique:  true,
+				Columns: []*schema.Column{RepoDeliveryWeeksColumns[1], RepoDeliveryWeeksColumns[2], RepoDeliveryWeeksColumns[10]},
+			},
+		},
+	}
 	// RepoMetaColumns holds the columns for the "repo_meta" table.
 	RepoMetaColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -226,10 +602,50 @@ var (
 			},
 		},
 	}
+	// ReviewEdgesColumns holds the columns for the "review_edges" table.
+	ReviewEdgesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "reviewer", Type: field.TypeString},
+		{Name: "author", Type: field.TypeString},
+		{Name: "name_with_owner", Type: field.TypeString},
+		{Name: "day", Type: field.TypeString},
+		{Name: "review_count", Type: field.TypeInt, Default: 0},
+		{Name: "snapshot_review_edges", Type: field.TypeInt},
+	}
+	// ReviewEdgesTable holds the schema information for the "review_edges" table.
+	ReviewEdgesTable = &schema.Table{
+		Name:       "review_edges",
+		Columns:    ReviewEdgesColumns,
+		PrimaryKey: []*schema.Column{ReviewEdgesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "review_edges_snapshots_review_edges",
+				Columns:    []*schema.Column{ReviewEdgesColumns[6]},
+				RefColumns: []*schema.Column{SnapshotsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "reviewedge_reviewer_author_name_with_owner_day_snapshot_review_edges",
+				Unique:  true,
+				Columns: []*schema.Column{ReviewEdgesColumns[1], ReviewEdgesColumns[2], ReviewEdgesColumns[3], ReviewEdgesColumns[4], ReviewEdgesColumns[6]},
+			},
+			{
+				Name:    "reviewedge_day_snapshot_review_edges",
+				Unique:  false,
+				Columns: []*schema.Column{ReviewEdgesColumns[4], ReviewEdgesColumns[6]},
+			},
+		},
+	}
 	// SnapshotsColumns holds the columns for the "snapshots" table.
 	SnapshotsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "captured_at", Type: field.TypeTime},
+		{Name: "tag", Type: field.TypeString, Nullable: true},
+		{Name: "period_since", Type: field.TypeTime, Nullable: true},
+		{Name: "period_until", Type: field.TypeTime, Nullable: true},
+		{Name: "lookback_years", Type: field.TypeInt, Default: 0},
 	}
 	// SnapshotsTable holds the schema information for the "snapshots" table.
 	SnapshotsTable = &schema.Table{
@@ -246,21 +662,40 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		ActivityEventsTable,
+		ExcludedMembersTable,
+		MemberAccountsTable,
+		MemberDataGapsTable,
 		MemberDayStatsTable,
+		MemberHourStatsTable,
+		MemberIssuesTable,
+		MemberPullRequestsTable,
 		MemberRepoDayStatsTable,
 		MemberRepoStatsTable,
+		MemberReviewRequestsTable,
 		MemberStatsTable,
 		MemberYearStatsTable,
+		RepoDeliveryWeeksTable,
 		RepoMetaTable,
+		ReviewEdgesTable,
 		SnapshotsTable,
 	}
 )
 
 func init() {
+	ExcludedMembersTable.ForeignKeys[0].RefTable = SnapshotsTable
+	MemberAccountsTable.ForeignKeys[0].RefTable = SnapshotsTable
+	MemberDataGapsTable.ForeignKeys[0].RefTable = SnapshotsTable
 	MemberDayStatsTable.ForeignKeys[0].RefTable = SnapshotsTable
+	MemberHourStatsTable.ForeignKeys[0].RefTable = SnapshotsTable
+	MemberIssuesTable.ForeignKeys[0].RefTable = SnapshotsTable
+	MemberPullRequestsTable.ForeignKeys[0].RefTable = SnapshotsTable
 	MemberRepoDayStatsTable.ForeignKeys[0].RefTable = SnapshotsTable
 	MemberRepoStatsTable.ForeignKeys[0].RefTable = SnapshotsTable
+	MemberReviewRequestsTable.ForeignKeys[0].RefTable = SnapshotsTable
 	MemberStatsTable.ForeignKeys[0].RefTable = SnapshotsTable
 	MemberYearStatsTable.ForeignKeys[0].RefTable = SnapshotsTable
+	RepoDeliveryWeeksTable.ForeignKeys[0].RefTable = SnapshotsTable
 	RepoMetaTable.ForeignKeys[0].RefTable = SnapshotsTable
+	ReviewEdgesTable.ForeignKeys[0].RefTable = SnapshotsTable
 }
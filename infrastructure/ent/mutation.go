@@ -11,13 +11,23 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/activityevent"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/excludedmember"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberaccount"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberdatagap"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/memberdaystat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberhourstat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberissue"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberpullrequest"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/memberrepodaystat"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/memberrepostat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberreviewrequest"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/memberstat"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/memberyearstat"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/predicate"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/repodeliveryweek"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/repometa"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/reviewedge"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/snapshot"
 )
 
@@ -30,56 +40,68 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeMemberDayStat     = "MemberDayStat"
-	TypeMemberRepoDayStat = "MemberRepoDayStat"
-	TypeMemberRepoStat    = "MemberRepoStat"
-	TypeMemberStat        = "MemberStat"
-	TypeMemberYearStat    = "MemberYearStat"
-	TypeRepoMeta          = "RepoMeta"
-	TypeSnapshot          = "Snapshot"
+	TypeActivityEvent       = "ActivityEvent"
+	TypeExcludedMember      = "ExcludedMember"
+	TypeMemberAccount       = "MemberAccount"
+	TypeMemberDataGap       = "MemberDataGap"
+	TypeMemberDayStat       = "MemberDayStat"
+	TypeMemberHourStat      = "MemberHourStat"
+	TypeMemberIssue         = "MemberIssue"
+	TypeMemberPullRequest   = "MemberPullRequest"
+	TypeMemberRepoDayStat   = "MemberRepoDayStat"
+	TypeMemberRepoStat      = "MemberRepoStat"
+	TypeMemberReviewRequest = "MemberReviewRequest"
+	TypeMemberStat          = "MemberStat"
+	TypeMemberYearStat      = "MemberYearStat"
+	TypeRepoDeliveryWeek    = "RepoDeliveryWeek"
+	TypeRepoMeta            = "RepoMeta"
+	TypeReviewEdge          = "ReviewEdge"
+	TypeSnapshot            = "Snapshot"
 )
 
-// MemberDayStatMutation represents an operation that mutates the MemberDayStat nodes in the graph.
-type MemberDayStatMutation struct {
+// ActivityEventMutation represents an operation that mutates the ActivityEvent nodes in the graph.
+type ActivityEventMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	login           *string
-	day             *string
-	commit_count    *int
-	addcommit_count *int
-	pr_created      *int
-	addpr_created   *int
-	pr_merged       *int
-	addpr_merged    *int
-	issue_count     *int
-	addissue_count  *int
-	review_count    *int
-	addreview_count *int
-	additions       *int
-	addadditions    *int
-	deletions       *int
-	adddeletions    *int
-	clearedFields   map[string]struct{}
-	snapshot        *int
-	clearedsnapshot bool
-	done            bool
-	oldValue        func(context.Context) (*MemberDayStat, error)
-	predicates      []predicate.MemberDayStat
+	op                       Op
+	typ                      string
+	id                       *int
+	natural_key              *string
+	login                    *string
+	activity_type            *string
+	source_id                *string
+	name_with_owner          *string
+	owner                    *string
+	owner_type               *string
+	occurred_at              *time.Time
+	additions                *int
+	addadditions             *int
+	deletions                *int
+	adddeletions             *int
+	is_merged                *bool
+	pull_request_author      *string
+	pull_request_author_type *string
+	issue_kind               *string
+	issue_opened_at          *time.Time
+	work_category            *string
+	day_only                 *bool
+	recorded_at              *time.Time
+	clearedFields            map[string]struct{}
+	done                     bool
+	oldValue                 func(context.Context) (*ActivityEvent, error)
+	predicates               []predicate.ActivityEvent
 }
 
-var _ ent.Mutation = (*MemberDayStatMutation)(nil)
+var _ ent.Mutation = (*ActivityEventMutation)(nil)
 
-// memberdaystatOption allows management of the mutation configuration using functional options.
-type memberdaystatOption func(*MemberDayStatMutation)
+// activityeventOption allows management of the mutation configuration using functional options.
+type activityeventOption func(*ActivityEventMutation)
 
-// newMemberDayStatMutation creates new mutation for the MemberDayStat entity.
-func newMemberDayStatMutation(c config, op Op, opts ...memberdaystatOption) *MemberDayStatMutation {
-	m := &MemberDayStatMutation{
+// newActivityEventMutation creates new mutation for the ActivityEvent entity.
+func newActivityEventMutation(c config, op Op, opts ...activityeventOption) *ActivityEventMutation {
+	m := &ActivityEventMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMemberDayStat,
+		typ:           TypeActivityEvent,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -88,20 +110,20 @@ func newMemberDayStatMutation(c config, op Op, opts ...memberdaystatOption) *Mem
 	return m
 }
 
-// withMemberDayStatID sets the ID field of the mutation.
-func withMemberDayStatID(id int) memberdaystatOption {
-	return func(m *MemberDayStatMutation) {
+// withActivityEventID sets the ID field of the mutation.
+func withActivityEventID(id int) activityeventOption {
+	return func(m *ActivityEventMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *MemberDayStat
+			value *ActivityEvent
 		)
-		m.oldValue = func(ctx context.Context) (*MemberDayStat, error) {
+		m.oldValue = func(ctx context.Context) (*ActivityEvent, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().MemberDayStat.Get(ctx, id)
+					value, err = m.Client().ActivityEvent.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -110,10 +132,10 @@ func withMemberDayStatID(id int) memberdaystatOption {
 	}
 }
 
-// withMemberDayStat sets the old MemberDayStat of the mutation.
-func withMemberDayStat(node *MemberDayStat) memberdaystatOption {
-	return func(m *MemberDayStatMutation) {
-		m.oldValue = func(context.Context) (*MemberDayStat, error) {
+// withActivityEvent sets the old ActivityEvent of the mutation.
+func withActivityEvent(node *ActivityEvent) activityeventOption {
+	return func(m *ActivityEventMutation) {
+		m.oldValue = func(context.Context) (*ActivityEvent, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -122,7 +144,7 @@ func withMemberDayStat(node *MemberDayStat) memberdaystatOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MemberDayStatMutation) Client() *Client {
+func (m ActivityEventMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -130,7 +152,7 @@ func (m MemberDayStatMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MemberDayStatMutation) Tx() (*Tx, error) {
+func (m ActivityEventMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -141,7 +163,7 @@ func (m MemberDayStatMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MemberDayStatMutation) ID() (id int, exists bool) {
+func (m *ActivityEventMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -152,7 +174,7 @@ func (m *MemberDayStatMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MemberDayStatMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ActivityEventMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -161,19 +183,55 @@ func (m *MemberDayStatMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().MemberDayStat.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ActivityEvent.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
+// SetNaturalKey sets the "natural_key" field.
+func (m *ActivityEventMutation) SetNaturalKey(s string) {
+	m.natural_key = &s
+}
+
+// NaturalKey returns the value of the "natural_key" field in the mutation.
+func (m *ActivityEventMutation) NaturalKey() (r string, exists bool) {
+	v := m.natural_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNaturalKey returns the old "natural_key" field's value of the ActivityEvent entity.
+// If the ActivityEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivityEventMutation) OldNaturalKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNaturalKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNaturalKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNaturalKey: %w", err)
+	}
+	return oldValue.NaturalKey, nil
+}
+
+// ResetNaturalKey resets all changes to the "natural_key" field.
+func (m *ActivityEventMutation) ResetNaturalKey() {
+	m.natural_key = nil
+}
+
 // SetLogin sets the "login" field.
-func (m *MemberDayStatMutation) SetLogin(s string) {
+func (m *ActivityEventMutation) SetLogin(s string) {
 	m.login = &s
 }
 
 // Login returns the value of the "login" field in the mutation.
-func (m *MemberDayStatMutation) Login() (r string, exists bool) {
+func (m *ActivityEventMutation) Login() (r string, exists bool) {
 	v := m.login
 	if v == nil {
 		return
@@ -181,10 +239,10 @@ func (m *MemberDayStatMutation) Login() (r string, exists bool) {
 	return *v, true
 }
 
-// OldLogin returns the old "login" field's value of the MemberDayStat entity.
-// If the MemberDayStat object wasn't provided to the builder, the object is fetched from the database.
+// OldLogin returns the old "login" field's value of the ActivityEvent entity.
+// If the ActivityEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberDayStatMutation) OldLogin(ctx context.Context) (v string, err error) {
+func (m *ActivityEventMutation) OldLogin(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldLogin is only allowed on UpdateOne operations")
 	}
@@ -199,334 +257,234 @@ func (m *MemberDayStatMutation) OldLogin(ctx context.Context) (v string, err err
 }
 
 // ResetLogin resets all changes to the "login" field.
-func (m *MemberDayStatMutation) ResetLogin() {
+func (m *ActivityEventMutation) ResetLogin() {
 	m.login = nil
 }
 
-// SetDay sets the "day" field.
-func (m *MemberDayStatMutation) SetDay(s string) {
-	m.day = &s
+// SetActivityType sets the "activity_type" field.
+func (m *ActivityEventMutation) SetActivityType(s string) {
+	m.activity_type = &s
 }
 
-// Day returns the value of the "day" field in the mutation.
-func (m *MemberDayStatMutation) Day() (r string, exists bool) {
-	v := m.day
+// ActivityType returns the value of the "activity_type" field in the mutation.
+func (m *ActivityEventMutation) ActivityType() (r string, exists bool) {
+	v := m.activity_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDay returns the old "day" field's value of the MemberDayStat entity.
-// If the MemberDayStat object wasn't provided to the builder, the object is fetched from the database.
+// OldActivityType returns the old "activity_type" field's value of the ActivityEvent entity.
+// If the ActivityEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberDayStatMutation) OldDay(ctx context.Context) (v string, err error) {
+func (m *ActivityEventMutation) OldActivityType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDay is only allowed on UpdateOne operations")
+		return v, errors.New("OldActivityType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDay requires an ID field in the mutation")
+		return v, errors.New("OldActivityType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDay: %w", err)
+		return v, fmt.Errorf("querying old value for OldActivityType: %w", err)
 	}
-	return oldValue.Day, nil
+	return oldValue.ActivityType, nil
 }
 
-// ResetDay resets all changes to the "day" field.
-func (m *MemberDayStatMutation) ResetDay() {
-	m.day = nil
+// ResetActivityType resets all changes to the "activity_type" field.
+func (m *ActivityEventMutation) ResetActivityType() {
+	m.activity_type = nil
 }
 
-// SetCommitCount sets the "commit_count" field.
-func (m *MemberDayStatMutation) SetCommitCount(i int) {
-	m.commit_count = &i
-	m.addcommit_count = nil
+// SetSourceID sets the "source_id" field.
+func (m *ActivityEventMutation) SetSourceID(s string) {
+	m.source_id = &s
 }
 
-// CommitCount returns the value of the "commit_count" field in the mutation.
-func (m *MemberDayStatMutation) CommitCount() (r int, exists bool) {
-	v := m.commit_count
+// SourceID returns the value of the "source_id" field in the mutation.
+func (m *ActivityEventMutation) SourceID() (r string, exists bool) {
+	v := m.source_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCommitCount returns the old "commit_count" field's value of the MemberDayStat entity.
-// If the MemberDayStat object wasn't provided to the builder, the object is fetched from the database.
+// OldSourceID returns the old "source_id" field's value of the ActivityEvent entity.
+// If the ActivityEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberDayStatMutation) OldCommitCount(ctx context.Context) (v int, err error) {
+func (m *ActivityEventMutation) OldSourceID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCommitCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldSourceID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCommitCount requires an ID field in the mutation")
+		return v, errors.New("OldSourceID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCommitCount: %w", err)
-	}
-	return oldValue.CommitCount, nil
-}
-
-// AddCommitCount adds i to the "commit_count" field.
-func (m *MemberDayStatMutation) AddCommitCount(i int) {
-	if m.addcommit_count != nil {
-		*m.addcommit_count += i
-	} else {
-		m.addcommit_count = &i
-	}
-}
-
-// AddedCommitCount returns the value that was added to the "commit_count" field in this mutation.
-func (m *MemberDayStatMutation) AddedCommitCount() (r int, exists bool) {
-	v := m.addcommit_count
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldSourceID: %w", err)
 	}
-	return *v, true
+	return oldValue.SourceID, nil
 }
 
-// ResetCommitCount resets all changes to the "commit_count" field.
-func (m *MemberDayStatMutation) ResetCommitCount() {
-	m.commit_count = nil
-	m.addcommit_count = nil
+// ResetSourceID resets all changes to the "source_id" field.
+func (m *ActivityEventMutation) ResetSourceID() {
+	m.source_id = nil
 }
 
-// SetPrCreated sets the "pr_created" field.
-func (m *MemberDayStatMutation) SetPrCreated(i int) {
-	m.pr_created = &i
-	m.addpr_created = nil
+// SetNameWithOwner sets the "name_with_owner" field.
+func (m *ActivityEventMutation) SetNameWithOwner(s string) {
+	m.name_with_owner = &s
 }
 
-// PrCreated returns the value of the "pr_created" field in the mutation.
-func (m *MemberDayStatMutation) PrCreated() (r int, exists bool) {
-	v := m.pr_created
+// NameWithOwner returns the value of the "name_with_owner" field in the mutation.
+func (m *ActivityEventMutation) NameWithOwner() (r string, exists bool) {
+	v := m.name_with_owner
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPrCreated returns the old "pr_created" field's value of the MemberDayStat entity.
-// If the MemberDayStat object wasn't provided to the builder, the object is fetched from the database.
+// OldNameWithOwner returns the old "name_with_owner" field's value of the ActivityEvent entity.
+// If the ActivityEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberDayStatMutation) OldPrCreated(ctx context.Context) (v int, err error) {
+func (m *ActivityEventMutation) OldNameWithOwner(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPrCreated is only allowed on UpdateOne operations")
+		return v, errors.New("OldNameWithOwner is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPrCreated requires an ID field in the mutation")
+		return v, errors.New("OldNameWithOwner requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPrCreated: %w", err)
-	}
-	return oldValue.PrCreated, nil
-}
-
-// AddPrCreated adds i to the "pr_created" field.
-func (m *MemberDayStatMutation) AddPrCreated(i int) {
-	if m.addpr_created != nil {
-		*m.addpr_created += i
-	} else {
-		m.addpr_created = &i
-	}
-}
-
-// AddedPrCreated returns the value that was added to the "pr_created" field in this mutation.
-func (m *MemberDayStatMutation) AddedPrCreated() (r int, exists bool) {
-	v := m.addpr_created
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldNameWithOwner: %w", err)
 	}
-	return *v, true
+	return oldValue.NameWithOwner, nil
 }
 
-// ResetPrCreated resets all changes to the "pr_created" field.
-func (m *MemberDayStatMutation) ResetPrCreated() {
-	m.pr_created = nil
-	m.addpr_created = nil
+// ResetNameWithOwner resets all changes to the "name_with_owner" field.
+func (m *ActivityEventMutation) ResetNameWithOwner() {
+	m.name_with_owner = nil
 }
 
-// SetPrMerged sets the "pr_merged" field.
-func (m *MemberDayStatMutation) SetPrMerged(i int) {
-	m.pr_merged = &i
-	m.addpr_merged = nil
+// SetOwner sets the "owner" field.
+func (m *ActivityEventMutation) SetOwner(s string) {
+	m.owner = &s
 }
 
-// PrMerged returns the value of the "pr_merged" field in the mutation.
-func (m *MemberDayStatMutation) PrMerged() (r int, exists bool) {
-	v := m.pr_merged
+// Owner returns the value of the "owner" field in the mutation.
+func (m *ActivityEventMutation) Owner() (r string, exists bool) {
+	v := m.owner
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPrMerged returns the old "pr_merged" field's value of the MemberDayStat entity.
-// If the MemberDayStat object wasn't provided to the builder, the object is fetched from the database.
+// OldOwner returns the old "owner" field's value of the ActivityEvent entity.
+// If the ActivityEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberDayStatMutation) OldPrMerged(ctx context.Context) (v int, err error) {
+func (m *ActivityEventMutation) OldOwner(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPrMerged is only allowed on UpdateOne operations")
+		return v, errors.New("OldOwner is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPrMerged requires an ID field in the mutation")
+		return v, errors.New("OldOwner requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPrMerged: %w", err)
+		return v, fmt.Errorf("querying old value for OldOwner: %w", err)
 	}
-	return oldValue.PrMerged, nil
+	return oldValue.Owner, nil
 }
 
-// AddPrMerged adds i to the "pr_merged" field.
-func (m *MemberDayStatMutation) AddPrMerged(i int) {
-	if m.addpr_merged != nil {
-		*m.addpr_merged += i
-	} else {
-		m.addpr_merged = &i
-	}
+// ResetOwner resets all changes to the "owner" field.
+func (m *ActivityEventMutation) ResetOwner() {
+	m.owner = nil
 }
 
-// AddedPrMerged returns the value that was added to the "pr_merged" field in this mutation.
-func (m *MemberDayStatMutation) AddedPrMerged() (r int, exists bool) {
-	v := m.addpr_merged
+// SetOwnerType sets the "owner_type" field.
+func (m *ActivityEventMutation) SetOwnerType(s string) {
+	m.owner_type = &s
+}
+
+// OwnerType returns the value of the "owner_type" field in the mutation.
+func (m *ActivityEventMutation) OwnerType() (r string, exists bool) {
+	v := m.owner_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetPrMerged resets all changes to the "pr_merged" field.
-func (m *MemberDayStatMutation) ResetPrMerged() {
-	m.pr_merged = nil
-	m.addpr_merged = nil
+// OldOwnerType returns the old "owner_type" field's value of the ActivityEvent entity.
+// If the ActivityEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivityEventMutation) OldOwnerType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerType: %w", err)
+	}
+	return oldValue.OwnerType, nil
 }
 
-// SetIssueCount sets the "issue_count" field.
-func (m *MemberDayStatMutation) SetIssueCount(i int) {
-	m.issue_count = &i
-	m.addissue_count = nil
+// ResetOwnerType resets all changes to the "owner_type" field.
+func (m *ActivityEventMutation) ResetOwnerType() {
+	m.owner_type = nil
 }
 
-// IssueCount returns the value of the "issue_count" field in the mutation.
-func (m *MemberDayStatMutation) IssueCount() (r int, exists bool) {
-	v := m.issue_count
+// SetOccurredAt sets the "occurred_at" field.
+func (m *ActivityEventMutation) SetOccurredAt(t time.Time) {
+	m.occurred_at = &t
+}
+
+// OccurredAt returns the value of the "occurred_at" field in the mutation.
+func (m *ActivityEventMutation) OccurredAt() (r time.Time, exists bool) {
+	v := m.occurred_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIssueCount returns the old "issue_count" field's value of the MemberDayStat entity.
-// If the MemberDayStat object wasn't provided to the builder, the object is fetched from the database.
+// OldOccurredAt returns the old "occurred_at" field's value of the ActivityEvent entity.
+// If the ActivityEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberDayStatMutation) OldIssueCount(ctx context.Context) (v int, err error) {
+func (m *ActivityEventMutation) OldOccurredAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIssueCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldOccurredAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIssueCount requires an ID field in the mutation")
+		return v, errors.New("OldOccurredAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIssueCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldOccurredAt: %w", err)
 	}
-	return oldValue.IssueCount, nil
+	return oldValue.OccurredAt, nil
 }
 
-// AddIssueCount adds i to the "issue_count" field.
-func (m *MemberDayStatMutation) AddIssueCount(i int) {
-	if m.addissue_count != nil {
-		*m.addissue_count += i
-	} else {
-		m.addissue_count = &i
-	}
-}
-
-// AddedIssueCount returns the value that was added to the "issue_count" field in this mutation.
-func (m *MemberDayStatMutation) AddedIssueCount() (r int, exists bool) {
-	v := m.addissue_count
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetIssueCount resets all changes to the "issue_count" field.
-func (m *MemberDayStatMutation) ResetIssueCount() {
-	m.issue_count = nil
-	m.addissue_count = nil
-}
-
-// SetReviewCount sets the "review_count" field.
-func (m *MemberDayStatMutation) SetReviewCount(i int) {
-	m.review_count = &i
-	m.addreview_count = nil
-}
-
-// ReviewCount returns the value of the "review_count" field in the mutation.
-func (m *MemberDayStatMutation) ReviewCount() (r int, exists bool) {
-	v := m.review_count
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldReviewCount returns the old "review_count" field's value of the MemberDayStat entity.
-// If the MemberDayStat object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberDayStatMutation) OldReviewCount(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReviewCount is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReviewCount requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReviewCount: %w", err)
-	}
-	return oldValue.ReviewCount, nil
-}
-
-// AddReviewCount adds i to the "review_count" field.
-func (m *MemberDayStatMutation) AddReviewCount(i int) {
-	if m.addreview_count != nil {
-		*m.addreview_count += i
-	} else {
-		m.addreview_count = &i
-	}
-}
-
-// AddedReviewCount returns the value that was added to the "review_count" field in this mutation.
-func (m *MemberDayStatMutation) AddedReviewCount() (r int, exists bool) {
-	v := m.addreview_count
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetReviewCount resets all changes to the "review_count" field.
-func (m *MemberDayStatMutation) ResetReviewCount() {
-	m.review_count = nil
-	m.addreview_count = nil
+// ResetOccurredAt resets all changes to the "occurred_at" field.
+func (m *ActivityEventMutation) ResetOccurredAt() {
+	m.occurred_at = nil
 }
 
 // SetAdditions sets the "additions" field.
-func (m *MemberDayStatMutation) SetAdditions(i int) {
+func (m *ActivityEventMutation) SetAdditions(i int) {
 	m.additions = &i
 	m.addadditions = nil
 }
 
 // Additions returns the value of the "additions" field in the mutation.
-func (m *MemberDayStatMutation) Additions() (r int, exists bool) {
+func (m *ActivityEventMutation) Additions() (r int, exists bool) {
 	v := m.additions
 	if v == nil {
 		return
@@ -534,10 +492,10 @@ func (m *MemberDayStatMutation) Additions() (r int, exists bool) {
 	return *v, true
 }
 
-// OldAdditions returns the old "additions" field's value of the MemberDayStat entity.
-// If the MemberDayStat object wasn't provided to the builder, the object is fetched from the database.
+// OldAdditions returns the old "additions" field's value of the ActivityEvent entity.
+// If the ActivityEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberDayStatMutation) OldAdditions(ctx context.Context) (v int, err error) {
+func (m *ActivityEventMutation) OldAdditions(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldAdditions is only allowed on UpdateOne operations")
 	}
@@ -552,7 +510,7 @@ func (m *MemberDayStatMutation) OldAdditions(ctx context.Context) (v int, err er
 }
 
 // AddAdditions adds i to the "additions" field.
-func (m *MemberDayStatMutation) AddAdditions(i int) {
+func (m *ActivityEventMutation) AddAdditions(i int) {
 	if m.addadditions != nil {
 		*m.addadditions += i
 	} else {
@@ -561,7 +519,7 @@ func (m *MemberDayStatMutation) AddAdditions(i int) {
 }
 
 // AddedAdditions returns the value that was added to the "additions" field in this mutation.
-func (m *MemberDayStatMutation) AddedAdditions() (r int, exists bool) {
+func (m *ActivityEventMutation) AddedAdditions() (r int, exists bool) {
 	v := m.addadditions
 	if v == nil {
 		return
@@ -570,19 +528,19 @@ func (m *MemberDayStatMutation) AddedAdditions() (r int, exists bool) {
 }
 
 // ResetAdditions resets all changes to the "additions" field.
-func (m *MemberDayStatMutation) ResetAdditions() {
+func (m *ActivityEventMutation) ResetAdditions() {
 	m.additions = nil
 	m.addadditions = nil
 }
 
 // SetDeletions sets the "deletions" field.
-func (m *MemberDayStatMutation) SetDeletions(i int) {
+func (m *ActivityEventMutation) SetDeletions(i int) {
 	m.deletions = &i
 	m.adddeletions = nil
 }
 
 // Deletions returns the value of the "deletions" field in the mutation.
-func (m *MemberDayStatMutation) Deletions() (r int, exists bool) {
+func (m *ActivityEventMutation) Deletions() (r int, exists bool) {
 	v := m.deletions
 	if v == nil {
 		return
@@ -590,10 +548,10 @@ func (m *MemberDayStatMutation) Deletions() (r int, exists bool) {
 	return *v, true
 }
 
-// OldDeletions returns the old "deletions" field's value of the MemberDayStat entity.
-// If the MemberDayStat object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletions returns the old "deletions" field's value of the ActivityEvent entity.
+// If the ActivityEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberDayStatMutation) OldDeletions(ctx context.Context) (v int, err error) {
+func (m *ActivityEventMutation) OldDeletions(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDeletions is only allowed on UpdateOne operations")
 	}
@@ -608,7 +566,7 @@ func (m *MemberDayStatMutation) OldDeletions(ctx context.Context) (v int, err er
 }
 
 // AddDeletions adds i to the "deletions" field.
-func (m *MemberDayStatMutation) AddDeletions(i int) {
+func (m *ActivityEventMutation) AddDeletions(i int) {
 	if m.adddeletions != nil {
 		*m.adddeletions += i
 	} else {
@@ -617,7 +575,7 @@ func (m *MemberDayStatMutation) AddDeletions(i int) {
 }
 
 // AddedDeletions returns the value that was added to the "deletions" field in this mutation.
-func (m *MemberDayStatMutation) AddedDeletions() (r int, exists bool) {
+func (m *ActivityEventMutation) AddedDeletions() (r int, exists bool) {
 	v := m.adddeletions
 	if v == nil {
 		return
@@ -626,265 +584,638 @@ func (m *MemberDayStatMutation) AddedDeletions() (r int, exists bool) {
 }
 
 // ResetDeletions resets all changes to the "deletions" field.
-func (m *MemberDayStatMutation) ResetDeletions() {
+func (m *ActivityEventMutation) ResetDeletions() {
 	m.deletions = nil
 	m.adddeletions = nil
 }
 
-// SetSnapshotID sets the "snapshot" edge to the Snapshot entity by id.
-func (m *MemberDayStatMutation) SetSnapshotID(id int) {
-	m.snapshot = &id
+// SetIsMerged sets the "is_merged" field.
+func (m *ActivityEventMutation) SetIsMerged(b bool) {
+	m.is_merged = &b
 }
 
-// ClearSnapshot clears the "snapshot" edge to the Snapshot entity.
-func (m *MemberDayStatMutation) ClearSnapshot() {
-	m.clearedsnapshot = true
+// IsMerged returns the value of the "is_merged" field in the mutation.
+func (m *ActivityEventMutation) IsMerged() (r bool, exists bool) {
+	v := m.is_merged
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SnapshotCleared reports if the "snapshot" edge to the Snapshot entity was cleared.
-func (m *MemberDayStatMutation) SnapshotCleared() bool {
-	return m.clearedsnapshot
+// OldIsMerged returns the old "is_merged" field's value of the ActivityEvent entity.
+// If the ActivityEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivityEventMutation) OldIsMerged(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsMerged is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsMerged requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsMerged: %w", err)
+	}
+	return oldValue.IsMerged, nil
 }
 
-// SnapshotID returns the "snapshot" edge ID in the mutation.
-func (m *MemberDayStatMutation) SnapshotID() (id int, exists bool) {
-	if m.snapshot != nil {
-		return *m.snapshot, true
+// ResetIsMerged resets all changes to the "is_merged" field.
+func (m *ActivityEventMutation) ResetIsMerged() {
+	m.is_merged = nil
+}
+
+// SetPullRequestAuthor sets the "pull_request_author" field.
+func (m *ActivityEventMutation) SetPullRequestAuthor(s string) {
+	m.pull_request_author = &s
+}
+
+// PullRequestAuthor returns the value of the "pull_request_author" field in the mutation.
+func (m *ActivityEventMutation) PullRequestAuthor() (r string, exists bool) {
+	v := m.pull_request_author
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// SnapshotIDs returns the "snapshot" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// SnapshotID instead. It exists only for internal usage by the builders.
-func (m *MemberDayStatMutation) SnapshotIDs() (ids []int) {
-	if id := m.snapshot; id != nil {
-		ids = append(ids, *id)
+// OldPullRequestAuthor returns the old "pull_request_author" field's value of the ActivityEvent entity.
+// If the ActivityEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivityEventMutation) OldPullRequestAuthor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPullRequestAuthor is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPullRequestAuthor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPullRequestAuthor: %w", err)
+	}
+	return oldValue.PullRequestAuthor, nil
 }
 
-// ResetSnapshot resets all changes to the "snapshot" edge.
-func (m *MemberDayStatMutation) ResetSnapshot() {
-	m.snapshot = nil
-	m.clearedsnapshot = false
+// ResetPullRequestAuthor resets all changes to the "pull_request_author" field.
+func (m *ActivityEventMutation) ResetPullRequestAuthor() {
+	m.pull_request_author = nil
 }
 
-// Where appends a list predicates to the MemberDayStatMutation builder.
-func (m *MemberDayStatMutation) Where(ps ...predicate.MemberDayStat) {
-	m.predicates = append(m.predicates, ps...)
+// SetPullRequestAuthorType sets the "pull_request_author_type" field.
+func (m *ActivityEventMutation) SetPullRequestAuthorType(s string) {
+	m.pull_request_author_type = &s
 }
 
-// WhereP appends storage-level predicates to the MemberDayStatMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MemberDayStatMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.MemberDayStat, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// PullRequestAuthorType returns the value of the "pull_request_author_type" field in the mutation.
+func (m *ActivityEventMutation) PullRequestAuthorType() (r string, exists bool) {
+	v := m.pull_request_author_type
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *MemberDayStatMutation) Op() Op {
-	return m.op
+// OldPullRequestAuthorType returns the old "pull_request_author_type" field's value of the ActivityEvent entity.
+// If the ActivityEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivityEventMutation) OldPullRequestAuthorType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPullRequestAuthorType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPullRequestAuthorType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPullRequestAuthorType: %w", err)
+	}
+	return oldValue.PullRequestAuthorType, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *MemberDayStatMutation) SetOp(op Op) {
-	m.op = op
+// ResetPullRequestAuthorType resets all changes to the "pull_request_author_type" field.
+func (m *ActivityEventMutation) ResetPullRequestAuthorType() {
+	m.pull_request_author_type = nil
 }
 
-// Type returns the node type of this mutation (MemberDayStat).
-func (m *MemberDayStatMutation) Type() string {
-	return m.typ
+// SetIssueKind sets the "issue_kind" field.
+func (m *ActivityEventMutation) SetIssueKind(s string) {
+	m.issue_kind = &s
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *MemberDayStatMutation) Fields() []string {
-	fields := make([]string, 0, 9)
-	if m.login != nil {
-		fields = append(fields, memberdaystat.FieldLogin)
-	}
-	if m.day != nil {
-		fields = append(fields, memberdaystat.FieldDay)
+// IssueKind returns the value of the "issue_kind" field in the mutation.
+func (m *ActivityEventMutation) IssueKind() (r string, exists bool) {
+	v := m.issue_kind
+	if v == nil {
+		return
 	}
-	if m.commit_count != nil {
-		fields = append(fields, memberdaystat.FieldCommitCount)
+	return *v, true
+}
+
+// OldIssueKind returns the old "issue_kind" field's value of the ActivityEvent entity.
+// If the ActivityEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivityEventMutation) OldIssueKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIssueKind is only allowed on UpdateOne operations")
 	}
-	if m.pr_created != nil {
-		fields = append(fields, memberdaystat.FieldPrCreated)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIssueKind requires an ID field in the mutation")
 	}
-	if m.pr_merged != nil {
-		fields = append(fields, memberdaystat.FieldPrMerged)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIssueKind: %w", err)
 	}
-	if m.issue_count != nil {
-		fields = append(fields, memberdaystat.FieldIssueCount)
+	return oldValue.IssueKind, nil
+}
+
+// ResetIssueKind resets all changes to the "issue_kind" field.
+func (m *ActivityEventMutation) ResetIssueKind() {
+	m.issue_kind = nil
+}
+
+// SetIssueOpenedAt sets the "issue_opened_at" field.
+func (m *ActivityEventMutation) SetIssueOpenedAt(t time.Time) {
+	m.issue_opened_at = &t
+}
+
+// IssueOpenedAt returns the value of the "issue_opened_at" field in the mutation.
+func (m *ActivityEventMutation) IssueOpenedAt() (r time.Time, exists bool) {
+	v := m.issue_opened_at
+	if v == nil {
+		return
 	}
-	if m.review_count != nil {
-		fields = append(fields, memberdaystat.FieldReviewCount)
+	return *v, true
+}
+
+// OldIssueOpenedAt returns the old "issue_opened_at" field's value of the ActivityEvent entity.
+// If the ActivityEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivityEventMutation) OldIssueOpenedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIssueOpenedAt is only allowed on UpdateOne operations")
 	}
-	if m.additions != nil {
-		fields = append(fields, memberdaystat.FieldAdditions)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIssueOpenedAt requires an ID field in the mutation")
 	}
-	if m.deletions != nil {
-		fields = append(fields, memberdaystat.FieldDeletions)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIssueOpenedAt: %w", err)
 	}
-	return fields
+	return oldValue.IssueOpenedAt, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *MemberDayStatMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case memberdaystat.FieldLogin:
-		return m.Login()
-	case memberdaystat.FieldDay:
-		return m.Day()
-	case memberdaystat.FieldCommitCount:
-		return m.CommitCount()
-	case memberdaystat.FieldPrCreated:
-		return m.PrCreated()
-	case memberdaystat.FieldPrMerged:
-		return m.PrMerged()
-	case memberdaystat.FieldIssueCount:
-		return m.IssueCount()
-	case memberdaystat.FieldReviewCount:
-		return m.ReviewCount()
-	case memberdaystat.FieldAdditions:
-		return m.Additions()
-	case memberdaystat.FieldDeletions:
-		return m.Deletions()
-	}
-	return nil, false
+// ClearIssueOpenedAt clears the value of the "issue_opened_at" field.
+func (m *ActivityEventMutation) ClearIssueOpenedAt() {
+	m.issue_opened_at = nil
+	m.clearedFields[activityevent.FieldIssueOpenedAt] = struct{}{}
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *MemberDayStatMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case memberdaystat.FieldLogin:
-		return m.OldLogin(ctx)
-	case memberdaystat.FieldDay:
-		return m.OldDay(ctx)
-	case memberdaystat.FieldCommitCount:
-		return m.OldCommitCount(ctx)
-	case memberdaystat.FieldPrCreated:
-		return m.OldPrCreated(ctx)
-	case memberdaystat.FieldPrMerged:
-		return m.OldPrMerged(ctx)
-	case memberdaystat.FieldIssueCount:
-		return m.OldIssueCount(ctx)
-	case memberdaystat.FieldReviewCount:
-		return m.OldReviewCount(ctx)
-	case memberdaystat.FieldAdditions:
+// IssueOpenedAtCleared returns if the "issue_opened_at" field was cleared in this mutation.
+func (m *ActivityEventMutation) IssueOpenedAtCleared() bool {
+	_, ok := m.clearedFields[activityevent.FieldIssueOpenedAt]
+	return ok
+}
+
+// ResetIssueOpenedAt resets all changes to the "issue_opened_at" field.
+func (m *ActivityEventMutation) ResetIssueOpenedAt() {
+	m.issue_opened_at = nil
+	delete(m.clearedFields, activityevent.FieldIssueOpenedAt)
+}
+
+// SetWorkCategory sets the "work_category" field.
+func (m *ActivityEventMutation) SetWorkCategory(s string) {
+	m.work_category = &s
+}
+
+// WorkCategory returns the value of the "work_category" field in the mutation.
+func (m *ActivityEventMutation) WorkCategory() (r string, exists bool) {
+	v := m.work_category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkCategory returns the old "work_category" field's value of the ActivityEvent entity.
+// If the ActivityEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivityEventMutation) OldWorkCategory(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkCategory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkCategory: %w", err)
+	}
+	return oldValue.WorkCategory, nil
+}
+
+// ResetWorkCategory resets all changes to the "work_category" field.
+func (m *ActivityEventMutation) ResetWorkCategory() {
+	m.work_category = nil
+}
+
+// SetDayOnly sets the "day_only" field.
+func (m *ActivityEventMutation) SetDayOnly(b bool) {
+	m.day_only = &b
+}
+
+// DayOnly returns the value of the "day_only" field in the mutation.
+func (m *ActivityEventMutation) DayOnly() (r bool, exists bool) {
+	v := m.day_only
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDayOnly returns the old "day_only" field's value of the ActivityEvent entity.
+// If the ActivityEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivityEventMutation) OldDayOnly(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDayOnly is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDayOnly requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDayOnly: %w", err)
+	}
+	return oldValue.DayOnly, nil
+}
+
+// ResetDayOnly resets all changes to the "day_only" field.
+func (m *ActivityEventMutation) ResetDayOnly() {
+	m.day_only = nil
+}
+
+// SetRecordedAt sets the "recorded_at" field.
+func (m *ActivityEventMutation) SetRecordedAt(t time.Time) {
+	m.recorded_at = &t
+}
+
+// RecordedAt returns the value of the "recorded_at" field in the mutation.
+func (m *ActivityEventMutation) RecordedAt() (r time.Time, exists bool) {
+	v := m.recorded_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRecordedAt returns the old "recorded_at" field's value of the ActivityEvent entity.
+// If the ActivityEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivityEventMutation) OldRecordedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRecordedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRecordedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRecordedAt: %w", err)
+	}
+	return oldValue.RecordedAt, nil
+}
+
+// ResetRecordedAt resets all changes to the "recorded_at" field.
+func (m *ActivityEventMutation) ResetRecordedAt() {
+	m.recorded_at = nil
+}
+
+// Where appends a list predicates to the ActivityEventMutation builder.
+func (m *ActivityEventMutation) Where(ps ...predicate.ActivityEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ActivityEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ActivityEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ActivityEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ActivityEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ActivityEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ActivityEvent).
+func (m *ActivityEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ActivityEventMutation) Fields() []string {
+	fields := make([]string, 0, 18)
+	if m.natural_key != nil {
+		fields = append(fields, activityevent.FieldNaturalKey)
+	}
+	if m.login != nil {
+		fields = append(fields, activityevent.FieldLogin)
+	}
+	if m.activity_type != nil {
+		fields = append(fields, activityevent.FieldActivityType)
+	}
+	if m.source_id != nil {
+		fields = append(fields, activityevent.FieldSourceID)
+	}
+	if m.name_with_owner != nil {
+		fields = append(fields, activityevent.FieldNameWithOwner)
+	}
+	if m.owner != nil {
+		fields = append(fields, activityevent.FieldOwner)
+	}
+	if m.owner_type != nil {
+		fields = append(fields, activityevent.FieldOwnerType)
+	}
+	if m.occurred_at != nil {
+		fields = append(fields, activityevent.FieldOccurredAt)
+	}
+	if m.additions != nil {
+		fields = append(fields, activityevent.FieldAdditions)
+	}
+	if m.deletions != nil {
+		fields = append(fields, activityevent.FieldDeletions)
+	}
+	if m.is_merged != nil {
+		fields = append(fields, activityevent.FieldIsMerged)
+	}
+	if m.pull_request_author != nil {
+		fields = append(fields, activityevent.FieldPullRequestAuthor)
+	}
+	if m.pull_request_author_type != nil {
+		fields = append(fields, activityevent.FieldPullRequestAuthorType)
+	}
+	if m.issue_kind != nil {
+		fields = append(fields, activityevent.FieldIssueKind)
+	}
+	if m.issue_opened_at != nil {
+		fields = append(fields, activityevent.FieldIssueOpenedAt)
+	}
+	if m.work_category != nil {
+		fields = append(fields, activityevent.FieldWorkCategory)
+	}
+	if m.day_only != nil {
+		fields = append(fields, activityevent.FieldDayOnly)
+	}
+	if m.recorded_at != nil {
+		fields = append(fields, activityevent.FieldRecordedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ActivityEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case activityevent.FieldNaturalKey:
+		return m.NaturalKey()
+	case activityevent.FieldLogin:
+		return m.Login()
+	case activityevent.FieldActivityType:
+		return m.ActivityType()
+	case activityevent.FieldSourceID:
+		return m.SourceID()
+	case activityevent.FieldNameWithOwner:
+		return m.NameWithOwner()
+	case activityevent.FieldOwner:
+		return m.Owner()
+	case activityevent.FieldOwnerType:
+		return m.OwnerType()
+	case activityevent.FieldOccurredAt:
+		return m.OccurredAt()
+	case activityevent.FieldAdditions:
+		return m.Additions()
+	case activityevent.FieldDeletions:
+		return m.Deletions()
+	case activityevent.FieldIsMerged:
+		return m.IsMerged()
+	case activityevent.FieldPullRequestAuthor:
+		return m.PullRequestAuthor()
+	case activityevent.FieldPullRequestAuthorType:
+		return m.PullRequestAuthorType()
+	case activityevent.FieldIssueKind:
+		return m.IssueKind()
+	case activityevent.FieldIssueOpenedAt:
+		return m.IssueOpenedAt()
+	case activityevent.FieldWorkCategory:
+		return m.WorkCategory()
+	case activityevent.FieldDayOnly:
+		return m.DayOnly()
+	case activityevent.FieldRecordedAt:
+		return m.RecordedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ActivityEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case activityevent.FieldNaturalKey:
+		return m.OldNaturalKey(ctx)
+	case activityevent.FieldLogin:
+		return m.OldLogin(ctx)
+	case activityevent.FieldActivityType:
+		return m.OldActivityType(ctx)
+	case activityevent.FieldSourceID:
+		return m.OldSourceID(ctx)
+	case activityevent.FieldNameWithOwner:
+		return m.OldNameWithOwner(ctx)
+	case activityevent.FieldOwner:
+		return m.OldOwner(ctx)
+	case activityevent.FieldOwnerType:
+		return m.OldOwnerType(ctx)
+	case activityevent.FieldOccurredAt:
+		return m.OldOccurredAt(ctx)
+	case activityevent.FieldAdditions:
 		return m.OldAdditions(ctx)
-	case memberdaystat.FieldDeletions:
+	case activityevent.FieldDeletions:
 		return m.OldDeletions(ctx)
-	}
-	return nil, fmt.Errorf("unknown MemberDayStat field %s", name)
+	case activityevent.FieldIsMerged:
+		return m.OldIsMerged(ctx)
+	case activityevent.FieldPullRequestAuthor:
+		return m.OldPullRequestAuthor(ctx)
+	case activityevent.FieldPullRequestAuthorType:
+		return m.OldPullRequestAuthorType(ctx)
+	case activityevent.FieldIssueKind:
+		return m.OldIssueKind(ctx)
+	case activityevent.FieldIssueOpenedAt:
+		return m.OldIssueOpenedAt(ctx)
+	case activityevent.FieldWorkCategory:
+		return m.OldWorkCategory(ctx)
+	case activityevent.FieldDayOnly:
+		return m.OldDayOnly(ctx)
+	case activityevent.FieldRecordedAt:
+		return m.OldRecordedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ActivityEvent field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MemberDayStatMutation) SetField(name string, value ent.Value) error {
+func (m *ActivityEventMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case memberdaystat.FieldLogin:
+	case activityevent.FieldNaturalKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNaturalKey(v)
+		return nil
+	case activityevent.FieldLogin:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetLogin(v)
 		return nil
-	case memberdaystat.FieldDay:
+	case activityevent.FieldActivityType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDay(v)
+		m.SetActivityType(v)
 		return nil
-	case memberdaystat.FieldCommitCount:
-		v, ok := value.(int)
+	case activityevent.FieldSourceID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCommitCount(v)
+		m.SetSourceID(v)
 		return nil
-	case memberdaystat.FieldPrCreated:
-		v, ok := value.(int)
+	case activityevent.FieldNameWithOwner:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPrCreated(v)
+		m.SetNameWithOwner(v)
 		return nil
-	case memberdaystat.FieldPrMerged:
-		v, ok := value.(int)
+	case activityevent.FieldOwner:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPrMerged(v)
+		m.SetOwner(v)
 		return nil
-	case memberdaystat.FieldIssueCount:
-		v, ok := value.(int)
+	case activityevent.FieldOwnerType:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIssueCount(v)
+		m.SetOwnerType(v)
 		return nil
-	case memberdaystat.FieldReviewCount:
-		v, ok := value.(int)
+	case activityevent.FieldOccurredAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReviewCount(v)
+		m.SetOccurredAt(v)
 		return nil
-	case memberdaystat.FieldAdditions:
+	case activityevent.FieldAdditions:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetAdditions(v)
 		return nil
-	case memberdaystat.FieldDeletions:
+	case activityevent.FieldDeletions:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeletions(v)
 		return nil
+	case activityevent.FieldIsMerged:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsMerged(v)
+		return nil
+	case activityevent.FieldPullRequestAuthor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPullRequestAuthor(v)
+		return nil
+	case activityevent.FieldPullRequestAuthorType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPullRequestAuthorType(v)
+		return nil
+	case activityevent.FieldIssueKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIssueKind(v)
+		return nil
+	case activityevent.FieldIssueOpenedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIssueOpenedAt(v)
+		return nil
+	case activityevent.FieldWorkCategory:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkCategory(v)
+		return nil
+	case activityevent.FieldDayOnly:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDayOnly(v)
+		return nil
+	case activityevent.FieldRecordedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRecordedAt(v)
+		return nil
 	}
-	return fmt.Errorf("unknown MemberDayStat field %s", name)
+	return fmt.Errorf("unknown ActivityEvent field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MemberDayStatMutation) AddedFields() []string {
+func (m *ActivityEventMutation) AddedFields() []string {
 	var fields []string
-	if m.addcommit_count != nil {
-		fields = append(fields, memberdaystat.FieldCommitCount)
-	}
-	if m.addpr_created != nil {
-		fields = append(fields, memberdaystat.FieldPrCreated)
-	}
-	if m.addpr_merged != nil {
-		fields = append(fields, memberdaystat.FieldPrMerged)
-	}
-	if m.addissue_count != nil {
-		fields = append(fields, memberdaystat.FieldIssueCount)
-	}
-	if m.addreview_count != nil {
-		fields = append(fields, memberdaystat.FieldReviewCount)
-	}
 	if m.addadditions != nil {
-		fields = append(fields, memberdaystat.FieldAdditions)
+		fields = append(fields, activityevent.FieldAdditions)
 	}
 	if m.adddeletions != nil {
-		fields = append(fields, memberdaystat.FieldDeletions)
+		fields = append(fields, activityevent.FieldDeletions)
 	}
 	return fields
 }
@@ -892,21 +1223,11 @@ func (m *MemberDayStatMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MemberDayStatMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ActivityEventMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case memberdaystat.FieldCommitCount:
-		return m.AddedCommitCount()
-	case memberdaystat.FieldPrCreated:
-		return m.AddedPrCreated()
-	case memberdaystat.FieldPrMerged:
-		return m.AddedPrMerged()
-	case memberdaystat.FieldIssueCount:
-		return m.AddedIssueCount()
-	case memberdaystat.FieldReviewCount:
-		return m.AddedReviewCount()
-	case memberdaystat.FieldAdditions:
+	case activityevent.FieldAdditions:
 		return m.AddedAdditions()
-	case memberdaystat.FieldDeletions:
+	case activityevent.FieldDeletions:
 		return m.AddedDeletions()
 	}
 	return nil, false
@@ -915,51 +1236,16 @@ func (m *MemberDayStatMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MemberDayStatMutation) AddField(name string, value ent.Value) error {
+func (m *ActivityEventMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case memberdaystat.FieldCommitCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCommitCount(v)
-		return nil
-	case memberdaystat.FieldPrCreated:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPrCreated(v)
-		return nil
-	case memberdaystat.FieldPrMerged:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPrMerged(v)
-		return nil
-	case memberdaystat.FieldIssueCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddIssueCount(v)
-		return nil
-	case memberdaystat.FieldReviewCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddReviewCount(v)
-		return nil
-	case memberdaystat.FieldAdditions:
+	case activityevent.FieldAdditions:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddAdditions(v)
 		return nil
-	case memberdaystat.FieldDeletions:
+	case activityevent.FieldDeletions:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -967,179 +1253,180 @@ func (m *MemberDayStatMutation) AddField(name string, value ent.Value) error {
 		m.AddDeletions(v)
 		return nil
 	}
-	return fmt.Errorf("unknown MemberDayStat numeric field %s", name)
+	return fmt.Errorf("unknown ActivityEvent numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MemberDayStatMutation) ClearedFields() []string {
-	return nil
+func (m *ActivityEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(activityevent.FieldIssueOpenedAt) {
+		fields = append(fields, activityevent.FieldIssueOpenedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MemberDayStatMutation) FieldCleared(name string) bool {
+func (m *ActivityEventMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MemberDayStatMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown MemberDayStat nullable field %s", name)
+func (m *ActivityEventMutation) ClearField(name string) error {
+	switch name {
+	case activityevent.FieldIssueOpenedAt:
+		m.ClearIssueOpenedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ActivityEvent nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MemberDayStatMutation) ResetField(name string) error {
+func (m *ActivityEventMutation) ResetField(name string) error {
 	switch name {
-	case memberdaystat.FieldLogin:
+	case activityevent.FieldNaturalKey:
+		m.ResetNaturalKey()
+		return nil
+	case activityevent.FieldLogin:
 		m.ResetLogin()
 		return nil
-	case memberdaystat.FieldDay:
-		m.ResetDay()
+	case activityevent.FieldActivityType:
+		m.ResetActivityType()
 		return nil
-	case memberdaystat.FieldCommitCount:
-		m.ResetCommitCount()
+	case activityevent.FieldSourceID:
+		m.ResetSourceID()
 		return nil
-	case memberdaystat.FieldPrCreated:
-		m.ResetPrCreated()
+	case activityevent.FieldNameWithOwner:
+		m.ResetNameWithOwner()
 		return nil
-	case memberdaystat.FieldPrMerged:
-		m.ResetPrMerged()
+	case activityevent.FieldOwner:
+		m.ResetOwner()
 		return nil
-	case memberdaystat.FieldIssueCount:
-		m.ResetIssueCount()
+	case activityevent.FieldOwnerType:
+		m.ResetOwnerType()
 		return nil
-	case memberdaystat.FieldReviewCount:
-		m.ResetReviewCount()
+	case activityevent.FieldOccurredAt:
+		m.ResetOccurredAt()
 		return nil
-	case memberdaystat.FieldAdditions:
+	case activityevent.FieldAdditions:
 		m.ResetAdditions()
 		return nil
-	case memberdaystat.FieldDeletions:
+	case activityevent.FieldDeletions:
 		m.ResetDeletions()
 		return nil
+	case activityevent.FieldIsMerged:
+		m.ResetIsMerged()
+		return nil
+	case activityevent.FieldPullRequestAuthor:
+		m.ResetPullRequestAuthor()
+		return nil
+	case activityevent.FieldPullRequestAuthorType:
+		m.ResetPullRequestAuthorType()
+		return nil
+	case activityevent.FieldIssueKind:
+		m.ResetIssueKind()
+		return nil
+	case activityevent.FieldIssueOpenedAt:
+		m.ResetIssueOpenedAt()
+		return nil
+	case activityevent.FieldWorkCategory:
+		m.ResetWorkCategory()
+		return nil
+	case activityevent.FieldDayOnly:
+		m.ResetDayOnly()
+		return nil
+	case activityevent.FieldRecordedAt:
+		m.ResetRecordedAt()
+		return nil
 	}
-	return fmt.Errorf("unknown MemberDayStat field %s", name)
+	return fmt.Errorf("unknown ActivityEvent field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MemberDayStatMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.snapshot != nil {
-		edges = append(edges, memberdaystat.EdgeSnapshot)
-	}
+func (m *ActivityEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MemberDayStatMutation) AddedIDs(name string) []ent.Value {
+func (m *ActivityEventMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case memberdaystat.EdgeSnapshot:
-		if id := m.snapshot; id != nil {
-			return []ent.Value{*id}
-		}
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MemberDayStatMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *ActivityEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MemberDayStatMutation) RemovedIDs(name string) []ent.Value {
+func (m *ActivityEventMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MemberDayStatMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedsnapshot {
-		edges = append(edges, memberdaystat.EdgeSnapshot)
-	}
+func (m *ActivityEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MemberDayStatMutation) EdgeCleared(name string) bool {
-	switch name {
-	case memberdaystat.EdgeSnapshot:
-		return m.clearedsnapshot
-	}
+func (m *ActivityEventMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MemberDayStatMutation) ClearEdge(name string) error {
+func (m *ActivityEventMutation) ClearEdge(name string) error {
 	switch name {
-	case memberdaystat.EdgeSnapshot:
-		m.ClearSnapshot()
-		return nil
 	}
-	return fmt.Errorf("unknown MemberDayStat unique edge %s", name)
+	return fmt.Errorf("unknown ActivityEvent unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MemberDayStatMutation) ResetEdge(name string) error {
+func (m *ActivityEventMutation) ResetEdge(name string) error {
 	switch name {
-	case memberdaystat.EdgeSnapshot:
-		m.ResetSnapshot()
-		return nil
 	}
-	return fmt.Errorf("unknown MemberDayStat edge %s", name)
+	return fmt.Errorf("unknown ActivityEvent edge %s", name)
 }
 
-// MemberRepoDayStatMutation represents an operation that mutates the MemberRepoDayStat nodes in the graph.
-type MemberRepoDayStatMutation struct {
+// ExcludedMemberMutation represents an operation that mutates the ExcludedMember nodes in the graph.
+type ExcludedMemberMutation struct {
 	config
 	op              Op
 	typ             string
 	id              *int
 	login           *string
-	name_with_owner *string
-	day             *string
-	commit_count    *int
-	addcommit_count *int
-	pr_created      *int
-	addpr_created   *int
-	pr_merged       *int
-	addpr_merged    *int
-	issue_count     *int
-	addissue_count  *int
-	review_count    *int
-	addreview_count *int
-	additions       *int
-	addadditions    *int
-	deletions       *int
-	adddeletions    *int
+	rule            *string
 	clearedFields   map[string]struct{}
 	snapshot        *int
 	clearedsnapshot bool
 	done            bool
-	oldValue        func(context.Context) (*MemberRepoDayStat, error)
-	predicates      []predicate.MemberRepoDayStat
+	oldValue        func(context.Context) (*ExcludedMember, error)
+	predicates      []predicate.ExcludedMember
 }
 
-var _ ent.Mutation = (*MemberRepoDayStatMutation)(nil)
+var _ ent.Mutation = (*ExcludedMemberMutation)(nil)
 
-// memberrepodaystatOption allows management of the mutation configuration using functional options.
-type memberrepodaystatOption func(*MemberRepoDayStatMutation)
+// excludedmemberOption allows management of the mutation configuration using functional options.
+type excludedmemberOption func(*ExcludedMemberMutation)
 
-// newMemberRepoDayStatMutation creates new mutation for the MemberRepoDayStat entity.
-func newMemberRepoDayStatMutation(c config, op Op, opts ...memberrepodaystatOption) *MemberRepoDayStatMutation {
-	m := &MemberRepoDayStatMutation{
+// newExcludedMemberMutation creates new mutation for the ExcludedMember entity.
+func newExcludedMemberMutation(c config, op Op, opts ...excludedmemberOption) *ExcludedMemberMutation {
+	m := &ExcludedMemberMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMemberRepoDayStat,
+		typ:           TypeExcludedMember,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1148,20 +1435,20 @@ func newMemberRepoDayStatMutation(c config, op Op, opts ...memberrepodaystatOpti
 	return m
 }
 
-// withMemberRepoDayStatID sets the ID field of the mutation.
-func withMemberRepoDayStatID(id int) memberrepodaystatOption {
-	return func(m *MemberRepoDayStatMutation) {
+// withExcludedMemberID sets the ID field of the mutation.
+func withExcludedMemberID(id int) excludedmemberOption {
+	return func(m *ExcludedMemberMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *MemberRepoDayStat
+			value *ExcludedMember
 		)
-		m.oldValue = func(ctx context.Context) (*MemberRepoDayStat, error) {
+		m.oldValue = func(ctx context.Context) (*ExcludedMember, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().MemberRepoDayStat.Get(ctx, id)
+					value, err = m.Client().ExcludedMember.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1170,10 +1457,10 @@ func withMemberRepoDayStatID(id int) memberrepodaystatOption {
 	}
 }
 
-// withMemberRepoDayStat sets the old MemberRepoDayStat of the mutation.
-func withMemberRepoDayStat(node *MemberRepoDayStat) memberrepodaystatOption {
-	return func(m *MemberRepoDayStatMutation) {
-		m.oldValue = func(context.Context) (*MemberRepoDayStat, error) {
+// withExcludedMember sets the old ExcludedMember of the mutation.
+func withExcludedMember(node *ExcludedMember) excludedmemberOption {
+	return func(m *ExcludedMemberMutation) {
+		m.oldValue = func(context.Context) (*ExcludedMember, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1182,7 +1469,7 @@ func withMemberRepoDayStat(node *MemberRepoDayStat) memberrepodaystatOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MemberRepoDayStatMutation) Client() *Client {
+func (m ExcludedMemberMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1190,7 +1477,7 @@ func (m MemberRepoDayStatMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MemberRepoDayStatMutation) Tx() (*Tx, error) {
+func (m ExcludedMemberMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1201,7 +1488,7 @@ func (m MemberRepoDayStatMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MemberRepoDayStatMutation) ID() (id int, exists bool) {
+func (m *ExcludedMemberMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1212,7 +1499,7 @@ func (m *MemberRepoDayStatMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MemberRepoDayStatMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ExcludedMemberMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1221,19 +1508,19 @@ func (m *MemberRepoDayStatMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().MemberRepoDayStat.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ExcludedMember.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetLogin sets the "login" field.
-func (m *MemberRepoDayStatMutation) SetLogin(s string) {
+func (m *ExcludedMemberMutation) SetLogin(s string) {
 	m.login = &s
 }
 
 // Login returns the value of the "login" field in the mutation.
-func (m *MemberRepoDayStatMutation) Login() (r string, exists bool) {
+func (m *ExcludedMemberMutation) Login() (r string, exists bool) {
 	v := m.login
 	if v == nil {
 		return
@@ -1241,10 +1528,10 @@ func (m *MemberRepoDayStatMutation) Login() (r string, exists bool) {
 	return *v, true
 }
 
-// OldLogin returns the old "login" field's value of the MemberRepoDayStat entity.
-// If the MemberRepoDayStat object wasn't provided to the builder, the object is fetched from the database.
+// OldLogin returns the old "login" field's value of the ExcludedMember entity.
+// If the ExcludedMember object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberRepoDayStatMutation) OldLogin(ctx context.Context) (v string, err error) {
+func (m *ExcludedMemberMutation) OldLogin(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldLogin is only allowed on UpdateOne operations")
 	}
@@ -1259,491 +1546,510 @@ func (m *MemberRepoDayStatMutation) OldLogin(ctx context.Context) (v string, err
 }
 
 // ResetLogin resets all changes to the "login" field.
-func (m *MemberRepoDayStatMutation) ResetLogin() {
+func (m *ExcludedMemberMutation) ResetLogin() {
 	m.login = nil
 }
 
-// SetNameWithOwner sets the "name_with_owner" field.
-func (m *MemberRepoDayStatMutation) SetNameWithOwner(s string) {
-	m.name_with_owner = &s
+// SetRule sets the "rule" field.
+func (m *ExcludedMemberMutation) SetRule(s string) {
+	m.rule = &s
 }
 
-// NameWithOwner returns the value of the "name_with_owner" field in the mutation.
-func (m *MemberRepoDayStatMutation) NameWithOwner() (r string, exists bool) {
-	v := m.name_with_owner
+// Rule returns the value of the "rule" field in the mutation.
+func (m *ExcludedMemberMutation) Rule() (r string, exists bool) {
+	v := m.rule
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNameWithOwner returns the old "name_with_owner" field's value of the MemberRepoDayStat entity.
-// If the MemberRepoDayStat object wasn't provided to the builder, the object is fetched from the database.
+// OldRule returns the old "rule" field's value of the ExcludedMember entity.
+// If the ExcludedMember object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberRepoDayStatMutation) OldNameWithOwner(ctx context.Context) (v string, err error) {
+func (m *ExcludedMemberMutation) OldRule(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNameWithOwner is only allowed on UpdateOne operations")
+		return v, errors.New("OldRule is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNameWithOwner requires an ID field in the mutation")
+		return v, errors.New("OldRule requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNameWithOwner: %w", err)
+		return v, fmt.Errorf("querying old value for OldRule: %w", err)
 	}
-	return oldValue.NameWithOwner, nil
+	return oldValue.Rule, nil
 }
 
-// ResetNameWithOwner resets all changes to the "name_with_owner" field.
-func (m *MemberRepoDayStatMutation) ResetNameWithOwner() {
-	m.name_with_owner = nil
+// ResetRule resets all changes to the "rule" field.
+func (m *ExcludedMemberMutation) ResetRule() {
+	m.rule = nil
 }
 
-// SetDay sets the "day" field.
-func (m *MemberRepoDayStatMutation) SetDay(s string) {
-	m.day = &s
+// SetSnapshotID sets the "snapshot" edge to the Snapshot entity by id.
+func (m *ExcludedMemberMutation) SetSnapshotID(id int) {
+	m.snapshot = &id
 }
 
-// Day returns the value of the "day" field in the mutation.
-func (m *MemberRepoDayStatMutation) Day() (r string, exists bool) {
-	v := m.day
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearSnapshot clears the "snapshot" edge to the Snapshot entity.
+func (m *ExcludedMemberMutation) ClearSnapshot() {
+	m.clearedsnapshot = true
 }
 
-// OldDay returns the old "day" field's value of the MemberRepoDayStat entity.
-// If the MemberRepoDayStat object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberRepoDayStatMutation) OldDay(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDay is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDay requires an ID field in the mutation")
+// SnapshotCleared reports if the "snapshot" edge to the Snapshot entity was cleared.
+func (m *ExcludedMemberMutation) SnapshotCleared() bool {
+	return m.clearedsnapshot
+}
+
+// SnapshotID returns the "snapshot" edge ID in the mutation.
+func (m *ExcludedMemberMutation) SnapshotID() (id int, exists bool) {
+	if m.snapshot != nil {
+		return *m.snapshot, true
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDay: %w", err)
+	return
+}
+
+// SnapshotIDs returns the "snapshot" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SnapshotID instead. It exists only for internal usage by the builders.
+func (m *ExcludedMemberMutation) SnapshotIDs() (ids []int) {
+	if id := m.snapshot; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.Day, nil
+	return
 }
 
-// ResetDay resets all changes to the "day" field.
-func (m *MemberRepoDayStatMutation) ResetDay() {
-	m.day = nil
+// ResetSnapshot resets all changes to the "snapshot" edge.
+func (m *ExcludedMemberMutation) ResetSnapshot() {
+	m.snapshot = nil
+	m.clearedsnapshot = false
 }
 
-// SetCommitCount sets the "commit_count" field.
-func (m *MemberRepoDayStatMutation) SetCommitCount(i int) {
-	m.commit_count = &i
-	m.addcommit_count = nil
+// Where appends a list predicates to the ExcludedMemberMutation builder.
+func (m *ExcludedMemberMutation) Where(ps ...predicate.ExcludedMember) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// CommitCount returns the value of the "commit_count" field in the mutation.
-func (m *MemberRepoDayStatMutation) CommitCount() (r int, exists bool) {
-	v := m.commit_count
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the ExcludedMemberMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ExcludedMemberMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ExcludedMember, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldCommitCount returns the old "commit_count" field's value of the MemberRepoDayStat entity.
-// If the MemberRepoDayStat object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberRepoDayStatMutation) OldCommitCount(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCommitCount is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCommitCount requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCommitCount: %w", err)
-	}
-	return oldValue.CommitCount, nil
+// Op returns the operation name.
+func (m *ExcludedMemberMutation) Op() Op {
+	return m.op
 }
 
-// AddCommitCount adds i to the "commit_count" field.
-func (m *MemberRepoDayStatMutation) AddCommitCount(i int) {
-	if m.addcommit_count != nil {
-		*m.addcommit_count += i
-	} else {
-		m.addcommit_count = &i
-	}
+// SetOp allows setting the mutation operation.
+func (m *ExcludedMemberMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// AddedCommitCount returns the value that was added to the "commit_count" field in this mutation.
-func (m *MemberRepoDayStatMutation) AddedCommitCount() (r int, exists bool) {
-	v := m.addcommit_count
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (ExcludedMember).
+func (m *ExcludedMemberMutation) Type() string {
+	return m.typ
 }
 
-// ResetCommitCount resets all changes to the "commit_count" field.
-func (m *MemberRepoDayStatMutation) ResetCommitCount() {
-	m.commit_count = nil
-	m.addcommit_count = nil
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ExcludedMemberMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.login != nil {
+		fields = append(fields, excludedmember.FieldLogin)
+	}
+	if m.rule != nil {
+		fields = append(fields, excludedmember.FieldRule)
+	}
+	return fields
 }
 
-// SetPrCreated sets the "pr_created" field.
-func (m *MemberRepoDayStatMutation) SetPrCreated(i int) {
-	m.pr_created = &i
-	m.addpr_created = nil
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ExcludedMemberMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case excludedmember.FieldLogin:
+		return m.Login()
+	case excludedmember.FieldRule:
+		return m.Rule()
+	}
+	return nil, false
 }
 
-// PrCreated returns the value of the "pr_created" field in the mutation.
-func (m *MemberRepoDayStatMutation) PrCreated() (r int, exists bool) {
-	v := m.pr_created
-	if v == nil {
-		return
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ExcludedMemberMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case excludedmember.FieldLogin:
+		return m.OldLogin(ctx)
+	case excludedmember.FieldRule:
+		return m.OldRule(ctx)
 	}
-	return *v, true
+	return nil, fmt.Errorf("unknown ExcludedMember field %s", name)
 }
 
-// OldPrCreated returns the old "pr_created" field's value of the MemberRepoDayStat entity.
-// If the MemberRepoDayStat object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberRepoDayStatMutation) OldPrCreated(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPrCreated is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPrCreated requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPrCreated: %w", err)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExcludedMemberMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case excludedmember.FieldLogin:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLogin(v)
+		return nil
+	case excludedmember.FieldRule:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRule(v)
+		return nil
 	}
-	return oldValue.PrCreated, nil
+	return fmt.Errorf("unknown ExcludedMember field %s", name)
 }
 
-// AddPrCreated adds i to the "pr_created" field.
-func (m *MemberRepoDayStatMutation) AddPrCreated(i int) {
-	if m.addpr_created != nil {
-		*m.addpr_created += i
-	} else {
-		m.addpr_created = &i
-	}
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ExcludedMemberMutation) AddedFields() []string {
+	return nil
 }
 
-// AddedPrCreated returns the value that was added to the "pr_created" field in this mutation.
-func (m *MemberRepoDayStatMutation) AddedPrCreated() (r int, exists bool) {
-	v := m.addpr_created
-	if v == nil {
-		return
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ExcludedMemberMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExcludedMemberMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown ExcludedMember numeric field %s", name)
 }
 
-// ResetPrCreated resets all changes to the "pr_created" field.
-func (m *MemberRepoDayStatMutation) ResetPrCreated() {
-	m.pr_created = nil
-	m.addpr_created = nil
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ExcludedMemberMutation) ClearedFields() []string {
+	return nil
 }
 
-// SetPrMerged sets the "pr_merged" field.
-func (m *MemberRepoDayStatMutation) SetPrMerged(i int) {
-	m.pr_merged = &i
-	m.addpr_merged = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ExcludedMemberMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// PrMerged returns the value of the "pr_merged" field in the mutation.
-func (m *MemberRepoDayStatMutation) PrMerged() (r int, exists bool) {
-	v := m.pr_merged
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ExcludedMemberMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ExcludedMember nullable field %s", name)
 }
 
-// OldPrMerged returns the old "pr_merged" field's value of the MemberRepoDayStat entity.
-// If the MemberRepoDayStat object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberRepoDayStatMutation) OldPrMerged(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPrMerged is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPrMerged requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPrMerged: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ExcludedMemberMutation) ResetField(name string) error {
+	switch name {
+	case excludedmember.FieldLogin:
+		m.ResetLogin()
+		return nil
+	case excludedmember.FieldRule:
+		m.ResetRule()
+		return nil
 	}
-	return oldValue.PrMerged, nil
+	return fmt.Errorf("unknown ExcludedMember field %s", name)
 }
 
-// AddPrMerged adds i to the "pr_merged" field.
-func (m *MemberRepoDayStatMutation) AddPrMerged(i int) {
-	if m.addpr_merged != nil {
-		*m.addpr_merged += i
-	} else {
-		m.addpr_merged = &i
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ExcludedMemberMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.snapshot != nil {
+		edges = append(edges, excludedmember.EdgeSnapshot)
 	}
+	return edges
 }
 
-// AddedPrMerged returns the value that was added to the "pr_merged" field in this mutation.
-func (m *MemberRepoDayStatMutation) AddedPrMerged() (r int, exists bool) {
-	v := m.addpr_merged
-	if v == nil {
-		return
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ExcludedMemberMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case excludedmember.EdgeSnapshot:
+		if id := m.snapshot; id != nil {
+			return []ent.Value{*id}
+		}
 	}
-	return *v, true
+	return nil
 }
 
-// ResetPrMerged resets all changes to the "pr_merged" field.
-func (m *MemberRepoDayStatMutation) ResetPrMerged() {
-	m.pr_merged = nil
-	m.addpr_merged = nil
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ExcludedMemberMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
 }
 
-// SetIssueCount sets the "issue_count" field.
-func (m *MemberRepoDayStatMutation) SetIssueCount(i int) {
-	m.issue_count = &i
-	m.addissue_count = nil
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ExcludedMemberMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// IssueCount returns the value of the "issue_count" field in the mutation.
-func (m *MemberRepoDayStatMutation) IssueCount() (r int, exists bool) {
-	v := m.issue_count
-	if v == nil {
-		return
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ExcludedMemberMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsnapshot {
+		edges = append(edges, excludedmember.EdgeSnapshot)
 	}
-	return *v, true
+	return edges
 }
 
-// OldIssueCount returns the old "issue_count" field's value of the MemberRepoDayStat entity.
-// If the MemberRepoDayStat object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberRepoDayStatMutation) OldIssueCount(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIssueCount is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIssueCount requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIssueCount: %w", err)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ExcludedMemberMutation) EdgeCleared(name string) bool {
+	switch name {
+	case excludedmember.EdgeSnapshot:
+		return m.clearedsnapshot
 	}
-	return oldValue.IssueCount, nil
+	return false
 }
 
-// AddIssueCount adds i to the "issue_count" field.
-func (m *MemberRepoDayStatMutation) AddIssueCount(i int) {
-	if m.addissue_count != nil {
-		*m.addissue_count += i
-	} else {
-		m.addissue_count = &i
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ExcludedMemberMutation) ClearEdge(name string) error {
+	switch name {
+	case excludedmember.EdgeSnapshot:
+		m.ClearSnapshot()
+		return nil
 	}
+	return fmt.Errorf("unknown ExcludedMember unique edge %s", name)
 }
 
-// AddedIssueCount returns the value that was added to the "issue_count" field in this mutation.
-func (m *MemberRepoDayStatMutation) AddedIssueCount() (r int, exists bool) {
-	v := m.addissue_count
-	if v == nil {
-		return
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ExcludedMemberMutation) ResetEdge(name string) error {
+	switch name {
+	case excludedmember.EdgeSnapshot:
+		m.ResetSnapshot()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown ExcludedMember edge %s", name)
 }
 
-// ResetIssueCount resets all changes to the "issue_count" field.
-func (m *MemberRepoDayStatMutation) ResetIssueCount() {
-	m.issue_count = nil
-	m.addissue_count = nil
+// MemberAccountMutation represents an operation that mutates the MemberAccount nodes in the graph.
+type MemberAccountMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	login           *string
+	account         *string
+	clearedFields   map[string]struct{}
+	snapshot        *int
+	clearedsnapshot bool
+	done            bool
+	oldValue        func(context.Context) (*MemberAccount, error)
+	predicates      []predicate.MemberAccount
 }
 
-// SetReviewCount sets the "review_count" field.
-func (m *MemberRepoDayStatMutation) SetReviewCount(i int) {
-	m.review_count = &i
-	m.addreview_count = nil
-}
+var _ ent.Mutation = (*MemberAccountMutation)(nil)
 
-// ReviewCount returns the value of the "review_count" field in the mutation.
-func (m *MemberRepoDayStatMutation) ReviewCount() (r int, exists bool) {
-	v := m.review_count
-	if v == nil {
-		return
+// memberaccountOption allows management of the mutation configuration using functional options.
+type memberaccountOption func(*MemberAccountMutation)
+
+// newMemberAccountMutation creates new mutation for the MemberAccount entity.
+func newMemberAccountMutation(c config, op Op, opts ...memberaccountOption) *MemberAccountMutation {
+	m := &MemberAccountMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMemberAccount,
+		clearedFields: make(map[string]struct{}),
 	}
-	return *v, true
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// OldReviewCount returns the old "review_count" field's value of the MemberRepoDayStat entity.
-// If the MemberRepoDayStat object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberRepoDayStatMutation) OldReviewCount(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReviewCount is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReviewCount requires an ID field in the mutation")
+// withMemberAccountID sets the ID field of the mutation.
+func withMemberAccountID(id int) memberaccountOption {
+	return func(m *MemberAccountMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MemberAccount
+		)
+		m.oldValue = func(ctx context.Context) (*MemberAccount, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MemberAccount.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReviewCount: %w", err)
+}
+
+// withMemberAccount sets the old MemberAccount of the mutation.
+func withMemberAccount(node *MemberAccount) memberaccountOption {
+	return func(m *MemberAccountMutation) {
+		m.oldValue = func(context.Context) (*MemberAccount, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return oldValue.ReviewCount, nil
 }
 
-// AddReviewCount adds i to the "review_count" field.
-func (m *MemberRepoDayStatMutation) AddReviewCount(i int) {
-	if m.addreview_count != nil {
-		*m.addreview_count += i
-	} else {
-		m.addreview_count = &i
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MemberAccountMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MemberAccountMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// AddedReviewCount returns the value that was added to the "review_count" field in this mutation.
-func (m *MemberRepoDayStatMutation) AddedReviewCount() (r int, exists bool) {
-	v := m.addreview_count
-	if v == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MemberAccountMutation) ID() (id int, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// ResetReviewCount resets all changes to the "review_count" field.
-func (m *MemberRepoDayStatMutation) ResetReviewCount() {
-	m.review_count = nil
-	m.addreview_count = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MemberAccountMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MemberAccount.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetAdditions sets the "additions" field.
-func (m *MemberRepoDayStatMutation) SetAdditions(i int) {
-	m.additions = &i
-	m.addadditions = nil
+// SetLogin sets the "login" field.
+func (m *MemberAccountMutation) SetLogin(s string) {
+	m.login = &s
 }
 
-// Additions returns the value of the "additions" field in the mutation.
-func (m *MemberRepoDayStatMutation) Additions() (r int, exists bool) {
-	v := m.additions
+// Login returns the value of the "login" field in the mutation.
+func (m *MemberAccountMutation) Login() (r string, exists bool) {
+	v := m.login
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAdditions returns the old "additions" field's value of the MemberRepoDayStat entity.
-// If the MemberRepoDayStat object wasn't provided to the builder, the object is fetched from the database.
+// OldLogin returns the old "login" field's value of the MemberAccount entity.
+// If the MemberAccount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberRepoDayStatMutation) OldAdditions(ctx context.Context) (v int, err error) {
+func (m *MemberAccountMutation) OldLogin(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAdditions is only allowed on UpdateOne operations")
+		return v, errors.New("OldLogin is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAdditions requires an ID field in the mutation")
+		return v, errors.New("OldLogin requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAdditions: %w", err)
-	}
-	return oldValue.Additions, nil
-}
-
-// AddAdditions adds i to the "additions" field.
-func (m *MemberRepoDayStatMutation) AddAdditions(i int) {
-	if m.addadditions != nil {
-		*m.addadditions += i
-	} else {
-		m.addadditions = &i
-	}
-}
-
-// AddedAdditions returns the value that was added to the "additions" field in this mutation.
-func (m *MemberRepoDayStatMutation) AddedAdditions() (r int, exists bool) {
-	v := m.addadditions
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldLogin: %w", err)
 	}
-	return *v, true
+	return oldValue.Login, nil
 }
 
-// ResetAdditions resets all changes to the "additions" field.
-func (m *MemberRepoDayStatMutation) ResetAdditions() {
-	m.additions = nil
-	m.addadditions = nil
+// ResetLogin resets all changes to the "login" field.
+func (m *MemberAccountMutation) ResetLogin() {
+	m.login = nil
 }
 
-// SetDeletions sets the "deletions" field.
-func (m *MemberRepoDayStatMutation) SetDeletions(i int) {
-	m.deletions = &i
-	m.adddeletions = nil
+// SetAccount sets the "account" field.
+func (m *MemberAccountMutation) SetAccount(s string) {
+	m.account = &s
 }
 
-// Deletions returns the value of the "deletions" field in the mutation.
-func (m *MemberRepoDayStatMutation) Deletions() (r int, exists bool) {
-	v := m.deletions
+// Account returns the value of the "account" field in the mutation.
+func (m *MemberAccountMutation) Account() (r string, exists bool) {
+	v := m.account
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletions returns the old "deletions" field's value of the MemberRepoDayStat entity.
-// If the MemberRepoDayStat object wasn't provided to the builder, the object is fetched from the database.
+// OldAccount returns the old "account" field's value of the MemberAccount entity.
+// If the MemberAccount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberRepoDayStatMutation) OldDeletions(ctx context.Context) (v int, err error) {
+func (m *MemberAccountMutation) OldAccount(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletions is only allowed on UpdateOne operations")
+		return v, errors.New("OldAccount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletions requires an ID field in the mutation")
+		return v, errors.New("OldAccount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletions: %w", err)
-	}
-	return oldValue.Deletions, nil
-}
-
-// AddDeletions adds i to the "deletions" field.
-func (m *MemberRepoDayStatMutation) AddDeletions(i int) {
-	if m.adddeletions != nil {
-		*m.adddeletions += i
-	} else {
-		m.adddeletions = &i
-	}
-}
-
-// AddedDeletions returns the value that was added to the "deletions" field in this mutation.
-func (m *MemberRepoDayStatMutation) AddedDeletions() (r int, exists bool) {
-	v := m.adddeletions
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldAccount: %w", err)
 	}
-	return *v, true
+	return oldValue.Account, nil
 }
 
-// ResetDeletions resets all changes to the "deletions" field.
-func (m *MemberRepoDayStatMutation) ResetDeletions() {
-	m.deletions = nil
-	m.adddeletions = nil
+// ResetAccount resets all changes to the "account" field.
+func (m *MemberAccountMutation) ResetAccount() {
+	m.account = nil
 }
 
 // SetSnapshotID sets the "snapshot" edge to the Snapshot entity by id.
-func (m *MemberRepoDayStatMutation) SetSnapshotID(id int) {
+func (m *MemberAccountMutation) SetSnapshotID(id int) {
 	m.snapshot = &id
 }
 
 // ClearSnapshot clears the "snapshot" edge to the Snapshot entity.
-func (m *MemberRepoDayStatMutation) ClearSnapshot() {
+func (m *MemberAccountMutation) ClearSnapshot() {
 	m.clearedsnapshot = true
 }
 
 // SnapshotCleared reports if the "snapshot" edge to the Snapshot entity was cleared.
-func (m *MemberRepoDayStatMutation) SnapshotCleared() bool {
+func (m *MemberAccountMutation) SnapshotCleared() bool {
 	return m.clearedsnapshot
 }
 
 // SnapshotID returns the "snapshot" edge ID in the mutation.
-func (m *MemberRepoDayStatMutation) SnapshotID() (id int, exists bool) {
+func (m *MemberAccountMutation) SnapshotID() (id int, exists bool) {
 	if m.snapshot != nil {
 		return *m.snapshot, true
 	}
@@ -1753,7 +2059,7 @@ func (m *MemberRepoDayStatMutation) SnapshotID() (id int, exists bool) {
 // SnapshotIDs returns the "snapshot" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // SnapshotID instead. It exists only for internal usage by the builders.
-func (m *MemberRepoDayStatMutation) SnapshotIDs() (ids []int) {
+func (m *MemberAccountMutation) SnapshotIDs() (ids []int) {
 	if id := m.snapshot; id != nil {
 		ids = append(ids, *id)
 	}
@@ -1761,20 +2067,20 @@ func (m *MemberRepoDayStatMutation) SnapshotIDs() (ids []int) {
 }
 
 // ResetSnapshot resets all changes to the "snapshot" edge.
-func (m *MemberRepoDayStatMutation) ResetSnapshot() {
+func (m *MemberAccountMutation) ResetSnapshot() {
 	m.snapshot = nil
 	m.clearedsnapshot = false
 }
 
-// Where appends a list predicates to the MemberRepoDayStatMutation builder.
-func (m *MemberRepoDayStatMutation) Where(ps ...predicate.MemberRepoDayStat) {
+// Where appends a list predicates to the MemberAccountMutation builder.
+func (m *MemberAccountMutation) Where(ps ...predicate.MemberAccount) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MemberRepoDayStatMutation builder. Using this method,
+// WhereP appends storage-level predicates to the MemberAccountMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MemberRepoDayStatMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.MemberRepoDayStat, len(ps))
+func (m *MemberAccountMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MemberAccount, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1782,54 +2088,30 @@ func (m *MemberRepoDayStatMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MemberRepoDayStatMutation) Op() Op {
+func (m *MemberAccountMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MemberRepoDayStatMutation) SetOp(op Op) {
+func (m *MemberAccountMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (MemberRepoDayStat).
-func (m *MemberRepoDayStatMutation) Type() string {
+// Type returns the node type of this mutation (MemberAccount).
+func (m *MemberAccountMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MemberRepoDayStatMutation) Fields() []string {
-	fields := make([]string, 0, 10)
+func (m *MemberAccountMutation) Fields() []string {
+	fields := make([]string, 0, 2)
 	if m.login != nil {
-		fields = append(fields, memberrepodaystat.FieldLogin)
-	}
-	if m.name_with_owner != nil {
-		fields = append(fields, memberrepodaystat.FieldNameWithOwner)
+		fields = append(fields, memberaccount.FieldLogin)
 	}
-	if m.day != nil {
-		fields = append(fields, memberrepodaystat.FieldDay)
-	}
-	if m.commit_count != nil {
-		fields = append(fields, memberrepodaystat.FieldCommitCount)
-	}
-	if m.pr_created != nil {
-		fields = append(fields, memberrepodaystat.FieldPrCreated)
-	}
-	if m.pr_merged != nil {
-		fields = append(fields, memberrepodaystat.FieldPrMerged)
-	}
-	if m.issue_count != nil {
-		fields = append(fields, memberrepodaystat.FieldIssueCount)
-	}
-	if m.review_count != nil {
-		fields = append(fields, memberrepodaystat.FieldReviewCount)
-	}
-	if m.additions != nil {
-		fields = append(fields, memberrepodaystat.FieldAdditions)
-	}
-	if m.deletions != nil {
-		fields = append(fields, memberrepodaystat.FieldDeletions)
+	if m.account != nil {
+		fields = append(fields, memberaccount.FieldAccount)
 	}
 	return fields
 }
@@ -1837,28 +2119,12 @@ func (m *MemberRepoDayStatMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MemberRepoDayStatMutation) Field(name string) (ent.Value, bool) {
+func (m *MemberAccountMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case memberrepodaystat.FieldLogin:
+	case memberaccount.FieldLogin:
 		return m.Login()
-	case memberrepodaystat.FieldNameWithOwner:
-		return m.NameWithOwner()
-	case memberrepodaystat.FieldDay:
-		return m.Day()
-	case memberrepodaystat.FieldCommitCount:
-		return m.CommitCount()
-	case memberrepodaystat.FieldPrCreated:
-		return m.PrCreated()
-	case memberrepodaystat.FieldPrMerged:
-		return m.PrMerged()
-	case memberrepodaystat.FieldIssueCount:
-		return m.IssueCount()
-	case memberrepodaystat.FieldReviewCount:
-		return m.ReviewCount()
-	case memberrepodaystat.FieldAdditions:
-		return m.Additions()
-	case memberrepodaystat.FieldDeletions:
-		return m.Deletions()
+	case memberaccount.FieldAccount:
+		return m.Account()
 	}
 	return nil, false
 }
@@ -1866,291 +2132,108 @@ func (m *MemberRepoDayStatMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MemberRepoDayStatMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *MemberAccountMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case memberrepodaystat.FieldLogin:
+	case memberaccount.FieldLogin:
 		return m.OldLogin(ctx)
-	case memberrepodaystat.FieldNameWithOwner:
-		return m.OldNameWithOwner(ctx)
-	case memberrepodaystat.FieldDay:
-		return m.OldDay(ctx)
-	case memberrepodaystat.FieldCommitCount:
-		return m.OldCommitCount(ctx)
-	case memberrepodaystat.FieldPrCreated:
-		return m.OldPrCreated(ctx)
-	case memberrepodaystat.FieldPrMerged:
-		return m.OldPrMerged(ctx)
-	case memberrepodaystat.FieldIssueCount:
-		return m.OldIssueCount(ctx)
-	case memberrepodaystat.FieldReviewCount:
-		return m.OldReviewCount(ctx)
-	case memberrepodaystat.FieldAdditions:
-		return m.OldAdditions(ctx)
-	case memberrepodaystat.FieldDeletions:
-		return m.OldDeletions(ctx)
+	case memberaccount.FieldAccount:
+		return m.OldAccount(ctx)
 	}
-	return nil, fmt.Errorf("unknown MemberRepoDayStat field %s", name)
+	return nil, fmt.Errorf("unknown MemberAccount field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MemberRepoDayStatMutation) SetField(name string, value ent.Value) error {
+func (m *MemberAccountMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case memberrepodaystat.FieldLogin:
+	case memberaccount.FieldLogin:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetLogin(v)
 		return nil
-	case memberrepodaystat.FieldNameWithOwner:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetNameWithOwner(v)
-		return nil
-	case memberrepodaystat.FieldDay:
+	case memberaccount.FieldAccount:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDay(v)
-		return nil
-	case memberrepodaystat.FieldCommitCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCommitCount(v)
-		return nil
-	case memberrepodaystat.FieldPrCreated:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPrCreated(v)
-		return nil
-	case memberrepodaystat.FieldPrMerged:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPrMerged(v)
-		return nil
-	case memberrepodaystat.FieldIssueCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIssueCount(v)
-		return nil
-	case memberrepodaystat.FieldReviewCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetReviewCount(v)
-		return nil
-	case memberrepodaystat.FieldAdditions:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAdditions(v)
-		return nil
-	case memberrepodaystat.FieldDeletions:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeletions(v)
+		m.SetAccount(v)
 		return nil
 	}
-	return fmt.Errorf("unknown MemberRepoDayStat field %s", name)
+	return fmt.Errorf("unknown MemberAccount field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MemberRepoDayStatMutation) AddedFields() []string {
-	var fields []string
-	if m.addcommit_count != nil {
-		fields = append(fields, memberrepodaystat.FieldCommitCount)
-	}
-	if m.addpr_created != nil {
-		fields = append(fields, memberrepodaystat.FieldPrCreated)
-	}
-	if m.addpr_merged != nil {
-		fields = append(fields, memberrepodaystat.FieldPrMerged)
-	}
-	if m.addissue_count != nil {
-		fields = append(fields, memberrepodaystat.FieldIssueCount)
-	}
-	if m.addreview_count != nil {
-		fields = append(fields, memberrepodaystat.FieldReviewCount)
-	}
-	if m.addadditions != nil {
-		fields = append(fields, memberrepodaystat.FieldAdditions)
-	}
-	if m.adddeletions != nil {
-		fields = append(fields, memberrepodaystat.FieldDeletions)
-	}
-	return fields
+func (m *MemberAccountMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MemberRepoDayStatMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case memberrepodaystat.FieldCommitCount:
-		return m.AddedCommitCount()
-	case memberrepodaystat.FieldPrCreated:
-		return m.AddedPrCreated()
-	case memberrepodaystat.FieldPrMerged:
-		return m.AddedPrMerged()
-	case memberrepodaystat.FieldIssueCount:
-		return m.AddedIssueCount()
-	case memberrepodaystat.FieldReviewCount:
-		return m.AddedReviewCount()
-	case memberrepodaystat.FieldAdditions:
-		return m.AddedAdditions()
-	case memberrepodaystat.FieldDeletions:
-		return m.AddedDeletions()
-	}
+func (m *MemberAccountMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MemberRepoDayStatMutation) AddField(name string, value ent.Value) error {
+func (m *MemberAccountMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case memberrepodaystat.FieldCommitCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCommitCount(v)
-		return nil
-	case memberrepodaystat.FieldPrCreated:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPrCreated(v)
-		return nil
-	case memberrepodaystat.FieldPrMerged:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPrMerged(v)
-		return nil
-	case memberrepodaystat.FieldIssueCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddIssueCount(v)
-		return nil
-	case memberrepodaystat.FieldReviewCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddReviewCount(v)
-		return nil
-	case memberrepodaystat.FieldAdditions:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddAdditions(v)
-		return nil
-	case memberrepodaystat.FieldDeletions:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDeletions(v)
-		return nil
 	}
-	return fmt.Errorf("unknown MemberRepoDayStat numeric field %s", name)
+	return fmt.Errorf("unknown MemberAccount numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MemberRepoDayStatMutation) ClearedFields() []string {
+func (m *MemberAccountMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MemberRepoDayStatMutation) FieldCleared(name string) bool {
+func (m *MemberAccountMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MemberRepoDayStatMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown MemberRepoDayStat nullable field %s", name)
+func (m *MemberAccountMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MemberAccount nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MemberRepoDayStatMutation) ResetField(name string) error {
+func (m *MemberAccountMutation) ResetField(name string) error {
 	switch name {
-	case memberrepodaystat.FieldLogin:
+	case memberaccount.FieldLogin:
 		m.ResetLogin()
 		return nil
-	case memberrepodaystat.FieldNameWithOwner:
-		m.ResetNameWithOwner()
-		return nil
-	case memberrepodaystat.FieldDay:
-		m.ResetDay()
-		return nil
-	case memberrepodaystat.FieldCommitCount:
-		m.ResetCommitCount()
-		return nil
-	case memberrepodaystat.FieldPrCreated:
-		m.ResetPrCreated()
-		return nil
-	case memberrepodaystat.FieldPrMerged:
-		m.ResetPrMerged()
-		return nil
-	case memberrepodaystat.FieldIssueCount:
-		m.ResetIssueCount()
-		return nil
-	case memberrepodaystat.FieldReviewCount:
-		m.ResetReviewCount()
-		return nil
-	case memberrepodaystat.FieldAdditions:
-		m.ResetAdditions()
-		return nil
-	case memberrepodaystat.FieldDeletions:
-		m.ResetDeletions()
+	case memberaccount.FieldAccount:
+		m.ResetAccount()
 		return nil
 	}
-	return fmt.Errorf("unknown MemberRepoDayStat field %s", name)
+	return fmt.Errorf("unknown MemberAccount field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MemberRepoDayStatMutation) AddedEdges() []string {
+func (m *MemberAccountMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.snapshot != nil {
-		edges = append(edges, memberrepodaystat.EdgeSnapshot)
+		edges = append(edges, memberaccount.EdgeSnapshot)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MemberRepoDayStatMutation) AddedIDs(name string) []ent.Value {
+func (m *MemberAccountMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case memberrepodaystat.EdgeSnapshot:
+	case memberaccount.EdgeSnapshot:
 		if id := m.snapshot; id != nil {
 			return []ent.Value{*id}
 		}
@@ -2159,31 +2242,31 @@ func (m *MemberRepoDayStatMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MemberRepoDayStatMutation) RemovedEdges() []string {
+func (m *MemberAccountMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MemberRepoDayStatMutation) RemovedIDs(name string) []ent.Value {
+func (m *MemberAccountMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MemberRepoDayStatMutation) ClearedEdges() []string {
+func (m *MemberAccountMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.clearedsnapshot {
-		edges = append(edges, memberrepodaystat.EdgeSnapshot)
+		edges = append(edges, memberaccount.EdgeSnapshot)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MemberRepoDayStatMutation) EdgeCleared(name string) bool {
+func (m *MemberAccountMutation) EdgeCleared(name string) bool {
 	switch name {
-	case memberrepodaystat.EdgeSnapshot:
+	case memberaccount.EdgeSnapshot:
 		return m.clearedsnapshot
 	}
 	return false
@@ -2191,67 +2274,56 @@ func (m *MemberRepoDayStatMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MemberRepoDayStatMutation) ClearEdge(name string) error {
+func (m *MemberAccountMutation) ClearEdge(name string) error {
 	switch name {
-	case memberrepodaystat.EdgeSnapshot:
+	case memberaccount.EdgeSnapshot:
 		m.ClearSnapshot()
 		return nil
 	}
-	return fmt.Errorf("unknown MemberRepoDayStat unique edge %s", name)
+	return fmt.Errorf("unknown MemberAccount unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MemberRepoDayStatMutation) ResetEdge(name string) error {
+func (m *MemberAccountMutation) ResetEdge(name string) error {
 	switch name {
-	case memberrepodaystat.EdgeSnapshot:
+	case memberaccount.EdgeSnapshot:
 		m.ResetSnapshot()
 		return nil
 	}
-	return fmt.Errorf("unknown MemberRepoDayStat edge %s", name)
+	return fmt.Errorf("unknown MemberAccount edge %s", name)
 }
 
-// MemberRepoStatMutation represents an operation that mutates the MemberRepoStat nodes in the graph.
-type MemberRepoStatMutation struct {
+// MemberDataGapMutation represents an operation that mutates the MemberDataGap nodes in the graph.
+type MemberDataGapMutation struct {
 	config
 	op              Op
 	typ             string
 	id              *int
 	login           *string
+	activity_type   *string
 	name_with_owner *string
-	commit_count    *int
-	addcommit_count *int
-	pr_created      *int
-	addpr_created   *int
-	pr_merged       *int
-	addpr_merged    *int
-	issue_count     *int
-	addissue_count  *int
-	review_count    *int
-	addreview_count *int
-	additions       *int
-	addadditions    *int
-	deletions       *int
-	adddeletions    *int
+	reason          *string
+	message         *string
 	clearedFields   map[string]struct{}
 	snapshot        *int
 	clearedsnapshot bool
 	done            bool
-	oldValue        func(context.Context) (*MemberRepoStat, error)
-	predicates      []predicate.MemberRepoStat
+	oldValue        func(context.Context) (*MemberDataGap, error)
+	predicates      []predicate.MemberDataGap
 }
 
-var _ ent.Mutation = (*MemberRepoStatMutation)(nil)
+var _ ent.Mutation = (*MemberDataGapMutation)(nil)
 
-// memberrepostatOption allows management of the mutation configuration using functional options.
-type memberrepostatOption func(*MemberRepoStatMutation)
+// memberdatagapOption allows management of the mutation configuration using functional options.
+type memberdatagapOption func(*MemberDataGapMutation)
 
-// newMemberRepoStatMutation creates new mutation for the MemberRepoStat entity.
-func newMemberRepoStatMutation(c config, op Op, opts ...memberrepostatOption) *MemberRepoStatMutation {
-	m := &MemberRepoStatMutation{
+// newMemberDataGapMutation creates new mutation for the MemberDataGap entity.
+func newMemberDataGapMutation(c config, op Op, opts ...memberdatagapOption) *MemberDataGapMutation {
+	m := &MemberDataGapMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMemberRepoStat,
+		typ:           TypeMemberDataGap,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2260,20 +2332,20 @@ func newMemberRepoStatMutation(c config, op Op, opts ...memberrepostatOption) *M
 	return m
 }
 
-// withMemberRepoStatID sets the ID field of the mutation.
-func withMemberRepoStatID(id int) memberrepostatOption {
-	return func(m *MemberRepoStatMutation) {
+// withMemberDataGapID sets the ID field of the mutation.
+func withMemberDataGapID(id int) memberdatagapOption {
+	return func(m *MemberDataGapMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *MemberRepoStat
+			value *MemberDataGap
 		)
-		m.oldValue = func(ctx context.Context) (*MemberRepoStat, error) {
+		m.oldValue = func(ctx context.Context) (*MemberDataGap, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().MemberRepoStat.Get(ctx, id)
+					value, err = m.Client().MemberDataGap.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2282,10 +2354,10 @@ func withMemberRepoStatID(id int) memberrepostatOption {
 	}
 }
 
-// withMemberRepoStat sets the old MemberRepoStat of the mutation.
-func withMemberRepoStat(node *MemberRepoStat) memberrepostatOption {
-	return func(m *MemberRepoStatMutation) {
-		m.oldValue = func(context.Context) (*MemberRepoStat, error) {
+// withMemberDataGap sets the old MemberDataGap of the mutation.
+func withMemberDataGap(node *MemberDataGap) memberdatagapOption {
+	return func(m *MemberDataGapMutation) {
+		m.oldValue = func(context.Context) (*MemberDataGap, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2294,7 +2366,7 @@ func withMemberRepoStat(node *MemberRepoStat) memberrepostatOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MemberRepoStatMutation) Client() *Client {
+func (m MemberDataGapMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2302,7 +2374,7 @@ func (m MemberRepoStatMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MemberRepoStatMutation) Tx() (*Tx, error) {
+func (m MemberDataGapMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2313,7 +2385,7 @@ func (m MemberRepoStatMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MemberRepoStatMutation) ID() (id int, exists bool) {
+func (m *MemberDataGapMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2324,7 +2396,7 @@ func (m *MemberRepoStatMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MemberRepoStatMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *MemberDataGapMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2333,19 +2405,19 @@ func (m *MemberRepoStatMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().MemberRepoStat.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().MemberDataGap.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetLogin sets the "login" field.
-func (m *MemberRepoStatMutation) SetLogin(s string) {
+func (m *MemberDataGapMutation) SetLogin(s string) {
 	m.login = &s
 }
 
 // Login returns the value of the "login" field in the mutation.
-func (m *MemberRepoStatMutation) Login() (r string, exists bool) {
+func (m *MemberDataGapMutation) Login() (r string, exists bool) {
 	v := m.login
 	if v == nil {
 		return
@@ -2353,10 +2425,10 @@ func (m *MemberRepoStatMutation) Login() (r string, exists bool) {
 	return *v, true
 }
 
-// OldLogin returns the old "login" field's value of the MemberRepoStat entity.
-// If the MemberRepoStat object wasn't provided to the builder, the object is fetched from the database.
+// OldLogin returns the old "login" field's value of the MemberDataGap entity.
+// If the MemberDataGap object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberRepoStatMutation) OldLogin(ctx context.Context) (v string, err error) {
+func (m *MemberDataGapMutation) OldLogin(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldLogin is only allowed on UpdateOne operations")
 	}
@@ -2371,503 +2443,9211 @@ func (m *MemberRepoStatMutation) OldLogin(ctx context.Context) (v string, err er
 }
 
 // ResetLogin resets all changes to the "login" field.
-func (m *MemberRepoStatMutation) ResetLogin() {
+func (m *MemberDataGapMutation) ResetLogin() {
 	m.login = nil
 }
 
-// SetNameWithOwner sets the "name_with_owner" field.
-func (m *MemberRepoStatMutation) SetNameWithOwner(s string) {
-	m.name_with_owner = &s
+// SetActivityType sets the "activity_type" field.
+func (m *MemberDataGapMutation) SetActivityType(s string) {
+	m.activity_type = &s
 }
 
-// NameWithOwner returns the value of the "name_with_owner" field in the mutation.
-func (m *MemberRepoStatMutation) NameWithOwner() (r string, exists bool) {
-	v := m.name_with_owner
+// ActivityType returns the value of the "activity_type" field in the mutation.
+func (m *MemberDataGapMutation) ActivityType() (r string, exists bool) {
+	v := m.activity_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNameWithOwner returns the old "name_with_owner" field's value of the MemberRepoStat entity.
-// If the MemberRepoStat object wasn't provided to the builder, the object is fetched from the database.
+// OldActivityType returns the old "activity_type" field's value of the MemberDataGap entity.
+// If the MemberDataGap object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberRepoStatMutation) OldNameWithOwner(ctx context.Context) (v string, err error) {
+func (m *MemberDataGapMutation) OldActivityType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNameWithOwner is only allowed on UpdateOne operations")
+		return v, errors.New("OldActivityType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNameWithOwner requires an ID field in the mutation")
+		return v, errors.New("OldActivityType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNameWithOwner: %w", err)
+		return v, fmt.Errorf("querying old value for OldActivityType: %w", err)
 	}
-	return oldValue.NameWithOwner, nil
+	return oldValue.ActivityType, nil
 }
 
-// ResetNameWithOwner resets all changes to the "name_with_owner" field.
-func (m *MemberRepoStatMutation) ResetNameWithOwner() {
-	m.name_with_owner = nil
+// ResetActivityType resets all changes to the "activity_type" field.
+func (m *MemberDataGapMutation) ResetActivityType() {
+	m.activity_type = nil
 }
 
-// SetCommitCount sets the "commit_count" field.
-func (m *MemberRepoStatMutation) SetCommitCount(i int) {
-	m.commit_count = &i
-	m.addcommit_count = nil
+// SetNameWithOwner sets the "name_with_owner" field.
+func (m *MemberDataGapMutation) SetNameWithOwner(s string) {
+	m.name_with_owner = &s
 }
 
-// CommitCount returns the value of the "commit_count" field in the mutation.
-func (m *MemberRepoStatMutation) CommitCount() (r int, exists bool) {
-	v := m.commit_count
+// NameWithOwner returns the value of the "name_with_owner" field in the mutation.
+func (m *MemberDataGapMutation) NameWithOwner() (r string, exists bool) {
+	v := m.name_with_owner
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCommitCount returns the old "commit_count" field's value of the MemberRepoStat entity.
-// If the MemberRepoStat object wasn't provided to the builder, the object is fetched from the database.
+// OldNameWithOwner returns the old "name_with_owner" field's value of the MemberDataGap entity.
+// If the MemberDataGap object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberRepoStatMutation) OldCommitCount(ctx context.Context) (v int, err error) {
+func (m *MemberDataGapMutation) OldNameWithOwner(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCommitCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldNameWithOwner is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCommitCount requires an ID field in the mutation")
+		return v, errors.New("OldNameWithOwner requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCommitCount: %w", err)
-	}
-	return oldValue.CommitCount, nil
-}
-
-// AddCommitCount adds i to the "commit_count" field.
-func (m *MemberRepoStatMutation) AddCommitCount(i int) {
-	if m.addcommit_count != nil {
-		*m.addcommit_count += i
-	} else {
-		m.addcommit_count = &i
-	}
-}
-
-// AddedCommitCount returns the value that was added to the "commit_count" field in this mutation.
-func (m *MemberRepoStatMutation) AddedCommitCount() (r int, exists bool) {
-	v := m.addcommit_count
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldNameWithOwner: %w", err)
 	}
-	return *v, true
+	return oldValue.NameWithOwner, nil
 }
 
-// ResetCommitCount resets all changes to the "commit_count" field.
-func (m *MemberRepoStatMutation) ResetCommitCount() {
-	m.commit_count = nil
-	m.addcommit_count = nil
+// ResetNameWithOwner resets all changes to the "name_with_owner" field.
+func (m *MemberDataGapMutation) ResetNameWithOwner() {
+	m.name_with_owner = nil
 }
 
-// SetPrCreated sets the "pr_created" field.
-func (m *MemberRepoStatMutation) SetPrCreated(i int) {
-	m.pr_created = &i
-	m.addpr_created = nil
+// SetReason sets the "reason" field.
+func (m *MemberDataGapMutation) SetReason(s string) {
+	m.reason = &s
 }
 
-// PrCreated returns the value of the "pr_created" field in the mutation.
-func (m *MemberRepoStatMutation) PrCreated() (r int, exists bool) {
-	v := m.pr_created
+// Reason returns the value of the "reason" field in the mutation.
+func (m *MemberDataGapMutation) Reason() (r string, exists bool) {
+	v := m.reason
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPrCreated returns the old "pr_created" field's value of the MemberRepoStat entity.
-// If the MemberRepoStat object wasn't provided to the builder, the object is fetched from the database.
+// OldReason returns the old "reason" field's value of the MemberDataGap entity.
+// If the MemberDataGap object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberRepoStatMutation) OldPrCreated(ctx context.Context) (v int, err error) {
+func (m *MemberDataGapMutation) OldReason(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPrCreated is only allowed on UpdateOne operations")
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPrCreated requires an ID field in the mutation")
+		return v, errors.New("OldReason requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPrCreated: %w", err)
-	}
-	return oldValue.PrCreated, nil
-}
-
-// AddPrCreated adds i to the "pr_created" field.
-func (m *MemberRepoStatMutation) AddPrCreated(i int) {
-	if m.addpr_created != nil {
-		*m.addpr_created += i
-	} else {
-		m.addpr_created = &i
-	}
-}
-
-// AddedPrCreated returns the value that was added to the "pr_created" field in this mutation.
-func (m *MemberRepoStatMutation) AddedPrCreated() (r int, exists bool) {
-	v := m.addpr_created
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
 	}
-	return *v, true
+	return oldValue.Reason, nil
 }
 
-// ResetPrCreated resets all changes to the "pr_created" field.
-func (m *MemberRepoStatMutation) ResetPrCreated() {
-	m.pr_created = nil
-	m.addpr_created = nil
+// ResetReason resets all changes to the "reason" field.
+func (m *MemberDataGapMutation) ResetReason() {
+	m.reason = nil
 }
 
-// SetPrMerged sets the "pr_merged" field.
-func (m *MemberRepoStatMutation) SetPrMerged(i int) {
-	m.pr_merged = &i
-	m.addpr_merged = nil
+// SetMessage sets the "message" field.
+func (m *MemberDataGapMutation) SetMessage(s string) {
+	m.message = &s
 }
 
-// PrMerged returns the value of the "pr_merged" field in the mutation.
-func (m *MemberRepoStatMutation) PrMerged() (r int, exists bool) {
-	v := m.pr_merged
+// Message returns the value of the "message" field in the mutation.
+func (m *MemberDataGapMutation) Message() (r string, exists bool) {
+	v := m.message
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPrMerged returns the old "pr_merged" field's value of the MemberRepoStat entity.
-// If the MemberRepoStat object wasn't provided to the builder, the object is fetched from the database.
+// OldMessage returns the old "message" field's value of the MemberDataGap entity.
+// If the MemberDataGap object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberRepoStatMutation) OldPrMerged(ctx context.Context) (v int, err error) {
+func (m *MemberDataGapMutation) OldMessage(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPrMerged is only allowed on UpdateOne operations")
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPrMerged requires an ID field in the mutation")
+		return v, errors.New("OldMessage requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPrMerged: %w", err)
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
 	}
-	return oldValue.PrMerged, nil
+	return oldValue.Message, nil
 }
 
-// AddPrMerged adds i to the "pr_merged" field.
-func (m *MemberRepoStatMutation) AddPrMerged(i int) {
-	if m.addpr_merged != nil {
-		*m.addpr_merged += i
-	} else {
-		m.addpr_merged = &i
-	}
+// ResetMessage resets all changes to the "message" field.
+func (m *MemberDataGapMutation) ResetMessage() {
+	m.message = nil
 }
 
-// AddedPrMerged returns the value that was added to the "pr_merged" field in this mutation.
-func (m *MemberRepoStatMutation) AddedPrMerged() (r int, exists bool) {
-	v := m.addpr_merged
-	if v == nil {
-		return
-	}
-	return *v, true
+// SetSnapshotID sets the "snapshot" edge to the Snapshot entity by id.
+func (m *MemberDataGapMutation) SetSnapshotID(id int) {
+	m.snapshot = &id
 }
 
-// ResetPrMerged resets all changes to the "pr_merged" field.
-func (m *MemberRepoStatMutation) ResetPrMerged() {
-	m.pr_merged = nil
-	m.addpr_merged = nil
+// ClearSnapshot clears the "snapshot" edge to the Snapshot entity.
+func (m *MemberDataGapMutation) ClearSnapshot() {
+	m.clearedsnapshot = true
 }
 
-// SetIssueCount sets the "issue_count" field.
-func (m *MemberRepoStatMutation) SetIssueCount(i int) {
-	m.issue_count = &i
-	m.addissue_count = nil
+// SnapshotCleared reports if the "snapshot" edge to the Snapshot entity was cleared.
+func (m *MemberDataGapMutation) SnapshotCleared() bool {
+	return m.clearedsnapshot
 }
 
-// IssueCount returns the value of the "issue_count" field in the mutation.
-func (m *MemberRepoStatMutation) IssueCount() (r int, exists bool) {
-	v := m.issue_count
-	if v == nil {
-		return
+// SnapshotID returns the "snapshot" edge ID in the mutation.
+func (m *MemberDataGapMutation) SnapshotID() (id int, exists bool) {
+	if m.snapshot != nil {
+		return *m.snapshot, true
 	}
-	return *v, true
+	return
 }
 
-// OldIssueCount returns the old "issue_count" field's value of the MemberRepoStat entity.
-// If the MemberRepoStat object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberRepoStatMutation) OldIssueCount(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIssueCount is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIssueCount requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIssueCount: %w", err)
+// SnapshotIDs returns the "snapshot" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SnapshotID instead. It exists only for internal usage by the builders.
+func (m *MemberDataGapMutation) SnapshotIDs() (ids []int) {
+	if id := m.snapshot; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.IssueCount, nil
+	return
 }
 
-// AddIssueCount adds i to the "issue_count" field.
-func (m *MemberRepoStatMutation) AddIssueCount(i int) {
-	if m.addissue_count != nil {
-		*m.addissue_count += i
-	} else {
-		m.addissue_count = &i
-	}
+// ResetSnapshot resets all changes to the "snapshot" edge.
+func (m *MemberDataGapMutation) ResetSnapshot() {
+	m.snapshot = nil
+	m.clearedsnapshot = false
 }
 
-// AddedIssueCount returns the value that was added to the "issue_count" field in this mutation.
-func (m *MemberRepoStatMutation) AddedIssueCount() (r int, exists bool) {
-	v := m.addissue_count
-	if v == nil {
-		return
+// Where appends a list predicates to the MemberDataGapMutation builder.
+func (m *MemberDataGapMutation) Where(ps ...predicate.MemberDataGap) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MemberDataGapMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MemberDataGapMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MemberDataGap, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// ResetIssueCount resets all changes to the "issue_count" field.
-func (m *MemberRepoStatMutation) ResetIssueCount() {
-	m.issue_count = nil
-	m.addissue_count = nil
+// Op returns the operation name.
+func (m *MemberDataGapMutation) Op() Op {
+	return m.op
 }
 
-// SetReviewCount sets the "review_count" field.
-func (m *MemberRepoStatMutation) SetReviewCount(i int) {
-	m.review_count = &i
-	m.addreview_count = nil
+// SetOp allows setting the mutation operation.
+func (m *MemberDataGapMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// ReviewCount returns the value of the "review_count" field in the mutation.
-func (m *MemberRepoStatMutation) ReviewCount() (r int, exists bool) {
-	v := m.review_count
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (MemberDataGap).
+func (m *MemberDataGapMutation) Type() string {
+	return m.typ
 }
 
-// OldReviewCount returns the old "review_count" field's value of the MemberRepoStat entity.
-// If the MemberRepoStat object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberRepoStatMutation) OldReviewCount(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReviewCount is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MemberDataGapMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.login != nil {
+		fields = append(fields, memberdatagap.FieldLogin)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReviewCount requires an ID field in the mutation")
+	if m.activity_type != nil {
+		fields = append(fields, memberdatagap.FieldActivityType)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReviewCount: %w", err)
+	if m.name_with_owner != nil {
+		fields = append(fields, memberdatagap.FieldNameWithOwner)
 	}
-	return oldValue.ReviewCount, nil
-}
-
-// AddReviewCount adds i to the "review_count" field.
-func (m *MemberRepoStatMutation) AddReviewCount(i int) {
-	if m.addreview_count != nil {
-		*m.addreview_count += i
-	} else {
-		m.addreview_count = &i
+	if m.reason != nil {
+		fields = append(fields, memberdatagap.FieldReason)
+	}
+	if m.message != nil {
+		fields = append(fields, memberdatagap.FieldMessage)
 	}
+	return fields
 }
 
-// AddedReviewCount returns the value that was added to the "review_count" field in this mutation.
-func (m *MemberRepoStatMutation) AddedReviewCount() (r int, exists bool) {
-	v := m.addreview_count
-	if v == nil {
-		return
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MemberDataGapMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case memberdatagap.FieldLogin:
+		return m.Login()
+	case memberdatagap.FieldActivityType:
+		return m.ActivityType()
+	case memberdatagap.FieldNameWithOwner:
+		return m.NameWithOwner()
+	case memberdatagap.FieldReason:
+		return m.Reason()
+	case memberdatagap.FieldMessage:
+		return m.Message()
 	}
-	return *v, true
+	return nil, false
 }
 
-// ResetReviewCount resets all changes to the "review_count" field.
-func (m *MemberRepoStatMutation) ResetReviewCount() {
-	m.review_count = nil
-	m.addreview_count = nil
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MemberDataGapMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case memberdatagap.FieldLogin:
+		return m.OldLogin(ctx)
+	case memberdatagap.FieldActivityType:
+		return m.OldActivityType(ctx)
+	case memberdatagap.FieldNameWithOwner:
+		return m.OldNameWithOwner(ctx)
+	case memberdatagap.FieldReason:
+		return m.OldReason(ctx)
+	case memberdatagap.FieldMessage:
+		return m.OldMessage(ctx)
+	}
+	return nil, fmt.Errorf("unknown MemberDataGap field %s", name)
 }
 
-// SetAdditions sets the "additions" field.
-func (m *MemberRepoStatMutation) SetAdditions(i int) {
-	m.additions = &i
-	m.addadditions = nil
-}
-
-// Additions returns the value of the "additions" field in the mutation.
-func (m *MemberRepoStatMutation) Additions() (r int, exists bool) {
-	v := m.additions
-	if v == nil {
-		return
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MemberDataGapMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case memberdatagap.FieldLogin:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLogin(v)
+		return nil
+	case memberdatagap.FieldActivityType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActivityType(v)
+		return nil
+	case memberdatagap.FieldNameWithOwner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNameWithOwner(v)
+		return nil
+	case memberdatagap.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case memberdatagap.FieldMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessage(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown MemberDataGap field %s", name)
 }
 
-// OldAdditions returns the old "additions" field's value of the MemberRepoStat entity.
-// If the MemberRepoStat object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberRepoStatMutation) OldAdditions(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAdditions is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAdditions requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAdditions: %w", err)
-	}
-	return oldValue.Additions, nil
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MemberDataGapMutation) AddedFields() []string {
+	return nil
 }
 
-// AddAdditions adds i to the "additions" field.
-func (m *MemberRepoStatMutation) AddAdditions(i int) {
-	if m.addadditions != nil {
-		*m.addadditions += i
-	} else {
-		m.addadditions = &i
-	}
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MemberDataGapMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// AddedAdditions returns the value that was added to the "additions" field in this mutation.
-func (m *MemberRepoStatMutation) AddedAdditions() (r int, exists bool) {
-	v := m.addadditions
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MemberDataGapMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown MemberDataGap numeric field %s", name)
 }
 
-// ResetAdditions resets all changes to the "additions" field.
-func (m *MemberRepoStatMutation) ResetAdditions() {
-	m.additions = nil
-	m.addadditions = nil
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MemberDataGapMutation) ClearedFields() []string {
+	return nil
 }
 
-// SetDeletions sets the "deletions" field.
-func (m *MemberRepoStatMutation) SetDeletions(i int) {
-	m.deletions = &i
-	m.adddeletions = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MemberDataGapMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// Deletions returns the value of the "deletions" field in the mutation.
-func (m *MemberRepoStatMutation) Deletions() (r int, exists bool) {
-	v := m.deletions
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MemberDataGapMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MemberDataGap nullable field %s", name)
 }
 
-// OldDeletions returns the old "deletions" field's value of the MemberRepoStat entity.
-// If the MemberRepoStat object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberRepoStatMutation) OldDeletions(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletions is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletions requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletions: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MemberDataGapMutation) ResetField(name string) error {
+	switch name {
+	case memberdatagap.FieldLogin:
+		m.ResetLogin()
+		return nil
+	case memberdatagap.FieldActivityType:
+		m.ResetActivityType()
+		return nil
+	case memberdatagap.FieldNameWithOwner:
+		m.ResetNameWithOwner()
+		return nil
+	case memberdatagap.FieldReason:
+		m.ResetReason()
+		return nil
+	case memberdatagap.FieldMessage:
+		m.ResetMessage()
+		return nil
 	}
-	return oldValue.Deletions, nil
+	return fmt.Errorf("unknown MemberDataGap field %s", name)
 }
 
-// AddDeletions adds i to the "deletions" field.
-func (m *MemberRepoStatMutation) AddDeletions(i int) {
-	if m.adddeletions != nil {
-		*m.adddeletions += i
-	} else {
-		m.adddeletions = &i
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MemberDataGapMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.snapshot != nil {
+		edges = append(edges, memberdatagap.EdgeSnapshot)
 	}
+	return edges
 }
 
-// AddedDeletions returns the value that was added to the "deletions" field in this mutation.
-func (m *MemberRepoStatMutation) AddedDeletions() (r int, exists bool) {
-	v := m.adddeletions
-	if v == nil {
-		return
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MemberDataGapMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case memberdatagap.EdgeSnapshot:
+		if id := m.snapshot; id != nil {
+			return []ent.Value{*id}
+		}
 	}
-	return *v, true
-}
-
-// ResetDeletions resets all changes to the "deletions" field.
-func (m *MemberRepoStatMutation) ResetDeletions() {
-	m.deletions = nil
-	m.adddeletions = nil
+	return nil
 }
 
-// SetSnapshotID sets the "snapshot" edge to the Snapshot entity by id.
-func (m *MemberRepoStatMutation) SetSnapshotID(id int) {
-	m.snapshot = &id
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MemberDataGapMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
 }
 
-// ClearSnapshot clears the "snapshot" edge to the Snapshot entity.
-func (m *MemberRepoStatMutation) ClearSnapshot() {
-	m.clearedsnapshot = true
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MemberDataGapMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// SnapshotCleared reports if the "snapshot" edge to the Snapshot entity was cleared.
-func (m *MemberRepoStatMutation) SnapshotCleared() bool {
-	return m.clearedsnapshot
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MemberDataGapMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsnapshot {
+		edges = append(edges, memberdatagap.EdgeSnapshot)
+	}
+	return edges
 }
 
-// SnapshotID returns the "snapshot" edge ID in the mutation.
-func (m *MemberRepoStatMutation) SnapshotID() (id int, exists bool) {
-	if m.snapshot != nil {
-		return *m.snapshot, true
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MemberDataGapMutation) EdgeCleared(name string) bool {
+	switch name {
+	case memberdatagap.EdgeSnapshot:
+		return m.clearedsnapshot
 	}
-	return
+	return false
 }
 
-// SnapshotIDs returns the "snapshot" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// SnapshotID instead. It exists only for internal usage by the builders.
-func (m *MemberRepoStatMutation) SnapshotIDs() (ids []int) {
-	if id := m.snapshot; id != nil {
-		ids = append(ids, *id)
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MemberDataGapMutation) ClearEdge(name string) error {
+	switch name {
+	case memberdatagap.EdgeSnapshot:
+		m.ClearSnapshot()
+		return nil
 	}
-	return
+	return fmt.Errorf("unknown MemberDataGap unique edge %s", name)
 }
 
-// ResetSnapshot resets all changes to the "snapshot" edge.
-func (m *MemberRepoStatMutation) ResetSnapshot() {
-	m.snapshot = nil
-	m.clearedsnapshot = false
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MemberDataGapMutation) ResetEdge(name string) error {
+	switch name {
+	case memberdatagap.EdgeSnapshot:
+		m.ResetSnapshot()
+		return nil
+	}
+	return fmt.Errorf("unknown MemberDataGap edge %s", name)
 }
 
-// Where appends a list predicates to the MemberRepoStatMutation builder.
-func (m *MemberRepoStatMutation) Where(ps ...predicate.MemberRepoStat) {
-	m.predicates = append(m.predicates, ps...)
+// MemberDayStatMutation represents an operation that mutates the MemberDayStat nodes in the graph.
+type MemberDayStatMutation struct {
+	config
+	op                       Op
+	typ                      string
+	id                       *int
+	login                    *string
+	day                      *string
+	commit_count             *int
+	addcommit_count          *int
+	pr_created               *int
+	addpr_created            *int
+	pr_merged                *int
+	addpr_merged             *int
+	issue_count              *int
+	addissue_count           *int
+	review_count             *int
+	addreview_count          *int
+	additions                *int
+	addadditions             *int
+	deletions                *int
+	adddeletions             *int
+	excluded_review_count    *int
+	addexcluded_review_count *int
+	issues_closed            *int
+	addissues_closed         *int
+	issue_close_seconds      *int
+	addissue_close_seconds   *int
+	bug_issues_opened        *int
+	addbug_issues_opened     *int
+	feature_issues_opened    *int
+	addfeature_issues_opened *int
+	bug_issues_closed        *int
+	addbug_issues_closed     *int
+	feature_issues_closed    *int
+	addfeature_issues_closed *int
+	feature_prs              *int
+	addfeature_prs           *int
+	bug_prs                  *int
+	addbug_prs               *int
+	chore_prs                *int
+	addchore_prs             *int
+	docs_prs                 *int
+	adddocs_prs              *int
+	test_prs                 *int
+	addtest_prs              *int
+	infra_prs                *int
+	addinfra_prs             *int
+	other_prs                *int
+	addother_prs             *int
+	clearedFields            map[string]struct{}
+	snapshot                 *int
+	clearedsnapshot          bool
+	done                     bool
+	oldValue                 func(context.Context) (*MemberDayStat, error)
+	predicates               []predicate.MemberDayStat
 }
 
-// WhereP appends storage-level predicates to the MemberRepoStatMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MemberRepoStatMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.MemberRepoStat, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
-}
+var _ ent.Mutation = (*MemberDayStatMutation)(nil)
 
-// Op returns the operation name.
-func (m *MemberRepoStatMutation) Op() Op {
-	return m.op
-}
+// memberdaystatOption allows management of the mutation configuration using functional options.
+type memberdaystatOption func(*MemberDayStatMutation)
 
-// SetOp allows setting the mutation operation.
-func (m *MemberRepoStatMutation) SetOp(op Op) {
-	m.op = op
+// newMemberDayStatMutation creates new mutation for the MemberDayStat entity.
+func newMemberDayStatMutation(c config, op Op, opts ...memberdaystatOption) *MemberDayStatMutation {
+	m := &MemberDayStatMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMemberDayStat,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// Type returns the node type of this mutation (MemberRepoStat).
+// withMemberDayStatID sets the ID field of the mutation.
+func withMemberDayStatID(id int) memberdaystatOption {
+	return func(m *MemberDayStatMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MemberDayStat
+		)
+		m.oldValue = func(ctx context.Context) (*MemberDayStat, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MemberDayStat.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMemberDayStat sets the old MemberDayStat of the mutation.
+func withMemberDayStat(node *MemberDayStat) memberdaystatOption {
+	return func(m *MemberDayStatMutation) {
+		m.oldValue = func(context.Context) (*MemberDayStat, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MemberDayStatMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MemberDayStatMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MemberDayStatMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MemberDayStatMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MemberDayStat.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetLogin sets the "login" field.
+func (m *MemberDayStatMutation) SetLogin(s string) {
+	m.login = &s
+}
+
+// Login returns the value of the "login" field in the mutation.
+func (m *MemberDayStatMutation) Login() (r string, exists bool) {
+	v := m.login
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLogin returns the old "login" field's value of the MemberDayStat entity.
+// If the MemberDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberDayStatMutation) OldLogin(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLogin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLogin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLogin: %w", err)
+	}
+	return oldValue.Login, nil
+}
+
+// ResetLogin resets all changes to the "login" field.
+func (m *MemberDayStatMutation) ResetLogin() {
+	m.login = nil
+}
+
+// SetDay sets the "day" field.
+func (m *MemberDayStatMutation) SetDay(s string) {
+	m.day = &s
+}
+
+// Day returns the value of the "day" field in the mutation.
+func (m *MemberDayStatMutation) Day() (r string, exists bool) {
+	v := m.day
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDay returns the old "day" field's value of the MemberDayStat entity.
+// If the MemberDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberDayStatMutation) OldDay(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDay is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDay requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDay: %w", err)
+	}
+	return oldValue.Day, nil
+}
+
+// ResetDay resets all changes to the "day" field.
+func (m *MemberDayStatMutation) ResetDay() {
+	m.day = nil
+}
+
+// SetCommitCount sets the "commit_count" field.
+func (m *MemberDayStatMutation) SetCommitCount(i int) {
+	m.commit_count = &i
+	m.addcommit_count = nil
+}
+
+// CommitCount returns the value of the "commit_count" field in the mutation.
+func (m *MemberDayStatMutation) CommitCount() (r int, exists bool) {
+	v := m.commit_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCommitCount returns the old "commit_count" field's value of the MemberDayStat entity.
+// If the MemberDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberDayStatMutation) OldCommitCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCommitCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCommitCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCommitCount: %w", err)
+	}
+	return oldValue.CommitCount, nil
+}
+
+// AddCommitCount adds i to the "commit_count" field.
+func (m *MemberDayStatMutation) AddCommitCount(i int) {
+	if m.addcommit_count != nil {
+		*m.addcommit_count += i
+	} else {
+		m.addcommit_count = &i
+	}
+}
+
+// AddedCommitCount returns the value that was added to the "commit_count" field in this mutation.
+func (m *MemberDayStatMutation) AddedCommitCount() (r int, exists bool) {
+	v := m.addcommit_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCommitCount resets all changes to the "commit_count" field.
+func (m *MemberDayStatMutation) ResetCommitCount() {
+	m.commit_count = nil
+	m.addcommit_count = nil
+}
+
+// SetPrCreated sets the "pr_created" field.
+func (m *MemberDayStatMutation) SetPrCreated(i int) {
+	m.pr_created = &i
+	m.addpr_created = nil
+}
+
+// PrCreated returns the value of the "pr_created" field in the mutation.
+func (m *MemberDayStatMutation) PrCreated() (r int, exists bool) {
+	v := m.pr_created
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrCreated returns the old "pr_created" field's value of the MemberDayStat entity.
+// If the MemberDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberDayStatMutation) OldPrCreated(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrCreated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrCreated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrCreated: %w", err)
+	}
+	return oldValue.PrCreated, nil
+}
+
+// AddPrCreated adds i to the "pr_created" field.
+func (m *MemberDayStatMutation) AddPrCreated(i int) {
+	if m.addpr_created != nil {
+		*m.addpr_created += i
+	} else {
+		m.addpr_created = &i
+	}
+}
+
+// AddedPrCreated returns the value that was added to the "pr_created" field in this mutation.
+func (m *MemberDayStatMutation) AddedPrCreated() (r int, exists bool) {
+	v := m.addpr_created
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPrCreated resets all changes to the "pr_created" field.
+func (m *MemberDayStatMutation) ResetPrCreated() {
+	m.pr_created = nil
+	m.addpr_created = nil
+}
+
+// SetPrMerged sets the "pr_merged" field.
+func (m *MemberDayStatMutation) SetPrMerged(i int) {
+	m.pr_merged = &i
+	m.addpr_merged = nil
+}
+
+// PrMerged returns the value of the "pr_merged" field in the mutation.
+func (m *MemberDayStatMutation) PrMerged() (r int, exists bool) {
+	v := m.pr_merged
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrMerged returns the old "pr_merged" field's value of the MemberDayStat entity.
+// If the MemberDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberDayStatMutation) OldPrMerged(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrMerged is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrMerged requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrMerged: %w", err)
+	}
+	return oldValue.PrMerged, nil
+}
+
+// AddPrMerged adds i to the "pr_merged" field.
+func (m *MemberDayStatMutation) AddPrMerged(i int) {
+	if m.addpr_merged != nil {
+		*m.addpr_merged += i
+	} else {
+		m.addpr_merged = &i
+	}
+}
+
+// AddedPrMerged returns the value that was added to the "pr_merged" field in this mutation.
+func (m *MemberDayStatMutation) AddedPrMerged() (r int, exists bool) {
+	v := m.addpr_merged
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPrMerged resets all changes to the "pr_merged" field.
+func (m *MemberDayStatMutation) ResetPrMerged() {
+	m.pr_merged = nil
+	m.addpr_merged = nil
+}
+
+// SetIssueCount sets the "issue_count" field.
+func (m *MemberDayStatMutation) SetIssueCount(i int) {
+	m.issue_count = &i
+	m.addissue_count = nil
+}
+
+// IssueCount returns the value of the "issue_count" field in the mutation.
+func (m *MemberDayStatMutation) IssueCount() (r int, exists bool) {
+	v := m.issue_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIssueCount returns the old "issue_count" field's value of the MemberDayStat entity.
+// If the MemberDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberDayStatMutation) OldIssueCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIssueCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIssueCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIssueCount: %w", err)
+	}
+	return oldValue.IssueCount, nil
+}
+
+// AddIssueCount adds i to the "issue_count" field.
+func (m *MemberDayStatMutation) AddIssueCount(i int) {
+	if m.addissue_count != nil {
+		*m.addissue_count += i
+	} else {
+		m.addissue_count = &i
+	}
+}
+
+// AddedIssueCount returns the value that was added to the "issue_count" field in this mutation.
+func (m *MemberDayStatMutation) AddedIssueCount() (r int, exists bool) {
+	v := m.addissue_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetIssueCount resets all changes to the "issue_count" field.
+func (m *MemberDayStatMutation) ResetIssueCount() {
+	m.issue_count = nil
+	m.addissue_count = nil
+}
+
+// SetReviewCount sets the "review_count" field.
+func (m *MemberDayStatMutation) SetReviewCount(i int) {
+	m.review_count = &i
+	m.addreview_count = nil
+}
+
+// ReviewCount returns the value of the "review_count" field in the mutation.
+func (m *MemberDayStatMutation) ReviewCount() (r int, exists bool) {
+	v := m.review_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewCount returns the old "review_count" field's value of the MemberDayStat entity.
+// If the MemberDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberDayStatMutation) OldReviewCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewCount: %w", err)
+	}
+	return oldValue.ReviewCount, nil
+}
+
+// AddReviewCount adds i to the "review_count" field.
+func (m *MemberDayStatMutation) AddReviewCount(i int) {
+	if m.addreview_count != nil {
+		*m.addreview_count += i
+	} else {
+		m.addreview_count = &i
+	}
+}
+
+// AddedReviewCount returns the value that was added to the "review_count" field in this mutation.
+func (m *MemberDayStatMutation) AddedReviewCount() (r int, exists bool) {
+	v := m.addreview_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetReviewCount resets all changes to the "review_count" field.
+func (m *MemberDayStatMutation) ResetReviewCount() {
+	m.review_count = nil
+	m.addreview_count = nil
+}
+
+// SetAdditions sets the "additions" field.
+func (m *MemberDayStatMutation) SetAdditions(i int) {
+	m.additions = &i
+	m.addadditions = nil
+}
+
+// Additions returns the value of the "additions" field in the mutation.
+func (m *MemberDayStatMutation) Additions() (r int, exists bool) {
+	v := m.additions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAdditions returns the old "additions" field's value of the MemberDayStat entity.
+// If the MemberDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberDayStatMutation) OldAdditions(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAdditions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAdditions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAdditions: %w", err)
+	}
+	return oldValue.Additions, nil
+}
+
+// AddAdditions adds i to the "additions" field.
+func (m *MemberDayStatMutation) AddAdditions(i int) {
+	if m.addadditions != nil {
+		*m.addadditions += i
+	} else {
+		m.addadditions = &i
+	}
+}
+
+// AddedAdditions returns the value that was added to the "additions" field in this mutation.
+func (m *MemberDayStatMutation) AddedAdditions() (r int, exists bool) {
+	v := m.addadditions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAdditions resets all changes to the "additions" field.
+func (m *MemberDayStatMutation) ResetAdditions() {
+	m.additions = nil
+	m.addadditions = nil
+}
+
+// SetDeletions sets the "deletions" field.
+func (m *MemberDayStatMutation) SetDeletions(i int) {
+	m.deletions = &i
+	m.adddeletions = nil
+}
+
+// Deletions returns the value of the "deletions" field in the mutation.
+func (m *MemberDayStatMutation) Deletions() (r int, exists bool) {
+	v := m.deletions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletions returns the old "deletions" field's value of the MemberDayStat entity.
+// If the MemberDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberDayStatMutation) OldDeletions(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletions: %w", err)
+	}
+	return oldValue.Deletions, nil
+}
+
+// AddDeletions adds i to the "deletions" field.
+func (m *MemberDayStatMutation) AddDeletions(i int) {
+	if m.adddeletions != nil {
+		*m.adddeletions += i
+	} else {
+		m.adddeletions = &i
+	}
+}
+
+// AddedDeletions returns the value that was added to the "deletions" field in this mutation.
+func (m *MemberDayStatMutation) AddedDeletions() (r int, exists bool) {
+	v := m.adddeletions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDeletions resets all changes to the "deletions" field.
+func (m *MemberDayStatMutation) ResetDeletions() {
+	m.deletions = nil
+	m.adddeletions = nil
+}
+
+// SetExcludedReviewCount sets the "excluded_review_count" field.
+func (m *MemberDayStatMutation) SetExcludedReviewCount(i int) {
+	m.excluded_review_count = &i
+	m.addexcluded_review_count = nil
+}
+
+// ExcludedReviewCount returns the value of the "excluded_review_count" field in the mutation.
+func (m *MemberDayStatMutation) ExcludedReviewCount() (r int, exists bool) {
+	v := m.excluded_review_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExcludedReviewCount returns the old "excluded_review_count" field's value of the MemberDayStat entity.
+// If the MemberDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberDayStatMutation) OldExcludedReviewCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExcludedReviewCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExcludedReviewCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExcludedReviewCount: %w", err)
+	}
+	return oldValue.ExcludedReviewCount, nil
+}
+
+// AddExcludedReviewCount adds i to the "excluded_review_count" field.
+func (m *MemberDayStatMutation) AddExcludedReviewCount(i int) {
+	if m.addexcluded_review_count != nil {
+		*m.addexcluded_review_count += i
+	} else {
+		m.addexcluded_review_count = &i
+	}
+}
+
+// AddedExcludedReviewCount returns the value that was added to the "excluded_review_count" field in this mutation.
+func (m *MemberDayStatMutation) AddedExcludedReviewCount() (r int, exists bool) {
+	v := m.addexcluded_review_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetExcludedReviewCount resets all changes to the "excluded_review_count" field.
+func (m *MemberDayStatMutation) ResetExcludedReviewCount() {
+	m.excluded_review_count = nil
+	m.addexcluded_review_count = nil
+}
+
+// SetIssuesClosed sets the "issues_closed" field.
+func (m *MemberDayStatMutation) SetIssuesClosed(i int) {
+	m.issues_closed = &i
+	m.addissues_closed = nil
+}
+
+// IssuesClosed returns the value of the "issues_closed" field in the mutation.
+func (m *MemberDayStatMutation) IssuesClosed() (r int, exists bool) {
+	v := m.issues_closed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIssuesClosed returns the old "issues_closed" field's value of the MemberDayStat entity.
+// If the MemberDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberDayStatMutation) OldIssuesClosed(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIssuesClosed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIssuesClosed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIssuesClosed: %w", err)
+	}
+	return oldValue.IssuesClosed, nil
+}
+
+// AddIssuesClosed adds i to the "issues_closed" field.
+func (m *MemberDayStatMutation) AddIssuesClosed(i int) {
+	if m.addissues_closed != nil {
+		*m.addissues_closed += i
+	} else {
+		m.addissues_closed = &i
+	}
+}
+
+// AddedIssuesClosed returns the value that was added to the "issues_closed" field in this mutation.
+func (m *MemberDayStatMutation) AddedIssuesClosed() (r int, exists bool) {
+	v := m.addissues_closed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetIssuesClosed resets all changes to the "issues_closed" field.
+func (m *MemberDayStatMutation) ResetIssuesClosed() {
+	m.issues_closed = nil
+	m.addissues_closed = nil
+}
+
+// SetIssueCloseSeconds sets the "issue_close_seconds" field.
+func (m *MemberDayStatMutation) SetIssueCloseSeconds(i int) {
+	m.issue_close_seconds = &i
+	m.addissue_close_seconds = nil
+}
+
+// IssueCloseSeconds returns the value of the "issue_close_seconds" field in the mutation.
+func (m *MemberDayStatMutation) IssueCloseSeconds() (r int, exists bool) {
+	v := m.issue_close_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIssueCloseSeconds returns the old "issue_close_seconds" field's value of the MemberDayStat entity.
+// If the MemberDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberDayStatMutation) OldIssueCloseSeconds(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIssueCloseSeconds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIssueCloseSeconds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIssueCloseSeconds: %w", err)
+	}
+	return oldValue.IssueCloseSeconds, nil
+}
+
+// AddIssueCloseSeconds adds i to the "issue_close_seconds" field.
+func (m *MemberDayStatMutation) AddIssueCloseSeconds(i int) {
+	if m.addissue_close_seconds != nil {
+		*m.addissue_close_seconds += i
+	} else {
+		m.addissue_close_seconds = &i
+	}
+}
+
+// AddedIssueCloseSeconds returns the value that was added to the "issue_close_seconds" field in this mutation.
+func (m *MemberDayStatMutation) AddedIssueCloseSeconds() (r int, exists bool) {
+	v := m.addissue_close_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetIssueCloseSeconds resets all changes to the "issue_close_seconds" field.
+func (m *MemberDayStatMutation) ResetIssueCloseSeconds() {
+	m.issue_close_seconds = nil
+	m.addissue_close_seconds = nil
+}
+
+// SetBugIssuesOpened sets the "bug_issues_opened" field.
+func (m *MemberDayStatMutation) SetBugIssuesOpened(i int) {
+	m.bug_issues_opened = &i
+	m.addbug_issues_opened = nil
+}
+
+// BugIssuesOpened returns the value of the "bug_issues_opened" field in the mutation.
+func (m *MemberDayStatMutation) BugIssuesOpened() (r int, exists bool) {
+	v := m.bug_issues_opened
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBugIssuesOpened returns the old "bug_issues_opened" field's value of the MemberDayStat entity.
+// If the MemberDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberDayStatMutation) OldBugIssuesOpened(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBugIssuesOpened is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBugIssuesOpened requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBugIssuesOpened: %w", err)
+	}
+	return oldValue.BugIssuesOpened, nil
+}
+
+// AddBugIssuesOpened adds i to the "bug_issues_opened" field.
+func (m *MemberDayStatMutation) AddBugIssuesOpened(i int) {
+	if m.addbug_issues_opened != nil {
+		*m.addbug_issues_opened += i
+	} else {
+		m.addbug_issues_opened = &i
+	}
+}
+
+// AddedBugIssuesOpened returns the value that was added to the "bug_issues_opened" field in this mutation.
+func (m *MemberDayStatMutation) AddedBugIssuesOpened() (r int, exists bool) {
+	v := m.addbug_issues_opened
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBugIssuesOpened resets all changes to the "bug_issues_opened" field.
+func (m *MemberDayStatMutation) ResetBugIssuesOpened() {
+	m.bug_issues_opened = nil
+	m.addbug_issues_opened = nil
+}
+
+// SetFeatureIssuesOpened sets the "feature_issues_opened" field.
+func (m *MemberDayStatMutation) SetFeatureIssuesOpened(i int) {
+	m.feature_issues_opened = &i
+	m.addfeature_issues_opened = nil
+}
+
+// FeatureIssuesOpened returns the value of the "feature_issues_opened" field in the mutation.
+func (m *MemberDayStatMutation) FeatureIssuesOpened() (r int, exists bool) {
+	v := m.feature_issues_opened
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFeatureIssuesOpened returns the old "feature_issues_opened" field's value of the MemberDayStat entity.
+// If the MemberDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberDayStatMutation) OldFeatureIssuesOpened(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFeatureIssuesOpened is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFeatureIssuesOpened requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFeatureIssuesOpened: %w", err)
+	}
+	return oldValue.FeatureIssuesOpened, nil
+}
+
+// AddFeatureIssuesOpened adds i to the "feature_issues_opened" field.
+func (m *MemberDayStatMutation) AddFeatureIssuesOpened(i int) {
+	if m.addfeature_issues_opened != nil {
+		*m.addfeature_issues_opened += i
+	} else {
+		m.addfeature_issues_opened = &i
+	}
+}
+
+// AddedFeatureIssuesOpened returns the value that was added to the "feature_issues_opened" field in this mutation.
+func (m *MemberDayStatMutation) AddedFeatureIssuesOpened() (r int, exists bool) {
+	v := m.addfeature_issues_opened
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFeatureIssuesOpened resets all changes to the "feature_issues_opened" field.
+func (m *MemberDayStatMutation) ResetFeatureIssuesOpened() {
+	m.feature_issues_opened = nil
+	m.addfeature_issues_opened = nil
+}
+
+// SetBugIssuesClosed sets the "bug_issues_closed" field.
+func (m *MemberDayStatMutation) SetBugIssuesClosed(i int) {
+	m.bug_issues_closed = &i
+	m.addbug_issues_closed = nil
+}
+
+// BugIssuesClosed returns the value of the "bug_issues_closed" field in the mutation.
+func (m *MemberDayStatMutation) BugIssuesClosed() (r int, exists bool) {
+	v := m.bug_issues_closed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBugIssuesClosed returns the old "bug_issues_closed" field's value of the MemberDayStat entity.
+// If the MemberDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberDayStatMutation) OldBugIssuesClosed(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBugIssuesClosed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBugIssuesClosed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBugIssuesClosed: %w", err)
+	}
+	return oldValue.BugIssuesClosed, nil
+}
+
+// AddBugIssuesClosed adds i to the "bug_issues_closed" field.
+func (m *MemberDayStatMutation) AddBugIssuesClosed(i int) {
+	if m.addbug_issues_closed != nil {
+		*m.addbug_issues_closed += i
+	} else {
+		m.addbug_issues_closed = &i
+	}
+}
+
+// AddedBugIssuesClosed returns the value that was added to the "bug_issues_closed" field in this mutation.
+func (m *MemberDayStatMutation) AddedBugIssuesClosed() (r int, exists bool) {
+	v := m.addbug_issues_closed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBugIssuesClosed resets all changes to the "bug_issues_closed" field.
+func (m *MemberDayStatMutation) ResetBugIssuesClosed() {
+	m.bug_issues_closed = nil
+	m.addbug_issues_closed = nil
+}
+
+// SetFeatureIssuesClosed sets the "feature_issues_closed" field.
+func (m *MemberDayStatMutation) SetFeatureIssuesClosed(i int) {
+	m.feature_issues_closed = &i
+	m.addfeature_issues_closed = nil
+}
+
+// FeatureIssuesClosed returns the value of the "feature_issues_closed" field in the mutation.
+func (m *MemberDayStatMutation) FeatureIssuesClosed() (r int, exists bool) {
+	v := m.feature_issues_closed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFeatureIssuesClosed returns the old "feature_issues_closed" field's value of the MemberDayStat entity.
+// If the MemberDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberDayStatMutation) OldFeatureIssuesClosed(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFeatureIssuesClosed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFeatureIssuesClosed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFeatureIssuesClosed: %w", err)
+	}
+	return oldValue.FeatureIssuesClosed, nil
+}
+
+// AddFeatureIssuesClosed adds i to the "feature_issues_closed" field.
+func (m *MemberDayStatMutation) AddFeatureIssuesClosed(i int) {
+	if m.addfeature_issues_closed != nil {
+		*m.addfeature_issues_closed += i
+	} else {
+		m.addfeature_issues_closed = &i
+	}
+}
+
+// AddedFeatureIssuesClosed returns the value that was added to the "feature_issues_closed" field in this mutation.
+func (m *MemberDayStatMutation) AddedFeatureIssuesClosed() (r int, exists bool) {
+	v := m.addfeature_issues_closed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFeatureIssuesClosed resets all changes to the "feature_issues_closed" field.
+func (m *MemberDayStatMutation) ResetFeatureIssuesClosed() {
+	m.feature_issues_closed = nil
+	m.addfeature_issues_closed = nil
+}
+
+// SetFeaturePrs sets the "feature_prs" field.
+func (m *MemberDayStatMutation) SetFeaturePrs(i int) {
+	m.feature_prs = &i
+	m.addfeature_prs = nil
+}
+
+// FeaturePrs returns the value of the "feature_prs" field in the mutation.
+func (m *MemberDayStatMutation) FeaturePrs() (r int, exists bool) {
+	v := m.feature_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFeaturePrs returns the old "feature_prs" field's value of the MemberDayStat entity.
+// If the MemberDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberDayStatMutation) OldFeaturePrs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFeaturePrs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFeaturePrs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFeaturePrs: %w", err)
+	}
+	return oldValue.FeaturePrs, nil
+}
+
+// AddFeaturePrs adds i to the "feature_prs" field.
+func (m *MemberDayStatMutation) AddFeaturePrs(i int) {
+	if m.addfeature_prs != nil {
+		*m.addfeature_prs += i
+	} else {
+		m.addfeature_prs = &i
+	}
+}
+
+// AddedFeaturePrs returns the value that was added to the "feature_prs" field in this mutation.
+func (m *MemberDayStatMutation) AddedFeaturePrs() (r int, exists bool) {
+	v := m.addfeature_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFeaturePrs resets all changes to the "feature_prs" field.
+func (m *MemberDayStatMutation) ResetFeaturePrs() {
+	m.feature_prs = nil
+	m.addfeature_prs = nil
+}
+
+// SetBugPrs sets the "bug_prs" field.
+func (m *MemberDayStatMutation) SetBugPrs(i int) {
+	m.bug_prs = &i
+	m.addbug_prs = nil
+}
+
+// BugPrs returns the value of the "bug_prs" field in the mutation.
+func (m *MemberDayStatMutation) BugPrs() (r int, exists bool) {
+	v := m.bug_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBugPrs returns the old "bug_prs" field's value of the MemberDayStat entity.
+// If the MemberDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberDayStatMutation) OldBugPrs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBugPrs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBugPrs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBugPrs: %w", err)
+	}
+	return oldValue.BugPrs, nil
+}
+
+// AddBugPrs adds i to the "bug_prs" field.
+func (m *MemberDayStatMutation) AddBugPrs(i int) {
+	if m.addbug_prs != nil {
+		*m.addbug_prs += i
+	} else {
+		m.addbug_prs = &i
+	}
+}
+
+// AddedBugPrs returns the value that was added to the "bug_prs" field in this mutation.
+func (m *MemberDayStatMutation) AddedBugPrs() (r int, exists bool) {
+	v := m.addbug_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBugPrs resets all changes to the "bug_prs" field.
+func (m *MemberDayStatMutation) ResetBugPrs() {
+	m.bug_prs = nil
+	m.addbug_prs = nil
+}
+
+// SetChorePrs sets the "chore_prs" field.
+func (m *MemberDayStatMutation) SetChorePrs(i int) {
+	m.chore_prs = &i
+	m.addchore_prs = nil
+}
+
+// ChorePrs returns the value of the "chore_prs" field in the mutation.
+func (m *MemberDayStatMutation) ChorePrs() (r int, exists bool) {
+	v := m.chore_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChorePrs returns the old "chore_prs" field's value of the MemberDayStat entity.
+// If the MemberDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberDayStatMutation) OldChorePrs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChorePrs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChorePrs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChorePrs: %w", err)
+	}
+	return oldValue.ChorePrs, nil
+}
+
+// AddChorePrs adds i to the "chore_prs" field.
+func (m *MemberDayStatMutation) AddChorePrs(i int) {
+	if m.addchore_prs != nil {
+		*m.addchore_prs += i
+	} else {
+		m.addchore_prs = &i
+	}
+}
+
+// AddedChorePrs returns the value that was added to the "chore_prs" field in this mutation.
+func (m *MemberDayStatMutation) AddedChorePrs() (r int, exists bool) {
+	v := m.addchore_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetChorePrs resets all changes to the "chore_prs" field.
+func (m *MemberDayStatMutation) ResetChorePrs() {
+	m.chore_prs = nil
+	m.addchore_prs = nil
+}
+
+// SetDocsPrs sets the "docs_prs" field.
+func (m *MemberDayStatMutation) SetDocsPrs(i int) {
+	m.docs_prs = &i
+	m.adddocs_prs = nil
+}
+
+// DocsPrs returns the value of the "docs_prs" field in the mutation.
+func (m *MemberDayStatMutation) DocsPrs() (r int, exists bool) {
+	v := m.docs_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDocsPrs returns the old "docs_prs" field's value of the MemberDayStat entity.
+// If the MemberDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberDayStatMutation) OldDocsPrs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDocsPrs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDocsPrs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDocsPrs: %w", err)
+	}
+	return oldValue.DocsPrs, nil
+}
+
+// AddDocsPrs adds i to the "docs_prs" field.
+func (m *MemberDayStatMutation) AddDocsPrs(i int) {
+	if m.adddocs_prs != nil {
+		*m.adddocs_prs += i
+	} else {
+		m.adddocs_prs = &i
+	}
+}
+
+// AddedDocsPrs returns the value that was added to the "docs_prs" field in this mutation.
+func (m *MemberDayStatMutation) AddedDocsPrs() (r int, exists bool) {
+	v := m.adddocs_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDocsPrs resets all changes to the "docs_prs" field.
+func (m *MemberDayStatMutation) ResetDocsPrs() {
+	m.docs_prs = nil
+	m.adddocs_prs = nil
+}
+
+// SetTestPrs sets the "test_prs" field.
+func (m *MemberDayStatMutation) SetTestPrs(i int) {
+	m.test_prs = &i
+	m.addtest_prs = nil
+}
+
+// TestPrs returns the value of the "test_prs" field in the mutation.
+func (m *MemberDayStatMutation) TestPrs() (r int, exists bool) {
+	v := m.test_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTestPrs returns the old "test_prs" field's value of the MemberDayStat entity.
+// If the MemberDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberDayStatMutation) OldTestPrs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTestPrs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTestPrs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTestPrs: %w", err)
+	}
+	return oldValue.TestPrs, nil
+}
+
+// AddTestPrs adds i to the "test_prs" field.
+func (m *MemberDayStatMutation) AddTestPrs(i int) {
+	if m.addtest_prs != nil {
+		*m.addtest_prs += i
+	} else {
+		m.addtest_prs = &i
+	}
+}
+
+// AddedTestPrs returns the value that was added to the "test_prs" field in this mutation.
+func (m *MemberDayStatMutation) AddedTestPrs() (r int, exists bool) {
+	v := m.addtest_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTestPrs resets all changes to the "test_prs" field.
+func (m *MemberDayStatMutation) ResetTestPrs() {
+	m.test_prs = nil
+	m.addtest_prs = nil
+}
+
+// SetInfraPrs sets the "infra_prs" field.
+func (m *MemberDayStatMutation) SetInfraPrs(i int) {
+	m.infra_prs = &i
+	m.addinfra_prs = nil
+}
+
+// InfraPrs returns the value of the "infra_prs" field in the mutation.
+func (m *MemberDayStatMutation) InfraPrs() (r int, exists bool) {
+	v := m.infra_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInfraPrs returns the old "infra_prs" field's value of the MemberDayStat entity.
+// If the MemberDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberDayStatMutation) OldInfraPrs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInfraPrs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInfraPrs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInfraPrs: %w", err)
+	}
+	return oldValue.InfraPrs, nil
+}
+
+// AddInfraPrs adds i to the "infra_prs" field.
+func (m *MemberDayStatMutation) AddInfraPrs(i int) {
+	if m.addinfra_prs != nil {
+		*m.addinfra_prs += i
+	} else {
+		m.addinfra_prs = &i
+	}
+}
+
+// AddedInfraPrs returns the value that was added to the "infra_prs" field in this mutation.
+func (m *MemberDayStatMutation) AddedInfraPrs() (r int, exists bool) {
+	v := m.addinfra_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetInfraPrs resets all changes to the "infra_prs" field.
+func (m *MemberDayStatMutation) ResetInfraPrs() {
+	m.infra_prs = nil
+	m.addinfra_prs = nil
+}
+
+// SetOtherPrs sets the "other_prs" field.
+func (m *MemberDayStatMutation) SetOtherPrs(i int) {
+	m.other_prs = &i
+	m.addother_prs = nil
+}
+
+// OtherPrs returns the value of the "other_prs" field in the mutation.
+func (m *MemberDayStatMutation) OtherPrs() (r int, exists bool) {
+	v := m.other_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOtherPrs returns the old "other_prs" field's value of the MemberDayStat entity.
+// If the MemberDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberDayStatMutation) OldOtherPrs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOtherPrs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOtherPrs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOtherPrs: %w", err)
+	}
+	return oldValue.OtherPrs, nil
+}
+
+// AddOtherPrs adds i to the "other_prs" field.
+func (m *MemberDayStatMutation) AddOtherPrs(i int) {
+	if m.addother_prs != nil {
+		*m.addother_prs += i
+	} else {
+		m.addother_prs = &i
+	}
+}
+
+// AddedOtherPrs returns the value that was added to the "other_prs" field in this mutation.
+func (m *MemberDayStatMutation) AddedOtherPrs() (r int, exists bool) {
+	v := m.addother_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetOtherPrs resets all changes to the "other_prs" field.
+func (m *MemberDayStatMutation) ResetOtherPrs() {
+	m.other_prs = nil
+	m.addother_prs = nil
+}
+
+// SetSnapshotID sets the "snapshot" edge to the Snapshot entity by id.
+func (m *MemberDayStatMutation) SetSnapshotID(id int) {
+	m.snapshot = &id
+}
+
+// ClearSnapshot clears the "snapshot" edge to the Snapshot entity.
+func (m *MemberDayStatMutation) ClearSnapshot() {
+	m.clearedsnapshot = true
+}
+
+// SnapshotCleared reports if the "snapshot" edge to the Snapshot entity was cleared.
+func (m *MemberDayStatMutation) SnapshotCleared() bool {
+	return m.clearedsnapshot
+}
+
+// SnapshotID returns the "snapshot" edge ID in the mutation.
+func (m *MemberDayStatMutation) SnapshotID() (id int, exists bool) {
+	if m.snapshot != nil {
+		return *m.snapshot, true
+	}
+	return
+}
+
+// SnapshotIDs returns the "snapshot" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SnapshotID instead. It exists only for internal usage by the builders.
+func (m *MemberDayStatMutation) SnapshotIDs() (ids []int) {
+	if id := m.snapshot; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSnapshot resets all changes to the "snapshot" edge.
+func (m *MemberDayStatMutation) ResetSnapshot() {
+	m.snapshot = nil
+	m.clearedsnapshot = false
+}
+
+// Where appends a list predicates to the MemberDayStatMutation builder.
+func (m *MemberDayStatMutation) Where(ps ...predicate.MemberDayStat) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MemberDayStatMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MemberDayStatMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MemberDayStat, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MemberDayStatMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MemberDayStatMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MemberDayStat).
+func (m *MemberDayStatMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MemberDayStatMutation) Fields() []string {
+	fields := make([]string, 0, 23)
+	if m.login != nil {
+		fields = append(fields, memberdaystat.FieldLogin)
+	}
+	if m.day != nil {
+		fields = append(fields, memberdaystat.FieldDay)
+	}
+	if m.commit_count != nil {
+		fields = append(fields, memberdaystat.FieldCommitCount)
+	}
+	if m.pr_created != nil {
+		fields = append(fields, memberdaystat.FieldPrCreated)
+	}
+	if m.pr_merged != nil {
+		fields = append(fields, memberdaystat.FieldPrMerged)
+	}
+	if m.issue_count != nil {
+		fields = append(fields, memberdaystat.FieldIssueCount)
+	}
+	if m.review_count != nil {
+		fields = append(fields, memberdaystat.FieldReviewCount)
+	}
+	if m.additions != nil {
+		fields = append(fields, memberdaystat.FieldAdditions)
+	}
+	if m.deletions != nil {
+		fields = append(fields, memberdaystat.FieldDeletions)
+	}
+	if m.excluded_review_count != nil {
+		fields = append(fields, memberdaystat.FieldExcludedReviewCount)
+	}
+	if m.issues_closed != nil {
+		fields = append(fields, memberdaystat.FieldIssuesClosed)
+	}
+	if m.issue_close_seconds != nil {
+		fields = append(fields, memberdaystat.FieldIssueCloseSeconds)
+	}
+	if m.bug_issues_opened != nil {
+		fields = append(fields, memberdaystat.FieldBugIssuesOpened)
+	}
+	if m.feature_issues_opened != nil {
+		fields = append(fields, memberdaystat.FieldFeatureIssuesOpened)
+	}
+	if m.bug_issues_closed != nil {
+		fields = append(fields, memberdaystat.FieldBugIssuesClosed)
+	}
+	if m.feature_issues_closed != nil {
+		fields = append(fields, memberdaystat.FieldFeatureIssuesClosed)
+	}
+	if m.feature_prs != nil {
+		fields = append(fields, memberdaystat.FieldFeaturePrs)
+	}
+	if m.bug_prs != nil {
+		fields = append(fields, memberdaystat.FieldBugPrs)
+	}
+	if m.chore_prs != nil {
+		fields = append(fields, memberdaystat.FieldChorePrs)
+	}
+	if m.docs_prs != nil {
+		fields = append(fields, memberdaystat.FieldDocsPrs)
+	}
+	if m.test_prs != nil {
+		fields = append(fields, memberdaystat.FieldTestPrs)
+	}
+	if m.infra_prs != nil {
+		fields = append(fields, memberdaystat.FieldInfraPrs)
+	}
+	if m.other_prs != nil {
+		fields = append(fields, memberdaystat.FieldOtherPrs)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MemberDayStatMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case memberdaystat.FieldLogin:
+		return m.Login()
+	case memberdaystat.FieldDay:
+		return m.Day()
+	case memberdaystat.FieldCommitCount:
+		return m.CommitCount()
+	case memberdaystat.FieldPrCreated:
+		return m.PrCreated()
+	case memberdaystat.FieldPrMerged:
+		return m.PrMerged()
+	case memberdaystat.FieldIssueCount:
+		return m.IssueCount()
+	case memberdaystat.FieldReviewCount:
+		return m.ReviewCount()
+	case memberdaystat.FieldAdditions:
+		return m.Additions()
+	case memberdaystat.FieldDeletions:
+		return m.Deletions()
+	case memberdaystat.FieldExcludedReviewCount:
+		return m.ExcludedReviewCount()
+	case memberdaystat.FieldIssuesClosed:
+		return m.IssuesClosed()
+	case memberdaystat.FieldIssueCloseSeconds:
+		return m.IssueCloseSeconds()
+	case memberdaystat.FieldBugIssuesOpened:
+		return m.BugIssuesOpened()
+	case memberdaystat.FieldFeatureIssuesOpened:
+		return m.FeatureIssuesOpened()
+	case memberdaystat.FieldBugIssuesClosed:
+		return m.BugIssuesClosed()
+	case memberdaystat.FieldFeatureIssuesClosed:
+		return m.FeatureIssuesClosed()
+	case memberdaystat.FieldFeaturePrs:
+		return m.FeaturePrs()
+	case memberdaystat.FieldBugPrs:
+		return m.BugPrs()
+	case memberdaystat.FieldChorePrs:
+		return m.ChorePrs()
+	case memberdaystat.FieldDocsPrs:
+		return m.DocsPrs()
+	case memberdaystat.FieldTestPrs:
+		return m.TestPrs()
+	case memberdaystat.FieldInfraPrs:
+		return m.InfraPrs()
+	case memberdaystat.FieldOtherPrs:
+		return m.OtherPrs()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MemberDayStatMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case memberdaystat.FieldLogin:
+		return m.OldLogin(ctx)
+	case memberdaystat.FieldDay:
+		return m.OldDay(ctx)
+	case memberdaystat.FieldCommitCount:
+		return m.OldCommitCount(ctx)
+	case memberdaystat.FieldPrCreated:
+		return m.OldPrCreated(ctx)
+	case memberdaystat.FieldPrMerged:
+		return m.OldPrMerged(ctx)
+	case memberdaystat.FieldIssueCount:
+		return m.OldIssueCount(ctx)
+	case memberdaystat.FieldReviewCount:
+		return m.OldReviewCount(ctx)
+	case memberdaystat.FieldAdditions:
+		return m.OldAdditions(ctx)
+	case memberdaystat.FieldDeletions:
+		return m.OldDeletions(ctx)
+	case memberdaystat.FieldExcludedReviewCount:
+		return m.OldExcludedReviewCount(ctx)
+	case memberdaystat.FieldIssuesClosed:
+		return m.OldIssuesClosed(ctx)
+	case memberdaystat.FieldIssueCloseSeconds:
+		return m.OldIssueCloseSeconds(ctx)
+	case memberdaystat.FieldBugIssuesOpened:
+		return m.OldBugIssuesOpened(ctx)
+	case memberdaystat.FieldFeatureIssuesOpened:
+		return m.OldFeatureIssuesOpened(ctx)
+	case memberdaystat.FieldBugIssuesClosed:
+		return m.OldBugIssuesClosed(ctx)
+	case memberdaystat.FieldFeatureIssuesClosed:
+		return m.OldFeatureIssuesClosed(ctx)
+	case memberdaystat.FieldFeaturePrs:
+		return m.OldFeaturePrs(ctx)
+	case memberdaystat.FieldBugPrs:
+		return m.OldBugPrs(ctx)
+	case memberdaystat.FieldChorePrs:
+		return m.OldChorePrs(ctx)
+	case memberdaystat.FieldDocsPrs:
+		return m.OldDocsPrs(ctx)
+	case memberdaystat.FieldTestPrs:
+		return m.OldTestPrs(ctx)
+	case memberdaystat.FieldInfraPrs:
+		return m.OldInfraPrs(ctx)
+	case memberdaystat.FieldOtherPrs:
+		return m.OldOtherPrs(ctx)
+	}
+	return nil, fmt.Errorf("unknown MemberDayStat field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MemberDayStatMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case memberdaystat.FieldLogin:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLogin(v)
+		return nil
+	case memberdaystat.FieldDay:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDay(v)
+		return nil
+	case memberdaystat.FieldCommitCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCommitCount(v)
+		return nil
+	case memberdaystat.FieldPrCreated:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrCreated(v)
+		return nil
+	case memberdaystat.FieldPrMerged:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrMerged(v)
+		return nil
+	case memberdaystat.FieldIssueCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIssueCount(v)
+		return nil
+	case memberdaystat.FieldReviewCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewCount(v)
+		return nil
+	case memberdaystat.FieldAdditions:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAdditions(v)
+		return nil
+	case memberdaystat.FieldDeletions:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletions(v)
+		return nil
+	case memberdaystat.FieldExcludedReviewCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExcludedReviewCount(v)
+		return nil
+	case memberdaystat.FieldIssuesClosed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIssuesClosed(v)
+		return nil
+	case memberdaystat.FieldIssueCloseSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIssueCloseSeconds(v)
+		return nil
+	case memberdaystat.FieldBugIssuesOpened:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBugIssuesOpened(v)
+		return nil
+	case memberdaystat.FieldFeatureIssuesOpened:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFeatureIssuesOpened(v)
+		return nil
+	case memberdaystat.FieldBugIssuesClosed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBugIssuesClosed(v)
+		return nil
+	case memberdaystat.FieldFeatureIssuesClosed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFeatureIssuesClosed(v)
+		return nil
+	case memberdaystat.FieldFeaturePrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFeaturePrs(v)
+		return nil
+	case memberdaystat.FieldBugPrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBugPrs(v)
+		return nil
+	case memberdaystat.FieldChorePrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChorePrs(v)
+		return nil
+	case memberdaystat.FieldDocsPrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDocsPrs(v)
+		return nil
+	case memberdaystat.FieldTestPrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTestPrs(v)
+		return nil
+	case memberdaystat.FieldInfraPrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInfraPrs(v)
+		return nil
+	case memberdaystat.FieldOtherPrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOtherPrs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MemberDayStat field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MemberDayStatMutation) AddedFields() []string {
+	var fields []string
+	if m.addcommit_count != nil {
+		fields = append(fields, memberdaystat.FieldCommitCount)
+	}
+	if m.addpr_created != nil {
+		fields = append(fields, memberdaystat.FieldPrCreated)
+	}
+	if m.addpr_merged != nil {
+		fields = append(fields, memberdaystat.FieldPrMerged)
+	}
+	if m.addissue_count != nil {
+		fields = append(fields, memberdaystat.FieldIssueCount)
+	}
+	if m.addreview_count != nil {
+		fields = append(fields, memberdaystat.FieldReviewCount)
+	}
+	if m.addadditions != nil {
+		fields = append(fields, memberdaystat.FieldAdditions)
+	}
+	if m.adddeletions != nil {
+		fields = append(fields, memberdaystat.FieldDeletions)
+	}
+	if m.addexcluded_review_count != nil {
+		fields = append(fields, memberdaystat.FieldExcludedReviewCount)
+	}
+	if m.addissues_closed != nil {
+		fields = append(fields, memberdaystat.FieldIssuesClosed)
+	}
+	if m.addissue_close_seconds != nil {
+		fields = append(fields, memberdaystat.FieldIssueCloseSeconds)
+	}
+	if m.addbug_issues_opened != nil {
+		fields = append(fields, memberdaystat.FieldBugIssuesOpened)
+	}
+	if m.addfeature_issues_opened != nil {
+		fields = append(fields, memberdaystat.FieldFeatureIssuesOpened)
+	}
+	if m.addbug_issues_closed != nil {
+		fields = append(fields, memberdaystat.FieldBugIssuesClosed)
+	}
+	if m.addfeature_issues_closed != nil {
+		fields = append(fields, memberdaystat.FieldFeatureIssuesClosed)
+	}
+	if m.addfeature_prs != nil {
+		fields = append(fields, memberdaystat.FieldFeaturePrs)
+	}
+	if m.addbug_prs != nil {
+		fields = append(fields, memberdaystat.FieldBugPrs)
+	}
+	if m.addchore_prs != nil {
+		fields = append(fields, memberdaystat.FieldChorePrs)
+	}
+	if m.adddocs_prs != nil {
+		fields = append(fields, memberdaystat.FieldDocsPrs)
+	}
+	if m.addtest_prs != nil {
+		fields = append(fields, memberdaystat.FieldTestPrs)
+	}
+	if m.addinfra_prs != nil {
+		fields = append(fields, memberdaystat.FieldInfraPrs)
+	}
+	if m.addother_prs != nil {
+		fields = append(fields, memberdaystat.FieldOtherPrs)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MemberDayStatMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case memberdaystat.FieldCommitCount:
+		return m.AddedCommitCount()
+	case memberdaystat.FieldPrCreated:
+		return m.AddedPrCreated()
+	case memberdaystat.FieldPrMerged:
+		return m.AddedPrMerged()
+	case memberdaystat.FieldIssueCount:
+		return m.AddedIssueCount()
+	case memberdaystat.FieldReviewCount:
+		return m.AddedReviewCount()
+	case memberdaystat.FieldAdditions:
+		return m.AddedAdditions()
+	case memberdaystat.FieldDeletions:
+		return m.AddedDeletions()
+	case memberdaystat.FieldExcludedReviewCount:
+		return m.AddedExcludedReviewCount()
+	case memberdaystat.FieldIssuesClosed:
+		return m.AddedIssuesClosed()
+	case memberdaystat.FieldIssueCloseSeconds:
+		return m.AddedIssueCloseSeconds()
+	case memberdaystat.FieldBugIssuesOpened:
+		return m.AddedBugIssuesOpened()
+	case memberdaystat.FieldFeatureIssuesOpened:
+		return m.AddedFeatureIssuesOpened()
+	case memberdaystat.FieldBugIssuesClosed:
+		return m.AddedBugIssuesClosed()
+	case memberdaystat.FieldFeatureIssuesClosed:
+		return m.AddedFeatureIssuesClosed()
+	case memberdaystat.FieldFeaturePrs:
+		return m.AddedFeaturePrs()
+	case memberdaystat.FieldBugPrs:
+		return m.AddedBugPrs()
+	case memberdaystat.FieldChorePrs:
+		return m.AddedChorePrs()
+	case memberdaystat.FieldDocsPrs:
+		return m.AddedDocsPrs()
+	case memberdaystat.FieldTestPrs:
+		return m.AddedTestPrs()
+	case memberdaystat.FieldInfraPrs:
+		return m.AddedInfraPrs()
+	case memberdaystat.FieldOtherPrs:
+		return m.AddedOtherPrs()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MemberDayStatMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case memberdaystat.FieldCommitCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCommitCount(v)
+		return nil
+	case memberdaystat.FieldPrCreated:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPrCreated(v)
+		return nil
+	case memberdaystat.FieldPrMerged:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPrMerged(v)
+		return nil
+	case memberdaystat.FieldIssueCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIssueCount(v)
+		return nil
+	case memberdaystat.FieldReviewCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReviewCount(v)
+		return nil
+	case memberdaystat.FieldAdditions:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAdditions(v)
+		return nil
+	case memberdaystat.FieldDeletions:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDeletions(v)
+		return nil
+	case memberdaystat.FieldExcludedReviewCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExcludedReviewCount(v)
+		return nil
+	case memberdaystat.FieldIssuesClosed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIssuesClosed(v)
+		return nil
+	case memberdaystat.FieldIssueCloseSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIssueCloseSeconds(v)
+		return nil
+	case memberdaystat.FieldBugIssuesOpened:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBugIssuesOpened(v)
+		return nil
+	case memberdaystat.FieldFeatureIssuesOpened:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFeatureIssuesOpened(v)
+		return nil
+	case memberdaystat.FieldBugIssuesClosed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBugIssuesClosed(v)
+		return nil
+	case memberdaystat.FieldFeatureIssuesClosed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFeatureIssuesClosed(v)
+		return nil
+	case memberdaystat.FieldFeaturePrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFeaturePrs(v)
+		return nil
+	case memberdaystat.FieldBugPrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBugPrs(v)
+		return nil
+	case memberdaystat.FieldChorePrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChorePrs(v)
+		return nil
+	case memberdaystat.FieldDocsPrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDocsPrs(v)
+		return nil
+	case memberdaystat.FieldTestPrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTestPrs(v)
+		return nil
+	case memberdaystat.FieldInfraPrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddInfraPrs(v)
+		return nil
+	case memberdaystat.FieldOtherPrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOtherPrs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MemberDayStat numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MemberDayStatMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MemberDayStatMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MemberDayStatMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MemberDayStat nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MemberDayStatMutation) ResetField(name string) error {
+	switch name {
+	case memberdaystat.FieldLogin:
+		m.ResetLogin()
+		return nil
+	case memberdaystat.FieldDay:
+		m.ResetDay()
+		return nil
+	case memberdaystat.FieldCommitCount:
+		m.ResetCommitCount()
+		return nil
+	case memberdaystat.FieldPrCreated:
+		m.ResetPrCreated()
+		return nil
+	case memberdaystat.FieldPrMerged:
+		m.ResetPrMerged()
+		return nil
+	case memberdaystat.FieldIssueCount:
+		m.ResetIssueCount()
+		return nil
+	case memberdaystat.FieldReviewCount:
+		m.ResetReviewCount()
+		return nil
+	case memberdaystat.FieldAdditions:
+		m.ResetAdditions()
+		return nil
+	case memberdaystat.FieldDeletions:
+		m.ResetDeletions()
+		return nil
+	case memberdaystat.FieldExcludedReviewCount:
+		m.ResetExcludedReviewCount()
+		return nil
+	case memberdaystat.FieldIssuesClosed:
+		m.ResetIssuesClosed()
+		return nil
+	case memberdaystat.FieldIssueCloseSeconds:
+		m.ResetIssueCloseSeconds()
+		return nil
+	case memberdaystat.FieldBugIssuesOpened:
+		m.ResetBugIssuesOpened()
+		return nil
+	case memberdaystat.FieldFeatureIssuesOpened:
+		m.ResetFeatureIssuesOpened()
+		return nil
+	case memberdaystat.FieldBugIssuesClosed:
+		m.ResetBugIssuesClosed()
+		return nil
+	case memberdaystat.FieldFeatureIssuesClosed:
+		m.ResetFeatureIssuesClosed()
+		return nil
+	case memberdaystat.FieldFeaturePrs:
+		m.ResetFeaturePrs()
+		return nil
+	case memberdaystat.FieldBugPrs:
+		m.ResetBugPrs()
+		return nil
+	case memberdaystat.FieldChorePrs:
+		m.ResetChorePrs()
+		return nil
+	case memberdaystat.FieldDocsPrs:
+		m.ResetDocsPrs()
+		return nil
+	case memberdaystat.FieldTestPrs:
+		m.ResetTestPrs()
+		return nil
+	case memberdaystat.FieldInfraPrs:
+		m.ResetInfraPrs()
+		return nil
+	case memberdaystat.FieldOtherPrs:
+		m.ResetOtherPrs()
+		return nil
+	}
+	return fmt.Errorf("unknown MemberDayStat field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MemberDayStatMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.snapshot != nil {
+		edges = append(edges, memberdaystat.EdgeSnapshot)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MemberDayStatMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case memberdaystat.EdgeSnapshot:
+		if id := m.snapshot; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MemberDayStatMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MemberDayStatMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MemberDayStatMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsnapshot {
+		edges = append(edges, memberdaystat.EdgeSnapshot)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MemberDayStatMutation) EdgeCleared(name string) bool {
+	switch name {
+	case memberdaystat.EdgeSnapshot:
+		return m.clearedsnapshot
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MemberDayStatMutation) ClearEdge(name string) error {
+	switch name {
+	case memberdaystat.EdgeSnapshot:
+		m.ClearSnapshot()
+		return nil
+	}
+	return fmt.Errorf("unknown MemberDayStat unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MemberDayStatMutation) ResetEdge(name string) error {
+	switch name {
+	case memberdaystat.EdgeSnapshot:
+		m.ResetSnapshot()
+		return nil
+	}
+	return fmt.Errorf("unknown MemberDayStat edge %s", name)
+}
+
+// MemberHourStatMutation represents an operation that mutates the MemberHourStat nodes in the graph.
+type MemberHourStatMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	login             *string
+	hour_start        *time.Time
+	day               *string
+	weekday           *int
+	addweekday        *int
+	hour              *int
+	addhour           *int
+	activity_count    *int
+	addactivity_count *int
+	clearedFields     map[string]struct{}
+	snapshot          *int
+	clearedsnapshot   bool
+	done              bool
+	oldValue          func(context.Context) (*MemberHourStat, error)
+	predicates        []predicate.MemberHourStat
+}
+
+var _ ent.Mutation = (*MemberHourStatMutation)(nil)
+
+// memberhourstatOption allows management of the mutation configuration using functional options.
+type memberhourstatOption func(*MemberHourStatMutation)
+
+// newMemberHourStatMutation creates new mutation for the MemberHourStat entity.
+func newMemberHourStatMutation(c config, op Op, opts ...memberhourstatOption) *MemberHourStatMutation {
+	m := &MemberHourStatMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMemberHourStat,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMemberHourStatID sets the ID field of the mutation.
+func withMemberHourStatID(id int) memberhourstatOption {
+	return func(m *MemberHourStatMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MemberHourStat
+		)
+		m.oldValue = func(ctx context.Context) (*MemberHourStat, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MemberHourStat.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMemberHourStat sets the old MemberHourStat of the mutation.
+func withMemberHourStat(node *MemberHourStat) memberhourstatOption {
+	return func(m *MemberHourStatMutation) {
+		m.oldValue = func(context.Context) (*MemberHourStat, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MemberHourStatMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MemberHourStatMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MemberHourStatMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MemberHourStatMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MemberHourStat.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetLogin sets the "login" field.
+func (m *MemberHourStatMutation) SetLogin(s string) {
+	m.login = &s
+}
+
+// Login returns the value of the "login" field in the mutation.
+func (m *MemberHourStatMutation) Login() (r string, exists bool) {
+	v := m.login
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLogin returns the old "login" field's value of the MemberHourStat entity.
+// If the MemberHourStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberHourStatMutation) OldLogin(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLogin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLogin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLogin: %w", err)
+	}
+	return oldValue.Login, nil
+}
+
+// ResetLogin resets all changes to the "login" field.
+func (m *MemberHourStatMutation) ResetLogin() {
+	m.login = nil
+}
+
+// SetHourStart sets the "hour_start" field.
+func (m *MemberHourStatMutation) SetHourStart(t time.Time) {
+	m.hour_start = &t
+}
+
+// HourStart returns the value of the "hour_start" field in the mutation.
+func (m *MemberHourStatMutation) HourStart() (r time.Time, exists bool) {
+	v := m.hour_start
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHourStart returns the old "hour_start" field's value of the MemberHourStat entity.
+// If the MemberHourStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberHourStatMutation) OldHourStart(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHourStart is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHourStart requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHourStart: %w", err)
+	}
+	return oldValue.HourStart, nil
+}
+
+// ResetHourStart resets all changes to the "hour_start" field.
+func (m *MemberHourStatMutation) ResetHourStart() {
+	m.hour_start = nil
+}
+
+// SetDay sets the "day" field.
+func (m *MemberHourStatMutation) SetDay(s string) {
+	m.day = &s
+}
+
+// Day returns the value of the "day" field in the mutation.
+func (m *MemberHourStatMutation) Day() (r string, exists bool) {
+	v := m.day
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDay returns the old "day" field's value of the MemberHourStat entity.
+// If the MemberHourStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberHourStatMutation) OldDay(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDay is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDay requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDay: %w", err)
+	}
+	return oldValue.Day, nil
+}
+
+// ResetDay resets all changes to the "day" field.
+func (m *MemberHourStatMutation) ResetDay() {
+	m.day = nil
+}
+
+// SetWeekday sets the "weekday" field.
+func (m *MemberHourStatMutation) SetWeekday(i int) {
+	m.weekday = &i
+	m.addweekday = nil
+}
+
+// Weekday returns the value of the "weekday" field in the mutation.
+func (m *MemberHourStatMutation) Weekday() (r int, exists bool) {
+	v := m.weekday
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWeekday returns the old "weekday" field's value of the MemberHourStat entity.
+// If the MemberHourStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberHourStatMutation) OldWeekday(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWeekday is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWeekday requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWeekday: %w", err)
+	}
+	return oldValue.Weekday, nil
+}
+
+// AddWeekday adds i to the "weekday" field.
+func (m *MemberHourStatMutation) AddWeekday(i int) {
+	if m.addweekday != nil {
+		*m.addweekday += i
+	} else {
+		m.addweekday = &i
+	}
+}
+
+// AddedWeekday returns the value that was added to the "weekday" field in this mutation.
+func (m *MemberHourStatMutation) AddedWeekday() (r int, exists bool) {
+	v := m.addweekday
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWeekday resets all changes to the "weekday" field.
+func (m *MemberHourStatMutation) ResetWeekday() {
+	m.weekday = nil
+	m.addweekday = nil
+}
+
+// SetHour sets the "hour" field.
+func (m *MemberHourStatMutation) SetHour(i int) {
+	m.hour = &i
+	m.addhour = nil
+}
+
+// Hour returns the value of the "hour" field in the mutation.
+func (m *MemberHourStatMutation) Hour() (r int, exists bool) {
+	v := m.hour
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHour returns the old "hour" field's value of the MemberHourStat entity.
+// If the MemberHourStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberHourStatMutation) OldHour(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHour is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHour requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHour: %w", err)
+	}
+	return oldValue.Hour, nil
+}
+
+// AddHour adds i to the "hour" field.
+func (m *MemberHourStatMutation) AddHour(i int) {
+	if m.addhour != nil {
+		*m.addhour += i
+	} else {
+		m.addhour = &i
+	}
+}
+
+// AddedHour returns the value that was added to the "hour" field in this mutation.
+func (m *MemberHourStatMutation) AddedHour() (r int, exists bool) {
+	v := m.addhour
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetHour resets all changes to the "hour" field.
+func (m *MemberHourStatMutation) ResetHour() {
+	m.hour = nil
+	m.addhour = nil
+}
+
+// SetActivityCount sets the "activity_count" field.
+func (m *MemberHourStatMutation) SetActivityCount(i int) {
+	m.activity_count = &i
+	m.addactivity_count = nil
+}
+
+// ActivityCount returns the value of the "activity_count" field in the mutation.
+func (m *MemberHourStatMutation) ActivityCount() (r int, exists bool) {
+	v := m.activity_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActivityCount returns the old "activity_count" field's value of the MemberHourStat entity.
+// If the MemberHourStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberHourStatMutation) OldActivityCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActivityCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActivityCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActivityCount: %w", err)
+	}
+	return oldValue.ActivityCount, nil
+}
+
+// AddActivityCount adds i to the "activity_count" field.
+func (m *MemberHourStatMutation) AddActivityCount(i int) {
+	if m.addactivity_count != nil {
+		*m.addactivity_count += i
+	} else {
+		m.addactivity_count = &i
+	}
+}
+
+// AddedActivityCount returns the value that was added to the "activity_count" field in this mutation.
+func (m *MemberHourStatMutation) AddedActivityCount() (r int, exists bool) {
+	v := m.addactivity_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetActivityCount resets all changes to the "activity_count" field.
+func (m *MemberHourStatMutation) ResetActivityCount() {
+	m.activity_count = nil
+	m.addactivity_count = nil
+}
+
+// SetSnapshotID sets the "snapshot" edge to the Snapshot entity by id.
+func (m *MemberHourStatMutation) SetSnapshotID(id int) {
+	m.snapshot = &id
+}
+
+// ClearSnapshot clears the "snapshot" edge to the Snapshot entity.
+func (m *MemberHourStatMutation) ClearSnapshot() {
+	m.clearedsnapshot = true
+}
+
+// SnapshotCleared reports if the "snapshot" edge to the Snapshot entity was cleared.
+func (m *MemberHourStatMutation) SnapshotCleared() bool {
+	return m.clearedsnapshot
+}
+
+// SnapshotID returns the "snapshot" edge ID in the mutation.
+func (m *MemberHourStatMutation) SnapshotID() (id int, exists bool) {
+	if m.snapshot != nil {
+		return *m.snapshot, true
+	}
+	return
+}
+
+// SnapshotIDs returns the "snapshot" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SnapshotID instead. It exists only for internal usage by the builders.
+func (m *MemberHourStatMutation) SnapshotIDs() (ids []int) {
+	if id := m.snapshot; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSnapshot resets all changes to the "snapshot" edge.
+func (m *MemberHourStatMutation) ResetSnapshot() {
+	m.snapshot = nil
+	m.clearedsnapshot = false
+}
+
+// Where appends a list predicates to the MemberHourStatMutation builder.
+func (m *MemberHourStatMutation) Where(ps ...predicate.MemberHourStat) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MemberHourStatMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MemberHourStatMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MemberHourStat, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MemberHourStatMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MemberHourStatMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MemberHourStat).
+func (m *MemberHourStatMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MemberHourStatMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.login != nil {
+		fields = append(fields, memberhourstat.FieldLogin)
+	}
+	if m.hour_start != nil {
+		fields = append(fields, memberhourstat.FieldHourStart)
+	}
+	if m.day != nil {
+		fields = append(fields, memberhourstat.FieldDay)
+	}
+	if m.weekday != nil {
+		fields = append(fields, memberhourstat.FieldWeekday)
+	}
+	if m.hour != nil {
+		fields = append(fields, memberhourstat.FieldHour)
+	}
+	if m.activity_count != nil {
+		fields = append(fields, memberhourstat.FieldActivityCount)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MemberHourStatMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case memberhourstat.FieldLogin:
+		return m.Login()
+	case memberhourstat.FieldHourStart:
+		return m.HourStart()
+	case memberhourstat.FieldDay:
+		return m.Day()
+	case memberhourstat.FieldWeekday:
+		return m.Weekday()
+	case memberhourstat.FieldHour:
+		return m.Hour()
+	case memberhourstat.FieldActivityCount:
+		return m.ActivityCount()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MemberHourStatMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case memberhourstat.FieldLogin:
+		return m.OldLogin(ctx)
+	case memberhourstat.FieldHourStart:
+		return m.OldHourStart(ctx)
+	case memberhourstat.FieldDay:
+		return m.OldDay(ctx)
+	case memberhourstat.FieldWeekday:
+		return m.OldWeekday(ctx)
+	case memberhourstat.FieldHour:
+		return m.OldHour(ctx)
+	case memberhourstat.FieldActivityCount:
+		return m.OldActivityCount(ctx)
+	}
+	return nil, fmt.Errorf("unknown MemberHourStat field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MemberHourStatMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case memberhourstat.FieldLogin:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLogin(v)
+		return nil
+	case memberhourstat.FieldHourStart:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHourStart(v)
+		return nil
+	case memberhourstat.FieldDay:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDay(v)
+		return nil
+	case memberhourstat.FieldWeekday:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWeekday(v)
+		return nil
+	case memberhourstat.FieldHour:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHour(v)
+		return nil
+	case memberhourstat.FieldActivityCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActivityCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MemberHourStat field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MemberHourStatMutation) AddedFields() []string {
+	var fields []string
+	if m.addweekday != nil {
+		fields = append(fields, memberhourstat.FieldWeekday)
+	}
+	if m.addhour != nil {
+		fields = append(fields, memberhourstat.FieldHour)
+	}
+	if m.addactivity_count != nil {
+		fields = append(fields, memberhourstat.FieldActivityCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MemberHourStatMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case memberhourstat.FieldWeekday:
+		return m.AddedWeekday()
+	case memberhourstat.FieldHour:
+		return m.AddedHour()
+	case memberhourstat.FieldActivityCount:
+		return m.AddedActivityCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MemberHourStatMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case memberhourstat.FieldWeekday:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWeekday(v)
+		return nil
+	case memberhourstat.FieldHour:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHour(v)
+		return nil
+	case memberhourstat.FieldActivityCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddActivityCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MemberHourStat numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MemberHourStatMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MemberHourStatMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MemberHourStatMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MemberHourStat nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MemberHourStatMutation) ResetField(name string) error {
+	switch name {
+	case memberhourstat.FieldLogin:
+		m.ResetLogin()
+		return nil
+	case memberhourstat.FieldHourStart:
+		m.ResetHourStart()
+		return nil
+	case memberhourstat.FieldDay:
+		m.ResetDay()
+		return nil
+	case memberhourstat.FieldWeekday:
+		m.ResetWeekday()
+		return nil
+	case memberhourstat.FieldHour:
+		m.ResetHour()
+		return nil
+	case memberhourstat.FieldActivityCount:
+		m.ResetActivityCount()
+		return nil
+	}
+	return fmt.Errorf("unknown MemberHourStat field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MemberHourStatMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.snapshot != nil {
+		edges = append(edges, memberhourstat.EdgeSnapshot)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MemberHourStatMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case memberhourstat.EdgeSnapshot:
+		if id := m.snapshot; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MemberHourStatMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MemberHourStatMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MemberHourStatMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsnapshot {
+		edges = append(edges, memberhourstat.EdgeSnapshot)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MemberHourStatMutation) EdgeCleared(name string) bool {
+	switch name {
+	case memberhourstat.EdgeSnapshot:
+		return m.clearedsnapshot
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MemberHourStatMutation) ClearEdge(name string) error {
+	switch name {
+	case memberhourstat.EdgeSnapshot:
+		m.ClearSnapshot()
+		return nil
+	}
+	return fmt.Errorf("unknown MemberHourStat unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MemberHourStatMutation) ResetEdge(name string) error {
+	switch name {
+	case memberhourstat.EdgeSnapshot:
+		m.ResetSnapshot()
+		return nil
+	}
+	return fmt.Errorf("unknown MemberHourStat edge %s", name)
+}
+
+// MemberIssueMutation represents an operation that mutates the MemberIssue nodes in the graph.
+type MemberIssueMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	login           *string
+	name_with_owner *string
+	source_id       *string
+	author          *string
+	opened_at       *time.Time
+	closed_at       *time.Time
+	closed_by       *string
+	state_reason    *string
+	kind            *string
+	clearedFields   map[string]struct{}
+	snapshot        *int
+	clearedsnapshot bool
+	done            bool
+	oldValue        func(context.Context) (*MemberIssue, error)
+	predicates      []predicate.MemberIssue
+}
+
+var _ ent.Mutation = (*MemberIssueMutation)(nil)
+
+// memberissueOption allows management of the mutation configuration using functional options.
+type memberissueOption func(*MemberIssueMutation)
+
+// newMemberIssueMutation creates new mutation for the MemberIssue entity.
+func newMemberIssueMutation(c config, op Op, opts ...memberissueOption) *MemberIssueMutation {
+	m := &MemberIssueMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMemberIssue,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMemberIssueID sets the ID field of the mutation.
+func withMemberIssueID(id int) memberissueOption {
+	return func(m *MemberIssueMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MemberIssue
+		)
+		m.oldValue = func(ctx context.Context) (*MemberIssue, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MemberIssue.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMemberIssue sets the old MemberIssue of the mutation.
+func withMemberIssue(node *MemberIssue) memberissueOption {
+	return func(m *MemberIssueMutation) {
+		m.oldValue = func(context.Context) (*MemberIssue, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MemberIssueMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MemberIssueMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MemberIssueMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MemberIssueMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MemberIssue.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetLogin sets the "login" field.
+func (m *MemberIssueMutation) SetLogin(s string) {
+	m.login = &s
+}
+
+// Login returns the value of the "login" field in the mutation.
+func (m *MemberIssueMutation) Login() (r string, exists bool) {
+	v := m.login
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLogin returns the old "login" field's value of the MemberIssue entity.
+// If the MemberIssue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberIssueMutation) OldLogin(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLogin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLogin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLogin: %w", err)
+	}
+	return oldValue.Login, nil
+}
+
+// ResetLogin resets all changes to the "login" field.
+func (m *MemberIssueMutation) ResetLogin() {
+	m.login = nil
+}
+
+// SetNameWithOwner sets the "name_with_owner" field.
+func (m *MemberIssueMutation) SetNameWithOwner(s string) {
+	m.name_with_owner = &s
+}
+
+// NameWithOwner returns the value of the "name_with_owner" field in the mutation.
+func (m *MemberIssueMutation) NameWithOwner() (r string, exists bool) {
+	v := m.name_with_owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNameWithOwner returns the old "name_with_owner" field's value of the MemberIssue entity.
+// If the MemberIssue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberIssueMutation) OldNameWithOwner(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNameWithOwner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNameWithOwner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNameWithOwner: %w", err)
+	}
+	return oldValue.NameWithOwner, nil
+}
+
+// ResetNameWithOwner resets all changes to the "name_with_owner" field.
+func (m *MemberIssueMutation) ResetNameWithOwner() {
+	m.name_with_owner = nil
+}
+
+// SetSourceID sets the "source_id" field.
+func (m *MemberIssueMutation) SetSourceID(s string) {
+	m.source_id = &s
+}
+
+// SourceID returns the value of the "source_id" field in the mutation.
+func (m *MemberIssueMutation) SourceID() (r string, exists bool) {
+	v := m.source_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceID returns the old "source_id" field's value of the MemberIssue entity.
+// If the MemberIssue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberIssueMutation) OldSourceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceID: %w", err)
+	}
+	return oldValue.SourceID, nil
+}
+
+// ResetSourceID resets all changes to the "source_id" field.
+func (m *MemberIssueMutation) ResetSourceID() {
+	m.source_id = nil
+}
+
+// SetAuthor sets the "author" field.
+func (m *MemberIssueMutation) SetAuthor(s string) {
+	m.author = &s
+}
+
+// Author returns the value of the "author" field in the mutation.
+func (m *MemberIssueMutation) Author() (r string, exists bool) {
+	v := m.author
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthor returns the old "author" field's value of the MemberIssue entity.
+// If the MemberIssue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberIssueMutation) OldAuthor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthor: %w", err)
+	}
+	return oldValue.Author, nil
+}
+
+// ResetAuthor resets all changes to the "author" field.
+func (m *MemberIssueMutation) ResetAuthor() {
+	m.author = nil
+}
+
+// SetOpenedAt sets the "opened_at" field.
+func (m *MemberIssueMutation) SetOpenedAt(t time.Time) {
+	m.opened_at = &t
+}
+
+// OpenedAt returns the value of the "opened_at" field in the mutation.
+func (m *MemberIssueMutation) OpenedAt() (r time.Time, exists bool) {
+	v := m.opened_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOpenedAt returns the old "opened_at" field's value of the MemberIssue entity.
+// If the MemberIssue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberIssueMutation) OldOpenedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOpenedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOpenedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOpenedAt: %w", err)
+	}
+	return oldValue.OpenedAt, nil
+}
+
+// ResetOpenedAt resets all changes to the "opened_at" field.
+func (m *MemberIssueMutation) ResetOpenedAt() {
+	m.opened_at = nil
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (m *MemberIssueMutation) SetClosedAt(t time.Time) {
+	m.closed_at = &t
+}
+
+// ClosedAt returns the value of the "closed_at" field in the mutation.
+func (m *MemberIssueMutation) ClosedAt() (r time.Time, exists bool) {
+	v := m.closed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClosedAt returns the old "closed_at" field's value of the MemberIssue entity.
+// If the MemberIssue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberIssueMutation) OldClosedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClosedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClosedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClosedAt: %w", err)
+	}
+	return oldValue.ClosedAt, nil
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (m *MemberIssueMutation) ClearClosedAt() {
+	m.closed_at = nil
+	m.clearedFields[memberissue.FieldClosedAt] = struct{}{}
+}
+
+// ClosedAtCleared returns if the "closed_at" field was cleared in this mutation.
+func (m *MemberIssueMutation) ClosedAtCleared() bool {
+	_, ok := m.clearedFields[memberissue.FieldClosedAt]
+	return ok
+}
+
+// ResetClosedAt resets all changes to the "closed_at" field.
+func (m *MemberIssueMutation) ResetClosedAt() {
+	m.closed_at = nil
+	delete(m.clearedFields, memberissue.FieldClosedAt)
+}
+
+// SetClosedBy sets the "closed_by" field.
+func (m *MemberIssueMutation) SetClosedBy(s string) {
+	m.closed_by = &s
+}
+
+// ClosedBy returns the value of the "closed_by" field in the mutation.
+func (m *MemberIssueMutation) ClosedBy() (r string, exists bool) {
+	v := m.closed_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClosedBy returns the old "closed_by" field's value of the MemberIssue entity.
+// If the MemberIssue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberIssueMutation) OldClosedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClosedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClosedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClosedBy: %w", err)
+	}
+	return oldValue.ClosedBy, nil
+}
+
+// ResetClosedBy resets all changes to the "closed_by" field.
+func (m *MemberIssueMutation) ResetClosedBy() {
+	m.closed_by = nil
+}
+
+// SetStateReason sets the "state_reason" field.
+func (m *MemberIssueMutation) SetStateReason(s string) {
+	m.state_reason = &s
+}
+
+// StateReason returns the value of the "state_reason" field in the mutation.
+func (m *MemberIssueMutation) StateReason() (r string, exists bool) {
+	v := m.state_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStateReason returns the old "state_reason" field's value of the MemberIssue entity.
+// If the MemberIssue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberIssueMutation) OldStateReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStateReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStateReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStateReason: %w", err)
+	}
+	return oldValue.StateReason, nil
+}
+
+// ResetStateReason resets all changes to the "state_reason" field.
+func (m *MemberIssueMutation) ResetStateReason() {
+	m.state_reason = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *MemberIssueMutation) SetKind(s string) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *MemberIssueMutation) Kind() (r string, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the MemberIssue entity.
+// If the MemberIssue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberIssueMutation) OldKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *MemberIssueMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetSnapshotID sets the "snapshot" edge to the Snapshot entity by id.
+func (m *MemberIssueMutation) SetSnapshotID(id int) {
+	m.snapshot = &id
+}
+
+// ClearSnapshot clears the "snapshot" edge to the Snapshot entity.
+func (m *MemberIssueMutation) ClearSnapshot() {
+	m.clearedsnapshot = true
+}
+
+// SnapshotCleared reports if the "snapshot" edge to the Snapshot entity was cleared.
+func (m *MemberIssueMutation) SnapshotCleared() bool {
+	return m.clearedsnapshot
+}
+
+// SnapshotID returns the "snapshot" edge ID in the mutation.
+func (m *MemberIssueMutation) SnapshotID() (id int, exists bool) {
+	if m.snapshot != nil {
+		return *m.snapshot, true
+	}
+	return
+}
+
+// SnapshotIDs returns the "snapshot" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SnapshotID instead. It exists only for internal usage by the builders.
+func (m *MemberIssueMutation) SnapshotIDs() (ids []int) {
+	if id := m.snapshot; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSnapshot resets all changes to the "snapshot" edge.
+func (m *MemberIssueMutation) ResetSnapshot() {
+	m.snapshot = nil
+	m.clearedsnapshot = false
+}
+
+// Where appends a list predicates to the MemberIssueMutation builder.
+func (m *MemberIssueMutation) Where(ps ...predicate.MemberIssue) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MemberIssueMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MemberIssueMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MemberIssue, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MemberIssueMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MemberIssueMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MemberIssue).
+func (m *MemberIssueMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MemberIssueMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.login != nil {
+		fields = append(fields, memberissue.FieldLogin)
+	}
+	if m.name_with_owner != nil {
+		fields = append(fields, memberissue.FieldNameWithOwner)
+	}
+	if m.source_id != nil {
+		fields = append(fields, memberissue.FieldSourceID)
+	}
+	if m.author != nil {
+		fields = append(fields, memberissue.FieldAuthor)
+	}
+	if m.opened_at != nil {
+		fields = append(fields, memberissue.FieldOpenedAt)
+	}
+	if m.closed_at != nil {
+		fields = append(fields, memberissue.FieldClosedAt)
+	}
+	if m.closed_by != nil {
+		fields = append(fields, memberissue.FieldClosedBy)
+	}
+	if m.state_reason != nil {
+		fields = append(fields, memberissue.FieldStateReason)
+	}
+	if m.kind != nil {
+		fields = append(fields, memberissue.FieldKind)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MemberIssueMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case memberissue.FieldLogin:
+		return m.Login()
+	case memberissue.FieldNameWithOwner:
+		return m.NameWithOwner()
+	case memberissue.FieldSourceID:
+		return m.SourceID()
+	case memberissue.FieldAuthor:
+		return m.Author()
+	case memberissue.FieldOpenedAt:
+		return m.OpenedAt()
+	case memberissue.FieldClosedAt:
+		return m.ClosedAt()
+	case memberissue.FieldClosedBy:
+		return m.ClosedBy()
+	case memberissue.FieldStateReason:
+		return m.StateReason()
+	case memberissue.FieldKind:
+		return m.Kind()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MemberIssueMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case memberissue.FieldLogin:
+		return m.OldLogin(ctx)
+	case memberissue.FieldNameWithOwner:
+		return m.OldNameWithOwner(ctx)
+	case memberissue.FieldSourceID:
+		return m.OldSourceID(ctx)
+	case memberissue.FieldAuthor:
+		return m.OldAuthor(ctx)
+	case memberissue.FieldOpenedAt:
+		return m.OldOpenedAt(ctx)
+	case memberissue.FieldClosedAt:
+		return m.OldClosedAt(ctx)
+	case memberissue.FieldClosedBy:
+		return m.OldClosedBy(ctx)
+	case memberissue.FieldStateReason:
+		return m.OldStateReason(ctx)
+	case memberissue.FieldKind:
+		return m.OldKind(ctx)
+	}
+	return nil, fmt.Errorf("unknown MemberIssue field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MemberIssueMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case memberissue.FieldLogin:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLogin(v)
+		return nil
+	case memberissue.FieldNameWithOwner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNameWithOwner(v)
+		return nil
+	case memberissue.FieldSourceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceID(v)
+		return nil
+	case memberissue.FieldAuthor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthor(v)
+		return nil
+	case memberissue.FieldOpenedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOpenedAt(v)
+		return nil
+	case memberissue.FieldClosedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClosedAt(v)
+		return nil
+	case memberissue.FieldClosedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClosedBy(v)
+		return nil
+	case memberissue.FieldStateReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStateReason(v)
+		return nil
+	case memberissue.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MemberIssue field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MemberIssueMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MemberIssueMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MemberIssueMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MemberIssue numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MemberIssueMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(memberissue.FieldClosedAt) {
+		fields = append(fields, memberissue.FieldClosedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MemberIssueMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MemberIssueMutation) ClearField(name string) error {
+	switch name {
+	case memberissue.FieldClosedAt:
+		m.ClearClosedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MemberIssue nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MemberIssueMutation) ResetField(name string) error {
+	switch name {
+	case memberissue.FieldLogin:
+		m.ResetLogin()
+		return nil
+	case memberissue.FieldNameWithOwner:
+		m.ResetNameWithOwner()
+		return nil
+	case memberissue.FieldSourceID:
+		m.ResetSourceID()
+		return nil
+	case memberissue.FieldAuthor:
+		m.ResetAuthor()
+		return nil
+	case memberissue.FieldOpenedAt:
+		m.ResetOpenedAt()
+		return nil
+	case memberissue.FieldClosedAt:
+		m.ResetClosedAt()
+		return nil
+	case memberissue.FieldClosedBy:
+		m.ResetClosedBy()
+		return nil
+	case memberissue.FieldStateReason:
+		m.ResetStateReason()
+		return nil
+	case memberissue.FieldKind:
+		m.ResetKind()
+		return nil
+	}
+	return fmt.Errorf("unknown MemberIssue field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MemberIssueMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.snapshot != nil {
+		edges = append(edges, memberissue.EdgeSnapshot)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MemberIssueMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case memberissue.EdgeSnapshot:
+		if id := m.snapshot; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MemberIssueMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MemberIssueMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MemberIssueMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsnapshot {
+		edges = append(edges, memberissue.EdgeSnapshot)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MemberIssueMutation) EdgeCleared(name string) bool {
+	switch name {
+	case memberissue.EdgeSnapshot:
+		return m.clearedsnapshot
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MemberIssueMutation) ClearEdge(name string) error {
+	switch name {
+	case memberissue.EdgeSnapshot:
+		m.ClearSnapshot()
+		return nil
+	}
+	return fmt.Errorf("unknown MemberIssue unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MemberIssueMutation) ResetEdge(name string) error {
+	switch name {
+	case memberissue.EdgeSnapshot:
+		m.ResetSnapshot()
+		return nil
+	}
+	return fmt.Errorf("unknown MemberIssue edge %s", name)
+}
+
+// MemberPullRequestMutation represents an operation that mutates the MemberPullRequest nodes in the graph.
+type MemberPullRequestMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	login            *string
+	name_with_owner  *string
+	source_id        *string
+	opened_at        *time.Time
+	first_review_at  *time.Time
+	approved_at      *time.Time
+	merged_at        *time.Time
+	closed_at        *time.Time
+	review_rounds    *int
+	addreview_rounds *int
+	additions        *int
+	addadditions     *int
+	deletions        *int
+	adddeletions     *int
+	changed_files    *int
+	addchanged_files *int
+	clearedFields    map[string]struct{}
+	snapshot         *int
+	clearedsnapshot  bool
+	done             bool
+	oldValue         func(context.Context) (*MemberPullRequest, error)
+	predicates       []predicate.MemberPullRequest
+}
+
+var _ ent.Mutation = (*MemberPullRequestMutation)(nil)
+
+// memberpullrequestOption allows management of the mutation configuration using functional options.
+type memberpullrequestOption func(*MemberPullRequestMutation)
+
+// newMemberPullRequestMutation creates new mutation for the MemberPullRequest entity.
+func newMemberPullRequestMutation(c config, op Op, opts ...memberpullrequestOption) *MemberPullRequestMutation {
+	m := &MemberPullRequestMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMemberPullRequest,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMemberPullRequestID sets the ID field of the mutation.
+func withMemberPullRequestID(id int) memberpullrequestOption {
+	return func(m *MemberPullRequestMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MemberPullRequest
+		)
+		m.oldValue = func(ctx context.Context) (*MemberPullRequest, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MemberPullRequest.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMemberPullRequest sets the old MemberPullRequest of the mutation.
+func withMemberPullRequest(node *MemberPullRequest) memberpullrequestOption {
+	return func(m *MemberPullRequestMutation) {
+		m.oldValue = func(context.Context) (*MemberPullRequest, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MemberPullRequestMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MemberPullRequestMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MemberPullRequestMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MemberPullRequestMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MemberPullRequest.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetLogin sets the "login" field.
+func (m *MemberPullRequestMutation) SetLogin(s string) {
+	m.login = &s
+}
+
+// Login returns the value of the "login" field in the mutation.
+func (m *MemberPullRequestMutation) Login() (r string, exists bool) {
+	v := m.login
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLogin returns the old "login" field's value of the MemberPullRequest entity.
+// If the MemberPullRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberPullRequestMutation) OldLogin(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLogin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLogin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLogin: %w", err)
+	}
+	return oldValue.Login, nil
+}
+
+// ResetLogin resets all changes to the "login" field.
+func (m *MemberPullRequestMutation) ResetLogin() {
+	m.login = nil
+}
+
+// SetNameWithOwner sets the "name_with_owner" field.
+func (m *MemberPullRequestMutation) SetNameWithOwner(s string) {
+	m.name_with_owner = &s
+}
+
+// NameWithOwner returns the value of the "name_with_owner" field in the mutation.
+func (m *MemberPullRequestMutation) NameWithOwner() (r string, exists bool) {
+	v := m.name_with_owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNameWithOwner returns the old "name_with_owner" field's value of the MemberPullRequest entity.
+// If the MemberPullRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberPullRequestMutation) OldNameWithOwner(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNameWithOwner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNameWithOwner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNameWithOwner: %w", err)
+	}
+	return oldValue.NameWithOwner, nil
+}
+
+// ResetNameWithOwner resets all changes to the "name_with_owner" field.
+func (m *MemberPullRequestMutation) ResetNameWithOwner() {
+	m.name_with_owner = nil
+}
+
+// SetSourceID sets the "source_id" field.
+func (m *MemberPullRequestMutation) SetSourceID(s string) {
+	m.source_id = &s
+}
+
+// SourceID returns the value of the "source_id" field in the mutation.
+func (m *MemberPullRequestMutation) SourceID() (r string, exists bool) {
+	v := m.source_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceID returns the old "source_id" field's value of the MemberPullRequest entity.
+// If the MemberPullRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberPullRequestMutation) OldSourceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceID: %w", err)
+	}
+	return oldValue.SourceID, nil
+}
+
+// ResetSourceID resets all changes to the "source_id" field.
+func (m *MemberPullRequestMutation) ResetSourceID() {
+	m.source_id = nil
+}
+
+// SetOpenedAt sets the "opened_at" field.
+func (m *MemberPullRequestMutation) SetOpenedAt(t time.Time) {
+	m.opened_at = &t
+}
+
+// OpenedAt returns the value of the "opened_at" field in the mutation.
+func (m *MemberPullRequestMutation) OpenedAt() (r time.Time, exists bool) {
+	v := m.opened_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOpenedAt returns the old "opened_at" field's value of the MemberPullRequest entity.
+// If the MemberPullRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberPullRequestMutation) OldOpenedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOpenedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOpenedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOpenedAt: %w", err)
+	}
+	return oldValue.OpenedAt, nil
+}
+
+// ResetOpenedAt resets all changes to the "opened_at" field.
+func (m *MemberPullRequestMutation) ResetOpenedAt() {
+	m.opened_at = nil
+}
+
+// SetFirstReviewAt sets the "first_review_at" field.
+func (m *MemberPullRequestMutation) SetFirstReviewAt(t time.Time) {
+	m.first_review_at = &t
+}
+
+// FirstReviewAt returns the value of the "first_review_at" field in the mutation.
+func (m *MemberPullRequestMutation) FirstReviewAt() (r time.Time, exists bool) {
+	v := m.first_review_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstReviewAt returns the old "first_review_at" field's value of the MemberPullRequest entity.
+// If the MemberPullRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberPullRequestMutation) OldFirstReviewAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstReviewAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstReviewAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstReviewAt: %w", err)
+	}
+	return oldValue.FirstReviewAt, nil
+}
+
+// ClearFirstReviewAt clears the value of the "first_review_at" field.
+func (m *MemberPullRequestMutation) ClearFirstReviewAt() {
+	m.first_review_at = nil
+	m.clearedFields[memberpullrequest.FieldFirstReviewAt] = struct{}{}
+}
+
+// FirstReviewAtCleared returns if the "first_review_at" field was cleared in this mutation.
+func (m *MemberPullRequestMutation) FirstReviewAtCleared() bool {
+	_, ok := m.clearedFields[memberpullrequest.FieldFirstReviewAt]
+	return ok
+}
+
+// ResetFirstReviewAt resets all changes to the "first_review_at" field.
+func (m *MemberPullRequestMutation) ResetFirstReviewAt() {
+	m.first_review_at = nil
+	delete(m.clearedFields, memberpullrequest.FieldFirstReviewAt)
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (m *MemberPullRequestMutation) SetApprovedAt(t time.Time) {
+	m.approved_at = &t
+}
+
+// ApprovedAt returns the value of the "approved_at" field in the mutation.
+func (m *MemberPullRequestMutation) ApprovedAt() (r time.Time, exists bool) {
+	v := m.approved_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldApprovedAt returns the old "approved_at" field's value of the MemberPullRequest entity.
+// If the MemberPullRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberPullRequestMutation) OldApprovedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldApprovedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldApprovedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldApprovedAt: %w", err)
+	}
+	return oldValue.ApprovedAt, nil
+}
+
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (m *MemberPullRequestMutation) ClearApprovedAt() {
+	m.approved_at = nil
+	m.clearedFields[memberpullrequest.FieldApprovedAt] = struct{}{}
+}
+
+// ApprovedAtCleared returns if the "approved_at" field was cleared in this mutation.
+func (m *MemberPullRequestMutation) ApprovedAtCleared() bool {
+	_, ok := m.clearedFields[memberpullrequest.FieldApprovedAt]
+	return ok
+}
+
+// ResetApprovedAt resets all changes to the "approved_at" field.
+func (m *MemberPullRequestMutation) ResetApprovedAt() {
+	m.approved_at = nil
+	delete(m.clearedFields, memberpullrequest.FieldApprovedAt)
+}
+
+// SetMergedAt sets the "merged_at" field.
+func (m *MemberPullRequestMutation) SetMergedAt(t time.Time) {
+	m.merged_at = &t
+}
+
+// MergedAt returns the value of the "merged_at" field in the mutation.
+func (m *MemberPullRequestMutation) MergedAt() (r time.Time, exists bool) {
+	v := m.merged_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMergedAt returns the old "merged_at" field's value of the MemberPullRequest entity.
+// If the MemberPullRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberPullRequestMutation) OldMergedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMergedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMergedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMergedAt: %w", err)
+	}
+	return oldValue.MergedAt, nil
+}
+
+// ClearMergedAt clears the value of the "merged_at" field.
+func (m *MemberPullRequestMutation) ClearMergedAt() {
+	m.merged_at = nil
+	m.clearedFields[memberpullrequest.FieldMergedAt] = struct{}{}
+}
+
+// MergedAtCleared returns if the "merged_at" field was cleared in this mutation.
+func (m *MemberPullRequestMutation) MergedAtCleared() bool {
+	_, ok := m.clearedFields[memberpullrequest.FieldMergedAt]
+	return ok
+}
+
+// ResetMergedAt resets all changes to the "merged_at" field.
+func (m *MemberPullRequestMutation) ResetMergedAt() {
+	m.merged_at = nil
+	delete(m.clearedFields, memberpullrequest.FieldMergedAt)
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (m *MemberPullRequestMutation) SetClosedAt(t time.Time) {
+	m.closed_at = &t
+}
+
+// ClosedAt returns the value of the "closed_at" field in the mutation.
+func (m *MemberPullRequestMutation) ClosedAt() (r time.Time, exists bool) {
+	v := m.closed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClosedAt returns the old "closed_at" field's value of the MemberPullRequest entity.
+// If the MemberPullRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberPullRequestMutation) OldClosedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClosedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClosedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClosedAt: %w", err)
+	}
+	return oldValue.ClosedAt, nil
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (m *MemberPullRequestMutation) ClearClosedAt() {
+	m.closed_at = nil
+	m.clearedFields[memberpullrequest.FieldClosedAt] = struct{}{}
+}
+
+// ClosedAtCleared returns if the "closed_at" field was cleared in this mutation.
+func (m *MemberPullRequestMutation) ClosedAtCleared() bool {
+	_, ok := m.clearedFields[memberpullrequest.FieldClosedAt]
+	return ok
+}
+
+// ResetClosedAt resets all changes to the "closed_at" field.
+func (m *MemberPullRequestMutation) ResetClosedAt() {
+	m.closed_at = nil
+	delete(m.clearedFields, memberpullrequest.FieldClosedAt)
+}
+
+// SetReviewRounds sets the "review_rounds" field.
+func (m *MemberPullRequestMutation) SetReviewRounds(i int) {
+	m.review_rounds = &i
+	m.addreview_rounds = nil
+}
+
+// ReviewRounds returns the value of the "review_rounds" field in the mutation.
+func (m *MemberPullRequestMutation) ReviewRounds() (r int, exists bool) {
+	v := m.review_rounds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewRounds returns the old "review_rounds" field's value of the MemberPullRequest entity.
+// If the MemberPullRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberPullRequestMutation) OldReviewRounds(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewRounds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewRounds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewRounds: %w", err)
+	}
+	return oldValue.ReviewRounds, nil
+}
+
+// AddReviewRounds adds i to the "review_rounds" field.
+func (m *MemberPullRequestMutation) AddReviewRounds(i int) {
+	if m.addreview_rounds != nil {
+		*m.addreview_rounds += i
+	} else {
+		m.addreview_rounds = &i
+	}
+}
+
+// AddedReviewRounds returns the value that was added to the "review_rounds" field in this mutation.
+func (m *MemberPullRequestMutation) AddedReviewRounds() (r int, exists bool) {
+	v := m.addreview_rounds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetReviewRounds resets all changes to the "review_rounds" field.
+func (m *MemberPullRequestMutation) ResetReviewRounds() {
+	m.review_rounds = nil
+	m.addreview_rounds = nil
+}
+
+// SetAdditions sets the "additions" field.
+func (m *MemberPullRequestMutation) SetAdditions(i int) {
+	m.additions = &i
+	m.addadditions = nil
+}
+
+// Additions returns the value of the "additions" field in the mutation.
+func (m *MemberPullRequestMutation) Additions() (r int, exists bool) {
+	v := m.additions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAdditions returns the old "additions" field's value of the MemberPullRequest entity.
+// If the MemberPullRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberPullRequestMutation) OldAdditions(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAdditions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAdditions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAdditions: %w", err)
+	}
+	return oldValue.Additions, nil
+}
+
+// AddAdditions adds i to the "additions" field.
+func (m *MemberPullRequestMutation) AddAdditions(i int) {
+	if m.addadditions != nil {
+		*m.addadditions += i
+	} else {
+		m.addadditions = &i
+	}
+}
+
+// AddedAdditions returns the value that was added to the "additions" field in this mutation.
+func (m *MemberPullRequestMutation) AddedAdditions() (r int, exists bool) {
+	v := m.addadditions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAdditions resets all changes to the "additions" field.
+func (m *MemberPullRequestMutation) ResetAdditions() {
+	m.additions = nil
+	m.addadditions = nil
+}
+
+// SetDeletions sets the "deletions" field.
+func (m *MemberPullRequestMutation) SetDeletions(i int) {
+	m.deletions = &i
+	m.adddeletions = nil
+}
+
+// Deletions returns the value of the "deletions" field in the mutation.
+func (m *MemberPullRequestMutation) Deletions() (r int, exists bool) {
+	v := m.deletions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletions returns the old "deletions" field's value of the MemberPullRequest entity.
+// If the MemberPullRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberPullRequestMutation) OldDeletions(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletions: %w", err)
+	}
+	return oldValue.Deletions, nil
+}
+
+// AddDeletions adds i to the "deletions" field.
+func (m *MemberPullRequestMutation) AddDeletions(i int) {
+	if m.adddeletions != nil {
+		*m.adddeletions += i
+	} else {
+		m.adddeletions = &i
+	}
+}
+
+// AddedDeletions returns the value that was added to the "deletions" field in this mutation.
+func (m *MemberPullRequestMutation) AddedDeletions() (r int, exists bool) {
+	v := m.adddeletions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDeletions resets all changes to the "deletions" field.
+func (m *MemberPullRequestMutation) ResetDeletions() {
+	m.deletions = nil
+	m.adddeletions = nil
+}
+
+// SetChangedFiles sets the "changed_files" field.
+func (m *MemberPullRequestMutation) SetChangedFiles(i int) {
+	m.changed_files = &i
+	m.addchanged_files = nil
+}
+
+// ChangedFiles returns the value of the "changed_files" field in the mutation.
+func (m *MemberPullRequestMutation) ChangedFiles() (r int, exists bool) {
+	v := m.changed_files
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChangedFiles returns the old "changed_files" field's value of the MemberPullRequest entity.
+// If the MemberPullRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberPullRequestMutation) OldChangedFiles(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChangedFiles is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChangedFiles requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChangedFiles: %w", err)
+	}
+	return oldValue.ChangedFiles, nil
+}
+
+// AddChangedFiles adds i to the "changed_files" field.
+func (m *MemberPullRequestMutation) AddChangedFiles(i int) {
+	if m.addchanged_files != nil {
+		*m.addchanged_files += i
+	} else {
+		m.addchanged_files = &i
+	}
+}
+
+// AddedChangedFiles returns the value that was added to the "changed_files" field in this mutation.
+func (m *MemberPullRequestMutation) AddedChangedFiles() (r int, exists bool) {
+	v := m.addchanged_files
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetChangedFiles resets all changes to the "changed_files" field.
+func (m *MemberPullRequestMutation) ResetChangedFiles() {
+	m.changed_files = nil
+	m.addchanged_files = nil
+}
+
+// SetSnapshotID sets the "snapshot" edge to the Snapshot entity by id.
+func (m *MemberPullRequestMutation) SetSnapshotID(id int) {
+	m.snapshot = &id
+}
+
+// ClearSnapshot clears the "snapshot" edge to the Snapshot entity.
+func (m *MemberPullRequestMutation) ClearSnapshot() {
+	m.clearedsnapshot = true
+}
+
+// SnapshotCleared reports if the "snapshot" edge to the Snapshot entity was cleared.
+func (m *MemberPullRequestMutation) SnapshotCleared() bool {
+	return m.clearedsnapshot
+}
+
+// SnapshotID returns the "snapshot" edge ID in the mutation.
+func (m *MemberPullRequestMutation) SnapshotID() (id int, exists bool) {
+	if m.snapshot != nil {
+		return *m.snapshot, true
+	}
+	return
+}
+
+// SnapshotIDs returns the "snapshot" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SnapshotID instead. It exists only for internal usage by the builders.
+func (m *MemberPullRequestMutation) SnapshotIDs() (ids []int) {
+	if id := m.snapshot; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSnapshot resets all changes to the "snapshot" edge.
+func (m *MemberPullRequestMutation) ResetSnapshot() {
+	m.snapshot = nil
+	m.clearedsnapshot = false
+}
+
+// Where appends a list predicates to the MemberPullRequestMutation builder.
+func (m *MemberPullRequestMutation) Where(ps ...predicate.MemberPullRequest) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MemberPullRequestMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MemberPullRequestMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MemberPullRequest, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MemberPullRequestMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MemberPullRequestMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MemberPullRequest).
+func (m *MemberPullRequestMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MemberPullRequestMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.login != nil {
+		fields = append(fields, memberpullrequest.FieldLogin)
+	}
+	if m.name_with_owner != nil {
+		fields = append(fields, memberpullrequest.FieldNameWithOwner)
+	}
+	if m.source_id != nil {
+		fields = append(fields, memberpullrequest.FieldSourceID)
+	}
+	if m.opened_at != nil {
+		fields = append(fields, memberpullrequest.FieldOpenedAt)
+	}
+	if m.first_review_at != nil {
+		fields = append(fields, memberpullrequest.FieldFirstReviewAt)
+	}
+	if m.approved_at != nil {
+		fields = append(fields, memberpullrequest.FieldApprovedAt)
+	}
+	if m.merged_at != nil {
+		fields = append(fields, memberpullrequest.FieldMergedAt)
+	}
+	if m.closed_at != nil {
+		fields = append(fields, memberpullrequest.FieldClosedAt)
+	}
+	if m.review_rounds != nil {
+		fields = append(fields, memberpullrequest.FieldReviewRounds)
+	}
+	if m.additions != nil {
+		fields = append(fields, memberpullrequest.FieldAdditions)
+	}
+	if m.deletions != nil {
+		fields = append(fields, memberpullrequest.FieldDeletions)
+	}
+	if m.changed_files != nil {
+		fields = append(fields, memberpullrequest.FieldChangedFiles)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MemberPullRequestMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case memberpullrequest.FieldLogin:
+		return m.Login()
+	case memberpullrequest.FieldNameWithOwner:
+		return m.NameWithOwner()
+	case memberpullrequest.FieldSourceID:
+		return m.SourceID()
+	case memberpullrequest.FieldOpenedAt:
+		return m.OpenedAt()
+	case memberpullrequest.FieldFirstReviewAt:
+		return m.FirstReviewAt()
+	case memberpullrequest.FieldApprovedAt:
+		return m.ApprovedAt()
+	case memberpullrequest.FieldMergedAt:
+		return m.MergedAt()
+	case memberpullrequest.FieldClosedAt:
+		return m.ClosedAt()
+	case memberpullrequest.FieldReviewRounds:
+		return m.ReviewRounds()
+	case memberpullrequest.FieldAdditions:
+		return m.Additions()
+	case memberpullrequest.FieldDeletions:
+		return m.Deletions()
+	case memberpullrequest.FieldChangedFiles:
+		return m.ChangedFiles()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MemberPullRequestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case memberpullrequest.FieldLogin:
+		return m.OldLogin(ctx)
+	case memberpullrequest.FieldNameWithOwner:
+		return m.OldNameWithOwner(ctx)
+	case memberpullrequest.FieldSourceID:
+		return m.OldSourceID(ctx)
+	case memberpullrequest.FieldOpenedAt:
+		return m.OldOpenedAt(ctx)
+	case memberpullrequest.FieldFirstReviewAt:
+		return m.OldFirstReviewAt(ctx)
+	case memberpullrequest.FieldApprovedAt:
+		return m.OldApprovedAt(ctx)
+	case memberpullrequest.FieldMergedAt:
+		return m.OldMergedAt(ctx)
+	case memberpullrequest.FieldClosedAt:
+		return m.OldClosedAt(ctx)
+	case memberpullrequest.FieldReviewRounds:
+		return m.OldReviewRounds(ctx)
+	case memberpullrequest.FieldAdditions:
+		return m.OldAdditions(ctx)
+	case memberpullrequest.FieldDeletions:
+		return m.OldDeletions(ctx)
+	case memberpullrequest.FieldChangedFiles:
+		return m.OldChangedFiles(ctx)
+	}
+	return nil, fmt.Errorf("unknown MemberPullRequest field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MemberPullRequestMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case memberpullrequest.FieldLogin:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLogin(v)
+		return nil
+	case memberpullrequest.FieldNameWithOwner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNameWithOwner(v)
+		return nil
+	case memberpullrequest.FieldSourceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceID(v)
+		return nil
+	case memberpullrequest.FieldOpenedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOpenedAt(v)
+		return nil
+	case memberpullrequest.FieldFirstReviewAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstReviewAt(v)
+		return nil
+	case memberpullrequest.FieldApprovedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetApprovedAt(v)
+		return nil
+	case memberpullrequest.FieldMergedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMergedAt(v)
+		return nil
+	case memberpullrequest.FieldClosedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClosedAt(v)
+		return nil
+	case memberpullrequest.FieldReviewRounds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewRounds(v)
+		return nil
+	case memberpullrequest.FieldAdditions:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAdditions(v)
+		return nil
+	case memberpullrequest.FieldDeletions:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletions(v)
+		return nil
+	case memberpullrequest.FieldChangedFiles:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChangedFiles(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MemberPullRequest field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MemberPullRequestMutation) AddedFields() []string {
+	var fields []string
+	if m.addreview_rounds != nil {
+		fields = append(fields, memberpullrequest.FieldReviewRounds)
+	}
+	if m.addadditions != nil {
+		fields = append(fields, memberpullrequest.FieldAdditions)
+	}
+	if m.adddeletions != nil {
+		fields = append(fields, memberpullrequest.FieldDeletions)
+	}
+	if m.addchanged_files != nil {
+		fields = append(fields, memberpullrequest.FieldChangedFiles)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MemberPullRequestMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case memberpullrequest.FieldReviewRounds:
+		return m.AddedReviewRounds()
+	case memberpullrequest.FieldAdditions:
+		return m.AddedAdditions()
+	case memberpullrequest.FieldDeletions:
+		return m.AddedDeletions()
+	case memberpullrequest.FieldChangedFiles:
+		return m.AddedChangedFiles()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MemberPullRequestMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case memberpullrequest.FieldReviewRounds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReviewRounds(v)
+		return nil
+	case memberpullrequest.FieldAdditions:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAdditions(v)
+		return nil
+	case memberpullrequest.FieldDeletions:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDeletions(v)
+		return nil
+	case memberpullrequest.FieldChangedFiles:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChangedFiles(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MemberPullRequest numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MemberPullRequestMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(memberpullrequest.FieldFirstReviewAt) {
+		fields = append(fields, memberpullrequest.FieldFirstReviewAt)
+	}
+	if m.FieldCleared(memberpullrequest.FieldApprovedAt) {
+		fields = append(fields, memberpullrequest.FieldApprovedAt)
+	}
+	if m.FieldCleared(memberpullrequest.FieldMergedAt) {
+		fields = append(fields, memberpullrequest.FieldMergedAt)
+	}
+	if m.FieldCleared(memberpullrequest.FieldClosedAt) {
+		fields = append(fields, memberpullrequest.FieldClosedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MemberPullRequestMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MemberPullRequestMutation) ClearField(name string) error {
+	switch name {
+	case memberpullrequest.FieldFirstReviewAt:
+		m.ClearFirstReviewAt()
+		return nil
+	case memberpullrequest.FieldApprovedAt:
+		m.ClearApprovedAt()
+		return nil
+	case memberpullrequest.FieldMergedAt:
+		m.ClearMergedAt()
+		return nil
+	case memberpullrequest.FieldClosedAt:
+		m.ClearClosedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MemberPullRequest nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MemberPullRequestMutation) ResetField(name string) error {
+	switch name {
+	case memberpullrequest.FieldLogin:
+		m.ResetLogin()
+		return nil
+	case memberpullrequest.FieldNameWithOwner:
+		m.ResetNameWithOwner()
+		return nil
+	case memberpullrequest.FieldSourceID:
+		m.ResetSourceID()
+		return nil
+	case memberpullrequest.FieldOpenedAt:
+		m.ResetOpenedAt()
+		return nil
+	case memberpullrequest.FieldFirstReviewAt:
+		m.ResetFirstReviewAt()
+		return nil
+	case memberpullrequest.FieldApprovedAt:
+		m.ResetApprovedAt()
+		return nil
+	case memberpullrequest.FieldMergedAt:
+		m.ResetMergedAt()
+		return nil
+	case memberpullrequest.FieldClosedAt:
+		m.ResetClosedAt()
+		return nil
+	case memberpullrequest.FieldReviewRounds:
+		m.ResetReviewRounds()
+		return nil
+	case memberpullrequest.FieldAdditions:
+		m.ResetAdditions()
+		return nil
+	case memberpullrequest.FieldDeletions:
+		m.ResetDeletions()
+		return nil
+	case memberpullrequest.FieldChangedFiles:
+		m.ResetChangedFiles()
+		return nil
+	}
+	return fmt.Errorf("unknown MemberPullRequest field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MemberPullRequestMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.snapshot != nil {
+		edges = append(edges, memberpullrequest.EdgeSnapshot)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MemberPullRequestMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case memberpullrequest.EdgeSnapshot:
+		if id := m.snapshot; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MemberPullRequestMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MemberPullRequestMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MemberPullRequestMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsnapshot {
+		edges = append(edges, memberpullrequest.EdgeSnapshot)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MemberPullRequestMutation) EdgeCleared(name string) bool {
+	switch name {
+	case memberpullrequest.EdgeSnapshot:
+		return m.clearedsnapshot
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MemberPullRequestMutation) ClearEdge(name string) error {
+	switch name {
+	case memberpullrequest.EdgeSnapshot:
+		m.ClearSnapshot()
+		return nil
+	}
+	return fmt.Errorf("unknown MemberPullRequest unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MemberPullRequestMutation) ResetEdge(name string) error {
+	switch name {
+	case memberpullrequest.EdgeSnapshot:
+		m.ResetSnapshot()
+		return nil
+	}
+	return fmt.Errorf("unknown MemberPullRequest edge %s", name)
+}
+
+// MemberRepoDayStatMutation represents an operation that mutates the MemberRepoDayStat nodes in the graph.
+type MemberRepoDayStatMutation struct {
+	config
+	op                       Op
+	typ                      string
+	id                       *int
+	login                    *string
+	name_with_owner          *string
+	day                      *string
+	commit_count             *int
+	addcommit_count          *int
+	pr_created               *int
+	addpr_created            *int
+	pr_merged                *int
+	addpr_merged             *int
+	issue_count              *int
+	addissue_count           *int
+	review_count             *int
+	addreview_count          *int
+	additions                *int
+	addadditions             *int
+	deletions                *int
+	adddeletions             *int
+	issues_closed            *int
+	addissues_closed         *int
+	issue_close_seconds      *int
+	addissue_close_seconds   *int
+	bug_issues_opened        *int
+	addbug_issues_opened     *int
+	feature_issues_opened    *int
+	addfeature_issues_opened *int
+	bug_issues_closed        *int
+	addbug_issues_closed     *int
+	feature_issues_closed    *int
+	addfeature_issues_closed *int
+	feature_prs              *int
+	addfeature_prs           *int
+	bug_prs                  *int
+	addbug_prs               *int
+	chore_prs                *int
+	addchore_prs             *int
+	docs_prs                 *int
+	adddocs_prs              *int
+	test_prs                 *int
+	addtest_prs              *int
+	infra_prs                *int
+	addinfra_prs             *int
+	other_prs                *int
+	addother_prs             *int
+	clearedFields            map[string]struct{}
+	snapshot                 *int
+	clearedsnapshot          bool
+	done                     bool
+	oldValue                 func(context.Context) (*MemberRepoDayStat, error)
+	predicates               []predicate.MemberRepoDayStat
+}
+
+var _ ent.Mutation = (*MemberRepoDayStatMutation)(nil)
+
+// memberrepodaystatOption allows management of the mutation configuration using functional options.
+type memberrepodaystatOption func(*MemberRepoDayStatMutation)
+
+// newMemberRepoDayStatMutation creates new mutation for the MemberRepoDayStat entity.
+func newMemberRepoDayStatMutation(c config, op Op, opts ...memberrepodaystatOption) *MemberRepoDayStatMutation {
+	m := &MemberRepoDayStatMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMemberRepoDayStat,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMemberRepoDayStatID sets the ID field of the mutation.
+func withMemberRepoDayStatID(id int) memberrepodaystatOption {
+	return func(m *MemberRepoDayStatMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MemberRepoDayStat
+		)
+		m.oldValue = func(ctx context.Context) (*MemberRepoDayStat, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MemberRepoDayStat.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMemberRepoDayStat sets the old MemberRepoDayStat of the mutation.
+func withMemberRepoDayStat(node *MemberRepoDayStat) memberrepodaystatOption {
+	return func(m *MemberRepoDayStatMutation) {
+		m.oldValue = func(context.Context) (*MemberRepoDayStat, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MemberRepoDayStatMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MemberRepoDayStatMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MemberRepoDayStatMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MemberRepoDayStatMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MemberRepoDayStat.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetLogin sets the "login" field.
+func (m *MemberRepoDayStatMutation) SetLogin(s string) {
+	m.login = &s
+}
+
+// Login returns the value of the "login" field in the mutation.
+func (m *MemberRepoDayStatMutation) Login() (r string, exists bool) {
+	v := m.login
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLogin returns the old "login" field's value of the MemberRepoDayStat entity.
+// If the MemberRepoDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoDayStatMutation) OldLogin(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLogin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLogin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLogin: %w", err)
+	}
+	return oldValue.Login, nil
+}
+
+// ResetLogin resets all changes to the "login" field.
+func (m *MemberRepoDayStatMutation) ResetLogin() {
+	m.login = nil
+}
+
+// SetNameWithOwner sets the "name_with_owner" field.
+func (m *MemberRepoDayStatMutation) SetNameWithOwner(s string) {
+	m.name_with_owner = &s
+}
+
+// NameWithOwner returns the value of the "name_with_owner" field in the mutation.
+func (m *MemberRepoDayStatMutation) NameWithOwner() (r string, exists bool) {
+	v := m.name_with_owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNameWithOwner returns the old "name_with_owner" field's value of the MemberRepoDayStat entity.
+// If the MemberRepoDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoDayStatMutation) OldNameWithOwner(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNameWithOwner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNameWithOwner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNameWithOwner: %w", err)
+	}
+	return oldValue.NameWithOwner, nil
+}
+
+// ResetNameWithOwner resets all changes to the "name_with_owner" field.
+func (m *MemberRepoDayStatMutation) ResetNameWithOwner() {
+	m.name_with_owner = nil
+}
+
+// SetDay sets the "day" field.
+func (m *MemberRepoDayStatMutation) SetDay(s string) {
+	m.day = &s
+}
+
+// Day returns the value of the "day" field in the mutation.
+func (m *MemberRepoDayStatMutation) Day() (r string, exists bool) {
+	v := m.day
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDay returns the old "day" field's value of the MemberRepoDayStat entity.
+// If the MemberRepoDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoDayStatMutation) OldDay(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDay is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDay requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDay: %w", err)
+	}
+	return oldValue.Day, nil
+}
+
+// ResetDay resets all changes to the "day" field.
+func (m *MemberRepoDayStatMutation) ResetDay() {
+	m.day = nil
+}
+
+// SetCommitCount sets the "commit_count" field.
+func (m *MemberRepoDayStatMutation) SetCommitCount(i int) {
+	m.commit_count = &i
+	m.addcommit_count = nil
+}
+
+// CommitCount returns the value of the "commit_count" field in the mutation.
+func (m *MemberRepoDayStatMutation) CommitCount() (r int, exists bool) {
+	v := m.commit_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCommitCount returns the old "commit_count" field's value of the MemberRepoDayStat entity.
+// If the MemberRepoDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoDayStatMutation) OldCommitCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCommitCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCommitCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCommitCount: %w", err)
+	}
+	return oldValue.CommitCount, nil
+}
+
+// AddCommitCount adds i to the "commit_count" field.
+func (m *MemberRepoDayStatMutation) AddCommitCount(i int) {
+	if m.addcommit_count != nil {
+		*m.addcommit_count += i
+	} else {
+		m.addcommit_count = &i
+	}
+}
+
+// AddedCommitCount returns the value that was added to the "commit_count" field in this mutation.
+func (m *MemberRepoDayStatMutation) AddedCommitCount() (r int, exists bool) {
+	v := m.addcommit_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCommitCount resets all changes to the "commit_count" field.
+func (m *MemberRepoDayStatMutation) ResetCommitCount() {
+	m.commit_count = nil
+	m.addcommit_count = nil
+}
+
+// SetPrCreated sets the "pr_created" field.
+func (m *MemberRepoDayStatMutation) SetPrCreated(i int) {
+	m.pr_created = &i
+	m.addpr_created = nil
+}
+
+// PrCreated returns the value of the "pr_created" field in the mutation.
+func (m *MemberRepoDayStatMutation) PrCreated() (r int, exists bool) {
+	v := m.pr_created
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrCreated returns the old "pr_created" field's value of the MemberRepoDayStat entity.
+// If the MemberRepoDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoDayStatMutation) OldPrCreated(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrCreated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrCreated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrCreated: %w", err)
+	}
+	return oldValue.PrCreated, nil
+}
+
+// AddPrCreated adds i to the "pr_created" field.
+func (m *MemberRepoDayStatMutation) AddPrCreated(i int) {
+	if m.addpr_created != nil {
+		*m.addpr_created += i
+	} else {
+		m.addpr_created = &i
+	}
+}
+
+// AddedPrCreated returns the value that was added to the "pr_created" field in this mutation.
+func (m *MemberRepoDayStatMutation) AddedPrCreated() (r int, exists bool) {
+	v := m.addpr_created
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPrCreated resets all changes to the "pr_created" field.
+func (m *MemberRepoDayStatMutation) ResetPrCreated() {
+	m.pr_created = nil
+	m.addpr_created = nil
+}
+
+// SetPrMerged sets the "pr_merged" field.
+func (m *MemberRepoDayStatMutation) SetPrMerged(i int) {
+	m.pr_merged = &i
+	m.addpr_merged = nil
+}
+
+// PrMerged returns the value of the "pr_merged" field in the mutation.
+func (m *MemberRepoDayStatMutation) PrMerged() (r int, exists bool) {
+	v := m.pr_merged
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrMerged returns the old "pr_merged" field's value of the MemberRepoDayStat entity.
+// If the MemberRepoDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoDayStatMutation) OldPrMerged(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrMerged is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrMerged requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrMerged: %w", err)
+	}
+	return oldValue.PrMerged, nil
+}
+
+// AddPrMerged adds i to the "pr_merged" field.
+func (m *MemberRepoDayStatMutation) AddPrMerged(i int) {
+	if m.addpr_merged != nil {
+		*m.addpr_merged += i
+	} else {
+		m.addpr_merged = &i
+	}
+}
+
+// AddedPrMerged returns the value that was added to the "pr_merged" field in this mutation.
+func (m *MemberRepoDayStatMutation) AddedPrMerged() (r int, exists bool) {
+	v := m.addpr_merged
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPrMerged resets all changes to the "pr_merged" field.
+func (m *MemberRepoDayStatMutation) ResetPrMerged() {
+	m.pr_merged = nil
+	m.addpr_merged = nil
+}
+
+// SetIssueCount sets the "issue_count" field.
+func (m *MemberRepoDayStatMutation) SetIssueCount(i int) {
+	m.issue_count = &i
+	m.addissue_count = nil
+}
+
+// IssueCount returns the value of the "issue_count" field in the mutation.
+func (m *MemberRepoDayStatMutation) IssueCount() (r int, exists bool) {
+	v := m.issue_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIssueCount returns the old "issue_count" field's value of the MemberRepoDayStat entity.
+// If the MemberRepoDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoDayStatMutation) OldIssueCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIssueCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIssueCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIssueCount: %w", err)
+	}
+	return oldValue.IssueCount, nil
+}
+
+// AddIssueCount adds i to the "issue_count" field.
+func (m *MemberRepoDayStatMutation) AddIssueCount(i int) {
+	if m.addissue_count != nil {
+		*m.addissue_count += i
+	} else {
+		m.addissue_count = &i
+	}
+}
+
+// AddedIssueCount returns the value that was added to the "issue_count" field in this mutation.
+func (m *MemberRepoDayStatMutation) AddedIssueCount() (r int, exists bool) {
+	v := m.addissue_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetIssueCount resets all changes to the "issue_count" field.
+func (m *MemberRepoDayStatMutation) ResetIssueCount() {
+	m.issue_count = nil
+	m.addissue_count = nil
+}
+
+// SetReviewCount sets the "review_count" field.
+func (m *MemberRepoDayStatMutation) SetReviewCount(i int) {
+	m.review_count = &i
+	m.addreview_count = nil
+}
+
+// ReviewCount returns the value of the "review_count" field in the mutation.
+func (m *MemberRepoDayStatMutation) ReviewCount() (r int, exists bool) {
+	v := m.review_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewCount returns the old "review_count" field's value of the MemberRepoDayStat entity.
+// If the MemberRepoDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoDayStatMutation) OldReviewCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewCount: %w", err)
+	}
+	return oldValue.ReviewCount, nil
+}
+
+// AddReviewCount adds i to the "review_count" field.
+func (m *MemberRepoDayStatMutation) AddReviewCount(i int) {
+	if m.addreview_count != nil {
+		*m.addreview_count += i
+	} else {
+		m.addreview_count = &i
+	}
+}
+
+// AddedReviewCount returns the value that was added to the "review_count" field in this mutation.
+func (m *MemberRepoDayStatMutation) AddedReviewCount() (r int, exists bool) {
+	v := m.addreview_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetReviewCount resets all changes to the "review_count" field.
+func (m *MemberRepoDayStatMutation) ResetReviewCount() {
+	m.review_count = nil
+	m.addreview_count = nil
+}
+
+// SetAdditions sets the "additions" field.
+func (m *MemberRepoDayStatMutation) SetAdditions(i int) {
+	m.additions = &i
+	m.addadditions = nil
+}
+
+// Additions returns the value of the "additions" field in the mutation.
+func (m *MemberRepoDayStatMutation) Additions() (r int, exists bool) {
+	v := m.additions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAdditions returns the old "additions" field's value of the MemberRepoDayStat entity.
+// If the MemberRepoDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoDayStatMutation) OldAdditions(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAdditions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAdditions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAdditions: %w", err)
+	}
+	return oldValue.Additions, nil
+}
+
+// AddAdditions adds i to the "additions" field.
+func (m *MemberRepoDayStatMutation) AddAdditions(i int) {
+	if m.addadditions != nil {
+		*m.addadditions += i
+	} else {
+		m.addadditions = &i
+	}
+}
+
+// AddedAdditions returns the value that was added to the "additions" field in this mutation.
+func (m *MemberRepoDayStatMutation) AddedAdditions() (r int, exists bool) {
+	v := m.addadditions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAdditions resets all changes to the "additions" field.
+func (m *MemberRepoDayStatMutation) ResetAdditions() {
+	m.additions = nil
+	m.addadditions = nil
+}
+
+// SetDeletions sets the "deletions" field.
+func (m *MemberRepoDayStatMutation) SetDeletions(i int) {
+	m.deletions = &i
+	m.adddeletions = nil
+}
+
+// Deletions returns the value of the "deletions" field in the mutation.
+func (m *MemberRepoDayStatMutation) Deletions() (r int, exists bool) {
+	v := m.deletions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletions returns the old "deletions" field's value of the MemberRepoDayStat entity.
+// If the MemberRepoDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoDayStatMutation) OldDeletions(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletions: %w", err)
+	}
+	return oldValue.Deletions, nil
+}
+
+// AddDeletions adds i to the "deletions" field.
+func (m *MemberRepoDayStatMutation) AddDeletions(i int) {
+	if m.adddeletions != nil {
+		*m.adddeletions += i
+	} else {
+		m.adddeletions = &i
+	}
+}
+
+// AddedDeletions returns the value that was added to the "deletions" field in this mutation.
+func (m *MemberRepoDayStatMutation) AddedDeletions() (r int, exists bool) {
+	v := m.adddeletions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDeletions resets all changes to the "deletions" field.
+func (m *MemberRepoDayStatMutation) ResetDeletions() {
+	m.deletions = nil
+	m.adddeletions = nil
+}
+
+// SetIssuesClosed sets the "issues_closed" field.
+func (m *MemberRepoDayStatMutation) SetIssuesClosed(i int) {
+	m.issues_closed = &i
+	m.addissues_closed = nil
+}
+
+// IssuesClosed returns the value of the "issues_closed" field in the mutation.
+func (m *MemberRepoDayStatMutation) IssuesClosed() (r int, exists bool) {
+	v := m.issues_closed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIssuesClosed returns the old "issues_closed" field's value of the MemberRepoDayStat entity.
+// If the MemberRepoDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoDayStatMutation) OldIssuesClosed(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIssuesClosed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIssuesClosed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIssuesClosed: %w", err)
+	}
+	return oldValue.IssuesClosed, nil
+}
+
+// AddIssuesClosed adds i to the "issues_closed" field.
+func (m *MemberRepoDayStatMutation) AddIssuesClosed(i int) {
+	if m.addissues_closed != nil {
+		*m.addissues_closed += i
+	} else {
+		m.addissues_closed = &i
+	}
+}
+
+// AddedIssuesClosed returns the value that was added to the "issues_closed" field in this mutation.
+func (m *MemberRepoDayStatMutation) AddedIssuesClosed() (r int, exists bool) {
+	v := m.addissues_closed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetIssuesClosed resets all changes to the "issues_closed" field.
+func (m *MemberRepoDayStatMutation) ResetIssuesClosed() {
+	m.issues_closed = nil
+	m.addissues_closed = nil
+}
+
+// SetIssueCloseSeconds sets the "issue_close_seconds" field.
+func (m *MemberRepoDayStatMutation) SetIssueCloseSeconds(i int) {
+	m.issue_close_seconds = &i
+	m.addissue_close_seconds = nil
+}
+
+// IssueCloseSeconds returns the value of the "issue_close_seconds" field in the mutation.
+func (m *MemberRepoDayStatMutation) IssueCloseSeconds() (r int, exists bool) {
+	v := m.issue_close_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIssueCloseSeconds returns the old "issue_close_seconds" field's value of the MemberRepoDayStat entity.
+// If the MemberRepoDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoDayStatMutation) OldIssueCloseSeconds(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIssueCloseSeconds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIssueCloseSeconds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIssueCloseSeconds: %w", err)
+	}
+	return oldValue.IssueCloseSeconds, nil
+}
+
+// AddIssueCloseSeconds adds i to the "issue_close_seconds" field.
+func (m *MemberRepoDayStatMutation) AddIssueCloseSeconds(i int) {
+	if m.addissue_close_seconds != nil {
+		*m.addissue_close_seconds += i
+	} else {
+		m.addissue_close_seconds = &i
+	}
+}
+
+// AddedIssueCloseSeconds returns the value that was added to the "issue_close_seconds" field in this mutation.
+func (m *MemberRepoDayStatMutation) AddedIssueCloseSeconds() (r int, exists bool) {
+	v := m.addissue_close_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetIssueCloseSeconds resets all changes to the "issue_close_seconds" field.
+func (m *MemberRepoDayStatMutation) ResetIssueCloseSeconds() {
+	m.issue_close_seconds = nil
+	m.addissue_close_seconds = nil
+}
+
+// SetBugIssuesOpened sets the "bug_issues_opened" field.
+func (m *MemberRepoDayStatMutation) SetBugIssuesOpened(i int) {
+	m.bug_issues_opened = &i
+	m.addbug_issues_opened = nil
+}
+
+// BugIssuesOpened returns the value of the "bug_issues_opened" field in the mutation.
+func (m *MemberRepoDayStatMutation) BugIssuesOpened() (r int, exists bool) {
+	v := m.bug_issues_opened
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBugIssuesOpened returns the old "bug_issues_opened" field's value of the MemberRepoDayStat entity.
+// If the MemberRepoDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoDayStatMutation) OldBugIssuesOpened(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBugIssuesOpened is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBugIssuesOpened requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBugIssuesOpened: %w", err)
+	}
+	return oldValue.BugIssuesOpened, nil
+}
+
+// AddBugIssuesOpened adds i to the "bug_issues_opened" field.
+func (m *MemberRepoDayStatMutation) AddBugIssuesOpened(i int) {
+	if m.addbug_issues_opened != nil {
+		*m.addbug_issues_opened += i
+	} else {
+		m.addbug_issues_opened = &i
+	}
+}
+
+// AddedBugIssuesOpened returns the value that was added to the "bug_issues_opened" field in this mutation.
+func (m *MemberRepoDayStatMutation) AddedBugIssuesOpened() (r int, exists bool) {
+	v := m.addbug_issues_opened
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBugIssuesOpened resets all changes to the "bug_issues_opened" field.
+func (m *MemberRepoDayStatMutation) ResetBugIssuesOpened() {
+	m.bug_issues_opened = nil
+	m.addbug_issues_opened = nil
+}
+
+// SetFeatureIssuesOpened sets the "feature_issues_opened" field.
+func (m *MemberRepoDayStatMutation) SetFeatureIssuesOpened(i int) {
+	m.feature_issues_opened = &i
+	m.addfeature_issues_opened = nil
+}
+
+// FeatureIssuesOpened returns the value of the "feature_issues_opened" field in the mutation.
+func (m *MemberRepoDayStatMutation) FeatureIssuesOpened() (r int, exists bool) {
+	v := m.feature_issues_opened
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFeatureIssuesOpened returns the old "feature_issues_opened" field's value of the MemberRepoDayStat entity.
+// If the MemberRepoDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoDayStatMutation) OldFeatureIssuesOpened(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFeatureIssuesOpened is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFeatureIssuesOpened requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFeatureIssuesOpened: %w", err)
+	}
+	return oldValue.FeatureIssuesOpened, nil
+}
+
+// AddFeatureIssuesOpened adds i to the "feature_issues_opened" field.
+func (m *MemberRepoDayStatMutation) AddFeatureIssuesOpened(i int) {
+	if m.addfeature_issues_opened != nil {
+		*m.addfeature_issues_opened += i
+	} else {
+		m.addfeature_issues_opened = &i
+	}
+}
+
+// AddedFeatureIssuesOpened returns the value that was added to the "feature_issues_opened" field in this mutation.
+func (m *MemberRepoDayStatMutation) AddedFeatureIssuesOpened() (r int, exists bool) {
+	v := m.addfeature_issues_opened
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFeatureIssuesOpened resets all changes to the "feature_issues_opened" field.
+func (m *MemberRepoDayStatMutation) ResetFeatureIssuesOpened() {
+	m.feature_issues_opened = nil
+	m.addfeature_issues_opened = nil
+}
+
+// SetBugIssuesClosed sets the "bug_issues_closed" field.
+func (m *MemberRepoDayStatMutation) SetBugIssuesClosed(i int) {
+	m.bug_issues_closed = &i
+	m.addbug_issues_closed = nil
+}
+
+// BugIssuesClosed returns the value of the "bug_issues_closed" field in the mutation.
+func (m *MemberRepoDayStatMutation) BugIssuesClosed() (r int, exists bool) {
+	v := m.bug_issues_closed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBugIssuesClosed returns the old "bug_issues_closed" field's value of the MemberRepoDayStat entity.
+// If the MemberRepoDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoDayStatMutation) OldBugIssuesClosed(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBugIssuesClosed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBugIssuesClosed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBugIssuesClosed: %w", err)
+	}
+	return oldValue.BugIssuesClosed, nil
+}
+
+// AddBugIssuesClosed adds i to the "bug_issues_closed" field.
+func (m *MemberRepoDayStatMutation) AddBugIssuesClosed(i int) {
+	if m.addbug_issues_closed != nil {
+		*m.addbug_issues_closed += i
+	} else {
+		m.addbug_issues_closed = &i
+	}
+}
+
+// AddedBugIssuesClosed returns the value that was added to the "bug_issues_closed" field in this mutation.
+func (m *MemberRepoDayStatMutation) AddedBugIssuesClosed() (r int, exists bool) {
+	v := m.addbug_issues_closed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBugIssuesClosed resets all changes to the "bug_issues_closed" field.
+func (m *MemberRepoDayStatMutation) ResetBugIssuesClosed() {
+	m.bug_issues_closed = nil
+	m.addbug_issues_closed = nil
+}
+
+// SetFeatureIssuesClosed sets the "feature_issues_closed" field.
+func (m *MemberRepoDayStatMutation) SetFeatureIssuesClosed(i int) {
+	m.feature_issues_closed = &i
+	m.addfeature_issues_closed = nil
+}
+
+// FeatureIssuesClosed returns the value of the "feature_issues_closed" field in the mutation.
+func (m *MemberRepoDayStatMutation) FeatureIssuesClosed() (r int, exists bool) {
+	v := m.feature_issues_closed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFeatureIssuesClosed returns the old "feature_issues_closed" field's value of the MemberRepoDayStat entity.
+// If the MemberRepoDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoDayStatMutation) OldFeatureIssuesClosed(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFeatureIssuesClosed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFeatureIssuesClosed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFeatureIssuesClosed: %w", err)
+	}
+	return oldValue.FeatureIssuesClosed, nil
+}
+
+// AddFeatureIssuesClosed adds i to the "feature_issues_closed" field.
+func (m *MemberRepoDayStatMutation) AddFeatureIssuesClosed(i int) {
+	if m.addfeature_issues_closed != nil {
+		*m.addfeature_issues_closed += i
+	} else {
+		m.addfeature_issues_closed = &i
+	}
+}
+
+// AddedFeatureIssuesClosed returns the value that was added to the "feature_issues_closed" field in this mutation.
+func (m *MemberRepoDayStatMutation) AddedFeatureIssuesClosed() (r int, exists bool) {
+	v := m.addfeature_issues_closed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFeatureIssuesClosed resets all changes to the "feature_issues_closed" field.
+func (m *MemberRepoDayStatMutation) ResetFeatureIssuesClosed() {
+	m.feature_issues_closed = nil
+	m.addfeature_issues_closed = nil
+}
+
+// SetFeaturePrs sets the "feature_prs" field.
+func (m *MemberRepoDayStatMutation) SetFeaturePrs(i int) {
+	m.feature_prs = &i
+	m.addfeature_prs = nil
+}
+
+// FeaturePrs returns the value of the "feature_prs" field in the mutation.
+func (m *MemberRepoDayStatMutation) FeaturePrs() (r int, exists bool) {
+	v := m.feature_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFeaturePrs returns the old "feature_prs" field's value of the MemberRepoDayStat entity.
+// If the MemberRepoDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoDayStatMutation) OldFeaturePrs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFeaturePrs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFeaturePrs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFeaturePrs: %w", err)
+	}
+	return oldValue.FeaturePrs, nil
+}
+
+// AddFeaturePrs adds i to the "feature_prs" field.
+func (m *MemberRepoDayStatMutation) AddFeaturePrs(i int) {
+	if m.addfeature_prs != nil {
+		*m.addfeature_prs += i
+	} else {
+		m.addfeature_prs = &i
+	}
+}
+
+// AddedFeaturePrs returns the value that was added to the "feature_prs" field in this mutation.
+func (m *MemberRepoDayStatMutation) AddedFeaturePrs() (r int, exists bool) {
+	v := m.addfeature_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFeaturePrs resets all changes to the "feature_prs" field.
+func (m *MemberRepoDayStatMutation) ResetFeaturePrs() {
+	m.feature_prs = nil
+	m.addfeature_prs = nil
+}
+
+// SetBugPrs sets the "bug_prs" field.
+func (m *MemberRepoDayStatMutation) SetBugPrs(i int) {
+	m.bug_prs = &i
+	m.addbug_prs = nil
+}
+
+// BugPrs returns the value of the "bug_prs" field in the mutation.
+func (m *MemberRepoDayStatMutation) BugPrs() (r int, exists bool) {
+	v := m.bug_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBugPrs returns the old "bug_prs" field's value of the MemberRepoDayStat entity.
+// If the MemberRepoDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoDayStatMutation) OldBugPrs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBugPrs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBugPrs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBugPrs: %w", err)
+	}
+	return oldValue.BugPrs, nil
+}
+
+// AddBugPrs adds i to the "bug_prs" field.
+func (m *MemberRepoDayStatMutation) AddBugPrs(i int) {
+	if m.addbug_prs != nil {
+		*m.addbug_prs += i
+	} else {
+		m.addbug_prs = &i
+	}
+}
+
+// AddedBugPrs returns the value that was added to the "bug_prs" field in this mutation.
+func (m *MemberRepoDayStatMutation) AddedBugPrs() (r int, exists bool) {
+	v := m.addbug_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBugPrs resets all changes to the "bug_prs" field.
+func (m *MemberRepoDayStatMutation) ResetBugPrs() {
+	m.bug_prs = nil
+	m.addbug_prs = nil
+}
+
+// SetChorePrs sets the "chore_prs" field.
+func (m *MemberRepoDayStatMutation) SetChorePrs(i int) {
+	m.chore_prs = &i
+	m.addchore_prs = nil
+}
+
+// ChorePrs returns the value of the "chore_prs" field in the mutation.
+func (m *MemberRepoDayStatMutation) ChorePrs() (r int, exists bool) {
+	v := m.chore_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChorePrs returns the old "chore_prs" field's value of the MemberRepoDayStat entity.
+// If the MemberRepoDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoDayStatMutation) OldChorePrs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChorePrs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChorePrs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChorePrs: %w", err)
+	}
+	return oldValue.ChorePrs, nil
+}
+
+// AddChorePrs adds i to the "chore_prs" field.
+func (m *MemberRepoDayStatMutation) AddChorePrs(i int) {
+	if m.addchore_prs != nil {
+		*m.addchore_prs += i
+	} else {
+		m.addchore_prs = &i
+	}
+}
+
+// AddedChorePrs returns the value that was added to the "chore_prs" field in this mutation.
+func (m *MemberRepoDayStatMutation) AddedChorePrs() (r int, exists bool) {
+	v := m.addchore_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetChorePrs resets all changes to the "chore_prs" field.
+func (m *MemberRepoDayStatMutation) ResetChorePrs() {
+	m.chore_prs = nil
+	m.addchore_prs = nil
+}
+
+// SetDocsPrs sets the "docs_prs" field.
+func (m *MemberRepoDayStatMutation) SetDocsPrs(i int) {
+	m.docs_prs = &i
+	m.adddocs_prs = nil
+}
+
+// DocsPrs returns the value of the "docs_prs" field in the mutation.
+func (m *MemberRepoDayStatMutation) DocsPrs() (r int, exists bool) {
+	v := m.docs_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDocsPrs returns the old "docs_prs" field's value of the MemberRepoDayStat entity.
+// If the MemberRepoDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoDayStatMutation) OldDocsPrs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDocsPrs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDocsPrs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDocsPrs: %w", err)
+	}
+	return oldValue.DocsPrs, nil
+}
+
+// AddDocsPrs adds i to the "docs_prs" field.
+func (m *MemberRepoDayStatMutation) AddDocsPrs(i int) {
+	if m.adddocs_prs != nil {
+		*m.adddocs_prs += i
+	} else {
+		m.adddocs_prs = &i
+	}
+}
+
+// AddedDocsPrs returns the value that was added to the "docs_prs" field in this mutation.
+func (m *MemberRepoDayStatMutation) AddedDocsPrs() (r int, exists bool) {
+	v := m.adddocs_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDocsPrs resets all changes to the "docs_prs" field.
+func (m *MemberRepoDayStatMutation) ResetDocsPrs() {
+	m.docs_prs = nil
+	m.adddocs_prs = nil
+}
+
+// SetTestPrs sets the "test_prs" field.
+func (m *MemberRepoDayStatMutation) SetTestPrs(i int) {
+	m.test_prs = &i
+	m.addtest_prs = nil
+}
+
+// TestPrs returns the value of the "test_prs" field in the mutation.
+func (m *MemberRepoDayStatMutation) TestPrs() (r int, exists bool) {
+	v := m.test_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTestPrs returns the old "test_prs" field's value of the MemberRepoDayStat entity.
+// If the MemberRepoDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoDayStatMutation) OldTestPrs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTestPrs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTestPrs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTestPrs: %w", err)
+	}
+	return oldValue.TestPrs, nil
+}
+
+// AddTestPrs adds i to the "test_prs" field.
+func (m *MemberRepoDayStatMutation) AddTestPrs(i int) {
+	if m.addtest_prs != nil {
+		*m.addtest_prs += i
+	} else {
+		m.addtest_prs = &i
+	}
+}
+
+// AddedTestPrs returns the value that was added to the "test_prs" field in this mutation.
+func (m *MemberRepoDayStatMutation) AddedTestPrs() (r int, exists bool) {
+	v := m.addtest_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTestPrs resets all changes to the "test_prs" field.
+func (m *MemberRepoDayStatMutation) ResetTestPrs() {
+	m.test_prs = nil
+	m.addtest_prs = nil
+}
+
+// SetInfraPrs sets the "infra_prs" field.
+func (m *MemberRepoDayStatMutation) SetInfraPrs(i int) {
+	m.infra_prs = &i
+	m.addinfra_prs = nil
+}
+
+// InfraPrs returns the value of the "infra_prs" field in the mutation.
+func (m *MemberRepoDayStatMutation) InfraPrs() (r int, exists bool) {
+	v := m.infra_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInfraPrs returns the old "infra_prs" field's value of the MemberRepoDayStat entity.
+// If the MemberRepoDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoDayStatMutation) OldInfraPrs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInfraPrs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInfraPrs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInfraPrs: %w", err)
+	}
+	return oldValue.InfraPrs, nil
+}
+
+// AddInfraPrs adds i to the "infra_prs" field.
+func (m *MemberRepoDayStatMutation) AddInfraPrs(i int) {
+	if m.addinfra_prs != nil {
+		*m.addinfra_prs += i
+	} else {
+		m.addinfra_prs = &i
+	}
+}
+
+// AddedInfraPrs returns the value that was added to the "infra_prs" field in this mutation.
+func (m *MemberRepoDayStatMutation) AddedInfraPrs() (r int, exists bool) {
+	v := m.addinfra_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetInfraPrs resets all changes to the "infra_prs" field.
+func (m *MemberRepoDayStatMutation) ResetInfraPrs() {
+	m.infra_prs = nil
+	m.addinfra_prs = nil
+}
+
+// SetOtherPrs sets the "other_prs" field.
+func (m *MemberRepoDayStatMutation) SetOtherPrs(i int) {
+	m.other_prs = &i
+	m.addother_prs = nil
+}
+
+// OtherPrs returns the value of the "other_prs" field in the mutation.
+func (m *MemberRepoDayStatMutation) OtherPrs() (r int, exists bool) {
+	v := m.other_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOtherPrs returns the old "other_prs" field's value of the MemberRepoDayStat entity.
+// If the MemberRepoDayStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoDayStatMutation) OldOtherPrs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOtherPrs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOtherPrs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOtherPrs: %w", err)
+	}
+	return oldValue.OtherPrs, nil
+}
+
+// AddOtherPrs adds i to the "other_prs" field.
+func (m *MemberRepoDayStatMutation) AddOtherPrs(i int) {
+	if m.addother_prs != nil {
+		*m.addother_prs += i
+	} else {
+		m.addother_prs = &i
+	}
+}
+
+// AddedOtherPrs returns the value that was added to the "other_prs" field in this mutation.
+func (m *MemberRepoDayStatMutation) AddedOtherPrs() (r int, exists bool) {
+	v := m.addother_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetOtherPrs resets all changes to the "other_prs" field.
+func (m *MemberRepoDayStatMutation) ResetOtherPrs() {
+	m.other_prs = nil
+	m.addother_prs = nil
+}
+
+// SetSnapshotID sets the "snapshot" edge to the Snapshot entity by id.
+func (m *MemberRepoDayStatMutation) SetSnapshotID(id int) {
+	m.snapshot = &id
+}
+
+// ClearSnapshot clears the "snapshot" edge to the Snapshot entity.
+func (m *MemberRepoDayStatMutation) ClearSnapshot() {
+	m.clearedsnapshot = true
+}
+
+// SnapshotCleared reports if the "snapshot" edge to the Snapshot entity was cleared.
+func (m *MemberRepoDayStatMutation) SnapshotCleared() bool {
+	return m.clearedsnapshot
+}
+
+// SnapshotID returns the "snapshot" edge ID in the mutation.
+func (m *MemberRepoDayStatMutation) SnapshotID() (id int, exists bool) {
+	if m.snapshot != nil {
+		return *m.snapshot, true
+	}
+	return
+}
+
+// SnapshotIDs returns the "snapshot" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SnapshotID instead. It exists only for internal usage by the builders.
+func (m *MemberRepoDayStatMutation) SnapshotIDs() (ids []int) {
+	if id := m.snapshot; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSnapshot resets all changes to the "snapshot" edge.
+func (m *MemberRepoDayStatMutation) ResetSnapshot() {
+	m.snapshot = nil
+	m.clearedsnapshot = false
+}
+
+// Where appends a list predicates to the MemberRepoDayStatMutation builder.
+func (m *MemberRepoDayStatMutation) Where(ps ...predicate.MemberRepoDayStat) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MemberRepoDayStatMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MemberRepoDayStatMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MemberRepoDayStat, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MemberRepoDayStatMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MemberRepoDayStatMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MemberRepoDayStat).
+func (m *MemberRepoDayStatMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MemberRepoDayStatMutation) Fields() []string {
+	fields := make([]string, 0, 23)
+	if m.login != nil {
+		fields = append(fields, memberrepodaystat.FieldLogin)
+	}
+	if m.name_with_owner != nil {
+		fields = append(fields, memberrepodaystat.FieldNameWithOwner)
+	}
+	if m.day != nil {
+		fields = append(fields, memberrepodaystat.FieldDay)
+	}
+	if m.commit_count != nil {
+		fields = append(fields, memberrepodaystat.FieldCommitCount)
+	}
+	if m.pr_created != nil {
+		fields = append(fields, memberrepodaystat.FieldPrCreated)
+	}
+	if m.pr_merged != nil {
+		fields = append(fields, memberrepodaystat.FieldPrMerged)
+	}
+	if m.issue_count != nil {
+		fields = append(fields, memberrepodaystat.FieldIssueCount)
+	}
+	if m.review_count != nil {
+		fields = append(fields, memberrepodaystat.FieldReviewCount)
+	}
+	if m.additions != nil {
+		fields = append(fields, memberrepodaystat.FieldAdditions)
+	}
+	if m.deletions != nil {
+		fields = append(fields, memberrepodaystat.FieldDeletions)
+	}
+	if m.issues_closed != nil {
+		fields = append(fields, memberrepodaystat.FieldIssuesClosed)
+	}
+	if m.issue_close_seconds != nil {
+		fields = append(fields, memberrepodaystat.FieldIssueCloseSeconds)
+	}
+	if m.bug_issues_opened != nil {
+		fields = append(fields, memberrepodaystat.FieldBugIssuesOpened)
+	}
+	if m.feature_issues_opened != nil {
+		fields = append(fields, memberrepodaystat.FieldFeatureIssuesOpened)
+	}
+	if m.bug_issues_closed != nil {
+		fields = append(fields, memberrepodaystat.FieldBugIssuesClosed)
+	}
+	if m.feature_issues_closed != nil {
+		fields = append(fields, memberrepodaystat.FieldFeatureIssuesClosed)
+	}
+	if m.feature_prs != nil {
+		fields = append(fields, memberrepodaystat.FieldFeaturePrs)
+	}
+	if m.bug_prs != nil {
+		fields = append(fields, memberrepodaystat.FieldBugPrs)
+	}
+	if m.chore_prs != nil {
+		fields = append(fields, memberrepodaystat.FieldChorePrs)
+	}
+	if m.docs_prs != nil {
+		fields = append(fields, memberrepodaystat.FieldDocsPrs)
+	}
+	if m.test_prs != nil {
+		fields = append(fields, memberrepodaystat.FieldTestPrs)
+	}
+	if m.infra_prs != nil {
+		fields = append(fields, memberrepodaystat.FieldInfraPrs)
+	}
+	if m.other_prs != nil {
+		fields = append(fields, memberrepodaystat.FieldOtherPrs)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MemberRepoDayStatMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case memberrepodaystat.FieldLogin:
+		return m.Login()
+	case memberrepodaystat.FieldNameWithOwner:
+		return m.NameWithOwner()
+	case memberrepodaystat.FieldDay:
+		return m.Day()
+	case memberrepodaystat.FieldCommitCount:
+		return m.CommitCount()
+	case memberrepodaystat.FieldPrCreated:
+		return m.PrCreated()
+	case memberrepodaystat.FieldPrMerged:
+		return m.PrMerged()
+	case memberrepodaystat.FieldIssueCount:
+		return m.IssueCount()
+	case memberrepodaystat.FieldReviewCount:
+		return m.ReviewCount()
+	case memberrepodaystat.FieldAdditions:
+		return m.Additions()
+	case memberrepodaystat.FieldDeletions:
+		return m.Deletions()
+	case memberrepodaystat.FieldIssuesClosed:
+		return m.IssuesClosed()
+	case memberrepodaystat.FieldIssueCloseSeconds:
+		return m.IssueCloseSeconds()
+	case memberrepodaystat.FieldBugIssuesOpened:
+		return m.BugIssuesOpened()
+	case memberrepodaystat.FieldFeatureIssuesOpened:
+		return m.FeatureIssuesOpened()
+	case memberrepodaystat.FieldBugIssuesClosed:
+		return m.BugIssuesClosed()
+	case memberrepodaystat.FieldFeatureIssuesClosed:
+		return m.FeatureIssuesClosed()
+	case memberrepodaystat.FieldFeaturePrs:
+		return m.FeaturePrs()
+	case memberrepodaystat.FieldBugPrs:
+		return m.BugPrs()
+	case memberrepodaystat.FieldChorePrs:
+		return m.ChorePrs()
+	case memberrepodaystat.FieldDocsPrs:
+		return m.DocsPrs()
+	case memberrepodaystat.FieldTestPrs:
+		return m.TestPrs()
+	case memberrepodaystat.FieldInfraPrs:
+		return m.InfraPrs()
+	case memberrepodaystat.FieldOtherPrs:
+		return m.OtherPrs()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MemberRepoDayStatMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case memberrepodaystat.FieldLogin:
+		return m.OldLogin(ctx)
+	case memberrepodaystat.FieldNameWithOwner:
+		return m.OldNameWithOwner(ctx)
+	case memberrepodaystat.FieldDay:
+		return m.OldDay(ctx)
+	case memberrepodaystat.FieldCommitCount:
+		return m.OldCommitCount(ctx)
+	case memberrepodaystat.FieldPrCreated:
+		return m.OldPrCreated(ctx)
+	case memberrepodaystat.FieldPrMerged:
+		return m.OldPrMerged(ctx)
+	case memberrepodaystat.FieldIssueCount:
+		return m.OldIssueCount(ctx)
+	case memberrepodaystat.FieldReviewCount:
+		return m.OldReviewCount(ctx)
+	case memberrepodaystat.FieldAdditions:
+		return m.OldAdditions(ctx)
+	case memberrepodaystat.FieldDeletions:
+		return m.OldDeletions(ctx)
+	case memberrepodaystat.FieldIssuesClosed:
+		return m.OldIssuesClosed(ctx)
+	case memberrepodaystat.FieldIssueCloseSeconds:
+		return m.OldIssueCloseSeconds(ctx)
+	case memberrepodaystat.FieldBugIssuesOpened:
+		return m.OldBugIssuesOpened(ctx)
+	case memberrepodaystat.FieldFeatureIssuesOpened:
+		return m.OldFeatureIssuesOpened(ctx)
+	case memberrepodaystat.FieldBugIssuesClosed:
+		return m.OldBugIssuesClosed(ctx)
+	case memberrepodaystat.FieldFeatureIssuesClosed:
+		return m.OldFeatureIssuesClosed(ctx)
+	case memberrepodaystat.FieldFeaturePrs:
+		return m.OldFeaturePrs(ctx)
+	case memberrepodaystat.FieldBugPrs:
+		return m.OldBugPrs(ctx)
+	case memberrepodaystat.FieldChorePrs:
+		return m.OldChorePrs(ctx)
+	case memberrepodaystat.FieldDocsPrs:
+		return m.OldDocsPrs(ctx)
+	case memberrepodaystat.FieldTestPrs:
+		return m.OldTestPrs(ctx)
+	case memberrepodaystat.FieldInfraPrs:
+		return m.OldInfraPrs(ctx)
+	case memberrepodaystat.FieldOtherPrs:
+		return m.OldOtherPrs(ctx)
+	}
+	return nil, fmt.Errorf("unknown MemberRepoDayStat field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MemberRepoDayStatMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case memberrepodaystat.FieldLogin:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLogin(v)
+		return nil
+	case memberrepodaystat.FieldNameWithOwner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNameWithOwner(v)
+		return nil
+	case memberrepodaystat.FieldDay:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDay(v)
+		return nil
+	case memberrepodaystat.FieldCommitCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCommitCount(v)
+		return nil
+	case memberrepodaystat.FieldPrCreated:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrCreated(v)
+		return nil
+	case memberrepodaystat.FieldPrMerged:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrMerged(v)
+		return nil
+	case memberrepodaystat.FieldIssueCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIssueCount(v)
+		return nil
+	case memberrepodaystat.FieldReviewCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewCount(v)
+		return nil
+	case memberrepodaystat.FieldAdditions:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAdditions(v)
+		return nil
+	case memberrepodaystat.FieldDeletions:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletions(v)
+		return nil
+	case memberrepodaystat.FieldIssuesClosed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIssuesClosed(v)
+		return nil
+	case memberrepodaystat.FieldIssueCloseSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIssueCloseSeconds(v)
+		return nil
+	case memberrepodaystat.FieldBugIssuesOpened:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBugIssuesOpened(v)
+		return nil
+	case memberrepodaystat.FieldFeatureIssuesOpened:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFeatureIssuesOpened(v)
+		return nil
+	case memberrepodaystat.FieldBugIssuesClosed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBugIssuesClosed(v)
+		return nil
+	case memberrepodaystat.FieldFeatureIssuesClosed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFeatureIssuesClosed(v)
+		return nil
+	case memberrepodaystat.FieldFeaturePrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFeaturePrs(v)
+		return nil
+	case memberrepodaystat.FieldBugPrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBugPrs(v)
+		return nil
+	case memberrepodaystat.FieldChorePrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChorePrs(v)
+		return nil
+	case memberrepodaystat.FieldDocsPrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDocsPrs(v)
+		return nil
+	case memberrepodaystat.FieldTestPrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTestPrs(v)
+		return nil
+	case memberrepodaystat.FieldInfraPrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInfraPrs(v)
+		return nil
+	case memberrepodaystat.FieldOtherPrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOtherPrs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MemberRepoDayStat field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MemberRepoDayStatMutation) AddedFields() []string {
+	var fields []string
+	if m.addcommit_count != nil {
+		fields = append(fields, memberrepodaystat.FieldCommitCount)
+	}
+	if m.addpr_created != nil {
+		fields = append(fields, memberrepodaystat.FieldPrCreated)
+	}
+	if m.addpr_merged != nil {
+		fields = append(fields, memberrepodaystat.FieldPrMerged)
+	}
+	if m.addissue_count != nil {
+		fields = append(fields, memberrepodaystat.FieldIssueCount)
+	}
+	if m.addreview_count != nil {
+		fields = append(fields, memberrepodaystat.FieldReviewCount)
+	}
+	if m.addadditions != nil {
+		fields = append(fields, memberrepodaystat.FieldAdditions)
+	}
+	if m.adddeletions != nil {
+		fields = append(fields, memberrepodaystat.FieldDeletions)
+	}
+	if m.addissues_closed != nil {
+		fields = append(fields, memberrepodaystat.FieldIssuesClosed)
+	}
+	if m.addissue_close_seconds != nil {
+		fields = append(fields, memberrepodaystat.FieldIssueCloseSeconds)
+	}
+	if m.addbug_issues_opened != nil {
+		fields = append(fields, memberrepodaystat.FieldBugIssuesOpened)
+	}
+	if m.addfeature_issues_opened != nil {
+		fields = append(fields, memberrepodaystat.FieldFeatureIssuesOpened)
+	}
+	if m.addbug_issues_closed != nil {
+		fields = append(fields, memberrepodaystat.FieldBugIssuesClosed)
+	}
+	if m.addfeature_issues_closed != nil {
+		fields = append(fields, memberrepodaystat.FieldFeatureIssuesClosed)
+	}
+	if m.addfeature_prs != nil {
+		fields = append(fields, memberrepodaystat.FieldFeaturePrs)
+	}
+	if m.addbug_prs != nil {
+		fields = append(fields, memberrepodaystat.FieldBugPrs)
+	}
+	if m.addchore_prs != nil {
+		fields = append(fields, memberrepodaystat.FieldChorePrs)
+	}
+	if m.adddocs_prs != nil {
+		fields = append(fields, memberrepodaystat.FieldDocsPrs)
+	}
+	if m.addtest_prs != nil {
+		fields = append(fields, memberrepodaystat.FieldTestPrs)
+	}
+	if m.addinfra_prs != nil {
+		fields = append(fields, memberrepodaystat.FieldInfraPrs)
+	}
+	if m.addother_prs != nil {
+		fields = append(fields, memberrepodaystat.FieldOtherPrs)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MemberRepoDayStatMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case memberrepodaystat.FieldCommitCount:
+		return m.AddedCommitCount()
+	case memberrepodaystat.FieldPrCreated:
+		return m.AddedPrCreated()
+	case memberrepodaystat.FieldPrMerged:
+		return m.AddedPrMerged()
+	case memberrepodaystat.FieldIssueCount:
+		return m.AddedIssueCount()
+	case memberrepodaystat.FieldReviewCount:
+		return m.AddedReviewCount()
+	case memberrepodaystat.FieldAdditions:
+		return m.AddedAdditions()
+	case memberrepodaystat.FieldDeletions:
+		return m.AddedDeletions()
+	case memberrepodaystat.FieldIssuesClosed:
+		return m.AddedIssuesClosed()
+	case memberrepodaystat.FieldIssueCloseSeconds:
+		return m.AddedIssueCloseSeconds()
+	case memberrepodaystat.FieldBugIssuesOpened:
+		return m.AddedBugIssuesOpened()
+	case memberrepodaystat.FieldFeatureIssuesOpened:
+		return m.AddedFeatureIssuesOpened()
+	case memberrepodaystat.FieldBugIssuesClosed:
+		return m.AddedBugIssuesClosed()
+	case memberrepodaystat.FieldFeatureIssuesClosed:
+		return m.AddedFeatureIssuesClosed()
+	case memberrepodaystat.FieldFeaturePrs:
+		return m.AddedFeaturePrs()
+	case memberrepodaystat.FieldBugPrs:
+		return m.AddedBugPrs()
+	case memberrepodaystat.FieldChorePrs:
+		return m.AddedChorePrs()
+	case memberrepodaystat.FieldDocsPrs:
+		return m.AddedDocsPrs()
+	case memberrepodaystat.FieldTestPrs:
+		return m.AddedTestPrs()
+	case memberrepodaystat.FieldInfraPrs:
+		return m.AddedInfraPrs()
+	case memberrepodaystat.FieldOtherPrs:
+		return m.AddedOtherPrs()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MemberRepoDayStatMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case memberrepodaystat.FieldCommitCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCommitCount(v)
+		return nil
+	case memberrepodaystat.FieldPrCreated:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPrCreated(v)
+		return nil
+	case memberrepodaystat.FieldPrMerged:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPrMerged(v)
+		return nil
+	case memberrepodaystat.FieldIssueCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIssueCount(v)
+		return nil
+	case memberrepodaystat.FieldReviewCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReviewCount(v)
+		return nil
+	case memberrepodaystat.FieldAdditions:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAdditions(v)
+		return nil
+	case memberrepodaystat.FieldDeletions:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDeletions(v)
+		return nil
+	case memberrepodaystat.FieldIssuesClosed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIssuesClosed(v)
+		return nil
+	case memberrepodaystat.FieldIssueCloseSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIssueCloseSeconds(v)
+		return nil
+	case memberrepodaystat.FieldBugIssuesOpened:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBugIssuesOpened(v)
+		return nil
+	case memberrepodaystat.FieldFeatureIssuesOpened:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFeatureIssuesOpened(v)
+		return nil
+	case memberrepodaystat.FieldBugIssuesClosed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBugIssuesClosed(v)
+		return nil
+	case memberrepodaystat.FieldFeatureIssuesClosed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFeatureIssuesClosed(v)
+		return nil
+	case memberrepodaystat.FieldFeaturePrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFeaturePrs(v)
+		return nil
+	case memberrepodaystat.FieldBugPrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBugPrs(v)
+		return nil
+	case memberrepodaystat.FieldChorePrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChorePrs(v)
+		return nil
+	case memberrepodaystat.FieldDocsPrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDocsPrs(v)
+		return nil
+	case memberrepodaystat.FieldTestPrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTestPrs(v)
+		return nil
+	case memberrepodaystat.FieldInfraPrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddInfraPrs(v)
+		return nil
+	case memberrepodaystat.FieldOtherPrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOtherPrs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MemberRepoDayStat numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MemberRepoDayStatMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MemberRepoDayStatMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MemberRepoDayStatMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MemberRepoDayStat nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MemberRepoDayStatMutation) ResetField(name string) error {
+	switch name {
+	case memberrepodaystat.FieldLogin:
+		m.ResetLogin()
+		return nil
+	case memberrepodaystat.FieldNameWithOwner:
+		m.ResetNameWithOwner()
+		return nil
+	case memberrepodaystat.FieldDay:
+		m.ResetDay()
+		return nil
+	case memberrepodaystat.FieldCommitCount:
+		m.ResetCommitCount()
+		return nil
+	case memberrepodaystat.FieldPrCreated:
+		m.ResetPrCreated()
+		return nil
+	case memberrepodaystat.FieldPrMerged:
+		m.ResetPrMerged()
+		return nil
+	case memberrepodaystat.FieldIssueCount:
+		m.ResetIssueCount()
+		return nil
+	case memberrepodaystat.FieldReviewCount:
+		m.ResetReviewCount()
+		return nil
+	case memberrepodaystat.FieldAdditions:
+		m.ResetAdditions()
+		return nil
+	case memberrepodaystat.FieldDeletions:
+		m.ResetDeletions()
+		return nil
+	case memberrepodaystat.FieldIssuesClosed:
+		m.ResetIssuesClosed()
+		return nil
+	case memberrepodaystat.FieldIssueCloseSeconds:
+		m.ResetIssueCloseSeconds()
+		return nil
+	case memberrepodaystat.FieldBugIssuesOpened:
+		m.ResetBugIssuesOpened()
+		return nil
+	case memberrepodaystat.FieldFeatureIssuesOpened:
+		m.ResetFeatureIssuesOpened()
+		return nil
+	case memberrepodaystat.FieldBugIssuesClosed:
+		m.ResetBugIssuesClosed()
+		return nil
+	case memberrepodaystat.FieldFeatureIssuesClosed:
+		m.ResetFeatureIssuesClosed()
+		return nil
+	case memberrepodaystat.FieldFeaturePrs:
+		m.ResetFeaturePrs()
+		return nil
+	case memberrepodaystat.FieldBugPrs:
+		m.ResetBugPrs()
+		return nil
+	case memberrepodaystat.FieldChorePrs:
+		m.ResetChorePrs()
+		return nil
+	case memberrepodaystat.FieldDocsPrs:
+		m.ResetDocsPrs()
+		return nil
+	case memberrepodaystat.FieldTestPrs:
+		m.ResetTestPrs()
+		return nil
+	case memberrepodaystat.FieldInfraPrs:
+		m.ResetInfraPrs()
+		return nil
+	case memberrepodaystat.FieldOtherPrs:
+		m.ResetOtherPrs()
+		return nil
+	}
+	return fmt.Errorf("unknown MemberRepoDayStat field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MemberRepoDayStatMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.snapshot != nil {
+		edges = append(edges, memberrepodaystat.EdgeSnapshot)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MemberRepoDayStatMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case memberrepodaystat.EdgeSnapshot:
+		if id := m.snapshot; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MemberRepoDayStatMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MemberRepoDayStatMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MemberRepoDayStatMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsnapshot {
+		edges = append(edges, memberrepodaystat.EdgeSnapshot)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MemberRepoDayStatMutation) EdgeCleared(name string) bool {
+	switch name {
+	case memberrepodaystat.EdgeSnapshot:
+		return m.clearedsnapshot
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MemberRepoDayStatMutation) ClearEdge(name string) error {
+	switch name {
+	case memberrepodaystat.EdgeSnapshot:
+		m.ClearSnapshot()
+		return nil
+	}
+	return fmt.Errorf("unknown MemberRepoDayStat unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MemberRepoDayStatMutation) ResetEdge(name string) error {
+	switch name {
+	case memberrepodaystat.EdgeSnapshot:
+		m.ResetSnapshot()
+		return nil
+	}
+	return fmt.Errorf("unknown MemberRepoDayStat edge %s", name)
+}
+
+// MemberRepoStatMutation represents an operation that mutates the MemberRepoStat nodes in the graph.
+type MemberRepoStatMutation struct {
+	config
+	op                       Op
+	typ                      string
+	id                       *int
+	login                    *string
+	name_with_owner          *string
+	commit_count             *int
+	addcommit_count          *int
+	pr_created               *int
+	addpr_created            *int
+	pr_merged                *int
+	addpr_merged             *int
+	issue_count              *int
+	addissue_count           *int
+	review_count             *int
+	addreview_count          *int
+	additions                *int
+	addadditions             *int
+	deletions                *int
+	adddeletions             *int
+	issues_closed            *int
+	addissues_closed         *int
+	issue_close_seconds      *int
+	addissue_close_seconds   *int
+	bug_issues_opened        *int
+	addbug_issues_opened     *int
+	feature_issues_opened    *int
+	addfeature_issues_opened *int
+	bug_issues_closed        *int
+	addbug_issues_closed     *int
+	feature_issues_closed    *int
+	addfeature_issues_closed *int
+	feature_prs              *int
+	addfeature_prs           *int
+	bug_prs                  *int
+	addbug_prs               *int
+	chore_prs                *int
+	addchore_prs             *int
+	docs_prs                 *int
+	adddocs_prs              *int
+	test_prs                 *int
+	addtest_prs              *int
+	infra_prs                *int
+	addinfra_prs             *int
+	other_prs                *int
+	addother_prs             *int
+	clearedFields            map[string]struct{}
+	snapshot                 *int
+	clearedsnapshot          bool
+	done                     bool
+	oldValue                 func(context.Context) (*MemberRepoStat, error)
+	predicates               []predicate.MemberRepoStat
+}
+
+var _ ent.Mutation = (*MemberRepoStatMutation)(nil)
+
+// memberrepostatOption allows management of the mutation configuration using functional options.
+type memberrepostatOption func(*MemberRepoStatMutation)
+
+// newMemberRepoStatMutation creates new mutation for the MemberRepoStat entity.
+func newMemberRepoStatMutation(c config, op Op, opts ...memberrepostatOption) *MemberRepoStatMutation {
+	m := &MemberRepoStatMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMemberRepoStat,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMemberRepoStatID sets the ID field of the mutation.
+func withMemberRepoStatID(id int) memberrepostatOption {
+	return func(m *MemberRepoStatMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MemberRepoStat
+		)
+		m.oldValue = func(ctx context.Context) (*MemberRepoStat, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MemberRepoStat.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMemberRepoStat sets the old MemberRepoStat of the mutation.
+func withMemberRepoStat(node *MemberRepoStat) memberrepostatOption {
+	return func(m *MemberRepoStatMutation) {
+		m.oldValue = func(context.Context) (*MemberRepoStat, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MemberRepoStatMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MemberRepoStatMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MemberRepoStatMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MemberRepoStatMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MemberRepoStat.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetLogin sets the "login" field.
+func (m *MemberRepoStatMutation) SetLogin(s string) {
+	m.login = &s
+}
+
+// Login returns the value of the "login" field in the mutation.
+func (m *MemberRepoStatMutation) Login() (r string, exists bool) {
+	v := m.login
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLogin returns the old "login" field's value of the MemberRepoStat entity.
+// If the MemberRepoStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoStatMutation) OldLogin(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLogin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLogin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLogin: %w", err)
+	}
+	return oldValue.Login, nil
+}
+
+// ResetLogin resets all changes to the "login" field.
+func (m *MemberRepoStatMutation) ResetLogin() {
+	m.login = nil
+}
+
+// SetNameWithOwner sets the "name_with_owner" field.
+func (m *MemberRepoStatMutation) SetNameWithOwner(s string) {
+	m.name_with_owner = &s
+}
+
+// NameWithOwner returns the value of the "name_with_owner" field in the mutation.
+func (m *MemberRepoStatMutation) NameWithOwner() (r string, exists bool) {
+	v := m.name_with_owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNameWithOwner returns the old "name_with_owner" field's value of the MemberRepoStat entity.
+// If the MemberRepoStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoStatMutation) OldNameWithOwner(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNameWithOwner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNameWithOwner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNameWithOwner: %w", err)
+	}
+	return oldValue.NameWithOwner, nil
+}
+
+// ResetNameWithOwner resets all changes to the "name_with_owner" field.
+func (m *MemberRepoStatMutation) ResetNameWithOwner() {
+	m.name_with_owner = nil
+}
+
+// SetCommitCount sets the "commit_count" field.
+func (m *MemberRepoStatMutation) SetCommitCount(i int) {
+	m.commit_count = &i
+	m.addcommit_count = nil
+}
+
+// CommitCount returns the value of the "commit_count" field in the mutation.
+func (m *MemberRepoStatMutation) CommitCount() (r int, exists bool) {
+	v := m.commit_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCommitCount returns the old "commit_count" field's value of the MemberRepoStat entity.
+// If the MemberRepoStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoStatMutation) OldCommitCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCommitCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCommitCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCommitCount: %w", err)
+	}
+	return oldValue.CommitCount, nil
+}
+
+// AddCommitCount adds i to the "commit_count" field.
+func (m *MemberRepoStatMutation) AddCommitCount(i int) {
+	if m.addcommit_count != nil {
+		*m.addcommit_count += i
+	} else {
+		m.addcommit_count = &i
+	}
+}
+
+// AddedCommitCount returns the value that was added to the "commit_count" field in this mutation.
+func (m *MemberRepoStatMutation) AddedCommitCount() (r int, exists bool) {
+	v := m.addcommit_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCommitCount resets all changes to the "commit_count" field.
+func (m *MemberRepoStatMutation) ResetCommitCount() {
+	m.commit_count = nil
+	m.addcommit_count = nil
+}
+
+// SetPrCreated sets the "pr_created" field.
+func (m *MemberRepoStatMutation) SetPrCreated(i int) {
+	m.pr_created = &i
+	m.addpr_created = nil
+}
+
+// PrCreated returns the value of the "pr_created" field in the mutation.
+func (m *MemberRepoStatMutation) PrCreated() (r int, exists bool) {
+	v := m.pr_created
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrCreated returns the old "pr_created" field's value of the MemberRepoStat entity.
+// If the MemberRepoStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoStatMutation) OldPrCreated(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrCreated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrCreated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrCreated: %w", err)
+	}
+	return oldValue.PrCreated, nil
+}
+
+// AddPrCreated adds i to the "pr_created" field.
+func (m *MemberRepoStatMutation) AddPrCreated(i int) {
+	if m.addpr_created != nil {
+		*m.addpr_created += i
+	} else {
+		m.addpr_created = &i
+	}
+}
+
+// AddedPrCreated returns the value that was added to the "pr_created" field in this mutation.
+func (m *MemberRepoStatMutation) AddedPrCreated() (r int, exists bool) {
+	v := m.addpr_created
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPrCreated resets all changes to the "pr_created" field.
+func (m *MemberRepoStatMutation) ResetPrCreated() {
+	m.pr_created = nil
+	m.addpr_created = nil
+}
+
+// SetPrMerged sets the "pr_merged" field.
+func (m *MemberRepoStatMutation) SetPrMerged(i int) {
+	m.pr_merged = &i
+	m.addpr_merged = nil
+}
+
+// PrMerged returns the value of the "pr_merged" field in the mutation.
+func (m *MemberRepoStatMutation) PrMerged() (r int, exists bool) {
+	v := m.pr_merged
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrMerged returns the old "pr_merged" field's value of the MemberRepoStat entity.
+// If the MemberRepoStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoStatMutation) OldPrMerged(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrMerged is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrMerged requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrMerged: %w", err)
+	}
+	return oldValue.PrMerged, nil
+}
+
+// AddPrMerged adds i to the "pr_merged" field.
+func (m *MemberRepoStatMutation) AddPrMerged(i int) {
+	if m.addpr_merged != nil {
+		*m.addpr_merged += i
+	} else {
+		m.addpr_merged = &i
+	}
+}
+
+// AddedPrMerged returns the value that was added to the "pr_merged" field in this mutation.
+func (m *MemberRepoStatMutation) AddedPrMerged() (r int, exists bool) {
+	v := m.addpr_merged
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPrMerged resets all changes to the "pr_merged" field.
+func (m *MemberRepoStatMutation) ResetPrMerged() {
+	m.pr_merged = nil
+	m.addpr_merged = nil
+}
+
+// SetIssueCount sets the "issue_count" field.
+func (m *MemberRepoStatMutation) SetIssueCount(i int) {
+	m.issue_count = &i
+	m.addissue_count = nil
+}
+
+// IssueCount returns the value of the "issue_count" field in the mutation.
+func (m *MemberRepoStatMutation) IssueCount() (r int, exists bool) {
+	v := m.issue_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIssueCount returns the old "issue_count" field's value of the MemberRepoStat entity.
+// If the MemberRepoStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoStatMutation) OldIssueCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIssueCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIssueCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIssueCount: %w", err)
+	}
+	return oldValue.IssueCount, nil
+}
+
+// AddIssueCount adds i to the "issue_count" field.
+func (m *MemberRepoStatMutation) AddIssueCount(i int) {
+	if m.addissue_count != nil {
+		*m.addissue_count += i
+	} else {
+		m.addissue_count = &i
+	}
+}
+
+// AddedIssueCount returns the value that was added to the "issue_count" field in this mutation.
+func (m *MemberRepoStatMutation) AddedIssueCount() (r int, exists bool) {
+	v := m.addissue_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetIssueCount resets all changes to the "issue_count" field.
+func (m *MemberRepoStatMutation) ResetIssueCount() {
+	m.issue_count = nil
+	m.addissue_count = nil
+}
+
+// SetReviewCount sets the "review_count" field.
+func (m *MemberRepoStatMutation) SetReviewCount(i int) {
+	m.review_count = &i
+	m.addreview_count = nil
+}
+
+// ReviewCount returns the value of the "review_count" field in the mutation.
+func (m *MemberRepoStatMutation) ReviewCount() (r int, exists bool) {
+	v := m.review_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewCount returns the old "review_count" field's value of the MemberRepoStat entity.
+// If the MemberRepoStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoStatMutation) OldReviewCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewCount: %w", err)
+	}
+	return oldValue.ReviewCount, nil
+}
+
+// AddReviewCount adds i to the "review_count" field.
+func (m *MemberRepoStatMutation) AddReviewCount(i int) {
+	if m.addreview_count != nil {
+		*m.addreview_count += i
+	} else {
+		m.addreview_count = &i
+	}
+}
+
+// AddedReviewCount returns the value that was added to the "review_count" field in this mutation.
+func (m *MemberRepoStatMutation) AddedReviewCount() (r int, exists bool) {
+	v := m.addreview_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetReviewCount resets all changes to the "review_count" field.
+func (m *MemberRepoStatMutation) ResetReviewCount() {
+	m.review_count = nil
+	m.addreview_count = nil
+}
+
+// SetAdditions sets the "additions" field.
+func (m *MemberRepoStatMutation) SetAdditions(i int) {
+	m.additions = &i
+	m.addadditions = nil
+}
+
+// Additions returns the value of the "additions" field in the mutation.
+func (m *MemberRepoStatMutation) Additions() (r int, exists bool) {
+	v := m.additions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAdditions returns the old "additions" field's value of the MemberRepoStat entity.
+// If the MemberRepoStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoStatMutation) OldAdditions(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAdditions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAdditions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAdditions: %w", err)
+	}
+	return oldValue.Additions, nil
+}
+
+// AddAdditions adds i to the "additions" field.
+func (m *MemberRepoStatMutation) AddAdditions(i int) {
+	if m.addadditions != nil {
+		*m.addadditions += i
+	} else {
+		m.addadditions = &i
+	}
+}
+
+// AddedAdditions returns the value that was added to the "additions" field in this mutation.
+func (m *MemberRepoStatMutation) AddedAdditions() (r int, exists bool) {
+	v := m.addadditions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAdditions resets all changes to the "additions" field.
+func (m *MemberRepoStatMutation) ResetAdditions() {
+	m.additions = nil
+	m.addadditions = nil
+}
+
+// SetDeletions sets the "deletions" field.
+func (m *MemberRepoStatMutation) SetDeletions(i int) {
+	m.deletions = &i
+	m.adddeletions = nil
+}
+
+// Deletions returns the value of the "deletions" field in the mutation.
+func (m *MemberRepoStatMutation) Deletions() (r int, exists bool) {
+	v := m.deletions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletions returns the old "deletions" field's value of the MemberRepoStat entity.
+// If the MemberRepoStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoStatMutation) OldDeletions(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletions: %w", err)
+	}
+	return oldValue.Deletions, nil
+}
+
+// AddDeletions adds i to the "deletions" field.
+func (m *MemberRepoStatMutation) AddDeletions(i int) {
+	if m.adddeletions != nil {
+		*m.adddeletions += i
+	} else {
+		m.adddeletions = &i
+	}
+}
+
+// AddedDeletions returns the value that was added to the "deletions" field in this mutation.
+func (m *MemberRepoStatMutation) AddedDeletions() (r int, exists bool) {
+	v := m.adddeletions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDeletions resets all changes to the "deletions" field.
+func (m *MemberRepoStatMutation) ResetDeletions() {
+	m.deletions = nil
+	m.adddeletions = nil
+}
+
+// SetIssuesClosed sets the "issues_closed" field.
+func (m *MemberRepoStatMutation) SetIssuesClosed(i int) {
+	m.issues_closed = &i
+	m.addissues_closed = nil
+}
+
+// IssuesClosed returns the value of the "issues_closed" field in the mutation.
+func (m *MemberRepoStatMutation) IssuesClosed() (r int, exists bool) {
+	v := m.issues_closed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIssuesClosed returns the old "issues_closed" field's value of the MemberRepoStat entity.
+// If the MemberRepoStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoStatMutation) OldIssuesClosed(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIssuesClosed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIssuesClosed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIssuesClosed: %w", err)
+	}
+	return oldValue.IssuesClosed, nil
+}
+
+// AddIssuesClosed adds i to the "issues_closed" field.
+func (m *MemberRepoStatMutation) AddIssuesClosed(i int) {
+	if m.addissues_closed != nil {
+		*m.addissues_closed += i
+	} else {
+		m.addissues_closed = &i
+	}
+}
+
+// AddedIssuesClosed returns the value that was added to the "issues_closed" field in this mutation.
+func (m *MemberRepoStatMutation) AddedIssuesClosed() (r int, exists bool) {
+	v := m.addissues_closed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetIssuesClosed resets all changes to the "issues_closed" field.
+func (m *MemberRepoStatMutation) ResetIssuesClosed() {
+	m.issues_closed = nil
+	m.addissues_closed = nil
+}
+
+// SetIssueCloseSeconds sets the "issue_close_seconds" field.
+func (m *MemberRepoStatMutation) SetIssueCloseSeconds(i int) {
+	m.issue_close_seconds = &i
+	m.addissue_close_seconds = nil
+}
+
+// IssueCloseSeconds returns the value of the "issue_close_seconds" field in the mutation.
+func (m *MemberRepoStatMutation) IssueCloseSeconds() (r int, exists bool) {
+	v := m.issue_close_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIssueCloseSeconds returns the old "issue_close_seconds" field's value of the MemberRepoStat entity.
+// If the MemberRepoStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoStatMutation) OldIssueCloseSeconds(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIssueCloseSeconds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIssueCloseSeconds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIssueCloseSeconds: %w", err)
+	}
+	return oldValue.IssueCloseSeconds, nil
+}
+
+// AddIssueCloseSeconds adds i to the "issue_close_seconds" field.
+func (m *MemberRepoStatMutation) AddIssueCloseSeconds(i int) {
+	if m.addissue_close_seconds != nil {
+		*m.addissue_close_seconds += i
+	} else {
+		m.addissue_close_seconds = &i
+	}
+}
+
+// AddedIssueCloseSeconds returns the value that was added to the "issue_close_seconds" field in this mutation.
+func (m *MemberRepoStatMutation) AddedIssueCloseSeconds() (r int, exists bool) {
+	v := m.addissue_close_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetIssueCloseSeconds resets all changes to the "issue_close_seconds" field.
+func (m *MemberRepoStatMutation) ResetIssueCloseSeconds() {
+	m.issue_close_seconds = nil
+	m.addissue_close_seconds = nil
+}
+
+// SetBugIssuesOpened sets the "bug_issues_opened" field.
+func (m *MemberRepoStatMutation) SetBugIssuesOpened(i int) {
+	m.bug_issues_opened = &i
+	m.addbug_issues_opened = nil
+}
+
+// BugIssuesOpened returns the value of the "bug_issues_opened" field in the mutation.
+func (m *MemberRepoStatMutation) BugIssuesOpened() (r int, exists bool) {
+	v := m.bug_issues_opened
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBugIssuesOpened returns the old "bug_issues_opened" field's value of the MemberRepoStat entity.
+// If the MemberRepoStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoStatMutation) OldBugIssuesOpened(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBugIssuesOpened is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBugIssuesOpened requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBugIssuesOpened: %w", err)
+	}
+	return oldValue.BugIssuesOpened, nil
+}
+
+// AddBugIssuesOpened adds i to the "bug_issues_opened" field.
+func (m *MemberRepoStatMutation) AddBugIssuesOpened(i int) {
+	if m.addbug_issues_opened != nil {
+		*m.addbug_issues_opened += i
+	} else {
+		m.addbug_issues_opened = &i
+	}
+}
+
+// AddedBugIssuesOpened returns the value that was added to the "bug_issues_opened" field in this mutation.
+func (m *MemberRepoStatMutation) AddedBugIssuesOpened() (r int, exists bool) {
+	v := m.addbug_issues_opened
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBugIssuesOpened resets all changes to the "bug_issues_opened" field.
+func (m *MemberRepoStatMutation) ResetBugIssuesOpened() {
+	m.bug_issues_opened = nil
+	m.addbug_issues_opened = nil
+}
+
+// SetFeatureIssuesOpened sets the "feature_issues_opened" field.
+func (m *MemberRepoStatMutation) SetFeatureIssuesOpened(i int) {
+	m.feature_issues_opened = &i
+	m.addfeature_issues_opened = nil
+}
+
+// FeatureIssuesOpened returns the value of the "feature_issues_opened" field in the mutation.
+func (m *MemberRepoStatMutation) FeatureIssuesOpened() (r int, exists bool) {
+	v := m.feature_issues_opened
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFeatureIssuesOpened returns the old "feature_issues_opened" field's value of the MemberRepoStat entity.
+// If the MemberRepoStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoStatMutation) OldFeatureIssuesOpened(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFeatureIssuesOpened is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFeatureIssuesOpened requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFeatureIssuesOpened: %w", err)
+	}
+	return oldValue.FeatureIssuesOpened, nil
+}
+
+// AddFeatureIssuesOpened adds i to the "feature_issues_opened" field.
+func (m *MemberRepoStatMutation) AddFeatureIssuesOpened(i int) {
+	if m.addfeature_issues_opened != nil {
+		*m.addfeature_issues_opened += i
+	} else {
+		m.addfeature_issues_opened = &i
+	}
+}
+
+// AddedFeatureIssuesOpened returns the value that was added to the "feature_issues_opened" field in this mutation.
+func (m *MemberRepoStatMutation) AddedFeatureIssuesOpened() (r int, exists bool) {
+	v := m.addfeature_issues_opened
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFeatureIssuesOpened resets all changes to the "feature_issues_opened" field.
+func (m *MemberRepoStatMutation) ResetFeatureIssuesOpened() {
+	m.feature_issues_opened = nil
+	m.addfeature_issues_opened = nil
+}
+
+// SetBugIssuesClosed sets the "bug_issues_closed" field.
+func (m *MemberRepoStatMutation) SetBugIssuesClosed(i int) {
+	m.bug_issues_closed = &i
+	m.addbug_issues_closed = nil
+}
+
+// BugIssuesClosed returns the value of the "bug_issues_closed" field in the mutation.
+func (m *MemberRepoStatMutation) BugIssuesClosed() (r int, exists bool) {
+	v := m.bug_issues_closed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBugIssuesClosed returns the old "bug_issues_closed" field's value of the MemberRepoStat entity.
+// If the MemberRepoStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoStatMutation) OldBugIssuesClosed(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBugIssuesClosed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBugIssuesClosed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBugIssuesClosed: %w", err)
+	}
+	return oldValue.BugIssuesClosed, nil
+}
+
+// AddBugIssuesClosed adds i to the "bug_issues_closed" field.
+func (m *MemberRepoStatMutation) AddBugIssuesClosed(i int) {
+	if m.addbug_issues_closed != nil {
+		*m.addbug_issues_closed += i
+	} else {
+		m.addbug_issues_closed = &i
+	}
+}
+
+// AddedBugIssuesClosed returns the value that was added to the "bug_issues_closed" field in this mutation.
+func (m *MemberRepoStatMutation) AddedBugIssuesClosed() (r int, exists bool) {
+	v := m.addbug_issues_closed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBugIssuesClosed resets all changes to the "bug_issues_closed" field.
+func (m *MemberRepoStatMutation) ResetBugIssuesClosed() {
+	m.bug_issues_closed = nil
+	m.addbug_issues_closed = nil
+}
+
+// SetFeatureIssuesClosed sets the "feature_issues_closed" field.
+func (m *MemberRepoStatMutation) SetFeatureIssuesClosed(i int) {
+	m.feature_issues_closed = &i
+	m.addfeature_issues_closed = nil
+}
+
+// FeatureIssuesClosed returns the value of the "feature_issues_closed" field in the mutation.
+func (m *MemberRepoStatMutation) FeatureIssuesClosed() (r int, exists bool) {
+	v := m.feature_issues_closed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFeatureIssuesClosed returns the old "feature_issues_closed" field's value of the MemberRepoStat entity.
+// If the MemberRepoStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoStatMutation) OldFeatureIssuesClosed(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFeatureIssuesClosed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFeatureIssuesClosed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFeatureIssuesClosed: %w", err)
+	}
+	return oldValue.FeatureIssuesClosed, nil
+}
+
+// AddFeatureIssuesClosed adds i to the "feature_issues_closed" field.
+func (m *MemberRepoStatMutation) AddFeatureIssuesClosed(i int) {
+	if m.addfeature_issues_closed != nil {
+		*m.addfeature_issues_closed += i
+	} else {
+		m.addfeature_issues_closed = &i
+	}
+}
+
+// AddedFeatureIssuesClosed returns the value that was added to the "feature_issues_closed" field in this mutation.
+func (m *MemberRepoStatMutation) AddedFeatureIssuesClosed() (r int, exists bool) {
+	v := m.addfeature_issues_closed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFeatureIssuesClosed resets all changes to the "feature_issues_closed" field.
+func (m *MemberRepoStatMutation) ResetFeatureIssuesClosed() {
+	m.feature_issues_closed = nil
+	m.addfeature_issues_closed = nil
+}
+
+// SetFeaturePrs sets the "feature_prs" field.
+func (m *MemberRepoStatMutation) SetFeaturePrs(i int) {
+	m.feature_prs = &i
+	m.addfeature_prs = nil
+}
+
+// FeaturePrs returns the value of the "feature_prs" field in the mutation.
+func (m *MemberRepoStatMutation) FeaturePrs() (r int, exists bool) {
+	v := m.feature_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFeaturePrs returns the old "feature_prs" field's value of the MemberRepoStat entity.
+// If the MemberRepoStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoStatMutation) OldFeaturePrs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFeaturePrs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFeaturePrs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFeaturePrs: %w", err)
+	}
+	return oldValue.FeaturePrs, nil
+}
+
+// AddFeaturePrs adds i to the "feature_prs" field.
+func (m *MemberRepoStatMutation) AddFeaturePrs(i int) {
+	if m.addfeature_prs != nil {
+		*m.addfeature_prs += i
+	} else {
+		m.addfeature_prs = &i
+	}
+}
+
+// AddedFeaturePrs returns the value that was added to the "feature_prs" field in this mutation.
+func (m *MemberRepoStatMutation) AddedFeaturePrs() (r int, exists bool) {
+	v := m.addfeature_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFeaturePrs resets all changes to the "feature_prs" field.
+func (m *MemberRepoStatMutation) ResetFeaturePrs() {
+	m.feature_prs = nil
+	m.addfeature_prs = nil
+}
+
+// SetBugPrs sets the "bug_prs" field.
+func (m *MemberRepoStatMutation) SetBugPrs(i int) {
+	m.bug_prs = &i
+	m.addbug_prs = nil
+}
+
+// BugPrs returns the value of the "bug_prs" field in the mutation.
+func (m *MemberRepoStatMutation) BugPrs() (r int, exists bool) {
+	v := m.bug_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBugPrs returns the old "bug_prs" field's value of the MemberRepoStat entity.
+// If the MemberRepoStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoStatMutation) OldBugPrs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBugPrs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBugPrs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBugPrs: %w", err)
+	}
+	return oldValue.BugPrs, nil
+}
+
+// AddBugPrs adds i to the "bug_prs" field.
+func (m *MemberRepoStatMutation) AddBugPrs(i int) {
+	if m.addbug_prs != nil {
+		*m.addbug_prs += i
+	} else {
+		m.addbug_prs = &i
+	}
+}
+
+// AddedBugPrs returns the value that was added to the "bug_prs" field in this mutation.
+func (m *MemberRepoStatMutation) AddedBugPrs() (r int, exists bool) {
+	v := m.addbug_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBugPrs resets all changes to the "bug_prs" field.
+func (m *MemberRepoStatMutation) ResetBugPrs() {
+	m.bug_prs = nil
+	m.addbug_prs = nil
+}
+
+// SetChorePrs sets the "chore_prs" field.
+func (m *MemberRepoStatMutation) SetChorePrs(i int) {
+	m.chore_prs = &i
+	m.addchore_prs = nil
+}
+
+// ChorePrs returns the value of the "chore_prs" field in the mutation.
+func (m *MemberRepoStatMutation) ChorePrs() (r int, exists bool) {
+	v := m.chore_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChorePrs returns the old "chore_prs" field's value of the MemberRepoStat entity.
+// If the MemberRepoStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoStatMutation) OldChorePrs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChorePrs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChorePrs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChorePrs: %w", err)
+	}
+	return oldValue.ChorePrs, nil
+}
+
+// AddChorePrs adds i to the "chore_prs" field.
+func (m *MemberRepoStatMutation) AddChorePrs(i int) {
+	if m.addchore_prs != nil {
+		*m.addchore_prs += i
+	} else {
+		m.addchore_prs = &i
+	}
+}
+
+// AddedChorePrs returns the value that was added to the "chore_prs" field in this mutation.
+func (m *MemberRepoStatMutation) AddedChorePrs() (r int, exists bool) {
+	v := m.addchore_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetChorePrs resets all changes to the "chore_prs" field.
+func (m *MemberRepoStatMutation) ResetChorePrs() {
+	m.chore_prs = nil
+	m.addchore_prs = nil
+}
+
+// SetDocsPrs sets the "docs_prs" field.
+func (m *MemberRepoStatMutation) SetDocsPrs(i int) {
+	m.docs_prs = &i
+	m.adddocs_prs = nil
+}
+
+// DocsPrs returns the value of the "docs_prs" field in the mutation.
+func (m *MemberRepoStatMutation) DocsPrs() (r int, exists bool) {
+	v := m.docs_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDocsPrs returns the old "docs_prs" field's value of the MemberRepoStat entity.
+// If the MemberRepoStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoStatMutation) OldDocsPrs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDocsPrs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDocsPrs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDocsPrs: %w", err)
+	}
+	return oldValue.DocsPrs, nil
+}
+
+// AddDocsPrs adds i to the "docs_prs" field.
+func (m *MemberRepoStatMutation) AddDocsPrs(i int) {
+	if m.adddocs_prs != nil {
+		*m.adddocs_prs += i
+	} else {
+		m.adddocs_prs = &i
+	}
+}
+
+// AddedDocsPrs returns the value that was added to the "docs_prs" field in this mutation.
+func (m *MemberRepoStatMutation) AddedDocsPrs() (r int, exists bool) {
+	v := m.adddocs_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDocsPrs resets all changes to the "docs_prs" field.
+func (m *MemberRepoStatMutation) ResetDocsPrs() {
+	m.docs_prs = nil
+	m.adddocs_prs = nil
+}
+
+// SetTestPrs sets the "test_prs" field.
+func (m *MemberRepoStatMutation) SetTestPrs(i int) {
+	m.test_prs = &i
+	m.addtest_prs = nil
+}
+
+// TestPrs returns the value of the "test_prs" field in the mutation.
+func (m *MemberRepoStatMutation) TestPrs() (r int, exists bool) {
+	v := m.test_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTestPrs returns the old "test_prs" field's value of the MemberRepoStat entity.
+// If the MemberRepoStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoStatMutation) OldTestPrs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTestPrs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTestPrs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTestPrs: %w", err)
+	}
+	return oldValue.TestPrs, nil
+}
+
+// AddTestPrs adds i to the "test_prs" field.
+func (m *MemberRepoStatMutation) AddTestPrs(i int) {
+	if m.addtest_prs != nil {
+		*m.addtest_prs += i
+	} else {
+		m.addtest_prs = &i
+	}
+}
+
+// AddedTestPrs returns the value that was added to the "test_prs" field in this mutation.
+func (m *MemberRepoStatMutation) AddedTestPrs() (r int, exists bool) {
+	v := m.addtest_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTestPrs resets all changes to the "test_prs" field.
+func (m *MemberRepoStatMutation) ResetTestPrs() {
+	m.test_prs = nil
+	m.addtest_prs = nil
+}
+
+// SetInfraPrs sets the "infra_prs" field.
+func (m *MemberRepoStatMutation) SetInfraPrs(i int) {
+	m.infra_prs = &i
+	m.addinfra_prs = nil
+}
+
+// InfraPrs returns the value of the "infra_prs" field in the mutation.
+func (m *MemberRepoStatMutation) InfraPrs() (r int, exists bool) {
+	v := m.infra_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInfraPrs returns the old "infra_prs" field's value of the MemberRepoStat entity.
+// If the MemberRepoStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoStatMutation) OldInfraPrs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInfraPrs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInfraPrs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInfraPrs: %w", err)
+	}
+	return oldValue.InfraPrs, nil
+}
+
+// AddInfraPrs adds i to the "infra_prs" field.
+func (m *MemberRepoStatMutation) AddInfraPrs(i int) {
+	if m.addinfra_prs != nil {
+		*m.addinfra_prs += i
+	} else {
+		m.addinfra_prs = &i
+	}
+}
+
+// AddedInfraPrs returns the value that was added to the "infra_prs" field in this mutation.
+func (m *MemberRepoStatMutation) AddedInfraPrs() (r int, exists bool) {
+	v := m.addinfra_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetInfraPrs resets all changes to the "infra_prs" field.
+func (m *MemberRepoStatMutation) ResetInfraPrs() {
+	m.infra_prs = nil
+	m.addinfra_prs = nil
+}
+
+// SetOtherPrs sets the "other_prs" field.
+func (m *MemberRepoStatMutation) SetOtherPrs(i int) {
+	m.other_prs = &i
+	m.addother_prs = nil
+}
+
+// OtherPrs returns the value of the "other_prs" field in the mutation.
+func (m *MemberRepoStatMutation) OtherPrs() (r int, exists bool) {
+	v := m.other_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOtherPrs returns the old "other_prs" field's value of the MemberRepoStat entity.
+// If the MemberRepoStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberRepoStatMutation) OldOtherPrs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOtherPrs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOtherPrs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOtherPrs: %w", err)
+	}
+	return oldValue.OtherPrs, nil
+}
+
+// AddOtherPrs adds i to the "other_prs" field.
+func (m *MemberRepoStatMutation) AddOtherPrs(i int) {
+	if m.addother_prs != nil {
+		*m.addother_prs += i
+	} else {
+		m.addother_prs = &i
+	}
+}
+
+// AddedOtherPrs returns the value that was added to the "other_prs" field in this mutation.
+func (m *MemberRepoStatMutation) AddedOtherPrs() (r int, exists bool) {
+	v := m.addother_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetOtherPrs resets all changes to the "other_prs" field.
+func (m *MemberRepoStatMutation) ResetOtherPrs() {
+	m.other_prs = nil
+	m.addother_prs = nil
+}
+
+// SetSnapshotID sets the "snapshot" edge to the Snapshot entity by id.
+func (m *MemberRepoStatMutation) SetSnapshotID(id int) {
+	m.snapshot = &id
+}
+
+// ClearSnapshot clears the "snapshot" edge to the Snapshot entity.
+func (m *MemberRepoStatMutation) ClearSnapshot() {
+	m.clearedsnapshot = true
+}
+
+// SnapshotCleared reports if the "snapshot" edge to the Snapshot entity was cleared.
+func (m *MemberRepoStatMutation) SnapshotCleared() bool {
+	return m.clearedsnapshot
+}
+
+// SnapshotID returns the "snapshot" edge ID in the mutation.
+func (m *MemberRepoStatMutation) SnapshotID() (id int, exists bool) {
+	if m.snapshot != nil {
+		return *m.snapshot, true
+	}
+	return
+}
+
+// SnapshotIDs returns the "snapshot" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SnapshotID instead. It exists only for internal usage by the builders.
+func (m *MemberRepoStatMutation) SnapshotIDs() (ids []int) {
+	if id := m.snapshot; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSnapshot resets all changes to the "snapshot" edge.
+func (m *MemberRepoStatMutation) ResetSnapshot() {
+	m.snapshot = nil
+	m.clearedsnapshot = false
+}
+
+// Where appends a list predicates to the MemberRepoStatMutation builder.
+func (m *MemberRepoStatMutation) Where(ps ...predicate.MemberRepoStat) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MemberRepoStatMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MemberRepoStatMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MemberRepoStat, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MemberRepoStatMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MemberRepoStatMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MemberRepoStat).
 func (m *MemberRepoStatMutation) Type() string {
 	return m.typ
 }
@@ -2875,34 +11655,4887 @@ func (m *MemberRepoStatMutation) Type() string {
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MemberRepoStatMutation) Fields() []string {
+func (m *MemberRepoStatMutation) Fields() []string {
+	fields := make([]string, 0, 22)
+	if m.login != nil {
+		fields = append(fields, memberrepostat.FieldLogin)
+	}
+	if m.name_with_owner != nil {
+		fields = append(fields, memberrepostat.FieldNameWithOwner)
+	}
+	if m.commit_count != nil {
+		fields = append(fields, memberrepostat.FieldCommitCount)
+	}
+	if m.pr_created != nil {
+		fields = append(fields, memberrepostat.FieldPrCreated)
+	}
+	if m.pr_merged != nil {
+		fields = append(fields, memberrepostat.FieldPrMerged)
+	}
+	if m.issue_count != nil {
+		fields = append(fields, memberrepostat.FieldIssueCount)
+	}
+	if m.review_count != nil {
+		fields = append(fields, memberrepostat.FieldReviewCount)
+	}
+	if m.additions != nil {
+		fields = append(fields, memberrepostat.FieldAdditions)
+	}
+	if m.deletions != nil {
+		fields = append(fields, memberrepostat.FieldDeletions)
+	}
+	if m.issues_closed != nil {
+		fields = append(fields, memberrepostat.FieldIssuesClosed)
+	}
+	if m.issue_close_seconds != nil {
+		fields = append(fields, memberrepostat.FieldIssueCloseSeconds)
+	}
+	if m.bug_issues_opened != nil {
+		fields = append(fields, memberrepostat.FieldBugIssuesOpened)
+	}
+	if m.feature_issues_opened != nil {
+		fields = append(fields, memberrepostat.FieldFeatureIssuesOpened)
+	}
+	if m.bug_issues_closed != nil {
+		fields = append(fields, memberrepostat.FieldBugIssuesClosed)
+	}
+	if m.feature_issues_closed != nil {
+		fields = append(fields, memberrepostat.FieldFeatureIssuesClosed)
+	}
+	if m.feature_prs != nil {
+		fields = append(fields, memberrepostat.FieldFeaturePrs)
+	}
+	if m.bug_prs != nil {
+		fields = append(fields, memberrepostat.FieldBugPrs)
+	}
+	if m.chore_prs != nil {
+		fields = append(fields, memberrepostat.FieldChorePrs)
+	}
+	if m.docs_prs != nil {
+		fields = append(fields, memberrepostat.FieldDocsPrs)
+	}
+	if m.test_prs != nil {
+		fields = append(fields, memberrepostat.FieldTestPrs)
+	}
+	if m.infra_prs != nil {
+		fields = append(fields, memberrepostat.FieldInfraPrs)
+	}
+	if m.other_prs != nil {
+		fields = append(fields, memberrepostat.FieldOtherPrs)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MemberRepoStatMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case memberrepostat.FieldLogin:
+		return m.Login()
+	case memberrepostat.FieldNameWithOwner:
+		return m.NameWithOwner()
+	case memberrepostat.FieldCommitCount:
+		return m.CommitCount()
+	case memberrepostat.FieldPrCreated:
+		return m.PrCreated()
+	case memberrepostat.FieldPrMerged:
+		return m.PrMerged()
+	case memberrepostat.FieldIssueCount:
+		return m.IssueCount()
+	case memberrepostat.FieldReviewCount:
+		return m.ReviewCount()
+	case memberrepostat.FieldAdditions:
+		return m.Additions()
+	case memberrepostat.FieldDeletions:
+		return m.Deletions()
+	case memberrepostat.FieldIssuesClosed:
+		return m.IssuesClosed()
+	case memberrepostat.FieldIssueCloseSeconds:
+		return m.IssueCloseSeconds()
+	case memberrepostat.FieldBugIssuesOpened:
+		return m.BugIssuesOpened()
+	case memberrepostat.FieldFeatureIssuesOpened:
+		return m.FeatureIssuesOpened()
+	case memberrepostat.FieldBugIssuesClosed:
+		return m.BugIssuesClosed()
+	case memberrepostat.FieldFeatureIssuesClosed:
+		return m.FeatureIssuesClosed()
+	case memberrepostat.FieldFeaturePrs:
+		return m.FeaturePrs()
+	case memberrepostat.FieldBugPrs:
+		return m.BugPrs()
+	case memberrepostat.FieldChorePrs:
+		return m.ChorePrs()
+	case memberrepostat.FieldDocsPrs:
+		return m.DocsPrs()
+	case memberrepostat.FieldTestPrs:
+		return m.TestPrs()
+	case memberrepostat.FieldInfraPrs:
+		return m.InfraPrs()
+	case memberrepostat.FieldOtherPrs:
+		return m.OtherPrs()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MemberRepoStatMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case memberrepostat.FieldLogin:
+		return m.OldLogin(ctx)
+	case memberrepostat.FieldNameWithOwner:
+		return m.OldNameWithOwner(ctx)
+	case memberrepostat.FieldCommitCount:
+		return m.OldCommitCount(ctx)
+	case memberrepostat.FieldPrCreated:
+		return m.OldPrCreated(ctx)
+	case memberrepostat.FieldPrMerged:
+		return m.OldPrMerged(ctx)
+	case memberrepostat.FieldIssueCount:
+		return m.OldIssueCount(ctx)
+	case memberrepostat.FieldReviewCount:
+		return m.OldReviewCount(ctx)
+	case memberrepostat.FieldAdditions:
+		return m.OldAdditions(ctx)
+	case memberrepostat.FieldDeletions:
+		return m.OldDeletions(ctx)
+	case memberrepostat.FieldIssuesClosed:
+		return m.OldIssuesClosed(ctx)
+	case memberrepostat.FieldIssueCloseSeconds:
+		return m.OldIssueCloseSeconds(ctx)
+	case memberrepostat.FieldBugIssuesOpened:
+		return m.OldBugIssuesOpened(ctx)
+	case memberrepostat.FieldFeatureIssuesOpened:
+		return m.OldFeatureIssuesOpened(ctx)
+	case memberrepostat.FieldBugIssuesClosed:
+		return m.OldBugIssuesClosed(ctx)
+	case memberrepostat.FieldFeatureIssuesClosed:
+		return m.OldFeatureIssuesClosed(ctx)
+	case memberrepostat.FieldFeaturePrs:
+		return m.OldFeaturePrs(ctx)
+	case memberrepostat.FieldBugPrs:
+		return m.OldBugPrs(ctx)
+	case memberrepostat.FieldChorePrs:
+		return m.OldChorePrs(ctx)
+	case memberrepostat.FieldDocsPrs:
+		return m.OldDocsPrs(ctx)
+	case memberrepostat.FieldTestPrs:
+		return m.OldTestPrs(ctx)
+	case memberrepostat.FieldInfraPrs:
+		return m.OldInfraPrs(ctx)
+	case memberrepostat.FieldOtherPrs:
+		return m.OldOtherPrs(ctx)
+	}
+	return nil, fmt.Errorf("unknown MemberRepoStat field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MemberRepoStatMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case memberrepostat.FieldLogin:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLogin(v)
+		return nil
+	case memberrepostat.FieldNameWithOwner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNameWithOwner(v)
+		return nil
+	case memberrepostat.FieldCommitCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCommitCount(v)
+		return nil
+	case memberrepostat.FieldPrCreated:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrCreated(v)
+		return nil
+	case memberrepostat.FieldPrMerged:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrMerged(v)
+		return nil
+	case memberrepostat.FieldIssueCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIssueCount(v)
+		return nil
+	case memberrepostat.FieldReviewCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewCount(v)
+		return nil
+	case memberrepostat.FieldAdditions:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAdditions(v)
+		return nil
+	case memberrepostat.FieldDeletions:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletions(v)
+		return nil
+	case memberrepostat.FieldIssuesClosed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIssuesClosed(v)
+		return nil
+	case memberrepostat.FieldIssueCloseSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIssueCloseSeconds(v)
+		return nil
+	case memberrepostat.FieldBugIssuesOpened:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBugIssuesOpened(v)
+		return nil
+	case memberrepostat.FieldFeatureIssuesOpened:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFeatureIssuesOpened(v)
+		return nil
+	case memberrepostat.FieldBugIssuesClosed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBugIssuesClosed(v)
+		return nil
+	case memberrepostat.FieldFeatureIssuesClosed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFeatureIssuesClosed(v)
+		return nil
+	case memberrepostat.FieldFeaturePrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFeaturePrs(v)
+		return nil
+	case memberrepostat.FieldBugPrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBugPrs(v)
+		return nil
+	case memberrepostat.FieldChorePrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChorePrs(v)
+		return nil
+	case memberrepostat.FieldDocsPrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDocsPrs(v)
+		return nil
+	case memberrepostat.FieldTestPrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTestPrs(v)
+		return nil
+	case memberrepostat.FieldInfraPrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInfraPrs(v)
+		return nil
+	case memberrepostat.FieldOtherPrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOtherPrs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MemberRepoStat field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MemberRepoStatMutation) AddedFields() []string {
+	var fields []string
+	if m.addcommit_count != nil {
+		fields = append(fields, memberrepostat.FieldCommitCount)
+	}
+	if m.addpr_created != nil {
+		fields = append(fields, memberrepostat.FieldPrCreated)
+	}
+	if m.addpr_merged != nil {
+		fields = append(fields, memberrepostat.FieldPrMerged)
+	}
+	if m.addissue_count != nil {
+		fields = append(fields, memberrepostat.FieldIssueCount)
+	}
+	if m.addreview_count != nil {
+		fields = append(fields, memberrepostat.FieldReviewCount)
+	}
+	if m.addadditions != nil {
+		fields = append(fields, memberrepostat.FieldAdditions)
+	}
+	if m.adddeletions != nil {
+		fields = append(fields, memberrepostat.FieldDeletions)
+	}
+	if m.addissues_closed != nil {
+		fields = append(fields, memberrepostat.FieldIssuesClosed)
+	}
+	if m.addissue_close_seconds != nil {
+		fields = append(fields, memberrepostat.FieldIssueCloseSeconds)
+	}
+	if m.addbug_issues_opened != nil {
+		fields = append(fields, memberrepostat.FieldBugIssuesOpened)
+	}
+	if m.addfeature_issues_opened != nil {
+		fields = append(fields, memberrepostat.FieldFeatureIssuesOpened)
+	}
+	if m.addbug_issues_closed != nil {
+		fields = append(fields, memberrepostat.FieldBugIssuesClosed)
+	}
+	if m.addfeature_issues_closed != nil {
+		fields = append(fields, memberrepostat.FieldFeatureIssuesClosed)
+	}
+	if m.addfeature_prs != nil {
+		fields = append(fields, memberrepostat.FieldFeaturePrs)
+	}
+	if m.addbug_prs != nil {
+		fields = append(fields, memberrepostat.FieldBugPrs)
+	}
+	if m.addchore_prs != nil {
+		fields = append(fields, memberrepostat.FieldChorePrs)
+	}
+	if m.adddocs_prs != nil {
+		fields = append(fields, memberrepostat.FieldDocsPrs)
+	}
+	if m.addtest_prs != nil {
+		fields = append(fields, memberrepostat.FieldTestPrs)
+	}
+	if m.addinfra_prs != nil {
+		fields = append(fields, memberrepostat.FieldInfraPrs)
+	}
+	if m.addother_prs != nil {
+		fields = append(fields, memberrepostat.FieldOtherPrs)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MemberRepoStatMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case memberrepostat.FieldCommitCount:
+		return m.AddedCommitCount()
+	case memberrepostat.FieldPrCreated:
+		return m.AddedPrCreated()
+	case memberrepostat.FieldPrMerged:
+		return m.AddedPrMerged()
+	case memberrepostat.FieldIssueCount:
+		return m.AddedIssueCount()
+	case memberrepostat.FieldReviewCount:
+		return m.AddedReviewCount()
+	case memberrepostat.FieldAdditions:
+		return m.AddedAdditions()
+	case memberrepostat.FieldDeletions:
+		return m.AddedDeletions()
+	case memberrepostat.FieldIssuesClosed:
+		return m.AddedIssuesClosed()
+	case memberrepostat.FieldIssueCloseSeconds:
+		return m.AddedIssueCloseSeconds()
+	case memberrepostat.FieldBugIssuesOpened:
+		return m.AddedBugIssuesOpened()
+	case memberrepostat.FieldFeatureIssuesOpened:
+		return m.AddedFeatureIssuesOpened()
+	case memberrepostat.FieldBugIssuesClosed:
+		return m.AddedBugIssuesClosed()
+	case memberrepostat.FieldFeatureIssuesClosed:
+		return m.AddedFeatureIssuesClosed()
+	case memberrepostat.FieldFeaturePrs:
+		return m.AddedFeaturePrs()
+	case memberrepostat.FieldBugPrs:
+		return m.AddedBugPrs()
+	case memberrepostat.FieldChorePrs:
+		return m.AddedChorePrs()
+	case memberrepostat.FieldDocsPrs:
+		return m.AddedDocsPrs()
+	case memberrepostat.FieldTestPrs:
+		return m.AddedTestPrs()
+	case memberrepostat.FieldInfraPrs:
+		return m.AddedInfraPrs()
+	case memberrepostat.FieldOtherPrs:
+		return m.AddedOtherPrs()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MemberRepoStatMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case memberrepostat.FieldCommitCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCommitCount(v)
+		return nil
+	case memberrepostat.FieldPrCreated:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPrCreated(v)
+		return nil
+	case memberrepostat.FieldPrMerged:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPrMerged(v)
+		return nil
+	case memberrepostat.FieldIssueCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIssueCount(v)
+		return nil
+	case memberrepostat.FieldReviewCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReviewCount(v)
+		return nil
+	case memberrepostat.FieldAdditions:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAdditions(v)
+		return nil
+	case memberrepostat.FieldDeletions:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDeletions(v)
+		return nil
+	case memberrepostat.FieldIssuesClosed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIssuesClosed(v)
+		return nil
+	case memberrepostat.FieldIssueCloseSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIssueCloseSeconds(v)
+		return nil
+	case memberrepostat.FieldBugIssuesOpened:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBugIssuesOpened(v)
+		return nil
+	case memberrepostat.FieldFeatureIssuesOpened:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFeatureIssuesOpened(v)
+		return nil
+	case memberrepostat.FieldBugIssuesClosed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBugIssuesClosed(v)
+		return nil
+	case memberrepostat.FieldFeatureIssuesClosed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFeatureIssuesClosed(v)
+		return nil
+	case memberrepostat.FieldFeaturePrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFeaturePrs(v)
+		return nil
+	case memberrepostat.FieldBugPrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBugPrs(v)
+		return nil
+	case memberrepostat.FieldChorePrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChorePrs(v)
+		return nil
+	case memberrepostat.FieldDocsPrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDocsPrs(v)
+		return nil
+	case memberrepostat.FieldTestPrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTestPrs(v)
+		return nil
+	case memberrepostat.FieldInfraPrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddInfraPrs(v)
+		return nil
+	case memberrepostat.FieldOtherPrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOtherPrs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MemberRepoStat numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MemberRepoStatMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MemberRepoStatMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MemberRepoStatMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MemberRepoStat nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MemberRepoStatMutation) ResetField(name string) error {
+	switch name {
+	case memberrepostat.FieldLogin:
+		m.ResetLogin()
+		return nil
+	case memberrepostat.FieldNameWithOwner:
+		m.ResetNameWithOwner()
+		return nil
+	case memberrepostat.FieldCommitCount:
+		m.ResetCommitCount()
+		return nil
+	case memberrepostat.FieldPrCreated:
+		m.ResetPrCreated()
+		return nil
+	case memberrepostat.FieldPrMerged:
+		m.ResetPrMerged()
+		return nil
+	case memberrepostat.FieldIssueCount:
+		m.ResetIssueCount()
+		return nil
+	case memberrepostat.FieldReviewCount:
+		m.ResetReviewCount()
+		return nil
+	case memberrepostat.FieldAdditions:
+		m.ResetAdditions()
+		return nil
+	case memberrepostat.FieldDeletions:
+		m.ResetDeletions()
+		return nil
+	case memberrepostat.FieldIssuesClosed:
+		m.ResetIssuesClosed()
+		return nil
+	case memberrepostat.FieldIssueCloseSeconds:
+		m.ResetIssueCloseSeconds()
+		return nil
+	case memberrepostat.FieldBugIssuesOpened:
+		m.ResetBugIssuesOpened()
+		return nil
+	case memberrepostat.FieldFeatureIssuesOpened:
+		m.ResetFeatureIssuesOpened()
+		return nil
+	case memberrepostat.FieldBugIssuesClosed:
+		m.ResetBugIssuesClosed()
+		return nil
+	case memberrepostat.FieldFeatureIssuesClosed:
+		m.ResetFeatureIssuesClosed()
+		return nil
+	case memberrepostat.FieldFeaturePrs:
+		m.ResetFeaturePrs()
+		return nil
+	case memberrepostat.FieldBugPrs:
+		m.ResetBugPrs()
+		return nil
+	case memberrepostat.FieldChorePrs:
+		m.ResetChorePrs()
+		return nil
+	case memberrepostat.FieldDocsPrs:
+		m.ResetDocsPrs()
+		return nil
+	case memberrepostat.FieldTestPrs:
+		m.ResetTestPrs()
+		return nil
+	case memberrepostat.FieldInfraPrs:
+		m.ResetInfraPrs()
+		return nil
+	case memberrepostat.FieldOtherPrs:
+		m.ResetOtherPrs()
+		return nil
+	}
+	return fmt.Errorf("unknown MemberRepoStat field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MemberRepoStatMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.snapshot != nil {
+		edges = append(edges, memberrepostat.EdgeSnapshot)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MemberRepoStatMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case memberrepostat.EdgeSnapshot:
+		if id := m.snapshot; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MemberRepoStatMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MemberRepoStatMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MemberRepoStatMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsnapshot {
+		edges = append(edges, memberrepostat.EdgeSnapshot)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MemberRepoStatMutation) EdgeCleared(name string) bool {
+	switch name {
+	case memberrepostat.EdgeSnapshot:
+		return m.clearedsnapshot
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MemberRepoStatMutation) ClearEdge(name string) error {
+	switch name {
+	case memberrepostat.EdgeSnapshot:
+		m.ClearSnapshot()
+		return nil
+	}
+	return fmt.Errorf("unknown MemberRepoStat unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MemberRepoStatMutation) ResetEdge(name string) error {
+	switch name {
+	case memberrepostat.EdgeSnapshot:
+		m.ResetSnapshot()
+		return nil
+	}
+	return fmt.Errorf("unknown MemberRepoStat edge %s", name)
+}
+
+// MemberReviewRequestMutation represents an operation that mutates the MemberReviewRequest nodes in the graph.
+type MemberReviewRequestMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	login           *string
+	name_with_owner *string
+	source_id       *string
+	reviewer        *string
+	requested_at    *time.Time
+	completed       *bool
+	pending         *bool
+	clearedFields   map[string]struct{}
+	snapshot        *int
+	clearedsnapshot bool
+	done            bool
+	oldValue        func(context.Context) (*MemberReviewRequest, error)
+	predicates      []predicate.MemberReviewRequest
+}
+
+var _ ent.Mutation = (*MemberReviewRequestMutation)(nil)
+
+// memberreviewrequestOption allows management of the mutation configuration using functional options.
+type memberreviewrequestOption func(*MemberReviewRequestMutation)
+
+// newMemberReviewRequestMutation creates new mutation for the MemberReviewRequest entity.
+func newMemberReviewRequestMutation(c config, op Op, opts ...memberreviewrequestOption) *MemberReviewRequestMutation {
+	m := &MemberReviewRequestMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMemberReviewRequest,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMemberReviewRequestID sets the ID field of the mutation.
+func withMemberReviewRequestID(id int) memberreviewrequestOption {
+	return func(m *MemberReviewRequestMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MemberReviewRequest
+		)
+		m.oldValue = func(ctx context.Context) (*MemberReviewRequest, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MemberReviewRequest.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMemberReviewRequest sets the old MemberReviewRequest of the mutation.
+func withMemberReviewRequest(node *MemberReviewRequest) memberreviewrequestOption {
+	return func(m *MemberReviewRequestMutation) {
+		m.oldValue = func(context.Context) (*MemberReviewRequest, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MemberReviewRequestMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MemberReviewRequestMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MemberReviewRequestMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MemberReviewRequestMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MemberReviewRequest.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetLogin sets the "login" field.
+func (m *MemberReviewRequestMutation) SetLogin(s string) {
+	m.login = &s
+}
+
+// Login returns the value of the "login" field in the mutation.
+func (m *MemberReviewRequestMutation) Login() (r string, exists bool) {
+	v := m.login
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLogin returns the old "login" field's value of the MemberReviewRequest entity.
+// If the MemberReviewRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberReviewRequestMutation) OldLogin(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLogin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLogin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLogin: %w", err)
+	}
+	return oldValue.Login, nil
+}
+
+// ResetLogin resets all changes to the "login" field.
+func (m *MemberReviewRequestMutation) ResetLogin() {
+	m.login = nil
+}
+
+// SetNameWithOwner sets the "name_with_owner" field.
+func (m *MemberReviewRequestMutation) SetNameWithOwner(s string) {
+	m.name_with_owner = &s
+}
+
+// NameWithOwner returns the value of the "name_with_owner" field in the mutation.
+func (m *MemberReviewRequestMutation) NameWithOwner() (r string, exists bool) {
+	v := m.name_with_owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNameWithOwner returns the old "name_with_owner" field's value of the MemberReviewRequest entity.
+// If the MemberReviewRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberReviewRequestMutation) OldNameWithOwner(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNameWithOwner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNameWithOwner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNameWithOwner: %w", err)
+	}
+	return oldValue.NameWithOwner, nil
+}
+
+// ResetNameWithOwner resets all changes to the "name_with_owner" field.
+func (m *MemberReviewRequestMutation) ResetNameWithOwner() {
+	m.name_with_owner = nil
+}
+
+// SetSourceID sets the "source_id" field.
+func (m *MemberReviewRequestMutation) SetSourceID(s string) {
+	m.source_id = &s
+}
+
+// SourceID returns the value of the "source_id" field in the mutation.
+func (m *MemberReviewRequestMutation) SourceID() (r string, exists bool) {
+	v := m.source_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceID returns the old "source_id" field's value of the MemberReviewRequest entity.
+// If the MemberReviewRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberReviewRequestMutation) OldSourceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceID: %w", err)
+	}
+	return oldValue.SourceID, nil
+}
+
+// ResetSourceID resets all changes to the "source_id" field.
+func (m *MemberReviewRequestMutation) ResetSourceID() {
+	m.source_id = nil
+}
+
+// SetReviewer sets the "reviewer" field.
+func (m *MemberReviewRequestMutation) SetReviewer(s string) {
+	m.reviewer = &s
+}
+
+// Reviewer returns the value of the "reviewer" field in the mutation.
+func (m *MemberReviewRequestMutation) Reviewer() (r string, exists bool) {
+	v := m.reviewer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewer returns the old "reviewer" field's value of the MemberReviewRequest entity.
+// If the MemberReviewRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberReviewRequestMutation) OldReviewer(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewer: %w", err)
+	}
+	return oldValue.Reviewer, nil
+}
+
+// ResetReviewer resets all changes to the "reviewer" field.
+func (m *MemberReviewRequestMutation) ResetReviewer() {
+	m.reviewer = nil
+}
+
+// SetRequestedAt sets the "requested_at" field.
+func (m *MemberReviewRequestMutation) SetRequestedAt(t time.Time) {
+	m.requested_at = &t
+}
+
+// RequestedAt returns the value of the "requested_at" field in the mutation.
+func (m *MemberReviewRequestMutation) RequestedAt() (r time.Time, exists bool) {
+	v := m.requested_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequestedAt returns the old "requested_at" field's value of the MemberReviewRequest entity.
+// If the MemberReviewRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberReviewRequestMutation) OldRequestedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestedAt: %w", err)
+	}
+	return oldValue.RequestedAt, nil
+}
+
+// ResetRequestedAt resets all changes to the "requested_at" field.
+func (m *MemberReviewRequestMutation) ResetRequestedAt() {
+	m.requested_at = nil
+}
+
+// SetCompleted sets the "completed" field.
+func (m *MemberReviewRequestMutation) SetCompleted(b bool) {
+	m.completed = &b
+}
+
+// Completed returns the value of the "completed" field in the mutation.
+func (m *MemberReviewRequestMutation) Completed() (r bool, exists bool) {
+	v := m.completed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompleted returns the old "completed" field's value of the MemberReviewRequest entity.
+// If the MemberReviewRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberReviewRequestMutation) OldCompleted(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompleted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompleted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompleted: %w", err)
+	}
+	return oldValue.Completed, nil
+}
+
+// ResetCompleted resets all changes to the "completed" field.
+func (m *MemberReviewRequestMutation) ResetCompleted() {
+	m.completed = nil
+}
+
+// SetPending sets the "pending" field.
+func (m *MemberReviewRequestMutation) SetPending(b bool) {
+	m.pending = &b
+}
+
+// Pending returns the value of the "pending" field in the mutation.
+func (m *MemberReviewRequestMutation) Pending() (r bool, exists bool) {
+	v := m.pending
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPending returns the old "pending" field's value of the MemberReviewRequest entity.
+// If the MemberReviewRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberReviewRequestMutation) OldPending(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPending is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPending requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPending: %w", err)
+	}
+	return oldValue.Pending, nil
+}
+
+// ResetPending resets all changes to the "pending" field.
+func (m *MemberReviewRequestMutation) ResetPending() {
+	m.pending = nil
+}
+
+// SetSnapshotID sets the "snapshot" edge to the Snapshot entity by id.
+func (m *MemberReviewRequestMutation) SetSnapshotID(id int) {
+	m.snapshot = &id
+}
+
+// ClearSnapshot clears the "snapshot" edge to the Snapshot entity.
+func (m *MemberReviewRequestMutation) ClearSnapshot() {
+	m.clearedsnapshot = true
+}
+
+// SnapshotCleared reports if the "snapshot" edge to the Snapshot entity was cleared.
+func (m *MemberReviewRequestMutation) SnapshotCleared() bool {
+	return m.clearedsnapshot
+}
+
+// SnapshotID returns the "snapshot" edge ID in the mutation.
+func (m *MemberReviewRequestMutation) SnapshotID() (id int, exists bool) {
+	if m.snapshot != nil {
+		return *m.snapshot, true
+	}
+	return
+}
+
+// SnapshotIDs returns the "snapshot" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SnapshotID instead. It exists only for internal usage by the builders.
+func (m *MemberReviewRequestMutation) SnapshotIDs() (ids []int) {
+	if id := m.snapshot; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSnapshot resets all changes to the "snapshot" edge.
+func (m *MemberReviewRequestMutation) ResetSnapshot() {
+	m.snapshot = nil
+	m.clearedsnapshot = false
+}
+
+// Where appends a list predicates to the MemberReviewRequestMutation builder.
+func (m *MemberReviewRequestMutation) Where(ps ...predicate.MemberReviewRequest) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MemberReviewRequestMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MemberReviewRequestMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MemberReviewRequest, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MemberReviewRequestMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MemberReviewRequestMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MemberReviewRequest).
+func (m *MemberReviewRequestMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MemberReviewRequestMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.login != nil {
+		fields = append(fields, memberreviewrequest.FieldLogin)
+	}
+	if m.name_with_owner != nil {
+		fields = append(fields, memberreviewrequest.FieldNameWithOwner)
+	}
+	if m.source_id != nil {
+		fields = append(fields, memberreviewrequest.FieldSourceID)
+	}
+	if m.reviewer != nil {
+		fields = append(fields, memberreviewrequest.FieldReviewer)
+	}
+	if m.requested_at != nil {
+		fields = append(fields, memberreviewrequest.FieldRequestedAt)
+	}
+	if m.completed != nil {
+		fields = append(fields, memberreviewrequest.FieldCompleted)
+	}
+	if m.pending != nil {
+		fields = append(fields, memberreviewrequest.FieldPending)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MemberReviewRequestMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case memberreviewrequest.FieldLogin:
+		return m.Login()
+	case memberreviewrequest.FieldNameWithOwner:
+		return m.NameWithOwner()
+	case memberreviewrequest.FieldSourceID:
+		return m.SourceID()
+	case memberreviewrequest.FieldReviewer:
+		return m.Reviewer()
+	case memberreviewrequest.FieldRequestedAt:
+		return m.RequestedAt()
+	case memberreviewrequest.FieldCompleted:
+		return m.Completed()
+	case memberreviewrequest.FieldPending:
+		return m.Pending()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MemberReviewRequestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case memberreviewrequest.FieldLogin:
+		return m.OldLogin(ctx)
+	case memberreviewrequest.FieldNameWithOwner:
+		return m.OldNameWithOwner(ctx)
+	case memberreviewrequest.FieldSourceID:
+		return m.OldSourceID(ctx)
+	case memberreviewrequest.FieldReviewer:
+		return m.OldReviewer(ctx)
+	case memberreviewrequest.FieldRequestedAt:
+		return m.OldRequestedAt(ctx)
+	case memberreviewrequest.FieldCompleted:
+		return m.OldCompleted(ctx)
+	case memberreviewrequest.FieldPending:
+		return m.OldPending(ctx)
+	}
+	return nil, fmt.Errorf("unknown MemberReviewRequest field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MemberReviewRequestMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case memberreviewrequest.FieldLogin:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLogin(v)
+		return nil
+	case memberreviewrequest.FieldNameWithOwner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNameWithOwner(v)
+		return nil
+	case memberreviewrequest.FieldSourceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceID(v)
+		return nil
+	case memberreviewrequest.FieldReviewer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewer(v)
+		return nil
+	case memberreviewrequest.FieldRequestedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestedAt(v)
+		return nil
+	case memberreviewrequest.FieldCompleted:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompleted(v)
+		return nil
+	case memberreviewrequest.FieldPending:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPending(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MemberReviewRequest field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MemberReviewRequestMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MemberReviewRequestMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MemberReviewRequestMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MemberReviewRequest numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MemberReviewRequestMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MemberReviewRequestMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MemberReviewRequestMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MemberReviewRequest nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MemberReviewRequestMutation) ResetField(name string) error {
+	switch name {
+	case memberreviewrequest.FieldLogin:
+		m.ResetLogin()
+		return nil
+	case memberreviewrequest.FieldNameWithOwner:
+		m.ResetNameWithOwner()
+		return nil
+	case memberreviewrequest.FieldSourceID:
+		m.ResetSourceID()
+		return nil
+	case memberreviewrequest.FieldReviewer:
+		m.ResetReviewer()
+		return nil
+	case memberreviewrequest.FieldRequestedAt:
+		m.ResetRequestedAt()
+		return nil
+	case memberreviewrequest.FieldCompleted:
+		m.ResetCompleted()
+		return nil
+	case memberreviewrequest.FieldPending:
+		m.ResetPending()
+		return nil
+	}
+	return fmt.Errorf("unknown MemberReviewRequest field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MemberReviewRequestMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.snapshot != nil {
+		edges = append(edges, memberreviewrequest.EdgeSnapshot)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MemberReviewRequestMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case memberreviewrequest.EdgeSnapshot:
+		if id := m.snapshot; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MemberReviewRequestMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MemberReviewRequestMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MemberReviewRequestMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsnapshot {
+		edges = append(edges, memberreviewrequest.EdgeSnapshot)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MemberReviewRequestMutation) EdgeCleared(name string) bool {
+	switch name {
+	case memberreviewrequest.EdgeSnapshot:
+		return m.clearedsnapshot
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MemberReviewRequestMutation) ClearEdge(name string) error {
+	switch name {
+	case memberreviewrequest.EdgeSnapshot:
+		m.ClearSnapshot()
+		return nil
+	}
+	return fmt.Errorf("unknown MemberReviewRequest unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MemberReviewRequestMutation) ResetEdge(name string) error {
+	switch name {
+	case memberreviewrequest.EdgeSnapshot:
+		m.ResetSnapshot()
+		return nil
+	}
+	return fmt.Errorf("unknown MemberReviewRequest edge %s", name)
+}
+
+// MemberStatMutation represents an operation that mutates the MemberStat nodes in the graph.
+type MemberStatMutation struct {
+	config
+	op                             Op
+	typ                            string
+	id                             *int
+	login                          *string
+	name                           *string
+	total_commits                  *int
+	addtotal_commits               *int
+	total_pr_created               *int
+	addtotal_pr_created            *int
+	total_pr_merged                *int
+	addtotal_pr_merged             *int
+	total_issues                   *int
+	addtotal_issues                *int
+	total_reviews                  *int
+	addtotal_reviews               *int
+	total_additions                *int
+	addtotal_additions             *int
+	total_deletions                *int
+	addtotal_deletions             *int
+	first_activity_year            *int
+	addfirst_activity_year         *int
+	peak_activity_year             *int
+	addpeak_activity_year          *int
+	peak_activity_commits          *int
+	addpeak_activity_commits       *int
+	total_excluded_reviews         *int
+	addtotal_excluded_reviews      *int
+	total_issues_closed            *int
+	addtotal_issues_closed         *int
+	total_issue_close_seconds      *int
+	addtotal_issue_close_seconds   *int
+	total_bug_issues_opened        *int
+	addtotal_bug_issues_opened     *int
+	total_feature_issues_opened    *int
+	addtotal_feature_issues_opened *int
+	total_bug_issues_closed        *int
+	addtotal_bug_issues_closed     *int
+	total_feature_issues_closed    *int
+	addtotal_feature_issues_closed *int
+	total_feature_prs              *int
+	addtotal_feature_prs           *int
+	total_bug_prs                  *int
+	addtotal_bug_prs               *int
+	total_chore_prs                *int
+	addtotal_chore_prs             *int
+	total_docs_prs                 *int
+	addtotal_docs_prs              *int
+	total_test_prs                 *int
+	addtotal_test_prs              *int
+	total_infra_prs                *int
+	addtotal_infra_prs             *int
+	total_other_prs                *int
+	addtotal_other_prs             *int
+	pr_to_review_ratio             *float64
+	addpr_to_review_ratio          *float64
+	time_zone                      *string
+	clearedFields                  map[string]struct{}
+	snapshot                       *int
+	clearedsnapshot                bool
+	done                           bool
+	oldValue                       func(context.Context) (*MemberStat, error)
+	predicates                     []predicate.MemberStat
+}
+
+var _ ent.Mutation = (*MemberStatMutation)(nil)
+
+// memberstatOption allows management of the mutation configuration using functional options.
+type memberstatOption func(*MemberStatMutation)
+
+// newMemberStatMutation creates new mutation for the MemberStat entity.
+func newMemberStatMutation(c config, op Op, opts ...memberstatOption) *MemberStatMutation {
+	m := &MemberStatMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMemberStat,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMemberStatID sets the ID field of the mutation.
+func withMemberStatID(id int) memberstatOption {
+	return func(m *MemberStatMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MemberStat
+		)
+		m.oldValue = func(ctx context.Context) (*MemberStat, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MemberStat.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMemberStat sets the old MemberStat of the mutation.
+func withMemberStat(node *MemberStat) memberstatOption {
+	return func(m *MemberStatMutation) {
+		m.oldValue = func(context.Context) (*MemberStat, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MemberStatMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MemberStatMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MemberStatMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MemberStatMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MemberStat.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetLogin sets the "login" field.
+func (m *MemberStatMutation) SetLogin(s string) {
+	m.login = &s
+}
+
+// Login returns the value of the "login" field in the mutation.
+func (m *MemberStatMutation) Login() (r string, exists bool) {
+	v := m.login
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLogin returns the old "login" field's value of the MemberStat entity.
+// If the MemberStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberStatMutation) OldLogin(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLogin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLogin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLogin: %w", err)
+	}
+	return oldValue.Login, nil
+}
+
+// ResetLogin resets all changes to the "login" field.
+func (m *MemberStatMutation) ResetLogin() {
+	m.login = nil
+}
+
+// SetName sets the "name" field.
+func (m *MemberStatMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *MemberStatMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the MemberStat entity.
+// If the MemberStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberStatMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *MemberStatMutation) ResetName() {
+	m.name = nil
+}
+
+// SetTotalCommits sets the "total_commits" field.
+func (m *MemberStatMutation) SetTotalCommits(i int) {
+	m.total_commits = &i
+	m.addtotal_commits = nil
+}
+
+// TotalCommits returns the value of the "total_commits" field in the mutation.
+func (m *MemberStatMutation) TotalCommits() (r int, exists bool) {
+	v := m.total_commits
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalCommits returns the old "total_commits" field's value of the MemberStat entity.
+// If the MemberStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberStatMutation) OldTotalCommits(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalCommits is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalCommits requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalCommits: %w", err)
+	}
+	return oldValue.TotalCommits, nil
+}
+
+// AddTotalCommits adds i to the "total_commits" field.
+func (m *MemberStatMutation) AddTotalCommits(i int) {
+	if m.addtotal_commits != nil {
+		*m.addtotal_commits += i
+	} else {
+		m.addtotal_commits = &i
+	}
+}
+
+// AddedTotalCommits returns the value that was added to the "total_commits" field in this mutation.
+func (m *MemberStatMutation) AddedTotalCommits() (r int, exists bool) {
+	v := m.addtotal_commits
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalCommits resets all changes to the "total_commits" field.
+func (m *MemberStatMutation) ResetTotalCommits() {
+	m.total_commits = nil
+	m.addtotal_commits = nil
+}
+
+// SetTotalPrCreated sets the "total_pr_created" field.
+func (m *MemberStatMutation) SetTotalPrCreated(i int) {
+	m.total_pr_created = &i
+	m.addtotal_pr_created = nil
+}
+
+// TotalPrCreated returns the value of the "total_pr_created" field in the mutation.
+func (m *MemberStatMutation) TotalPrCreated() (r int, exists bool) {
+	v := m.total_pr_created
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalPrCreated returns the old "total_pr_created" field's value of the MemberStat entity.
+// If the MemberStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberStatMutation) OldTotalPrCreated(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalPrCreated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalPrCreated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalPrCreated: %w", err)
+	}
+	return oldValue.TotalPrCreated, nil
+}
+
+// AddTotalPrCreated adds i to the "total_pr_created" field.
+func (m *MemberStatMutation) AddTotalPrCreated(i int) {
+	if m.addtotal_pr_created != nil {
+		*m.addtotal_pr_created += i
+	} else {
+		m.addtotal_pr_created = &i
+	}
+}
+
+// AddedTotalPrCreated returns the value that was added to the "total_pr_created" field in this mutation.
+func (m *MemberStatMutation) AddedTotalPrCreated() (r int, exists bool) {
+	v := m.addtotal_pr_created
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalPrCreated resets all changes to the "total_pr_created" field.
+func (m *MemberStatMutation) ResetTotalPrCreated() {
+	m.total_pr_created = nil
+	m.addtotal_pr_created = nil
+}
+
+// SetTotalPrMerged sets the "total_pr_merged" field.
+func (m *MemberStatMutation) SetTotalPrMerged(i int) {
+	m.total_pr_merged = &i
+	m.addtotal_pr_merged = nil
+}
+
+// TotalPrMerged returns the value of the "total_pr_merged" field in the mutation.
+func (m *MemberStatMutation) TotalPrMerged() (r int, exists bool) {
+	v := m.total_pr_merged
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalPrMerged returns the old "total_pr_merged" field's value of the MemberStat entity.
+// If the MemberStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberStatMutation) OldTotalPrMerged(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalPrMerged is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalPrMerged requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalPrMerged: %w", err)
+	}
+	return oldValue.TotalPrMerged, nil
+}
+
+// AddTotalPrMerged adds i to the "total_pr_merged" field.
+func (m *MemberStatMutation) AddTotalPrMerged(i int) {
+	if m.addtotal_pr_merged != nil {
+		*m.addtotal_pr_merged += i
+	} else {
+		m.addtotal_pr_merged = &i
+	}
+}
+
+// AddedTotalPrMerged returns the value that was added to the "total_pr_merged" field in this mutation.
+func (m *MemberStatMutation) AddedTotalPrMerged() (r int, exists bool) {
+	v := m.addtotal_pr_merged
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalPrMerged resets all changes to the "total_pr_merged" field.
+func (m *MemberStatMutation) ResetTotalPrMerged() {
+	m.total_pr_merged = nil
+	m.addtotal_pr_merged = nil
+}
+
+// SetTotalIssues sets the "total_issues" field.
+func (m *MemberStatMutation) SetTotalIssues(i int) {
+	m.total_issues = &i
+	m.addtotal_issues = nil
+}
+
+// TotalIssues returns the value of the "total_issues" field in the mutation.
+func (m *MemberStatMutation) TotalIssues() (r int, exists bool) {
+	v := m.total_issues
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalIssues returns the old "total_issues" field's value of the MemberStat entity.
+// If the MemberStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberStatMutation) OldTotalIssues(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalIssues is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalIssues requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalIssues: %w", err)
+	}
+	return oldValue.TotalIssues, nil
+}
+
+// AddTotalIssues adds i to the "total_issues" field.
+func (m *MemberStatMutation) AddTotalIssues(i int) {
+	if m.addtotal_issues != nil {
+		*m.addtotal_issues += i
+	} else {
+		m.addtotal_issues = &i
+	}
+}
+
+// AddedTotalIssues returns the value that was added to the "total_issues" field in this mutation.
+func (m *MemberStatMutation) AddedTotalIssues() (r int, exists bool) {
+	v := m.addtotal_issues
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalIssues resets all changes to the "total_issues" field.
+func (m *MemberStatMutation) ResetTotalIssues() {
+	m.total_issues = nil
+	m.addtotal_issues = nil
+}
+
+// SetTotalReviews sets the "total_reviews" field.
+func (m *MemberStatMutation) SetTotalReviews(i int) {
+	m.total_reviews = &i
+	m.addtotal_reviews = nil
+}
+
+// TotalReviews returns the value of the "total_reviews" field in the mutation.
+func (m *MemberStatMutation) TotalReviews() (r int, exists bool) {
+	v := m.total_reviews
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalReviews returns the old "total_reviews" field's value of the MemberStat entity.
+// If the MemberStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberStatMutation) OldTotalReviews(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalReviews is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalReviews requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalReviews: %w", err)
+	}
+	return oldValue.TotalReviews, nil
+}
+
+// AddTotalReviews adds i to the "total_reviews" field.
+func (m *MemberStatMutation) AddTotalReviews(i int) {
+	if m.addtotal_reviews != nil {
+		*m.addtotal_reviews += i
+	} else {
+		m.addtotal_reviews = &i
+	}
+}
+
+// AddedTotalReviews returns the value that was added to the "total_reviews" field in this mutation.
+func (m *MemberStatMutation) AddedTotalReviews() (r int, exists bool) {
+	v := m.addtotal_reviews
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalReviews resets all changes to the "total_reviews" field.
+func (m *MemberStatMutation) ResetTotalReviews() {
+	m.total_reviews = nil
+	m.addtotal_reviews = nil
+}
+
+// SetTotalAdditions sets the "total_additions" field.
+func (m *MemberStatMutation) SetTotalAdditions(i int) {
+	m.total_additions = &i
+	m.addtotal_additions = nil
+}
+
+// TotalAdditions returns the value of the "total_additions" field in the mutation.
+func (m *MemberStatMutation) TotalAdditions() (r int, exists bool) {
+	v := m.total_additions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalAdditions returns the old "total_additions" field's value of the MemberStat entity.
+// If the MemberStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberStatMutation) OldTotalAdditions(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalAdditions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalAdditions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalAdditions: %w", err)
+	}
+	return oldValue.TotalAdditions, nil
+}
+
+// AddTotalAdditions adds i to the "total_additions" field.
+func (m *MemberStatMutation) AddTotalAdditions(i int) {
+	if m.addtotal_additions != nil {
+		*m.addtotal_additions += i
+	} else {
+		m.addtotal_additions = &i
+	}
+}
+
+// AddedTotalAdditions returns the value that was added to the "total_additions" field in this mutation.
+func (m *MemberStatMutation) AddedTotalAdditions() (r int, exists bool) {
+	v := m.addtotal_additions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalAdditions resets all changes to the "total_additions" field.
+func (m *MemberStatMutation) ResetTotalAdditions() {
+	m.total_additions = nil
+	m.addtotal_additions = nil
+}
+
+// SetTotalDeletions sets the "total_deletions" field.
+func (m *MemberStatMutation) SetTotalDeletions(i int) {
+	m.total_deletions = &i
+	m.addtotal_deletions = nil
+}
+
+// TotalDeletions returns the value of the "total_deletions" field in the mutation.
+func (m *MemberStatMutation) TotalDeletions() (r int, exists bool) {
+	v := m.total_deletions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalDeletions returns the old "total_deletions" field's value of the MemberStat entity.
+// If the MemberStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberStatMutation) OldTotalDeletions(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalDeletions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalDeletions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalDeletions: %w", err)
+	}
+	return oldValue.TotalDeletions, nil
+}
+
+// AddTotalDeletions adds i to the "total_deletions" field.
+func (m *MemberStatMutation) AddTotalDeletions(i int) {
+	if m.addtotal_deletions != nil {
+		*m.addtotal_deletions += i
+	} else {
+		m.addtotal_deletions = &i
+	}
+}
+
+// AddedTotalDeletions returns the value that was added to the "total_deletions" field in this mutation.
+func (m *MemberStatMutation) AddedTotalDeletions() (r int, exists bool) {
+	v := m.addtotal_deletions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalDeletions resets all changes to the "total_deletions" field.
+func (m *MemberStatMutation) ResetTotalDeletions() {
+	m.total_deletions = nil
+	m.addtotal_deletions = nil
+}
+
+// SetFirstActivityYear sets the "first_activity_year" field.
+func (m *MemberStatMutation) SetFirstActivityYear(i int) {
+	m.first_activity_year = &i
+	m.addfirst_activity_year = nil
+}
+
+// FirstActivityYear returns the value of the "first_activity_year" field in the mutation.
+func (m *MemberStatMutation) FirstActivityYear() (r int, exists bool) {
+	v := m.first_activity_year
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstActivityYear returns the old "first_activity_year" field's value of the MemberStat entity.
+// If the MemberStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberStatMutation) OldFirstActivityYear(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstActivityYear is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstActivityYear requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstActivityYear: %w", err)
+	}
+	return oldValue.FirstActivityYear, nil
+}
+
+// AddFirstActivityYear adds i to the "first_activity_year" field.
+func (m *MemberStatMutation) AddFirstActivityYear(i int) {
+	if m.addfirst_activity_year != nil {
+		*m.addfirst_activity_year += i
+	} else {
+		m.addfirst_activity_year = &i
+	}
+}
+
+// AddedFirstActivityYear returns the value that was added to the "first_activity_year" field in this mutation.
+func (m *MemberStatMutation) AddedFirstActivityYear() (r int, exists bool) {
+	v := m.addfirst_activity_year
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFirstActivityYear resets all changes to the "first_activity_year" field.
+func (m *MemberStatMutation) ResetFirstActivityYear() {
+	m.first_activity_year = nil
+	m.addfirst_activity_year = nil
+}
+
+// SetPeakActivityYear sets the "peak_activity_year" field.
+func (m *MemberStatMutation) SetPeakActivityYear(i int) {
+	m.peak_activity_year = &i
+	m.addpeak_activity_year = nil
+}
+
+// PeakActivityYear returns the value of the "peak_activity_year" field in the mutation.
+func (m *MemberStatMutation) PeakActivityYear() (r int, exists bool) {
+	v := m.peak_activity_year
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPeakActivityYear returns the old "peak_activity_year" field's value of the MemberStat entity.
+// If the MemberStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberStatMutation) OldPeakActivityYear(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPeakActivityYear is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPeakActivityYear requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPeakActivityYear: %w", err)
+	}
+	return oldValue.PeakActivityYear, nil
+}
+
+// AddPeakActivityYear adds i to the "peak_activity_year" field.
+func (m *MemberStatMutation) AddPeakActivityYear(i int) {
+	if m.addpeak_activity_year != nil {
+		*m.addpeak_activity_year += i
+	} else {
+		m.addpeak_activity_year = &i
+	}
+}
+
+// AddedPeakActivityYear returns the value that was added to the "peak_activity_year" field in this mutation.
+func (m *MemberStatMutation) AddedPeakActivityYear() (r int, exists bool) {
+	v := m.addpeak_activity_year
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPeakActivityYear resets all changes to the "peak_activity_year" field.
+func (m *MemberStatMutation) ResetPeakActivityYear() {
+	m.peak_activity_year = nil
+	m.addpeak_activity_year = nil
+}
+
+// SetPeakActivityCommits sets the "peak_activity_commits" field.
+func (m *MemberStatMutation) SetPeakActivityCommits(i int) {
+	m.peak_activity_commits = &i
+	m.addpeak_activity_commits = nil
+}
+
+// PeakActivityCommits returns the value of the "peak_activity_commits" field in the mutation.
+func (m *MemberStatMutation) PeakActivityCommits() (r int, exists bool) {
+	v := m.peak_activity_commits
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPeakActivityCommits returns the old "peak_activity_commits" field's value of the MemberStat entity.
+// If the MemberStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberStatMutation) OldPeakActivityCommits(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPeakActivityCommits is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPeakActivityCommits requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPeakActivityCommits: %w", err)
+	}
+	return oldValue.PeakActivityCommits, nil
+}
+
+// AddPeakActivityCommits adds i to the "peak_activity_commits" field.
+func (m *MemberStatMutation) AddPeakActivityCommits(i int) {
+	if m.addpeak_activity_commits != nil {
+		*m.addpeak_activity_commits += i
+	} else {
+		m.addpeak_activity_commits = &i
+	}
+}
+
+// AddedPeakActivityCommits returns the value that was added to the "peak_activity_commits" field in this mutation.
+func (m *MemberStatMutation) AddedPeakActivityCommits() (r int, exists bool) {
+	v := m.addpeak_activity_commits
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPeakActivityCommits resets all changes to the "peak_activity_commits" field.
+func (m *MemberStatMutation) ResetPeakActivityCommits() {
+	m.peak_activity_commits = nil
+	m.addpeak_activity_commits = nil
+}
+
+// SetTotalExcludedReviews sets the "total_excluded_reviews" field.
+func (m *MemberStatMutation) SetTotalExcludedReviews(i int) {
+	m.total_excluded_reviews = &i
+	m.addtotal_excluded_reviews = nil
+}
+
+// TotalExcludedReviews returns the value of the "total_excluded_reviews" field in the mutation.
+func (m *MemberStatMutation) TotalExcludedReviews() (r int, exists bool) {
+	v := m.total_excluded_reviews
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalExcludedReviews returns the old "total_excluded_reviews" field's value of the MemberStat entity.
+// If the MemberStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberStatMutation) OldTotalExcludedReviews(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalExcludedReviews is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalExcludedReviews requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalExcludedReviews: %w", err)
+	}
+	return oldValue.TotalExcludedReviews, nil
+}
+
+// AddTotalExcludedReviews adds i to the "total_excluded_reviews" field.
+func (m *MemberStatMutation) AddTotalExcludedReviews(i int) {
+	if m.addtotal_excluded_reviews != nil {
+		*m.addtotal_excluded_reviews += i
+	} else {
+		m.addtotal_excluded_reviews = &i
+	}
+}
+
+// AddedTotalExcludedReviews returns the value that was added to the "total_excluded_reviews" field in this mutation.
+func (m *MemberStatMutation) AddedTotalExcludedReviews() (r int, exists bool) {
+	v := m.addtotal_excluded_reviews
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalExcludedReviews resets all changes to the "total_excluded_reviews" field.
+func (m *MemberStatMutation) ResetTotalExcludedReviews() {
+	m.total_excluded_reviews = nil
+	m.addtotal_excluded_reviews = nil
+}
+
+// SetTotalIssuesClosed sets the "total_issues_closed" field.
+func (m *MemberStatMutation) SetTotalIssuesClosed(i int) {
+	m.total_issues_closed = &i
+	m.addtotal_issues_closed = nil
+}
+
+// TotalIssuesClosed returns the value of the "total_issues_closed" field in the mutation.
+func (m *MemberStatMutation) TotalIssuesClosed() (r int, exists bool) {
+	v := m.total_issues_closed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalIssuesClosed returns the old "total_issues_closed" field's value of the MemberStat entity.
+// If the MemberStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberStatMutation) OldTotalIssuesClosed(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalIssuesClosed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalIssuesClosed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalIssuesClosed: %w", err)
+	}
+	return oldValue.TotalIssuesClosed, nil
+}
+
+// AddTotalIssuesClosed adds i to the "total_issues_closed" field.
+func (m *MemberStatMutation) AddTotalIssuesClosed(i int) {
+	if m.addtotal_issues_closed != nil {
+		*m.addtotal_issues_closed += i
+	} else {
+		m.addtotal_issues_closed = &i
+	}
+}
+
+// AddedTotalIssuesClosed returns the value that was added to the "total_issues_closed" field in this mutation.
+func (m *MemberStatMutation) AddedTotalIssuesClosed() (r int, exists bool) {
+	v := m.addtotal_issues_closed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalIssuesClosed resets all changes to the "total_issues_closed" field.
+func (m *MemberStatMutation) ResetTotalIssuesClosed() {
+	m.total_issues_closed = nil
+	m.addtotal_issues_closed = nil
+}
+
+// SetTotalIssueCloseSeconds sets the "total_issue_close_seconds" field.
+func (m *MemberStatMutation) SetTotalIssueCloseSeconds(i int) {
+	m.total_issue_close_seconds = &i
+	m.addtotal_issue_close_seconds = nil
+}
+
+// TotalIssueCloseSeconds returns the value of the "total_issue_close_seconds" field in the mutation.
+func (m *MemberStatMutation) TotalIssueCloseSeconds() (r int, exists bool) {
+	v := m.total_issue_close_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalIssueCloseSeconds returns the old "total_issue_close_seconds" field's value of the MemberStat entity.
+// If the MemberStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberStatMutation) OldTotalIssueCloseSeconds(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalIssueCloseSeconds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalIssueCloseSeconds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalIssueCloseSeconds: %w", err)
+	}
+	return oldValue.TotalIssueCloseSeconds, nil
+}
+
+// AddTotalIssueCloseSeconds adds i to the "total_issue_close_seconds" field.
+func (m *MemberStatMutation) AddTotalIssueCloseSeconds(i int) {
+	if m.addtotal_issue_close_seconds != nil {
+		*m.addtotal_issue_close_seconds += i
+	} else {
+		m.addtotal_issue_close_seconds = &i
+	}
+}
+
+// AddedTotalIssueCloseSeconds returns the value that was added to the "total_issue_close_seconds" field in this mutation.
+func (m *MemberStatMutation) AddedTotalIssueCloseSeconds() (r int, exists bool) {
+	v := m.addtotal_issue_close_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalIssueCloseSeconds resets all changes to the "total_issue_close_seconds" field.
+func (m *MemberStatMutation) ResetTotalIssueCloseSeconds() {
+	m.total_issue_close_seconds = nil
+	m.addtotal_issue_close_seconds = nil
+}
+
+// SetTotalBugIssuesOpened sets the "total_bug_issues_opened" field.
+func (m *MemberStatMutation) SetTotalBugIssuesOpened(i int) {
+	m.total_bug_issues_opened = &i
+	m.addtotal_bug_issues_opened = nil
+}
+
+// TotalBugIssuesOpened returns the value of the "total_bug_issues_opened" field in the mutation.
+func (m *MemberStatMutation) TotalBugIssuesOpened() (r int, exists bool) {
+	v := m.total_bug_issues_opened
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalBugIssuesOpened returns the old "total_bug_issues_opened" field's value of the MemberStat entity.
+// If the MemberStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberStatMutation) OldTotalBugIssuesOpened(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalBugIssuesOpened is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalBugIssuesOpened requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalBugIssuesOpened: %w", err)
+	}
+	return oldValue.TotalBugIssuesOpened, nil
+}
+
+// AddTotalBugIssuesOpened adds i to the "total_bug_issues_opened" field.
+func (m *MemberStatMutation) AddTotalBugIssuesOpened(i int) {
+	if m.addtotal_bug_issues_opened != nil {
+		*m.addtotal_bug_issues_opened += i
+	} else {
+		m.addtotal_bug_issues_opened = &i
+	}
+}
+
+// AddedTotalBugIssuesOpened returns the value that was added to the "total_bug_issues_opened" field in this mutation.
+func (m *MemberStatMutation) AddedTotalBugIssuesOpened() (r int, exists bool) {
+	v := m.addtotal_bug_issues_opened
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalBugIssuesOpened resets all changes to the "total_bug_issues_opened" field.
+func (m *MemberStatMutation) ResetTotalBugIssuesOpened() {
+	m.total_bug_issues_opened = nil
+	m.addtotal_bug_issues_opened = nil
+}
+
+// SetTotalFeatureIssuesOpened sets the "total_feature_issues_opened" field.
+func (m *MemberStatMutation) SetTotalFeatureIssuesOpened(i int) {
+	m.total_feature_issues_opened = &i
+	m.addtotal_feature_issues_opened = nil
+}
+
+// TotalFeatureIssuesOpened returns the value of the "total_feature_issues_opened" field in the mutation.
+func (m *MemberStatMutation) TotalFeatureIssuesOpened() (r int, exists bool) {
+	v := m.total_feature_issues_opened
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalFeatureIssuesOpened returns the old "total_feature_issues_opened" field's value of the MemberStat entity.
+// If the MemberStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberStatMutation) OldTotalFeatureIssuesOpened(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalFeatureIssuesOpened is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalFeatureIssuesOpened requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalFeatureIssuesOpened: %w", err)
+	}
+	return oldValue.TotalFeatureIssuesOpened, nil
+}
+
+// AddTotalFeatureIssuesOpened adds i to the "total_feature_issues_opened" field.
+func (m *MemberStatMutation) AddTotalFeatureIssuesOpened(i int) {
+	if m.addtotal_feature_issues_opened != nil {
+		*m.addtotal_feature_issues_opened += i
+	} else {
+		m.addtotal_feature_issues_opened = &i
+	}
+}
+
+// AddedTotalFeatureIssuesOpened returns the value that was added to the "total_feature_issues_opened" field in this mutation.
+func (m *MemberStatMutation) AddedTotalFeatureIssuesOpened() (r int, exists bool) {
+	v := m.addtotal_feature_issues_opened
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalFeatureIssuesOpened resets all changes to the "total_feature_issues_opened" field.
+func (m *MemberStatMutation) ResetTotalFeatureIssuesOpened() {
+	m.total_feature_issues_opened = nil
+	m.addtotal_feature_issues_opened = nil
+}
+
+// SetTotalBugIssuesClosed sets the "total_bug_issues_closed" field.
+func (m *MemberStatMutation) SetTotalBugIssuesClosed(i int) {
+	m.total_bug_issues_closed = &i
+	m.addtotal_bug_issues_closed = nil
+}
+
+// TotalBugIssuesClosed returns the value of the "total_bug_issues_closed" field in the mutation.
+func (m *MemberStatMutation) TotalBugIssuesClosed() (r int, exists bool) {
+	v := m.total_bug_issues_closed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalBugIssuesClosed returns the old "total_bug_issues_closed" field's value of the MemberStat entity.
+// If the MemberStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberStatMutation) OldTotalBugIssuesClosed(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalBugIssuesClosed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalBugIssuesClosed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalBugIssuesClosed: %w", err)
+	}
+	return oldValue.TotalBugIssuesClosed, nil
+}
+
+// AddTotalBugIssuesClosed adds i to the "total_bug_issues_closed" field.
+func (m *MemberStatMutation) AddTotalBugIssuesClosed(i int) {
+	if m.addtotal_bug_issues_closed != nil {
+		*m.addtotal_bug_issues_closed += i
+	} else {
+		m.addtotal_bug_issues_closed = &i
+	}
+}
+
+// AddedTotalBugIssuesClosed returns the value that was added to the "total_bug_issues_closed" field in this mutation.
+func (m *MemberStatMutation) AddedTotalBugIssuesClosed() (r int, exists bool) {
+	v := m.addtotal_bug_issues_closed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalBugIssuesClosed resets all changes to the "total_bug_issues_closed" field.
+func (m *MemberStatMutation) ResetTotalBugIssuesClosed() {
+	m.total_bug_issues_closed = nil
+	m.addtotal_bug_issues_closed = nil
+}
+
+// SetTotalFeatureIssuesClosed sets the "total_feature_issues_closed" field.
+func (m *MemberStatMutation) SetTotalFeatureIssuesClosed(i int) {
+	m.total_feature_issues_closed = &i
+	m.addtotal_feature_issues_closed = nil
+}
+
+// TotalFeatureIssuesClosed returns the value of the "total_feature_issues_closed" field in the mutation.
+func (m *MemberStatMutation) TotalFeatureIssuesClosed() (r int, exists bool) {
+	v := m.total_feature_issues_closed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalFeatureIssuesClosed returns the old "total_feature_issues_closed" field's value of the MemberStat entity.
+// If the MemberStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberStatMutation) OldTotalFeatureIssuesClosed(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalFeatureIssuesClosed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalFeatureIssuesClosed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalFeatureIssuesClosed: %w", err)
+	}
+	return oldValue.TotalFeatureIssuesClosed, nil
+}
+
+// AddTotalFeatureIssuesClosed adds i to the "total_feature_issues_closed" field.
+func (m *MemberStatMutation) AddTotalFeatureIssuesClosed(i int) {
+	if m.addtotal_feature_issues_closed != nil {
+		*m.addtotal_feature_issues_closed += i
+	} else {
+		m.addtotal_feature_issues_closed = &i
+	}
+}
+
+// AddedTotalFeatureIssuesClosed returns the value that was added to the "total_feature_issues_closed" field in this mutation.
+func (m *MemberStatMutation) AddedTotalFeatureIssuesClosed() (r int, exists bool) {
+	v := m.addtotal_feature_issues_closed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalFeatureIssuesClosed resets all changes to the "total_feature_issues_closed" field.
+func (m *MemberStatMutation) ResetTotalFeatureIssuesClosed() {
+	m.total_feature_issues_closed = nil
+	m.addtotal_feature_issues_closed = nil
+}
+
+// SetTotalFeaturePrs sets the "total_feature_prs" field.
+func (m *MemberStatMutation) SetTotalFeaturePrs(i int) {
+	m.total_feature_prs = &i
+	m.addtotal_feature_prs = nil
+}
+
+// TotalFeaturePrs returns the value of the "total_feature_prs" field in the mutation.
+func (m *MemberStatMutation) TotalFeaturePrs() (r int, exists bool) {
+	v := m.total_feature_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalFeaturePrs returns the old "total_feature_prs" field's value of the MemberStat entity.
+// If the MemberStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberStatMutation) OldTotalFeaturePrs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalFeaturePrs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalFeaturePrs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalFeaturePrs: %w", err)
+	}
+	return oldValue.TotalFeaturePrs, nil
+}
+
+// AddTotalFeaturePrs adds i to the "total_feature_prs" field.
+func (m *MemberStatMutation) AddTotalFeaturePrs(i int) {
+	if m.addtotal_feature_prs != nil {
+		*m.addtotal_feature_prs += i
+	} else {
+		m.addtotal_feature_prs = &i
+	}
+}
+
+// AddedTotalFeaturePrs returns the value that was added to the "total_feature_prs" field in this mutation.
+func (m *MemberStatMutation) AddedTotalFeaturePrs() (r int, exists bool) {
+	v := m.addtotal_feature_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalFeaturePrs resets all changes to the "total_feature_prs" field.
+func (m *MemberStatMutation) ResetTotalFeaturePrs() {
+	m.total_feature_prs = nil
+	m.addtotal_feature_prs = nil
+}
+
+// SetTotalBugPrs sets the "total_bug_prs" field.
+func (m *MemberStatMutation) SetTotalBugPrs(i int) {
+	m.total_bug_prs = &i
+	m.addtotal_bug_prs = nil
+}
+
+// TotalBugPrs returns the value of the "total_bug_prs" field in the mutation.
+func (m *MemberStatMutation) TotalBugPrs() (r int, exists bool) {
+	v := m.total_bug_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalBugPrs returns the old "total_bug_prs" field's value of the MemberStat entity.
+// If the MemberStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberStatMutation) OldTotalBugPrs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalBugPrs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalBugPrs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalBugPrs: %w", err)
+	}
+	return oldValue.TotalBugPrs, nil
+}
+
+// AddTotalBugPrs adds i to the "total_bug_prs" field.
+func (m *MemberStatMutation) AddTotalBugPrs(i int) {
+	if m.addtotal_bug_prs != nil {
+		*m.addtotal_bug_prs += i
+	} else {
+		m.addtotal_bug_prs = &i
+	}
+}
+
+// AddedTotalBugPrs returns the value that was added to the "total_bug_prs" field in this mutation.
+func (m *MemberStatMutation) AddedTotalBugPrs() (r int, exists bool) {
+	v := m.addtotal_bug_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalBugPrs resets all changes to the "total_bug_prs" field.
+func (m *MemberStatMutation) ResetTotalBugPrs() {
+	m.total_bug_prs = nil
+	m.addtotal_bug_prs = nil
+}
+
+// SetTotalChorePrs sets the "total_chore_prs" field.
+func (m *MemberStatMutation) SetTotalChorePrs(i int) {
+	m.total_chore_prs = &i
+	m.addtotal_chore_prs = nil
+}
+
+// TotalChorePrs returns the value of the "total_chore_prs" field in the mutation.
+func (m *MemberStatMutation) TotalChorePrs() (r int, exists bool) {
+	v := m.total_chore_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalChorePrs returns the old "total_chore_prs" field's value of the MemberStat entity.
+// If the MemberStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberStatMutation) OldTotalChorePrs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalChorePrs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalChorePrs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalChorePrs: %w", err)
+	}
+	return oldValue.TotalChorePrs, nil
+}
+
+// AddTotalChorePrs adds i to the "total_chore_prs" field.
+func (m *MemberStatMutation) AddTotalChorePrs(i int) {
+	if m.addtotal_chore_prs != nil {
+		*m.addtotal_chore_prs += i
+	} else {
+		m.addtotal_chore_prs = &i
+	}
+}
+
+// AddedTotalChorePrs returns the value that was added to the "total_chore_prs" field in this mutation.
+func (m *MemberStatMutation) AddedTotalChorePrs() (r int, exists bool) {
+	v := m.addtotal_chore_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalChorePrs resets all changes to the "total_chore_prs" field.
+func (m *MemberStatMutation) ResetTotalChorePrs() {
+	m.total_chore_prs = nil
+	m.addtotal_chore_prs = nil
+}
+
+// SetTotalDocsPrs sets the "total_docs_prs" field.
+func (m *MemberStatMutation) SetTotalDocsPrs(i int) {
+	m.total_docs_prs = &i
+	m.addtotal_docs_prs = nil
+}
+
+// TotalDocsPrs returns the value of the "total_docs_prs" field in the mutation.
+func (m *MemberStatMutation) TotalDocsPrs() (r int, exists bool) {
+	v := m.total_docs_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalDocsPrs returns the old "total_docs_prs" field's value of the MemberStat entity.
+// If the MemberStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberStatMutation) OldTotalDocsPrs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalDocsPrs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalDocsPrs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalDocsPrs: %w", err)
+	}
+	return oldValue.TotalDocsPrs, nil
+}
+
+// AddTotalDocsPrs adds i to the "total_docs_prs" field.
+func (m *MemberStatMutation) AddTotalDocsPrs(i int) {
+	if m.addtotal_docs_prs != nil {
+		*m.addtotal_docs_prs += i
+	} else {
+		m.addtotal_docs_prs = &i
+	}
+}
+
+// AddedTotalDocsPrs returns the value that was added to the "total_docs_prs" field in this mutation.
+func (m *MemberStatMutation) AddedTotalDocsPrs() (r int, exists bool) {
+	v := m.addtotal_docs_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalDocsPrs resets all changes to the "total_docs_prs" field.
+func (m *MemberStatMutation) ResetTotalDocsPrs() {
+	m.total_docs_prs = nil
+	m.addtotal_docs_prs = nil
+}
+
+// SetTotalTestPrs sets the "total_test_prs" field.
+func (m *MemberStatMutation) SetTotalTestPrs(i int) {
+	m.total_test_prs = &i
+	m.addtotal_test_prs = nil
+}
+
+// TotalTestPrs returns the value of the "total_test_prs" field in the mutation.
+func (m *MemberStatMutation) TotalTestPrs() (r int, exists bool) {
+	v := m.total_test_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalTestPrs returns the old "total_test_prs" field's value of the MemberStat entity.
+// If the MemberStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberStatMutation) OldTotalTestPrs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalTestPrs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalTestPrs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalTestPrs: %w", err)
+	}
+	return oldValue.TotalTestPrs, nil
+}
+
+// AddTotalTestPrs adds i to the "total_test_prs" field.
+func (m *MemberStatMutation) AddTotalTestPrs(i int) {
+	if m.addtotal_test_prs != nil {
+		*m.addtotal_test_prs += i
+	} else {
+		m.addtotal_test_prs = &i
+	}
+}
+
+// AddedTotalTestPrs returns the value that was added to the "total_test_prs" field in this mutation.
+func (m *MemberStatMutation) AddedTotalTestPrs() (r int, exists bool) {
+	v := m.addtotal_test_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalTestPrs resets all changes to the "total_test_prs" field.
+func (m *MemberStatMutation) ResetTotalTestPrs() {
+	m.total_test_prs = nil
+	m.addtotal_test_prs = nil
+}
+
+// SetTotalInfraPrs sets the "total_infra_prs" field.
+func (m *MemberStatMutation) SetTotalInfraPrs(i int) {
+	m.total_infra_prs = &i
+	m.addtotal_infra_prs = nil
+}
+
+// TotalInfraPrs returns the value of the "total_infra_prs" field in the mutation.
+func (m *MemberStatMutation) TotalInfraPrs() (r int, exists bool) {
+	v := m.total_infra_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalInfraPrs returns the old "total_infra_prs" field's value of the MemberStat entity.
+// If the MemberStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberStatMutation) OldTotalInfraPrs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalInfraPrs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalInfraPrs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalInfraPrs: %w", err)
+	}
+	return oldValue.TotalInfraPrs, nil
+}
+
+// AddTotalInfraPrs adds i to the "total_infra_prs" field.
+func (m *MemberStatMutation) AddTotalInfraPrs(i int) {
+	if m.addtotal_infra_prs != nil {
+		*m.addtotal_infra_prs += i
+	} else {
+		m.addtotal_infra_prs = &i
+	}
+}
+
+// AddedTotalInfraPrs returns the value that was added to the "total_infra_prs" field in this mutation.
+func (m *MemberStatMutation) AddedTotalInfraPrs() (r int, exists bool) {
+	v := m.addtotal_infra_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalInfraPrs resets all changes to the "total_infra_prs" field.
+func (m *MemberStatMutation) ResetTotalInfraPrs() {
+	m.total_infra_prs = nil
+	m.addtotal_infra_prs = nil
+}
+
+// SetTotalOtherPrs sets the "total_other_prs" field.
+func (m *MemberStatMutation) SetTotalOtherPrs(i int) {
+	m.total_other_prs = &i
+	m.addtotal_other_prs = nil
+}
+
+// TotalOtherPrs returns the value of the "total_other_prs" field in the mutation.
+func (m *MemberStatMutation) TotalOtherPrs() (r int, exists bool) {
+	v := m.total_other_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalOtherPrs returns the old "total_other_prs" field's value of the MemberStat entity.
+// If the MemberStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberStatMutation) OldTotalOtherPrs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalOtherPrs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalOtherPrs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalOtherPrs: %w", err)
+	}
+	return oldValue.TotalOtherPrs, nil
+}
+
+// AddTotalOtherPrs adds i to the "total_other_prs" field.
+func (m *MemberStatMutation) AddTotalOtherPrs(i int) {
+	if m.addtotal_other_prs != nil {
+		*m.addtotal_other_prs += i
+	} else {
+		m.addtotal_other_prs = &i
+	}
+}
+
+// AddedTotalOtherPrs returns the value that was added to the "total_other_prs" field in this mutation.
+func (m *MemberStatMutation) AddedTotalOtherPrs() (r int, exists bool) {
+	v := m.addtotal_other_prs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalOtherPrs resets all changes to the "total_other_prs" field.
+func (m *MemberStatMutation) ResetTotalOtherPrs() {
+	m.total_other_prs = nil
+	m.addtotal_other_prs = nil
+}
+
+// SetPrToReviewRatio sets the "pr_to_review_ratio" field.
+func (m *MemberStatMutation) SetPrToReviewRatio(f float64) {
+	m.pr_to_review_ratio = &f
+	m.addpr_to_review_ratio = nil
+}
+
+// PrToReviewRatio returns the value of the "pr_to_review_ratio" field in the mutation.
+func (m *MemberStatMutation) PrToReviewRatio() (r float64, exists bool) {
+	v := m.pr_to_review_ratio
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrToReviewRatio returns the old "pr_to_review_ratio" field's value of the MemberStat entity.
+// If the MemberStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberStatMutation) OldPrToReviewRatio(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrToReviewRatio is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrToReviewRatio requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrToReviewRatio: %w", err)
+	}
+	return oldValue.PrToReviewRatio, nil
+}
+
+// AddPrToReviewRatio adds f to the "pr_to_review_ratio" field.
+func (m *MemberStatMutation) AddPrToReviewRatio(f float64) {
+	if m.addpr_to_review_ratio != nil {
+		*m.addpr_to_review_ratio += f
+	} else {
+		m.addpr_to_review_ratio = &f
+	}
+}
+
+// AddedPrToReviewRatio returns the value that was added to the "pr_to_review_ratio" field in this mutation.
+func (m *MemberStatMutation) AddedPrToReviewRatio() (r float64, exists bool) {
+	v := m.addpr_to_review_ratio
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPrToReviewRatio resets all changes to the "pr_to_review_ratio" field.
+func (m *MemberStatMutation) ResetPrToReviewRatio() {
+	m.pr_to_review_ratio = nil
+	m.addpr_to_review_ratio = nil
+}
+
+// SetTimeZone sets the "time_zone" field.
+func (m *MemberStatMutation) SetTimeZone(s string) {
+	m.time_zone = &s
+}
+
+// TimeZone returns the value of the "time_zone" field in the mutation.
+func (m *MemberStatMutation) TimeZone() (r string, exists bool) {
+	v := m.time_zone
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimeZone returns the old "time_zone" field's value of the MemberStat entity.
+// If the MemberStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberStatMutation) OldTimeZone(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimeZone is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimeZone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimeZone: %w", err)
+	}
+	return oldValue.TimeZone, nil
+}
+
+// ResetTimeZone resets all changes to the "time_zone" field.
+func (m *MemberStatMutation) ResetTimeZone() {
+	m.time_zone = nil
+}
+
+// SetSnapshotID sets the "snapshot" edge to the Snapshot entity by id.
+func (m *MemberStatMutation) SetSnapshotID(id int) {
+	m.snapshot = &id
+}
+
+// ClearSnapshot clears the "snapshot" edge to the Snapshot entity.
+func (m *MemberStatMutation) ClearSnapshot() {
+	m.clearedsnapshot = true
+}
+
+// SnapshotCleared reports if the "snapshot" edge to the Snapshot entity was cleared.
+func (m *MemberStatMutation) SnapshotCleared() bool {
+	return m.clearedsnapshot
+}
+
+// SnapshotID returns the "snapshot" edge ID in the mutation.
+func (m *MemberStatMutation) SnapshotID() (id int, exists bool) {
+	if m.snapshot != nil {
+		return *m.snapshot, true
+	}
+	return
+}
+
+// SnapshotIDs returns the "snapshot" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SnapshotID instead. It exists only for internal usage by the builders.
+func (m *MemberStatMutation) SnapshotIDs() (ids []int) {
+	if id := m.snapshot; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSnapshot resets all changes to the "snapshot" edge.
+func (m *MemberStatMutation) ResetSnapshot() {
+	m.snapshot = nil
+	m.clearedsnapshot = false
+}
+
+// Where appends a list predicates to the MemberStatMutation builder.
+func (m *MemberStatMutation) Where(ps ...predicate.MemberStat) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MemberStatMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MemberStatMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MemberStat, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MemberStatMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MemberStatMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MemberStat).
+func (m *MemberStatMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MemberStatMutation) Fields() []string {
+	fields := make([]string, 0, 28)
+	if m.login != nil {
+		fields = append(fields, memberstat.FieldLogin)
+	}
+	if m.name != nil {
+		fields = append(fields, memberstat.FieldName)
+	}
+	if m.total_commits != nil {
+		fields = append(fields, memberstat.FieldTotalCommits)
+	}
+	if m.total_pr_created != nil {
+		fields = append(fields, memberstat.FieldTotalPrCreated)
+	}
+	if m.total_pr_merged != nil {
+		fields = append(fields, memberstat.FieldTotalPrMerged)
+	}
+	if m.total_issues != nil {
+		fields = append(fields, memberstat.FieldTotalIssues)
+	}
+	if m.total_reviews != nil {
+		fields = append(fields, memberstat.FieldTotalReviews)
+	}
+	if m.total_additions != nil {
+		fields = append(fields, memberstat.FieldTotalAdditions)
+	}
+	if m.total_deletions != nil {
+		fields = append(fields, memberstat.FieldTotalDeletions)
+	}
+	if m.first_activity_year != nil {
+		fields = append(fields, memberstat.FieldFirstActivityYear)
+	}
+	if m.peak_activity_year != nil {
+		fields = append(fields, memberstat.FieldPeakActivityYear)
+	}
+	if m.peak_activity_commits != nil {
+		fields = append(fields, memberstat.FieldPeakActivityCommits)
+	}
+	if m.total_excluded_reviews != nil {
+		fields = append(fields, memberstat.FieldTotalExcludedReviews)
+	}
+	if m.total_issues_closed != nil {
+		fields = append(fields, memberstat.FieldTotalIssuesClosed)
+	}
+	if m.total_issue_close_seconds != nil {
+		fields = append(fields, memberstat.FieldTotalIssueCloseSeconds)
+	}
+	if m.total_bug_issues_opened != nil {
+		fields = append(fields, memberstat.FieldTotalBugIssuesOpened)
+	}
+	if m.total_feature_issues_opened != nil {
+		fields = append(fields, memberstat.FieldTotalFeatureIssuesOpened)
+	}
+	if m.total_bug_issues_closed != nil {
+		fields = append(fields, memberstat.FieldTotalBugIssuesClosed)
+	}
+	if m.total_feature_issues_closed != nil {
+		fields = append(fields, memberstat.FieldTotalFeatureIssuesClosed)
+	}
+	if m.total_feature_prs != nil {
+		fields = append(fields, memberstat.FieldTotalFeaturePrs)
+	}
+	if m.total_bug_prs != nil {
+		fields = append(fields, memberstat.FieldTotalBugPrs)
+	}
+	if m.total_chore_prs != nil {
+		fields = append(fields, memberstat.FieldTotalChorePrs)
+	}
+	if m.total_docs_prs != nil {
+		fields = append(fields, memberstat.FieldTotalDocsPrs)
+	}
+	if m.total_test_prs != nil {
+		fields = append(fields, memberstat.FieldTotalTestPrs)
+	}
+	if m.total_infra_prs != nil {
+		fields = append(fields, memberstat.FieldTotalInfraPrs)
+	}
+	if m.total_other_prs != nil {
+		fields = append(fields, memberstat.FieldTotalOtherPrs)
+	}
+	if m.pr_to_review_ratio != nil {
+		fields = append(fields, memberstat.FieldPrToReviewRatio)
+	}
+	if m.time_zone != nil {
+		fields = append(fields, memberstat.FieldTimeZone)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MemberStatMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case memberstat.FieldLogin:
+		return m.Login()
+	case memberstat.FieldName:
+		return m.Name()
+	case memberstat.FieldTotalCommits:
+		return m.TotalCommits()
+	case memberstat.FieldTotalPrCreated:
+		return m.TotalPrCreated()
+	case memberstat.FieldTotalPrMerged:
+		return m.TotalPrMerged()
+	case memberstat.FieldTotalIssues:
+		return m.TotalIssues()
+	case memberstat.FieldTotalReviews:
+		return m.TotalReviews()
+	case memberstat.FieldTotalAdditions:
+		return m.TotalAdditions()
+	case memberstat.FieldTotalDeletions:
+		return m.TotalDeletions()
+	case memberstat.FieldFirstActivityYear:
+		return m.FirstActivityYear()
+	case memberstat.FieldPeakActivityYear:
+		return m.PeakActivityYear()
+	case memberstat.FieldPeakActivityCommits:
+		return m.PeakActivityCommits()
+	case memberstat.FieldTotalExcludedReviews:
+		return m.TotalExcludedReviews()
+	case memberstat.FieldTotalIssuesClosed:
+		return m.TotalIssuesClosed()
+	case memberstat.FieldTotalIssueCloseSeconds:
+		return m.TotalIssueCloseSeconds()
+	case memberstat.FieldTotalBugIssuesOpened:
+		return m.TotalBugIssuesOpened()
+	case memberstat.FieldTotalFeatureIssuesOpened:
+		return m.TotalFeatureIssuesOpened()
+	case memberstat.FieldTotalBugIssuesClosed:
+		return m.TotalBugIssuesClosed()
+	case memberstat.FieldTotalFeatureIssuesClosed:
+		return m.TotalFeatureIssuesClosed()
+	case memberstat.FieldTotalFeaturePrs:
+		return m.TotalFeaturePrs()
+	case memberstat.FieldTotalBugPrs:
+		return m.TotalBugPrs()
+	case memberstat.FieldTotalChorePrs:
+		return m.TotalChorePrs()
+	case memberstat.FieldTotalDocsPrs:
+		return m.TotalDocsPrs()
+	case memberstat.FieldTotalTestPrs:
+		return m.TotalTestPrs()
+	case memberstat.FieldTotalInfraPrs:
+		return m.TotalInfraPrs()
+	case memberstat.FieldTotalOtherPrs:
+		return m.TotalOtherPrs()
+	case memberstat.FieldPrToReviewRatio:
+		return m.PrToReviewRatio()
+	case memberstat.FieldTimeZone:
+		return m.TimeZone()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MemberStatMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case memberstat.FieldLogin:
+		return m.OldLogin(ctx)
+	case memberstat.FieldName:
+		return m.OldName(ctx)
+	case memberstat.FieldTotalCommits:
+		return m.OldTotalCommits(ctx)
+	case memberstat.FieldTotalPrCreated:
+		return m.OldTotalPrCreated(ctx)
+	case memberstat.FieldTotalPrMerged:
+		return m.OldTotalPrMerged(ctx)
+	case memberstat.FieldTotalIssues:
+		return m.OldTotalIssues(ctx)
+	case memberstat.FieldTotalReviews:
+		return m.OldTotalReviews(ctx)
+	case memberstat.FieldTotalAdditions:
+		return m.OldTotalAdditions(ctx)
+	case memberstat.FieldTotalDeletions:
+		return m.OldTotalDeletions(ctx)
+	case memberstat.FieldFirstActivityYear:
+		return m.OldFirstActivityYear(ctx)
+	case memberstat.FieldPeakActivityYear:
+		return m.OldPeakActivityYear(ctx)
+	case memberstat.FieldPeakActivityCommits:
+		return m.OldPeakActivityCommits(ctx)
+	case memberstat.FieldTotalExcludedReviews:
+		return m.OldTotalExcludedReviews(ctx)
+	case memberstat.FieldTotalIssuesClosed:
+		return m.OldTotalIssuesClosed(ctx)
+	case memberstat.FieldTotalIssueCloseSeconds:
+		return m.OldTotalIssueCloseSeconds(ctx)
+	case memberstat.FieldTotalBugIssuesOpened:
+		return m.OldTotalBugIssuesOpened(ctx)
+	case memberstat.FieldTotalFeatureIssuesOpened:
+		return m.OldTotalFeatureIssuesOpened(ctx)
+	case memberstat.FieldTotalBugIssuesClosed:
+		return m.OldTotalBugIssuesClosed(ctx)
+	case memberstat.FieldTotalFeatureIssuesClosed:
+		return m.OldTotalFeatureIssuesClosed(ctx)
+	case memberstat.FieldTotalFeaturePrs:
+		return m.OldTotalFeaturePrs(ctx)
+	case memberstat.FieldTotalBugPrs:
+		return m.OldTotalBugPrs(ctx)
+	case memberstat.FieldTotalChorePrs:
+		return m.OldTotalChorePrs(ctx)
+	case memberstat.FieldTotalDocsPrs:
+		return m.OldTotalDocsPrs(ctx)
+	case memberstat.FieldTotalTestPrs:
+		return m.OldTotalTestPrs(ctx)
+	case memberstat.FieldTotalInfraPrs:
+		return m.OldTotalInfraPrs(ctx)
+	case memberstat.FieldTotalOtherPrs:
+		return m.OldTotalOtherPrs(ctx)
+	case memberstat.FieldPrToReviewRatio:
+		return m.OldPrToReviewRatio(ctx)
+	case memberstat.FieldTimeZone:
+		return m.OldTimeZone(ctx)
+	}
+	return nil, fmt.Errorf("unknown MemberStat field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MemberStatMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case memberstat.FieldLogin:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLogin(v)
+		return nil
+	case memberstat.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case memberstat.FieldTotalCommits:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalCommits(v)
+		return nil
+	case memberstat.FieldTotalPrCreated:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalPrCreated(v)
+		return nil
+	case memberstat.FieldTotalPrMerged:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalPrMerged(v)
+		return nil
+	case memberstat.FieldTotalIssues:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalIssues(v)
+		return nil
+	case memberstat.FieldTotalReviews:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalReviews(v)
+		return nil
+	case memberstat.FieldTotalAdditions:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalAdditions(v)
+		return nil
+	case memberstat.FieldTotalDeletions:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalDeletions(v)
+		return nil
+	case memberstat.FieldFirstActivityYear:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstActivityYear(v)
+		return nil
+	case memberstat.FieldPeakActivityYear:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPeakActivityYear(v)
+		return nil
+	case memberstat.FieldPeakActivityCommits:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPeakActivityCommits(v)
+		return nil
+	case memberstat.FieldTotalExcludedReviews:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalExcludedReviews(v)
+		return nil
+	case memberstat.FieldTotalIssuesClosed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalIssuesClosed(v)
+		return nil
+	case memberstat.FieldTotalIssueCloseSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalIssueCloseSeconds(v)
+		return nil
+	case memberstat.FieldTotalBugIssuesOpened:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalBugIssuesOpened(v)
+		return nil
+	case memberstat.FieldTotalFeatureIssuesOpened:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalFeatureIssuesOpened(v)
+		return nil
+	case memberstat.FieldTotalBugIssuesClosed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalBugIssuesClosed(v)
+		return nil
+	case memberstat.FieldTotalFeatureIssuesClosed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalFeatureIssuesClosed(v)
+		return nil
+	case memberstat.FieldTotalFeaturePrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalFeaturePrs(v)
+		return nil
+	case memberstat.FieldTotalBugPrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalBugPrs(v)
+		return nil
+	case memberstat.FieldTotalChorePrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalChorePrs(v)
+		return nil
+	case memberstat.FieldTotalDocsPrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalDocsPrs(v)
+		return nil
+	case memberstat.FieldTotalTestPrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalTestPrs(v)
+		return nil
+	case memberstat.FieldTotalInfraPrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalInfraPrs(v)
+		return nil
+	case memberstat.FieldTotalOtherPrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalOtherPrs(v)
+		return nil
+	case memberstat.FieldPrToReviewRatio:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrToReviewRatio(v)
+		return nil
+	case memberstat.FieldTimeZone:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimeZone(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MemberStat field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MemberStatMutation) AddedFields() []string {
+	var fields []string
+	if m.addtotal_commits != nil {
+		fields = append(fields, memberstat.FieldTotalCommits)
+	}
+	if m.addtotal_pr_created != nil {
+		fields = append(fields, memberstat.FieldTotalPrCreated)
+	}
+	if m.addtotal_pr_merged != nil {
+		fields = append(fields, memberstat.FieldTotalPrMerged)
+	}
+	if m.addtotal_issues != nil {
+		fields = append(fields, memberstat.FieldTotalIssues)
+	}
+	if m.addtotal_reviews != nil {
+		fields = append(fields, memberstat.FieldTotalReviews)
+	}
+	if m.addtotal_additions != nil {
+		fields = append(fields, memberstat.FieldTotalAdditions)
+	}
+	if m.addtotal_deletions != nil {
+		fields = append(fields, memberstat.FieldTotalDeletions)
+	}
+	if m.addfirst_activity_year != nil {
+		fields = append(fields, memberstat.FieldFirstActivityYear)
+	}
+	if m.addpeak_activity_year != nil {
+		fields = append(fields, memberstat.FieldPeakActivityYear)
+	}
+	if m.addpeak_activity_commits != nil {
+		fields = append(fields, memberstat.FieldPeakActivityCommits)
+	}
+	if m.addtotal_excluded_reviews != nil {
+		fields = append(fields, memberstat.FieldTotalExcludedReviews)
+	}
+	if m.addtotal_issues_closed != nil {
+		fields = append(fields, memberstat.FieldTotalIssuesClosed)
+	}
+	if m.addtotal_issue_close_seconds != nil {
+		fields = append(fields, memberstat.FieldTotalIssueCloseSeconds)
+	}
+	if m.addtotal_bug_issues_opened != nil {
+		fields = append(fields, memberstat.FieldTotalBugIssuesOpened)
+	}
+	if m.addtotal_feature_issues_opened != nil {
+		fields = append(fields, memberstat.FieldTotalFeatureIssuesOpened)
+	}
+	if m.addtotal_bug_issues_closed != nil {
+		fields = append(fields, memberstat.FieldTotalBugIssuesClosed)
+	}
+	if m.addtotal_feature_issues_closed != nil {
+		fields = append(fields, memberstat.FieldTotalFeatureIssuesClosed)
+	}
+	if m.addtotal_feature_prs != nil {
+		fields = append(fields, memberstat.FieldTotalFeaturePrs)
+	}
+	if m.addtotal_bug_prs != nil {
+		fields = append(fields, memberstat.FieldTotalBugPrs)
+	}
+	if m.addtotal_chore_prs != nil {
+		fields = append(fields, memberstat.FieldTotalChorePrs)
+	}
+	if m.addtotal_docs_prs != nil {
+		fields = append(fields, memberstat.FieldTotalDocsPrs)
+	}
+	if m.addtotal_test_prs != nil {
+		fields = append(fields, memberstat.FieldTotalTestPrs)
+	}
+	if m.addtotal_infra_prs != nil {
+		fields = append(fields, memberstat.FieldTotalInfraPrs)
+	}
+	if m.addtotal_other_prs != nil {
+		fields = append(fields, memberstat.FieldTotalOtherPrs)
+	}
+	if m.addpr_to_review_ratio != nil {
+		fields = append(fields, memberstat.FieldPrToReviewRatio)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MemberStatMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case memberstat.FieldTotalCommits:
+		return m.AddedTotalCommits()
+	case memberstat.FieldTotalPrCreated:
+		return m.AddedTotalPrCreated()
+	case memberstat.FieldTotalPrMerged:
+		return m.AddedTotalPrMerged()
+	case memberstat.FieldTotalIssues:
+		return m.AddedTotalIssues()
+	case memberstat.FieldTotalReviews:
+		return m.AddedTotalReviews()
+	case memberstat.FieldTotalAdditions:
+		return m.AddedTotalAdditions()
+	case memberstat.FieldTotalDeletions:
+		return m.AddedTotalDeletions()
+	case memberstat.FieldFirstActivityYear:
+		return m.AddedFirstActivityYear()
+	case memberstat.FieldPeakActivityYear:
+		return m.AddedPeakActivityYear()
+	case memberstat.FieldPeakActivityCommits:
+		return m.AddedPeakActivityCommits()
+	case memberstat.FieldTotalExcludedReviews:
+		return m.AddedTotalExcludedReviews()
+	case memberstat.FieldTotalIssuesClosed:
+		return m.AddedTotalIssuesClosed()
+	case memberstat.FieldTotalIssueCloseSeconds:
+		return m.AddedTotalIssueCloseSeconds()
+	case memberstat.FieldTotalBugIssuesOpened:
+		return m.AddedTotalBugIssuesOpened()
+	case memberstat.FieldTotalFeatureIssuesOpened:
+		return m.AddedTotalFeatureIssuesOpened()
+	case memberstat.FieldTotalBugIssuesClosed:
+		return m.AddedTotalBugIssuesClosed()
+	case memberstat.FieldTotalFeatureIssuesClosed:
+		return m.AddedTotalFeatureIssuesClosed()
+	case memberstat.FieldTotalFeaturePrs:
+		return m.AddedTotalFeaturePrs()
+	case memberstat.FieldTotalBugPrs:
+		return m.AddedTotalBugPrs()
+	case memberstat.FieldTotalChorePrs:
+		return m.AddedTotalChorePrs()
+	case memberstat.FieldTotalDocsPrs:
+		return m.AddedTotalDocsPrs()
+	case memberstat.FieldTotalTestPrs:
+		return m.AddedTotalTestPrs()
+	case memberstat.FieldTotalInfraPrs:
+		return m.AddedTotalInfraPrs()
+	case memberstat.FieldTotalOtherPrs:
+		return m.AddedTotalOtherPrs()
+	case memberstat.FieldPrToReviewRatio:
+		return m.AddedPrToReviewRatio()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MemberStatMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case memberstat.FieldTotalCommits:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalCommits(v)
+		return nil
+	case memberstat.FieldTotalPrCreated:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalPrCreated(v)
+		return nil
+	case memberstat.FieldTotalPrMerged:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalPrMerged(v)
+		return nil
+	case memberstat.FieldTotalIssues:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalIssues(v)
+		return nil
+	case memberstat.FieldTotalReviews:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalReviews(v)
+		return nil
+	case memberstat.FieldTotalAdditions:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalAdditions(v)
+		return nil
+	case memberstat.FieldTotalDeletions:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalDeletions(v)
+		return nil
+	case memberstat.FieldFirstActivityYear:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFirstActivityYear(v)
+		return nil
+	case memberstat.FieldPeakActivityYear:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPeakActivityYear(v)
+		return nil
+	case memberstat.FieldPeakActivityCommits:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPeakActivityCommits(v)
+		return nil
+	case memberstat.FieldTotalExcludedReviews:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalExcludedReviews(v)
+		return nil
+	case memberstat.FieldTotalIssuesClosed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalIssuesClosed(v)
+		return nil
+	case memberstat.FieldTotalIssueCloseSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalIssueCloseSeconds(v)
+		return nil
+	case memberstat.FieldTotalBugIssuesOpened:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalBugIssuesOpened(v)
+		return nil
+	case memberstat.FieldTotalFeatureIssuesOpened:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalFeatureIssuesOpened(v)
+		return nil
+	case memberstat.FieldTotalBugIssuesClosed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalBugIssuesClosed(v)
+		return nil
+	case memberstat.FieldTotalFeatureIssuesClosed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalFeatureIssuesClosed(v)
+		return nil
+	case memberstat.FieldTotalFeaturePrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalFeaturePrs(v)
+		return nil
+	case memberstat.FieldTotalBugPrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalBugPrs(v)
+		return nil
+	case memberstat.FieldTotalChorePrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalChorePrs(v)
+		return nil
+	case memberstat.FieldTotalDocsPrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalDocsPrs(v)
+		return nil
+	case memberstat.FieldTotalTestPrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalTestPrs(v)
+		return nil
+	case memberstat.FieldTotalInfraPrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalInfraPrs(v)
+		return nil
+	case memberstat.FieldTotalOtherPrs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalOtherPrs(v)
+		return nil
+	case memberstat.FieldPrToReviewRatio:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPrToReviewRatio(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MemberStat numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MemberStatMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MemberStatMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MemberStatMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MemberStat nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MemberStatMutation) ResetField(name string) error {
+	switch name {
+	case memberstat.FieldLogin:
+		m.ResetLogin()
+		return nil
+	case memberstat.FieldName:
+		m.ResetName()
+		return nil
+	case memberstat.FieldTotalCommits:
+		m.ResetTotalCommits()
+		return nil
+	case memberstat.FieldTotalPrCreated:
+		m.ResetTotalPrCreated()
+		return nil
+	case memberstat.FieldTotalPrMerged:
+		m.ResetTotalPrMerged()
+		return nil
+	case memberstat.FieldTotalIssues:
+		m.ResetTotalIssues()
+		return nil
+	case memberstat.FieldTotalReviews:
+		m.ResetTotalReviews()
+		return nil
+	case memberstat.FieldTotalAdditions:
+		m.ResetTotalAdditions()
+		return nil
+	case memberstat.FieldTotalDeletions:
+		m.ResetTotalDeletions()
+		return nil
+	case memberstat.FieldFirstActivityYear:
+		m.ResetFirstActivityYear()
+		return nil
+	case memberstat.FieldPeakActivityYear:
+		m.ResetPeakActivityYear()
+		return nil
+	case memberstat.FieldPeakActivityCommits:
+		m.ResetPeakActivityCommits()
+		return nil
+	case memberstat.FieldTotalExcludedReviews:
+		m.ResetTotalExcludedReviews()
+		return nil
+	case memberstat.FieldTotalIssuesClosed:
+		m.ResetTotalIssuesClosed()
+		return nil
+	case memberstat.FieldTotalIssueCloseSeconds:
+		m.ResetTotalIssueCloseSeconds()
+		return nil
+	case memberstat.FieldTotalBugIssuesOpened:
+		m.ResetTotalBugIssuesOpened()
+		return nil
+	case memberstat.FieldTotalFeatureIssuesOpened:
+		m.ResetTotalFeatureIssuesOpened()
+		return nil
+	case memberstat.FieldTotalBugIssuesClosed:
+		m.ResetTotalBugIssuesClosed()
+		return nil
+	case memberstat.FieldTotalFeatureIssuesClosed:
+		m.ResetTotalFeatureIssuesClosed()
+		return nil
+	case memberstat.FieldTotalFeaturePrs:
+		m.ResetTotalFeaturePrs()
+		return nil
+	case memberstat.FieldTotalBugPrs:
+		m.ResetTotalBugPrs()
+		return nil
+	case memberstat.FieldTotalChorePrs:
+		m.ResetTotalChorePrs()
+		return nil
+	case memberstat.FieldTotalDocsPrs:
+		m.ResetTotalDocsPrs()
+		return nil
+	case memberstat.FieldTotalTestPrs:
+		m.ResetTotalTestPrs()
+		return nil
+	case memberstat.FieldTotalInfraPrs:
+		m.ResetTotalInfraPrs()
+		return nil
+	case memberstat.FieldTotalOtherPrs:
+		m.ResetTotalOtherPrs()
+		return nil
+	case memberstat.FieldPrToReviewRatio:
+		m.ResetPrToReviewRatio()
+		return nil
+	case memberstat.FieldTimeZone:
+		m.ResetTimeZone()
+		return nil
+	}
+	return fmt.Errorf("unknown MemberStat field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MemberStatMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.snapshot != nil {
+		edges = append(edges, memberstat.EdgeSnapshot)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MemberStatMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case memberstat.EdgeSnapshot:
+		if id := m.snapshot; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MemberStatMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MemberStatMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MemberStatMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsnapshot {
+		edges = append(edges, memberstat.EdgeSnapshot)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MemberStatMutation) EdgeCleared(name string) bool {
+	switch name {
+	case memberstat.EdgeSnapshot:
+		return m.clearedsnapshot
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MemberStatMutation) ClearEdge(name string) error {
+	switch name {
+	case memberstat.EdgeSnapshot:
+		m.ClearSnapshot()
+		return nil
+	}
+	return fmt.Errorf("unknown MemberStat unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MemberStatMutation) ResetEdge(name string) error {
+	switch name {
+	case memberstat.EdgeSnapshot:
+		m.ResetSnapshot()
+		return nil
+	}
+	return fmt.Errorf("unknown MemberStat edge %s", name)
+}
+
+// MemberYearStatMutation represents an operation that mutates the MemberYearStat nodes in the graph.
+type MemberYearStatMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	login           *string
+	year            *int
+	addyear         *int
+	commit_count    *int
+	addcommit_count *int
+	pr_created      *int
+	addpr_created   *int
+	pr_merged       *int
+	addpr_merged    *int
+	issue_count     *int
+	addissue_count  *int
+	review_count    *int
+	addreview_count *int
+	additions       *int
+	addadditions    *int
+	deletions       *int
+	adddeletions    *int
+	clearedFields   map[string]struct{}
+	snapshot        *int
+	clearedsnapshot bool
+	done            bool
+	oldValue        func(context.Context) (*MemberYearStat, error)
+	predicates      []predicate.MemberYearStat
+}
+
+var _ ent.Mutation = (*MemberYearStatMutation)(nil)
+
+// memberyearstatOption allows management of the mutation configuration using functional options.
+type memberyearstatOption func(*MemberYearStatMutation)
+
+// newMemberYearStatMutation creates new mutation for the MemberYearStat entity.
+func newMemberYearStatMutation(c config, op Op, opts ...memberyearstatOption) *MemberYearStatMutation {
+	m := &MemberYearStatMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMemberYearStat,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMemberYearStatID sets the ID field of the mutation.
+func withMemberYearStatID(id int) memberyearstatOption {
+	return func(m *MemberYearStatMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MemberYearStat
+		)
+		m.oldValue = func(ctx context.Context) (*MemberYearStat, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MemberYearStat.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMemberYearStat sets the old MemberYearStat of the mutation.
+func withMemberYearStat(node *MemberYearStat) memberyearstatOption {
+	return func(m *MemberYearStatMutation) {
+		m.oldValue = func(context.Context) (*MemberYearStat, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MemberYearStatMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MemberYearStatMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MemberYearStatMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MemberYearStatMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MemberYearStat.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetLogin sets the "login" field.
+func (m *MemberYearStatMutation) SetLogin(s string) {
+	m.login = &s
+}
+
+// Login returns the value of the "login" field in the mutation.
+func (m *MemberYearStatMutation) Login() (r string, exists bool) {
+	v := m.login
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLogin returns the old "login" field's value of the MemberYearStat entity.
+// If the MemberYearStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberYearStatMutation) OldLogin(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLogin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLogin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLogin: %w", err)
+	}
+	return oldValue.Login, nil
+}
+
+// ResetLogin resets all changes to the "login" field.
+func (m *MemberYearStatMutation) ResetLogin() {
+	m.login = nil
+}
+
+// SetYear sets the "year" field.
+func (m *MemberYearStatMutation) SetYear(i int) {
+	m.year = &i
+	m.addyear = nil
+}
+
+// Year returns the value of the "year" field in the mutation.
+func (m *MemberYearStatMutation) Year() (r int, exists bool) {
+	v := m.year
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldYear returns the old "year" field's value of the MemberYearStat entity.
+// If the MemberYearStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberYearStatMutation) OldYear(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldYear is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldYear requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldYear: %w", err)
+	}
+	return oldValue.Year, nil
+}
+
+// AddYear adds i to the "year" field.
+func (m *MemberYearStatMutation) AddYear(i int) {
+	if m.addyear != nil {
+		*m.addyear += i
+	} else {
+		m.addyear = &i
+	}
+}
+
+// AddedYear returns the value that was added to the "year" field in this mutation.
+func (m *MemberYearStatMutation) AddedYear() (r int, exists bool) {
+	v := m.addyear
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetYear resets all changes to the "year" field.
+func (m *MemberYearStatMutation) ResetYear() {
+	m.year = nil
+	m.addyear = nil
+}
+
+// SetCommitCount sets the "commit_count" field.
+func (m *MemberYearStatMutation) SetCommitCount(i int) {
+	m.commit_count = &i
+	m.addcommit_count = nil
+}
+
+// CommitCount returns the value of the "commit_count" field in the mutation.
+func (m *MemberYearStatMutation) CommitCount() (r int, exists bool) {
+	v := m.commit_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCommitCount returns the old "commit_count" field's value of the MemberYearStat entity.
+// If the MemberYearStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberYearStatMutation) OldCommitCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCommitCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCommitCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCommitCount: %w", err)
+	}
+	return oldValue.CommitCount, nil
+}
+
+// AddCommitCount adds i to the "commit_count" field.
+func (m *MemberYearStatMutation) AddCommitCount(i int) {
+	if m.addcommit_count != nil {
+		*m.addcommit_count += i
+	} else {
+		m.addcommit_count = &i
+	}
+}
+
+// AddedCommitCount returns the value that was added to the "commit_count" field in this mutation.
+func (m *MemberYearStatMutation) AddedCommitCount() (r int, exists bool) {
+	v := m.addcommit_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCommitCount resets all changes to the "commit_count" field.
+func (m *MemberYearStatMutation) ResetCommitCount() {
+	m.commit_count = nil
+	m.addcommit_count = nil
+}
+
+// SetPrCreated sets the "pr_created" field.
+func (m *MemberYearStatMutation) SetPrCreated(i int) {
+	m.pr_created = &i
+	m.addpr_created = nil
+}
+
+// PrCreated returns the value of the "pr_created" field in the mutation.
+func (m *MemberYearStatMutation) PrCreated() (r int, exists bool) {
+	v := m.pr_created
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrCreated returns the old "pr_created" field's value of the MemberYearStat entity.
+// If the MemberYearStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberYearStatMutation) OldPrCreated(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrCreated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrCreated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrCreated: %w", err)
+	}
+	return oldValue.PrCreated, nil
+}
+
+// AddPrCreated adds i to the "pr_created" field.
+func (m *MemberYearStatMutation) AddPrCreated(i int) {
+	if m.addpr_created != nil {
+		*m.addpr_created += i
+	} else {
+		m.addpr_created = &i
+	}
+}
+
+// AddedPrCreated returns the value that was added to the "pr_created" field in this mutation.
+func (m *MemberYearStatMutation) AddedPrCreated() (r int, exists bool) {
+	v := m.addpr_created
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPrCreated resets all changes to the "pr_created" field.
+func (m *MemberYearStatMutation) ResetPrCreated() {
+	m.pr_created = nil
+	m.addpr_created = nil
+}
+
+// SetPrMerged sets the "pr_merged" field.
+func (m *MemberYearStatMutation) SetPrMerged(i int) {
+	m.pr_merged = &i
+	m.addpr_merged = nil
+}
+
+// PrMerged returns the value of the "pr_merged" field in the mutation.
+func (m *MemberYearStatMutation) PrMerged() (r int, exists bool) {
+	v := m.pr_merged
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrMerged returns the old "pr_merged" field's value of the MemberYearStat entity.
+// If the MemberYearStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberYearStatMutation) OldPrMerged(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrMerged is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrMerged requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrMerged: %w", err)
+	}
+	return oldValue.PrMerged, nil
+}
+
+// AddPrMerged adds i to the "pr_merged" field.
+func (m *MemberYearStatMutation) AddPrMerged(i int) {
+	if m.addpr_merged != nil {
+		*m.addpr_merged += i
+	} else {
+		m.addpr_merged = &i
+	}
+}
+
+// AddedPrMerged returns the value that was added to the "pr_merged" field in this mutation.
+func (m *MemberYearStatMutation) AddedPrMerged() (r int, exists bool) {
+	v := m.addpr_merged
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPrMerged resets all changes to the "pr_merged" field.
+func (m *MemberYearStatMutation) ResetPrMerged() {
+	m.pr_merged = nil
+	m.addpr_merged = nil
+}
+
+// SetIssueCount sets the "issue_count" field.
+func (m *MemberYearStatMutation) SetIssueCount(i int) {
+	m.issue_count = &i
+	m.addissue_count = nil
+}
+
+// IssueCount returns the value of the "issue_count" field in the mutation.
+func (m *MemberYearStatMutation) IssueCount() (r int, exists bool) {
+	v := m.issue_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIssueCount returns the old "issue_count" field's value of the MemberYearStat entity.
+// If the MemberYearStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberYearStatMutation) OldIssueCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIssueCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIssueCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIssueCount: %w", err)
+	}
+	return oldValue.IssueCount, nil
+}
+
+// AddIssueCount adds i to the "issue_count" field.
+func (m *MemberYearStatMutation) AddIssueCount(i int) {
+	if m.addissue_count != nil {
+		*m.addissue_count += i
+	} else {
+		m.addissue_count = &i
+	}
+}
+
+// AddedIssueCount returns the value that was added to the "issue_count" field in this mutation.
+func (m *MemberYearStatMutation) AddedIssueCount() (r int, exists bool) {
+	v := m.addissue_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetIssueCount resets all changes to the "issue_count" field.
+func (m *MemberYearStatMutation) ResetIssueCount() {
+	m.issue_count = nil
+	m.addissue_count = nil
+}
+
+// SetReviewCount sets the "review_count" field.
+func (m *MemberYearStatMutation) SetReviewCount(i int) {
+	m.review_count = &i
+	m.addreview_count = nil
+}
+
+// ReviewCount returns the value of the "review_count" field in the mutation.
+func (m *MemberYearStatMutation) ReviewCount() (r int, exists bool) {
+	v := m.review_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewCount returns the old "review_count" field's value of the MemberYearStat entity.
+// If the MemberYearStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberYearStatMutation) OldReviewCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewCount: %w", err)
+	}
+	return oldValue.ReviewCount, nil
+}
+
+// AddReviewCount adds i to the "review_count" field.
+func (m *MemberYearStatMutation) AddReviewCount(i int) {
+	if m.addreview_count != nil {
+		*m.addreview_count += i
+	} else {
+		m.addreview_count = &i
+	}
+}
+
+// AddedReviewCount returns the value that was added to the "review_count" field in this mutation.
+func (m *MemberYearStatMutation) AddedReviewCount() (r int, exists bool) {
+	v := m.addreview_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetReviewCount resets all changes to the "review_count" field.
+func (m *MemberYearStatMutation) ResetReviewCount() {
+	m.review_count = nil
+	m.addreview_count = nil
+}
+
+// SetAdditions sets the "additions" field.
+func (m *MemberYearStatMutation) SetAdditions(i int) {
+	m.additions = &i
+	m.addadditions = nil
+}
+
+// Additions returns the value of the "additions" field in the mutation.
+func (m *MemberYearStatMutation) Additions() (r int, exists bool) {
+	v := m.additions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAdditions returns the old "additions" field's value of the MemberYearStat entity.
+// If the MemberYearStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberYearStatMutation) OldAdditions(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAdditions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAdditions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAdditions: %w", err)
+	}
+	return oldValue.Additions, nil
+}
+
+// AddAdditions adds i to the "additions" field.
+func (m *MemberYearStatMutation) AddAdditions(i int) {
+	if m.addadditions != nil {
+		*m.addadditions += i
+	} else {
+		m.addadditions = &i
+	}
+}
+
+// AddedAdditions returns the value that was added to the "additions" field in this mutation.
+func (m *MemberYearStatMutation) AddedAdditions() (r int, exists bool) {
+	v := m.addadditions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAdditions resets all changes to the "additions" field.
+func (m *MemberYearStatMutation) ResetAdditions() {
+	m.additions = nil
+	m.addadditions = nil
+}
+
+// SetDeletions sets the "deletions" field.
+func (m *MemberYearStatMutation) SetDeletions(i int) {
+	m.deletions = &i
+	m.adddeletions = nil
+}
+
+// Deletions returns the value of the "deletions" field in the mutation.
+func (m *MemberYearStatMutation) Deletions() (r int, exists bool) {
+	v := m.deletions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletions returns the old "deletions" field's value of the MemberYearStat entity.
+// If the MemberYearStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberYearStatMutation) OldDeletions(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletions: %w", err)
+	}
+	return oldValue.Deletions, nil
+}
+
+// AddDeletions adds i to the "deletions" field.
+func (m *MemberYearStatMutation) AddDeletions(i int) {
+	if m.adddeletions != nil {
+		*m.adddeletions += i
+	} else {
+		m.adddeletions = &i
+	}
+}
+
+// AddedDeletions returns the value that was added to the "deletions" field in this mutation.
+func (m *MemberYearStatMutation) AddedDeletions() (r int, exists bool) {
+	v := m.adddeletions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDeletions resets all changes to the "deletions" field.
+func (m *MemberYearStatMutation) ResetDeletions() {
+	m.deletions = nil
+	m.adddeletions = nil
+}
+
+// SetSnapshotID sets the "snapshot" edge to the Snapshot entity by id.
+func (m *MemberYearStatMutation) SetSnapshotID(id int) {
+	m.snapshot = &id
+}
+
+// ClearSnapshot clears the "snapshot" edge to the Snapshot entity.
+func (m *MemberYearStatMutation) ClearSnapshot() {
+	m.clearedsnapshot = true
+}
+
+// SnapshotCleared reports if the "snapshot" edge to the Snapshot entity was cleared.
+func (m *MemberYearStatMutation) SnapshotCleared() bool {
+	return m.clearedsnapshot
+}
+
+// SnapshotID returns the "snapshot" edge ID in the mutation.
+func (m *MemberYearStatMutation) SnapshotID() (id int, exists bool) {
+	if m.snapshot != nil {
+		return *m.snapshot, true
+	}
+	return
+}
+
+// SnapshotIDs returns the "snapshot" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SnapshotID instead. It exists only for internal usage by the builders.
+func (m *MemberYearStatMutation) SnapshotIDs() (ids []int) {
+	if id := m.snapshot; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSnapshot resets all changes to the "snapshot" edge.
+func (m *MemberYearStatMutation) ResetSnapshot() {
+	m.snapshot = nil
+	m.clearedsnapshot = false
+}
+
+// Where appends a list predicates to the MemberYearStatMutation builder.
+func (m *MemberYearStatMutation) Where(ps ...predicate.MemberYearStat) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MemberYearStatMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MemberYearStatMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MemberYearStat, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MemberYearStatMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MemberYearStatMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MemberYearStat).
+func (m *MemberYearStatMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MemberYearStatMutation) Fields() []string {
 	fields := make([]string, 0, 9)
 	if m.login != nil {
-		fields = append(fields, memberrepostat.FieldLogin)
+		fields = append(fields, memberyearstat.FieldLogin)
 	}
-	if m.name_with_owner != nil {
-		fields = append(fields, memberrepostat.FieldNameWithOwner)
+	if m.year != nil {
+		fields = append(fields, memberyearstat.FieldYear)
 	}
 	if m.commit_count != nil {
-		fields = append(fields, memberrepostat.FieldCommitCount)
+		fields = append(fields, memberyearstat.FieldCommitCount)
 	}
 	if m.pr_created != nil {
-		fields = append(fields, memberrepostat.FieldPrCreated)
+		fields = append(fields, memberyearstat.FieldPrCreated)
 	}
 	if m.pr_merged != nil {
-		fields = append(fields, memberrepostat.FieldPrMerged)
+		fields = append(fields, memberyearstat.FieldPrMerged)
 	}
 	if m.issue_count != nil {
-		fields = append(fields, memberrepostat.FieldIssueCount)
+		fields = append(fields, memberyearstat.FieldIssueCount)
 	}
 	if m.review_count != nil {
-		fields = append(fields, memberrepostat.FieldReviewCount)
+		fields = append(fields, memberyearstat.FieldReviewCount)
 	}
 	if m.additions != nil {
-		fields = append(fields, memberrepostat.FieldAdditions)
+		fields = append(fields, memberyearstat.FieldAdditions)
 	}
 	if m.deletions != nil {
-		fields = append(fields, memberrepostat.FieldDeletions)
+		fields = append(fields, memberyearstat.FieldDeletions)
 	}
 	return fields
 }
@@ -2910,25 +16543,25 @@ func (m *MemberRepoStatMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MemberRepoStatMutation) Field(name string) (ent.Value, bool) {
+func (m *MemberYearStatMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case memberrepostat.FieldLogin:
+	case memberyearstat.FieldLogin:
 		return m.Login()
-	case memberrepostat.FieldNameWithOwner:
-		return m.NameWithOwner()
-	case memberrepostat.FieldCommitCount:
+	case memberyearstat.FieldYear:
+		return m.Year()
+	case memberyearstat.FieldCommitCount:
 		return m.CommitCount()
-	case memberrepostat.FieldPrCreated:
+	case memberyearstat.FieldPrCreated:
 		return m.PrCreated()
-	case memberrepostat.FieldPrMerged:
+	case memberyearstat.FieldPrMerged:
 		return m.PrMerged()
-	case memberrepostat.FieldIssueCount:
+	case memberyearstat.FieldIssueCount:
 		return m.IssueCount()
-	case memberrepostat.FieldReviewCount:
+	case memberyearstat.FieldReviewCount:
 		return m.ReviewCount()
-	case memberrepostat.FieldAdditions:
+	case memberyearstat.FieldAdditions:
 		return m.Additions()
-	case memberrepostat.FieldDeletions:
+	case memberyearstat.FieldDeletions:
 		return m.Deletions()
 	}
 	return nil, false
@@ -2937,92 +16570,92 @@ func (m *MemberRepoStatMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MemberRepoStatMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *MemberYearStatMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case memberrepostat.FieldLogin:
+	case memberyearstat.FieldLogin:
 		return m.OldLogin(ctx)
-	case memberrepostat.FieldNameWithOwner:
-		return m.OldNameWithOwner(ctx)
-	case memberrepostat.FieldCommitCount:
+	case memberyearstat.FieldYear:
+		return m.OldYear(ctx)
+	case memberyearstat.FieldCommitCount:
 		return m.OldCommitCount(ctx)
-	case memberrepostat.FieldPrCreated:
+	case memberyearstat.FieldPrCreated:
 		return m.OldPrCreated(ctx)
-	case memberrepostat.FieldPrMerged:
+	case memberyearstat.FieldPrMerged:
 		return m.OldPrMerged(ctx)
-	case memberrepostat.FieldIssueCount:
+	case memberyearstat.FieldIssueCount:
 		return m.OldIssueCount(ctx)
-	case memberrepostat.FieldReviewCount:
+	case memberyearstat.FieldReviewCount:
 		return m.OldReviewCount(ctx)
-	case memberrepostat.FieldAdditions:
+	case memberyearstat.FieldAdditions:
 		return m.OldAdditions(ctx)
-	case memberrepostat.FieldDeletions:
+	case memberyearstat.FieldDeletions:
 		return m.OldDeletions(ctx)
 	}
-	return nil, fmt.Errorf("unknown MemberRepoStat field %s", name)
+	return nil, fmt.Errorf("unknown MemberYearStat field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MemberRepoStatMutation) SetField(name string, value ent.Value) error {
+func (m *MemberYearStatMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case memberrepostat.FieldLogin:
+	case memberyearstat.FieldLogin:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetLogin(v)
 		return nil
-	case memberrepostat.FieldNameWithOwner:
-		v, ok := value.(string)
+	case memberyearstat.FieldYear:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNameWithOwner(v)
+		m.SetYear(v)
 		return nil
-	case memberrepostat.FieldCommitCount:
+	case memberyearstat.FieldCommitCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCommitCount(v)
 		return nil
-	case memberrepostat.FieldPrCreated:
+	case memberyearstat.FieldPrCreated:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetPrCreated(v)
 		return nil
-	case memberrepostat.FieldPrMerged:
+	case memberyearstat.FieldPrMerged:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetPrMerged(v)
 		return nil
-	case memberrepostat.FieldIssueCount:
+	case memberyearstat.FieldIssueCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetIssueCount(v)
 		return nil
-	case memberrepostat.FieldReviewCount:
+	case memberyearstat.FieldReviewCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetReviewCount(v)
 		return nil
-	case memberrepostat.FieldAdditions:
+	case memberyearstat.FieldAdditions:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetAdditions(v)
 		return nil
-	case memberrepostat.FieldDeletions:
+	case memberyearstat.FieldDeletions:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -3030,33 +16663,36 @@ func (m *MemberRepoStatMutation) SetField(name string, value ent.Value) error {
 		m.SetDeletions(v)
 		return nil
 	}
-	return fmt.Errorf("unknown MemberRepoStat field %s", name)
+	return fmt.Errorf("unknown MemberYearStat field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MemberRepoStatMutation) AddedFields() []string {
+func (m *MemberYearStatMutation) AddedFields() []string {
 	var fields []string
+	if m.addyear != nil {
+		fields = append(fields, memberyearstat.FieldYear)
+	}
 	if m.addcommit_count != nil {
-		fields = append(fields, memberrepostat.FieldCommitCount)
+		fields = append(fields, memberyearstat.FieldCommitCount)
 	}
 	if m.addpr_created != nil {
-		fields = append(fields, memberrepostat.FieldPrCreated)
+		fields = append(fields, memberyearstat.FieldPrCreated)
 	}
 	if m.addpr_merged != nil {
-		fields = append(fields, memberrepostat.FieldPrMerged)
+		fields = append(fields, memberyearstat.FieldPrMerged)
 	}
 	if m.addissue_count != nil {
-		fields = append(fields, memberrepostat.FieldIssueCount)
+		fields = append(fields, memberyearstat.FieldIssueCount)
 	}
 	if m.addreview_count != nil {
-		fields = append(fields, memberrepostat.FieldReviewCount)
+		fields = append(fields, memberyearstat.FieldReviewCount)
 	}
 	if m.addadditions != nil {
-		fields = append(fields, memberrepostat.FieldAdditions)
+		fields = append(fields, memberyearstat.FieldAdditions)
 	}
 	if m.adddeletions != nil {
-		fields = append(fields, memberrepostat.FieldDeletions)
+		fields = append(fields, memberyearstat.FieldDeletions)
 	}
 	return fields
 }
@@ -3064,21 +16700,23 @@ func (m *MemberRepoStatMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MemberRepoStatMutation) AddedField(name string) (ent.Value, bool) {
+func (m *MemberYearStatMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case memberrepostat.FieldCommitCount:
+	case memberyearstat.FieldYear:
+		return m.AddedYear()
+	case memberyearstat.FieldCommitCount:
 		return m.AddedCommitCount()
-	case memberrepostat.FieldPrCreated:
+	case memberyearstat.FieldPrCreated:
 		return m.AddedPrCreated()
-	case memberrepostat.FieldPrMerged:
+	case memberyearstat.FieldPrMerged:
 		return m.AddedPrMerged()
-	case memberrepostat.FieldIssueCount:
+	case memberyearstat.FieldIssueCount:
 		return m.AddedIssueCount()
-	case memberrepostat.FieldReviewCount:
+	case memberyearstat.FieldReviewCount:
 		return m.AddedReviewCount()
-	case memberrepostat.FieldAdditions:
+	case memberyearstat.FieldAdditions:
 		return m.AddedAdditions()
-	case memberrepostat.FieldDeletions:
+	case memberyearstat.FieldDeletions:
 		return m.AddedDeletions()
 	}
 	return nil, false
@@ -3087,51 +16725,58 @@ func (m *MemberRepoStatMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MemberRepoStatMutation) AddField(name string, value ent.Value) error {
+func (m *MemberYearStatMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case memberrepostat.FieldCommitCount:
+	case memberyearstat.FieldYear:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddYear(v)
+		return nil
+	case memberyearstat.FieldCommitCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddCommitCount(v)
 		return nil
-	case memberrepostat.FieldPrCreated:
+	case memberyearstat.FieldPrCreated:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddPrCreated(v)
 		return nil
-	case memberrepostat.FieldPrMerged:
+	case memberyearstat.FieldPrMerged:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddPrMerged(v)
 		return nil
-	case memberrepostat.FieldIssueCount:
+	case memberyearstat.FieldIssueCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddIssueCount(v)
 		return nil
-	case memberrepostat.FieldReviewCount:
+	case memberyearstat.FieldReviewCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddReviewCount(v)
 		return nil
-	case memberrepostat.FieldAdditions:
+	case memberyearstat.FieldAdditions:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddAdditions(v)
 		return nil
-	case memberrepostat.FieldDeletions:
+	case memberyearstat.FieldDeletions:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -3139,77 +16784,77 @@ func (m *MemberRepoStatMutation) AddField(name string, value ent.Value) error {
 		m.AddDeletions(v)
 		return nil
 	}
-	return fmt.Errorf("unknown MemberRepoStat numeric field %s", name)
+	return fmt.Errorf("unknown MemberYearStat numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MemberRepoStatMutation) ClearedFields() []string {
+func (m *MemberYearStatMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MemberRepoStatMutation) FieldCleared(name string) bool {
+func (m *MemberYearStatMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MemberRepoStatMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown MemberRepoStat nullable field %s", name)
+func (m *MemberYearStatMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MemberYearStat nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MemberRepoStatMutation) ResetField(name string) error {
+func (m *MemberYearStatMutation) ResetField(name string) error {
 	switch name {
-	case memberrepostat.FieldLogin:
+	case memberyearstat.FieldLogin:
 		m.ResetLogin()
 		return nil
-	case memberrepostat.FieldNameWithOwner:
-		m.ResetNameWithOwner()
+	case memberyearstat.FieldYear:
+		m.ResetYear()
 		return nil
-	case memberrepostat.FieldCommitCount:
+	case memberyearstat.FieldCommitCount:
 		m.ResetCommitCount()
 		return nil
-	case memberrepostat.FieldPrCreated:
+	case memberyearstat.FieldPrCreated:
 		m.ResetPrCreated()
 		return nil
-	case memberrepostat.FieldPrMerged:
+	case memberyearstat.FieldPrMerged:
 		m.ResetPrMerged()
 		return nil
-	case memberrepostat.FieldIssueCount:
+	case memberyearstat.FieldIssueCount:
 		m.ResetIssueCount()
 		return nil
-	case memberrepostat.FieldReviewCount:
+	case memberyearstat.FieldReviewCount:
 		m.ResetReviewCount()
 		return nil
-	case memberrepostat.FieldAdditions:
+	case memberyearstat.FieldAdditions:
 		m.ResetAdditions()
 		return nil
-	case memberrepostat.FieldDeletions:
+	case memberyearstat.FieldDeletions:
 		m.ResetDeletions()
 		return nil
 	}
-	return fmt.Errorf("unknown MemberRepoStat field %s", name)
+	return fmt.Errorf("unknown MemberYearStat field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MemberRepoStatMutation) AddedEdges() []string {
+func (m *MemberYearStatMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.snapshot != nil {
-		edges = append(edges, memberrepostat.EdgeSnapshot)
+		edges = append(edges, memberyearstat.EdgeSnapshot)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MemberRepoStatMutation) AddedIDs(name string) []ent.Value {
+func (m *MemberYearStatMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case memberrepostat.EdgeSnapshot:
+	case memberyearstat.EdgeSnapshot:
 		if id := m.snapshot; id != nil {
 			return []ent.Value{*id}
 		}
@@ -3218,31 +16863,31 @@ func (m *MemberRepoStatMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MemberRepoStatMutation) RemovedEdges() []string {
+func (m *MemberYearStatMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MemberRepoStatMutation) RemovedIDs(name string) []ent.Value {
+func (m *MemberYearStatMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MemberRepoStatMutation) ClearedEdges() []string {
+func (m *MemberYearStatMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.clearedsnapshot {
-		edges = append(edges, memberrepostat.EdgeSnapshot)
+		edges = append(edges, memberyearstat.EdgeSnapshot)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MemberRepoStatMutation) EdgeCleared(name string) bool {
+func (m *MemberYearStatMutation) EdgeCleared(name string) bool {
 	switch name {
-	case memberrepostat.EdgeSnapshot:
+	case memberyearstat.EdgeSnapshot:
 		return m.clearedsnapshot
 	}
 	return false
@@ -3250,74 +16895,66 @@ func (m *MemberRepoStatMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MemberRepoStatMutation) ClearEdge(name string) error {
+func (m *MemberYearStatMutation) ClearEdge(name string) error {
 	switch name {
-	case memberrepostat.EdgeSnapshot:
+	case memberyearstat.EdgeSnapshot:
 		m.ClearSnapshot()
 		return nil
 	}
-	return fmt.Errorf("unknown MemberRepoStat unique edge %s", name)
+	return fmt.Errorf("unknown MemberYearStat unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MemberRepoStatMutation) ResetEdge(name string) error {
+func (m *MemberYearStatMutation) ResetEdge(name string) error {
 	switch name {
-	case memberrepostat.EdgeSnapshot:
+	case memberyearstat.EdgeSnapshot:
 		m.ResetSnapshot()
 		return nil
 	}
-	return fmt.Errorf("unknown MemberRepoStat edge %s", name)
+	return fmt.Errorf("unknown MemberYearStat edge %s", name)
 }
 
-// MemberStatMutation represents an operation that mutates the MemberStat nodes in the graph.
-type MemberStatMutation struct {
+// RepoDeliveryWeekMutation represents an operation that mutates the RepoDeliveryWeek nodes in the graph.
+type RepoDeliveryWeekMutation struct {
 	config
-	op                       Op
-	typ                      string
-	id                       *int
-	login                    *string
-	total_commits            *int
-	addtotal_commits         *int
-	total_pr_created         *int
-	addtotal_pr_created      *int
-	total_pr_merged          *int
-	addtotal_pr_merged       *int
-	total_issues             *int
-	addtotal_issues          *int
-	total_reviews            *int
-	addtotal_reviews         *int
-	total_additions          *int
-	addtotal_additions       *int
-	total_deletions          *int
-	addtotal_deletions       *int
-	first_activity_year      *int
-	addfirst_activity_year   *int
-	peak_activity_year       *int
-	addpeak_activity_year    *int
-	peak_activity_commits    *int
-	addpeak_activity_commits *int
-	pr_to_review_ratio       *float64
-	addpr_to_review_ratio    *float64
-	clearedFields            map[string]struct{}
-	snapshot                 *int
-	clearedsnapshot          bool
-	done                     bool
-	oldValue                 func(context.Context) (*MemberStat, error)
-	predicates               []predicate.MemberStat
-}
-
-var _ ent.Mutation = (*MemberStatMutation)(nil)
-
-// memberstatOption allows management of the mutation configuration using functional options.
-type memberstatOption func(*MemberStatMutation)
-
-// newMemberStatMutation creates new mutation for the MemberStat entity.
-func newMemberStatMutation(c config, op Op, opts ...memberstatOption) *MemberStatMutation {
-	m := &MemberStatMutation{
+	op                         Op
+	typ                        string
+	id                         *int
+	name_with_owner            *string
+	week                       *string
+	source                     *string
+	deployment_count           *int
+	adddeployment_count        *int
+	failed_deployment_count    *int
+	addfailed_deployment_count *int
+	lead_time_seconds          *int
+	addlead_time_seconds       *int
+	lead_time_count            *int
+	addlead_time_count         *int
+	restore_seconds            *int
+	addrestore_seconds         *int
+	restore_count              *int
+	addrestore_count           *int
+	clearedFields              map[string]struct{}
+	snapshot                   *int
+	clearedsnapshot            bool
+	done                       bool
+	oldValue                   func(context.Context) (*RepoDeliveryWeek, error)
+	predicates                 []predicate.RepoDeliveryWeek
+}
+
+var _ ent.Mutation = (*RepoDeliveryWeekMutation)(nil)
+
+// repodeliveryweekOption allows management of the mutation configuration using functional options.
+type repodeliveryweekOption func(*RepoDeliveryWeekMutation)
+
+// newRepoDeliveryWeekMutation creates new mutation for the RepoDeliveryWeek entity.
+func newRepoDeliveryWeekMutation(c config, op Op, opts ...repodeliveryweekOption) *RepoDeliveryWeekMutation {
+	m := &RepoDeliveryWeekMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMemberStat,
+		typ:           TypeRepoDeliveryWeek,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3326,20 +16963,20 @@ func newMemberStatMutation(c config, op Op, opts ...memberstatOption) *MemberSta
 	return m
 }
 
-// withMemberStatID sets the ID field of the mutation.
-func withMemberStatID(id int) memberstatOption {
-	return func(m *MemberStatMutation) {
+// withRepoDeliveryWeekID sets the ID field of the mutation.
+func withRepoDeliveryWeekID(id int) repodeliveryweekOption {
+	return func(m *RepoDeliveryWeekMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *MemberStat
+			value *RepoDeliveryWeek
 		)
-		m.oldValue = func(ctx context.Context) (*MemberStat, error) {
+		m.oldValue = func(ctx context.Context) (*RepoDeliveryWeek, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().MemberStat.Get(ctx, id)
+					value, err = m.Client().RepoDeliveryWeek.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3348,10 +16985,10 @@ func withMemberStatID(id int) memberstatOption {
 	}
 }
 
-// withMemberStat sets the old MemberStat of the mutation.
-func withMemberStat(node *MemberStat) memberstatOption {
-	return func(m *MemberStatMutation) {
-		m.oldValue = func(context.Context) (*MemberStat, error) {
+// withRepoDeliveryWeek sets the old RepoDeliveryWeek of the mutation.
+func withRepoDeliveryWeek(node *RepoDeliveryWeek) repodeliveryweekOption {
+	return func(m *RepoDeliveryWeekMutation) {
+		m.oldValue = func(context.Context) (*RepoDeliveryWeek, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3360,7 +16997,7 @@ func withMemberStat(node *MemberStat) memberstatOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MemberStatMutation) Client() *Client {
+func (m RepoDeliveryWeekMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3368,7 +17005,7 @@ func (m MemberStatMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MemberStatMutation) Tx() (*Tx, error) {
+func (m RepoDeliveryWeekMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3379,7 +17016,7 @@ func (m MemberStatMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MemberStatMutation) ID() (id int, exists bool) {
+func (m *RepoDeliveryWeekMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3390,7 +17027,7 @@ func (m *MemberStatMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MemberStatMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *RepoDeliveryWeekMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3399,681 +17036,473 @@ func (m *MemberStatMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().MemberStat.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().RepoDeliveryWeek.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetLogin sets the "login" field.
-func (m *MemberStatMutation) SetLogin(s string) {
-	m.login = &s
-}
-
-// Login returns the value of the "login" field in the mutation.
-func (m *MemberStatMutation) Login() (r string, exists bool) {
-	v := m.login
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldLogin returns the old "login" field's value of the MemberStat entity.
-// If the MemberStat object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberStatMutation) OldLogin(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLogin is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLogin requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLogin: %w", err)
-	}
-	return oldValue.Login, nil
-}
-
-// ResetLogin resets all changes to the "login" field.
-func (m *MemberStatMutation) ResetLogin() {
-	m.login = nil
-}
-
-// SetTotalCommits sets the "total_commits" field.
-func (m *MemberStatMutation) SetTotalCommits(i int) {
-	m.total_commits = &i
-	m.addtotal_commits = nil
-}
-
-// TotalCommits returns the value of the "total_commits" field in the mutation.
-func (m *MemberStatMutation) TotalCommits() (r int, exists bool) {
-	v := m.total_commits
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldTotalCommits returns the old "total_commits" field's value of the MemberStat entity.
-// If the MemberStat object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberStatMutation) OldTotalCommits(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTotalCommits is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTotalCommits requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTotalCommits: %w", err)
-	}
-	return oldValue.TotalCommits, nil
-}
-
-// AddTotalCommits adds i to the "total_commits" field.
-func (m *MemberStatMutation) AddTotalCommits(i int) {
-	if m.addtotal_commits != nil {
-		*m.addtotal_commits += i
-	} else {
-		m.addtotal_commits = &i
-	}
-}
-
-// AddedTotalCommits returns the value that was added to the "total_commits" field in this mutation.
-func (m *MemberStatMutation) AddedTotalCommits() (r int, exists bool) {
-	v := m.addtotal_commits
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetTotalCommits resets all changes to the "total_commits" field.
-func (m *MemberStatMutation) ResetTotalCommits() {
-	m.total_commits = nil
-	m.addtotal_commits = nil
-}
-
-// SetTotalPrCreated sets the "total_pr_created" field.
-func (m *MemberStatMutation) SetTotalPrCreated(i int) {
-	m.total_pr_created = &i
-	m.addtotal_pr_created = nil
-}
-
-// TotalPrCreated returns the value of the "total_pr_created" field in the mutation.
-func (m *MemberStatMutation) TotalPrCreated() (r int, exists bool) {
-	v := m.total_pr_created
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldTotalPrCreated returns the old "total_pr_created" field's value of the MemberStat entity.
-// If the MemberStat object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberStatMutation) OldTotalPrCreated(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTotalPrCreated is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTotalPrCreated requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTotalPrCreated: %w", err)
-	}
-	return oldValue.TotalPrCreated, nil
-}
-
-// AddTotalPrCreated adds i to the "total_pr_created" field.
-func (m *MemberStatMutation) AddTotalPrCreated(i int) {
-	if m.addtotal_pr_created != nil {
-		*m.addtotal_pr_created += i
-	} else {
-		m.addtotal_pr_created = &i
-	}
-}
-
-// AddedTotalPrCreated returns the value that was added to the "total_pr_created" field in this mutation.
-func (m *MemberStatMutation) AddedTotalPrCreated() (r int, exists bool) {
-	v := m.addtotal_pr_created
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetTotalPrCreated resets all changes to the "total_pr_created" field.
-func (m *MemberStatMutation) ResetTotalPrCreated() {
-	m.total_pr_created = nil
-	m.addtotal_pr_created = nil
-}
-
-// SetTotalPrMerged sets the "total_pr_merged" field.
-func (m *MemberStatMutation) SetTotalPrMerged(i int) {
-	m.total_pr_merged = &i
-	m.addtotal_pr_merged = nil
+// SetNameWithOwner sets the "name_with_owner" field.
+func (m *RepoDeliveryWeekMutation) SetNameWithOwner(s string) {
+	m.name_with_owner = &s
 }
 
-// TotalPrMerged returns the value of the "total_pr_merged" field in the mutation.
-func (m *MemberStatMutation) TotalPrMerged() (r int, exists bool) {
-	v := m.total_pr_merged
+// NameWithOwner returns the value of the "name_with_owner" field in the mutation.
+func (m *RepoDeliveryWeekMutation) NameWithOwner() (r string, exists bool) {
+	v := m.name_with_owner
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTotalPrMerged returns the old "total_pr_merged" field's value of the MemberStat entity.
-// If the MemberStat object wasn't provided to the builder, the object is fetched from the database.
+// OldNameWithOwner returns the old "name_with_owner" field's value of the RepoDeliveryWeek entity.
+// If the RepoDeliveryWeek object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberStatMutation) OldTotalPrMerged(ctx context.Context) (v int, err error) {
+func (m *RepoDeliveryWeekMutation) OldNameWithOwner(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTotalPrMerged is only allowed on UpdateOne operations")
+		return v, errors.New("OldNameWithOwner is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTotalPrMerged requires an ID field in the mutation")
+		return v, errors.New("OldNameWithOwner requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTotalPrMerged: %w", err)
-	}
-	return oldValue.TotalPrMerged, nil
-}
-
-// AddTotalPrMerged adds i to the "total_pr_merged" field.
-func (m *MemberStatMutation) AddTotalPrMerged(i int) {
-	if m.addtotal_pr_merged != nil {
-		*m.addtotal_pr_merged += i
-	} else {
-		m.addtotal_pr_merged = &i
-	}
-}
-
-// AddedTotalPrMerged returns the value that was added to the "total_pr_merged" field in this mutation.
-func (m *MemberStatMutation) AddedTotalPrMerged() (r int, exists bool) {
-	v := m.addtotal_pr_merged
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldNameWithOwner: %w", err)
 	}
-	return *v, true
+	return oldValue.NameWithOwner, nil
 }
 
-// ResetTotalPrMerged resets all changes to the "total_pr_merged" field.
-func (m *MemberStatMutation) ResetTotalPrMerged() {
-	m.total_pr_merged = nil
-	m.addtotal_pr_merged = nil
+// ResetNameWithOwner resets all changes to the "name_with_owner" field.
+func (m *RepoDeliveryWeekMutation) ResetNameWithOwner() {
+	m.name_with_owner = nil
 }
 
-// SetTotalIssues sets the "total_issues" field.
-func (m *MemberStatMutation) SetTotalIssues(i int) {
-	m.total_issues = &i
-	m.addtotal_issues = nil
+// SetWeek sets the "week" field.
+func (m *RepoDeliveryWeekMutation) SetWeek(s string) {
+	m.week = &s
 }
 
-// TotalIssues returns the value of the "total_issues" field in the mutation.
-func (m *MemberStatMutation) TotalIssues() (r int, exists bool) {
-	v := m.total_issues
+// Week returns the value of the "week" field in the mutation.
+func (m *RepoDeliveryWeekMutation) Week() (r string, exists bool) {
+	v := m.week
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTotalIssues returns the old "total_issues" field's value of the MemberStat entity.
-// If the MemberStat object wasn't provided to the builder, the object is fetched from the database.
+// OldWeek returns the old "week" field's value of the RepoDeliveryWeek entity.
+// If the RepoDeliveryWeek object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberStatMutation) OldTotalIssues(ctx context.Context) (v int, err error) {
+func (m *RepoDeliveryWeekMutation) OldWeek(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTotalIssues is only allowed on UpdateOne operations")
+		return v, errors.New("OldWeek is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTotalIssues requires an ID field in the mutation")
+		return v, errors.New("OldWeek requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTotalIssues: %w", err)
-	}
-	return oldValue.TotalIssues, nil
-}
-
-// AddTotalIssues adds i to the "total_issues" field.
-func (m *MemberStatMutation) AddTotalIssues(i int) {
-	if m.addtotal_issues != nil {
-		*m.addtotal_issues += i
-	} else {
-		m.addtotal_issues = &i
-	}
-}
-
-// AddedTotalIssues returns the value that was added to the "total_issues" field in this mutation.
-func (m *MemberStatMutation) AddedTotalIssues() (r int, exists bool) {
-	v := m.addtotal_issues
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldWeek: %w", err)
 	}
-	return *v, true
+	return oldValue.Week, nil
 }
 
-// ResetTotalIssues resets all changes to the "total_issues" field.
-func (m *MemberStatMutation) ResetTotalIssues() {
-	m.total_issues = nil
-	m.addtotal_issues = nil
+// ResetWeek resets all changes to the "week" field.
+func (m *RepoDeliveryWeekMutation) ResetWeek() {
+	m.week = nil
 }
 
-// SetTotalReviews sets the "total_reviews" field.
-func (m *MemberStatMutation) SetTotalReviews(i int) {
-	m.total_reviews = &i
-	m.addtotal_reviews = nil
+// SetSource sets the "source" field.
+func (m *RepoDeliveryWeekMutation) SetSource(s string) {
+	m.source = &s
 }
 
-// TotalReviews returns the value of the "total_reviews" field in the mutation.
-func (m *MemberStatMutation) TotalReviews() (r int, exists bool) {
-	v := m.total_reviews
+// Source returns the value of the "source" field in the mutation.
+func (m *RepoDeliveryWeekMutation) Source() (r string, exists bool) {
+	v := m.source
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTotalReviews returns the old "total_reviews" field's value of the MemberStat entity.
-// If the MemberStat object wasn't provided to the builder, the object is fetched from the database.
+// OldSource returns the old "source" field's value of the RepoDeliveryWeek entity.
+// If the RepoDeliveryWeek object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberStatMutation) OldTotalReviews(ctx context.Context) (v int, err error) {
+func (m *RepoDeliveryWeekMutation) OldSource(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTotalReviews is only allowed on UpdateOne operations")
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTotalReviews requires an ID field in the mutation")
+		return v, errors.New("OldSource requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTotalReviews: %w", err)
-	}
-	return oldValue.TotalReviews, nil
-}
-
-// AddTotalReviews adds i to the "total_reviews" field.
-func (m *MemberStatMutation) AddTotalReviews(i int) {
-	if m.addtotal_reviews != nil {
-		*m.addtotal_reviews += i
-	} else {
-		m.addtotal_reviews = &i
-	}
-}
-
-// AddedTotalReviews returns the value that was added to the "total_reviews" field in this mutation.
-func (m *MemberStatMutation) AddedTotalReviews() (r int, exists bool) {
-	v := m.addtotal_reviews
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
 	}
-	return *v, true
+	return oldValue.Source, nil
 }
 
-// ResetTotalReviews resets all changes to the "total_reviews" field.
-func (m *MemberStatMutation) ResetTotalReviews() {
-	m.total_reviews = nil
-	m.addtotal_reviews = nil
+// ResetSource resets all changes to the "source" field.
+func (m *RepoDeliveryWeekMutation) ResetSource() {
+	m.source = nil
 }
 
-// SetTotalAdditions sets the "total_additions" field.
-func (m *MemberStatMutation) SetTotalAdditions(i int) {
-	m.total_additions = &i
-	m.addtotal_additions = nil
+// SetDeploymentCount sets the "deployment_count" field.
+func (m *RepoDeliveryWeekMutation) SetDeploymentCount(i int) {
+	m.deployment_count = &i
+	m.adddeployment_count = nil
 }
 
-// TotalAdditions returns the value of the "total_additions" field in the mutation.
-func (m *MemberStatMutation) TotalAdditions() (r int, exists bool) {
-	v := m.total_additions
+// DeploymentCount returns the value of the "deployment_count" field in the mutation.
+func (m *RepoDeliveryWeekMutation) DeploymentCount() (r int, exists bool) {
+	v := m.deployment_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTotalAdditions returns the old "total_additions" field's value of the MemberStat entity.
-// If the MemberStat object wasn't provided to the builder, the object is fetched from the database.
+// OldDeploymentCount returns the old "deployment_count" field's value of the RepoDeliveryWeek entity.
+// If the RepoDeliveryWeek object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberStatMutation) OldTotalAdditions(ctx context.Context) (v int, err error) {
+func (m *RepoDeliveryWeekMutation) OldDeploymentCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTotalAdditions is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeploymentCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTotalAdditions requires an ID field in the mutation")
+		return v, errors.New("OldDeploymentCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTotalAdditions: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeploymentCount: %w", err)
 	}
-	return oldValue.TotalAdditions, nil
+	return oldValue.DeploymentCount, nil
 }
 
-// AddTotalAdditions adds i to the "total_additions" field.
-func (m *MemberStatMutation) AddTotalAdditions(i int) {
-	if m.addtotal_additions != nil {
-		*m.addtotal_additions += i
+// AddDeploymentCount adds i to the "deployment_count" field.
+func (m *RepoDeliveryWeekMutation) AddDeploymentCount(i int) {
+	if m.adddeployment_count != nil {
+		*m.adddeployment_count += i
 	} else {
-		m.addtotal_additions = &i
+		m.adddeployment_count = &i
 	}
 }
 
-// AddedTotalAdditions returns the value that was added to the "total_additions" field in this mutation.
-func (m *MemberStatMutation) AddedTotalAdditions() (r int, exists bool) {
-	v := m.addtotal_additions
+// AddedDeploymentCount returns the value that was added to the "deployment_count" field in this mutation.
+func (m *RepoDeliveryWeekMutation) AddedDeploymentCount() (r int, exists bool) {
+	v := m.adddeployment_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetTotalAdditions resets all changes to the "total_additions" field.
-func (m *MemberStatMutation) ResetTotalAdditions() {
-	m.total_additions = nil
-	m.addtotal_additions = nil
+// ResetDeploymentCount resets all changes to the "deployment_count" field.
+func (m *RepoDeliveryWeekMutation) ResetDeploymentCount() {
+	m.deployment_count = nil
+	m.adddeployment_count = nil
 }
 
-// SetTotalDeletions sets the "total_deletions" field.
-func (m *MemberStatMutation) SetTotalDeletions(i int) {
-	m.total_deletions = &i
-	m.addtotal_deletions = nil
+// SetFailedDeploymentCount sets the "failed_deployment_count" field.
+func (m *RepoDeliveryWeekMutation) SetFailedDeploymentCount(i int) {
+	m.failed_deployment_count = &i
+	m.addfailed_deployment_count = nil
 }
 
-// TotalDeletions returns the value of the "total_deletions" field in the mutation.
-func (m *MemberStatMutation) TotalDeletions() (r int, exists bool) {
-	v := m.total_deletions
+// FailedDeploymentCount returns the value of the "failed_deployment_count" field in the mutation.
+func (m *RepoDeliveryWeekMutation) FailedDeploymentCount() (r int, exists bool) {
+	v := m.failed_deployment_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTotalDeletions returns the old "total_deletions" field's value of the MemberStat entity.
-// If the MemberStat object wasn't provided to the builder, the object is fetched from the database.
+// OldFailedDeploymentCount returns the old "failed_deployment_count" field's value of the RepoDeliveryWeek entity.
+// If the RepoDeliveryWeek object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberStatMutation) OldTotalDeletions(ctx context.Context) (v int, err error) {
+func (m *RepoDeliveryWeekMutation) OldFailedDeploymentCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTotalDeletions is only allowed on UpdateOne operations")
+		return v, errors.New("OldFailedDeploymentCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTotalDeletions requires an ID field in the mutation")
+		return v, errors.New("OldFailedDeploymentCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTotalDeletions: %w", err)
+		return v, fmt.Errorf("querying old value for OldFailedDeploymentCount: %w", err)
 	}
-	return oldValue.TotalDeletions, nil
+	return oldValue.FailedDeploymentCount, nil
 }
 
-// AddTotalDeletions adds i to the "total_deletions" field.
-func (m *MemberStatMutation) AddTotalDeletions(i int) {
-	if m.addtotal_deletions != nil {
-		*m.addtotal_deletions += i
+// AddFailedDeploymentCount adds i to the "failed_deployment_count" field.
+func (m *RepoDeliveryWeekMutation) AddFailedDeploymentCount(i int) {
+	if m.addfailed_deployment_count != nil {
+		*m.addfailed_deployment_count += i
 	} else {
-		m.addtotal_deletions = &i
+		m.addfailed_deployment_count = &i
 	}
 }
 
-// AddedTotalDeletions returns the value that was added to the "total_deletions" field in this mutation.
-func (m *MemberStatMutation) AddedTotalDeletions() (r int, exists bool) {
-	v := m.addtotal_deletions
+// AddedFailedDeploymentCount returns the value that was added to the "failed_deployment_count" field in this mutation.
+func (m *RepoDeliveryWeekMutation) AddedFailedDeploymentCount() (r int, exists bool) {
+	v := m.addfailed_deployment_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetTotalDeletions resets all changes to the "total_deletions" field.
-func (m *MemberStatMutation) ResetTotalDeletions() {
-	m.total_deletions = nil
-	m.addtotal_deletions = nil
+// ResetFailedDeploymentCount resets all changes to the "failed_deployment_count" field.
+func (m *RepoDeliveryWeekMutation) ResetFailedDeploymentCount() {
+	m.failed_deployment_count = nil
+	m.addfailed_deployment_count = nil
 }
 
-// SetFirstActivityYear sets the "first_activity_year" field.
-func (m *MemberStatMutation) SetFirstActivityYear(i int) {
-	m.first_activity_year = &i
-	m.addfirst_activity_year = nil
+// SetLeadTimeSeconds sets the "lead_time_seconds" field.
+func (m *RepoDeliveryWeekMutation) SetLeadTimeSeconds(i int) {
+	m.lead_time_seconds = &i
+	m.addlead_time_seconds = nil
 }
 
-// FirstActivityYear returns the value of the "first_activity_year" field in the mutation.
-func (m *MemberStatMutation) FirstActivityYear() (r int, exists bool) {
-	v := m.first_activity_year
+// LeadTimeSeconds returns the value of the "lead_time_seconds" field in the mutation.
+func (m *RepoDeliveryWeekMutation) LeadTimeSeconds() (r int, exists bool) {
+	v := m.lead_time_seconds
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFirstActivityYear returns the old "first_activity_year" field's value of the MemberStat entity.
-// If the MemberStat object wasn't provided to the builder, the object is fetched from the database.
+// OldLeadTimeSeconds returns the old "lead_time_seconds" field's value of the RepoDeliveryWeek entity.
+// If the RepoDeliveryWeek object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberStatMutation) OldFirstActivityYear(ctx context.Context) (v int, err error) {
+func (m *RepoDeliveryWeekMutation) OldLeadTimeSeconds(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFirstActivityYear is only allowed on UpdateOne operations")
+		return v, errors.New("OldLeadTimeSeconds is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFirstActivityYear requires an ID field in the mutation")
+		return v, errors.New("OldLeadTimeSeconds requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFirstActivityYear: %w", err)
+		return v, fmt.Errorf("querying old value for OldLeadTimeSeconds: %w", err)
 	}
-	return oldValue.FirstActivityYear, nil
+	return oldValue.LeadTimeSeconds, nil
 }
 
-// AddFirstActivityYear adds i to the "first_activity_year" field.
-func (m *MemberStatMutation) AddFirstActivityYear(i int) {
-	if m.addfirst_activity_year != nil {
-		*m.addfirst_activity_year += i
+// AddLeadTimeSeconds adds i to the "lead_time_seconds" field.
+func (m *RepoDeliveryWeekMutation) AddLeadTimeSeconds(i int) {
+	if m.addlead_time_seconds != nil {
+		*m.addlead_time_seconds += i
 	} else {
-		m.addfirst_activity_year = &i
+		m.addlead_time_seconds = &i
 	}
 }
 
-// AddedFirstActivityYear returns the value that was added to the "first_activity_year" field in this mutation.
-func (m *MemberStatMutation) AddedFirstActivityYear() (r int, exists bool) {
-	v := m.addfirst_activity_year
+// AddedLeadTimeSeconds returns the value that was added to the "lead_time_seconds" field in this mutation.
+func (m *RepoDeliveryWeekMutation) AddedLeadTimeSeconds() (r int, exists bool) {
+	v := m.addlead_time_seconds
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetFirstActivityYear resets all changes to the "first_activity_year" field.
-func (m *MemberStatMutation) ResetFirstActivityYear() {
-	m.first_activity_year = nil
-	m.addfirst_activity_year = nil
+// ResetLeadTimeSeconds resets all changes to the "lead_time_seconds" field.
+func (m *RepoDeliveryWeekMutation) ResetLeadTimeSeconds() {
+	m.lead_time_seconds = nil
+	m.addlead_time_seconds = nil
 }
 
-// SetPeakActivityYear sets the "peak_activity_year" field.
-func (m *MemberStatMutation) SetPeakActivityYear(i int) {
-	m.peak_activity_year = &i
-	m.addpeak_activity_year = nil
+// SetLeadTimeCount sets the "lead_time_count" field.
+func (m *RepoDeliveryWeekMutation) SetLeadTimeCount(i int) {
+	m.lead_time_count = &i
+	m.addlead_time_count = nil
 }
 
-// PeakActivityYear returns the value of the "peak_activity_year" field in the mutation.
-func (m *MemberStatMutation) PeakActivityYear() (r int, exists bool) {
-	v := m.peak_activity_year
+// LeadTimeCount returns the value of the "lead_time_count" field in the mutation.
+func (m *RepoDeliveryWeekMutation) LeadTimeCount() (r int, exists bool) {
+	v := m.lead_time_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPeakActivityYear returns the old "peak_activity_year" field's value of the MemberStat entity.
-// If the MemberStat object wasn't provided to the builder, the object is fetched from the database.
+// OldLeadTimeCount returns the old "lead_time_count" field's value of the RepoDeliveryWeek entity.
+// If the RepoDeliveryWeek object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberStatMutation) OldPeakActivityYear(ctx context.Context) (v int, err error) {
+func (m *RepoDeliveryWeekMutation) OldLeadTimeCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPeakActivityYear is only allowed on UpdateOne operations")
+		return v, errors.New("OldLeadTimeCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPeakActivityYear requires an ID field in the mutation")
+		return v, errors.New("OldLeadTimeCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPeakActivityYear: %w", err)
+		return v, fmt.Errorf("querying old value for OldLeadTimeCount: %w", err)
 	}
-	return oldValue.PeakActivityYear, nil
+	return oldValue.LeadTimeCount, nil
 }
 
-// AddPeakActivityYear adds i to the "peak_activity_year" field.
-func (m *MemberStatMutation) AddPeakActivityYear(i int) {
-	if m.addpeak_activity_year != nil {
-		*m.addpeak_activity_year += i
+// AddLeadTimeCount adds i to the "lead_time_count" field.
+func (m *RepoDeliveryWeekMutation) AddLeadTimeCount(i int) {
+	if m.addlead_time_count != nil {
+		*m.addlead_time_count += i
 	} else {
-		m.addpeak_activity_year = &i
+		m.addlead_time_count = &i
 	}
 }
 
-// AddedPeakActivityYear returns the value that was added to the "peak_activity_year" field in this mutation.
-func (m *MemberStatMutation) AddedPeakActivityYear() (r int, exists bool) {
-	v := m.addpeak_activity_year
+// AddedLeadTimeCount returns the value that was added to the "lead_time_count" field in this mutation.
+func (m *RepoDeliveryWeekMutation) AddedLeadTimeCount() (r int, exists bool) {
+	v := m.addlead_time_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetPeakActivityYear resets all changes to the "peak_activity_year" field.
-func (m *MemberStatMutation) ResetPeakActivityYear() {
-	m.peak_activity_year = nil
-	m.addpeak_activity_year = nil
+// ResetLeadTimeCount resets all changes to the "lead_time_count" field.
+func (m *RepoDeliveryWeekMutation) ResetLeadTimeCount() {
+	m.lead_time_count = nil
+	m.addlead_time_count = nil
 }
 
-// SetPeakActivityCommits sets the "peak_activity_commits" field.
-func (m *MemberStatMutation) SetPeakActivityCommits(i int) {
-	m.peak_activity_commits = &i
-	m.addpeak_activity_commits = nil
+// SetRestoreSeconds sets the "restore_seconds" field.
+func (m *RepoDeliveryWeekMutation) SetRestoreSeconds(i int) {
+	m.restore_seconds = &i
+	m.addrestore_seconds = nil
 }
 
-// PeakActivityCommits returns the value of the "peak_activity_commits" field in the mutation.
-func (m *MemberStatMutation) PeakActivityCommits() (r int, exists bool) {
-	v := m.peak_activity_commits
+// RestoreSeconds returns the value of the "restore_seconds" field in the mutation.
+func (m *RepoDeliveryWeekMutation) RestoreSeconds() (r int, exists bool) {
+	v := m.restore_seconds
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPeakActivityCommits returns the old "peak_activity_commits" field's value of the MemberStat entity.
-// If the MemberStat object wasn't provided to the builder, the object is fetched from the database.
+// OldRestoreSeconds returns the old "restore_seconds" field's value of the RepoDeliveryWeek entity.
+// If the RepoDeliveryWeek object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberStatMutation) OldPeakActivityCommits(ctx context.Context) (v int, err error) {
+func (m *RepoDeliveryWeekMutation) OldRestoreSeconds(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPeakActivityCommits is only allowed on UpdateOne operations")
+		return v, errors.New("OldRestoreSeconds is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPeakActivityCommits requires an ID field in the mutation")
+		return v, errors.New("OldRestoreSeconds requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPeakActivityCommits: %w", err)
+		return v, fmt.Errorf("querying old value for OldRestoreSeconds: %w", err)
 	}
-	return oldValue.PeakActivityCommits, nil
+	return oldValue.RestoreSeconds, nil
 }
 
-// AddPeakActivityCommits adds i to the "peak_activity_commits" field.
-func (m *MemberStatMutation) AddPeakActivityCommits(i int) {
-	if m.addpeak_activity_commits != nil {
-		*m.addpeak_activity_commits += i
+// AddRestoreSeconds adds i to the "restore_seconds" field.
+func (m *RepoDeliveryWeekMutation) AddRestoreSeconds(i int) {
+	if m.addrestore_seconds != nil {
+		*m.addrestore_seconds += i
 	} else {
-		m.addpeak_activity_commits = &i
+		m.addrestore_seconds = &i
 	}
 }
 
-// AddedPeakActivityCommits returns the value that was added to the "peak_activity_commits" field in this mutation.
-func (m *MemberStatMutation) AddedPeakActivityCommits() (r int, exists bool) {
-	v := m.addpeak_activity_commits
+// AddedRestoreSeconds returns the value that was added to the "restore_seconds" field in this mutation.
+func (m *RepoDeliveryWeekMutation) AddedRestoreSeconds() (r int, exists bool) {
+	v := m.addrestore_seconds
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetPeakActivityCommits resets all changes to the "peak_activity_commits" field.
-func (m *MemberStatMutation) ResetPeakActivityCommits() {
-	m.peak_activity_commits = nil
-	m.addpeak_activity_commits = nil
+// ResetRestoreSeconds resets all changes to the "restore_seconds" field.
+func (m *RepoDeliveryWeekMutation) ResetRestoreSeconds() {
+	m.restore_seconds = nil
+	m.addrestore_seconds = nil
 }
 
-// SetPrToReviewRatio sets the "pr_to_review_ratio" field.
-func (m *MemberStatMutation) SetPrToReviewRatio(f float64) {
-	m.pr_to_review_ratio = &f
-	m.addpr_to_review_ratio = nil
+// SetRestoreCount sets the "restore_count" field.
+func (m *RepoDeliveryWeekMutation) SetRestoreCount(i int) {
+	m.restore_count = &i
+	m.addrestore_count = nil
 }
 
-// PrToReviewRatio returns the value of the "pr_to_review_ratio" field in the mutation.
-func (m *MemberStatMutation) PrToReviewRatio() (r float64, exists bool) {
-	v := m.pr_to_review_ratio
+// RestoreCount returns the value of the "restore_count" field in the mutation.
+func (m *RepoDeliveryWeekMutation) RestoreCount() (r int, exists bool) {
+	v := m.restore_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPrToReviewRatio returns the old "pr_to_review_ratio" field's value of the MemberStat entity.
-// If the MemberStat object wasn't provided to the builder, the object is fetched from the database.
+// OldRestoreCount returns the old "restore_count" field's value of the RepoDeliveryWeek entity.
+// If the RepoDeliveryWeek object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberStatMutation) OldPrToReviewRatio(ctx context.Context) (v float64, err error) {
+func (m *RepoDeliveryWeekMutation) OldRestoreCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPrToReviewRatio is only allowed on UpdateOne operations")
+		return v, errors.New("OldRestoreCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPrToReviewRatio requires an ID field in the mutation")
+		return v, errors.New("OldRestoreCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPrToReviewRatio: %w", err)
+		return v, fmt.Errorf("querying old value for OldRestoreCount: %w", err)
 	}
-	return oldValue.PrToReviewRatio, nil
+	return oldValue.RestoreCount, nil
 }
 
-// AddPrToReviewRatio adds f to the "pr_to_review_ratio" field.
-func (m *MemberStatMutation) AddPrToReviewRatio(f float64) {
-	if m.addpr_to_review_ratio != nil {
-		*m.addpr_to_review_ratio += f
+// AddRestoreCount adds i to the "restore_count" field.
+func (m *RepoDeliveryWeekMutation) AddRestoreCount(i int) {
+	if m.addrestore_count != nil {
+		*m.addrestore_count += i
 	} else {
-		m.addpr_to_review_ratio = &f
+		m.addrestore_count = &i
 	}
 }
 
-// AddedPrToReviewRatio returns the value that was added to the "pr_to_review_ratio" field in this mutation.
-func (m *MemberStatMutation) AddedPrToReviewRatio() (r float64, exists bool) {
-	v := m.addpr_to_review_ratio
+// AddedRestoreCount returns the value that was added to the "restore_count" field in this mutation.
+func (m *RepoDeliveryWeekMutation) AddedRestoreCount() (r int, exists bool) {
+	v := m.addrestore_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetPrToReviewRatio resets all changes to the "pr_to_review_ratio" field.
-func (m *MemberStatMutation) ResetPrToReviewRatio() {
-	m.pr_to_review_ratio = nil
-	m.addpr_to_review_ratio = nil
+// ResetRestoreCount resets all changes to the "restore_count" field.
+func (m *RepoDeliveryWeekMutation) ResetRestoreCount() {
+	m.restore_count = nil
+	m.addrestore_count = nil
 }
 
 // SetSnapshotID sets the "snapshot" edge to the Snapshot entity by id.
-func (m *MemberStatMutation) SetSnapshotID(id int) {
+func (m *RepoDeliveryWeekMutation) SetSnapshotID(id int) {
 	m.snapshot = &id
 }
 
 // ClearSnapshot clears the "snapshot" edge to the Snapshot entity.
-func (m *MemberStatMutation) ClearSnapshot() {
+func (m *RepoDeliveryWeekMutation) ClearSnapshot() {
 	m.clearedsnapshot = true
 }
 
 // SnapshotCleared reports if the "snapshot" edge to the Snapshot entity was cleared.
-func (m *MemberStatMutation) SnapshotCleared() bool {
+func (m *RepoDeliveryWeekMutation) SnapshotCleared() bool {
 	return m.clearedsnapshot
 }
 
 // SnapshotID returns the "snapshot" edge ID in the mutation.
-func (m *MemberStatMutation) SnapshotID() (id int, exists bool) {
+func (m *RepoDeliveryWeekMutation) SnapshotID() (id int, exists bool) {
 	if m.snapshot != nil {
 		return *m.snapshot, true
 	}
@@ -4083,7 +17512,7 @@ func (m *MemberStatMutation) SnapshotID() (id int, exists bool) {
 // SnapshotIDs returns the "snapshot" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // SnapshotID instead. It exists only for internal usage by the builders.
-func (m *MemberStatMutation) SnapshotIDs() (ids []int) {
+func (m *RepoDeliveryWeekMutation) SnapshotIDs() (ids []int) {
 	if id := m.snapshot; id != nil {
 		ids = append(ids, *id)
 	}
@@ -4091,20 +17520,20 @@ func (m *MemberStatMutation) SnapshotIDs() (ids []int) {
 }
 
 // ResetSnapshot resets all changes to the "snapshot" edge.
-func (m *MemberStatMutation) ResetSnapshot() {
+func (m *RepoDeliveryWeekMutation) ResetSnapshot() {
 	m.snapshot = nil
 	m.clearedsnapshot = false
 }
 
-// Where appends a list predicates to the MemberStatMutation builder.
-func (m *MemberStatMutation) Where(ps ...predicate.MemberStat) {
+// Where appends a list predicates to the RepoDeliveryWeekMutation builder.
+func (m *RepoDeliveryWeekMutation) Where(ps ...predicate.RepoDeliveryWeek) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MemberStatMutation builder. Using this method,
+// WhereP appends storage-level predicates to the RepoDeliveryWeekMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MemberStatMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.MemberStat, len(ps))
+func (m *RepoDeliveryWeekMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RepoDeliveryWeek, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4112,60 +17541,51 @@ func (m *MemberStatMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MemberStatMutation) Op() Op {
+func (m *RepoDeliveryWeekMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MemberStatMutation) SetOp(op Op) {
+func (m *RepoDeliveryWeekMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (MemberStat).
-func (m *MemberStatMutation) Type() string {
+// Type returns the node type of this mutation (RepoDeliveryWeek).
+func (m *RepoDeliveryWeekMutation) Type() string {
 	return m.typ
 }
-
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *MemberStatMutation) Fields() []string {
-	fields := make([]string, 0, 12)
-	if m.login != nil {
-		fields = append(fields, memberstat.FieldLogin)
-	}
-	if m.total_commits != nil {
-		fields = append(fields, memberstat.FieldTotalCommits)
-	}
-	if m.total_pr_created != nil {
-		fields = append(fields, memberstat.FieldTotalPrCreated)
-	}
-	if m.total_pr_merged != nil {
-		fields = append(fields, memberstat.FieldTotalPrMerged)
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RepoDeliveryWeekMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.name_with_owner != nil {
+		fields = append(fields, repodeliveryweek.FieldNameWithOwner)
 	}
-	if m.total_issues != nil {
-		fields = append(fields, memberstat.FieldTotalIssues)
+	if m.week != nil {
+		fields = append(fields, repodeliveryweek.FieldWeek)
 	}
-	if m.total_reviews != nil {
-		fields = append(fields, memberstat.FieldTotalReviews)
+	if m.source != nil {
+		fields = append(fields, repodeliveryweek.FieldSource)
 	}
-	if m.total_additions != nil {
-		fields = append(fields, memberstat.FieldTotalAdditions)
+	if m.deployment_count != nil {
+		fields = append(fields, repodeliveryweek.FieldDeploymentCount)
 	}
-	if m.total_deletions != nil {
-		fields = append(fields, memberstat.FieldTotalDeletions)
+	if m.failed_deployment_count != nil {
+		fields = append(fields, repodeliveryweek.FieldFailedDeploymentCount)
 	}
-	if m.first_activity_year != nil {
-		fields = append(fields, memberstat.FieldFirstActivityYear)
+	if m.lead_time_seconds != nil {
+		fields = append(fields, repodeliveryweek.FieldLeadTimeSeconds)
 	}
-	if m.peak_activity_year != nil {
-		fields = append(fields, memberstat.FieldPeakActivityYear)
+	if m.lead_time_count != nil {
+		fields = append(fields, repodeliveryweek.FieldLeadTimeCount)
 	}
-	if m.peak_activity_commits != nil {
-		fields = append(fields, memberstat.FieldPeakActivityCommits)
+	if m.restore_seconds != nil {
+		fields = append(fields, repodeliveryweek.FieldRestoreSeconds)
 	}
-	if m.pr_to_review_ratio != nil {
-		fields = append(fields, memberstat.FieldPrToReviewRatio)
+	if m.restore_count != nil {
+		fields = append(fields, repodeliveryweek.FieldRestoreCount)
 	}
 	return fields
 }
@@ -4173,32 +17593,26 @@ func (m *MemberStatMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MemberStatMutation) Field(name string) (ent.Value, bool) {
+func (m *RepoDeliveryWeekMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case memberstat.FieldLogin:
-		return m.Login()
-	case memberstat.FieldTotalCommits:
-		return m.TotalCommits()
-	case memberstat.FieldTotalPrCreated:
-		return m.TotalPrCreated()
-	case memberstat.FieldTotalPrMerged:
-		return m.TotalPrMerged()
-	case memberstat.FieldTotalIssues:
-		return m.TotalIssues()
-	case memberstat.FieldTotalReviews:
-		return m.TotalReviews()
-	case memberstat.FieldTotalAdditions:
-		return m.TotalAdditions()
-	case memberstat.FieldTotalDeletions:
-		return m.TotalDeletions()
-	case memberstat.FieldFirstActivityYear:
-		return m.FirstActivityYear()
-	case memberstat.FieldPeakActivityYear:
-		return m.PeakActivityYear()
-	case memberstat.FieldPeakActivityCommits:
-		return m.PeakActivityCommits()
-	case memberstat.FieldPrToReviewRatio:
-		return m.PrToReviewRatio()
+	case repodeliveryweek.FieldNameWithOwner:
+		return m.NameWithOwner()
+	case repodeliveryweek.FieldWeek:
+		return m.Week()
+	case repodeliveryweek.FieldSource:
+		return m.Source()
+	case repodeliveryweek.FieldDeploymentCount:
+		return m.DeploymentCount()
+	case repodeliveryweek.FieldFailedDeploymentCount:
+		return m.FailedDeploymentCount()
+	case repodeliveryweek.FieldLeadTimeSeconds:
+		return m.LeadTimeSeconds()
+	case repodeliveryweek.FieldLeadTimeCount:
+		return m.LeadTimeCount()
+	case repodeliveryweek.FieldRestoreSeconds:
+		return m.RestoreSeconds()
+	case repodeliveryweek.FieldRestoreCount:
+		return m.RestoreCount()
 	}
 	return nil, false
 }
@@ -4206,165 +17620,123 @@ func (m *MemberStatMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MemberStatMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *RepoDeliveryWeekMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case memberstat.FieldLogin:
-		return m.OldLogin(ctx)
-	case memberstat.FieldTotalCommits:
-		return m.OldTotalCommits(ctx)
-	case memberstat.FieldTotalPrCreated:
-		return m.OldTotalPrCreated(ctx)
-	case memberstat.FieldTotalPrMerged:
-		return m.OldTotalPrMerged(ctx)
-	case memberstat.FieldTotalIssues:
-		return m.OldTotalIssues(ctx)
-	case memberstat.FieldTotalReviews:
-		return m.OldTotalReviews(ctx)
-	case memberstat.FieldTotalAdditions:
-		return m.OldTotalAdditions(ctx)
-	case memberstat.FieldTotalDeletions:
-		return m.OldTotalDeletions(ctx)
-	case memberstat.FieldFirstActivityYear:
-		return m.OldFirstActivityYear(ctx)
-	case memberstat.FieldPeakActivityYear:
-		return m.OldPeakActivityYear(ctx)
-	case memberstat.FieldPeakActivityCommits:
-		return m.OldPeakActivityCommits(ctx)
-	case memberstat.FieldPrToReviewRatio:
-		return m.OldPrToReviewRatio(ctx)
-	}
-	return nil, fmt.Errorf("unknown MemberStat field %s", name)
+	case repodeliveryweek.FieldNameWithOwner:
+		return m.OldNameWithOwner(ctx)
+	case repodeliveryweek.FieldWeek:
+		return m.OldWeek(ctx)
+	case repodeliveryweek.FieldSource:
+		return m.OldSource(ctx)
+	case repodeliveryweek.FieldDeploymentCount:
+		return m.OldDeploymentCount(ctx)
+	case repodeliveryweek.FieldFailedDeploymentCount:
+		return m.OldFailedDeploymentCount(ctx)
+	case repodeliveryweek.FieldLeadTimeSeconds:
+		return m.OldLeadTimeSeconds(ctx)
+	case repodeliveryweek.FieldLeadTimeCount:
+		return m.OldLeadTimeCount(ctx)
+	case repodeliveryweek.FieldRestoreSeconds:
+		return m.OldRestoreSeconds(ctx)
+	case repodeliveryweek.FieldRestoreCount:
+		return m.OldRestoreCount(ctx)
+	}
+	return nil, fmt.Errorf("unknown RepoDeliveryWeek field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MemberStatMutation) SetField(name string, value ent.Value) error {
+func (m *RepoDeliveryWeekMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case memberstat.FieldLogin:
+	case repodeliveryweek.FieldNameWithOwner:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLogin(v)
-		return nil
-	case memberstat.FieldTotalCommits:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTotalCommits(v)
-		return nil
-	case memberstat.FieldTotalPrCreated:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTotalPrCreated(v)
+		m.SetNameWithOwner(v)
 		return nil
-	case memberstat.FieldTotalPrMerged:
-		v, ok := value.(int)
+	case repodeliveryweek.FieldWeek:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTotalPrMerged(v)
+		m.SetWeek(v)
 		return nil
-	case memberstat.FieldTotalIssues:
-		v, ok := value.(int)
+	case repodeliveryweek.FieldSource:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTotalIssues(v)
+		m.SetSource(v)
 		return nil
-	case memberstat.FieldTotalReviews:
+	case repodeliveryweek.FieldDeploymentCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTotalReviews(v)
+		m.SetDeploymentCount(v)
 		return nil
-	case memberstat.FieldTotalAdditions:
+	case repodeliveryweek.FieldFailedDeploymentCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTotalAdditions(v)
+		m.SetFailedDeploymentCount(v)
 		return nil
-	case memberstat.FieldTotalDeletions:
+	case repodeliveryweek.FieldLeadTimeSeconds:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTotalDeletions(v)
+		m.SetLeadTimeSeconds(v)
 		return nil
-	case memberstat.FieldFirstActivityYear:
+	case repodeliveryweek.FieldLeadTimeCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFirstActivityYear(v)
+		m.SetLeadTimeCount(v)
 		return nil
-	case memberstat.FieldPeakActivityYear:
+	case repodeliveryweek.FieldRestoreSeconds:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPeakActivityYear(v)
+		m.SetRestoreSeconds(v)
 		return nil
-	case memberstat.FieldPeakActivityCommits:
+	case repodeliveryweek.FieldRestoreCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPeakActivityCommits(v)
-		return nil
-	case memberstat.FieldPrToReviewRatio:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPrToReviewRatio(v)
+		m.SetRestoreCount(v)
 		return nil
 	}
-	return fmt.Errorf("unknown MemberStat field %s", name)
+	return fmt.Errorf("unknown RepoDeliveryWeek field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MemberStatMutation) AddedFields() []string {
+func (m *RepoDeliveryWeekMutation) AddedFields() []string {
 	var fields []string
-	if m.addtotal_commits != nil {
-		fields = append(fields, memberstat.FieldTotalCommits)
-	}
-	if m.addtotal_pr_created != nil {
-		fields = append(fields, memberstat.FieldTotalPrCreated)
+	if m.adddeployment_count != nil {
+		fields = append(fields, repodeliveryweek.FieldDeploymentCount)
 	}
-	if m.addtotal_pr_merged != nil {
-		fields = append(fields, memberstat.FieldTotalPrMerged)
-	}
-	if m.addtotal_issues != nil {
-		fields = append(fields, memberstat.FieldTotalIssues)
-	}
-	if m.addtotal_reviews != nil {
-		fields = append(fields, memberstat.FieldTotalReviews)
+	if m.addfailed_deployment_count != nil {
+		fields = append(fields, repodeliveryweek.FieldFailedDeploymentCount)
 	}
-	if m.addtotal_additions != nil {
-		fields = append(fields, memberstat.FieldTotalAdditions)
-	}
-	if m.addtotal_deletions != nil {
-		fields = append(fields, memberstat.FieldTotalDeletions)
-	}
-	if m.addfirst_activity_year != nil {
-		fields = append(fields, memberstat.FieldFirstActivityYear)
+	if m.addlead_time_seconds != nil {
+		fields = append(fields, repodeliveryweek.FieldLeadTimeSeconds)
 	}
-	if m.addpeak_activity_year != nil {
-		fields = append(fields, memberstat.FieldPeakActivityYear)
+	if m.addlead_time_count != nil {
+		fields = append(fields, repodeliveryweek.FieldLeadTimeCount)
 	}
-	if m.addpeak_activity_commits != nil {
-		fields = append(fields, memberstat.FieldPeakActivityCommits)
+	if m.addrestore_seconds != nil {
+		fields = append(fields, repodeliveryweek.FieldRestoreSeconds)
 	}
-	if m.addpr_to_review_ratio != nil {
-		fields = append(fields, memberstat.FieldPrToReviewRatio)
+	if m.addrestore_count != nil {
+		fields = append(fields, repodeliveryweek.FieldRestoreCount)
 	}
 	return fields
 }
@@ -4372,30 +17744,20 @@ func (m *MemberStatMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MemberStatMutation) AddedField(name string) (ent.Value, bool) {
+func (m *RepoDeliveryWeekMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case memberstat.FieldTotalCommits:
-		return m.AddedTotalCommits()
-	case memberstat.FieldTotalPrCreated:
-		return m.AddedTotalPrCreated()
-	case memberstat.FieldTotalPrMerged:
-		return m.AddedTotalPrMerged()
-	case memberstat.FieldTotalIssues:
-		return m.AddedTotalIssues()
-	case memberstat.FieldTotalReviews:
-		return m.AddedTotalReviews()
-	case memberstat.FieldTotalAdditions:
-		return m.AddedTotalAdditions()
-	case memberstat.FieldTotalDeletions:
-		return m.AddedTotalDeletions()
-	case memberstat.FieldFirstActivityYear:
-		return m.AddedFirstActivityYear()
-	case memberstat.FieldPeakActivityYear:
-		return m.AddedPeakActivityYear()
-	case memberstat.FieldPeakActivityCommits:
-		return m.AddedPeakActivityCommits()
-	case memberstat.FieldPrToReviewRatio:
-		return m.AddedPrToReviewRatio()
+	case repodeliveryweek.FieldDeploymentCount:
+		return m.AddedDeploymentCount()
+	case repodeliveryweek.FieldFailedDeploymentCount:
+		return m.AddedFailedDeploymentCount()
+	case repodeliveryweek.FieldLeadTimeSeconds:
+		return m.AddedLeadTimeSeconds()
+	case repodeliveryweek.FieldLeadTimeCount:
+		return m.AddedLeadTimeCount()
+	case repodeliveryweek.FieldRestoreSeconds:
+		return m.AddedRestoreSeconds()
+	case repodeliveryweek.FieldRestoreCount:
+		return m.AddedRestoreCount()
 	}
 	return nil, false
 }
@@ -4403,166 +17765,122 @@ func (m *MemberStatMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MemberStatMutation) AddField(name string, value ent.Value) error {
+func (m *RepoDeliveryWeekMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case memberstat.FieldTotalCommits:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTotalCommits(v)
-		return nil
-	case memberstat.FieldTotalPrCreated:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTotalPrCreated(v)
-		return nil
-	case memberstat.FieldTotalPrMerged:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTotalPrMerged(v)
-		return nil
-	case memberstat.FieldTotalIssues:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTotalIssues(v)
-		return nil
-	case memberstat.FieldTotalReviews:
+	case repodeliveryweek.FieldDeploymentCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTotalReviews(v)
+		m.AddDeploymentCount(v)
 		return nil
-	case memberstat.FieldTotalAdditions:
+	case repodeliveryweek.FieldFailedDeploymentCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTotalAdditions(v)
+		m.AddFailedDeploymentCount(v)
 		return nil
-	case memberstat.FieldTotalDeletions:
+	case repodeliveryweek.FieldLeadTimeSeconds:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTotalDeletions(v)
+		m.AddLeadTimeSeconds(v)
 		return nil
-	case memberstat.FieldFirstActivityYear:
+	case repodeliveryweek.FieldLeadTimeCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddFirstActivityYear(v)
+		m.AddLeadTimeCount(v)
 		return nil
-	case memberstat.FieldPeakActivityYear:
+	case repodeliveryweek.FieldRestoreSeconds:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddPeakActivityYear(v)
+		m.AddRestoreSeconds(v)
 		return nil
-	case memberstat.FieldPeakActivityCommits:
+	case repodeliveryweek.FieldRestoreCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddPeakActivityCommits(v)
-		return nil
-	case memberstat.FieldPrToReviewRatio:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPrToReviewRatio(v)
+		m.AddRestoreCount(v)
 		return nil
 	}
-	return fmt.Errorf("unknown MemberStat numeric field %s", name)
+	return fmt.Errorf("unknown RepoDeliveryWeek numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MemberStatMutation) ClearedFields() []string {
+func (m *RepoDeliveryWeekMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MemberStatMutation) FieldCleared(name string) bool {
+func (m *RepoDeliveryWeekMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MemberStatMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown MemberStat nullable field %s", name)
+func (m *RepoDeliveryWeekMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RepoDeliveryWeek nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MemberStatMutation) ResetField(name string) error {
+func (m *RepoDeliveryWeekMutation) ResetField(name string) error {
 	switch name {
-	case memberstat.FieldLogin:
-		m.ResetLogin()
-		return nil
-	case memberstat.FieldTotalCommits:
-		m.ResetTotalCommits()
-		return nil
-	case memberstat.FieldTotalPrCreated:
-		m.ResetTotalPrCreated()
-		return nil
-	case memberstat.FieldTotalPrMerged:
-		m.ResetTotalPrMerged()
-		return nil
-	case memberstat.FieldTotalIssues:
-		m.ResetTotalIssues()
+	case repodeliveryweek.FieldNameWithOwner:
+		m.ResetNameWithOwner()
 		return nil
-	case memberstat.FieldTotalReviews:
-		m.ResetTotalReviews()
+	case repodeliveryweek.FieldWeek:
+		m.ResetWeek()
 		return nil
-	case memberstat.FieldTotalAdditions:
-		m.ResetTotalAdditions()
+	case repodeliveryweek.FieldSource:
+		m.ResetSource()
 		return nil
-	case memberstat.FieldTotalDeletions:
-		m.ResetTotalDeletions()
+	case repodeliveryweek.FieldDeploymentCount:
+		m.ResetDeploymentCount()
 		return nil
-	case memberstat.FieldFirstActivityYear:
-		m.ResetFirstActivityYear()
+	case repodeliveryweek.FieldFailedDeploymentCount:
+		m.ResetFailedDeploymentCount()
 		return nil
-	case memberstat.FieldPeakActivityYear:
-		m.ResetPeakActivityYear()
+	case repodeliveryweek.FieldLeadTimeSeconds:
+		m.ResetLeadTimeSeconds()
 		return nil
-	case memberstat.FieldPeakActivityCommits:
-		m.ResetPeakActivityCommits()
+	case repodeliveryweek.FieldLeadTimeCount:
+		m.ResetLeadTimeCount()
 		return nil
-	case memberstat.FieldPrToReviewRatio:
-		m.ResetPrToReviewRatio()
+	case repodeliveryweek.FieldRestoreSeconds:
+		m.ResetRestoreSeconds()
+		return nil
+	case repodeliveryweek.FieldRestoreCount:
+		m.ResetRestoreCount()
 		return nil
 	}
-	return fmt.Errorf("unknown MemberStat field %s", name)
+	return fmt.Errorf("unknown RepoDeliveryWeek field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MemberStatMutation) AddedEdges() []string {
+func (m *RepoDeliveryWeekMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.snapshot != nil {
-		edges = append(edges, memberstat.EdgeSnapshot)
+		edges = append(edges, repodeliveryweek.EdgeSnapshot)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MemberStatMutation) AddedIDs(name string) []ent.Value {
+func (m *RepoDeliveryWeekMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case memberstat.EdgeSnapshot:
+	case repodeliveryweek.EdgeSnapshot:
 		if id := m.snapshot; id != nil {
 			return []ent.Value{*id}
 		}
@@ -4571,31 +17889,31 @@ func (m *MemberStatMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MemberStatMutation) RemovedEdges() []string {
+func (m *RepoDeliveryWeekMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MemberStatMutation) RemovedIDs(name string) []ent.Value {
+func (m *RepoDeliveryWeekMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MemberStatMutation) ClearedEdges() []string {
+func (m *RepoDeliveryWeekMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.clearedsnapshot {
-		edges = append(edges, memberstat.EdgeSnapshot)
+		edges = append(edges, repodeliveryweek.EdgeSnapshot)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MemberStatMutation) EdgeCleared(name string) bool {
+func (m *RepoDeliveryWeekMutation) EdgeCleared(name string) bool {
 	switch name {
-	case memberstat.EdgeSnapshot:
+	case repodeliveryweek.EdgeSnapshot:
 		return m.clearedsnapshot
 	}
 	return false
@@ -4603,68 +17921,54 @@ func (m *MemberStatMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MemberStatMutation) ClearEdge(name string) error {
+func (m *RepoDeliveryWeekMutation) ClearEdge(name string) error {
 	switch name {
-	case memberstat.EdgeSnapshot:
+	case repodeliveryweek.EdgeSnapshot:
 		m.ClearSnapshot()
 		return nil
 	}
-	return fmt.Errorf("unknown MemberStat unique edge %s", name)
+	return fmt.Errorf("unknown RepoDeliveryWeek unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MemberStatMutation) ResetEdge(name string) error {
+func (m *RepoDeliveryWeekMutation) ResetEdge(name string) error {
 	switch name {
-	case memberstat.EdgeSnapshot:
+	case repodeliveryweek.EdgeSnapshot:
 		m.ResetSnapshot()
 		return nil
 	}
-	return fmt.Errorf("unknown MemberStat edge %s", name)
+	return fmt.Errorf("unknown RepoDeliveryWeek edge %s", name)
 }
 
-// MemberYearStatMutation represents an operation that mutates the MemberYearStat nodes in the graph.
-type MemberYearStatMutation struct {
+// RepoMetaMutation represents an operation that mutates the RepoMeta nodes in the graph.
+type RepoMetaMutation struct {
 	config
 	op              Op
 	typ             string
 	id              *int
-	login           *string
-	year            *int
-	addyear         *int
-	commit_count    *int
-	addcommit_count *int
-	pr_created      *int
-	addpr_created   *int
-	pr_merged       *int
-	addpr_merged    *int
-	issue_count     *int
-	addissue_count  *int
-	review_count    *int
-	addreview_count *int
-	additions       *int
-	addadditions    *int
-	deletions       *int
-	adddeletions    *int
+	name_with_owner *string
+	owner           *string
+	owner_type      *string
 	clearedFields   map[string]struct{}
 	snapshot        *int
 	clearedsnapshot bool
 	done            bool
-	oldValue        func(context.Context) (*MemberYearStat, error)
-	predicates      []predicate.MemberYearStat
+	oldValue        func(context.Context) (*RepoMeta, error)
+	predicates      []predicate.RepoMeta
 }
 
-var _ ent.Mutation = (*MemberYearStatMutation)(nil)
+var _ ent.Mutation = (*RepoMetaMutation)(nil)
 
-// memberyearstatOption allows management of the mutation configuration using functional options.
-type memberyearstatOption func(*MemberYearStatMutation)
+// repometaOption allows management of the mutation configuration using functional options.
+type repometaOption func(*RepoMetaMutation)
 
-// newMemberYearStatMutation creates new mutation for the MemberYearStat entity.
-func newMemberYearStatMutation(c config, op Op, opts ...memberyearstatOption) *MemberYearStatMutation {
-	m := &MemberYearStatMutation{
+// newRepoMetaMutation creates new mutation for the RepoMeta entity.
+func newRepoMetaMutation(c config, op Op, opts ...repometaOption) *RepoMetaMutation {
+	m := &RepoMetaMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMemberYearStat,
+		typ:           TypeRepoMeta,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4673,20 +17977,20 @@ func newMemberYearStatMutation(c config, op Op, opts ...memberyearstatOption) *M
 	return m
 }
 
-// withMemberYearStatID sets the ID field of the mutation.
-func withMemberYearStatID(id int) memberyearstatOption {
-	return func(m *MemberYearStatMutation) {
+// withRepoMetaID sets the ID field of the mutation.
+func withRepoMetaID(id int) repometaOption {
+	return func(m *RepoMetaMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *MemberYearStat
+			value *RepoMeta
 		)
-		m.oldValue = func(ctx context.Context) (*MemberYearStat, error) {
+		m.oldValue = func(ctx context.Context) (*RepoMeta, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().MemberYearStat.Get(ctx, id)
+					value, err = m.Client().RepoMeta.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4695,10 +17999,10 @@ func withMemberYearStatID(id int) memberyearstatOption {
 	}
 }
 
-// withMemberYearStat sets the old MemberYearStat of the mutation.
-func withMemberYearStat(node *MemberYearStat) memberyearstatOption {
-	return func(m *MemberYearStatMutation) {
-		m.oldValue = func(context.Context) (*MemberYearStat, error) {
+// withRepoMeta sets the old RepoMeta of the mutation.
+func withRepoMeta(node *RepoMeta) repometaOption {
+	return func(m *RepoMetaMutation) {
+		m.oldValue = func(context.Context) (*RepoMeta, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4707,7 +18011,7 @@ func withMemberYearStat(node *MemberYearStat) memberyearstatOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MemberYearStatMutation) Client() *Client {
+func (m RepoMetaMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4715,7 +18019,7 @@ func (m MemberYearStatMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MemberYearStatMutation) Tx() (*Tx, error) {
+func (m RepoMetaMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4724,535 +18028,755 @@ func (m MemberYearStatMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *MemberYearStatMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RepoMetaMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RepoMetaMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RepoMeta.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetNameWithOwner sets the "name_with_owner" field.
+func (m *RepoMetaMutation) SetNameWithOwner(s string) {
+	m.name_with_owner = &s
+}
+
+// NameWithOwner returns the value of the "name_with_owner" field in the mutation.
+func (m *RepoMetaMutation) NameWithOwner() (r string, exists bool) {
+	v := m.name_with_owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNameWithOwner returns the old "name_with_owner" field's value of the RepoMeta entity.
+// If the RepoMeta object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RepoMetaMutation) OldNameWithOwner(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNameWithOwner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNameWithOwner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNameWithOwner: %w", err)
+	}
+	return oldValue.NameWithOwner, nil
+}
+
+// ResetNameWithOwner resets all changes to the "name_with_owner" field.
+func (m *RepoMetaMutation) ResetNameWithOwner() {
+	m.name_with_owner = nil
+}
+
+// SetOwner sets the "owner" field.
+func (m *RepoMetaMutation) SetOwner(s string) {
+	m.owner = &s
+}
+
+// Owner returns the value of the "owner" field in the mutation.
+func (m *RepoMetaMutation) Owner() (r string, exists bool) {
+	v := m.owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwner returns the old "owner" field's value of the RepoMeta entity.
+// If the RepoMeta object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RepoMetaMutation) OldOwner(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwner: %w", err)
+	}
+	return oldValue.Owner, nil
+}
+
+// ResetOwner resets all changes to the "owner" field.
+func (m *RepoMetaMutation) ResetOwner() {
+	m.owner = nil
+}
+
+// SetOwnerType sets the "owner_type" field.
+func (m *RepoMetaMutation) SetOwnerType(s string) {
+	m.owner_type = &s
+}
+
+// OwnerType returns the value of the "owner_type" field in the mutation.
+func (m *RepoMetaMutation) OwnerType() (r string, exists bool) {
+	v := m.owner_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerType returns the old "owner_type" field's value of the RepoMeta entity.
+// If the RepoMeta object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RepoMetaMutation) OldOwnerType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerType: %w", err)
+	}
+	return oldValue.OwnerType, nil
+}
+
+// ResetOwnerType resets all changes to the "owner_type" field.
+func (m *RepoMetaMutation) ResetOwnerType() {
+	m.owner_type = nil
+}
+
+// SetSnapshotID sets the "snapshot" edge to the Snapshot entity by id.
+func (m *RepoMetaMutation) SetSnapshotID(id int) {
+	m.snapshot = &id
+}
+
+// ClearSnapshot clears the "snapshot" edge to the Snapshot entity.
+func (m *RepoMetaMutation) ClearSnapshot() {
+	m.clearedsnapshot = true
+}
+
+// SnapshotCleared reports if the "snapshot" edge to the Snapshot entity was cleared.
+func (m *RepoMetaMutation) SnapshotCleared() bool {
+	return m.clearedsnapshot
+}
+
+// SnapshotID returns the "snapshot" edge ID in the mutation.
+func (m *RepoMetaMutation) SnapshotID() (id int, exists bool) {
+	if m.snapshot != nil {
+		return *m.snapshot, true
+	}
+	return
+}
+
+// SnapshotIDs returns the "snapshot" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SnapshotID instead. It exists only for internal usage by the builders.
+func (m *RepoMetaMutation) SnapshotIDs() (ids []int) {
+	if id := m.snapshot; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSnapshot resets all changes to the "snapshot" edge.
+func (m *RepoMetaMutation) ResetSnapshot() {
+	m.snapshot = nil
+	m.clearedsnapshot = false
+}
+
+// Where appends a list predicates to the RepoMetaMutation builder.
+func (m *RepoMetaMutation) Where(ps ...predicate.RepoMeta) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *MemberYearStatMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().MemberYearStat.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// WhereP appends storage-level predicates to the RepoMetaMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RepoMetaMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RepoMeta, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
+	m.Where(p...)
 }
 
-// SetLogin sets the "login" field.
-func (m *MemberYearStatMutation) SetLogin(s string) {
-	m.login = &s
+// Op returns the operation name.
+func (m *RepoMetaMutation) Op() Op {
+	return m.op
 }
 
-// Login returns the value of the "login" field in the mutation.
-func (m *MemberYearStatMutation) Login() (r string, exists bool) {
-	v := m.login
-	if v == nil {
-		return
-	}
-	return *v, true
+// SetOp allows setting the mutation operation.
+func (m *RepoMetaMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// OldLogin returns the old "login" field's value of the MemberYearStat entity.
-// If the MemberYearStat object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberYearStatMutation) OldLogin(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLogin is only allowed on UpdateOne operations")
+// Type returns the node type of this mutation (RepoMeta).
+func (m *RepoMetaMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RepoMetaMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.name_with_owner != nil {
+		fields = append(fields, repometa.FieldNameWithOwner)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLogin requires an ID field in the mutation")
+	if m.owner != nil {
+		fields = append(fields, repometa.FieldOwner)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLogin: %w", err)
+	if m.owner_type != nil {
+		fields = append(fields, repometa.FieldOwnerType)
 	}
-	return oldValue.Login, nil
+	return fields
 }
 
-// ResetLogin resets all changes to the "login" field.
-func (m *MemberYearStatMutation) ResetLogin() {
-	m.login = nil
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RepoMetaMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case repometa.FieldNameWithOwner:
+		return m.NameWithOwner()
+	case repometa.FieldOwner:
+		return m.Owner()
+	case repometa.FieldOwnerType:
+		return m.OwnerType()
+	}
+	return nil, false
 }
 
-// SetYear sets the "year" field.
-func (m *MemberYearStatMutation) SetYear(i int) {
-	m.year = &i
-	m.addyear = nil
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RepoMetaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case repometa.FieldNameWithOwner:
+		return m.OldNameWithOwner(ctx)
+	case repometa.FieldOwner:
+		return m.OldOwner(ctx)
+	case repometa.FieldOwnerType:
+		return m.OldOwnerType(ctx)
+	}
+	return nil, fmt.Errorf("unknown RepoMeta field %s", name)
 }
 
-// Year returns the value of the "year" field in the mutation.
-func (m *MemberYearStatMutation) Year() (r int, exists bool) {
-	v := m.year
-	if v == nil {
-		return
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RepoMetaMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case repometa.FieldNameWithOwner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNameWithOwner(v)
+		return nil
+	case repometa.FieldOwner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwner(v)
+		return nil
+	case repometa.FieldOwnerType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerType(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown RepoMeta field %s", name)
 }
 
-// OldYear returns the old "year" field's value of the MemberYearStat entity.
-// If the MemberYearStat object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberYearStatMutation) OldYear(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldYear is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldYear requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldYear: %w", err)
-	}
-	return oldValue.Year, nil
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RepoMetaMutation) AddedFields() []string {
+	return nil
 }
 
-// AddYear adds i to the "year" field.
-func (m *MemberYearStatMutation) AddYear(i int) {
-	if m.addyear != nil {
-		*m.addyear += i
-	} else {
-		m.addyear = &i
-	}
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RepoMetaMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// AddedYear returns the value that was added to the "year" field in this mutation.
-func (m *MemberYearStatMutation) AddedYear() (r int, exists bool) {
-	v := m.addyear
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RepoMetaMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown RepoMeta numeric field %s", name)
 }
 
-// ResetYear resets all changes to the "year" field.
-func (m *MemberYearStatMutation) ResetYear() {
-	m.year = nil
-	m.addyear = nil
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RepoMetaMutation) ClearedFields() []string {
+	return nil
 }
 
-// SetCommitCount sets the "commit_count" field.
-func (m *MemberYearStatMutation) SetCommitCount(i int) {
-	m.commit_count = &i
-	m.addcommit_count = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RepoMetaMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// CommitCount returns the value of the "commit_count" field in the mutation.
-func (m *MemberYearStatMutation) CommitCount() (r int, exists bool) {
-	v := m.commit_count
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RepoMetaMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RepoMeta nullable field %s", name)
 }
 
-// OldCommitCount returns the old "commit_count" field's value of the MemberYearStat entity.
-// If the MemberYearStat object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberYearStatMutation) OldCommitCount(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCommitCount is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCommitCount requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCommitCount: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RepoMetaMutation) ResetField(name string) error {
+	switch name {
+	case repometa.FieldNameWithOwner:
+		m.ResetNameWithOwner()
+		return nil
+	case repometa.FieldOwner:
+		m.ResetOwner()
+		return nil
+	case repometa.FieldOwnerType:
+		m.ResetOwnerType()
+		return nil
 	}
-	return oldValue.CommitCount, nil
+	return fmt.Errorf("unknown RepoMeta field %s", name)
 }
 
-// AddCommitCount adds i to the "commit_count" field.
-func (m *MemberYearStatMutation) AddCommitCount(i int) {
-	if m.addcommit_count != nil {
-		*m.addcommit_count += i
-	} else {
-		m.addcommit_count = &i
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RepoMetaMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.snapshot != nil {
+		edges = append(edges, repometa.EdgeSnapshot)
 	}
+	return edges
 }
 
-// AddedCommitCount returns the value that was added to the "commit_count" field in this mutation.
-func (m *MemberYearStatMutation) AddedCommitCount() (r int, exists bool) {
-	v := m.addcommit_count
-	if v == nil {
-		return
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RepoMetaMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case repometa.EdgeSnapshot:
+		if id := m.snapshot; id != nil {
+			return []ent.Value{*id}
+		}
 	}
-	return *v, true
+	return nil
 }
 
-// ResetCommitCount resets all changes to the "commit_count" field.
-func (m *MemberYearStatMutation) ResetCommitCount() {
-	m.commit_count = nil
-	m.addcommit_count = nil
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RepoMetaMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
 }
 
-// SetPrCreated sets the "pr_created" field.
-func (m *MemberYearStatMutation) SetPrCreated(i int) {
-	m.pr_created = &i
-	m.addpr_created = nil
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RepoMetaMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// PrCreated returns the value of the "pr_created" field in the mutation.
-func (m *MemberYearStatMutation) PrCreated() (r int, exists bool) {
-	v := m.pr_created
-	if v == nil {
-		return
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RepoMetaMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsnapshot {
+		edges = append(edges, repometa.EdgeSnapshot)
 	}
-	return *v, true
+	return edges
 }
 
-// OldPrCreated returns the old "pr_created" field's value of the MemberYearStat entity.
-// If the MemberYearStat object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberYearStatMutation) OldPrCreated(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPrCreated is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPrCreated requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPrCreated: %w", err)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RepoMetaMutation) EdgeCleared(name string) bool {
+	switch name {
+	case repometa.EdgeSnapshot:
+		return m.clearedsnapshot
 	}
-	return oldValue.PrCreated, nil
+	return false
 }
 
-// AddPrCreated adds i to the "pr_created" field.
-func (m *MemberYearStatMutation) AddPrCreated(i int) {
-	if m.addpr_created != nil {
-		*m.addpr_created += i
-	} else {
-		m.addpr_created = &i
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RepoMetaMutation) ClearEdge(name string) error {
+	switch name {
+	case repometa.EdgeSnapshot:
+		m.ClearSnapshot()
+		return nil
 	}
+	return fmt.Errorf("unknown RepoMeta unique edge %s", name)
 }
 
-// AddedPrCreated returns the value that was added to the "pr_created" field in this mutation.
-func (m *MemberYearStatMutation) AddedPrCreated() (r int, exists bool) {
-	v := m.addpr_created
-	if v == nil {
-		return
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RepoMetaMutation) ResetEdge(name string) error {
+	switch name {
+	case repometa.EdgeSnapshot:
+		m.ResetSnapshot()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown RepoMeta edge %s", name)
 }
 
-// ResetPrCreated resets all changes to the "pr_created" field.
-func (m *MemberYearStatMutation) ResetPrCreated() {
-	m.pr_created = nil
-	m.addpr_created = nil
+// ReviewEdgeMutation represents an operation that mutates the ReviewEdge nodes in the graph.
+type ReviewEdgeMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	reviewer        *string
+	author          *string
+	name_with_owner *string
+	day             *string
+	review_count    *int
+	addreview_count *int
+	clearedFields   map[string]struct{}
+	snapshot        *int
+	clearedsnapshot bool
+	done            bool
+	oldValue        func(context.Context) (*ReviewEdge, error)
+	predicates      []predicate.ReviewEdge
 }
 
-// SetPrMerged sets the "pr_merged" field.
-func (m *MemberYearStatMutation) SetPrMerged(i int) {
-	m.pr_merged = &i
-	m.addpr_merged = nil
-}
+var _ ent.Mutation = (*ReviewEdgeMutation)(nil)
 
-// PrMerged returns the value of the "pr_merged" field in the mutation.
-func (m *MemberYearStatMutation) PrMerged() (r int, exists bool) {
-	v := m.pr_merged
-	if v == nil {
-		return
-	}
-	return *v, true
-}
+// reviewedgeOption allows management of the mutation configuration using functional options.
+type reviewedgeOption func(*ReviewEdgeMutation)
 
-// OldPrMerged returns the old "pr_merged" field's value of the MemberYearStat entity.
-// If the MemberYearStat object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberYearStatMutation) OldPrMerged(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPrMerged is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPrMerged requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPrMerged: %w", err)
+// newReviewEdgeMutation creates new mutation for the ReviewEdge entity.
+func newReviewEdgeMutation(c config, op Op, opts ...reviewedgeOption) *ReviewEdgeMutation {
+	m := &ReviewEdgeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeReviewEdge,
+		clearedFields: make(map[string]struct{}),
 	}
-	return oldValue.PrMerged, nil
-}
-
-// AddPrMerged adds i to the "pr_merged" field.
-func (m *MemberYearStatMutation) AddPrMerged(i int) {
-	if m.addpr_merged != nil {
-		*m.addpr_merged += i
-	} else {
-		m.addpr_merged = &i
+	for _, opt := range opts {
+		opt(m)
 	}
+	return m
 }
 
-// AddedPrMerged returns the value that was added to the "pr_merged" field in this mutation.
-func (m *MemberYearStatMutation) AddedPrMerged() (r int, exists bool) {
-	v := m.addpr_merged
-	if v == nil {
-		return
+// withReviewEdgeID sets the ID field of the mutation.
+func withReviewEdgeID(id int) reviewedgeOption {
+	return func(m *ReviewEdgeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ReviewEdge
+		)
+		m.oldValue = func(ctx context.Context) (*ReviewEdge, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ReviewEdge.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return *v, true
-}
-
-// ResetPrMerged resets all changes to the "pr_merged" field.
-func (m *MemberYearStatMutation) ResetPrMerged() {
-	m.pr_merged = nil
-	m.addpr_merged = nil
 }
 
-// SetIssueCount sets the "issue_count" field.
-func (m *MemberYearStatMutation) SetIssueCount(i int) {
-	m.issue_count = &i
-	m.addissue_count = nil
-}
-
-// IssueCount returns the value of the "issue_count" field in the mutation.
-func (m *MemberYearStatMutation) IssueCount() (r int, exists bool) {
-	v := m.issue_count
-	if v == nil {
-		return
+// withReviewEdge sets the old ReviewEdge of the mutation.
+func withReviewEdge(node *ReviewEdge) reviewedgeOption {
+	return func(m *ReviewEdgeMutation) {
+		m.oldValue = func(context.Context) (*ReviewEdge, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return *v, true
 }
 
-// OldIssueCount returns the old "issue_count" field's value of the MemberYearStat entity.
-// If the MemberYearStat object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberYearStatMutation) OldIssueCount(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIssueCount is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIssueCount requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIssueCount: %w", err)
-	}
-	return oldValue.IssueCount, nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ReviewEdgeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// AddIssueCount adds i to the "issue_count" field.
-func (m *MemberYearStatMutation) AddIssueCount(i int) {
-	if m.addissue_count != nil {
-		*m.addissue_count += i
-	} else {
-		m.addissue_count = &i
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReviewEdgeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// AddedIssueCount returns the value that was added to the "issue_count" field in this mutation.
-func (m *MemberYearStatMutation) AddedIssueCount() (r int, exists bool) {
-	v := m.addissue_count
-	if v == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReviewEdgeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// ResetIssueCount resets all changes to the "issue_count" field.
-func (m *MemberYearStatMutation) ResetIssueCount() {
-	m.issue_count = nil
-	m.addissue_count = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReviewEdgeMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ReviewEdge.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetReviewCount sets the "review_count" field.
-func (m *MemberYearStatMutation) SetReviewCount(i int) {
-	m.review_count = &i
-	m.addreview_count = nil
+// SetReviewer sets the "reviewer" field.
+func (m *ReviewEdgeMutation) SetReviewer(s string) {
+	m.reviewer = &s
 }
 
-// ReviewCount returns the value of the "review_count" field in the mutation.
-func (m *MemberYearStatMutation) ReviewCount() (r int, exists bool) {
-	v := m.review_count
+// Reviewer returns the value of the "reviewer" field in the mutation.
+func (m *ReviewEdgeMutation) Reviewer() (r string, exists bool) {
+	v := m.reviewer
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldReviewCount returns the old "review_count" field's value of the MemberYearStat entity.
-// If the MemberYearStat object wasn't provided to the builder, the object is fetched from the database.
+// OldReviewer returns the old "reviewer" field's value of the ReviewEdge entity.
+// If the ReviewEdge object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberYearStatMutation) OldReviewCount(ctx context.Context) (v int, err error) {
+func (m *ReviewEdgeMutation) OldReviewer(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReviewCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldReviewer is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReviewCount requires an ID field in the mutation")
+		return v, errors.New("OldReviewer requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReviewCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldReviewer: %w", err)
 	}
-	return oldValue.ReviewCount, nil
+	return oldValue.Reviewer, nil
 }
 
-// AddReviewCount adds i to the "review_count" field.
-func (m *MemberYearStatMutation) AddReviewCount(i int) {
-	if m.addreview_count != nil {
-		*m.addreview_count += i
-	} else {
-		m.addreview_count = &i
-	}
+// ResetReviewer resets all changes to the "reviewer" field.
+func (m *ReviewEdgeMutation) ResetReviewer() {
+	m.reviewer = nil
 }
 
-// AddedReviewCount returns the value that was added to the "review_count" field in this mutation.
-func (m *MemberYearStatMutation) AddedReviewCount() (r int, exists bool) {
-	v := m.addreview_count
+// SetAuthor sets the "author" field.
+func (m *ReviewEdgeMutation) SetAuthor(s string) {
+	m.author = &s
+}
+
+// Author returns the value of the "author" field in the mutation.
+func (m *ReviewEdgeMutation) Author() (r string, exists bool) {
+	v := m.author
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetReviewCount resets all changes to the "review_count" field.
-func (m *MemberYearStatMutation) ResetReviewCount() {
-	m.review_count = nil
-	m.addreview_count = nil
+// OldAuthor returns the old "author" field's value of the ReviewEdge entity.
+// If the ReviewEdge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewEdgeMutation) OldAuthor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthor: %w", err)
+	}
+	return oldValue.Author, nil
 }
 
-// SetAdditions sets the "additions" field.
-func (m *MemberYearStatMutation) SetAdditions(i int) {
-	m.additions = &i
-	m.addadditions = nil
+// ResetAuthor resets all changes to the "author" field.
+func (m *ReviewEdgeMutation) ResetAuthor() {
+	m.author = nil
 }
 
-// Additions returns the value of the "additions" field in the mutation.
-func (m *MemberYearStatMutation) Additions() (r int, exists bool) {
-	v := m.additions
+// SetNameWithOwner sets the "name_with_owner" field.
+func (m *ReviewEdgeMutation) SetNameWithOwner(s string) {
+	m.name_with_owner = &s
+}
+
+// NameWithOwner returns the value of the "name_with_owner" field in the mutation.
+func (m *ReviewEdgeMutation) NameWithOwner() (r string, exists bool) {
+	v := m.name_with_owner
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAdditions returns the old "additions" field's value of the MemberYearStat entity.
-// If the MemberYearStat object wasn't provided to the builder, the object is fetched from the database.
+// OldNameWithOwner returns the old "name_with_owner" field's value of the ReviewEdge entity.
+// If the ReviewEdge object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberYearStatMutation) OldAdditions(ctx context.Context) (v int, err error) {
+func (m *ReviewEdgeMutation) OldNameWithOwner(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAdditions is only allowed on UpdateOne operations")
+		return v, errors.New("OldNameWithOwner is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAdditions requires an ID field in the mutation")
+		return v, errors.New("OldNameWithOwner requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAdditions: %w", err)
+		return v, fmt.Errorf("querying old value for OldNameWithOwner: %w", err)
 	}
-	return oldValue.Additions, nil
+	return oldValue.NameWithOwner, nil
 }
 
-// AddAdditions adds i to the "additions" field.
-func (m *MemberYearStatMutation) AddAdditions(i int) {
-	if m.addadditions != nil {
-		*m.addadditions += i
-	} else {
-		m.addadditions = &i
-	}
+// ResetNameWithOwner resets all changes to the "name_with_owner" field.
+func (m *ReviewEdgeMutation) ResetNameWithOwner() {
+	m.name_with_owner = nil
 }
 
-// AddedAdditions returns the value that was added to the "additions" field in this mutation.
-func (m *MemberYearStatMutation) AddedAdditions() (r int, exists bool) {
-	v := m.addadditions
+// SetDay sets the "day" field.
+func (m *ReviewEdgeMutation) SetDay(s string) {
+	m.day = &s
+}
+
+// Day returns the value of the "day" field in the mutation.
+func (m *ReviewEdgeMutation) Day() (r string, exists bool) {
+	v := m.day
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetAdditions resets all changes to the "additions" field.
-func (m *MemberYearStatMutation) ResetAdditions() {
-	m.additions = nil
-	m.addadditions = nil
+// OldDay returns the old "day" field's value of the ReviewEdge entity.
+// If the ReviewEdge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewEdgeMutation) OldDay(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDay is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDay requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDay: %w", err)
+	}
+	return oldValue.Day, nil
 }
 
-// SetDeletions sets the "deletions" field.
-func (m *MemberYearStatMutation) SetDeletions(i int) {
-	m.deletions = &i
-	m.adddeletions = nil
+// ResetDay resets all changes to the "day" field.
+func (m *ReviewEdgeMutation) ResetDay() {
+	m.day = nil
 }
 
-// Deletions returns the value of the "deletions" field in the mutation.
-func (m *MemberYearStatMutation) Deletions() (r int, exists bool) {
-	v := m.deletions
+// SetReviewCount sets the "review_count" field.
+func (m *ReviewEdgeMutation) SetReviewCount(i int) {
+	m.review_count = &i
+	m.addreview_count = nil
+}
+
+// ReviewCount returns the value of the "review_count" field in the mutation.
+func (m *ReviewEdgeMutation) ReviewCount() (r int, exists bool) {
+	v := m.review_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletions returns the old "deletions" field's value of the MemberYearStat entity.
-// If the MemberYearStat object wasn't provided to the builder, the object is fetched from the database.
+// OldReviewCount returns the old "review_count" field's value of the ReviewEdge entity.
+// If the ReviewEdge object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberYearStatMutation) OldDeletions(ctx context.Context) (v int, err error) {
+func (m *ReviewEdgeMutation) OldReviewCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletions is only allowed on UpdateOne operations")
+		return v, errors.New("OldReviewCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletions requires an ID field in the mutation")
+		return v, errors.New("OldReviewCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletions: %w", err)
+		return v, fmt.Errorf("querying old value for OldReviewCount: %w", err)
 	}
-	return oldValue.Deletions, nil
+	return oldValue.ReviewCount, nil
 }
 
-// AddDeletions adds i to the "deletions" field.
-func (m *MemberYearStatMutation) AddDeletions(i int) {
-	if m.adddeletions != nil {
-		*m.adddeletions += i
+// AddReviewCount adds i to the "review_count" field.
+func (m *ReviewEdgeMutation) AddReviewCount(i int) {
+	if m.addreview_count != nil {
+		*m.addreview_count += i
 	} else {
-		m.adddeletions = &i
+		m.addreview_count = &i
 	}
 }
 
-// AddedDeletions returns the value that was added to the "deletions" field in this mutation.
-func (m *MemberYearStatMutation) AddedDeletions() (r int, exists bool) {
-	v := m.adddeletions
+// AddedReviewCount returns the value that was added to the "review_count" field in this mutation.
+func (m *ReviewEdgeMutation) AddedReviewCount() (r int, exists bool) {
+	v := m.addreview_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetDeletions resets all changes to the "deletions" field.
-func (m *MemberYearStatMutation) ResetDeletions() {
-	m.deletions = nil
-	m.adddeletions = nil
+// ResetReviewCount resets all changes to the "review_count" field.
+func (m *ReviewEdgeMutation) ResetReviewCount() {
+	m.review_count = nil
+	m.addreview_count = nil
 }
 
 // SetSnapshotID sets the "snapshot" edge to the Snapshot entity by id.
-func (m *MemberYearStatMutation) SetSnapshotID(id int) {
+func (m *ReviewEdgeMutation) SetSnapshotID(id int) {
 	m.snapshot = &id
 }
 
 // ClearSnapshot clears the "snapshot" edge to the Snapshot entity.
-func (m *MemberYearStatMutation) ClearSnapshot() {
+func (m *ReviewEdgeMutation) ClearSnapshot() {
 	m.clearedsnapshot = true
 }
 
 // SnapshotCleared reports if the "snapshot" edge to the Snapshot entity was cleared.
-func (m *MemberYearStatMutation) SnapshotCleared() bool {
+func (m *ReviewEdgeMutation) SnapshotCleared() bool {
 	return m.clearedsnapshot
 }
 
 // SnapshotID returns the "snapshot" edge ID in the mutation.
-func (m *MemberYearStatMutation) SnapshotID() (id int, exists bool) {
+func (m *ReviewEdgeMutation) SnapshotID() (id int, exists bool) {
 	if m.snapshot != nil {
 		return *m.snapshot, true
 	}
@@ -5262,7 +18786,7 @@ func (m *MemberYearStatMutation) SnapshotID() (id int, exists bool) {
 // SnapshotIDs returns the "snapshot" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // SnapshotID instead. It exists only for internal usage by the builders.
-func (m *MemberYearStatMutation) SnapshotIDs() (ids []int) {
+func (m *ReviewEdgeMutation) SnapshotIDs() (ids []int) {
 	if id := m.snapshot; id != nil {
 		ids = append(ids, *id)
 	}
@@ -5270,20 +18794,20 @@ func (m *MemberYearStatMutation) SnapshotIDs() (ids []int) {
 }
 
 // ResetSnapshot resets all changes to the "snapshot" edge.
-func (m *MemberYearStatMutation) ResetSnapshot() {
+func (m *ReviewEdgeMutation) ResetSnapshot() {
 	m.snapshot = nil
 	m.clearedsnapshot = false
 }
 
-// Where appends a list predicates to the MemberYearStatMutation builder.
-func (m *MemberYearStatMutation) Where(ps ...predicate.MemberYearStat) {
+// Where appends a list predicates to the ReviewEdgeMutation builder.
+func (m *ReviewEdgeMutation) Where(ps ...predicate.ReviewEdge) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MemberYearStatMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ReviewEdgeMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MemberYearStatMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.MemberYearStat, len(ps))
+func (m *ReviewEdgeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ReviewEdge, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5291,51 +18815,39 @@ func (m *MemberYearStatMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MemberYearStatMutation) Op() Op {
+func (m *ReviewEdgeMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MemberYearStatMutation) SetOp(op Op) {
+func (m *ReviewEdgeMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (MemberYearStat).
-func (m *MemberYearStatMutation) Type() string {
+// Type returns the node type of this mutation (ReviewEdge).
+func (m *ReviewEdgeMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MemberYearStatMutation) Fields() []string {
-	fields := make([]string, 0, 9)
-	if m.login != nil {
-		fields = append(fields, memberyearstat.FieldLogin)
-	}
-	if m.year != nil {
-		fields = append(fields, memberyearstat.FieldYear)
-	}
-	if m.commit_count != nil {
-		fields = append(fields, memberyearstat.FieldCommitCount)
+func (m *ReviewEdgeMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.reviewer != nil {
+		fields = append(fields, reviewedge.FieldReviewer)
 	}
-	if m.pr_created != nil {
-		fields = append(fields, memberyearstat.FieldPrCreated)
+	if m.author != nil {
+		fields = append(fields, reviewedge.FieldAuthor)
 	}
-	if m.pr_merged != nil {
-		fields = append(fields, memberyearstat.FieldPrMerged)
+	if m.name_with_owner != nil {
+		fields = append(fields, reviewedge.FieldNameWithOwner)
 	}
-	if m.issue_count != nil {
-		fields = append(fields, memberyearstat.FieldIssueCount)
+	if m.day != nil {
+		fields = append(fields, reviewedge.FieldDay)
 	}
 	if m.review_count != nil {
-		fields = append(fields, memberyearstat.FieldReviewCount)
-	}
-	if m.additions != nil {
-		fields = append(fields, memberyearstat.FieldAdditions)
-	}
-	if m.deletions != nil {
-		fields = append(fields, memberyearstat.FieldDeletions)
+		fields = append(fields, reviewedge.FieldReviewCount)
 	}
 	return fields
 }
@@ -5343,26 +18855,18 @@ func (m *MemberYearStatMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MemberYearStatMutation) Field(name string) (ent.Value, bool) {
+func (m *ReviewEdgeMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case memberyearstat.FieldLogin:
-		return m.Login()
-	case memberyearstat.FieldYear:
-		return m.Year()
-	case memberyearstat.FieldCommitCount:
-		return m.CommitCount()
-	case memberyearstat.FieldPrCreated:
-		return m.PrCreated()
-	case memberyearstat.FieldPrMerged:
-		return m.PrMerged()
-	case memberyearstat.FieldIssueCount:
-		return m.IssueCount()
-	case memberyearstat.FieldReviewCount:
+	case reviewedge.FieldReviewer:
+		return m.Reviewer()
+	case reviewedge.FieldAuthor:
+		return m.Author()
+	case reviewedge.FieldNameWithOwner:
+		return m.NameWithOwner()
+	case reviewedge.FieldDay:
+		return m.Day()
+	case reviewedge.FieldReviewCount:
 		return m.ReviewCount()
-	case memberyearstat.FieldAdditions:
-		return m.Additions()
-	case memberyearstat.FieldDeletions:
-		return m.Deletions()
 	}
 	return nil, false
 }
@@ -5370,129 +18874,72 @@ func (m *MemberYearStatMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MemberYearStatMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ReviewEdgeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case memberyearstat.FieldLogin:
-		return m.OldLogin(ctx)
-	case memberyearstat.FieldYear:
-		return m.OldYear(ctx)
-	case memberyearstat.FieldCommitCount:
-		return m.OldCommitCount(ctx)
-	case memberyearstat.FieldPrCreated:
-		return m.OldPrCreated(ctx)
-	case memberyearstat.FieldPrMerged:
-		return m.OldPrMerged(ctx)
-	case memberyearstat.FieldIssueCount:
-		return m.OldIssueCount(ctx)
-	case memberyearstat.FieldReviewCount:
+	case reviewedge.FieldReviewer:
+		return m.OldReviewer(ctx)
+	case reviewedge.FieldAuthor:
+		return m.OldAuthor(ctx)
+	case reviewedge.FieldNameWithOwner:
+		return m.OldNameWithOwner(ctx)
+	case reviewedge.FieldDay:
+		return m.OldDay(ctx)
+	case reviewedge.FieldReviewCount:
 		return m.OldReviewCount(ctx)
-	case memberyearstat.FieldAdditions:
-		return m.OldAdditions(ctx)
-	case memberyearstat.FieldDeletions:
-		return m.OldDeletions(ctx)
 	}
-	return nil, fmt.Errorf("unknown MemberYearStat field %s", name)
+	return nil, fmt.Errorf("unknown ReviewEdge field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MemberYearStatMutation) SetField(name string, value ent.Value) error {
+func (m *ReviewEdgeMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case memberyearstat.FieldLogin:
+	case reviewedge.FieldReviewer:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLogin(v)
-		return nil
-	case memberyearstat.FieldYear:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetYear(v)
-		return nil
-	case memberyearstat.FieldCommitCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCommitCount(v)
-		return nil
-	case memberyearstat.FieldPrCreated:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPrCreated(v)
-		return nil
-	case memberyearstat.FieldPrMerged:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPrMerged(v)
+		m.SetReviewer(v)
 		return nil
-	case memberyearstat.FieldIssueCount:
-		v, ok := value.(int)
+	case reviewedge.FieldAuthor:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIssueCount(v)
+		m.SetAuthor(v)
 		return nil
-	case memberyearstat.FieldReviewCount:
-		v, ok := value.(int)
+	case reviewedge.FieldNameWithOwner:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReviewCount(v)
+		m.SetNameWithOwner(v)
 		return nil
-	case memberyearstat.FieldAdditions:
-		v, ok := value.(int)
+	case reviewedge.FieldDay:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAdditions(v)
+		m.SetDay(v)
 		return nil
-	case memberyearstat.FieldDeletions:
+	case reviewedge.FieldReviewCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeletions(v)
+		m.SetReviewCount(v)
 		return nil
 	}
-	return fmt.Errorf("unknown MemberYearStat field %s", name)
+	return fmt.Errorf("unknown ReviewEdge field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MemberYearStatMutation) AddedFields() []string {
+func (m *ReviewEdgeMutation) AddedFields() []string {
 	var fields []string
-	if m.addyear != nil {
-		fields = append(fields, memberyearstat.FieldYear)
-	}
-	if m.addcommit_count != nil {
-		fields = append(fields, memberyearstat.FieldCommitCount)
-	}
-	if m.addpr_created != nil {
-		fields = append(fields, memberyearstat.FieldPrCreated)
-	}
-	if m.addpr_merged != nil {
-		fields = append(fields, memberyearstat.FieldPrMerged)
-	}
-	if m.addissue_count != nil {
-		fields = append(fields, memberyearstat.FieldIssueCount)
-	}
 	if m.addreview_count != nil {
-		fields = append(fields, memberyearstat.FieldReviewCount)
-	}
-	if m.addadditions != nil {
-		fields = append(fields, memberyearstat.FieldAdditions)
-	}
-	if m.adddeletions != nil {
-		fields = append(fields, memberyearstat.FieldDeletions)
+		fields = append(fields, reviewedge.FieldReviewCount)
 	}
 	return fields
 }
@@ -5500,24 +18947,10 @@ func (m *MemberYearStatMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MemberYearStatMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ReviewEdgeMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case memberyearstat.FieldYear:
-		return m.AddedYear()
-	case memberyearstat.FieldCommitCount:
-		return m.AddedCommitCount()
-	case memberyearstat.FieldPrCreated:
-		return m.AddedPrCreated()
-	case memberyearstat.FieldPrMerged:
-		return m.AddedPrMerged()
-	case memberyearstat.FieldIssueCount:
-		return m.AddedIssueCount()
-	case memberyearstat.FieldReviewCount:
+	case reviewedge.FieldReviewCount:
 		return m.AddedReviewCount()
-	case memberyearstat.FieldAdditions:
-		return m.AddedAdditions()
-	case memberyearstat.FieldDeletions:
-		return m.AddedDeletions()
 	}
 	return nil, false
 }
@@ -5525,136 +18958,75 @@ func (m *MemberYearStatMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MemberYearStatMutation) AddField(name string, value ent.Value) error {
+func (m *ReviewEdgeMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case memberyearstat.FieldYear:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddYear(v)
-		return nil
-	case memberyearstat.FieldCommitCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCommitCount(v)
-		return nil
-	case memberyearstat.FieldPrCreated:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPrCreated(v)
-		return nil
-	case memberyearstat.FieldPrMerged:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPrMerged(v)
-		return nil
-	case memberyearstat.FieldIssueCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddIssueCount(v)
-		return nil
-	case memberyearstat.FieldReviewCount:
+	case reviewedge.FieldReviewCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddReviewCount(v)
 		return nil
-	case memberyearstat.FieldAdditions:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddAdditions(v)
-		return nil
-	case memberyearstat.FieldDeletions:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDeletions(v)
-		return nil
 	}
-	return fmt.Errorf("unknown MemberYearStat numeric field %s", name)
+	return fmt.Errorf("unknown ReviewEdge numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MemberYearStatMutation) ClearedFields() []string {
+func (m *ReviewEdgeMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MemberYearStatMutation) FieldCleared(name string) bool {
+func (m *ReviewEdgeMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MemberYearStatMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown MemberYearStat nullable field %s", name)
+func (m *ReviewEdgeMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ReviewEdge nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MemberYearStatMutation) ResetField(name string) error {
+func (m *ReviewEdgeMutation) ResetField(name string) error {
 	switch name {
-	case memberyearstat.FieldLogin:
-		m.ResetLogin()
+	case reviewedge.FieldReviewer:
+		m.ResetReviewer()
 		return nil
-	case memberyearstat.FieldYear:
-		m.ResetYear()
-		return nil
-	case memberyearstat.FieldCommitCount:
-		m.ResetCommitCount()
-		return nil
-	case memberyearstat.FieldPrCreated:
-		m.ResetPrCreated()
+	case reviewedge.FieldAuthor:
+		m.ResetAuthor()
 		return nil
-	case memberyearstat.FieldPrMerged:
-		m.ResetPrMerged()
+	case reviewedge.FieldNameWithOwner:
+		m.ResetNameWithOwner()
 		return nil
-	case memberyearstat.FieldIssueCount:
-		m.ResetIssueCount()
+	case reviewedge.FieldDay:
+		m.ResetDay()
 		return nil
-	case memberyearstat.FieldReviewCount:
+	case reviewedge.FieldReviewCount:
 		m.ResetReviewCount()
 		return nil
-	case memberyearstat.FieldAdditions:
-		m.ResetAdditions()
-		return nil
-	case memberyearstat.FieldDeletions:
-		m.ResetDeletions()
-		return nil
 	}
-	return fmt.Errorf("unknown MemberYearStat field %s", name)
+	return fmt.Errorf("unknown ReviewEdge field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MemberYearStatMutation) AddedEdges() []string {
+func (m *ReviewEdgeMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.snapshot != nil {
-		edges = append(edges, memberyearstat.EdgeSnapshot)
+		edges = append(edges, reviewedge.EdgeSnapshot)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MemberYearStatMutation) AddedIDs(name string) []ent.Value {
+func (m *ReviewEdgeMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case memberyearstat.EdgeSnapshot:
+	case reviewedge.EdgeSnapshot:
 		if id := m.snapshot; id != nil {
 			return []ent.Value{*id}
 		}
@@ -5663,31 +19035,31 @@ func (m *MemberYearStatMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MemberYearStatMutation) RemovedEdges() []string {
+func (m *ReviewEdgeMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MemberYearStatMutation) RemovedIDs(name string) []ent.Value {
+func (m *ReviewEdgeMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MemberYearStatMutation) ClearedEdges() []string {
+func (m *ReviewEdgeMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.clearedsnapshot {
-		edges = append(edges, memberyearstat.EdgeSnapshot)
+		edges = append(edges, reviewedge.EdgeSnapshot)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MemberYearStatMutation) EdgeCleared(name string) bool {
+func (m *ReviewEdgeMutation) EdgeCleared(name string) bool {
 	switch name {
-	case memberyearstat.EdgeSnapshot:
+	case reviewedge.EdgeSnapshot:
 		return m.clearedsnapshot
 	}
 	return false
@@ -5695,54 +19067,100 @@ func (m *MemberYearStatMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MemberYearStatMutation) ClearEdge(name string) error {
+func (m *ReviewEdgeMutation) ClearEdge(name string) error {
 	switch name {
-	case memberyearstat.EdgeSnapshot:
+	case reviewedge.EdgeSnapshot:
 		m.ClearSnapshot()
 		return nil
 	}
-	return fmt.Errorf("unknown MemberYearStat unique edge %s", name)
+	return fmt.Errorf("unknown ReviewEdge unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MemberYearStatMutation) ResetEdge(name string) error {
+func (m *ReviewEdgeMutation) ResetEdge(name string) error {
 	switch name {
-	case memberyearstat.EdgeSnapshot:
+	case reviewedge.EdgeSnapshot:
 		m.ResetSnapshot()
 		return nil
 	}
-	return fmt.Errorf("unknown MemberYearStat edge %s", name)
+	return fmt.Errorf("unknown ReviewEdge edge %s", name)
 }
 
-// RepoMetaMutation represents an operation that mutates the RepoMeta nodes in the graph.
-type RepoMetaMutation struct {
+// SnapshotMutation represents an operation that mutates the Snapshot nodes in the graph.
+type SnapshotMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	name_with_owner *string
-	owner           *string
-	owner_type      *string
-	clearedFields   map[string]struct{}
-	snapshot        *int
-	clearedsnapshot bool
-	done            bool
-	oldValue        func(context.Context) (*RepoMeta, error)
-	predicates      []predicate.RepoMeta
+	op                            Op
+	typ                           string
+	id                            *int
+	captured_at                   *time.Time
+	tag                           *string
+	period_since                  *time.Time
+	period_until                  *time.Time
+	lookback_years                *int
+	addlookback_years             *int
+	clearedFields                 map[string]struct{}
+	member_stats                  map[int]struct{}
+	removedmember_stats           map[int]struct{}
+	clearedmember_stats           bool
+	member_year_stats             map[int]struct{}
+	removedmember_year_stats      map[int]struct{}
+	clearedmember_year_stats      bool
+	member_day_stats              map[int]struct{}
+	removedmember_day_stats       map[int]struct{}
+	clearedmember_day_stats       bool
+	member_repo_stats             map[int]struct{}
+	removedmember_repo_stats      map[int]struct{}
+	clearedmember_repo_stats      bool
+	member_repo_day_stats         map[int]struct{}
+	removedmember_repo_day_stats  map[int]struct{}
+	clearedmember_repo_day_stats  bool
+	repo_metas                    map[int]struct{}
+	removedrepo_metas             map[int]struct{}
+	clearedrepo_metas             bool
+	member_pull_requests          map[int]struct{}
+	removedmember_pull_requests   map[int]struct{}
+	clearedmember_pull_requests   bool
+	review_edges                  map[int]struct{}
+	removedreview_edges           map[int]struct{}
+	clearedreview_edges           bool
+	member_data_gaps              map[int]struct{}
+	removedmember_data_gaps       map[int]struct{}
+	clearedmember_data_gaps       bool
+	excluded_members              map[int]struct{}
+	removedexcluded_members       map[int]struct{}
+	clearedexcluded_members       bool
+	member_accounts               map[int]struct{}
+	removedmember_accounts        map[int]struct{}
+	clearedmember_accounts        bool
+	repo_delivery_weeks           map[int]struct{}
+	removedrepo_delivery_weeks    map[int]struct{}
+	clearedrepo_delivery_weeks    bool
+	member_issues                 map[int]struct{}
+	removedmember_issues          map[int]struct{}
+	clearedmember_issues          bool
+	member_review_requests        map[int]struct{}
+	removedmember_review_requests map[int]struct{}
+	clearedmember_review_requests bool
+	member_hour_stats             map[int]struct{}
+	removedmember_hour_stats      map[int]struct{}
+	clearedmember_hour_stats      bool
+	done                          bool
+	oldValue                      func(context.Context) (*Snapshot, error)
+	predicates                    []predicate.Snapshot
 }
 
-var _ ent.Mutation = (*RepoMetaMutation)(nil)
+var _ ent.Mutation = (*SnapshotMutation)(nil)
 
-// repometaOption allows management of the mutation configuration using functional options.
-type repometaOption func(*RepoMetaMutation)
+// snapshotOption allows management of the mutation configuration using functional options.
+type snapshotOption func(*SnapshotMutation)
 
-// newRepoMetaMutation creates new mutation for the RepoMeta entity.
-func newRepoMetaMutation(c config, op Op, opts ...repometaOption) *RepoMetaMutation {
-	m := &RepoMetaMutation{
+// newSnapshotMutation creates new mutation for the Snapshot entity.
+func newSnapshotMutation(c config, op Op, opts ...snapshotOption) *SnapshotMutation {
+	m := &SnapshotMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeRepoMeta,
+		typ:           TypeSnapshot,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5751,20 +19169,20 @@ func newRepoMetaMutation(c config, op Op, opts ...repometaOption) *RepoMetaMutat
 	return m
 }
 
-// withRepoMetaID sets the ID field of the mutation.
-func withRepoMetaID(id int) repometaOption {
-	return func(m *RepoMetaMutation) {
+// withSnapshotID sets the ID field of the mutation.
+func withSnapshotID(id int) snapshotOption {
+	return func(m *SnapshotMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *RepoMeta
+			value *Snapshot
 		)
-		m.oldValue = func(ctx context.Context) (*RepoMeta, error) {
+		m.oldValue = func(ctx context.Context) (*Snapshot, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().RepoMeta.Get(ctx, id)
+					value, err = m.Client().Snapshot.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5773,10 +19191,10 @@ func withRepoMetaID(id int) repometaOption {
 	}
 }
 
-// withRepoMeta sets the old RepoMeta of the mutation.
-func withRepoMeta(node *RepoMeta) repometaOption {
-	return func(m *RepoMetaMutation) {
-		m.oldValue = func(context.Context) (*RepoMeta, error) {
+// withSnapshot sets the old Snapshot of the mutation.
+func withSnapshot(node *Snapshot) snapshotOption {
+	return func(m *SnapshotMutation) {
+		m.oldValue = func(context.Context) (*Snapshot, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5785,7 +19203,7 @@ func withRepoMeta(node *RepoMeta) repometaOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RepoMetaMutation) Client() *Client {
+func (m SnapshotMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5793,7 +19211,7 @@ func (m RepoMetaMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m RepoMetaMutation) Tx() (*Tx, error) {
+func (m SnapshotMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -5804,7 +19222,7 @@ func (m RepoMetaMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *RepoMetaMutation) ID() (id int, exists bool) {
+func (m *SnapshotMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5815,7 +19233,7 @@ func (m *RepoMetaMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *RepoMetaMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *SnapshotMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -5824,885 +19242,1059 @@ func (m *RepoMetaMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().RepoMeta.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Snapshot.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetNameWithOwner sets the "name_with_owner" field.
-func (m *RepoMetaMutation) SetNameWithOwner(s string) {
-	m.name_with_owner = &s
+// SetCapturedAt sets the "captured_at" field.
+func (m *SnapshotMutation) SetCapturedAt(t time.Time) {
+	m.captured_at = &t
+}
+
+// CapturedAt returns the value of the "captured_at" field in the mutation.
+func (m *SnapshotMutation) CapturedAt() (r time.Time, exists bool) {
+	v := m.captured_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCapturedAt returns the old "captured_at" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotMutation) OldCapturedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCapturedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCapturedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCapturedAt: %w", err)
+	}
+	return oldValue.CapturedAt, nil
+}
+
+// ResetCapturedAt resets all changes to the "captured_at" field.
+func (m *SnapshotMutation) ResetCapturedAt() {
+	m.captured_at = nil
+}
+
+// SetTag sets the "tag" field.
+func (m *SnapshotMutation) SetTag(s string) {
+	m.tag = &s
+}
+
+// Tag returns the value of the "tag" field in the mutation.
+func (m *SnapshotMutation) Tag() (r string, exists bool) {
+	v := m.tag
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTag returns the old "tag" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotMutation) OldTag(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTag is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTag requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTag: %w", err)
+	}
+	return oldValue.Tag, nil
+}
+
+// ClearTag clears the value of the "tag" field.
+func (m *SnapshotMutation) ClearTag() {
+	m.tag = nil
+	m.clearedFields[snapshot.FieldTag] = struct{}{}
 }
 
-// NameWithOwner returns the value of the "name_with_owner" field in the mutation.
-func (m *RepoMetaMutation) NameWithOwner() (r string, exists bool) {
-	v := m.name_with_owner
+// TagCleared returns if the "tag" field was cleared in this mutation.
+func (m *SnapshotMutation) TagCleared() bool {
+	_, ok := m.clearedFields[snapshot.FieldTag]
+	return ok
+}
+
+// ResetTag resets all changes to the "tag" field.
+func (m *SnapshotMutation) ResetTag() {
+	m.tag = nil
+	delete(m.clearedFields, snapshot.FieldTag)
+}
+
+// SetPeriodSince sets the "period_since" field.
+func (m *SnapshotMutation) SetPeriodSince(t time.Time) {
+	m.period_since = &t
+}
+
+// PeriodSince returns the value of the "period_since" field in the mutation.
+func (m *SnapshotMutation) PeriodSince() (r time.Time, exists bool) {
+	v := m.period_since
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNameWithOwner returns the old "name_with_owner" field's value of the RepoMeta entity.
-// If the RepoMeta object wasn't provided to the builder, the object is fetched from the database.
+// OldPeriodSince returns the old "period_since" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RepoMetaMutation) OldNameWithOwner(ctx context.Context) (v string, err error) {
+func (m *SnapshotMutation) OldPeriodSince(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNameWithOwner is only allowed on UpdateOne operations")
+		return v, errors.New("OldPeriodSince is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNameWithOwner requires an ID field in the mutation")
+		return v, errors.New("OldPeriodSince requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNameWithOwner: %w", err)
+		return v, fmt.Errorf("querying old value for OldPeriodSince: %w", err)
 	}
-	return oldValue.NameWithOwner, nil
+	return oldValue.PeriodSince, nil
 }
 
-// ResetNameWithOwner resets all changes to the "name_with_owner" field.
-func (m *RepoMetaMutation) ResetNameWithOwner() {
-	m.name_with_owner = nil
+// ClearPeriodSince clears the value of the "period_since" field.
+func (m *SnapshotMutation) ClearPeriodSince() {
+	m.period_since = nil
+	m.clearedFields[snapshot.FieldPeriodSince] = struct{}{}
 }
 
-// SetOwner sets the "owner" field.
-func (m *RepoMetaMutation) SetOwner(s string) {
-	m.owner = &s
+// PeriodSinceCleared returns if the "period_since" field was cleared in this mutation.
+func (m *SnapshotMutation) PeriodSinceCleared() bool {
+	_, ok := m.clearedFields[snapshot.FieldPeriodSince]
+	return ok
 }
 
-// Owner returns the value of the "owner" field in the mutation.
-func (m *RepoMetaMutation) Owner() (r string, exists bool) {
-	v := m.owner
+// ResetPeriodSince resets all changes to the "period_since" field.
+func (m *SnapshotMutation) ResetPeriodSince() {
+	m.period_since = nil
+	delete(m.clearedFields, snapshot.FieldPeriodSince)
+}
+
+// SetPeriodUntil sets the "period_until" field.
+func (m *SnapshotMutation) SetPeriodUntil(t time.Time) {
+	m.period_until = &t
+}
+
+// PeriodUntil returns the value of the "period_until" field in the mutation.
+func (m *SnapshotMutation) PeriodUntil() (r time.Time, exists bool) {
+	v := m.period_until
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOwner returns the old "owner" field's value of the RepoMeta entity.
-// If the RepoMeta object wasn't provided to the builder, the object is fetched from the database.
+// OldPeriodUntil returns the old "period_until" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RepoMetaMutation) OldOwner(ctx context.Context) (v string, err error) {
+func (m *SnapshotMutation) OldPeriodUntil(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOwner is only allowed on UpdateOne operations")
+		return v, errors.New("OldPeriodUntil is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOwner requires an ID field in the mutation")
+		return v, errors.New("OldPeriodUntil requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOwner: %w", err)
+		return v, fmt.Errorf("querying old value for OldPeriodUntil: %w", err)
 	}
-	return oldValue.Owner, nil
+	return oldValue.PeriodUntil, nil
 }
 
-// ResetOwner resets all changes to the "owner" field.
-func (m *RepoMetaMutation) ResetOwner() {
-	m.owner = nil
+// ClearPeriodUntil clears the value of the "period_until" field.
+func (m *SnapshotMutation) ClearPeriodUntil() {
+	m.period_until = nil
+	m.clearedFields[snapshot.FieldPeriodUntil] = struct{}{}
 }
 
-// SetOwnerType sets the "owner_type" field.
-func (m *RepoMetaMutation) SetOwnerType(s string) {
-	m.owner_type = &s
+// PeriodUntilCleared returns if the "period_until" field was cleared in this mutation.
+func (m *SnapshotMutation) PeriodUntilCleared() bool {
+	_, ok := m.clearedFields[snapshot.FieldPeriodUntil]
+	return ok
 }
 
-// OwnerType returns the value of the "owner_type" field in the mutation.
-func (m *RepoMetaMutation) OwnerType() (r string, exists bool) {
-	v := m.owner_type
+// ResetPeriodUntil resets all changes to the "period_until" field.
+func (m *SnapshotMutation) ResetPeriodUntil() {
+	m.period_until = nil
+	delete(m.clearedFields, snapshot.FieldPeriodUntil)
+}
+
+// SetLookbackYears sets the "lookback_years" field.
+func (m *SnapshotMutation) SetLookbackYears(i int) {
+	m.lookback_years = &i
+	m.addlookback_years = nil
+}
+
+// LookbackYears returns the value of the "lookback_years" field in the mutation.
+func (m *SnapshotMutation) LookbackYears() (r int, exists bool) {
+	v := m.lookback_years
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOwnerType returns the old "owner_type" field's value of the RepoMeta entity.
-// If the RepoMeta object wasn't provided to the builder, the object is fetched from the database.
+// OldLookbackYears returns the old "lookback_years" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RepoMetaMutation) OldOwnerType(ctx context.Context) (v string, err error) {
+func (m *SnapshotMutation) OldLookbackYears(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOwnerType is only allowed on UpdateOne operations")
+		return v, errors.New("OldLookbackYears is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOwnerType requires an ID field in the mutation")
+		return v, errors.New("OldLookbackYears requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOwnerType: %w", err)
+		return v, fmt.Errorf("querying old value for OldLookbackYears: %w", err)
+	}
+	return oldValue.LookbackYears, nil
+}
+
+// AddLookbackYears adds i to the "lookback_years" field.
+func (m *SnapshotMutation) AddLookbackYears(i int) {
+	if m.addlookback_years != nil {
+		*m.addlookback_years += i
+	} else {
+		m.addlookback_years = &i
+	}
+}
+
+// AddedLookbackYears returns the value that was added to the "lookback_years" field in this mutation.
+func (m *SnapshotMutation) AddedLookbackYears() (r int, exists bool) {
+	v := m.addlookback_years
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLookbackYears resets all changes to the "lookback_years" field.
+func (m *SnapshotMutation) ResetLookbackYears() {
+	m.lookback_years = nil
+	m.addlookback_years = nil
+}
+
+// AddMemberStatIDs adds the "member_stats" edge to the MemberStat entity by ids.
+func (m *SnapshotMutation) AddMemberStatIDs(ids ...int) {
+	if m.member_stats == nil {
+		m.member_stats = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.member_stats[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMemberStats clears the "member_stats" edge to the MemberStat entity.
+func (m *SnapshotMutation) ClearMemberStats() {
+	m.clearedmember_stats = true
+}
+
+// MemberStatsCleared reports if the "member_stats" edge to the MemberStat entity was cleared.
+func (m *SnapshotMutation) MemberStatsCleared() bool {
+	return m.clearedmember_stats
+}
+
+// RemoveMemberStatIDs removes the "member_stats" edge to the MemberStat entity by IDs.
+func (m *SnapshotMutation) RemoveMemberStatIDs(ids ...int) {
+	if m.removedmember_stats == nil {
+		m.removedmember_stats = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.member_stats, ids[i])
+		m.removedmember_stats[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMemberStats returns the removed IDs of the "member_stats" edge to the MemberStat entity.
+func (m *SnapshotMutation) RemovedMemberStatsIDs() (ids []int) {
+	for id := range m.removedmember_stats {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MemberStatsIDs returns the "member_stats" edge IDs in the mutation.
+func (m *SnapshotMutation) MemberStatsIDs() (ids []int) {
+	for id := range m.member_stats {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMemberStats resets all changes to the "member_stats" edge.
+func (m *SnapshotMutation) ResetMemberStats() {
+	m.member_stats = nil
+	m.clearedmember_stats = false
+	m.removedmember_stats = nil
+}
+
+// AddMemberYearStatIDs adds the "member_year_stats" edge to the MemberYearStat entity by ids.
+func (m *SnapshotMutation) AddMemberYearStatIDs(ids ...int) {
+	if m.member_year_stats == nil {
+		m.member_year_stats = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.member_year_stats[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMemberYearStats clears the "member_year_stats" edge to the MemberYearStat entity.
+func (m *SnapshotMutation) ClearMemberYearStats() {
+	m.clearedmember_year_stats = true
+}
+
+// MemberYearStatsCleared reports if the "member_year_stats" edge to the MemberYearStat entity was cleared.
+func (m *SnapshotMutation) MemberYearStatsCleared() bool {
+	return m.clearedmember_year_stats
+}
+
+// RemoveMemberYearStatIDs removes the "member_year_stats" edge to the MemberYearStat entity by IDs.
+func (m *SnapshotMutation) RemoveMemberYearStatIDs(ids ...int) {
+	if m.removedmember_year_stats == nil {
+		m.removedmember_year_stats = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.member_year_stats, ids[i])
+		m.removedmember_year_stats[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMemberYearStats returns the removed IDs of the "member_year_stats" edge to the MemberYearStat entity.
+func (m *SnapshotMutation) RemovedMemberYearStatsIDs() (ids []int) {
+	for id := range m.removedmember_year_stats {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MemberYearStatsIDs returns the "member_year_stats" edge IDs in the mutation.
+func (m *SnapshotMutation) MemberYearStatsIDs() (ids []int) {
+	for id := range m.member_year_stats {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMemberYearStats resets all changes to the "member_year_stats" edge.
+func (m *SnapshotMutation) ResetMemberYearStats() {
+	m.member_year_stats = nil
+	m.clearedmember_year_stats = false
+	m.removedmember_year_stats = nil
+}
+
+// AddMemberDayStatIDs adds the "member_day_stats" edge to the MemberDayStat entity by ids.
+func (m *SnapshotMutation) AddMemberDayStatIDs(ids ...int) {
+	if m.member_day_stats == nil {
+		m.member_day_stats = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.member_day_stats[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMemberDayStats clears the "member_day_stats" edge to the MemberDayStat entity.
+func (m *SnapshotMutation) ClearMemberDayStats() {
+	m.clearedmember_day_stats = true
+}
+
+// MemberDayStatsCleared reports if the "member_day_stats" edge to the MemberDayStat entity was cleared.
+func (m *SnapshotMutation) MemberDayStatsCleared() bool {
+	return m.clearedmember_day_stats
+}
+
+// RemoveMemberDayStatIDs removes the "member_day_stats" edge to the MemberDayStat entity by IDs.
+func (m *SnapshotMutation) RemoveMemberDayStatIDs(ids ...int) {
+	if m.removedmember_day_stats == nil {
+		m.removedmember_day_stats = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.member_day_stats, ids[i])
+		m.removedmember_day_stats[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMemberDayStats returns the removed IDs of the "member_day_stats" edge to the MemberDayStat entity.
+func (m *SnapshotMutation) RemovedMemberDayStatsIDs() (ids []int) {
+	for id := range m.removedmember_day_stats {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MemberDayStatsIDs returns the "member_day_stats" edge IDs in the mutation.
+func (m *SnapshotMutation) MemberDayStatsIDs() (ids []int) {
+	for id := range m.member_day_stats {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMemberDayStats resets all changes to the "member_day_stats" edge.
+func (m *SnapshotMutation) ResetMemberDayStats() {
+	m.member_day_stats = nil
+	m.clearedmember_day_stats = false
+	m.removedmember_day_stats = nil
+}
+
+// AddMemberRepoStatIDs adds the "member_repo_stats" edge to the MemberRepoStat entity by ids.
+func (m *SnapshotMutation) AddMemberRepoStatIDs(ids ...int) {
+	if m.member_repo_stats == nil {
+		m.member_repo_stats = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.member_repo_stats[ids[i]] = struct{}{}
 	}
-	return oldValue.OwnerType, nil
-}
-
-// ResetOwnerType resets all changes to the "owner_type" field.
-func (m *RepoMetaMutation) ResetOwnerType() {
-	m.owner_type = nil
 }
 
-// SetSnapshotID sets the "snapshot" edge to the Snapshot entity by id.
-func (m *RepoMetaMutation) SetSnapshotID(id int) {
-	m.snapshot = &id
+// ClearMemberRepoStats clears the "member_repo_stats" edge to the MemberRepoStat entity.
+func (m *SnapshotMutation) ClearMemberRepoStats() {
+	m.clearedmember_repo_stats = true
 }
 
-// ClearSnapshot clears the "snapshot" edge to the Snapshot entity.
-func (m *RepoMetaMutation) ClearSnapshot() {
-	m.clearedsnapshot = true
+// MemberRepoStatsCleared reports if the "member_repo_stats" edge to the MemberRepoStat entity was cleared.
+func (m *SnapshotMutation) MemberRepoStatsCleared() bool {
+	return m.clearedmember_repo_stats
 }
 
-// SnapshotCleared reports if the "snapshot" edge to the Snapshot entity was cleared.
-func (m *RepoMetaMutation) SnapshotCleared() bool {
-	return m.clearedsnapshot
+// RemoveMemberRepoStatIDs removes the "member_repo_stats" edge to the MemberRepoStat entity by IDs.
+func (m *SnapshotMutation) RemoveMemberRepoStatIDs(ids ...int) {
+	if m.removedmember_repo_stats == nil {
+		m.removedmember_repo_stats = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.member_repo_stats, ids[i])
+		m.removedmember_repo_stats[ids[i]] = struct{}{}
+	}
 }
 
-// SnapshotID returns the "snapshot" edge ID in the mutation.
-func (m *RepoMetaMutation) SnapshotID() (id int, exists bool) {
-	if m.snapshot != nil {
-		return *m.snapshot, true
+// RemovedMemberRepoStats returns the removed IDs of the "member_repo_stats" edge to the MemberRepoStat entity.
+func (m *SnapshotMutation) RemovedMemberRepoStatsIDs() (ids []int) {
+	for id := range m.removedmember_repo_stats {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// SnapshotIDs returns the "snapshot" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// SnapshotID instead. It exists only for internal usage by the builders.
-func (m *RepoMetaMutation) SnapshotIDs() (ids []int) {
-	if id := m.snapshot; id != nil {
-		ids = append(ids, *id)
+// MemberRepoStatsIDs returns the "member_repo_stats" edge IDs in the mutation.
+func (m *SnapshotMutation) MemberRepoStatsIDs() (ids []int) {
+	for id := range m.member_repo_stats {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetSnapshot resets all changes to the "snapshot" edge.
-func (m *RepoMetaMutation) ResetSnapshot() {
-	m.snapshot = nil
-	m.clearedsnapshot = false
-}
-
-// Where appends a list predicates to the RepoMetaMutation builder.
-func (m *RepoMetaMutation) Where(ps ...predicate.RepoMeta) {
-	m.predicates = append(m.predicates, ps...)
+// ResetMemberRepoStats resets all changes to the "member_repo_stats" edge.
+func (m *SnapshotMutation) ResetMemberRepoStats() {
+	m.member_repo_stats = nil
+	m.clearedmember_repo_stats = false
+	m.removedmember_repo_stats = nil
 }
 
-// WhereP appends storage-level predicates to the RepoMetaMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RepoMetaMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.RepoMeta, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// AddMemberRepoDayStatIDs adds the "member_repo_day_stats" edge to the MemberRepoDayStat entity by ids.
+func (m *SnapshotMutation) AddMemberRepoDayStatIDs(ids ...int) {
+	if m.member_repo_day_stats == nil {
+		m.member_repo_day_stats = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.member_repo_day_stats[ids[i]] = struct{}{}
 	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *RepoMetaMutation) Op() Op {
-	return m.op
 }
 
-// SetOp allows setting the mutation operation.
-func (m *RepoMetaMutation) SetOp(op Op) {
-	m.op = op
+// ClearMemberRepoDayStats clears the "member_repo_day_stats" edge to the MemberRepoDayStat entity.
+func (m *SnapshotMutation) ClearMemberRepoDayStats() {
+	m.clearedmember_repo_day_stats = true
 }
 
-// Type returns the node type of this mutation (RepoMeta).
-func (m *RepoMetaMutation) Type() string {
-	return m.typ
+// MemberRepoDayStatsCleared reports if the "member_repo_day_stats" edge to the MemberRepoDayStat entity was cleared.
+func (m *SnapshotMutation) MemberRepoDayStatsCleared() bool {
+	return m.clearedmember_repo_day_stats
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *RepoMetaMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.name_with_owner != nil {
-		fields = append(fields, repometa.FieldNameWithOwner)
-	}
-	if m.owner != nil {
-		fields = append(fields, repometa.FieldOwner)
-	}
-	if m.owner_type != nil {
-		fields = append(fields, repometa.FieldOwnerType)
+// RemoveMemberRepoDayStatIDs removes the "member_repo_day_stats" edge to the MemberRepoDayStat entity by IDs.
+func (m *SnapshotMutation) RemoveMemberRepoDayStatIDs(ids ...int) {
+	if m.removedmember_repo_day_stats == nil {
+		m.removedmember_repo_day_stats = make(map[int]struct{})
 	}
-	return fields
-}
-
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *RepoMetaMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case repometa.FieldNameWithOwner:
-		return m.NameWithOwner()
-	case repometa.FieldOwner:
-		return m.Owner()
-	case repometa.FieldOwnerType:
-		return m.OwnerType()
+	for i := range ids {
+		delete(m.member_repo_day_stats, ids[i])
+		m.removedmember_repo_day_stats[ids[i]] = struct{}{}
 	}
-	return nil, false
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *RepoMetaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case repometa.FieldNameWithOwner:
-		return m.OldNameWithOwner(ctx)
-	case repometa.FieldOwner:
-		return m.OldOwner(ctx)
-	case repometa.FieldOwnerType:
-		return m.OldOwnerType(ctx)
+// RemovedMemberRepoDayStats returns the removed IDs of the "member_repo_day_stats" edge to the MemberRepoDayStat entity.
+func (m *SnapshotMutation) RemovedMemberRepoDayStatsIDs() (ids []int) {
+	for id := range m.removedmember_repo_day_stats {
+		ids = append(ids, id)
 	}
-	return nil, fmt.Errorf("unknown RepoMeta field %s", name)
+	return
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *RepoMetaMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case repometa.FieldNameWithOwner:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetNameWithOwner(v)
-		return nil
-	case repometa.FieldOwner:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOwner(v)
-		return nil
-	case repometa.FieldOwnerType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOwnerType(v)
-		return nil
+// MemberRepoDayStatsIDs returns the "member_repo_day_stats" edge IDs in the mutation.
+func (m *SnapshotMutation) MemberRepoDayStatsIDs() (ids []int) {
+	for id := range m.member_repo_day_stats {
+		ids = append(ids, id)
 	}
-	return fmt.Errorf("unknown RepoMeta field %s", name)
+	return
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *RepoMetaMutation) AddedFields() []string {
-	return nil
+// ResetMemberRepoDayStats resets all changes to the "member_repo_day_stats" edge.
+func (m *SnapshotMutation) ResetMemberRepoDayStats() {
+	m.member_repo_day_stats = nil
+	m.clearedmember_repo_day_stats = false
+	m.removedmember_repo_day_stats = nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *RepoMetaMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// AddRepoMetaIDs adds the "repo_metas" edge to the RepoMeta entity by ids.
+func (m *SnapshotMutation) AddRepoMetaIDs(ids ...int) {
+	if m.repo_metas == nil {
+		m.repo_metas = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.repo_metas[ids[i]] = struct{}{}
+	}
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *RepoMetaMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown RepoMeta numeric field %s", name)
+// ClearRepoMetas clears the "repo_metas" edge to the RepoMeta entity.
+func (m *SnapshotMutation) ClearRepoMetas() {
+	m.clearedrepo_metas = true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *RepoMetaMutation) ClearedFields() []string {
-	return nil
+// RepoMetasCleared reports if the "repo_metas" edge to the RepoMeta entity was cleared.
+func (m *SnapshotMutation) RepoMetasCleared() bool {
+	return m.clearedrepo_metas
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *RepoMetaMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// RemoveRepoMetaIDs removes the "repo_metas" edge to the RepoMeta entity by IDs.
+func (m *SnapshotMutation) RemoveRepoMetaIDs(ids ...int) {
+	if m.removedrepo_metas == nil {
+		m.removedrepo_metas = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.repo_metas, ids[i])
+		m.removedrepo_metas[ids[i]] = struct{}{}
+	}
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *RepoMetaMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown RepoMeta nullable field %s", name)
+// RemovedRepoMetas returns the removed IDs of the "repo_metas" edge to the RepoMeta entity.
+func (m *SnapshotMutation) RemovedRepoMetasIDs() (ids []int) {
+	for id := range m.removedrepo_metas {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *RepoMetaMutation) ResetField(name string) error {
-	switch name {
-	case repometa.FieldNameWithOwner:
-		m.ResetNameWithOwner()
-		return nil
-	case repometa.FieldOwner:
-		m.ResetOwner()
-		return nil
-	case repometa.FieldOwnerType:
-		m.ResetOwnerType()
-		return nil
+// RepoMetasIDs returns the "repo_metas" edge IDs in the mutation.
+func (m *SnapshotMutation) RepoMetasIDs() (ids []int) {
+	for id := range m.repo_metas {
+		ids = append(ids, id)
 	}
-	return fmt.Errorf("unknown RepoMeta field %s", name)
+	return
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RepoMetaMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.snapshot != nil {
-		edges = append(edges, repometa.EdgeSnapshot)
-	}
-	return edges
+// ResetRepoMetas resets all changes to the "repo_metas" edge.
+func (m *SnapshotMutation) ResetRepoMetas() {
+	m.repo_metas = nil
+	m.clearedrepo_metas = false
+	m.removedrepo_metas = nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *RepoMetaMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case repometa.EdgeSnapshot:
-		if id := m.snapshot; id != nil {
-			return []ent.Value{*id}
-		}
+// AddMemberPullRequestIDs adds the "member_pull_requests" edge to the MemberPullRequest entity by ids.
+func (m *SnapshotMutation) AddMemberPullRequestIDs(ids ...int) {
+	if m.member_pull_requests == nil {
+		m.member_pull_requests = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.member_pull_requests[ids[i]] = struct{}{}
 	}
-	return nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RepoMetaMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	return edges
+// ClearMemberPullRequests clears the "member_pull_requests" edge to the MemberPullRequest entity.
+func (m *SnapshotMutation) ClearMemberPullRequests() {
+	m.clearedmember_pull_requests = true
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *RepoMetaMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// MemberPullRequestsCleared reports if the "member_pull_requests" edge to the MemberPullRequest entity was cleared.
+func (m *SnapshotMutation) MemberPullRequestsCleared() bool {
+	return m.clearedmember_pull_requests
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RepoMetaMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedsnapshot {
-		edges = append(edges, repometa.EdgeSnapshot)
+// RemoveMemberPullRequestIDs removes the "member_pull_requests" edge to the MemberPullRequest entity by IDs.
+func (m *SnapshotMutation) RemoveMemberPullRequestIDs(ids ...int) {
+	if m.removedmember_pull_requests == nil {
+		m.removedmember_pull_requests = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.member_pull_requests, ids[i])
+		m.removedmember_pull_requests[ids[i]] = struct{}{}
 	}
-	return edges
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *RepoMetaMutation) EdgeCleared(name string) bool {
-	switch name {
-	case repometa.EdgeSnapshot:
-		return m.clearedsnapshot
+// RemovedMemberPullRequests returns the removed IDs of the "member_pull_requests" edge to the MemberPullRequest entity.
+func (m *SnapshotMutation) RemovedMemberPullRequestsIDs() (ids []int) {
+	for id := range m.removedmember_pull_requests {
+		ids = append(ids, id)
 	}
-	return false
+	return
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *RepoMetaMutation) ClearEdge(name string) error {
-	switch name {
-	case repometa.EdgeSnapshot:
-		m.ClearSnapshot()
-		return nil
+// MemberPullRequestsIDs returns the "member_pull_requests" edge IDs in the mutation.
+func (m *SnapshotMutation) MemberPullRequestsIDs() (ids []int) {
+	for id := range m.member_pull_requests {
+		ids = append(ids, id)
 	}
-	return fmt.Errorf("unknown RepoMeta unique edge %s", name)
+	return
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *RepoMetaMutation) ResetEdge(name string) error {
-	switch name {
-	case repometa.EdgeSnapshot:
-		m.ResetSnapshot()
-		return nil
-	}
-	return fmt.Errorf("unknown RepoMeta edge %s", name)
+// ResetMemberPullRequests resets all changes to the "member_pull_requests" edge.
+func (m *SnapshotMutation) ResetMemberPullRequests() {
+	m.member_pull_requests = nil
+	m.clearedmember_pull_requests = false
+	m.removedmember_pull_requests = nil
 }
 
-// SnapshotMutation represents an operation that mutates the Snapshot nodes in the graph.
-type SnapshotMutation struct {
-	config
-	op                           Op
-	typ                          string
-	id                           *int
-	captured_at                  *time.Time
-	clearedFields                map[string]struct{}
-	member_stats                 map[int]struct{}
-	removedmember_stats          map[int]struct{}
-	clearedmember_stats          bool
-	member_year_stats            map[int]struct{}
-	removedmember_year_stats     map[int]struct{}
-	clearedmember_year_stats     bool
-	member_day_stats             map[int]struct{}
-	removedmember_day_stats      map[int]struct{}
-	clearedmember_day_stats      bool
-	member_repo_stats            map[int]struct{}
-	removedmember_repo_stats     map[int]struct{}
-	clearedmember_repo_stats     bool
-	member_repo_day_stats        map[int]struct{}
-	removedmember_repo_day_stats map[int]struct{}
-	clearedmember_repo_day_stats bool
-	repo_metas                   map[int]struct{}
-	removedrepo_metas            map[int]struct{}
-	clearedrepo_metas            bool
-	done                         bool
-	oldValue                     func(context.Context) (*Snapshot, error)
-	predicates                   []predicate.Snapshot
+// AddReviewEdgeIDs adds the "review_edges" edge to the ReviewEdge entity by ids.
+func (m *SnapshotMutation) AddReviewEdgeIDs(ids ...int) {
+	if m.review_edges == nil {
+		m.review_edges = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.review_edges[ids[i]] = struct{}{}
+	}
 }
 
-var _ ent.Mutation = (*SnapshotMutation)(nil)
+// ClearReviewEdges clears the "review_edges" edge to the ReviewEdge entity.
+func (m *SnapshotMutation) ClearReviewEdges() {
+	m.clearedreview_edges = true
+}
 
-// snapshotOption allows management of the mutation configuration using functional options.
-type snapshotOption func(*SnapshotMutation)
+// ReviewEdgesCleared reports if the "review_edges" edge to the ReviewEdge entity was cleared.
+func (m *SnapshotMutation) ReviewEdgesCleared() bool {
+	return m.clearedreview_edges
+}
 
-// newSnapshotMutation creates new mutation for the Snapshot entity.
-func newSnapshotMutation(c config, op Op, opts ...snapshotOption) *SnapshotMutation {
-	m := &SnapshotMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeSnapshot,
-		clearedFields: make(map[string]struct{}),
+// RemoveReviewEdgeIDs removes the "review_edges" edge to the ReviewEdge entity by IDs.
+func (m *SnapshotMutation) RemoveReviewEdgeIDs(ids ...int) {
+	if m.removedreview_edges == nil {
+		m.removedreview_edges = make(map[int]struct{})
 	}
-	for _, opt := range opts {
-		opt(m)
+	for i := range ids {
+		delete(m.review_edges, ids[i])
+		m.removedreview_edges[ids[i]] = struct{}{}
 	}
-	return m
 }
 
-// withSnapshotID sets the ID field of the mutation.
-func withSnapshotID(id int) snapshotOption {
-	return func(m *SnapshotMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Snapshot
-		)
-		m.oldValue = func(ctx context.Context) (*Snapshot, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Snapshot.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// RemovedReviewEdges returns the removed IDs of the "review_edges" edge to the ReviewEdge entity.
+func (m *SnapshotMutation) RemovedReviewEdgesIDs() (ids []int) {
+	for id := range m.removedreview_edges {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// withSnapshot sets the old Snapshot of the mutation.
-func withSnapshot(node *Snapshot) snapshotOption {
-	return func(m *SnapshotMutation) {
-		m.oldValue = func(context.Context) (*Snapshot, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// ReviewEdgesIDs returns the "review_edges" edge IDs in the mutation.
+func (m *SnapshotMutation) ReviewEdgesIDs() (ids []int) {
+	for id := range m.review_edges {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SnapshotMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetReviewEdges resets all changes to the "review_edges" edge.
+func (m *SnapshotMutation) ResetReviewEdges() {
+	m.review_edges = nil
+	m.clearedreview_edges = false
+	m.removedreview_edges = nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m SnapshotMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// AddMemberDataGapIDs adds the "member_data_gaps" edge to the MemberDataGap entity by ids.
+func (m *SnapshotMutation) AddMemberDataGapIDs(ids ...int) {
+	if m.member_data_gaps == nil {
+		m.member_data_gaps = make(map[int]struct{})
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
-}
-
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *SnapshotMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
+	for i := range ids {
+		m.member_data_gaps[ids[i]] = struct{}{}
 	}
-	return *m.id, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *SnapshotMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Snapshot.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ClearMemberDataGaps clears the "member_data_gaps" edge to the MemberDataGap entity.
+func (m *SnapshotMutation) ClearMemberDataGaps() {
+	m.clearedmember_data_gaps = true
 }
 
-// SetCapturedAt sets the "captured_at" field.
-func (m *SnapshotMutation) SetCapturedAt(t time.Time) {
-	m.captured_at = &t
+// MemberDataGapsCleared reports if the "member_data_gaps" edge to the MemberDataGap entity was cleared.
+func (m *SnapshotMutation) MemberDataGapsCleared() bool {
+	return m.clearedmember_data_gaps
 }
 
-// CapturedAt returns the value of the "captured_at" field in the mutation.
-func (m *SnapshotMutation) CapturedAt() (r time.Time, exists bool) {
-	v := m.captured_at
-	if v == nil {
-		return
+// RemoveMemberDataGapIDs removes the "member_data_gaps" edge to the MemberDataGap entity by IDs.
+func (m *SnapshotMutation) RemoveMemberDataGapIDs(ids ...int) {
+	if m.removedmember_data_gaps == nil {
+		m.removedmember_data_gaps = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.member_data_gaps, ids[i])
+		m.removedmember_data_gaps[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldCapturedAt returns the old "captured_at" field's value of the Snapshot entity.
-// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SnapshotMutation) OldCapturedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCapturedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCapturedAt requires an ID field in the mutation")
+// RemovedMemberDataGaps returns the removed IDs of the "member_data_gaps" edge to the MemberDataGap entity.
+func (m *SnapshotMutation) RemovedMemberDataGapsIDs() (ids []int) {
+	for id := range m.removedmember_data_gaps {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCapturedAt: %w", err)
+	return
+}
+
+// MemberDataGapsIDs returns the "member_data_gaps" edge IDs in the mutation.
+func (m *SnapshotMutation) MemberDataGapsIDs() (ids []int) {
+	for id := range m.member_data_gaps {
+		ids = append(ids, id)
 	}
-	return oldValue.CapturedAt, nil
+	return
 }
 
-// ResetCapturedAt resets all changes to the "captured_at" field.
-func (m *SnapshotMutation) ResetCapturedAt() {
-	m.captured_at = nil
+// ResetMemberDataGaps resets all changes to the "member_data_gaps" edge.
+func (m *SnapshotMutation) ResetMemberDataGaps() {
+	m.member_data_gaps = nil
+	m.clearedmember_data_gaps = false
+	m.removedmember_data_gaps = nil
 }
 
-// AddMemberStatIDs adds the "member_stats" edge to the MemberStat entity by ids.
-func (m *SnapshotMutation) AddMemberStatIDs(ids ...int) {
-	if m.member_stats == nil {
-		m.member_stats = make(map[int]struct{})
+// AddExcludedMemberIDs adds the "excluded_members" edge to the ExcludedMember entity by ids.
+func (m *SnapshotMutation) AddExcludedMemberIDs(ids ...int) {
+	if m.excluded_members == nil {
+		m.excluded_members = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.member_stats[ids[i]] = struct{}{}
+		m.excluded_members[ids[i]] = struct{}{}
 	}
 }
 
-// ClearMemberStats clears the "member_stats" edge to the MemberStat entity.
-func (m *SnapshotMutation) ClearMemberStats() {
-	m.clearedmember_stats = true
+// ClearExcludedMembers clears the "excluded_members" edge to the ExcludedMember entity.
+func (m *SnapshotMutation) ClearExcludedMembers() {
+	m.clearedexcluded_members = true
 }
 
-// MemberStatsCleared reports if the "member_stats" edge to the MemberStat entity was cleared.
-func (m *SnapshotMutation) MemberStatsCleared() bool {
-	return m.clearedmember_stats
+// ExcludedMembersCleared reports if the "excluded_members" edge to the ExcludedMember entity was cleared.
+func (m *SnapshotMutation) ExcludedMembersCleared() bool {
+	return m.clearedexcluded_members
 }
 
-// RemoveMemberStatIDs removes the "member_stats" edge to the MemberStat entity by IDs.
-func (m *SnapshotMutation) RemoveMemberStatIDs(ids ...int) {
-	if m.removedmember_stats == nil {
-		m.removedmember_stats = make(map[int]struct{})
+// RemoveExcludedMemberIDs removes the "excluded_members" edge to the ExcludedMember entity by IDs.
+func (m *SnapshotMutation) RemoveExcludedMemberIDs(ids ...int) {
+	if m.removedexcluded_members == nil {
+		m.removedexcluded_members = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.member_stats, ids[i])
-		m.removedmember_stats[ids[i]] = struct{}{}
+		delete(m.excluded_members, ids[i])
+		m.removedexcluded_members[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedMemberStats returns the removed IDs of the "member_stats" edge to the MemberStat entity.
-func (m *SnapshotMutation) RemovedMemberStatsIDs() (ids []int) {
-	for id := range m.removedmember_stats {
+// RemovedExcludedMembers returns the removed IDs of the "excluded_members" edge to the ExcludedMember entity.
+func (m *SnapshotMutation) RemovedExcludedMembersIDs() (ids []int) {
+	for id := range m.removedexcluded_members {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// MemberStatsIDs returns the "member_stats" edge IDs in the mutation.
-func (m *SnapshotMutation) MemberStatsIDs() (ids []int) {
-	for id := range m.member_stats {
+// ExcludedMembersIDs returns the "excluded_members" edge IDs in the mutation.
+func (m *SnapshotMutation) ExcludedMembersIDs() (ids []int) {
+	for id := range m.excluded_members {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetMemberStats resets all changes to the "member_stats" edge.
-func (m *SnapshotMutation) ResetMemberStats() {
-	m.member_stats = nil
-	m.clearedmember_stats = false
-	m.removedmember_stats = nil
+// ResetExcludedMembers resets all changes to the "excluded_members" edge.
+func (m *SnapshotMutation) ResetExcludedMembers() {
+	m.excluded_members = nil
+	m.clearedexcluded_members = false
+	m.removedexcluded_members = nil
 }
 
-// AddMemberYearStatIDs adds the "member_year_stats" edge to the MemberYearStat entity by ids.
-func (m *SnapshotMutation) AddMemberYearStatIDs(ids ...int) {
-	if m.member_year_stats == nil {
-		m.member_year_stats = make(map[int]struct{})
+// AddMemberAccountIDs adds the "member_accounts" edge to the MemberAccount entity by ids.
+func (m *SnapshotMutation) AddMemberAccountIDs(ids ...int) {
+	if m.member_accounts == nil {
+		m.member_accounts = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.member_year_stats[ids[i]] = struct{}{}
+		m.member_accounts[ids[i]] = struct{}{}
 	}
 }
 
-// ClearMemberYearStats clears the "member_year_stats" edge to the MemberYearStat entity.
-func (m *SnapshotMutation) ClearMemberYearStats() {
-	m.clearedmember_year_stats = true
+// ClearMemberAccounts clears the "member_accounts" edge to the MemberAccount entity.
+func (m *SnapshotMutation) ClearMemberAccounts() {
+	m.clearedmember_accounts = true
 }
 
-// MemberYearStatsCleared reports if the "member_year_stats" edge to the MemberYearStat entity was cleared.
-func (m *SnapshotMutation) MemberYearStatsCleared() bool {
-	return m.clearedmember_year_stats
+// MemberAccountsCleared reports if the "member_accounts" edge to the MemberAccount entity was cleared.
+func (m *SnapshotMutation) MemberAccountsCleared() bool {
+	return m.clearedmember_accounts
 }
 
-// RemoveMemberYearStatIDs removes the "member_year_stats" edge to the MemberYearStat entity by IDs.
-func (m *SnapshotMutation) RemoveMemberYearStatIDs(ids ...int) {
-	if m.removedmember_year_stats == nil {
-		m.removedmember_year_stats = make(map[int]struct{})
+// RemoveMemberAccountIDs removes the "member_accounts" edge to the MemberAccount entity by IDs.
+func (m *SnapshotMutation) RemoveMemberAccountIDs(ids ...int) {
+	if m.removedmember_accounts == nil {
+		m.removedmember_accounts = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.member_year_stats, ids[i])
-		m.removedmember_year_stats[ids[i]] = struct{}{}
+		delete(m.member_accounts, ids[i])
+		m.removedmember_accounts[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedMemberYearStats returns the removed IDs of the "member_year_stats" edge to the MemberYearStat entity.
-func (m *SnapshotMutation) RemovedMemberYearStatsIDs() (ids []int) {
-	for id := range m.removedmember_year_stats {
+// RemovedMemberAccounts returns the removed IDs of the "member_accounts" edge to the MemberAccount entity.
+func (m *SnapshotMutation) RemovedMemberAccountsIDs() (ids []int) {
+	for id := range m.removedmember_accounts {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// MemberYearStatsIDs returns the "member_year_stats" edge IDs in the mutation.
-func (m *SnapshotMutation) MemberYearStatsIDs() (ids []int) {
-	for id := range m.member_year_stats {
+// MemberAccountsIDs returns the "member_accounts" edge IDs in the mutation.
+func (m *SnapshotMutation) MemberAccountsIDs() (ids []int) {
+	for id := range m.member_accounts {
 		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetMemberYearStats resets all changes to the "member_year_stats" edge.
-func (m *SnapshotMutation) ResetMemberYearStats() {
-	m.member_year_stats = nil
-	m.clearedmember_year_stats = false
-	m.removedmember_year_stats = nil
+	}
+	return
 }
 
-// AddMemberDayStatIDs adds the "member_day_stats" edge to the MemberDayStat entity by ids.
-func (m *SnapshotMutation) AddMemberDayStatIDs(ids ...int) {
-	if m.member_day_stats == nil {
-		m.member_day_stats = make(map[int]struct{})
+// ResetMemberAccounts resets all changes to the "member_accounts" edge.
+func (m *SnapshotMutation) ResetMemberAccounts() {
+	m.member_accounts = nil
+	m.clearedmember_accounts = false
+	m.removedmember_accounts = nil
+}
+
+// AddRepoDeliveryWeekIDs adds the "repo_delivery_weeks" edge to the RepoDeliveryWeek entity by ids.
+func (m *SnapshotMutation) AddRepoDeliveryWeekIDs(ids ...int) {
+	if m.repo_delivery_weeks == nil {
+		m.repo_delivery_weeks = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.member_day_stats[ids[i]] = struct{}{}
+		m.repo_delivery_weeks[ids[i]] = struct{}{}
 	}
 }
 
-// ClearMemberDayStats clears the "member_day_stats" edge to the MemberDayStat entity.
-func (m *SnapshotMutation) ClearMemberDayStats() {
-	m.clearedmember_day_stats = true
+// ClearRepoDeliveryWeeks clears the "repo_delivery_weeks" edge to the RepoDeliveryWeek entity.
+func (m *SnapshotMutation) ClearRepoDeliveryWeeks() {
+	m.clearedrepo_delivery_weeks = true
 }
 
-// MemberDayStatsCleared reports if the "member_day_stats" edge to the MemberDayStat entity was cleared.
-func (m *SnapshotMutation) MemberDayStatsCleared() bool {
-	return m.clearedmember_day_stats
+// RepoDeliveryWeeksCleared reports if the "repo_delivery_weeks" edge to the RepoDeliveryWeek entity was cleared.
+func (m *SnapshotMutation) RepoDeliveryWeeksCleared() bool {
+	return m.clearedrepo_delivery_weeks
 }
 
-// RemoveMemberDayStatIDs removes the "member_day_stats" edge to the MemberDayStat entity by IDs.
-func (m *SnapshotMutation) RemoveMemberDayStatIDs(ids ...int) {
-	if m.removedmember_day_stats == nil {
-		m.removedmember_day_stats = make(map[int]struct{})
+// RemoveRepoDeliveryWeekIDs removes the "repo_delivery_weeks" edge to the RepoDeliveryWeek entity by IDs.
+func (m *SnapshotMutation) RemoveRepoDeliveryWeekIDs(ids ...int) {
+	if m.removedrepo_delivery_weeks == nil {
+		m.removedrepo_delivery_weeks = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.member_day_stats, ids[i])
-		m.removedmember_day_stats[ids[i]] = struct{}{}
+		delete(m.repo_delivery_weeks, ids[i])
+		m.removedrepo_delivery_weeks[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedMemberDayStats returns the removed IDs of the "member_day_stats" edge to the MemberDayStat entity.
-func (m *SnapshotMutation) RemovedMemberDayStatsIDs() (ids []int) {
-	for id := range m.removedmember_day_stats {
+// RemovedRepoDeliveryWeeks returns the removed IDs of the "repo_delivery_weeks" edge to the RepoDeliveryWeek entity.
+func (m *SnapshotMutation) RemovedRepoDeliveryWeeksIDs() (ids []int) {
+	for id := range m.removedrepo_delivery_weeks {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// MemberDayStatsIDs returns the "member_day_stats" edge IDs in the mutation.
-func (m *SnapshotMutation) MemberDayStatsIDs() (ids []int) {
-	for id := range m.member_day_stats {
+// RepoDeliveryWeeksIDs returns the "repo_delivery_weeks" edge IDs in the mutation.
+func (m *SnapshotMutation) RepoDeliveryWeeksIDs() (ids []int) {
+	for id := range m.repo_delivery_weeks {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetMemberDayStats resets all changes to the "member_day_stats" edge.
-func (m *SnapshotMutation) ResetMemberDayStats() {
-	m.member_day_stats = nil
-	m.clearedmember_day_stats = false
-	m.removedmember_day_stats = nil
+// ResetRepoDeliveryWeeks resets all changes to the "repo_delivery_weeks" edge.
+func (m *SnapshotMutation) ResetRepoDeliveryWeeks() {
+	m.repo_delivery_weeks = nil
+	m.clearedrepo_delivery_weeks = false
+	m.removedrepo_delivery_weeks = nil
 }
 
-// AddMemberRepoStatIDs adds the "member_repo_stats" edge to the MemberRepoStat entity by ids.
-func (m *SnapshotMutation) AddMemberRepoStatIDs(ids ...int) {
-	if m.member_repo_stats == nil {
-		m.member_repo_stats = make(map[int]struct{})
+// AddMemberIssueIDs adds the "member_issues" edge to the MemberIssue entity by ids.
+func (m *SnapshotMutation) AddMemberIssueIDs(ids ...int) {
+	if m.member_issues == nil {
+		m.member_issues = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.member_repo_stats[ids[i]] = struct{}{}
+		m.member_issues[ids[i]] = struct{}{}
 	}
 }
 
-// ClearMemberRepoStats clears the "member_repo_stats" edge to the MemberRepoStat entity.
-func (m *SnapshotMutation) ClearMemberRepoStats() {
-	m.clearedmember_repo_stats = true
+// ClearMemberIssues clears the "member_issues" edge to the MemberIssue entity.
+func (m *SnapshotMutation) ClearMemberIssues() {
+	m.clearedmember_issues = true
 }
 
-// MemberRepoStatsCleared reports if the "member_repo_stats" edge to the MemberRepoStat entity was cleared.
-func (m *SnapshotMutation) MemberRepoStatsCleared() bool {
-	return m.clearedmember_repo_stats
+// MemberIssuesCleared reports if the "member_issues" edge to the MemberIssue entity was cleared.
+func (m *SnapshotMutation) MemberIssuesCleared() bool {
+	return m.clearedmember_issues
 }
 
-// RemoveMemberRepoStatIDs removes the "member_repo_stats" edge to the MemberRepoStat entity by IDs.
-func (m *SnapshotMutation) RemoveMemberRepoStatIDs(ids ...int) {
-	if m.removedmember_repo_stats == nil {
-		m.removedmember_repo_stats = make(map[int]struct{})
+// RemoveMemberIssueIDs removes the "member_issues" edge to the MemberIssue entity by IDs.
+func (m *SnapshotMutation) RemoveMemberIssueIDs(ids ...int) {
+	if m.removedmember_issues == nil {
+		m.removedmember_issues = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.member_repo_stats, ids[i])
-		m.removedmember_repo_stats[ids[i]] = struct{}{}
+		delete(m.member_issues, ids[i])
+		m.removedmember_issues[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedMemberRepoStats returns the removed IDs of the "member_repo_stats" edge to the MemberRepoStat entity.
-func (m *SnapshotMutation) RemovedMemberRepoStatsIDs() (ids []int) {
-	for id := range m.removedmember_repo_stats {
+// RemovedMemberIssues returns the removed IDs of the "member_issues" edge to the MemberIssue entity.
+func (m *SnapshotMutation) RemovedMemberIssuesIDs() (ids []int) {
+	for id := range m.removedmember_issues {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// MemberRepoStatsIDs returns the "member_repo_stats" edge IDs in the mutation.
-func (m *SnapshotMutation) MemberRepoStatsIDs() (ids []int) {
-	for id := range m.member_repo_stats {
+// MemberIssuesIDs returns the "member_issues" edge IDs in the mutation.
+func (m *SnapshotMutation) MemberIssuesIDs() (ids []int) {
+	for id := range m.member_issues {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetMemberRepoStats resets all changes to the "member_repo_stats" edge.
-func (m *SnapshotMutation) ResetMemberRepoStats() {
-	m.member_repo_stats = nil
-	m.clearedmember_repo_stats = false
-	m.removedmember_repo_stats = nil
+// ResetMemberIssues resets all changes to the "member_issues" edge.
+func (m *SnapshotMutation) ResetMemberIssues() {
+	m.member_issues = nil
+	m.clearedmember_issues = false
+	m.removedmember_issues = nil
 }
 
-// AddMemberRepoDayStatIDs adds the "member_repo_day_stats" edge to the MemberRepoDayStat entity by ids.
-func (m *SnapshotMutation) AddMemberRepoDayStatIDs(ids ...int) {
-	if m.member_repo_day_stats == nil {
-		m.member_repo_day_stats = make(map[int]struct{})
+// AddMemberReviewRequestIDs adds the "member_review_requests" edge to the MemberReviewRequest entity by ids.
+func (m *SnapshotMutation) AddMemberReviewRequestIDs(ids ...int) {
+	if m.member_review_requests == nil {
+		m.member_review_requests = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.member_repo_day_stats[ids[i]] = struct{}{}
+		m.member_review_requests[ids[i]] = struct{}{}
 	}
 }
 
-// ClearMemberRepoDayStats clears the "member_repo_day_stats" edge to the MemberRepoDayStat entity.
-func (m *SnapshotMutation) ClearMemberRepoDayStats() {
-	m.clearedmember_repo_day_stats = true
+// ClearMemberReviewRequests clears the "member_review_requests" edge to the MemberReviewRequest entity.
+func (m *SnapshotMutation) ClearMemberReviewRequests() {
+	m.clearedmember_review_requests = true
 }
 
-// MemberRepoDayStatsCleared reports if the "member_repo_day_stats" edge to the MemberRepoDayStat entity was cleared.
-func (m *SnapshotMutation) MemberRepoDayStatsCleared() bool {
-	return m.clearedmember_repo_day_stats
+// MemberReviewRequestsCleared reports if the "member_review_requests" edge to the MemberReviewRequest entity was cleared.
+func (m *SnapshotMutation) MemberReviewRequestsCleared() bool {
+	return m.clearedmember_review_requests
 }
 
-// RemoveMemberRepoDayStatIDs removes the "member_repo_day_stats" edge to the MemberRepoDayStat entity by IDs.
-func (m *SnapshotMutation) RemoveMemberRepoDayStatIDs(ids ...int) {
-	if m.removedmember_repo_day_stats == nil {
-		m.removedmember_repo_day_stats = make(map[int]struct{})
+// RemoveMemberReviewRequestIDs removes the "member_review_requests" edge to the MemberReviewRequest entity by IDs.
+func (m *SnapshotMutation) RemoveMemberReviewRequestIDs(ids ...int) {
+	if m.removedmember_review_requests == nil {
+		m.removedmember_review_requests = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.member_repo_day_stats, ids[i])
-		m.removedmember_repo_day_stats[ids[i]] = struct{}{}
+		delete(m.member_review_requests, ids[i])
+		m.removedmember_review_requests[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedMemberRepoDayStats returns the removed IDs of the "member_repo_day_stats" edge to the MemberRepoDayStat entity.
-func (m *SnapshotMutation) RemovedMemberRepoDayStatsIDs() (ids []int) {
-	for id := range m.removedmember_repo_day_stats {
+// RemovedMemberReviewRequests returns the removed IDs of the "member_review_requests" edge to the MemberReviewRequest entity.
+func (m *SnapshotMutation) RemovedMemberReviewRequestsIDs() (ids []int) {
+	for id := range m.removedmember_review_requests {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// MemberRepoDayStatsIDs returns the "member_repo_day_stats" edge IDs in the mutation.
-func (m *SnapshotMutation) MemberRepoDayStatsIDs() (ids []int) {
-	for id := range m.member_repo_day_stats {
+// MemberReviewRequestsIDs returns the "member_review_requests" edge IDs in the mutation.
+func (m *SnapshotMutation) MemberReviewRequestsIDs() (ids []int) {
+	for id := range m.member_review_requests {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetMemberRepoDayStats resets all changes to the "member_repo_day_stats" edge.
-func (m *SnapshotMutation) ResetMemberRepoDayStats() {
-	m.member_repo_day_stats = nil
-	m.clearedmember_repo_day_stats = false
-	m.removedmember_repo_day_stats = nil
+// ResetMemberReviewRequests resets all changes to the "member_review_requests" edge.
+func (m *SnapshotMutation) ResetMemberReviewRequests() {
+	m.member_review_requests = nil
+	m.clearedmember_review_requests = false
+	m.removedmember_review_requests = nil
 }
 
-// AddRepoMetaIDs adds the "repo_metas" edge to the RepoMeta entity by ids.
-func (m *SnapshotMutation) AddRepoMetaIDs(ids ...int) {
-	if m.repo_metas == nil {
-		m.repo_metas = make(map[int]struct{})
+// AddMemberHourStatIDs adds the "member_hour_stats" edge to the MemberHourStat entity by ids.
+func (m *SnapshotMutation) AddMemberHourStatIDs(ids ...int) {
+	if m.member_hour_stats == nil {
+		m.member_hour_stats = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.repo_metas[ids[i]] = struct{}{}
+		m.member_hour_stats[ids[i]] = struct{}{}
 	}
 }
 
-// ClearRepoMetas clears the "repo_metas" edge to the RepoMeta entity.
-func (m *SnapshotMutation) ClearRepoMetas() {
-	m.clearedrepo_metas = true
+// ClearMemberHourStats clears the "member_hour_stats" edge to the MemberHourStat entity.
+func (m *SnapshotMutation) ClearMemberHourStats() {
+	m.clearedmember_hour_stats = true
 }
 
-// RepoMetasCleared reports if the "repo_metas" edge to the RepoMeta entity was cleared.
-func (m *SnapshotMutation) RepoMetasCleared() bool {
-	return m.clearedrepo_metas
+// MemberHourStatsCleared reports if the "member_hour_stats" edge to the MemberHourStat entity was cleared.
+func (m *SnapshotMutation) MemberHourStatsCleared() bool {
+	return m.clearedmember_hour_stats
 }
 
-// RemoveRepoMetaIDs removes the "repo_metas" edge to the RepoMeta entity by IDs.
-func (m *SnapshotMutation) RemoveRepoMetaIDs(ids ...int) {
-	if m.removedrepo_metas == nil {
-		m.removedrepo_metas = make(map[int]struct{})
+// RemoveMemberHourStatIDs removes the "member_hour_stats" edge to the MemberHourStat entity by IDs.
+func (m *SnapshotMutation) RemoveMemberHourStatIDs(ids ...int) {
+	if m.removedmember_hour_stats == nil {
+		m.removedmember_hour_stats = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.repo_metas, ids[i])
-		m.removedrepo_metas[ids[i]] = struct{}{}
+		delete(m.member_hour_stats, ids[i])
+		m.removedmember_hour_stats[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedRepoMetas returns the removed IDs of the "repo_metas" edge to the RepoMeta entity.
-func (m *SnapshotMutation) RemovedRepoMetasIDs() (ids []int) {
-	for id := range m.removedrepo_metas {
+// RemovedMemberHourStats returns the removed IDs of the "member_hour_stats" edge to the MemberHourStat entity.
+func (m *SnapshotMutation) RemovedMemberHourStatsIDs() (ids []int) {
+	for id := range m.removedmember_hour_stats {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// RepoMetasIDs returns the "repo_metas" edge IDs in the mutation.
-func (m *SnapshotMutation) RepoMetasIDs() (ids []int) {
-	for id := range m.repo_metas {
+// MemberHourStatsIDs returns the "member_hour_stats" edge IDs in the mutation.
+func (m *SnapshotMutation) MemberHourStatsIDs() (ids []int) {
+	for id := range m.member_hour_stats {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetRepoMetas resets all changes to the "repo_metas" edge.
-func (m *SnapshotMutation) ResetRepoMetas() {
-	m.repo_metas = nil
-	m.clearedrepo_metas = false
-	m.removedrepo_metas = nil
+// ResetMemberHourStats resets all changes to the "member_hour_stats" edge.
+func (m *SnapshotMutation) ResetMemberHourStats() {
+	m.member_hour_stats = nil
+	m.clearedmember_hour_stats = false
+	m.removedmember_hour_stats = nil
 }
 
 // Where appends a list predicates to the SnapshotMutation builder.
@@ -6739,10 +20331,22 @@ func (m *SnapshotMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *SnapshotMutation) Fields() []string {
-	fields := make([]string, 0, 1)
+	fields := make([]string, 0, 5)
 	if m.captured_at != nil {
 		fields = append(fields, snapshot.FieldCapturedAt)
 	}
+	if m.tag != nil {
+		fields = append(fields, snapshot.FieldTag)
+	}
+	if m.period_since != nil {
+		fields = append(fields, snapshot.FieldPeriodSince)
+	}
+	if m.period_until != nil {
+		fields = append(fields, snapshot.FieldPeriodUntil)
+	}
+	if m.lookback_years != nil {
+		fields = append(fields, snapshot.FieldLookbackYears)
+	}
 	return fields
 }
 
@@ -6753,6 +20357,14 @@ func (m *SnapshotMutation) Field(name string) (ent.Value, bool) {
 	switch name {
 	case snapshot.FieldCapturedAt:
 		return m.CapturedAt()
+	case snapshot.FieldTag:
+		return m.Tag()
+	case snapshot.FieldPeriodSince:
+		return m.PeriodSince()
+	case snapshot.FieldPeriodUntil:
+		return m.PeriodUntil()
+	case snapshot.FieldLookbackYears:
+		return m.LookbackYears()
 	}
 	return nil, false
 }
@@ -6764,6 +20376,14 @@ func (m *SnapshotMutation) OldField(ctx context.Context, name string) (ent.Value
 	switch name {
 	case snapshot.FieldCapturedAt:
 		return m.OldCapturedAt(ctx)
+	case snapshot.FieldTag:
+		return m.OldTag(ctx)
+	case snapshot.FieldPeriodSince:
+		return m.OldPeriodSince(ctx)
+	case snapshot.FieldPeriodUntil:
+		return m.OldPeriodUntil(ctx)
+	case snapshot.FieldLookbackYears:
+		return m.OldLookbackYears(ctx)
 	}
 	return nil, fmt.Errorf("unknown Snapshot field %s", name)
 }
@@ -6780,6 +20400,34 @@ func (m *SnapshotMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetCapturedAt(v)
 		return nil
+	case snapshot.FieldTag:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTag(v)
+		return nil
+	case snapshot.FieldPeriodSince:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPeriodSince(v)
+		return nil
+	case snapshot.FieldPeriodUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPeriodUntil(v)
+		return nil
+	case snapshot.FieldLookbackYears:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLookbackYears(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Snapshot field %s", name)
 }
@@ -6787,13 +20435,21 @@ func (m *SnapshotMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *SnapshotMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addlookback_years != nil {
+		fields = append(fields, snapshot.FieldLookbackYears)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *SnapshotMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case snapshot.FieldLookbackYears:
+		return m.AddedLookbackYears()
+	}
 	return nil, false
 }
 
@@ -6802,6 +20458,13 @@ func (m *SnapshotMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *SnapshotMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case snapshot.FieldLookbackYears:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLookbackYears(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Snapshot numeric field %s", name)
 }
@@ -6809,7 +20472,17 @@ func (m *SnapshotMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *SnapshotMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(snapshot.FieldTag) {
+		fields = append(fields, snapshot.FieldTag)
+	}
+	if m.FieldCleared(snapshot.FieldPeriodSince) {
+		fields = append(fields, snapshot.FieldPeriodSince)
+	}
+	if m.FieldCleared(snapshot.FieldPeriodUntil) {
+		fields = append(fields, snapshot.FieldPeriodUntil)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -6822,6 +20495,17 @@ func (m *SnapshotMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *SnapshotMutation) ClearField(name string) error {
+	switch name {
+	case snapshot.FieldTag:
+		m.ClearTag()
+		return nil
+	case snapshot.FieldPeriodSince:
+		m.ClearPeriodSince()
+		return nil
+	case snapshot.FieldPeriodUntil:
+		m.ClearPeriodUntil()
+		return nil
+	}
 	return fmt.Errorf("unknown Snapshot nullable field %s", name)
 }
 
@@ -6832,13 +20516,25 @@ func (m *SnapshotMutation) ResetField(name string) error {
 	case snapshot.FieldCapturedAt:
 		m.ResetCapturedAt()
 		return nil
+	case snapshot.FieldTag:
+		m.ResetTag()
+		return nil
+	case snapshot.FieldPeriodSince:
+		m.ResetPeriodSince()
+		return nil
+	case snapshot.FieldPeriodUntil:
+		m.ResetPeriodUntil()
+		return nil
+	case snapshot.FieldLookbackYears:
+		m.ResetLookbackYears()
+		return nil
 	}
 	return fmt.Errorf("unknown Snapshot field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *SnapshotMutation) AddedEdges() []string {
-	edges := make([]string, 0, 6)
+	edges := make([]string, 0, 15)
 	if m.member_stats != nil {
 		edges = append(edges, snapshot.EdgeMemberStats)
 	}
@@ -6857,6 +20553,33 @@ func (m *SnapshotMutation) AddedEdges() []string {
 	if m.repo_metas != nil {
 		edges = append(edges, snapshot.EdgeRepoMetas)
 	}
+	if m.member_pull_requests != nil {
+		edges = append(edges, snapshot.EdgeMemberPullRequests)
+	}
+	if m.review_edges != nil {
+		edges = append(edges, snapshot.EdgeReviewEdges)
+	}
+	if m.member_data_gaps != nil {
+		edges = append(edges, snapshot.EdgeMemberDataGaps)
+	}
+	if m.excluded_members != nil {
+		edges = append(edges, snapshot.EdgeExcludedMembers)
+	}
+	if m.member_accounts != nil {
+		edges = append(edges, snapshot.EdgeMemberAccounts)
+	}
+	if m.repo_delivery_weeks != nil {
+		edges = append(edges, snapshot.EdgeRepoDeliveryWeeks)
+	}
+	if m.member_issues != nil {
+		edges = append(edges, snapshot.EdgeMemberIssues)
+	}
+	if m.member_review_requests != nil {
+		edges = append(edges, snapshot.EdgeMemberReviewRequests)
+	}
+	if m.member_hour_stats != nil {
+		edges = append(edges, snapshot.EdgeMemberHourStats)
+	}
 	return edges
 }
 
@@ -6900,13 +20623,67 @@ func (m *SnapshotMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case snapshot.EdgeMemberPullRequests:
+		ids := make([]ent.Value, 0, len(m.member_pull_requests))
+		for id := range m.member_pull_requests {
+			ids = append(ids, id)
+		}
+		return ids
+	case snapshot.EdgeReviewEdges:
+		ids := make([]ent.Value, 0, len(m.review_edges))
+		for id := range m.review_edges {
+			ids = append(ids, id)
+		}
+		return ids
+	case snapshot.EdgeMemberDataGaps:
+		ids := make([]ent.Value, 0, len(m.member_data_gaps))
+		for id := range m.member_data_gaps {
+			ids = append(ids, id)
+		}
+		return ids
+	case snapshot.EdgeExcludedMembers:
+		ids := make([]ent.Value, 0, len(m.excluded_members))
+		for id := range m.excluded_members {
+			ids = append(ids, id)
+		}
+		return ids
+	case snapshot.EdgeMemberAccounts:
+		ids := make([]ent.Value, 0, len(m.member_accounts))
+		for id := range m.member_accounts {
+			ids = append(ids, id)
+		}
+		return ids
+	case snapshot.EdgeRepoDeliveryWeeks:
+		ids := make([]ent.Value, 0, len(m.repo_delivery_weeks))
+		for id := range m.repo_delivery_weeks {
+			ids = append(ids, id)
+		}
+		return ids
+	case snapshot.EdgeMemberIssues:
+		ids := make([]ent.Value, 0, len(m.member_issues))
+		for id := range m.member_issues {
+			ids = append(ids, id)
+		}
+		return ids
+	case snapshot.EdgeMemberReviewRequests:
+		ids := make([]ent.Value, 0, len(m.member_review_requests))
+		for id := range m.member_review_requests {
+			ids = append(ids, id)
+		}
+		return ids
+	case snapshot.EdgeMemberHourStats:
+		ids := make([]ent.Value, 0, len(m.member_hour_stats))
+		for id := range m.member_hour_stats {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *SnapshotMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 6)
+	edges := make([]string, 0, 15)
 	if m.removedmember_stats != nil {
 		edges = append(edges, snapshot.EdgeMemberStats)
 	}
@@ -6925,6 +20702,33 @@ func (m *SnapshotMutation) RemovedEdges() []string {
 	if m.removedrepo_metas != nil {
 		edges = append(edges, snapshot.EdgeRepoMetas)
 	}
+	if m.removedmember_pull_requests != nil {
+		edges = append(edges, snapshot.EdgeMemberPullRequests)
+	}
+	if m.removedreview_edges != nil {
+		edges = append(edges, snapshot.EdgeReviewEdges)
+	}
+	if m.removedmember_data_gaps != nil {
+		edges = append(edges, snapshot.EdgeMemberDataGaps)
+	}
+	if m.removedexcluded_members != nil {
+		edges = append(edges, snapshot.EdgeExcludedMembers)
+	}
+	if m.removedmember_accounts != nil {
+		edges = append(edges, snapshot.EdgeMemberAccounts)
+	}
+	if m.removedrepo_delivery_weeks != nil {
+		edges = append(edges, snapshot.EdgeRepoDeliveryWeeks)
+	}
+	if m.removedmember_issues != nil {
+		edges = append(edges, snapshot.EdgeMemberIssues)
+	}
+	if m.removedmember_review_requests != nil {
+		edges = append(edges, snapshot.EdgeMemberReviewRequests)
+	}
+	if m.removedmember_hour_stats != nil {
+		edges = append(edges, snapshot.EdgeMemberHourStats)
+	}
 	return edges
 }
 
@@ -6968,13 +20772,67 @@ func (m *SnapshotMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case snapshot.EdgeMemberPullRequests:
+		ids := make([]ent.Value, 0, len(m.removedmember_pull_requests))
+		for id := range m.removedmember_pull_requests {
+			ids = append(ids, id)
+		}
+		return ids
+	case snapshot.EdgeReviewEdges:
+		ids := make([]ent.Value, 0, len(m.removedreview_edges))
+		for id := range m.removedreview_edges {
+			ids = append(ids, id)
+		}
+		return ids
+	case snapshot.EdgeMemberDataGaps:
+		ids := make([]ent.Value, 0, len(m.removedmember_data_gaps))
+		for id := range m.removedmember_data_gaps {
+			ids = append(ids, id)
+		}
+		return ids
+	case snapshot.EdgeExcludedMembers:
+		ids := make([]ent.Value, 0, len(m.removedexcluded_members))
+		for id := range m.removedexcluded_members {
+			ids = append(ids, id)
+		}
+		return ids
+	case snapshot.EdgeMemberAccounts:
+		ids := make([]ent.Value, 0, len(m.removedmember_accounts))
+		for id := range m.removedmember_accounts {
+			ids = append(ids, id)
+		}
+		return ids
+	case snapshot.EdgeRepoDeliveryWeeks:
+		ids := make([]ent.Value, 0, len(m.removedrepo_delivery_weeks))
+		for id := range m.removedrepo_delivery_weeks {
+			ids = append(ids, id)
+		}
+		return ids
+	case snapshot.EdgeMemberIssues:
+		ids := make([]ent.Value, 0, len(m.removedmember_issues))
+		for id := range m.removedmember_issues {
+			ids = append(ids, id)
+		}
+		return ids
+	case snapshot.EdgeMemberReviewRequests:
+		ids := make([]ent.Value, 0, len(m.removedmember_review_requests))
+		for id := range m.removedmember_review_requests {
+			ids = append(ids, id)
+		}
+		return ids
+	case snapshot.EdgeMemberHourStats:
+		ids := make([]ent.Value, 0, len(m.removedmember_hour_stats))
+		for id := range m.removedmember_hour_stats {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *SnapshotMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 6)
+	edges := make([]string, 0, 15)
 	if m.clearedmember_stats {
 		edges = append(edges, snapshot.EdgeMemberStats)
 	}
@@ -6993,6 +20851,33 @@ func (m *SnapshotMutation) ClearedEdges() []string {
 	if m.clearedrepo_metas {
 		edges = append(edges, snapshot.EdgeRepoMetas)
 	}
+	if m.clearedmember_pull_requests {
+		edges = append(edges, snapshot.EdgeMemberPullRequests)
+	}
+	if m.clearedreview_edges {
+		edges = append(edges, snapshot.EdgeReviewEdges)
+	}
+	if m.clearedmember_data_gaps {
+		edges = append(edges, snapshot.EdgeMemberDataGaps)
+	}
+	if m.clearedexcluded_members {
+		edges = append(edges, snapshot.EdgeExcludedMembers)
+	}
+	if m.clearedmember_accounts {
+		edges = append(edges, snapshot.EdgeMemberAccounts)
+	}
+	if m.clearedrepo_delivery_weeks {
+		edges = append(edges, snapshot.EdgeRepoDeliveryWeeks)
+	}
+	if m.clearedmember_issues {
+		edges = append(edges, snapshot.EdgeMemberIssues)
+	}
+	if m.clearedmember_review_requests {
+		edges = append(edges, snapshot.EdgeMemberReviewRequests)
+	}
+	if m.clearedmember_hour_stats {
+		edges = append(edges, snapshot.EdgeMemberHourStats)
+	}
 	return edges
 }
 
@@ -7012,6 +20897,24 @@ func (m *SnapshotMutation) EdgeCleared(name string) bool {
 		return m.clearedmember_repo_day_stats
 	case snapshot.EdgeRepoMetas:
 		return m.clearedrepo_metas
+	case snapshot.EdgeMemberPullRequests:
+		return m.clearedmember_pull_requests
+	case snapshot.EdgeReviewEdges:
+		return m.clearedreview_edges
+	case snapshot.EdgeMemberDataGaps:
+		return m.clearedmember_data_gaps
+	case snapshot.EdgeExcludedMembers:
+		return m.clearedexcluded_members
+	case snapshot.EdgeMemberAccounts:
+		return m.clearedmember_accounts
+	case snapshot.EdgeRepoDeliveryWeeks:
+		return m.clearedrepo_delivery_weeks
+	case snapshot.EdgeMemberIssues:
+		return m.clearedmember_issues
+	case snapshot.EdgeMemberReviewRequests:
+		return m.clearedmember_review_requests
+	case snapshot.EdgeMemberHourStats:
+		return m.clearedmember_hour_stats
 	}
 	return false
 }
@@ -7046,6 +20949,33 @@ func (m *SnapshotMutation) ResetEdge(name string) error {
 	case snapshot.EdgeRepoMetas:
 		m.ResetRepoMetas()
 		return nil
+	case snapshot.EdgeMemberPullRequests:
+		m.ResetMemberPullRequests()
+		return nil
+	case snapshot.EdgeReviewEdges:
+		m.ResetReviewEdges()
+		return nil
+	case snapshot.EdgeMemberDataGaps:
+		m.ResetMemberDataGaps()
+		return nil
+	case snapshot.EdgeExcludedMembers:
+		m.ResetExcludedMembers()
+		return nil
+	case snapshot.EdgeMemberAccounts:
+		m.ResetMemberAccounts()
+		return nil
+	case snapshot.EdgeRepoDeliveryWeeks:
+		m.ResetRepoDeliveryWeeks()
+		return nil
+	case snapshot.EdgeMemberIssues:
+		m.ResetMemberIssues()
+		return nil
+	case snapshot.EdgeMemberReviewRequests:
+		m.ResetMemberReviewRequests()
+		return nil
+	case snapshot.EdgeMemberHourStats:
+		m.ResetMemberHourStats()
+		return nil
 	}
 	return fmt.Errorf("unknown Snapshot edge %s", name)
 }
@@ -6,23 +6,53 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// ActivityEvent is the predicate function for activityevent builders.
+type ActivityEvent func(*sql.Selector)
+
+// ExcludedMember is the predicate function for excludedmember builders.
+type ExcludedMember func(*sql.Selector)
+
+// MemberAccount is the predicate function for memberaccount builders.
+type MemberAccount func(*sql.Selector)
+
+// MemberDataGap is the predicate function for memberdatagap builders.
+type MemberDataGap func(*sql.Selector)
+
 // MemberDayStat is the predicate function for memberdaystat builders.
 type MemberDayStat func(*sql.Selector)
 
+// MemberHourStat is the predicate function for memberhourstat builders.
+type MemberHourStat func(*sql.Selector)
+
+// MemberIssue is the predicate function for memberissue builders.
+type MemberIssue func(*sql.Selector)
+
+// MemberPullRequest is the predicate function for memberpullrequest builders.
+type MemberPullRequest func(*sql.Selector)
+
 // MemberRepoDayStat is the predicate function for memberrepodaystat builders.
 type MemberRepoDayStat func(*sql.Selector)
 
 // MemberRepoStat is the predicate function for memberrepostat builders.
 type MemberRepoStat func(*sql.Selector)
 
+// MemberReviewRequest is the predicate function for memberreviewrequest builders.
+type MemberReviewRequest func(*sql.Selector)
+
 // MemberStat is the predicate function for memberstat builders.
 type MemberStat func(*sql.Selector)
 
 // MemberYearStat is the predicate function for memberyearstat builders.
 type MemberYearStat func(*sql.Selector)
 
+// RepoDeliveryWeek is the predicate function for repodeliveryweek builders.
+type RepoDeliveryWeek func(*sql.Selector)
+
 // RepoMeta is the predicate function for repometa builders.
 type RepoMeta func(*sql.Selector)
 
+// ReviewEdge is the predicate function for reviewedge builders.
+type ReviewEdge func(*sql.Selector)
+
 // Snapshot is the predicate function for snapshot builders.
 type Snapshot func(*sql.Selector)
@@ -5,12 +5,22 @@ package ent
 import (
 	"time"
 
+	"github.com/Tattsum/github-analytics/infrastructure/ent/activityevent"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/excludedmember"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberaccount"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberdatagap"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/memberdaystat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberhourstat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberissue"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberpullrequest"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/memberrepodaystat"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/memberrepostat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberreviewrequest"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/memberstat"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/memberyearstat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/repodeliveryweek"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/repometa"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/reviewedge"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/schema"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/snapshot"
 )
@@ -19,6 +29,114 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	activityeventFields := schema.ActivityEvent{}.Fields()
+	_ = activityeventFields
+	// activityeventDescNaturalKey is the schema descriptor for natural_key field.
+	activityeventDescNaturalKey := activityeventFields[0].Descriptor()
+	// activityevent.NaturalKeyValidator is a validator for the "natural_key" field. It is called by the builders before save.
+	activityevent.NaturalKeyValidator = activityeventDescNaturalKey.Validators[0].(func(string) error)
+	// activityeventDescLogin is the schema descriptor for login field.
+	activityeventDescLogin := activityeventFields[1].Descriptor()
+	// activityevent.LoginValidator is a validator for the "login" field. It is called by the builders before save.
+	activityevent.LoginValidator = activityeventDescLogin.Validators[0].(func(string) error)
+	// activityeventDescActivityType is the schema descriptor for activity_type field.
+	activityeventDescActivityType := activityeventFields[2].Descriptor()
+	// activityevent.ActivityTypeValidator is a validator for the "activity_type" field. It is called by the builders before save.
+	activityevent.ActivityTypeValidator = activityeventDescActivityType.Validators[0].(func(string) error)
+	// activityeventDescSourceID is the schema descriptor for source_id field.
+	activityeventDescSourceID := activityeventFields[3].Descriptor()
+	// activityevent.DefaultSourceID holds the default value on creation for the source_id field.
+	activityevent.DefaultSourceID = activityeventDescSourceID.Default.(string)
+	// activityeventDescNameWithOwner is the schema descriptor for name_with_owner field.
+	activityeventDescNameWithOwner := activityeventFields[4].Descriptor()
+	// activityevent.NameWithOwnerValidator is a validator for the "name_with_owner" field. It is called by the builders before save.
+	activityevent.NameWithOwnerValidator = activityeventDescNameWithOwner.Validators[0].(func(string) error)
+	// activityeventDescOwner is the schema descriptor for owner field.
+	activityeventDescOwner := activityeventFields[5].Descriptor()
+	// activityevent.DefaultOwner holds the default value on creation for the owner field.
+	activityevent.DefaultOwner = activityeventDescOwner.Default.(string)
+	// activityeventDescOwnerType is the schema descriptor for owner_type field.
+	activityeventDescOwnerType := activityeventFields[6].Descriptor()
+	// activityevent.DefaultOwnerType holds the default value on creation for the owner_type field.
+	activityevent.DefaultOwnerType = activityeventDescOwnerType.Default.(string)
+	// activityeventDescAdditions is the schema descriptor for additions field.
+	activityeventDescAdditions := activityeventFields[8].Descriptor()
+	// activityevent.DefaultAdditions holds the default value on creation for the additions field.
+	activityevent.DefaultAdditions = activityeventDescAdditions.Default.(int)
+	// activityeventDescDeletions is the schema descriptor for deletions field.
+	activityeventDescDeletions := activityeventFields[9].Descriptor()
+	// activityevent.DefaultDeletions holds the default value on creation for the deletions field.
+	activityevent.DefaultDeletions = activityeventDescDeletions.Default.(int)
+	// activityeventDescIsMerged is the schema descriptor for is_merged field.
+	activityeventDescIsMerged := activityeventFields[10].Descriptor()
+	// activityevent.DefaultIsMerged holds the default value on creation for the is_merged field.
+	activityevent.DefaultIsMerged = activityeventDescIsMerged.Default.(bool)
+	// activityeventDescPullRequestAuthor is the schema descriptor for pull_request_author field.
+	activityeventDescPullRequestAuthor := activityeventFields[11].Descriptor()
+	// activityevent.DefaultPullRequestAuthor holds the default value on creation for the pull_request_author field.
+	activityevent.DefaultPullRequestAuthor = activityeventDescPullRequestAuthor.Default.(string)
+	// activityeventDescPullRequestAuthorType is the schema descriptor for pull_request_author_type field.
+	activityeventDescPullRequestAuthorType := activityeventFields[12].Descriptor()
+	// activityevent.DefaultPullRequestAuthorType holds the default value on creation for the pull_request_author_type field.
+	activityevent.DefaultPullRequestAuthorType = activityeventDescPullRequestAuthorType.Default.(string)
+	// activityeventDescIssueKind is the schema descriptor for issue_kind field.
+	activityeventDescIssueKind := activityeventFields[13].Descriptor()
+	// activityevent.DefaultIssueKind holds the default value on creation for the issue_kind field.
+	activityevent.DefaultIssueKind = activityeventDescIssueKind.Default.(string)
+	// activityeventDescWorkCategory is the schema descriptor for work_category field.
+	activityeventDescWorkCategory := activityeventFields[15].Descriptor()
+	// activityevent.DefaultWorkCategory holds the default value on creation for the work_category field.
+	activityevent.DefaultWorkCategory = activityeventDescWorkCategory.Default.(string)
+	// activityeventDescDayOnly is the schema descriptor for day_only field.
+	activityeventDescDayOnly := activityeventFields[16].Descriptor()
+	// activityevent.DefaultDayOnly holds the default value on creation for the day_only field.
+	activityevent.DefaultDayOnly = activityeventDescDayOnly.Default.(bool)
+	// activityeventDescRecordedAt is the schema descriptor for recorded_at field.
+	activityeventDescRecordedAt := activityeventFields[17].Descriptor()
+	// activityevent.DefaultRecordedAt holds the default value on creation for the recorded_at field.
+	activityevent.DefaultRecordedAt = activityeventDescRecordedAt.Default.(func() time.Time)
+	excludedmemberFields := schema.ExcludedMember{}.Fields()
+	_ = excludedmemberFields
+	// excludedmemberDescLogin is the schema descriptor for login field.
+	excludedmemberDescLogin := excludedmemberFields[0].Descriptor()
+	// excludedmember.LoginValidator is a validator for the "login" field. It is called by the builders before save.
+	excludedmember.LoginValidator = excludedmemberDescLogin.Validators[0].(func(string) error)
+	// excludedmemberDescRule is the schema descriptor for rule field.
+	excludedmemberDescRule := excludedmemberFields[1].Descriptor()
+	// excludedmember.DefaultRule holds the default value on creation for the rule field.
+	excludedmember.DefaultRule = excludedmemberDescRule.Default.(string)
+	memberaccountFields := schema.MemberAccount{}.Fields()
+	_ = memberaccountFields
+	// memberaccountDescLogin is the schema descriptor for login field.
+	memberaccountDescLogin := memberaccountFields[0].Descriptor()
+	// memberaccount.LoginValidator is a validator for the "login" field. It is called by the builders before save.
+	memberaccount.LoginValidator = memberaccountDescLogin.Validators[0].(func(string) error)
+	// memberaccountDescAccount is the schema descriptor for account field.
+	memberaccountDescAccount := memberaccountFields[1].Descriptor()
+	// memberaccount.AccountValidator is a validator for the "account" field. It is called by the builders before save.
+	memberaccount.AccountValidator = memberaccountDescAccount.Validators[0].(func(string) error)
+	memberdatagapFields := schema.MemberDataGap{}.Fields()
+	_ = memberdatagapFields
+	// memberdatagapDescLogin is the schema descriptor for login field.
+	memberdatagapDescLogin := memberdatagapFields[0].Descriptor()
+	// memberdatagap.LoginValidator is a validator for the "login" field. It is called by the builders before save.
+	memberdatagap.LoginValidator = memberdatagapDescLogin.Validators[0].(func(string) error)
+	// memberdatagapDescActivityType is the schema descriptor for activity_type field.
+	memberdatagapDescActivityType := memberdatagapFields[1].Descriptor()
+	// memberdatagap.ActivityTypeValidator is a validator for the "activity_type" field. It is called by the builders before save.
+	memberdatagap.ActivityTypeValidator = memberdatagapDescActivityType.Validators[0].(func(string) error)
+	// memberdatagapDescNameWithOwner is the schema descriptor for name_with_owner field.
+	memberdatagapDescNameWithOwner := memberdatagapFields[2].Descriptor()
+	// memberdatagap.DefaultNameWithOwner holds the default value on creation for the name_with_owner field.
+	memberdatagap.DefaultNameWithOwner = memberdatagapDescNameWithOwner.Default.(string)
+	// memberdatagapDescReason is the schema descriptor for reason field.
+	memberdatagapDescReason := memberdatagapFields[3].Descriptor()
+	// memberdatagap.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	memberdatagap.ReasonValidator = memberdatagapDescReason.Validators[0].(func(string) error)
+	// memberdatagapDescMessage is the schema descriptor for message field.
+	memberdatagapDescMessage := memberdatagapFields[4].Descriptor()
+	// memberdatagap.DefaultMessage holds the default value on creation for the message field.
+	memberdatagap.DefaultMessage = memberdatagapDescMessage.Default.(string)
 	memberdaystatFields := schema.MemberDayStat{}.Fields()
 	_ = memberdaystatFields
 	// memberdaystatDescLogin is the schema descriptor for login field.
@@ -57,6 +175,144 @@ func init() {
 	memberdaystatDescDeletions := memberdaystatFields[8].Descriptor()
 	// memberdaystat.DefaultDeletions holds the default value on creation for the deletions field.
 	memberdaystat.DefaultDeletions = memberdaystatDescDeletions.Default.(int)
+	// memberdaystatDescExcludedReviewCount is the schema descriptor for excluded_review_count field.
+	memberdaystatDescExcludedReviewCount := memberdaystatFields[9].Descriptor()
+	// memberdaystat.DefaultExcludedReviewCount holds the default value on creation for the excluded_review_count field.
+	memberdaystat.DefaultExcludedReviewCount = memberdaystatDescExcludedReviewCount.Default.(int)
+	// memberdaystatDescIssuesClosed is the schema descriptor for issues_closed field.
+	memberdaystatDescIssuesClosed := memberdaystatFields[10].Descriptor()
+	// memberdaystat.DefaultIssuesClosed holds the default value on creation for the issues_closed field.
+	memberdaystat.DefaultIssuesClosed = memberdaystatDescIssuesClosed.Default.(int)
+	// memberdaystatDescIssueCloseSeconds is the schema descriptor for issue_close_seconds field.
+	memberdaystatDescIssueCloseSeconds := memberdaystatFields[11].Descriptor()
+	// memberdaystat.DefaultIssueCloseSeconds holds the default value on creation for the issue_close_seconds field.
+	memberdaystat.DefaultIssueCloseSeconds = memberdaystatDescIssueCloseSeconds.Default.(int)
+	// memberdaystatDescBugIssuesOpened is the schema descriptor for bug_issues_opened field.
+	memberdaystatDescBugIssuesOpened := memberdaystatFields[12].Descriptor()
+	// memberdaystat.DefaultBugIssuesOpened holds the default value on creation for the bug_issues_opened field.
+	memberdaystat.DefaultBugIssuesOpened = memberdaystatDescBugIssuesOpened.Default.(int)
+	// memberdaystatDescFeatureIssuesOpened is the schema descriptor for feature_issues_opened field.
+	memberdaystatDescFeatureIssuesOpened := memberdaystatFields[13].Descriptor()
+	// memberdaystat.DefaultFeatureIssuesOpened holds the default value on creation for the feature_issues_opened field.
+	memberdaystat.DefaultFeatureIssuesOpened = memberdaystatDescFeatureIssuesOpened.Default.(int)
+	// memberdaystatDescBugIssuesClosed is the schema descriptor for bug_issues_closed field.
+	memberdaystatDescBugIssuesClosed := memberdaystatFields[14].Descriptor()
+	// memberdaystat.DefaultBugIssuesClosed holds the default value on creation for the bug_issues_closed field.
+	memberdaystat.DefaultBugIssuesClosed = memberdaystatDescBugIssuesClosed.Default.(int)
+	// memberdaystatDescFeatureIssuesClosed is the schema descriptor for feature_issues_closed field.
+	memberdaystatDescFeatureIssuesClosed := memberdaystatFields[15].Descriptor()
+	// memberdaystat.DefaultFeatureIssuesClosed holds the default value on creation for the feature_issues_closed field.
+	memberdaystat.DefaultFeatureIssuesClosed = memberdaystatDescFeatureIssuesClosed.Default.(int)
+	// memberdaystatDescFeaturePrs is the schema descriptor for feature_prs field.
+	memberdaystatDescFeaturePrs := memberdaystatFields[16].Descriptor()
+	// memberdaystat.DefaultFeaturePrs holds the default value on creation for the feature_prs field.
+	memberdaystat.DefaultFeaturePrs = memberdaystatDescFeaturePrs.Default.(int)
+	// memberdaystatDescBugPrs is the schema descriptor for bug_prs field.
+	memberdaystatDescBugPrs := memberdaystatFields[17].Descriptor()
+	// memberdaystat.DefaultBugPrs holds the default value on creation for the bug_prs field.
+	memberdaystat.DefaultBugPrs = memberdaystatDescBugPrs.Default.(int)
+	// memberdaystatDescChorePrs is the schema descriptor for chore_prs field.
+	memberdaystatDescChorePrs := memberdaystatFields[18].Descriptor()
+	// memberdaystat.DefaultChorePrs holds the default value on creation for the chore_prs field.
+	memberdaystat.DefaultChorePrs = memberdaystatDescChorePrs.Default.(int)
+	// memberdaystatDescDocsPrs is the schema descriptor for docs_prs field.
+	memberdaystatDescDocsPrs := memberdaystatFields[19].Descriptor()
+	// memberdaystat.DefaultDocsPrs holds the default value on creation for the docs_prs field.
+	memberdaystat.DefaultDocsPrs = memberdaystatDescDocsPrs.Default.(int)
+	// memberdaystatDescTestPrs is the schema descriptor for test_prs field.
+	memberdaystatDescTestPrs := memberdaystatFields[20].Descriptor()
+	// memberdaystat.DefaultTestPrs holds the default value on creation for the test_prs field.
+	memberdaystat.DefaultTestPrs = memberdaystatDescTestPrs.Default.(int)
+	// memberdaystatDescInfraPrs is the schema descriptor for infra_prs field.
+	memberdaystatDescInfraPrs := memberdaystatFields[21].Descriptor()
+	// memberdaystat.DefaultInfraPrs holds the default value on creation for the infra_prs field.
+	memberdaystat.DefaultInfraPrs = memberdaystatDescInfraPrs.Default.(int)
+	// memberdaystatDescOtherPrs is the schema descriptor for other_prs field.
+	memberdaystatDescOtherPrs := memberdaystatFields[22].Descriptor()
+	// memberdaystat.DefaultOtherPrs holds the default value on creation for the other_prs field.
+	memberdaystat.DefaultOtherPrs = memberdaystatDescOtherPrs.Default.(int)
+	memberhourstatFields := schema.MemberHourStat{}.Fields()
+	_ = memberhourstatFields
+	// memberhourstatDescLogin is the schema descriptor for login field.
+	memberhourstatDescLogin := memberhourstatFields[0].Descriptor()
+	// memberhourstat.LoginValidator is a validator for the "login" field. It is called by the builders before save.
+	memberhourstat.LoginValidator = memberhourstatDescLogin.Validators[0].(func(string) error)
+	// memberhourstatDescDay is the schema descriptor for day field.
+	memberhourstatDescDay := memberhourstatFields[2].Descriptor()
+	// memberhourstat.DayValidator is a validator for the "day" field. It is called by the builders before save.
+	memberhourstat.DayValidator = memberhourstatDescDay.Validators[0].(func(string) error)
+	// memberhourstatDescWeekday is the schema descriptor for weekday field.
+	memberhourstatDescWeekday := memberhourstatFields[3].Descriptor()
+	// memberhourstat.DefaultWeekday holds the default value on creation for the weekday field.
+	memberhourstat.DefaultWeekday = memberhourstatDescWeekday.Default.(int)
+	// memberhourstatDescHour is the schema descriptor for hour field.
+	memberhourstatDescHour := memberhourstatFields[4].Descriptor()
+	// memberhourstat.DefaultHour holds the default value on creation for the hour field.
+	memberhourstat.DefaultHour = memberhourstatDescHour.Default.(int)
+	// memberhourstatDescActivityCount is the schema descriptor for activity_count field.
+	memberhourstatDescActivityCount := memberhourstatFields[5].Descriptor()
+	// memberhourstat.DefaultActivityCount holds the default value on creation for the activity_count field.
+	memberhourstat.DefaultActivityCount = memberhourstatDescActivityCount.Default.(int)
+	memberissueFields := schema.MemberIssue{}.Fields()
+	_ = memberissueFields
+	// memberissueDescLogin is the schema descriptor for login field.
+	memberissueDescLogin := memberissueFields[0].Descriptor()
+	// memberissue.LoginValidator is a validator for the "login" field. It is called by the builders before save.
+	memberissue.LoginValidator = memberissueDescLogin.Validators[0].(func(string) error)
+	// memberissueDescNameWithOwner is the schema descriptor for name_with_owner field.
+	memberissueDescNameWithOwner := memberissueFields[1].Descriptor()
+	// memberissue.NameWithOwnerValidator is a validator for the "name_with_owner" field. It is called by the builders before save.
+	memberissue.NameWithOwnerValidator = memberissueDescNameWithOwner.Validators[0].(func(string) error)
+	// memberissueDescSourceID is the schema descriptor for source_id field.
+	memberissueDescSourceID := memberissueFields[2].Descriptor()
+	// memberissue.SourceIDValidator is a validator for the "source_id" field. It is called by the builders before save.
+	memberissue.SourceIDValidator = memberissueDescSourceID.Validators[0].(func(string) error)
+	// memberissueDescAuthor is the schema descriptor for author field.
+	memberissueDescAuthor := memberissueFields[3].Descriptor()
+	// memberissue.DefaultAuthor holds the default value on creation for the author field.
+	memberissue.DefaultAuthor = memberissueDescAuthor.Default.(string)
+	// memberissueDescClosedBy is the schema descriptor for closed_by field.
+	memberissueDescClosedBy := memberissueFields[6].Descriptor()
+	// memberissue.DefaultClosedBy holds the default value on creation for the closed_by field.
+	memberissue.DefaultClosedBy = memberissueDescClosedBy.Default.(string)
+	// memberissueDescStateReason is the schema descriptor for state_reason field.
+	memberissueDescStateReason := memberissueFields[7].Descriptor()
+	// memberissue.DefaultStateReason holds the default value on creation for the state_reason field.
+	memberissue.DefaultStateReason = memberissueDescStateReason.Default.(string)
+	// memberissueDescKind is the schema descriptor for kind field.
+	memberissueDescKind := memberissueFields[8].Descriptor()
+	// memberissue.DefaultKind holds the default value on creation for the kind field.
+	memberissue.DefaultKind = memberissueDescKind.Default.(string)
+	memberpullrequestFields := schema.MemberPullRequest{}.Fields()
+	_ = memberpullrequestFields
+	// memberpullrequestDescLogin is the schema descriptor for login field.
+	memberpullrequestDescLogin := memberpullrequestFields[0].Descriptor()
+	// memberpullrequest.LoginValidator is a validator for the "login" field. It is called by the builders before save.
+	memberpullrequest.LoginValidator = memberpullrequestDescLogin.Validators[0].(func(string) error)
+	// memberpullrequestDescNameWithOwner is the schema descriptor for name_with_owner field.
+	memberpullrequestDescNameWithOwner := memberpullrequestFields[1].Descriptor()
+	// memberpullrequest.NameWithOwnerValidator is a validator for the "name_with_owner" field. It is called by the builders before save.
+	memberpullrequest.NameWithOwnerValidator = memberpullrequestDescNameWithOwner.Validators[0].(func(string) error)
+	// memberpullrequestDescSourceID is the schema descriptor for source_id field.
+	memberpullrequestDescSourceID := memberpullrequestFields[2].Descriptor()
+	// memberpullrequest.DefaultSourceID holds the default value on creation for the source_id field.
+	memberpullrequest.DefaultSourceID = memberpullrequestDescSourceID.Default.(string)
+	// memberpullrequestDescReviewRounds is the schema descriptor for review_rounds field.
+	memberpullrequestDescReviewRounds := memberpullrequestFields[8].Descriptor()
+	// memberpullrequest.DefaultReviewRounds holds the default value on creation for the review_rounds field.
+	memberpullrequest.DefaultReviewRounds = memberpullrequestDescReviewRounds.Default.(int)
+	// memberpullrequestDescAdditions is the schema descriptor for additions field.
+	memberpullrequestDescAdditions := memberpullrequestFields[9].Descriptor()
+	// memberpullrequest.DefaultAdditions holds the default value on creation for the additions field.
+	memberpullrequest.DefaultAdditions = memberpullrequestDescAdditions.Default.(int)
+	// memberpullrequestDescDeletions is the schema descriptor for deletions field.
+	memberpullrequestDescDeletions := memberpullrequestFields[10].Descriptor()
+	// memberpullrequest.DefaultDeletions holds the default value on creation for the deletions field.
+	memberpullrequest.DefaultDeletions = memberpullrequestDescDeletions.Default.(int)
+	// memberpullrequestDescChangedFiles is the schema descriptor for changed_files field.
+	memberpullrequestDescChangedFiles := memberpullrequestFields[11].Descriptor()
+	// memberpullrequest.DefaultChangedFiles holds the default value on creation for the changed_files field.
+	memberpullrequest.DefaultChangedFiles = memberpullrequestDescChangedFiles.Default.(int)
 	memberrepodaystatFields := schema.MemberRepoDayStat{}.Fields()
 	_ = memberrepodaystatFields
 	// memberrepodaystatDescLogin is the schema descriptor for login field.
@@ -99,6 +355,58 @@ func init() {
 	memberrepodaystatDescDeletions := memberrepodaystatFields[9].Descriptor()
 	// memberrepodaystat.DefaultDeletions holds the default value on creation for the deletions field.
 	memberrepodaystat.DefaultDeletions = memberrepodaystatDescDeletions.Default.(int)
+	// memberrepodaystatDescIssuesClosed is the schema descriptor for issues_closed field.
+	memberrepodaystatDescIssuesClosed := memberrepodaystatFields[10].Descriptor()
+	// memberrepodaystat.DefaultIssuesClosed holds the default value on creation for the issues_closed field.
+	memberrepodaystat.DefaultIssuesClosed = memberrepodaystatDescIssuesClosed.Default.(int)
+	// memberrepodaystatDescIssueCloseSeconds is the schema descriptor for issue_close_seconds field.
+	memberrepodaystatDescIssueCloseSeconds := memberrepodaystatFields[11].Descriptor()
+	// memberrepodaystat.DefaultIssueCloseSeconds holds the default value on creation for the issue_close_seconds field.
+	memberrepodaystat.DefaultIssueCloseSeconds = memberrepodaystatDescIssueCloseSeconds.Default.(int)
+	// memberrepodaystatDescBugIssuesOpened is the schema descriptor for bug_issues_opened field.
+	memberrepodaystatDescBugIssuesOpened := memberrepodaystatFields[12].Descriptor()
+	// memberrepodaystat.DefaultBugIssuesOpened holds the default value on creation for the bug_issues_opened field.
+	memberrepodaystat.DefaultBugIssuesOpened = memberrepodaystatDescBugIssuesOpened.Default.(int)
+	// memberrepodaystatDescFeatureIssuesOpened is the schema descriptor for feature_issues_opened field.
+	memberrepodaystatDescFeatureIssuesOpened := memberrepodaystatFields[13].Descriptor()
+	// memberrepodaystat.DefaultFeatureIssuesOpened holds the default value on creation for the feature_issues_opened field.
+	memberrepodaystat.DefaultFeatureIssuesOpened = memberrepodaystatDescFeatureIssuesOpened.Default.(int)
+	// memberrepodaystatDescBugIssuesClosed is the schema descriptor for bug_issues_closed field.
+	memberrepodaystatDescBugIssuesClosed := memberrepodaystatFields[14].Descriptor()
+	// memberrepodaystat.DefaultBugIssuesClosed holds the default value on creation for the bug_issues_closed field.
+	memberrepodaystat.DefaultBugIssuesClosed = memberrepodaystatDescBugIssuesClosed.Default.(int)
+	// memberrepodaystatDescFeatureIssuesClosed is the schema descriptor for feature_issues_closed field.
+	memberrepodaystatDescFeatureIssuesClosed := memberrepodaystatFields[15].Descriptor()
+	// memberrepodaystat.DefaultFeatureIssuesClosed holds the default value on creation for the feature_issues_closed field.
+	memberrepodaystat.DefaultFeatureIssuesClosed = memberrepodaystatDescFeatureIssuesClosed.Default.(int)
+	// memberrepodaystatDescFeaturePrs is the schema descriptor for feature_prs field.
+	memberrepodaystatDescFeaturePrs := memberrepodaystatFields[16].Descriptor()
+	// memberrepodaystat.DefaultFeaturePrs holds the default value on creation for the feature_prs field.
+	memberrepodaystat.DefaultFeaturePrs = memberrepodaystatDescFeaturePrs.Default.(int)
+	// memberrepodaystatDescBugPrs is the schema descriptor for bug_prs field.
+	memberrepodaystatDescBugPrs := memberrepodaystatFields[17].Descriptor()
+	// memberrepodaystat.DefaultBugPrs holds the default value on creation for the bug_prs field.
+	memberrepodaystat.DefaultBugPrs = memberrepodaystatDescBugPrs.Default.(int)
+	// memberrepodaystatDescChorePrs is the schema descriptor for chore_prs field.
+	memberrepodaystatDescChorePrs := memberrepodaystatFields[18].Descriptor()
+	// memberrepodaystat.DefaultChorePrs holds the default value on creation for the chore_prs field.
+	memberrepodaystat.DefaultChorePrs = memberrepodaystatDescChorePrs.Default.(int)
+	// memberrepodaystatDescDocsPrs is the schema descriptor for docs_prs field.
+	memberrepodaystatDescDocsPrs := memberrepodaystatFields[19].Descriptor()
+	// memberrepodaystat.DefaultDocsPrs holds the default value on creation for the docs_prs field.
+	memberrepodaystat.DefaultDocsPrs = memberrepodaystatDescDocsPrs.Default.(int)
+	// memberrepodaystatDescTestPrs is the schema descriptor for test_prs field.
+	memberrepodaystatDescTestPrs := memberrepodaystatFields[20].Descriptor()
+	// memberrepodaystat.DefaultTestPrs holds the default value on creation for the test_prs field.
+	memberrepodaystat.DefaultTestPrs = memberrepodaystatDescTestPrs.Default.(int)
+	// memberrepodaystatDescInfraPrs is the schema descriptor for infra_prs field.
+	memberrepodaystatDescInfraPrs := memberrepodaystatFields[21].Descriptor()
+	// memberrepodaystat.DefaultInfraPrs holds the default value on creation for the infra_prs field.
+	memberrepodaystat.DefaultInfraPrs = memberrepodaystatDescInfraPrs.Default.(int)
+	// memberrepodaystatDescOtherPrs is the schema descriptor for other_prs field.
+	memberrepodaystatDescOtherPrs := memberrepodaystatFields[22].Descriptor()
+	// memberrepodaystat.DefaultOtherPrs holds the default value on creation for the other_prs field.
+	memberrepodaystat.DefaultOtherPrs = memberrepodaystatDescOtherPrs.Default.(int)
 	memberrepostatFields := schema.MemberRepoStat{}.Fields()
 	_ = memberrepostatFields
 	// memberrepostatDescLogin is the schema descriptor for login field.
@@ -137,56 +445,198 @@ func init() {
 	memberrepostatDescDeletions := memberrepostatFields[8].Descriptor()
 	// memberrepostat.DefaultDeletions holds the default value on creation for the deletions field.
 	memberrepostat.DefaultDeletions = memberrepostatDescDeletions.Default.(int)
+	// memberrepostatDescIssuesClosed is the schema descriptor for issues_closed field.
+	memberrepostatDescIssuesClosed := memberrepostatFields[9].Descriptor()
+	// memberrepostat.DefaultIssuesClosed holds the default value on creation for the issues_closed field.
+	memberrepostat.DefaultIssuesClosed = memberrepostatDescIssuesClosed.Default.(int)
+	// memberrepostatDescIssueCloseSeconds is the schema descriptor for issue_close_seconds field.
+	memberrepostatDescIssueCloseSeconds := memberrepostatFields[10].Descriptor()
+	// memberrepostat.DefaultIssueCloseSeconds holds the default value on creation for the issue_close_seconds field.
+	memberrepostat.DefaultIssueCloseSeconds = memberrepostatDescIssueCloseSeconds.Default.(int)
+	// memberrepostatDescBugIssuesOpened is the schema descriptor for bug_issues_opened field.
+	memberrepostatDescBugIssuesOpened := memberrepostatFields[11].Descriptor()
+	// memberrepostat.DefaultBugIssuesOpened holds the default value on creation for the bug_issues_opened field.
+	memberrepostat.DefaultBugIssuesOpened = memberrepostatDescBugIssuesOpened.Default.(int)
+	// memberrepostatDescFeatureIssuesOpened is the schema descriptor for feature_issues_opened field.
+	memberrepostatDescFeatureIssuesOpened := memberrepostatFields[12].Descriptor()
+	// memberrepostat.DefaultFeatureIssuesOpened holds the default value on creation for the feature_issues_opened field.
+	memberrepostat.DefaultFeatureIssuesOpened = memberrepostatDescFeatureIssuesOpened.Default.(int)
+	// memberrepostatDescBugIssuesClosed is the schema descriptor for bug_issues_closed field.
+	memberrepostatDescBugIssuesClosed := memberrepostatFields[13].Descriptor()
+	// memberrepostat.DefaultBugIssuesClosed holds the default value on creation for the bug_issues_closed field.
+	memberrepostat.DefaultBugIssuesClosed = memberrepostatDescBugIssuesClosed.Default.(int)
+	// memberrepostatDescFeatureIssuesClosed is the schema descriptor for feature_issues_closed field.
+	memberrepostatDescFeatureIssuesClosed := memberrepostatFields[14].Descriptor()
+	// memberrepostat.DefaultFeatureIssuesClosed holds the default value on creation for the feature_issues_closed field.
+	memberrepostat.DefaultFeatureIssuesClosed = memberrepostatDescFeatureIssuesClosed.Default.(int)
+	// memberrepostatDescFeaturePrs is the schema descriptor for feature_prs field.
+	memberrepostatDescFeaturePrs := memberrepostatFields[15].Descriptor()
+	// memberrepostat.DefaultFeaturePrs holds the default value on creation for the feature_prs field.
+	memberrepostat.DefaultFeaturePrs = memberrepostatDescFeaturePrs.Default.(int)
+	// memberrepostatDescBugPrs is the schema descriptor for bug_prs field.
+	memberrepostatDescBugPrs := memberrepostatFields[16].Descriptor()
+	// memberrepostat.DefaultBugPrs holds the default value on creation for the bug_prs field.
+	memberrepostat.DefaultBugPrs = memberrepostatDescBugPrs.Default.(int)
+	// memberrepostatDescChorePrs is the schema descriptor for chore_prs field.
+	memberrepostatDescChorePrs := memberrepostatFields[17].Descriptor()
+	// memberrepostat.DefaultChorePrs holds the default value on creation for the chore_prs field.
+	memberrepostat.DefaultChorePrs = memberrepostatDescChorePrs.Default.(int)
+	// memberrepostatDescDocsPrs is the schema descriptor for docs_prs field.
+	memberrepostatDescDocsPrs := memberrepostatFields[18].Descriptor()
+	// memberrepostat.DefaultDocsPrs holds the default value on creation for the docs_prs field.
+	memberrepostat.DefaultDocsPrs = memberrepostatDescDocsPrs.Default.(int)
+	// memberrepostatDescTestPrs is the schema descriptor for test_prs field.
+	memberrepostatDescTestPrs := memberrepostatFields[19].Descriptor()
+	// memberrepostat.DefaultTestPrs holds the default value on creation for the test_prs field.
+	memberrepostat.DefaultTestPrs = memberrepostatDescTestPrs.Default.(int)
+	// memberrepostatDescInfraPrs is the schema descriptor for infra_prs field.
+	memberrepostatDescInfraPrs := memberrepostatFields[20].Descriptor()
+	// memberrepostat.DefaultInfraPrs holds the default value on creation for the infra_prs field.
+	memberrepostat.DefaultInfraPrs = memberrepostatDescInfraPrs.Default.(int)
+	// memberrepostatDescOtherPrs is the schema descriptor for other_prs field.
+	memberrepostatDescOtherPrs := memberrepostatFields[21].Descriptor()
+	// memberrepostat.DefaultOtherPrs holds the default value on creation for the other_prs field.
+	memberrepostat.DefaultOtherPrs = memberrepostatDescOtherPrs.Default.(int)
+	memberreviewrequestFields := schema.MemberReviewRequest{}.Fields()
+	_ = memberreviewrequestFields
+	// memberreviewrequestDescLogin is the schema descriptor for login field.
+	memberreviewrequestDescLogin := memberreviewrequestFields[0].Descriptor()
+	// memberreviewrequest.LoginValidator is a validator for the "login" field. It is called by the builders before save.
+	memberreviewrequest.LoginValidator = memberreviewrequestDescLogin.Validators[0].(func(string) error)
+	// memberreviewrequestDescNameWithOwner is the schema descriptor for name_with_owner field.
+	memberreviewrequestDescNameWithOwner := memberreviewrequestFields[1].Descriptor()
+	// memberreviewrequest.NameWithOwnerValidator is a validator for the "name_with_owner" field. It is called by the builders before save.
+	memberreviewrequest.NameWithOwnerValidator = memberreviewrequestDescNameWithOwner.Validators[0].(func(string) error)
+	// memberreviewrequestDescSourceID is the schema descriptor for source_id field.
+	memberreviewrequestDescSourceID := memberreviewrequestFields[2].Descriptor()
+	// memberreviewrequest.DefaultSourceID holds the default value on creation for the source_id field.
+	memberreviewrequest.DefaultSourceID = memberreviewrequestDescSourceID.Default.(string)
+	// memberreviewrequestDescReviewer is the schema descriptor for reviewer field.
+	memberreviewrequestDescReviewer := memberreviewrequestFields[3].Descriptor()
+	// memberreviewrequest.ReviewerValidator is a validator for the "reviewer" field. It is called by the builders before save.
+	memberreviewrequest.ReviewerValidator = memberreviewrequestDescReviewer.Validators[0].(func(string) error)
+	// memberreviewrequestDescCompleted is the schema descriptor for completed field.
+	memberreviewrequestDescCompleted := memberreviewrequestFields[5].Descriptor()
+	// memberreviewrequest.DefaultCompleted holds the default value on creation for the completed field.
+	memberreviewrequest.DefaultCompleted = memberreviewrequestDescCompleted.Default.(bool)
+	// memberreviewrequestDescPending is the schema descriptor for pending field.
+	memberreviewrequestDescPending := memberreviewrequestFields[6].Descriptor()
+	// memberreviewrequest.DefaultPending holds the default value on creation for the pending field.
+	memberreviewrequest.DefaultPending = memberreviewrequestDescPending.Default.(bool)
 	memberstatFields := schema.MemberStat{}.Fields()
 	_ = memberstatFields
 	// memberstatDescLogin is the schema descriptor for login field.
 	memberstatDescLogin := memberstatFields[0].Descriptor()
 	// memberstat.LoginValidator is a validator for the "login" field. It is called by the builders before save.
 	memberstat.LoginValidator = memberstatDescLogin.Validators[0].(func(string) error)
+	// memberstatDescName is the schema descriptor for name field.
+	memberstatDescName := memberstatFields[1].Descriptor()
+	// memberstat.DefaultName holds the default value on creation for the name field.
+	memberstat.DefaultName = memberstatDescName.Default.(string)
 	// memberstatDescTotalCommits is the schema descriptor for total_commits field.
-	memberstatDescTotalCommits := memberstatFields[1].Descriptor()
+	memberstatDescTotalCommits := memberstatFields[2].Descriptor()
 	// memberstat.DefaultTotalCommits holds the default value on creation for the total_commits field.
 	memberstat.DefaultTotalCommits = memberstatDescTotalCommits.Default.(int)
 	// memberstatDescTotalPrCreated is the schema descriptor for total_pr_created field.
-	memberstatDescTotalPrCreated := memberstatFields[2].Descriptor()
+	memberstatDescTotalPrCreated := memberstatFields[3].Descriptor()
 	// memberstat.DefaultTotalPrCreated holds the default value on creation for the total_pr_created field.
 	memberstat.DefaultTotalPrCreated = memberstatDescTotalPrCreated.Default.(int)
 	// memberstatDescTotalPrMerged is the schema descriptor for total_pr_merged field.
-	memberstatDescTotalPrMerged := memberstatFields[3].Descriptor()
+	memberstatDescTotalPrMerged := memberstatFields[4].Descriptor()
 	// memberstat.DefaultTotalPrMerged holds the default value on creation for the total_pr_merged field.
 	memberstat.DefaultTotalPrMerged = memberstatDescTotalPrMerged.Default.(int)
 	// memberstatDescTotalIssues is the schema descriptor for total_issues field.
-	memberstatDescTotalIssues := memberstatFields[4].Descriptor()
+	memberstatDescTotalIssues := memberstatFields[5].Descriptor()
 	// memberstat.DefaultTotalIssues holds the default value on creation for the total_issues field.
 	memberstat.DefaultTotalIssues = memberstatDescTotalIssues.Default.(int)
 	// memberstatDescTotalReviews is the schema descriptor for total_reviews field.
-	memberstatDescTotalReviews := memberstatFields[5].Descriptor()
+	memberstatDescTotalReviews := memberstatFields[6].Descriptor()
 	// memberstat.DefaultTotalReviews holds the default value on creation for the total_reviews field.
 	memberstat.DefaultTotalReviews = memberstatDescTotalReviews.Default.(int)
 	// memberstatDescTotalAdditions is the schema descriptor for total_additions field.
-	memberstatDescTotalAdditions := memberstatFields[6].Descriptor()
+	memberstatDescTotalAdditions := memberstatFields[7].Descriptor()
 	// memberstat.DefaultTotalAdditions holds the default value on creation for the total_additions field.
 	memberstat.DefaultTotalAdditions = memberstatDescTotalAdditions.Default.(int)
 	// memberstatDescTotalDeletions is the schema descriptor for total_deletions field.
-	memberstatDescTotalDeletions := memberstatFields[7].Descriptor()
+	memberstatDescTotalDeletions := memberstatFields[8].Descriptor()
 	// memberstat.DefaultTotalDeletions holds the default value on creation for the total_deletions field.
 	memberstat.DefaultTotalDeletions = memberstatDescTotalDeletions.Default.(int)
 	// memberstatDescFirstActivityYear is the schema descriptor for first_activity_year field.
-	memberstatDescFirstActivityYear := memberstatFields[8].Descriptor()
+	memberstatDescFirstActivityYear := memberstatFields[9].Descriptor()
 	// memberstat.DefaultFirstActivityYear holds the default value on creation for the first_activity_year field.
 	memberstat.DefaultFirstActivityYear = memberstatDescFirstActivityYear.Default.(int)
 	// memberstatDescPeakActivityYear is the schema descriptor for peak_activity_year field.
-	memberstatDescPeakActivityYear := memberstatFields[9].Descriptor()
+	memberstatDescPeakActivityYear := memberstatFields[10].Descriptor()
 	// memberstat.DefaultPeakActivityYear holds the default value on creation for the peak_activity_year field.
 	memberstat.DefaultPeakActivityYear = memberstatDescPeakActivityYear.Default.(int)
 	// memberstatDescPeakActivityCommits is the schema descriptor for peak_activity_commits field.
-	memberstatDescPeakActivityCommits := memberstatFields[10].Descriptor()
+	memberstatDescPeakActivityCommits := memberstatFields[11].Descriptor()
 	// memberstat.DefaultPeakActivityCommits holds the default value on creation for the peak_activity_commits field.
 	memberstat.DefaultPeakActivityCommits = memberstatDescPeakActivityCommits.Default.(int)
+	// memberstatDescTotalExcludedReviews is the schema descriptor for total_excluded_reviews field.
+	memberstatDescTotalExcludedReviews := memberstatFields[12].Descriptor()
+	// memberstat.DefaultTotalExcludedReviews holds the default value on creation for the total_excluded_reviews field.
+	memberstat.DefaultTotalExcludedReviews = memberstatDescTotalExcludedReviews.Default.(int)
+	// memberstatDescTotalIssuesClosed is the schema descriptor for total_issues_closed field.
+	memberstatDescTotalIssuesClosed := memberstatFields[13].Descriptor()
+	// memberstat.DefaultTotalIssuesClosed holds the default value on creation for the total_issues_closed field.
+	memberstat.DefaultTotalIssuesClosed = memberstatDescTotalIssuesClosed.Default.(int)
+	// memberstatDescTotalIssueCloseSeconds is the schema descriptor for total_issue_close_seconds field.
+	memberstatDescTotalIssueCloseSeconds := memberstatFields[14].Descriptor()
+	// memberstat.DefaultTotalIssueCloseSeconds holds the default value on creation for the total_issue_close_seconds field.
+	memberstat.DefaultTotalIssueCloseSeconds = memberstatDescTotalIssueCloseSeconds.Default.(int)
+	// memberstatDescTotalBugIssuesOpened is the schema descriptor for total_bug_issues_opened field.
+	memberstatDescTotalBugIssuesOpened := memberstatFields[15].Descriptor()
+	// memberstat.DefaultTotalBugIssuesOpened holds the default value on creation for the total_bug_issues_opened field.
+	memberstat.DefaultTotalBugIssuesOpened = memberstatDescTotalBugIssuesOpened.Default.(int)
+	// memberstatDescTotalFeatureIssuesOpened is the schema descriptor for total_feature_issues_opened field.
+	memberstatDescTotalFeatureIssuesOpened := memberstatFields[16].Descriptor()
+	// memberstat.DefaultTotalFeatureIssuesOpened holds the default value on creation for the total_feature_issues_opened field.
+	memberstat.DefaultTotalFeatureIssuesOpened = memberstatDescTotalFeatureIssuesOpened.Default.(int)
+	// memberstatDescTotalBugIssuesClosed is the schema descriptor for total_bug_issues_closed field.
+	memberstatDescTotalBugIssuesClosed := memberstatFields[17].Descriptor()
+	// memberstat.DefaultTotalBugIssuesClosed holds the default value on creation for the total_bug_issues_closed field.
+	memberstat.DefaultTotalBugIssuesClosed = memberstatDescTotalBugIssuesClosed.Default.(int)
+	// memberstatDescTotalFeatureIssuesClosed is the schema descriptor for total_feature_issues_closed field.
+	memberstatDescTotalFeatureIssuesClosed := memberstatFields[18].Descriptor()
+	// memberstat.DefaultTotalFeatureIssuesClosed holds the default value on creation for the total_feature_issues_closed field.
+	memberstat.DefaultTotalFeatureIssuesClosed = memberstatDescTotalFeatureIssuesClosed.Default.(int)
+	// memberstatDescTotalFeaturePrs is the schema descriptor for total_feature_prs field.
+	memberstatDescTotalFeaturePrs := memberstatFields[19].Descriptor()
+	// memberstat.DefaultTotalFeaturePrs holds the default value on creation for the total_feature_prs field.
+	memberstat.DefaultTotalFeaturePrs = memberstatDescTotalFeaturePrs.Default.(int)
+	// memberstatDescTotalBugPrs is the schema descriptor for total_bug_prs field.
+	memberstatDescTotalBugPrs := memberstatFields[20].Descriptor()
+	// memberstat.DefaultTotalBugPrs holds the default value on creation for the total_bug_prs field.
+	memberstat.DefaultTotalBugPrs = memberstatDescTotalBugPrs.Default.(int)
+	// memberstatDescTotalChorePrs is the schema descriptor for total_chore_prs field.
+	memberstatDescTotalChorePrs := memberstatFields[21].Descriptor()
+	// memberstat.DefaultTotalChorePrs holds the default value on creation for the total_chore_prs field.
+	memberstat.DefaultTotalChorePrs = memberstatDescTotalChorePrs.Default.(int)
+	// memberstatDescTotalDocsPrs is the schema descriptor for total_docs_prs field.
+	memberstatDescTotalDocsPrs := memberstatFields[22].Descriptor()
+	// memberstat.DefaultTotalDocsPrs holds the default value on creation for the total_docs_prs field.
+	memberstat.DefaultTotalDocsPrs = memberstatDescTotalDocsPrs.Default.(int)
+	// memberstatDescTotalTestPrs is the schema descriptor for total_test_prs field.
+	memberstatDescTotalTestPrs := memberstatFields[23].Descriptor()
+	// memberstat.DefaultTotalTestPrs holds the default value on creation for the total_test_prs field.
+	memberstat.DefaultTotalTestPrs = memberstatDescTotalTestPrs.Default.(int)
+	// memberstatDescTotalInfraPrs is the schema descriptor for total_infra_prs field.
+	memberstatDescTotalInfraPrs := memberstatFields[24].Descriptor()
+	// memberstat.DefaultTotalInfraPrs holds the default value on creation for the total_infra_prs field.
+	memberstat.DefaultTotalInfraPrs = memberstatDescTotalInfraPrs.Default.(int)
+	// memberstatDescTotalOtherPrs is the schema descriptor for total_other_prs field.
+	memberstatDescTotalOtherPrs := memberstatFields[25].Descriptor()
+	// memberstat.DefaultTotalOtherPrs holds the default value on creation for the total_other_prs field.
+	memberstat.DefaultTotalOtherPrs = memberstatDescTotalOtherPrs.Default.(int)
 	// memberstatDescPrToReviewRatio is the schema descriptor for pr_to_review_ratio field.
-	memberstatDescPrToReviewRatio := memberstatFields[11].Descriptor()
+	memberstatDescPrToReviewRatio := memberstatFields[26].Descriptor()
 	// memberstat.DefaultPrToReviewRatio holds the default value on creation for the pr_to_review_ratio field.
 	memberstat.DefaultPrToReviewRatio = memberstatDescPrToReviewRatio.Default.(float64)
+	// memberstatDescTimeZone is the schema descriptor for time_zone field.
+	memberstatDescTimeZone := memberstatFields[27].Descriptor()
+	// memberstat.DefaultTimeZone holds the default value on creation for the time_zone field.
+	memberstat.DefaultTimeZone = memberstatDescTimeZone.Default.(string)
 	memberyearstatFields := schema.MemberYearStat{}.Fields()
 	_ = memberyearstatFields
 	// memberyearstatDescLogin is the schema descriptor for login field.
@@ -221,6 +671,44 @@ func init() {
 	memberyearstatDescDeletions := memberyearstatFields[8].Descriptor()
 	// memberyearstat.DefaultDeletions holds the default value on creation for the deletions field.
 	memberyearstat.DefaultDeletions = memberyearstatDescDeletions.Default.(int)
+	repodeliveryweekFields := schema.RepoDeliveryWeek{}.Fields()
+	_ = repodeliveryweekFields
+	// repodeliveryweekDescNameWithOwner is the schema descriptor for name_with_owner field.
+	repodeliveryweekDescNameWithOwner := repodeliveryweekFields[0].Descriptor()
+	// repodeliveryweek.NameWithOwnerValidator is a validator for the "name_with_owner" field. It is called by the builders before save.
+	repodeliveryweek.NameWithOwnerValidator = repodeliveryweekDescNameWithOwner.Validators[0].(func(string) error)
+	// repodeliveryweekDescWeek is the schema descriptor for week field.
+	repodeliveryweekDescWeek := repodeliveryweekFields[1].Descriptor()
+	// repodeliveryweek.WeekValidator is a validator for the "week" field. It is called by the builders before save.
+	repodeliveryweek.WeekValidator = repodeliveryweekDescWeek.Validators[0].(func(string) error)
+	// repodeliveryweekDescSource is the schema descriptor for source field.
+	repodeliveryweekDescSource := repodeliveryweekFields[2].Descriptor()
+	// repodeliveryweek.SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	repodeliveryweek.SourceValidator = repodeliveryweekDescSource.Validators[0].(func(string) error)
+	// repodeliveryweekDescDeploymentCount is the schema descriptor for deployment_count field.
+	repodeliveryweekDescDeploymentCount := repodeliveryweekFields[3].Descriptor()
+	// repodeliveryweek.DefaultDeploymentCount holds the default value on creation for the deployment_count field.
+	repodeliveryweek.DefaultDeploymentCount = repodeliveryweekDescDeploymentCount.Default.(int)
+	// repodeliveryweekDescFailedDeploymentCount is the schema descriptor for failed_deployment_count field.
+	repodeliveryweekDescFailedDeploymentCount := repodeliveryweekFields[4].Descriptor()
+	// repodeliveryweek.DefaultFailedDeploymentCount holds the default value on creation for the failed_deployment_count field.
+	repodeliveryweek.DefaultFailedDeploymentCount = repodeliveryweekDescFailedDeploymentCount.Default.(int)
+	// repodeliveryweekDescLeadTimeSeconds is the schema descriptor for lead_time_seconds field.
+	repodeliveryweekDescLeadTimeSeconds := repodeliveryweekFields[5].Descriptor()
+	// repodeliveryweek.DefaultLeadTimeSeconds holds the default value on creation for the lead_time_seconds field.
+	repodeliveryweek.DefaultLeadTimeSeconds = repodeliveryweekDescLeadTimeSeconds.Default.(int)
+	// repodeliveryweekDescLeadTimeCount is the schema descriptor for lead_time_count field.
+	repodeliveryweekDescLeadTimeCount := repodeliveryweekFields[6].Descriptor()
+	// repodeliveryweek.DefaultLeadTimeCount holds the default value on creation for the lead_time_count field.
+	repodeliveryweek.DefaultLeadTimeCount = repodeliveryweekDescLeadTimeCount.Default.(int)
+	// repodeliveryweekDescRestoreSeconds is the schema descriptor for restore_seconds field.
+	repodeliveryweekDescRestoreSeconds := repodeliveryweekFields[7].Descriptor()
+	// repodeliveryweek.DefaultRestoreSeconds holds the default value on creation for the restore_seconds field.
+	repodeliveryweek.DefaultRestoreSeconds = repodeliveryweekDescRestoreSeconds.Default.(int)
+	// repodeliveryweekDescRestoreCount is the schema descriptor for restore_count field.
+	repodeliveryweekDescRestoreCount := repodeliveryweekFields[8].Descriptor()
+	// repodeliveryweek.DefaultRestoreCount holds the default value on creation for the restore_count field.
+	repodeliveryweek.DefaultRestoreCount = repodeliveryweekDescRestoreCount.Default.(int)
 	repometaFields := schema.RepoMeta{}.Fields()
 	_ = repometaFields
 	// repometaDescNameWithOwner is the schema descriptor for name_with_owner field.
@@ -235,10 +723,36 @@ func init() {
 	repometaDescOwnerType := repometaFields[2].Descriptor()
 	// repometa.DefaultOwnerType holds the default value on creation for the owner_type field.
 	repometa.DefaultOwnerType = repometaDescOwnerType.Default.(string)
+	reviewedgeFields := schema.ReviewEdge{}.Fields()
+	_ = reviewedgeFields
+	// reviewedgeDescReviewer is the schema descriptor for reviewer field.
+	reviewedgeDescReviewer := reviewedgeFields[0].Descriptor()
+	// reviewedge.ReviewerValidator is a validator for the "reviewer" field. It is called by the builders before save.
+	reviewedge.ReviewerValidator = reviewedgeDescReviewer.Validators[0].(func(string) error)
+	// reviewedgeDescAuthor is the schema descriptor for author field.
+	reviewedgeDescAuthor := reviewedgeFields[1].Descriptor()
+	// reviewedge.AuthorValidator is a validator for the "author" field. It is called by the builders before save.
+	reviewedge.AuthorValidator = reviewedgeDescAuthor.Validators[0].(func(string) error)
+	// reviewedgeDescNameWithOwner is the schema descriptor for name_with_owner field.
+	reviewedgeDescNameWithOwner := reviewedgeFields[2].Descriptor()
+	// reviewedge.NameWithOwnerValidator is a validator for the "name_with_owner" field. It is called by the builders before save.
+	reviewedge.NameWithOwnerValidator = reviewedgeDescNameWithOwner.Validators[0].(func(string) error)
+	// reviewedgeDescDay is the schema descriptor for day field.
+	reviewedgeDescDay := reviewedgeFields[3].Descriptor()
+	// reviewedge.DayValidator is a validator for the "day" field. It is called by the builders before save.
+	reviewedge.DayValidator = reviewedgeDescDay.Validators[0].(func(string) error)
+	// reviewedgeDescReviewCount is the schema descriptor for review_count field.
+	reviewedgeDescReviewCount := reviewedgeFields[4].Descriptor()
+	// reviewedge.DefaultReviewCount holds the default value on creation for the review_count field.
+	reviewedge.DefaultReviewCount = reviewedgeDescReviewCount.Default.(int)
 	snapshotFields := schema.Snapshot{}.Fields()
 	_ = snapshotFields
 	// snapshotDescCapturedAt is the schema descriptor for captured_at field.
 	snapshotDescCapturedAt := snapshotFields[0].Descriptor()
 	// snapshot.DefaultCapturedAt holds the default value on creation for the captured_at field.
 	snapshot.DefaultCapturedAt = snapshotDescCapturedAt.Default.(func() time.Time)
+	// snapshotDescLookbackYears is the schema descriptor for lookback_years field.
+	snapshotDescLookbackYears := snapshotFields[4].Descriptor()
+	// snapshot.DefaultLookbackYears holds the default value on creation for the lookback_years field.
+	snapshot.DefaultLookbackYears = snapshotDescLookbackYears.Default.(int)
 }
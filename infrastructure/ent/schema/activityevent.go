@@ -0,0 +1,112 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ActivityEvent is one raw activity (commit contribution, pull request, issue,
+// issue close, review or merge) fetched from GitHub. Unlike the per-snapshot
+// stat buckets it is not owned by a snapshot: events form an append-only store
+// that grows with every batch run, so new metrics can be computed (and
+// snapshots rebuilt) from the stored events without re-fetching from GitHub.
+// Every field is immutable; an event is only ever inserted once, deduplicated
+// by its natural key.
+type ActivityEvent struct {
+	ent.Schema
+}
+
+// Fields of the ActivityEvent.
+func (ActivityEvent) Fields() []ent.Field {
+	return []ent.Field{
+		// natural_key identifies the event independently of when it was
+		// fetched (see domain.ActivityNaturalKey), so re-fetching the same
+		// window never inserts duplicates.
+		field.String("natural_key").
+			NotEmpty().
+			Immutable(),
+		field.String("login").
+			NotEmpty().
+			Immutable(),
+		// activity_type is the domain.ActivityType value, e.g. "commit".
+		field.String("activity_type").
+			NotEmpty().
+			Immutable(),
+		// source_id is the GitHub node ID of the pull request, issue or review.
+		// Commit contributions have no node ID and leave it empty.
+		field.String("source_id").
+			Default("").
+			Immutable(),
+		field.String("name_with_owner").
+			NotEmpty().
+			Immutable(),
+		field.String("owner").
+			Default("").
+			Immutable(),
+		field.String("owner_type").
+			Default("").
+			Immutable(),
+		field.Time("occurred_at").
+			Immutable(),
+		field.Int("additions").
+			Default(0).
+			Immutable(),
+		field.Int("deletions").
+			Default(0).
+			Immutable(),
+		// is_merged is the merge state observed when the pull request event was
+		// first stored. A later merge is recorded as a separate "pr_merge" event
+		// instead of updating this row.
+		field.Bool("is_merged").
+			Default(false).
+			Immutable(),
+		// pull_request_author is the author of the reviewed pull request, set
+		// for review events only (empty when GitHub no longer knows the author).
+		field.String("pull_request_author").
+			Default("").
+			Immutable(),
+		// pull_request_author_type is the GraphQL type of that author (User,
+		// Bot, ...), so reaggregation can apply the bot exclusion rule.
+		field.String("pull_request_author_type").
+			Default("").
+			Immutable(),
+		// issue_kind is the bug/feature classification of the issue's labels,
+		// set for issue and issue_close events.
+		field.String("issue_kind").
+			Default("").
+			Immutable(),
+		// issue_opened_at is when the closed issue was opened, set for
+		// issue_close events only so time to close can be re-derived.
+		field.Time("issue_opened_at").
+			Optional().
+			Nillable().
+			Immutable(),
+		// work_category is the classification of a pull_request event (FEATURE,
+		// BUG, ...), empty for events stored before it was recorded.
+		field.String("work_category").
+			Default("").
+			Immutable(),
+		// day_only marks events whose occurred_at carries only the day (commit
+		// contributions collected per user), so they are left out of the
+		// hour-of-week heatmap.
+		field.Bool("day_only").
+			Default(false).
+			Immutable(),
+		field.Time("recorded_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the ActivityEvent.
+func (ActivityEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("natural_key").
+			Unique(),
+		// Re-aggregation reads all events of a member in time order.
+		index.Fields("login", "occurred_at"),
+	}
+}
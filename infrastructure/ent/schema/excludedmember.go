@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ExcludedMember records an account that matched the bot/service-account
+// exclusion rules and was left out of a snapshot's roster, so the web layer
+// can show who was excluded and why.
+type ExcludedMember struct {
+	ent.Schema
+}
+
+// Fields of the ExcludedMember.
+func (ExcludedMember) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("login").
+			NotEmpty(),
+		// rule is the matching rule: login, pattern:<regexp> or bot.
+		field.String("rule").
+			Default(""),
+	}
+}
+
+// Edges of the ExcludedMember.
+func (ExcludedMember) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("snapshot", Snapshot.Type).
+			Ref("excluded_members").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the ExcludedMember.
+func (ExcludedMember) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("snapshot").
+			Fields("login"),
+	}
+}
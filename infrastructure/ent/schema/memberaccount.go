@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MemberAccount records one of the GitHub accounts that the identity map
+// merged into a member of a snapshot. Members with a single account have no
+// rows; the drill-down lists the accounts of the others.
+type MemberAccount struct {
+	ent.Schema
+}
+
+// Fields of the MemberAccount.
+func (MemberAccount) Fields() []ent.Field {
+	return []ent.Field{
+		// login is the member's canonical login (MemberStat.login).
+		field.String("login").
+			NotEmpty(),
+		field.String("account").
+			NotEmpty(),
+	}
+}
+
+// Edges of the MemberAccount.
+func (MemberAccount) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("snapshot", Snapshot.Type).
+			Ref("member_accounts").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the MemberAccount.
+func (MemberAccount) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("snapshot").
+			Fields("login", "account").
+			Unique(),
+	}
+}
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MemberDataGap records a part of a member's activity that could not be
+// fetched for a snapshot, even after retries: for example the later pages of
+// one repository's commit contributions when GitHub kept failing. A member
+// with at least one gap has totals that may be lower than the truth, and the
+// web layer flags them as incomplete.
+type MemberDataGap struct {
+	ent.Schema
+}
+
+// Fields of the MemberDataGap.
+func (MemberDataGap) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("login").
+			NotEmpty(),
+		// activity_type is the domain.ActivityType that is missing (commit, review, ...).
+		field.String("activity_type").
+			NotEmpty(),
+		// name_with_owner is empty when the gap is not tied to one repository.
+		field.String("name_with_owner").
+			Default(""),
+		// reason is the error class: transient, rate_limited, not_found,
+		// permission or unknown.
+		field.String("reason").
+			NotEmpty(),
+		field.String("message").
+			Default(""),
+	}
+}
+
+// Edges of the MemberDataGap.
+func (MemberDataGap) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("snapshot", Snapshot.Type).
+			Ref("member_data_gaps").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the MemberDataGap.
+func (MemberDataGap) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("snapshot").
+			Fields("login"),
+	}
+}
@@ -37,6 +37,42 @@ func (MemberDayStat) Fields() []ent.Field {
 			Default(0),
 		field.Int("deletions").
 			Default(0),
+		// excluded_review_count counts the day's reviews of pull requests
+		// opened by excluded bots and service accounts.
+		field.Int("excluded_review_count").
+			Default(0),
+		// issues_closed counts issues the member closed, and issue_close_seconds
+		// sums their open-to-close durations so averages can be derived from any
+		// range of rows. The bug_/feature_ counts split opened and closed issues
+		// by the configured label mapping.
+		field.Int("issues_closed").
+			Default(0),
+		field.Int("issue_close_seconds").
+			Default(0),
+		field.Int("bug_issues_opened").
+			Default(0),
+		field.Int("feature_issues_opened").
+			Default(0),
+		field.Int("bug_issues_closed").
+			Default(0),
+		field.Int("feature_issues_closed").
+			Default(0),
+		// The *_prs columns count created pull requests by work category
+		// (labels, conventional-commit title prefix or changed paths).
+		field.Int("feature_prs").
+			Default(0),
+		field.Int("bug_prs").
+			Default(0),
+		field.Int("chore_prs").
+			Default(0),
+		field.Int("docs_prs").
+			Default(0),
+		field.Int("test_prs").
+			Default(0),
+		field.Int("infra_prs").
+			Default(0),
+		field.Int("other_prs").
+			Default(0),
 	}
 }
 
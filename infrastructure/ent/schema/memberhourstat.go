@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MemberHourStat holds the number of activities a member performed in one
+// local hour for a single snapshot. It backs the hour-of-week heatmap and the
+// off-hours ratio. hour_start is the start of the hour stored in UTC, while
+// day, weekday and hour are the local calendar values in the member's time
+// zone (MemberStat.time_zone), so range filtering by day follows the member's
+// local dates.
+type MemberHourStat struct {
+	ent.Schema
+}
+
+// Fields of the MemberHourStat.
+func (MemberHourStat) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("login").
+			NotEmpty(),
+		field.Time("hour_start"),
+		field.String("day").
+			NotEmpty(),
+		// weekday follows Go's time.Weekday (0 is Sunday).
+		field.Int("weekday").
+			Default(0),
+		field.Int("hour").
+			Default(0),
+		field.Int("activity_count").
+			Default(0),
+	}
+}
+
+// Edges of the MemberHourStat.
+func (MemberHourStat) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("snapshot", Snapshot.Type).
+			Ref("member_hour_stats").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the MemberHourStat.
+func (MemberHourStat) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("snapshot").
+			Fields("login", "hour_start").
+			Unique(),
+		index.Edges("snapshot").
+			Fields("login", "day"),
+	}
+}
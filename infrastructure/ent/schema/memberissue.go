@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MemberIssue holds the lifecycle of one issue a member opened or closed, for
+// a single snapshot. The open backlog of a repository at any point in time is
+// derived from these rows at read time. An issue opened by one member and
+// closed by another is stored once per member, so readers deduplicate by
+// source_id.
+//
+// closed_at is NULL while the issue is open.
+type MemberIssue struct {
+	ent.Schema
+}
+
+// Fields of the MemberIssue.
+func (MemberIssue) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("login").
+			NotEmpty(),
+		field.String("name_with_owner").
+			NotEmpty(),
+		// source_id is the GitHub node ID of the issue.
+		field.String("source_id").
+			NotEmpty(),
+		field.String("author").
+			Default(""),
+		field.Time("opened_at"),
+		field.Time("closed_at").
+			Optional().
+			Nillable(),
+		field.String("closed_by").
+			Default(""),
+		// state_reason is GitHub's close reason (COMPLETED, NOT_PLANNED).
+		field.String("state_reason").
+			Default(""),
+		// kind is the bug/feature classification of the issue's labels.
+		field.String("kind").
+			Default(""),
+	}
+}
+
+// Edges of the MemberIssue.
+func (MemberIssue) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("snapshot", Snapshot.Type).
+			Ref("member_issues").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the MemberIssue.
+func (MemberIssue) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("snapshot").
+			Fields("login"),
+		// Repository backlogs scan by repository within a snapshot.
+		index.Edges("snapshot").
+			Fields("name_with_owner"),
+	}
+}
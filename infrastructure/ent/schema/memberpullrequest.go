@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MemberPullRequest holds the lifecycle of one pull request authored by a
+// member, for a single snapshot: when it was opened, first reviewed, approved
+// and merged or closed, how many review rounds it took, and its size. Medians
+// and percentiles cannot be summed, so cycle-time and size aggregates (per
+// member and per repository) are computed from these per-PR rows at read time
+// instead of being stored pre-aggregated.
+//
+// Stages a pull request has not reached yet are NULL.
+type MemberPullRequest struct {
+	ent.Schema
+}
+
+// Fields of the MemberPullRequest.
+func (MemberPullRequest) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("login").
+			NotEmpty(),
+		field.String("name_with_owner").
+			NotEmpty(),
+		// source_id is the GitHub node ID of the pull request.
+		field.String("source_id").
+			Default(""),
+		field.Time("opened_at"),
+		field.Time("first_review_at").
+			Optional().
+			Nillable(),
+		field.Time("approved_at").
+			Optional().
+			Nillable(),
+		field.Time("merged_at").
+			Optional().
+			Nillable(),
+		// closed_at is also set for merged pull requests (GitHub closes them on merge).
+		field.Time("closed_at").
+			Optional().
+			Nillable(),
+		field.Int("review_rounds").
+			Default(0),
+		// additions, deletions and changed_files are the size of the pull
+		// request; all zero for rows stored before sizes were recorded.
+		field.Int("additions").
+			Default(0),
+		field.Int("deletions").
+			Default(0),
+		field.Int("changed_files").
+			Default(0),
+	}
+}
+
+// Edges of the MemberPullRequest.
+func (MemberPullRequest) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("snapshot", Snapshot.Type).
+			Ref("member_pull_requests").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the MemberPullRequest.
+func (MemberPullRequest) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("snapshot").
+			Fields("login"),
+		// Repository-axis cycle times scan by repository within a snapshot.
+		index.Edges("snapshot").
+			Fields("name_with_owner"),
+	}
+}
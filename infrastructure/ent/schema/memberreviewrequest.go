@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MemberReviewRequest holds one reviewer's review request on a pull request
+// authored by a member, for a single snapshot. Repeated requests to the same
+// reviewer are stored once, with the latest request time. Review load per
+// reviewer (requested, completed and the pending queue) is derived from these
+// rows at read time.
+type MemberReviewRequest struct {
+	ent.Schema
+}
+
+// Fields of the MemberReviewRequest.
+func (MemberReviewRequest) Fields() []ent.Field {
+	return []ent.Field{
+		// login is the member who authored the pull request.
+		field.String("login").
+			NotEmpty(),
+		field.String("name_with_owner").
+			NotEmpty(),
+		// source_id is the GitHub node ID of the pull request.
+		field.String("source_id").
+			Default(""),
+		field.String("reviewer").
+			NotEmpty(),
+		field.Time("requested_at"),
+		// completed is set when the reviewer submitted a review after the
+		// latest request.
+		field.Bool("completed").
+			Default(false),
+		// pending is set while the pull request is open and the request is
+		// still outstanding.
+		field.Bool("pending").
+			Default(false),
+	}
+}
+
+// Edges of the MemberReviewRequest.
+func (MemberReviewRequest) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("snapshot", Snapshot.Type).
+			Ref("member_review_requests").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the MemberReviewRequest.
+func (MemberReviewRequest) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("snapshot").
+			Fields("login"),
+		// Review load is grouped by reviewer within a snapshot.
+		index.Edges("snapshot").
+			Fields("reviewer"),
+	}
+}
@@ -19,6 +19,9 @@ func (MemberStat) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("login").
 			NotEmpty(),
+		// name is the member's display name; empty means the login is shown.
+		field.String("name").
+			Default(""),
 		field.Int("total_commits").
 			Default(0),
 		field.Int("total_pr_created").
@@ -40,8 +43,48 @@ func (MemberStat) Fields() []ent.Field {
 			Default(0),
 		field.Int("peak_activity_commits").
 			Default(0),
+		// total_excluded_reviews counts reviews of pull requests opened by
+		// excluded bots and service accounts; they are not in total_reviews.
+		field.Int("total_excluded_reviews").
+			Default(0),
+		// total_issues_closed counts issues the member closed, and total_issue_close_seconds
+		// sums their open-to-close durations so averages can be derived from any
+		// range of rows. The bug_/feature_ counts split opened and closed issues
+		// by the configured label mapping.
+		field.Int("total_issues_closed").
+			Default(0),
+		field.Int("total_issue_close_seconds").
+			Default(0),
+		field.Int("total_bug_issues_opened").
+			Default(0),
+		field.Int("total_feature_issues_opened").
+			Default(0),
+		field.Int("total_bug_issues_closed").
+			Default(0),
+		field.Int("total_feature_issues_closed").
+			Default(0),
+		// The total_*_prs columns count created pull requests by work category
+		// (labels, conventional-commit title prefix or changed paths).
+		field.Int("total_feature_prs").
+			Default(0),
+		field.Int("total_bug_prs").
+			Default(0),
+		field.Int("total_chore_prs").
+			Default(0),
+		field.Int("total_docs_prs").
+			Default(0),
+		field.Int("total_test_prs").
+			Default(0),
+		field.Int("total_infra_prs").
+			Default(0),
+		field.Int("total_other_prs").
+			Default(0),
 		field.Float("pr_to_review_ratio").
 			Default(0),
+		// time_zone is the IANA time zone the member's hour-of-week activity
+		// (MemberHourStat) was bucketed in.
+		field.String("time_zone").
+			Default(""),
 	}
 }
 
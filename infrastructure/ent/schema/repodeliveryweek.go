@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RepoDeliveryWeek holds the delivery (DORA) metrics of one repository for one
+// week (the Monday it starts on, YYYY-MM-DD, UTC) of a single snapshot. Lead
+// time and time to restore are stored as totals with their counts so averages
+// can be taken over any range of weeks. Weeks without any deployment have no
+// row.
+type RepoDeliveryWeek struct {
+	ent.Schema
+}
+
+// Fields of the RepoDeliveryWeek.
+func (RepoDeliveryWeek) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name_with_owner").
+			NotEmpty(),
+		field.String("week").
+			NotEmpty(),
+		// source is what was counted as a deployment: DEPLOYMENT, RELEASE or TAG.
+		field.String("source").
+			NotEmpty(),
+		field.Int("deployment_count").
+			Default(0),
+		// failed_deployment_count counts failed and rolled-back deployments.
+		field.Int("failed_deployment_count").
+			Default(0),
+		// lead_time_seconds sums, over the pull requests shipped by this week's
+		// deployments, the time from merge to deployment.
+		field.Int("lead_time_seconds").
+			Default(0),
+		field.Int("lead_time_count").
+			Default(0),
+		// restore_seconds sums, over the failures that started this week, the
+		// time until the next successful deployment.
+		field.Int("restore_seconds").
+			Default(0),
+		field.Int("restore_count").
+			Default(0),
+	}
+}
+
+// Edges of the RepoDeliveryWeek.
+func (RepoDeliveryWeek) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("snapshot", Snapshot.Type).
+			Ref("repo_delivery_weeks").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the RepoDeliveryWeek.
+func (RepoDeliveryWeek) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("snapshot").
+			Fields("name_with_owner", "week").
+			Unique(),
+	}
+}
@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ReviewEdge holds how many reviews one member (the reviewer) left on pull
+// requests authored by another user, within one repository on one day
+// (YYYY-MM-DD, UTC), for a single snapshot. Summed across repositories and
+// days it yields the weighted reviewer -> author collaboration graph. Like the
+// other day-level buckets it is sparse: only combinations with at least one
+// review get a row.
+type ReviewEdge struct {
+	ent.Schema
+}
+
+// Fields of the ReviewEdge.
+func (ReviewEdge) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("reviewer").
+			NotEmpty(),
+		// author is the pull request author; it need not be a tracked member.
+		field.String("author").
+			NotEmpty(),
+		field.String("name_with_owner").
+			NotEmpty(),
+		field.String("day").
+			NotEmpty(),
+		field.Int("review_count").
+			Default(0),
+	}
+}
+
+// Edges of the ReviewEdge.
+func (ReviewEdge) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("snapshot", Snapshot.Type).
+			Ref("review_edges").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the ReviewEdge.
+func (ReviewEdge) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("snapshot").
+			Fields("reviewer", "author", "name_with_owner", "day").
+			Unique(),
+		// reviewNetwork filters by a day range within a snapshot.
+		index.Edges("snapshot").
+			Fields("day"),
+	}
+}
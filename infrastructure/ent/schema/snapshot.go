@@ -24,6 +24,24 @@ func (Snapshot) Fields() []ent.Field {
 		field.Time("captured_at").
 			Default(time.Now).
 			Immutable(),
+		// tag marks a snapshot to keep forever: the retention policy
+		// (snapshot prune) never deletes tagged snapshots.
+		field.String("tag").
+			Optional().
+			Nillable(),
+		// period_since and period_until record the collection period the
+		// batch was limited to (-since / -until); period_until is exclusive.
+		// Either is null when that side of the period was not limited.
+		field.Time("period_since").
+			Optional().
+			Nillable(),
+		field.Time("period_until").
+			Optional().
+			Nillable(),
+		// lookback_years records how many years back the batch fetched
+		// activity (-lookback-years); 0 is the default lookback.
+		field.Int("lookback_years").
+			Default(0),
 	}
 }
 
@@ -36,6 +54,15 @@ func (Snapshot) Edges() []ent.Edge {
 		edge.To("member_repo_stats", MemberRepoStat.Type),
 		edge.To("member_repo_day_stats", MemberRepoDayStat.Type),
 		edge.To("repo_metas", RepoMeta.Type),
+		edge.To("member_pull_requests", MemberPullRequest.Type),
+		edge.To("review_edges", ReviewEdge.Type),
+		edge.To("member_data_gaps", MemberDataGap.Type),
+		edge.To("excluded_members", ExcludedMember.Type),
+		edge.To("member_accounts", MemberAccount.Type),
+		edge.To("repo_delivery_weeks", RepoDeliveryWeek.Type),
+		edge.To("member_issues", MemberIssue.Type),
+		edge.To("member_review_requests", MemberReviewRequest.Type),
+		edge.To("member_hour_stats", MemberHourStat.Type),
 	}
 }
 
@@ -19,6 +19,14 @@ type Snapshot struct {
 	ID int `json:"id,omitempty"`
 	// CapturedAt holds the value of the "captured_at" field.
 	CapturedAt time.Time `json:"captured_at,omitempty"`
+	// Tag holds the value of the "tag" field.
+	Tag *string `json:"tag,omitempty"`
+	// PeriodSince holds the value of the "period_since" field.
+	PeriodSince *time.Time `json:"period_since,omitempty"`
+	// PeriodUntil holds the value of the "period_until" field.
+	PeriodUntil *time.Time `json:"period_until,omitempty"`
+	// LookbackYears holds the value of the "lookback_years" field.
+	LookbackYears int `json:"lookback_years,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SnapshotQuery when eager-loading is set.
 	Edges        SnapshotEdges `json:"edges"`
@@ -39,9 +47,27 @@ type SnapshotEdges struct {
 	MemberRepoDayStats []*MemberRepoDayStat `json:"member_repo_day_stats,omitempty"`
 	// RepoMetas holds the value of the repo_metas edge.
 	RepoMetas []*RepoMeta `json:"repo_metas,omitempty"`
+	// MemberPullRequests holds the value of the member_pull_requests edge.
+	MemberPullRequests []*MemberPullRequest `json:"member_pull_requests,omitempty"`
+	// ReviewEdges holds the value of the review_edges edge.
+	ReviewEdges []*ReviewEdge `json:"review_edges,omitempty"`
+	// MemberDataGaps holds the value of the member_data_gaps edge.
+	MemberDataGaps []*MemberDataGap `json:"member_data_gaps,omitempty"`
+	// ExcludedMembers holds the value of the excluded_members edge.
+	ExcludedMembers []*ExcludedMember `json:"excluded_members,omitempty"`
+	// MemberAccounts holds the value of the member_accounts edge.
+	MemberAccounts []*MemberAccount `json:"member_accounts,omitempty"`
+	// RepoDeliveryWeeks holds the value of the repo_delivery_weeks edge.
+	RepoDeliveryWeeks []*RepoDeliveryWeek `json:"repo_delivery_weeks,omitempty"`
+	// MemberIssues holds the value of the member_issues edge.
+	MemberIssues []*MemberIssue `json:"member_issues,omitempty"`
+	// MemberReviewRequests holds the value of the member_review_requests edge.
+	MemberReviewRequests []*MemberReviewRequest `json:"member_review_requests,omitempty"`
+	// MemberHourStats holds the value of the member_hour_stats edge.
+	MemberHourStats []*MemberHourStat `json:"member_hour_stats,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [6]bool
+	loadedTypes [15]bool
 }
 
 // MemberStatsOrErr returns the MemberStats value or an error if the edge
@@ -98,14 +124,97 @@ func (e SnapshotEdges) RepoMetasOrErr() ([]*RepoMeta, error) {
 	return nil, &NotLoadedError{edge: "repo_metas"}
 }
 
+// MemberPullRequestsOrErr returns the MemberPullRequests value or an error if the edge
+// was not loaded in eager-loading.
+func (e SnapshotEdges) MemberPullRequestsOrErr() ([]*MemberPullRequest, error) {
+	if e.loadedTypes[6] {
+		return e.MemberPullRequests, nil
+	}
+	return nil, &NotLoadedError{edge: "member_pull_requests"}
+}
+
+// ReviewEdgesOrErr returns the ReviewEdges value or an error if the edge
+// was not loaded in eager-loading.
+func (e SnapshotEdges) ReviewEdgesOrErr() ([]*ReviewEdge, error) {
+	if e.loadedTypes[7] {
+		return e.ReviewEdges, nil
+	}
+	return nil, &NotLoadedError{edge: "review_edges"}
+}
+
+// MemberDataGapsOrErr returns the MemberDataGaps value or an error if the edge
+// was not loaded in eager-loading.
+func (e SnapshotEdges) MemberDataGapsOrErr() ([]*MemberDataGap, error) {
+	if e.loadedTypes[8] {
+		return e.MemberDataGaps, nil
+	}
+	return nil, &NotLoadedError{edge: "member_data_gaps"}
+}
+
+// ExcludedMembersOrErr returns the ExcludedMembers value or an error if the edge
+// was not loaded in eager-loading.
+func (e SnapshotEdges) ExcludedMembersOrErr() ([]*ExcludedMember, error) {
+	if e.loadedTypes[9] {
+		return e.ExcludedMembers, nil
+	}
+	return nil, &NotLoadedError{edge: "excluded_members"}
+}
+
+// MemberAccountsOrErr returns the MemberAccounts value or an error if the edge
+// was not loaded in eager-loading.
+func (e SnapshotEdges) MemberAccountsOrErr() ([]*MemberAccount, error) {
+	if e.loadedTypes[10] {
+		return e.MemberAccounts, nil
+	}
+	return nil, &NotLoadedError{edge: "member_accounts"}
+}
+
+// RepoDeliveryWeeksOrErr returns the RepoDeliveryWeeks value or an error if the edge
+// was not loaded in eager-loading.
+func (e SnapshotEdges) RepoDeliveryWeeksOrErr() ([]*RepoDeliveryWeek, error) {
+	if e.loadedTypes[11] {
+		return e.RepoDeliveryWeeks, nil
+	}
+	return nil, &NotLoadedError{edge: "repo_delivery_weeks"}
+}
+
+// MemberIssuesOrErr returns the MemberIssues value or an error if the edge
+// was not loaded in eager-loading.
+func (e SnapshotEdges) MemberIssuesOrErr() ([]*MemberIssue, error) {
+	if e.loadedTypes[12] {
+		return e.MemberIssues, nil
+	}
+	return nil, &NotLoadedError{edge: "member_issues"}
+}
+
+// MemberReviewRequestsOrErr returns the MemberReviewRequests value or an error if the edge
+// was not loaded in eager-loading.
+func (e SnapshotEdges) MemberReviewRequestsOrErr() ([]*MemberReviewRequest, error) {
+	if e.loadedTypes[13] {
+		return e.MemberReviewRequests, nil
+	}
+	return nil, &NotLoadedError{edge: "member_review_requests"}
+}
+
+// MemberHourStatsOrErr returns the MemberHourStats value or an error if the edge
+// was not loaded in eager-loading.
+func (e SnapshotEdges) MemberHourStatsOrErr() ([]*MemberHourStat, error) {
+	if e.loadedTypes[14] {
+		return e.MemberHourStats, nil
+	}
+	return nil, &NotLoadedError{edge: "member_hour_stats"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Snapshot) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case snapshot.FieldID:
+		case snapshot.FieldID, snapshot.FieldLookbackYears:
 			values[i] = new(sql.NullInt64)
-		case snapshot.FieldCapturedAt:
+		case snapshot.FieldTag:
+			values[i] = new(sql.NullString)
+		case snapshot.FieldCapturedAt, snapshot.FieldPeriodSince, snapshot.FieldPeriodUntil:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -134,6 +243,33 @@ func (_m *Snapshot) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.CapturedAt = value.Time
 			}
+		case snapshot.FieldTag:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tag", values[i])
+			} else if value.Valid {
+				_m.Tag = new(string)
+				*_m.Tag = value.String
+			}
+		case snapshot.FieldPeriodSince:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field period_since", values[i])
+			} else if value.Valid {
+				_m.PeriodSince = new(time.Time)
+				*_m.PeriodSince = value.Time
+			}
+		case snapshot.FieldPeriodUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field period_until", values[i])
+			} else if value.Valid {
+				_m.PeriodUntil = new(time.Time)
+				*_m.PeriodUntil = value.Time
+			}
+		case snapshot.FieldLookbackYears:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field lookback_years", values[i])
+			} else if value.Valid {
+				_m.LookbackYears = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -177,6 +313,51 @@ func (_m *Snapshot) QueryRepoMetas() *RepoMetaQuery {
 	return NewSnapshotClient(_m.config).QueryRepoMetas(_m)
 }
 
+// QueryMemberPullRequests queries the "member_pull_requests" edge of the Snapshot entity.
+func (_m *Snapshot) QueryMemberPullRequests() *MemberPullRequestQuery {
+	return NewSnapshotClient(_m.config).QueryMemberPullRequests(_m)
+}
+
+// QueryReviewEdges queries the "review_edges" edge of the Snapshot entity.
+func (_m *Snapshot) QueryReviewEdges() *ReviewEdgeQuery {
+	return NewSnapshotClient(_m.config).QueryReviewEdges(_m)
+}
+
+// QueryMemberDataGaps queries the "member_data_gaps" edge of the Snapshot entity.
+func (_m *Snapshot) QueryMemberDataGaps() *MemberDataGapQuery {
+	return NewSnapshotClient(_m.config).QueryMemberDataGaps(_m)
+}
+
+// QueryExcludedMembers queries the "excluded_members" edge of the Snapshot entity.
+func (_m *Snapshot) QueryExcludedMembers() *ExcludedMemberQuery {
+	return NewSnapshotClient(_m.config).QueryExcludedMembers(_m)
+}
+
+// QueryMemberAccounts queries the "member_accounts" edge of the Snapshot entity.
+func (_m *Snapshot) QueryMemberAccounts() *MemberAccountQuery {
+	return NewSnapshotClient(_m.config).QueryMemberAccounts(_m)
+}
+
+// QueryRepoDeliveryWeeks queries the "repo_delivery_weeks" edge of the Snapshot entity.
+func (_m *Snapshot) QueryRepoDeliveryWeeks() *RepoDeliveryWeekQuery {
+	return NewSnapshotClient(_m.config).QueryRepoDeliveryWeeks(_m)
+}
+
+// QueryMemberIssues queries the "member_issues" edge of the Snapshot entity.
+func (_m *Snapshot) QueryMemberIssues() *MemberIssueQuery {
+	return NewSnapshotClient(_m.config).QueryMemberIssues(_m)
+}
+
+// QueryMemberReviewRequests queries the "member_review_requests" edge of the Snapshot entity.
+func (_m *Snapshot) QueryMemberReviewRequests() *MemberReviewRequestQuery {
+	return NewSnapshotClient(_m.config).QueryMemberReviewRequests(_m)
+}
+
+// QueryMemberHourStats queries the "member_hour_stats" edge of the Snapshot entity.
+func (_m *Snapshot) QueryMemberHourStats() *MemberHourStatQuery {
+	return NewSnapshotClient(_m.config).QueryMemberHourStats(_m)
+}
+
 // Update returns a builder for updating this Snapshot.
 // Note that you need to call Snapshot.Unwrap() before calling this method if this Snapshot
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -202,6 +383,24 @@ func (_m *Snapshot) String() string {
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
 	builder.WriteString("captured_at=")
 	builder.WriteString(_m.CapturedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.Tag; v != nil {
+		builder.WriteString("tag=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.PeriodSince; v != nil {
+		builder.WriteString("period_since=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.PeriodUntil; v != nil {
+		builder.WriteString("period_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("lookback_years=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LookbackYears))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -16,6 +16,14 @@ const (
 	FieldID = "id"
 	// FieldCapturedAt holds the string denoting the captured_at field in the database.
 	FieldCapturedAt = "captured_at"
+	// FieldTag holds the string denoting the tag field in the database.
+	FieldTag = "tag"
+	// FieldPeriodSince holds the string denoting the period_since field in the database.
+	FieldPeriodSince = "period_since"
+	// FieldPeriodUntil holds the string denoting the period_until field in the database.
+	FieldPeriodUntil = "period_until"
+	// FieldLookbackYears holds the string denoting the lookback_years field in the database.
+	FieldLookbackYears = "lookback_years"
 	// EdgeMemberStats holds the string denoting the member_stats edge name in mutations.
 	EdgeMemberStats = "member_stats"
 	// EdgeMemberYearStats holds the string denoting the member_year_stats edge name in mutations.
@@ -28,6 +36,24 @@ const (
 	EdgeMemberRepoDayStats = "member_repo_day_stats"
 	// EdgeRepoMetas holds the string denoting the repo_metas edge name in mutations.
 	EdgeRepoMetas = "repo_metas"
+	// EdgeMemberPullRequests holds the string denoting the member_pull_requests edge name in mutations.
+	EdgeMemberPullRequests = "member_pull_requests"
+	// EdgeReviewEdges holds the string denoting the review_edges edge name in mutations.
+	EdgeReviewEdges = "review_edges"
+	// EdgeMemberDataGaps holds the string denoting the member_data_gaps edge name in mutations.
+	EdgeMemberDataGaps = "member_data_gaps"
+	// EdgeExcludedMembers holds the string denoting the excluded_members edge name in mutations.
+	EdgeExcludedMembers = "excluded_members"
+	// EdgeMemberAccounts holds the string denoting the member_accounts edge name in mutations.
+	EdgeMemberAccounts = "member_accounts"
+	// EdgeRepoDeliveryWeeks holds the string denoting the repo_delivery_weeks edge name in mutations.
+	EdgeRepoDeliveryWeeks = "repo_delivery_weeks"
+	// EdgeMemberIssues holds the string denoting the member_issues edge name in mutations.
+	EdgeMemberIssues = "member_issues"
+	// EdgeMemberReviewRequests holds the string denoting the member_review_requests edge name in mutations.
+	EdgeMemberReviewRequests = "member_review_requests"
+	// EdgeMemberHourStats holds the string denoting the member_hour_stats edge name in mutations.
+	EdgeMemberHourStats = "member_hour_stats"
 	// Table holds the table name of the snapshot in the database.
 	Table = "snapshots"
 	// MemberStatsTable is the table that holds the member_stats relation/edge.
@@ -72,12 +98,79 @@ const (
 	RepoMetasInverseTable = "repo_meta"
 	// RepoMetasColumn is the table column denoting the repo_metas relation/edge.
 	RepoMetasColumn = "snapshot_repo_metas"
+	// MemberPullRequestsTable is the table that holds the member_pull_requests relation/edge.
+	MemberPullRequestsTable = "member_pull_requests"
+	// MemberPullRequestsInverseTable is the table name for the MemberPullRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "memberpullrequest" package.
+	MemberPullRequestsInverseTable = "member_pull_requests"
+	// MemberPullRequestsColumn is the table column denoting the member_pull_requests relation/edge.
+	MemberPullRequestsColumn = "snapshot_member_pull_requests"
+	// ReviewEdgesTable is the table that holds the review_edges relation/edge.
+	ReviewEdgesTable = "review_edges"
+	// ReviewEdgesInverseTable is the table name for the ReviewEdge entity.
+	// It exists in this package in order to avoid circular dependency with the "reviewedge" package.
+	ReviewEdgesInverseTable = "review_edges"
+	// ReviewEdgesColumn is the table column denoting the review_edges relation/edge.
+	ReviewEdgesColumn = "snapshot_review_edges"
+	// MemberDataGapsTable is the table that holds the member_data_gaps relation/edge.
+	MemberDataGapsTable = "member_data_gaps"
+	// MemberDataGapsInverseTable is the table name for the MemberDataGap entity.
+	// It exists in this package in order to avoid circular dependency with the "memberdatagap" package.
+	MemberDataGapsInverseTable = "member_data_gaps"
+	// MemberDataGapsColumn is the table column denoting the member_data_gaps relation/edge.
+	MemberDataGapsColumn = "snapshot_member_data_gaps"
+	// ExcludedMembersTable is the table that holds the excluded_members relation/edge.
+	ExcludedMembersTable = "excluded_members"
+	// ExcludedMembersInverseTable is the table name for the ExcludedMember entity.
+	// It exists in this package in order to avoid circular dependency with the "excludedmember" package.
+	ExcludedMembersInverseTable = "excluded_members"
+	// ExcludedMembersColumn is the table column denoting the excluded_members relation/edge.
+	ExcludedMembersColumn = "snapshot_excluded_members"
+	// MemberAccountsTable is the table that holds the member_accounts relation/edge.
+	MemberAccountsTable = "member_accounts"
+	// MemberAccountsInverseTable is the table name for the MemberAccount entity.
+	// It exists in this package in order to avoid circular dependency with the "memberaccount" package.
+	MemberAccountsInverseTable = "member_accounts"
+	// MemberAccountsColumn is the table column denoting the member_accounts relation/edge.
+	MemberAccountsColumn = "snapshot_member_accounts"
+	// RepoDeliveryWeeksTable is the table that holds the repo_delivery_weeks relation/edge.
+	RepoDeliveryWeeksTable = "repo_delivery_weeks"
+	// RepoDeliveryWeeksInverseTable is the table name for the RepoDeliveryWeek entity.
+	// It exists in this package in order to avoid circular dependency with the "repodeliveryweek" package.
+	RepoDeliveryWeeksInverseTable = "repo_delivery_weeks"
+	// RepoDeliveryWeeksColumn is the table column denoting the repo_delivery_weeks relation/edge.
+	RepoDeliveryWeeksColumn = "snapshot_repo_delivery_weeks"
+	// MemberIssuesTable is the table that holds the member_issues relation/edge.
+	MemberIssuesTable = "member_issues"
+	// MemberIssuesInverseTable is the table name for the MemberIssue entity.
+	// It exists in this package in order to avoid circular dependency with the "memberissue" package.
+	MemberIssuesInverseTable = "member_issues"
+	// MemberIssuesColumn is the table column denoting the member_issues relation/edge.
+	MemberIssuesColumn = "snapshot_member_issues"
+	// MemberReviewRequestsTable is the table that holds the member_review_requests relation/edge.
+	MemberReviewRequestsTable = "member_review_requests"
+	// MemberReviewRequestsInverseTable is the table name for the MemberReviewRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "memberreviewrequest" package.
+	MemberReviewRequestsInverseTable = "member_review_requests"
+	// MemberReviewRequestsColumn is the table column denoting the member_review_requests relation/edge.
+	MemberReviewRequestsColumn = "snapshot_member_review_requests"
+	// MemberHourStatsTable is the table that holds the member_hour_stats relation/edge.
+	MemberHourStatsTable = "member_hour_stats"
+	// MemberHourStatsInverseTable is the table name for the MemberHourStat entity.
+	// It exists in this package in order to avoid circular dependency with the "memberhourstat" package.
+	MemberHourStatsInverseTable = "member_hour_stats"
+	// MemberHourStatsColumn is the table column denoting the member_hour_stats relation/edge.
+	MemberHourStatsColumn = "snapshot_member_hour_stats"
 )
 
 // Columns holds all SQL columns for snapshot fields.
 var Columns = []string{
 	FieldID,
 	FieldCapturedAt,
+	FieldTag,
+	FieldPeriodSince,
+	FieldPeriodUntil,
+	FieldLookbackYears,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -93,6 +186,8 @@ func ValidColumn(column string) bool {
 var (
 	// DefaultCapturedAt holds the default value on creation for the "captured_at" field.
 	DefaultCapturedAt func() time.Time
+	// DefaultLookbackYears holds the default value on creation for the "lookback_years" field.
+	DefaultLookbackYears int
 )
 
 // OrderOption defines the ordering options for the Snapshot queries.
@@ -108,6 +203,26 @@ func ByCapturedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCapturedAt, opts...).ToFunc()
 }
 
+// ByTag orders the results by the tag field.
+func ByTag(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTag, opts...).ToFunc()
+}
+
+// ByPeriodSince orders the results by the period_since field.
+func ByPeriodSince(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeriodSince, opts...).ToFunc()
+}
+
+// ByPeriodUntil orders the results by the period_until field.
+func ByPeriodUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeriodUntil, opts...).ToFunc()
+}
+
+// ByLookbackYears orders the results by the lookback_years field.
+func ByLookbackYears(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLookbackYears, opts...).ToFunc()
+}
+
 // ByMemberStatsCount orders the results by member_stats count.
 func ByMemberStatsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -191,6 +306,132 @@ func ByRepoMetas(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newRepoMetasStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByMemberPullRequestsCount orders the results by member_pull_requests count.
+func ByMemberPullRequestsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMemberPullRequestsStep(), opts...)
+	}
+}
+
+// ByMemberPullRequests orders the results by member_pull_requests terms.
+func ByMemberPullRequests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMemberPullRequestsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReviewEdgesCount orders the results by review_edges count.
+func ByReviewEdgesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReviewEdgesStep(), opts...)
+	}
+}
+
+// ByReviewEdges orders the results by review_edges terms.
+func ByReviewEdges(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReviewEdgesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMemberDataGapsCount orders the results by member_data_gaps count.
+func ByMemberDataGapsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMemberDataGapsStep(), opts...)
+	}
+}
+
+// ByMemberDataGaps orders the results by member_data_gaps terms.
+func ByMemberDataGaps(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMemberDataGapsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByExcludedMembersCount orders the results by excluded_members count.
+func ByExcludedMembersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newExcludedMembersStep(), opts...)
+	}
+}
+
+// ByExcludedMembers orders the results by excluded_members terms.
+func ByExcludedMembers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newExcludedMembersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMemberAccountsCount orders the results by member_accounts count.
+func ByMemberAccountsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMemberAccountsStep(), opts...)
+	}
+}
+
+// ByMemberAccounts orders the results by member_accounts terms.
+func ByMemberAccounts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMemberAccountsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRepoDeliveryWeeksCount orders the results by repo_delivery_weeks count.
+func ByRepoDeliveryWeeksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRepoDeliveryWeeksStep(), opts...)
+	}
+}
+
+// ByRepoDeliveryWeeks orders the results by repo_delivery_weeks terms.
+func ByRepoDeliveryWeeks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRepoDeliveryWeeksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMemberIssuesCount orders the results by member_issues count.
+func ByMemberIssuesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMemberIssuesStep(), opts...)
+	}
+}
+
+// ByMemberIssues orders the results by member_issues terms.
+func ByMemberIssues(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMemberIssuesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMemberReviewRequestsCount orders the results by member_review_requests count.
+func ByMemberReviewRequestsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMemberReviewRequestsStep(), opts...)
+	}
+}
+
+// ByMemberReviewRequests orders the results by member_review_requests terms.
+func ByMemberReviewRequests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMemberReviewRequestsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMemberHourStatsCount orders the results by member_hour_stats count.
+func ByMemberHourStatsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMemberHourStatsStep(), opts...)
+	}
+}
+
+// ByMemberHourStats orders the results by member_hour_stats terms.
+func ByMemberHourStats(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMemberHourStatsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newMemberStatsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -233,3 +474,66 @@ func newRepoMetasStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, RepoMetasTable, RepoMetasColumn),
 	)
 }
+func newMemberPullRequestsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MemberPullRequestsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MemberPullRequestsTable, MemberPullRequestsColumn),
+	)
+}
+func newReviewEdgesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReviewEdgesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReviewEdgesTable, ReviewEdgesColumn),
+	)
+}
+func newMemberDataGapsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MemberDataGapsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MemberDataGapsTable, MemberDataGapsColumn),
+	)
+}
+func newExcludedMembersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ExcludedMembersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ExcludedMembersTable, ExcludedMembersColumn),
+	)
+}
+func newMemberAccountsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MemberAccountsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MemberAccountsTable, MemberAccountsColumn),
+	)
+}
+func newRepoDeliveryWeeksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RepoDeliveryWeeksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RepoDeliveryWeeksTable, RepoDeliveryWeeksColumn),
+	)
+}
+func newMemberIssuesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MemberIssuesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MemberIssuesTable, MemberIssuesColumn),
+	)
+}
+func newMemberReviewRequestsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MemberReviewRequestsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MemberReviewRequestsTable, MemberReviewRequestsColumn),
+	)
+}
+func newMemberHourStatsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MemberHourStatsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MemberHourStatsTable, MemberHourStatsColumn),
+	)
+}
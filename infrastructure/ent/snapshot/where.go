@@ -60,6 +60,26 @@ func CapturedAt(v time.Time) predicate.Snapshot {
 	return predicate.Snapshot(sql.FieldEQ(FieldCapturedAt, v))
 }
 
+// Tag applies equality check predicate on the "tag" field. It's identical to TagEQ.
+func Tag(v string) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldEQ(FieldTag, v))
+}
+
+// PeriodSince applies equality check predicate on the "period_since" field. It's identical to PeriodSinceEQ.
+func PeriodSince(v time.Time) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldEQ(FieldPeriodSince, v))
+}
+
+// PeriodUntil applies equality check predicate on the "period_until" field. It's identical to PeriodUntilEQ.
+func PeriodUntil(v time.Time) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldEQ(FieldPeriodUntil, v))
+}
+
+// LookbackYears applies equality check predicate on the "lookback_years" field. It's identical to LookbackYearsEQ.
+func LookbackYears(v int) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldEQ(FieldLookbackYears, v))
+}
+
 // CapturedAtEQ applies the EQ predicate on the "captured_at" field.
 func CapturedAtEQ(v time.Time) predicate.Snapshot {
 	return predicate.Snapshot(sql.FieldEQ(FieldCapturedAt, v))
@@ -100,6 +120,221 @@ func CapturedAtLTE(v time.Time) predicate.Snapshot {
 	return predicate.Snapshot(sql.FieldLTE(FieldCapturedAt, v))
 }
 
+// TagEQ applies the EQ predicate on the "tag" field.
+func TagEQ(v string) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldEQ(FieldTag, v))
+}
+
+// TagNEQ applies the NEQ predicate on the "tag" field.
+func TagNEQ(v string) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldNEQ(FieldTag, v))
+}
+
+// TagIn applies the In predicate on the "tag" field.
+func TagIn(vs ...string) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldIn(FieldTag, vs...))
+}
+
+// TagNotIn applies the NotIn predicate on the "tag" field.
+func TagNotIn(vs ...string) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldNotIn(FieldTag, vs...))
+}
+
+// TagGT applies the GT predicate on the "tag" field.
+func TagGT(v string) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldGT(FieldTag, v))
+}
+
+// TagGTE applies the GTE predicate on the "tag" field.
+func TagGTE(v string) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldGTE(FieldTag, v))
+}
+
+// TagLT applies the LT predicate on the "tag" field.
+func TagLT(v string) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldLT(FieldTag, v))
+}
+
+// TagLTE applies the LTE predicate on the "tag" field.
+func TagLTE(v string) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldLTE(FieldTag, v))
+}
+
+// TagContains applies the Contains predicate on the "tag" field.
+func TagContains(v string) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldContains(FieldTag, v))
+}
+
+// TagHasPrefix applies the HasPrefix predicate on the "tag" field.
+func TagHasPrefix(v string) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldHasPrefix(FieldTag, v))
+}
+
+// TagHasSuffix applies the HasSuffix predicate on the "tag" field.
+func TagHasSuffix(v string) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldHasSuffix(FieldTag, v))
+}
+
+// TagIsNil applies the IsNil predicate on the "tag" field.
+func TagIsNil() predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldIsNull(FieldTag))
+}
+
+// TagNotNil applies the NotNil predicate on the "tag" field.
+func TagNotNil() predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldNotNull(FieldTag))
+}
+
+// TagEqualFold applies the EqualFold predicate on the "tag" field.
+func TagEqualFold(v string) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldEqualFold(FieldTag, v))
+}
+
+// TagContainsFold applies the ContainsFold predicate on the "tag" field.
+func TagContainsFold(v string) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldContainsFold(FieldTag, v))
+}
+
+// PeriodSinceEQ applies the EQ predicate on the "period_since" field.
+func PeriodSinceEQ(v time.Time) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldEQ(FieldPeriodSince, v))
+}
+
+// PeriodSinceNEQ applies the NEQ predicate on the "period_since" field.
+func PeriodSinceNEQ(v time.Time) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldNEQ(FieldPeriodSince, v))
+}
+
+// PeriodSinceIn applies the In predicate on the "period_since" field.
+func PeriodSinceIn(vs ...time.Time) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldIn(FieldPeriodSince, vs...))
+}
+
+// PeriodSinceNotIn applies the NotIn predicate on the "period_since" field.
+func PeriodSinceNotIn(vs ...time.Time) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldNotIn(FieldPeriodSince, vs...))
+}
+
+// PeriodSinceGT applies the GT predicate on the "period_since" field.
+func PeriodSinceGT(v time.Time) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldGT(FieldPeriodSince, v))
+}
+
+// PeriodSinceGTE applies the GTE predicate on the "period_since" field.
+func PeriodSinceGTE(v time.Time) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldGTE(FieldPeriodSince, v))
+}
+
+// PeriodSinceLT applies the LT predicate on the "period_since" field.
+func PeriodSinceLT(v time.Time) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldLT(FieldPeriodSince, v))
+}
+
+// PeriodSinceLTE applies the LTE predicate on the "period_since" field.
+func PeriodSinceLTE(v time.Time) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldLTE(FieldPeriodSince, v))
+}
+
+// PeriodSinceIsNil applies the IsNil predicate on the "period_since" field.
+func PeriodSinceIsNil() predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldIsNull(FieldPeriodSince))
+}
+
+// PeriodSinceNotNil applies the NotNil predicate on the "period_since" field.
+func PeriodSinceNotNil() predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldNotNull(FieldPeriodSince))
+}
+
+// PeriodUntilEQ applies the EQ predicate on the "period_until" field.
+func PeriodUntilEQ(v time.Time) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldEQ(FieldPeriodUntil, v))
+}
+
+// PeriodUntilNEQ applies the NEQ predicate on the "period_until" field.
+func PeriodUntilNEQ(v time.Time) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldNEQ(FieldPeriodUntil, v))
+}
+
+// PeriodUntilIn applies the In predicate on the "period_until" field.
+func PeriodUntilIn(vs ...time.Time) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldIn(FieldPeriodUntil, vs...))
+}
+
+// PeriodUntilNotIn applies the NotIn predicate on the "period_until" field.
+func PeriodUntilNotIn(vs ...time.Time) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldNotIn(FieldPeriodUntil, vs...))
+}
+
+// PeriodUntilGT applies the GT predicate on the "period_until" field.
+func PeriodUntilGT(v time.Time) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldGT(FieldPeriodUntil, v))
+}
+
+// PeriodUntilGTE applies the GTE predicate on the "period_until" field.
+func PeriodUntilGTE(v time.Time) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldGTE(FieldPeriodUntil, v))
+}
+
+// PeriodUntilLT applies the LT predicate on the "period_until" field.
+func PeriodUntilLT(v time.Time) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldLT(FieldPeriodUntil, v))
+}
+
+// PeriodUntilLTE applies the LTE predicate on the "period_until" field.
+func PeriodUntilLTE(v time.Time) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldLTE(FieldPeriodUntil, v))
+}
+
+// PeriodUntilIsNil applies the IsNil predicate on the "period_until" field.
+func PeriodUntilIsNil() predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldIsNull(FieldPeriodUntil))
+}
+
+// PeriodUntilNotNil applies the NotNil predicate on the "period_until" field.
+func PeriodUntilNotNil() predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldNotNull(FieldPeriodUntil))
+}
+
+// LookbackYearsEQ applies the EQ predicate on the "lookback_years" field.
+func LookbackYearsEQ(v int) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldEQ(FieldLookbackYears, v))
+}
+
+// LookbackYearsNEQ applies the NEQ predicate on the "lookback_years" field.
+func LookbackYearsNEQ(v int) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldNEQ(FieldLookbackYears, v))
+}
+
+// LookbackYearsIn applies the In predicate on the "lookback_years" field.
+func LookbackYearsIn(vs ...int) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldIn(FieldLookbackYears, vs...))
+}
+
+// LookbackYearsNotIn applies the NotIn predicate on the "lookback_years" field.
+func LookbackYearsNotIn(vs ...int) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldNotIn(FieldLookbackYears, vs...))
+}
+
+// LookbackYearsGT applies the GT predicate on the "lookback_years" field.
+func LookbackYearsGT(v int) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldGT(FieldLookbackYears, v))
+}
+
+// LookbackYearsGTE applies the GTE predicate on the "lookback_years" field.
+func LookbackYearsGTE(v int) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldGTE(FieldLookbackYears, v))
+}
+
+// LookbackYearsLT applies the LT predicate on the "lookback_years" field.
+func LookbackYearsLT(v int) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldLT(FieldLookbackYears, v))
+}
+
+// LookbackYearsLTE applies the LTE predicate on the "lookback_years" field.
+func LookbackYearsLTE(v int) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldLTE(FieldLookbackYears, v))
+}
+
 // HasMemberStats applies the HasEdge predicate on the "member_stats" edge.
 func HasMemberStats() predicate.Snapshot {
 	return predicate.Snapshot(func(s *sql.Selector) {
@@ -238,6 +473,213 @@ func HasRepoMetasWith(preds ...predicate.RepoMeta) predicate.Snapshot {
 	})
 }
 
+// HasMemberPullRequests applies the HasEdge predicate on the "member_pull_requests" edge.
+func HasMemberPullRequests() predicate.Snapshot {
+	return predicate.Snapshot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MemberPullRequestsTable, MemberPullRequestsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMemberPullRequestsWith applies the HasEdge predicate on the "member_pull_requests" edge with a given conditions (other predicates).
+func HasMemberPullRequestsWith(preds ...predicate.MemberPullRequest) predicate.Snapshot {
+	return predicate.Snapshot(func(s *sql.Selector) {
+		step := newMemberPullRequestsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReviewEdges applies the HasEdge predicate on the "review_edges" edge.
+func HasReviewEdges() predicate.Snapshot {
+	return predicate.Snapshot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReviewEdgesTable, ReviewEdgesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReviewEdgesWith applies the HasEdge predicate on the "review_edges" edge with a given conditions (other predicates).
+func HasReviewEdgesWith(preds ...predicate.ReviewEdge) predicate.Snapshot {
+	return predicate.Snapshot(func(s *sql.Selector) {
+		step := newReviewEdgesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMemberDataGaps applies the HasEdge predicate on the "member_data_gaps" edge.
+func HasMemberDataGaps() predicate.Snapshot {
+	return predicate.Snapshot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MemberDataGapsTable, MemberDataGapsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMemberDataGapsWith applies the HasEdge predicate on the "member_data_gaps" edge with a given conditions (other predicates).
+func HasMemberDataGapsWith(preds ...predicate.MemberDataGap) predicate.Snapshot {
+	return predicate.Snapshot(func(s *sql.Selector) {
+		step := newMemberDataGapsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasExcludedMembers applies the HasEdge predicate on the "excluded_members" edge.
+func HasExcludedMembers() predicate.Snapshot {
+	return predicate.Snapshot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ExcludedMembersTable, ExcludedMembersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasExcludedMembersWith applies the HasEdge predicate on the "excluded_members" edge with a given conditions (other predicates).
+func HasExcludedMembersWith(preds ...predicate.ExcludedMember) predicate.Snapshot {
+	return predicate.Snapshot(func(s *sql.Selector) {
+		step := newExcludedMembersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMemberAccounts applies the HasEdge predicate on the "member_accounts" edge.
+func HasMemberAccounts() predicate.Snapshot {
+	return predicate.Snapshot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MemberAccountsTable, MemberAccountsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMemberAccountsWith applies the HasEdge predicate on the "member_accounts" edge with a given conditions (other predicates).
+func HasMemberAccountsWith(preds ...predicate.MemberAccount) predicate.Snapshot {
+	return predicate.Snapshot(func(s *sql.Selector) {
+		step := newMemberAccountsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRepoDeliveryWeeks applies the HasEdge predicate on the "repo_delivery_weeks" edge.
+func HasRepoDeliveryWeeks() predicate.Snapshot {
+	return predicate.Snapshot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RepoDeliveryWeeksTable, RepoDeliveryWeeksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRepoDeliveryWeeksWith applies the HasEdge predicate on the "repo_delivery_weeks" edge with a given conditions (other predicates).
+func HasRepoDeliveryWeeksWith(preds ...predicate.RepoDeliveryWeek) predicate.Snapshot {
+	return predicate.Snapshot(func(s *sql.Selector) {
+		step := newRepoDeliveryWeeksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMemberIssues applies the HasEdge predicate on the "member_issues" edge.
+func HasMemberIssues() predicate.Snapshot {
+	return predicate.Snapshot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MemberIssuesTable, MemberIssuesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMemberIssuesWith applies the HasEdge predicate on the "member_issues" edge with a given conditions (other predicates).
+func HasMemberIssuesWith(preds ...predicate.MemberIssue) predicate.Snapshot {
+	return predicate.Snapshot(func(s *sql.Selector) {
+		step := newMemberIssuesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMemberReviewRequests applies the HasEdge predicate on the "member_review_requests" edge.
+func HasMemberReviewRequests() predicate.Snapshot {
+	return predicate.Snapshot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MemberReviewRequestsTable, MemberReviewRequestsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMemberReviewRequestsWith applies the HasEdge predicate on the "member_review_requests" edge with a given conditions (other predicates).
+func HasMemberReviewRequestsWith(preds ...predicate.MemberReviewRequest) predicate.Snapshot {
+	return predicate.Snapshot(func(s *sql.Selector) {
+		step := newMemberReviewRequestsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMemberHourStats applies the HasEdge predicate on the "member_hour_stats" edge.
+func HasMemberHourStats() predicate.Snapshot {
+	return predicate.Snapshot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MemberHourStatsTable, MemberHourStatsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMemberHourStatsWith applies the HasEdge predicate on the "member_hour_stats" edge with a given conditions (other predicates).
+func HasMemberHourStatsWith(preds ...predicate.MemberHourStat) predicate.Snapshot {
+	return predicate.Snapshot(func(s *sql.Selector) {
+		step := newMemberHourStatsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Snapshot) predicate.Snapshot {
 	return predicate.Snapshot(sql.AndPredicates(predicates...))
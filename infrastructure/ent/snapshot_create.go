@@ -10,12 +10,21 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/excludedmember"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberaccount"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberdatagap"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/memberdaystat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberhourstat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberissue"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberpullrequest"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/memberrepodaystat"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/memberrepostat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberreviewrequest"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/memberstat"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/memberyearstat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/repodeliveryweek"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/repometa"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/reviewedge"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/snapshot"
 )
 
@@ -40,6 +49,62 @@ func (_c *SnapshotCreate) SetNillableCapturedAt(v *time.Time) *SnapshotCreate {
 	return _c
 }
 
+// SetTag sets the "tag" field.
+func (_c *SnapshotCreate) SetTag(v string) *SnapshotCreate {
+	_c.mutation.SetTag(v)
+	return _c
+}
+
+// SetNillableTag sets the "tag" field if the given value is not nil.
+func (_c *SnapshotCreate) SetNillableTag(v *string) *SnapshotCreate {
+	if v != nil {
+		_c.SetTag(*v)
+	}
+	return _c
+}
+
+// SetPeriodSince sets the "period_since" field.
+func (_c *SnapshotCreate) SetPeriodSince(v time.Time) *SnapshotCreate {
+	_c.mutation.SetPeriodSince(v)
+	return _c
+}
+
+// SetNillablePeriodSince sets the "period_since" field if the given value is not nil.
+func (_c *SnapshotCreate) SetNillablePeriodSince(v *time.Time) *SnapshotCreate {
+	if v != nil {
+		_c.SetPeriodSince(*v)
+	}
+	return _c
+}
+
+// SetPeriodUntil sets the "period_until" field.
+func (_c *SnapshotCreate) SetPeriodUntil(v time.Time) *SnapshotCreate {
+	_c.mutation.SetPeriodUntil(v)
+	return _c
+}
+
+// SetNillablePeriodUntil sets the "period_until" field if the given value is not nil.
+func (_c *SnapshotCreate) SetNillablePeriodUntil(v *time.Time) *SnapshotCreate {
+	if v != nil {
+		_c.SetPeriodUntil(*v)
+	}
+	return _c
+}
+
+// SetLookbackYears sets the "lookback_years" field.
+func (_c *SnapshotCreate) SetLookbackYears(v int) *SnapshotCreate {
+	_c.mutation.SetLookbackYears(v)
+	return _c
+}
+
+// SetNillableLookbackYears sets the "lookback_years" field if the given value is not nil.
+func (_c *SnapshotCreate) SetNillableLookbackYears(v *int) *SnapshotCreate {
+	if v != nil {
+		_c.SetLookbackYears(*v)
+	}
+	return _c
+}
+
 // AddMemberStatIDs adds the "member_stats" edge to the MemberStat entity by IDs.
 func (_c *SnapshotCreate) AddMemberStatIDs(ids ...int) *SnapshotCreate {
 	_c.mutation.AddMemberStatIDs(ids...)
@@ -130,6 +195,141 @@ func (_c *SnapshotCreate) AddRepoMetas(v ...*RepoMeta) *SnapshotCreate {
 	return _c.AddRepoMetaIDs(ids...)
 }
 
+// AddMemberPullRequestIDs adds the "member_pull_requests" edge to the MemberPullRequest entity by IDs.
+func (_c *SnapshotCreate) AddMemberPullRequestIDs(ids ...int) *SnapshotCreate {
+	_c.mutation.AddMemberPullRequestIDs(ids...)
+	return _c
+}
+
+// AddMemberPullRequests adds the "member_pull_requests" edges to the MemberPullRequest entity.
+func (_c *SnapshotCreate) AddMemberPullRequests(v ...*MemberPullRequest) *SnapshotCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMemberPullRequestIDs(ids...)
+}
+
+// AddReviewEdgeIDs adds the "review_edges" edge to the ReviewEdge entity by IDs.
+func (_c *SnapshotCreate) AddReviewEdgeIDs(ids ...int) *SnapshotCreate {
+	_c.mutation.AddReviewEdgeIDs(ids...)
+	return _c
+}
+
+// AddReviewEdges adds the "review_edges" edges to the ReviewEdge entity.
+func (_c *SnapshotCreate) AddReviewEdges(v ...*ReviewEdge) *SnapshotCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReviewEdgeIDs(ids...)
+}
+
+// AddMemberDataGapIDs adds the "member_data_gaps" edge to the MemberDataGap entity by IDs.
+func (_c *SnapshotCreate) AddMemberDataGapIDs(ids ...int) *SnapshotCreate {
+	_c.mutation.AddMemberDataGapIDs(ids...)
+	return _c
+}
+
+// AddMemberDataGaps adds the "member_data_gaps" edges to the MemberDataGap entity.
+func (_c *SnapshotCreate) AddMemberDataGaps(v ...*MemberDataGap) *SnapshotCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMemberDataGapIDs(ids...)
+}
+
+// AddExcludedMemberIDs adds the "excluded_members" edge to the ExcludedMember entity by IDs.
+func (_c *SnapshotCreate) AddExcludedMemberIDs(ids ...int) *SnapshotCreate {
+	_c.mutation.AddExcludedMemberIDs(ids...)
+	return _c
+}
+
+// AddExcludedMembers adds the "excluded_members" edges to the ExcludedMember entity.
+func (_c *SnapshotCreate) AddExcludedMembers(v ...*ExcludedMember) *SnapshotCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddExcludedMemberIDs(ids...)
+}
+
+// AddMemberAccountIDs adds the "member_accounts" edge to the MemberAccount entity by IDs.
+func (_c *SnapshotCreate) AddMemberAccountIDs(ids ...int) *SnapshotCreate {
+	_c.mutation.AddMemberAccountIDs(ids...)
+	return _c
+}
+
+// AddMemberAccounts adds the "member_accounts" edges to the MemberAccount entity.
+func (_c *SnapshotCreate) AddMemberAccounts(v ...*MemberAccount) *SnapshotCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMemberAccountIDs(ids...)
+}
+
+// AddRepoDeliveryWeekIDs adds the "repo_delivery_weeks" edge to the RepoDeliveryWeek entity by IDs.
+func (_c *SnapshotCreate) AddRepoDeliveryWeekIDs(ids ...int) *SnapshotCreate {
+	_c.mutation.AddRepoDeliveryWeekIDs(ids...)
+	return _c
+}
+
+// AddRepoDeliveryWeeks adds the "repo_delivery_weeks" edges to the RepoDeliveryWeek entity.
+func (_c *SnapshotCreate) AddRepoDeliveryWeeks(v ...*RepoDeliveryWeek) *SnapshotCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRepoDeliveryWeekIDs(ids...)
+}
+
+// AddMemberIssueIDs adds the "member_issues" edge to the MemberIssue entity by IDs.
+func (_c *SnapshotCreate) AddMemberIssueIDs(ids ...int) *SnapshotCreate {
+	_c.mutation.AddMemberIssueIDs(ids...)
+	return _c
+}
+
+// AddMemberIssues adds the "member_issues" edges to the MemberIssue entity.
+func (_c *SnapshotCreate) AddMemberIssues(v ...*MemberIssue) *SnapshotCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMemberIssueIDs(ids...)
+}
+
+// AddMemberReviewRequestIDs adds the "member_review_requests" edge to the MemberReviewRequest entity by IDs.
+func (_c *SnapshotCreate) AddMemberReviewRequestIDs(ids ...int) *SnapshotCreate {
+	_c.mutation.AddMemberReviewRequestIDs(ids...)
+	return _c
+}
+
+// AddMemberReviewRequests adds the "member_review_requests" edges to the MemberReviewRequest entity.
+func (_c *SnapshotCreate) AddMemberReviewRequests(v ...*MemberReviewRequest) *SnapshotCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMemberReviewRequestIDs(ids...)
+}
+
+// AddMemberHourStatIDs adds the "member_hour_stats" edge to the MemberHourStat entity by IDs.
+func (_c *SnapshotCreate) AddMemberHourStatIDs(ids ...int) *SnapshotCreate {
+	_c.mutation.AddMemberHourStatIDs(ids...)
+	return _c
+}
+
+// AddMemberHourStats adds the "member_hour_stats" edges to the MemberHourStat entity.
+func (_c *SnapshotCreate) AddMemberHourStats(v ...*MemberHourStat) *SnapshotCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMemberHourStatIDs(ids...)
+}
+
 // Mutation returns the SnapshotMutation object of the builder.
 func (_c *SnapshotCreate) Mutation() *SnapshotMutation {
 	return _c.mutation
@@ -169,6 +369,10 @@ func (_c *SnapshotCreate) defaults() {
 		v := snapshot.DefaultCapturedAt()
 		_c.mutation.SetCapturedAt(v)
 	}
+	if _, ok := _c.mutation.LookbackYears(); !ok {
+		v := snapshot.DefaultLookbackYears
+		_c.mutation.SetLookbackYears(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -176,6 +380,9 @@ func (_c *SnapshotCreate) check() error {
 	if _, ok := _c.mutation.CapturedAt(); !ok {
 		return &ValidationError{Name: "captured_at", err: errors.New(`ent: missing required field "Snapshot.captured_at"`)}
 	}
+	if _, ok := _c.mutation.LookbackYears(); !ok {
+		return &ValidationError{Name: "lookback_years", err: errors.New(`ent: missing required field "Snapshot.lookback_years"`)}
+	}
 	return nil
 }
 
@@ -206,6 +413,22 @@ func (_c *SnapshotCreate) createSpec() (*Snapshot, *sqlgraph.CreateSpec) {
 		_spec.SetField(snapshot.FieldCapturedAt, field.TypeTime, value)
 		_node.CapturedAt = value
 	}
+	if value, ok := _c.mutation.Tag(); ok {
+		_spec.SetField(snapshot.FieldTag, field.TypeString, value)
+		_node.Tag = &value
+	}
+	if value, ok := _c.mutation.PeriodSince(); ok {
+		_spec.SetField(snapshot.FieldPeriodSince, field.TypeTime, value)
+		_node.PeriodSince = &value
+	}
+	if value, ok := _c.mutation.PeriodUntil(); ok {
+		_spec.SetField(snapshot.FieldPeriodUntil, field.TypeTime, value)
+		_node.PeriodUntil = &value
+	}
+	if value, ok := _c.mutation.LookbackYears(); ok {
+		_spec.SetField(snapshot.FieldLookbackYears, field.TypeInt, value)
+		_node.LookbackYears = value
+	}
 	if nodes := _c.mutation.MemberStatsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -302,6 +525,150 @@ func (_c *SnapshotCreate) createSpec() (*Snapshot, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.MemberPullRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberPullRequestsTable,
+			Columns: []string{snapshot.MemberPullRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberpullrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReviewEdgesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.ReviewEdgesTable,
+			Columns: []string{snapshot.ReviewEdgesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reviewedge.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MemberDataGapsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberDataGapsTable,
+			Columns: []string{snapshot.MemberDataGapsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberdatagap.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ExcludedMembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.ExcludedMembersTable,
+			Columns: []string{snapshot.ExcludedMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(excludedmember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MemberAccountsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberAccountsTable,
+			Columns: []string{snapshot.MemberAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberaccount.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RepoDeliveryWeeksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.RepoDeliveryWeeksTable,
+			Columns: []string{snapshot.RepoDeliveryWeeksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(repodeliveryweek.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MemberIssuesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberIssuesTable,
+			Columns: []string{snapshot.MemberIssuesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberissue.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MemberReviewRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberReviewRequestsTable,
+			Columns: []string{snapshot.MemberReviewRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberreviewrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MemberHourStatsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberHourStatsTable,
+			Columns: []string{snapshot.MemberHourStatsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberhourstat.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
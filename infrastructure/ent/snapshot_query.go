@@ -12,29 +12,48 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/excludedmember"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberaccount"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberdatagap"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/memberdaystat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberhourstat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberissue"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberpullrequest"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/memberrepodaystat"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/memberrepostat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberreviewrequest"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/memberstat"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/memberyearstat"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/predicate"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/repodeliveryweek"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/repometa"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/reviewedge"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/snapshot"
 )
 
 // SnapshotQuery is the builder for querying Snapshot entities.
 type SnapshotQuery struct {
 	config
-	ctx                    *QueryContext
-	order                  []snapshot.OrderOption
-	inters                 []Interceptor
-	predicates             []predicate.Snapshot
-	withMemberStats        *MemberStatQuery
-	withMemberYearStats    *MemberYearStatQuery
-	withMemberDayStats     *MemberDayStatQuery
-	withMemberRepoStats    *MemberRepoStatQuery
-	withMemberRepoDayStats *MemberRepoDayStatQuery
-	withRepoMetas          *RepoMetaQuery
+	ctx                      *QueryContext
+	order                    []snapshot.OrderOption
+	inters                   []Interceptor
+	predicates               []predicate.Snapshot
+	withMemberStats          *MemberStatQuery
+	withMemberYearStats      *MemberYearStatQuery
+	withMemberDayStats       *MemberDayStatQuery
+	withMemberRepoStats      *MemberRepoStatQuery
+	withMemberRepoDayStats   *MemberRepoDayStatQuery
+	withRepoMetas            *RepoMetaQuery
+	withMemberPullRequests   *MemberPullRequestQuery
+	withReviewEdges          *ReviewEdgeQuery
+	withMemberDataGaps       *MemberDataGapQuery
+	withExcludedMembers      *ExcludedMemberQuery
+	withMemberAccounts       *MemberAccountQuery
+	withRepoDeliveryWeeks    *RepoDeliveryWeekQuery
+	withMemberIssues         *MemberIssueQuery
+	withMemberReviewRequests *MemberReviewRequestQuery
+	withMemberHourStats      *MemberHourStatQuery
+	modifiers                []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -203,6 +222,204 @@ func (_q *SnapshotQuery) QueryRepoMetas() *RepoMetaQuery {
 	return query
 }
 
+// QueryMemberPullRequests chains the current query on the "member_pull_requests" edge.
+func (_q *SnapshotQuery) QueryMemberPullRequests() *MemberPullRequestQuery {
+	query := (&MemberPullRequestClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(snapshot.Table, snapshot.FieldID, selector),
+			sqlgraph.To(memberpullrequest.Table, memberpullrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, snapshot.MemberPullRequestsTable, snapshot.MemberPullRequestsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReviewEdges chains the current query on the "review_edges" edge.
+func (_q *SnapshotQuery) QueryReviewEdges() *ReviewEdgeQuery {
+	query := (&ReviewEdgeClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(snapshot.Table, snapshot.FieldID, selector),
+			sqlgraph.To(reviewedge.Table, reviewedge.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, snapshot.ReviewEdgesTable, snapshot.ReviewEdgesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMemberDataGaps chains the current query on the "member_data_gaps" edge.
+func (_q *SnapshotQuery) QueryMemberDataGaps() *MemberDataGapQuery {
+	query := (&MemberDataGapClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(snapshot.Table, snapshot.FieldID, selector),
+			sqlgraph.To(memberdatagap.Table, memberdatagap.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, snapshot.MemberDataGapsTable, snapshot.MemberDataGapsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryExcludedMembers chains the current query on the "excluded_members" edge.
+func (_q *SnapshotQuery) QueryExcludedMembers() *ExcludedMemberQuery {
+	query := (&ExcludedMemberClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(snapshot.Table, snapshot.FieldID, selector),
+			sqlgraph.To(excludedmember.Table, excludedmember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, snapshot.ExcludedMembersTable, snapshot.ExcludedMembersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMemberAccounts chains the current query on the "member_accounts" edge.
+func (_q *SnapshotQuery) QueryMemberAccounts() *MemberAccountQuery {
+	query := (&MemberAccountClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(snapshot.Table, snapshot.FieldID, selector),
+			sqlgraph.To(memberaccount.Table, memberaccount.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, snapshot.MemberAccountsTable, snapshot.MemberAccountsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRepoDeliveryWeeks chains the current query on the "repo_delivery_weeks" edge.
+func (_q *SnapshotQuery) QueryRepoDeliveryWeeks() *RepoDeliveryWeekQuery {
+	query := (&RepoDeliveryWeekClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(snapshot.Table, snapshot.FieldID, selector),
+			sqlgraph.To(repodeliveryweek.Table, repodeliveryweek.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, snapshot.RepoDeliveryWeeksTable, snapshot.RepoDeliveryWeeksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMemberIssues chains the current query on the "member_issues" edge.
+func (_q *SnapshotQuery) QueryMemberIssues() *MemberIssueQuery {
+	query := (&MemberIssueClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(snapshot.Table, snapshot.FieldID, selector),
+			sqlgraph.To(memberissue.Table, memberissue.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, snapshot.MemberIssuesTable, snapshot.MemberIssuesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMemberReviewRequests chains the current query on the "member_review_requests" edge.
+func (_q *SnapshotQuery) QueryMemberReviewRequests() *MemberReviewRequestQuery {
+	query := (&MemberReviewRequestClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(snapshot.Table, snapshot.FieldID, selector),
+			sqlgraph.To(memberreviewrequest.Table, memberreviewrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, snapshot.MemberReviewRequestsTable, snapshot.MemberReviewRequestsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMemberHourStats chains the current query on the "member_hour_stats" edge.
+func (_q *SnapshotQuery) QueryMemberHourStats() *MemberHourStatQuery {
+	query := (&MemberHourStatClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(snapshot.Table, snapshot.FieldID, selector),
+			sqlgraph.To(memberhourstat.Table, memberhourstat.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, snapshot.MemberHourStatsTable, snapshot.MemberHourStatsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Snapshot entity from the query.
 // Returns a *NotFoundError when no Snapshot was found.
 func (_q *SnapshotQuery) First(ctx context.Context) (*Snapshot, error) {
@@ -390,17 +607,27 @@ func (_q *SnapshotQuery) Clone() *SnapshotQuery {
 		return nil
 	}
 	return &SnapshotQuery{
-		config:                 _q.config,
-		ctx:                    _q.ctx.Clone(),
-		order:                  append([]snapshot.OrderOption{}, _q.order...),
-		inters:                 append([]Interceptor{}, _q.inters...),
-		predicates:             append([]predicate.Snapshot{}, _q.predicates...),
-		withMemberStats:        _q.withMemberStats.Clone(),
-		withMemberYearStats:    _q.withMemberYearStats.Clone(),
-		withMemberDayStats:     _q.withMemberDayStats.Clone(),
-		withMemberRepoStats:    _q.withMemberRepoStats.Clone(),
-		withMemberRepoDayStats: _q.withMemberRepoDayStats.Clone(),
-		withRepoMetas:          _q.withRepoMetas.Clone(),
+		config:                   _q.config,
+		ctx:                      _q.ctx.Clone(),
+		order:                    append([]snapshot.OrderOption{}, _q.order...),
+		inters:                   append([]Interceptor{}, _q.inters...),
+		predicates:               append([]predicate.Snapshot{}, _q.predicates...),
+		withMemberStats:          _q.withMemberStats.Clone(),
+		withMemberYearStats:      _q.withMemberYearStats.Clone(),
+		withMemberDayStats:       _q.withMemberDayStats.Clone(),
+		withMemberRepoStats:      _q.withMemberRepoStats.Clone(),
+		withMemberRepoDayStats:   _q.withMemberRepoDayStats.Clone(),
+		withRepoMetas:            _q.withRepoMetas.Clone(),
+		withMemberPullRequests:   _q.withMemberPullRequests.Clone(),
+		withReviewEdges:          _q.withReviewEdges.Clone(),
+		withMemberDataGaps:       _q.withMemberDataGaps.Clone(),
+		withExcludedMembers:      _q.withExcludedMembers.Clone(),
+		withMemberAccounts:       _q.withMemberAccounts.Clone(),
+		withRepoDeliveryWeeks:    _q.withRepoDeliveryWeeks.Clone(),
+		withMemberIssues:         _q.withMemberIssues.Clone(),
+		withMemberReviewRequests: _q.withMemberReviewRequests.Clone(),
+		withMemberHourStats:      _q.withMemberHourStats.Clone(),
+		modifiers:                append([]func(*sql.Selector){}, _q.modifiers...),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -473,6 +700,105 @@ func (_q *SnapshotQuery) WithRepoMetas(opts ...func(*RepoMetaQuery)) *SnapshotQu
 	return _q
 }
 
+// WithMemberPullRequests tells the query-builder to eager-load the nodes that are connected to
+// the "member_pull_requests" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *SnapshotQuery) WithMemberPullRequests(opts ...func(*MemberPullRequestQuery)) *SnapshotQuery {
+	query := (&MemberPullRequestClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMemberPullRequests = query
+	return _q
+}
+
+// WithReviewEdges tells the query-builder to eager-load the nodes that are connected to
+// the "review_edges" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *SnapshotQuery) WithReviewEdges(opts ...func(*ReviewEdgeQuery)) *SnapshotQuery {
+	query := (&ReviewEdgeClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReviewEdges = query
+	return _q
+}
+
+// WithMemberDataGaps tells the query-builder to eager-load the nodes that are connected to
+// the "member_data_gaps" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *SnapshotQuery) WithMemberDataGaps(opts ...func(*MemberDataGapQuery)) *SnapshotQuery {
+	query := (&MemberDataGapClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMemberDataGaps = query
+	return _q
+}
+
+// WithExcludedMembers tells the query-builder to eager-load the nodes that are connected to
+// the "excluded_members" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *SnapshotQuery) WithExcludedMembers(opts ...func(*ExcludedMemberQuery)) *SnapshotQuery {
+	query := (&ExcludedMemberClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withExcludedMembers = query
+	return _q
+}
+
+// WithMemberAccounts tells the query-builder to eager-load the nodes that are connected to
+// the "member_accounts" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *SnapshotQuery) WithMemberAccounts(opts ...func(*MemberAccountQuery)) *SnapshotQuery {
+	query := (&MemberAccountClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMemberAccounts = query
+	return _q
+}
+
+// WithRepoDeliveryWeeks tells the query-builder to eager-load the nodes that are connected to
+// the "repo_delivery_weeks" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *SnapshotQuery) WithRepoDeliveryWeeks(opts ...func(*RepoDeliveryWeekQuery)) *SnapshotQuery {
+	query := (&RepoDeliveryWeekClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRepoDeliveryWeeks = query
+	return _q
+}
+
+// WithMemberIssues tells the query-builder to eager-load the nodes that are connected to
+// the "member_issues" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *SnapshotQuery) WithMemberIssues(opts ...func(*MemberIssueQuery)) *SnapshotQuery {
+	query := (&MemberIssueClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMemberIssues = query
+	return _q
+}
+
+// WithMemberReviewRequests tells the query-builder to eager-load the nodes that are connected to
+// the "member_review_requests" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *SnapshotQuery) WithMemberReviewRequests(opts ...func(*MemberReviewRequestQuery)) *SnapshotQuery {
+	query := (&MemberReviewRequestClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMemberReviewRequests = query
+	return _q
+}
+
+// WithMemberHourStats tells the query-builder to eager-load the nodes that are connected to
+// the "member_hour_stats" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *SnapshotQuery) WithMemberHourStats(opts ...func(*MemberHourStatQuery)) *SnapshotQuery {
+	query := (&MemberHourStatClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMemberHourStats = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -551,13 +877,22 @@ func (_q *SnapshotQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Sna
 	var (
 		nodes       = []*Snapshot{}
 		_spec       = _q.querySpec()
-		loadedTypes = [6]bool{
+		loadedTypes = [15]bool{
 			_q.withMemberStats != nil,
 			_q.withMemberYearStats != nil,
 			_q.withMemberDayStats != nil,
 			_q.withMemberRepoStats != nil,
 			_q.withMemberRepoDayStats != nil,
 			_q.withRepoMetas != nil,
+			_q.withMemberPullRequests != nil,
+			_q.withReviewEdges != nil,
+			_q.withMemberDataGaps != nil,
+			_q.withExcludedMembers != nil,
+			_q.withMemberAccounts != nil,
+			_q.withRepoDeliveryWeeks != nil,
+			_q.withMemberIssues != nil,
+			_q.withMemberReviewRequests != nil,
+			_q.withMemberHourStats != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -569,6 +904,9 @@ func (_q *SnapshotQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Sna
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -622,6 +960,75 @@ func (_q *SnapshotQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Sna
 			return nil, err
 		}
 	}
+	if query := _q.withMemberPullRequests; query != nil {
+		if err := _q.loadMemberPullRequests(ctx, query, nodes,
+			func(n *Snapshot) { n.Edges.MemberPullRequests = []*MemberPullRequest{} },
+			func(n *Snapshot, e *MemberPullRequest) {
+				n.Edges.MemberPullRequests = append(n.Edges.MemberPullRequests, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withReviewEdges; query != nil {
+		if err := _q.loadReviewEdges(ctx, query, nodes,
+			func(n *Snapshot) { n.Edges.ReviewEdges = []*ReviewEdge{} },
+			func(n *Snapshot, e *ReviewEdge) { n.Edges.ReviewEdges = append(n.Edges.ReviewEdges, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withMemberDataGaps; query != nil {
+		if err := _q.loadMemberDataGaps(ctx, query, nodes,
+			func(n *Snapshot) { n.Edges.MemberDataGaps = []*MemberDataGap{} },
+			func(n *Snapshot, e *MemberDataGap) { n.Edges.MemberDataGaps = append(n.Edges.MemberDataGaps, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withExcludedMembers; query != nil {
+		if err := _q.loadExcludedMembers(ctx, query, nodes,
+			func(n *Snapshot) { n.Edges.ExcludedMembers = []*ExcludedMember{} },
+			func(n *Snapshot, e *ExcludedMember) { n.Edges.ExcludedMembers = append(n.Edges.ExcludedMembers, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withMemberAccounts; query != nil {
+		if err := _q.loadMemberAccounts(ctx, query, nodes,
+			func(n *Snapshot) { n.Edges.MemberAccounts = []*MemberAccount{} },
+			func(n *Snapshot, e *MemberAccount) { n.Edges.MemberAccounts = append(n.Edges.MemberAccounts, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRepoDeliveryWeeks; query != nil {
+		if err := _q.loadRepoDeliveryWeeks(ctx, query, nodes,
+			func(n *Snapshot) { n.Edges.RepoDeliveryWeeks = []*RepoDeliveryWeek{} },
+			func(n *Snapshot, e *RepoDeliveryWeek) {
+				n.Edges.RepoDeliveryWeeks = append(n.Edges.RepoDeliveryWeeks, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withMemberIssues; query != nil {
+		if err := _q.loadMemberIssues(ctx, query, nodes,
+			func(n *Snapshot) { n.Edges.MemberIssues = []*MemberIssue{} },
+			func(n *Snapshot, e *MemberIssue) { n.Edges.MemberIssues = append(n.Edges.MemberIssues, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withMemberReviewRequests; query != nil {
+		if err := _q.loadMemberReviewRequests(ctx, query, nodes,
+			func(n *Snapshot) { n.Edges.MemberReviewRequests = []*MemberReviewRequest{} },
+			func(n *Snapshot, e *MemberReviewRequest) {
+				n.Edges.MemberReviewRequests = append(n.Edges.MemberReviewRequests, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withMemberHourStats; query != nil {
+		if err := _q.loadMemberHourStats(ctx, query, nodes,
+			func(n *Snapshot) { n.Edges.MemberHourStats = []*MemberHourStat{} },
+			func(n *Snapshot, e *MemberHourStat) { n.Edges.MemberHourStats = append(n.Edges.MemberHourStats, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -811,9 +1218,291 @@ func (_q *SnapshotQuery) loadRepoMetas(ctx context.Context, query *RepoMetaQuery
 	}
 	return nil
 }
+func (_q *SnapshotQuery) loadMemberPullRequests(ctx context.Context, query *MemberPullRequestQuery, nodes []*Snapshot, init func(*Snapshot), assign func(*Snapshot, *MemberPullRequest)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Snapshot)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.MemberPullRequest(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(snapshot.MemberPullRequestsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.snapshot_member_pull_requests
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "snapshot_member_pull_requests" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "snapshot_member_pull_requests" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *SnapshotQuery) loadReviewEdges(ctx context.Context, query *ReviewEdgeQuery, nodes []*Snapshot, init func(*Snapshot), assign func(*Snapshot, *ReviewEdge)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Snapshot)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ReviewEdge(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(snapshot.ReviewEdgesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.snapshot_review_edges
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "snapshot_review_edges" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "snapshot_review_edges" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *SnapshotQuery) loadMemberDataGaps(ctx context.Context, query *MemberDataGapQuery, nodes []*Snapshot, init func(*Snapshot), assign func(*Snapshot, *MemberDataGap)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Snapshot)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.MemberDataGap(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(snapshot.MemberDataGapsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.snapshot_member_data_gaps
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "snapshot_member_data_gaps" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "snapshot_member_data_gaps" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *SnapshotQuery) loadExcludedMembers(ctx context.Context, query *ExcludedMemberQuery, nodes []*Snapshot, init func(*Snapshot), assign func(*Snapshot, *ExcludedMember)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Snapshot)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ExcludedMember(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(snapshot.ExcludedMembersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.snapshot_excluded_members
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "snapshot_excluded_members" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "snapshot_excluded_members" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *SnapshotQuery) loadMemberAccounts(ctx context.Context, query *MemberAccountQuery, nodes []*Snapshot, init func(*Snapshot), assign func(*Snapshot, *MemberAccount)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Snapshot)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.MemberAccount(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(snapshot.MemberAccountsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.snapshot_member_accounts
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "snapshot_member_accounts" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "snapshot_member_accounts" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *SnapshotQuery) loadRepoDeliveryWeeks(ctx context.Context, query *RepoDeliveryWeekQuery, nodes []*Snapshot, init func(*Snapshot), assign func(*Snapshot, *RepoDeliveryWeek)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Snapshot)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.RepoDeliveryWeek(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(snapshot.RepoDeliveryWeeksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.snapshot_repo_delivery_weeks
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "snapshot_repo_delivery_weeks" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "snapshot_repo_delivery_weeks" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *SnapshotQuery) loadMemberIssues(ctx context.Context, query *MemberIssueQuery, nodes []*Snapshot, init func(*Snapshot), assign func(*Snapshot, *MemberIssue)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Snapshot)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.MemberIssue(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(snapshot.MemberIssuesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.snapshot_member_issues
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "snapshot_member_issues" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "snapshot_member_issues" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *SnapshotQuery) loadMemberReviewRequests(ctx context.Context, query *MemberReviewRequestQuery, nodes []*Snapshot, init func(*Snapshot), assign func(*Snapshot, *MemberReviewRequest)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Snapshot)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.MemberReviewRequest(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(snapshot.MemberReviewRequestsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.snapshot_member_review_requests
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "snapshot_member_review_requests" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "snapshot_member_review_requests" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *SnapshotQuery) loadMemberHourStats(ctx context.Context, query *MemberHourStatQuery, nodes []*Snapshot, init func(*Snapshot), assign func(*Snapshot, *MemberHourStat)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Snapshot)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.MemberHourStat(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(snapshot.MemberHourStatsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.snapshot_member_hour_stats
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "snapshot_member_hour_stats" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "snapshot_member_hour_stats" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *SnapshotQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -876,6 +1565,9 @@ func (_q *SnapshotQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -893,6 +1585,12 @@ func (_q *SnapshotQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *SnapshotQuery) Modify(modifiers ...func(s *sql.Selector)) *SnapshotSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // SnapshotGroupBy is the group-by builder for Snapshot entities.
 type SnapshotGroupBy struct {
 	selector
@@ -982,3 +1680,9 @@ func (_s *SnapshotSelect) sqlScan(ctx context.Context, root *SnapshotQuery, v an
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *SnapshotSelect) Modify(modifiers ...func(s *sql.Selector)) *SnapshotSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
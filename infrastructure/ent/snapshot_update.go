@@ -6,17 +6,27 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/excludedmember"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberaccount"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberdatagap"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/memberdaystat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberhourstat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberissue"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberpullrequest"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/memberrepodaystat"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/memberrepostat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberreviewrequest"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/memberstat"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/memberyearstat"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/predicate"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/repodeliveryweek"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/repometa"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/reviewedge"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/snapshot"
 )
 
@@ -33,6 +43,87 @@ func (_u *SnapshotUpdate) Where(ps ...predicate.Snapshot) *SnapshotUpdate {
 	return _u
 }
 
+// SetTag sets the "tag" field.
+func (_u *SnapshotUpdate) SetTag(v string) *SnapshotUpdate {
+	_u.mutation.SetTag(v)
+	return _u
+}
+
+// SetNillableTag sets the "tag" field if the given value is not nil.
+func (_u *SnapshotUpdate) SetNillableTag(v *string) *SnapshotUpdate {
+	if v != nil {
+		_u.SetTag(*v)
+	}
+	return _u
+}
+
+// ClearTag clears the value of the "tag" field.
+func (_u *SnapshotUpdate) ClearTag() *SnapshotUpdate {
+	_u.mutation.ClearTag()
+	return _u
+}
+
+// SetPeriodSince sets the "period_since" field.
+func (_u *SnapshotUpdate) SetPeriodSince(v time.Time) *SnapshotUpdate {
+	_u.mutation.SetPeriodSince(v)
+	return _u
+}
+
+// SetNillablePeriodSince sets the "period_since" field if the given value is not nil.
+func (_u *SnapshotUpdate) SetNillablePeriodSince(v *time.Time) *SnapshotUpdate {
+	if v != nil {
+		_u.SetPeriodSince(*v)
+	}
+	return _u
+}
+
+// ClearPeriodSince clears the value of the "period_since" field.
+func (_u *SnapshotUpdate) ClearPeriodSince() *SnapshotUpdate {
+	_u.mutation.ClearPeriodSince()
+	return _u
+}
+
+// SetPeriodUntil sets the "period_until" field.
+func (_u *SnapshotUpdate) SetPeriodUntil(v time.Time) *SnapshotUpdate {
+	_u.mutation.SetPeriodUntil(v)
+	return _u
+}
+
+// SetNillablePeriodUntil sets the "period_until" field if the given value is not nil.
+func (_u *SnapshotUpdate) SetNillablePeriodUntil(v *time.Time) *SnapshotUpdate {
+	if v != nil {
+		_u.SetPeriodUntil(*v)
+	}
+	return _u
+}
+
+// ClearPeriodUntil clears the value of the "period_until" field.
+func (_u *SnapshotUpdate) ClearPeriodUntil() *SnapshotUpdate {
+	_u.mutation.ClearPeriodUntil()
+	return _u
+}
+
+// SetLookbackYears sets the "lookback_years" field.
+func (_u *SnapshotUpdate) SetLookbackYears(v int) *SnapshotUpdate {
+	_u.mutation.ResetLookbackYears()
+	_u.mutation.SetLookbackYears(v)
+	return _u
+}
+
+// SetNillableLookbackYears sets the "lookback_years" field if the given value is not nil.
+func (_u *SnapshotUpdate) SetNillableLookbackYears(v *int) *SnapshotUpdate {
+	if v != nil {
+		_u.SetLookbackYears(*v)
+	}
+	return _u
+}
+
+// AddLookbackYears adds value to the "lookback_years" field.
+func (_u *SnapshotUpdate) AddLookbackYears(v int) *SnapshotUpdate {
+	_u.mutation.AddLookbackYears(v)
+	return _u
+}
+
 // AddMemberStatIDs adds the "member_stats" edge to the MemberStat entity by IDs.
 func (_u *SnapshotUpdate) AddMemberStatIDs(ids ...int) *SnapshotUpdate {
 	_u.mutation.AddMemberStatIDs(ids...)
@@ -123,6 +214,141 @@ func (_u *SnapshotUpdate) AddRepoMetas(v ...*RepoMeta) *SnapshotUpdate {
 	return _u.AddRepoMetaIDs(ids...)
 }
 
+// AddMemberPullRequestIDs adds the "member_pull_requests" edge to the MemberPullRequest entity by IDs.
+func (_u *SnapshotUpdate) AddMemberPullRequestIDs(ids ...int) *SnapshotUpdate {
+	_u.mutation.AddMemberPullRequestIDs(ids...)
+	return _u
+}
+
+// AddMemberPullRequests adds the "member_pull_requests" edges to the MemberPullRequest entity.
+func (_u *SnapshotUpdate) AddMemberPullRequests(v ...*MemberPullRequest) *SnapshotUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMemberPullRequestIDs(ids...)
+}
+
+// AddReviewEdgeIDs adds the "review_edges" edge to the ReviewEdge entity by IDs.
+func (_u *SnapshotUpdate) AddReviewEdgeIDs(ids ...int) *SnapshotUpdate {
+	_u.mutation.AddReviewEdgeIDs(ids...)
+	return _u
+}
+
+// AddReviewEdges adds the "review_edges" edges to the ReviewEdge entity.
+func (_u *SnapshotUpdate) AddReviewEdges(v ...*ReviewEdge) *SnapshotUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReviewEdgeIDs(ids...)
+}
+
+// AddMemberDataGapIDs adds the "member_data_gaps" edge to the MemberDataGap entity by IDs.
+func (_u *SnapshotUpdate) AddMemberDataGapIDs(ids ...int) *SnapshotUpdate {
+	_u.mutation.AddMemberDataGapIDs(ids...)
+	return _u
+}
+
+// AddMemberDataGaps adds the "member_data_gaps" edges to the MemberDataGap entity.
+func (_u *SnapshotUpdate) AddMemberDataGaps(v ...*MemberDataGap) *SnapshotUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMemberDataGapIDs(ids...)
+}
+
+// AddExcludedMemberIDs adds the "excluded_members" edge to the ExcludedMember entity by IDs.
+func (_u *SnapshotUpdate) AddExcludedMemberIDs(ids ...int) *SnapshotUpdate {
+	_u.mutation.AddExcludedMemberIDs(ids...)
+	return _u
+}
+
+// AddExcludedMembers adds the "excluded_members" edges to the ExcludedMember entity.
+func (_u *SnapshotUpdate) AddExcludedMembers(v ...*ExcludedMember) *SnapshotUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddExcludedMemberIDs(ids...)
+}
+
+// AddMemberAccountIDs adds the "member_accounts" edge to the MemberAccount entity by IDs.
+func (_u *SnapshotUpdate) AddMemberAccountIDs(ids ...int) *SnapshotUpdate {
+	_u.mutation.AddMemberAccountIDs(ids...)
+	return _u
+}
+
+// AddMemberAccounts adds the "member_accounts" edges to the MemberAccount entity.
+func (_u *SnapshotUpdate) AddMemberAccounts(v ...*MemberAccount) *SnapshotUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMemberAccountIDs(ids...)
+}
+
+// AddRepoDeliveryWeekIDs adds the "repo_delivery_weeks" edge to the RepoDeliveryWeek entity by IDs.
+func (_u *SnapshotUpdate) AddRepoDeliveryWeekIDs(ids ...int) *SnapshotUpdate {
+	_u.mutation.AddRepoDeliveryWeekIDs(ids...)
+	return _u
+}
+
+// AddRepoDeliveryWeeks adds the "repo_delivery_weeks" edges to the RepoDeliveryWeek entity.
+func (_u *SnapshotUpdate) AddRepoDeliveryWeeks(v ...*RepoDeliveryWeek) *SnapshotUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRepoDeliveryWeekIDs(ids...)
+}
+
+// AddMemberIssueIDs adds the "member_issues" edge to the MemberIssue entity by IDs.
+func (_u *SnapshotUpdate) AddMemberIssueIDs(ids ...int) *SnapshotUpdate {
+	_u.mutation.AddMemberIssueIDs(ids...)
+	return _u
+}
+
+// AddMemberIssues adds the "member_issues" edges to the MemberIssue entity.
+func (_u *SnapshotUpdate) AddMemberIssues(v ...*MemberIssue) *SnapshotUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMemberIssueIDs(ids...)
+}
+
+// AddMemberReviewRequestIDs adds the "member_review_requests" edge to the MemberReviewRequest entity by IDs.
+func (_u *SnapshotUpdate) AddMemberReviewRequestIDs(ids ...int) *SnapshotUpdate {
+	_u.mutation.AddMemberReviewRequestIDs(ids...)
+	return _u
+}
+
+// AddMemberReviewRequests adds the "member_review_requests" edges to the MemberReviewRequest entity.
+func (_u *SnapshotUpdate) AddMemberReviewRequests(v ...*MemberReviewRequest) *SnapshotUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMemberReviewRequestIDs(ids...)
+}
+
+// AddMemberHourStatIDs adds the "member_hour_stats" edge to the MemberHourStat entity by IDs.
+func (_u *SnapshotUpdate) AddMemberHourStatIDs(ids ...int) *SnapshotUpdate {
+	_u.mutation.AddMemberHourStatIDs(ids...)
+	return _u
+}
+
+// AddMemberHourStats adds the "member_hour_stats" edges to the MemberHourStat entity.
+func (_u *SnapshotUpdate) AddMemberHourStats(v ...*MemberHourStat) *SnapshotUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMemberHourStatIDs(ids...)
+}
+
 // Mutation returns the SnapshotMutation object of the builder.
 func (_u *SnapshotUpdate) Mutation() *SnapshotMutation {
 	return _u.mutation
@@ -254,6 +480,195 @@ func (_u *SnapshotUpdate) RemoveRepoMetas(v ...*RepoMeta) *SnapshotUpdate {
 	return _u.RemoveRepoMetaIDs(ids...)
 }
 
+// ClearMemberPullRequests clears all "member_pull_requests" edges to the MemberPullRequest entity.
+func (_u *SnapshotUpdate) ClearMemberPullRequests() *SnapshotUpdate {
+	_u.mutation.ClearMemberPullRequests()
+	return _u
+}
+
+// RemoveMemberPullRequestIDs removes the "member_pull_requests" edge to MemberPullRequest entities by IDs.
+func (_u *SnapshotUpdate) RemoveMemberPullRequestIDs(ids ...int) *SnapshotUpdate {
+	_u.mutation.RemoveMemberPullRequestIDs(ids...)
+	return _u
+}
+
+// RemoveMemberPullRequests removes "member_pull_requests" edges to MemberPullRequest entities.
+func (_u *SnapshotUpdate) RemoveMemberPullRequests(v ...*MemberPullRequest) *SnapshotUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMemberPullRequestIDs(ids...)
+}
+
+// ClearReviewEdges clears all "review_edges" edges to the ReviewEdge entity.
+func (_u *SnapshotUpdate) ClearReviewEdges() *SnapshotUpdate {
+	_u.mutation.ClearReviewEdges()
+	return _u
+}
+
+// RemoveReviewEdgeIDs removes the "review_edges" edge to ReviewEdge entities by IDs.
+func (_u *SnapshotUpdate) RemoveReviewEdgeIDs(ids ...int) *SnapshotUpdate {
+	_u.mutation.RemoveReviewEdgeIDs(ids...)
+	return _u
+}
+
+// RemoveReviewEdges removes "review_edges" edges to ReviewEdge entities.
+func (_u *SnapshotUpdate) RemoveReviewEdges(v ...*ReviewEdge) *SnapshotUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReviewEdgeIDs(ids...)
+}
+
+// ClearMemberDataGaps clears all "member_data_gaps" edges to the MemberDataGap entity.
+func (_u *SnapshotUpdate) ClearMemberDataGaps() *SnapshotUpdate {
+	_u.mutation.ClearMemberDataGaps()
+	return _u
+}
+
+// RemoveMemberDataGapIDs removes the "member_data_gaps" edge to MemberDataGap entities by IDs.
+func (_u *SnapshotUpdate) RemoveMemberDataGapIDs(ids ...int) *SnapshotUpdate {
+	_u.mutation.RemoveMemberDataGapIDs(ids...)
+	return _u
+}
+
+// RemoveMemberDataGaps removes "member_data_gaps" edges to MemberDataGap entities.
+func (_u *SnapshotUpdate) RemoveMemberDataGaps(v ...*MemberDataGap) *SnapshotUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMemberDataGapIDs(ids...)
+}
+
+// ClearExcludedMembers clears all "excluded_members" edges to the ExcludedMember entity.
+func (_u *SnapshotUpdate) ClearExcludedMembers() *SnapshotUpdate {
+	_u.mutation.ClearExcludedMembers()
+	return _u
+}
+
+// RemoveExcludedMemberIDs removes the "excluded_members" edge to ExcludedMember entities by IDs.
+func (_u *SnapshotUpdate) RemoveExcludedMemberIDs(ids ...int) *SnapshotUpdate {
+	_u.mutation.RemoveExcludedMemberIDs(ids...)
+	return _u
+}
+
+// RemoveExcludedMembers removes "excluded_members" edges to ExcludedMember entities.
+func (_u *SnapshotUpdate) RemoveExcludedMembers(v ...*ExcludedMember) *SnapshotUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveExcludedMemberIDs(ids...)
+}
+
+// ClearMemberAccounts clears all "member_accounts" edges to the MemberAccount entity.
+func (_u *SnapshotUpdate) ClearMemberAccounts() *SnapshotUpdate {
+	_u.mutation.ClearMemberAccounts()
+	return _u
+}
+
+// RemoveMemberAccountIDs removes the "member_accounts" edge to MemberAccount entities by IDs.
+func (_u *SnapshotUpdate) RemoveMemberAccountIDs(ids ...int) *SnapshotUpdate {
+	_u.mutation.RemoveMemberAccountIDs(ids...)
+	return _u
+}
+
+// RemoveMemberAccounts removes "member_accounts" edges to MemberAccount entities.
+func (_u *SnapshotUpdate) RemoveMemberAccounts(v ...*MemberAccount) *SnapshotUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMemberAccountIDs(ids...)
+}
+
+// ClearRepoDeliveryWeeks clears all "repo_delivery_weeks" edges to the RepoDeliveryWeek entity.
+func (_u *SnapshotUpdate) ClearRepoDeliveryWeeks() *SnapshotUpdate {
+	_u.mutation.ClearRepoDeliveryWeeks()
+	return _u
+}
+
+// RemoveRepoDeliveryWeekIDs removes the "repo_delivery_weeks" edge to RepoDeliveryWeek entities by IDs.
+func (_u *SnapshotUpdate) RemoveRepoDeliveryWeekIDs(ids ...int) *SnapshotUpdate {
+	_u.mutation.RemoveRepoDeliveryWeekIDs(ids...)
+	return _u
+}
+
+// RemoveRepoDeliveryWeeks removes "repo_delivery_weeks" edges to RepoDeliveryWeek entities.
+func (_u *SnapshotUpdate) RemoveRepoDeliveryWeeks(v ...*RepoDeliveryWeek) *SnapshotUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRepoDeliveryWeekIDs(ids...)
+}
+
+// ClearMemberIssues clears all "member_issues" edges to the MemberIssue entity.
+func (_u *SnapshotUpdate) ClearMemberIssues() *SnapshotUpdate {
+	_u.mutation.ClearMemberIssues()
+	return _u
+}
+
+// RemoveMemberIssueIDs removes the "member_issues" edge to MemberIssue entities by IDs.
+func (_u *SnapshotUpdate) RemoveMemberIssueIDs(ids ...int) *SnapshotUpdate {
+	_u.mutation.RemoveMemberIssueIDs(ids...)
+	return _u
+}
+
+// RemoveMemberIssues removes "member_issues" edges to MemberIssue entities.
+func (_u *SnapshotUpdate) RemoveMemberIssues(v ...*MemberIssue) *SnapshotUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMemberIssueIDs(ids...)
+}
+
+// ClearMemberReviewRequests clears all "member_review_requests" edges to the MemberReviewRequest entity.
+func (_u *SnapshotUpdate) ClearMemberReviewRequests() *SnapshotUpdate {
+	_u.mutation.ClearMemberReviewRequests()
+	return _u
+}
+
+// RemoveMemberReviewRequestIDs removes the "member_review_requests" edge to MemberReviewRequest entities by IDs.
+func (_u *SnapshotUpdate) RemoveMemberReviewRequestIDs(ids ...int) *SnapshotUpdate {
+	_u.mutation.RemoveMemberReviewRequestIDs(ids...)
+	return _u
+}
+
+// RemoveMemberReviewRequests removes "member_review_requests" edges to MemberReviewRequest entities.
+func (_u *SnapshotUpdate) RemoveMemberReviewRequests(v ...*MemberReviewRequest) *SnapshotUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMemberReviewRequestIDs(ids...)
+}
+
+// ClearMemberHourStats clears all "member_hour_stats" edges to the MemberHourStat entity.
+func (_u *SnapshotUpdate) ClearMemberHourStats() *SnapshotUpdate {
+	_u.mutation.ClearMemberHourStats()
+	return _u
+}
+
+// RemoveMemberHourStatIDs removes the "member_hour_stats" edge to MemberHourStat entities by IDs.
+func (_u *SnapshotUpdate) RemoveMemberHourStatIDs(ids ...int) *SnapshotUpdate {
+	_u.mutation.RemoveMemberHourStatIDs(ids...)
+	return _u
+}
+
+// RemoveMemberHourStats removes "member_hour_stats" edges to MemberHourStat entities.
+func (_u *SnapshotUpdate) RemoveMemberHourStats(v ...*MemberHourStat) *SnapshotUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMemberHourStatIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *SnapshotUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -290,6 +705,30 @@ func (_u *SnapshotUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			}
 		}
 	}
+	if value, ok := _u.mutation.Tag(); ok {
+		_spec.SetField(snapshot.FieldTag, field.TypeString, value)
+	}
+	if _u.mutation.TagCleared() {
+		_spec.ClearField(snapshot.FieldTag, field.TypeString)
+	}
+	if value, ok := _u.mutation.PeriodSince(); ok {
+		_spec.SetField(snapshot.FieldPeriodSince, field.TypeTime, value)
+	}
+	if _u.mutation.PeriodSinceCleared() {
+		_spec.ClearField(snapshot.FieldPeriodSince, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PeriodUntil(); ok {
+		_spec.SetField(snapshot.FieldPeriodUntil, field.TypeTime, value)
+	}
+	if _u.mutation.PeriodUntilCleared() {
+		_spec.ClearField(snapshot.FieldPeriodUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LookbackYears(); ok {
+		_spec.SetField(snapshot.FieldLookbackYears, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLookbackYears(); ok {
+		_spec.AddField(snapshot.FieldLookbackYears, field.TypeInt, value)
+	}
 	if _u.mutation.MemberStatsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -560,335 +999,1574 @@ func (_u *SnapshotUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{snapshot.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if _u.mutation.MemberPullRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberPullRequestsTable,
+			Columns: []string{snapshot.MemberPullRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberpullrequest.FieldID, field.TypeInt),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_u.mutation.done = true
-	return _node, nil
-}
-
-// SnapshotUpdateOne is the builder for updating a single Snapshot entity.
-type SnapshotUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *SnapshotMutation
-}
-
-// AddMemberStatIDs adds the "member_stats" edge to the MemberStat entity by IDs.
-func (_u *SnapshotUpdateOne) AddMemberStatIDs(ids ...int) *SnapshotUpdateOne {
-	_u.mutation.AddMemberStatIDs(ids...)
-	return _u
-}
-
-// AddMemberStats adds the "member_stats" edges to the MemberStat entity.
-func (_u *SnapshotUpdateOne) AddMemberStats(v ...*MemberStat) *SnapshotUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedMemberPullRequestsIDs(); len(nodes) > 0 && !_u.mutation.MemberPullRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberPullRequestsTable,
+			Columns: []string{snapshot.MemberPullRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberpullrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddMemberStatIDs(ids...)
-}
-
-// AddMemberYearStatIDs adds the "member_year_stats" edge to the MemberYearStat entity by IDs.
-func (_u *SnapshotUpdateOne) AddMemberYearStatIDs(ids ...int) *SnapshotUpdateOne {
-	_u.mutation.AddMemberYearStatIDs(ids...)
-	return _u
-}
-
-// AddMemberYearStats adds the "member_year_stats" edges to the MemberYearStat entity.
-func (_u *SnapshotUpdateOne) AddMemberYearStats(v ...*MemberYearStat) *SnapshotUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.MemberPullRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberPullRequestsTable,
+			Columns: []string{snapshot.MemberPullRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberpullrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.AddMemberYearStatIDs(ids...)
-}
-
-// AddMemberDayStatIDs adds the "member_day_stats" edge to the MemberDayStat entity by IDs.
-func (_u *SnapshotUpdateOne) AddMemberDayStatIDs(ids ...int) *SnapshotUpdateOne {
-	_u.mutation.AddMemberDayStatIDs(ids...)
-	return _u
-}
-
-// AddMemberDayStats adds the "member_day_stats" edges to the MemberDayStat entity.
-func (_u *SnapshotUpdateOne) AddMemberDayStats(v ...*MemberDayStat) *SnapshotUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.ReviewEdgesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.ReviewEdgesTable,
+			Columns: []string{snapshot.ReviewEdgesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reviewedge.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddMemberDayStatIDs(ids...)
-}
-
-// AddMemberRepoStatIDs adds the "member_repo_stats" edge to the MemberRepoStat entity by IDs.
-func (_u *SnapshotUpdateOne) AddMemberRepoStatIDs(ids ...int) *SnapshotUpdateOne {
-	_u.mutation.AddMemberRepoStatIDs(ids...)
-	return _u
-}
-
-// AddMemberRepoStats adds the "member_repo_stats" edges to the MemberRepoStat entity.
-func (_u *SnapshotUpdateOne) AddMemberRepoStats(v ...*MemberRepoStat) *SnapshotUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedReviewEdgesIDs(); len(nodes) > 0 && !_u.mutation.ReviewEdgesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.ReviewEdgesTable,
+			Columns: []string{snapshot.ReviewEdgesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reviewedge.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddMemberRepoStatIDs(ids...)
-}
-
-// AddMemberRepoDayStatIDs adds the "member_repo_day_stats" edge to the MemberRepoDayStat entity by IDs.
-func (_u *SnapshotUpdateOne) AddMemberRepoDayStatIDs(ids ...int) *SnapshotUpdateOne {
-	_u.mutation.AddMemberRepoDayStatIDs(ids...)
-	return _u
-}
-
-// AddMemberRepoDayStats adds the "member_repo_day_stats" edges to the MemberRepoDayStat entity.
-func (_u *SnapshotUpdateOne) AddMemberRepoDayStats(v ...*MemberRepoDayStat) *SnapshotUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.ReviewEdgesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.ReviewEdgesTable,
+			Columns: []string{snapshot.ReviewEdgesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reviewedge.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.AddMemberRepoDayStatIDs(ids...)
-}
-
-// AddRepoMetaIDs adds the "repo_metas" edge to the RepoMeta entity by IDs.
-func (_u *SnapshotUpdateOne) AddRepoMetaIDs(ids ...int) *SnapshotUpdateOne {
-	_u.mutation.AddRepoMetaIDs(ids...)
-	return _u
-}
-
-// AddRepoMetas adds the "repo_metas" edges to the RepoMeta entity.
-func (_u *SnapshotUpdateOne) AddRepoMetas(v ...*RepoMeta) *SnapshotUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.MemberDataGapsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberDataGapsTable,
+			Columns: []string{snapshot.MemberDataGapsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberdatagap.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddRepoMetaIDs(ids...)
-}
-
-// Mutation returns the SnapshotMutation object of the builder.
-func (_u *SnapshotUpdateOne) Mutation() *SnapshotMutation {
-	return _u.mutation
-}
-
-// ClearMemberStats clears all "member_stats" edges to the MemberStat entity.
-func (_u *SnapshotUpdateOne) ClearMemberStats() *SnapshotUpdateOne {
-	_u.mutation.ClearMemberStats()
-	return _u
-}
-
-// RemoveMemberStatIDs removes the "member_stats" edge to MemberStat entities by IDs.
-func (_u *SnapshotUpdateOne) RemoveMemberStatIDs(ids ...int) *SnapshotUpdateOne {
-	_u.mutation.RemoveMemberStatIDs(ids...)
-	return _u
-}
-
-// RemoveMemberStats removes "member_stats" edges to MemberStat entities.
-func (_u *SnapshotUpdateOne) RemoveMemberStats(v ...*MemberStat) *SnapshotUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedMemberDataGapsIDs(); len(nodes) > 0 && !_u.mutation.MemberDataGapsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberDataGapsTable,
+			Columns: []string{snapshot.MemberDataGapsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberdatagap.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.RemoveMemberStatIDs(ids...)
-}
-
-// ClearMemberYearStats clears all "member_year_stats" edges to the MemberYearStat entity.
-func (_u *SnapshotUpdateOne) ClearMemberYearStats() *SnapshotUpdateOne {
-	_u.mutation.ClearMemberYearStats()
-	return _u
-}
-
-// RemoveMemberYearStatIDs removes the "member_year_stats" edge to MemberYearStat entities by IDs.
-func (_u *SnapshotUpdateOne) RemoveMemberYearStatIDs(ids ...int) *SnapshotUpdateOne {
-	_u.mutation.RemoveMemberYearStatIDs(ids...)
-	return _u
-}
-
-// RemoveMemberYearStats removes "member_year_stats" edges to MemberYearStat entities.
-func (_u *SnapshotUpdateOne) RemoveMemberYearStats(v ...*MemberYearStat) *SnapshotUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.MemberDataGapsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberDataGapsTable,
+			Columns: []string{snapshot.MemberDataGapsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberdatagap.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.RemoveMemberYearStatIDs(ids...)
-}
-
-// ClearMemberDayStats clears all "member_day_stats" edges to the MemberDayStat entity.
-func (_u *SnapshotUpdateOne) ClearMemberDayStats() *SnapshotUpdateOne {
-	_u.mutation.ClearMemberDayStats()
-	return _u
-}
-
-// RemoveMemberDayStatIDs removes the "member_day_stats" edge to MemberDayStat entities by IDs.
-func (_u *SnapshotUpdateOne) RemoveMemberDayStatIDs(ids ...int) *SnapshotUpdateOne {
-	_u.mutation.RemoveMemberDayStatIDs(ids...)
-	return _u
-}
-
-// RemoveMemberDayStats removes "member_day_stats" edges to MemberDayStat entities.
-func (_u *SnapshotUpdateOne) RemoveMemberDayStats(v ...*MemberDayStat) *SnapshotUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.ExcludedMembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.ExcludedMembersTable,
+			Columns: []string{snapshot.ExcludedMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(excludedmember.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.RemoveMemberDayStatIDs(ids...)
-}
-
-// ClearMemberRepoStats clears all "member_repo_stats" edges to the MemberRepoStat entity.
-func (_u *SnapshotUpdateOne) ClearMemberRepoStats() *SnapshotUpdateOne {
-	_u.mutation.ClearMemberRepoStats()
-	return _u
-}
-
-// RemoveMemberRepoStatIDs removes the "member_repo_stats" edge to MemberRepoStat entities by IDs.
-func (_u *SnapshotUpdateOne) RemoveMemberRepoStatIDs(ids ...int) *SnapshotUpdateOne {
-	_u.mutation.RemoveMemberRepoStatIDs(ids...)
-	return _u
-}
+	if nodes := _u.mutation.RemovedExcludedMembersIDs(); len(nodes) > 0 && !_u.mutation.ExcludedMembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.ExcludedMembersTable,
+			Columns: []string{snapshot.ExcludedMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(excludedmember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ExcludedMembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.ExcludedMembersTable,
+			Columns: []string{snapshot.ExcludedMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(excludedmember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MemberAccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberAccountsTable,
+			Columns: []string{snapshot.MemberAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberaccount.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMemberAccountsIDs(); len(nodes) > 0 && !_u.mutation.MemberAccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberAccountsTable,
+			Columns: []string{snapshot.MemberAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberaccount.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MemberAccountsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberAccountsTable,
+			Columns: []string{snapshot.MemberAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberaccount.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RepoDeliveryWeeksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.RepoDeliveryWeeksTable,
+			Columns: []string{snapshot.RepoDeliveryWeeksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(repodeliveryweek.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRepoDeliveryWeeksIDs(); len(nodes) > 0 && !_u.mutation.RepoDeliveryWeeksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.RepoDeliveryWeeksTable,
+			Columns: []string{snapshot.RepoDeliveryWeeksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(repodeliveryweek.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RepoDeliveryWeeksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.RepoDeliveryWeeksTable,
+			Columns: []string{snapshot.RepoDeliveryWeeksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(repodeliveryweek.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MemberIssuesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberIssuesTable,
+			Columns: []string{snapshot.MemberIssuesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberissue.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMemberIssuesIDs(); len(nodes) > 0 && !_u.mutation.MemberIssuesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberIssuesTable,
+			Columns: []string{snapshot.MemberIssuesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberissue.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MemberIssuesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberIssuesTable,
+			Columns: []string{snapshot.MemberIssuesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberissue.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MemberReviewRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberReviewRequestsTable,
+			Columns: []string{snapshot.MemberReviewRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberreviewrequest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMemberReviewRequestsIDs(); len(nodes) > 0 && !_u.mutation.MemberReviewRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberReviewRequestsTable,
+			Columns: []string{snapshot.MemberReviewRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberreviewrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MemberReviewRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberReviewRequestsTable,
+			Columns: []string{snapshot.MemberReviewRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberreviewrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MemberHourStatsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberHourStatsTable,
+			Columns: []string{snapshot.MemberHourStatsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberhourstat.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMemberHourStatsIDs(); len(nodes) > 0 && !_u.mutation.MemberHourStatsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberHourStatsTable,
+			Columns: []string{snapshot.MemberHourStatsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberhourstat.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MemberHourStatsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberHourStatsTable,
+			Columns: []string{snapshot.MemberHourStatsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberhourstat.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{snapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
 
-// RemoveMemberRepoStats removes "member_repo_stats" edges to MemberRepoStat entities.
-func (_u *SnapshotUpdateOne) RemoveMemberRepoStats(v ...*MemberRepoStat) *SnapshotUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SnapshotUpdateOne is the builder for updating a single Snapshot entity.
+type SnapshotUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SnapshotMutation
+}
+
+// SetTag sets the "tag" field.
+func (_u *SnapshotUpdateOne) SetTag(v string) *SnapshotUpdateOne {
+	_u.mutation.SetTag(v)
+	return _u
+}
+
+// SetNillableTag sets the "tag" field if the given value is not nil.
+func (_u *SnapshotUpdateOne) SetNillableTag(v *string) *SnapshotUpdateOne {
+	if v != nil {
+		_u.SetTag(*v)
+	}
+	return _u
+}
+
+// ClearTag clears the value of the "tag" field.
+func (_u *SnapshotUpdateOne) ClearTag() *SnapshotUpdateOne {
+	_u.mutation.ClearTag()
+	return _u
+}
+
+// SetPeriodSince sets the "period_since" field.
+func (_u *SnapshotUpdateOne) SetPeriodSince(v time.Time) *SnapshotUpdateOne {
+	_u.mutation.SetPeriodSince(v)
+	return _u
+}
+
+// SetNillablePeriodSince sets the "period_since" field if the given value is not nil.
+func (_u *SnapshotUpdateOne) SetNillablePeriodSince(v *time.Time) *SnapshotUpdateOne {
+	if v != nil {
+		_u.SetPeriodSince(*v)
+	}
+	return _u
+}
+
+// ClearPeriodSince clears the value of the "period_since" field.
+func (_u *SnapshotUpdateOne) ClearPeriodSince() *SnapshotUpdateOne {
+	_u.mutation.ClearPeriodSince()
+	return _u
+}
+
+// SetPeriodUntil sets the "period_until" field.
+func (_u *SnapshotUpdateOne) SetPeriodUntil(v time.Time) *SnapshotUpdateOne {
+	_u.mutation.SetPeriodUntil(v)
+	return _u
+}
+
+// SetNillablePeriodUntil sets the "period_until" field if the given value is not nil.
+func (_u *SnapshotUpdateOne) SetNillablePeriodUntil(v *time.Time) *SnapshotUpdateOne {
+	if v != nil {
+		_u.SetPeriodUntil(*v)
+	}
+	return _u
+}
+
+// ClearPeriodUntil clears the value of the "period_until" field.
+func (_u *SnapshotUpdateOne) ClearPeriodUntil() *SnapshotUpdateOne {
+	_u.mutation.ClearPeriodUntil()
+	return _u
+}
+
+// SetLookbackYears sets the "lookback_years" field.
+func (_u *SnapshotUpdateOne) SetLookbackYears(v int) *SnapshotUpdateOne {
+	_u.mutation.ResetLookbackYears()
+	_u.mutation.SetLookbackYears(v)
+	return _u
+}
+
+// SetNillableLookbackYears sets the "lookback_years" field if the given value is not nil.
+func (_u *SnapshotUpdateOne) SetNillableLookbackYears(v *int) *SnapshotUpdateOne {
+	if v != nil {
+		_u.SetLookbackYears(*v)
+	}
+	return _u
+}
+
+// AddLookbackYears adds value to the "lookback_years" field.
+func (_u *SnapshotUpdateOne) AddLookbackYears(v int) *SnapshotUpdateOne {
+	_u.mutation.AddLookbackYears(v)
+	return _u
+}
+
+// AddMemberStatIDs adds the "member_stats" edge to the MemberStat entity by IDs.
+func (_u *SnapshotUpdateOne) AddMemberStatIDs(ids ...int) *SnapshotUpdateOne {
+	_u.mutation.AddMemberStatIDs(ids...)
+	return _u
+}
+
+// AddMemberStats adds the "member_stats" edges to the MemberStat entity.
+func (_u *SnapshotUpdateOne) AddMemberStats(v ...*MemberStat) *SnapshotUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMemberStatIDs(ids...)
+}
+
+// AddMemberYearStatIDs adds the "member_year_stats" edge to the MemberYearStat entity by IDs.
+func (_u *SnapshotUpdateOne) AddMemberYearStatIDs(ids ...int) *SnapshotUpdateOne {
+	_u.mutation.AddMemberYearStatIDs(ids...)
+	return _u
+}
+
+// AddMemberYearStats adds the "member_year_stats" edges to the MemberYearStat entity.
+func (_u *SnapshotUpdateOne) AddMemberYearStats(v ...*MemberYearStat) *SnapshotUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMemberYearStatIDs(ids...)
+}
+
+// AddMemberDayStatIDs adds the "member_day_stats" edge to the MemberDayStat entity by IDs.
+func (_u *SnapshotUpdateOne) AddMemberDayStatIDs(ids ...int) *SnapshotUpdateOne {
+	_u.mutation.AddMemberDayStatIDs(ids...)
+	return _u
+}
+
+// AddMemberDayStats adds the "member_day_stats" edges to the MemberDayStat entity.
+func (_u *SnapshotUpdateOne) AddMemberDayStats(v ...*MemberDayStat) *SnapshotUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMemberDayStatIDs(ids...)
+}
+
+// AddMemberRepoStatIDs adds the "member_repo_stats" edge to the MemberRepoStat entity by IDs.
+func (_u *SnapshotUpdateOne) AddMemberRepoStatIDs(ids ...int) *SnapshotUpdateOne {
+	_u.mutation.AddMemberRepoStatIDs(ids...)
+	return _u
+}
+
+// AddMemberRepoStats adds the "member_repo_stats" edges to the MemberRepoStat entity.
+func (_u *SnapshotUpdateOne) AddMemberRepoStats(v ...*MemberRepoStat) *SnapshotUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMemberRepoStatIDs(ids...)
+}
+
+// AddMemberRepoDayStatIDs adds the "member_repo_day_stats" edge to the MemberRepoDayStat entity by IDs.
+func (_u *SnapshotUpdateOne) AddMemberRepoDayStatIDs(ids ...int) *SnapshotUpdateOne {
+	_u.mutation.AddMemberRepoDayStatIDs(ids...)
+	return _u
+}
+
+// AddMemberRepoDayStats adds the "member_repo_day_stats" edges to the MemberRepoDayStat entity.
+func (_u *SnapshotUpdateOne) AddMemberRepoDayStats(v ...*MemberRepoDayStat) *SnapshotUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMemberRepoDayStatIDs(ids...)
+}
+
+// AddRepoMetaIDs adds the "repo_metas" edge to the RepoMeta entity by IDs.
+func (_u *SnapshotUpdateOne) AddRepoMetaIDs(ids ...int) *SnapshotUpdateOne {
+	_u.mutation.AddRepoMetaIDs(ids...)
+	return _u
+}
+
+// AddRepoMetas adds the "repo_metas" edges to the RepoMeta entity.
+func (_u *SnapshotUpdateOne) AddRepoMetas(v ...*RepoMeta) *SnapshotUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRepoMetaIDs(ids...)
+}
+
+// AddMemberPullRequestIDs adds the "member_pull_requests" edge to the MemberPullRequest entity by IDs.
+func (_u *SnapshotUpdateOne) AddMemberPullRequestIDs(ids ...int) *SnapshotUpdateOne {
+	_u.mutation.AddMemberPullRequestIDs(ids...)
+	return _u
+}
+
+// AddMemberPullRequests adds the "member_pull_requests" edges to the MemberPullRequest entity.
+func (_u *SnapshotUpdateOne) AddMemberPullRequests(v ...*MemberPullRequest) *SnapshotUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMemberPullRequestIDs(ids...)
+}
+
+// AddReviewEdgeIDs adds the "review_edges" edge to the ReviewEdge entity by IDs.
+func (_u *SnapshotUpdateOne) AddReviewEdgeIDs(ids ...int) *SnapshotUpdateOne {
+	_u.mutation.AddReviewEdgeIDs(ids...)
+	return _u
+}
+
+// AddReviewEdges adds the "review_edges" edges to the ReviewEdge entity.
+func (_u *SnapshotUpdateOne) AddReviewEdges(v ...*ReviewEdge) *SnapshotUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReviewEdgeIDs(ids...)
+}
+
+// AddMemberDataGapIDs adds the "member_data_gaps" edge to the MemberDataGap entity by IDs.
+func (_u *SnapshotUpdateOne) AddMemberDataGapIDs(ids ...int) *SnapshotUpdateOne {
+	_u.mutation.AddMemberDataGapIDs(ids...)
+	return _u
+}
+
+// AddMemberDataGaps adds the "member_data_gaps" edges to the MemberDataGap entity.
+func (_u *SnapshotUpdateOne) AddMemberDataGaps(v ...*MemberDataGap) *SnapshotUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMemberDataGapIDs(ids...)
+}
+
+// AddExcludedMemberIDs adds the "excluded_members" edge to the ExcludedMember entity by IDs.
+func (_u *SnapshotUpdateOne) AddExcludedMemberIDs(ids ...int) *SnapshotUpdateOne {
+	_u.mutation.AddExcludedMemberIDs(ids...)
+	return _u
+}
+
+// AddExcludedMembers adds the "excluded_members" edges to the ExcludedMember entity.
+func (_u *SnapshotUpdateOne) AddExcludedMembers(v ...*ExcludedMember) *SnapshotUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddExcludedMemberIDs(ids...)
+}
+
+// AddMemberAccountIDs adds the "member_accounts" edge to the MemberAccount entity by IDs.
+func (_u *SnapshotUpdateOne) AddMemberAccountIDs(ids ...int) *SnapshotUpdateOne {
+	_u.mutation.AddMemberAccountIDs(ids...)
+	return _u
+}
+
+// AddMemberAccounts adds the "member_accounts" edges to the MemberAccount entity.
+func (_u *SnapshotUpdateOne) AddMemberAccounts(v ...*MemberAccount) *SnapshotUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMemberAccountIDs(ids...)
+}
+
+// AddRepoDeliveryWeekIDs adds the "repo_delivery_weeks" edge to the RepoDeliveryWeek entity by IDs.
+func (_u *SnapshotUpdateOne) AddRepoDeliveryWeekIDs(ids ...int) *SnapshotUpdateOne {
+	_u.mutation.AddRepoDeliveryWeekIDs(ids...)
+	return _u
+}
+
+// AddRepoDeliveryWeeks adds the "repo_delivery_weeks" edges to the RepoDeliveryWeek entity.
+func (_u *SnapshotUpdateOne) AddRepoDeliveryWeeks(v ...*RepoDeliveryWeek) *SnapshotUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRepoDeliveryWeekIDs(ids...)
+}
+
+// AddMemberIssueIDs adds the "member_issues" edge to the MemberIssue entity by IDs.
+func (_u *SnapshotUpdateOne) AddMemberIssueIDs(ids ...int) *SnapshotUpdateOne {
+	_u.mutation.AddMemberIssueIDs(ids...)
+	return _u
+}
+
+// AddMemberIssues adds the "member_issues" edges to the MemberIssue entity.
+func (_u *SnapshotUpdateOne) AddMemberIssues(v ...*MemberIssue) *SnapshotUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMemberIssueIDs(ids...)
+}
+
+// AddMemberReviewRequestIDs adds the "member_review_requests" edge to the MemberReviewRequest entity by IDs.
+func (_u *SnapshotUpdateOne) AddMemberReviewRequestIDs(ids ...int) *SnapshotUpdateOne {
+	_u.mutation.AddMemberReviewRequestIDs(ids...)
+	return _u
+}
+
+// AddMemberReviewRequests adds the "member_review_requests" edges to the MemberReviewRequest entity.
+func (_u *SnapshotUpdateOne) AddMemberReviewRequests(v ...*MemberReviewRequest) *SnapshotUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMemberReviewRequestIDs(ids...)
+}
+
+// AddMemberHourStatIDs adds the "member_hour_stats" edge to the MemberHourStat entity by IDs.
+func (_u *SnapshotUpdateOne) AddMemberHourStatIDs(ids ...int) *SnapshotUpdateOne {
+	_u.mutation.AddMemberHourStatIDs(ids...)
+	return _u
+}
+
+// AddMemberHourStats adds the "member_hour_stats" edges to the MemberHourStat entity.
+func (_u *SnapshotUpdateOne) AddMemberHourStats(v ...*MemberHourStat) *SnapshotUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMemberHourStatIDs(ids...)
+}
+
+// Mutation returns the SnapshotMutation object of the builder.
+func (_u *SnapshotUpdateOne) Mutation() *SnapshotMutation {
+	return _u.mutation
+}
+
+// ClearMemberStats clears all "member_stats" edges to the MemberStat entity.
+func (_u *SnapshotUpdateOne) ClearMemberStats() *SnapshotUpdateOne {
+	_u.mutation.ClearMemberStats()
+	return _u
+}
+
+// RemoveMemberStatIDs removes the "member_stats" edge to MemberStat entities by IDs.
+func (_u *SnapshotUpdateOne) RemoveMemberStatIDs(ids ...int) *SnapshotUpdateOne {
+	_u.mutation.RemoveMemberStatIDs(ids...)
+	return _u
+}
+
+// RemoveMemberStats removes "member_stats" edges to MemberStat entities.
+func (_u *SnapshotUpdateOne) RemoveMemberStats(v ...*MemberStat) *SnapshotUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMemberStatIDs(ids...)
+}
+
+// ClearMemberYearStats clears all "member_year_stats" edges to the MemberYearStat entity.
+func (_u *SnapshotUpdateOne) ClearMemberYearStats() *SnapshotUpdateOne {
+	_u.mutation.ClearMemberYearStats()
+	return _u
+}
+
+// RemoveMemberYearStatIDs removes the "member_year_stats" edge to MemberYearStat entities by IDs.
+func (_u *SnapshotUpdateOne) RemoveMemberYearStatIDs(ids ...int) *SnapshotUpdateOne {
+	_u.mutation.RemoveMemberYearStatIDs(ids...)
+	return _u
+}
+
+// RemoveMemberYearStats removes "member_year_stats" edges to MemberYearStat entities.
+func (_u *SnapshotUpdateOne) RemoveMemberYearStats(v ...*MemberYearStat) *SnapshotUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMemberYearStatIDs(ids...)
+}
+
+// ClearMemberDayStats clears all "member_day_stats" edges to the MemberDayStat entity.
+func (_u *SnapshotUpdateOne) ClearMemberDayStats() *SnapshotUpdateOne {
+	_u.mutation.ClearMemberDayStats()
+	return _u
+}
+
+// RemoveMemberDayStatIDs removes the "member_day_stats" edge to MemberDayStat entities by IDs.
+func (_u *SnapshotUpdateOne) RemoveMemberDayStatIDs(ids ...int) *SnapshotUpdateOne {
+	_u.mutation.RemoveMemberDayStatIDs(ids...)
+	return _u
+}
+
+// RemoveMemberDayStats removes "member_day_stats" edges to MemberDayStat entities.
+func (_u *SnapshotUpdateOne) RemoveMemberDayStats(v ...*MemberDayStat) *SnapshotUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMemberDayStatIDs(ids...)
+}
+
+// ClearMemberRepoStats clears all "member_repo_stats" edges to the MemberRepoStat entity.
+func (_u *SnapshotUpdateOne) ClearMemberRepoStats() *SnapshotUpdateOne {
+	_u.mutation.ClearMemberRepoStats()
+	return _u
+}
+
+// RemoveMemberRepoStatIDs removes the "member_repo_stats" edge to MemberRepoStat entities by IDs.
+func (_u *SnapshotUpdateOne) RemoveMemberRepoStatIDs(ids ...int) *SnapshotUpdateOne {
+	_u.mutation.RemoveMemberRepoStatIDs(ids...)
+	return _u
+}
+
+// RemoveMemberRepoStats removes "member_repo_stats" edges to MemberRepoStat entities.
+func (_u *SnapshotUpdateOne) RemoveMemberRepoStats(v ...*MemberRepoStat) *SnapshotUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMemberRepoStatIDs(ids...)
+}
+
+// ClearMemberRepoDayStats clears all "member_repo_day_stats" edges to the MemberRepoDayStat entity.
+func (_u *SnapshotUpdateOne) ClearMemberRepoDayStats() *SnapshotUpdateOne {
+	_u.mutation.ClearMemberRepoDayStats()
+	return _u
+}
+
+// RemoveMemberRepoDayStatIDs removes the "member_repo_day_stats" edge to MemberRepoDayStat entities by IDs.
+func (_u *SnapshotUpdateOne) RemoveMemberRepoDayStatIDs(ids ...int) *SnapshotUpdateOne {
+	_u.mutation.RemoveMemberRepoDayStatIDs(ids...)
+	return _u
+}
+
+// RemoveMemberRepoDayStats removes "member_repo_day_stats" edges to MemberRepoDayStat entities.
+func (_u *SnapshotUpdateOne) RemoveMemberRepoDayStats(v ...*MemberRepoDayStat) *SnapshotUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMemberRepoDayStatIDs(ids...)
+}
+
+// ClearRepoMetas clears all "repo_metas" edges to the RepoMeta entity.
+func (_u *SnapshotUpdateOne) ClearRepoMetas() *SnapshotUpdateOne {
+	_u.mutation.ClearRepoMetas()
+	return _u
+}
+
+// RemoveRepoMetaIDs removes the "repo_metas" edge to RepoMeta entities by IDs.
+func (_u *SnapshotUpdateOne) RemoveRepoMetaIDs(ids ...int) *SnapshotUpdateOne {
+	_u.mutation.RemoveRepoMetaIDs(ids...)
+	return _u
+}
+
+// RemoveRepoMetas removes "repo_metas" edges to RepoMeta entities.
+func (_u *SnapshotUpdateOne) RemoveRepoMetas(v ...*RepoMeta) *SnapshotUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRepoMetaIDs(ids...)
+}
+
+// ClearMemberPullRequests clears all "member_pull_requests" edges to the MemberPullRequest entity.
+func (_u *SnapshotUpdateOne) ClearMemberPullRequests() *SnapshotUpdateOne {
+	_u.mutation.ClearMemberPullRequests()
+	return _u
+}
+
+// RemoveMemberPullRequestIDs removes the "member_pull_requests" edge to MemberPullRequest entities by IDs.
+func (_u *SnapshotUpdateOne) RemoveMemberPullRequestIDs(ids ...int) *SnapshotUpdateOne {
+	_u.mutation.RemoveMemberPullRequestIDs(ids...)
+	return _u
+}
+
+// RemoveMemberPullRequests removes "member_pull_requests" edges to MemberPullRequest entities.
+func (_u *SnapshotUpdateOne) RemoveMemberPullRequests(v ...*MemberPullRequest) *SnapshotUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMemberPullRequestIDs(ids...)
+}
+
+// ClearReviewEdges clears all "review_edges" edges to the ReviewEdge entity.
+func (_u *SnapshotUpdateOne) ClearReviewEdges() *SnapshotUpdateOne {
+	_u.mutation.ClearReviewEdges()
+	return _u
+}
+
+// RemoveReviewEdgeIDs removes the "review_edges" edge to ReviewEdge entities by IDs.
+func (_u *SnapshotUpdateOne) RemoveReviewEdgeIDs(ids ...int) *SnapshotUpdateOne {
+	_u.mutation.RemoveReviewEdgeIDs(ids...)
+	return _u
+}
+
+// RemoveReviewEdges removes "review_edges" edges to ReviewEdge entities.
+func (_u *SnapshotUpdateOne) RemoveReviewEdges(v ...*ReviewEdge) *SnapshotUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReviewEdgeIDs(ids...)
+}
+
+// ClearMemberDataGaps clears all "member_data_gaps" edges to the MemberDataGap entity.
+func (_u *SnapshotUpdateOne) ClearMemberDataGaps() *SnapshotUpdateOne {
+	_u.mutation.ClearMemberDataGaps()
+	return _u
+}
+
+// RemoveMemberDataGapIDs removes the "member_data_gaps" edge to MemberDataGap entities by IDs.
+func (_u *SnapshotUpdateOne) RemoveMemberDataGapIDs(ids ...int) *SnapshotUpdateOne {
+	_u.mutation.RemoveMemberDataGapIDs(ids...)
+	return _u
+}
+
+// RemoveMemberDataGaps removes "member_data_gaps" edges to MemberDataGap entities.
+func (_u *SnapshotUpdateOne) RemoveMemberDataGaps(v ...*MemberDataGap) *SnapshotUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMemberDataGapIDs(ids...)
+}
+
+// ClearExcludedMembers clears all "excluded_members" edges to the ExcludedMember entity.
+func (_u *SnapshotUpdateOne) ClearExcludedMembers() *SnapshotUpdateOne {
+	_u.mutation.ClearExcludedMembers()
+	return _u
+}
+
+// RemoveExcludedMemberIDs removes the "excluded_members" edge to ExcludedMember entities by IDs.
+func (_u *SnapshotUpdateOne) RemoveExcludedMemberIDs(ids ...int) *SnapshotUpdateOne {
+	_u.mutation.RemoveExcludedMemberIDs(ids...)
+	return _u
+}
+
+// RemoveExcludedMembers removes "excluded_members" edges to ExcludedMember entities.
+func (_u *SnapshotUpdateOne) RemoveExcludedMembers(v ...*ExcludedMember) *SnapshotUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveExcludedMemberIDs(ids...)
+}
+
+// ClearMemberAccounts clears all "member_accounts" edges to the MemberAccount entity.
+func (_u *SnapshotUpdateOne) ClearMemberAccounts() *SnapshotUpdateOne {
+	_u.mutation.ClearMemberAccounts()
+	return _u
+}
+
+// RemoveMemberAccountIDs removes the "member_accounts" edge to MemberAccount entities by IDs.
+func (_u *SnapshotUpdateOne) RemoveMemberAccountIDs(ids ...int) *SnapshotUpdateOne {
+	_u.mutation.RemoveMemberAccountIDs(ids...)
+	return _u
+}
+
+// RemoveMemberAccounts removes "member_accounts" edges to MemberAccount entities.
+func (_u *SnapshotUpdateOne) RemoveMemberAccounts(v ...*MemberAccount) *SnapshotUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMemberAccountIDs(ids...)
+}
+
+// ClearRepoDeliveryWeeks clears all "repo_delivery_weeks" edges to the RepoDeliveryWeek entity.
+func (_u *SnapshotUpdateOne) ClearRepoDeliveryWeeks() *SnapshotUpdateOne {
+	_u.mutation.ClearRepoDeliveryWeeks()
+	return _u
+}
+
+// RemoveRepoDeliveryWeekIDs removes the "repo_delivery_weeks" edge to RepoDeliveryWeek entities by IDs.
+func (_u *SnapshotUpdateOne) RemoveRepoDeliveryWeekIDs(ids ...int) *SnapshotUpdateOne {
+	_u.mutation.RemoveRepoDeliveryWeekIDs(ids...)
+	return _u
+}
+
+// RemoveRepoDeliveryWeeks removes "repo_delivery_weeks" edges to RepoDeliveryWeek entities.
+func (_u *SnapshotUpdateOne) RemoveRepoDeliveryWeeks(v ...*RepoDeliveryWeek) *SnapshotUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRepoDeliveryWeekIDs(ids...)
+}
+
+// ClearMemberIssues clears all "member_issues" edges to the MemberIssue entity.
+func (_u *SnapshotUpdateOne) ClearMemberIssues() *SnapshotUpdateOne {
+	_u.mutation.ClearMemberIssues()
+	return _u
+}
+
+// RemoveMemberIssueIDs removes the "member_issues" edge to MemberIssue entities by IDs.
+func (_u *SnapshotUpdateOne) RemoveMemberIssueIDs(ids ...int) *SnapshotUpdateOne {
+	_u.mutation.RemoveMemberIssueIDs(ids...)
+	return _u
+}
+
+// RemoveMemberIssues removes "member_issues" edges to MemberIssue entities.
+func (_u *SnapshotUpdateOne) RemoveMemberIssues(v ...*MemberIssue) *SnapshotUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMemberIssueIDs(ids...)
+}
+
+// ClearMemberReviewRequests clears all "member_review_requests" edges to the MemberReviewRequest entity.
+func (_u *SnapshotUpdateOne) ClearMemberReviewRequests() *SnapshotUpdateOne {
+	_u.mutation.ClearMemberReviewRequests()
+	return _u
+}
+
+// RemoveMemberReviewRequestIDs removes the "member_review_requests" edge to MemberReviewRequest entities by IDs.
+func (_u *SnapshotUpdateOne) RemoveMemberReviewRequestIDs(ids ...int) *SnapshotUpdateOne {
+	_u.mutation.RemoveMemberReviewRequestIDs(ids...)
+	return _u
+}
+
+// RemoveMemberReviewRequests removes "member_review_requests" edges to MemberReviewRequest entities.
+func (_u *SnapshotUpdateOne) RemoveMemberReviewRequests(v ...*MemberReviewRequest) *SnapshotUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMemberReviewRequestIDs(ids...)
+}
+
+// ClearMemberHourStats clears all "member_hour_stats" edges to the MemberHourStat entity.
+func (_u *SnapshotUpdateOne) ClearMemberHourStats() *SnapshotUpdateOne {
+	_u.mutation.ClearMemberHourStats()
+	return _u
+}
+
+// RemoveMemberHourStatIDs removes the "member_hour_stats" edge to MemberHourStat entities by IDs.
+func (_u *SnapshotUpdateOne) RemoveMemberHourStatIDs(ids ...int) *SnapshotUpdateOne {
+	_u.mutation.RemoveMemberHourStatIDs(ids...)
+	return _u
+}
+
+// RemoveMemberHourStats removes "member_hour_stats" edges to MemberHourStat entities.
+func (_u *SnapshotUpdateOne) RemoveMemberHourStats(v ...*MemberHourStat) *SnapshotUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMemberHourStatIDs(ids...)
+}
+
+// Where appends a list predicates to the SnapshotUpdate builder.
+func (_u *SnapshotUpdateOne) Where(ps ...predicate.Snapshot) *SnapshotUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SnapshotUpdateOne) Select(field string, fields ...string) *SnapshotUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Snapshot entity.
+func (_u *SnapshotUpdateOne) Save(ctx context.Context) (*Snapshot, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SnapshotUpdateOne) SaveX(ctx context.Context) *Snapshot {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SnapshotUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SnapshotUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *SnapshotUpdateOne) sqlSave(ctx context.Context) (_node *Snapshot, err error) {
+	_spec := sqlgraph.NewUpdateSpec(snapshot.Table, snapshot.Columns, sqlgraph.NewFieldSpec(snapshot.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Snapshot.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, snapshot.FieldID)
+		for _, f := range fields {
+			if !snapshot.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != snapshot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Tag(); ok {
+		_spec.SetField(snapshot.FieldTag, field.TypeString, value)
+	}
+	if _u.mutation.TagCleared() {
+		_spec.ClearField(snapshot.FieldTag, field.TypeString)
+	}
+	if value, ok := _u.mutation.PeriodSince(); ok {
+		_spec.SetField(snapshot.FieldPeriodSince, field.TypeTime, value)
+	}
+	if _u.mutation.PeriodSinceCleared() {
+		_spec.ClearField(snapshot.FieldPeriodSince, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PeriodUntil(); ok {
+		_spec.SetField(snapshot.FieldPeriodUntil, field.TypeTime, value)
+	}
+	if _u.mutation.PeriodUntilCleared() {
+		_spec.ClearField(snapshot.FieldPeriodUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LookbackYears(); ok {
+		_spec.SetField(snapshot.FieldLookbackYears, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLookbackYears(); ok {
+		_spec.AddField(snapshot.FieldLookbackYears, field.TypeInt, value)
+	}
+	if _u.mutation.MemberStatsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberStatsTable,
+			Columns: []string{snapshot.MemberStatsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberstat.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMemberStatsIDs(); len(nodes) > 0 && !_u.mutation.MemberStatsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberStatsTable,
+			Columns: []string{snapshot.MemberStatsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberstat.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MemberStatsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberStatsTable,
+			Columns: []string{snapshot.MemberStatsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberstat.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MemberYearStatsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberYearStatsTable,
+			Columns: []string{snapshot.MemberYearStatsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberyearstat.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMemberYearStatsIDs(); len(nodes) > 0 && !_u.mutation.MemberYearStatsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberYearStatsTable,
+			Columns: []string{snapshot.MemberYearStatsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberyearstat.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MemberYearStatsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberYearStatsTable,
+			Columns: []string{snapshot.MemberYearStatsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberyearstat.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MemberDayStatsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberDayStatsTable,
+			Columns: []string{snapshot.MemberDayStatsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberdaystat.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMemberDayStatsIDs(); len(nodes) > 0 && !_u.mutation.MemberDayStatsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberDayStatsTable,
+			Columns: []string{snapshot.MemberDayStatsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberdaystat.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MemberDayStatsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberDayStatsTable,
+			Columns: []string{snapshot.MemberDayStatsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberdaystat.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MemberRepoStatsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberRepoStatsTable,
+			Columns: []string{snapshot.MemberRepoStatsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberrepostat.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMemberRepoStatsIDs(); len(nodes) > 0 && !_u.mutation.MemberRepoStatsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberRepoStatsTable,
+			Columns: []string{snapshot.MemberRepoStatsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberrepostat.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MemberRepoStatsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberRepoStatsTable,
+			Columns: []string{snapshot.MemberRepoStatsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberrepostat.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MemberRepoDayStatsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberRepoDayStatsTable,
+			Columns: []string{snapshot.MemberRepoDayStatsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberrepodaystat.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMemberRepoDayStatsIDs(); len(nodes) > 0 && !_u.mutation.MemberRepoDayStatsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberRepoDayStatsTable,
+			Columns: []string{snapshot.MemberRepoDayStatsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberrepodaystat.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MemberRepoDayStatsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberRepoDayStatsTable,
+			Columns: []string{snapshot.MemberRepoDayStatsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberrepodaystat.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RepoMetasCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.RepoMetasTable,
+			Columns: []string{snapshot.RepoMetasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(repometa.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRepoMetasIDs(); len(nodes) > 0 && !_u.mutation.RepoMetasCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.RepoMetasTable,
+			Columns: []string{snapshot.RepoMetasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(repometa.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RepoMetasIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.RepoMetasTable,
+			Columns: []string{snapshot.RepoMetasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(repometa.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MemberPullRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberPullRequestsTable,
+			Columns: []string{snapshot.MemberPullRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberpullrequest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.RemoveMemberRepoStatIDs(ids...)
-}
-
-// ClearMemberRepoDayStats clears all "member_repo_day_stats" edges to the MemberRepoDayStat entity.
-func (_u *SnapshotUpdateOne) ClearMemberRepoDayStats() *SnapshotUpdateOne {
-	_u.mutation.ClearMemberRepoDayStats()
-	return _u
-}
-
-// RemoveMemberRepoDayStatIDs removes the "member_repo_day_stats" edge to MemberRepoDayStat entities by IDs.
-func (_u *SnapshotUpdateOne) RemoveMemberRepoDayStatIDs(ids ...int) *SnapshotUpdateOne {
-	_u.mutation.RemoveMemberRepoDayStatIDs(ids...)
-	return _u
-}
-
-// RemoveMemberRepoDayStats removes "member_repo_day_stats" edges to MemberRepoDayStat entities.
-func (_u *SnapshotUpdateOne) RemoveMemberRepoDayStats(v ...*MemberRepoDayStat) *SnapshotUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedMemberPullRequestsIDs(); len(nodes) > 0 && !_u.mutation.MemberPullRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberPullRequestsTable,
+			Columns: []string{snapshot.MemberPullRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberpullrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.RemoveMemberRepoDayStatIDs(ids...)
-}
-
-// ClearRepoMetas clears all "repo_metas" edges to the RepoMeta entity.
-func (_u *SnapshotUpdateOne) ClearRepoMetas() *SnapshotUpdateOne {
-	_u.mutation.ClearRepoMetas()
-	return _u
-}
-
-// RemoveRepoMetaIDs removes the "repo_metas" edge to RepoMeta entities by IDs.
-func (_u *SnapshotUpdateOne) RemoveRepoMetaIDs(ids ...int) *SnapshotUpdateOne {
-	_u.mutation.RemoveRepoMetaIDs(ids...)
-	return _u
-}
-
-// RemoveRepoMetas removes "repo_metas" edges to RepoMeta entities.
-func (_u *SnapshotUpdateOne) RemoveRepoMetas(v ...*RepoMeta) *SnapshotUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.MemberPullRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberPullRequestsTable,
+			Columns: []string{snapshot.MemberPullRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberpullrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.RemoveRepoMetaIDs(ids...)
-}
-
-// Where appends a list predicates to the SnapshotUpdate builder.
-func (_u *SnapshotUpdateOne) Where(ps ...predicate.Snapshot) *SnapshotUpdateOne {
-	_u.mutation.Where(ps...)
-	return _u
-}
-
-// Select allows selecting one or more fields (columns) of the returned entity.
-// The default is selecting all fields defined in the entity schema.
-func (_u *SnapshotUpdateOne) Select(field string, fields ...string) *SnapshotUpdateOne {
-	_u.fields = append([]string{field}, fields...)
-	return _u
-}
-
-// Save executes the query and returns the updated Snapshot entity.
-func (_u *SnapshotUpdateOne) Save(ctx context.Context) (*Snapshot, error) {
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
-}
-
-// SaveX is like Save, but panics if an error occurs.
-func (_u *SnapshotUpdateOne) SaveX(ctx context.Context) *Snapshot {
-	node, err := _u.Save(ctx)
-	if err != nil {
-		panic(err)
+	if _u.mutation.ReviewEdgesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.ReviewEdgesTable,
+			Columns: []string{snapshot.ReviewEdgesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reviewedge.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return node
-}
-
-// Exec executes the query on the entity.
-func (_u *SnapshotUpdateOne) Exec(ctx context.Context) error {
-	_, err := _u.Save(ctx)
-	return err
-}
-
-// ExecX is like Exec, but panics if an error occurs.
-func (_u *SnapshotUpdateOne) ExecX(ctx context.Context) {
-	if err := _u.Exec(ctx); err != nil {
-		panic(err)
+	if nodes := _u.mutation.RemovedReviewEdgesIDs(); len(nodes) > 0 && !_u.mutation.ReviewEdgesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.ReviewEdgesTable,
+			Columns: []string{snapshot.ReviewEdgesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reviewedge.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-}
-
-func (_u *SnapshotUpdateOne) sqlSave(ctx context.Context) (_node *Snapshot, err error) {
-	_spec := sqlgraph.NewUpdateSpec(snapshot.Table, snapshot.Columns, sqlgraph.NewFieldSpec(snapshot.FieldID, field.TypeInt))
-	id, ok := _u.mutation.ID()
-	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Snapshot.id" for update`)}
+	if nodes := _u.mutation.ReviewEdgesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.ReviewEdgesTable,
+			Columns: []string{snapshot.ReviewEdgesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reviewedge.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_spec.Node.ID.Value = id
-	if fields := _u.fields; len(fields) > 0 {
-		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, snapshot.FieldID)
-		for _, f := range fields {
-			if !snapshot.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
-			}
-			if f != snapshot.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, f)
-			}
+	if _u.mutation.MemberDataGapsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberDataGapsTable,
+			Columns: []string{snapshot.MemberDataGapsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberdatagap.FieldID, field.TypeInt),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if ps := _u.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
+	if nodes := _u.mutation.RemovedMemberDataGapsIDs(); len(nodes) > 0 && !_u.mutation.MemberDataGapsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.MemberDataGapsTable,
+			Columns: []string{snapshot.MemberDataGapsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(memberdatagap.FieldID, field.TypeInt),
+			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if _u.mutation.MemberStatsCleared() {
+	if nodes := _u.mutation.MemberDataGapsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   snapshot.MemberStatsTable,
-			Columns: []string{snapshot.MemberStatsColumn},
+			Table:   snapshot.MemberDataGapsTable,
+			Columns: []string{snapshot.MemberDataGapsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(memberstat.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(memberdatagap.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ExcludedMembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.ExcludedMembersTable,
+			Columns: []string{snapshot.ExcludedMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(excludedmember.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedMemberStatsIDs(); len(nodes) > 0 && !_u.mutation.MemberStatsCleared() {
+	if nodes := _u.mutation.RemovedExcludedMembersIDs(); len(nodes) > 0 && !_u.mutation.ExcludedMembersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   snapshot.MemberStatsTable,
-			Columns: []string{snapshot.MemberStatsColumn},
+			Table:   snapshot.ExcludedMembersTable,
+			Columns: []string{snapshot.ExcludedMembersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(memberstat.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(excludedmember.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -896,15 +2574,15 @@ func (_u *SnapshotUpdateOne) sqlSave(ctx context.Context) (_node *Snapshot, err
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.MemberStatsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ExcludedMembersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   snapshot.MemberStatsTable,
-			Columns: []string{snapshot.MemberStatsColumn},
+			Table:   snapshot.ExcludedMembersTable,
+			Columns: []string{snapshot.ExcludedMembersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(memberstat.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(excludedmember.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -912,28 +2590,28 @@ func (_u *SnapshotUpdateOne) sqlSave(ctx context.Context) (_node *Snapshot, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.MemberYearStatsCleared() {
+	if _u.mutation.MemberAccountsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   snapshot.MemberYearStatsTable,
-			Columns: []string{snapshot.MemberYearStatsColumn},
+			Table:   snapshot.MemberAccountsTable,
+			Columns: []string{snapshot.MemberAccountsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(memberyearstat.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(memberaccount.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedMemberYearStatsIDs(); len(nodes) > 0 && !_u.mutation.MemberYearStatsCleared() {
+	if nodes := _u.mutation.RemovedMemberAccountsIDs(); len(nodes) > 0 && !_u.mutation.MemberAccountsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   snapshot.MemberYearStatsTable,
-			Columns: []string{snapshot.MemberYearStatsColumn},
+			Table:   snapshot.MemberAccountsTable,
+			Columns: []string{snapshot.MemberAccountsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(memberyearstat.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(memberaccount.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -941,15 +2619,15 @@ func (_u *SnapshotUpdateOne) sqlSave(ctx context.Context) (_node *Snapshot, err
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.MemberYearStatsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.MemberAccountsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   snapshot.MemberYearStatsTable,
-			Columns: []string{snapshot.MemberYearStatsColumn},
+			Table:   snapshot.MemberAccountsTable,
+			Columns: []string{snapshot.MemberAccountsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(memberyearstat.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(memberaccount.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -957,28 +2635,28 @@ func (_u *SnapshotUpdateOne) sqlSave(ctx context.Context) (_node *Snapshot, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.MemberDayStatsCleared() {
+	if _u.mutation.RepoDeliveryWeeksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   snapshot.MemberDayStatsTable,
-			Columns: []string{snapshot.MemberDayStatsColumn},
+			Table:   snapshot.RepoDeliveryWeeksTable,
+			Columns: []string{snapshot.RepoDeliveryWeeksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(memberdaystat.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(repodeliveryweek.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedMemberDayStatsIDs(); len(nodes) > 0 && !_u.mutation.MemberDayStatsCleared() {
+	if nodes := _u.mutation.RemovedRepoDeliveryWeeksIDs(); len(nodes) > 0 && !_u.mutation.RepoDeliveryWeeksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   snapshot.MemberDayStatsTable,
-			Columns: []string{snapshot.MemberDayStatsColumn},
+			Table:   snapshot.RepoDeliveryWeeksTable,
+			Columns: []string{snapshot.RepoDeliveryWeeksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(memberdaystat.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(repodeliveryweek.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -986,15 +2664,15 @@ func (_u *SnapshotUpdateOne) sqlSave(ctx context.Context) (_node *Snapshot, err
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.MemberDayStatsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.RepoDeliveryWeeksIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   snapshot.MemberDayStatsTable,
-			Columns: []string{snapshot.MemberDayStatsColumn},
+			Table:   snapshot.RepoDeliveryWeeksTable,
+			Columns: []string{snapshot.RepoDeliveryWeeksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(memberdaystat.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(repodeliveryweek.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1002,28 +2680,28 @@ func (_u *SnapshotUpdateOne) sqlSave(ctx context.Context) (_node *Snapshot, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.MemberRepoStatsCleared() {
+	if _u.mutation.MemberIssuesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   snapshot.MemberRepoStatsTable,
-			Columns: []string{snapshot.MemberRepoStatsColumn},
+			Table:   snapshot.MemberIssuesTable,
+			Columns: []string{snapshot.MemberIssuesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(memberrepostat.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(memberissue.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedMemberRepoStatsIDs(); len(nodes) > 0 && !_u.mutation.MemberRepoStatsCleared() {
+	if nodes := _u.mutation.RemovedMemberIssuesIDs(); len(nodes) > 0 && !_u.mutation.MemberIssuesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   snapshot.MemberRepoStatsTable,
-			Columns: []string{snapshot.MemberRepoStatsColumn},
+			Table:   snapshot.MemberIssuesTable,
+			Columns: []string{snapshot.MemberIssuesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(memberrepostat.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(memberissue.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1031,15 +2709,15 @@ func (_u *SnapshotUpdateOne) sqlSave(ctx context.Context) (_node *Snapshot, err
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.MemberRepoStatsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.MemberIssuesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   snapshot.MemberRepoStatsTable,
-			Columns: []string{snapshot.MemberRepoStatsColumn},
+			Table:   snapshot.MemberIssuesTable,
+			Columns: []string{snapshot.MemberIssuesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(memberrepostat.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(memberissue.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1047,28 +2725,28 @@ func (_u *SnapshotUpdateOne) sqlSave(ctx context.Context) (_node *Snapshot, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.MemberRepoDayStatsCleared() {
+	if _u.mutation.MemberReviewRequestsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   snapshot.MemberRepoDayStatsTable,
-			Columns: []string{snapshot.MemberRepoDayStatsColumn},
+			Table:   snapshot.MemberReviewRequestsTable,
+			Columns: []string{snapshot.MemberReviewRequestsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(memberrepodaystat.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(memberreviewrequest.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedMemberRepoDayStatsIDs(); len(nodes) > 0 && !_u.mutation.MemberRepoDayStatsCleared() {
+	if nodes := _u.mutation.RemovedMemberReviewRequestsIDs(); len(nodes) > 0 && !_u.mutation.MemberReviewRequestsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   snapshot.MemberRepoDayStatsTable,
-			Columns: []string{snapshot.MemberRepoDayStatsColumn},
+			Table:   snapshot.MemberReviewRequestsTable,
+			Columns: []string{snapshot.MemberReviewRequestsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(memberrepodaystat.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(memberreviewrequest.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1076,15 +2754,15 @@ func (_u *SnapshotUpdateOne) sqlSave(ctx context.Context) (_node *Snapshot, err
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.MemberRepoDayStatsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.MemberReviewRequestsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   snapshot.MemberRepoDayStatsTable,
-			Columns: []string{snapshot.MemberRepoDayStatsColumn},
+			Table:   snapshot.MemberReviewRequestsTable,
+			Columns: []string{snapshot.MemberReviewRequestsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(memberrepodaystat.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(memberreviewrequest.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1092,28 +2770,28 @@ func (_u *SnapshotUpdateOne) sqlSave(ctx context.Context) (_node *Snapshot, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.RepoMetasCleared() {
+	if _u.mutation.MemberHourStatsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   snapshot.RepoMetasTable,
-			Columns: []string{snapshot.RepoMetasColumn},
+			Table:   snapshot.MemberHourStatsTable,
+			Columns: []string{snapshot.MemberHourStatsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(repometa.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(memberhourstat.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedRepoMetasIDs(); len(nodes) > 0 && !_u.mutation.RepoMetasCleared() {
+	if nodes := _u.mutation.RemovedMemberHourStatsIDs(); len(nodes) > 0 && !_u.mutation.MemberHourStatsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   snapshot.RepoMetasTable,
-			Columns: []string{snapshot.RepoMetasColumn},
+			Table:   snapshot.MemberHourStatsTable,
+			Columns: []string{snapshot.MemberHourStatsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(repometa.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(memberhourstat.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1121,15 +2799,15 @@ func (_u *SnapshotUpdateOne) sqlSave(ctx context.Context) (_node *Snapshot, err
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RepoMetasIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.MemberHourStatsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   snapshot.RepoMetasTable,
-			Columns: []string{snapshot.RepoMetasColumn},
+			Table:   snapshot.MemberHourStatsTable,
+			Columns: []string{snapshot.MemberHourStatsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(repometa.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(memberhourstat.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -12,18 +12,38 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// ActivityEvent is the client for interacting with the ActivityEvent builders.
+	ActivityEvent *ActivityEventClient
+	// ExcludedMember is the client for interacting with the ExcludedMember builders.
+	ExcludedMember *ExcludedMemberClient
+	// MemberAccount is the client for interacting with the MemberAccount builders.
+	MemberAccount *MemberAccountClient
+	// MemberDataGap is the client for interacting with the MemberDataGap builders.
+	MemberDataGap *MemberDataGapClient
 	// MemberDayStat is the client for interacting with the MemberDayStat builders.
 	MemberDayStat *MemberDayStatClient
+	// MemberHourStat is the client for interacting with the MemberHourStat builders.
+	MemberHourStat *MemberHourStatClient
+	// MemberIssue is the client for interacting with the MemberIssue builders.
+	MemberIssue *MemberIssueClient
+	// MemberPullRequest is the client for interacting with the MemberPullRequest builders.
+	MemberPullRequest *MemberPullRequestClient
 	// MemberRepoDayStat is the client for interacting with the MemberRepoDayStat builders.
 	MemberRepoDayStat *MemberRepoDayStatClient
 	// MemberRepoStat is the client for interacting with the MemberRepoStat builders.
 	MemberRepoStat *MemberRepoStatClient
+	// MemberReviewRequest is the client for interacting with the MemberReviewRequest builders.
+	MemberReviewRequest *MemberReviewRequestClient
 	// MemberStat is the client for interacting with the MemberStat builders.
 	MemberStat *MemberStatClient
 	// MemberYearStat is the client for interacting with the MemberYearStat builders.
 	MemberYearStat *MemberYearStatClient
+	// RepoDeliveryWeek is the client for interacting with the RepoDeliveryWeek builders.
+	RepoDeliveryWeek *RepoDeliveryWeekClient
 	// RepoMeta is the client for interacting with the RepoMeta builders.
 	RepoMeta *RepoMetaClient
+	// ReviewEdge is the client for interacting with the ReviewEdge builders.
+	ReviewEdge *ReviewEdgeClient
 	// Snapshot is the client for interacting with the Snapshot builders.
 	Snapshot *SnapshotClient
 
@@ -157,12 +177,22 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.ActivityEvent = NewActivityEventClient(tx.config)
+	tx.ExcludedMember = NewExcludedMemberClient(tx.config)
+	tx.MemberAccount = NewMemberAccountClient(tx.config)
+	tx.MemberDataGap = NewMemberDataGapClient(tx.config)
 	tx.MemberDayStat = NewMemberDayStatClient(tx.config)
+	tx.MemberHourStat = NewMemberHourStatClient(tx.config)
+	tx.MemberIssue = NewMemberIssueClient(tx.config)
+	tx.MemberPullRequest = NewMemberPullRequestClient(tx.config)
 	tx.MemberRepoDayStat = NewMemberRepoDayStatClient(tx.config)
 	tx.MemberRepoStat = NewMemberRepoStatClient(tx.config)
+	tx.MemberReviewRequest = NewMemberReviewRequestClient(tx.config)
 	tx.MemberStat = NewMemberStatClient(tx.config)
 	tx.MemberYearStat = NewMemberYearStatClient(tx.config)
+	tx.RepoDeliveryWeek = NewRepoDeliveryWeekClient(tx.config)
 	tx.RepoMeta = NewRepoMetaClient(tx.config)
+	tx.ReviewEdge = NewReviewEdgeClient(tx.config)
 	tx.Snapshot = NewSnapshotClient(tx.config)
 }
 
@@ -173,7 +203,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: MemberDayStat.QueryXXX(), the query will be executed
+// applies a query, for example: ActivityEvent.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
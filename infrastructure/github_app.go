@@ -0,0 +1,226 @@
+package infrastructure
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	// appJWTLifetime は GitHub App の JWT の有効期間です（GitHub の上限は 10 分）.
+	appJWTLifetime = 9 * time.Minute
+	// appJWTClockSkew は JWT の発行時刻を過去へずらす幅です（GitHub 側との時計のずれ対策）.
+	appJWTClockSkew = time.Minute
+	// installationTokenEarlyExpiry は、インストールトークンを有効期限のこの時間前に更新する幅です.
+	installationTokenEarlyExpiry = 5 * time.Minute
+	// installationTokenTimeout はインストールトークンの取得1回あたりのタイムアウトです.
+	installationTokenTimeout = 30 * time.Second
+)
+
+var (
+	// ErrInvalidAppConfig は GitHub App の認証設定が不正であることを表します.
+	ErrInvalidAppConfig = errors.New("invalid GitHub App configuration")
+	// ErrInstallationToken はインストールトークンを取得できなかったことを表します.
+	ErrInstallationToken = errors.New("failed to obtain GitHub App installation token")
+)
+
+// GitHubAppConfig は GitHub App のインストールとして認証するための設定です.
+type GitHubAppConfig struct {
+	// AppID は GitHub App の App ID です.
+	AppID int64
+	// InstallationID は組織（またはユーザー）へのインストールの ID です.
+	InstallationID int64
+	// PrivateKeyPath は GitHub App の秘密鍵（PEM）のファイルパスです.
+	PrivateKeyPath string
+}
+
+// Validate は GitHub App の認証設定を検証します.
+func (c *GitHubAppConfig) Validate() error {
+	switch {
+	case c.AppID <= 0:
+		return fmt.Errorf("%w: app ID is required", ErrInvalidAppConfig)
+	case c.InstallationID <= 0:
+		return fmt.Errorf("%w: installation ID is required", ErrInvalidAppConfig)
+	case c.PrivateKeyPath == "":
+		return fmt.Errorf("%w: private key file is required", ErrInvalidAppConfig)
+	default:
+		return nil
+	}
+}
+
+// RESTEndpoint は GitHub API の URL から REST API のベース URL を求めます.
+// github.com（空文字・https://api.github.com）は https://api.github.com、GitHub Enterprise Server は /api/v3 です.
+func RESTEndpoint(apiURL string) (string, error) {
+	graphqlURL, err := GraphQLEndpoint(apiURL)
+	if err != nil {
+		return "", err
+	}
+
+	if graphqlURL == "" {
+		return "https://" + publicAPIHost, nil
+	}
+
+	return strings.TrimSuffix(graphqlURL, "/graphql") + "/v3", nil
+}
+
+// newInstallationTokenSource は GitHub App のインストールトークンを発行し、有効期限の
+// installationTokenEarlyExpiry 前に自動で再発行する TokenSource を作成します.
+func newInstallationTokenSource(app *GitHubAppConfig, restBase string, httpClient *http.Client) (oauth2.TokenSource, error) {
+	if err := app.Validate(); err != nil {
+		return nil, err
+	}
+
+	key, err := loadAppPrivateKey(app.PrivateKeyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	src := &installationTokenSource{
+		httpClient:     httpClient,
+		restBase:       restBase,
+		appID:          app.AppID,
+		installationID: app.InstallationID,
+		key:            key,
+		now:            time.Now,
+	}
+
+	return oauth2.ReuseTokenSourceWithExpiry(nil, src, installationTokenEarlyExpiry), nil
+}
+
+// loadAppPrivateKey は GitHub App の秘密鍵（PKCS#1 または PKCS#8 の RSA 鍵）を読み込みます.
+func loadAppPrivateKey(path string) (*rsa.PrivateKey, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("read GitHub App private key: %w", err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("%w: %s is not a PEM file", ErrInvalidAppConfig, path)
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s is not an RSA private key: %w", ErrInvalidAppConfig, path, err)
+	}
+
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s is not an RSA private key", ErrInvalidAppConfig, path)
+	}
+
+	return key, nil
+}
+
+// signAppJWT は GitHub App として API を呼ぶための RS256 の JWT を作成します.
+func signAppJWT(appID int64, key *rsa.PrivateKey, now time.Time) (string, error) {
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	if err != nil {
+		return "", fmt.Errorf("encode JWT header: %w", err)
+	}
+
+	claims, err := json.Marshal(map[string]any{
+		"iat": now.Add(-appJWTClockSkew).Unix(),
+		"exp": now.Add(appJWTLifetime).Unix(),
+		"iss": strconv.FormatInt(appID, 10),
+	})
+	if err != nil {
+		return "", fmt.Errorf("encode JWT claims: %w", err)
+	}
+
+	enc := base64.RawURLEncoding
+	signingInput := enc.EncodeToString(header) + "." + enc.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(signingInput))
+
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("sign JWT: %w", err)
+	}
+
+	return signingInput + "." + enc.EncodeToString(sig), nil
+}
+
+// installationTokenSource は JWT をインストールトークンへ交換する oauth2.TokenSource です.
+// 呼び出しのたびに交換するため、ReuseTokenSourceWithExpiry で包んで使います.
+type installationTokenSource struct {
+	httpClient     *http.Client
+	restBase       string
+	appID          int64
+	installationID int64
+	key            *rsa.PrivateKey
+	now            func() time.Time
+}
+
+// Token はインストールトークンを新たに発行します.
+func (s *installationTokenSource) Token() (*oauth2.Token, error) {
+	jwt, err := signAppJWT(s.appID, s.key, s.now())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), installationTokenTimeout)
+	defer cancel()
+
+	endpoint := s.restBase + "/app/installations/" + url.PathEscape(strconv.FormatInt(s.installationID, 10)) + "/access_tokens"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(nil))
+	if err != nil {
+		return nil, fmt.Errorf("build installation token request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+jwt)
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInstallationToken, err)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err = errors.Join(err, resp.Body.Close()); err != nil {
+		return nil, fmt.Errorf("%w: read response: %w", ErrInstallationToken, err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("%w: installation %d: %s: %s",
+			ErrInstallationToken, s.installationID, resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	var payload struct {
+		Token     string    `json:"token"`
+		ExpiresAt time.Time `json:"expires_at"`
+	}
+
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("%w: decode response: %w", ErrInstallationToken, err)
+	}
+
+	if payload.Token == "" {
+		return nil, fmt.Errorf("%w: response contains no token", ErrInstallationToken)
+	}
+
+	return &oauth2.Token{AccessToken: payload.Token, TokenType: "Bearer", Expiry: payload.ExpiresAt}, nil
+}
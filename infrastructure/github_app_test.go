@@ -0,0 +1,271 @@
+package infrastructure
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testAppKey is generated once; RSA key generation is slow enough to matter
+// when every test does it.
+var testAppKey = sync.OnceValue(func() *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+
+	return key
+})
+
+// writeAppKey writes the test key to a PEM file in the given encoding.
+func writeAppKey(t *testing.T, pkcs8 bool) string {
+	t.Helper()
+
+	block := &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(testAppKey())}
+
+	if pkcs8 {
+		der, err := x509.MarshalPKCS8PrivateKey(testAppKey())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		block = &pem.Block{Type: "PRIVATE KEY", Bytes: der}
+	}
+
+	path := filepath.Join(t.TempDir(), "app.pem")
+	if err := os.WriteFile(path, pem.EncodeToMemory(block), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+// verifyAppJWT checks the RS256 signature of token against the test key and
+// returns its claims. It does not call t.Fatal, so that HTTP handlers can use it.
+func verifyAppJWT(token string) (map[string]any, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("JWT has %d parts, want 3: %q", len(parts), token)
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("decode signature: %w", err)
+	}
+
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(&testAppKey().PublicKey, crypto.SHA256, digest[:], sig); err != nil {
+		return nil, fmt.Errorf("JWT signature does not verify: %w", err)
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("decode claims: %w", err)
+	}
+
+	var claims map[string]any
+	if err := json.Unmarshal(raw, &claims); err != nil {
+		return nil, fmt.Errorf("unmarshal claims: %w", err)
+	}
+
+	return claims, nil
+}
+
+func TestSignAppJWT(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, time.March, 15, 9, 30, 0, 0, time.UTC)
+
+	token, err := signAppJWT(12345, testAppKey(), now)
+	if err != nil {
+		t.Fatalf("signAppJWT: %v", err)
+	}
+
+	claims, err := verifyAppJWT(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if claims["iss"] != "12345" {
+		t.Errorf("iss = %v, want \"12345\"", claims["iss"])
+	}
+
+	if iat := int64(claims["iat"].(float64)); iat != now.Add(-appJWTClockSkew).Unix() {
+		t.Errorf("iat = %d, want %d", iat, now.Add(-appJWTClockSkew).Unix())
+	}
+
+	if exp := int64(claims["exp"].(float64)); exp != now.Add(appJWTLifetime).Unix() {
+		t.Errorf("exp = %d, want %d", exp, now.Add(appJWTLifetime).Unix())
+	}
+}
+
+func TestLoadAppPrivateKey(t *testing.T) {
+	t.Parallel()
+
+	for _, pkcs8 := range []bool{false, true} {
+		key, err := loadAppPrivateKey(writeAppKey(t, pkcs8))
+		if err != nil {
+			t.Fatalf("loadAppPrivateKey(pkcs8=%v): %v", pkcs8, err)
+		}
+
+		if !key.Equal(testAppKey()) {
+			t.Errorf("loadAppPrivateKey(pkcs8=%v) returned a different key", pkcs8)
+		}
+	}
+
+	notPEM := filepath.Join(t.TempDir(), "not.pem")
+	if err := os.WriteFile(notPEM, []byte("not a key\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := loadAppPrivateKey(notPEM); !errors.Is(err, ErrInvalidAppConfig) {
+		t.Errorf("loadAppPrivateKey(non-PEM) error = %v, want ErrInvalidAppConfig", err)
+	}
+}
+
+func TestRESTEndpoint(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"":                                     "https://api.github.com",
+		"https://api.github.com":               "https://api.github.com",
+		"https://ghes.example.com/api/v3":      "https://ghes.example.com/api/v3",
+		"https://ghes.example.com":             "https://ghes.example.com/api/v3",
+		"https://ghes.example.com/api/graphql": "https://ghes.example.com/api/v3",
+	}
+
+	for apiURL, want := range tests {
+		got, err := RESTEndpoint(apiURL)
+		if err != nil {
+			t.Fatalf("RESTEndpoint(%q): %v", apiURL, err)
+		}
+
+		if got != want {
+			t.Errorf("RESTEndpoint(%q) = %q, want %q", apiURL, got, want)
+		}
+	}
+}
+
+func TestGitHubAppConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	for _, cfg := range []GitHubAppConfig{
+		{InstallationID: 2, PrivateKeyPath: "app.pem"},
+		{AppID: 1, PrivateKeyPath: "app.pem"},
+		{AppID: 1, InstallationID: 2},
+	} {
+		if err := cfg.Validate(); !errors.Is(err, ErrInvalidAppConfig) {
+			t.Errorf("Validate(%+v) error = %v, want ErrInvalidAppConfig", cfg, err)
+		}
+	}
+}
+
+// TestInstallationTokenSource exchanges the App JWT against a fake REST API and
+// checks that a token about to expire is replaced while a fresh one is reused.
+func TestInstallationTokenSource(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu       sync.Mutex
+		issued   int
+		lifetime = 2 * time.Minute // shorter than installationTokenEarlyExpiry
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v3/app/installations/42/access_tokens" {
+			http.Error(w, "unexpected request "+r.Method+" "+r.URL.Path, http.StatusNotFound)
+
+			return
+		}
+
+		claims, err := verifyAppJWT(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "))
+		if err != nil || claims["iss"] != "7" {
+			http.Error(w, fmt.Sprintf("bad JWT: %v (claims %v)", err, claims), http.StatusUnauthorized)
+
+			return
+		}
+
+		mu.Lock()
+		issued++
+		n, ttl := issued, lifetime
+		mu.Unlock()
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"token":"ghs_%d","expires_at":%q}`, n, time.Now().Add(ttl).UTC().Format(time.RFC3339))
+	}))
+	defer server.Close()
+
+	app := &GitHubAppConfig{AppID: 7, InstallationID: 42, PrivateKeyPath: writeAppKey(t, false)}
+
+	ts, err := newInstallationTokenSource(app, server.URL+"/api/v3", server.Client())
+	if err != nil {
+		t.Fatalf("newInstallationTokenSource: %v", err)
+	}
+
+	first, err := ts.Token()
+	if err != nil {
+		t.Fatalf("Token: %v", err)
+	}
+
+	if first.AccessToken != "ghs_1" {
+		t.Fatalf("first token = %q, want ghs_1", first.AccessToken)
+	}
+
+	mu.Lock()
+	lifetime = time.Hour
+	mu.Unlock()
+
+	// ghs_1 expires within the early-expiry window, so it is refreshed.
+	second, err := ts.Token()
+	if err != nil {
+		t.Fatalf("Token: %v", err)
+	}
+
+	// ghs_2 is valid for an hour, so it is reused.
+	third, err := ts.Token()
+	if err != nil {
+		t.Fatalf("Token: %v", err)
+	}
+
+	if second.AccessToken != "ghs_2" || third.AccessToken != "ghs_2" {
+		t.Errorf("tokens = %q, %q, want ghs_2 refreshed once and then reused", second.AccessToken, third.AccessToken)
+	}
+}
+
+func TestInstallationTokenSource_ErrorStatus(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = io.WriteString(w, `{"message":"Not Found"}`)
+	}))
+	defer server.Close()
+
+	app := &GitHubAppConfig{AppID: 7, InstallationID: 42, PrivateKeyPath: writeAppKey(t, true)}
+
+	ts, err := newInstallationTokenSource(app, server.URL, server.Client())
+	if err != nil {
+		t.Fatalf("newInstallationTokenSource: %v", err)
+	}
+
+	_, err = ts.Token()
+	if !errors.Is(err, ErrInstallationToken) || !strings.Contains(err.Error(), "Not Found") {
+		t.Errorf("Token error = %v, want ErrInstallationToken with the response body", err)
+	}
+}
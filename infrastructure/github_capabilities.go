@@ -0,0 +1,94 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrUnsupportedField は接続先の GraphQL スキーマが取得に必要なフィールドを提供しないことを表します.
+var ErrUnsupportedField = errors.New("field is not provided by the GitHub server")
+
+// GitHubCapabilities は接続先の GraphQL スキーマが、フェッチャーの使うフィールドを提供しているかを表します.
+// github.com は常にすべてを提供します. 古い GitHub Enterprise Server では欠けていることがあり、
+// 欠けている取得対象はエラーにせず空として扱い、メンバーごとの DataGap として記録します（Missing に欠けているフィールドを記録します）.
+type GitHubCapabilities struct {
+	// CommitContributions は ContributionsCollection.commitContributionsByRepository を利用できるかどうかです.
+	CommitContributions bool
+	// ReviewContributions は ContributionsCollection.pullRequestReviewContributionsByRepository を利用できるかどうかです.
+	ReviewContributions bool
+	// Missing は欠けているフィールドを "Type.field" 形式で列挙したものです.
+	Missing []string
+}
+
+// fullCapabilities は github.com（すべてのフィールドを提供）の GitHubCapabilities を返します.
+func fullCapabilities() *GitHubCapabilities {
+	return &GitHubCapabilities{CommitContributions: true, ReviewContributions: true}
+}
+
+// introspectedType はスキーマイントロスペクションで取得する型のフィールド一覧です（型が無ければ nil）.
+type introspectedType struct {
+	Fields []struct {
+		Name string
+	}
+}
+
+// has は型がフィールド name を持つかどうかを返します.
+func (t *introspectedType) has(name string) bool {
+	if t == nil {
+		return false
+	}
+
+	for _, f := range t.Fields {
+		if f.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// DetectCapabilities は接続先のスキーマをイントロスペクションし、GitHubCapabilities を求めます.
+// github.com に接続している場合は問い合わせずにすべて利用可能として返します.
+func DetectCapabilities(ctx context.Context, client *GitHubClient) (*GitHubCapabilities, error) {
+	if !client.IsEnterprise() {
+		return fullCapabilities(), nil
+	}
+
+	var query struct {
+		User                    *introspectedType `graphql:"user: __type(name: \"User\")"`
+		ContributionsCollection *introspectedType `graphql:"contributionsCollection: __type(name: \"ContributionsCollection\")"`
+	}
+
+	if err := client.Query(ctx, &query, nil); err != nil {
+		return nil, fmt.Errorf("failed to introspect GraphQL schema at %s: %w", client.Endpoint(), err)
+	}
+
+	caps := &GitHubCapabilities{}
+	require := func(t *introspectedType, typeName, field string) bool {
+		if t.has(field) {
+			return true
+		}
+
+		caps.Missing = append(caps.Missing, typeName+"."+field)
+
+		return false
+	}
+
+	if require(query.User, "User", "contributionsCollection") {
+		caps.CommitContributions = require(query.ContributionsCollection, "ContributionsCollection", "commitContributionsByRepository")
+		caps.ReviewContributions = require(query.ContributionsCollection, "ContributionsCollection", "pullRequestReviewContributionsByRepository")
+	}
+
+	return caps, nil
+}
+
+// String は欠けているフィールドの一覧を返します（すべて利用可能なら "all supported"）.
+func (c *GitHubCapabilities) String() string {
+	if len(c.Missing) == 0 {
+		return "all supported"
+	}
+
+	return "missing " + strings.Join(c.Missing, ", ")
+}
@@ -4,8 +4,15 @@ package infrastructure
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
-	"sync"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/shurcooL/githubv4"
@@ -13,12 +20,42 @@ import (
 	"golang.org/x/time/rate"
 )
 
+// publicAPIHost は github.com の API ホストです.
+const publicAPIHost = "api.github.com"
+
+var (
+	// ErrInvalidGitHubURL は GitHub API の URL から GraphQL エンドポイントを決められないことを表します.
+	ErrInvalidGitHubURL = errors.New("invalid GitHub API URL")
+	// ErrEmptyCABundle は CA バンドルに証明書が1つも含まれていないことを表します.
+	ErrEmptyCABundle = errors.New("CA bundle contains no certificates")
+)
+
 // GitHubClient はGitHub APIとの通信を担当するクライアントです.
+// 複数のトークンを設定した場合は、クエリごとに残量の最も多いトークンを使います.
 type GitHubClient struct {
-	client             *githubv4.Client
-	limiter            *rate.Limiter
-	mu                 sync.Mutex
-	lastRateLimitReset time.Time
+	pool    *tokenPool
+	limiter *rate.Limiter
+	retry   retryPolicy
+	// endpoint は GitHub Enterprise Server の GraphQL エンドポイントです（github.com の場合は空文字）.
+	endpoint string
+}
+
+// GitHubClientConfig は GitHubClient の接続設定です.
+type GitHubClientConfig struct {
+	// Token は GitHub Personal Access Token です.
+	Token string
+	// Tokens は Token に加えて使う Personal Access Token です.
+	// レート制限の残量を見ながら、クエリごとに残量の最も多いトークンへ振り分けます.
+	Tokens []string
+	// APIURL は GitHub Enterprise Server の API の URL です（例: https://ghes.example.com/api/v3）.
+	// 空文字または https://api.github.com の場合は github.com に接続します.
+	APIURL string
+	// CABundlePath は追加で信頼する CA 証明書（PEM）のファイルパスです（空文字ならシステムの CA のみ）.
+	CABundlePath string
+	// App が設定されている場合は Token の代わりに GitHub App のインストールとして認証します.
+	App *GitHubAppConfig
+	// OnRateLimitWait は、すべてのトークンのレート制限を使い切って until まで待機を始めるときに呼ばれます（nil 可）.
+	OnRateLimitWait func(until time.Time)
 }
 
 // RateLimitInfo はAPIレート制限情報を表します.
@@ -30,39 +67,194 @@ type RateLimitInfo struct {
 // NewGitHubClient は新しいGitHubClientを作成します
 // token: GitHub Personal Access Token（環境変数 GITHUB_TOKEN から読み込む想定）
 func NewGitHubClient(token string) *GitHubClient {
-	ctx := context.Background()
 	ts := oauth2.StaticTokenSource(
 		&oauth2.Token{AccessToken: token},
 	)
-	tc := oauth2.NewClient(ctx, ts)
 
-	// GraphQL APIのrate limitは5000リクエスト/時
-	// 安全のため、4500リクエスト/時に制限
+	tokens := []*pooledToken{{
+		client: githubv4.NewClient(oauth2.NewClient(recordingContext(http.DefaultTransport), ts)),
+		budget: TokenBudget{Name: "token 1"},
+	}}
+
+	return newGitHubClient(newTokenPool(tokens, nil), "")
+}
+
+// NewGitHubClientFromConfig は接続設定から GitHubClient を作成します.
+// APIURL が GitHub Enterprise Server を指す場合は、その GraphQL エンドポイントに接続します.
+func NewGitHubClientFromConfig(cfg GitHubClientConfig) (*GitHubClient, error) {
+	endpoint, err := GraphQLEndpoint(cfg.APIURL)
+	if err != nil {
+		return nil, err
+	}
+
+	transport, err := newTransport(cfg.CABundlePath)
+	if err != nil {
+		return nil, err
+	}
+
+	sources, err := newTokenSources(cfg, &http.Client{Transport: transport})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := recordingContext(transport)
+	tokens := make([]*pooledToken, len(sources))
+
+	for i, src := range sources {
+		httpClient := oauth2.NewClient(ctx, src.TokenSource)
+
+		client := githubv4.NewClient(httpClient)
+		if endpoint != "" {
+			client = githubv4.NewEnterpriseClient(endpoint, httpClient)
+		}
+
+		tokens[i] = &pooledToken{client: client, budget: TokenBudget{Name: src.name}}
+	}
+
+	return newGitHubClient(newTokenPool(tokens, cfg.OnRateLimitWait), endpoint), nil
+}
+
+// recordingContext は、oauth2 のクライアントが responseRecorder を挟んだ transport で通信するコンテキストを返します.
+func recordingContext(transport http.RoundTripper) context.Context {
+	return context.WithValue(context.Background(), oauth2.HTTPClient, &http.Client{Transport: &responseRecorder{base: transport}})
+}
+
+// namedTokenSource は表示名付きのトークンの取得元です.
+type namedTokenSource struct {
+	oauth2.TokenSource
+
+	name string
+}
+
+// newTokenSources は接続設定に応じたトークンの取得元を作成します.
+// GitHub App が設定されていればインストールトークン（期限前に自動更新）、そうでなければ Token と Tokens を使います.
+func newTokenSources(cfg GitHubClientConfig, httpClient *http.Client) ([]namedTokenSource, error) {
+	if cfg.App == nil {
+		tokens := append([]string{cfg.Token}, cfg.Tokens...)
+		sources := make([]namedTokenSource, 0, len(tokens))
+
+		for _, token := range tokens {
+			if token == "" && len(sources) > 0 {
+				continue
+			}
+
+			sources = append(sources, namedTokenSource{
+				TokenSource: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token}),
+				name:        fmt.Sprintf("token %d", len(sources)+1),
+			})
+		}
+
+		return sources, nil
+	}
+
+	restBase, err := RESTEndpoint(cfg.APIURL)
+	if err != nil {
+		return nil, err
+	}
+
+	ts, err := newInstallationTokenSource(cfg.App, restBase, httpClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return []namedTokenSource{{TokenSource: ts, name: fmt.Sprintf("GitHub App installation %d", cfg.App.InstallationID)}}, nil
+}
+
+// newGitHubClient はレート制限付きの GitHubClient を作成します.
+func newGitHubClient(pool *tokenPool, endpoint string) *GitHubClient {
+	// GraphQL APIのrate limitは5000リクエスト/時（トークンごと）
+	// 短時間に集中しないよう、トークンあたり4500リクエスト/時に均す
 	const requestsPerHour = 4500
 
-	limiter := rate.NewLimiter(rate.Every(time.Hour/requestsPerHour), 1)
+	limiter := rate.NewLimiter(rate.Every(time.Hour/time.Duration(requestsPerHour*len(pool.tokens))), 1)
 
 	return &GitHubClient{
-		client:  githubv4.NewClient(tc),
-		limiter: limiter,
+		pool:     pool,
+		limiter:  limiter,
+		retry:    defaultRetryPolicy,
+		endpoint: endpoint,
 	}
 }
 
-// WaitForRateLimit はrate limitを考慮して待機します.
-func (c *GitHubClient) WaitForRateLimit(ctx context.Context) error {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-
-	// レート制限のリセット時刻を確認
-	if !c.lastRateLimitReset.IsZero() && time.Now().Before(c.lastRateLimitReset) {
-		waitTime := time.Until(c.lastRateLimitReset)
-		select {
-		case <-ctx.Done():
-			return fmt.Errorf("context cancelled: %w", ctx.Err())
-		case <-time.After(waitTime):
-		}
+// IsEnterprise は GitHub Enterprise Server に接続しているかどうかを返します.
+func (c *GitHubClient) IsEnterprise() bool {
+	return c.endpoint != ""
+}
+
+// Endpoint は接続先の GraphQL エンドポイントを返します.
+func (c *GitHubClient) Endpoint() string {
+	if c.endpoint == "" {
+		return "https://" + publicAPIHost + "/graphql"
 	}
 
+	return c.endpoint
+}
+
+// GraphQLEndpoint は GitHub API の URL から GraphQL エンドポイントを求めます.
+// 空文字と https://api.github.com は github.com を表し、空文字を返します.
+// GitHub Enterprise Server は REST の URL（/api/v3）・/api・ホストのみ・/api/graphql のいずれでも指定できます.
+func GraphQLEndpoint(apiURL string) (string, error) {
+	apiURL = strings.TrimSpace(apiURL)
+	if apiURL == "" {
+		return "", nil
+	}
+
+	u, err := url.Parse(apiURL)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return "", fmt.Errorf("%w: %q (expected e.g. https://ghes.example.com/api/v3)", ErrInvalidGitHubURL, apiURL)
+	}
+
+	if strings.EqualFold(u.Host, publicAPIHost) {
+		return "", nil
+	}
+
+	path := strings.TrimSuffix(u.Path, "/")
+
+	switch {
+	case strings.HasSuffix(path, "/graphql"):
+	case path == "", path == "/api":
+		path = "/api/graphql"
+	case strings.HasSuffix(path, "/api/v3"):
+		path = strings.TrimSuffix(path, "/v3") + "/graphql"
+	default:
+		return "", fmt.Errorf("%w: %q (expected e.g. https://ghes.example.com/api/v3)", ErrInvalidGitHubURL, apiURL)
+	}
+
+	return (&url.URL{Scheme: u.Scheme, Host: u.Host, Path: path}).String(), nil
+}
+
+// newTransport は caBundlePath の CA 証明書をシステムの CA に加えて信頼する HTTP トランスポートを作成します.
+// caBundlePath が空文字の場合は既定のトランスポートを返します.
+func newTransport(caBundlePath string) (http.RoundTripper, error) {
+	base, ok := http.DefaultTransport.(*http.Transport)
+	if !ok || caBundlePath == "" {
+		return http.DefaultTransport, nil
+	}
+
+	pem, err := os.ReadFile(filepath.Clean(caBundlePath))
+	if err != nil {
+		return nil, fmt.Errorf("read CA bundle: %w", err)
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("%w: %s", ErrEmptyCABundle, caBundlePath)
+	}
+
+	transport := base.Clone()
+	transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+
+	return transport, nil
+}
+
+// WaitForRateLimit はrate limitを考慮して待機します.
+// 1つの GitHubClient を複数のワーカーで共有できるよう、待機中はロックを保持しません.
+// リクエスト頻度の上限はワーカー数によらずリミッターが保証します.
+func (c *GitHubClient) WaitForRateLimit(ctx context.Context) error {
 	if err := c.limiter.Wait(ctx); err != nil {
 		return fmt.Errorf("rate limiter wait failed: %w", err)
 	}
@@ -71,36 +263,116 @@ func (c *GitHubClient) WaitForRateLimit(ctx context.Context) error {
 }
 
 // Query はGraphQLクエリを実行します（rate limit対応）.
+// クエリには rateLimit { cost remaining resetAt } を加えて送り、応答からトークンの残量を更新します.
+// すべてのトークンの残量が尽きている場合は、最も早いリセット時刻まで待ってから実行します.
 func (c *GitHubClient) Query(ctx context.Context, q any, variables map[string]any) error {
+	return c.do(ctx, func(ctx context.Context, client *githubv4.Client) (*rateLimitFields, error) {
+		query, copyBack := queryWithRateLimit(q)
+		if err := client.Query(ctx, query, variables); err != nil {
+			return copyBack(), fmt.Errorf("graphql query failed: %w", err)
+		}
+
+		return copyBack(), nil
+	})
+}
+
+// do は残量の最も多いトークンで query を実行し、観測した rateLimit をそのトークンに記録します.
+// 失敗は GitHubAPIError に分類し、一時的な障害は指数バックオフ（Retry-After があればそれ以上）で、
+// レート制限はそのトークンを解除まで使わずに、retry.maxAttempts 回まで再試行します.
+func (c *GitHubClient) do(ctx context.Context, query func(ctx context.Context, client *githubv4.Client) (*rateLimitFields, error)) error {
+	for attempt := 0; ; attempt++ {
+		err := c.attempt(ctx, query)
+		if err == nil {
+			return nil
+		}
+
+		var apiErr *GitHubAPIError
+		if !errors.As(err, &apiErr) || !apiErr.retryable() || attempt+1 >= c.retry.maxAttempts {
+			return err
+		}
+
+		// レート制限ではトークンを使い切った状態にしてあるため、待機は acquire に任せます.
+		if errors.Is(apiErr, ErrGitHubRateLimited) {
+			continue
+		}
+
+		if err := sleepContext(ctx, c.retry.delay(attempt, apiErr)); err != nil {
+			return fmt.Errorf("retry wait failed: %w", err)
+		}
+	}
+}
+
+// attempt は query を1回実行し、失敗を分類して返します.
+func (c *GitHubClient) attempt(ctx context.Context, query func(ctx context.Context, client *githubv4.Client) (*rateLimitFields, error)) error {
 	if err := c.WaitForRateLimit(ctx); err != nil {
 		return fmt.Errorf("rate limit wait failed: %w", err)
 	}
 
-	err := c.client.Query(ctx, q, variables)
+	token, err := c.pool.acquire(ctx)
 	if err != nil {
-		return fmt.Errorf("graphql query failed: %w", err)
+		return fmt.Errorf("rate limit wait failed: %w", err)
 	}
 
-	return nil
+	info := &responseInfo{}
+	rl, err := query(withResponseInfo(ctx, info), token.client)
+	c.pool.record(token, rl)
+
+	if err == nil {
+		return nil
+	}
+
+	now := time.Now()
+	err = classifyGitHubError(err, info, now)
+
+	var apiErr *GitHubAPIError
+	if errors.As(err, &apiErr) && errors.Is(apiErr, ErrGitHubRateLimited) {
+		until := apiErr.ResetAt
+		if until.IsZero() {
+			until = now.Add(c.retry.delay(0, apiErr))
+		}
+
+		c.pool.exhaust(token, until)
+	}
+
+	return err
+}
+
+// sleepContext は d だけ待ちます. 待っている間に ctx が終了した場合はそのエラーを返します.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("context done: %w", ctx.Err())
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Budgets はトークンごとの、この実行でのポイント消費と最後に観測した残量を返します.
+func (c *GitHubClient) Budgets() []TokenBudget {
+	return c.pool.budgets()
 }
 
 // GetRateLimitInfo は現在のrate limit情報を取得します.
+// 複数のトークンがある場合は、問い合わせに使ったトークン（残量の最も多いもの）の情報です.
 func (c *GitHubClient) GetRateLimitInfo(ctx context.Context) (*RateLimitInfo, error) {
 	var query struct {
-		RateLimit struct {
-			Remaining int
-			ResetAt   time.Time
-		}
+		RateLimit rateLimitFields
 	}
 
-	if err := c.Query(ctx, &query, nil); err != nil {
+	err := c.do(ctx, func(ctx context.Context, client *githubv4.Client) (*rateLimitFields, error) {
+		if err := client.Query(ctx, &query, nil); err != nil {
+			return nil, fmt.Errorf("graphql query failed: %w", err)
+		}
+
+		return &query.RateLimit, nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	c.mu.Lock()
-	c.lastRateLimitReset = query.RateLimit.ResetAt
-	c.mu.Unlock()
-
 	return &RateLimitInfo{
 		Remaining: query.RateLimit.Remaining,
 		ResetAt:   query.RateLimit.ResetAt,
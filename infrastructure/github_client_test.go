@@ -0,0 +1,171 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGraphQLEndpoint(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		apiURL  string
+		want    string
+		wantErr bool
+	}{
+		{name: "empty means github.com", apiURL: "", want: ""},
+		{name: "public API URL means github.com", apiURL: "https://api.github.com", want: ""},
+		{name: "public API URL with trailing slash", apiURL: "https://api.github.com/", want: ""},
+		{name: "GHES REST URL", apiURL: "https://ghes.example.com/api/v3", want: "https://ghes.example.com/api/graphql"},
+		{name: "GHES REST URL with trailing slash", apiURL: "https://ghes.example.com/api/v3/", want: "https://ghes.example.com/api/graphql"},
+		{name: "GHES host only", apiURL: "https://ghes.example.com", want: "https://ghes.example.com/api/graphql"},
+		{name: "GHES api root", apiURL: "https://ghes.example.com/api", want: "https://ghes.example.com/api/graphql"},
+		{name: "GHES GraphQL URL is used as-is", apiURL: "https://ghes.example.com/api/graphql", want: "https://ghes.example.com/api/graphql"},
+		{name: "GHES behind a path prefix", apiURL: "https://example.com/github/api/v3", want: "https://example.com/github/api/graphql"},
+		{name: "missing scheme", apiURL: "ghes.example.com/api/v3", wantErr: true},
+		{name: "unsupported scheme", apiURL: "ftp://ghes.example.com/api/v3", wantErr: true},
+		{name: "unknown path", apiURL: "https://ghes.example.com/somewhere", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := GraphQLEndpoint(tt.apiURL)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidGitHubURL) {
+					t.Fatalf("GraphQLEndpoint(%q) error = %v, want ErrInvalidGitHubURL", tt.apiURL, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("GraphQLEndpoint(%q): %v", tt.apiURL, err)
+			}
+
+			if got != tt.want {
+				t.Errorf("GraphQLEndpoint(%q) = %q, want %q", tt.apiURL, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewGitHubClientFromConfig_CABundleErrors(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	empty := filepath.Join(dir, "empty.pem")
+
+	if err := os.WriteFile(empty, []byte("not a certificate\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := NewGitHubClientFromConfig(GitHubClientConfig{CABundlePath: empty}); !errors.Is(err, ErrEmptyCABundle) {
+		t.Errorf("bundle without certificates: error = %v, want ErrEmptyCABundle", err)
+	}
+
+	if _, err := NewGitHubClientFromConfig(GitHubClientConfig{CABundlePath: filepath.Join(dir, "missing.pem")}); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("missing bundle: error = %v, want os.ErrNotExist", err)
+	}
+}
+
+// TestDetectCapabilities_Enterprise runs the capability probe against a fake
+// GHES GraphQL endpoint served over TLS with a certificate that is only
+// trusted through the configured CA bundle.
+func TestDetectCapabilities_Enterprise(t *testing.T) {
+	t.Parallel()
+
+	var gotPath, gotAuth, gotQuery string
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotAuth = r.Header.Get("Authorization")
+
+		var body struct {
+			Query string `json:"query"`
+		}
+
+		raw, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(raw, &body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+
+		gotQuery = body.Query
+
+		// An old GHES: contributionsCollection exists, but without review contributions.
+		_, _ = io.WriteString(w, `{"data":{
+			"user":{"fields":[{"name":"login"},{"name":"contributionsCollection"}]},
+			"contributionsCollection":{"fields":[{"name":"commitContributionsByRepository"}]}
+		}}`)
+	}))
+	defer server.Close()
+
+	bundle := filepath.Join(t.TempDir(), "ca.pem")
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	if err := os.WriteFile(bundle, certPEM, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := NewGitHubClientFromConfig(GitHubClientConfig{
+		Token:        "secret",
+		APIURL:       server.URL + "/api/v3",
+		CABundlePath: bundle,
+	})
+	if err != nil {
+		t.Fatalf("NewGitHubClientFromConfig: %v", err)
+	}
+
+	if !client.IsEnterprise() || client.Endpoint() != server.URL+"/api/graphql" {
+		t.Fatalf("client endpoint = %q (enterprise %v), want %s/api/graphql", client.Endpoint(), client.IsEnterprise(), server.URL)
+	}
+
+	caps, err := DetectCapabilities(context.Background(), client)
+	if err != nil {
+		t.Fatalf("DetectCapabilities: %v", err)
+	}
+
+	if gotPath != "/api/graphql" || gotAuth != "Bearer secret" {
+		t.Errorf("request path = %q, auth = %q", gotPath, gotAuth)
+	}
+
+	if !strings.Contains(gotQuery, `contributionsCollection: __type(name: "ContributionsCollection")`) {
+		t.Errorf("query does not introspect ContributionsCollection: %s", gotQuery)
+	}
+
+	want := &GitHubCapabilities{
+		CommitContributions: true,
+		ReviewContributions: false,
+		Missing:             []string{"ContributionsCollection.pullRequestReviewContributionsByRepository"},
+	}
+	if !reflect.DeepEqual(caps, want) {
+		t.Errorf("DetectCapabilities = %+v, want %+v", caps, want)
+	}
+}
+
+func TestDetectCapabilities_PublicSkipsIntrospection(t *testing.T) {
+	t.Parallel()
+
+	caps, err := DetectCapabilities(context.Background(), NewGitHubClient("unused"))
+	if err != nil {
+		t.Fatalf("DetectCapabilities: %v", err)
+	}
+
+	if !caps.CommitContributions || !caps.ReviewContributions || len(caps.Missing) != 0 {
+		t.Errorf("DetectCapabilities(github.com) = %+v, want everything supported", caps)
+	}
+}
@@ -0,0 +1,79 @@
+package infrastructure
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/Tattsum/github-analytics/domain"
+)
+
+// commitContributionSpan はコミット貢献1件がまとめている期間です（GitHub は日ごとに1件の貢献として数えます）.
+const commitContributionSpan = 24 * time.Hour
+
+// fillCommitLineCounts は commits（コミット貢献）の追加・削除行数を、リポジトリごとにデフォルトブランチの履歴から求めて設定します.
+// 履歴は username が作者のコミットに絞り込み、最初の貢献から最後の貢献の1日後までを取得します.
+// 履歴を取得できなかったリポジトリの貢献は、取得できたページの分だけを設定し、欠けたことを gaps に記録します.
+func (f *GitHubDataFetcher) fillCommitLineCounts(
+	ctx context.Context,
+	username string,
+	commits []*domain.Activity,
+	gaps *dataGaps,
+) error {
+	if len(commits) == 0 {
+		return nil
+	}
+
+	authorID, err := f.repo.fetchUserID(ctx, username)
+	if err != nil {
+		return err
+	}
+
+	byRepo := make(map[string][]*domain.Activity)
+	repos := make([]string, 0)
+
+	for _, commit := range commits {
+		if _, ok := byRepo[commit.Repository]; !ok {
+			repos = append(repos, commit.Repository)
+		}
+
+		byRepo[commit.Repository] = append(byRepo[commit.Repository], commit)
+	}
+
+	for _, repo := range repos {
+		contributions := byRepo[repo]
+		slices.SortFunc(contributions, func(a, b *domain.Activity) int { return a.Date.Compare(b.Date) })
+
+		since := contributions[0].Date
+		until := contributions[len(contributions)-1].Date.Add(commitContributionSpan)
+
+		history, err := f.repo.fetchRepositoryCommits(ctx, authorID, repo, since, until)
+		matchCommitLineCounts(contributions, history)
+
+		if err != nil {
+			if err := gaps.record(domain.ActivityTypeCommit, repo, err); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// matchCommitLineCounts は history の各コミットの行数を、作成日時を含む貢献（発生日時から commitContributionSpan 以内）に加算します.
+// どの貢献にも含まれないコミット（貢献として数えられなかったもの）は無視します.
+// contributions は発生日時の昇順である必要があります.
+func matchCommitLineCounts(contributions []*domain.Activity, history []CommitNode) {
+	for _, commit := range history {
+		at := commit.Author.Date.Time
+
+		i := sort.Search(len(contributions), func(i int) bool { return contributions[i].Date.After(at) }) - 1
+		if i < 0 || !at.Before(contributions[i].Date.Add(commitContributionSpan)) {
+			continue
+		}
+
+		contributions[i].Additions += commit.Additions
+		contributions[i].Deletions += commit.Deletions
+	}
+}
@@ -0,0 +1,101 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Tattsum/github-analytics/domain"
+)
+
+func TestFillCommitLineCounts_MatchesHistoryToContributions(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query     string         `json:"query"`
+			Variables map[string]any `json:"variables"`
+		}
+
+		raw, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(raw, &body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+
+		switch {
+		case strings.Contains(body.Query, "user("):
+			_, _ = io.WriteString(w, `{"data":{"user":{"id":"U_alice"}}}`)
+		case body.Variables["name"] == "api":
+			author, _ := body.Variables["author"].(map[string]any)
+			if author["id"] != "U_alice" {
+				t.Errorf("history author = %v, want the user's node ID", body.Variables["author"])
+			}
+
+			_, _ = io.WriteString(w, `{"data":{"repository":{"name":"api","isPrivate":false,"defaultBranchRef":{"target":{"history":{
+				"totalCount":3,"nodes":[
+				{"oid":"a1","message":"","author":{"date":"2024-03-10T09:00:00Z","user":{"login":"alice"}},"additions":10,"deletions":2},
+				{"oid":"a2","message":"","author":{"date":"2024-03-10T18:00:00Z","user":{"login":"alice"}},"additions":5,"deletions":1},
+				{"oid":"a3","message":"","author":{"date":"2024-03-12T09:00:00Z","user":{"login":"alice"}},"additions":99,"deletions":99}
+			],"pageInfo":{"hasNextPage":false,"endCursor":""}}}}}}}`)
+		default:
+			w.WriteHeader(http.StatusForbidden)
+		}
+	}))
+	defer server.Close()
+
+	fetcher := NewGitHubDataFetcher(NewGitHubRepository(newTestClient(t, server.URL, "token")))
+
+	day := time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)
+	api := domain.NewActivity(domain.ActivityTypeCommit, "acme/api", day, 0, 0)
+	web := domain.NewActivity(domain.ActivityTypeCommit, "acme/web", day, 0, 0)
+	gaps := dataGaps{}
+
+	if err := fetcher.fillCommitLineCounts(context.Background(), "alice", []*domain.Activity{api, web}, &gaps); err != nil {
+		t.Fatalf("fillCommitLineCounts: %v", err)
+	}
+
+	// Both commits of March 10 belong to that day's contribution; the commit of
+	// March 12 was not counted as a contribution and is ignored.
+	if api.Additions != 15 || api.Deletions != 3 {
+		t.Errorf("acme/api lines = +%d -%d, want +15 -3", api.Additions, api.Deletions)
+	}
+
+	if web.Additions != 0 || len(gaps) != 1 || gaps[0].Repository != "acme/web" || gaps[0].Reason != domain.DataGapPermission {
+		t.Errorf("acme/web lines = +%d, gaps = %+v; want no lines and a permission gap", web.Additions, gaps)
+	}
+}
+
+func TestMatchCommitLineCounts(t *testing.T) {
+	t.Parallel()
+
+	base := time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)
+	first := domain.NewActivity(domain.ActivityTypeCommit, "acme/api", base, 0, 0)
+	second := domain.NewActivity(domain.ActivityTypeCommit, "acme/api", base.AddDate(0, 0, 1), 0, 0)
+
+	commit := func(at time.Time, additions int) CommitNode {
+		var c CommitNode
+		c.Author.Date.Time = at
+		c.Additions = additions
+
+		return c
+	}
+
+	matchCommitLineCounts([]*domain.Activity{first, second}, []CommitNode{
+		commit(base.Add(-time.Minute), 1),  // before every contribution
+		commit(base, 2),                    // start of the first day
+		commit(base.Add(23*time.Hour), 4),  // end of the first day
+		commit(base.Add(24*time.Hour), 8),  // start of the second day
+		commit(base.Add(48*time.Hour), 16), // after the last day
+	})
+
+	if first.Additions != 6 || second.Additions != 8 {
+		t.Errorf("additions = %d, %d; want 6, 8", first.Additions, second.Additions)
+	}
+}
@@ -3,22 +3,28 @@ package infrastructure
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/Tattsum/github-analytics/domain"
 	"github.com/shurcooL/githubv4"
 )
 
-// contributionLookbackYears はcontributionsCollectionを遡って取得する年数です.
+// contributionLookbackYears はcontributionsCollectionを遡って取得する年数の既定値です.
 const contributionLookbackYears = 10
 
 // GraphQL クエリ変数名（複数のクエリで共通利用するためまとめて定義）.
 const (
-	gqlVarLogin = "login"
-	gqlVarFrom  = "from"
-	gqlVarTo    = "to"
-	gqlVarFirst = "first"
-	gqlVarAfter = "after"
+	gqlVarLogin  = "login"
+	gqlVarFrom   = "from"
+	gqlVarTo     = "to"
+	gqlVarFirst  = "first"
+	gqlVarAfter  = "after"
+	gqlVarOwner  = "owner"
+	gqlVarName   = "name"
+	gqlVarSince  = "since"
+	gqlVarUntil  = "until"
+	gqlVarAuthor = "author"
 )
 
 // contributionWindow はcontributionsCollectionの取得期間（1年以内）を表します.
@@ -48,9 +54,36 @@ func yearlyWindows(start, end time.Time) []contributionWindow {
 	return windows
 }
 
+// contributionStart はcontributionsCollectionの取得開始日時を返します.
+// 差分取得の起点 since と遡り上限（now から years 年前）のうち、より新しい方を採用します.
+func contributionStart(since, now time.Time, years int) time.Time {
+	earliest := now.AddDate(-years, 0, 0)
+	if since.After(earliest) {
+		return since
+	}
+
+	return earliest
+}
+
 // GitHubDataFetcher はGitHub APIから各種データを取得するフェッチャーです.
 type GitHubDataFetcher struct {
 	repo *GitHubRepository
+	// commitLineCounts はコミット貢献の追加・削除行数をデフォルトブランチの履歴から取得するかどうかです.
+	commitLineCounts bool
+	// exclusion はPRのライフサイクルから除くレビュアーです（nil なら除外しない）.
+	exclusion *domain.ActorExclusion
+	// lookbackYears は活動を遡って取得する年数です（0 なら既定の遡り方）.
+	lookbackYears int
+	// period は活動を取得する期間です（ゼロ値なら既定の遡り方で取得時点まで）.
+	period domain.CollectionPeriod
+	// issueLabels はIssueの種類を判定するラベル名の対応です（nil なら既定の対応）.
+	issueLabels *domain.IssueLabelMapping
+	// prClassifier はPRの作業の種類を判定します（nil なら既定の規則）.
+	prClassifier *domain.PRClassifier
+
+	capsOnce sync.Once
+	caps     *GitHubCapabilities
+	capsErr  error
 }
 
 // NewGitHubDataFetcher は新しいGitHubDataFetcherを作成します.
@@ -60,6 +93,79 @@ func NewGitHubDataFetcher(repo *GitHubRepository) *GitHubDataFetcher {
 	}
 }
 
+// EnableCommitLineCounts は、コミット貢献ごとの追加・削除行数を取得するようにします.
+// コミットしたリポジトリごとにデフォルトブランチの履歴をたどるためクエリ数が大きく増えます.
+// 取得を始める前に呼び出してください.
+func (f *GitHubDataFetcher) EnableCommitLineCounts() {
+	f.commitLineCounts = true
+}
+
+// SetActorExclusion は、PRのライフサイクル（初回レビュー・承認・ラウンド）から exclusion に一致するレビュアーのレビューを除くようにします.
+// 活動そのものは除外せずに取得します（レビュー数からの除外は集計時に行います）.
+// 取得を始める前に呼び出してください.
+func (f *GitHubDataFetcher) SetActorExclusion(exclusion *domain.ActorExclusion) {
+	f.exclusion = exclusion
+}
+
+// SetLookbackYears は、活動を遡って取得する年数を years 年に制限します.
+// 既定ではコミット・レビュー貢献を contributionLookbackYears 年分、PR・Issue を全期間取得しますが、
+// 設定後はすべての活動を直近 years 年分だけ取得します. 取得を始める前に呼び出してください.
+func (f *GitHubDataFetcher) SetLookbackYears(years int) {
+	f.lookbackYears = years
+}
+
+// SetCollectionPeriod は、活動を取得する期間を period に制限します.
+// period.Until を設定するとコミット・レビュー貢献の取得期間はその日時で終わり、PR・Issue はそれ以降に作成されたものを読み飛ばします.
+// period.Since を設定するとその日時より前の活動は取得せず、PR・Issue のページネーションもそこで打ち切ります.
+// 遡る年数（SetLookbackYears）は period.Until から数えます. 取得を始める前に呼び出してください.
+func (f *GitHubDataFetcher) SetCollectionPeriod(period domain.CollectionPeriod) {
+	f.period = period
+}
+
+// SetIssueLabelMapping は、Issueの種類（不具合・機能追加）を mapping のラベル名で判定するようにします.
+// 種類は取得時に判定して活動に記録します. 取得を始める前に呼び出してください.
+func (f *GitHubDataFetcher) SetIssueLabelMapping(mapping *domain.IssueLabelMapping) {
+	f.issueLabels = mapping
+}
+
+// SetPRClassifier は、PRの作業の種類（機能追加・不具合修正・ドキュメントなど）を classifier で判定するようにします.
+// 種類は取得時に判定して活動に記録します. 取得を始める前に呼び出してください.
+func (f *GitHubDataFetcher) SetPRClassifier(classifier *domain.PRClassifier) {
+	f.prClassifier = classifier
+}
+
+// fetchEnd はコミット・レビュー貢献の取得期間の終わりを返します（期間の終わりが無いか未来なら現在時刻）.
+func (f *GitHubDataFetcher) fetchEnd() time.Time {
+	now := time.Now()
+	if f.period.Until.IsZero() || f.period.Until.After(now) {
+		return now
+	}
+
+	return f.period.Until
+}
+
+// contributionYears はコミット・レビュー貢献を遡って取得する年数を返します.
+func (f *GitHubDataFetcher) contributionYears() int {
+	if f.lookbackYears > 0 {
+		return f.lookbackYears
+	}
+
+	return contributionLookbackYears
+}
+
+// lookbackSince は取得の起点 since を、収集期間の始まりと遡る年数（設定されている場合）の範囲内に切り詰めます.
+func (f *GitHubDataFetcher) lookbackSince(since time.Time) time.Time {
+	if f.period.Since.After(since) {
+		since = f.period.Since
+	}
+
+	if f.lookbackYears <= 0 {
+		return since
+	}
+
+	return contributionStart(since, f.fetchEnd(), f.lookbackYears)
+}
+
 // UserActivityData はユーザーの全活動データを表します.
 type UserActivityData struct {
 	User    *domain.User
@@ -67,10 +173,72 @@ type UserActivityData struct {
 	PRs     []*domain.Activity
 	Issues  []*domain.Activity
 	Reviews []*domain.Activity
+	// IssueCloses はユーザーがIssueをクローズした活動です（日時はクローズ日時）.
+	IssueCloses []*domain.Activity
+	// PRLifecycles は PRs と同じPRのライフサイクル（レビュー・承認・マージ/クローズの日時）です.
+	PRLifecycles []*domain.PullRequestLifecycle
+	// IssueLifecycles は Issues と IssueCloses のIssueのライフサイクルです（同じIssueは1件）.
+	IssueLifecycles []*domain.IssueLifecycle
+	// Gaps は再試行しても取得できず、上記に含まれていない範囲です（空なら完全）.
+	Gaps []*domain.DataGap
+	// Accounts は User にまとめた GitHub アカウントです（複数のアカウントをまとめた場合のみ設定されます）.
+	Accounts []string
+}
+
+// dataGaps は1ユーザーの取得中に記録した DataGap です.
+type dataGaps []*domain.DataGap
+
+// record は repository の activityType の取得失敗を記録します.
+// 記録先が無い（nil の）場合は err を返し、呼び出し元が取得全体を失敗させます.
+func (g *dataGaps) record(activityType domain.ActivityType, repository string, err error) error {
+	if g == nil {
+		return err
+	}
+
+	*g = append(*g, &domain.DataGap{
+		ActivityType: activityType,
+		Repository:   repository,
+		Reason:       DataGapReasonOf(err),
+		Message:      err.Error(),
+	})
+
+	return nil
+}
+
+// Capabilities は接続先のスキーマが提供するフィールドを返します.
+// 初回の呼び出しでのみスキーマを問い合わせ、以後は（エラーも含めて）その結果を返します.
+func (f *GitHubDataFetcher) Capabilities(ctx context.Context) (*GitHubCapabilities, error) {
+	f.capsOnce.Do(func() {
+		f.caps, f.capsErr = DetectCapabilities(ctx, f.repo.client)
+	})
+
+	return f.caps, f.capsErr
 }
 
 // FetchAllUserActivity はユーザーの全活動データを取得します.
 func (f *GitHubDataFetcher) FetchAllUserActivity(ctx context.Context, username string, includePrivate bool) (*UserActivityData, error) {
+	return f.FetchUserActivitySince(ctx, username, includePrivate, time.Time{})
+}
+
+// FetchUserActivitySince は since 以降に発生したユーザーの活動データのみを取得します（差分取得）.
+// since がゼロ値の場合は contributionLookbackYears 年分（SetLookbackYears で設定した場合はその年数分）を遡る全期間取得になります.
+// SetCollectionPeriod で期間を設定した場合は、その期間内の活動だけを取得します.
+// 差分バッチは前回スナップショット以降の活動だけを取得し、永続化済みの日別行とマージします.
+// 接続先が提供しないフィールドに依存する取得対象（古い GitHub Enterprise Server のコミット貢献・レビュー貢献）は空になり、
+// 活動が無いメンバーと区別できるよう ErrUnsupportedField による DataGap として記録します.
+func (f *GitHubDataFetcher) FetchUserActivitySince(
+	ctx context.Context,
+	username string,
+	_ bool,
+	since time.Time,
+) (*UserActivityData, error) {
+	caps, err := f.Capabilities(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	since = f.lookbackSince(since)
+
 	user, err := f.repo.FetchUserInfo(ctx, username)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch user info: %w", err)
@@ -78,20 +246,49 @@ func (f *GitHubDataFetcher) FetchAllUserActivity(ctx context.Context, username s
 
 	// 並列で各種データを取得
 	type result struct {
-		commits []*domain.Activity
-		prs     []*domain.Activity
-		issues  []*domain.Activity
-		reviews []*domain.Activity
-		err     error
+		commits    []*domain.Activity
+		prs        []*domain.Activity
+		lifecycles []*domain.PullRequestLifecycle
+		issues     []*domain.Activity
+		closes     []*domain.Activity
+		issueLCs   []*domain.IssueLifecycle
+		reviews    []*domain.Activity
+		gaps       dataGaps
+		err        error
 	}
 
 	resultChan := make(chan result, 1)
 
 	go func() {
-		commits, err1 := f.FetchCommits(ctx, username, includePrivate)
-		prs, err2 := f.FetchPullRequests(ctx, username, includePrivate)
-		issues, err3 := f.FetchIssues(ctx, username, includePrivate)
-		reviews, err4 := f.FetchReviews(ctx, username, includePrivate)
+		var (
+			commits = make([]*domain.Activity, 0)
+			reviews = make([]*domain.Activity, 0)
+			gaps    = dataGaps{}
+			err1    error
+			err4    error
+		)
+
+		if caps.CommitContributions {
+			commits, err1 = f.fetchCommitsSince(ctx, username, since, &gaps)
+		} else {
+			err1 = gaps.record(domain.ActivityTypeCommit, "",
+				fmt.Errorf("%w: ContributionsCollection.commitContributionsByRepository", ErrUnsupportedField))
+		}
+
+		if err1 == nil && f.commitLineCounts {
+			err1 = f.fillCommitLineCounts(ctx, username, commits, &gaps)
+		}
+
+		prs, lifecycles, err2 := f.fetchPullRequestsSince(ctx, username, since)
+		issues, opened, err3 := f.fetchIssuesSince(ctx, username, since)
+		closes, closed, err5 := f.fetchIssueClosesSince(ctx, username, since, &gaps)
+
+		if caps.ReviewContributions {
+			reviews, err4 = f.fetchReviewsSince(ctx, username, since, &gaps)
+		} else {
+			err4 = gaps.record(domain.ActivityTypeReview, "",
+				fmt.Errorf("%w: ContributionsCollection.pullRequestReviewContributionsByRepository", ErrUnsupportedField))
+		}
 
 		err := err1
 		if err == nil {
@@ -106,12 +303,20 @@ func (f *GitHubDataFetcher) FetchAllUserActivity(ctx context.Context, username s
 			err = err4
 		}
 
+		if err == nil {
+			err = err5
+		}
+
 		resultChan <- result{
-			commits: commits,
-			prs:     prs,
-			issues:  issues,
-			reviews: reviews,
-			err:     err,
+			commits:    commits,
+			prs:        prs,
+			lifecycles: lifecycles,
+			issues:     issues,
+			closes:     closes,
+			issueLCs:   appendIssueLifecycles(opened, closed),
+			reviews:    reviews,
+			gaps:       gaps,
+			err:        err,
 		}
 	}()
 
@@ -121,11 +326,15 @@ func (f *GitHubDataFetcher) FetchAllUserActivity(ctx context.Context, username s
 	}
 
 	return &UserActivityData{
-		User:    user,
-		Commits: r.commits,
-		PRs:     r.prs,
-		Issues:  r.issues,
-		Reviews: r.reviews,
+		User:            user,
+		Commits:         r.commits,
+		PRs:             r.prs,
+		Issues:          r.issues,
+		Reviews:         r.reviews,
+		IssueCloses:     r.closes,
+		PRLifecycles:    r.lifecycles,
+		IssueLifecycles: r.issueLCs,
+		Gaps:            r.gaps,
 	}, nil
 }
 
@@ -195,6 +404,7 @@ func (f *GitHubDataFetcher) processCommitContributionsFromRepo(
 		)
 		activity.RepositoryOwner = repoContrib.Repository.Owner.Login
 		activity.RepositoryOwnerType = repoContrib.Repository.Owner.Typename
+		activity.DayOnly = true // 貢献の occurredAt は日単位のため時刻を持たない
 		activities = append(activities, activity)
 	}
 
@@ -268,6 +478,7 @@ func (f *GitHubDataFetcher) fetchRepositoryCommitContributionsPage(
 
 // fetchRepositoryCommitContributionsPaginated は特定リポジトリのコミット貢献をページネーションで取得します.
 // 最初のページは既に取得済みのため、2ページ目以降を取得します.
+// 途中のページで失敗した場合は、それまでに取得できた活動とエラーを返します.
 func (f *GitHubDataFetcher) fetchRepositoryCommitContributionsPaginated(
 	ctx context.Context,
 	username string,
@@ -281,7 +492,7 @@ func (f *GitHubDataFetcher) fetchRepositoryCommitContributionsPaginated(
 	for {
 		pageActivities, nextAfter, hasNext, err := f.fetchRepositoryCommitContributionsPage(ctx, username, repoName, from, to, after)
 		if err != nil {
-			return nil, err
+			return activities, err
 		}
 
 		if pageActivities == nil {
@@ -306,11 +517,23 @@ func (f *GitHubDataFetcher) fetchRepositoryCommitContributionsPaginated(
 // 変更行数の詳細が必要な場合は、各リポジトリのコミット履歴を個別に取得する必要があります。
 // ページネーション: 各リポジトリのContributionsをページネーションで取得します。
 func (f *GitHubDataFetcher) FetchCommits(ctx context.Context, username string, _ bool) ([]*domain.Activity, error) {
+	return f.fetchCommitsSince(ctx, username, time.Time{}, nil)
+}
+
+// fetchCommitsSince は since 以降のコミット貢献を取得します（since がゼロ値なら全期間）.
+// 2ページ目以降を取得できなかったリポジトリは gaps に記録します（gaps が nil ならエラーにします）.
+func (f *GitHubDataFetcher) fetchCommitsSince(
+	ctx context.Context,
+	username string,
+	since time.Time,
+	gaps *dataGaps,
+) ([]*domain.Activity, error) {
 	activities := make([]*domain.Activity, 0)
+	end := f.fetchEnd()
 
 	// GitHubのcontributionsCollectionはfrom/toの差が1年を超えるとエラーになるため、年単位で取得する.
-	for _, window := range yearlyWindows(time.Now().AddDate(-contributionLookbackYears, 0, 0), time.Now()) {
-		windowActivities, err := f.fetchCommitsWindow(ctx, username, window.from, window.to)
+	for _, window := range yearlyWindows(contributionStart(since, end, f.contributionYears()), end) {
+		windowActivities, err := f.fetchCommitsWindow(ctx, username, window.from, window.to, gaps)
 		if err != nil {
 			return nil, err
 		}
@@ -322,7 +545,12 @@ func (f *GitHubDataFetcher) FetchCommits(ctx context.Context, username string, _
 }
 
 // fetchCommitsWindow は1年以内のウィンドウのコミット貢献を取得します.
-func (f *GitHubDataFetcher) fetchCommitsWindow(ctx context.Context, username string, from, to githubv4.DateTime) ([]*domain.Activity, error) {
+func (f *GitHubDataFetcher) fetchCommitsWindow(
+	ctx context.Context,
+	username string,
+	from, to githubv4.DateTime,
+	gaps *dataGaps,
+) ([]*domain.Activity, error) {
 	var query struct {
 		User struct {
 			ContributionsCollection struct {
@@ -365,7 +593,7 @@ func (f *GitHubDataFetcher) fetchCommitsWindow(ctx context.Context, username str
 		return nil, fmt.Errorf("failed to fetch commits: %w", err)
 	}
 
-	return f.processCommitsWithPagination(ctx, username, query.User.ContributionsCollection.CommitContributionsByRepository, from, to)
+	return f.processCommitsWithPagination(ctx, username, query.User.ContributionsCollection.CommitContributionsByRepository, from, to, gaps)
 }
 
 // processCommitsWithPagination はコミット貢献をページネーションで処理します.
@@ -392,6 +620,7 @@ func (f *GitHubDataFetcher) processCommitsWithPagination(
 		} `graphql:"contributions(first: $first, after: $after)"`
 	},
 	from, to githubv4.DateTime,
+	gaps *dataGaps,
 ) ([]*domain.Activity, error) {
 	activities := make([]*domain.Activity, 0)
 
@@ -405,11 +634,12 @@ func (f *GitHubDataFetcher) processCommitsWithPagination(
 				domain.ActivityTypeCommit,
 				repoContrib.Repository.NameWithOwner,
 				contrib.OccurredAt.Time,
-				0, // Additions: GraphQL APIの制限により取得不可
-				0, // Deletions: GraphQL APIの制限により取得不可
+				0, // Additions: 貢献には含まれないため、commitLineCounts の場合に fillCommitLineCounts で設定
+				0, // Deletions: 同上
 			)
 			activity.RepositoryOwner = repoContrib.Repository.Owner.Login
 			activity.RepositoryOwnerType = repoContrib.Repository.Owner.Typename
+			activity.DayOnly = true // 貢献の occurredAt は日単位のため時刻を持たない
 			activities = append(activities, activity)
 		}
 
@@ -418,12 +648,14 @@ func (f *GitHubDataFetcher) processCommitsWithPagination(
 			startAfter := githubv4.String(repoContrib.Contributions.PageInfo.EndCursor)
 
 			repoActivities, err := f.fetchRepositoryCommitContributionsPaginated(ctx, username, repoName, from, to, &startAfter)
+			activities = append(activities, repoActivities...)
+
+			// 取得できたページは残し、欠けたことを記録して他のリポジトリの処理を続行
 			if err != nil {
-				// エラーが発生しても他のリポジトリの処理は続行
-				continue
+				if err := gaps.record(domain.ActivityTypeCommit, repoName, err); err != nil {
+					return nil, err
+				}
 			}
-
-			activities = append(activities, repoActivities...)
 		}
 	}
 
@@ -432,98 +664,192 @@ func (f *GitHubDataFetcher) processCommitsWithPagination(
 
 // FetchPullRequests はPull Requestを取得します.
 func (f *GitHubDataFetcher) FetchPullRequests(ctx context.Context, username string, _ bool) ([]*domain.Activity, error) {
-	var query struct {
-		User struct {
-			PullRequests struct {
-				TotalCount int
-				Nodes      []struct {
-					Title      string
-					CreatedAt  githubv4.DateTime
-					MergedAt   *githubv4.DateTime
-					Repository struct {
-						NameWithOwner string
-						Owner         struct {
-							Login    string
-							Typename string `graphql:"__typename"`
-						}
-					}
-					Additions int
-					Deletions int
-				}
-				PageInfo struct {
-					HasNextPage bool
-					EndCursor   string
-				}
-			} `graphql:"pullRequests(first: $first, after: $after, states: [OPEN, CLOSED, MERGED])"`
-		} `graphql:"user(login: $login)"`
-	}
+	activities, _, err := f.fetchPullRequestsSince(ctx, username, time.Time{})
 
-	activities := make([]*domain.Activity, 0)
-	first := 100
-	after := (*githubv4.String)(nil)
+	return activities, err
+}
 
-	for {
-		variables := map[string]any{
-			gqlVarLogin: githubv4.String(username),
-			gqlVarFirst: githubv4.Int(first),
-			gqlVarAfter: after,
+// pullRequestNode はPull Request検索結果の1件です.
+// サイクルタイム集計のため、レビュー（提出日時・状態・レビュアー）とクローズ日時も取得します.
+// 1PRあたりのレビューは先頭 50 件までを対象にします（それ以上の往復は稀なため、クエリコストを優先）.
+// 作業の種類の判定のため、ラベルは先頭 20 件、変更したファイルのパスは先頭 100 件を取得します.
+// レビュー負荷の集計のため、残っているレビュー依頼（先頭 20 件）とレビュー依頼のイベント（先頭 50 件）も取得します.
+type pullRequestNode struct {
+	ID         string
+	Title      string
+	CreatedAt  githubv4.DateTime
+	MergedAt   *githubv4.DateTime
+	ClosedAt   *githubv4.DateTime
+	Repository struct {
+		NameWithOwner string
+		Owner         struct {
+			Login    string
+			Typename string `graphql:"__typename"`
+		}
+	}
+	Additions    int
+	Deletions    int
+	ChangedFiles int
+	Labels       struct {
+		Nodes []struct {
+			Name string
+		}
+	} `graphql:"labels(first: 20)"`
+	Files struct {
+		Nodes []struct {
+			Path string
+		}
+	} `graphql:"files(first: 100)"`
+	Reviews struct {
+		Nodes []struct {
+			ID          string
+			State       string
+			SubmittedAt *githubv4.DateTime
+			Author      struct {
+				Login    string
+				Typename string `graphql:"__typename"`
+			}
+		}
+	} `graphql:"reviews(first: 50)"`
+	ReviewRequests struct {
+		Nodes []struct {
+			RequestedReviewer requestedReviewer
+		}
+	} `graphql:"reviewRequests(first: 20)"`
+	TimelineItems struct {
+		Nodes []struct {
+			ReviewRequestedEvent struct {
+				CreatedAt         githubv4.DateTime
+				RequestedReviewer requestedReviewer
+			} `graphql:"... on ReviewRequestedEvent"`
 		}
+	} `graphql:"timelineItems(itemTypes: [REVIEW_REQUESTED_EVENT], first: 50)"`
+}
 
-		if err := f.repo.client.Query(ctx, &query, variables); err != nil {
-			return nil, fmt.Errorf("failed to fetch pull requests: %w", err)
+// requestedReviewer はレビューを依頼された相手です（チームへの依頼はログインが空です）.
+type requestedReviewer struct {
+	User struct {
+		Login string
+	} `graphql:"... on User"`
+}
+
+// newPullRequestLifecycle はPull Requestの取得結果から、作成者 username のPRのライフサイクルを組み立てます.
+// exclusion に一致するレビュアー（ボット等）のレビューは、初回レビュー・承認・ラウンドに数えません.
+func newPullRequestLifecycle(username string, pr *pullRequestNode, exclusion *domain.ActorExclusion) *domain.PullRequestLifecycle {
+	reviews := make([]domain.PullRequestReview, 0, len(pr.Reviews.Nodes))
+
+	for _, review := range pr.Reviews.Nodes {
+		if review.SubmittedAt == nil || exclusion.Excludes(review.Author.Login, review.Author.Typename) {
+			continue
 		}
 
-		for _, pr := range query.User.PullRequests.Nodes {
-			activity := domain.NewActivity(
-				domain.ActivityTypePR,
-				pr.Repository.NameWithOwner,
-				pr.CreatedAt.Time,
-				pr.Additions,
-				pr.Deletions,
-			)
-			activity.RepositoryOwner = pr.Repository.Owner.Login
-			activity.RepositoryOwnerType = pr.Repository.Owner.Typename
-			activity.IsMerged = pr.MergedAt != nil
-			activities = append(activities, activity)
+		reviews = append(reviews, domain.PullRequestReview{
+			Author:      review.Author.Login,
+			State:       domain.PullRequestReviewState(review.State),
+			SubmittedAt: review.SubmittedAt.Time,
+		})
+	}
+
+	lifecycle := domain.NewPullRequestLifecycle(
+		pr.Repository.NameWithOwner,
+		pr.ID,
+		username,
+		pr.CreatedAt.Time,
+		optionalTime(pr.MergedAt),
+		optionalTime(pr.ClosedAt),
+		reviews,
+	)
+	lifecycle.Additions = pr.Additions
+	lifecycle.Deletions = pr.Deletions
+	lifecycle.ChangedFiles = pr.ChangedFiles
+	lifecycle.ReviewRequests = newReviewRequests(username, pr, reviews, exclusion)
+
+	return lifecycle
+}
+
+// newReviewRequests はPRのレビュー依頼のイベントと残っている依頼から、レビュアーごとの依頼を組み立てます.
+// exclusion に一致するレビュアー（ボット等）への依頼は数えません.
+func newReviewRequests(
+	username string,
+	pr *pullRequestNode,
+	reviews []domain.PullRequestReview,
+	exclusion *domain.ActorExclusion,
+) []domain.ReviewRequest {
+	const userTypename = "User"
+
+	requested := make([]domain.RequestedReview, 0, len(pr.TimelineItems.Nodes))
+
+	for _, item := range pr.TimelineItems.Nodes {
+		login := item.ReviewRequestedEvent.RequestedReviewer.User.Login
+		if login == "" || exclusion.Excludes(login, userTypename) {
+			continue
 		}
 
-		if !query.User.PullRequests.PageInfo.HasNextPage {
-			break
+		requested = append(requested, domain.RequestedReview{Reviewer: login, RequestedAt: item.ReviewRequestedEvent.CreatedAt.Time})
+	}
+
+	pending := make([]string, 0, len(pr.ReviewRequests.Nodes))
+
+	for _, request := range pr.ReviewRequests.Nodes {
+		login := request.RequestedReviewer.User.Login
+		if login == "" || exclusion.Excludes(login, userTypename) {
+			continue
 		}
 
-		cursor := githubv4.String(query.User.PullRequests.PageInfo.EndCursor)
-		after = &cursor
+		pending = append(pending, login)
 	}
 
-	return activities, nil
+	return domain.NewReviewRequests(username, pr.CreatedAt.Time, pr.ClosedAt == nil, requested, pending, reviews)
+}
+
+// classifyPullRequest はPRのラベル・タイトル・変更したファイルのパスから作業の種類を判定します.
+func classifyPullRequest(pr *pullRequestNode, classifier *domain.PRClassifier) domain.WorkCategory {
+	labels := make([]string, 0, len(pr.Labels.Nodes))
+	for _, label := range pr.Labels.Nodes {
+		labels = append(labels, label.Name)
+	}
+
+	paths := make([]string, 0, len(pr.Files.Nodes))
+	for _, file := range pr.Files.Nodes {
+		paths = append(paths, file.Path)
+	}
+
+	return classifier.Classify(labels, pr.Title, paths)
 }
 
-// FetchIssues はIssueを取得します.
-func (f *GitHubDataFetcher) FetchIssues(ctx context.Context, username string, _ bool) ([]*domain.Activity, error) {
+// optionalTime は GraphQL の nullable な日時を *time.Time へ変換します.
+func optionalTime(dt *githubv4.DateTime) *time.Time {
+	if dt == nil {
+		return nil
+	}
+
+	t := dt.Time
+
+	return &t
+}
+
+// fetchPullRequestsSince は since 以降に作成されたPull Requestを、活動とライフサイクルの組で取得します（since がゼロ値なら全件）.
+// 作成日時の降順で取得し、収集期間の終わり以降に作成されたPRは読み飛ばし、since より古いPRに到達した時点でページネーションを打ち切ります.
+func (f *GitHubDataFetcher) fetchPullRequestsSince(
+	ctx context.Context,
+	username string,
+	since time.Time,
+) ([]*domain.Activity, []*domain.PullRequestLifecycle, error) {
 	var query struct {
 		User struct {
-			Issues struct {
+			PullRequests struct {
 				TotalCount int
-				Nodes      []struct {
-					Title      string
-					CreatedAt  githubv4.DateTime
-					Repository struct {
-						NameWithOwner string
-						Owner         struct {
-							Login    string
-							Typename string `graphql:"__typename"`
-						}
-					}
-				}
-				PageInfo struct {
+				Nodes      []pullRequestNode
+				PageInfo   struct {
 					HasNextPage bool
 					EndCursor   string
 				}
-			} `graphql:"issues(first: $first, after: $after, states: [OPEN, CLOSED])"`
+			} `graphql:"pullRequests(first: $first, after: $after, states: [OPEN, CLOSED, MERGED], orderBy: {field: CREATED_AT, direction: DESC})"`
 		} `graphql:"user(login: $login)"`
 	}
 
 	activities := make([]*domain.Activity, 0)
+	lifecycles := make([]*domain.PullRequestLifecycle, 0)
 	first := 100
 	after := (*githubv4.String)(nil)
 
@@ -535,31 +861,47 @@ func (f *GitHubDataFetcher) FetchIssues(ctx context.Context, username string, _
 		}
 
 		if err := f.repo.client.Query(ctx, &query, variables); err != nil {
-			return nil, fmt.Errorf("failed to fetch issues: %w", err)
+			return nil, nil, fmt.Errorf("failed to fetch pull requests: %w", err)
 		}
 
-		for _, issue := range query.User.Issues.Nodes {
+		reachedSince := false
+
+		for _, pr := range query.User.PullRequests.Nodes {
+			if pr.CreatedAt.Before(since) {
+				reachedSince = true
+
+				continue
+			}
+
+			if f.period.EndsBy(pr.CreatedAt.Time) {
+				continue
+			}
+
 			activity := domain.NewActivity(
-				domain.ActivityTypeIssue,
-				issue.Repository.NameWithOwner,
-				issue.CreatedAt.Time,
-				0,
-				0,
+				domain.ActivityTypePR,
+				pr.Repository.NameWithOwner,
+				pr.CreatedAt.Time,
+				pr.Additions,
+				pr.Deletions,
 			)
-			activity.RepositoryOwner = issue.Repository.Owner.Login
-			activity.RepositoryOwnerType = issue.Repository.Owner.Typename
+			activity.RepositoryOwner = pr.Repository.Owner.Login
+			activity.RepositoryOwnerType = pr.Repository.Owner.Typename
+			activity.IsMerged = pr.MergedAt != nil
+			activity.SourceID = pr.ID
+			activity.WorkCategory = classifyPullRequest(&pr, f.prClassifier)
 			activities = append(activities, activity)
+			lifecycles = append(lifecycles, newPullRequestLifecycle(username, &pr, f.exclusion))
 		}
 
-		if !query.User.Issues.PageInfo.HasNextPage {
+		if reachedSince || !query.User.PullRequests.PageInfo.HasNextPage {
 			break
 		}
 
-		cursor := githubv4.String(query.User.Issues.PageInfo.EndCursor)
+		cursor := githubv4.String(query.User.PullRequests.PageInfo.EndCursor)
 		after = &cursor
 	}
 
-	return activities, nil
+	return activities, lifecycles, nil
 }
 
 // findReviewRepositoryInQuery はクエリ結果から指定されたリポジトリを探します.
@@ -577,7 +919,14 @@ func (f *GitHubDataFetcher) findReviewRepositoryInQuery(
 			Nodes      []struct {
 				OccurredAt        githubv4.DateTime
 				PullRequestReview struct {
-					State string
+					ID          string
+					State       string
+					PullRequest struct {
+						Author struct {
+							Login    string
+							Typename string `graphql:"__typename"`
+						}
+					}
 				}
 			}
 			PageInfo struct {
@@ -612,7 +961,14 @@ func (f *GitHubDataFetcher) processReviewContributionsFromRepo(
 			Nodes      []struct {
 				OccurredAt        githubv4.DateTime
 				PullRequestReview struct {
-					State string
+					ID          string
+					State       string
+					PullRequest struct {
+						Author struct {
+							Login    string
+							Typename string `graphql:"__typename"`
+						}
+					}
 				}
 			}
 			PageInfo struct {
@@ -635,6 +991,9 @@ func (f *GitHubDataFetcher) processReviewContributionsFromRepo(
 		activity.RepositoryOwner = repoContrib.Repository.Owner.Login
 		activity.RepositoryOwnerType = repoContrib.Repository.Owner.Typename
 		activity.IsReview = true
+		activity.SourceID = contrib.PullRequestReview.ID
+		activity.PullRequestAuthor = contrib.PullRequestReview.PullRequest.Author.Login
+		activity.PullRequestAuthorType = contrib.PullRequestReview.PullRequest.Author.Typename
 		activities = append(activities, activity)
 	}
 
@@ -665,7 +1024,14 @@ func (f *GitHubDataFetcher) fetchRepositoryReviewContributionsPage(
 						Nodes      []struct {
 							OccurredAt        githubv4.DateTime
 							PullRequestReview struct {
-								State string
+								ID          string
+								State       string
+								PullRequest struct {
+									Author struct {
+										Login    string
+										Typename string `graphql:"__typename"`
+									}
+								}
 							}
 						}
 						PageInfo struct {
@@ -711,6 +1077,7 @@ func (f *GitHubDataFetcher) fetchRepositoryReviewContributionsPage(
 
 // fetchRepositoryReviewContributionsPaginated は特定リポジトリのレビュー貢献をページネーションで取得します.
 // 最初のページは既に取得済みのため、2ページ目以降を取得します.
+// 途中のページで失敗した場合は、それまでに取得できた活動とエラーを返します.
 func (f *GitHubDataFetcher) fetchRepositoryReviewContributionsPaginated(
 	ctx context.Context,
 	username string,
@@ -724,7 +1091,7 @@ func (f *GitHubDataFetcher) fetchRepositoryReviewContributionsPaginated(
 	for {
 		pageActivities, nextAfter, hasNext, err := f.fetchRepositoryReviewContributionsPage(ctx, username, repoName, from, to, after)
 		if err != nil {
-			return nil, err
+			return activities, err
 		}
 
 		if pageActivities == nil {
@@ -746,11 +1113,23 @@ func (f *GitHubDataFetcher) fetchRepositoryReviewContributionsPaginated(
 // FetchReviews はPRレビューを取得します.
 // ページネーション: 各リポジトリのContributionsをページネーションで取得します。
 func (f *GitHubDataFetcher) FetchReviews(ctx context.Context, username string, _ bool) ([]*domain.Activity, error) {
+	return f.fetchReviewsSince(ctx, username, time.Time{}, nil)
+}
+
+// fetchReviewsSince は since 以降のレビュー貢献を取得します（since がゼロ値なら全期間）.
+// 2ページ目以降を取得できなかったリポジトリは gaps に記録します（gaps が nil ならエラーにします）.
+func (f *GitHubDataFetcher) fetchReviewsSince(
+	ctx context.Context,
+	username string,
+	since time.Time,
+	gaps *dataGaps,
+) ([]*domain.Activity, error) {
 	activities := make([]*domain.Activity, 0)
+	end := f.fetchEnd()
 
 	// GitHubのcontributionsCollectionはfrom/toの差が1年を超えるとエラーになるため、年単位で取得する.
-	for _, window := range yearlyWindows(time.Now().AddDate(-contributionLookbackYears, 0, 0), time.Now()) {
-		windowActivities, err := f.fetchReviewsWindow(ctx, username, window.from, window.to)
+	for _, window := range yearlyWindows(contributionStart(since, end, f.contributionYears()), end) {
+		windowActivities, err := f.fetchReviewsWindow(ctx, username, window.from, window.to, gaps)
 		if err != nil {
 			return nil, err
 		}
@@ -762,7 +1141,12 @@ func (f *GitHubDataFetcher) FetchReviews(ctx context.Context, username string, _
 }
 
 // fetchReviewsWindow は1年以内のウィンドウのレビュー貢献を取得します.
-func (f *GitHubDataFetcher) fetchReviewsWindow(ctx context.Context, username string, from, to githubv4.DateTime) ([]*domain.Activity, error) {
+func (f *GitHubDataFetcher) fetchReviewsWindow(
+	ctx context.Context,
+	username string,
+	from, to githubv4.DateTime,
+	gaps *dataGaps,
+) ([]*domain.Activity, error) {
 	var query struct {
 		User struct {
 			ContributionsCollection struct {
@@ -779,7 +1163,14 @@ func (f *GitHubDataFetcher) fetchReviewsWindow(ctx context.Context, username str
 						Nodes      []struct {
 							OccurredAt        githubv4.DateTime
 							PullRequestReview struct {
-								State string
+								ID          string
+								State       string
+								PullRequest struct {
+									Author struct {
+										Login    string
+										Typename string `graphql:"__typename"`
+									}
+								}
 							}
 						}
 						PageInfo struct {
@@ -808,7 +1199,7 @@ func (f *GitHubDataFetcher) fetchReviewsWindow(ctx context.Context, username str
 		return nil, fmt.Errorf("failed to fetch reviews: %w", err)
 	}
 
-	return f.processReviewsWithPagination(ctx, username, query.User.ContributionsCollection.PullRequestReviewContributionsByRepository, from, to)
+	return f.processReviewsWithPagination(ctx, username, query.User.ContributionsCollection.PullRequestReviewContributionsByRepository, from, to, gaps)
 }
 
 // processReviewsWithPagination はレビュー貢献をページネーションで処理します.
@@ -828,7 +1219,14 @@ func (f *GitHubDataFetcher) processReviewsWithPagination(
 			Nodes      []struct {
 				OccurredAt        githubv4.DateTime
 				PullRequestReview struct {
-					State string
+					ID          string
+					State       string
+					PullRequest struct {
+						Author struct {
+							Login    string
+							Typename string `graphql:"__typename"`
+						}
+					}
 				}
 			}
 			PageInfo struct {
@@ -838,6 +1236,7 @@ func (f *GitHubDataFetcher) processReviewsWithPagination(
 		} `graphql:"contributions(first: $first, after: $after)"`
 	},
 	from, to githubv4.DateTime,
+	gaps *dataGaps,
 ) ([]*domain.Activity, error) {
 	activities := make([]*domain.Activity, 0)
 
@@ -857,6 +1256,9 @@ func (f *GitHubDataFetcher) processReviewsWithPagination(
 			activity.RepositoryOwner = repoContrib.Repository.Owner.Login
 			activity.RepositoryOwnerType = repoContrib.Repository.Owner.Typename
 			activity.IsReview = true
+			activity.SourceID = contrib.PullRequestReview.ID
+			activity.PullRequestAuthor = contrib.PullRequestReview.PullRequest.Author.Login
+			activity.PullRequestAuthorType = contrib.PullRequestReview.PullRequest.Author.Typename
 			activities = append(activities, activity)
 		}
 
@@ -865,12 +1267,14 @@ func (f *GitHubDataFetcher) processReviewsWithPagination(
 			startAfter := githubv4.String(repoContrib.Contributions.PageInfo.EndCursor)
 
 			repoActivities, err := f.fetchRepositoryReviewContributionsPaginated(ctx, username, repoName, from, to, &startAfter)
+			activities = append(activities, repoActivities...)
+
+			// 取得できたページは残し、欠けたことを記録して他のリポジトリの処理を続行
 			if err != nil {
-				// エラーが発生しても他のリポジトリの処理は続行
-				continue
+				if err := gaps.record(domain.ActivityTypeReview, repoName, err); err != nil {
+					return nil, err
+				}
 			}
-
-			activities = append(activities, repoActivities...)
 		}
 	}
 
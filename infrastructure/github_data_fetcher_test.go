@@ -0,0 +1,164 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Tattsum/github-analytics/domain"
+)
+
+func TestGitHubDataFetcher_LookbackYears(t *testing.T) {
+	t.Parallel()
+
+	recent := time.Now().AddDate(0, -1, 0)
+
+	fetcher := NewGitHubDataFetcher(nil)
+	if got := fetcher.contributionYears(); got != contributionLookbackYears {
+		t.Errorf("default contributionYears = %d, want %d", got, contributionLookbackYears)
+	}
+
+	if got := fetcher.lookbackSince(time.Time{}); !got.IsZero() {
+		t.Errorf("default lookbackSince(zero) = %v, want zero (PRs and issues are fetched in full)", got)
+	}
+
+	fetcher.SetLookbackYears(2)
+
+	if got := fetcher.contributionYears(); got != 2 {
+		t.Errorf("contributionYears = %d, want 2", got)
+	}
+
+	earliest := time.Now().AddDate(-2, 0, 0)
+	if got := fetcher.lookbackSince(time.Time{}); got.Before(earliest.Add(-time.Minute)) || got.After(earliest.Add(time.Minute)) {
+		t.Errorf("lookbackSince(zero) = %v, want about %v", got, earliest)
+	}
+
+	if got := fetcher.lookbackSince(recent); !got.Equal(recent) {
+		t.Errorf("lookbackSince(recent) = %v, want the later incremental cutoff %v", got, recent)
+	}
+}
+
+func TestGitHubDataFetcher_CollectionPeriod(t *testing.T) {
+	t.Parallel()
+
+	var pages atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query string `json:"query"`
+		}
+
+		raw, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(raw, &body); err != nil || !strings.Contains(body.Query, "pullRequests(") {
+			t.Errorf("unexpected query: %s", raw)
+			http.Error(w, "unexpected query", http.StatusBadRequest)
+
+			return
+		}
+
+		pages.Add(1)
+
+		// Newest first: one PR after the period, one inside it and one before it, with a next page that must not be requested.
+		_, _ = io.WriteString(w, `{"data":{"user":{"pullRequests":{"totalCount":3,"nodes":[
+			{"id":"PR_after","createdAt":"2026-05-01T00:00:00Z","repository":{"nameWithOwner":"acme/api","owner":{"login":"acme"}},"reviews":{"nodes":[]}},
+			{"id":"PR_inside","title":"Explain -since","createdAt":"2025-10-01T00:00:00Z","repository":{"nameWithOwner":"acme/api","owner":{"login":"acme"}},
+			 "files":{"nodes":[{"path":"docs/usage.md"},{"path":"README.md"}]},"reviews":{"nodes":[]}},
+			{"id":"PR_before","createdAt":"2025-03-01T00:00:00Z","repository":{"nameWithOwner":"acme/api","owner":{"login":"acme"}},"reviews":{"nodes":[]}}
+		],"pageInfo":{"hasNextPage":true,"endCursor":"never-requested"}}}}}`)
+	}))
+	defer server.Close()
+
+	since := time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC)
+
+	fetcher := NewGitHubDataFetcher(NewGitHubRepository(newTestClient(t, server.URL, "token")))
+	fetcher.SetCollectionPeriod(domain.CollectionPeriod{Since: since, Until: until})
+
+	if got := fetcher.fetchEnd(); !got.Equal(until) {
+		t.Errorf("fetchEnd = %v, want the end of the period %v", got, until)
+	}
+
+	if got := fetcher.lookbackSince(time.Time{}); !got.Equal(since) {
+		t.Errorf("lookbackSince(zero) = %v, want the start of the period %v", got, since)
+	}
+
+	later := since.AddDate(0, 3, 0)
+	if got := fetcher.lookbackSince(later); !got.Equal(later) {
+		t.Errorf("lookbackSince(later) = %v, want the later incremental cutoff %v", got, later)
+	}
+
+	prs, lifecycles, err := fetcher.fetchPullRequestsSince(context.Background(), "alice", fetcher.lookbackSince(time.Time{}))
+	if err != nil {
+		t.Fatalf("fetchPullRequestsSince: %v", err)
+	}
+
+	if len(prs) != 1 || prs[0].SourceID != "PR_inside" || len(lifecycles) != 1 {
+		t.Fatalf("PRs = %+v, want only the PR created inside the period", prs)
+	}
+
+	if prs[0].WorkCategory != domain.WorkCategoryDocs {
+		t.Errorf("work category = %q, want DOCS from the changed paths", prs[0].WorkCategory)
+	}
+
+	if got := pages.Load(); got != 1 {
+		t.Errorf("requested %d pages, want pagination to stop at the PR before the period", got)
+	}
+
+	fetcher.SetLookbackYears(1)
+
+	if got := fetcher.lookbackSince(time.Time{}); !got.Equal(since) {
+		t.Errorf("lookbackSince(zero) with a one-year lookback = %v, want %v counted back from the end of the period", got, since)
+	}
+}
+
+func TestFetchUserActivitySince_RecordsUnsupportedContributions(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query string `json:"query"`
+		}
+
+		raw, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(raw, &body)
+
+		switch {
+		case strings.Contains(body.Query, "contributionsCollection"):
+			t.Errorf("queried contributions the server does not provide: %s", body.Query)
+			http.Error(w, "unexpected query", http.StatusBadRequest)
+		case strings.Contains(body.Query, "search("):
+			_, _ = io.WriteString(w, `{"data":{"search":{"issueCount":0,"nodes":[],"pageInfo":{"hasNextPage":false,"endCursor":""}}}}`)
+		case strings.Contains(body.Query, "pullRequests("):
+			_, _ = io.WriteString(w, `{"data":{"user":{"pullRequests":{"totalCount":0,"nodes":[],"pageInfo":{"hasNextPage":false,"endCursor":""}}}}}`)
+		case strings.Contains(body.Query, "issues("):
+			_, _ = io.WriteString(w, `{"data":{"user":{"issues":{"totalCount":0,"nodes":[],"pageInfo":{"hasNextPage":false,"endCursor":""}}}}}`)
+		default:
+			_, _ = io.WriteString(w, `{"data":{"user":{"login":"octocat","name":"","createdAt":"2020-01-01T00:00:00Z"}}}`)
+		}
+	}))
+	defer server.Close()
+
+	fetcher := NewGitHubDataFetcher(NewGitHubRepository(newTestClient(t, server.URL, "token")))
+	// An older GitHub Enterprise Server without contributionsCollection.
+	fetcher.capsOnce.Do(func() {
+		fetcher.caps = &GitHubCapabilities{Missing: []string{"User.contributionsCollection"}}
+	})
+
+	data, err := fetcher.FetchUserActivitySince(context.Background(), "octocat", false, time.Time{})
+	if err != nil {
+		t.Fatalf("FetchUserActivitySince: %v", err)
+	}
+
+	// The missing commits and reviews are gaps rather than a member without activity.
+	if len(data.Gaps) != 2 ||
+		data.Gaps[0].ActivityType != domain.ActivityTypeCommit || data.Gaps[0].Reason != domain.DataGapUnsupported ||
+		data.Gaps[1].ActivityType != domain.ActivityTypeReview || data.Gaps[1].Reason != domain.DataGapUnsupported {
+		t.Errorf("gaps = %+v, want unsupported commit and review gaps", data.Gaps)
+	}
+}
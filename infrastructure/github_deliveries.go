@@ -0,0 +1,283 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Tattsum/github-analytics/domain"
+	"github.com/shurcooL/githubv4"
+)
+
+// deploymentNode はGraphQLから取得するデプロイメント1件です.
+type deploymentNode struct {
+	Environment  string
+	CommitOid    string
+	CreatedAt    githubv4.DateTime
+	LatestStatus *struct {
+		State     string
+		CreatedAt githubv4.DateTime
+	}
+}
+
+// releaseNode はGraphQLから取得するリリース1件です.
+type releaseNode struct {
+	TagName      string
+	IsDraft      bool
+	IsPrerelease bool
+	CreatedAt    githubv4.DateTime
+	PublishedAt  *githubv4.DateTime
+	TagCommit    *struct {
+		Oid string
+	}
+}
+
+// tagRefNode はGraphQLから取得するタグ（refs/tags/ の ref）1件です.
+// 軽量タグの Target はコミット、注釈付きタグの Target はタグオブジェクトです.
+type tagRefNode struct {
+	Name   string
+	Target struct {
+		Oid    string
+		Commit struct {
+			CommittedDate githubv4.DateTime
+		} `graphql:"... on Commit"`
+		Tag struct {
+			Tagger *struct {
+				Date githubv4.DateTime
+			}
+			Target struct {
+				Oid    string
+				Commit struct {
+					CommittedDate githubv4.DateTime
+				} `graphql:"... on Commit"`
+			}
+		} `graphql:"... on Tag"`
+	}
+}
+
+// toTag はタグを返します. 注釈付きタグの日時はタグの作成日時、軽量タグの日時はコミットの日時です.
+func (n *tagRefNode) toTag() *domain.Tag {
+	if n.Target.Tag.Tagger != nil {
+		return &domain.Tag{Name: n.Name, CommitOID: n.Target.Tag.Target.Oid, At: n.Target.Tag.Tagger.Date.Time}
+	}
+
+	if !n.Target.Tag.Target.Commit.CommittedDate.IsZero() {
+		return &domain.Tag{Name: n.Name, CommitOID: n.Target.Tag.Target.Oid, At: n.Target.Tag.Target.Commit.CommittedDate.Time}
+	}
+
+	return &domain.Tag{Name: n.Name, CommitOID: n.Target.Oid, At: n.Target.Commit.CommittedDate.Time}
+}
+
+// deliverySince はデプロイメント・リリース・タグを取得する期間の始まりを返します.
+// 収集期間の始まり（無ければ取得期間の終わりから遡る年数）までを取得します.
+func (f *GitHubDataFetcher) deliverySince() time.Time {
+	return contributionStart(f.period.Since, f.fetchEnd(), f.contributionYears())
+}
+
+// FetchRepositoryDeliveries は nameWithOwner のデプロイメント・リリース・タグを取得します.
+// 収集期間の始まり（無ければ遡る年数）から取得期間の終わりまでに作成されたものが対象です.
+func (f *GitHubDataFetcher) FetchRepositoryDeliveries(ctx context.Context, nameWithOwner string) (*domain.RepositoryDeliveries, error) {
+	owner, name, ok := strings.Cut(nameWithOwner, "/")
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidRepositoryName, nameWithOwner)
+	}
+
+	since := f.deliverySince()
+	deliveries := &domain.RepositoryDeliveries{Repository: nameWithOwner}
+
+	var err error
+
+	if deliveries.Deployments, err = f.fetchDeployments(ctx, owner, name, since); err != nil {
+		return nil, fmt.Errorf("failed to fetch deployments of %s: %w", nameWithOwner, err)
+	}
+
+	if deliveries.Releases, err = f.fetchReleases(ctx, owner, name, since); err != nil {
+		return nil, fmt.Errorf("failed to fetch releases of %s: %w", nameWithOwner, err)
+	}
+
+	if deliveries.Tags, err = f.fetchTags(ctx, owner, name, since); err != nil {
+		return nil, fmt.Errorf("failed to fetch tags of %s: %w", nameWithOwner, err)
+	}
+
+	return deliveries, nil
+}
+
+// fetchDeployments は since 以降に作成されたデプロイメントを、最新のステータスとともに取得します.
+// 作成日時の降順で取得し、since より古いデプロイメントに到達した時点でページネーションを打ち切ります.
+func (f *GitHubDataFetcher) fetchDeployments(ctx context.Context, owner, name string, since time.Time) ([]*domain.Deployment, error) {
+	var query struct {
+		Repository struct {
+			Deployments struct {
+				Nodes    []deploymentNode
+				PageInfo struct {
+					HasNextPage bool
+					EndCursor   string
+				}
+			} `graphql:"deployments(first: $first, after: $after, orderBy: {field: CREATED_AT, direction: DESC})"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+
+	deployments := make([]*domain.Deployment, 0)
+	after := (*githubv4.String)(nil)
+
+	for {
+		if err := f.repo.client.Query(ctx, &query, deliveryPageVariables(owner, name, after)); err != nil {
+			return nil, fmt.Errorf("query deployments: %w", err)
+		}
+
+		reachedSince := false
+
+		for _, node := range query.Repository.Deployments.Nodes {
+			if node.CreatedAt.Before(since) {
+				reachedSince = true
+
+				continue
+			}
+
+			if f.period.EndsBy(node.CreatedAt.Time) {
+				continue
+			}
+
+			deployment := &domain.Deployment{
+				Environment: node.Environment,
+				CommitOID:   node.CommitOid,
+				CreatedAt:   node.CreatedAt.Time,
+			}
+			if node.LatestStatus != nil {
+				deployment.State = domain.DeploymentState(node.LatestStatus.State)
+				deployment.StatusAt = node.LatestStatus.CreatedAt.Time
+			}
+
+			deployments = append(deployments, deployment)
+		}
+
+		page := query.Repository.Deployments.PageInfo
+		if reachedSince || !page.HasNextPage {
+			return deployments, nil
+		}
+
+		cursor := githubv4.String(page.EndCursor)
+		after = &cursor
+	}
+}
+
+// fetchReleases は since 以降に作成されたリリースを取得します.
+// 作成日時の降順で取得し、since より古いリリースに到達した時点でページネーションを打ち切ります.
+func (f *GitHubDataFetcher) fetchReleases(ctx context.Context, owner, name string, since time.Time) ([]*domain.Release, error) {
+	var query struct {
+		Repository struct {
+			Releases struct {
+				Nodes    []releaseNode
+				PageInfo struct {
+					HasNextPage bool
+					EndCursor   string
+				}
+			} `graphql:"releases(first: $first, after: $after, orderBy: {field: CREATED_AT, direction: DESC})"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+
+	releases := make([]*domain.Release, 0)
+	after := (*githubv4.String)(nil)
+
+	for {
+		if err := f.repo.client.Query(ctx, &query, deliveryPageVariables(owner, name, after)); err != nil {
+			return nil, fmt.Errorf("query releases: %w", err)
+		}
+
+		reachedSince := false
+
+		for _, node := range query.Repository.Releases.Nodes {
+			if node.CreatedAt.Before(since) {
+				reachedSince = true
+
+				continue
+			}
+
+			if f.period.EndsBy(node.CreatedAt.Time) {
+				continue
+			}
+
+			release := &domain.Release{TagName: node.TagName, IsDraft: node.IsDraft, IsPrerelease: node.IsPrerelease}
+			if node.PublishedAt != nil {
+				release.PublishedAt = node.PublishedAt.Time
+			}
+
+			if node.TagCommit != nil {
+				release.CommitOID = node.TagCommit.Oid
+			}
+
+			releases = append(releases, release)
+		}
+
+		page := query.Repository.Releases.PageInfo
+		if reachedSince || !page.HasNextPage {
+			return releases, nil
+		}
+
+		cursor := githubv4.String(page.EndCursor)
+		after = &cursor
+	}
+}
+
+// fetchTags は since 以降のタグを取得します.
+// タグが指すコミットの日時の降順で取得し、since より古いタグに到達した時点でページネーションを打ち切ります.
+func (f *GitHubDataFetcher) fetchTags(ctx context.Context, owner, name string, since time.Time) ([]*domain.Tag, error) {
+	var query struct {
+		Repository struct {
+			Refs struct {
+				Nodes    []tagRefNode
+				PageInfo struct {
+					HasNextPage bool
+					EndCursor   string
+				}
+			} `graphql:"refs(refPrefix: \"refs/tags/\", first: $first, after: $after, orderBy: {field: TAG_COMMIT_DATE, direction: DESC})"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+
+	tags := make([]*domain.Tag, 0)
+	after := (*githubv4.String)(nil)
+
+	for {
+		if err := f.repo.client.Query(ctx, &query, deliveryPageVariables(owner, name, after)); err != nil {
+			return nil, fmt.Errorf("query tags: %w", err)
+		}
+
+		reachedSince := false
+
+		for _, node := range query.Repository.Refs.Nodes {
+			tag := node.toTag()
+			if tag.At.Before(since) {
+				reachedSince = true
+
+				continue
+			}
+
+			if f.period.EndsBy(tag.At) {
+				continue
+			}
+
+			tags = append(tags, tag)
+		}
+
+		page := query.Repository.Refs.PageInfo
+		if reachedSince || !page.HasNextPage {
+			return tags, nil
+		}
+
+		cursor := githubv4.String(page.EndCursor)
+		after = &cursor
+	}
+}
+
+// deliveryPageVariables はデプロイメント・リリース・タグの1ページを取得するクエリ変数を返します.
+func deliveryPageVariables(owner, name string, after *githubv4.String) map[string]any {
+	const first = 100
+
+	return map[string]any{
+		gqlVarOwner: githubv4.String(owner),
+		gqlVarName:  githubv4.String(name),
+		gqlVarFirst: githubv4.Int(first),
+		gqlVarAfter: after,
+	}
+}
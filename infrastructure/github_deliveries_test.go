@@ -0,0 +1,77 @@
+package infrastructure
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Tattsum/github-analytics/domain"
+)
+
+func TestFetchRepositoryDeliveries(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := io.ReadAll(r.Body)
+		query := string(raw)
+
+		switch {
+		case strings.Contains(query, "deployments("):
+			_, _ = io.WriteString(w, `{"data":{"repository":{"deployments":{"nodes":[
+				{"environment":"production","commitOid":"c2","createdAt":"2024-05-02T09:00:00Z","latestStatus":null},
+				{"environment":"production","commitOid":"c1","createdAt":"2024-03-05T09:00:00Z","latestStatus":{"state":"SUCCESS","createdAt":"2024-03-05T09:10:00Z"}},
+				{"environment":"production","commitOid":"c0","createdAt":"2023-12-01T09:00:00Z","latestStatus":{"state":"SUCCESS","createdAt":"2023-12-01T09:10:00Z"}}
+			],"pageInfo":{"hasNextPage":true,"endCursor":"next"}}}}}`)
+		case strings.Contains(query, "releases("):
+			_, _ = io.WriteString(w, `{"data":{"repository":{"releases":{"nodes":[
+				{"tagName":"v1","isDraft":false,"isPrerelease":false,"createdAt":"2024-03-05T08:00:00Z","publishedAt":"2024-03-05T08:30:00Z","tagCommit":{"oid":"c1"}}
+			],"pageInfo":{"hasNextPage":false,"endCursor":""}}}}}`)
+		case strings.Contains(query, "refs("):
+			_, _ = io.WriteString(w, `{"data":{"repository":{"refs":{"nodes":[
+				{"name":"v1","target":{"oid":"t1","tagger":{"date":"2024-03-05T08:00:00Z"},"target":{"oid":"c1","committedDate":"2024-03-04T08:00:00Z"}}},
+				{"name":"v0","target":{"oid":"c0","committedDate":"2023-11-30T08:00:00Z"}}
+			],"pageInfo":{"hasNextPage":true,"endCursor":"next"}}}}}`)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	fetcher := NewGitHubDataFetcher(NewGitHubRepository(newTestClient(t, server.URL, "token")))
+	fetcher.SetCollectionPeriod(domain.CollectionPeriod{
+		Since: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+		Until: time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC),
+	})
+
+	got, err := fetcher.FetchRepositoryDeliveries(context.Background(), "acme/api")
+	if err != nil {
+		t.Fatalf("FetchRepositoryDeliveries: %v", err)
+	}
+
+	// The deployment after the period is skipped, and reaching the one before
+	// it stops the pagination (the server would fail a second page).
+	if len(got.Deployments) != 1 || got.Deployments[0].CommitOID != "c1" ||
+		got.Deployments[0].State != domain.DeploymentStateSuccess ||
+		!got.Deployments[0].StatusAt.Equal(time.Date(2024, time.March, 5, 9, 10, 0, 0, time.UTC)) {
+		t.Errorf("deployments = %+v, want only c1 with its SUCCESS status", got.Deployments)
+	}
+
+	if len(got.Releases) != 1 || got.Releases[0].TagName != "v1" || got.Releases[0].CommitOID != "c1" ||
+		!got.Releases[0].PublishedAt.Equal(time.Date(2024, time.March, 5, 8, 30, 0, 0, time.UTC)) {
+		t.Errorf("releases = %+v, want v1 published at 2024-03-05T08:30", got.Releases)
+	}
+
+	// An annotated tag is dated by its tagger and points at the tagged commit.
+	if len(got.Tags) != 1 || got.Tags[0].Name != "v1" || got.Tags[0].CommitOID != "c1" ||
+		!got.Tags[0].At.Equal(time.Date(2024, time.March, 5, 8, 0, 0, 0, time.UTC)) {
+		t.Errorf("tags = %+v, want only v1 dated by its tagger", got.Tags)
+	}
+
+	if _, err := fetcher.FetchRepositoryDeliveries(context.Background(), "api"); err == nil {
+		t.Error("FetchRepositoryDeliveries(api) succeeded, want an invalid repository name error")
+	}
+}
@@ -0,0 +1,288 @@
+package infrastructure
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Tattsum/github-analytics/domain"
+)
+
+var (
+	// ErrGitHubTransient は再試行で解消し得る一時的な障害（5xx・通信エラー・GitHub 側のタイムアウト）を表します.
+	ErrGitHubTransient = errors.New("transient GitHub API error")
+	// ErrGitHubRateLimited はレート制限（セカンダリレート制限を含む）に達したことを表します.
+	ErrGitHubRateLimited = errors.New("GitHub API rate limit exceeded")
+	// ErrGitHubNotFound は対象が存在しないことを表します.
+	ErrGitHubNotFound = errors.New("GitHub resource not found")
+	// ErrGitHubPermission はトークンに対象を読む権限が無いことを表します.
+	ErrGitHubPermission = errors.New("insufficient permission for GitHub resource")
+)
+
+// GitHubAPIError は分類済みの GitHub API のエラーです.
+// errors.Is で ErrGitHubTransient・ErrGitHubRateLimited・ErrGitHubNotFound・ErrGitHubPermission のいずれかと一致します.
+type GitHubAPIError struct {
+	// Class は分類を表すセンチネルエラーです.
+	Class error
+	// StatusCode は HTTP ステータスコードです（応答が無い場合は 0）.
+	StatusCode int
+	// RetryAfter は Retry-After ヘッダーが指定した待ち時間です（無ければ 0）.
+	RetryAfter time.Duration
+	// ResetAt はレート制限が解除される時刻です（不明ならゼロ値）.
+	ResetAt time.Time
+	// Err は元のエラーです.
+	Err error
+}
+
+// Error はエラーメッセージを返します.
+func (e *GitHubAPIError) Error() string {
+	return fmt.Sprintf("%v: %v", e.Class, e.Err)
+}
+
+// Unwrap は分類と元のエラーの両方を返します.
+func (e *GitHubAPIError) Unwrap() []error {
+	return []error{e.Class, e.Err}
+}
+
+// retryable は再試行する価値のあるエラーかどうかを返します.
+func (e *GitHubAPIError) retryable() bool {
+	return errors.Is(e.Class, ErrGitHubTransient) || errors.Is(e.Class, ErrGitHubRateLimited)
+}
+
+// DataGapReasonOf はエラーの分類を、スナップショットに記録する domain.DataGapReason に変換します.
+func DataGapReasonOf(err error) domain.DataGapReason {
+	switch {
+	case errors.Is(err, ErrGitHubRateLimited):
+		return domain.DataGapRateLimited
+	case errors.Is(err, ErrGitHubNotFound):
+		return domain.DataGapNotFound
+	case errors.Is(err, ErrGitHubPermission):
+		return domain.DataGapPermission
+	case errors.Is(err, ErrGitHubTransient):
+		return domain.DataGapTransient
+	case errors.Is(err, ErrSearchResultLimit):
+		return domain.DataGapTruncated
+	case errors.Is(err, ErrUnsupportedField):
+		return domain.DataGapUnsupported
+	default:
+		return domain.DataGapUnknown
+	}
+}
+
+// retryPolicy は分類済みエラーの再試行方針です.
+type retryPolicy struct {
+	// maxAttempts は最初の試行を含む試行回数の上限です.
+	maxAttempts int
+	// baseDelay は1回目の再試行までの待ち時間で、以後は試行ごとに倍にします.
+	baseDelay time.Duration
+	// maxDelay は待ち時間の上限です（Retry-After の指定はこれを超えても従います）.
+	maxDelay time.Duration
+}
+
+// defaultRetryPolicy は GitHubClient の既定の再試行方針です.
+var defaultRetryPolicy = retryPolicy{maxAttempts: 5, baseDelay: time.Second, maxDelay: time.Minute}
+
+// delay は attempt 回目（0 始まり）の失敗の後、次の試行までに待つ時間を返します.
+// 指数バックオフの待ち時間より Retry-After の指定が長い場合は Retry-After に従います.
+func (p retryPolicy) delay(attempt int, apiErr *GitHubAPIError) time.Duration {
+	wait := p.baseDelay
+	for range attempt {
+		if wait >= p.maxDelay {
+			break
+		}
+
+		wait *= 2
+	}
+
+	return max(min(wait, p.maxDelay), apiErr.RetryAfter)
+}
+
+// responseInfo は1回のクエリの HTTP 応答から、エラーの分類に使う情報を記録したものです.
+// githubv4 は応答のステータス・ヘッダー・GraphQL エラーの type を呼び出し元へ返さないため、
+// responseRecorder がリクエストのコンテキスト経由でここへ書き込みます.
+type responseInfo struct {
+	statusCode int
+	header     http.Header
+	// errorTypes は GraphQL の errors[].type です（RATE_LIMITED・NOT_FOUND・FORBIDDEN など）.
+	errorTypes []string
+}
+
+// responseInfoKey は responseInfo を入れるコンテキストのキーです.
+type responseInfoKey struct{}
+
+// withResponseInfo は応答の情報を info へ記録させるコンテキストを返します.
+func withResponseInfo(ctx context.Context, info *responseInfo) context.Context {
+	return context.WithValue(ctx, responseInfoKey{}, info)
+}
+
+// responseRecorder はコンテキストに responseInfo があるリクエストについて、応答の情報を記録するトランスポートです.
+type responseRecorder struct {
+	base http.RoundTripper
+}
+
+// RoundTrip はリクエストを base へ送り、応答の情報を記録します.
+// 200 の応答は GraphQL エラーの type を読むために本文を読み切り、同じ内容で差し替えます.
+func (t *responseRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, fmt.Errorf("github request: %w", err)
+	}
+
+	info, ok := req.Context().Value(responseInfoKey{}).(*responseInfo)
+	if !ok {
+		return resp, nil
+	}
+
+	info.statusCode = resp.StatusCode
+	info.header = resp.Header.Clone()
+
+	if resp.StatusCode != http.StatusOK {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err = errors.Join(err, resp.Body.Close()); err != nil {
+		return nil, fmt.Errorf("read github response: %w", err)
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	var payload struct {
+		Errors []struct {
+			Type string `json:"type"`
+		} `json:"errors"`
+	}
+
+	if json.Unmarshal(body, &payload) == nil {
+		for _, e := range payload.Errors {
+			info.errorTypes = append(info.errorTypes, e.Type)
+		}
+	}
+
+	return resp, nil
+}
+
+// classifyGitHubError はクエリのエラーを、応答の情報から GitHubAPIError に分類します.
+// 分類できないエラー（クエリの誤りなど）とコンテキストの終了はそのまま返します.
+func classifyGitHubError(err error, info *responseInfo, now time.Time) error {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+
+	apiErr := &GitHubAPIError{StatusCode: info.statusCode, Err: err}
+	apiErr.RetryAfter = retryAfter(info.header, now)
+
+	if info.header.Get("X-RateLimit-Remaining") == "0" {
+		if reset, perr := strconv.ParseInt(info.header.Get("X-RateLimit-Reset"), 10, 64); perr == nil {
+			apiErr.ResetAt = time.Unix(reset, 0)
+		}
+	}
+
+	apiErr.Class = classOf(err, info, apiErr)
+	if apiErr.Class == nil {
+		return err
+	}
+
+	return apiErr
+}
+
+// classOf はエラーの分類を返します（分類できなければ nil）.
+func classOf(err error, info *responseInfo, apiErr *GitHubAPIError) error {
+	switch status := info.statusCode; {
+	case status == 0:
+		// 応答が無い. 通信の失敗だけを再試行し、トークンの取得やリクエストの組み立ての失敗は分類しません.
+		if isTransportError(err) {
+			return ErrGitHubTransient
+		}
+
+		return nil
+	case status == http.StatusTooManyRequests,
+		status == http.StatusForbidden && (apiErr.RetryAfter > 0 || !apiErr.ResetAt.IsZero() || isSecondaryRateLimit(err)):
+		return ErrGitHubRateLimited
+	case status == http.StatusUnauthorized, status == http.StatusForbidden:
+		return ErrGitHubPermission
+	case status == http.StatusNotFound:
+		return ErrGitHubNotFound
+	case status >= http.StatusInternalServerError:
+		return ErrGitHubTransient
+	}
+
+	for _, typ := range info.errorTypes {
+		switch typ {
+		case "RATE_LIMITED":
+			return ErrGitHubRateLimited
+		case "NOT_FOUND":
+			return ErrGitHubNotFound
+		case "FORBIDDEN", "INSUFFICIENT_SCOPES":
+			return ErrGitHubPermission
+		}
+	}
+
+	// GitHub はクエリのタイムアウトを type の無い GraphQL エラーとして返します.
+	if strings.Contains(err.Error(), "Something went wrong while executing your query") {
+		return ErrGitHubTransient
+	}
+
+	return nil
+}
+
+// isTransportError はエラーが接続・TLS・読み取りの失敗かどうかを返します.
+// http.Client は RoundTripper のエラーを *url.Error で包むため、oauth2 のトークン取得の失敗もその中身で判断します.
+func isTransportError(err error) bool {
+	if errors.Is(err, ErrInstallationToken) {
+		return false
+	}
+
+	if urlErr := (*url.Error)(nil); errors.As(err, &urlErr) {
+		// 応答の途中で接続が閉じられた場合は io.EOF になります.
+		if errors.Is(urlErr.Err, io.EOF) {
+			return true
+		}
+
+		err = urlErr.Err
+	}
+
+	var (
+		netErr    net.Error
+		recordErr tls.RecordHeaderError
+		alertErr  tls.AlertError
+	)
+
+	return errors.As(err, &netErr) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.As(err, &recordErr) || errors.As(err, &alertErr)
+}
+
+// isSecondaryRateLimit は 403 応答がセカンダリレート制限（旧 abuse detection）によるものかどうかを返します.
+func isSecondaryRateLimit(err error) bool {
+	msg := strings.ToLower(err.Error())
+
+	return strings.Contains(msg, "secondary rate limit") || strings.Contains(msg, "abuse")
+}
+
+// retryAfter は Retry-After ヘッダー（秒数または HTTP 日付）が指定する待ち時間を返します.
+func retryAfter(header http.Header, now time.Time) time.Duration {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if at, err := http.ParseTime(value); err == nil && at.After(now) {
+		return at.Sub(now)
+	}
+
+	return 0
+}
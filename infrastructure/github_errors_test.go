@@ -0,0 +1,383 @@
+package infrastructure
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/Tattsum/github-analytics/domain"
+	"github.com/shurcooL/githubv4"
+)
+
+func TestClassifyGitHubError(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, time.March, 15, 9, 0, 0, 0, time.UTC)
+	reset := now.Add(20 * time.Minute)
+
+	tests := []struct {
+		name           string
+		info           responseInfo
+		err            error
+		want           error
+		wantRetryAfter time.Duration
+		wantResetAt    time.Time
+	}{
+		{
+			name: "connection reset",
+			err:  &url.Error{Op: "Post", URL: "https://api.github.com/graphql", Err: &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}},
+			want: ErrGitHubTransient,
+		},
+		{name: "connection closed", err: &url.Error{Op: "Post", URL: "https://api.github.com/graphql", Err: io.EOF}, want: ErrGitHubTransient},
+		{name: "truncated response", err: fmt.Errorf("failed to read response: %w", io.ErrUnexpectedEOF), want: ErrGitHubTransient},
+		{
+			name: "TLS failure",
+			err:  &url.Error{Op: "Post", URL: "https://api.github.com/graphql", Err: tls.RecordHeaderError{Msg: "first record does not look like a TLS handshake"}},
+			want: ErrGitHubTransient,
+		},
+		{
+			name: "installation token is not retried",
+			err: &url.Error{Op: "Post", URL: "https://api.github.com/graphql", Err: fmt.Errorf(
+				"%w: installation 42: 401 Unauthorized: A JSON web token could not be decoded", ErrInstallationToken)},
+			want: nil,
+		},
+		{
+			name: "token source failure is not retried",
+			err:  &url.Error{Op: "Post", URL: "https://api.github.com/graphql", Err: errors.New("oauth2: token expired and refresh token is not set")},
+			want: nil,
+		},
+		{name: "request construction is not retried", err: errors.New("json: unsupported type: func()"), want: nil},
+		{name: "server error", info: responseInfo{statusCode: http.StatusBadGateway}, err: errors.New("non-200 OK status code: 502"), want: ErrGitHubTransient},
+		{
+			name:           "429 with Retry-After",
+			info:           responseInfo{statusCode: http.StatusTooManyRequests, header: http.Header{"Retry-After": {"30"}}},
+			err:            errors.New("non-200 OK status code: 429"),
+			want:           ErrGitHubRateLimited,
+			wantRetryAfter: 30 * time.Second,
+		},
+		{
+			name: "primary rate limit",
+			info: responseInfo{statusCode: http.StatusForbidden, header: http.Header{
+				"X-Ratelimit-Remaining": {"0"},
+				"X-Ratelimit-Reset":     {strconv.FormatInt(reset.Unix(), 10)},
+			}},
+			err:         errors.New("non-200 OK status code: 403"),
+			want:        ErrGitHubRateLimited,
+			wantResetAt: reset,
+		},
+		{
+			name: "secondary rate limit",
+			info: responseInfo{statusCode: http.StatusForbidden},
+			err:  errors.New(`non-200 OK status code: 403 body: "You have exceeded a secondary rate limit"`),
+			want: ErrGitHubRateLimited,
+		},
+		{name: "forbidden", info: responseInfo{statusCode: http.StatusForbidden}, err: errors.New("non-200 OK status code: 403"), want: ErrGitHubPermission},
+		{name: "unauthorized", info: responseInfo{statusCode: http.StatusUnauthorized}, err: errors.New("non-200 OK status code: 401"), want: ErrGitHubPermission},
+		{name: "not found", info: responseInfo{statusCode: http.StatusNotFound}, err: errors.New("non-200 OK status code: 404"), want: ErrGitHubNotFound},
+		{name: "GraphQL RATE_LIMITED", info: responseInfo{statusCode: http.StatusOK, errorTypes: []string{"RATE_LIMITED"}}, err: errors.New("API rate limit exceeded"), want: ErrGitHubRateLimited},
+		{name: "GraphQL NOT_FOUND", info: responseInfo{statusCode: http.StatusOK, errorTypes: []string{"NOT_FOUND"}}, err: errors.New("Could not resolve"), want: ErrGitHubNotFound},
+		{name: "GraphQL FORBIDDEN", info: responseInfo{statusCode: http.StatusOK, errorTypes: []string{"FORBIDDEN"}}, err: errors.New("Resource not accessible"), want: ErrGitHubPermission},
+		{
+			name: "GraphQL timeout",
+			info: responseInfo{statusCode: http.StatusOK},
+			err:  errors.New("Something went wrong while executing your query. This may be the result of a timeout"),
+			want: ErrGitHubTransient,
+		},
+		{name: "query error is not classified", info: responseInfo{statusCode: http.StatusOK}, err: errors.New("Field 'foo' doesn't exist"), want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := classifyGitHubError(tt.err, &tt.info, now)
+
+			var apiErr *GitHubAPIError
+			if tt.want == nil {
+				if errors.As(err, &apiErr) || !errors.Is(err, tt.err) {
+					t.Fatalf("classifyGitHubError = %v, want the original error unclassified", err)
+				}
+
+				return
+			}
+
+			if !errors.As(err, &apiErr) || !errors.Is(err, tt.want) || !errors.Is(err, tt.err) {
+				t.Fatalf("classifyGitHubError = %v, want %v wrapping the original error", err, tt.want)
+			}
+
+			if apiErr.RetryAfter != tt.wantRetryAfter || !apiErr.ResetAt.Equal(tt.wantResetAt) {
+				t.Errorf("RetryAfter, ResetAt = %v, %v; want %v, %v", apiErr.RetryAfter, apiErr.ResetAt, tt.wantRetryAfter, tt.wantResetAt)
+			}
+		})
+	}
+}
+
+func TestClassifyGitHubError_ContextErrorsAreNotClassified(t *testing.T) {
+	t.Parallel()
+
+	err := classifyGitHubError(fmt.Errorf("graphql query failed: %w", context.Canceled), &responseInfo{}, time.Now())
+
+	var apiErr *GitHubAPIError
+	if errors.As(err, &apiErr) || !errors.Is(err, context.Canceled) {
+		t.Errorf("classifyGitHubError = %v, want the cancellation as-is", err)
+	}
+}
+
+func TestRetryPolicy_Delay(t *testing.T) {
+	t.Parallel()
+
+	policy := retryPolicy{maxAttempts: 5, baseDelay: time.Second, maxDelay: 5 * time.Second}
+	plain := &GitHubAPIError{}
+
+	for attempt, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second} {
+		if got := policy.delay(attempt, plain); got != want {
+			t.Errorf("delay(%d) = %v, want %v", attempt, got, want)
+		}
+	}
+
+	// Retry-After wins over the backoff, even beyond maxDelay.
+	if got := policy.delay(0, &GitHubAPIError{RetryAfter: 90 * time.Second}); got != 90*time.Second {
+		t.Errorf("delay with Retry-After = %v, want 1m30s", got)
+	}
+}
+
+func TestDataGapReasonOf(t *testing.T) {
+	t.Parallel()
+
+	tests := map[error]domain.DataGapReason{
+		&GitHubAPIError{Class: ErrGitHubTransient, Err: io.EOF}:                           domain.DataGapTransient,
+		&GitHubAPIError{Class: ErrGitHubRateLimited, Err: io.EOF}:                         domain.DataGapRateLimited,
+		&GitHubAPIError{Class: ErrGitHubNotFound, Err: io.EOF}:                            domain.DataGapNotFound,
+		&GitHubAPIError{Class: ErrGitHubPermission, Err: io.EOF}:                          domain.DataGapPermission,
+		fmt.Errorf("wrapped: %w", &GitHubAPIError{Class: ErrGitHubNotFound, Err: io.EOF}): domain.DataGapNotFound,
+		fmt.Errorf("%w: 1500 issues", ErrSearchResultLimit):                               domain.DataGapTruncated,
+		fmt.Errorf("%w: User.contributionsCollection", ErrUnsupportedField):               domain.DataGapUnsupported,
+		io.EOF: domain.DataGapUnknown,
+	}
+
+	for err, want := range tests {
+		if got := DataGapReasonOf(err); got != want {
+			t.Errorf("DataGapReasonOf(%v) = %q, want %q", err, got, want)
+		}
+	}
+}
+
+// newTestClient creates a client against a fake GraphQL endpoint with no
+// request pacing and millisecond retry delays.
+func newTestClient(t *testing.T, serverURL string, tokens ...string) *GitHubClient {
+	t.Helper()
+
+	client, err := NewGitHubClientFromConfig(GitHubClientConfig{Token: tokens[0], Tokens: tokens[1:], APIURL: serverURL})
+	if err != nil {
+		t.Fatalf("NewGitHubClientFromConfig: %v", err)
+	}
+
+	client.limiter.SetLimit(1000)
+	client.retry = retryPolicy{maxAttempts: 3, baseDelay: time.Millisecond, maxDelay: 10 * time.Millisecond}
+
+	return client
+}
+
+type loginQuery struct {
+	User struct {
+		Login string
+	} `graphql:"user(login: \"octocat\")"`
+}
+
+func TestGitHubClient_RetriesTransientErrors(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		if calls < 3 {
+			http.Error(w, "bad gateway", http.StatusBadGateway)
+
+			return
+		}
+
+		_, _ = io.WriteString(w, `{"data":{"user":{"login":"octocat"}}}`)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL, "t")
+
+	var query loginQuery
+	if err := client.Query(context.Background(), &query, nil); err != nil {
+		t.Fatalf("Query: %v", err)
+	}
+
+	if calls != 3 || query.User.Login != "octocat" {
+		t.Errorf("calls = %d, login = %q; want success on the third attempt", calls, query.User.Login)
+	}
+}
+
+func TestGitHubClient_GivesUpAfterMaxAttempts(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL, "t")
+
+	var query loginQuery
+
+	err := client.Query(context.Background(), &query, nil)
+	if !errors.Is(err, ErrGitHubTransient) {
+		t.Fatalf("Query error = %v, want ErrGitHubTransient", err)
+	}
+
+	if calls != 3 {
+		t.Errorf("calls = %d, want maxAttempts (3)", calls)
+	}
+}
+
+func TestGitHubClient_DoesNotRetryNotFound(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+
+		_, _ = io.WriteString(w, `{"data":{"user":null},"errors":[{"type":"NOT_FOUND","message":"Could not resolve to a User"}]}`)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL, "t")
+
+	var query loginQuery
+
+	err := client.Query(context.Background(), &query, nil)
+	if !errors.Is(err, ErrGitHubNotFound) {
+		t.Fatalf("Query error = %v, want ErrGitHubNotFound", err)
+	}
+
+	if calls != 1 {
+		t.Errorf("calls = %d, want no retry", calls)
+	}
+}
+
+// TestGitHubClient_RateLimitedTokenIsSetAside checks that a secondary rate
+// limit parks the token until Retry-After and the retry goes to the other one.
+func TestGitHubClient_RateLimitedTokenIsSetAside(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu   sync.Mutex
+		used []string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+
+		mu.Lock()
+		used = append(used, auth)
+		mu.Unlock()
+
+		if auth == "Bearer limited" {
+			w.Header().Set("Retry-After", "60")
+			http.Error(w, "You have exceeded a secondary rate limit", http.StatusForbidden)
+
+			return
+		}
+
+		_, _ = io.WriteString(w, `{"data":{"user":{"login":"octocat"}}}`)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL, "limited", "spare")
+
+	for range 2 {
+		var query loginQuery
+		if err := client.Query(context.Background(), &query, nil); err != nil {
+			t.Fatalf("Query: %v", err)
+		}
+	}
+
+	want := []string{"Bearer limited", "Bearer spare", "Bearer spare"}
+	if fmt.Sprint(used) != fmt.Sprint(want) {
+		t.Errorf("tokens used = %v, want %v", used, want)
+	}
+
+	if b := client.Budgets()[0]; b.Remaining != 0 || time.Until(b.ResetAt) < 50*time.Second {
+		t.Errorf("budget of the limited token = %+v, want it exhausted for about a minute", b)
+	}
+}
+
+// TestFetchCommitsWindow_RecordsGapForFailedPage serves one repository whose
+// second page always fails, and checks that the failure becomes a data gap
+// rather than silently dropping the repository's remaining commits.
+func TestFetchCommitsWindow_RecordsGapForFailedPage(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Variables map[string]any `json:"variables"`
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+
+		if body.Variables["after"] != nil {
+			http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+
+			return
+		}
+
+		_, _ = io.WriteString(w, `{"data":{"user":{"contributionsCollection":{"commitContributionsByRepository":[
+			{"repository":{"nameWithOwner":"acme/big","owner":{"login":"acme","__typename":"Organization"}},
+			 "contributions":{"totalCount":150,"nodes":[{"occurredAt":"2024-03-01T00:00:00Z"}],"pageInfo":{"hasNextPage":true,"endCursor":"c1"}}},
+			{"repository":{"nameWithOwner":"acme/small","owner":{"login":"acme","__typename":"Organization"}},
+			 "contributions":{"totalCount":1,"nodes":[{"occurredAt":"2024-03-02T00:00:00Z"}],"pageInfo":{"hasNextPage":false,"endCursor":""}}}
+		]}}}}`)
+	}))
+	defer server.Close()
+
+	fetcher := NewGitHubDataFetcher(NewGitHubRepository(newTestClient(t, server.URL, "t")))
+	from := githubv4.DateTime{Time: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	to := githubv4.DateTime{Time: time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)}
+
+	gaps := dataGaps{}
+
+	commits, err := fetcher.fetchCommitsWindow(context.Background(), "octocat", from, to, &gaps)
+	if err != nil {
+		t.Fatalf("fetchCommitsWindow: %v", err)
+	}
+
+	if len(commits) != 2 {
+		t.Errorf("commits = %d, want the first pages of both repositories", len(commits))
+	}
+
+	if len(gaps) != 1 || gaps[0].Repository != "acme/big" || gaps[0].ActivityType != domain.ActivityTypeCommit ||
+		gaps[0].Reason != domain.DataGapNotFound {
+		t.Fatalf("gaps = %+v, want one not_found commit gap for acme/big", gaps)
+	}
+
+	// Without a gap collector (the public FetchCommits path) the failure is an error.
+	if _, err := fetcher.fetchCommitsWindow(context.Background(), "octocat", from, to, nil); !errors.Is(err, ErrGitHubNotFound) {
+		t.Errorf("fetchCommitsWindow without gaps error = %v, want ErrGitHubNotFound", err)
+	}
+}
@@ -0,0 +1,337 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Tattsum/github-analytics/domain"
+	"github.com/shurcooL/githubv4"
+)
+
+// gqlVarQuery は検索クエリのクエリ変数名です.
+const gqlVarQuery = "query"
+
+// issueNode はIssue一覧・検索結果の1件です.
+// 種類の判定とライフサイクルのため、クローズ日時・クローズした人・理由・ラベル・担当者も取得します.
+// ラベルは先頭 20 件、担当者は先頭 10 件までを対象にします.
+type issueNode struct {
+	ID          string
+	CreatedAt   githubv4.DateTime
+	ClosedAt    *githubv4.DateTime
+	StateReason *string
+	Author      *struct {
+		Login string
+	}
+	Repository struct {
+		NameWithOwner string
+		Owner         struct {
+			Login    string
+			Typename string `graphql:"__typename"`
+		}
+	}
+	Labels struct {
+		Nodes []struct {
+			Name string
+		}
+	} `graphql:"labels(first: 20)"`
+	Assignees struct {
+		Nodes []struct {
+			Login string
+		}
+	} `graphql:"assignees(first: 10)"`
+	// TimelineItems は最後のクローズイベントです（クローズした人を求めるため）.
+	TimelineItems struct {
+		Nodes []struct {
+			ClosedEvent struct {
+				Actor *struct {
+					Login string
+				}
+			} `graphql:"... on ClosedEvent"`
+		}
+	} `graphql:"timelineItems(itemTypes: [CLOSED_EVENT], last: 1)"`
+}
+
+// newIssueLifecycle はIssueの取得結果からライフサイクルを組み立て、ラベルを mapping で種類に分類します.
+func newIssueLifecycle(issue *issueNode, mapping *domain.IssueLabelMapping) *domain.IssueLifecycle {
+	lifecycle := &domain.IssueLifecycle{
+		Repository: issue.Repository.NameWithOwner,
+		SourceID:   issue.ID,
+		CreatedAt:  issue.CreatedAt.Time,
+		ClosedAt:   optionalTime(issue.ClosedAt),
+		Labels:     make([]string, 0, len(issue.Labels.Nodes)),
+		Assignees:  make([]string, 0, len(issue.Assignees.Nodes)),
+	}
+
+	if issue.Author != nil {
+		lifecycle.Author = issue.Author.Login
+	}
+
+	if lifecycle.ClosedAt != nil {
+		if issue.StateReason != nil {
+			lifecycle.StateReason = domain.IssueStateReason(*issue.StateReason)
+		}
+
+		for _, item := range issue.TimelineItems.Nodes {
+			if item.ClosedEvent.Actor != nil {
+				lifecycle.ClosedBy = item.ClosedEvent.Actor.Login
+			}
+		}
+	}
+
+	for _, label := range issue.Labels.Nodes {
+		lifecycle.Labels = append(lifecycle.Labels, label.Name)
+	}
+
+	for _, assignee := range issue.Assignees.Nodes {
+		lifecycle.Assignees = append(lifecycle.Assignees, assignee.Login)
+	}
+
+	lifecycle.Kind = mapping.Classify(lifecycle.Labels)
+
+	return lifecycle
+}
+
+// newIssueActivity はIssueの作成（ActivityTypeIssue）またはクローズ（ActivityTypeIssueClose）の活動を作成します.
+// クローズの活動の日時はクローズ日時です.
+func newIssueActivity(activityType domain.ActivityType, issue *domain.IssueLifecycle, owner, ownerType string) *domain.Activity {
+	date := issue.CreatedAt
+	if activityType == domain.ActivityTypeIssueClose && issue.ClosedAt != nil {
+		date = *issue.ClosedAt
+	}
+
+	activity := domain.NewActivity(activityType, issue.Repository, date, 0, 0)
+	activity.RepositoryOwner = owner
+	activity.RepositoryOwnerType = ownerType
+	activity.SourceID = issue.SourceID
+	activity.IssueKind = issue.Kind
+
+	if activityType == domain.ActivityTypeIssueClose {
+		activity.IssueOpenedAt = issue.CreatedAt
+	}
+
+	return activity
+}
+
+// appendIssueLifecycles は src のうち dst に同じノードIDが無いライフサイクルを dst に追加します.
+func appendIssueLifecycles(dst, src []*domain.IssueLifecycle) []*domain.IssueLifecycle {
+	seen := make(map[string]bool, len(dst))
+	for _, issue := range dst {
+		seen[issue.SourceID] = true
+	}
+
+	for _, issue := range src {
+		if !seen[issue.SourceID] {
+			seen[issue.SourceID] = true
+			dst = append(dst, issue)
+		}
+	}
+
+	return dst
+}
+
+// FetchIssues はIssueを取得します.
+func (f *GitHubDataFetcher) FetchIssues(ctx context.Context, username string, _ bool) ([]*domain.Activity, error) {
+	activities, _, err := f.fetchIssuesSince(ctx, username, time.Time{})
+
+	return activities, err
+}
+
+// fetchIssuesSince は since 以降に作成されたIssueを、活動とライフサイクルの組で取得します（since がゼロ値なら全件）.
+// 作成日時の降順で取得し、収集期間の終わり以降に作成されたIssueは読み飛ばし、since より古いIssueに到達した時点でページネーションを打ち切ります.
+func (f *GitHubDataFetcher) fetchIssuesSince(
+	ctx context.Context,
+	username string,
+	since time.Time,
+) ([]*domain.Activity, []*domain.IssueLifecycle, error) {
+	var query struct {
+		User struct {
+			Issues struct {
+				TotalCount int
+				Nodes      []issueNode
+				PageInfo   struct {
+					HasNextPage bool
+					EndCursor   string
+				}
+			} `graphql:"issues(first: $first, after: $after, states: [OPEN, CLOSED], orderBy: {field: CREATED_AT, direction: DESC})"`
+		} `graphql:"user(login: $login)"`
+	}
+
+	activities := make([]*domain.Activity, 0)
+	lifecycles := make([]*domain.IssueLifecycle, 0)
+	first := 100
+	after := (*githubv4.String)(nil)
+
+	for {
+		variables := map[string]any{
+			gqlVarLogin: githubv4.String(username),
+			gqlVarFirst: githubv4.Int(first),
+			gqlVarAfter: after,
+		}
+
+		if err := f.repo.client.Query(ctx, &query, variables); err != nil {
+			return nil, nil, fmt.Errorf("failed to fetch issues: %w", err)
+		}
+
+		reachedSince := false
+
+		for i := range query.User.Issues.Nodes {
+			issue := &query.User.Issues.Nodes[i]
+			if issue.CreatedAt.Before(since) {
+				reachedSince = true
+
+				continue
+			}
+
+			if f.period.EndsBy(issue.CreatedAt.Time) {
+				continue
+			}
+
+			lifecycle := newIssueLifecycle(issue, f.issueLabels)
+			activities = append(activities, newIssueActivity(
+				domain.ActivityTypeIssue, lifecycle, issue.Repository.Owner.Login, issue.Repository.Owner.Typename))
+			lifecycles = append(lifecycles, lifecycle)
+		}
+
+		if reachedSince || !query.User.Issues.PageInfo.HasNextPage {
+			break
+		}
+
+		cursor := githubv4.String(query.User.Issues.PageInfo.EndCursor)
+		after = &cursor
+	}
+
+	return activities, lifecycles, nil
+}
+
+const (
+	// issueSearchLimit は検索 API が1つのクエリにつき返す結果の上限です.
+	issueSearchLimit = 1000
+	// minIssueSearchWindow は、検索結果が上限を超えたときに期間を分割する最小の幅です.
+	minIssueSearchWindow = 24 * time.Hour
+)
+
+// issueSearchEpoch は since がゼロ値のときの検索期間の始まりです（GitHub の公開より前）.
+var issueSearchEpoch = time.Date(2008, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// ErrSearchResultLimit は検索結果が検索 API の上限を超え、一部しか取得できなかったことを表します.
+var ErrSearchResultLimit = errors.New("search results exceed the GitHub search limit")
+
+// issueCloseSearchQuery は username が関わった（作成・担当・コメント・メンション）Issueのうち、
+// [start, end] にクローズされたものを探す検索クエリを返します.
+func issueCloseSearchQuery(username string, start, end time.Time) string {
+	// 検索の日時は秒単位のため、end は切り上げます.
+	end = end.Add(time.Second - 1).Truncate(time.Second)
+
+	return fmt.Sprintf("is:issue is:closed involves:%s closed:%s..%s",
+		username, start.UTC().Format(time.RFC3339), end.UTC().Format(time.RFC3339))
+}
+
+// issueCloseResults は期間ごとに検索したIssueのクローズを集めます.
+type issueCloseResults struct {
+	activities []*domain.Activity
+	lifecycles []*domain.IssueLifecycle
+}
+
+// fetchIssueClosesSince は username が since 以降にクローズしたIssueを、クローズの活動とライフサイクルの組で取得します.
+// 検索 API で username が関わったクローズ済みのIssueを探し、最後にクローズしたのが username のものだけを残します.
+// 検索 API は1つのクエリにつき 1,000 件までしか返さないため、それを超える期間は半分に分けて検索し直します.
+// 1日に絞っても上限を超える期間は、取得できた分だけを残して gaps に記録します.
+func (f *GitHubDataFetcher) fetchIssueClosesSince(
+	ctx context.Context,
+	username string,
+	since time.Time,
+	gaps *dataGaps,
+) ([]*domain.Activity, []*domain.IssueLifecycle, error) {
+	start := since
+	if start.IsZero() {
+		start = issueSearchEpoch
+	}
+
+	results := &issueCloseResults{
+		activities: make([]*domain.Activity, 0),
+		lifecycles: make([]*domain.IssueLifecycle, 0),
+	}
+
+	if err := f.searchIssueCloses(ctx, username, start, f.fetchEnd(), results, gaps); err != nil {
+		return nil, nil, err
+	}
+
+	return results.activities, results.lifecycles, nil
+}
+
+// searchIssueCloses は username が [start, end) にクローズしたIssueを results に加えます.
+func (f *GitHubDataFetcher) searchIssueCloses(
+	ctx context.Context,
+	username string,
+	start, end time.Time,
+	results *issueCloseResults,
+	gaps *dataGaps,
+) error {
+	var query struct {
+		Search struct {
+			IssueCount int
+			Nodes      []struct {
+				Issue issueNode `graphql:"... on Issue"`
+			}
+			PageInfo struct {
+				HasNextPage bool
+				EndCursor   string
+			}
+		} `graphql:"search(query: $query, type: ISSUE, first: $first, after: $after)"`
+	}
+
+	after := (*githubv4.String)(nil)
+
+	for {
+		variables := map[string]any{
+			gqlVarQuery: githubv4.String(issueCloseSearchQuery(username, start, end)),
+			gqlVarFirst: githubv4.Int(organizationPageSize),
+			gqlVarAfter: after,
+		}
+
+		if err := f.repo.client.Query(ctx, &query, variables); err != nil {
+			return fmt.Errorf("failed to search closed issues: %w", err)
+		}
+
+		if after == nil && query.Search.IssueCount > issueSearchLimit {
+			if end.Sub(start) > minIssueSearchWindow {
+				middle := start.Add(end.Sub(start) / 2).Truncate(time.Second)
+				if err := f.searchIssueCloses(ctx, username, start, middle, results, gaps); err != nil {
+					return err
+				}
+
+				return f.searchIssueCloses(ctx, username, middle, end, results, gaps)
+			}
+
+			limitErr := fmt.Errorf("%w: %d issues closed between %s and %s, only the first %d are counted",
+				ErrSearchResultLimit, query.Search.IssueCount, start.Format(time.RFC3339), end.Format(time.RFC3339), issueSearchLimit)
+			if err := gaps.record(domain.ActivityTypeIssueClose, "", limitErr); err != nil {
+				return err
+			}
+		}
+
+		for i := range query.Search.Nodes {
+			issue := &query.Search.Nodes[i].Issue
+
+			lifecycle := newIssueLifecycle(issue, f.issueLabels)
+			if lifecycle.ClosedAt == nil || !strings.EqualFold(lifecycle.ClosedBy, username) ||
+				lifecycle.ClosedAt.Before(start) || !lifecycle.ClosedAt.Before(end) {
+				continue
+			}
+
+			results.activities = append(results.activities, newIssueActivity(
+				domain.ActivityTypeIssueClose, lifecycle, issue.Repository.Owner.Login, issue.Repository.Owner.Typename))
+			results.lifecycles = append(results.lifecycles, lifecycle)
+		}
+
+		if !query.Search.PageInfo.HasNextPage {
+			return nil
+		}
+
+		cursor := githubv4.String(query.Search.PageInfo.EndCursor)
+		after = &cursor
+	}
+}
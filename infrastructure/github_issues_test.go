@@ -0,0 +1,194 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Tattsum/github-analytics/domain"
+)
+
+func TestFetchIssues_OpenedAndClosed(t *testing.T) {
+	t.Parallel()
+
+	var searchQuery string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query     string         `json:"query"`
+			Variables map[string]any `json:"variables"`
+		}
+
+		raw, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(raw, &body)
+
+		switch {
+		case strings.Contains(body.Query, "search("):
+			searchQuery, _ = body.Variables["query"].(string)
+			_, _ = io.WriteString(w, `{"data":{"search":{"nodes":[
+				{"id":"I_fixed","createdAt":"2024-03-01T09:00:00Z","closedAt":"2024-03-04T09:00:00Z","stateReason":"COMPLETED",
+				 "author":{"login":"outsider"},"repository":{"nameWithOwner":"acme/api","owner":{"login":"acme","__typename":"Organization"}},
+				 "labels":{"nodes":[{"name":"Bug"}]},"assignees":{"nodes":[{"login":"alice"}]},
+				 "timelineItems":{"nodes":[{"actor":{"login":"alice"}}]}},
+				{"id":"I_other","createdAt":"2024-03-01T09:00:00Z","closedAt":"2024-03-04T09:00:00Z",
+				 "author":{"login":"alice"},"repository":{"nameWithOwner":"acme/api","owner":{"login":"acme","__typename":"Organization"}},
+				 "timelineItems":{"nodes":[{"actor":{"login":"bob"}}]}}
+			],"pageInfo":{"hasNextPage":false,"endCursor":""}}}}`)
+		case strings.Contains(body.Query, "issues("):
+			_, _ = io.WriteString(w, `{"data":{"user":{"issues":{"totalCount":2,"nodes":[
+				{"id":"I_new","createdAt":"2024-03-02T09:00:00Z","author":{"login":"alice"},
+				 "repository":{"nameWithOwner":"acme/api","owner":{"login":"acme","__typename":"Organization"}},
+				 "labels":{"nodes":[{"name":"enhancement"}]}},
+				{"id":"I_old","createdAt":"2023-12-01T09:00:00Z","author":{"login":"alice"},
+				 "repository":{"nameWithOwner":"acme/api","owner":{"login":"acme","__typename":"Organization"}}}
+			],"pageInfo":{"hasNextPage":true,"endCursor":"never-requested"}}}}}`)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	fetcher := NewGitHubDataFetcher(NewGitHubRepository(newTestClient(t, server.URL, "token")))
+	since := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	fetcher.SetCollectionPeriod(domain.CollectionPeriod{Until: time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC)})
+
+	opened, openedIssues, err := fetcher.fetchIssuesSince(context.Background(), "alice", since)
+	if err != nil {
+		t.Fatalf("fetchIssuesSince: %v", err)
+	}
+
+	if len(opened) != 1 || opened[0].SourceID != "I_new" || opened[0].IssueKind != domain.IssueKindFeature || len(openedIssues) != 1 {
+		t.Errorf("opened = %+v, want only I_new as a feature", opened)
+	}
+
+	closes, closedIssues, err := fetcher.fetchIssueClosesSince(context.Background(), "alice", since, &dataGaps{})
+	if err != nil {
+		t.Fatalf("fetchIssueClosesSince: %v", err)
+	}
+
+	if searchQuery != "is:issue is:closed involves:alice closed:2024-01-01T00:00:00Z..2024-04-01T00:00:00Z" {
+		t.Errorf("search query = %q", searchQuery)
+	}
+
+	// Only the issue alice closed counts, dated by its close.
+	if len(closes) != 1 || closes[0].Type != domain.ActivityTypeIssueClose || closes[0].SourceID != "I_fixed" ||
+		closes[0].IssueKind != domain.IssueKindBug || !closes[0].Date.Equal(time.Date(2024, time.March, 4, 9, 0, 0, 0, time.UTC)) ||
+		closes[0].RepositoryOwnerType != "Organization" {
+		t.Errorf("closes = %+v, want the bug alice closed", closes)
+	}
+
+	if len(closedIssues) != 1 || closedIssues[0].ClosedBy != "alice" || closedIssues[0].Author != "outsider" ||
+		len(closedIssues[0].Assignees) != 1 || closedIssues[0].StateReason != domain.IssueStateReasonCompleted {
+		t.Errorf("closed issues = %+v, want I_fixed with its closer, author and assignee", closedIssues)
+	}
+
+	merged := appendIssueLifecycles(openedIssues, append(closedIssues, openedIssues[0]))
+	if len(merged) != 2 {
+		t.Errorf("appendIssueLifecycles = %d lifecycles, want 2 distinct issues", len(merged))
+	}
+}
+
+// closedIssuesByWindow answers closed issue searches with issueCount for
+// windows longer than split and with one issue alice closed an hour into the
+// window otherwise, and reports every searched window.
+func closedIssuesByWindow(t *testing.T, split time.Duration, issueCount int, windows chan<- string) http.HandlerFunc {
+	t.Helper()
+
+	closedRange := regexp.MustCompile(`closed:(\S+)\.\.(\S+)`)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Variables map[string]any `json:"variables"`
+		}
+
+		raw, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(raw, &body)
+
+		query, _ := body.Variables["query"].(string)
+		windows <- query
+
+		match := closedRange.FindStringSubmatch(query)
+		if match == nil {
+			t.Errorf("search query without a closed range: %q", query)
+			http.Error(w, "unexpected query", http.StatusBadRequest)
+
+			return
+		}
+
+		start, _ := time.Parse(time.RFC3339, match[1])
+		end, _ := time.Parse(time.RFC3339, match[2])
+
+		count := 1
+		if end.Sub(start) > split {
+			count = issueCount
+		}
+
+		closedAt := start.Add(time.Hour).Format(time.RFC3339)
+		_, _ = io.WriteString(w, `{"data":{"search":{"issueCount":`+strconv.Itoa(count)+`,"nodes":[
+			{"id":"I_`+match[1]+`","createdAt":"2023-01-01T09:00:00Z","closedAt":"`+closedAt+`",
+			 "author":{"login":"outsider"},"repository":{"nameWithOwner":"acme/api","owner":{"login":"acme","__typename":"Organization"}},
+			 "timelineItems":{"nodes":[{"actor":{"login":"alice"}}]}}
+		],"pageInfo":{"hasNextPage":false,"endCursor":""}}}}`)
+	}
+}
+
+func TestFetchIssueCloses_SplitsWindowsOverSearchLimit(t *testing.T) {
+	t.Parallel()
+
+	windows := make(chan string, 100)
+	server := httptest.NewServer(closedIssuesByWindow(t, 40*24*time.Hour, 1500, windows))
+	defer server.Close()
+
+	fetcher := NewGitHubDataFetcher(NewGitHubRepository(newTestClient(t, server.URL, "token")))
+	fetcher.SetCollectionPeriod(domain.CollectionPeriod{Until: time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC)})
+
+	gaps := dataGaps{}
+
+	closes, _, err := fetcher.fetchIssueClosesSince(context.Background(), "alice", time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), &gaps)
+	if err != nil {
+		t.Fatalf("fetchIssueClosesSince: %v", err)
+	}
+
+	close(windows)
+
+	// 91 days are split into halves until each window is at most 40 days long: 1 + 2 + 4 searches.
+	if len(windows) != 7 || len(closes) != 4 || len(gaps) != 0 {
+		t.Errorf("%d searches, %d closes, gaps %+v; want 7 searches, one close per 4 windows and no gaps", len(windows), len(closes), gaps)
+	}
+}
+
+func TestFetchIssueCloses_RecordsGapWhenDayExceedsSearchLimit(t *testing.T) {
+	t.Parallel()
+
+	windows := make(chan string, 100)
+	server := httptest.NewServer(closedIssuesByWindow(t, 0, 1500, windows))
+	defer server.Close()
+
+	fetcher := NewGitHubDataFetcher(NewGitHubRepository(newTestClient(t, server.URL, "token")))
+	fetcher.SetCollectionPeriod(domain.CollectionPeriod{Until: time.Date(2024, time.March, 3, 0, 0, 0, 0, time.UTC)})
+
+	gaps := dataGaps{}
+
+	closes, _, err := fetcher.fetchIssueClosesSince(context.Background(), "alice", time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC), &gaps)
+	if err != nil {
+		t.Fatalf("fetchIssueClosesSince: %v", err)
+	}
+
+	close(windows)
+
+	// Two days are split into two one-day windows, which still exceed the limit and keep what they returned.
+	if len(windows) != 3 || len(closes) != 2 {
+		t.Errorf("%d searches, %d closes; want 3 searches and the close of each day", len(windows), len(closes))
+	}
+
+	if len(gaps) != 2 || gaps[0].ActivityType != domain.ActivityTypeIssueClose || gaps[0].Reason != domain.DataGapTruncated {
+		t.Errorf("gaps = %+v, want a truncated issue close gap for each day", gaps)
+	}
+}
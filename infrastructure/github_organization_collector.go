@@ -0,0 +1,600 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Tattsum/github-analytics/domain"
+	"github.com/shurcooL/githubv4"
+)
+
+// organizationPageSize はリポジトリ単位の収集で1ページに取得する件数です.
+const organizationPageSize = 100
+
+// ErrActivityNotCollected は、リポジトリ単位の収集に含まれていないメンバー・期間の活動を求められたことを表します.
+var ErrActivityNotCollected = errors.New("activity was not collected for this member and period")
+
+// organizationRepository は組織のリポジトリ一覧の1件です.
+type organizationRepository struct {
+	Name          string
+	NameWithOwner string
+	IsPrivate     bool
+	Owner         struct {
+		Login    string
+		Typename string `graphql:"__typename"`
+	}
+}
+
+// historyCommitNode はデフォルトブランチの履歴の1コミットです.
+type historyCommitNode struct {
+	AuthoredDate githubv4.DateTime
+	Additions    int
+	Deletions    int
+	Author       struct {
+		User *struct {
+			Login string
+		}
+	}
+}
+
+// repositoryPullRequestNode はリポジトリのPull Request一覧の1件です.
+// 作成後にレビューされたPRも拾うため、更新日時の降順で走査します.
+type repositoryPullRequestNode struct {
+	pullRequestNode
+
+	UpdatedAt githubv4.DateTime
+	Author    *struct {
+		Login    string
+		Typename string `graphql:"__typename"`
+	}
+}
+
+// repositoryIssueNode はリポジトリのIssue一覧の1件です.
+// 作成後にクローズされたIssueも拾うため、更新日時の降順で走査します.
+type repositoryIssueNode struct {
+	issueNode
+
+	UpdatedAt githubv4.DateTime
+}
+
+// OrganizationActivity は組織のリポジトリを1度ずつ走査して集めた、ロースターのメンバーごとの活動です.
+// FetchUserActivitySince で、ユーザー単位の取得と同じ UserActivityData としてメンバーごとに取り出せます.
+type OrganizationActivity struct {
+	repo *GitHubRepository
+	// Since は収集の起点です（ゼロ値なら全期間）.
+	Since time.Time
+	// Repositories は走査したリポジトリの数です.
+	Repositories int
+
+	members map[string]*UserActivityData
+	// gaps は取得に失敗したリポジトリの DataGap です. そのリポジトリに活動があるメンバーにだけ記録します.
+	gaps []*domain.DataGap
+}
+
+// CollectOrganizationActivity は組織 org のリポジトリを列挙し、各リポジトリのデフォルトブランチの履歴・Pull Request・
+// レビュー・Issue を1度ずつ走査して、roster のログインに帰属させた活動を返します.
+// ユーザー単位の取得と異なり、contributionsCollection に数えられない活動（未検証のメールアドレスによるコミットなど）も含まれ、
+// コミットは実際の追加・削除行数を持ちます. roster に含まれないログインの活動は捨てます.
+// includePrivate が false の場合は private リポジトリを走査しません.
+// リポジトリの一覧を取得できなければエラーを返しますが、個々のリポジトリの失敗は DataGap として記録して続行します.
+// DataGap は、そのリポジトリで取得できた活動があるメンバーにだけ帰属させます.
+// リポジトリは最大 concurrency 件ずつ並行に走査します.
+func (f *GitHubDataFetcher) CollectOrganizationActivity(
+	ctx context.Context,
+	org string,
+	roster []string,
+	includePrivate bool,
+	since time.Time,
+	concurrency int,
+) (*OrganizationActivity, error) {
+	repos, err := f.fetchOrganizationRepositories(ctx, org, includePrivate)
+	if err != nil {
+		return nil, err
+	}
+
+	collector := newOrganizationCollector(roster, f.lookbackSince(since), f.fetchEnd(), f.contributionYears())
+	collector.exclusion = f.exclusion
+	collector.issueLabels = f.issueLabels
+	collector.prClassifier = f.prClassifier
+
+	work := make(chan *organizationRepository)
+
+	var wg sync.WaitGroup
+
+	for range max(concurrency, 1) {
+		wg.Go(func() {
+			for repo := range work {
+				f.collectRepository(ctx, repo, collector)
+			}
+		})
+	}
+
+	for _, repo := range repos {
+		work <- repo
+	}
+
+	close(work)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("failed to collect organization activity: %w", err)
+	}
+
+	return &OrganizationActivity{
+		repo:         f.repo,
+		Since:        since,
+		Repositories: len(repos),
+		members:      collector.members,
+		gaps:         collector.gaps,
+	}, nil
+}
+
+// FetchUserActivitySince は収集した活動のうち、login の since 以降の活動を返します.
+// ユーザー情報だけはこの呼び出しで取得します.
+// login がロースターに無い場合や、since が収集の起点より前の場合は ErrActivityNotCollected を返します.
+func (a *OrganizationActivity) FetchUserActivitySince(
+	ctx context.Context,
+	login string,
+	_ bool,
+	since time.Time,
+) (*UserActivityData, error) {
+	collected, ok := a.members[strings.ToLower(login)]
+	if !ok || since.Before(a.Since) {
+		return nil, fmt.Errorf("%w: %s since %s", ErrActivityNotCollected, login, since.Format(time.RFC3339))
+	}
+
+	user, err := a.repo.FetchUserInfo(ctx, login)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch user info: %w", err)
+	}
+
+	data := &UserActivityData{
+		User:            user,
+		Commits:         activitiesSince(collected.Commits, since),
+		PRs:             activitiesSince(collected.PRs, since),
+		Issues:          activitiesSince(collected.Issues, since),
+		Reviews:         activitiesSince(collected.Reviews, since),
+		IssueCloses:     activitiesSince(collected.IssueCloses, since),
+		PRLifecycles:    make([]*domain.PullRequestLifecycle, 0, len(collected.PRLifecycles)),
+		IssueLifecycles: make([]*domain.IssueLifecycle, 0, len(collected.IssueLifecycles)),
+		Gaps:            memberGaps(collected, a.gaps),
+	}
+
+	for _, lifecycle := range collected.PRLifecycles {
+		if !lifecycle.CreatedAt.Before(since) {
+			data.PRLifecycles = append(data.PRLifecycles, lifecycle)
+		}
+	}
+
+	for _, lifecycle := range collected.IssueLifecycles {
+		if !lifecycle.CreatedAt.Before(since) || (lifecycle.ClosedAt != nil && !lifecycle.ClosedAt.Before(since)) {
+			data.IssueLifecycles = append(data.IssueLifecycles, lifecycle)
+		}
+	}
+
+	return data, nil
+}
+
+// memberGaps は gaps のうち、collected に活動があるリポジトリのものを返します.
+// 1つのリポジトリの失敗で、そのリポジトリに関わらないメンバーまで欠損扱いにしないためです.
+func memberGaps(collected *UserActivityData, gaps []*domain.DataGap) []*domain.DataGap {
+	active := make(map[string]bool)
+
+	for _, activities := range [][]*domain.Activity{
+		collected.Commits, collected.PRs, collected.Issues, collected.Reviews, collected.IssueCloses,
+	} {
+		for _, activity := range activities {
+			active[activity.Repository] = true
+		}
+	}
+
+	for _, lifecycle := range collected.IssueLifecycles {
+		active[lifecycle.Repository] = true
+	}
+
+	filtered := make([]*domain.DataGap, 0, len(gaps))
+
+	for _, gap := range gaps {
+		if active[gap.Repository] {
+			filtered = append(filtered, gap)
+		}
+	}
+
+	return filtered
+}
+
+// activitiesSince は since 以降に発生した活動を返します.
+func activitiesSince(activities []*domain.Activity, since time.Time) []*domain.Activity {
+	filtered := make([]*domain.Activity, 0, len(activities))
+
+	for _, activity := range activities {
+		if !activity.Date.Before(since) {
+			filtered = append(filtered, activity)
+		}
+	}
+
+	return filtered
+}
+
+// organizationCollector は並行に走査したリポジトリの結果を、ロースターのメンバーごとに集めます.
+type organizationCollector struct {
+	since time.Time
+	// start はコミット・レビューの取得開始日時です（ユーザー単位の取得と同じく遡り上限で打ち切ります）.
+	start time.Time
+	// end は収集期間の終わりです. これ以降の活動は帰属させません.
+	end time.Time
+	// exclusion はPRのライフサイクルから除くレビュアーです.
+	exclusion *domain.ActorExclusion
+	// issueLabels はIssueの種類を判定するラベル名の対応です.
+	issueLabels *domain.IssueLabelMapping
+	// prClassifier はPRの作業の種類を判定します.
+	prClassifier *domain.PRClassifier
+
+	mu sync.Mutex
+	// members は小文字のログインをキーとする、メンバーごとの活動です.
+	members map[string]*UserActivityData
+	gaps    []*domain.DataGap
+}
+
+// newOrganizationCollector は roster のメンバーの [since, end) の活動を集める organizationCollector を作成します.
+func newOrganizationCollector(roster []string, since, end time.Time, years int) *organizationCollector {
+	members := make(map[string]*UserActivityData, len(roster))
+
+	for _, login := range roster {
+		members[strings.ToLower(login)] = &UserActivityData{
+			Commits:         make([]*domain.Activity, 0),
+			PRs:             make([]*domain.Activity, 0),
+			Issues:          make([]*domain.Activity, 0),
+			Reviews:         make([]*domain.Activity, 0),
+			IssueCloses:     make([]*domain.Activity, 0),
+			PRLifecycles:    make([]*domain.PullRequestLifecycle, 0),
+			IssueLifecycles: make([]*domain.IssueLifecycle, 0),
+		}
+	}
+
+	return &organizationCollector{
+		since:   since,
+		start:   contributionStart(since, end, years),
+		end:     end,
+		members: members,
+	}
+}
+
+// member はロースターに含まれる login の活動を返します（含まれなければ nil）.
+// 呼び出し元が mu を保持している必要があります.
+func (c *organizationCollector) member(login string) *UserActivityData {
+	if login == "" {
+		return nil
+	}
+
+	return c.members[strings.ToLower(login)]
+}
+
+// recordGap は repo の activityType の取得失敗を記録します.
+func (c *organizationCollector) recordGap(activityType domain.ActivityType, repo string, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.gaps = append(c.gaps, &domain.DataGap{
+		ActivityType: activityType,
+		Repository:   repo,
+		Reason:       DataGapReasonOf(err),
+		Message:      err.Error(),
+	})
+}
+
+// newRepositoryActivity はリポジトリ repo で発生した活動を作成します.
+func newRepositoryActivity(
+	activityType domain.ActivityType,
+	repo *organizationRepository,
+	date time.Time,
+	additions, deletions int,
+) *domain.Activity {
+	activity := domain.NewActivity(activityType, repo.NameWithOwner, date, additions, deletions)
+	activity.RepositoryOwner = repo.Owner.Login
+	activity.RepositoryOwnerType = repo.Owner.Typename
+
+	return activity
+}
+
+// addCommits はコミットを作者に帰属させます.
+func (c *organizationCollector) addCommits(repo *organizationRepository, commits []historyCommitNode) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, commit := range commits {
+		if commit.Author.User == nil || commit.AuthoredDate.Before(c.start) || !commit.AuthoredDate.Before(c.end) {
+			continue
+		}
+
+		if data := c.member(commit.Author.User.Login); data != nil {
+			data.Commits = append(data.Commits, newRepositoryActivity(
+				domain.ActivityTypeCommit, repo, commit.AuthoredDate.Time, commit.Additions, commit.Deletions))
+		}
+	}
+}
+
+// addPullRequest はPull Requestを作成者に、そのレビューをレビュアーに帰属させます.
+func (c *organizationCollector) addPullRequest(repo *organizationRepository, pr *repositoryPullRequestNode) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var author, authorType string
+	if pr.Author != nil {
+		author, authorType = pr.Author.Login, pr.Author.Typename
+	}
+
+	if data := c.member(author); data != nil && !pr.CreatedAt.Before(c.since) && pr.CreatedAt.Before(c.end) {
+		activity := newRepositoryActivity(domain.ActivityTypePR, repo, pr.CreatedAt.Time, pr.Additions, pr.Deletions)
+		activity.IsMerged = pr.MergedAt != nil
+		activity.SourceID = pr.ID
+		activity.WorkCategory = classifyPullRequest(&pr.pullRequestNode, c.prClassifier)
+		data.PRs = append(data.PRs, activity)
+		data.PRLifecycles = append(data.PRLifecycles, newPullRequestLifecycle(author, &pr.pullRequestNode, c.exclusion))
+	}
+
+	for _, review := range pr.Reviews.Nodes {
+		if review.SubmittedAt == nil || review.SubmittedAt.Before(c.start) || !review.SubmittedAt.Before(c.end) {
+			continue
+		}
+
+		if data := c.member(review.Author.Login); data != nil {
+			activity := newRepositoryActivity(domain.ActivityTypeReview, repo, review.SubmittedAt.Time, 0, 0)
+			activity.IsReview = true
+			activity.SourceID = review.ID
+			activity.PullRequestAuthor = author
+			activity.PullRequestAuthorType = authorType
+			data.Reviews = append(data.Reviews, activity)
+		}
+	}
+}
+
+// addIssue はIssueの作成を作成者に、クローズをクローズした人に帰属させます.
+// ライフサイクルはそのどちらかに当たるメンバーに1件ずつ持たせます.
+func (c *organizationCollector) addIssue(repo *organizationRepository, issue *repositoryIssueNode) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	lifecycle := newIssueLifecycle(&issue.issueNode, c.issueLabels)
+	lifecycle.Repository = repo.NameWithOwner
+
+	author := c.member(lifecycle.Author)
+	if author != nil && (lifecycle.CreatedAt.Before(c.since) || !lifecycle.CreatedAt.Before(c.end)) {
+		author = nil
+	}
+
+	if author != nil {
+		activity := newIssueActivity(domain.ActivityTypeIssue, lifecycle, repo.Owner.Login, repo.Owner.Typename)
+		author.Issues = append(author.Issues, activity)
+		author.IssueLifecycles = append(author.IssueLifecycles, lifecycle)
+	}
+
+	if lifecycle.ClosedAt == nil || lifecycle.ClosedAt.Before(c.since) || !lifecycle.ClosedAt.Before(c.end) {
+		return
+	}
+
+	if closer := c.member(lifecycle.ClosedBy); closer != nil {
+		activity := newIssueActivity(domain.ActivityTypeIssueClose, lifecycle, repo.Owner.Login, repo.Owner.Typename)
+		closer.IssueCloses = append(closer.IssueCloses, activity)
+
+		if closer != author {
+			closer.IssueLifecycles = append(closer.IssueLifecycles, lifecycle)
+		}
+	}
+}
+
+// collectRepository は1つのリポジトリの履歴・Pull Request・Issue を走査します.
+// 失敗した種類は DataGap として記録し、取得できたページの活動は残します.
+func (f *GitHubDataFetcher) collectRepository(ctx context.Context, repo *organizationRepository, c *organizationCollector) {
+	if err := f.walkRepositoryHistory(ctx, repo, c); err != nil {
+		c.recordGap(domain.ActivityTypeCommit, repo.NameWithOwner, err)
+	}
+
+	if err := f.walkRepositoryPullRequests(ctx, repo, c); err != nil {
+		c.recordGap(domain.ActivityTypePR, repo.NameWithOwner, err)
+		c.recordGap(domain.ActivityTypeReview, repo.NameWithOwner, err)
+	}
+
+	if err := f.walkRepositoryIssues(ctx, repo, c); err != nil {
+		c.recordGap(domain.ActivityTypeIssue, repo.NameWithOwner, err)
+		c.recordGap(domain.ActivityTypeIssueClose, repo.NameWithOwner, err)
+	}
+}
+
+// fetchOrganizationRepositories は組織のリポジトリ一覧を取得します.
+func (f *GitHubDataFetcher) fetchOrganizationRepositories(
+	ctx context.Context,
+	org string,
+	includePrivate bool,
+) ([]*organizationRepository, error) {
+	var query struct {
+		Organization struct {
+			Repositories struct {
+				Nodes    []organizationRepository
+				PageInfo struct {
+					HasNextPage bool
+					EndCursor   string
+				}
+			} `graphql:"repositories(first: $first, after: $after, orderBy: {field: NAME, direction: ASC})"`
+		} `graphql:"organization(login: $login)"`
+	}
+
+	repos := make([]*organizationRepository, 0)
+	after := (*githubv4.String)(nil)
+
+	for {
+		variables := map[string]any{
+			gqlVarLogin: githubv4.String(org),
+			gqlVarFirst: githubv4.Int(organizationPageSize),
+			gqlVarAfter: after,
+		}
+
+		if err := f.repo.client.Query(ctx, &query, variables); err != nil {
+			return nil, fmt.Errorf("failed to fetch repositories of organization %s: %w", org, err)
+		}
+
+		for i := range query.Organization.Repositories.Nodes {
+			if repo := query.Organization.Repositories.Nodes[i]; includePrivate || !repo.IsPrivate {
+				repos = append(repos, &repo)
+			}
+		}
+
+		if !query.Organization.Repositories.PageInfo.HasNextPage {
+			return repos, nil
+		}
+
+		cursor := githubv4.String(query.Organization.Repositories.PageInfo.EndCursor)
+		after = &cursor
+	}
+}
+
+// walkRepositoryHistory はデフォルトブランチの履歴のうち、取得開始日時から収集期間の終わりまでのコミットを走査します.
+// 空のリポジトリ（デフォルトブランチが無い）ではコミットはありません.
+func (f *GitHubDataFetcher) walkRepositoryHistory(ctx context.Context, repo *organizationRepository, c *organizationCollector) error {
+	var query struct {
+		Repository struct {
+			DefaultBranchRef *struct {
+				Target struct {
+					Commit struct {
+						History struct {
+							Nodes    []historyCommitNode
+							PageInfo struct {
+								HasNextPage bool
+								EndCursor   string
+							}
+						} `graphql:"history(first: $first, after: $after, since: $since, until: $until)"`
+					} `graphql:"... on Commit"`
+				}
+			}
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+
+	after := (*githubv4.String)(nil)
+
+	for {
+		variables := map[string]any{
+			gqlVarOwner: githubv4.String(repo.Owner.Login),
+			gqlVarName:  githubv4.String(repo.Name),
+			gqlVarSince: githubv4.GitTimestamp{Time: c.start},
+			gqlVarUntil: githubv4.GitTimestamp{Time: c.end},
+			gqlVarFirst: githubv4.Int(organizationPageSize),
+			gqlVarAfter: after,
+		}
+
+		if err := f.repo.client.Query(ctx, &query, variables); err != nil {
+			return fmt.Errorf("failed to fetch history of %s: %w", repo.NameWithOwner, err)
+		}
+
+		if query.Repository.DefaultBranchRef == nil {
+			return nil
+		}
+
+		history := query.Repository.DefaultBranchRef.Target.Commit.History
+		c.addCommits(repo, history.Nodes)
+
+		if !history.PageInfo.HasNextPage {
+			return nil
+		}
+
+		cursor := githubv4.String(history.PageInfo.EndCursor)
+		after = &cursor
+	}
+}
+
+// walkRepositoryPullRequests はPull Requestを更新日時の降順で走査し、since より前に更新されたPRに到達した時点で打ち切ります.
+// since より前に作成され、その後にレビューされたPRも、レビューだけは帰属させます.
+func (f *GitHubDataFetcher) walkRepositoryPullRequests(ctx context.Context, repo *organizationRepository, c *organizationCollector) error {
+	var query struct {
+		Repository struct {
+			PullRequests struct {
+				Nodes    []repositoryPullRequestNode
+				PageInfo struct {
+					HasNextPage bool
+					EndCursor   string
+				}
+			} `graphql:"pullRequests(first: $first, after: $after, orderBy: {field: UPDATED_AT, direction: DESC})"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+
+	after := (*githubv4.String)(nil)
+
+	for {
+		variables := map[string]any{
+			gqlVarOwner: githubv4.String(repo.Owner.Login),
+			gqlVarName:  githubv4.String(repo.Name),
+			gqlVarFirst: githubv4.Int(organizationPageSize),
+			gqlVarAfter: after,
+		}
+
+		if err := f.repo.client.Query(ctx, &query, variables); err != nil {
+			return fmt.Errorf("failed to fetch pull requests of %s: %w", repo.NameWithOwner, err)
+		}
+
+		for i := range query.Repository.PullRequests.Nodes {
+			pr := &query.Repository.PullRequests.Nodes[i]
+			if pr.UpdatedAt.Before(c.since) {
+				return nil
+			}
+
+			c.addPullRequest(repo, pr)
+		}
+
+		if !query.Repository.PullRequests.PageInfo.HasNextPage {
+			return nil
+		}
+
+		cursor := githubv4.String(query.Repository.PullRequests.PageInfo.EndCursor)
+		after = &cursor
+	}
+}
+
+// walkRepositoryIssues はIssueを更新日時の降順で走査し、since より前に更新されたIssueに到達した時点で打ち切ります.
+// since 以降に作成されたIssueと、since 以降にクローズされたIssueはどちらもその時点以降に更新されています.
+func (f *GitHubDataFetcher) walkRepositoryIssues(ctx context.Context, repo *organizationRepository, c *organizationCollector) error {
+	var query struct {
+		Repository struct {
+			Issues struct {
+				Nodes    []repositoryIssueNode
+				PageInfo struct {
+					HasNextPage bool
+					EndCursor   string
+				}
+			} `graphql:"issues(first: $first, after: $after, orderBy: {field: UPDATED_AT, direction: DESC})"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+
+	after := (*githubv4.String)(nil)
+
+	for {
+		variables := map[string]any{
+			gqlVarOwner: githubv4.String(repo.Owner.Login),
+			gqlVarName:  githubv4.String(repo.Name),
+			gqlVarFirst: githubv4.Int(organizationPageSize),
+			gqlVarAfter: after,
+		}
+
+		if err := f.repo.client.Query(ctx, &query, variables); err != nil {
+			return fmt.Errorf("failed to fetch issues of %s: %w", repo.NameWithOwner, err)
+		}
+
+		for i := range query.Repository.Issues.Nodes {
+			issue := &query.Repository.Issues.Nodes[i]
+			if issue.UpdatedAt.Before(c.since) {
+				return nil
+			}
+
+			c.addIssue(repo, issue)
+		}
+
+		if !query.Repository.Issues.PageInfo.HasNextPage {
+			return nil
+		}
+
+		cursor := githubv4.String(query.Repository.Issues.PageInfo.EndCursor)
+		after = &cursor
+	}
+}
@@ -0,0 +1,280 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Tattsum/github-analytics/domain"
+)
+
+// organizationResponses answers the repository-centric collection queries of
+// a fake organization with two repositories, one of which fails to list its
+// pull requests.
+func organizationResponses(t *testing.T) http.HandlerFunc {
+	t.Helper()
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query     string         `json:"query"`
+			Variables map[string]any `json:"variables"`
+		}
+
+		raw, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(raw, &body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+
+		name, _ := body.Variables["name"].(string)
+
+		switch {
+		case strings.Contains(body.Query, "organization("):
+			_, _ = io.WriteString(w, `{"data":{"organization":{"repositories":{"nodes":[
+				{"name":"api","nameWithOwner":"acme/api","isPrivate":false,"owner":{"login":"acme","__typename":"Organization"}},
+				{"name":"web","nameWithOwner":"acme/web","isPrivate":false,"owner":{"login":"acme","__typename":"Organization"}},
+				{"name":"secret","nameWithOwner":"acme/secret","isPrivate":true,"owner":{"login":"acme","__typename":"Organization"}}
+			],"pageInfo":{"hasNextPage":false,"endCursor":""}}}}}`)
+		case strings.Contains(body.Query, "history(") && name == "api":
+			_, _ = io.WriteString(w, `{"data":{"repository":{"defaultBranchRef":{"target":{"history":{"nodes":[
+				{"authoredDate":"2024-03-10T10:00:00Z","additions":12,"deletions":3,"author":{"user":{"login":"Alice"}}},
+				{"authoredDate":"2024-03-05T10:00:00Z","additions":7,"deletions":1,"author":{"user":{"login":"outsider"}}},
+				{"authoredDate":"2024-01-05T10:00:00Z","additions":4,"deletions":0,"author":{"user":null}}
+			],"pageInfo":{"hasNextPage":false,"endCursor":""}}}}}}}`)
+		case strings.Contains(body.Query, "history("):
+			_, _ = io.WriteString(w, `{"data":{"repository":{"defaultBranchRef":null}}}`)
+		case strings.Contains(body.Query, "pullRequests(") && name == "api":
+			_, _ = io.WriteString(w, `{"data":{"repository":{"pullRequests":{"nodes":[
+				{"id":"PR_new","title":"new","createdAt":"2024-03-08T09:00:00Z","updatedAt":"2024-03-09T09:00:00Z",
+				 "mergedAt":"2024-03-09T09:00:00Z","closedAt":"2024-03-09T09:00:00Z",
+				 "repository":{"nameWithOwner":"acme/api","owner":{"login":"acme","__typename":"Organization"}},
+				 "additions":30,"deletions":5,"changedFiles":3,"author":{"login":"alice"},"labels":{"nodes":[{"name":"type: bug"}]},
+				 "reviews":{"nodes":[{"id":"R_1","state":"APPROVED","submittedAt":"2024-03-08T15:00:00Z","author":{"login":"bob"}}]},
+				 "timelineItems":{"nodes":[{"createdAt":"2024-03-08T10:00:00Z","requestedReviewer":{"login":"bob"}},
+				  {"createdAt":"2024-03-08T10:00:00Z","requestedReviewer":{}}]}},
+				{"id":"PR_old","title":"old","createdAt":"2023-12-01T09:00:00Z","updatedAt":"2024-03-02T09:00:00Z",
+				 "mergedAt":null,"closedAt":null,
+				 "repository":{"nameWithOwner":"acme/api","owner":{"login":"acme","__typename":"Organization"}},
+				 "additions":1,"deletions":1,"author":{"login":"outsider"},
+				 "reviews":{"nodes":[{"id":"R_2","state":"COMMENTED","submittedAt":"2024-03-02T09:00:00Z","author":{"login":"alice"}}]}},
+				{"id":"PR_stale","title":"stale","createdAt":"2023-06-01T09:00:00Z","updatedAt":"2023-06-02T09:00:00Z",
+				 "mergedAt":null,"closedAt":null,
+				 "repository":{"nameWithOwner":"acme/api","owner":{"login":"acme","__typename":"Organization"}},
+				 "additions":1,"deletions":1,"author":{"login":"alice"},"reviews":{"nodes":[]}}
+			],"pageInfo":{"hasNextPage":true,"endCursor":"never-requested"}}}}}`)
+		case strings.Contains(body.Query, "pullRequests("):
+			w.WriteHeader(http.StatusNotFound)
+		case strings.Contains(body.Query, "issues("):
+			_, _ = io.WriteString(w, `{"data":{"repository":{"issues":{"nodes":[
+				{"id":"IC_`+name+`","createdAt":"2023-11-01T09:00:00Z","updatedAt":"2024-03-04T09:00:00Z",
+				 "closedAt":"2024-03-04T09:00:00Z","stateReason":"COMPLETED","author":{"login":"outsider"},
+				 "labels":{"nodes":[{"name":"type: bug"}]},"assignees":{"nodes":[{"login":"alice"}]},
+				 "timelineItems":{"nodes":[{"actor":{"login":"alice"}}]}},
+				{"id":"I_`+name+`","createdAt":"2024-03-03T09:00:00Z","updatedAt":"2024-03-03T09:00:00Z","author":{"login":"bob"}},
+				{"id":"I_stale","createdAt":"2023-01-01T09:00:00Z","updatedAt":"2023-01-02T09:00:00Z","author":{"login":"bob"}}
+			],"pageInfo":{"hasNextPage":true,"endCursor":"never-requested"}}}}}`)
+		case strings.Contains(body.Query, "user("):
+			login, _ := body.Variables["login"].(string)
+			_, _ = io.WriteString(w, `{"data":{"user":{"login":"`+login+`","name":"","createdAt":"2020-01-01T00:00:00Z"}}}`)
+		default:
+			t.Errorf("unexpected query: %s", body.Query)
+			http.Error(w, "unexpected query", http.StatusBadRequest)
+		}
+	}
+}
+
+func TestCollectOrganizationActivity_AttributesEventsToRoster(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(organizationResponses(t))
+	defer server.Close()
+
+	fetcher := NewGitHubDataFetcher(NewGitHubRepository(newTestClient(t, server.URL, "token")))
+	since := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	collected, err := fetcher.CollectOrganizationActivity(context.Background(), "acme", []string{"alice", "bob"}, false, since, 2)
+	if err != nil {
+		t.Fatalf("CollectOrganizationActivity: %v", err)
+	}
+
+	if collected.Repositories != 2 {
+		t.Errorf("Repositories = %d, want the two public repositories", collected.Repositories)
+	}
+
+	alice, err := collected.FetchUserActivitySince(context.Background(), "alice", false, since)
+	if err != nil {
+		t.Fatalf("FetchUserActivitySince(alice): %v", err)
+	}
+
+	if alice.User.Login != "alice" {
+		t.Errorf("User.Login = %q, want alice", alice.User.Login)
+	}
+
+	// The commit authored as "Alice" is matched case-insensitively and keeps its real line counts.
+	if len(alice.Commits) != 1 || alice.Commits[0].Additions != 12 || alice.Commits[0].Deletions != 3 {
+		t.Errorf("alice commits = %+v, want one commit with 12/3 lines", alice.Commits)
+	}
+
+	if len(alice.PRs) != 1 || alice.PRs[0].SourceID != "PR_new" || !alice.PRs[0].IsMerged || alice.PRs[0].Additions != 30 ||
+		alice.PRs[0].WorkCategory != domain.WorkCategoryBug {
+		t.Errorf("alice PRs = %+v, want only the merged PR created since the cutoff", alice.PRs)
+	}
+
+	if len(alice.PRLifecycles) != 1 || alice.PRLifecycles[0].FirstReviewAt == nil {
+		t.Fatalf("alice lifecycles = %+v, want the reviewed PR", alice.PRLifecycles)
+	}
+
+	// The team review request has no login and is not counted.
+	if lifecycle := alice.PRLifecycles[0]; lifecycle.ChangedLines() != 35 || lifecycle.ChangedFiles != 3 ||
+		len(lifecycle.ReviewRequests) != 1 || !lifecycle.ReviewRequests[0].Completed {
+		t.Errorf("alice lifecycle = %+v, want 35 lines in 3 files and bob's completed review request", lifecycle)
+	}
+
+	// A review on an older PR that was updated since the cutoff still counts.
+	if len(alice.Reviews) != 1 || alice.Reviews[0].SourceID != "R_2" || alice.Reviews[0].PullRequestAuthor != "outsider" {
+		t.Errorf("alice reviews = %+v, want the review on the outsider's PR", alice.Reviews)
+	}
+
+	// Closing an outsider's older issue counts for the closer, with the issue's kind and age.
+	if len(alice.IssueCloses) != 2 || alice.IssueCloses[0].IssueKind != domain.IssueKindBug ||
+		!alice.IssueCloses[0].IssueOpenedAt.Equal(time.Date(2023, time.November, 1, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("alice issue closes = %+v, want the two closed bugs", alice.IssueCloses)
+	}
+
+	if len(alice.IssueLifecycles) != 2 || alice.IssueLifecycles[0].ClosedBy != "alice" ||
+		alice.IssueLifecycles[0].StateReason != domain.IssueStateReasonCompleted || alice.IssueLifecycles[0].Author != "outsider" {
+		t.Errorf("alice issue lifecycles = %+v, want the two closed issues", alice.IssueLifecycles)
+	}
+
+	bob, err := collected.FetchUserActivitySince(context.Background(), "bob", false, since)
+	if err != nil {
+		t.Fatalf("FetchUserActivitySince(bob): %v", err)
+	}
+
+	if len(bob.Commits) != 0 || len(bob.PRs) != 0 || len(bob.Reviews) != 1 || len(bob.Issues) != 2 {
+		t.Errorf("bob = %d commits, %d PRs, %d reviews, %d issues; want 0, 0, 1, 2",
+			len(bob.Commits), len(bob.PRs), len(bob.Reviews), len(bob.Issues))
+	}
+
+	if len(bob.IssueCloses) != 0 || len(bob.IssueLifecycles) != 2 || bob.Issues[0].IssueKind != domain.IssueKindOther {
+		t.Errorf("bob = %d issue closes, %d issue lifecycles, issue kind %q; want 0, 2, OTHER",
+			len(bob.IssueCloses), len(bob.IssueLifecycles), bob.Issues[0].IssueKind)
+	}
+
+	// Both members have issue activity in acme/web, so its failed pull request walk is a gap for each of them.
+	for _, data := range []*UserActivityData{alice, bob} {
+		if len(data.Gaps) != 2 || data.Gaps[0].Repository != "acme/web" || data.Gaps[0].Reason != domain.DataGapNotFound {
+			t.Errorf("%s gaps = %+v, want pull request and review gaps for acme/web", data.User.Login, data.Gaps)
+		}
+	}
+}
+
+func TestOrganizationActivity_FetchUserActivitySince(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(organizationResponses(t))
+	defer server.Close()
+
+	fetcher := NewGitHubDataFetcher(NewGitHubRepository(newTestClient(t, server.URL, "token")))
+	since := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	collected, err := fetcher.CollectOrganizationActivity(context.Background(), "acme", []string{"alice"}, true, since, 1)
+	if err != nil {
+		t.Fatalf("CollectOrganizationActivity: %v", err)
+	}
+
+	if collected.Repositories != 3 {
+		t.Errorf("Repositories = %d, want private repositories included", collected.Repositories)
+	}
+
+	later, err := collected.FetchUserActivitySince(context.Background(), "alice", false, since.AddDate(0, 0, 7))
+	if err != nil {
+		t.Fatalf("FetchUserActivitySince: %v", err)
+	}
+
+	if len(later.Commits) != 1 || len(later.PRs) != 1 || len(later.PRLifecycles) != 1 || len(later.Reviews) != 0 {
+		t.Errorf("activity since March 8 = %d commits, %d PRs, %d lifecycles, %d reviews; want 1, 1, 1, 0",
+			len(later.Commits), len(later.PRs), len(later.PRLifecycles), len(later.Reviews))
+	}
+
+	if _, err := collected.FetchUserActivitySince(context.Background(), "bob", false, since); !errors.Is(err, ErrActivityNotCollected) {
+		t.Errorf("member outside the roster: err = %v, want ErrActivityNotCollected", err)
+	}
+
+	if _, err := collected.FetchUserActivitySince(context.Background(), "alice", false, time.Time{}); !errors.Is(err, ErrActivityNotCollected) {
+		t.Errorf("period before the collection: err = %v, want ErrActivityNotCollected", err)
+	}
+}
+
+func TestCollectOrganizationActivity_SkipsExcludedReviewers(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(organizationResponses(t))
+	defer server.Close()
+
+	exclusion, err := domain.NewActorExclusion([]string{"bob"}, nil, true)
+	if err != nil {
+		t.Fatalf("NewActorExclusion: %v", err)
+	}
+
+	fetcher := NewGitHubDataFetcher(NewGitHubRepository(newTestClient(t, server.URL, "token")))
+	fetcher.SetActorExclusion(exclusion)
+
+	since := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	collected, err := fetcher.CollectOrganizationActivity(context.Background(), "acme", []string{"alice"}, false, since, 1)
+	if err != nil {
+		t.Fatalf("CollectOrganizationActivity: %v", err)
+	}
+
+	alice, err := collected.FetchUserActivitySince(context.Background(), "alice", false, since)
+	if err != nil {
+		t.Fatalf("FetchUserActivitySince: %v", err)
+	}
+
+	// bob's approval is the only review of PR_new, so the PR counts as never reviewed.
+	if len(alice.PRLifecycles) != 1 || alice.PRLifecycles[0].FirstReviewAt != nil || alice.PRLifecycles[0].ApprovedAt != nil {
+		t.Errorf("alice lifecycles = %+v, want PR_new without the excluded reviewer's approval", alice.PRLifecycles)
+	}
+}
+
+func TestCollectOrganizationActivity_GapsOnlyForActiveMembers(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(organizationResponses(t))
+	defer server.Close()
+
+	fetcher := NewGitHubDataFetcher(NewGitHubRepository(newTestClient(t, server.URL, "token")))
+	since := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	collected, err := fetcher.CollectOrganizationActivity(context.Background(), "acme", []string{"alice", "carol"}, false, since, 1)
+	if err != nil {
+		t.Fatalf("CollectOrganizationActivity: %v", err)
+	}
+
+	alice, err := collected.FetchUserActivitySince(context.Background(), "alice", false, since)
+	if err != nil {
+		t.Fatalf("FetchUserActivitySince(alice): %v", err)
+	}
+
+	if len(alice.Gaps) != 2 || alice.Gaps[0].Repository != "acme/web" {
+		t.Errorf("alice gaps = %+v, want pull request and review gaps for acme/web", alice.Gaps)
+	}
+
+	// carol has no activity in acme/web, so its failure does not mark their data as incomplete.
+	carol, err := collected.FetchUserActivitySince(context.Background(), "carol", false, since)
+	if err != nil {
+		t.Fatalf("FetchUserActivitySince(carol): %v", err)
+	}
+
+	if len(carol.Gaps) != 0 {
+		t.Errorf("carol gaps = %+v, want none", carol.Gaps)
+	}
+}
@@ -2,13 +2,18 @@ package infrastructure
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Tattsum/github-analytics/domain"
 	"github.com/shurcooL/githubv4"
 )
 
+// ErrInvalidRepositoryName は owner/name の形式でないリポジトリ名を表します.
+var ErrInvalidRepositoryName = errors.New("invalid repository name")
+
 // GitHubRepository はGitHub APIからデータを取得するリポジトリです.
 type GitHubRepository struct {
 	client *GitHubClient
@@ -61,7 +66,7 @@ type CommitNode struct {
 	Message string
 	Author  struct {
 		Date githubv4.DateTime
-		User struct {
+		User *struct {
 			Login string
 		}
 	}
@@ -70,10 +75,11 @@ type CommitNode struct {
 }
 
 // RepositoryNode はリポジトリ情報を表すGraphQLノードです.
+// 空のリポジトリでは DefaultBranch は nil です.
 type RepositoryNode struct {
 	Name          string
 	IsPrivate     bool
-	DefaultBranch struct {
+	DefaultBranch *struct {
 		Target struct {
 			Commit struct {
 				History struct {
@@ -83,13 +89,15 @@ type RepositoryNode struct {
 						HasNextPage bool
 						EndCursor   string
 					}
-				} `graphql:"history(first: $first, after: $after, author: $author)"`
+				} `graphql:"history(first: $first, after: $after, author: $author, since: $since, until: $until)"`
 			} `graphql:"... on Commit"`
 		}
-	} `graphql:"defaultBranchRef @include(if: $includeBranch)"`
+	} `graphql:"defaultBranchRef"`
 }
 
 // FetchCommits は指定ユーザーのコミットを取得します.
+// ユーザーがアクセス可能なリポジトリのデフォルトブランチの履歴を直近 contributionLookbackYears 年分たどるため、
+// コミットは実際の追加・削除行数を持ちます.
 func (r *GitHubRepository) FetchCommits(ctx context.Context, username string, includePrivate bool) ([]*domain.Activity, error) {
 	activities := make([]*domain.Activity, 0)
 
@@ -99,6 +107,14 @@ func (r *GitHubRepository) FetchCommits(ctx context.Context, username string, in
 		return nil, fmt.Errorf("failed to fetch repositories: %w", err)
 	}
 
+	authorID, err := r.fetchUserID(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	until := time.Now()
+	since := until.AddDate(-contributionLookbackYears, 0, 0)
+
 	// 各リポジトリのコミットを並列で取得
 	type repoCommitResult struct {
 		activities []*domain.Activity
@@ -112,13 +128,20 @@ func (r *GitHubRepository) FetchCommits(ctx context.Context, username string, in
 	semaphore := make(chan struct{}, maxConcurrentRepos) // 最大5並列
 
 	for _, repo := range repos {
-		go func(repoName string) {
+		go func(nameWithOwner string) {
 			semaphore <- struct{}{}
 
 			defer func() { <-semaphore }()
 
-			commits, err := r.fetchRepositoryCommits(ctx, username, repoName)
-			results <- repoCommitResult{activities: commits, err: err}
+			commits, err := r.fetchRepositoryCommits(ctx, authorID, nameWithOwner, since, until)
+
+			repoActivities := make([]*domain.Activity, 0, len(commits))
+			for _, commit := range commits {
+				repoActivities = append(repoActivities, domain.NewActivity(
+					domain.ActivityTypeCommit, nameWithOwner, commit.Author.Date.Time, commit.Additions, commit.Deletions))
+			}
+
+			results <- repoCommitResult{activities: repoActivities, err: err}
 		}(repo)
 	}
 
@@ -136,14 +159,14 @@ func (r *GitHubRepository) FetchCommits(ctx context.Context, username string, in
 	return activities, nil
 }
 
-// fetchUserRepositories はユーザーがアクセス可能なリポジトリ一覧を取得します.
+// fetchUserRepositories はユーザーがアクセス可能なリポジトリ一覧（owner/name）を取得します.
 func (r *GitHubRepository) fetchUserRepositories(ctx context.Context, username string, includePrivate bool) ([]string, error) {
 	var query struct {
 		User struct {
 			Repositories struct {
 				Nodes []struct {
-					Name      string
-					IsPrivate bool
+					NameWithOwner string
+					IsPrivate     bool
 				}
 				PageInfo struct {
 					HasNextPage bool
@@ -173,7 +196,7 @@ func (r *GitHubRepository) fetchUserRepositories(ctx context.Context, username s
 				continue
 			}
 
-			repos = append(repos, node.Name)
+			repos = append(repos, node.NameWithOwner)
 		}
 
 		if !query.User.Repositories.PageInfo.HasNextPage {
@@ -187,10 +210,73 @@ func (r *GitHubRepository) fetchUserRepositories(ctx context.Context, username s
 	return repos, nil
 }
 
-// fetchRepositoryCommits は特定リポジトリのコミットを取得します.
-func (r *GitHubRepository) fetchRepositoryCommits(_ context.Context, _, _ string) ([]*domain.Activity, error) {
-	// この実装は簡略化されています
-	// 実際には、リポジトリのowner情報も必要です
-	// より詳細な実装が必要な場合は、リポジトリの完全な情報（owner/repo）を取得する必要があります
-	return nil, domain.ErrNotImplemented
+// fetchUserID はユーザーのノードIDを取得します（コミット履歴を作者で絞り込むために使います）.
+func (r *GitHubRepository) fetchUserID(ctx context.Context, username string) (githubv4.ID, error) {
+	var query struct {
+		User struct {
+			ID githubv4.ID
+		} `graphql:"user(login: $login)"`
+	}
+
+	variables := map[string]any{
+		gqlVarLogin: githubv4.String(username),
+	}
+
+	if err := r.client.Query(ctx, &query, variables); err != nil {
+		return nil, fmt.Errorf("failed to fetch user id: %w", err)
+	}
+
+	return query.User.ID, nil
+}
+
+// fetchRepositoryCommits は nameWithOwner のデフォルトブランチの履歴から、authorID のユーザーが
+// [since, until) に作成したコミットを取得します. 空のリポジトリではコミットはありません.
+func (r *GitHubRepository) fetchRepositoryCommits(
+	ctx context.Context,
+	authorID githubv4.ID,
+	nameWithOwner string,
+	since, until time.Time,
+) ([]CommitNode, error) {
+	owner, name, ok := strings.Cut(nameWithOwner, "/")
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidRepositoryName, nameWithOwner)
+	}
+
+	var query struct {
+		Repository RepositoryNode `graphql:"repository(owner: $owner, name: $name)"`
+	}
+
+	commits := make([]CommitNode, 0)
+	first := 100
+	after := (*githubv4.String)(nil)
+
+	for {
+		variables := map[string]any{
+			gqlVarOwner:  githubv4.String(owner),
+			gqlVarName:   githubv4.String(name),
+			gqlVarAuthor: githubv4.CommitAuthor{ID: &authorID},
+			gqlVarSince:  githubv4.GitTimestamp{Time: since},
+			gqlVarUntil:  githubv4.GitTimestamp{Time: until},
+			gqlVarFirst:  githubv4.Int(first),
+			gqlVarAfter:  after,
+		}
+
+		if err := r.client.Query(ctx, &query, variables); err != nil {
+			return commits, fmt.Errorf("failed to fetch commits of %s: %w", nameWithOwner, err)
+		}
+
+		if query.Repository.DefaultBranch == nil {
+			return commits, nil
+		}
+
+		history := query.Repository.DefaultBranch.Target.Commit.History
+		commits = append(commits, history.Nodes...)
+
+		if !history.PageInfo.HasNextPage {
+			return commits, nil
+		}
+
+		cursor := githubv4.String(history.PageInfo.EndCursor)
+		after = &cursor
+	}
 }
@@ -0,0 +1,218 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/shurcooL/githubv4"
+)
+
+// defaultRateLimitBudget は残量をまだ観測していないトークンに仮定する、1時間あたりのポイント数です.
+const defaultRateLimitBudget = 5000
+
+// rateLimitFields はすべてのクエリに加えて取得する rateLimit です.
+type rateLimitFields struct {
+	Cost      int
+	Remaining int
+	ResetAt   time.Time
+}
+
+// TokenBudget は1つのトークンの、この実行でのポイント消費と最後に観測した残量です.
+// トークンそのものは含みません.
+type TokenBudget struct {
+	// Name はトークンの表示名です（"token 1"、"GitHub App installation 67890" など）.
+	Name string
+	// Requests はこのトークンで実行したクエリの数です.
+	Requests int
+	// Cost はこのトークンで消費したポイントの合計です.
+	Cost int
+	// Observed は応答から残量を観測できたかどうかです（false の場合 Remaining・ResetAt は未設定）.
+	Observed bool
+	// Remaining は最後に観測した残りポイントです.
+	Remaining int
+	// ResetAt は Remaining がリセットされる時刻です.
+	ResetAt time.Time
+}
+
+// pooledToken はトークンプールの1つのトークンです.
+type pooledToken struct {
+	client   *githubv4.Client
+	budget   TokenBudget
+	lastCost int
+}
+
+// available は now 時点で使えると見込まれる残りポイントを返します.
+// 未観測のトークンとリセット時刻を過ぎたトークンは defaultRateLimitBudget とみなします.
+func (t *pooledToken) available(now time.Time) int {
+	if !t.budget.Observed || !now.Before(t.budget.ResetAt) {
+		return defaultRateLimitBudget
+	}
+
+	return t.budget.Remaining
+}
+
+// exhausted は次のクエリ（直前と同じコストを仮定）を実行できない残量かどうかを返します.
+func (t *pooledToken) exhausted(now time.Time) bool {
+	return t.available(now) < max(t.lastCost, 1)
+}
+
+// tokenPool は複数のトークンを束ね、クエリごとに残量の最も多いトークンへ振り分けます.
+// すべてのトークンを使い切った場合だけ、最も早いリセット時刻まで待ちます.
+type tokenPool struct {
+	mu     sync.Mutex
+	tokens []*pooledToken
+	now    func() time.Time
+	// onWait はすべてのトークンを使い切って待機を始めるときに呼ばれます（nil 可）.
+	onWait func(until time.Time)
+}
+
+// newTokenPool は tokens からトークンプールを作成します.
+func newTokenPool(tokens []*pooledToken, onWait func(until time.Time)) *tokenPool {
+	return &tokenPool{tokens: tokens, now: time.Now, onWait: onWait}
+}
+
+// acquire は残量の最も多いトークンを返します.
+// すべてのトークンを使い切っている場合は、いずれかがリセットされるまで待ちます.
+func (p *tokenPool) acquire(ctx context.Context) (*pooledToken, error) {
+	for {
+		token, until := p.pick()
+		if token != nil {
+			return token, nil
+		}
+
+		if p.onWait != nil {
+			p.onWait(until)
+		}
+
+		timer := time.NewTimer(until.Sub(p.now()))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return nil, fmt.Errorf("context cancelled while waiting for rate limit reset: %w", ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+// pick は残量の最も多いトークンを返します.
+// すべて使い切っている場合は nil と、最も早いリセット時刻を返します.
+func (p *tokenPool) pick() (*pooledToken, time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+
+	var (
+		best     *pooledToken
+		earliest time.Time
+	)
+
+	for _, t := range p.tokens {
+		if t.exhausted(now) {
+			if earliest.IsZero() || t.budget.ResetAt.Before(earliest) {
+				earliest = t.budget.ResetAt
+			}
+
+			continue
+		}
+
+		if best == nil || t.available(now) > best.available(now) {
+			best = t
+		}
+	}
+
+	return best, earliest
+}
+
+// record はクエリの応答で観測した rateLimit をトークンに反映します.
+// 並行に実行したクエリの応答は順不同で届くため、同じリセット期間内では小さい方の残量を採用します.
+func (p *tokenPool) record(t *pooledToken, rl *rateLimitFields) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	t.budget.Requests++
+
+	if rl == nil || rl.ResetAt.IsZero() {
+		return
+	}
+
+	t.budget.Cost += rl.Cost
+	t.lastCost = rl.Cost
+
+	switch {
+	case !t.budget.Observed || rl.ResetAt.After(t.budget.ResetAt):
+		t.budget.Observed = true
+		t.budget.Remaining = rl.Remaining
+		t.budget.ResetAt = rl.ResetAt
+	case rl.ResetAt.Equal(t.budget.ResetAt) && rl.Remaining < t.budget.Remaining:
+		t.budget.Remaining = rl.Remaining
+	}
+}
+
+// exhaust はレート制限に達したと応答されたトークンを、until まで使い切った状態にします.
+func (p *tokenPool) exhaust(t *pooledToken, until time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	t.budget.Observed = true
+	t.budget.Remaining = 0
+	t.budget.ResetAt = maxTime(t.budget.ResetAt, until)
+}
+
+// maxTime は a と b の遅い方を返します.
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+
+	return b
+}
+
+// budgets はトークンごとの TokenBudget を返します.
+func (p *tokenPool) budgets() []TokenBudget {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	budgets := make([]TokenBudget, len(p.tokens))
+	for i, t := range p.tokens {
+		budgets[i] = t.budget
+	}
+
+	return budgets
+}
+
+// queryWithRateLimit は q に rateLimit を加えたクエリを作成します.
+// 戻り値の query を実行した後に copyBack を呼ぶと、結果を q へ書き戻し、観測した rateLimit を返します.
+// q に加えられない場合（メソッドを持つ型や、すでに RateLimit を持つクエリ）は q をそのまま返します.
+func queryWithRateLimit(q any) (query any, copyBack func() *rateLimitFields) {
+	v := reflect.ValueOf(q)
+	if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct {
+		return q, func() *rateLimitFields { return nil }
+	}
+
+	t := v.Elem().Type()
+	if _, ok := t.FieldByName("RateLimit"); ok || t.NumMethod() > 0 || v.Type().NumMethod() > 0 {
+		return q, func() *rateLimitFields { return nil }
+	}
+
+	// 埋め込んだ構造体のフィールドは、クエリの組み立てでも応答のデコードでも元のクエリと同じ階層に展開されます.
+	wrapped := reflect.New(reflect.StructOf([]reflect.StructField{
+		{Name: "Query", Type: t, Anonymous: true},
+		{Name: "RateLimit", Type: reflect.TypeFor[*rateLimitFields]()},
+	}))
+
+	return wrapped.Interface(), func() *rateLimitFields {
+		v.Elem().Set(wrapped.Elem().Field(0))
+
+		if rl, ok := wrapped.Elem().Field(1).Interface().(*rateLimitFields); ok {
+			return rl
+		}
+
+		return nil
+	}
+}
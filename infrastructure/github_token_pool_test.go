@@ -0,0 +1,173 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestGitHubClient_RoutesToTokenWithMostBudget serves a fake GraphQL endpoint
+// that reports a different remaining budget per token, and checks that the
+// client reads rateLimit from every response and moves to the fuller token.
+func TestGitHubClient_RoutesToTokenWithMostBudget(t *testing.T) {
+	t.Parallel()
+
+	resetAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	remaining := map[string]int{"Bearer low": 10, "Bearer high": 4000}
+
+	var (
+		mu      sync.Mutex
+		used    []string
+		queries []string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query string `json:"query"`
+		}
+
+		raw, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(raw, &body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+
+		auth := r.Header.Get("Authorization")
+
+		mu.Lock()
+		used = append(used, auth)
+		queries = append(queries, body.Query)
+		remaining[auth] -= 2
+		left := remaining[auth]
+		mu.Unlock()
+
+		fmt.Fprintf(w, `{"data":{"user":{"login":"octocat"},"rateLimit":{"cost":2,"remaining":%d,"resetAt":%q}}}`,
+			left, resetAt.Format(time.RFC3339))
+	}))
+	defer server.Close()
+
+	client, err := NewGitHubClientFromConfig(GitHubClientConfig{Token: "low", Tokens: []string{"high"}, APIURL: server.URL})
+	if err != nil {
+		t.Fatalf("NewGitHubClientFromConfig: %v", err)
+	}
+
+	client.limiter.SetLimit(1000)
+
+	for range 3 {
+		var query struct {
+			User struct {
+				Login string
+			} `graphql:"user(login: \"octocat\")"`
+		}
+
+		if err := client.Query(context.Background(), &query, nil); err != nil {
+			t.Fatalf("Query: %v", err)
+		}
+
+		if query.User.Login != "octocat" {
+			t.Fatalf("query.User.Login = %q, want the result copied back into the caller's query", query.User.Login)
+		}
+	}
+
+	// Both tokens start unobserved; once "low" reports 8 points left, the
+	// unobserved "high" and then the observed 3998 win.
+	want := []string{"Bearer low", "Bearer high", "Bearer high"}
+	if strings.Join(used, ",") != strings.Join(want, ",") {
+		t.Errorf("tokens used = %v, want %v", used, want)
+	}
+
+	if !strings.Contains(queries[0], "rateLimit{cost,remaining,resetAt}") {
+		t.Errorf("query does not ask for rateLimit: %s", queries[0])
+	}
+
+	budgets := client.Budgets()
+	if len(budgets) != 2 {
+		t.Fatalf("Budgets = %+v, want two tokens", budgets)
+	}
+
+	if b := budgets[0]; b.Name != "token 1" || b.Requests != 1 || b.Cost != 2 || b.Remaining != 8 || !b.ResetAt.Equal(resetAt) {
+		t.Errorf("budget of token 1 = %+v", b)
+	}
+
+	if b := budgets[1]; b.Name != "token 2" || b.Requests != 2 || b.Cost != 4 || b.Remaining != 3996 {
+		t.Errorf("budget of token 2 = %+v", b)
+	}
+}
+
+func TestTokenPool_WaitsOnlyWhenAllTokensAreExhausted(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, time.March, 15, 9, 0, 0, 0, time.UTC)
+	exhausted := &pooledToken{budget: TokenBudget{Name: "a"}}
+	spare := &pooledToken{budget: TokenBudget{Name: "b"}}
+
+	pool := newTokenPool([]*pooledToken{exhausted, spare}, nil)
+	pool.now = func() time.Time { return now }
+
+	pool.record(exhausted, &rateLimitFields{Cost: 5, Remaining: 3, ResetAt: now.Add(10 * time.Minute)})
+	pool.record(spare, &rateLimitFields{Cost: 1, Remaining: 1, ResetAt: now.Add(20 * time.Minute)})
+
+	// 3 points are not enough for another 5-point query, but 1 point is enough for a 1-point one.
+	if got, _ := pool.pick(); got != spare {
+		t.Fatalf("pick = %+v, want the token that still has budget", got)
+	}
+
+	pool.record(spare, &rateLimitFields{Cost: 1, Remaining: 0, ResetAt: now.Add(20 * time.Minute)})
+
+	got, until := pool.pick()
+	if got != nil || !until.Equal(now.Add(10*time.Minute)) {
+		t.Fatalf("pick = %+v, %v; want no token until the earliest reset", got, until)
+	}
+
+	// A late response from the same window must not raise the remaining budget again.
+	pool.record(spare, &rateLimitFields{Cost: 1, Remaining: 50, ResetAt: now.Add(20 * time.Minute)})
+
+	if got, _ := pool.pick(); got != nil {
+		t.Fatalf("pick after a stale response = %+v, want no token", got)
+	}
+
+	now = now.Add(10 * time.Minute)
+
+	if got, _ := pool.pick(); got != exhausted {
+		t.Errorf("pick after the reset = %+v, want the reset token", got)
+	}
+}
+
+func TestTokenPool_AcquireWaitsForReset(t *testing.T) {
+	t.Parallel()
+
+	token := &pooledToken{}
+
+	var waited []time.Time
+
+	pool := newTokenPool([]*pooledToken{token}, func(until time.Time) { waited = append(waited, until) })
+
+	resetAt := time.Now().Add(50 * time.Millisecond)
+	pool.record(token, &rateLimitFields{Cost: 1, Remaining: 0, ResetAt: resetAt})
+
+	got, err := pool.acquire(context.Background())
+	if err != nil || got != token {
+		t.Fatalf("acquire = %v, %v; want the token after its reset", got, err)
+	}
+
+	if len(waited) != 1 || !waited[0].Equal(resetAt) {
+		t.Errorf("onWait calls = %v, want one wait until %v", waited, resetAt)
+	}
+
+	pool.record(token, &rateLimitFields{Cost: 1, Remaining: 0, ResetAt: time.Now().Add(time.Hour)})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := pool.acquire(ctx); err == nil {
+		t.Error("acquire with a cancelled context succeeded while every token is exhausted")
+	}
+}
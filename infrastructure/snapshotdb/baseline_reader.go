@@ -0,0 +1,382 @@
+package snapshotdb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Tattsum/github-analytics/application"
+	"github.com/Tattsum/github-analytics/domain"
+	"github.com/Tattsum/github-analytics/infrastructure/ent"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberaccount"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberdatagap"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberdaystat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberhourstat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberissue"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberpullrequest"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberrepodaystat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberreviewrequest"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberstat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/repometa"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/reviewedge"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/snapshot"
+)
+
+// SnapshotReader が application.BaselineReader を満たすことをコンパイル時に保証します.
+var _ application.BaselineReader = (*SnapshotReader)(nil)
+
+// Baselines は指定ログインごとに、そのメンバーを含む最新スナップショットの日別行・リポジトリ×日別行・所有者メタ・PRライフサイクル・レビューエッジを返します.
+// 前回バッチで取得に失敗したメンバーは直近のスナップショットに含まれないため、メンバー単位で起点を探します.
+// 収集期間を指定したスナップショットは起点にせず、それより前の期間を指定していないスナップショットから探します.
+// どのスナップショットにも存在しないログインと、最新のスナップショットでデータに欠け（MemberDataGap）があるログインは
+// 戻り値に含めません（呼び出し元が全期間取得して欠けを埋めます）.
+func (r *SnapshotReader) Baselines(ctx context.Context, logins []string) (map[string]*application.MemberBaseline, error) {
+	baselines := make(map[string]*application.MemberBaseline, len(logins))
+	if len(logins) == 0 {
+		return baselines, nil
+	}
+
+	rows, err := r.client.MemberStat.
+		Query().
+		Where(memberstat.LoginIn(logins...)).
+		WithSnapshot().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query member baselines: %w", err)
+	}
+
+	latestByLogin := latestBaselineSnapshots(rows)
+
+	if err := r.dropIncompleteBaselines(ctx, logins, latestByLogin); err != nil {
+		return nil, err
+	}
+
+	snaps := make(map[int]*ent.Snapshot)
+	loginsBySnapshot := make(map[int][]string)
+
+	for login, snap := range latestByLogin {
+		snaps[snap.ID] = snap
+		loginsBySnapshot[snap.ID] = append(loginsBySnapshot[snap.ID], login)
+	}
+
+	for id, snapLogins := range loginsBySnapshot {
+		if err := r.loadBaselines(ctx, snaps[id], snapLogins, baselines); err != nil {
+			return nil, err
+		}
+	}
+
+	return baselines, nil
+}
+
+// latestBaselineSnapshots はメンバー行から、ログインごとにそのメンバーを含む最新スナップショットを選びます.
+// 収集期間（-since / -until）を指定したスナップショットは期間外の活動を含まないため、起点にしません.
+func latestBaselineSnapshots(rows []*ent.MemberStat) map[string]*ent.Snapshot {
+	latestByLogin := make(map[string]*ent.Snapshot, len(rows))
+
+	for _, ms := range rows {
+		snap := ms.Edges.Snapshot
+		if snap == nil || snap.PeriodSince != nil || snap.PeriodUntil != nil {
+			continue
+		}
+
+		if current, exists := latestByLogin[ms.Login]; !exists || snap.CapturedAt.After(current.CapturedAt) {
+			latestByLogin[ms.Login] = snap
+		}
+	}
+
+	return latestByLogin
+}
+
+// dropIncompleteBaselines は、起点のスナップショットでデータに欠けがあるログインを latestByLogin から除きます.
+func (r *SnapshotReader) dropIncompleteBaselines(ctx context.Context, logins []string, latestByLogin map[string]*ent.Snapshot) error {
+	gaps, err := r.client.MemberDataGap.
+		Query().
+		Where(memberdatagap.LoginIn(logins...)).
+		WithSnapshot().
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("query baseline data gaps: %w", err)
+	}
+
+	for _, gap := range gaps {
+		if snap, ok := latestByLogin[gap.Login]; ok && gap.Edges.Snapshot != nil && gap.Edges.Snapshot.ID == snap.ID {
+			delete(latestByLogin, gap.Login)
+		}
+	}
+
+	return nil
+}
+
+// loadBaselines は1スナップショット分の起点統計を、指定ログインについて読み出して baselines へ格納します.
+func (r *SnapshotReader) loadBaselines(
+	ctx context.Context,
+	snap *ent.Snapshot,
+	logins []string,
+	baselines map[string]*application.MemberBaseline,
+) error {
+	dayStats, err := r.client.MemberDayStat.
+		Query().
+		Where(
+			memberdaystat.HasSnapshotWith(snapshot.ID(snap.ID)),
+			memberdaystat.LoginIn(logins...),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("query baseline day stats: %w", err)
+	}
+
+	repoDayStats, err := r.client.MemberRepoDayStat.
+		Query().
+		Where(
+			memberrepodaystat.HasSnapshotWith(snapshot.ID(snap.ID)),
+			memberrepodaystat.LoginIn(logins...),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("query baseline repo day stats: %w", err)
+	}
+
+	metas, err := r.client.RepoMeta.
+		Query().
+		Where(repometa.HasSnapshotWith(snapshot.ID(snap.ID))).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("query baseline repo metas: %w", err)
+	}
+
+	for _, login := range logins {
+		baselines[login] = &application.MemberBaseline{
+			Login:          login,
+			CapturedAt:     snap.CapturedAt,
+			LookbackYears:  snap.LookbackYears,
+			Accounts:       []string{login},
+			DailyStats:     make(map[string]*domain.DailyStatistics),
+			RepoDailyStats: make([]*domain.RepoDailyStatistics, 0),
+		}
+	}
+
+	for _, mds := range dayStats {
+		baselines[mds.Login].DailyStats[mds.Day] = toDailyStatistic(mds)
+	}
+
+	touched := make(map[string]map[string]struct{}, len(logins))
+
+	for _, mrds := range repoDayStats {
+		baseline := baselines[mrds.Login]
+		baseline.RepoDailyStats = append(baseline.RepoDailyStats, &domain.RepoDailyStatistics{
+			Repository:     mrds.NameWithOwner,
+			Date:           mrds.Day,
+			CommitCount:    mrds.CommitCount,
+			PRCreated:      mrds.PrCreated,
+			PRMerged:       mrds.PrMerged,
+			IssueCount:     mrds.IssueCount,
+			ReviewCount:    mrds.ReviewCount,
+			TotalAdditions: mrds.Additions,
+			TotalDeletions: mrds.Deletions,
+			IssueThroughput: domain.IssueThroughput{
+				ClosedCount:   mrds.IssuesClosed,
+				CloseSeconds:  mrds.IssueCloseSeconds,
+				BugOpened:     mrds.BugIssuesOpened,
+				FeatureOpened: mrds.FeatureIssuesOpened,
+				BugClosed:     mrds.BugIssuesClosed,
+				FeatureClosed: mrds.FeatureIssuesClosed,
+			},
+			WorkCategories: domain.WorkCategoryCounts{
+				Feature: mrds.FeaturePrs,
+				Bug:     mrds.BugPrs,
+				Chore:   mrds.ChorePrs,
+				Docs:    mrds.DocsPrs,
+				Test:    mrds.TestPrs,
+				Infra:   mrds.InfraPrs,
+				Other:   mrds.OtherPrs,
+			},
+		})
+
+		if touched[mrds.Login] == nil {
+			touched[mrds.Login] = make(map[string]struct{})
+		}
+
+		touched[mrds.Login][mrds.NameWithOwner] = struct{}{}
+	}
+
+	for _, meta := range toRepoMetaInputs(metas) {
+		for login, repos := range touched {
+			if _, ok := repos[meta.NameWithOwner]; ok {
+				baselines[login].RepoMetas = append(baselines[login].RepoMetas, meta)
+			}
+		}
+	}
+
+	if err := r.loadBaselinePullRequests(ctx, snap, logins, baselines); err != nil {
+		return err
+	}
+
+	if err := r.loadBaselineIssues(ctx, snap, logins, baselines); err != nil {
+		return err
+	}
+
+	if err := r.loadBaselineHours(ctx, snap, logins, baselines); err != nil {
+		return err
+	}
+
+	if err := r.loadBaselineAccounts(ctx, snap, logins, baselines); err != nil {
+		return err
+	}
+
+	return r.loadBaselineReviewEdges(ctx, snap, logins, baselines)
+}
+
+// loadBaselinePullRequests は1スナップショット分のPRライフサイクルを、レビュー依頼付きで指定ログインについて baselines へ格納します.
+func (r *SnapshotReader) loadBaselinePullRequests(
+	ctx context.Context,
+	snap *ent.Snapshot,
+	logins []string,
+	baselines map[string]*application.MemberBaseline,
+) error {
+	prs, err := r.client.MemberPullRequest.
+		Query().
+		Where(
+			memberpullrequest.HasSnapshotWith(snapshot.ID(snap.ID)),
+			memberpullrequest.LoginIn(logins...),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("query baseline pull requests: %w", err)
+	}
+
+	requests, err := r.client.MemberReviewRequest.
+		Query().
+		Where(
+			memberreviewrequest.HasSnapshotWith(snapshot.ID(snap.ID)),
+			memberreviewrequest.LoginIn(logins...),
+		).
+		Order(memberreviewrequest.ByReviewer()).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("query baseline review requests: %w", err)
+	}
+
+	type key struct{ login, sourceID string }
+
+	byPR := make(map[key][]domain.ReviewRequest)
+	for _, mrr := range requests {
+		k := key{login: mrr.Login, sourceID: mrr.SourceID}
+		byPR[k] = append(byPR[k], toReviewRequest(mrr))
+	}
+
+	for _, mpr := range prs {
+		lifecycle := toPullRequestLifecycle(mpr)
+		lifecycle.ReviewRequests = byPR[key{login: mpr.Login, sourceID: mpr.SourceID}]
+
+		baseline := baselines[mpr.Login]
+		baseline.PRLifecycles = append(baseline.PRLifecycles, lifecycle)
+	}
+
+	return nil
+}
+
+// loadBaselineIssues は1スナップショット分のIssueのライフサイクルを、指定ログインについて baselines へ格納します.
+func (r *SnapshotReader) loadBaselineIssues(
+	ctx context.Context,
+	snap *ent.Snapshot,
+	logins []string,
+	baselines map[string]*application.MemberBaseline,
+) error {
+	issues, err := r.client.MemberIssue.
+		Query().
+		Where(
+			memberissue.HasSnapshotWith(snapshot.ID(snap.ID)),
+			memberissue.LoginIn(logins...),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("query baseline issues: %w", err)
+	}
+
+	for _, mi := range issues {
+		baseline := baselines[mi.Login]
+		baseline.IssueLifecycles = append(baseline.IssueLifecycles, toIssueLifecycle(mi))
+	}
+
+	return nil
+}
+
+// loadBaselineHours は1スナップショット分の1時間ごとの活動件数を、指定ログインについて時間の古い順に baselines へ格納します.
+func (r *SnapshotReader) loadBaselineHours(
+	ctx context.Context,
+	snap *ent.Snapshot,
+	logins []string,
+	baselines map[string]*application.MemberBaseline,
+) error {
+	hours, err := r.client.MemberHourStat.
+		Query().
+		Where(
+			memberhourstat.HasSnapshotWith(snapshot.ID(snap.ID)),
+			memberhourstat.LoginIn(logins...),
+		).
+		Order(memberhourstat.ByHourStart()).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("query baseline hour stats: %w", err)
+	}
+
+	for _, mhs := range hours {
+		baseline := baselines[mhs.Login]
+		baseline.HourlyActivity = append(baseline.HourlyActivity, toHourlyActivity(mhs))
+	}
+
+	return nil
+}
+
+// loadBaselineAccounts は1スナップショット分の、指定ログインにまとめていたアカウントを baselines へ格納します.
+// 1アカウントのメンバーは行が無いため、代表ログインだけのままにします.
+func (r *SnapshotReader) loadBaselineAccounts(
+	ctx context.Context,
+	snap *ent.Snapshot,
+	logins []string,
+	baselines map[string]*application.MemberBaseline,
+) error {
+	accounts, err := r.client.MemberAccount.
+		Query().
+		Where(
+			memberaccount.HasSnapshotWith(snapshot.ID(snap.ID)),
+			memberaccount.LoginIn(logins...),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("query baseline member accounts: %w", err)
+	}
+
+	for _, login := range logins {
+		if merged := memberAccounts(login, accounts); len(merged) > 0 {
+			baselines[login].Accounts = merged
+		}
+	}
+
+	return nil
+}
+
+// loadBaselineReviewEdges は1スナップショット分のレビューエッジを、指定ログインをレビュアーとして baselines へ格納します.
+func (r *SnapshotReader) loadBaselineReviewEdges(
+	ctx context.Context,
+	snap *ent.Snapshot,
+	logins []string,
+	baselines map[string]*application.MemberBaseline,
+) error {
+	edges, err := r.client.ReviewEdge.
+		Query().
+		Where(
+			reviewedge.HasSnapshotWith(snapshot.ID(snap.ID)),
+			reviewedge.ReviewerIn(logins...),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("query baseline review edges: %w", err)
+	}
+
+	for _, re := range edges {
+		baseline := baselines[re.Reviewer]
+		baseline.ReviewEdges = append(baseline.ReviewEdges, toReviewEdge(re))
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package snapshotdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Tattsum/github-analytics/infrastructure/ent"
+)
+
+func TestLatestBaselineSnapshots_SkipsLimitedPeriods(t *testing.T) {
+	t.Parallel()
+
+	since := time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)
+	unbounded := &ent.Snapshot{ID: 1, CapturedAt: time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)}
+	limited := &ent.Snapshot{ID: 2, CapturedAt: time.Date(2025, time.May, 1, 0, 0, 0, 0, time.UTC), PeriodSince: &since}
+	untilOnly := &ent.Snapshot{ID: 3, CapturedAt: time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC), PeriodUntil: &since}
+
+	member := func(login string, snap *ent.Snapshot) *ent.MemberStat {
+		return &ent.MemberStat{Login: login, Edges: ent.MemberStatEdges{Snapshot: snap}}
+	}
+
+	got := latestBaselineSnapshots([]*ent.MemberStat{
+		member("alice", unbounded),
+		member("alice", limited),
+		member("alice", untilOnly),
+		member("bob", limited),
+		member("carol", nil),
+	})
+
+	// The newer period-limited snapshots lack the history before -since, so the older unbounded one is the baseline.
+	if snap := got["alice"]; snap == nil || snap.ID != unbounded.ID {
+		t.Errorf("alice baseline = %+v, want the unbounded snapshot", snap)
+	}
+
+	if _, ok := got["bob"]; ok {
+		t.Errorf("bob baseline = %+v, want none: the only snapshot has a period", got["bob"])
+	}
+
+	if len(got) != 1 {
+		t.Errorf("latestBaselineSnapshots = %d logins, want only alice", len(got))
+	}
+}
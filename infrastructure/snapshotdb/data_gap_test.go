@@ -0,0 +1,47 @@
+package snapshotdb
+
+import (
+	"testing"
+
+	"github.com/Tattsum/github-analytics/application"
+	"github.com/Tattsum/github-analytics/domain"
+	"github.com/Tattsum/github-analytics/infrastructure/ent"
+)
+
+func TestBuildDataGaps(t *testing.T) {
+	t.Parallel()
+
+	tattsum := newMember(t, "Tattsum")
+	tattsum.DataGaps = []*domain.DataGap{
+		{ActivityType: domain.ActivityTypeCommit, Repository: "acme/api", Reason: domain.DataGapTransient, Message: "502"},
+		nil, // nil gap must be skipped
+	}
+
+	complete := newMember(t, "hubot")
+
+	got := buildDataGaps(&application.Snapshot{
+		Members: []*domain.UserStatistics{tattsum, nil, complete},
+	})
+
+	want := []memberDataGapInput{
+		{login: "Tattsum", activityType: "commit", nameWithOwner: "acme/api", reason: "transient", message: "502"},
+	}
+
+	if len(got) != len(want) || got[0] != want[0] {
+		t.Errorf("buildDataGaps = %+v, want %+v", got, want)
+	}
+}
+
+func TestMemberDataGaps(t *testing.T) {
+	t.Parallel()
+
+	got := memberDataGaps("Tattsum", []*ent.MemberDataGap{
+		{Login: "hubot", ActivityType: "review", Reason: "permission"},
+		{Login: "Tattsum", ActivityType: "review", NameWithOwner: "acme/api", Reason: "not_found", Message: "gone"},
+	})
+
+	want := domain.DataGap{ActivityType: domain.ActivityTypeReview, Repository: "acme/api", Reason: domain.DataGapNotFound, Message: "gone"}
+	if len(got) != 1 || *got[0] != want {
+		t.Errorf("memberDataGaps = %+v, want only Tattsum's gap %+v", got, want)
+	}
+}
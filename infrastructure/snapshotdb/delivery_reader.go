@@ -0,0 +1,75 @@
+package snapshotdb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Tattsum/github-analytics/application"
+	"github.com/Tattsum/github-analytics/domain"
+	"github.com/Tattsum/github-analytics/infrastructure/ent"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/predicate"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/repodeliveryweek"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/snapshot"
+)
+
+// DeliveryMetrics は最新スナップショットの、指定リポジトリのデリバリー指標を返します.
+// from / to（"2006-01-02" 形式、両端を含む。空文字なら無制限）は、それぞれを含む週までに丸めて絞り込みます.
+// スナップショットが無い場合や、リポジトリのデリバリー指標が無い場合はデプロイ0件の指標を返します（エラーにしません）.
+func (r *SnapshotReader) DeliveryMetrics(ctx context.Context, repository, from, to string) (*application.DeliveryMetrics, error) {
+	weeks, err := r.DeliveryWeeks(ctx, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	return application.SummarizeDelivery(repository, weeks, from, to), nil
+}
+
+// DeliveryWeeks は最新スナップショットのリポジトリ×週のデリバリー指標を、リポジトリ・週の昇順で返します.
+// repository を指定した場合はそのリポジトリだけを返します（空文字なら全リポジトリ）.
+// スナップショットが無い場合は空スライスを返します.
+func (r *SnapshotReader) DeliveryWeeks(ctx context.Context, repository string) ([]*domain.DeliveryWeek, error) {
+	snap, err := r.latest(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if snap == nil {
+		return []*domain.DeliveryWeek{}, nil
+	}
+
+	predicates := []predicate.RepoDeliveryWeek{repodeliveryweek.HasSnapshotWith(snapshot.ID(snap.ID))}
+	if repository != "" {
+		predicates = append(predicates, repodeliveryweek.NameWithOwner(repository))
+	}
+
+	rows, err := r.client.RepoDeliveryWeek.
+		Query().
+		Where(predicates...).
+		Order(repodeliveryweek.ByNameWithOwner(), repodeliveryweek.ByWeek()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query repo delivery weeks: %w", err)
+	}
+
+	weeks := make([]*domain.DeliveryWeek, 0, len(rows))
+	for _, row := range rows {
+		weeks = append(weeks, toDeliveryWeek(row))
+	}
+
+	return weeks, nil
+}
+
+// toDeliveryWeek は ent の RepoDeliveryWeek を domain.DeliveryWeek へマッピングします.
+func toDeliveryWeek(row *ent.RepoDeliveryWeek) *domain.DeliveryWeek {
+	return &domain.DeliveryWeek{
+		Repository:            row.NameWithOwner,
+		Week:                  row.Week,
+		Source:                domain.DeliverySource(row.Source),
+		DeploymentCount:       row.DeploymentCount,
+		FailedDeploymentCount: row.FailedDeploymentCount,
+		LeadTimeSeconds:       row.LeadTimeSeconds,
+		LeadTimeCount:         row.LeadTimeCount,
+		RestoreSeconds:        row.RestoreSeconds,
+		RestoreCount:          row.RestoreCount,
+	}
+}
@@ -0,0 +1,41 @@
+package snapshotdb
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Tattsum/github-analytics/domain"
+	"github.com/Tattsum/github-analytics/infrastructure/ent"
+)
+
+func TestToDeliveryWeek(t *testing.T) {
+	t.Parallel()
+
+	got := toDeliveryWeek(&ent.RepoDeliveryWeek{
+		NameWithOwner:         "acme/api",
+		Week:                  "2024-03-04",
+		Source:                "DEPLOYMENT",
+		DeploymentCount:       3,
+		FailedDeploymentCount: 1,
+		LeadTimeSeconds:       14400,
+		LeadTimeCount:         1,
+		RestoreSeconds:        7200,
+		RestoreCount:          1,
+	})
+
+	want := &domain.DeliveryWeek{
+		Repository:            "acme/api",
+		Week:                  "2024-03-04",
+		Source:                domain.DeliverySourceDeployment,
+		DeploymentCount:       3,
+		FailedDeploymentCount: 1,
+		LeadTimeSeconds:       14400,
+		LeadTimeCount:         1,
+		RestoreSeconds:        7200,
+		RestoreCount:          1,
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("toDeliveryWeek = %+v, want %+v", got, want)
+	}
+}
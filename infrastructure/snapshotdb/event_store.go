@@ -0,0 +1,321 @@
+package snapshotdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Tattsum/github-analytics/application"
+	"github.com/Tattsum/github-analytics/domain"
+	"github.com/Tattsum/github-analytics/infrastructure"
+	"github.com/Tattsum/github-analytics/infrastructure/ent"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/activityevent"
+)
+
+// ErrNilActivityData is returned when AppendActivity is called without a user.
+var ErrNilActivityData = errors.New("activity data and its user must not be nil")
+
+// EventStore persists raw fetched activity as append-only ActivityEvent rows
+// and rebuilds per-member activity lists from them, so statistics can be
+// re-aggregated without touching GitHub.
+//
+// Rows are never updated. Each event is inserted at most once, identified by
+// its natural key; re-fetching an overlapping window only inserts the events
+// that were not stored yet.
+type EventStore struct {
+	client *ent.Client
+}
+
+// EventStore が application.ActivityEventStore を満たすことをコンパイル時に保証します.
+var _ application.ActivityEventStore = (*EventStore)(nil)
+
+// NewEventStore constructs an EventStore backed by the given ent client.
+func NewEventStore(client *ent.Client) *EventStore {
+	return &EventStore{client: client}
+}
+
+// activityEventInput is the plain-data form of one ActivityEvent row, kept
+// separate from the ent builders so the mapping can be unit-tested.
+type activityEventInput struct {
+	naturalKey    string
+	login         string
+	activityType  string
+	sourceID      string
+	nameWithOwner string
+	owner         string
+	ownerType     string
+	occurredAt    time.Time
+	additions     int
+	deletions     int
+	isMerged      bool
+	// prAuthor is the reviewed pull request's author (review events only).
+	prAuthor string
+	// prAuthorType is the GraphQL type of prAuthor (User, Bot, ...).
+	prAuthorType string
+	// issueKind is the label classification of the issue (issue and
+	// issue_close events only).
+	issueKind string
+	// issueOpenedAt is when the closed issue was opened (issue_close events
+	// only).
+	issueOpenedAt *time.Time
+	// workCategory is the work category of the pull request (pull_request
+	// events only).
+	workCategory string
+	// dayOnly marks commit contributions whose occurredAt carries only the
+	// day, which the hour-of-week heatmap skips.
+	dayOnly bool
+}
+
+// AppendActivity stores every activity of one member that is not stored yet,
+// in a single transaction, and returns the number of newly inserted events.
+func (s *EventStore) AppendActivity(ctx context.Context, data *infrastructure.UserActivityData) (int, error) {
+	if data == nil || data.User == nil {
+		return 0, fmt.Errorf("append activity events: %w", ErrNilActivityData)
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("begin event transaction: %w", err)
+	}
+
+	inserted, err := appendEventsTx(ctx, tx, buildActivityEvents(data))
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return 0, errors.Join(err, fmt.Errorf("rollback failed: %w", rbErr))
+		}
+
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("commit event transaction: %w", err)
+	}
+
+	return inserted, nil
+}
+
+// appendEventsTx inserts the events whose natural keys are not present yet.
+// Existing keys are looked up chunk by chunk so the IN list stays under
+// PostgreSQL's bind parameter limit.
+func appendEventsTx(ctx context.Context, tx *ent.Tx, events []activityEventInput) (int, error) {
+	existing := make(map[string]struct{}, len(events))
+
+	for _, chunk := range chunkRows(events) {
+		keys := make([]string, 0, len(chunk))
+		for _, event := range chunk {
+			keys = append(keys, event.naturalKey)
+		}
+
+		stored, err := tx.ActivityEvent.Query().
+			Where(activityevent.NaturalKeyIn(keys...)).
+			Select(activityevent.FieldNaturalKey).
+			Strings(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("query stored activity events: %w", err)
+		}
+
+		for _, key := range stored {
+			existing[key] = struct{}{}
+		}
+	}
+
+	missing := make([]activityEventInput, 0, len(events))
+	for _, event := range events {
+		if _, ok := existing[event.naturalKey]; !ok {
+			missing = append(missing, event)
+		}
+	}
+
+	for _, chunk := range chunkRows(missing) {
+		_, err := tx.ActivityEvent.MapCreateBulk(chunk, func(c *ent.ActivityEventCreate, i int) {
+			e := chunk[i]
+			c.SetNaturalKey(e.naturalKey).
+				SetLogin(e.login).
+				SetActivityType(e.activityType).
+				SetSourceID(e.sourceID).
+				SetNameWithOwner(e.nameWithOwner).
+				SetOwner(e.owner).
+				SetOwnerType(e.ownerType).
+				SetOccurredAt(e.occurredAt).
+				SetAdditions(e.additions).
+				SetDeletions(e.deletions).
+				SetIsMerged(e.isMerged).
+				SetPullRequestAuthor(e.prAuthor).
+				SetPullRequestAuthorType(e.prAuthorType).
+				SetIssueKind(e.issueKind).
+				SetNillableIssueOpenedAt(e.issueOpenedAt).
+				SetWorkCategory(e.workCategory).
+				SetDayOnly(e.dayOnly)
+		}).Save(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("create activity events: %w", err)
+		}
+	}
+
+	return len(missing), nil
+}
+
+// buildActivityEvents maps one member's fetched activity into event row inputs,
+// deduplicated by natural key within the batch. A merged pull request also
+// yields a "pr_merge" event keyed by the pull request's node ID: the pull
+// request row itself is immutable, so a merge observed on a later run is
+// recorded by that extra event rather than by updating the stored row.
+func buildActivityEvents(data *infrastructure.UserActivityData) []activityEventInput {
+	login := data.User.Login
+	seen := make(map[string]struct{})
+	out := make([]activityEventInput, 0)
+
+	add := func(activity *domain.Activity) {
+		key := domain.ActivityNaturalKey(login, activity)
+		if _, ok := seen[key]; ok {
+			return
+		}
+
+		seen[key] = struct{}{}
+
+		var issueOpenedAt *time.Time
+		if !activity.IssueOpenedAt.IsZero() {
+			openedAt := activity.IssueOpenedAt
+			issueOpenedAt = &openedAt
+		}
+
+		out = append(out, activityEventInput{
+			naturalKey:    key,
+			login:         login,
+			activityType:  string(activity.Type),
+			sourceID:      activity.SourceID,
+			nameWithOwner: activity.Repository,
+			owner:         ownerOf(activity.Repository, activity.RepositoryOwner),
+			ownerType:     activity.RepositoryOwnerType,
+			occurredAt:    activity.Date,
+			additions:     activity.Additions,
+			deletions:     activity.Deletions,
+			isMerged:      activity.IsMerged,
+			prAuthor:      activity.PullRequestAuthor,
+			prAuthorType:  activity.PullRequestAuthorType,
+			issueKind:     string(activity.IssueKind),
+			issueOpenedAt: issueOpenedAt,
+			workCategory:  string(activity.WorkCategory),
+			dayOnly:       activity.DayOnly,
+		})
+	}
+
+	for _, group := range [][]*domain.Activity{data.Commits, data.PRs, data.Issues, data.IssueCloses, data.Reviews} {
+		for _, activity := range group {
+			if activity == nil || activity.Repository == "" {
+				continue
+			}
+
+			add(activity)
+
+			if activity.Type == domain.ActivityTypePR && activity.IsMerged && activity.SourceID != "" {
+				merge := *activity
+				merge.Type = domain.ActivityTypePRMerge
+				add(&merge)
+			}
+		}
+	}
+
+	return out
+}
+
+// LoadActivity rebuilds each requested member's activity lists from the stored
+// events. Members without any stored event are omitted.
+func (s *EventStore) LoadActivity(ctx context.Context, logins []string) ([]*infrastructure.UserActivityData, error) {
+	out := make([]*infrastructure.UserActivityData, 0, len(logins))
+
+	for _, login := range logins {
+		events, err := s.client.ActivityEvent.Query().
+			Where(activityevent.Login(login)).
+			Order(activityevent.ByOccurredAt(), activityevent.ByID()).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("query activity events for %s: %w", login, err)
+		}
+
+		if len(events) == 0 {
+			continue
+		}
+
+		out = append(out, buildUserActivityData(login, events))
+	}
+
+	return out, nil
+}
+
+// buildUserActivityData groups stored events back into the per-type activity
+// lists the statistics service consumes. A pull request counts as merged if it
+// was merged when first stored or if a later "pr_merge" event exists for it.
+func buildUserActivityData(login string, events []*ent.ActivityEvent) *infrastructure.UserActivityData {
+	merged := make(map[string]struct{})
+
+	for _, event := range events {
+		if domain.ActivityType(event.ActivityType) == domain.ActivityTypePRMerge {
+			merged[event.SourceID] = struct{}{}
+		}
+	}
+
+	data := &infrastructure.UserActivityData{
+		User:        domain.NewUser(login, "", ""),
+		Commits:     make([]*domain.Activity, 0),
+		PRs:         make([]*domain.Activity, 0),
+		Issues:      make([]*domain.Activity, 0),
+		IssueCloses: make([]*domain.Activity, 0),
+		Reviews:     make([]*domain.Activity, 0),
+	}
+
+	for _, event := range events {
+		activityType := domain.ActivityType(event.ActivityType)
+
+		activity := domain.NewActivity(activityType, event.NameWithOwner, event.OccurredAt, event.Additions, event.Deletions)
+		activity.RepositoryOwner = event.Owner
+		activity.RepositoryOwnerType = event.OwnerType
+		activity.SourceID = event.SourceID
+		activity.PullRequestAuthor = event.PullRequestAuthor
+		activity.PullRequestAuthorType = event.PullRequestAuthorType
+		activity.IssueKind = domain.IssueKind(event.IssueKind)
+		activity.WorkCategory = domain.WorkCategory(event.WorkCategory)
+		activity.DayOnly = event.DayOnly
+
+		if event.IssueOpenedAt != nil {
+			activity.IssueOpenedAt = *event.IssueOpenedAt
+		}
+
+		switch activityType {
+		case domain.ActivityTypeCommit:
+			data.Commits = append(data.Commits, activity)
+		case domain.ActivityTypePR:
+			_, mergedLater := merged[event.SourceID]
+			activity.IsMerged = event.IsMerged || (event.SourceID != "" && mergedLater)
+			data.PRs = append(data.PRs, activity)
+		case domain.ActivityTypeIssue:
+			data.Issues = append(data.Issues, activity)
+		case domain.ActivityTypeIssueClose:
+			data.IssueCloses = append(data.IssueCloses, activity)
+		case domain.ActivityTypeReview:
+			activity.IsReview = true
+			data.Reviews = append(data.Reviews, activity)
+		case domain.ActivityTypePRMerge:
+			// Merge markers only feed the IsMerged flag above.
+		}
+	}
+
+	return data
+}
+
+// Logins returns every login that has stored events, in ascending order.
+func (s *EventStore) Logins(ctx context.Context) ([]string, error) {
+	logins, err := s.client.ActivityEvent.Query().
+		Unique(true).
+		Select(activityevent.FieldLogin).
+		Strings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query activity event logins: %w", err)
+	}
+
+	sort.Strings(logins)
+
+	return logins, nil
+}
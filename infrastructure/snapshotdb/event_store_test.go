@@ -0,0 +1,209 @@
+package snapshotdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Tattsum/github-analytics/domain"
+	"github.com/Tattsum/github-analytics/infrastructure"
+	"github.com/Tattsum/github-analytics/infrastructure/ent"
+)
+
+func TestBuildActivityEvents(t *testing.T) {
+	t.Parallel()
+
+	at := time.Date(2024, 3, 14, 9, 30, 0, 0, time.UTC)
+
+	commit := domain.NewActivity(domain.ActivityTypeCommit, "Tattsum/foo", at, 0, 0)
+	commit.RepositoryOwnerType = "User"
+	commit.DayOnly = true
+
+	openPR := domain.NewActivity(domain.ActivityTypePR, "Tattsum/foo", at, 10, 2)
+	openPR.SourceID = "PR_open"
+
+	mergedPR := domain.NewActivity(domain.ActivityTypePR, "acme/api", at, 120, 30)
+	mergedPR.RepositoryOwner = "acme"
+	mergedPR.RepositoryOwnerType = "Organization"
+	mergedPR.IsMerged = true
+	mergedPR.SourceID = "PR_merged"
+
+	review := domain.NewActivity(domain.ActivityTypeReview, "acme/api", at, 0, 0)
+	review.IsReview = true
+	review.SourceID = "PRR_1"
+	review.PullRequestAuthor = "hubot"
+	review.PullRequestAuthorType = domain.ActorTypeBot
+
+	data := &infrastructure.UserActivityData{
+		User: domain.NewUser("Tattsum", "", ""),
+		// The same commit contribution twice (e.g. overlapping pages) must be stored once.
+		Commits: []*domain.Activity{commit, commit, nil},
+		PRs:     []*domain.Activity{openPR, mergedPR},
+		Reviews: []*domain.Activity{review},
+	}
+
+	got := buildActivityEvents(data)
+
+	want := []activityEventInput{
+		{
+			naturalKey:    "Tattsum|commit|Tattsum/foo|2024-03-14T09:30:00Z",
+			login:         "Tattsum",
+			activityType:  "commit",
+			nameWithOwner: "Tattsum/foo",
+			owner:         "Tattsum",
+			ownerType:     "User",
+			occurredAt:    at,
+			dayOnly:       true,
+		},
+		{
+			naturalKey:    "Tattsum|pull_request|PR_open",
+			login:         "Tattsum",
+			activityType:  "pull_request",
+			sourceID:      "PR_open",
+			nameWithOwner: "Tattsum/foo",
+			owner:         "Tattsum",
+			occurredAt:    at,
+			additions:     10,
+			deletions:     2,
+		},
+		{
+			naturalKey:    "Tattsum|pull_request|PR_merged",
+			login:         "Tattsum",
+			activityType:  "pull_request",
+			sourceID:      "PR_merged",
+			nameWithOwner: "acme/api",
+			owner:         "acme",
+			ownerType:     "Organization",
+			occurredAt:    at,
+			additions:     120,
+			deletions:     30,
+			isMerged:      true,
+		},
+		{
+			naturalKey:    "Tattsum|pr_merge|PR_merged",
+			login:         "Tattsum",
+			activityType:  "pr_merge",
+			sourceID:      "PR_merged",
+			nameWithOwner: "acme/api",
+			owner:         "acme",
+			ownerType:     "Organization",
+			occurredAt:    at,
+			additions:     120,
+			deletions:     30,
+			isMerged:      true,
+		},
+		{
+			naturalKey:    "Tattsum|review|PRR_1",
+			login:         "Tattsum",
+			activityType:  "review",
+			sourceID:      "PRR_1",
+			nameWithOwner: "acme/api",
+			owner:         "acme",
+			occurredAt:    at,
+			prAuthor:      "hubot",
+			prAuthorType:  domain.ActorTypeBot,
+		},
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("buildActivityEvents returned %d events, want %d: %+v", len(got), len(want), got)
+	}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("event[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+
+	closed := domain.NewActivity(domain.ActivityTypeIssueClose, "acme/api", at, 0, 0)
+	closed.SourceID = "I_1"
+	closed.IssueKind = domain.IssueKindBug
+	closed.IssueOpenedAt = at.Add(-time.Hour)
+
+	closes := buildActivityEvents(&infrastructure.UserActivityData{
+		User:        domain.NewUser("Tattsum", "", ""),
+		IssueCloses: []*domain.Activity{closed},
+	})
+	if len(closes) != 1 || closes[0].naturalKey != "Tattsum|issue_close|I_1" || closes[0].issueKind != "BUG" ||
+		closes[0].issueOpenedAt == nil || !closes[0].issueOpenedAt.Equal(closed.IssueOpenedAt) {
+		t.Errorf("issue close events = %+v, want one BUG close keeping when the issue was opened", closes)
+	}
+
+	docs := domain.NewActivity(domain.ActivityTypePR, "acme/api", at, 3, 0)
+	docs.SourceID = "PR_9"
+	docs.WorkCategory = domain.WorkCategoryDocs
+
+	prs := buildActivityEvents(&infrastructure.UserActivityData{
+		User: domain.NewUser("Tattsum", "", ""),
+		PRs:  []*domain.Activity{docs},
+	})
+	if len(prs) != 1 || prs[0].workCategory != "DOCS" {
+		t.Errorf("pull request events = %+v, want one DOCS pull request", prs)
+	}
+}
+
+func TestBuildUserActivityData(t *testing.T) {
+	t.Parallel()
+
+	at := time.Date(2024, 3, 14, 9, 30, 0, 0, time.UTC)
+	later := at.Add(48 * time.Hour)
+
+	events := []*ent.ActivityEvent{
+		{ActivityType: "commit", NameWithOwner: "Tattsum/foo", Owner: "Tattsum", OwnerType: "User", OccurredAt: at, DayOnly: true},
+		// Stored while still open; the merge was observed on a later run.
+		{ActivityType: "pull_request", SourceID: "PR_1", NameWithOwner: "acme/api", OccurredAt: at, Additions: 5, WorkCategory: "BUG"},
+		{ActivityType: "pull_request", SourceID: "PR_2", NameWithOwner: "acme/api", OccurredAt: at},
+		{ActivityType: "issue", SourceID: "I_1", NameWithOwner: "acme/api", OccurredAt: at, IssueKind: "FEATURE"},
+		{ActivityType: "issue_close", SourceID: "I_1", NameWithOwner: "acme/api", OccurredAt: later, IssueKind: "FEATURE", IssueOpenedAt: &at},
+		{ActivityType: "review", SourceID: "PRR_1", NameWithOwner: "acme/api", OccurredAt: later, PullRequestAuthor: "hubot", PullRequestAuthorType: domain.ActorTypeBot},
+		{ActivityType: "pr_merge", SourceID: "PR_1", NameWithOwner: "acme/api", OccurredAt: at, IsMerged: true},
+	}
+
+	data := buildUserActivityData("Tattsum", events)
+
+	if data.User == nil || data.User.Login != "Tattsum" {
+		t.Fatalf("User = %+v, want login Tattsum", data.User)
+	}
+
+	if len(data.Commits) != 1 || len(data.PRs) != 2 || len(data.Issues) != 1 || len(data.Reviews) != 1 {
+		t.Fatalf("got %d commits, %d PRs, %d issues, %d reviews; want 1, 2, 1, 1",
+			len(data.Commits), len(data.PRs), len(data.Issues), len(data.Reviews))
+	}
+
+	if len(data.IssueCloses) != 1 || data.IssueCloses[0].IssueKind != domain.IssueKindFeature ||
+		!data.IssueCloses[0].IssueOpenedAt.Equal(at) || data.Issues[0].IssueKind != domain.IssueKindFeature {
+		t.Errorf("issue closes = %+v, want one FEATURE close opened at %v", data.IssueCloses, at)
+	}
+
+	if got := data.Commits[0]; got.RepositoryOwner != "Tattsum" || got.RepositoryOwnerType != "User" {
+		t.Errorf("commit owner = %q/%q, want Tattsum/User", got.RepositoryOwner, got.RepositoryOwnerType)
+	}
+
+	if !data.Commits[0].DayOnly || data.PRs[0].DayOnly {
+		t.Errorf("day-only flags = %v (commit), %v (PR); want only the commit contribution day-only",
+			data.Commits[0].DayOnly, data.PRs[0].DayOnly)
+	}
+
+	if data.PRs[0].WorkCategory != domain.WorkCategoryBug || data.PRs[1].WorkCategory != "" {
+		t.Errorf("PR work categories = %q, %q; want BUG and unclassified", data.PRs[0].WorkCategory, data.PRs[1].WorkCategory)
+	}
+
+	if !data.PRs[0].IsMerged {
+		t.Errorf("PR_1 should be merged via its pr_merge event")
+	}
+
+	if data.PRs[0].Additions != 5 {
+		t.Errorf("PR_1 additions = %d, want 5", data.PRs[0].Additions)
+	}
+
+	if data.PRs[1].IsMerged {
+		t.Errorf("PR_2 has no merge event and should not be merged")
+	}
+
+	if !data.Reviews[0].IsReview || !data.Reviews[0].Date.Equal(later) {
+		t.Errorf("review = %+v, want IsReview at %v", data.Reviews[0], later)
+	}
+
+	if data.Reviews[0].PullRequestAuthor != "hubot" || data.Reviews[0].PullRequestAuthorType != domain.ActorTypeBot {
+		t.Errorf("review PR author = %q (%q), want hubot (Bot)", data.Reviews[0].PullRequestAuthor, data.Reviews[0].PullRequestAuthorType)
+	}
+}
@@ -0,0 +1,211 @@
+package snapshotdb
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/Tattsum/github-analytics/application"
+	"github.com/Tattsum/github-analytics/domain"
+	"github.com/Tattsum/github-analytics/infrastructure/ent"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/excludedmember"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberdatagap"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberpullrequest"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberrepostat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberstat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/snapshot"
+)
+
+// snapshotCountRow はスナップショットごとの件数集計の1行です.
+type snapshotCountRow struct {
+	SnapshotID int `json:"snapshot_id"`
+	Count      int `json:"count"`
+}
+
+// Snapshots は保存済みスナップショットの一覧を、取得日時の新しい順で返します.
+// スナップショットが無い場合は空スライスを返します（エラーにしません）.
+func (r *SnapshotReader) Snapshots(ctx context.Context) ([]*application.SnapshotInfo, error) {
+	snaps, err := r.client.Snapshot.
+		Query().
+		Order(snapshot.ByCapturedAt(sql.OrderDesc()), snapshot.ByID(sql.OrderDesc())).
+		WithExcludedMembers(withExcludedMembersOrdered).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query snapshots: %w", err)
+	}
+
+	return r.snapshotInfos(ctx, snaps)
+}
+
+// Snapshot は指定IDのスナップショットの概要を返します.
+// 該当するスナップショットが存在しない場合は (nil, nil) を返します.
+func (r *SnapshotReader) Snapshot(ctx context.Context, id int) (*application.SnapshotInfo, error) {
+	snap, err := r.client.Snapshot.
+		Query().
+		Where(snapshot.ID(id)).
+		WithExcludedMembers(withExcludedMembersOrdered).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("query snapshot %d: %w", id, err)
+	}
+
+	infos, err := r.snapshotInfos(ctx, []*ent.Snapshot{snap})
+	if err != nil {
+		return nil, err
+	}
+
+	return infos[0], nil
+}
+
+// MemberHistory は指定ログインのスカラー指標を、そのメンバーを含む各スナップショットについて
+// 取得日時の古い順で返します. サイクルタイム・PRの大きさもスナップショットごとに保存済みの PR 行から計算します.
+// どのスナップショットにも存在しないログインには空スライスを返します（エラーにしません）.
+func (r *SnapshotReader) MemberHistory(ctx context.Context, login string) ([]*application.MemberHistoryPoint, error) {
+	snaps, err := r.client.Snapshot.
+		Query().
+		Where(snapshot.HasMemberStatsWith(memberstat.Login(login))).
+		Order(snapshot.ByCapturedAt(), snapshot.ByID()).
+		WithMemberStats(func(q *ent.MemberStatQuery) {
+			q.Where(memberstat.Login(login))
+		}).
+		WithMemberPullRequests(func(q *ent.MemberPullRequestQuery) {
+			q.Where(memberpullrequest.Login(login))
+		}).
+		WithMemberDataGaps(func(q *ent.MemberDataGapQuery) {
+			q.Where(memberdatagap.Login(login))
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query member history %q: %w", login, err)
+	}
+
+	history := make([]*application.MemberHistoryPoint, 0, len(snaps))
+	for _, snap := range snaps {
+		if len(snap.Edges.MemberStats) == 0 {
+			continue
+		}
+
+		stats := toMemberStats(snap.Edges.MemberStats[0])
+		prs := toMemberPullRequestInputs(snap.Edges.MemberPullRequests)
+		stats.CycleTime = application.AggregateCycleTimeByLogin(prs)[login]
+		stats.PRSize = application.AggregatePRSizeByLogin(prs)[login]
+		stats.DataGaps = memberDataGaps(login, snap.Edges.MemberDataGaps)
+
+		history = append(history, &application.MemberHistoryPoint{
+			SnapshotID: snap.ID,
+			CapturedAt: snap.CapturedAt,
+			Stats:      stats,
+		})
+	}
+
+	return history, nil
+}
+
+// snapshotInfos はスナップショット群に、メンバー数とユニークなリポジトリ数を SQL で数えて付与します.
+func (r *SnapshotReader) snapshotInfos(ctx context.Context, snaps []*ent.Snapshot) ([]*application.SnapshotInfo, error) {
+	infos := make([]*application.SnapshotInfo, 0, len(snaps))
+	if len(snaps) == 0 {
+		return infos, nil
+	}
+
+	ids := make([]int, 0, len(snaps))
+	for _, snap := range snaps {
+		ids = append(ids, snap.ID)
+	}
+
+	var memberCounts []snapshotCountRow
+
+	err := r.client.MemberStat.
+		Query().
+		Where(memberstat.HasSnapshotWith(snapshot.IDIn(ids...))).
+		Modify(countBySnapshotModifier(memberstat.SnapshotColumn, "")).
+		Scan(ctx, &memberCounts)
+	if err != nil {
+		return nil, fmt.Errorf("count snapshot members: %w", err)
+	}
+
+	var repoCounts []snapshotCountRow
+
+	err = r.client.MemberRepoStat.
+		Query().
+		Where(memberrepostat.HasSnapshotWith(snapshot.IDIn(ids...))).
+		Modify(countBySnapshotModifier(memberrepostat.SnapshotColumn, memberrepostat.FieldNameWithOwner)).
+		Scan(ctx, &repoCounts)
+	if err != nil {
+		return nil, fmt.Errorf("count snapshot repositories: %w", err)
+	}
+
+	members := countsBySnapshot(memberCounts)
+	repos := countsBySnapshot(repoCounts)
+
+	for _, snap := range snaps {
+		info := &application.SnapshotInfo{
+			ID:              snap.ID,
+			CapturedAt:      snap.CapturedAt,
+			MemberCount:     members[snap.ID],
+			RepositoryCount: repos[snap.ID],
+			ExcludedMembers: toExcludedActors(snap.Edges.ExcludedMembers),
+		}
+		if snap.Tag != nil {
+			info.Tag = *snap.Tag
+		}
+
+		if snap.PeriodSince != nil {
+			info.Period.Since = *snap.PeriodSince
+		}
+
+		if snap.PeriodUntil != nil {
+			info.Period.Until = *snap.PeriodUntil
+		}
+
+		infos = append(infos, info)
+	}
+
+	return infos, nil
+}
+
+// withExcludedMembersOrdered は除外したアカウントをログイン名の昇順で読み込みます.
+func withExcludedMembersOrdered(q *ent.ExcludedMemberQuery) {
+	q.Order(excludedmember.ByLogin())
+}
+
+// toExcludedActors は ent の ExcludedMember 群を domain.ExcludedActor へマッピングします.
+func toExcludedActors(rows []*ent.ExcludedMember) []*domain.ExcludedActor {
+	out := make([]*domain.ExcludedActor, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, &domain.ExcludedActor{Login: row.Login, Rule: row.Rule})
+	}
+
+	return out
+}
+
+// countBySnapshotModifier はスナップショットの外部キー列でグルーピングして件数を数えるクエリ修飾子を返します.
+// distinctColumn を指定するとその列のユニークな値の数を、空文字なら行数を数えます.
+func countBySnapshotModifier(snapshotColumn, distinctColumn string) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		count := sql.Count("*")
+		if distinctColumn != "" {
+			count = sql.Count(sql.Distinct(s.C(distinctColumn)))
+		}
+
+		s.Select(
+			sql.As(s.C(snapshotColumn), "snapshot_id"),
+			sql.As(count, "count"),
+		).GroupBy(s.C(snapshotColumn))
+	}
+}
+
+// countsBySnapshot は件数集計の行をスナップショットIDをキーとする map へ変換します.
+func countsBySnapshot(rows []snapshotCountRow) map[int]int {
+	counts := make(map[int]int, len(rows))
+	for _, row := range rows {
+		counts[row.SnapshotID] = row.Count
+	}
+
+	return counts
+}
@@ -0,0 +1,82 @@
+package snapshotdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Tattsum/github-analytics/application"
+	"github.com/Tattsum/github-analytics/domain"
+	"github.com/Tattsum/github-analytics/infrastructure/ent"
+)
+
+func TestBuildHourStats(t *testing.T) {
+	t.Parallel()
+
+	tokyo := time.FixedZone("JST", 9*60*60)
+	start := time.Date(2024, 3, 16, 0, 0, 0, 0, tokyo)
+
+	tattsum := newMember(t, "Tattsum")
+	tattsum.TimeZone = "Asia/Tokyo"
+	tattsum.HourlyActivity = []*domain.HourlyActivity{
+		{Start: start, Day: "2024-03-16", Weekday: time.Saturday, Hour: 0, Count: 2},
+		nil, // nil hour must be skipped
+		{Start: start.Add(time.Hour), Day: "2024-03-16", Weekday: time.Saturday, Hour: 1}, // empty hour: no row
+	}
+
+	got := buildHourStats(&application.Snapshot{
+		Members: []*domain.UserStatistics{nil, tattsum, newMember(t, "hubot")},
+	})
+
+	want := []memberHourStatInput{
+		{
+			login:     "Tattsum",
+			hourStart: time.Date(2024, 3, 15, 15, 0, 0, 0, time.UTC),
+			day:       "2024-03-16",
+			weekday:   int(time.Saturday),
+			hour:      0,
+			count:     2,
+		},
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("buildHourStats returned %d rows, want %d: %+v", len(got), len(want), got)
+	}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("hourStat[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+
+	if got[0].hourStart.Location() != time.UTC {
+		t.Errorf("hourStart location = %v, want UTC", got[0].hourStart.Location())
+	}
+}
+
+func TestToHourlyActivity(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 3, 15, 15, 0, 0, 0, time.UTC)
+
+	got := toHourlyActivity(&ent.MemberHourStat{
+		Login:         "Tattsum",
+		HourStart:     start,
+		Day:           "2024-03-16",
+		Weekday:       int(time.Saturday),
+		Hour:          0,
+		ActivityCount: 3,
+	})
+
+	want := &domain.HourlyActivity{Start: start, Day: "2024-03-16", Weekday: time.Saturday, Hour: 0, Count: 3}
+	if *got != *want {
+		t.Errorf("toHourlyActivity = %+v, want %+v", got, want)
+	}
+
+	if zone := memberTimeZone(&ent.MemberStat{}); zone != "UTC" {
+		t.Errorf("memberTimeZone without a stored zone = %q, want UTC", zone)
+	}
+
+	if zone := memberTimeZone(&ent.MemberStat{TimeZone: "Asia/Tokyo"}); zone != "Asia/Tokyo" {
+		t.Errorf("memberTimeZone = %q, want Asia/Tokyo", zone)
+	}
+}
@@ -0,0 +1,85 @@
+package snapshotdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Tattsum/github-analytics/application"
+	"github.com/Tattsum/github-analytics/domain"
+	"github.com/Tattsum/github-analytics/infrastructure/ent"
+)
+
+func TestBuildIssues(t *testing.T) {
+	t.Parallel()
+
+	opened := time.Date(2024, 3, 14, 9, 0, 0, 0, time.UTC)
+	closed := opened.Add(26 * time.Hour)
+
+	issue := &domain.IssueLifecycle{
+		Repository:  "acme/api",
+		SourceID:    "I_1",
+		Author:      "Tattsum",
+		CreatedAt:   opened,
+		ClosedAt:    &closed,
+		ClosedBy:    "hubot",
+		StateReason: domain.IssueStateReasonCompleted,
+		Kind:        domain.IssueKindBug,
+		Labels:      []string{"bug"},
+	}
+
+	tattsum := newMember(t, "Tattsum")
+	tattsum.IssueLifecycles = []*domain.IssueLifecycle{
+		issue,
+		nil, // nil lifecycle must be skipped
+		{Repository: "acme/api", CreatedAt: opened}, // no node ID: cannot be deduplicated
+	}
+
+	// The closer records the same issue too.
+	hubot := newMember(t, "hubot")
+	hubot.IssueLifecycles = []*domain.IssueLifecycle{issue}
+
+	got := buildIssues(&application.Snapshot{
+		Members: []*domain.UserStatistics{tattsum, nil, hubot},
+	})
+
+	want := memberIssueInput{
+		login:         "Tattsum",
+		nameWithOwner: "acme/api",
+		sourceID:      "I_1",
+		author:        "Tattsum",
+		openedAt:      opened,
+		closedAt:      &closed,
+		closedBy:      "hubot",
+		stateReason:   "COMPLETED",
+		kind:          "BUG",
+	}
+
+	if len(got) != 2 || got[0] != want || got[1].login != "hubot" || got[1].sourceID != "I_1" {
+		t.Fatalf("buildIssues = %+v, want one row per member for I_1", got)
+	}
+}
+
+func TestUniqueIssues(t *testing.T) {
+	t.Parallel()
+
+	opened := time.Date(2024, 3, 14, 9, 0, 0, 0, time.UTC)
+	closed := opened.Add(time.Hour)
+
+	got := uniqueIssues([]*ent.MemberIssue{
+		{Login: "Tattsum", NameWithOwner: "acme/api", SourceID: "I_1", OpenedAt: opened, Kind: "FEATURE"},
+		{Login: "hubot", NameWithOwner: "acme/api", SourceID: "I_1", OpenedAt: opened, ClosedAt: &closed, ClosedBy: "hubot", Kind: "FEATURE"},
+		{Login: "hubot", NameWithOwner: "acme/web", SourceID: "I_2", OpenedAt: opened},
+	})
+
+	if len(got) != 2 {
+		t.Fatalf("uniqueIssues returned %d issues, want 2: %+v", len(got), got)
+	}
+
+	if got[0].ClosedAt == nil || got[0].ClosedBy != "hubot" || got[0].Kind != domain.IssueKindFeature {
+		t.Errorf("I_1 = %+v, want the closer's record", got[0])
+	}
+
+	if open := domain.CountOpenIssues(got, closed.Add(time.Minute)); open != 1 {
+		t.Errorf("open issues at %v = %d, want 1", closed.Add(time.Minute), open)
+	}
+}
@@ -0,0 +1,70 @@
+package snapshotdb
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Tattsum/github-analytics/application"
+	"github.com/Tattsum/github-analytics/domain"
+	"github.com/Tattsum/github-analytics/infrastructure/ent"
+)
+
+func TestBuildMemberAccounts(t *testing.T) {
+	t.Parallel()
+
+	alice := newMember(t, "alice")
+	alice.Logins = []string{"alice", "alice-work"}
+
+	single := newMember(t, "bob")
+
+	got := buildMemberAccounts(&application.Snapshot{
+		Members: []*domain.UserStatistics{alice, nil, single},
+	})
+
+	want := []memberAccountInput{
+		{login: "alice", account: "alice"},
+		{login: "alice", account: "alice-work"},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("buildMemberAccounts = %+v, want %+v", got, want)
+	}
+}
+
+func TestMemberDisplayName(t *testing.T) {
+	t.Parallel()
+
+	if got := memberDisplayName(domain.NewUser("alice", "alice", "")); got != "" {
+		t.Errorf("memberDisplayName(login only) = %q, want empty", got)
+	}
+
+	if got := memberDisplayName(domain.NewUser("alice", "Alice Example", "")); got != "Alice Example" {
+		t.Errorf("memberDisplayName = %q, want %q", got, "Alice Example")
+	}
+}
+
+func TestCanonicalLoginAndMemberAccounts(t *testing.T) {
+	t.Parallel()
+
+	accounts := []*ent.MemberAccount{
+		{Login: "alice", Account: "alice"},
+		{Login: "alice", Account: "alice-work"},
+		{Login: "bob", Account: "bob-corp"},
+	}
+
+	if got := canonicalLogin("Alice-Work", accounts); got != "alice" {
+		t.Errorf("canonicalLogin(Alice-Work) = %q, want alice", got)
+	}
+
+	if got := canonicalLogin("carol", accounts); got != "carol" {
+		t.Errorf("canonicalLogin(carol) = %q, want carol", got)
+	}
+
+	if got := memberAccounts("alice", accounts); !reflect.DeepEqual(got, []string{"alice", "alice-work"}) {
+		t.Errorf("memberAccounts(alice) = %v", got)
+	}
+
+	if got := memberAccounts("carol", accounts); got != nil {
+		t.Errorf("memberAccounts(carol) = %v, want nil", got)
+	}
+}
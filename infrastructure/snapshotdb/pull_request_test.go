@@ -0,0 +1,200 @@
+package snapshotdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Tattsum/github-analytics/application"
+	"github.com/Tattsum/github-analytics/domain"
+	"github.com/Tattsum/github-analytics/infrastructure/ent"
+)
+
+func TestBuildPullRequests(t *testing.T) {
+	t.Parallel()
+
+	opened := time.Date(2024, 3, 14, 9, 0, 0, 0, time.UTC)
+	reviewed := opened.Add(3 * time.Hour)
+	merged := opened.Add(26 * time.Hour)
+
+	tattsum := newMember(t, "Tattsum")
+	tattsum.PRLifecycles = []*domain.PullRequestLifecycle{
+		{
+			Repository:    "Tattsum/foo",
+			SourceID:      "PR_1",
+			CreatedAt:     opened,
+			FirstReviewAt: &reviewed,
+			ApprovedAt:    &reviewed,
+			MergedAt:      &merged,
+			ClosedAt:      &merged,
+			ReviewRounds:  2,
+			Additions:     30,
+			Deletions:     5,
+			ChangedFiles:  3,
+		},
+		nil,                                   // nil lifecycle must be skipped
+		{SourceID: "PR_2", CreatedAt: opened}, // no repository: cannot be attributed
+	}
+
+	hubot := newMember(t, "hubot")
+	hubot.PRLifecycles = []*domain.PullRequestLifecycle{
+		{Repository: "acme/api", SourceID: "PR_3", CreatedAt: opened},
+	}
+
+	got := buildPullRequests(&application.Snapshot{
+		Members: []*domain.UserStatistics{tattsum, nil, hubot},
+	})
+
+	want := []memberPullRequestInput{
+		{
+			login:         "Tattsum",
+			nameWithOwner: "Tattsum/foo",
+			sourceID:      "PR_1",
+			openedAt:      opened,
+			firstReviewAt: &reviewed,
+			approvedAt:    &reviewed,
+			mergedAt:      &merged,
+			closedAt:      &merged,
+			reviewRounds:  2,
+			additions:     30,
+			deletions:     5,
+			changedFiles:  3,
+		},
+		{
+			login:         "hubot",
+			nameWithOwner: "acme/api",
+			sourceID:      "PR_3",
+			openedAt:      opened,
+		},
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("buildPullRequests returned %d rows, want %d: %+v", len(got), len(want), got)
+	}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("pullRequest[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestToPullRequestLifecycle(t *testing.T) {
+	t.Parallel()
+
+	opened := time.Date(2024, 3, 14, 9, 0, 0, 0, time.UTC)
+	closed := opened.Add(48 * time.Hour)
+
+	got := toPullRequestLifecycle(&ent.MemberPullRequest{
+		Login:         "Tattsum",
+		NameWithOwner: "Tattsum/foo",
+		SourceID:      "PR_1",
+		OpenedAt:      opened,
+		ClosedAt:      &closed,
+		ReviewRounds:  1,
+		Additions:     8,
+		Deletions:     2,
+		ChangedFiles:  1,
+	})
+
+	if got.Repository != "Tattsum/foo" || got.SourceID != "PR_1" || !got.CreatedAt.Equal(opened) {
+		t.Errorf("toPullRequestLifecycle identity = %+v", got)
+	}
+
+	if got.MergedAt != nil || got.ClosedAt != &closed || got.ReviewRounds != 1 {
+		t.Errorf("toPullRequestLifecycle stages = %+v, want closed without merge after 1 round", got)
+	}
+
+	if d, ok := got.TimeToClose(); !ok || d != 48*time.Hour {
+		t.Errorf("TimeToClose() = %v, %v; want 48h, true", d, ok)
+	}
+
+	if size, ok := got.Size(); !ok || size != domain.PRSizeXS || got.ChangedLines() != 10 {
+		t.Errorf("Size() = %v, %v with %d lines; want XS, true with 10 lines", size, ok, got.ChangedLines())
+	}
+}
+
+func TestBuildReviewRequests(t *testing.T) {
+	t.Parallel()
+
+	opened := time.Date(2024, 3, 14, 9, 0, 0, 0, time.UTC)
+	requested := opened.Add(time.Hour)
+
+	tattsum := newMember(t, "Tattsum")
+	tattsum.PRLifecycles = []*domain.PullRequestLifecycle{
+		{
+			Repository: "Tattsum/foo",
+			SourceID:   "PR_1",
+			CreatedAt:  opened,
+			ReviewRequests: []domain.ReviewRequest{
+				{Reviewer: "alice", RequestedAt: requested, Completed: true},
+				{Reviewer: "bob", RequestedAt: opened, Pending: true},
+			},
+		},
+		{SourceID: "PR_2", ReviewRequests: []domain.ReviewRequest{{Reviewer: "alice"}}}, // no repository
+	}
+
+	got := buildReviewRequests(&application.Snapshot{Members: []*domain.UserStatistics{tattsum, nil}})
+
+	want := []memberReviewRequestInput{
+		{
+			login:         "Tattsum",
+			nameWithOwner: "Tattsum/foo",
+			sourceID:      "PR_1",
+			reviewer:      "alice",
+			requestedAt:   requested,
+			completed:     true,
+		},
+		{
+			login:         "Tattsum",
+			nameWithOwner: "Tattsum/foo",
+			sourceID:      "PR_1",
+			reviewer:      "bob",
+			requestedAt:   opened,
+			pending:       true,
+		},
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("buildReviewRequests returned %d rows, want %d: %+v", len(got), len(want), got)
+	}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("reviewRequest[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestReviewRequestPullRequests(t *testing.T) {
+	t.Parallel()
+
+	requested := time.Date(2024, 3, 14, 10, 0, 0, 0, time.UTC)
+
+	prs := reviewRequestPullRequests(
+		[]*ent.MemberReviewRequest{
+			{Login: "Tattsum", NameWithOwner: "Tattsum/foo", SourceID: "PR_1", Reviewer: "alice-work", RequestedAt: requested, Pending: true},
+			{Login: "Tattsum", NameWithOwner: "Tattsum/foo", SourceID: "PR_1", Reviewer: "bob", RequestedAt: requested, Completed: true},
+			{Login: "bob", NameWithOwner: "acme/api", SourceID: "PR_2", Reviewer: "alice", RequestedAt: requested, Completed: true},
+		},
+		[]*ent.MemberAccount{{Login: "alice", Account: "alice-work"}},
+	)
+
+	if len(prs) != 2 {
+		t.Fatalf("reviewRequestPullRequests returned %d pull requests, want 2: %+v", len(prs), prs)
+	}
+
+	first := prs[0]
+	if first.Login != "Tattsum" || first.Lifecycle.SourceID != "PR_1" || len(first.Lifecycle.ReviewRequests) != 2 {
+		t.Fatalf("first pull request = %+v, want Tattsum's PR_1 with 2 requests", first.Lifecycle)
+	}
+
+	if reviewer := first.Lifecycle.ReviewRequests[0].Reviewer; reviewer != "alice" {
+		t.Errorf("merged account reviewer = %q, want canonical login alice", reviewer)
+	}
+
+	loads := application.BuildReviewLoad(prs)
+	if len(loads) != 2 || loads[0].Reviewer != "alice" || loads[0].Requested != 2 || loads[0].Completed != 1 ||
+		len(loads[0].PendingReviews) != 1 {
+		t.Errorf("review load of alice = %+v, want 2 requested, 1 completed, 1 pending", loads[0])
+	}
+}
@@ -0,0 +1,61 @@
+package snapshotdb
+
+import (
+	"testing"
+
+	"github.com/Tattsum/github-analytics/application"
+	"github.com/Tattsum/github-analytics/domain"
+	"github.com/Tattsum/github-analytics/infrastructure/ent"
+)
+
+func TestBuildReviewEdges(t *testing.T) {
+	t.Parallel()
+
+	tattsum := newMember(t, "Tattsum")
+	tattsum.ReviewEdges = []*domain.ReviewEdge{
+		{Author: "hubot", Repository: "acme/api", Date: "2024-03-14", ReviewCount: 2},
+		nil, // nil edge must be skipped
+		{Repository: "acme/api", Date: "2024-03-14", ReviewCount: 1}, // unknown author: no edge
+	}
+
+	hubot := newMember(t, "hubot")
+	hubot.ReviewEdges = []*domain.ReviewEdge{
+		{Author: "Tattsum", Repository: "Tattsum/foo", Date: "2024-03-15", ReviewCount: 1},
+	}
+
+	got := buildReviewEdges(&application.Snapshot{
+		Members: []*domain.UserStatistics{tattsum, nil, hubot},
+	})
+
+	want := []reviewEdgeInput{
+		{reviewer: "Tattsum", author: "hubot", nameWithOwner: "acme/api", day: "2024-03-14", reviewCount: 2},
+		{reviewer: "hubot", author: "Tattsum", nameWithOwner: "Tattsum/foo", day: "2024-03-15", reviewCount: 1},
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("buildReviewEdges returned %d rows, want %d: %+v", len(got), len(want), got)
+	}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("reviewEdge[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestToReviewEdge(t *testing.T) {
+	t.Parallel()
+
+	got := toReviewEdge(&ent.ReviewEdge{
+		Reviewer:      "Tattsum",
+		Author:        "hubot",
+		NameWithOwner: "acme/api",
+		Day:           "2024-03-14",
+		ReviewCount:   3,
+	})
+
+	want := &domain.ReviewEdge{Author: "hubot", Repository: "acme/api", Date: "2024-03-14", ReviewCount: 3}
+	if *got != *want {
+		t.Errorf("toReviewEdge = %+v, want %+v", got, want)
+	}
+}
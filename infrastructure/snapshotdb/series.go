@@ -0,0 +1,281 @@
+package snapshotdb
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/Tattsum/github-analytics/application"
+	"github.com/Tattsum/github-analytics/domain"
+	"github.com/Tattsum/github-analytics/infrastructure/ent"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberdaystat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberrepodaystat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/predicate"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/snapshot"
+)
+
+// seriesBucketColumn は集約後のバケット（期間の開始日）の列名です.
+const seriesBucketColumn = "bucket"
+
+// seriesMetricColumns は日別バケットで合算する指標列です.
+// MemberDayStat と MemberRepoDayStat は同じ列名を持つため、両方の集約に用います.
+var seriesMetricColumns = []string{
+	memberdaystat.FieldCommitCount,
+	memberdaystat.FieldPrCreated,
+	memberdaystat.FieldPrMerged,
+	memberdaystat.FieldIssueCount,
+	memberdaystat.FieldReviewCount,
+	memberdaystat.FieldAdditions,
+	memberdaystat.FieldDeletions,
+	memberdaystat.FieldIssuesClosed,
+	memberdaystat.FieldIssueCloseSeconds,
+	memberdaystat.FieldBugIssuesOpened,
+	memberdaystat.FieldFeatureIssuesOpened,
+	memberdaystat.FieldBugIssuesClosed,
+	memberdaystat.FieldFeatureIssuesClosed,
+	memberdaystat.FieldFeaturePrs,
+	memberdaystat.FieldBugPrs,
+	memberdaystat.FieldChorePrs,
+	memberdaystat.FieldDocsPrs,
+	memberdaystat.FieldTestPrs,
+	memberdaystat.FieldInfraPrs,
+	memberdaystat.FieldOtherPrs,
+}
+
+// seriesRow は日別バケットを SQL で集約した1行です.
+// login / name_with_owner はグルーピングのキーに含めた場合のみ設定されます.
+type seriesRow struct {
+	Login         string `json:"login"`
+	NameWithOwner string `json:"name_with_owner"`
+	Bucket        string `json:"bucket"`
+	CommitCount   int    `json:"commit_count"`
+	PrCreated     int    `json:"pr_created"`
+	PrMerged      int    `json:"pr_merged"`
+	IssueCount    int    `json:"issue_count"`
+	ReviewCount   int    `json:"review_count"`
+	Additions     int    `json:"additions"`
+	Deletions     int    `json:"deletions"`
+	// IssuesClosed 以降はIssueのクローズ数・クローズまでの秒数の合計と種類別の内訳です.
+	IssuesClosed        int `json:"issues_closed"`
+	IssueCloseSeconds   int `json:"issue_close_seconds"`
+	BugIssuesOpened     int `json:"bug_issues_opened"`
+	FeatureIssuesOpened int `json:"feature_issues_opened"`
+	BugIssuesClosed     int `json:"bug_issues_closed"`
+	FeatureIssuesClosed int `json:"feature_issues_closed"`
+	// FeaturePrs 以降は作成したPRの作業の種類別の件数です.
+	FeaturePrs int `json:"feature_prs"`
+	BugPrs     int `json:"bug_prs"`
+	ChorePrs   int `json:"chore_prs"`
+	DocsPrs    int `json:"docs_prs"`
+	TestPrs    int `json:"test_prs"`
+	InfraPrs   int `json:"infra_prs"`
+	OtherPrs   int `json:"other_prs"`
+}
+
+// selectSnapshot は id が 0 なら最新の、それ以外なら指定IDのスナップショットを、要求された各 stat エッジを eager-load して返します.
+// 最新を求めてスナップショットが1件も無い場合は (nil, nil) を、指定IDが存在しない場合は application.ErrSnapshotNotFound を返します.
+func (r *SnapshotReader) selectSnapshot(
+	ctx context.Context,
+	id int,
+	with func(*ent.SnapshotQuery) *ent.SnapshotQuery,
+) (*ent.Snapshot, error) {
+	if id == 0 {
+		return r.latest(ctx, with)
+	}
+
+	query := r.client.Snapshot.
+		Query().
+		Where(snapshot.ID(id))
+
+	if with != nil {
+		query = with(query)
+	}
+
+	snap, err := query.Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("snapshot %d: %w", id, application.ErrSnapshotNotFound)
+		}
+
+		return nil, fmt.Errorf("query snapshot %d: %w", id, err)
+	}
+
+	return snap, nil
+}
+
+// bucketExpr は日付列（"2006-01-02" 形式の文字列）を、粒度に応じたバケットの開始日へ丸める SQL 式を返します.
+// 週は PostgreSQL の date_trunc と同じく月曜始まりです.
+func bucketExpr(dayColumn string, granularity application.Granularity) string {
+	switch granularity {
+	case application.GranularityWeek:
+		return fmt.Sprintf("to_char(date_trunc('week', CAST(%s AS date)), 'YYYY-MM-DD')", dayColumn)
+	case application.GranularityMonth:
+		return fmt.Sprintf("to_char(date_trunc('month', CAST(%s AS date)), 'YYYY-MM-DD')", dayColumn)
+	case application.GranularityDay:
+		return dayColumn
+	default:
+		return dayColumn
+	}
+}
+
+// seriesModifier は日別の行をキー列（任意）とバケットでグルーピングし、指標列を合算するクエリ修飾子を返します.
+// 結果はキー列・バケットの昇順です.
+func seriesModifier(dayColumn string, granularity application.Granularity, keyColumns ...string) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		bucket := bucketExpr(s.C(dayColumn), granularity)
+
+		columns := make([]string, 0, len(keyColumns)+1+len(seriesMetricColumns))
+		groups := make([]string, 0, len(keyColumns)+1)
+
+		for _, key := range keyColumns {
+			columns = append(columns, sql.As(s.C(key), key))
+			groups = append(groups, s.C(key))
+		}
+
+		columns = append(columns, sql.As(bucket, seriesBucketColumn))
+		groups = append(groups, bucket)
+
+		// SUM(bigint) は numeric を返すため、bigint へ戻してから読み取ります.
+		for _, metric := range seriesMetricColumns {
+			columns = append(columns, sql.As(fmt.Sprintf("CAST(%s AS bigint)", sql.Sum(s.C(metric))), metric))
+		}
+
+		s.Select(columns...).
+			GroupBy(groups...).
+			OrderBy(groups...)
+	}
+}
+
+// memberDaySeries は指定スナップショットのメンバー日別行を期間で絞り込み、粒度のバケットごとに合算して日付昇順で返します.
+// extra で対象メンバーなどの追加条件を指定できます（指定しなければメンバー横断の合計です）.
+func (r *SnapshotReader) memberDaySeries(
+	ctx context.Context,
+	snapshotID int,
+	opts application.SeriesOptions,
+	extra ...predicate.MemberDayStat,
+) ([]*domain.DailyStatistics, error) {
+	predicates := append([]predicate.MemberDayStat{memberdaystat.HasSnapshotWith(snapshot.ID(snapshotID))}, extra...)
+	if opts.From != "" {
+		predicates = append(predicates, memberdaystat.DayGTE(opts.From))
+	}
+
+	if opts.To != "" {
+		predicates = append(predicates, memberdaystat.DayLTE(opts.To))
+	}
+
+	var rows []seriesRow
+
+	err := r.client.MemberDayStat.
+		Query().
+		Where(predicates...).
+		Modify(seriesModifier(memberdaystat.FieldDay, opts.Granularity)).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("query member day series: %w", err)
+	}
+
+	out := make([]*domain.DailyStatistics, 0, len(rows))
+	for i := range rows {
+		out = append(out, rows[i].toDailyStatistics())
+	}
+
+	return out, nil
+}
+
+// repoDaySeries は指定スナップショットのメンバー×リポジトリ×日別行を期間で絞り込み、
+// keyColumns（login / name_with_owner）と粒度のバケットごとに合算して返します.
+// 戻り値の Day はバケットの開始日で、グルーピングに含めなかったキーは空文字です.
+func (r *SnapshotReader) repoDaySeries(
+	ctx context.Context,
+	snapshotID int,
+	opts application.SeriesOptions,
+	keyColumns []string,
+	extra ...predicate.MemberRepoDayStat,
+) ([]*application.MemberRepoDayStat, error) {
+	predicates := append([]predicate.MemberRepoDayStat{memberrepodaystat.HasSnapshotWith(snapshot.ID(snapshotID))}, extra...)
+	if opts.From != "" {
+		predicates = append(predicates, memberrepodaystat.DayGTE(opts.From))
+	}
+
+	if opts.To != "" {
+		predicates = append(predicates, memberrepodaystat.DayLTE(opts.To))
+	}
+
+	var rows []seriesRow
+
+	err := r.client.MemberRepoDayStat.
+		Query().
+		Where(predicates...).
+		Modify(seriesModifier(memberrepodaystat.FieldDay, opts.Granularity, keyColumns...)).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("query member repo day series: %w", err)
+	}
+
+	out := make([]*application.MemberRepoDayStat, 0, len(rows))
+	for i := range rows {
+		out = append(out, rows[i].toMemberRepoDayStat())
+	}
+
+	return out, nil
+}
+
+// toDailyStatistics は集約行を domain.DailyStatistics へマッピングします（Date はバケットの開始日）.
+func (row *seriesRow) toDailyStatistics() *domain.DailyStatistics {
+	daily := domain.NewDailyStatistics(row.Bucket)
+	daily.CommitCount = row.CommitCount
+	daily.PRCreated = row.PrCreated
+	daily.PRMerged = row.PrMerged
+	daily.IssueCount = row.IssueCount
+	daily.ReviewCount = row.ReviewCount
+	daily.TotalAdditions = row.Additions
+	daily.TotalDeletions = row.Deletions
+	daily.IssueThroughput = row.issueThroughput()
+	daily.WorkCategories = row.workCategories()
+
+	return daily
+}
+
+// toMemberRepoDayStat は集約行を application.MemberRepoDayStat へマッピングします（Day はバケットの開始日）.
+func (row *seriesRow) toMemberRepoDayStat() *application.MemberRepoDayStat {
+	return &application.MemberRepoDayStat{
+		Login:           row.Login,
+		NameWithOwner:   row.NameWithOwner,
+		Day:             row.Bucket,
+		CommitCount:     row.CommitCount,
+		PRCreated:       row.PrCreated,
+		PRMerged:        row.PrMerged,
+		IssueCount:      row.IssueCount,
+		ReviewCount:     row.ReviewCount,
+		Additions:       row.Additions,
+		Deletions:       row.Deletions,
+		IssueThroughput: row.issueThroughput(),
+		WorkCategories:  row.workCategories(),
+	}
+}
+
+// issueThroughput は集約行のIssueの指標列を domain.IssueThroughput へまとめます.
+func (row *seriesRow) issueThroughput() domain.IssueThroughput {
+	return domain.IssueThroughput{
+		ClosedCount:   row.IssuesClosed,
+		CloseSeconds:  row.IssueCloseSeconds,
+		BugOpened:     row.BugIssuesOpened,
+		FeatureOpened: row.FeatureIssuesOpened,
+		BugClosed:     row.BugIssuesClosed,
+		FeatureClosed: row.FeatureIssuesClosed,
+	}
+}
+
+// workCategories は集約行のPRの作業の種類別の件数列を domain.WorkCategoryCounts へまとめます.
+func (row *seriesRow) workCategories() domain.WorkCategoryCounts {
+	return domain.WorkCategoryCounts{
+		Feature: row.FeaturePrs,
+		Bug:     row.BugPrs,
+		Chore:   row.ChorePrs,
+		Docs:    row.DocsPrs,
+		Test:    row.TestPrs,
+		Infra:   row.InfraPrs,
+		Other:   row.OtherPrs,
+	}
+}
@@ -0,0 +1,86 @@
+package snapshotdb
+
+import (
+	"testing"
+
+	"github.com/Tattsum/github-analytics/application"
+)
+
+func TestBucketExpr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		granularity application.Granularity
+		want        string
+	}{
+		{
+			name: "zero value is daily",
+			want: `"t1"."day"`,
+		},
+		{
+			name:        "day keeps the column",
+			granularity: application.GranularityDay,
+			want:        `"t1"."day"`,
+		},
+		{
+			name:        "week truncates to Monday",
+			granularity: application.GranularityWeek,
+			want:        `to_char(date_trunc('week', CAST("t1"."day" AS date)), 'YYYY-MM-DD')`,
+		},
+		{
+			name:        "month truncates to the first day",
+			granularity: application.GranularityMonth,
+			want:        `to_char(date_trunc('month', CAST("t1"."day" AS date)), 'YYYY-MM-DD')`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := bucketExpr(`"t1"."day"`, tt.granularity); got != tt.want {
+				t.Errorf("bucketExpr = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSeriesRowMapping(t *testing.T) {
+	t.Parallel()
+
+	row := seriesRow{
+		Login:         "Tattsum",
+		NameWithOwner: "acme/api",
+		Bucket:        "2024-03-11",
+		CommitCount:   5,
+		PrCreated:     2,
+		PrMerged:      1,
+		IssueCount:    3,
+		ReviewCount:   4,
+		Additions:     120,
+		Deletions:     30,
+	}
+
+	daily := row.toDailyStatistics()
+	if daily.Date != "2024-03-11" || daily.CommitCount != 5 || daily.PRCreated != 2 || daily.PRMerged != 1 ||
+		daily.IssueCount != 3 || daily.ReviewCount != 4 || daily.TotalAdditions != 120 || daily.TotalDeletions != 30 {
+		t.Errorf("toDailyStatistics = %+v", daily)
+	}
+
+	want := application.MemberRepoDayStat{
+		Login:         "Tattsum",
+		NameWithOwner: "acme/api",
+		Day:           "2024-03-11",
+		CommitCount:   5,
+		PRCreated:     2,
+		PRMerged:      1,
+		IssueCount:    3,
+		ReviewCount:   4,
+		Additions:     120,
+		Deletions:     30,
+	}
+	if got := row.toMemberRepoDayStat(); *got != want {
+		t.Errorf("toMemberRepoDayStat = %+v, want %+v", *got, want)
+	}
+}
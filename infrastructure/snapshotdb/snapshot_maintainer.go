@@ -0,0 +1,130 @@
+package snapshotdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Tattsum/github-analytics/application"
+	"github.com/Tattsum/github-analytics/infrastructure/ent"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/excludedmember"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberaccount"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberdatagap"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberdaystat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberhourstat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberissue"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberpullrequest"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberrepodaystat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberrepostat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberreviewrequest"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberstat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberyearstat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/repodeliveryweek"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/repometa"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/reviewedge"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/snapshot"
+)
+
+// SnapshotMaintainer tags and deletes stored snapshots. It is the write side
+// of the retention policy enforced by the "snapshot prune" subcommand.
+type SnapshotMaintainer struct {
+	client *ent.Client
+}
+
+// SnapshotMaintainer が application.SnapshotMaintainer を満たすことをコンパイル時に保証します.
+var _ application.SnapshotMaintainer = (*SnapshotMaintainer)(nil)
+
+// NewSnapshotMaintainer constructs a SnapshotMaintainer backed by the given ent client.
+func NewSnapshotMaintainer(client *ent.Client) *SnapshotMaintainer {
+	return &SnapshotMaintainer{client: client}
+}
+
+// TagSnapshot sets the tag of one snapshot; an empty tag clears it.
+func (m *SnapshotMaintainer) TagSnapshot(ctx context.Context, id int, tag string) error {
+	update := m.client.Snapshot.UpdateOneID(id)
+	if tag == "" {
+		update = update.ClearTag()
+	} else {
+		update = update.SetTag(tag)
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("tag snapshot %d: %w", id, application.ErrSnapshotNotFound)
+		}
+
+		return fmt.Errorf("tag snapshot %d: %w", id, err)
+	}
+
+	return nil
+}
+
+// DeleteSnapshots deletes the given snapshots together with every row that
+// belongs to them, in a single transaction. The stat tables reference their
+// snapshot through a plain foreign key, so the owned rows are deleted
+// explicitly before the snapshot rows. Activity events are not owned by a
+// snapshot and are left untouched.
+func (m *SnapshotMaintainer) DeleteSnapshots(ctx context.Context, ids []int) (int, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	tx, err := m.client.Tx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("begin prune transaction: %w", err)
+	}
+
+	deleted, err := deleteSnapshotsTx(ctx, tx, ids)
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return 0, errors.Join(err, fmt.Errorf("rollback failed: %w", rbErr))
+		}
+
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("commit prune transaction: %w", err)
+	}
+
+	return deleted, nil
+}
+
+// deleteSnapshotsTx performs the cascaded deletes inside the given transaction.
+func deleteSnapshotsTx(ctx context.Context, tx *ent.Tx, ids []int) (int, error) {
+	owned := snapshot.IDIn(ids...)
+
+	steps := []struct {
+		name string
+		exec func(context.Context) (int, error)
+	}{
+		{"member stats", tx.MemberStat.Delete().Where(memberstat.HasSnapshotWith(owned)).Exec},
+		{"member year stats", tx.MemberYearStat.Delete().Where(memberyearstat.HasSnapshotWith(owned)).Exec},
+		{"member day stats", tx.MemberDayStat.Delete().Where(memberdaystat.HasSnapshotWith(owned)).Exec},
+		{"member hour stats", tx.MemberHourStat.Delete().Where(memberhourstat.HasSnapshotWith(owned)).Exec},
+		{"member repo stats", tx.MemberRepoStat.Delete().Where(memberrepostat.HasSnapshotWith(owned)).Exec},
+		{"member repo day stats", tx.MemberRepoDayStat.Delete().Where(memberrepodaystat.HasSnapshotWith(owned)).Exec},
+		{"repo metas", tx.RepoMeta.Delete().Where(repometa.HasSnapshotWith(owned)).Exec},
+		{"member pull requests", tx.MemberPullRequest.Delete().Where(memberpullrequest.HasSnapshotWith(owned)).Exec},
+		{"member review requests", tx.MemberReviewRequest.Delete().Where(memberreviewrequest.HasSnapshotWith(owned)).Exec},
+		{"member issues", tx.MemberIssue.Delete().Where(memberissue.HasSnapshotWith(owned)).Exec},
+		{"review edges", tx.ReviewEdge.Delete().Where(reviewedge.HasSnapshotWith(owned)).Exec},
+		{"member data gaps", tx.MemberDataGap.Delete().Where(memberdatagap.HasSnapshotWith(owned)).Exec},
+		{"excluded members", tx.ExcludedMember.Delete().Where(excludedmember.HasSnapshotWith(owned)).Exec},
+		{"member accounts", tx.MemberAccount.Delete().Where(memberaccount.HasSnapshotWith(owned)).Exec},
+		{"repo delivery weeks", tx.RepoDeliveryWeek.Delete().Where(repodeliveryweek.HasSnapshotWith(owned)).Exec},
+	}
+
+	for _, step := range steps {
+		if _, err := step.exec(ctx); err != nil {
+			return 0, fmt.Errorf("delete %s: %w", step.name, err)
+		}
+	}
+
+	deleted, err := tx.Snapshot.Delete().Where(snapshot.IDIn(ids...)).Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("delete snapshots: %w", err)
+	}
+
+	return deleted, nil
+}
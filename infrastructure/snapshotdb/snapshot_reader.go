@@ -10,16 +10,26 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strings"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 
 	"github.com/Tattsum/github-analytics/application"
 	"github.com/Tattsum/github-analytics/domain"
 	"github.com/Tattsum/github-analytics/infrastructure/ent"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberaccount"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberdaystat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberhourstat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberissue"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberrepodaystat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/memberstat"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/predicate"
+	"github.com/Tattsum/github-analytics/infrastructure/ent/reviewedge"
 	"github.com/Tattsum/github-analytics/infrastructure/ent/snapshot"
 )
 
-// SnapshotReader は ent クライアントを用いて最新スナップショットを読み取り、
+// SnapshotReader は ent クライアントを用いて保存済みスナップショット（既定では最新）を読み取り、
 // application.SnapshotReader を満たす実装です.
 type SnapshotReader struct {
 	client *ent.Client
@@ -59,11 +69,14 @@ func (r *SnapshotReader) latest(
 	return snap, nil
 }
 
-// LatestMembers は最新スナップショットのメンバー横断スカラー指標を返します.
+// Members は対象スナップショット（snapshotID が 0 なら最新）のメンバー横断スカラー指標を返します.
 // スナップショットが無い場合は空スライスを返します（エラーにしません）.
-func (r *SnapshotReader) LatestMembers(ctx context.Context) ([]*application.MemberStats, error) {
-	snap, err := r.latest(ctx, func(q *ent.SnapshotQuery) *ent.SnapshotQuery {
-		return q.WithMemberStats()
+func (r *SnapshotReader) Members(ctx context.Context, snapshotID int) ([]*application.MemberStats, error) {
+	snap, err := r.selectSnapshot(ctx, snapshotID, func(q *ent.SnapshotQuery) *ent.SnapshotQuery {
+		return q.
+			WithMemberStats().
+			WithMemberPullRequests().
+			WithMemberDataGaps()
 	})
 	if err != nil {
 		return nil, err
@@ -73,23 +86,41 @@ func (r *SnapshotReader) LatestMembers(ctx context.Context) ([]*application.Memb
 		return []*application.MemberStats{}, nil
 	}
 
+	prs := toMemberPullRequestInputs(snap.Edges.MemberPullRequests)
+	cycleTimes := application.AggregateCycleTimeByLogin(prs)
+	prSizes := application.AggregatePRSizeByLogin(prs)
+
 	members := make([]*application.MemberStats, 0, len(snap.Edges.MemberStats))
 	for _, ms := range snap.Edges.MemberStats {
-		members = append(members, toMemberStats(ms))
+		member := toMemberStats(ms)
+		member.CycleTime = cycleTimes[ms.Login]
+		member.PRSize = prSizes[ms.Login]
+		member.DataGaps = memberDataGaps(ms.Login, snap.Edges.MemberDataGaps)
+		members = append(members, member)
 	}
 
 	return members, nil
 }
 
 // Member は指定ログインのドリルダウン統計（年次推移・全リポジトリ内訳）を返します.
-// 該当メンバーが最新スナップショットに存在しない場合は (nil, nil) を返します.
-func (r *SnapshotReader) Member(ctx context.Context, login string) (*domain.UserStatistics, error) {
-	snap, err := r.latest(ctx, func(q *ent.SnapshotQuery) *ent.SnapshotQuery {
+// 日別推移は opts の期間で絞り込み、粒度のバケットへ SQL で集約します.
+// 複数のアカウントをまとめたメンバーは、代表ログイン以外のアカウントのログインでも引けます.
+// 該当メンバーが対象スナップショットに存在しない場合は (nil, nil) を返します.
+func (r *SnapshotReader) Member(
+	ctx context.Context,
+	login string,
+	opts application.SeriesOptions,
+) (*domain.UserStatistics, error) {
+	snap, err := r.selectSnapshot(ctx, opts.SnapshotID, func(q *ent.SnapshotQuery) *ent.SnapshotQuery {
 		return q.
 			WithMemberStats().
 			WithMemberYearStats().
-			WithMemberDayStats().
-			WithMemberRepoStats()
+			WithMemberRepoStats().
+			WithMemberPullRequests().
+			WithMemberDataGaps().
+			WithMemberAccounts(func(q *ent.MemberAccountQuery) {
+				q.Order(memberaccount.ByAccount())
+			})
 	})
 	if err != nil {
 		return nil, err
@@ -99,6 +130,8 @@ func (r *SnapshotReader) Member(ctx context.Context, login string) (*domain.User
 		return nil, nil
 	}
 
+	login = canonicalLogin(login, snap.Edges.MemberAccounts)
+
 	var member *ent.MemberStat
 	for _, ms := range snap.Edges.MemberStats {
 		if ms.Login == login {
@@ -112,16 +145,51 @@ func (r *SnapshotReader) Member(ctx context.Context, login string) (*domain.User
 		return nil, nil
 	}
 
-	return buildUserStatistics(member, snap.Edges.MemberYearStats, snap.Edges.MemberDayStats, snap.Edges.MemberRepoStats), nil
+	daily, err := r.memberDaySeries(ctx, snap.ID, opts, memberdaystat.Login(login))
+	if err != nil {
+		return nil, err
+	}
+
+	stats := buildUserStatistics(member, snap.Edges.MemberYearStats, daily, snap.Edges.MemberRepoStats)
+	stats.SetPRLifecycles(memberPullRequests(member.Login, snap.Edges.MemberPullRequests))
+	stats.DataGaps = memberDataGaps(member.Login, snap.Edges.MemberDataGaps)
+	stats.Logins = memberAccounts(member.Login, snap.Edges.MemberAccounts)
+
+	return stats, nil
+}
+
+// canonicalLogin は login がまとめたアカウントのいずれかであれば、そのメンバーの代表ログインを返します.
+func canonicalLogin(login string, accounts []*ent.MemberAccount) string {
+	for _, account := range accounts {
+		if strings.EqualFold(account.Account, login) {
+			return account.Login
+		}
+	}
+
+	return login
 }
 
-// TeamDailyStats は最新スナップショットのメンバー日別統計をメンバー横断で同一日に合算し、
-// チーム全体の日別合計を日付昇順の時系列で返します.
+// memberAccounts は login のメンバーにまとめたアカウントを返します（1アカウントのメンバーは nil）.
+func memberAccounts(login string, accounts []*ent.MemberAccount) []string {
+	var out []string
+
+	for _, account := range accounts {
+		if account.Login == login {
+			out = append(out, account.Account)
+		}
+	}
+
+	return out
+}
+
+// TeamDailyStats は対象スナップショットのメンバー日別統計をメンバー横断で同一バケットに合算し、
+// チーム全体の合計を日付昇順の時系列で返します. 期間の絞り込みとバケットへの集約は SQL で行います.
 // スナップショットが無い場合は空スライスを返します（エラーにしません）.
-func (r *SnapshotReader) TeamDailyStats(ctx context.Context) ([]*domain.DailyStatistics, error) {
-	snap, err := r.latest(ctx, func(q *ent.SnapshotQuery) *ent.SnapshotQuery {
-		return q.WithMemberDayStats()
-	})
+func (r *SnapshotReader) TeamDailyStats(
+	ctx context.Context,
+	opts application.SeriesOptions,
+) ([]*domain.DailyStatistics, error) {
+	snap, err := r.selectSnapshot(ctx, opts.SnapshotID, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -130,35 +198,50 @@ func (r *SnapshotReader) TeamDailyStats(ctx context.Context) ([]*domain.DailySta
 		return []*domain.DailyStatistics{}, nil
 	}
 
-	return application.AggregateTeamDaily(toDailyStatistics(snap.Edges.MemberDayStats)), nil
+	return r.memberDaySeries(ctx, snap.ID, opts)
 }
 
-// toDailyStatistics は ent の MemberDayStat 群を domain.DailyStatistics へマッピングします.
-// 各行は1メンバーの1日分で、チーム合算は呼び出し元（AggregateTeamDaily）が行います.
-func toDailyStatistics(stats []*ent.MemberDayStat) []*domain.DailyStatistics {
-	out := make([]*domain.DailyStatistics, 0, len(stats))
-	for _, mds := range stats {
-		daily := domain.NewDailyStatistics(mds.Day)
-		daily.CommitCount = mds.CommitCount
-		daily.PRCreated = mds.PrCreated
-		daily.PRMerged = mds.PrMerged
-		daily.IssueCount = mds.IssueCount
-		daily.ReviewCount = mds.ReviewCount
-		daily.TotalAdditions = mds.Additions
-		daily.TotalDeletions = mds.Deletions
-		out = append(out, daily)
-	}
-
-	return out
+// toDailyStatistic は ent の MemberDayStat 1行を domain.DailyStatistics へマッピングします.
+func toDailyStatistic(mds *ent.MemberDayStat) *domain.DailyStatistics {
+	daily := domain.NewDailyStatistics(mds.Day)
+	daily.CommitCount = mds.CommitCount
+	daily.PRCreated = mds.PrCreated
+	daily.PRMerged = mds.PrMerged
+	daily.IssueCount = mds.IssueCount
+	daily.ReviewCount = mds.ReviewCount
+	daily.TotalAdditions = mds.Additions
+	daily.TotalDeletions = mds.Deletions
+	daily.ExcludedReviewCount = mds.ExcludedReviewCount
+	daily.IssueThroughput = domain.IssueThroughput{
+		ClosedCount:   mds.IssuesClosed,
+		CloseSeconds:  mds.IssueCloseSeconds,
+		BugOpened:     mds.BugIssuesOpened,
+		FeatureOpened: mds.FeatureIssuesOpened,
+		BugClosed:     mds.BugIssuesClosed,
+		FeatureClosed: mds.FeatureIssuesClosed,
+	}
+	daily.WorkCategories = domain.WorkCategoryCounts{
+		Feature: mds.FeaturePrs,
+		Bug:     mds.BugPrs,
+		Chore:   mds.ChorePrs,
+		Docs:    mds.DocsPrs,
+		Test:    mds.TestPrs,
+		Infra:   mds.InfraPrs,
+		Other:   mds.OtherPrs,
+	}
+
+	return daily
 }
 
-// TeamSummary はチーム全体の合計・集計値を返します.
-// RepositoryCount は最新スナップショット内のユニークな nameWithOwner 数です.
-func (r *SnapshotReader) TeamSummary(ctx context.Context) (*application.TeamSummary, error) {
-	snap, err := r.latest(ctx, func(q *ent.SnapshotQuery) *ent.SnapshotQuery {
+// TeamSummary は対象スナップショット（snapshotID が 0 なら最新）のチーム全体の合計・集計値を返します.
+// RepositoryCount は対象スナップショット内のユニークな nameWithOwner 数です.
+// OpenIssueCount は取得時点でオープンだったIssue数です（メンバーが作成またはクローズしたIssueに限ります）.
+func (r *SnapshotReader) TeamSummary(ctx context.Context, snapshotID int) (*application.TeamSummary, error) {
+	snap, err := r.selectSnapshot(ctx, snapshotID, func(q *ent.SnapshotQuery) *ent.SnapshotQuery {
 		return q.
 			WithMemberStats().
-			WithMemberRepoStats()
+			WithMemberRepoStats().
+			WithMemberIssues()
 	})
 	if err != nil {
 		return nil, err
@@ -175,15 +258,19 @@ func (r *SnapshotReader) TeamSummary(ctx context.Context) (*application.TeamSumm
 
 	summary := application.SummarizeTeam(members)
 	summary.RepositoryCount = countRepositories(snap.Edges.MemberRepoStats)
+	summary.OpenIssueCount = domain.CountOpenIssues(uniqueIssues(snap.Edges.MemberIssues), snap.CapturedAt)
 
 	return summary, nil
 }
 
-// Repositories はリポジトリ軸の横断集計を返します.
+// Repositories は対象スナップショット（snapshotID が 0 なら最新）のリポジトリ軸の横断集計を返します.
 // MemberRepoStat を nameWithOwner でグルーピングし、リポジトリごとの合計・貢献者一覧へ再集計します.
-func (r *SnapshotReader) Repositories(ctx context.Context) ([]*application.RepositoryStats, error) {
-	snap, err := r.latest(ctx, func(q *ent.SnapshotQuery) *ent.SnapshotQuery {
-		return q.WithMemberRepoStats()
+func (r *SnapshotReader) Repositories(ctx context.Context, snapshotID int) ([]*application.RepositoryStats, error) {
+	snap, err := r.selectSnapshot(ctx, snapshotID, func(q *ent.SnapshotQuery) *ent.SnapshotQuery {
+		return q.
+			WithMemberRepoStats().
+			WithMemberPullRequests().
+			WithMemberIssues()
 	})
 	if err != nil {
 		return nil, err
@@ -193,19 +280,37 @@ func (r *SnapshotReader) Repositories(ctx context.Context) ([]*application.Repos
 		return []*application.RepositoryStats{}, nil
 	}
 
-	return application.AggregateRepositories(toRepoStatInputs(snap.Edges.MemberRepoStats)), nil
+	repos := application.AggregateRepositories(toRepoStatInputs(snap.Edges.MemberRepoStats))
+	prs := toMemberPullRequestInputs(snap.Edges.MemberPullRequests)
+	cycleTimes := application.AggregateCycleTimeByRepository(prs)
+	prSizes := application.AggregatePRSizeByRepository(prs)
+
+	openIssues := application.CountOpenIssuesByRepository(uniqueIssues(snap.Edges.MemberIssues), snap.CapturedAt)
+
+	for _, repo := range repos {
+		repo.CycleTime = cycleTimes[repo.NameWithOwner]
+		repo.PRSize = prSizes[repo.NameWithOwner]
+		repo.OpenIssueCount = openIssues[repo.NameWithOwner]
+	}
+
+	return repos, nil
 }
 
 // Repository は指定リポジトリの集計を、貢献者ごとの日別時系列付きで返します.
-// 該当リポジトリが最新スナップショットに存在しない場合は (nil, nil) を返します.
+// 貢献者ごとの時系列は opts の期間で絞り込み、粒度のバケットへ SQL で集約します.
+// 該当リポジトリが対象スナップショットに存在しない場合は (nil, nil) を返します.
 func (r *SnapshotReader) Repository(
 	ctx context.Context,
 	nameWithOwner string,
+	opts application.SeriesOptions,
 ) (*application.RepositoryStats, error) {
-	snap, err := r.latest(ctx, func(q *ent.SnapshotQuery) *ent.SnapshotQuery {
+	snap, err := r.selectSnapshot(ctx, opts.SnapshotID, func(q *ent.SnapshotQuery) *ent.SnapshotQuery {
 		return q.
 			WithMemberRepoStats().
-			WithMemberRepoDayStats()
+			WithMemberPullRequests().
+			WithMemberIssues(func(q *ent.MemberIssueQuery) {
+				q.Where(memberissue.NameWithOwner(nameWithOwner))
+			})
 	})
 	if err != nil {
 		return nil, err
@@ -228,25 +333,43 @@ func (r *SnapshotReader) Repository(
 		return nil, nil
 	}
 
-	dailyByLogin := application.AggregateRepositoryContributorDaily(
-		toRepoDayStatInputs(snap.Edges.MemberRepoDayStats),
-		nameWithOwner,
+	repoDays, err := r.repoDaySeries(
+		ctx,
+		snap.ID,
+		opts,
+		[]string{memberrepodaystat.FieldLogin, memberrepodaystat.FieldNameWithOwner},
+		memberrepodaystat.NameWithOwner(nameWithOwner),
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	dailyByLogin := application.AggregateRepositoryContributorDaily(repoDays, nameWithOwner)
 	for _, contributor := range target.Contributors {
 		contributor.DailyStats = dailyByLogin[contributor.Login]
 	}
 
+	prs := toMemberPullRequestInputs(snap.Edges.MemberPullRequests)
+	target.CycleTime = application.AggregateCycleTimeByRepository(prs)[nameWithOwner]
+	target.PRSize = application.AggregatePRSizeByRepository(prs)[nameWithOwner]
+	target.OpenIssueCount = domain.CountOpenIssues(uniqueIssues(snap.Edges.MemberIssues), snap.CapturedAt)
+
 	return target, nil
 }
 
 // RepositoryDailyStats は各リポジトリの日別合計（メンバー横断で合算）を、所有者メタ付きで返します.
 // 複数リポジトリの活動推移を重ね合わせて比較するためのデータ源です.
+// 期間の絞り込みとバケットへの集約は SQL で行い、各バケットの終わりの時点でオープンだったIssue数を
+// 保存済みのIssueのライフサイクルから求めます.
 // スナップショットが無い場合は空スライスを返します（エラーにしません）.
-func (r *SnapshotReader) RepositoryDailyStats(ctx context.Context) ([]*application.RepositoryDailyStats, error) {
-	snap, err := r.latest(ctx, func(q *ent.SnapshotQuery) *ent.SnapshotQuery {
+func (r *SnapshotReader) RepositoryDailyStats(
+	ctx context.Context,
+	opts application.SeriesOptions,
+) ([]*application.RepositoryDailyStats, error) {
+	snap, err := r.selectSnapshot(ctx, opts.SnapshotID, func(q *ent.SnapshotQuery) *ent.SnapshotQuery {
 		return q.
-			WithMemberRepoDayStats().
-			WithRepoMetas()
+			WithRepoMetas().
+			WithMemberIssues()
 	})
 	if err != nil {
 		return nil, err
@@ -256,68 +379,251 @@ func (r *SnapshotReader) RepositoryDailyStats(ctx context.Context) ([]*applicati
 		return []*application.RepositoryDailyStats{}, nil
 	}
 
-	return application.AggregateRepositoryDaily(
-		toRepoDayStatInputs(snap.Edges.MemberRepoDayStats),
-		toRepoMetaInputs(snap.Edges.RepoMetas),
-	), nil
+	repoDays, err := r.repoDaySeries(ctx, snap.ID, opts, []string{memberrepodaystat.FieldNameWithOwner})
+	if err != nil {
+		return nil, err
+	}
+
+	repos := application.AggregateRepositoryDaily(repoDays, toRepoMetaInputs(snap.Edges.RepoMetas))
+	application.SetIssueBacklog(repos, uniqueIssues(snap.Edges.MemberIssues), opts.Granularity)
+
+	return repos, nil
+}
+
+// ReviewNetwork は最新スナップショットのレビュアー→PR作成者の協業グラフを返します.
+// from / to（"2006-01-02" 形式、両端を含む。空文字なら無制限）による日付範囲の絞り込みは SQL で行います.
+// スナップショットが無い場合は空のグラフを返します（エラーにしません）.
+func (r *SnapshotReader) ReviewNetwork(ctx context.Context, from, to string) (*application.ReviewNetwork, error) {
+	snap, err := r.latest(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if snap == nil {
+		return application.BuildReviewNetwork(nil), nil
+	}
+
+	predicates := []predicate.ReviewEdge{reviewedge.HasSnapshotWith(snapshot.ID(snap.ID))}
+	if from != "" {
+		predicates = append(predicates, reviewedge.DayGTE(from))
+	}
+
+	if to != "" {
+		predicates = append(predicates, reviewedge.DayLTE(to))
+	}
+
+	edges, err := r.client.ReviewEdge.
+		Query().
+		Where(predicates...).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query review edges: %w", err)
+	}
+
+	return application.BuildReviewNetwork(toMemberReviewEdgeInputs(edges)), nil
+}
+
+// ReviewLoad は最新スナップショットの、メンバーが作成したPRへのレビュー依頼をレビュアーごとに返します.
+// 複数のアカウントをまとめたメンバーへの依頼は、代表ログインへ合算します.
+// スナップショットが無い場合は空スライスを返します（エラーにしません）.
+func (r *SnapshotReader) ReviewLoad(ctx context.Context) ([]*application.ReviewLoad, error) {
+	snap, err := r.latest(ctx, func(q *ent.SnapshotQuery) *ent.SnapshotQuery {
+		return q.
+			WithMemberReviewRequests().
+			WithMemberAccounts()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if snap == nil {
+		return []*application.ReviewLoad{}, nil
+	}
+
+	return application.BuildReviewLoad(reviewRequestPullRequests(snap.Edges.MemberReviewRequests, snap.Edges.MemberAccounts)), nil
+}
+
+// ActivityHeatmap は最新スナップショットの指定ログインの活動を、メンバーのタイムゾーンの曜日×時に合算して返します.
+// from / to（メンバーの現地時刻の "2006-01-02" 形式、両端を含む。空文字なら無制限）による絞り込みは SQL で行います.
+// 複数のアカウントをまとめたメンバーは、代表ログイン以外のアカウントのログインでも引けます.
+// スナップショットが無い場合や、該当メンバーが存在しない場合は (nil, nil) を返します.
+func (r *SnapshotReader) ActivityHeatmap(ctx context.Context, login, from, to string) (*domain.ActivityHeatmap, error) {
+	snap, err := r.latest(ctx, func(q *ent.SnapshotQuery) *ent.SnapshotQuery {
+		return q.WithMemberAccounts()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if snap == nil {
+		return nil, nil
+	}
+
+	login = canonicalLogin(login, snap.Edges.MemberAccounts)
+
+	member, err := r.client.MemberStat.
+		Query().
+		Where(
+			memberstat.HasSnapshotWith(snapshot.ID(snap.ID)),
+			memberstat.Login(login),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("query member stat for heatmap: %w", err)
+	}
+
+	predicates := []predicate.MemberHourStat{
+		memberhourstat.HasSnapshotWith(snapshot.ID(snap.ID)),
+		memberhourstat.Login(login),
+	}
+	if from != "" {
+		predicates = append(predicates, memberhourstat.DayGTE(from))
+	}
+
+	if to != "" {
+		predicates = append(predicates, memberhourstat.DayLTE(to))
+	}
+
+	rows, err := r.client.MemberHourStat.
+		Query().
+		Where(predicates...).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query member hour stats: %w", err)
+	}
+
+	hours := make([]*domain.HourlyActivity, 0, len(rows))
+	for _, mhs := range rows {
+		hours = append(hours, toHourlyActivity(mhs))
+	}
+
+	return domain.NewActivityHeatmap(login, memberTimeZone(member), hours), nil
+}
+
+// memberTimeZone はメンバーの活動の時間帯を数えたタイムゾーンを返します（保存されていなければ UTC）.
+func memberTimeZone(ms *ent.MemberStat) string {
+	if ms.TimeZone == "" {
+		return time.UTC.String()
+	}
+
+	return ms.TimeZone
+}
+
+// toHourlyActivity は ent の MemberHourStat を domain.HourlyActivity へマッピングします.
+// Start は UTC のまま返します（Day・Weekday・Hour がメンバーの現地時刻の値です）.
+func toHourlyActivity(mhs *ent.MemberHourStat) *domain.HourlyActivity {
+	return &domain.HourlyActivity{
+		Start:   mhs.HourStart,
+		Day:     mhs.Day,
+		Weekday: time.Weekday(mhs.Weekday),
+		Hour:    mhs.Hour,
+		Count:   mhs.ActivityCount,
+	}
 }
 
 // toMemberStats は ent の MemberStat を application.MemberStats へマッピングします.
-// MemberStat スキーマは name を保持しないため、Name は login をそのまま用います.
+// 表示名が保存されていない場合、Name は login をそのまま用います.
 func toMemberStats(ms *ent.MemberStat) *application.MemberStats {
 	return &application.MemberStats{
-		Login:           ms.Login,
-		Name:            ms.Login,
-		TotalCommits:    ms.TotalCommits,
-		TotalPRCreated:  ms.TotalPrCreated,
-		TotalPRMerged:   ms.TotalPrMerged,
-		TotalIssues:     ms.TotalIssues,
-		TotalReviews:    ms.TotalReviews,
-		TotalAdditions:  ms.TotalAdditions,
-		TotalDeletions:  ms.TotalDeletions,
-		PRToReviewRatio: ms.PrToReviewRatio,
+		Login:                ms.Login,
+		Name:                 memberName(ms),
+		TotalCommits:         ms.TotalCommits,
+		TotalPRCreated:       ms.TotalPrCreated,
+		TotalPRMerged:        ms.TotalPrMerged,
+		TotalIssues:          ms.TotalIssues,
+		TotalReviews:         ms.TotalReviews,
+		TotalAdditions:       ms.TotalAdditions,
+		TotalDeletions:       ms.TotalDeletions,
+		PRToReviewRatio:      ms.PrToReviewRatio,
+		TotalExcludedReviews: ms.TotalExcludedReviews,
+		IssueThroughput:      memberIssueThroughput(ms),
+		WorkCategories:       memberWorkCategories(ms),
+	}
+}
+
+// memberIssueThroughput は MemberStat のIssueの指標列を domain.IssueThroughput へまとめます.
+func memberIssueThroughput(ms *ent.MemberStat) domain.IssueThroughput {
+	return domain.IssueThroughput{
+		ClosedCount:   ms.TotalIssuesClosed,
+		CloseSeconds:  ms.TotalIssueCloseSeconds,
+		BugOpened:     ms.TotalBugIssuesOpened,
+		FeatureOpened: ms.TotalFeatureIssuesOpened,
+		BugClosed:     ms.TotalBugIssuesClosed,
+		FeatureClosed: ms.TotalFeatureIssuesClosed,
+	}
+}
+
+// memberWorkCategories は MemberStat のPRの作業の種類別の件数列を domain.WorkCategoryCounts へまとめます.
+func memberWorkCategories(ms *ent.MemberStat) domain.WorkCategoryCounts {
+	return domain.WorkCategoryCounts{
+		Feature: ms.TotalFeaturePrs,
+		Bug:     ms.TotalBugPrs,
+		Chore:   ms.TotalChorePrs,
+		Docs:    ms.TotalDocsPrs,
+		Test:    ms.TotalTestPrs,
+		Infra:   ms.TotalInfraPrs,
+		Other:   ms.TotalOtherPrs,
 	}
 }
 
+// memberName は MemberStat の表示名を返します（保存されていない場合は login）.
+func memberName(ms *ent.MemberStat) string {
+	if ms.Name != "" {
+		return ms.Name
+	}
+
+	return ms.Login
+}
+
 // toRepoStatInputs は ent の MemberRepoStat 群を集計関数の入力構造体へマッピングします.
 func toRepoStatInputs(stats []*ent.MemberRepoStat) []*application.MemberRepoStat {
 	inputs := make([]*application.MemberRepoStat, 0, len(stats))
 	for _, mrs := range stats {
 		inputs = append(inputs, &application.MemberRepoStat{
-			Login:         mrs.Login,
-			NameWithOwner: mrs.NameWithOwner,
-			CommitCount:   mrs.CommitCount,
-			PRCreated:     mrs.PrCreated,
-			PRMerged:      mrs.PrMerged,
-			IssueCount:    mrs.IssueCount,
-			ReviewCount:   mrs.ReviewCount,
-			Additions:     mrs.Additions,
-			Deletions:     mrs.Deletions,
+			Login:           mrs.Login,
+			NameWithOwner:   mrs.NameWithOwner,
+			CommitCount:     mrs.CommitCount,
+			PRCreated:       mrs.PrCreated,
+			PRMerged:        mrs.PrMerged,
+			IssueCount:      mrs.IssueCount,
+			ReviewCount:     mrs.ReviewCount,
+			Additions:       mrs.Additions,
+			Deletions:       mrs.Deletions,
+			IssueThroughput: repoIssueThroughput(mrs),
+			WorkCategories:  repoWorkCategories(mrs),
 		})
 	}
 
 	return inputs
 }
 
-// toRepoDayStatInputs は ent の MemberRepoDayStat 群を集計関数の入力構造体へマッピングします.
-func toRepoDayStatInputs(stats []*ent.MemberRepoDayStat) []*application.MemberRepoDayStat {
-	inputs := make([]*application.MemberRepoDayStat, 0, len(stats))
-	for _, mrds := range stats {
-		inputs = append(inputs, &application.MemberRepoDayStat{
-			Login:         mrds.Login,
-			NameWithOwner: mrds.NameWithOwner,
-			Day:           mrds.Day,
-			CommitCount:   mrds.CommitCount,
-			PRCreated:     mrds.PrCreated,
-			PRMerged:      mrds.PrMerged,
-			IssueCount:    mrds.IssueCount,
-			ReviewCount:   mrds.ReviewCount,
-			Additions:     mrds.Additions,
-			Deletions:     mrds.Deletions,
-		})
+// repoIssueThroughput は MemberRepoStat のIssueの指標列を domain.IssueThroughput へまとめます.
+func repoIssueThroughput(mrs *ent.MemberRepoStat) domain.IssueThroughput {
+	return domain.IssueThroughput{
+		ClosedCount:   mrs.IssuesClosed,
+		CloseSeconds:  mrs.IssueCloseSeconds,
+		BugOpened:     mrs.BugIssuesOpened,
+		FeatureOpened: mrs.FeatureIssuesOpened,
+		BugClosed:     mrs.BugIssuesClosed,
+		FeatureClosed: mrs.FeatureIssuesClosed,
 	}
+}
 
-	return inputs
+// repoWorkCategories は MemberRepoStat のPRの作業の種類別の件数列を domain.WorkCategoryCounts へまとめます.
+func repoWorkCategories(mrs *ent.MemberRepoStat) domain.WorkCategoryCounts {
+	return domain.WorkCategoryCounts{
+		Feature: mrs.FeaturePrs,
+		Bug:     mrs.BugPrs,
+		Chore:   mrs.ChorePrs,
+		Docs:    mrs.DocsPrs,
+		Test:    mrs.TestPrs,
+		Infra:   mrs.InfraPrs,
+		Other:   mrs.OtherPrs,
+	}
 }
 
 // toRepoMetaInputs は ent の RepoMeta 群を集計関数の入力構造体へマッピングします.
@@ -334,6 +640,161 @@ func toRepoMetaInputs(metas []*ent.RepoMeta) []*application.RepoMeta {
 	return inputs
 }
 
+// toPullRequestLifecycle は ent の MemberPullRequest 1行を domain.PullRequestLifecycle へマッピングします.
+func toPullRequestLifecycle(mpr *ent.MemberPullRequest) *domain.PullRequestLifecycle {
+	return &domain.PullRequestLifecycle{
+		Repository:    mpr.NameWithOwner,
+		SourceID:      mpr.SourceID,
+		CreatedAt:     mpr.OpenedAt,
+		FirstReviewAt: mpr.FirstReviewAt,
+		ApprovedAt:    mpr.ApprovedAt,
+		MergedAt:      mpr.MergedAt,
+		ClosedAt:      mpr.ClosedAt,
+		ReviewRounds:  mpr.ReviewRounds,
+		Additions:     mpr.Additions,
+		Deletions:     mpr.Deletions,
+		ChangedFiles:  mpr.ChangedFiles,
+	}
+}
+
+// toReviewRequest は ent の MemberReviewRequest 1行を domain.ReviewRequest へマッピングします.
+func toReviewRequest(mrr *ent.MemberReviewRequest) domain.ReviewRequest {
+	return domain.ReviewRequest{
+		Reviewer:    mrr.Reviewer,
+		RequestedAt: mrr.RequestedAt,
+		Completed:   mrr.Completed,
+		Pending:     mrr.Pending,
+	}
+}
+
+// reviewRequestPullRequests はレビュー依頼の行を、作成者とPRごとにまとめたレビュー負荷集計の入力構造体へマッピングします.
+// レビュアーは accounts でまとめたメンバーの代表ログインへ置き換えます.
+func reviewRequestPullRequests(
+	requests []*ent.MemberReviewRequest,
+	accounts []*ent.MemberAccount,
+) []*application.MemberPullRequest {
+	type key struct{ login, repository, sourceID string }
+
+	byPR := make(map[key]*application.MemberPullRequest)
+	out := make([]*application.MemberPullRequest, 0)
+
+	for _, mrr := range requests {
+		k := key{login: mrr.Login, repository: mrr.NameWithOwner, sourceID: mrr.SourceID}
+
+		pr, exists := byPR[k]
+		if !exists {
+			pr = &application.MemberPullRequest{
+				Login:     mrr.Login,
+				Lifecycle: &domain.PullRequestLifecycle{Repository: mrr.NameWithOwner, SourceID: mrr.SourceID},
+			}
+			byPR[k] = pr
+			out = append(out, pr)
+		}
+
+		request := toReviewRequest(mrr)
+		request.Reviewer = canonicalLogin(mrr.Reviewer, accounts)
+		pr.Lifecycle.ReviewRequests = append(pr.Lifecycle.ReviewRequests, request)
+	}
+
+	return out
+}
+
+// toMemberPullRequestInputs は ent の MemberPullRequest 群をサイクルタイム集計の入力構造体へマッピングします.
+func toMemberPullRequestInputs(prs []*ent.MemberPullRequest) []*application.MemberPullRequest {
+	inputs := make([]*application.MemberPullRequest, 0, len(prs))
+	for _, mpr := range prs {
+		inputs = append(inputs, &application.MemberPullRequest{
+			Login:     mpr.Login,
+			Lifecycle: toPullRequestLifecycle(mpr),
+		})
+	}
+
+	return inputs
+}
+
+// memberPullRequests は指定ログインが作成したPRのライフサイクルだけを返します.
+func memberPullRequests(login string, prs []*ent.MemberPullRequest) []*domain.PullRequestLifecycle {
+	out := make([]*domain.PullRequestLifecycle, 0)
+	for _, mpr := range prs {
+		if mpr.Login == login {
+			out = append(out, toPullRequestLifecycle(mpr))
+		}
+	}
+
+	return out
+}
+
+// toIssueLifecycle は ent の MemberIssue 1行を domain.IssueLifecycle へマッピングします.
+// ラベル・担当者は保存していないため空です.
+func toIssueLifecycle(mi *ent.MemberIssue) *domain.IssueLifecycle {
+	return &domain.IssueLifecycle{
+		Repository:  mi.NameWithOwner,
+		SourceID:    mi.SourceID,
+		Author:      mi.Author,
+		CreatedAt:   mi.OpenedAt,
+		ClosedAt:    mi.ClosedAt,
+		ClosedBy:    mi.ClosedBy,
+		StateReason: domain.IssueStateReason(mi.StateReason),
+		Kind:        domain.IssueKind(mi.Kind),
+	}
+}
+
+// uniqueIssues は ent の MemberIssue 群を、メンバーをまたいだ重複を除いたIssueごとのライフサイクルへマッピングします.
+func uniqueIssues(issues []*ent.MemberIssue) []*domain.IssueLifecycle {
+	inputs := make([]*application.MemberIssue, 0, len(issues))
+	for _, mi := range issues {
+		inputs = append(inputs, &application.MemberIssue{
+			Login:     mi.Login,
+			Lifecycle: toIssueLifecycle(mi),
+		})
+	}
+
+	return application.UniqueIssues(inputs)
+}
+
+// memberDataGaps は指定ログインのデータの欠けだけを返します.
+func memberDataGaps(login string, gaps []*ent.MemberDataGap) []*domain.DataGap {
+	out := make([]*domain.DataGap, 0)
+	for _, gap := range gaps {
+		if gap.Login == login {
+			out = append(out, &domain.DataGap{
+				ActivityType: domain.ActivityType(gap.ActivityType),
+				Repository:   gap.NameWithOwner,
+				Reason:       domain.DataGapReason(gap.Reason),
+				Message:      gap.Message,
+			})
+		}
+	}
+
+	return out
+}
+
+// toReviewEdge は ent の ReviewEdge をレビュアー側から見た domain.ReviewEdge へマッピングします.
+func toReviewEdge(re *ent.ReviewEdge) *domain.ReviewEdge {
+	return &domain.ReviewEdge{
+		Author:      re.Author,
+		Repository:  re.NameWithOwner,
+		Date:        re.Day,
+		ReviewCount: re.ReviewCount,
+	}
+}
+
+// toMemberReviewEdgeInputs は ent の ReviewEdge 行を協業グラフ集計の入力へマッピングします.
+func toMemberReviewEdgeInputs(edges []*ent.ReviewEdge) []*application.MemberReviewEdge {
+	out := make([]*application.MemberReviewEdge, 0, len(edges))
+	for _, re := range edges {
+		out = append(out, &application.MemberReviewEdge{
+			Reviewer:      re.Reviewer,
+			Author:        re.Author,
+			NameWithOwner: re.NameWithOwner,
+			Day:           re.Day,
+			ReviewCount:   re.ReviewCount,
+		})
+	}
+
+	return out
+}
+
 // countRepositories は MemberRepoStat 群に含まれるユニークな nameWithOwner 数を返します.
 func countRepositories(stats []*ent.MemberRepoStat) int {
 	seen := make(map[string]struct{}, len(stats))
@@ -346,13 +807,14 @@ func countRepositories(stats []*ent.MemberRepoStat) int {
 
 // buildUserStatistics は ent の各 stat 行から、指定メンバーの UserStatistics を組み立てます.
 // 年次推移は MemberYearStat、全リポジトリ内訳は MemberRepoStat（当該 login 分のみ）から構築します.
+// 日別推移（dayStats）は呼び出し元が当該メンバー分を SQL で絞り込み・集約済みのものを受け取ります.
 func buildUserStatistics(
 	member *ent.MemberStat,
 	yearStats []*ent.MemberYearStat,
-	dayStats []*ent.MemberDayStat,
+	dayStats []*domain.DailyStatistics,
 	repoStats []*ent.MemberRepoStat,
 ) *domain.UserStatistics {
-	stats := domain.NewUserStatistics(domain.NewUser(member.Login, member.Login, ""))
+	stats := domain.NewUserStatistics(domain.NewUser(member.Login, memberName(member), ""))
 	stats.TotalCommits = member.TotalCommits
 	stats.TotalPRCreated = member.TotalPrCreated
 	stats.TotalPRMerged = member.TotalPrMerged
@@ -364,6 +826,10 @@ func buildUserStatistics(
 	stats.PeakActivityYear = member.PeakActivityYear
 	stats.PeakActivityCommits = member.PeakActivityCommits
 	stats.PRToReviewRatio = member.PrToReviewRatio
+	stats.TotalExcludedReviews = member.TotalExcludedReviews
+	stats.IssueThroughput = memberIssueThroughput(member)
+	stats.WorkCategories = memberWorkCategories(member)
+	stats.TimeZone = member.TimeZone
 
 	for _, ys := range yearStats {
 		if ys.Login != member.Login {
@@ -381,20 +847,8 @@ func buildUserStatistics(
 		stats.YearlyStats[ys.Year] = yearly
 	}
 
-	for _, ds := range dayStats {
-		if ds.Login != member.Login {
-			continue
-		}
-
-		daily := domain.NewDailyStatistics(ds.Day)
-		daily.CommitCount = ds.CommitCount
-		daily.PRCreated = ds.PrCreated
-		daily.PRMerged = ds.PrMerged
-		daily.IssueCount = ds.IssueCount
-		daily.ReviewCount = ds.ReviewCount
-		daily.TotalAdditions = ds.Additions
-		daily.TotalDeletions = ds.Deletions
-		stats.DailyStats[ds.Day] = daily
+	for _, daily := range dayStats {
+		stats.DailyStats[daily.Date] = daily
 	}
 
 	stats.AllRepositories = buildMemberRepositories(member.Login, repoStats)
@@ -437,6 +891,8 @@ func buildMemberRepositories(login string, repoStats []*ent.MemberRepoStat) []*d
 		repo.ReviewCount = mrs.ReviewCount
 		repo.TotalAdditions = mrs.Additions
 		repo.TotalDeletions = mrs.Deletions
+		repo.IssueThroughput = repoIssueThroughput(mrs)
+		repo.WorkCategories = repoWorkCategories(mrs)
 		repos = append(repos, repo)
 	}
 
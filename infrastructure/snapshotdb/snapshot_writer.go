@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/Tattsum/github-analytics/application"
 	"github.com/Tattsum/github-analytics/domain"
@@ -67,9 +68,18 @@ func (w *SnapshotWriter) Save(ctx context.Context, snapshot *application.Snapsho
 
 // saveTx performs the actual writes inside the given transaction.
 func (w *SnapshotWriter) saveTx(ctx context.Context, tx *ent.Tx, snapshot *application.Snapshot) error {
-	snapRow, err := tx.Snapshot.Create().
+	create := tx.Snapshot.Create().
 		SetCapturedAt(snapshot.CapturedAt).
-		Save(ctx)
+		SetLookbackYears(snapshot.LookbackYears)
+	if !snapshot.Period.Since.IsZero() {
+		create.SetPeriodSince(snapshot.Period.Since)
+	}
+
+	if !snapshot.Period.Until.IsZero() {
+		create.SetPeriodUntil(snapshot.Period.Until)
+	}
+
+	snapRow, err := create.Save(ctx)
 	if err != nil {
 		return fmt.Errorf("create snapshot row: %w", err)
 	}
@@ -87,12 +97,49 @@ func (w *SnapshotWriter) saveTx(ctx context.Context, tx *ent.Tx, snapshot *appli
 		return err
 	}
 
+	if err := applyPullRequestCreates(ctx, tx, snapRow.ID, buildPullRequests(snapshot)); err != nil {
+		return err
+	}
+
+	if err := applyReviewRequestCreates(ctx, tx, snapRow.ID, buildReviewRequests(snapshot)); err != nil {
+		return err
+	}
+
+	if err := applyHourStatCreates(ctx, tx, snapRow.ID, buildHourStats(snapshot)); err != nil {
+		return err
+	}
+
+	if err := applyIssueCreates(ctx, tx, snapRow.ID, buildIssues(snapshot)); err != nil {
+		return err
+	}
+
+	if err := applyReviewEdgeCreates(ctx, tx, snapRow.ID, buildReviewEdges(snapshot)); err != nil {
+		return err
+	}
+
+	if err := applyDataGapCreates(ctx, tx, snapRow.ID, buildDataGaps(snapshot)); err != nil {
+		return err
+	}
+
+	if err := applyExcludedMemberCreates(ctx, tx, snapRow.ID, snapshot.ExcludedMembers); err != nil {
+		return err
+	}
+
+	if err := applyMemberAccountCreates(ctx, tx, snapRow.ID, buildMemberAccounts(snapshot)); err != nil {
+		return err
+	}
+
+	if err := applyDeliveryWeekCreates(ctx, tx, snapRow.ID, snapshot.DeliveryWeeks); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 // memberStatInput captures the scalar fields of one MemberStat row.
 type memberStatInput struct {
 	login               string
+	name                string
 	totalCommits        int
 	totalPRCreated      int
 	totalPRMerged       int
@@ -103,7 +150,16 @@ type memberStatInput struct {
 	firstActivityYear   int
 	peakActivityYear    int
 	peakActivityCommits int
-	prToReviewRatio     float64
+	// totalExcludedReviews counts reviews of pull requests opened by excluded
+	// bots and service accounts.
+	totalExcludedReviews int
+	// issues holds the issue close counts, time to close and bug/feature split.
+	issues domain.IssueThroughput
+	// categories counts the created pull requests by work category.
+	categories      domain.WorkCategoryCounts
+	prToReviewRatio float64
+	// timeZone is the time zone the member's hour rows were bucketed in.
+	timeZone string
 }
 
 // memberYearStatInput captures the fields of one MemberYearStat row.
@@ -121,15 +177,18 @@ type memberYearStatInput struct {
 
 // memberDayStatInput captures the fields of one MemberDayStat row.
 type memberDayStatInput struct {
-	login       string
-	day         string
-	commitCount int
-	prCreated   int
-	prMerged    int
-	issueCount  int
-	reviewCount int
-	additions   int
-	deletions   int
+	login               string
+	day                 string
+	commitCount         int
+	prCreated           int
+	prMerged            int
+	issueCount          int
+	reviewCount         int
+	additions           int
+	deletions           int
+	excludedReviewCount int
+	issues              domain.IssueThroughput
+	categories          domain.WorkCategoryCounts
 }
 
 // memberRepoStatInput captures the fields of one MemberRepoStat row.
@@ -143,6 +202,8 @@ type memberRepoStatInput struct {
 	reviewCount   int
 	additions     int
 	deletions     int
+	issues        domain.IssueThroughput
+	categories    domain.WorkCategoryCounts
 }
 
 // memberRepoDayStatInput captures the fields of one MemberRepoDayStat row.
@@ -157,6 +218,8 @@ type memberRepoDayStatInput struct {
 	reviewCount   int
 	additions     int
 	deletions     int
+	issues        domain.IssueThroughput
+	categories    domain.WorkCategoryCounts
 }
 
 // repoMetaInput captures the fields of one RepoMeta row.
@@ -166,6 +229,80 @@ type repoMetaInput struct {
 	ownerType     string
 }
 
+// memberPullRequestInput captures the fields of one MemberPullRequest row.
+type memberPullRequestInput struct {
+	login         string
+	nameWithOwner string
+	sourceID      string
+	openedAt      time.Time
+	firstReviewAt *time.Time
+	approvedAt    *time.Time
+	mergedAt      *time.Time
+	closedAt      *time.Time
+	reviewRounds  int
+	additions     int
+	deletions     int
+	changedFiles  int
+}
+
+// memberReviewRequestInput captures the fields of one MemberReviewRequest row.
+type memberReviewRequestInput struct {
+	login         string
+	nameWithOwner string
+	sourceID      string
+	reviewer      string
+	requestedAt   time.Time
+	completed     bool
+	pending       bool
+}
+
+// memberHourStatInput captures the fields of one MemberHourStat row.
+type memberHourStatInput struct {
+	login     string
+	hourStart time.Time
+	day       string
+	weekday   int
+	hour      int
+	count     int
+}
+
+// memberIssueInput captures the fields of one MemberIssue row.
+type memberIssueInput struct {
+	login         string
+	nameWithOwner string
+	sourceID      string
+	author        string
+	openedAt      time.Time
+	closedAt      *time.Time
+	closedBy      string
+	stateReason   string
+	kind          string
+}
+
+// reviewEdgeInput captures the fields of one ReviewEdge row.
+type reviewEdgeInput struct {
+	reviewer      string
+	author        string
+	nameWithOwner string
+	day           string
+	reviewCount   int
+}
+
+// memberAccountInput captures the fields of one MemberAccount row.
+type memberAccountInput struct {
+	login   string
+	account string
+}
+
+// memberDataGapInput captures the fields of one MemberDataGap row.
+type memberDataGapInput struct {
+	login         string
+	activityType  string
+	nameWithOwner string
+	reason        string
+	message       string
+}
+
 // buildStatCreates maps the aggregated per-member statistics into the flat
 // row inputs persisted for a snapshot. It is pure (no I/O) so it can be unit
 // tested without a database.
@@ -183,18 +320,23 @@ func buildStatCreates(snapshot *application.Snapshot) ([]memberStatInput, []memb
 		login := member.User.Login
 
 		memberStats = append(memberStats, memberStatInput{
-			login:               login,
-			totalCommits:        member.TotalCommits,
-			totalPRCreated:      member.TotalPRCreated,
-			totalPRMerged:       member.TotalPRMerged,
-			totalIssues:         member.TotalIssues,
-			totalReviews:        member.TotalReviews,
-			totalAdditions:      member.TotalAdditions,
-			totalDeletions:      member.TotalDeletions,
-			firstActivityYear:   member.FirstActivityYear,
-			peakActivityYear:    member.PeakActivityYear,
-			peakActivityCommits: member.PeakActivityCommits,
-			prToReviewRatio:     member.PRToReviewRatio,
+			login:                login,
+			name:                 memberDisplayName(member.User),
+			totalCommits:         member.TotalCommits,
+			totalPRCreated:       member.TotalPRCreated,
+			totalPRMerged:        member.TotalPRMerged,
+			totalIssues:          member.TotalIssues,
+			totalReviews:         member.TotalReviews,
+			totalAdditions:       member.TotalAdditions,
+			totalDeletions:       member.TotalDeletions,
+			firstActivityYear:    member.FirstActivityYear,
+			peakActivityYear:     member.PeakActivityYear,
+			peakActivityCommits:  member.PeakActivityCommits,
+			totalExcludedReviews: member.TotalExcludedReviews,
+			issues:               member.IssueThroughput,
+			categories:           member.WorkCategories,
+			prToReviewRatio:      member.PRToReviewRatio,
+			timeZone:             member.TimeZone,
 		})
 
 		yearStats = append(yearStats, buildYearStats(login, member.YearlyStats)...)
@@ -240,15 +382,18 @@ func buildDayStats(login string, daily map[string]*domain.DailyStatistics) []mem
 		}
 
 		out = append(out, memberDayStatInput{
-			login:       login,
-			day:         day,
-			commitCount: stat.CommitCount,
-			prCreated:   stat.PRCreated,
-			prMerged:    stat.PRMerged,
-			issueCount:  stat.IssueCount,
-			reviewCount: stat.ReviewCount,
-			additions:   stat.TotalAdditions,
-			deletions:   stat.TotalDeletions,
+			login:               login,
+			day:                 day,
+			commitCount:         stat.CommitCount,
+			prCreated:           stat.PRCreated,
+			prMerged:            stat.PRMerged,
+			issueCount:          stat.IssueCount,
+			reviewCount:         stat.ReviewCount,
+			additions:           stat.TotalAdditions,
+			deletions:           stat.TotalDeletions,
+			excludedReviewCount: stat.ExcludedReviewCount,
+			issues:              stat.IssueThroughput,
+			categories:          stat.WorkCategories,
 		})
 	}
 
@@ -276,6 +421,8 @@ func buildRepoStats(login string, repos []*domain.RepositoryActivity) []memberRe
 			reviewCount: repo.ReviewCount,
 			additions:   repo.TotalAdditions,
 			deletions:   repo.TotalDeletions,
+			issues:      repo.IssueThroughput,
+			categories:  repo.WorkCategories,
 		})
 	}
 
@@ -310,6 +457,8 @@ func buildRepoDayStats(snapshot *application.Snapshot) []memberRepoDayStatInput
 				reviewCount:   stat.ReviewCount,
 				additions:     stat.TotalAdditions,
 				deletions:     stat.TotalDeletions,
+				issues:        stat.IssueThroughput,
+				categories:    stat.WorkCategories,
 			})
 		}
 	}
@@ -362,6 +511,223 @@ func buildRepoMetas(snapshot *application.Snapshot) []repoMetaInput {
 	return out
 }
 
+// buildPullRequests maps every member's pull request lifecycles into row
+// inputs. Pull requests without a repository cannot be attributed and are
+// skipped.
+func buildPullRequests(snapshot *application.Snapshot) []memberPullRequestInput {
+	out := make([]memberPullRequestInput, 0)
+
+	for _, member := range snapshot.Members {
+		if member == nil || member.User == nil {
+			continue
+		}
+
+		for _, pr := range member.PRLifecycles {
+			if pr == nil || pr.Repository == "" {
+				continue
+			}
+
+			out = append(out, memberPullRequestInput{
+				login:         member.User.Login,
+				nameWithOwner: pr.Repository,
+				sourceID:      pr.SourceID,
+				openedAt:      pr.CreatedAt,
+				firstReviewAt: pr.FirstReviewAt,
+				approvedAt:    pr.ApprovedAt,
+				mergedAt:      pr.MergedAt,
+				closedAt:      pr.ClosedAt,
+				reviewRounds:  pr.ReviewRounds,
+				additions:     pr.Additions,
+				deletions:     pr.Deletions,
+				changedFiles:  pr.ChangedFiles,
+			})
+		}
+	}
+
+	return out
+}
+
+// buildReviewRequests maps the review requests of every member's pull requests
+// into row inputs, keyed by the pull request's author and node ID. Pull
+// requests without a repository are skipped like in buildPullRequests.
+func buildReviewRequests(snapshot *application.Snapshot) []memberReviewRequestInput {
+	out := make([]memberReviewRequestInput, 0)
+
+	for _, member := range snapshot.Members {
+		if member == nil || member.User == nil {
+			continue
+		}
+
+		for _, pr := range member.PRLifecycles {
+			if pr == nil || pr.Repository == "" {
+				continue
+			}
+
+			for _, request := range pr.ReviewRequests {
+				out = append(out, memberReviewRequestInput{
+					login:         member.User.Login,
+					nameWithOwner: pr.Repository,
+					sourceID:      pr.SourceID,
+					reviewer:      request.Reviewer,
+					requestedAt:   request.RequestedAt,
+					completed:     request.Completed,
+					pending:       request.Pending,
+				})
+			}
+		}
+	}
+
+	return out
+}
+
+// buildHourStats maps every member's local-hour activity counts into row
+// inputs. The hour start is stored in UTC; day, weekday and hour keep the
+// member's local calendar values.
+func buildHourStats(snapshot *application.Snapshot) []memberHourStatInput {
+	out := make([]memberHourStatInput, 0)
+
+	for _, member := range snapshot.Members {
+		if member == nil || member.User == nil {
+			continue
+		}
+
+		for _, hour := range member.HourlyActivity {
+			if hour == nil || hour.Count == 0 {
+				continue
+			}
+
+			out = append(out, memberHourStatInput{
+				login:     member.User.Login,
+				hourStart: hour.Start.UTC(),
+				day:       hour.Day,
+				weekday:   int(hour.Weekday),
+				hour:      hour.Hour,
+				count:     hour.Count,
+			})
+		}
+	}
+
+	return out
+}
+
+// buildIssues maps every member's issue lifecycles into row inputs. An issue
+// opened by one member and closed by another yields a row for each of them.
+// Issues without a repository or node ID cannot be attributed and are skipped.
+func buildIssues(snapshot *application.Snapshot) []memberIssueInput {
+	out := make([]memberIssueInput, 0)
+
+	for _, member := range snapshot.Members {
+		if member == nil || member.User == nil {
+			continue
+		}
+
+		for _, issue := range member.IssueLifecycles {
+			if issue == nil || issue.Repository == "" || issue.SourceID == "" {
+				continue
+			}
+
+			out = append(out, memberIssueInput{
+				login:         member.User.Login,
+				nameWithOwner: issue.Repository,
+				sourceID:      issue.SourceID,
+				author:        issue.Author,
+				openedAt:      issue.CreatedAt,
+				closedAt:      issue.ClosedAt,
+				closedBy:      issue.ClosedBy,
+				stateReason:   string(issue.StateReason),
+				kind:          string(issue.Kind),
+			})
+		}
+	}
+
+	return out
+}
+
+// buildReviewEdges maps every member's reviewer -> author review counts into
+// row inputs, with the member as the reviewer. Edges without an author,
+// repository or day cannot be attributed and are skipped.
+func buildReviewEdges(snapshot *application.Snapshot) []reviewEdgeInput {
+	out := make([]reviewEdgeInput, 0)
+
+	for _, member := range snapshot.Members {
+		if member == nil || member.User == nil {
+			continue
+		}
+
+		for _, edge := range member.ReviewEdges {
+			if edge == nil || edge.Author == "" || edge.Repository == "" || edge.Date == "" {
+				continue
+			}
+
+			out = append(out, reviewEdgeInput{
+				reviewer:      member.User.Login,
+				author:        edge.Author,
+				nameWithOwner: edge.Repository,
+				day:           edge.Date,
+				reviewCount:   edge.ReviewCount,
+			})
+		}
+	}
+
+	return out
+}
+
+// buildDataGaps maps every member's data gaps (the parts that could not be
+// fetched) into row inputs.
+func buildDataGaps(snapshot *application.Snapshot) []memberDataGapInput {
+	out := make([]memberDataGapInput, 0)
+
+	for _, member := range snapshot.Members {
+		if member == nil || member.User == nil {
+			continue
+		}
+
+		for _, gap := range member.DataGaps {
+			if gap == nil {
+				continue
+			}
+
+			out = append(out, memberDataGapInput{
+				login:         member.User.Login,
+				activityType:  string(gap.ActivityType),
+				nameWithOwner: gap.Repository,
+				reason:        string(gap.Reason),
+				message:       gap.Message,
+			})
+		}
+	}
+
+	return out
+}
+
+// memberDisplayName returns the name to persist for a member: empty when it
+// is just the login, so that readers fall back to the login either way.
+func memberDisplayName(user *domain.User) string {
+	if user.Name == user.Login {
+		return ""
+	}
+
+	return user.Name
+}
+
+// buildMemberAccounts maps the accounts the identity map merged into each
+// member. Members with a single account yield no rows.
+func buildMemberAccounts(snapshot *application.Snapshot) []memberAccountInput {
+	out := make([]memberAccountInput, 0)
+
+	for _, member := range snapshot.Members {
+		if member == nil || member.User == nil {
+			continue
+		}
+
+		for _, account := range member.Logins {
+			out = append(out, memberAccountInput{login: member.User.Login, account: account})
+		}
+	}
+
+	return out
+}
+
 // ownerOf returns the repository owner login. It prefers the prefix of
 // nameWithOwner ("owner/name"), which is always authoritative, and falls back to
 // the collected owner login only when the name is not in owner/name form.
@@ -389,6 +755,7 @@ func applyStatCreates(
 			m := memberStats[i]
 			c.SetSnapshotID(snapshotID).
 				SetLogin(m.login).
+				SetName(m.name).
 				SetTotalCommits(m.totalCommits).
 				SetTotalPrCreated(m.totalPRCreated).
 				SetTotalPrMerged(m.totalPRMerged).
@@ -399,7 +766,22 @@ func applyStatCreates(
 				SetFirstActivityYear(m.firstActivityYear).
 				SetPeakActivityYear(m.peakActivityYear).
 				SetPeakActivityCommits(m.peakActivityCommits).
-				SetPrToReviewRatio(m.prToReviewRatio)
+				SetTotalExcludedReviews(m.totalExcludedReviews).
+				SetTotalIssuesClosed(m.issues.ClosedCount).
+				SetTotalIssueCloseSeconds(m.issues.CloseSeconds).
+				SetTotalBugIssuesOpened(m.issues.BugOpened).
+				SetTotalFeatureIssuesOpened(m.issues.FeatureOpened).
+				SetTotalBugIssuesClosed(m.issues.BugClosed).
+				SetTotalFeatureIssuesClosed(m.issues.FeatureClosed).
+				SetTotalFeaturePrs(m.categories.Feature).
+				SetTotalBugPrs(m.categories.Bug).
+				SetTotalChorePrs(m.categories.Chore).
+				SetTotalDocsPrs(m.categories.Docs).
+				SetTotalTestPrs(m.categories.Test).
+				SetTotalInfraPrs(m.categories.Infra).
+				SetTotalOtherPrs(m.categories.Other).
+				SetPrToReviewRatio(m.prToReviewRatio).
+				SetTimeZone(m.timeZone)
 		}).Save(ctx)
 		if err != nil {
 			return fmt.Errorf("create member stats: %w", err)
@@ -437,7 +819,21 @@ func applyStatCreates(
 				SetIssueCount(d.issueCount).
 				SetReviewCount(d.reviewCount).
 				SetAdditions(d.additions).
-				SetDeletions(d.deletions)
+				SetDeletions(d.deletions).
+				SetExcludedReviewCount(d.excludedReviewCount).
+				SetIssuesClosed(d.issues.ClosedCount).
+				SetIssueCloseSeconds(d.issues.CloseSeconds).
+				SetBugIssuesOpened(d.issues.BugOpened).
+				SetFeatureIssuesOpened(d.issues.FeatureOpened).
+				SetBugIssuesClosed(d.issues.BugClosed).
+				SetFeatureIssuesClosed(d.issues.FeatureClosed).
+				SetFeaturePrs(d.categories.Feature).
+				SetBugPrs(d.categories.Bug).
+				SetChorePrs(d.categories.Chore).
+				SetDocsPrs(d.categories.Docs).
+				SetTestPrs(d.categories.Test).
+				SetInfraPrs(d.categories.Infra).
+				SetOtherPrs(d.categories.Other)
 		}).Save(ctx)
 		if err != nil {
 			return fmt.Errorf("create member day stats: %w", err)
@@ -456,7 +852,20 @@ func applyStatCreates(
 				SetIssueCount(r.issueCount).
 				SetReviewCount(r.reviewCount).
 				SetAdditions(r.additions).
-				SetDeletions(r.deletions)
+				SetDeletions(r.deletions).
+				SetIssuesClosed(r.issues.ClosedCount).
+				SetIssueCloseSeconds(r.issues.CloseSeconds).
+				SetBugIssuesOpened(r.issues.BugOpened).
+				SetFeatureIssuesOpened(r.issues.FeatureOpened).
+				SetBugIssuesClosed(r.issues.BugClosed).
+				SetFeatureIssuesClosed(r.issues.FeatureClosed).
+				SetFeaturePrs(r.categories.Feature).
+				SetBugPrs(r.categories.Bug).
+				SetChorePrs(r.categories.Chore).
+				SetDocsPrs(r.categories.Docs).
+				SetTestPrs(r.categories.Test).
+				SetInfraPrs(r.categories.Infra).
+				SetOtherPrs(r.categories.Other)
 		}).Save(ctx)
 		if err != nil {
 			return fmt.Errorf("create member repo stats: %w", err)
@@ -468,8 +877,8 @@ func applyStatCreates(
 
 // maxBulkRows caps the rows per bulk INSERT. PostgreSQL's extended protocol
 // allows at most 65535 bind parameters per statement, and the member×repo×day
-// bucket sets ~11 columns per row, so a single snapshot easily exceeds the
-// limit. 1000 rows (~11k params) stays well under it for every bucket here.
+// bucket sets ~24 columns per row, so a single snapshot easily exceeds the
+// limit. 1000 rows (~24k params) stays well under it for every bucket here.
 const maxBulkRows = 1000
 
 // applyRepoDayAndMetaCreates persists the member×repository×day stats and the
@@ -496,7 +905,20 @@ func applyRepoDayAndMetaCreates(
 				SetIssueCount(d.issueCount).
 				SetReviewCount(d.reviewCount).
 				SetAdditions(d.additions).
-				SetDeletions(d.deletions)
+				SetDeletions(d.deletions).
+				SetIssuesClosed(d.issues.ClosedCount).
+				SetIssueCloseSeconds(d.issues.CloseSeconds).
+				SetBugIssuesOpened(d.issues.BugOpened).
+				SetFeatureIssuesOpened(d.issues.FeatureOpened).
+				SetBugIssuesClosed(d.issues.BugClosed).
+				SetFeatureIssuesClosed(d.issues.FeatureClosed).
+				SetFeaturePrs(d.categories.Feature).
+				SetBugPrs(d.categories.Bug).
+				SetChorePrs(d.categories.Chore).
+				SetDocsPrs(d.categories.Docs).
+				SetTestPrs(d.categories.Test).
+				SetInfraPrs(d.categories.Infra).
+				SetOtherPrs(d.categories.Other)
 		}).Save(ctx)
 		if err != nil {
 			return fmt.Errorf("create member repo day stats: %w", err)
@@ -519,6 +941,258 @@ func applyRepoDayAndMetaCreates(
 	return nil
 }
 
+// applyPullRequestCreates persists the per-pull-request lifecycle rows against
+// the given snapshot ID, chunked like the other high-cardinality buckets.
+func applyPullRequestCreates(
+	ctx context.Context,
+	tx *ent.Tx,
+	snapshotID int,
+	prs []memberPullRequestInput,
+) error {
+	for _, chunk := range chunkRows(prs) {
+		_, err := tx.MemberPullRequest.MapCreateBulk(chunk, func(c *ent.MemberPullRequestCreate, i int) {
+			p := chunk[i]
+			c.SetSnapshotID(snapshotID).
+				SetLogin(p.login).
+				SetNameWithOwner(p.nameWithOwner).
+				SetSourceID(p.sourceID).
+				SetOpenedAt(p.openedAt).
+				SetNillableFirstReviewAt(p.firstReviewAt).
+				SetNillableApprovedAt(p.approvedAt).
+				SetNillableMergedAt(p.mergedAt).
+				SetNillableClosedAt(p.closedAt).
+				SetReviewRounds(p.reviewRounds).
+				SetAdditions(p.additions).
+				SetDeletions(p.deletions).
+				SetChangedFiles(p.changedFiles)
+		}).Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create member pull requests: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// applyReviewRequestCreates persists the per-reviewer review request rows
+// against the given snapshot ID, chunked like the pull request rows.
+func applyReviewRequestCreates(
+	ctx context.Context,
+	tx *ent.Tx,
+	snapshotID int,
+	requests []memberReviewRequestInput,
+) error {
+	for _, chunk := range chunkRows(requests) {
+		_, err := tx.MemberReviewRequest.MapCreateBulk(chunk, func(c *ent.MemberReviewRequestCreate, i int) {
+			r := chunk[i]
+			c.SetSnapshotID(snapshotID).
+				SetLogin(r.login).
+				SetNameWithOwner(r.nameWithOwner).
+				SetSourceID(r.sourceID).
+				SetReviewer(r.reviewer).
+				SetRequestedAt(r.requestedAt).
+				SetCompleted(r.completed).
+				SetPending(r.pending)
+		}).Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create member review requests: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// applyHourStatCreates persists the per-member local-hour activity rows
+// against the given snapshot ID, chunked like the pull request rows.
+func applyHourStatCreates(
+	ctx context.Context,
+	tx *ent.Tx,
+	snapshotID int,
+	hours []memberHourStatInput,
+) error {
+	for _, chunk := range chunkRows(hours) {
+		_, err := tx.MemberHourStat.MapCreateBulk(chunk, func(c *ent.MemberHourStatCreate, i int) {
+			h := chunk[i]
+			c.SetSnapshotID(snapshotID).
+				SetLogin(h.login).
+				SetHourStart(h.hourStart).
+				SetDay(h.day).
+				SetWeekday(h.weekday).
+				SetHour(h.hour).
+				SetActivityCount(h.count)
+		}).Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create member hour stats: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// applyIssueCreates persists the per-issue lifecycle rows against the given
+// snapshot ID, chunked like the other high-cardinality buckets.
+func applyIssueCreates(
+	ctx context.Context,
+	tx *ent.Tx,
+	snapshotID int,
+	issues []memberIssueInput,
+) error {
+	for _, chunk := range chunkRows(issues) {
+		_, err := tx.MemberIssue.MapCreateBulk(chunk, func(c *ent.MemberIssueCreate, i int) {
+			is := chunk[i]
+			c.SetSnapshotID(snapshotID).
+				SetLogin(is.login).
+				SetNameWithOwner(is.nameWithOwner).
+				SetSourceID(is.sourceID).
+				SetAuthor(is.author).
+				SetOpenedAt(is.openedAt).
+				SetNillableClosedAt(is.closedAt).
+				SetClosedBy(is.closedBy).
+				SetStateReason(is.stateReason).
+				SetKind(is.kind)
+		}).Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create member issues: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// applyReviewEdgeCreates persists the reviewer -> author edge rows against the
+// given snapshot ID, chunked like the other high-cardinality buckets.
+func applyReviewEdgeCreates(
+	ctx context.Context,
+	tx *ent.Tx,
+	snapshotID int,
+	edges []reviewEdgeInput,
+) error {
+	for _, chunk := range chunkRows(edges) {
+		_, err := tx.ReviewEdge.MapCreateBulk(chunk, func(c *ent.ReviewEdgeCreate, i int) {
+			e := chunk[i]
+			c.SetSnapshotID(snapshotID).
+				SetReviewer(e.reviewer).
+				SetAuthor(e.author).
+				SetNameWithOwner(e.nameWithOwner).
+				SetDay(e.day).
+				SetReviewCount(e.reviewCount)
+		}).Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create review edges: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// applyDataGapCreates persists the per-member data gap rows against the given
+// snapshot ID.
+func applyDataGapCreates(
+	ctx context.Context,
+	tx *ent.Tx,
+	snapshotID int,
+	gaps []memberDataGapInput,
+) error {
+	for _, chunk := range chunkRows(gaps) {
+		_, err := tx.MemberDataGap.MapCreateBulk(chunk, func(c *ent.MemberDataGapCreate, i int) {
+			g := chunk[i]
+			c.SetSnapshotID(snapshotID).
+				SetLogin(g.login).
+				SetActivityType(g.activityType).
+				SetNameWithOwner(g.nameWithOwner).
+				SetReason(g.reason).
+				SetMessage(g.message)
+		}).Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create member data gaps: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// applyExcludedMemberCreates persists the accounts left out of the roster by
+// the exclusion rules against the given snapshot ID.
+func applyExcludedMemberCreates(
+	ctx context.Context,
+	tx *ent.Tx,
+	snapshotID int,
+	excluded []*domain.ExcludedActor,
+) error {
+	rows := make([]*domain.ExcludedActor, 0, len(excluded))
+
+	for _, actor := range excluded {
+		if actor != nil && actor.Login != "" {
+			rows = append(rows, actor)
+		}
+	}
+
+	for _, chunk := range chunkRows(rows) {
+		_, err := tx.ExcludedMember.MapCreateBulk(chunk, func(c *ent.ExcludedMemberCreate, i int) {
+			c.SetSnapshotID(snapshotID).
+				SetLogin(chunk[i].Login).
+				SetRule(chunk[i].Rule)
+		}).Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create excluded members: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// applyMemberAccountCreates persists the merged accounts of each member
+// against the given snapshot ID.
+func applyMemberAccountCreates(
+	ctx context.Context,
+	tx *ent.Tx,
+	snapshotID int,
+	accounts []memberAccountInput,
+) error {
+	for _, chunk := range chunkRows(accounts) {
+		_, err := tx.MemberAccount.MapCreateBulk(chunk, func(c *ent.MemberAccountCreate, i int) {
+			c.SetSnapshotID(snapshotID).
+				SetLogin(chunk[i].login).
+				SetAccount(chunk[i].account)
+		}).Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create member accounts: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// applyDeliveryWeekCreates persists the per-repository weekly delivery metrics
+// against the given snapshot ID.
+func applyDeliveryWeekCreates(
+	ctx context.Context,
+	tx *ent.Tx,
+	snapshotID int,
+	weeks []*domain.DeliveryWeek,
+) error {
+	for _, chunk := range chunkRows(weeks) {
+		_, err := tx.RepoDeliveryWeek.MapCreateBulk(chunk, func(c *ent.RepoDeliveryWeekCreate, i int) {
+			w := chunk[i]
+			c.SetSnapshotID(snapshotID).
+				SetNameWithOwner(w.Repository).
+				SetWeek(w.Week).
+				SetSource(string(w.Source)).
+				SetDeploymentCount(w.DeploymentCount).
+				SetFailedDeploymentCount(w.FailedDeploymentCount).
+				SetLeadTimeSeconds(w.LeadTimeSeconds).
+				SetLeadTimeCount(w.LeadTimeCount).
+				SetRestoreSeconds(w.RestoreSeconds).
+				SetRestoreCount(w.RestoreCount)
+		}).Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create repo delivery weeks: %w", err)
+		}
+	}
+
+	return nil
+}
+
 // chunkRows splits rows into consecutive slices of at most maxBulkRows so each
 // bulk INSERT stays under PostgreSQL's bind parameter limit.
 func chunkRows[T any](rows []T) [][]T {
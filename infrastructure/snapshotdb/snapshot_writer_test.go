@@ -38,15 +38,17 @@ func TestBuildStatCreates_MemberScalars(t *testing.T) {
 				m.FirstActivityYear = 2018
 				m.PeakActivityYear = 2021
 				m.PeakActivityCommits = 8
+				m.TotalExcludedReviews = 4
 				m.PRToReviewRatio = 1.2857
+				m.TimeZone = "Asia/Tokyo"
 				return &application.Snapshot{Members: []*domain.UserStatistics{m}}
 			},
 			want: []memberStatInput{
 				{
-					login: "octocat", totalCommits: 12, totalPRCreated: 7, totalPRMerged: 5,
+					login: "octocat", name: "octocat Name", totalCommits: 12, totalPRCreated: 7, totalPRMerged: 5,
 					totalIssues: 3, totalReviews: 9, totalAdditions: 1000, totalDeletions: 400,
 					firstActivityYear: 2018, peakActivityYear: 2021, peakActivityCommits: 8,
-					prToReviewRatio: 1.2857,
+					totalExcludedReviews: 4, prToReviewRatio: 1.2857, timeZone: "Asia/Tokyo",
 				},
 			},
 		},
@@ -60,7 +62,7 @@ func TestBuildStatCreates_MemberScalars(t *testing.T) {
 				return &application.Snapshot{Members: []*domain.UserStatistics{nil, nilUser, valid}}
 			},
 			want: []memberStatInput{
-				{login: "valid", totalCommits: 1},
+				{login: "valid", name: "valid Name", totalCommits: 1},
 			},
 		},
 		{
@@ -129,7 +131,7 @@ func TestBuildStatCreates_DayStats(t *testing.T) {
 
 	m := newMember(t, "dev")
 	m.DailyStats = map[string]*domain.DailyStatistics{
-		"2024-01-08": {Date: "2024-01-08", CommitCount: 8, PRCreated: 3, PRMerged: 2, IssueCount: 1, ReviewCount: 5, TotalAdditions: 80, TotalDeletions: 20},
+		"2024-01-08": {Date: "2024-01-08", CommitCount: 8, PRCreated: 3, PRMerged: 2, IssueCount: 1, ReviewCount: 5, TotalAdditions: 80, TotalDeletions: 20, ExcludedReviewCount: 2},
 		"2024-01-09": {Date: "2024-01-09", CommitCount: 4, PRCreated: 1, PRMerged: 1, IssueCount: 0, ReviewCount: 2, TotalAdditions: 40, TotalDeletions: 10},
 	}
 	// A nil daily entry must be skipped without panicking.
@@ -145,7 +147,7 @@ func TestBuildStatCreates_DayStats(t *testing.T) {
 	sort.Slice(dayStats, func(i, j int) bool { return dayStats[i].day < dayStats[j].day })
 
 	want := []memberDayStatInput{
-		{login: "dev", day: "2024-01-08", commitCount: 8, prCreated: 3, prMerged: 2, issueCount: 1, reviewCount: 5, additions: 80, deletions: 20},
+		{login: "dev", day: "2024-01-08", commitCount: 8, prCreated: 3, prMerged: 2, issueCount: 1, reviewCount: 5, additions: 80, deletions: 20, excludedReviewCount: 2},
 		{login: "dev", day: "2024-01-09", commitCount: 4, prCreated: 1, prMerged: 1, issueCount: 0, reviewCount: 2, additions: 40, deletions: 10},
 	}
 
@@ -5,9 +5,11 @@ package presentation
 import (
 	"encoding/csv"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -15,15 +17,75 @@ import (
 	"github.com/Tattsum/github-analytics/domain"
 )
 
+// ErrUnknownOutputFormat は未知の出力形式が指定されたことを表します.
+var ErrUnknownOutputFormat = errors.New("unknown output format")
+
+// OutputFormat は FormatAll が出力するファイルの形式です.
+type OutputFormat string
+
+// 出力形式.
+const (
+	// OutputFormatJSON は統計の JSON（<login>_statistics.json）です.
+	OutputFormatJSON OutputFormat = "json"
+	// OutputFormatCSV は統計の CSV（<login>_statistics.csv）です.
+	OutputFormatCSV OutputFormat = "csv"
+	// OutputFormatText はテキスト要約（<login>_summary.txt）です.
+	OutputFormatText OutputFormat = "text"
+	// OutputFormatPresentation はプレゼン用短文（<login>_presentation.txt）です.
+	OutputFormatPresentation OutputFormat = "presentation"
+)
+
+// allOutputFormats は既定で出力するすべての形式です（出力順）.
+var allOutputFormats = []OutputFormat{
+	OutputFormatJSON,
+	OutputFormatCSV,
+	OutputFormatText,
+	OutputFormatPresentation,
+}
+
+// ParseOutputFormats は形式名の一覧を出力形式に変換します（大文字小文字を区別せず、重複を除きます）.
+// 空の一覧はすべての形式を表します.
+func ParseOutputFormats(names []string) ([]OutputFormat, error) {
+	if len(names) == 0 {
+		return allOutputFormats, nil
+	}
+
+	formats := make([]OutputFormat, 0, len(names))
+
+	for _, name := range names {
+		format := OutputFormat(strings.ToLower(strings.TrimSpace(name)))
+		if !slices.Contains(allOutputFormats, format) {
+			return nil, fmt.Errorf("%w: %q (want one of json, csv, text, presentation)", ErrUnknownOutputFormat, name)
+		}
+
+		if !slices.Contains(formats, format) {
+			formats = append(formats, format)
+		}
+	}
+
+	return formats, nil
+}
+
 // OutputFormatter は出力フォーマットを担当するフォーマッターです.
 type OutputFormatter struct {
 	outputDir string
+	formats   []OutputFormat
 }
 
-// NewOutputFormatter は新しいOutputFormatterを作成します.
+// NewOutputFormatter はすべての形式を出力する新しいOutputFormatterを作成します.
 func NewOutputFormatter(outputDir string) *OutputFormatter {
+	return NewOutputFormatterWithFormats(outputDir, nil)
+}
+
+// NewOutputFormatterWithFormats は formats の形式だけを出力するOutputFormatterを作成します（空ならすべての形式）.
+func NewOutputFormatterWithFormats(outputDir string, formats []OutputFormat) *OutputFormatter {
+	if len(formats) == 0 {
+		formats = allOutputFormats
+	}
+
 	return &OutputFormatter{
 		outputDir: outputDir,
+		formats:   formats,
 	}
 }
 
@@ -33,30 +95,42 @@ const (
 	hoursPerDay = 24
 )
 
-// FormatAll は全ての出力形式を生成します.
+// FormatAll は設定された全ての出力形式を生成します.
 func (f *OutputFormatter) FormatAll(stats *domain.UserStatistics) error {
 	if err := os.MkdirAll(f.outputDir, dirPerm); err != nil {
 		return fmt.Errorf("failed to create output directory: %w", err)
 	}
 
-	// JSON形式で出力
-	if err := f.OutputJSON(stats); err != nil {
-		return fmt.Errorf("failed to output JSON: %w", err)
-	}
-
-	// CSV形式で出力
-	if err := f.OutputCSV(stats); err != nil {
-		return fmt.Errorf("failed to output CSV: %w", err)
+	for _, format := range f.formats {
+		if err := f.output(format, stats); err != nil {
+			return err
+		}
 	}
 
-	// テキスト要約を出力
-	if err := f.OutputTextSummary(stats); err != nil {
-		return fmt.Errorf("failed to output text summary: %w", err)
-	}
+	return nil
+}
 
-	// プレゼン用短文を出力
-	if err := f.OutputPresentationSummary(stats); err != nil {
-		return fmt.Errorf("failed to output presentation summary: %w", err)
+// output は stats を format の形式で出力します.
+func (f *OutputFormatter) output(format OutputFormat, stats *domain.UserStatistics) error {
+	switch format {
+	case OutputFormatJSON:
+		if err := f.OutputJSON(stats); err != nil {
+			return fmt.Errorf("failed to output JSON: %w", err)
+		}
+	case OutputFormatCSV:
+		if err := f.OutputCSV(stats); err != nil {
+			return fmt.Errorf("failed to output CSV: %w", err)
+		}
+	case OutputFormatText:
+		if err := f.OutputTextSummary(stats); err != nil {
+			return fmt.Errorf("failed to output text summary: %w", err)
+		}
+	case OutputFormatPresentation:
+		if err := f.OutputPresentationSummary(stats); err != nil {
+			return fmt.Errorf("failed to output presentation summary: %w", err)
+		}
+	default:
+		return fmt.Errorf("%w: %q", ErrUnknownOutputFormat, format)
 	}
 
 	return nil
@@ -52,6 +52,41 @@ func TestOutputFormatter_FormatAll(t *testing.T) {
 	}
 }
 
+func TestOutputFormatter_FormatAll_SelectedFormats(t *testing.T) {
+	t.Parallel()
+
+	formats, err := ParseOutputFormats([]string{"JSON", " text", "json"})
+	require.NoError(t, err)
+	assert.Equal(t, []OutputFormat{OutputFormatJSON, OutputFormatText}, formats)
+
+	tmpDir := t.TempDir()
+	formatter := NewOutputFormatterWithFormats(tmpDir, formats)
+
+	stats := domain.NewUserStatistics(domain.NewUser("testuser", "Test User", "2020-01-01T00:00:00Z"))
+	require.NoError(t, formatter.FormatAll(stats))
+
+	entries, err := os.ReadDir(tmpDir)
+	require.NoError(t, err)
+
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+
+	assert.ElementsMatch(t, []string{"testuser_statistics.json", "testuser_summary.txt"}, names)
+}
+
+func TestParseOutputFormats(t *testing.T) {
+	t.Parallel()
+
+	formats, err := ParseOutputFormats(nil)
+	require.NoError(t, err)
+	assert.Len(t, formats, 4, "空の一覧はすべての形式")
+
+	_, err = ParseOutputFormats([]string{"json", "xml"})
+	require.ErrorIs(t, err, ErrUnknownOutputFormat)
+}
+
 func TestOutputFormatter_OutputJSON(t *testing.T) {
 	t.Parallel()
 
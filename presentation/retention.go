@@ -0,0 +1,37 @@
+package presentation
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Tattsum/github-analytics/application"
+)
+
+// WriteRetentionPlan は保持ポリシーの判定結果を、スナップショットごとの表として書き出します.
+func WriteRetentionPlan(w io.Writer, plan *application.RetentionPlan) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tCAPTURED_AT\tTAG\tACTION\tREASON")
+
+	for _, d := range plan.Decisions {
+		action := "prune"
+		if d.Keep {
+			action = "keep"
+		}
+
+		tag := d.Snapshot.Tag
+		if tag == "" {
+			tag = "-"
+		}
+
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\n",
+			d.Snapshot.ID, d.Snapshot.CapturedAt.UTC().Format(time.RFC3339), tag, action, d.Reason)
+	}
+
+	if err := tw.Flush(); err != nil {
+		return fmt.Errorf("failed to write retention plan: %w", err)
+	}
+
+	return nil
+}
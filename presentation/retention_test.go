@@ -0,0 +1,44 @@
+package presentation
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Tattsum/github-analytics/application"
+)
+
+func TestWriteRetentionPlan(t *testing.T) {
+	t.Parallel()
+
+	plan := &application.RetentionPlan{Decisions: []*application.RetentionDecision{
+		{
+			Snapshot: &application.SnapshotInfo{ID: 7, CapturedAt: time.Date(2024, time.June, 29, 3, 0, 0, 0, time.UTC)},
+			Keep:     true,
+			Reason:   "latest 1",
+		},
+		{
+			Snapshot: &application.SnapshotInfo{ID: 4, CapturedAt: time.Date(2024, time.April, 20, 3, 0, 0, 0, time.UTC), Tag: "q1"},
+			Keep:     true,
+			Reason:   "tagged q1",
+		},
+		{
+			Snapshot: &application.SnapshotInfo{ID: 2, CapturedAt: time.Date(2024, time.March, 31, 3, 0, 0, 0, time.UTC)},
+			Keep:     false,
+			Reason:   "beyond retention",
+		},
+	}}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteRetentionPlan(&buf, plan))
+
+	want := `ID  CAPTURED_AT           TAG  ACTION  REASON
+7   2024-06-29T03:00:00Z  -    keep    latest 1
+4   2024-04-20T03:00:00Z  q1   keep    tagged q1
+2   2024-03-31T03:00:00Z  -    prune   beyond retention
+`
+	assert.Equal(t, want, buf.String())
+}
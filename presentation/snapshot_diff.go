@@ -0,0 +1,195 @@
+package presentation
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Tattsum/github-analytics/application"
+)
+
+// snapshotDiffJSON はスナップショット差分の JSON 出力の構造です.
+type snapshotDiffJSON struct {
+	Base                snapshotInfoJSON      `json:"base"`
+	Head                snapshotInfoJSON      `json:"head"`
+	AddedMembers        []string              `json:"added_members"`
+	RemovedMembers      []string              `json:"removed_members"`
+	AddedRepositories   []string              `json:"added_repositories"`
+	RemovedRepositories []string              `json:"removed_repositories"`
+	MemberDeltas        []memberDeltaJSON     `json:"member_deltas"`
+	RepositoryDeltas    []repositoryDeltaJSON `json:"repository_deltas"`
+}
+
+// snapshotInfoJSON はスナップショット概要の JSON 出力の構造です.
+type snapshotInfoJSON struct {
+	ID              int    `json:"id"`
+	CapturedAt      string `json:"captured_at"`
+	MemberCount     int    `json:"member_count"`
+	RepositoryCount int    `json:"repository_count"`
+}
+
+// metricDeltasJSON は指標の差分の JSON 出力の構造です.
+type metricDeltasJSON struct {
+	Commits   int `json:"commits"`
+	PRCreated int `json:"pr_created"`
+	PRMerged  int `json:"pr_merged"`
+	Issues    int `json:"issues"`
+	Reviews   int `json:"reviews"`
+	Additions int `json:"additions"`
+	Deletions int `json:"deletions"`
+}
+
+// memberDeltaJSON はメンバーの指標の差分の JSON 出力の構造です.
+type memberDeltaJSON struct {
+	Login string           `json:"login"`
+	Delta metricDeltasJSON `json:"delta"`
+}
+
+// repositoryDeltaJSON はリポジトリの指標の差分の JSON 出力の構造です.
+type repositoryDeltaJSON struct {
+	NameWithOwner string           `json:"name_with_owner"`
+	Delta         metricDeltasJSON `json:"delta"`
+}
+
+// WriteSnapshotDiffJSON はスナップショット差分をインデント付きの JSON で書き出します.
+func WriteSnapshotDiffJSON(w io.Writer, diff *application.SnapshotDiff) error {
+	out := snapshotDiffJSON{
+		Base:                toSnapshotInfoJSON(diff.Base),
+		Head:                toSnapshotInfoJSON(diff.Head),
+		AddedMembers:        diff.AddedMembers,
+		RemovedMembers:      diff.RemovedMembers,
+		AddedRepositories:   diff.AddedRepositories,
+		RemovedRepositories: diff.RemovedRepositories,
+		MemberDeltas:        make([]memberDeltaJSON, 0, len(diff.MemberDeltas)),
+		RepositoryDeltas:    make([]repositoryDeltaJSON, 0, len(diff.RepositoryDeltas)),
+	}
+
+	for _, m := range diff.MemberDeltas {
+		out.MemberDeltas = append(out.MemberDeltas, memberDeltaJSON{Login: m.Login, Delta: metricDeltasJSON(m.Delta)})
+	}
+
+	for _, r := range diff.RepositoryDeltas {
+		out.RepositoryDeltas = append(out.RepositoryDeltas, repositoryDeltaJSON{
+			NameWithOwner: r.NameWithOwner,
+			Delta:         metricDeltasJSON(r.Delta),
+		})
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(out); err != nil {
+		return fmt.Errorf("failed to encode snapshot diff: %w", err)
+	}
+
+	return nil
+}
+
+// WriteSnapshotDiffTable はスナップショット差分を、追加 / 削除の一覧と指標の差分表としてテキストで書き出します.
+func WriteSnapshotDiffTable(w io.Writer, diff *application.SnapshotDiff) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Snapshot diff: #%d (%s) -> #%d (%s)\n\n",
+		diff.Base.ID, diff.Base.CapturedAt.UTC().Format(time.RFC3339),
+		diff.Head.ID, diff.Head.CapturedAt.UTC().Format(time.RFC3339))
+
+	writeMembershipChanges(&b, "Members", diff.AddedMembers, diff.RemovedMembers)
+	writeMembershipChanges(&b, "Repositories", diff.AddedRepositories, diff.RemovedRepositories)
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("failed to write snapshot diff: %w", err)
+	}
+
+	memberRows := make([][]string, 0, len(diff.MemberDeltas))
+	for _, m := range diff.MemberDeltas {
+		memberRows = append(memberRows, append([]string{m.Login}, formatMetricDeltas(m.Delta)...))
+	}
+
+	if err := writeDeltaTable(w, "MEMBER", memberRows); err != nil {
+		return err
+	}
+
+	repoRows := make([][]string, 0, len(diff.RepositoryDeltas))
+	for _, r := range diff.RepositoryDeltas {
+		repoRows = append(repoRows, append([]string{r.NameWithOwner}, formatMetricDeltas(r.Delta)...))
+	}
+
+	return writeDeltaTable(w, "REPOSITORY", repoRows)
+}
+
+// writeMembershipChanges は追加（+）・削除（-）された要素の一覧を書き出します.
+func writeMembershipChanges(b *strings.Builder, label string, added, removed []string) {
+	fmt.Fprintf(b, "%s: +%d -%d\n", label, len(added), len(removed))
+
+	for _, name := range added {
+		fmt.Fprintf(b, "  + %s\n", name)
+	}
+
+	for _, name := range removed {
+		fmt.Fprintf(b, "  - %s\n", name)
+	}
+
+	b.WriteString("\n")
+}
+
+// writeDeltaTable は指標の差分を、先頭列を keyHeader とする表として書き出します.
+// 行が無い場合は変化が無かった旨を1行で書き出します.
+func writeDeltaTable(w io.Writer, keyHeader string, rows [][]string) error {
+	if len(rows) == 0 {
+		if _, err := fmt.Fprintf(w, "%s: no metric changes\n\n", keyHeader); err != nil {
+			return fmt.Errorf("failed to write snapshot diff: %w", err)
+		}
+
+		return nil
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join([]string{
+		keyHeader, "COMMITS", "PR_CREATED", "PR_MERGED", "ISSUES", "REVIEWS", "ADDITIONS", "DELETIONS",
+	}, "\t"))
+
+	for _, row := range rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+
+	if err := tw.Flush(); err != nil {
+		return fmt.Errorf("failed to write snapshot diff: %w", err)
+	}
+
+	if _, err := io.WriteString(w, "\n"); err != nil {
+		return fmt.Errorf("failed to write snapshot diff: %w", err)
+	}
+
+	return nil
+}
+
+// formatMetricDeltas は指標の差分を、増加に + を付けた表のセルへ整形します（変化なしは 0）.
+func formatMetricDeltas(d application.MetricDeltas) []string {
+	values := []int{d.Commits, d.PRCreated, d.PRMerged, d.Issues, d.Reviews, d.Additions, d.Deletions}
+
+	cells := make([]string, 0, len(values))
+	for _, v := range values {
+		if v == 0 {
+			cells = append(cells, "0")
+
+			continue
+		}
+
+		cells = append(cells, fmt.Sprintf("%+d", v))
+	}
+
+	return cells
+}
+
+// toSnapshotInfoJSON はスナップショット概要を JSON 出力の構造へ変換します.
+func toSnapshotInfoJSON(info *application.SnapshotInfo) snapshotInfoJSON {
+	return snapshotInfoJSON{
+		ID:              info.ID,
+		CapturedAt:      info.CapturedAt.UTC().Format(time.RFC3339),
+		MemberCount:     info.MemberCount,
+		RepositoryCount: info.RepositoryCount,
+	}
+}
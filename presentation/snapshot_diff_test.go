@@ -0,0 +1,74 @@
+package presentation
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Tattsum/github-analytics/application"
+)
+
+func testSnapshotDiff() *application.SnapshotDiff {
+	return &application.SnapshotDiff{
+		Base:                &application.SnapshotInfo{ID: 3, CapturedAt: time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC), MemberCount: 2},
+		Head:                &application.SnapshotInfo{ID: 5, CapturedAt: time.Date(2024, time.March, 8, 0, 0, 0, 0, time.UTC), MemberCount: 2},
+		AddedMembers:        []string{"dave"},
+		RemovedMembers:      []string{"bob"},
+		AddedRepositories:   []string{},
+		RemovedRepositories: []string{"acme/legacy"},
+		MemberDeltas: []*application.MemberDelta{
+			{Login: "alice", Delta: application.MetricDeltas{Commits: 4, Reviews: -1}},
+		},
+		RepositoryDeltas: []*application.RepositoryDelta{},
+	}
+}
+
+func TestWriteSnapshotDiffTable(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteSnapshotDiffTable(&buf, testSnapshotDiff()))
+
+	want := `Snapshot diff: #3 (2024-03-01T00:00:00Z) -> #5 (2024-03-08T00:00:00Z)
+
+Members: +1 -1
+  + dave
+  - bob
+
+Repositories: +0 -1
+  - acme/legacy
+
+MEMBER  COMMITS  PR_CREATED  PR_MERGED  ISSUES  REVIEWS  ADDITIONS  DELETIONS
+alice   +4       0           0          0       -1       0          0
+
+REPOSITORY: no metric changes
+
+`
+	assert.Equal(t, want, buf.String())
+}
+
+func TestWriteSnapshotDiffJSON(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteSnapshotDiffJSON(&buf, testSnapshotDiff()))
+
+	var got map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+
+	assert.Equal(t, "2024-03-08T00:00:00Z", got["head"].(map[string]any)["captured_at"])
+	assert.Equal(t, []any{"dave"}, got["added_members"])
+	assert.Equal(t, []any{}, got["added_repositories"])
+	assert.Equal(t, []any{}, got["repository_deltas"])
+
+	deltas := got["member_deltas"].([]any)
+	require.Len(t, deltas, 1)
+	alice := deltas[0].(map[string]any)
+	assert.Equal(t, "alice", alice["login"])
+	assert.InDelta(t, 4, alice["delta"].(map[string]any)["commits"], 0)
+	assert.InDelta(t, -1, alice["delta"].(map[string]any)["reviews"], 0)
+}